@@ -20,6 +20,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/bootstrap/versions"
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/frontend"
+	"github.com/matrixorigin/matrixone/pkg/pb/task"
+	"github.com/matrixorigin/matrixone/pkg/predefine"
 	"github.com/matrixorigin/matrixone/pkg/util/executor"
 )
 
@@ -30,6 +32,7 @@ var clusterUpgEntries = []versions.UpgradeEntry{
 	upg_mo_cdc_task,
 	upg_mo_cdc_watermark,
 	upg_mo_table_stats,
+	upg_event_scheduler_cron_task,
 }
 
 var upg_mo_pubs_add_account_id_column = versions.UpgradeEntry{
@@ -88,3 +91,22 @@ var upg_mo_table_stats = versions.UpgradeEntry{
 		return versions.CheckTableDefinition(txn, accountId, catalog.MO_CATALOG, catalog.MO_TABLE_STATS)
 	},
 }
+
+var upg_event_scheduler_cron_task = versions.UpgradeEntry{
+	Schema:    catalog.MOTaskDB,
+	TableName: "sys_cron_task",
+	UpgType:   versions.MODIFY_METADATA,
+	UpgSql:    genEventSchedulerCronTaskSQL(),
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		sql := fmt.Sprintf("select * from %s.sys_cron_task where task_metadata_id = '%s'", catalog.MOTaskDB, predefine.EventSchedulerTaskID)
+		return versions.CheckTableDataExist(txn, accountId, sql)
+	},
+}
+
+func genEventSchedulerCronTaskSQL() string {
+	sql, err := predefine.GenInitCronTaskSQL(int32(task.TaskCode_EventScheduler))
+	if err != nil {
+		panic(err)
+	}
+	return sql
+}
@@ -19,7 +19,9 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/bootstrap/versions"
 	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/frontend"
 	"github.com/matrixorigin/matrixone/pkg/util/executor"
+	"github.com/matrixorigin/matrixone/pkg/util/sysview"
 )
 
 var tenantUpgEntries = []versions.UpgradeEntry{
@@ -28,6 +30,13 @@ var tenantUpgEntries = []versions.UpgradeEntry{
 	upg_mo_user_add_login_attempts,
 	upg_mo_user_add_lock_time,
 	drop_mo_pubs,
+	upg_mo_triggers,
+	upg_information_schema_triggers,
+	upg_mo_events,
+	upg_mo_event_log,
+	upg_mo_event_history,
+	upg_information_schema_events,
+	upg_mo_ttl,
 }
 
 var upg_mo_user_add_password_last_changed = versions.UpgradeEntry{
@@ -118,3 +127,94 @@ var drop_mo_pubs = versions.UpgradeEntry{
 		return !exist, nil
 	},
 }
+
+var upg_mo_triggers = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_TRIGGERS,
+	UpgType:   versions.CREATE_NEW_TABLE,
+	UpgSql:    frontend.MoCatalogMoTriggersDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		return versions.CheckTableDefinition(txn, accountId, catalog.MO_CATALOG, catalog.MO_TRIGGERS)
+	},
+}
+
+var upg_information_schema_triggers = versions.UpgradeEntry{
+	Schema:    sysview.InformationDBConst,
+	TableName: "TRIGGERS",
+	UpgType:   versions.MODIFY_VIEW,
+	UpgSql:    sysview.InformationSchemaTriggersDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		exists, viewDef, err := versions.CheckViewDefinition(txn, accountId, sysview.InformationDBConst, "TRIGGERS")
+		if err != nil {
+			return false, err
+		}
+
+		if exists && viewDef == sysview.InformationSchemaTriggersDDL {
+			return true, nil
+		}
+		return false, nil
+	},
+	// TRIGGERS was a table before it became a view over mo_catalog.mo_triggers
+	PreSql: fmt.Sprintf("DROP TABLE IF EXISTS %s.%s;", sysview.InformationDBConst, "TRIGGERS"),
+}
+
+var upg_mo_events = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_EVENTS,
+	UpgType:   versions.CREATE_NEW_TABLE,
+	UpgSql:    frontend.MoCatalogMoEventsDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		return versions.CheckTableDefinition(txn, accountId, catalog.MO_CATALOG, catalog.MO_EVENTS)
+	},
+}
+
+var upg_mo_event_log = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_EVENT_LOG,
+	UpgType:   versions.CREATE_NEW_TABLE,
+	UpgSql:    frontend.MoCatalogMoEventLogDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		return versions.CheckTableDefinition(txn, accountId, catalog.MO_CATALOG, catalog.MO_EVENT_LOG)
+	},
+}
+
+var upg_mo_event_history = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_EVENT_HISTORY,
+	UpgType:   versions.CREATE_VIEW,
+	UpgSql:    frontend.MoCatalogMoEventHistoryDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		exists, _, err := versions.CheckViewDefinition(txn, accountId, catalog.MO_CATALOG, catalog.MO_EVENT_HISTORY)
+		return exists, err
+	},
+}
+
+var upg_information_schema_events = versions.UpgradeEntry{
+	Schema:    sysview.InformationDBConst,
+	TableName: "EVENTS",
+	UpgType:   versions.MODIFY_VIEW,
+	UpgSql:    sysview.InformationSchemaEventsDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		exists, viewDef, err := versions.CheckViewDefinition(txn, accountId, sysview.InformationDBConst, "EVENTS")
+		if err != nil {
+			return false, err
+		}
+
+		if exists && viewDef == sysview.InformationSchemaEventsDDL {
+			return true, nil
+		}
+		return false, nil
+	},
+	// EVENTS was a table before it became a view over mo_catalog.mo_events
+	PreSql: fmt.Sprintf("DROP TABLE IF EXISTS %s.%s;", sysview.InformationDBConst, "EVENTS"),
+}
+
+var upg_mo_ttl = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_TTL,
+	UpgType:   versions.CREATE_NEW_TABLE,
+	UpgSql:    frontend.MoCatalogMoTtlDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		return versions.CheckTableDefinition(txn, accountId, catalog.MO_CATALOG, catalog.MO_TTL)
+	},
+}
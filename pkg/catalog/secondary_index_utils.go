@@ -20,17 +20,20 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/matrixorigin/matrixone/pkg/common/fulltext"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
 
 // Index Algorithm names
 const (
-	MoIndexDefaultAlgo  = tree.INDEX_TYPE_INVALID  // used by UniqueIndex or default SecondaryIndex
-	MoIndexBTreeAlgo    = tree.INDEX_TYPE_BTREE    // used for Mocking MySQL behaviour.
-	MoIndexIvfFlatAlgo  = tree.INDEX_TYPE_IVFFLAT  // used for IVF flat index on Vector/Array columns
-	MOIndexMasterAlgo   = tree.INDEX_TYPE_MASTER   // used for Master Index on VARCHAR columns
-	MOIndexFullTextAlgo = tree.INDEX_TYPE_FULLTEXT // used for Fulltext Index on VARCHAR columns
+	MoIndexDefaultAlgo    = tree.INDEX_TYPE_INVALID    // used by UniqueIndex or default SecondaryIndex
+	MoIndexBTreeAlgo      = tree.INDEX_TYPE_BTREE      // used for Mocking MySQL behaviour.
+	MoIndexIvfFlatAlgo    = tree.INDEX_TYPE_IVFFLAT    // used for IVF flat index on Vector/Array columns
+	MOIndexMasterAlgo     = tree.INDEX_TYPE_MASTER     // used for Master Index on VARCHAR columns
+	MOIndexFullTextAlgo   = tree.INDEX_TYPE_FULLTEXT   // used for Fulltext Index on VARCHAR columns
+	MoIndexHnswAlgo       = tree.INDEX_TYPE_HNSW       // used for HNSW index on Vector/Array columns
+	MoIndexMultiValueAlgo = tree.INDEX_TYPE_MULTIVALUE // used for multi-valued index on JSON arrays
 )
 
 // ToLower is used for before comparing AlgoType and IndexAlgoParamOpType. Reason why they are strings
@@ -69,13 +72,43 @@ func IsFullTextIndexAlgo(algo string) bool {
 	return _algo == MOIndexFullTextAlgo.ToString()
 }
 
+func IsHnswIndexAlgo(algo string) bool {
+	_algo := ToLower(algo)
+	return _algo == MoIndexHnswAlgo.ToString()
+}
+
+func IsMultiValueIndexAlgo(algo string) bool {
+	_algo := ToLower(algo)
+	return _algo == MoIndexMultiValueAlgo.ToString()
+}
+
 // ------------------------[START] IndexAlgoParams------------------------
 const (
 	IndexAlgoParamLists     = "lists"
 	IndexAlgoParamOpType    = "op_type"
 	IndexAlgoParamOpType_l2 = "vector_l2_ops"
 	//IndexAlgoParamOpType_ip  = "vector_ip_ops"
-	//IndexAlgoParamOpType_cos = "vector_cosine_ops"
+	IndexAlgoParamOpType_cos = "vector_cosine_ops"
+
+	IndexAlgoParamM              = "m"
+	IndexAlgoParamEfConstruction = "ef_construction"
+	IndexAlgoParamEfSearch       = "ef_search"
+
+	// fulltext parser params, see fulltext.FullTextParserParam
+	IndexAlgoParamParser         = "parser"
+	IndexAlgoParamNgramTokenSize = "ngram_token_size"
+	IndexAlgoParamStopwords      = "stopwords"
+	IndexAlgoParamStemmer        = "stemmer"
+
+	// multi-valued index param, the type in CAST(... AS type ARRAY) of the key part
+	IndexAlgoParamCastType = "cast_type"
+)
+
+// Default HNSW build and search parameters, same as pgvector.
+const (
+	HnswDefaultM              = 16
+	HnswDefaultEfConstruction = 64
+	HnswDefaultEfSearch       = 40
 )
 
 const (
@@ -119,13 +152,20 @@ func IndexParamsToStringList(indexParams string) (string, error) {
 		res += fmt.Sprintf(" %s = %s ", IndexAlgoParamLists, val)
 	}
 
+	for _, param := range []string{IndexAlgoParamM, IndexAlgoParamEfConstruction, IndexAlgoParamEfSearch} {
+		if val, ok := result[param]; ok {
+			res += fmt.Sprintf(" %s = %s ", param, val)
+		}
+	}
+
 	if opType, ok := result[IndexAlgoParamOpType]; ok {
 		opType = ToLower(opType)
-		if opType != IndexAlgoParamOpType_l2 {
+		if opType != IndexAlgoParamOpType_l2 &&
+			opType != IndexAlgoParamOpType_cos {
 			//	opType != IndexAlgoParamOpType_ip &&
-			//	opType != IndexAlgoParamOpType_cos
-			return "", moerr.NewInternalErrorNoCtxf("invalid op_type. not of type '%s'", IndexAlgoParamOpType_l2)
-			//IndexAlgoParamOpType_ip, , IndexAlgoParamOpType_cos)
+			return "", moerr.NewInternalErrorNoCtxf("invalid op_type. not of type '%s', '%s'",
+				IndexAlgoParamOpType_l2, IndexAlgoParamOpType_cos)
+			//IndexAlgoParamOpType_ip,
 
 		}
 
@@ -177,11 +217,28 @@ func fullTextIndexParamsToMap(def *tree.FullTextIndex) (map[string]string, error
 
 	// fulltext index here
 	if def.IndexOption != nil {
-		parsername := strings.ToLower(def.IndexOption.ParserName)
-		if parsername != "ngram" && parsername != "default" && parsername != "json" && parsername != "json_value" {
-			return nil, moerr.NewInternalErrorNoCtx(fmt.Sprintf("invalid parser %s", parsername))
+		opt := def.IndexOption
+		param := fulltext.FullTextParserParam{
+			Parser:         strings.ToLower(opt.ParserName),
+			NgramTokenSize: int(opt.NgramTokenSize),
+			Stopwords:      opt.Stopwords,
+			Stemmer:        strings.ToLower(opt.Stemmer),
+		}
+		if err := param.Validate(); err != nil {
+			return nil, err
+		}
+		if param.Parser != "" {
+			res[IndexAlgoParamParser] = param.Parser
+		}
+		if param.NgramTokenSize > 0 {
+			res[IndexAlgoParamNgramTokenSize] = strconv.Itoa(param.NgramTokenSize)
+		}
+		if param.Stopwords != "" {
+			res[IndexAlgoParamStopwords] = param.Stopwords
+		}
+		if param.Stemmer != "" {
+			res[IndexAlgoParamStemmer] = param.Stemmer
 		}
-		res["parser"] = parsername
 	}
 	return res, nil
 }
@@ -228,6 +285,37 @@ func indexParamsToMap(def interface{}) (map[string]string, error) {
 			} else {
 				res[IndexAlgoParamOpType] = IndexAlgoParamOpType_l2 // set l2 as default
 			}
+		case tree.INDEX_TYPE_HNSW:
+			// NOTE: the parser already rejects the explicit value <= 0, 0 means the option is not set.
+			params := []struct {
+				name string
+				val  int64
+				dflt int64
+			}{
+				{IndexAlgoParamM, idx.IndexOption.HnswM, HnswDefaultM},
+				{IndexAlgoParamEfConstruction, idx.IndexOption.HnswEfConstruction, HnswDefaultEfConstruction},
+				{IndexAlgoParamEfSearch, idx.IndexOption.HnswEfSearch, HnswDefaultEfSearch},
+			}
+			for _, p := range params {
+				if p.val < 0 {
+					return nil, moerr.NewInternalErrorNoCtxf("invalid %s. %s must be > 0", p.name, p.name)
+				} else if p.val == 0 {
+					p.val = p.dflt
+				}
+				res[p.name] = strconv.FormatInt(p.val, 10)
+			}
+
+			if len(idx.IndexOption.AlgoParamVectorOpType) > 0 {
+				opType := ToLower(idx.IndexOption.AlgoParamVectorOpType)
+				if opType != IndexAlgoParamOpType_l2 &&
+					opType != IndexAlgoParamOpType_cos {
+					return nil, moerr.NewInternalErrorNoCtxf("invalid op_type. not of type '%s', '%s'",
+						IndexAlgoParamOpType_l2, IndexAlgoParamOpType_cos)
+				}
+				res[IndexAlgoParamOpType] = opType
+			} else {
+				res[IndexAlgoParamOpType] = IndexAlgoParamOpType_l2 // set l2 as default
+			}
 		default:
 			return nil, moerr.NewInternalErrorNoCtx("invalid index alogorithm type")
 		}
@@ -244,6 +332,15 @@ func DefaultIvfIndexAlgoOptions() map[string]string {
 	return res
 }
 
+func DefaultHnswIndexAlgoOptions() map[string]string {
+	res := make(map[string]string)
+	res[IndexAlgoParamM] = strconv.Itoa(HnswDefaultM)
+	res[IndexAlgoParamEfConstruction] = strconv.Itoa(HnswDefaultEfConstruction)
+	res[IndexAlgoParamEfSearch] = strconv.Itoa(HnswDefaultEfSearch)
+	res[IndexAlgoParamOpType] = IndexAlgoParamOpType_l2 // set l2 as default
+	return res
+}
+
 //------------------------[END] IndexAlgoParams------------------------
 
 // ------------------------[START] Aliaser------------------------
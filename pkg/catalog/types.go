@@ -30,6 +30,8 @@ import (
 const (
 	// for schema
 	PropSchemaExtra = "schema_extra"
+	// PropCompression is the compression algorithm of the table data, e.g. zstd:19
+	PropCompression = "compression"
 
 	Row_ID           = objectio.PhysicalAddr_Attr
 	PrefixPriColName = "__mo_cpkey_"
@@ -166,6 +168,14 @@ const (
 	MO_DATA_KEY = "mo_data_key"
 
 	MO_TABLE_STATS = "mo_table_stats_alpha"
+
+	MO_TRIGGERS = "mo_triggers"
+
+	MO_EVENTS        = "mo_events"
+	MO_EVENT_LOG     = "mo_event_log"
+	MO_EVENT_HISTORY = "mo_event_history"
+
+	MO_TTL = "mo_ttl"
 )
 
 func IsSystemTable(id uint64) bool {
@@ -355,11 +365,32 @@ const (
 	SystemSI_IVFFLAT_TblCol_Entries_pk      = IndexTablePrimaryColName
 	SystemSI_IVFFLAT_TblCol_Entries_entry   = "__mo_index_centroid_fk_entry"
 
-	/************ 3. FULLTEXT Index **************/
+	/************ 3. HNSW Secondary Index ************/
+
+	// HNSW Table Types
+	SystemSI_HNSW_TblType_Metadata = "metadata"
+
+	// HNSW MetadataTable - Column names. The graph itself is persisted in the fileservice,
+	// the metadata table records the current version and file of the graph.
+	SystemSI_HNSW_TblCol_Metadata_key = SystemSI_IVFFLAT_TblCol_Metadata_key
+	SystemSI_HNSW_TblCol_Metadata_val = SystemSI_IVFFLAT_TblCol_Metadata_val
+
+	/************ 4. FULLTEXT Index **************/
 
 	FullTextIndex_TabCol_Word     = "word"
 	FullTextIndex_TabCol_Id       = "doc_id"
 	FullTextIndex_TabCol_Position = "pos"
+
+	/************ 5. Functional and Multi-valued Index **************/
+
+	// FunctionalIndexColPrefix is the name prefix of the hidden stored generated columns which hold
+	// the values of the expression key parts of the indexes.
+	FunctionalIndexColPrefix = "__mo_func_idx_"
+
+	// Multi-valued index table - Column names. Like the fulltext index table, it has one row for each
+	// element of the array and a fake primary key.
+	MultiValueIndex_TabCol_Id    = IndexTablePrimaryColName
+	MultiValueIndex_TabCol_Value = IndexTableIndexColName
 )
 
 const (
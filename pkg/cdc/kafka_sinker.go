@@ -0,0 +1,554 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"go.uber.org/zap"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	v2 "github.com/matrixorigin/matrixone/pkg/util/metric/v2"
+)
+
+const (
+	kafkaConnectorName = "matrixone"
+
+	// debezium operation types
+	kafkaOpRead   = "r"
+	kafkaOpCreate = "c"
+	kafkaOpDelete = "d"
+
+	kafkaQueueFullWait  = 100 * time.Millisecond
+	kafkaCloseFlushMs   = 10 * 1000
+	kafkaCheckTimeoutMs = 10 * 1000
+)
+
+// kafkaSource is the source block of the debezium envelope
+type kafkaSource struct {
+	Connector string `json:"connector"`
+	Name      string `json:"name"`
+	TsMs      int64  `json:"ts_ms"`
+	Snapshot  string `json:"snapshot"`
+	Db        string `json:"db"`
+	Table     string `json:"table"`
+	CommitTs  string `json:"commit_ts"`
+}
+
+// kafkaEnvelope is the value of the message, which is compatible with the debezium envelope
+// without the schema part (the same as the JsonConverter with schemas.enable=false).
+// the `before` of a delete event only contains the primary key columns,
+// because the tombstone of the table only keeps them.
+type kafkaEnvelope struct {
+	Before map[string]any `json:"before"`
+	After  map[string]any `json:"after"`
+	Source kafkaSource    `json:"source"`
+	Op     string         `json:"op"`
+	TsMs   int64          `json:"ts_ms"`
+}
+
+var _ Sinker = new(kafkaSinker)
+
+// kafkaSinker sends every changed row as an event into the topic `<sink db>.<sink table>`.
+//
+// the events are produced asynchronously, SendCommit and SendDummy wait for the acks of
+// all the produced events, so the reader only advances the watermark after the broker
+// has acked all the events before it. kafka has no rollback, the events of a failed round
+// will be sent again in the next round, so the delivery is at-least-once.
+type kafkaSinker struct {
+	producer         *kafka.Producer
+	topic            string
+	dbTblInfo        *DbTableInfo
+	watermarkUpdater *WatermarkUpdater
+	ar               *ActiveRoutine
+
+	// delivery reports of the produced events, drained by Run
+	deliveryCh chan kafka.Event
+	// events that are produced but not acked yet
+	inflight sync.WaitGroup
+
+	// only contains user defined columns, no mo meta cols
+	insertNames []string
+	insertTypes []*types.Type
+	// only contains pk columns
+	pkNames []string
+	pkTypes []*types.Type
+	// position of the pk columns in the insert row
+	pkIdxes []int
+
+	// for collect row data, allocate only once
+	insertRow []any
+	deleteRow []any
+
+	err atomic.Value
+}
+
+var NewKafkaSinker = func(
+	sinkUri UriInfo,
+	dbTblInfo *DbTableInfo,
+	watermarkUpdater *WatermarkUpdater,
+	tableDef *plan.TableDef,
+	ar *ActiveRoutine,
+) (Sinker, error) {
+	producer, err := kafka.NewProducer(genKafkaConfigMap(sinkUri))
+	if err != nil {
+		return nil, err
+	}
+
+	s := &kafkaSinker{
+		producer:         producer,
+		topic:            fmt.Sprintf("%s.%s", dbTblInfo.SinkDbName, dbTblInfo.SinkTblName),
+		dbTblInfo:        dbTblInfo,
+		watermarkUpdater: watermarkUpdater,
+		ar:               ar,
+		deliveryCh:       make(chan kafka.Event, 1024),
+	}
+	logutil.Infof("cdc kafkaSinker(%v) topic = %s, key = %s", s.dbTblInfo, s.topic, genPrimaryKeyStr(tableDef))
+
+	// types
+	for _, col := range tableDef.Cols {
+		// skip internal columns
+		if _, ok := catalog.InternalColumns[col.Name]; ok {
+			continue
+		}
+
+		s.insertNames = append(s.insertNames, col.Name)
+		s.insertTypes = append(s.insertTypes, &types.Type{
+			Oid:   types.T(col.Typ.Id),
+			Width: col.Typ.Width,
+			Scale: col.Typ.Scale,
+		})
+	}
+	for _, name := range tableDef.Pkey.Names {
+		col := tableDef.Cols[tableDef.Name2ColIndex[name]]
+		s.pkNames = append(s.pkNames, name)
+		s.pkTypes = append(s.pkTypes, &types.Type{
+			Oid:   types.T(col.Typ.Id),
+			Width: col.Typ.Width,
+			Scale: col.Typ.Scale,
+		})
+		for i, insertName := range s.insertNames {
+			if insertName == name {
+				s.pkIdxes = append(s.pkIdxes, i)
+				break
+			}
+		}
+	}
+	if len(s.pkIdxes) != len(s.pkNames) {
+		producer.Close()
+		return nil, moerr.NewInternalErrorNoCtxf("cdc kafkaSinker(%v): primary key %s is not a user defined column",
+			s.dbTblInfo, genPrimaryKeyStr(tableDef))
+	}
+
+	// rows
+	s.insertRow = make([]any, len(s.insertTypes))
+	s.deleteRow = make([]any, 1)
+
+	// err
+	s.err = atomic.Value{}
+	return s, nil
+}
+
+func genKafkaConfigMap(sinkUri UriInfo) *kafka.ConfigMap {
+	configMap := &kafka.ConfigMap{
+		"bootstrap.servers": fmt.Sprintf("%s:%d", sinkUri.Ip, sinkUri.Port),
+		"acks":              "all",
+	}
+	if len(sinkUri.User) != 0 {
+		_ = configMap.SetKey("security.protocol", "SASL_PLAINTEXT")
+		_ = configMap.SetKey("sasl.mechanisms", "PLAIN")
+		_ = configMap.SetKey("sasl.username", sinkUri.User)
+		_ = configMap.SetKey("sasl.password", sinkUri.Password)
+	}
+	return configMap
+}
+
+// CheckKafkaConn checks the connectivity of the brokers by fetching the metadata
+var CheckKafkaConn = func(sinkUri UriInfo) error {
+	producer, err := kafka.NewProducer(genKafkaConfigMap(sinkUri))
+	if err != nil {
+		return err
+	}
+	defer producer.Close()
+
+	_, err = producer.GetMetadata(nil, false, kafkaCheckTimeoutMs)
+	return err
+}
+
+func (s *kafkaSinker) Run(_ context.Context, _ *ActiveRoutine) {
+	logutil.Infof("cdc kafkaSinker(%v).Run: start", s.dbTblInfo)
+	defer func() {
+		logutil.Infof("cdc kafkaSinker(%v).Run: end", s.dbTblInfo)
+	}()
+
+	for e := range s.deliveryCh {
+		if msg, ok := e.(*kafka.Message); ok && msg.TopicPartition.Error != nil {
+			logutil.Errorf("cdc kafkaSinker(%v) delivery failed, err: %v", s.dbTblInfo, msg.TopicPartition.Error)
+			v2.CdcKafkaSinkErrorCounter.Inc()
+			// record error
+			s.err.Store(moerr.NewInternalErrorNoCtxf("cdc kafkaSinker delivery failed, err: %v", msg.TopicPartition.Error))
+		}
+		s.inflight.Done()
+	}
+}
+
+func (s *kafkaSinker) Sink(ctx context.Context, data *DecoderOutput) {
+	watermark := s.watermarkUpdater.GetFromMem(s.dbTblInfo.SourceTblIdStr)
+	if data.toTs.LE(&watermark) {
+		logutil.Errorf("cdc kafkaSinker(%v): unexpected watermark: %s, current watermark: %s",
+			s.dbTblInfo, data.toTs.ToString(), watermark.ToString())
+		return
+	}
+
+	// events are sent instantly, nothing is left
+	if data.noMoreData {
+		return
+	}
+
+	start := time.Now()
+	defer func() {
+		v2.CdcSinkDurationHistogram.Observe(time.Since(start).Seconds())
+	}()
+
+	var err error
+	if data.outputTyp == OutputTypeSnapshot {
+		err = s.sinkSnapshot(ctx, data.checkpointBat, data.toTs)
+	} else if data.outputTyp == OutputTypeTail {
+		err = s.sinkTail(ctx, data.insertAtmBatch, data.deleteAtmBatch)
+	} else {
+		err = moerr.NewInternalError(ctx, fmt.Sprintf("cdc kafkaSinker unexpected output type: %v", data.outputTyp))
+	}
+	if err != nil {
+		// keep the type of the stored error consistent
+		s.err.Store(moerr.ConvertGoError(ctx, err))
+	}
+}
+
+func (s *kafkaSinker) SendBegin() {}
+
+func (s *kafkaSinker) SendCommit() {
+	s.inflight.Wait()
+}
+
+func (s *kafkaSinker) SendRollback() {
+	s.inflight.Wait()
+}
+
+func (s *kafkaSinker) SendDummy() {
+	s.inflight.Wait()
+}
+
+func (s *kafkaSinker) Error() error {
+	if val := s.err.Load(); val == nil {
+		return nil
+	} else {
+		return val.(error)
+	}
+}
+
+func (s *kafkaSinker) Reset() {
+	s.inflight.Wait()
+	s.err = atomic.Value{}
+}
+
+func (s *kafkaSinker) Close() {
+	if remain := s.producer.Flush(kafkaCloseFlushMs); remain > 0 {
+		logutil.Errorf("cdc kafkaSinker(%v) close with %d events not delivered", s.dbTblInfo, remain)
+	}
+	s.producer.Close()
+	// stop Run goroutine
+	close(s.deliveryCh)
+	s.insertNames = nil
+	s.insertTypes = nil
+	s.pkNames = nil
+	s.pkTypes = nil
+	s.pkIdxes = nil
+	s.insertRow = nil
+	s.deleteRow = nil
+}
+
+func (s *kafkaSinker) sinkSnapshot(ctx context.Context, bat *batch.Batch, ts types.TS) (err error) {
+	for i := 0; i < batchRowCount(bat); i++ {
+		if err = extractRowFromEveryVector(ctx, bat, i, s.insertRow); err != nil {
+			return
+		}
+		if err = s.sendInsert(ctx, ts, true); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// insertBatch and deleteBatch is sorted by ts
+// for the same ts, delete first, then insert
+func (s *kafkaSinker) sinkTail(ctx context.Context, insertBatch, deleteBatch *AtomicBatch) (err error) {
+	insertIter := insertBatch.GetRowIterator().(*atomicBatchRowIter)
+	deleteIter := deleteBatch.GetRowIterator().(*atomicBatchRowIter)
+	defer func() {
+		insertIter.Close()
+		deleteIter.Close()
+	}()
+
+	sinkInsert := func() error {
+		if err := insertIter.Row(ctx, s.insertRow); err != nil {
+			return err
+		}
+		return s.sendInsert(ctx, insertIter.Item().Ts, false)
+	}
+	sinkDelete := func() error {
+		if err := deleteIter.Row(ctx, s.deleteRow); err != nil {
+			return err
+		}
+		return s.sendDelete(ctx, deleteIter.Item().Ts)
+	}
+
+	// output events until one iterator reach the end
+	insertIterHasNext, deleteIterHasNext := insertIter.Next(), deleteIter.Next()
+	for insertIterHasNext && deleteIterHasNext {
+		insertItem, deleteItem := insertIter.Item(), deleteIter.Item()
+		// compare ts, ignore pk
+		if insertItem.Ts.LT(&deleteItem.Ts) {
+			if err = sinkInsert(); err != nil {
+				return
+			}
+			insertIterHasNext = insertIter.Next()
+		} else {
+			if err = sinkDelete(); err != nil {
+				return
+			}
+			deleteIterHasNext = deleteIter.Next()
+		}
+	}
+
+	// output the rest of insert iterator
+	for insertIterHasNext {
+		if err = sinkInsert(); err != nil {
+			return
+		}
+		insertIterHasNext = insertIter.Next()
+	}
+
+	// output the rest of delete iterator
+	for deleteIterHasNext {
+		if err = sinkDelete(); err != nil {
+			return
+		}
+		deleteIterHasNext = deleteIter.Next()
+	}
+	return
+}
+
+func (s *kafkaSinker) sendInsert(ctx context.Context, ts types.TS, snapshot bool) (err error) {
+	after := make(map[string]any, len(s.insertRow))
+	for i, data := range s.insertRow {
+		if after[s.insertNames[i]], err = convertColIntoJson(ctx, data, s.insertTypes[i]); err != nil {
+			return
+		}
+	}
+
+	key := make(map[string]any, len(s.pkIdxes))
+	for _, idx := range s.pkIdxes {
+		key[s.insertNames[idx]] = after[s.insertNames[idx]]
+	}
+
+	op := kafkaOpCreate
+	if snapshot {
+		op = kafkaOpRead
+	}
+	return s.send(ctx, key, &kafkaEnvelope{
+		After:  after,
+		Source: s.genSource(ts, snapshot),
+		Op:     op,
+		TsMs:   time.Now().UnixMilli(),
+	})
+}
+
+func (s *kafkaSinker) sendDelete(ctx context.Context, ts types.TS) (err error) {
+	key := make(map[string]any, len(s.pkNames))
+	if len(s.pkTypes) == 1 {
+		// single column pk
+		if key[s.pkNames[0]], err = convertColIntoJson(ctx, s.deleteRow[0], s.pkTypes[0]); err != nil {
+			return
+		}
+	} else {
+		// composite pk
+		var pkTuple types.Tuple
+		if pkTuple, _, err = unpackWithSchema(s.deleteRow[0].([]byte)); err != nil {
+			return
+		}
+		for i, pkEle := range pkTuple {
+			if key[s.pkNames[i]], err = convertColIntoJson(ctx, pkEle, s.pkTypes[i]); err != nil {
+				return
+			}
+		}
+	}
+
+	if err = s.send(ctx, key, &kafkaEnvelope{
+		Before: key,
+		Source: s.genSource(ts, false),
+		Op:     kafkaOpDelete,
+		TsMs:   time.Now().UnixMilli(),
+	}); err != nil {
+		return
+	}
+	// tombstone, let the log compaction remove all the events of the key
+	return s.send(ctx, key, nil)
+}
+
+func (s *kafkaSinker) genSource(ts types.TS, snapshot bool) kafkaSource {
+	source := kafkaSource{
+		Connector: kafkaConnectorName,
+		Name:      s.dbTblInfo.SourceAccountName,
+		TsMs:      ts.Physical() / int64(time.Millisecond),
+		Snapshot:  "false",
+		Db:        s.dbTblInfo.SourceDbName,
+		Table:     s.dbTblInfo.SourceTblName,
+		CommitTs:  ts.ToString(),
+	}
+	if snapshot {
+		source.Snapshot = "true"
+	}
+	return source
+}
+
+// send produces an event asynchronously, the delivery report will be handled in Run
+func (s *kafkaSinker) send(ctx context.Context, key map[string]any, envelope *kafkaEnvelope) (err error) {
+	msg := &kafka.Message{
+		TopicPartition: kafka.TopicPartition{
+			Topic:     &s.topic,
+			Partition: kafka.PartitionAny,
+		},
+	}
+	if msg.Key, err = json.Marshal(key); err != nil {
+		return
+	}
+	if envelope != nil {
+		if msg.Value, err = json.Marshal(envelope); err != nil {
+			return
+		}
+	}
+
+	s.inflight.Add(1)
+	for {
+		if err = s.producer.Produce(msg, s.deliveryCh); err == nil {
+			return
+		}
+		// wait for the local queue to be drained if it is full
+		if kerr, ok := err.(kafka.Error); !ok || kerr.Code() != kafka.ErrQueueFull {
+			break
+		}
+		if err = s.waitQueue(ctx); err != nil {
+			break
+		}
+	}
+
+	s.inflight.Done()
+	logutil.Errorf("cdc kafkaSinker(%v) produce failed, err: %v", s.dbTblInfo, err)
+	return
+}
+
+func (s *kafkaSinker) waitQueue(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-s.ar.Pause:
+		return moerr.NewInternalError(ctx, "cdc kafkaSinker paused")
+	case <-s.ar.Cancel:
+		return moerr.NewInternalError(ctx, "cdc kafkaSinker cancelled")
+	case <-time.After(kafkaQueueFullWait):
+		return nil
+	}
+}
+
+// convertColIntoJson converts the column value extracted by extractRowFromVector
+// into the value in the json event
+func convertColIntoJson(
+	ctx context.Context,
+	data any,
+	typ *types.Type,
+) (any, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	switch typ.Oid {
+	case types.T_json:
+		return data.(bytejson.ByteJson).String(), nil
+	case types.T_bool,
+		types.T_bit,
+		types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		return data, nil
+	case types.T_float32:
+		return jsonFloat64(float64(data.(float32))), nil
+	case types.T_float64:
+		return jsonFloat64(data.(float64)), nil
+	case types.T_char,
+		types.T_varchar,
+		types.T_text,
+		types.T_datalink:
+		return string(data.([]byte)), nil
+	case types.T_blob,
+		types.T_binary,
+		types.T_varbinary:
+		// encoded as base64 string, the same as debezium
+		return data.([]byte), nil
+	case types.T_array_float32:
+		return types.ArrayToString(data.([]float32)), nil
+	case types.T_array_float64:
+		return types.ArrayToString(data.([]float64)), nil
+	case types.T_date:
+		return data.(types.Date).String(), nil
+	case types.T_datetime,
+		types.T_time,
+		types.T_timestamp,
+		types.T_decimal64,
+		types.T_decimal128,
+		types.T_uuid:
+		return data.(string), nil
+	case types.T_enum:
+		return data.(types.Enum).String(), nil
+	default:
+		logutil.Error(
+			"Failed to convert column into json, unsupported type",
+			zap.Int("typeID", int(typ.Oid)))
+		return nil, moerr.NewInternalErrorf(ctx, "convertColIntoJson : unsupported type %d", typ.Oid)
+	}
+}
+
+// jsonFloat64 returns the float value, or its string for Inf and NaN which are not valid json numbers
+func jsonFloat64(value float64) any {
+	if math.IsInf(value, 1) {
+		return "+Infinity"
+	} else if math.IsInf(value, -1) {
+		return "-Infinity"
+	} else if math.IsNaN(value) {
+		return "NaN"
+	}
+	return value
+}
@@ -0,0 +1,297 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+)
+
+func newMockKafkaUri(t *testing.T, mockCluster *kafka.MockCluster) UriInfo {
+	host, port, err := net.SplitHostPort(mockCluster.BootstrapServers())
+	require.NoError(t, err)
+	portInt, err := strconv.Atoi(port)
+	require.NoError(t, err)
+	return UriInfo{
+		SinkTyp: KafkaSink,
+		Ip:      host,
+		Port:    portInt,
+	}
+}
+
+func readKafkaMessages(t *testing.T, mockCluster *kafka.MockCluster, topic string, n int) []*kafka.Message {
+	consumer, err := kafka.NewConsumer(&kafka.ConfigMap{
+		"bootstrap.servers": mockCluster.BootstrapServers(),
+		"group.id":          "test",
+		"auto.offset.reset": "earliest",
+	})
+	require.NoError(t, err)
+	defer consumer.Close()
+	require.NoError(t, consumer.Assign([]kafka.TopicPartition{{Topic: &topic, Partition: 0, Offset: kafka.OffsetBeginning}}))
+
+	msgs := make([]*kafka.Message, 0, n)
+	for len(msgs) < n {
+		msg, err := consumer.ReadMessage(10 * time.Second)
+		require.NoError(t, err)
+		msgs = append(msgs, msg)
+	}
+	return msgs
+}
+
+func Test_kafkaSinker_Sink(t *testing.T) {
+	ctx := context.Background()
+	t0 := types.BuildTS(0, 1)
+	t1 := types.BuildTS(1, 1)
+	t2 := types.BuildTS(2, 1)
+
+	mockCluster, err := kafka.NewMockCluster(1)
+	require.NoError(t, err)
+	defer mockCluster.Close()
+
+	topic := "dbName.tblName"
+	require.NoError(t, mockCluster.CreateTopic(topic, 1, 1))
+
+	dbTblInfo := &DbTableInfo{
+		SourceDbName:   "srcDb",
+		SourceTblName:  "srcTbl",
+		SourceTblIdStr: "1_0",
+		SinkDbName:     "dbName",
+		SinkTblName:    "tblName",
+	}
+
+	watermarkUpdater := &WatermarkUpdater{
+		watermarkMap: &sync.Map{},
+	}
+	watermarkUpdater.UpdateMem("1_0", t0)
+
+	tableDef := &plan.TableDef{
+		Cols: []*plan.ColDef{
+			{
+				Name: "pk",
+				Typ:  plan.Type{Id: int32(types.T_uint64)},
+			},
+			{
+				Name: "name",
+				Typ:  plan.Type{Id: int32(types.T_varchar)},
+			},
+		},
+		Name2ColIndex: map[string]int32{"pk": 0, "name": 1},
+		Pkey: &plan.PrimaryKeyDef{
+			Names: []string{"pk"},
+		},
+	}
+
+	ar := NewCdcActiveRoutine()
+	s, err := NewSinker(newMockKafkaUri(t, mockCluster), dbTblInfo, watermarkUpdater, tableDef, 0, 0, ar, 0, "")
+	require.NoError(t, err)
+	go s.Run(ctx, ar)
+	defer s.Close()
+
+	// first receive a ckp
+	ckpBat := batch.New([]string{"pk", "name", "ts"})
+	ckpBat.Vecs[0] = testutil.MakeUint64Vector([]uint64{1, 2}, nil)
+	ckpBat.Vecs[1] = testutil.MakeVarcharVector([]string{"a", "b"}, nil)
+	ckpBat.Vecs[2] = testutil.MakeTSVector([]types.TS{t1, t1}, nil)
+	ckpBat.SetRowCount(2)
+
+	s.Sink(ctx, &DecoderOutput{
+		outputTyp:     OutputTypeSnapshot,
+		fromTs:        t0,
+		toTs:          t1,
+		checkpointBat: ckpBat,
+	})
+	s.Sink(ctx, &DecoderOutput{
+		noMoreData: true,
+		fromTs:     t0,
+		toTs:       t1,
+	})
+	s.SendDummy()
+	require.NoError(t, s.Error())
+	watermarkUpdater.UpdateMem("1_0", t1)
+
+	// receive a tail
+	packer := types.NewPacker()
+	defer packer.Close()
+
+	insertAtomicBat := NewAtomicBatch(testutil.TestUtilMp)
+	insertBat := batch.New([]string{"pk", "name", "ts"})
+	insertBat.Vecs[0] = testutil.MakeUint64Vector([]uint64{3}, nil)
+	insertBat.Vecs[1] = testutil.MakeVarcharVector([]string{"c"}, nil)
+	insertBat.Vecs[2] = testutil.MakeTSVector([]types.TS{t2}, nil)
+	insertBat.SetRowCount(1)
+	insertAtomicBat.Append(packer, insertBat, 2, 0)
+	defer insertAtomicBat.Close()
+
+	deleteAtomicBat := NewAtomicBatch(testutil.TestUtilMp)
+	deleteBat := batch.New([]string{"pk", "ts"})
+	deleteBat.Vecs[0] = testutil.MakeUint64Vector([]uint64{1}, nil)
+	deleteBat.Vecs[1] = testutil.MakeTSVector([]types.TS{t2}, nil)
+	deleteBat.SetRowCount(1)
+	deleteAtomicBat.Append(packer, deleteBat, 1, 0)
+	defer deleteAtomicBat.Close()
+
+	s.SendBegin()
+	s.Sink(ctx, &DecoderOutput{
+		outputTyp:      OutputTypeTail,
+		fromTs:         t1,
+		toTs:           t2,
+		insertAtmBatch: insertAtomicBat,
+		deleteAtmBatch: deleteAtomicBat,
+	})
+	s.SendCommit()
+	s.SendDummy()
+	require.NoError(t, s.Error())
+
+	// 2 snapshot rows, 1 delete with its tombstone, 1 insert
+	msgs := readKafkaMessages(t, mockCluster, topic, 5)
+
+	expected := []struct {
+		key    string
+		op     string
+		before map[string]any
+		after  map[string]any
+	}{
+		{key: `{"pk":1}`, op: kafkaOpRead, after: map[string]any{"pk": float64(1), "name": "a"}},
+		{key: `{"pk":2}`, op: kafkaOpRead, after: map[string]any{"pk": float64(2), "name": "b"}},
+		{key: `{"pk":1}`, op: kafkaOpDelete, before: map[string]any{"pk": float64(1)}},
+		{key: `{"pk":1}`},
+		{key: `{"pk":3}`, op: kafkaOpCreate, after: map[string]any{"pk": float64(3), "name": "c"}},
+	}
+	for i, msg := range msgs {
+		assert.Equal(t, expected[i].key, string(msg.Key))
+		if expected[i].op == "" {
+			// tombstone
+			assert.Nil(t, msg.Value)
+			continue
+		}
+
+		var envelope kafkaEnvelope
+		require.NoError(t, json.Unmarshal(msg.Value, &envelope))
+		assert.Equal(t, expected[i].op, envelope.Op)
+		assert.Equal(t, expected[i].before, envelope.Before)
+		assert.Equal(t, expected[i].after, envelope.After)
+		assert.Equal(t, kafkaConnectorName, envelope.Source.Connector)
+		assert.Equal(t, "srcDb", envelope.Source.Db)
+		assert.Equal(t, "srcTbl", envelope.Source.Table)
+		assert.Equal(t, expected[i].op == kafkaOpRead, envelope.Source.Snapshot == "true")
+	}
+}
+
+func Test_kafkaSinker_DeliveryFailed(t *testing.T) {
+	ctx := context.Background()
+
+	mockCluster, err := kafka.NewMockCluster(1)
+	require.NoError(t, err)
+	defer mockCluster.Close()
+
+	dbTblInfo := &DbTableInfo{
+		SourceTblIdStr: "1_0",
+		SinkDbName:     "dbName",
+		SinkTblName:    "tblName",
+	}
+	watermarkUpdater := &WatermarkUpdater{
+		watermarkMap: &sync.Map{},
+	}
+	tableDef := &plan.TableDef{
+		Cols: []*plan.ColDef{
+			{
+				Name: "pk",
+				Typ:  plan.Type{Id: int32(types.T_int64)},
+			},
+		},
+		Name2ColIndex: map[string]int32{"pk": 0},
+		Pkey: &plan.PrimaryKeyDef{
+			Names: []string{"pk"},
+		},
+	}
+
+	ar := NewCdcActiveRoutine()
+	sinkUri := newMockKafkaUri(t, mockCluster)
+	s, err := NewKafkaSinker(sinkUri, dbTblInfo, watermarkUpdater, tableDef, ar)
+	require.NoError(t, err)
+	// fail fast
+	s.(*kafkaSinker).producer.Close()
+	s.(*kafkaSinker).producer, err = kafka.NewProducer(&kafka.ConfigMap{
+		"bootstrap.servers":  mockCluster.BootstrapServers(),
+		"message.timeout.ms": 500,
+	})
+	require.NoError(t, err)
+	go s.Run(ctx, ar)
+	defer s.Close()
+
+	require.NoError(t, mockCluster.SetBrokerDown(1))
+
+	ckpBat := batch.New([]string{"pk", "ts"})
+	ckpBat.Vecs[0] = testutil.MakeInt64Vector([]int64{1}, nil)
+	ckpBat.Vecs[1] = testutil.MakeTSVector([]types.TS{types.BuildTS(1, 1)}, nil)
+	ckpBat.SetRowCount(1)
+
+	s.Sink(ctx, &DecoderOutput{
+		outputTyp:     OutputTypeSnapshot,
+		toTs:          types.BuildTS(1, 1),
+		checkpointBat: ckpBat,
+	})
+	// the error of the delivery must be reported before the watermark is updated
+	s.SendDummy()
+	assert.Error(t, s.Error())
+
+	s.Reset()
+	assert.NoError(t, s.Error())
+}
+
+func Test_convertColIntoJson(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		data any
+		typ  types.T
+		want any
+	}{
+		{data: nil, typ: types.T_int32, want: nil},
+		{data: int32(1), typ: types.T_int32, want: int32(1)},
+		{data: math.Inf(1), typ: types.T_float64, want: "+Infinity"},
+		{data: float32(1.5), typ: types.T_float32, want: float64(1.5)},
+		{data: []byte("abc"), typ: types.T_varchar, want: "abc"},
+		{data: []byte("abc"), typ: types.T_varbinary, want: []byte("abc")},
+		{data: types.Date(0), typ: types.T_date, want: types.Date(0).String()},
+		{data: "1.23", typ: types.T_decimal64, want: "1.23"},
+		{data: []float32{1, 2}, typ: types.T_array_float32, want: "[1, 2]"},
+	}
+	for _, tt := range tests {
+		typ := tt.typ.ToType()
+		got, err := convertColIntoJson(ctx, tt.data, &typ)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.want, got)
+	}
+
+	typ := types.T_Rowid.ToType()
+	_, err := convertColIntoJson(ctx, types.Rowid{}, &typ)
+	assert.Error(t, err)
+}
@@ -63,6 +63,10 @@ func NewSinker(
 		return NewConsoleSinker(dbTblInfo, watermarkUpdater), nil
 	}
 
+	if sinkUri.SinkTyp == KafkaSink {
+		return NewKafkaSinker(sinkUri, dbTblInfo, watermarkUpdater, tableDef, ar)
+	}
+
 	sink, err := NewMysqlSink(sinkUri.User, sinkUri.Password, sinkUri.Ip, sinkUri.Port, retryTimes, retryDuration, sendSqlTimeout)
 	if err != nil {
 		return nil, err
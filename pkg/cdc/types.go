@@ -40,8 +40,10 @@ const (
 	MysqlSink       = "mysql"
 	MatrixoneSink   = "matrixone"
 	ConsoleSink     = "console"
+	KafkaSink       = "kafka"
 	SourceUriPrefix = "mysql://"
 	SinkUriPrefix   = "mysql://"
+	KafkaUriPrefix  = "kafka://"
 	ConsolePrefix   = "console://" //only used in testing stage
 
 	SASCommon = "common"
@@ -371,10 +371,19 @@ func (s *service) registerExecutorsLocked() {
 			if err != nil {
 				return err
 			}
+
+			ctx3, cancel3 := context.WithTimeoutCause(ctx, 10*time.Minute, moerr.CauseRetentionTTL)
+			err = purgeExpiredRows(ctx3, s.sqlExecutor, accountID, time.Now())
+			cancel3()
+			if err != nil {
+				return moerr.AttachCause(ctx3, err)
+			}
 		}
 		return nil
 	})
 
+	s.task.runner.RegisterExecutor(task.TaskCode_EventScheduler, frontend.EventSchedulerExecutor(s.sqlExecutor, ieFactory))
+
 	s.task.runner.RegisterExecutor(task.TaskCode_InitCdc,
 		frontend.RegisterCdcExecutor(
 			s.logger,
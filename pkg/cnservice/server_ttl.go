@@ -0,0 +1,157 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cnservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/util/executor"
+	"go.uber.org/zap"
+)
+
+const (
+	// ttlBatchSize is the max number of the expired rows deleted in one transaction
+	ttlBatchSize = 10000
+	// ttlMaxBatches is the max number of the batches deleted from a table in one run,
+	// the rest are deleted in the next run of the retention task
+	ttlMaxBatches = 100
+
+	selectTTLTablesSql = "select t.table_id, r.reldatabase, r.relname, t.ttl_column, t.interval_value, t.interval_unit from mo_catalog.mo_ttl t join mo_catalog.mo_tables r on t.table_id = r.rel_id"
+	dropExpiredObjsSql = "select mo_ctl('cn', 'drop-expired-objects', '%d.%d:%d:%s')"
+	// the times are passed as unix seconds and the statements are run in UTC, so they
+	// do not depend on the time zone of the server
+	deleteExpiredSql    = "delete from `%s`.`%s` where `%s` < from_unixtime(%d) limit %d"
+	updateLastPurgedSql = "update mo_catalog.mo_ttl set last_purged = from_unixtime(%d) where table_id = %d"
+)
+
+// ttlTable is the table with TTL in mo_catalog.mo_ttl
+type ttlTable struct {
+	tableId   uint64
+	dbName    string
+	tableName string
+	column    string
+	interval  int64
+	unit      string
+}
+
+// ttlCutoff returns now - INTERVAL n unit, the rows earlier than it are expired.
+func ttlCutoff(now time.Time, n int64, unit string) (time.Time, error) {
+	v := int(n)
+	switch unit {
+	case "SECOND":
+		return now.Add(-time.Duration(n) * time.Second), nil
+	case "MINUTE":
+		return now.Add(-time.Duration(n) * time.Minute), nil
+	case "HOUR":
+		return now.Add(-time.Duration(n) * time.Hour), nil
+	case "DAY":
+		return now.AddDate(0, 0, -v), nil
+	case "WEEK":
+		return now.AddDate(0, 0, -7*v), nil
+	case "MONTH":
+		return now.AddDate(0, -v, 0), nil
+	case "QUARTER":
+		return now.AddDate(0, -3*v, 0), nil
+	case "YEAR":
+		return now.AddDate(-v, 0, 0), nil
+	}
+	return time.Time{}, moerr.NewNotSupportedNoCtxf("TTL interval unit %s", unit)
+}
+
+// purgeExpiredRows deletes the expired rows of the tables with TTL of the account. The objects
+// whose rows are all expired are dropped as a whole first unless the table has indexes, foreign
+// key children, triggers or materialized views, then the rest expired rows are deleted in batches.
+func purgeExpiredRows(ctx context.Context, exec executor.SQLExecutor, accountID int32, now time.Time) error {
+	opts := executor.Options{}.WithAccountID(uint32(accountID)).WithDisableTrace()
+	result, err := exec.Exec(ctx, selectTTLTablesSql, opts.WithWaitCommittedLogApplied())
+	if err != nil {
+		return err
+	}
+	var tables []ttlTable
+	result.ReadRows(func(rows int, cols []*vector.Vector) bool {
+		for i := 0; i < rows; i++ {
+			tables = append(tables, ttlTable{
+				tableId:   vector.GetFixedAtNoTypeCheck[uint64](cols[0], i),
+				dbName:    cols[1].GetStringAt(i),
+				tableName: cols[2].GetStringAt(i),
+				column:    cols[3].GetStringAt(i),
+				interval:  vector.GetFixedAtNoTypeCheck[int64](cols[4], i),
+				unit:      cols[5].GetStringAt(i),
+			})
+		}
+		return true
+	})
+	result.Close()
+
+	for _, tbl := range tables {
+		if err = purgeTable(ctx, exec, accountID, tbl, now); err != nil {
+			logutil.Error("[TTL] failed to purge the expired rows",
+				zap.Int32("account", accountID),
+				zap.String("table", fmt.Sprintf("%s.%s", tbl.dbName, tbl.tableName)),
+				zap.Error(err),
+			)
+		}
+	}
+	return nil
+}
+
+func purgeTable(ctx context.Context, exec executor.SQLExecutor, accountID int32, tbl ttlTable, now time.Time) error {
+	cutoff, err := ttlCutoff(now, tbl.interval, tbl.unit)
+	if err != nil {
+		return err
+	}
+
+	// mo_ctl is only allowed for the sys account, the account of the table is in the parameter
+	res, err := exec.Exec(ctx,
+		fmt.Sprintf(dropExpiredObjsSql, tbl.tableId, accountID, cutoff.Unix(), tbl.column),
+		executor.Options{}.WithWaitCommittedLogApplied().WithDisableTrace())
+	if err != nil {
+		// the rows of the objects are deleted by the batches below
+		logutil.Warn("[TTL] failed to drop the expired objects",
+			zap.Uint64("table", tbl.tableId),
+			zap.Error(err),
+		)
+	} else {
+		res.Close()
+	}
+
+	opts := executor.Options{}.WithAccountID(uint32(accountID)).WithTimeZone(time.UTC).WithDisableTrace()
+	deleteSql := fmt.Sprintf(deleteExpiredSql, tbl.dbName, tbl.tableName, tbl.column,
+		cutoff.Unix(), ttlBatchSize)
+	for i := 0; i < ttlMaxBatches; i++ {
+		res, err = exec.Exec(ctx, deleteSql, opts)
+		if err != nil {
+			return err
+		}
+		affected := res.AffectedRows
+		res.Close()
+		if affected < ttlBatchSize {
+			break
+		}
+	}
+
+	res, err = exec.Exec(ctx,
+		fmt.Sprintf(updateLastPurgedSql, now.Unix(), tbl.tableId), opts)
+	if err != nil {
+		return err
+	}
+	res.Close()
+	return nil
+}
@@ -0,0 +1,102 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cnservice
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/util/executor"
+)
+
+func TestTTLCutoff(t *testing.T) {
+	now := time.Date(2024, 3, 31, 12, 0, 0, 0, time.UTC)
+	cases := []struct {
+		n    int64
+		unit string
+		want time.Time
+	}{
+		{30, "SECOND", time.Date(2024, 3, 31, 11, 59, 30, 0, time.UTC)},
+		{90, "MINUTE", time.Date(2024, 3, 31, 10, 30, 0, 0, time.UTC)},
+		{12, "HOUR", time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)},
+		{30, "DAY", time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)},
+		{2, "WEEK", time.Date(2024, 3, 17, 12, 0, 0, 0, time.UTC)},
+		{1, "MONTH", time.Date(2024, 2, 31, 12, 0, 0, 0, time.UTC)},
+		{1, "QUARTER", time.Date(2023, 12, 31, 12, 0, 0, 0, time.UTC)},
+		{1, "YEAR", time.Date(2023, 3, 31, 12, 0, 0, 0, time.UTC)},
+	}
+	for _, c := range cases {
+		got, err := ttlCutoff(now, c.n, c.unit)
+		require.NoError(t, err)
+		require.Equal(t, c.want, got, c.unit)
+	}
+	_, err := ttlCutoff(now, 1, "MICROSECOND")
+	require.Error(t, err)
+}
+
+func TestPurgeExpiredRows(t *testing.T) {
+	mp := mpool.MustNewZero()
+	now := time.Date(2024, 7, 31, 12, 0, 0, 0, time.Local)
+
+	var sqls []string
+	deletes := 0
+	exec := executor.NewMemExecutor(func(sql string) (executor.Result, error) {
+		sqls = append(sqls, sql)
+		switch {
+		case sql == selectTTLTablesSql:
+			mr := executor.NewMemResult([]types.Type{
+				types.T_uint64.ToType(),
+				types.T_varchar.ToType(),
+				types.T_varchar.ToType(),
+				types.T_varchar.ToType(),
+				types.T_int64.ToType(),
+				types.T_varchar.ToType(),
+			}, mp)
+			mr.NewBatchWithRowCount(1)
+			require.NoError(t, executor.AppendFixedRows(mr, 0, []uint64{272510}))
+			require.NoError(t, executor.AppendStringRows(mr, 1, []string{"db1"}))
+			require.NoError(t, executor.AppendStringRows(mr, 2, []string{"t1"}))
+			require.NoError(t, executor.AppendStringRows(mr, 3, []string{"created_at"}))
+			require.NoError(t, executor.AppendFixedRows(mr, 4, []int64{30}))
+			require.NoError(t, executor.AppendStringRows(mr, 5, []string{"DAY"}))
+			return mr.GetResult(), nil
+		case strings.HasPrefix(sql, "delete"):
+			// the first batch is full, the second one is the last
+			deletes++
+			if deletes == 1 {
+				return executor.Result{AffectedRows: ttlBatchSize}, nil
+			}
+			return executor.Result{AffectedRows: 10}, nil
+		}
+		return executor.Result{}, nil
+	})
+
+	require.NoError(t, purgeExpiredRows(context.Background(), exec, 1, now))
+	cutoff := now.AddDate(0, 0, -30)
+	require.Equal(t, []string{
+		selectTTLTablesSql,
+		"select mo_ctl('cn', 'drop-expired-objects', '272510.1:" + strconv.FormatInt(cutoff.Unix(), 10) + ":created_at')",
+		"delete from `db1`.`t1` where `created_at` < from_unixtime(" + strconv.FormatInt(cutoff.Unix(), 10) + ") limit 10000",
+		"delete from `db1`.`t1` where `created_at` < from_unixtime(" + strconv.FormatInt(cutoff.Unix(), 10) + ") limit 10000",
+		"update mo_catalog.mo_ttl set last_purged = from_unixtime(" + strconv.FormatInt(now.Unix(), 10) + ") where table_id = 272510",
+	}, sqls)
+}
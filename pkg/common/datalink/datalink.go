@@ -22,7 +22,11 @@ import (
 	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/common/datalink/docx"
+	"github.com/matrixorigin/matrixone/pkg/common/datalink/html"
+	"github.com/matrixorigin/matrixone/pkg/common/datalink/markdown"
 	"github.com/matrixorigin/matrixone/pkg/common/datalink/pdf"
+	"github.com/matrixorigin/matrixone/pkg/common/datalink/pptx"
+	"github.com/matrixorigin/matrixone/pkg/common/datalink/xlsx"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/stage"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
@@ -78,6 +82,14 @@ func (d Datalink) GetPlainText(proc *process.Process) ([]byte, error) {
 		return pdf.GetPlainText(fileBytes)
 	case ".docx":
 		return docx.GetPlainText(fileBytes)
+	case ".xlsx":
+		return xlsx.GetPlainText(fileBytes)
+	case ".pptx":
+		return pptx.GetPlainText(fileBytes)
+	case ".html", ".htm":
+		return html.GetPlainText(fileBytes)
+	case ".md", ".markdown":
+		return markdown.GetPlainText(fileBytes)
 	default:
 		return fileBytes, nil
 	}
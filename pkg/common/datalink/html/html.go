@@ -0,0 +1,155 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package html
+
+import (
+	"bytes"
+	"io"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// html parser is to get all text content of the html file.  The tags are stripped and the entities
+// are decoded.  The content of <script>, <style>, <noscript>, <template>, <svg> and <iframe> are not text and removed.
+// Block elements like <p>, <div>, <li>, <tr> and <h1> break the line so that the text of different blocks
+// are not concatenated into one word.  Whitespaces inside a line are collapsed into one space.
+
+// content of the elements are skipped
+var skipElements = map[atom.Atom]bool{
+	atom.Script:   true,
+	atom.Style:    true,
+	atom.Noscript: true,
+	atom.Template: true,
+	atom.Svg:      true,
+	atom.Iframe:   true,
+	atom.Object:   true,
+}
+
+// elements break the line
+var blockElements = map[atom.Atom]bool{
+	atom.Address:    true,
+	atom.Article:    true,
+	atom.Aside:      true,
+	atom.Blockquote: true,
+	atom.Br:         true,
+	atom.Caption:    true,
+	atom.Dd:         true,
+	atom.Div:        true,
+	atom.Dl:         true,
+	atom.Dt:         true,
+	atom.Fieldset:   true,
+	atom.Figcaption: true,
+	atom.Figure:     true,
+	atom.Footer:     true,
+	atom.Form:       true,
+	atom.H1:         true,
+	atom.H2:         true,
+	atom.H3:         true,
+	atom.H4:         true,
+	atom.H5:         true,
+	atom.H6:         true,
+	atom.Header:     true,
+	atom.Hr:         true,
+	atom.Li:         true,
+	atom.Main:       true,
+	atom.Nav:        true,
+	atom.Ol:         true,
+	atom.P:          true,
+	atom.Pre:        true,
+	atom.Section:    true,
+	atom.Table:      true,
+	atom.Td:         true,
+	atom.Th:         true,
+	atom.Title:      true,
+	atom.Tr:         true,
+	atom.Ul:         true,
+}
+
+type HtmlDocument struct {
+	Lines []string
+}
+
+func (h HtmlDocument) AsText() string {
+	return strings.Join(h.Lines, "\n")
+}
+
+func Parse(doc []byte) (HtmlDocument, error) {
+	return ParseFromReader(bytes.NewReader(doc))
+}
+
+func ParseFromReader(r io.Reader) (HtmlDocument, error) {
+	var doc HtmlDocument
+	var line strings.Builder
+	// depth of the skipped elements
+	skip := 0
+
+	flush := func() {
+		s := strings.Join(strings.Fields(line.String()), " ")
+		if len(s) > 0 {
+			doc.Lines = append(doc.Lines, s)
+		}
+		line.Reset()
+	}
+
+	z := html.NewTokenizer(r)
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			if err := z.Err(); err != io.EOF {
+				return HtmlDocument{}, err
+			}
+			flush()
+			return doc, nil
+		case html.TextToken:
+			if skip == 0 {
+				// Text() returns the text with the entities decoded
+				line.Write(z.Text())
+			}
+		case html.StartTagToken:
+			tn, _ := z.TagName()
+			a := atom.Lookup(tn)
+			if skipElements[a] {
+				skip++
+			} else if blockElements[a] {
+				flush()
+			}
+		case html.EndTagToken:
+			tn, _ := z.TagName()
+			a := atom.Lookup(tn)
+			if skipElements[a] {
+				if skip > 0 {
+					skip--
+				}
+			} else if blockElements[a] {
+				flush()
+			}
+		case html.SelfClosingTagToken:
+			tn, _ := z.TagName()
+			if blockElements[atom.Lookup(tn)] {
+				flush()
+			}
+		}
+	}
+}
+
+func GetPlainText(data []byte) ([]byte, error) {
+	doc, err := Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(doc.AsText()), nil
+}
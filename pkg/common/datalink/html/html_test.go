@@ -0,0 +1,57 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package html
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPlainText(t *testing.T) {
+	doc := `<!DOCTYPE html>
+<html>
+<head>
+  <title>Test &amp; Title</title>
+  <style>body { color: red; }</style>
+  <script type="text/javascript">var x = "<p>not text</p>";</script>
+</head>
+<body>
+  <h1>Heading</h1>
+  <p>This is   a <b>bold</b> paragraph
+     with &lt;entities&gt;.</p>
+  <div>line 1<br/>line 2</div>
+  <noscript>enable javascript</noscript>
+  <ul><li>item 1</li><li>item 2</li></ul>
+  <table><tr><td>cell 1</td><td>cell 2</td></tr></table>
+</body>
+</html>`
+
+	expected := "Test & Title\nHeading\nThis is a bold paragraph with <entities>.\nline 1\nline 2\nitem 1\nitem 2\ncell 1\ncell 2"
+
+	text, err := GetPlainText([]byte(doc))
+	require.NoError(t, err)
+	require.Equal(t, expected, string(text))
+}
+
+func TestGetPlainTextFragment(t *testing.T) {
+	text, err := GetPlainText([]byte("plain text without tags"))
+	require.NoError(t, err)
+	require.Equal(t, "plain text without tags", string(text))
+
+	text, err = GetPlainText([]byte("<style>p {}</style>"))
+	require.NoError(t, err)
+	require.Equal(t, "", string(text))
+}
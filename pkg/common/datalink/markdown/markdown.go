@@ -0,0 +1,185 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package markdown
+
+import (
+	"bufio"
+	"bytes"
+	"html"
+	"regexp"
+	"strings"
+)
+
+// markdown parser is to get all text content of the markdown file by removing the markdown syntax.
+// The file is processed line by line.
+//
+// block syntax:
+//   - heading "# title" and the setext underline "=====" / "-----"
+//   - block quote "> quote"
+//   - list item "- item", "* item", "+ item", "1. item" and task "- [x] item"
+//   - table row "| a | b |" and the delimiter row "|---|---|"
+//   - fenced code block ``` or ~~~, the code is kept as is
+//   - horizontal rule "***", "---" and "___"
+//   - link reference definition "[ref]: http://url"
+//
+// inline syntax:
+//   - emphasis *a*, _a_, **a**, __a__ and strikethrough ~~a~~
+//   - code span `a`
+//   - link [text](url) and [text][ref], image ![alt](url), autolink <http://url>
+//   - html tag and entity
+//   - backslash escape \*
+
+var (
+	headingRe   = regexp.MustCompile(`^ {0,3}#{1,6}(?:\s+|$)`)
+	closingRe   = regexp.MustCompile(`\s+#+\s*$`)
+	setextRe    = regexp.MustCompile(`^ {0,3}(?:=+|-+)\s*$`)
+	ruleRe      = regexp.MustCompile(`^ {0,3}(?:(?:\*\s*){3,}|(?:-\s*){3,}|(?:_\s*){3,})$`)
+	quoteRe     = regexp.MustCompile(`^ {0,3}>\s?`)
+	listRe      = regexp.MustCompile(`^\s*(?:[-*+]|\d{1,9}[.)])\s+(?:\[[ xX]\]\s+)?`)
+	fenceRe     = regexp.MustCompile("^ {0,3}(```|~~~)")
+	refDefRe    = regexp.MustCompile(`^ {0,3}\[[^\]]+\]:\s*\S+.*$`)
+	tableSepRe  = regexp.MustCompile(`^\s*\|?\s*:?-+:?\s*(?:\|\s*:?-+:?\s*)*\|?\s*$`)
+	escapeRe    = regexp.MustCompile("\\\\([\\\\`*_{}\\[\\]()#+\\-.!|~<>])")
+	imageRe     = regexp.MustCompile(`!\[([^\]]*)\](?:\([^)]*\)|\[[^\]]*\])`)
+	linkRe      = regexp.MustCompile(`\[([^\]]*)\](?:\([^)]*\)|\[[^\]]*\])`)
+	autolinkRe  = regexp.MustCompile(`<((?:https?|ftp|mailto):[^>\s]+)>`)
+	tagRe       = regexp.MustCompile(`</?[A-Za-z][^>]*>`)
+	strongRe    = regexp.MustCompile(`\*\*(\S(?:.*?\S)?)\*\*`)
+	strongUnRe  = regexp.MustCompile(`(^|\W)__(\S(?:.*?\S)?)__(\W|$)`)
+	emRe        = regexp.MustCompile(`\*(\S(?:.*?\S)?)\*`)
+	emUnRe      = regexp.MustCompile(`(^|\W)_(\S(?:.*?\S)?)_(\W|$)`)
+	strikeRe    = regexp.MustCompile(`~~(\S(?:.*?\S)?)~~`)
+	codeSpanRe  = regexp.MustCompile("(`+)(.+?)(`+)")
+	placeholder = rune(0xE000)
+)
+
+type MarkdownDocument struct {
+	Lines []string
+}
+
+func (m MarkdownDocument) AsText() string {
+	return strings.Join(m.Lines, "\n")
+}
+
+func Parse(doc []byte) (MarkdownDocument, error) {
+	var md MarkdownDocument
+	// the fence of the current code block, empty if not in code block
+	fence := ""
+
+	scanner := bufio.NewScanner(bytes.NewReader(doc))
+	scanner.Buffer(make([]byte, 0, 64*1024), len(doc)+1)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), " \t\r")
+
+		if m := fenceRe.FindStringSubmatch(line); m != nil {
+			if len(fence) == 0 {
+				fence = m[1]
+				continue
+			} else if m[1] == fence {
+				fence = ""
+				continue
+			}
+		}
+		if len(fence) > 0 {
+			md.Lines = append(md.Lines, line)
+			continue
+		}
+
+		if ruleRe.MatchString(line) || setextRe.MatchString(line) || refDefRe.MatchString(line) || tableSepRe.MatchString(line) {
+			continue
+		}
+
+		for quoteRe.MatchString(line) {
+			line = quoteRe.ReplaceAllString(line, "")
+		}
+		if headingRe.MatchString(line) {
+			line = headingRe.ReplaceAllString(line, "")
+			line = closingRe.ReplaceAllString(line, "")
+		}
+		line = listRe.ReplaceAllString(line, "")
+		if strings.HasPrefix(strings.TrimSpace(line), "|") {
+			line = tableRow(line)
+		}
+
+		line = strings.TrimSpace(inline(line))
+		if len(line) > 0 {
+			md.Lines = append(md.Lines, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return MarkdownDocument{}, err
+	}
+	return md, nil
+}
+
+// tableRow returns the cells of the table row separated by the tab
+func tableRow(line string) string {
+	line = strings.TrimSpace(line)
+	line = strings.TrimPrefix(line, "|")
+	line = strings.TrimSuffix(line, "|")
+	cells := strings.Split(line, "|")
+	for i := range cells {
+		cells[i] = strings.TrimSpace(cells[i])
+	}
+	return strings.Join(cells, "\t")
+}
+
+// inline removes the inline syntax of the line.  The content of the code spans is kept as is.
+func inline(line string) string {
+	// escaped characters are replaced by the placeholders so that they are not treated as syntax
+	line = escapeRe.ReplaceAllStringFunc(line, func(s string) string {
+		return string(placeholder + rune(s[1]))
+	})
+
+	var sb strings.Builder
+	for {
+		loc := codeSpanRe.FindStringSubmatchIndex(line)
+		if loc == nil {
+			break
+		}
+		sb.WriteString(inlineText(line[:loc[0]]))
+		sb.WriteString(strings.TrimSpace(line[loc[4]:loc[5]]))
+		line = line[loc[1]:]
+	}
+	sb.WriteString(inlineText(line))
+
+	return strings.Map(func(r rune) rune {
+		if r >= placeholder && r < placeholder+128 {
+			return r - placeholder
+		}
+		return r
+	}, sb.String())
+}
+
+func inlineText(s string) string {
+	s = imageRe.ReplaceAllString(s, "$1")
+	s = linkRe.ReplaceAllString(s, "$1")
+	s = autolinkRe.ReplaceAllString(s, "$1")
+	s = tagRe.ReplaceAllString(s, "")
+	s = strongRe.ReplaceAllString(s, "$1")
+	s = strongUnRe.ReplaceAllString(s, "$1$2$3")
+	s = emRe.ReplaceAllString(s, "$1")
+	s = emUnRe.ReplaceAllString(s, "$1$2$3")
+	s = strikeRe.ReplaceAllString(s, "$1")
+	return html.UnescapeString(s)
+}
+
+func GetPlainText(data []byte) ([]byte, error) {
+	doc, err := Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(doc.AsText()), nil
+}
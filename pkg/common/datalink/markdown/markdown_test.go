@@ -0,0 +1,71 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package markdown
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPlainText(t *testing.T) {
+	doc := "# Title #\n" +
+		"\n" +
+		"Sub Title\n" +
+		"---------\n" +
+		"\n" +
+		"This is **bold**, *italic*, __strong__, _em_ and ~~strike~~ with snake_case_name.\n" +
+		"See [the docs](https://example.com/docs \"title\") and ![logo](logo.png) or <https://example.com>.\n" +
+		"Use `a * b` and \\*not emphasis\\* &amp; <span>html</span>.\n" +
+		"\n" +
+		"> quoted\n" +
+		"> > nested\n" +
+		"\n" +
+		"- item 1\n" +
+		"* [x] item 2\n" +
+		"1. item 3\n" +
+		"\n" +
+		"***\n" +
+		"\n" +
+		"| a | b |\n" +
+		"|:--|--:|\n" +
+		"| 1 | 2 |\n" +
+		"\n" +
+		"```go\n" +
+		"# not a heading\n" +
+		"x := *p\n" +
+		"```\n" +
+		"\n" +
+		"[docs]: https://example.com/docs\n"
+
+	expected := "Title\n" +
+		"Sub Title\n" +
+		"This is bold, italic, strong, em and strike with snake_case_name.\n" +
+		"See the docs and logo or https://example.com.\n" +
+		"Use a * b and *not emphasis* & html.\n" +
+		"quoted\n" +
+		"nested\n" +
+		"item 1\n" +
+		"item 2\n" +
+		"item 3\n" +
+		"a\tb\n" +
+		"1\t2\n" +
+		"# not a heading\n" +
+		"x := *p"
+
+	text, err := GetPlainText([]byte(doc))
+	require.NoError(t, err)
+	require.Equal(t, expected, string(text))
+}
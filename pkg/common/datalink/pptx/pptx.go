@@ -0,0 +1,167 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pptx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+const (
+	presentationFile     = "ppt/presentation.xml"
+	presentationRelsFile = "ppt/_rels/presentation.xml.rels"
+)
+
+func Parse(r *zip.Reader) (Presentation, error) {
+	files := make(map[string]*zip.File, len(r.File))
+	for _, f := range r.File {
+		files[f.Name] = f
+	}
+
+	var pres xmlPresentation
+	if err := decodeFile(files, presentationFile, &pres); err != nil {
+		return Presentation{}, err
+	}
+
+	var rels xmlRelationships
+	if err := decodeFile(files, presentationRelsFile, &rels); err != nil {
+		return Presentation{}, err
+	}
+	targets := make(map[string]string, len(rels.Relationships))
+	for _, rel := range rels.Relationships {
+		targets[rel.Id] = rel.Target
+	}
+
+	presentation := Presentation{}
+	for _, s := range pres.Slides {
+		target, ok := targets[s.Id]
+		if !ok {
+			return Presentation{}, moerr.NewInternalErrorNoCtx(fmt.Sprintf("slide %s not found in %s", s.Id, presentationRelsFile))
+		}
+
+		slide, err := parseSlide(files, slideFile(target))
+		if err != nil {
+			return Presentation{}, err
+		}
+		presentation.Slides = append(presentation.Slides, slide)
+	}
+	return presentation, nil
+}
+
+// slideFile returns the file name in zip of the relationship target, which is either relative to ppt/ or absolute
+func slideFile(target string) string {
+	if strings.HasPrefix(target, "/") {
+		return strings.TrimPrefix(target, "/")
+	}
+	return path.Join("ppt", target)
+}
+
+// parseSlide traverses the paragraphs <a:p> of the slide and gets the text <a:t> inside
+func parseSlide(files map[string]*zip.File, name string) (Slide, error) {
+	f, ok := files[name]
+	if !ok {
+		return Slide{}, moerr.NewInternalErrorNoCtx(fmt.Sprintf("%s not found in pptx file", name))
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return Slide{}, err
+	}
+	defer rc.Close()
+
+	slide := Slide{}
+	var para strings.Builder
+	decoder := xml.NewDecoder(rc)
+	for {
+		t, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return Slide{}, moerr.NewInternalErrorNoCtx(fmt.Sprintf("Error parsing %s - %s", name, err))
+		}
+
+		switch se := t.(type) {
+		case xml.StartElement:
+			if se.Name.Space != drawingMLNamespace {
+				continue
+			}
+			switch se.Name.Local {
+			case "p":
+				para.Reset()
+			case "br":
+				para.WriteString("\n")
+			case "t":
+				var text string
+				if err := decoder.DecodeElement(&text, &se); err != nil {
+					return Slide{}, moerr.NewInternalErrorNoCtx(fmt.Sprintf("Error parsing %s - %s", name, err))
+				}
+				para.WriteString(text)
+			}
+		case xml.EndElement:
+			if se.Name.Space == drawingMLNamespace && se.Name.Local == "p" {
+				if text := strings.TrimSpace(para.String()); len(text) > 0 {
+					slide.Paragraphs = append(slide.Paragraphs, text)
+				}
+				para.Reset()
+			}
+		}
+	}
+	return slide, nil
+}
+
+func decodeFile(files map[string]*zip.File, name string, v any) error {
+	f, ok := files[name]
+	if !ok {
+		return moerr.NewInternalErrorNoCtx(fmt.Sprintf("%s not found in pptx file", name))
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	if err = xml.NewDecoder(rc).Decode(v); err != nil {
+		return moerr.NewInternalErrorNoCtx(fmt.Sprintf("Error parsing %s - %s", name, err))
+	}
+	return nil
+}
+
+func ParseTextFromReader(reader io.ReaderAt, size int64) (string, error) {
+	r, err := zip.NewReader(reader, size)
+	if err != nil {
+		return "", err
+	}
+
+	pres, err := Parse(r)
+	if err != nil {
+		return "", err
+	}
+	return pres.AsText(), nil
+}
+
+func GetPlainText(data []byte) ([]byte, error) {
+	text, err := ParseTextFromReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	return []byte(text), nil
+}
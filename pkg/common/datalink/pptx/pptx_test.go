@@ -0,0 +1,106 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pptx
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func zipFiles(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, content := range files {
+		f, err := w.Create(name)
+		require.NoError(t, err)
+		_, err = f.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+const (
+	presentationXml = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<p:presentation xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships" xmlns:p="http://schemas.openxmlformats.org/presentationml/2006/main">
+<p:sldIdLst>
+<p:sldId id="256" r:id="rId3"/>
+<p:sldId id="257" r:id="rId2"/>
+</p:sldIdLst>
+</p:presentation>`
+
+	relsXml = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/slideMaster" Target="slideMasters/slideMaster1.xml"/>
+<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/slide" Target="slides/slide1.xml"/>
+<Relationship Id="rId3" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/slide" Target="/ppt/slides/slide2.xml"/>
+</Relationships>`
+
+	slide1Xml = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<p:sld xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships" xmlns:p="http://schemas.openxmlformats.org/presentationml/2006/main">
+<p:cSld><p:spTree>
+<p:sp><p:txBody><a:bodyPr/>
+<a:p><a:r><a:t>Second slide</a:t></a:r></a:p>
+<a:p><a:r><a:t>line 1</a:t></a:r><a:br/><a:r><a:t>line 2</a:t></a:r></a:p>
+<a:p><a:endParaRPr/></a:p>
+</p:txBody></p:sp>
+<p:graphicFrame><a:graphic><a:graphicData><a:tbl>
+<a:tr><a:tc><a:txBody><a:p><a:r><a:t>cell 1</a:t></a:r></a:p></a:txBody></a:tc><a:tc><a:txBody><a:p><a:r><a:t>cell 2</a:t></a:r></a:p></a:txBody></a:tc></a:tr>
+</a:tbl></a:graphicData></a:graphic></p:graphicFrame>
+</p:spTree></p:cSld>
+</p:sld>`
+
+	slide2Xml = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<p:sld xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships" xmlns:p="http://schemas.openxmlformats.org/presentationml/2006/main">
+<p:cSld><p:spTree>
+<p:sp><p:txBody><a:bodyPr/>
+<a:p><a:r><a:t>This is the title </a:t></a:r><a:r><a:rPr b="1"/><a:t>of the first slide</a:t></a:r></a:p>
+<a:p><a:fld type="slidenum"><a:t>1</a:t></a:fld></a:p>
+</p:txBody></p:sp>
+</p:spTree></p:cSld>
+</p:sld>`
+)
+
+func TestGetPlainText(t *testing.T) {
+	data := zipFiles(t, map[string]string{
+		"ppt/presentation.xml":            presentationXml,
+		"ppt/_rels/presentation.xml.rels": relsXml,
+		"ppt/slides/slide1.xml":           slide1Xml,
+		"ppt/slides/slide2.xml":           slide2Xml,
+	})
+
+	expected := "This is the title of the first slide\n1\n\nSecond slide\nline 1\nline 2\ncell 1\ncell 2\n"
+
+	text, err := GetPlainText(data)
+	require.NoError(t, err)
+	require.Equal(t, expected, string(text))
+}
+
+func TestGetPlainTextInvalidFile(t *testing.T) {
+	_, err := GetPlainText([]byte("not a zip file"))
+	require.Error(t, err)
+
+	// missing slide
+	data := zipFiles(t, map[string]string{
+		"ppt/presentation.xml":            presentationXml,
+		"ppt/_rels/presentation.xml.rels": relsXml,
+		"ppt/slides/slide1.xml":           slide1Xml,
+	})
+	_, err = GetPlainText(data)
+	require.Error(t, err)
+}
@@ -0,0 +1,81 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pptx
+
+import "strings"
+
+// pptx parser is to get the text of all slides in the pptx file.
+// pptx file is zip file that contains xml files.
+//   - ppt/presentation.xml lists the slides in order <p:sldIdLst> with the relationship id.
+//   - ppt/_rels/presentation.xml.rels maps the relationship id to the slide file, e.g. slides/slide1.xml.
+//   - ppt/slides/slideN.xml contains the shapes of the slide.  Text of the shapes and tables are in the
+//     paragraphs <a:p> of the DrawingML namespace, each paragraph has multiple runs <a:r> or fields <a:fld>
+//     with a text <a:t>, and <a:br> is the line break inside the paragraph.
+//
+// Slide file name does not tell the order of the slide since slides can be reordered.
+//
+// sample xml with paragraph tag
+//
+//	<p:txBody>
+//	    <a:p>
+//	        <a:r><a:t>This is the title </a:t></a:r>
+//	        <a:r><a:rPr b="1"/><a:t>of the slide</a:t></a:r>
+//	    </a:p>
+//	</p:txBody>
+
+const drawingMLNamespace = "http://schemas.openxmlformats.org/drawingml/2006/main"
+
+type Presentation struct {
+	Slides []Slide
+}
+
+type Slide struct {
+	Paragraphs []string
+}
+
+// AsText returns the text of the slides.  Each paragraph is one line and slides are separated by the empty line.
+func (p Presentation) AsText() string {
+	var sb strings.Builder
+	for i, s := range p.Slides {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		for _, para := range s.Paragraphs {
+			sb.WriteString(para)
+			sb.WriteString("\n")
+		}
+	}
+	return sb.String()
+}
+
+// xml elements
+
+type xmlPresentation struct {
+	Slides []xmlSlideId `xml:"sldIdLst>sldId"`
+}
+
+type xmlSlideId struct {
+	// attribute r:id of the relationship namespace
+	Id string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+}
+
+type xmlRelationships struct {
+	Relationships []xmlRelationship `xml:"Relationship"`
+}
+
+type xmlRelationship struct {
+	Id     string `xml:"Id,attr"`
+	Target string `xml:"Target,attr"`
+}
@@ -0,0 +1,120 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xlsx
+
+import "strings"
+
+// xlsx parser is to get the cell text of all sheets in the xlsx file.
+// xlsx file is zip file that contains xml files.
+//   - xl/workbook.xml lists the sheets in order with the sheet name and the relationship id.
+//   - xl/_rels/workbook.xml.rels maps the relationship id to the sheet file, e.g. worksheets/sheet1.xml.
+//   - xl/sharedStrings.xml contains the strings shared by the cells.  Each string <si> has a text <t> or
+//     multiple rich text runs <r> and each run has a text <t>.
+//   - xl/worksheets/sheetN.xml contains the rows <row> of the sheet and each row has cells <c>.
+//
+// The type attribute t of the cell tells how to get the text of the cell
+//   - s: value <v> is the index of the shared string
+//   - inlineStr: text is in <is><t>
+//   - b: value 0 or 1 is FALSE or TRUE
+//   - others: value <v> is the text, e.g. number, formula string and error
+//
+// sample xml of the sheet
+//
+//	<sheetData>
+//	    <row r="1">
+//	        <c r="A1" t="s"><v>0</v></c>
+//	        <c r="B1"><v>3.14</v></c>
+//	        <c r="C1" t="inlineStr"><is><t>inline text</t></is></c>
+//	    </row>
+//	</sheetData>
+
+type Workbook struct {
+	Sheets []Sheet
+}
+
+type Sheet struct {
+	Name string
+	Rows []Row
+}
+
+type Row struct {
+	Cells []string
+}
+
+// AsText returns the text of the sheets.  Each sheet starts with the sheet name, each row is one line
+// and the cells of the row are separated by the tab.
+func (w Workbook) AsText() string {
+	var sb strings.Builder
+	for i, s := range w.Sheets {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString(s.Name)
+		sb.WriteString("\n")
+		for _, r := range s.Rows {
+			sb.WriteString(strings.Join(r.Cells, "\t"))
+			sb.WriteString("\n")
+		}
+	}
+	return sb.String()
+}
+
+// xml elements
+
+type xmlWorkbook struct {
+	Sheets []xmlSheet `xml:"sheets>sheet"`
+}
+
+type xmlSheet struct {
+	Name string `xml:"name,attr"`
+	// attribute r:id of the relationship namespace
+	Id string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+}
+
+type xmlRelationships struct {
+	Relationships []xmlRelationship `xml:"Relationship"`
+}
+
+type xmlRelationship struct {
+	Id     string `xml:"Id,attr"`
+	Target string `xml:"Target,attr"`
+}
+
+type xmlSharedStrings struct {
+	Items []xmlStringItem `xml:"si"`
+}
+
+type xmlStringItem struct {
+	Text string   `xml:"t"`
+	Runs []string `xml:"r>t"`
+}
+
+func (si xmlStringItem) String() string {
+	return si.Text + strings.Join(si.Runs, "")
+}
+
+type xmlWorksheet struct {
+	Rows []xmlRow `xml:"sheetData>row"`
+}
+
+type xmlRow struct {
+	Cells []xmlCell `xml:"c"`
+}
+
+type xmlCell struct {
+	Type   string        `xml:"t,attr"`
+	Value  string        `xml:"v"`
+	Inline xmlStringItem `xml:"is"`
+}
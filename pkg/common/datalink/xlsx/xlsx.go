@@ -0,0 +1,169 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+const (
+	workbookFile      = "xl/workbook.xml"
+	workbookRelsFile  = "xl/_rels/workbook.xml.rels"
+	sharedStringsFile = "xl/sharedStrings.xml"
+)
+
+func Parse(r *zip.Reader) (Workbook, error) {
+	files := make(map[string]*zip.File, len(r.File))
+	for _, f := range r.File {
+		files[f.Name] = f
+	}
+
+	var wb xmlWorkbook
+	if err := decodeFile(files, workbookFile, &wb); err != nil {
+		return Workbook{}, err
+	}
+
+	var rels xmlRelationships
+	if err := decodeFile(files, workbookRelsFile, &rels); err != nil {
+		return Workbook{}, err
+	}
+	targets := make(map[string]string, len(rels.Relationships))
+	for _, rel := range rels.Relationships {
+		targets[rel.Id] = rel.Target
+	}
+
+	// sharedStrings.xml is absent if no cell is string
+	var sst xmlSharedStrings
+	if _, ok := files[sharedStringsFile]; ok {
+		if err := decodeFile(files, sharedStringsFile, &sst); err != nil {
+			return Workbook{}, err
+		}
+	}
+
+	workbook := Workbook{}
+	for _, s := range wb.Sheets {
+		target, ok := targets[s.Id]
+		if !ok {
+			return Workbook{}, moerr.NewInternalErrorNoCtx(fmt.Sprintf("sheet %s not found in %s", s.Name, workbookRelsFile))
+		}
+
+		var ws xmlWorksheet
+		if err := decodeFile(files, sheetFile(target), &ws); err != nil {
+			return Workbook{}, err
+		}
+
+		sheet := Sheet{Name: s.Name}
+		for _, xr := range ws.Rows {
+			row := Row{}
+			empty := true
+			for _, c := range xr.Cells {
+				text, err := cellText(c, &sst)
+				if err != nil {
+					return Workbook{}, err
+				}
+				if len(text) > 0 {
+					empty = false
+				}
+				row.Cells = append(row.Cells, text)
+			}
+			if !empty {
+				sheet.Rows = append(sheet.Rows, row)
+			}
+		}
+		workbook.Sheets = append(workbook.Sheets, sheet)
+	}
+
+	return workbook, nil
+}
+
+// sheetFile returns the file name in zip of the relationship target, which is either relative to xl/ or absolute
+func sheetFile(target string) string {
+	if strings.HasPrefix(target, "/") {
+		return strings.TrimPrefix(target, "/")
+	}
+	return path.Join("xl", target)
+}
+
+func cellText(c xmlCell, sst *xmlSharedStrings) (string, error) {
+	switch c.Type {
+	case "s":
+		if len(c.Value) == 0 {
+			return "", nil
+		}
+		idx, err := strconv.Atoi(strings.TrimSpace(c.Value))
+		if err != nil || idx < 0 || idx >= len(sst.Items) {
+			return "", moerr.NewInternalErrorNoCtx(fmt.Sprintf("invalid shared string index %s", c.Value))
+		}
+		return sst.Items[idx].String(), nil
+	case "inlineStr":
+		return c.Inline.String(), nil
+	case "b":
+		if c.Value == "1" {
+			return "TRUE", nil
+		} else if c.Value == "0" {
+			return "FALSE", nil
+		}
+		return c.Value, nil
+	default:
+		return c.Value, nil
+	}
+}
+
+func decodeFile(files map[string]*zip.File, name string, v any) error {
+	f, ok := files[name]
+	if !ok {
+		return moerr.NewInternalErrorNoCtx(fmt.Sprintf("%s not found in xlsx file", name))
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	if err = xml.NewDecoder(rc).Decode(v); err != nil {
+		return moerr.NewInternalErrorNoCtx(fmt.Sprintf("Error parsing %s - %s", name, err))
+	}
+	return nil
+}
+
+func ParseTextFromReader(reader io.ReaderAt, size int64) (string, error) {
+	r, err := zip.NewReader(reader, size)
+	if err != nil {
+		return "", err
+	}
+
+	wb, err := Parse(r)
+	if err != nil {
+		return "", err
+	}
+	return wb.AsText(), nil
+}
+
+func GetPlainText(data []byte) ([]byte, error) {
+	text, err := ParseTextFromReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	return []byte(text), nil
+}
@@ -0,0 +1,118 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func zipFiles(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, content := range files {
+		f, err := w.Create(name)
+		require.NoError(t, err)
+		_, err = f.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+const (
+	workbookXml = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+<sheets>
+<sheet name="Products" sheetId="1" r:id="rId2"/>
+<sheet name="Summary" sheetId="2" r:id="rId1"/>
+</sheets>
+</workbook>`
+
+	relsXml = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet2.xml"/>
+<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="/xl/worksheets/sheet1.xml"/>
+<Relationship Id="rId3" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/sharedStrings" Target="sharedStrings.xml"/>
+</Relationships>`
+
+	sharedStringsXml = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" count="3" uniqueCount="3">
+<si><t>name</t></si>
+<si><t>price</t></si>
+<si><r><t>red </t></r><r><rPr><b/></rPr><t>apple</t></r></si>
+</sst>`
+
+	sheet1Xml = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+<sheetData>
+<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c></row>
+<row r="2"><c r="A2" t="s"><v>2</v></c><c r="B2"><v>3.5</v></c></row>
+<row r="3"><c r="A3"/></row>
+<row r="4"><c r="A4" t="inlineStr"><is><t>banana</t></is></c><c r="B4" t="str"><f>B2*2</f><v>7</v></c></row>
+</sheetData>
+</worksheet>`
+
+	sheet2Xml = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+<sheetData>
+<row r="1"><c r="A1" t="b"><v>1</v></c><c r="B1" t="e"><v>#DIV/0!</v></c></row>
+</sheetData>
+</worksheet>`
+)
+
+func TestGetPlainText(t *testing.T) {
+	data := zipFiles(t, map[string]string{
+		"xl/workbook.xml":            workbookXml,
+		"xl/_rels/workbook.xml.rels": relsXml,
+		"xl/sharedStrings.xml":       sharedStringsXml,
+		"xl/worksheets/sheet1.xml":   sheet1Xml,
+		"xl/worksheets/sheet2.xml":   sheet2Xml,
+	})
+
+	expected := "Products\nname\tprice\nred apple\t3.5\nbanana\t7\n\nSummary\nTRUE\t#DIV/0!\n"
+
+	text, err := GetPlainText(data)
+	require.NoError(t, err)
+	require.Equal(t, expected, string(text))
+}
+
+func TestGetPlainTextInvalidFile(t *testing.T) {
+	_, err := GetPlainText([]byte("not a zip file"))
+	require.Error(t, err)
+
+	// missing sheet
+	data := zipFiles(t, map[string]string{
+		"xl/workbook.xml":            workbookXml,
+		"xl/_rels/workbook.xml.rels": relsXml,
+		"xl/sharedStrings.xml":       sharedStringsXml,
+		"xl/worksheets/sheet1.xml":   sheet1Xml,
+	})
+	_, err = GetPlainText(data)
+	require.Error(t, err)
+
+	// invalid shared string index
+	data = zipFiles(t, map[string]string{
+		"xl/workbook.xml":            workbookXml,
+		"xl/_rels/workbook.xml.rels": relsXml,
+		"xl/worksheets/sheet1.xml":   sheet1Xml,
+		"xl/worksheets/sheet2.xml":   sheet2Xml,
+	})
+	_, err = GetPlainText(data)
+	require.Error(t, err)
+}
@@ -46,12 +46,17 @@ func NewWordAccum() *WordAccum {
 
 func NewSearchAccum(srctbl string, tblname string, pattern string, mode int64, params string) (*SearchAccum, error) {
 
-	ps, err := ParsePattern(pattern, mode)
+	param, err := ParseParserParam(params)
 	if err != nil {
 		return nil, err
 	}
 
-	return &SearchAccum{SrcTblName: srctbl, TblName: tblname, Mode: mode, Pattern: ps, Params: params, WordAccums: make(map[string]*WordAccum)}, nil
+	ps, err := ParsePatternWithParam(pattern, mode, param)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SearchAccum{SrcTblName: srctbl, TblName: tblname, Mode: mode, Pattern: ps, Params: params, Param: param, WordAccums: make(map[string]*WordAccum)}, nil
 }
 
 func findPatternByOperator(ps []*Pattern, op int) []*Pattern {
@@ -739,15 +744,38 @@ func ParsePatternInBooleanMode(pattern string) ([]*Pattern, error) {
 
 // Parse search string in natural language mode
 func ParsePatternInNLMode(pattern string) ([]*Pattern, error) {
+	return parsePatternInNLMode(pattern, FullTextParserParam{})
+}
+
+// parsePatternInNLMode tokenizes the search string with the tokenizer of the index
+func parsePatternInNLMode(pattern string, param FullTextParserParam) ([]*Pattern, error) {
 	runeSlice := []rune(pattern)
-	ngram_size := 3
-	// if number of character is small than Ngram size = 3, do prefix search
+	ngram_size := param.ngramSize()
+	// if number of character is small than Ngram size, do prefix search
 	if len(runeSlice) < ngram_size {
 		return []*Pattern{{Text: pattern + "*", Operator: STAR}}, nil
 	}
 
+	cfg := param.TokenizerConfig()
+	list, err := tokenizePattern(pattern, param.TokenizerName(), cfg)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 && len(cfg.Stopwords) > 0 {
+		// keep the stopwords if the search string only has stopwords
+		cfg.Stopwords = nil
+		return tokenizePattern(pattern, param.TokenizerName(), cfg)
+	}
+	return list, nil
+}
+
+func tokenizePattern(pattern string, name string, cfg tokenizer.Config) ([]*Pattern, error) {
+	tok, err := tokenizer.NewTokenizer(name, []byte(pattern), cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	list := make([]*Pattern, 0, 32)
-	tok, _ := tokenizer.NewSimpleTokenizer([]byte(pattern))
 	for t := range tok.Tokenize() {
 
 		slen := t.TokenBytes[0]
@@ -761,10 +789,15 @@ func ParsePatternInNLMode(pattern string) ([]*Pattern, error) {
 
 // Parse search string into list of patterns
 func ParsePattern(pattern string, mode int64) ([]*Pattern, error) {
+	return ParsePatternWithParam(pattern, mode, FullTextParserParam{})
+}
+
+// Parse search string into list of patterns with the parser parameters of the fulltext index
+func ParsePatternWithParam(pattern string, mode int64, param FullTextParserParam) ([]*Pattern, error) {
 	switch mode {
 	case int64(tree.FULLTEXT_NL), int64(tree.FULLTEXT_DEFAULT):
 		// Natural Language Mode or default mode
-		ps, err := ParsePatternInNLMode(pattern)
+		ps, err := parsePatternInNLMode(pattern, param)
 		if err != nil {
 			return nil, err
 		}
@@ -789,6 +822,9 @@ func ParsePattern(pattern string, mode int64) ([]*Pattern, error) {
 			}
 		}
 
+		cfg := param.TokenizerConfig()
+		ps = normalizePatterns(ps, &cfg)
+
 		// re-order the pattern with the precedency PHRASE, PLUS > TEXT,STAR,GROUP,RANKLESS > MINUS
 		// GROUP can only have LESSTHAN and GREATERTHAN children
 		// PLUS, MINUS, RANKLESS can only have TEXT, STAR and GROUP Children and only have Single Child
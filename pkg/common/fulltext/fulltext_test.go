@@ -171,6 +171,75 @@ func TestPatternNL(t *testing.T) {
 	}
 }
 
+func TestPatternWithParam(t *testing.T) {
+	param, err := ParseParserParam(`{"parser":"ngram","ngram_token_size":"2","stopwords":"english","stemmer":"porter"}`)
+	require.Nil(t, err)
+	require.Equal(t, 2, param.NgramTokenSize)
+
+	tests := []struct {
+		pattern string
+		mode    tree.FullTextSearchType
+		expect  string
+	}{
+		{
+			pattern: "The Running Shoes",
+			mode:    tree.FULLTEXT_NL,
+			expect:  "(text run) (text shoe)",
+		},
+		{
+			pattern: "the of",
+			mode:    tree.FULLTEXT_NL,
+			expect:  "(text the) (text of)",
+		},
+		{
+			pattern: "智能手机",
+			mode:    tree.FULLTEXT_NL,
+			expect:  "(text 智能) (text 能手) (text 手机) (text 机)",
+		},
+		{
+			pattern: "手",
+			mode:    tree.FULLTEXT_NL,
+			expect:  "(* 手*)",
+		},
+		{
+			pattern: "+the +running -shoes connect*",
+			mode:    tree.FULLTEXT_BOOLEAN,
+			expect:  "(+ (text run)) (* connect*) (- (text shoe))",
+		},
+		{
+			pattern: "+apple (>the <connections)",
+			mode:    tree.FULLTEXT_BOOLEAN,
+			expect:  "(+ (text appl)) (group (< (text connect)))",
+		},
+	}
+	for _, c := range tests {
+		ps, err := ParsePatternWithParam(c.pattern, int64(c.mode), param)
+		require.Nil(t, err)
+		assert.Equal(t, c.expect, PatternListToString(ps))
+	}
+
+	// positions of the phrase are relative to the first word which is not stopword
+	ps, err := ParsePatternWithParam("\"the running of shoes\"", int64(tree.FULLTEXT_BOOLEAN), param)
+	require.Nil(t, err)
+	assert.Equal(t, "(phrase (text 0 run) (text 11 shoe))", PatternListToStringWithPosition(ps))
+
+	param, err = ParseParserParam(`{"parser":"dictionary"}`)
+	require.Nil(t, err)
+	ps, err = ParsePatternWithParam("清华大学", int64(tree.FULLTEXT_NL), param)
+	require.Nil(t, err)
+	assert.Equal(t, "(text 清华) (text 大学) (text 清华大学)", PatternListToString(ps))
+
+	_, err = ParseParserParam(`{"parser":"unknown"}`)
+	require.NotNil(t, err)
+	_, err = ParseParserParam(`{"parser":"ngram","ngram_token_size":"10"}`)
+	require.NotNil(t, err)
+	_, err = ParseParserParam(`{"stemmer":"snowball"}`)
+	require.NotNil(t, err)
+	_, err = NewSearchAccum("src", "index", "apple", int64(tree.FULLTEXT_NL), `{"parser":1}`)
+	require.NotNil(t, err)
+	require.True(t, IsValidParser("JSON"))
+}
+
 func TestPatternQueryExpansion(t *testing.T) {
 
 	tests := []TestCase{
@@ -0,0 +1,146 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulltext
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/monlp/tokenizer"
+)
+
+const (
+	// json parsers tokenize the values of the json document
+	PARSER_JSON       = "json"
+	PARSER_JSON_VALUE = "json_value"
+)
+
+// IsValidParser returns true if the parser can be used by WITH PARSER of the fulltext index
+func IsValidParser(name string) bool {
+	name = strings.ToLower(name)
+	return name == PARSER_JSON || name == PARSER_JSON_VALUE || tokenizer.IsRegistered(name)
+}
+
+// ParseParserParam parses the IndexAlgoParams of the fulltext index.  Empty params means the default parser.
+func ParseParserParam(params string) (FullTextParserParam, error) {
+	var param FullTextParserParam
+	if len(params) > 0 {
+		if err := json.Unmarshal([]byte(params), &param); err != nil {
+			return param, moerr.NewInternalErrorNoCtxf("invalid fulltext parser params: %v", err)
+		}
+	}
+	param.Parser = strings.ToLower(param.Parser)
+	if err := param.Validate(); err != nil {
+		return param, err
+	}
+	return param, nil
+}
+
+func (p *FullTextParserParam) Validate() error {
+	if len(p.Parser) > 0 && !IsValidParser(p.Parser) {
+		return moerr.NewInternalErrorNoCtxf("invalid parser %s", p.Parser)
+	}
+	cfg := p.TokenizerConfig()
+	return cfg.Validate()
+}
+
+// TokenizerName returns the tokenizer of the text.  json parsers use the default tokenizer for the json values.
+func (p *FullTextParserParam) TokenizerName() string {
+	switch p.Parser {
+	case "", PARSER_JSON, PARSER_JSON_VALUE:
+		return tokenizer.TOKENIZER_DEFAULT
+	}
+	return p.Parser
+}
+
+func (p *FullTextParserParam) TokenizerConfig() tokenizer.Config {
+	return tokenizer.Config{
+		NgramSize: p.NgramTokenSize,
+		Stopwords: tokenizer.ParseStopwords(p.Stopwords),
+		Stemmer:   p.Stemmer,
+	}
+}
+
+// ngramSize returns the number of runes of the ngram which is also the min length of the search word
+func (p *FullTextParserParam) ngramSize() int {
+	if p.NgramTokenSize > 0 {
+		return p.NgramTokenSize
+	}
+	return tokenizer.DEFAULT_NGRAM_SIZE
+}
+
+// normalizePatterns stems the words and removes the stopwords of the boolean mode patterns in the same
+// way as the words are indexed.  The patterns are kept as is if all of them are stopwords.
+func normalizePatterns(ps []*Pattern, cfg *tokenizer.Config) []*Pattern {
+	if len(cfg.Stopwords) == 0 && len(cfg.Stemmer) == 0 {
+		return ps
+	}
+	res := make([]*Pattern, 0, len(ps))
+	for _, p := range ps {
+		if np := normalizePattern(p, cfg); np != nil {
+			res = append(res, np)
+		}
+	}
+	if len(res) == 0 {
+		return ps
+	}
+	return res
+}
+
+// normalizePattern returns nil if the pattern only has stopwords
+func normalizePattern(p *Pattern, cfg *tokenizer.Config) *Pattern {
+	switch p.Operator {
+	case TEXT:
+		word, ok := cfg.Normalize(p.Text)
+		if !ok {
+			return nil
+		}
+		p.Text = word
+		return p
+	case STAR:
+		// prefix search is not stemmed
+		return p
+	case PHRASE:
+		children := make([]*Pattern, 0, len(p.Children))
+		for _, c := range p.Children {
+			if word, ok := cfg.Normalize(c.Text); ok {
+				c.Text = word
+				children = append(children, c)
+			}
+		}
+		if len(children) == 0 {
+			return nil
+		}
+		// the positions are relative to the first word
+		for i := len(children) - 1; i >= 0; i-- {
+			children[i].Position -= children[0].Position
+		}
+		p.Children = children
+		return p
+	default:
+		children := make([]*Pattern, 0, len(p.Children))
+		for _, c := range p.Children {
+			if nc := normalizePattern(c, cfg); nc != nil {
+				children = append(children, nc)
+			}
+		}
+		if len(children) == 0 {
+			return nil
+		}
+		p.Children = children
+		return p
+	}
+}
@@ -52,7 +52,10 @@ Find rows that contain the exact phrase “some words” (for example, rows that
 
 // Parser parameters
 type FullTextParserParam struct {
-	Parser string `json:"parser"`
+	Parser         string `json:"parser"`
+	NgramTokenSize int    `json:"ngram_token_size,string,omitempty"`
+	Stopwords      string `json:"stopwords,omitempty"`
+	Stemmer        string `json:"stemmer,omitempty"`
 }
 
 // Word is associated with particular DocId (index.doc_id) and could have multiple positions
@@ -74,6 +77,7 @@ type SearchAccum struct {
 	Mode       int64
 	Pattern    []*Pattern
 	Params     string
+	Param      FullTextParserParam
 	WordAccums map[string]*WordAccum
 	Nrow       int64
 }
@@ -0,0 +1,150 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hnsw
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"math/rand"
+	"slices"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+const (
+	deltaMagic   = "MOHNSWD"
+	deltaVersion = uint16(1)
+)
+
+/*
+Delta is the changes of an index made by a statement. It is persisted as a small file
+next to the file of the whole graph, and replayed on the graph by Index.Apply when the
+index is loaded, so a statement does not need to rewrite the whole graph.
+
+The level of an inserted node is decided when it is added into the delta, to build the
+same graph wherever the delta is replayed.
+*/
+type Delta struct {
+	levelMult float64
+	rnd       *rand.Rand
+	ops       []deltaOp
+}
+
+// deltaOp removes the key if level is negative, or inserts the vector of the key otherwise
+type deltaOp struct {
+	key   int64
+	level int32
+	vec   []float32
+}
+
+// NewDelta returns an empty delta of the index with the m parameter
+func NewDelta(m int) *Delta {
+	if m < 2 {
+		m = 2
+	}
+	return &Delta{
+		levelMult: 1 / math.Log(float64(m)),
+		rnd:       rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// Len returns the number of the changes
+func (d *Delta) Len() int {
+	return len(d.ops)
+}
+
+func (d *Delta) Insert(key int64, vec []float32) {
+	d.ops = append(d.ops, deltaOp{
+		key:   key,
+		level: int32(randomLevel(d.rnd, d.levelMult)),
+		vec:   slices.Clone(vec),
+	})
+}
+
+func (d *Delta) Remove(key int64) {
+	d.ops = append(d.ops, deltaOp{key: key, level: -1})
+}
+
+// Marshal encodes the delta in little endian:
+//
+//	magic | version | count | ops: key level [dim vec]
+func (d *Delta) Marshal() []byte {
+	var buf bytes.Buffer
+	w := func(v any) {
+		_ = binary.Write(&buf, binary.LittleEndian, v)
+	}
+	buf.WriteString(deltaMagic)
+	w(deltaVersion)
+	w(uint32(len(d.ops)))
+	for _, op := range d.ops {
+		w(op.key)
+		w(op.level)
+		if op.level >= 0 {
+			w(uint32(len(op.vec)))
+			w(op.vec)
+		}
+	}
+	return buf.Bytes()
+}
+
+// UnmarshalDelta decodes the delta encoded by Delta.Marshal
+func UnmarshalDelta(data []byte) (*Delta, error) {
+	if len(data) < len(deltaMagic) || string(data[:len(deltaMagic)]) != deltaMagic {
+		return nil, moerr.NewInternalErrorNoCtx("hnsw: invalid delta file")
+	}
+	r := bytes.NewReader(data[len(deltaMagic):])
+	var err error
+	rd := func(v any) {
+		if err == nil {
+			err = binary.Read(r, binary.LittleEndian, v)
+		}
+	}
+	var version uint16
+	var count uint32
+	rd(&version)
+	if err == nil && version != deltaVersion {
+		return nil, moerr.NewInternalErrorNoCtxf("hnsw: unsupported delta file version %d", version)
+	}
+	rd(&count)
+	// each op takes 12 bytes at least
+	if err != nil || uint64(count)*12 > uint64(r.Len()) {
+		return nil, moerr.NewInternalErrorNoCtx("hnsw: invalid delta file: truncated")
+	}
+
+	d := &Delta{ops: make([]deltaOp, count)}
+	for i := range d.ops {
+		op := &d.ops[i]
+		rd(&op.key)
+		rd(&op.level)
+		if err == nil && op.level > 64 {
+			return nil, moerr.NewInternalErrorNoCtx("hnsw: invalid delta file: bad level")
+		}
+		if err == nil && op.level >= 0 {
+			var dim uint32
+			rd(&dim)
+			if err != nil || uint64(dim)*4 > uint64(r.Len()) {
+				return nil, moerr.NewInternalErrorNoCtx("hnsw: invalid delta file: bad vector")
+			}
+			op.vec = make([]float32, dim)
+			rd(op.vec)
+		}
+		if err != nil {
+			return nil, moerr.NewInternalErrorNoCtxf("hnsw: invalid delta file: %v", err)
+		}
+	}
+	return d, nil
+}
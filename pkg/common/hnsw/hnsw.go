@@ -0,0 +1,466 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hnsw
+
+import (
+	"container/heap"
+	"math"
+	"math/rand"
+	"slices"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+/*
+Index is an in-memory Hierarchical Navigable Small World graph, see
+https://arxiv.org/abs/1603.09320.
+
+Every node is identified by an int64 key (the primary key of the source table).
+Removed nodes are only marked as deleted and still used for the graph navigation,
+they are skipped in the search result. The graph is rebuilt from the live nodes by
+Compact when the deleted nodes outnumber the live ones.
+
+Index is not safe for concurrent mutation. The search is read only and can be run
+concurrently.
+*/
+type Index struct {
+	dim            int
+	m              int
+	mmax0          int
+	efConstruction int
+	efSearch       int
+	metric         Metric
+	levelMult      float64
+
+	nodes    []node
+	keys     map[int64]uint32
+	entry    uint32
+	maxLevel int
+	deleted  int
+
+	rnd *rand.Rand
+}
+
+type node struct {
+	key     int64
+	vec     []float32
+	level   int
+	deleted bool
+	// friends[l] is the neighbors of the node at layer l
+	friends [][]uint32
+}
+
+type Result struct {
+	Key      int64
+	Distance float64
+}
+
+func NewIndex(dim, m, efConstruction, efSearch int, metric Metric) *Index {
+	if m < 2 {
+		m = 2
+	}
+	if efConstruction < m {
+		efConstruction = m
+	}
+	if efSearch <= 0 {
+		efSearch = efConstruction
+	}
+	return &Index{
+		dim:            dim,
+		m:              m,
+		mmax0:          2 * m,
+		efConstruction: efConstruction,
+		efSearch:       efSearch,
+		metric:         metric,
+		levelMult:      1 / math.Log(float64(m)),
+		keys:           make(map[int64]uint32),
+		rnd:            rand.New(rand.NewSource(int64(dim)*31 + int64(m))),
+	}
+}
+
+func (idx *Index) Dim() int {
+	return idx.dim
+}
+
+// Len returns the number of live nodes
+func (idx *Index) Len() int {
+	return len(idx.keys)
+}
+
+// Deleted returns the number of the nodes marked as deleted
+func (idx *Index) Deleted() int {
+	return idx.deleted
+}
+
+// NeedCompact returns true if the deleted nodes outnumber the live ones
+func (idx *Index) NeedCompact() bool {
+	return idx.deleted > 0 && idx.deleted > len(idx.keys)
+}
+
+func (idx *Index) Contains(key int64) bool {
+	_, ok := idx.keys[key]
+	return ok
+}
+
+// Clone returns a deep copy of the index, the vectors are shared since they are never modified
+func (idx *Index) Clone() *Index {
+	c := *idx
+	c.nodes = make([]node, len(idx.nodes))
+	for i := range idx.nodes {
+		c.nodes[i] = idx.nodes[i]
+		c.nodes[i].friends = make([][]uint32, len(idx.nodes[i].friends))
+		for l, f := range idx.nodes[i].friends {
+			c.nodes[i].friends[l] = slices.Clone(f)
+		}
+	}
+	c.keys = make(map[int64]uint32, len(idx.keys))
+	for k, v := range idx.keys {
+		c.keys[k] = v
+	}
+	c.rnd = rand.New(rand.NewSource(int64(len(idx.nodes))))
+	return &c
+}
+
+// Insert adds the vector with the key into the index, the existing vector of the key is replaced
+func (idx *Index) Insert(key int64, vec []float32) error {
+	return idx.insert(key, vec, idx.randomLevel())
+}
+
+// Apply replays the changes of the delta on the index. The levels of the inserted nodes are
+// recorded in the delta, so the same graph is built wherever the delta is applied.
+func (idx *Index) Apply(d *Delta) error {
+	for _, op := range d.ops {
+		if op.level < 0 {
+			idx.Remove(op.key)
+			continue
+		}
+		if err := idx.insert(op.key, op.vec, int(op.level)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (idx *Index) insert(key int64, vec []float32, level int) error {
+	if len(vec) != idx.dim {
+		return moerr.NewInternalErrorNoCtxf("hnsw: vector dimension %d does not match the index dimension %d", len(vec), idx.dim)
+	}
+	idx.Remove(key)
+
+	v := slices.Clone(vec)
+	if idx.metric == MetricCosine {
+		normalize(v)
+	}
+	id := uint32(len(idx.nodes))
+	idx.nodes = append(idx.nodes, node{
+		key:     key,
+		vec:     v,
+		level:   level,
+		friends: make([][]uint32, level+1),
+	})
+	idx.keys[key] = id
+
+	if len(idx.nodes) == 1 {
+		idx.entry = id
+		idx.maxLevel = level
+		return nil
+	}
+
+	ep := idx.entry
+	epDist := idx.distance(v, idx.nodes[ep].vec)
+	for l := idx.maxLevel; l > level; l-- {
+		ep, epDist = idx.greedy(v, ep, epDist, l)
+	}
+	for l := min(level, idx.maxLevel); l >= 0; l-- {
+		candidates := idx.searchLayer(v, []candidate{{id: ep, dist: epDist}}, idx.efConstruction, l)
+		neighbors := idx.selectNeighbors(candidates, idx.maxFriends(l))
+		idx.nodes[id].friends[l] = neighbors
+		for _, n := range neighbors {
+			idx.link(n, id, l)
+		}
+		ep, epDist = candidates[0].id, candidates[0].dist
+	}
+	if level > idx.maxLevel {
+		idx.entry = id
+		idx.maxLevel = level
+	}
+	return nil
+}
+
+// Remove marks the node of the key as deleted, it returns false if the key does not exist
+func (idx *Index) Remove(key int64) bool {
+	id, ok := idx.keys[key]
+	if !ok {
+		return false
+	}
+	idx.nodes[id].deleted = true
+	delete(idx.keys, key)
+	idx.deleted++
+	return true
+}
+
+// Compact rebuilds the graph from the live nodes
+func (idx *Index) Compact() *Index {
+	c := NewIndex(idx.dim, idx.m, idx.efConstruction, idx.efSearch, idx.metric)
+	for i := range idx.nodes {
+		if !idx.nodes[i].deleted {
+			// normalizing the vector of a cosine index again is harmless
+			_ = c.Insert(idx.nodes[i].key, idx.nodes[i].vec)
+		}
+	}
+	return c
+}
+
+// Search returns the k nearest live nodes of the query, ordered by the distance.
+// ef is the size of the dynamic candidate list, the ef_search parameter of the index
+// is used if it is not positive.
+func (idx *Index) Search(query []float32, k int, ef int) ([]Result, error) {
+	if len(query) != idx.dim {
+		return nil, moerr.NewInternalErrorNoCtxf("hnsw: query dimension %d does not match the index dimension %d", len(query), idx.dim)
+	}
+	if k <= 0 || len(idx.keys) == 0 {
+		return nil, nil
+	}
+	if ef <= 0 {
+		ef = idx.efSearch
+	}
+	ef = max(ef, k)
+	// the deleted nodes take places in the candidate list, enlarge it to keep the recall
+	if idx.deleted > 0 {
+		ef += min(idx.deleted, ef)
+	}
+
+	q := query
+	if idx.metric == MetricCosine {
+		q = slices.Clone(query)
+		normalize(q)
+	}
+	ep := idx.entry
+	epDist := idx.distance(q, idx.nodes[ep].vec)
+	for l := idx.maxLevel; l > 0; l-- {
+		ep, epDist = idx.greedy(q, ep, epDist, l)
+	}
+	candidates := idx.searchLayer(q, []candidate{{id: ep, dist: epDist}}, ef, 0)
+
+	results := make([]Result, 0, k)
+	for _, c := range candidates {
+		if idx.nodes[c.id].deleted {
+			continue
+		}
+		results = append(results, Result{Key: idx.nodes[c.id].key, Distance: idx.outputDistance(c.dist)})
+		if len(results) == k {
+			break
+		}
+	}
+	return results, nil
+}
+
+func (idx *Index) randomLevel() int {
+	return randomLevel(idx.rnd, idx.levelMult)
+}
+
+func randomLevel(rnd *rand.Rand, levelMult float64) int {
+	return int(math.Floor(-math.Log(1-rnd.Float64()) * levelMult))
+}
+
+func (idx *Index) maxFriends(level int) int {
+	if level == 0 {
+		return idx.mmax0
+	}
+	return idx.m
+}
+
+// distance returns the internal distance, the squared l2 distance or 1 - cosine similarity
+func (idx *Index) distance(a, b []float32) float32 {
+	if idx.metric == MetricCosine {
+		var dot float32
+		for i := range a {
+			dot += a[i] * b[i]
+		}
+		return 1 - dot
+	}
+	var sum float32
+	for i := range a {
+		d := a[i] - b[i]
+		sum += d * d
+	}
+	return sum
+}
+
+// outputDistance converts the internal distance to the one of l2_distance or cosine_distance
+func (idx *Index) outputDistance(d float32) float64 {
+	if idx.metric == MetricCosine {
+		return float64(d)
+	}
+	return math.Sqrt(float64(d))
+}
+
+func (idx *Index) greedy(q []float32, ep uint32, epDist float32, level int) (uint32, float32) {
+	for changed := true; changed; {
+		changed = false
+		for _, f := range idx.nodes[ep].friends[level] {
+			if d := idx.distance(q, idx.nodes[f].vec); d < epDist {
+				ep, epDist, changed = f, d, true
+			}
+		}
+	}
+	return ep, epDist
+}
+
+// searchLayer returns at most ef nearest nodes of the layer, ordered by the distance
+func (idx *Index) searchLayer(q []float32, eps []candidate, ef int, level int) []candidate {
+	visited := make(map[uint32]struct{}, ef*4)
+	cands := &minHeap{}
+	results := &maxHeap{}
+	for _, ep := range eps {
+		visited[ep.id] = struct{}{}
+		heap.Push(cands, ep)
+		heap.Push(results, ep)
+	}
+	for cands.Len() > 0 {
+		c := heap.Pop(cands).(candidate)
+		if c.dist > (*results)[0].dist && results.Len() >= ef {
+			break
+		}
+		for _, f := range idx.nodes[c.id].friends[level] {
+			if _, ok := visited[f]; ok {
+				continue
+			}
+			visited[f] = struct{}{}
+			d := idx.distance(q, idx.nodes[f].vec)
+			if results.Len() < ef || d < (*results)[0].dist {
+				heap.Push(cands, candidate{id: f, dist: d})
+				heap.Push(results, candidate{id: f, dist: d})
+				if results.Len() > ef {
+					heap.Pop(results)
+				}
+			}
+		}
+	}
+	out := make([]candidate, results.Len())
+	for i := len(out) - 1; i >= 0; i-- {
+		out[i] = heap.Pop(results).(candidate)
+	}
+	return out
+}
+
+// selectNeighbors picks at most m neighbors from the candidates ordered by the distance
+// with the heuristic of the paper, which keeps the graph navigable for clustered data.
+func (idx *Index) selectNeighbors(candidates []candidate, m int) []uint32 {
+	if len(candidates) <= m {
+		ret := make([]uint32, len(candidates))
+		for i, c := range candidates {
+			ret[i] = c.id
+		}
+		return ret
+	}
+	ret := make([]uint32, 0, m)
+	var pruned []uint32
+	for _, c := range candidates {
+		if len(ret) == m {
+			break
+		}
+		good := true
+		for _, r := range ret {
+			if idx.distance(idx.nodes[c.id].vec, idx.nodes[r].vec) < c.dist {
+				good = false
+				break
+			}
+		}
+		if good {
+			ret = append(ret, c.id)
+		} else {
+			pruned = append(pruned, c.id)
+		}
+	}
+	// fill up with the pruned ones to keep the connectivity
+	for _, p := range pruned {
+		if len(ret) == m {
+			break
+		}
+		ret = append(ret, p)
+	}
+	return ret
+}
+
+// link adds the new node to the neighbors of the node, and shrinks them if they are too many
+func (idx *Index) link(id uint32, newId uint32, level int) {
+	n := &idx.nodes[id]
+	n.friends[level] = append(n.friends[level], newId)
+	limit := idx.maxFriends(level)
+	if len(n.friends[level]) <= limit {
+		return
+	}
+	candidates := make([]candidate, len(n.friends[level]))
+	for i, f := range n.friends[level] {
+		candidates[i] = candidate{id: f, dist: idx.distance(n.vec, idx.nodes[f].vec)}
+	}
+	slices.SortFunc(candidates, func(a, b candidate) int {
+		if a.dist < b.dist {
+			return -1
+		} else if a.dist > b.dist {
+			return 1
+		}
+		return 0
+	})
+	n.friends[level] = idx.selectNeighbors(candidates, limit)
+}
+
+func normalize(v []float32) {
+	var sum float64
+	for _, x := range v {
+		sum += float64(x) * float64(x)
+	}
+	if sum == 0 {
+		return
+	}
+	norm := float32(1 / math.Sqrt(sum))
+	for i := range v {
+		v[i] *= norm
+	}
+}
+
+type candidate struct {
+	id   uint32
+	dist float32
+}
+
+type minHeap []candidate
+
+func (h minHeap) Len() int           { return len(h) }
+func (h minHeap) Less(i, j int) bool { return h[i].dist < h[j].dist }
+func (h minHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *minHeap) Push(x any)        { *h = append(*h, x.(candidate)) }
+func (h *minHeap) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+type maxHeap []candidate
+
+func (h maxHeap) Len() int           { return len(h) }
+func (h maxHeap) Less(i, j int) bool { return h[i].dist > h[j].dist }
+func (h maxHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *maxHeap) Push(x any)        { *h = append(*h, x.(candidate)) }
+func (h *maxHeap) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
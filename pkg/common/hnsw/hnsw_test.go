@@ -0,0 +1,333 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hnsw
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+)
+
+func randomVectors(n, dim int, seed int64) [][]float32 {
+	rnd := rand.New(rand.NewSource(seed))
+	vecs := make([][]float32, n)
+	for i := range vecs {
+		vecs[i] = make([]float32, dim)
+		for j := range vecs[i] {
+			vecs[i][j] = rnd.Float32()
+		}
+	}
+	return vecs
+}
+
+func bruteForce(vecs [][]float32, live func(int64) bool, q []float32, k int) []int64 {
+	type pair struct {
+		key  int64
+		dist float64
+	}
+	pairs := make([]pair, 0, len(vecs))
+	for i, v := range vecs {
+		if !live(int64(i)) {
+			continue
+		}
+		var sum float64
+		for j := range v {
+			d := float64(v[j] - q[j])
+			sum += d * d
+		}
+		pairs = append(pairs, pair{key: int64(i), dist: sum})
+	}
+	sort.Slice(pairs, func(i, j int) bool { return pairs[i].dist < pairs[j].dist })
+	keys := make([]int64, 0, k)
+	for i := 0; i < k && i < len(pairs); i++ {
+		keys = append(keys, pairs[i].key)
+	}
+	return keys
+}
+
+func recall(t *testing.T, idx *Index, vecs [][]float32, live func(int64) bool, k int) float64 {
+	queries := randomVectors(50, idx.Dim(), 99)
+	hit, total := 0, 0
+	for _, q := range queries {
+		expected := bruteForce(vecs, live, q, k)
+		results, err := idx.Search(q, k, 64)
+		require.NoError(t, err)
+		got := make(map[int64]bool)
+		for _, r := range results {
+			require.True(t, live(r.Key))
+			got[r.Key] = true
+		}
+		for _, key := range expected {
+			if got[key] {
+				hit++
+			}
+		}
+		total += len(expected)
+	}
+	return float64(hit) / float64(total)
+}
+
+func TestIndexSearch(t *testing.T) {
+	vecs := randomVectors(2000, 16, 1)
+	idx := NewIndex(16, 16, 100, 40, MetricL2)
+	for i, v := range vecs {
+		require.NoError(t, idx.Insert(int64(i), v))
+	}
+	require.Equal(t, len(vecs), idx.Len())
+	all := func(int64) bool { return true }
+	require.Greater(t, recall(t, idx, vecs, all, 10), 0.9)
+
+	// the nearest one of an indexed vector is itself
+	results, err := idx.Search(vecs[42], 1, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(42), results[0].Key)
+	require.InDelta(t, 0, results[0].Distance, 1e-6)
+
+	_, err = idx.Search([]float32{1, 2}, 1, 0)
+	require.Error(t, err)
+	require.Error(t, idx.Insert(1, []float32{1, 2}))
+}
+
+func TestIndexRemove(t *testing.T) {
+	vecs := randomVectors(1000, 8, 2)
+	idx := NewIndex(8, 8, 64, 40, MetricL2)
+	for i, v := range vecs {
+		require.NoError(t, idx.Insert(int64(i), v))
+	}
+	for i := 0; i < len(vecs); i += 2 {
+		require.True(t, idx.Remove(int64(i)))
+	}
+	require.False(t, idx.Remove(0))
+	require.Equal(t, 500, idx.Len())
+	require.Equal(t, 500, idx.Deleted())
+	require.False(t, idx.NeedCompact())
+
+	odd := func(key int64) bool { return key%2 == 1 }
+	require.Greater(t, recall(t, idx, vecs, odd, 10), 0.9)
+
+	// replace the vector of a key
+	require.NoError(t, idx.Insert(1, vecs[0]))
+	results, err := idx.Search(vecs[0], 1, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), results[0].Key)
+	require.True(t, idx.NeedCompact())
+
+	vecs[1] = vecs[0]
+	compacted := idx.Compact()
+	require.Equal(t, 500, compacted.Len())
+	require.Equal(t, 0, compacted.Deleted())
+	require.Greater(t, recall(t, compacted, vecs, odd, 10), 0.9)
+}
+
+func TestIndexCosine(t *testing.T) {
+	idx := NewIndex(2, 4, 16, 16, MetricCosine)
+	require.NoError(t, idx.Insert(1, []float32{1, 0}))
+	require.NoError(t, idx.Insert(2, []float32{0, 3}))
+	require.NoError(t, idx.Insert(3, []float32{2, 2}))
+	results, err := idx.Search([]float32{10, 1}, 3, 0)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 3, 2}, []int64{results[0].Key, results[1].Key, results[2].Key})
+	expected := 1 - 10/math.Sqrt(101)
+	require.InDelta(t, expected, results[0].Distance, 1e-5)
+}
+
+func TestIndexMarshal(t *testing.T) {
+	vecs := randomVectors(300, 4, 3)
+	idx := NewIndex(4, 6, 32, 20, MetricCosine)
+	for i, v := range vecs {
+		require.NoError(t, idx.Insert(int64(i), v))
+	}
+	idx.Remove(7)
+
+	idx2, err := Unmarshal(idx.Marshal())
+	require.NoError(t, err)
+	require.Equal(t, idx.Len(), idx2.Len())
+	require.Equal(t, idx.Deleted(), idx2.Deleted())
+	require.False(t, idx2.Contains(7))
+	for _, q := range randomVectors(10, 4, 4) {
+		r1, err := idx.Search(q, 5, 0)
+		require.NoError(t, err)
+		r2, err := idx2.Search(q, 5, 0)
+		require.NoError(t, err)
+		require.Equal(t, r1, r2)
+	}
+
+	_, err = Unmarshal([]byte("bad"))
+	require.Error(t, err)
+	data := idx.Marshal()
+	_, err = Unmarshal(data[:len(data)/2])
+	require.Error(t, err)
+
+	// clone is not affected by the modification of the original index
+	clone := idx.Clone()
+	idx.Remove(8)
+	require.True(t, clone.Contains(8))
+}
+
+func TestSaveLoad(t *testing.T) {
+	ctx := context.Background()
+	fs, err := fileservice.NewMemoryFS("memory", fileservice.DisabledCacheConfig, nil)
+	require.NoError(t, err)
+
+	idx := NewIndex(2, 4, 16, 16, MetricL2)
+	require.NoError(t, idx.Insert(1, []float32{1, 0}))
+	path := FilePath("__mo_index_secondary_xxx", "1")
+	require.NoError(t, Save(ctx, fs, path, idx))
+
+	// the saved index is not cached
+	indexCache = newCache(cacheCapacity)
+	_, ok := indexCache.get(path)
+	require.False(t, ok)
+	loaded, err := Load(ctx, fs, path, nil)
+	require.NoError(t, err)
+	require.True(t, loaded.Contains(1))
+
+	cached, err := Load(ctx, fs, path, nil)
+	require.NoError(t, err)
+	require.True(t, loaded == cached)
+
+	// the deltas are replayed on the clone of the cached index
+	d := NewDelta(4)
+	d.Insert(2, []float32{0, 1})
+	d.Remove(1)
+	deltaPath := FilePath("__mo_index_secondary_xxx", "2")
+	require.NoError(t, SaveDelta(ctx, fs, deltaPath, d))
+	withDelta, err := Load(ctx, fs, path, []string{deltaPath})
+	require.NoError(t, err)
+	require.True(t, withDelta.Contains(2))
+	require.False(t, withDelta.Contains(1))
+	require.True(t, cached.Contains(1))
+	cachedDelta, err := Load(ctx, fs, path, []string{deltaPath})
+	require.NoError(t, err)
+	require.True(t, withDelta == cachedDelta)
+
+	_, err = Load(ctx, fs, FilePath("__mo_index_secondary_xxx", "3"), nil)
+	require.Error(t, err)
+
+	// the deleted files are removed from the cache too
+	require.NoError(t, DeleteFiles(ctx, fs, deltaPath))
+	_, ok = indexCache.get(deltaPath)
+	require.False(t, ok)
+	_, err = Load(ctx, fs, path, []string{deltaPath})
+	require.Error(t, err)
+
+	require.NoError(t, Save(ctx, fs, FilePath("__mo_index_secondary_yyy", "1"), idx))
+	require.NoError(t, DeleteAll(ctx, fs, "__mo_index_secondary_xxx"))
+	_, err = Load(ctx, fs, path, nil)
+	require.Error(t, err)
+	_, err = Load(ctx, fs, FilePath("__mo_index_secondary_yyy", "1"), nil)
+	require.NoError(t, err)
+}
+
+func TestDelta(t *testing.T) {
+	vecs := randomVectors(500, 8, 5)
+	idx := NewIndex(8, 8, 32, 32, MetricL2)
+	for i, v := range vecs[:300] {
+		require.NoError(t, idx.Insert(int64(i), v))
+	}
+
+	d := NewDelta(8)
+	for i := 300; i < len(vecs); i++ {
+		d.Insert(int64(i), vecs[i])
+	}
+	for i := 0; i < 50; i++ {
+		d.Remove(int64(i))
+	}
+	require.Equal(t, 250, d.Len())
+
+	decoded, err := UnmarshalDelta(d.Marshal())
+	require.NoError(t, err)
+	require.Equal(t, d.ops, decoded.ops)
+
+	// replaying the same delta builds the same graph
+	idx1, idx2 := idx.Clone(), idx.Clone()
+	require.NoError(t, idx1.Apply(d))
+	require.NoError(t, idx2.Apply(decoded))
+	require.Equal(t, idx1.Marshal(), idx2.Marshal())
+	require.Equal(t, 450, idx1.Len())
+
+	live := func(key int64) bool { return key >= 50 }
+	require.Greater(t, recall(t, idx1, vecs, live, 10), 0.9)
+
+	_, err = UnmarshalDelta([]byte("bad"))
+	require.Error(t, err)
+	data := d.Marshal()
+	_, err = UnmarshalDelta(data[:len(data)/2])
+	require.Error(t, err)
+
+	bad := NewDelta(8)
+	bad.Insert(1, []float32{1})
+	require.Error(t, idx.Clone().Apply(bad))
+}
+
+func TestGarbage(t *testing.T) {
+	garbage := []Garbage{
+		{Path: FilePath("meta", "1"), Since: 100},
+		{Path: FilePath("meta", "2"), Since: 200},
+	}
+	parsed, err := ParseGarbage(FormatGarbage(garbage))
+	require.NoError(t, err)
+	require.Equal(t, garbage, parsed)
+
+	parsed, err = ParseGarbage("")
+	require.NoError(t, err)
+	require.Empty(t, parsed)
+	_, err = ParseGarbage("hnsw/meta/1")
+	require.Error(t, err)
+	_, err = ParseGarbage("x:hnsw/meta/1")
+	require.Error(t, err)
+}
+
+func TestCacheEvict(t *testing.T) {
+	c := newCache(2)
+	c.put("a", &Index{})
+	c.put("b", &Index{})
+	_, ok := c.get("a")
+	require.True(t, ok)
+	c.put("c", &Index{})
+	_, ok = c.get("b")
+	require.False(t, ok)
+	_, ok = c.get("a")
+	require.True(t, ok)
+}
+
+func TestParseConfig(t *testing.T) {
+	cfg := &Config{
+		DbName:        "db",
+		SrcTable:      "t",
+		MetadataTable: "meta",
+		PkName:        "id",
+		VecName:       "v",
+		Param:         Param{M: 8, EfConstruction: 32, EfSearch: 16, OpType: OpTypeCosine},
+	}
+	parsed, err := ParseConfig(cfg.String())
+	require.NoError(t, err)
+	require.Equal(t, cfg, parsed)
+
+	parsed, err = ParseConfig(`{"db":"db","src":"t","meta":"meta","pk":"id","vec":"v","param":{"m":"4","ef_construction":"8","ef_search":"8","op_type":"vector_l2_ops"}}`)
+	require.NoError(t, err)
+	require.Equal(t, int64(4), parsed.Param.M)
+
+	_, err = ParseConfig(`{"db":"db"}`)
+	require.Error(t, err)
+	_, err = ParseConfig(`{"db":"db","src":"t","meta":"meta","pk":"id","vec":"v","param":{"op_type":"vector_ip_ops"}}`)
+	require.Error(t, err)
+}
@@ -0,0 +1,332 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hnsw
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+)
+
+const (
+	fileMagic   = "MOHNSW"
+	fileVersion = uint16(1)
+
+	// number of the loaded indexes kept in memory
+	cacheCapacity = 16
+)
+
+// FilePath returns the path of a new file of the index. Every change of the index is saved
+// into a new file, either the whole graph or a delta of it, so a file is never modified after
+// it is written, and it is safe to cache the loaded index by the path. The files replaced by
+// a new graph are removed by the writer after they are not used any more, see DeleteFiles.
+func FilePath(metadataTable string, id string) string {
+	return DirPath(metadataTable) + id
+}
+
+// DirPath returns the directory of all the files of the index
+func DirPath(metadataTable string) string {
+	return fmt.Sprintf("hnsw/%s/", metadataTable)
+}
+
+// Marshal encodes the index in little endian:
+//
+//	magic | version | dim m efConstruction efSearch metric | entry maxLevel deleted | count |
+//	nodes: key level deleted vec friends...
+func (idx *Index) Marshal() []byte {
+	var buf bytes.Buffer
+	buf.Grow(len(fileMagic) + 64 + len(idx.nodes)*(idx.dim*4+idx.mmax0*4+32))
+	w := func(v any) {
+		_ = binary.Write(&buf, binary.LittleEndian, v)
+	}
+	buf.WriteString(fileMagic)
+	w(fileVersion)
+	w(uint32(idx.dim))
+	w(uint32(idx.m))
+	w(uint32(idx.efConstruction))
+	w(uint32(idx.efSearch))
+	w(uint8(idx.metric))
+	w(idx.entry)
+	w(uint32(idx.maxLevel))
+	w(uint32(idx.deleted))
+	w(uint32(len(idx.nodes)))
+	for i := range idx.nodes {
+		n := &idx.nodes[i]
+		w(n.key)
+		w(uint32(n.level))
+		w(n.deleted)
+		w(n.vec)
+		for _, f := range n.friends {
+			w(uint32(len(f)))
+			w(f)
+		}
+	}
+	return buf.Bytes()
+}
+
+// Unmarshal decodes the index encoded by Marshal
+func Unmarshal(data []byte) (*Index, error) {
+	if len(data) < len(fileMagic) || string(data[:len(fileMagic)]) != fileMagic {
+		return nil, moerr.NewInternalErrorNoCtx("hnsw: invalid index file")
+	}
+	r := bytes.NewReader(data[len(fileMagic):])
+	var err error
+	rd := func(v any) {
+		if err == nil {
+			err = binary.Read(r, binary.LittleEndian, v)
+		}
+	}
+	var version uint16
+	rd(&version)
+	if err == nil && version != fileVersion {
+		return nil, moerr.NewInternalErrorNoCtxf("hnsw: unsupported index file version %d", version)
+	}
+	var dim, m, efConstruction, efSearch, maxLevel, deleted, count uint32
+	var metric uint8
+	var entry uint32
+	rd(&dim)
+	rd(&m)
+	rd(&efConstruction)
+	rd(&efSearch)
+	rd(&metric)
+	rd(&entry)
+	rd(&maxLevel)
+	rd(&deleted)
+	rd(&count)
+	if err != nil {
+		return nil, moerr.NewInternalErrorNoCtxf("hnsw: invalid index file: %v", err)
+	}
+	// each node takes 13 bytes at least
+	if uint64(count)*13 > uint64(r.Len()) {
+		return nil, moerr.NewInternalErrorNoCtx("hnsw: invalid index file: truncated")
+	}
+
+	idx := NewIndex(int(dim), int(m), int(efConstruction), int(efSearch), Metric(metric))
+	idx.entry = entry
+	idx.maxLevel = int(maxLevel)
+	idx.deleted = int(deleted)
+	idx.nodes = make([]node, count)
+	for i := range idx.nodes {
+		n := &idx.nodes[i]
+		var level uint32
+		rd(&n.key)
+		rd(&level)
+		rd(&n.deleted)
+		if err != nil || level > 64 {
+			return nil, moerr.NewInternalErrorNoCtx("hnsw: invalid index file: bad node")
+		}
+		n.level = int(level)
+		n.vec = make([]float32, dim)
+		rd(n.vec)
+		n.friends = make([][]uint32, n.level+1)
+		for l := range n.friends {
+			var size uint32
+			rd(&size)
+			if err != nil || size > count {
+				return nil, moerr.NewInternalErrorNoCtx("hnsw: invalid index file: bad neighbors")
+			}
+			n.friends[l] = make([]uint32, size)
+			rd(n.friends[l])
+		}
+		if err != nil {
+			return nil, moerr.NewInternalErrorNoCtxf("hnsw: invalid index file: %v", err)
+		}
+		if !n.deleted {
+			idx.keys[n.key] = uint32(i)
+		}
+	}
+	if count > 0 && entry >= count {
+		return nil, moerr.NewInternalErrorNoCtx("hnsw: invalid index file: bad entry point")
+	}
+	return idx, nil
+}
+
+// Save writes the index into the file of the fileservice. The index is not cached until it is
+// loaded, since it is not visible to others before the transaction commits.
+func Save(ctx context.Context, fs fileservice.FileService, path string, idx *Index) error {
+	return writeFile(ctx, fs, path, idx.Marshal())
+}
+
+// SaveDelta writes the delta into the file of the fileservice
+func SaveDelta(ctx context.Context, fs fileservice.FileService, path string, d *Delta) error {
+	return writeFile(ctx, fs, path, d.Marshal())
+}
+
+// Load reads the index from the file of the whole graph of the fileservice, and replays the
+// deltas on it. The returned index is shared by the cache, Clone it before modification.
+func Load(ctx context.Context, fs fileservice.FileService, file string, deltas []string) (*Index, error) {
+	// the last file identifies the index, since a file is never rewritten
+	key := file
+	if len(deltas) > 0 {
+		key = deltas[len(deltas)-1]
+	}
+	if idx, ok := indexCache.get(key); ok {
+		return idx, nil
+	}
+
+	idx, ok := indexCache.get(file)
+	if !ok {
+		data, err := readFile(ctx, fs, file)
+		if err != nil {
+			return nil, err
+		}
+		if idx, err = Unmarshal(data); err != nil {
+			return nil, err
+		}
+		indexCache.put(file, idx)
+	}
+	if len(deltas) == 0 {
+		return idx, nil
+	}
+
+	idx = idx.Clone()
+	for _, path := range deltas {
+		data, err := readFile(ctx, fs, path)
+		if err != nil {
+			return nil, err
+		}
+		d, err := UnmarshalDelta(data)
+		if err != nil {
+			return nil, err
+		}
+		if err = idx.Apply(d); err != nil {
+			return nil, err
+		}
+	}
+	indexCache.put(key, idx)
+	return idx, nil
+}
+
+// DeleteFiles removes the files of the index from the fileservice and the cache
+func DeleteFiles(ctx context.Context, fs fileservice.FileService, paths ...string) error {
+	if len(paths) == 0 {
+		return nil
+	}
+	indexCache.remove(paths...)
+	return fs.Delete(ctx, paths...)
+}
+
+// DeleteAll removes all the files of the index, it is called after the index is dropped
+func DeleteAll(ctx context.Context, fs fileservice.FileService, metadataTable string) error {
+	var paths []string
+	for entry, err := range fs.List(ctx, DirPath(metadataTable)) {
+		if err != nil {
+			return err
+		}
+		if !entry.IsDir {
+			paths = append(paths, FilePath(metadataTable, entry.Name))
+		}
+	}
+	return DeleteFiles(ctx, fs, paths...)
+}
+
+func writeFile(ctx context.Context, fs fileservice.FileService, path string, data []byte) error {
+	vec := fileservice.IOVector{
+		FilePath: path,
+		Entries: []fileservice.IOEntry{
+			{
+				Offset: 0,
+				Size:   int64(len(data)),
+				Data:   data,
+			},
+		},
+	}
+	return fs.Write(ctx, vec)
+}
+
+func readFile(ctx context.Context, fs fileservice.FileService, path string) ([]byte, error) {
+	vec := fileservice.IOVector{
+		FilePath: path,
+		Entries: []fileservice.IOEntry{
+			{
+				Offset: 0,
+				Size:   -1,
+			},
+		},
+	}
+	if err := fs.Read(ctx, &vec); err != nil {
+		return nil, err
+	}
+	return vec.Entries[0].Data, nil
+}
+
+var indexCache = newCache(cacheCapacity)
+
+type cache struct {
+	sync.Mutex
+	capacity int
+	tick     uint64
+	items    map[string]*cacheItem
+}
+
+type cacheItem struct {
+	idx      *Index
+	lastUsed uint64
+}
+
+func newCache(capacity int) *cache {
+	return &cache{
+		capacity: capacity,
+		items:    make(map[string]*cacheItem),
+	}
+}
+
+func (c *cache) get(path string) (*Index, bool) {
+	c.Lock()
+	defer c.Unlock()
+	item, ok := c.items[path]
+	if !ok {
+		return nil, false
+	}
+	c.tick++
+	item.lastUsed = c.tick
+	return item.idx, true
+}
+
+func (c *cache) put(path string, idx *Index) {
+	c.Lock()
+	defer c.Unlock()
+	c.tick++
+	if item, ok := c.items[path]; ok {
+		item.idx, item.lastUsed = idx, c.tick
+		return
+	}
+	if len(c.items) >= c.capacity {
+		// evict the least recently used one
+		var victim string
+		oldest := uint64(math.MaxUint64)
+		for p, item := range c.items {
+			if item.lastUsed < oldest {
+				victim, oldest = p, item.lastUsed
+			}
+		}
+		delete(c.items, victim)
+	}
+	c.items[path] = &cacheItem{idx: idx, lastUsed: c.tick}
+}
+
+func (c *cache) remove(paths ...string) {
+	c.Lock()
+	defer c.Unlock()
+	for _, path := range paths {
+		delete(c.items, path)
+	}
+}
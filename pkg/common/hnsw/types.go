@@ -0,0 +1,178 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hnsw
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+type Metric uint8
+
+const (
+	MetricL2 Metric = iota
+	MetricCosine
+)
+
+const (
+	// operator classes of the index, same as the op_type index parameter
+	OpTypeL2     = catalog.IndexAlgoParamOpType_l2
+	OpTypeCosine = catalog.IndexAlgoParamOpType_cos
+)
+
+// keys of the rows stored in the metadata index table
+const (
+	MetaKeyVersion = "version"
+	// the file of the whole graph, and the number of the vectors in it
+	MetaKeyFile  = "file"
+	MetaKeyCount = "count"
+	// the delta files replayed on the graph in order, and the number of the changes in them
+	MetaKeyDeltas   = "deltas"
+	MetaKeyDeltaOps = "delta_ops"
+	// the replaced files which are waiting to be removed
+	MetaKeyGarbage = "garbage"
+)
+
+// FileRetention is how long a replaced file is kept for the transactions which still read it
+const FileRetention = time.Hour
+
+// Garbage is a file replaced by a new graph since the unix time
+type Garbage struct {
+	Path  string
+	Since int64
+}
+
+// ParseGarbage decodes the garbage list saved in the metadata table by FormatGarbage
+func ParseGarbage(s string) ([]Garbage, error) {
+	if len(s) == 0 {
+		return nil, nil
+	}
+	items := strings.Split(s, ",")
+	ret := make([]Garbage, 0, len(items))
+	for _, item := range items {
+		since, path, ok := strings.Cut(item, ":")
+		if !ok {
+			return nil, moerr.NewInternalErrorNoCtxf("invalid hnsw garbage file %s", item)
+		}
+		ts, err := strconv.ParseInt(since, 10, 64)
+		if err != nil {
+			return nil, moerr.NewInternalErrorNoCtxf("invalid hnsw garbage file %s", item)
+		}
+		ret = append(ret, Garbage{Path: path, Since: ts})
+	}
+	return ret, nil
+}
+
+func FormatGarbage(garbage []Garbage) string {
+	items := make([]string, len(garbage))
+	for i, g := range garbage {
+		items[i] = strconv.FormatInt(g.Since, 10) + ":" + g.Path
+	}
+	return strings.Join(items, ",")
+}
+
+// Param is the index parameters saved in IndexDef.IndexAlgoParams
+type Param struct {
+	M              int64  `json:"m,string"`
+	EfConstruction int64  `json:"ef_construction,string"`
+	EfSearch       int64  `json:"ef_search,string"`
+	OpType         string `json:"op_type"`
+}
+
+// Config describes an hnsw index of a table. It is passed to the hnsw table functions
+// as a json string.
+type Config struct {
+	DbName        string `json:"db"`
+	SrcTable      string `json:"src"`
+	MetadataTable string `json:"meta"`
+	PkName        string `json:"pk"`
+	VecName       string `json:"vec"`
+	Param         Param  `json:"param"`
+}
+
+// NewConfig returns the config of the index, algoParams is the IndexAlgoParams json of the index
+func NewConfig(dbName, srcTable, metadataTable, pkName, vecName, algoParams string) (*Config, error) {
+	cfg := &Config{
+		DbName:        dbName,
+		SrcTable:      srcTable,
+		MetadataTable: metadataTable,
+		PkName:        pkName,
+		VecName:       vecName,
+	}
+	if len(algoParams) > 0 {
+		if err := json.Unmarshal([]byte(algoParams), &cfg.Param); err != nil {
+			return nil, moerr.NewInternalErrorNoCtxf("invalid hnsw index params: %v", err)
+		}
+	}
+	if len(cfg.Param.OpType) == 0 {
+		cfg.Param.OpType = OpTypeL2
+	}
+	if cfg.Param.M <= 0 {
+		cfg.Param.M = catalog.HnswDefaultM
+	}
+	if cfg.Param.EfConstruction <= 0 {
+		cfg.Param.EfConstruction = catalog.HnswDefaultEfConstruction
+	}
+	if cfg.Param.EfSearch <= 0 {
+		cfg.Param.EfSearch = catalog.HnswDefaultEfSearch
+	}
+	if _, err := cfg.Metric(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func ParseConfig(s string) (*Config, error) {
+	cfg := &Config{}
+	if err := json.Unmarshal([]byte(s), cfg); err != nil {
+		return nil, moerr.NewInternalErrorNoCtxf("invalid hnsw index config: %v", err)
+	}
+	if cfg.DbName == "" || cfg.SrcTable == "" || cfg.MetadataTable == "" || cfg.PkName == "" || cfg.VecName == "" {
+		return nil, moerr.NewInternalErrorNoCtx("invalid hnsw index config: missing table or column name")
+	}
+	if _, err := cfg.Metric(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func (cfg *Config) String() string {
+	b, _ := json.Marshal(cfg)
+	return string(b)
+}
+
+func (cfg *Config) Metric() (Metric, error) {
+	switch strings.ToLower(cfg.Param.OpType) {
+	case "", OpTypeL2:
+		return MetricL2, nil
+	case OpTypeCosine:
+		return MetricCosine, nil
+	}
+	return 0, moerr.NewInternalErrorNoCtxf("invalid hnsw op_type %s", cfg.Param.OpType)
+}
+
+// NewIndexFromConfig returns an empty index with the parameters of the config
+func NewIndexFromConfig(cfg *Config, dim int) (*Index, error) {
+	metric, err := cfg.Metric()
+	if err != nil {
+		return nil, err
+	}
+	return NewIndex(dim, int(cfg.Param.M), int(cfg.Param.EfConstruction), int(cfg.Param.EfSearch), metric), nil
+}
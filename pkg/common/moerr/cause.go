@@ -48,6 +48,7 @@ var (
 	CauseMergeObject        = NewInternalError(context.Background(), "merge object")
 	CauseRetention          = NewInternalError(context.Background(), "retention")
 	CauseRetention2         = NewInternalError(context.Background(), "retention 2")
+	CauseRetentionTTL       = NewInternalError(context.Background(), "retention ttl")
 	//pkg/common/morpc
 	CauseDeadlineContextCodec = NewInternalError(context.Background(), "morpc deadlineContextCodec")
 	CausePingPongMain         = NewInternalError(context.Background(), "morpc ping pong main")
@@ -48,6 +48,7 @@ var causeArray = []error{
 	CauseMergeObject,
 	CauseRetention,
 	CauseRetention2,
+	CauseRetentionTTL,
 
 	CauseDeadlineContextCodec,
 	CausePingPongMain,
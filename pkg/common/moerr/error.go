@@ -87,6 +87,17 @@ const (
 	ErrUpgrateError         uint16 = 20311
 	ErrInvalidTz            uint16 = 20312
 	ErrUnsupportedDML       uint16 = 20313
+	ErrSignal               uint16 = 20314
+	ErrSpFetchNoData        uint16 = 20315
+	ErrTriggerAlreadyExists uint16 = 20316
+	ErrTriggerNotExist      uint16 = 20317
+	ErrTriggerCantChangeRow uint16 = 20318
+	ErrTriggerNoSuchRow     uint16 = 20319
+	ErrEventAlreadyExists   uint16 = 20320
+	ErrEventNotExist        uint16 = 20321
+	ErrEventInvalidInterval uint16 = 20322
+	ErrEventInvalidEnds     uint16 = 20323
+	ErrEventInThePast       uint16 = 20324
 
 	// Group 4: unexpected state and io errors
 	ErrInvalidState                             uint16 = 20400
@@ -164,6 +175,7 @@ const (
 	ErrBlobCantHaveDefault                      uint16 = 20472
 	ErrCantCompileForPrepare                    uint16 = 20473
 	ErrTableMustHaveAVisibleColumn              uint16 = 20474
+	ErrSavepointNotExist                        uint16 = 20475
 
 	// Group 5: rpc timeout
 	// ErrRPCTimeout rpc timeout
@@ -243,6 +255,10 @@ const (
 	ErrLockNeedUpgrade uint16 = 20707
 	// ErrCannotCommitOnInvalidCN cannot commit transaction on invalid CN
 	ErrCannotCommitOnInvalidCN uint16 = 20708
+	// ErrLockNoWait lock conflict with NOWAIT
+	ErrLockNoWait uint16 = 20709
+	// ErrLockWaitTimeout lock wait time exceeds the WAIT n of select
+	ErrLockWaitTimeout uint16 = 20710
 
 	// Group 8: partition
 	ErrPartitionFunctionIsNotAllowed       uint16 = 20801
@@ -343,6 +359,17 @@ var errorMsgRefer = map[uint16]moErrorMsgItem{
 	ErrWrongDatetimeSpec:    {ER_WRONG_DATETIME_SPEC, []string{MySQLDefaultSqlState}, "wrong date/time format specifier: %s"},
 	ErrUpgrateError:         {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "CN upgrade table or view '%s.%s' under tenant '%s:%d' reports error: %s"},
 	ErrUnsupportedDML:       {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "unsupported DML: %s"},
+	ErrSignal:               {ER_SIGNAL_EXCEPTION, []string{"45000"}, "%s"},
+	ErrSpFetchNoData:        {ER_SP_FETCH_NO_DATA, []string{"02000"}, "No data - zero rows fetched, selected, or processed"},
+	ErrTriggerAlreadyExists: {ER_TRG_ALREADY_EXISTS, []string{MySQLDefaultSqlState}, "Trigger already exists"},
+	ErrTriggerNotExist:      {ER_TRG_DOES_NOT_EXIST, []string{MySQLDefaultSqlState}, "Trigger does not exist"},
+	ErrTriggerCantChangeRow: {ER_TRG_CANT_CHANGE_ROW, []string{MySQLDefaultSqlState}, "Updating of %s row is not allowed in %s trigger"},
+	ErrTriggerNoSuchRow:     {ER_TRG_NO_SUCH_ROW_IN_TRG, []string{MySQLDefaultSqlState}, "There is no %s row in %s trigger"},
+	ErrEventAlreadyExists:   {ER_EVENT_ALREADY_EXISTS, []string{MySQLDefaultSqlState}, "Event '%s' already exists"},
+	ErrEventNotExist:        {ER_EVENT_DOES_NOT_EXIST, []string{MySQLDefaultSqlState}, "Unknown event '%s'"},
+	ErrEventInvalidInterval: {ER_EVENT_INTERVAL_NOT_POSITIVE_OR_TOO_BIG, []string{MySQLDefaultSqlState}, "INTERVAL is either not positive or too big"},
+	ErrEventInvalidEnds:     {ER_EVENT_ENDS_BEFORE_STARTS, []string{MySQLDefaultSqlState}, "ENDS is either invalid or before STARTS"},
+	ErrEventInThePast:       {ER_EVENT_CANNOT_CREATE_IN_THE_PAST, []string{MySQLDefaultSqlState}, "Event execution time is in the past"},
 
 	// Group 4: unexpected state or file io error
 	ErrInvalidState:                             {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "invalid state %s"},
@@ -419,6 +446,7 @@ var errorMsgRefer = map[uint16]moErrorMsgItem{
 	ErrFKNoReferencedRow2:                       {ER_NO_REFERENCED_ROW_2, []string{"23000"}, "Cannot add or update a child row: a foreign key constraint fails"},
 	ErrBlobCantHaveDefault:                      {ER_BLOB_CANT_HAVE_DEFAULT, []string{MySQLDefaultSqlState}, "BLOB, TEXT, GEOMETRY or JSON column '%-.192s' can't have a default value"},
 	ErrTableMustHaveAVisibleColumn:              {ER_TABLE_MUST_HAVE_A_VISIBLE_COLUMN, []string{MySQLDefaultSqlState}, "A table must have at least one visible column."},
+	ErrSavepointNotExist:                        {ER_SP_DOES_NOT_EXIST, []string{"42000"}, "SAVEPOINT %s does not exist"},
 
 	// Group 5: rpc timeout
 	ErrRPCTimeout:   {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "rpc timeout"},
@@ -478,6 +506,8 @@ var errorMsgRefer = map[uint16]moErrorMsgItem{
 	ErrLockConflict:            {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "lock options conflict, wait policy is fast fail"},
 	ErrLockNeedUpgrade:         {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "row level lock is too large that need upgrade to table level lock"},
 	ErrCannotCommitOnInvalidCN: {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "cannot commit a orphan transaction on invalid cn"},
+	ErrLockNoWait:              {ER_LOCK_NOWAIT, []string{"HY000"}, "Statement aborted because lock(s) could not be acquired immediately and NOWAIT is set."},
+	ErrLockWaitTimeout:         {ER_LOCK_WAIT_TIMEOUT, []string{"HY000"}, "Lock wait timeout exceeded; try restarting transaction"},
 
 	// Group 8: partition
 	ErrPartitionFunctionIsNotAllowed:       {ER_PARTITION_FUNCTION_IS_NOT_ALLOWED, []string{MySQLDefaultSqlState}, "This partition function is not allowed"},
@@ -861,6 +891,59 @@ func NewUnsupportedDML(ctx context.Context, msg string, args ...any) *Error {
 	return newError(ctx, ErrUnsupportedDML, xmsg)
 }
 
+// NewSignal returns the condition raised by SIGNAL or RESIGNAL in a stored procedure,
+// it keeps the sqlstate and the mysql error code given by the user.
+func NewSignal(ctx context.Context, sqlState string, mysqlCode uint16, msg string) *Error {
+	err := newError(ctx, ErrSignal, msg)
+	err.sqlState = sqlState
+	err.mysqlCode = mysqlCode
+	return err
+}
+
+func NewSpFetchNoData(ctx context.Context) *Error {
+	return newError(ctx, ErrSpFetchNoData)
+}
+
+func NewTriggerAlreadyExists(ctx context.Context) *Error {
+	return newError(ctx, ErrTriggerAlreadyExists)
+}
+
+func NewTriggerNotExist(ctx context.Context) *Error {
+	return newError(ctx, ErrTriggerNotExist)
+}
+
+// NewTriggerCantChangeRow returns the error of assigning to the NEW or OLD row in the trigger
+// which can not change it, row is NEW or OLD and timing is BEFORE or AFTER.
+func NewTriggerCantChangeRow(ctx context.Context, row, timing string) *Error {
+	return newError(ctx, ErrTriggerCantChangeRow, row, timing)
+}
+
+// NewTriggerNoSuchRow returns the error of referring to the NEW row in a DELETE trigger
+// or the OLD row in an INSERT trigger.
+func NewTriggerNoSuchRow(ctx context.Context, row, event string) *Error {
+	return newError(ctx, ErrTriggerNoSuchRow, row, event)
+}
+
+func NewEventAlreadyExists(ctx context.Context, name string) *Error {
+	return newError(ctx, ErrEventAlreadyExists, name)
+}
+
+func NewEventNotExist(ctx context.Context, name string) *Error {
+	return newError(ctx, ErrEventNotExist, name)
+}
+
+func NewEventInvalidInterval(ctx context.Context) *Error {
+	return newError(ctx, ErrEventInvalidInterval)
+}
+
+func NewEventInvalidEnds(ctx context.Context) *Error {
+	return newError(ctx, ErrEventInvalidEnds)
+}
+
+func NewEventInThePast(ctx context.Context) *Error {
+	return newError(ctx, ErrEventInThePast)
+}
+
 func NewEmptyVector(ctx context.Context) *Error {
 	return newError(ctx, ErrEmptyVector)
 }
@@ -1292,6 +1375,14 @@ func NewLockConflict(ctx context.Context) *Error {
 	return newError(ctx, ErrLockConflict)
 }
 
+func NewLockNoWait(ctx context.Context) *Error {
+	return newError(ctx, ErrLockNoWait)
+}
+
+func NewLockWaitTimeout(ctx context.Context) *Error {
+	return newError(ctx, ErrLockWaitTimeout)
+}
+
 func NewPartitionFunctionIsNotAllowed(ctx context.Context) *Error {
 	return newError(ctx, ErrPartitionFunctionIsNotAllowed)
 }
@@ -1526,6 +1617,10 @@ func NewTableMustHaveVisibleColumn(ctx context.Context) *Error {
 	return newError(ctx, ErrTableMustHaveAVisibleColumn)
 }
 
+func NewSavepointNotExist(ctx context.Context, name string) *Error {
+	return newError(ctx, ErrSavepointNotExist, name)
+}
+
 func NewTxnUnknown(ctx context.Context, txnID string) *Error {
 	return newError(ctx, ErrTxnUnknown, txnID)
 }
@@ -47,9 +47,13 @@ const PARAMKEY_PROVIDER = "provider"
 const S3_PROVIDER_AMAZON = "amazon"
 const S3_PROVIDER_MINIO = "minio"
 const S3_PROVIDER_COS = "cos"
+const S3_PROVIDER_AZURE = "azure"
+const S3_PROVIDER_GCS = "gcs"
 
 const S3_SERVICE = "s3"
 const MINIO_SERVICE = "minio"
+const AZURE_SERVICE = "azure"
+const GCS_SERVICE = "gcs"
 
 type StageDef struct {
 	Id          uint32
@@ -96,6 +100,8 @@ func (s *StageDef) expandSubStage(proc *process.Process) (StageDef, error) {
 // get stages and expand the path. stage may be a file or s3
 // use the format of path  s3,<endpoint>,<region>,<bucket>,<key>,<secret>,<prefix>
 // or minio,<endpoint>,<region>,<bucket>,<key>,<secret>,<prefix>
+// or azure,<endpoint>,<region>,<container>,<account>,<account key>,<prefix>
+// or gcs,<endpoint>,<region>,<bucket>,<key>,<service account json>,<prefix>
 // expand the subpath to MO path.
 // subpath is in the format like path or path with query like path?q1=v1&q2=v2...
 func (s *StageDef) ToPath() (mopath string, query string, err error) {
@@ -157,6 +163,10 @@ func getS3ServiceFromProvider(provider string) (string, error) {
 		return S3_SERVICE, nil
 	case S3_PROVIDER_MINIO:
 		return MINIO_SERVICE, nil
+	case S3_PROVIDER_AZURE:
+		return AZURE_SERVICE, nil
+	case S3_PROVIDER_GCS:
+		return GCS_SERVICE, nil
 	default:
 		return "", moerr.NewBadConfigf(context.TODO(), "provider %s not supported", provider)
 	}
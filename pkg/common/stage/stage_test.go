@@ -34,4 +34,12 @@ func TestS3ServiceProvider(t *testing.T) {
 	require.Nil(t, err)
 	assert.Equal(t, protocol, "minio")
 
+	protocol, err = getS3ServiceFromProvider("azure")
+	require.Nil(t, err)
+	assert.Equal(t, protocol, "azure")
+
+	protocol, err = getS3ServiceFromProvider("gcs")
+	require.Nil(t, err)
+	assert.Equal(t, protocol, "gcs")
+
 }
@@ -15,15 +15,106 @@
 package compress
 
 import (
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
 	"github.com/pierrec/lz4/v4"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 )
 
 var Algorithms map[string]int = map[string]int{
-	"lz4":  Lz4,
-	"none": None,
+	"lz4":    Lz4,
+	"none":   None,
+	"zstd":   Zstd,
+	"snappy": Snappy,
+}
+
+var (
+	// zstd encoders of every level, the encoder is safe for concurrent use of EncodeAll
+	zstdEncoders sync.Map
+	// zstd decoder is safe for concurrent use of DecodeAll
+	zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderConcurrency(0))
+)
+
+func getZstdEncoder(level int) (*zstd.Encoder, error) {
+	if v, ok := zstdEncoders.Load(level); ok {
+		return v.(*zstd.Encoder), nil
+	}
+	enc, err := zstd.NewWriter(
+		nil,
+		zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level)),
+		zstd.WithEncoderConcurrency(1),
+	)
+	if err != nil {
+		return nil, err
+	}
+	v, loaded := zstdEncoders.LoadOrStore(level, enc)
+	if loaded {
+		_ = enc.Close()
+	}
+	return v.(*zstd.Encoder), nil
+}
+
+// ParseAlgorithm parses the compression option of a table, e.g. lz4, snappy, zstd or zstd:19.
+// the level is only meaningful for zstd, and it is 0 for the default level.
+func ParseAlgorithm(s string) (T, int, error) {
+	name, levelStr, hasLevel := strings.Cut(strings.ToLower(strings.TrimSpace(s)), ":")
+	alg, ok := Algorithms[name]
+	if !ok {
+		return None, 0, moerr.NewInvalidInputNoCtxf("unsupported compression algorithm '%s'", s)
+	}
+	if !hasLevel {
+		return T(alg), 0, nil
+	}
+	if alg != Zstd {
+		return None, 0, moerr.NewInvalidInputNoCtxf("compression algorithm '%s' does not support level", name)
+	}
+	level, err := strconv.Atoi(levelStr)
+	if err != nil || level < ZstdMinLevel || level > ZstdMaxLevel {
+		return None, 0, moerr.NewInvalidInputNoCtxf("invalid zstd compression level '%s', it should be in [%d, %d]",
+			levelStr, ZstdMinLevel, ZstdMaxLevel)
+	}
+	return T(alg), level, nil
+}
+
+// FormatAlgorithm is the reverse of ParseAlgorithm
+func FormatAlgorithm(alg T, level int) string {
+	name := strings.ToLower(alg.String())
+	if alg == Zstd && level != 0 {
+		return name + ":" + strconv.Itoa(level)
+	}
+	return name
+}
+
+// CompressBound returns the max length of the compressed data of typ
+func CompressBound(n int, typ int) int {
+	switch typ {
+	case Lz4:
+		return lz4.CompressBlockBound(n)
+	case Zstd:
+		// the same as ZSTD_COMPRESSBOUND
+		bound := n + n>>8
+		if n < 128<<10 {
+			bound += (128<<10 - n) >> 11
+		}
+		return bound
+	case Snappy:
+		return snappy.MaxEncodedLen(n)
+	}
+	return n
 }
 
 func Compress(src, dst []byte, typ int) ([]byte, error) {
+	return CompressWithLevel(src, dst, typ, 0)
+}
+
+// CompressWithLevel compresses src into dst, dst should be at least CompressBound(len(src), typ) long.
+// level is only used by zstd, 0 means the default level.
+func CompressWithLevel(src, dst []byte, typ int, level int) ([]byte, error) {
 	switch typ {
 	case Lz4:
 		n, err := lz4.CompressBlock(src, dst, nil)
@@ -31,10 +122,22 @@ func Compress(src, dst []byte, typ int) ([]byte, error) {
 			return nil, err
 		}
 		return dst[:n], nil
+	case Zstd:
+		if level == 0 {
+			level = ZstdDefaultLevel
+		}
+		enc, err := getZstdEncoder(level)
+		if err != nil {
+			return nil, err
+		}
+		return enc.EncodeAll(src, dst[:0]), nil
+	case Snappy:
+		return snappy.Encode(dst, src), nil
 	}
 	return nil, nil
 }
 
+// Decompress decompresses src into dst, dst should be as long as the original data
 func Decompress(src, dst []byte, typ int) ([]byte, error) {
 	switch typ {
 	case Lz4:
@@ -43,6 +146,24 @@ func Decompress(src, dst []byte, typ int) ([]byte, error) {
 			return nil, err
 		}
 		return dst[:n], nil
+	case Zstd:
+		res, err := zstdDecoder.DecodeAll(src, dst[:0])
+		if err != nil {
+			return nil, err
+		}
+		if len(res) > len(dst) {
+			return nil, moerr.NewInternalErrorNoCtxf("zstd decompress: dst is too small, %d < %d", len(dst), len(res))
+		}
+		return res, nil
+	case Snappy:
+		n, err := snappy.DecodedLen(src)
+		if err != nil {
+			return nil, err
+		}
+		if n > len(dst) {
+			return nil, moerr.NewInternalErrorNoCtxf("snappy decompress: dst is too small, %d < %d", len(dst), n)
+		}
+		return snappy.Decode(dst, src)
 	}
-	return nil, nil
+	return nil, moerr.NewInternalErrorNoCtxf("unsupported compress type: %d", typ)
 }
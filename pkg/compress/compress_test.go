@@ -22,6 +22,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 
 	"github.com/pierrec/lz4/v4"
+	"github.com/stretchr/testify/require"
 )
 
 func TestLz4(t *testing.T) {
@@ -41,3 +42,61 @@ func TestLz4(t *testing.T) {
 	}
 	fmt.Printf("dat: %v\n", data)
 }
+
+func TestCompressAlgorithms(t *testing.T) {
+	xs := make([]int64, 8192)
+	for i := range xs {
+		xs[i] = int64(i % 100)
+	}
+	raw := types.EncodeSlice(xs)
+
+	for _, typ := range []int{Lz4, Zstd, Snappy} {
+		for _, level := range []int{0, 1, 19} {
+			buf := make([]byte, CompressBound(len(raw), typ))
+			compressed, err := CompressWithLevel(raw, buf, typ, level)
+			require.NoError(t, err)
+			require.Less(t, len(compressed), len(raw), T(typ).String())
+
+			dst := make([]byte, len(raw))
+			data, err := Decompress(compressed, dst, typ)
+			require.NoError(t, err)
+			require.Equal(t, raw, data, T(typ).String())
+		}
+
+		// dst is too small
+		compressed, err := Compress(raw, make([]byte, CompressBound(len(raw), typ)), typ)
+		require.NoError(t, err)
+		_, err = Decompress(compressed, make([]byte, len(raw)/2), typ)
+		require.Error(t, err, T(typ).String())
+	}
+}
+
+func TestParseAlgorithm(t *testing.T) {
+	cases := []struct {
+		s     string
+		alg   T
+		level int
+		ok    bool
+	}{
+		{s: "lz4", alg: Lz4, ok: true},
+		{s: "None", alg: None, ok: true},
+		{s: "snappy", alg: Snappy, ok: true},
+		{s: "ZSTD", alg: Zstd, ok: true},
+		{s: "zstd:19", alg: Zstd, level: 19, ok: true},
+		{s: "zstd:0"},
+		{s: "zstd:23"},
+		{s: "zstd:abc"},
+		{s: "lz4:3"},
+		{s: "zlib"},
+	}
+	for _, c := range cases {
+		alg, level, err := ParseAlgorithm(c.s)
+		if !c.ok {
+			require.Error(t, err, c.s)
+			continue
+		}
+		require.NoError(t, err, c.s)
+		require.Equal(t, c.alg, alg, c.s)
+		require.Equal(t, c.level, level, c.s)
+	}
+}
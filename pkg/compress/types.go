@@ -16,9 +16,20 @@ package compress
 
 import "fmt"
 
+// the value is persisted in the extent of the object file,
+// so never change the value of an existing algorithm.
 const (
 	None = iota
 	Lz4
+	Zstd
+	Snappy
+)
+
+const (
+	// ZstdDefaultLevel is used when the level of zstd is not specified
+	ZstdDefaultLevel = 3
+	ZstdMinLevel     = 1
+	ZstdMaxLevel     = 22
 )
 
 type T uint8
@@ -29,6 +40,10 @@ func (t T) String() string {
 		return "None"
 	case Lz4:
 		return "LZ4"
+	case Zstd:
+		return "ZSTD"
+	case Snappy:
+		return "SNAPPY"
 	}
 	return fmt.Sprintf("unexpected compress type: %d", t)
 }
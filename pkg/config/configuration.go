@@ -88,6 +88,9 @@ var (
 	//export data to csv file default flush size
 	defaultExportDataDefaultFlushSize = 1
 
+	//export data to parquet file default rows of a row group
+	defaultExportParquetRowGroupSize = 128 * 1024
+
 	//port defines which port the rpc server listens on
 	defaultPortOfRpcServerInComputationEngine = 20000
 
@@ -139,6 +142,13 @@ var (
 	// defaultOBExporterCntPercent
 	defaultOBExporterCntPercent = 1000
 
+	// defaultOTLPProtocol default: grpc
+	defaultOTLPProtocol = OTLPProtocolGRPC
+	// defaultOTLPTimeout default: 10s
+	defaultOTLPTimeout = 10 * time.Second
+	// defaultOTLPMetricExportInterval default: 15s
+	defaultOTLPMetricExportInterval = 15 * time.Second
+
 	// defaultPrintDebugInterval default: 30 minutes
 	defaultPrintDebugInterval = 30
 
@@ -234,6 +244,9 @@ type FrontendParameters struct {
 	//export data to csv file default flush size
 	ExportDataDefaultFlushSize int64 `toml:"exportDataDefaultFlushSize"`
 
+	//export data to parquet file, the max rows of a row group
+	ExportParquetRowGroupSize int64 `toml:"exportParquetRowGroupSize"`
+
 	//port defines which port the rpc server listens on
 	PortOfRpcServerInComputationEngine int64 `toml:"portOfRpcServerInComputationEngine"`
 
@@ -380,6 +393,10 @@ func (fp *FrontendParameters) SetDefaultValues() {
 		fp.ExportDataDefaultFlushSize = int64(defaultExportDataDefaultFlushSize)
 	}
 
+	if fp.ExportParquetRowGroupSize == 0 {
+		fp.ExportParquetRowGroupSize = int64(defaultExportParquetRowGroupSize)
+	}
+
 	if fp.PortOfRpcServerInComputationEngine == 0 {
 		fp.PortOfRpcServerInComputationEngine = int64(defaultPortOfRpcServerInComputationEngine)
 	}
@@ -598,6 +615,9 @@ type ObservabilityParameters struct {
 
 	OBCollectorConfig
 
+	// OTLP exports spans and metrics to an OpenTelemetry collector
+	OTLP OBOTLPConfig `toml:"otlp"`
+
 	ObservabilityOldParameters
 }
 
@@ -663,6 +683,7 @@ func NewObservabilityParameters() *ObservabilityParameters {
 		CU:                                 *NewOBCUConfig(),
 		CUv1:                               *NewOBCUConfig(),
 		OBCollectorConfig:                  *NewOBCollectorConfig(),
+		OTLP:                               *NewOBOTLPConfig(),
 		//ObservabilityOldParameters // default as false/0/nil
 	}
 	op.MetricInternalGatherInterval.Duration = defaultMetricInternalGatherInterval
@@ -677,6 +698,7 @@ func NewObservabilityParameters() *ObservabilityParameters {
 
 func (op *ObservabilityParameters) SetDefaultValues(version string) {
 	op.OBCollectorConfig.SetDefaultValues()
+	op.OTLP.SetDefaultValues()
 	op.CU.SetDefaultValues()
 	op.CUv1.SetDefaultValues()
 
@@ -862,6 +884,64 @@ func (c *OBCollectorConfig) SetDefaultValues() {
 	}
 }
 
+const (
+	OTLPProtocolGRPC = "grpc"
+	OTLPProtocolHTTP = "http"
+)
+
+// OBOTLPConfig configs the OTLP exporters of spans and metrics
+type OBOTLPConfig struct {
+	// Endpoint of the collector, like 127.0.0.1:4317 for grpc or http://127.0.0.1:4318 for http.
+	// Empty means OTLP export is disabled.
+	Endpoint string `toml:"endpoint"`
+	// Protocol is grpc or http, default: grpc
+	Protocol string `toml:"protocol"`
+	// Insecure disables TLS, if the endpoint is not prefixed by http:// or https://
+	Insecure bool `toml:"insecure"`
+	// Headers are sent with every export request, like authorization tokens
+	Headers map[string]string `toml:"headers"`
+	// Timeout of every export request, default: 10s
+	Timeout toml.Duration `toml:"timeout"`
+	// DisableTrace disables the span exporter
+	DisableTrace bool `toml:"disable-trace"`
+	// DisableMetric disables the metric exporter
+	DisableMetric bool `toml:"disable-metric"`
+	// MetricExportInterval default: 15s
+	MetricExportInterval toml.Duration `toml:"metric-export-interval"`
+}
+
+func NewOBOTLPConfig() *OBOTLPConfig {
+	cfg := &OBOTLPConfig{
+		Protocol: defaultOTLPProtocol,
+		Headers:  map[string]string{},
+	}
+	cfg.Timeout.Duration = defaultOTLPTimeout
+	cfg.MetricExportInterval.Duration = defaultOTLPMetricExportInterval
+	return cfg
+}
+
+func (c *OBOTLPConfig) SetDefaultValues() {
+	if c.Protocol == "" {
+		c.Protocol = defaultOTLPProtocol
+	}
+	if c.Timeout.Duration <= 0 {
+		c.Timeout.Duration = defaultOTLPTimeout
+	}
+	if c.MetricExportInterval.Duration <= 0 {
+		c.MetricExportInterval.Duration = defaultOTLPMetricExportInterval
+	}
+}
+
+// EnableTrace returns true if spans should be exported by OTLP
+func (c *OBOTLPConfig) EnableTrace() bool {
+	return c.Endpoint != "" && !c.DisableTrace
+}
+
+// EnableMetric returns true if metrics should be exported by OTLP
+func (c *OBOTLPConfig) EnableMetric() bool {
+	return c.Endpoint != "" && !c.DisableMetric
+}
+
 type OBCUConfig struct {
 	// cu unit
 	CUUnit float64 `toml:"cu_unit"`
@@ -274,6 +274,15 @@ func (bj ByteJson) getValEntry(off int) ByteJson {
 }
 
 func (bj ByteJson) queryValByKey(key []byte) ByteJson {
+	val, ok := bj.lookupKey(key)
+	if !ok {
+		return Null
+	}
+	return val
+}
+
+// lookupKey returns the value of the key of the object, and false if the key does not exist
+func (bj ByteJson) lookupKey(key []byte) (ByteJson, bool) {
 	cnt := bj.GetElemCnt()
 	var idx int
 	if cnt < binarySearchCutoff {
@@ -292,9 +301,9 @@ func (bj ByteJson) queryValByKey(key []byte) ByteJson {
 	}
 
 	if idx >= cnt || !bytes.Equal(bj.getObjectKey(idx), key) {
-		return Null
+		return Null, false
 	}
-	return bj.getObjectVal(idx)
+	return bj.getObjectVal(idx), true
 }
 
 func (bj ByteJson) query(cur []ByteJson, path *Path) []ByteJson {
@@ -402,6 +411,86 @@ func (bj ByteJson) Query(paths []*Path) ByteJson {
 	return mergeToArray(out)
 }
 
+// QueryValues returns the values matched by the path in document order.  Unlike Query, the values are
+// not merged into an array, and the missing keys or out of range indices match nothing instead of null.
+func (bj ByteJson) QueryValues(path *Path) []ByteJson {
+	return bj.queryValues(nil, path)
+}
+
+func (bj ByteJson) queryValues(cur []ByteJson, path *Path) []ByteJson {
+	if path.empty() {
+		return append(cur, bj)
+	}
+	sub, nPath := path.step()
+
+	if sub.tp == subPathDoubleStar {
+		cur = bj.queryValues(cur, &nPath)
+		if bj.Type == TpCodeObject {
+			cnt := bj.GetElemCnt()
+			for i := 0; i < cnt; i++ {
+				cur = bj.getObjectVal(i).queryValues(cur, path)
+			}
+		} else if bj.Type == TpCodeArray {
+			cnt := bj.GetElemCnt()
+			for i := 0; i < cnt; i++ {
+				cur = bj.getArrayElem(i).queryValues(cur, path)
+			}
+		}
+		return cur
+	}
+
+	switch bj.Type {
+	case TpCodeArray:
+		cnt := bj.GetElemCnt()
+		switch sub.tp {
+		case subPathIdx:
+			idx, _, _ := sub.idx.genIndex(cnt)
+			if idx == subPathIdxALL {
+				for i := 0; i < cnt; i++ {
+					cur = bj.getArrayElem(i).queryValues(cur, &nPath)
+				}
+			} else if idx >= 0 && idx < cnt {
+				cur = bj.getArrayElem(idx).queryValues(cur, &nPath)
+			}
+		case subPathRange:
+			se := sub.iRange.genRange(cnt)
+			if se[0] == subPathIdxErr {
+				return cur
+			}
+			for i := max(se[0], 0); i <= se[1] && i < cnt; i++ {
+				cur = bj.getArrayElem(i).queryValues(cur, &nPath)
+			}
+		}
+	default:
+		// objects and scalars are auto-wrapped as a single element array.
+		switch sub.tp {
+		case subPathIdx:
+			start, _, _ := sub.idx.genIndex(1)
+			if start == 0 {
+				cur = bj.queryValues(cur, &nPath)
+			}
+		case subPathRange:
+			se := sub.iRange.genRange(1)
+			if se[0] == 0 {
+				cur = bj.queryValues(cur, &nPath)
+			}
+		case subPathKey:
+			if bj.Type != TpCodeObject {
+				break
+			}
+			if sub.key == "*" {
+				cnt := bj.GetElemCnt()
+				for i := 0; i < cnt; i++ {
+					cur = bj.getObjectVal(i).queryValues(cur, &nPath)
+				}
+			} else if val, ok := bj.lookupKey(util.UnsafeStringToBytes(sub.key)); ok {
+				cur = val.queryValues(cur, &nPath)
+			}
+		}
+	}
+	return cur
+}
+
 func (bj ByteJson) querySimple(path *Path) ByteJson {
 	cur := bj
 	// don't go through th step(), recursive call route.  We know
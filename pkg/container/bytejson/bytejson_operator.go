@@ -0,0 +1,394 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"bytes"
+	"slices"
+	"strconv"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// NewArray builds a json array of the elements.
+func NewArray(elems []ByteJson) ByteJson {
+	return buildBinaryJSONArray(elems)
+}
+
+// NewObject builds a json object of the key-value pairs. If a key is duplicated, the last value wins.
+func NewObject(keys [][]byte, vals []ByteJson) (ByteJson, error) {
+	if len(keys) != len(vals) {
+		return Null, moerr.NewInvalidInputNoCtx("keys and values of json object should have the same length")
+	}
+	idx := make([]int, len(keys))
+	for i := range idx {
+		idx[i] = i
+	}
+	// the keys are sorted for the binary search, the later one of the duplicated keys is kept
+	slices.SortStableFunc(idx, func(a, b int) int {
+		return bytes.Compare(keys[a], keys[b])
+	})
+	sortedKeys := make([][]byte, 0, len(keys))
+	sortedVals := make([]ByteJson, 0, len(vals))
+	for _, i := range idx {
+		if n := len(sortedKeys); n > 0 && bytes.Equal(sortedKeys[n-1], keys[i]) {
+			sortedVals[n-1] = vals[i]
+			continue
+		}
+		sortedKeys = append(sortedKeys, keys[i])
+		sortedVals = append(sortedVals, vals[i])
+	}
+	return buildJsonObject(sortedKeys, sortedVals)
+}
+
+// TypeName returns the type name of the json value, as MySQL JSON_TYPE.
+func (bj ByteJson) TypeName() string {
+	if bj.Type == TpCodeLiteral {
+		if bj.Data[0] == LiteralNull {
+			return "NULL"
+		}
+		return "BOOLEAN"
+	}
+	return bj.TYPE()
+}
+
+// Length returns the number of elements of an array, the number of members of an object,
+// and 1 for a scalar.
+func (bj ByteJson) Length() int {
+	if bj.Type == TpCodeArray || bj.Type == TpCodeObject {
+		return bj.GetElemCnt()
+	}
+	return 1
+}
+
+// Keys returns the keys of the object as a json array, and false if bj is not an object.
+func (bj ByteJson) Keys() (ByteJson, bool, error) {
+	if bj.Type != TpCodeObject {
+		return Null, false, nil
+	}
+	cnt := bj.GetElemCnt()
+	keys := make([]ByteJson, 0, cnt)
+	for i := 0; i < cnt; i++ {
+		key, err := CreateByteJSON(string(bj.getObjectKey(i)))
+		if err != nil {
+			return Null, false, err
+		}
+		keys = append(keys, key)
+	}
+	return NewArray(keys), true, nil
+}
+
+// QueryFirst returns the first value selected by the path, and false if the path selects nothing.
+func (bj ByteJson) QueryFirst(path *Path) (ByteJson, bool) {
+	ret, found := Null, false
+	bj.walkPath(*path, "$", func(_ string, val ByteJson) bool {
+		ret, found = val, true
+		return true
+	})
+	return ret, found
+}
+
+// objectMembers returns the keys and values of the object.
+func (bj ByteJson) objectMembers() ([][]byte, []ByteJson) {
+	cnt := bj.GetElemCnt()
+	keys := make([][]byte, cnt)
+	vals := make([]ByteJson, cnt)
+	for i := 0; i < cnt; i++ {
+		keys[i] = bj.getObjectKey(i)
+		vals[i] = bj.getObjectVal(i)
+	}
+	return keys, vals
+}
+
+// arrayElems returns the elements of the array.
+func (bj ByteJson) arrayElems() []ByteJson {
+	cnt := bj.GetElemCnt()
+	elems := make([]ByteJson, cnt)
+	for i := 0; i < cnt; i++ {
+		elems[i] = bj.getArrayElem(i)
+	}
+	return elems
+}
+
+// Remove removes the values at the paths one by one, as MySQL JSON_REMOVE. The paths must be
+// simple paths other than '$', a path that does not exist is ignored.
+func (bj ByteJson) Remove(paths []*Path) (ByteJson, error) {
+	for _, path := range paths {
+		if path.empty() || !path.IsSimple() {
+			return Null, moerr.NewInvalidInputNoCtxf("invalid json path '%s' for json_remove", path.String())
+		}
+	}
+	var err error
+	for _, path := range paths {
+		if bj, err = bj.removePath(*path); err != nil {
+			return Null, err
+		}
+	}
+	return bj, nil
+}
+
+func (bj ByteJson) removePath(path Path) (ByteJson, error) {
+	sub, rest := path.step()
+	switch {
+	case sub.tp == subPathKey && bj.Type == TpCodeObject:
+		keys, vals := bj.objectMembers()
+		i := slices.IndexFunc(keys, func(key []byte) bool {
+			return string(key) == sub.key
+		})
+		if i == -1 {
+			return bj, nil
+		}
+		if rest.empty() {
+			keys = slices.Delete(keys, i, i+1)
+			vals = slices.Delete(vals, i, i+1)
+		} else {
+			val, err := vals[i].removePath(rest)
+			if err != nil {
+				return Null, err
+			}
+			vals[i] = val
+		}
+		return buildJsonObject(keys, vals)
+	case sub.tp == subPathIdx && bj.Type == TpCodeArray:
+		elems := bj.arrayElems()
+		orig, i, _ := sub.idx.genIndex(len(elems))
+		if orig < 0 || orig >= len(elems) {
+			return bj, nil
+		}
+		if rest.empty() {
+			elems = slices.Delete(elems, i, i+1)
+		} else {
+			elem, err := elems[i].removePath(rest)
+			if err != nil {
+				return Null, err
+			}
+			elems[i] = elem
+		}
+		return NewArray(elems), nil
+	}
+	return bj, nil
+}
+
+// MergePreserve merges the documents as MySQL JSON_MERGE_PRESERVE: adjacent arrays are
+// concatenated, adjacent objects are combined with the values of the same key merged, and a
+// scalar is wrapped as an array to be merged with an array.
+func MergePreserve(docs []ByteJson) (ByteJson, error) {
+	result := docs[0]
+	var err error
+	for _, doc := range docs[1:] {
+		if result, err = mergePreserve(result, doc); err != nil {
+			return Null, err
+		}
+	}
+	return result, nil
+}
+
+func mergePreserve(left, right ByteJson) (ByteJson, error) {
+	if left.Type == TpCodeObject && right.Type == TpCodeObject {
+		keys, vals := left.objectMembers()
+		rightKeys, rightVals := right.objectMembers()
+		for i, key := range rightKeys {
+			j := slices.IndexFunc(keys, func(k []byte) bool {
+				return bytes.Equal(k, key)
+			})
+			if j == -1 {
+				keys = append(keys, key)
+				vals = append(vals, rightVals[i])
+				continue
+			}
+			val, err := mergePreserve(vals[j], rightVals[i])
+			if err != nil {
+				return Null, err
+			}
+			vals[j] = val
+		}
+		return NewObject(keys, vals)
+	}
+
+	var elems []ByteJson
+	for _, doc := range []ByteJson{left, right} {
+		if doc.Type == TpCodeArray {
+			elems = append(elems, doc.arrayElems()...)
+		} else {
+			elems = append(elems, doc)
+		}
+	}
+	return NewArray(elems), nil
+}
+
+// MergePatch merges the documents as MySQL JSON_MERGE_PATCH, which follows RFC 7396: a
+// non-object patch replaces the document, and the members of an object patch are merged into
+// the document recursively, with a null value removing the member.
+func MergePatch(docs []ByteJson) (ByteJson, error) {
+	result := docs[0]
+	var err error
+	for _, doc := range docs[1:] {
+		if result, err = mergePatch(result, doc); err != nil {
+			return Null, err
+		}
+	}
+	return result, nil
+}
+
+func mergePatch(target, patch ByteJson) (ByteJson, error) {
+	if patch.Type != TpCodeObject {
+		return patch, nil
+	}
+	var keys [][]byte
+	var vals []ByteJson
+	if target.Type == TpCodeObject {
+		keys, vals = target.objectMembers()
+	}
+	patchKeys, patchVals := patch.objectMembers()
+	for i, key := range patchKeys {
+		j := slices.IndexFunc(keys, func(k []byte) bool {
+			return bytes.Equal(k, key)
+		})
+		if patchVals[i].IsNull() {
+			if j != -1 {
+				keys = slices.Delete(keys, j, j+1)
+				vals = slices.Delete(vals, j, j+1)
+			}
+			continue
+		}
+		var val ByteJson
+		if j == -1 {
+			val = Null
+		} else {
+			val = vals[j]
+		}
+		val, err := mergePatch(val, patchVals[i])
+		if err != nil {
+			return Null, err
+		}
+		if j == -1 {
+			keys = append(keys, key)
+			vals = append(vals, val)
+		} else {
+			vals[j] = val
+		}
+	}
+	return NewObject(keys, vals)
+}
+
+// Search returns the paths of the string values matched by match under the paths, in the
+// format of MySQL JSON_SEARCH. If one is true, only the first path is returned.
+func (bj ByteJson) Search(paths []*Path, match func([]byte) bool, one bool) []string {
+	var found []string
+	seen := make(map[string]struct{})
+	for _, path := range paths {
+		done := bj.walkPath(*path, "$", func(pathStr string, val ByteJson) bool {
+			return val.search(pathStr, match, func(p string) bool {
+				if _, ok := seen[p]; !ok {
+					seen[p] = struct{}{}
+					found = append(found, p)
+				}
+				return one
+			})
+		})
+		if done {
+			break
+		}
+	}
+	return found
+}
+
+// walkPath calls fn with each value selected by the path and its location, and stops when fn
+// returns true. A scalar is treated as an array of itself for the array legs.
+func (bj ByteJson) walkPath(path Path, pathStr string, fn func(string, ByteJson) bool) bool {
+	if path.empty() {
+		return fn(pathStr, bj)
+	}
+	sub, rest := path.step()
+	switch sub.tp {
+	case subPathDoubleStar:
+		if bj.walkPath(rest, pathStr, fn) {
+			return true
+		}
+		return bj.walkChildren(pathStr, func(childPath string, child ByteJson) bool {
+			return child.walkPath(path, childPath, fn)
+		})
+	case subPathKey:
+		if bj.Type != TpCodeObject {
+			return false
+		}
+		if sub.key == "*" {
+			return bj.walkChildren(pathStr, func(childPath string, child ByteJson) bool {
+				return child.walkPath(rest, childPath, fn)
+			})
+		}
+		if val, ok := bj.lookupKey([]byte(sub.key)); ok {
+			return val.walkPath(rest, pathStr+"."+formatPathKey(sub.key), fn)
+		}
+	case subPathIdx, subPathRange:
+		elems := []ByteJson{bj}
+		if bj.Type == TpCodeArray {
+			elems = bj.arrayElems()
+		}
+		start, end := 0, len(elems)-1
+		if sub.tp == subPathIdx && sub.idx.num != subPathIdxALL {
+			orig, _, _ := sub.idx.genIndex(len(elems))
+			start, end = orig, orig
+		} else if sub.tp == subPathRange {
+			se := sub.iRange.genRange(len(elems))
+			start, end = se[0], se[1]
+		}
+		for i := max(start, 0); i <= end && i < len(elems); i++ {
+			if elems[i].walkPath(rest, pathStr+"["+strconv.Itoa(i)+"]", fn) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// walkChildren calls fn with each element of an array or member of an object and its location.
+func (bj ByteJson) walkChildren(pathStr string, fn func(string, ByteJson) bool) bool {
+	switch bj.Type {
+	case TpCodeArray:
+		cnt := bj.GetElemCnt()
+		for i := 0; i < cnt; i++ {
+			if fn(pathStr+"["+strconv.Itoa(i)+"]", bj.getArrayElem(i)) {
+				return true
+			}
+		}
+	case TpCodeObject:
+		cnt := bj.GetElemCnt()
+		for i := 0; i < cnt; i++ {
+			if fn(pathStr+"."+formatPathKey(string(bj.getObjectKey(i))), bj.getObjectVal(i)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// formatPathKey quotes the key of a path leg if it is not an identifier.
+func formatPathKey(key string) string {
+	if isIdentifier(key) {
+		return key
+	}
+	return strconv.Quote(key)
+}
+
+// search calls yield with the path of each matched string value, and stops when yield returns true.
+func (bj ByteJson) search(pathStr string, match func([]byte) bool, yield func(string) bool) bool {
+	if bj.Type == TpCodeString {
+		return match(bj.GetString()) && yield(pathStr)
+	}
+	return bj.walkChildren(pathStr, func(childPath string, child ByteJson) bool {
+		return child.search(childPath, match, yield)
+	})
+}
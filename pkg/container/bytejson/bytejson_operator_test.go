@@ -0,0 +1,118 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func mustParse(t *testing.T, s string) ByteJson {
+	bj, err := ParseFromString(s)
+	require.NoError(t, err)
+	return bj
+}
+
+func mustParsePaths(t *testing.T, ss ...string) []*Path {
+	paths := make([]*Path, len(ss))
+	for i, s := range ss {
+		p, err := ParseJsonPath(s)
+		require.NoError(t, err)
+		paths[i] = &p
+	}
+	return paths
+}
+
+func TestNewArrayAndObject(t *testing.T) {
+	arr := NewArray([]ByteJson{mustParse(t, `1`), Null, mustParse(t, `{"a": [true]}`)})
+	require.Equal(t, `[1, null, {"a": [true]}]`, arr.String())
+	require.Equal(t, `[]`, NewArray(nil).String())
+
+	obj, err := NewObject(
+		[][]byte{[]byte("b"), []byte("a"), []byte("b")},
+		[]ByteJson{mustParse(t, `1`), mustParse(t, `"x"`), mustParse(t, `2`)})
+	require.NoError(t, err)
+	require.Equal(t, `{"a": "x", "b": 2}`, obj.String())
+	v, ok := obj.QueryFirst(mustParsePaths(t, `$.b`)[0])
+	require.True(t, ok)
+	require.Equal(t, `2`, v.String())
+}
+
+func TestInspection(t *testing.T) {
+	cases := []struct {
+		doc    string
+		typ    string
+		length int
+	}{
+		{`{"a": 1, "b": 2}`, "OBJECT", 2},
+		{`[1, 2, 3]`, "ARRAY", 3},
+		{`1`, "INTEGER", 1},
+		{`1.5`, "DOUBLE", 1},
+		{`"s"`, "STRING", 1},
+		{`false`, "BOOLEAN", 1},
+		{`null`, "NULL", 1},
+	}
+	for _, c := range cases {
+		bj := mustParse(t, c.doc)
+		require.Equal(t, c.typ, bj.TypeName(), c.doc)
+		require.Equal(t, c.length, bj.Length(), c.doc)
+	}
+
+	keys, ok, err := mustParse(t, `{"b": 1, "a": 2}`).Keys()
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, `["a", "b"]`, keys.String())
+	_, ok, err = mustParse(t, `[1]`).Keys()
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestRemove(t *testing.T) {
+	doc := mustParse(t, `{"a": [1, 2, 3], "b": {"c": 1, "d": 2}}`)
+	out, err := doc.Remove(mustParsePaths(t, `$.a[0]`, `$.b.c`, `$.x`))
+	require.NoError(t, err)
+	require.Equal(t, `{"a": [2, 3], "b": {"d": 2}}`, out.String())
+
+	_, err = doc.Remove(mustParsePaths(t, `$`))
+	require.Error(t, err)
+	_, err = doc.Remove(mustParsePaths(t, `$**.c`))
+	require.Error(t, err)
+}
+
+func TestMerge(t *testing.T) {
+	out, err := MergePreserve([]ByteJson{mustParse(t, `{"a": 1}`), mustParse(t, `{"a": {"b": 2}}`), mustParse(t, `3`)})
+	require.NoError(t, err)
+	require.Equal(t, `[{"a": [1, {"b": 2}]}, 3]`, out.String())
+
+	out, err = MergePatch([]ByteJson{mustParse(t, `{"a": 1, "b": {"c": 1}}`), mustParse(t, `{"a": null, "b": {"d": 2}}`)})
+	require.NoError(t, err)
+	require.Equal(t, `{"b": {"c": 1, "d": 2}}`, out.String())
+
+	out, err = MergePatch([]ByteJson{mustParse(t, `{"a": 1}`), mustParse(t, `[1]`), mustParse(t, `{"b": null}`)})
+	require.NoError(t, err)
+	require.Equal(t, `{}`, out.String())
+}
+
+func TestSearch(t *testing.T) {
+	doc := mustParse(t, `{"a": ["abc", "xyz"], "b c": {"d": "abd"}}`)
+	prefix := func(b []byte) bool { return strings.HasPrefix(string(b), "ab") }
+
+	require.Equal(t, []string{`$.a[0]`, `$."b c".d`}, doc.Search(mustParsePaths(t, `$`), prefix, false))
+	require.Len(t, doc.Search(mustParsePaths(t, `$`), prefix, true), 1)
+	require.Equal(t, []string{`$.a[0]`}, doc.Search(mustParsePaths(t, `$.a`, `$.a[*]`), prefix, false))
+	require.Empty(t, doc.Search(mustParsePaths(t, `$.x`), prefix, false))
+}
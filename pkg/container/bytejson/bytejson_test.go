@@ -217,6 +217,116 @@ func TestQuery(t *testing.T) {
 	}
 }
 
+func TestQueryValues(t *testing.T) {
+	kases := []struct {
+		jsonStr string
+		pathStr string
+		outStrs []string
+	}{
+		{
+			jsonStr: `{"a": "1", "b": null}`,
+			pathStr: "$.a",
+			outStrs: []string{`"1"`},
+		},
+		{
+			jsonStr: `{"a": "1", "b": null}`,
+			pathStr: "$.b",
+			outStrs: []string{`null`},
+		},
+		{
+			jsonStr: `{"a": "1", "b": null}`,
+			pathStr: "$.c",
+			outStrs: nil,
+		},
+		{
+			jsonStr: `[1,2,{"b":3}]`,
+			pathStr: "$[*]",
+			outStrs: []string{`1`, `2`, `{"b":3}`},
+		},
+		{
+			jsonStr: `[1,2,{"b":3}]`,
+			pathStr: "$[5]",
+			outStrs: nil,
+		},
+		{
+			jsonStr: `[1,2,{"b":3}]`,
+			pathStr: "$[*].b",
+			outStrs: []string{`3`},
+		},
+		{
+			jsonStr: `[1,2,3,4]`,
+			pathStr: "$[1 to 2]",
+			outStrs: []string{`2`, `3`},
+		},
+		{
+			jsonStr: `{"a":[1,{"a":4}]}`,
+			pathStr: "$**.a",
+			outStrs: []string{`[1,{"a":4}]`, `4`},
+		},
+		{
+			jsonStr: `{"a":1}`,
+			pathStr: "$[0].a",
+			outStrs: []string{`1`},
+		},
+		{
+			jsonStr: `3`,
+			pathStr: "$[0 to last]",
+			outStrs: []string{`3`},
+		},
+		{
+			jsonStr: `"x"`,
+			pathStr: "$[1]",
+			outStrs: nil,
+		},
+	}
+	for _, kase := range kases {
+		bj, err := ParseFromString(kase.jsonStr)
+		require.Nil(t, err)
+		path, err := ParseJsonPath(kase.pathStr)
+		require.Nil(t, err)
+		out := bj.QueryValues(&path)
+		require.Equal(t, len(kase.outStrs), len(out), kase.pathStr)
+		for i := range out {
+			require.JSONEq(t, kase.outStrs[i], out[i].String())
+		}
+	}
+}
+
+func TestContainsAndOverlaps(t *testing.T) {
+	kases := []struct {
+		target    string
+		candidate string
+		contains  bool
+		overlaps  bool
+		memberOf  bool
+	}{
+		{`[1, 2, 3]`, `2`, true, true, true},
+		{`[1, 2, 3]`, `2.0`, true, true, true},
+		{`[1, 2, 3]`, `[3, 1]`, true, true, false},
+		{`[1, 2, 3]`, `[3, 4]`, false, true, false},
+		{`[1, 2, 3]`, `[]`, true, false, false},
+		{`[[1, 2], 3]`, `[1, 2]`, true, false, true},
+		{`[1, 2, 3]`, `"2"`, false, false, false},
+		{`{"a": 1, "b": [1, 2]}`, `{"b": 2}`, true, false, false},
+		{`{"a": 1, "b": [1, 2]}`, `{"a": 1, "c": 1}`, false, true, false},
+		{`{"a": 1}`, `1`, false, false, false},
+		{`[{"a": 1}, 2]`, `{"a": 1}`, true, true, true},
+		{`"abc"`, `"abc"`, true, true, true},
+		{`null`, `null`, true, true, true},
+		{`true`, `1`, false, false, false},
+	}
+	for _, kase := range kases {
+		target, err := ParseFromString(kase.target)
+		require.NoError(t, err)
+		candidate, err := ParseFromString(kase.candidate)
+		require.NoError(t, err)
+		require.Equal(t, kase.contains, target.Contains(candidate), "contains %s %s", kase.target, kase.candidate)
+		require.Equal(t, kase.overlaps, target.Overlaps(candidate), "overlaps %s %s", kase.target, kase.candidate)
+		require.Equal(t, kase.overlaps, candidate.Overlaps(target), "overlaps %s %s", kase.candidate, kase.target)
+		require.Equal(t, kase.memberOf, target.MemberOf(candidate), "member of %s %s", kase.candidate, kase.target)
+	}
+}
+
 func TestUnnest(t *testing.T) {
 	kases := []struct {
 		jsonStr   string
@@ -340,3 +340,128 @@ func compareInt64Uint64(x int64, y uint64) int {
 func compareFloat64Int64(x float64, y int64) int {
 	return compareFloat64PrecisionLoss(x, float64(y))
 }
+
+// equalByteJson reports whether two json values are equal.  Numbers of different types are compared by
+// value, arrays and objects are compared element by element.
+func equalByteJson(left, right ByteJson) bool {
+	switch {
+	case left.Type == TpCodeArray && right.Type == TpCodeArray:
+		cnt := left.GetElemCnt()
+		if cnt != right.GetElemCnt() {
+			return false
+		}
+		for i := 0; i < cnt; i++ {
+			if !equalByteJson(left.getArrayElem(i), right.getArrayElem(i)) {
+				return false
+			}
+		}
+		return true
+	case left.Type == TpCodeObject && right.Type == TpCodeObject:
+		cnt := left.GetElemCnt()
+		if cnt != right.GetElemCnt() {
+			return false
+		}
+		for i := 0; i < cnt; i++ {
+			if !bytes.Equal(left.getObjectKey(i), right.getObjectKey(i)) ||
+				!equalByteJson(left.getObjectVal(i), right.getObjectVal(i)) {
+				return false
+			}
+		}
+		return true
+	case left.Type == TpCodeArray || right.Type == TpCodeArray ||
+		left.Type == TpCodeObject || right.Type == TpCodeObject:
+		return false
+	}
+	return CompareByteJson(left, right) == 0
+}
+
+// Contains reports whether candidate is contained in bj, with the semantics of MySQL JSON_CONTAINS:
+// a scalar is contained in a scalar if they are equal, a candidate array is contained in a target array if
+// each of its elements is contained in some element of the target, a non-array candidate is contained in a
+// target array if it is contained in some element of the target, and a candidate object is contained in a
+// target object if each of its keys exists in the target with a contained value.
+func (bj ByteJson) Contains(candidate ByteJson) bool {
+	switch bj.Type {
+	case TpCodeObject:
+		if candidate.Type != TpCodeObject {
+			return false
+		}
+		cnt := candidate.GetElemCnt()
+		for i := 0; i < cnt; i++ {
+			val, ok := bj.lookupKey(candidate.getObjectKey(i))
+			if !ok || !val.Contains(candidate.getObjectVal(i)) {
+				return false
+			}
+		}
+		return true
+	case TpCodeArray:
+		cnt := bj.GetElemCnt()
+		if candidate.Type == TpCodeArray {
+			candCnt := candidate.GetElemCnt()
+			for i := 0; i < candCnt; i++ {
+				if !bj.Contains(candidate.getArrayElem(i)) {
+					return false
+				}
+			}
+			return true
+		}
+		for i := 0; i < cnt; i++ {
+			if bj.getArrayElem(i).Contains(candidate) {
+				return true
+			}
+		}
+		return false
+	}
+	return equalByteJson(bj, candidate)
+}
+
+// Overlaps reports whether bj and other have anything in common, with the semantics of MySQL JSON_OVERLAPS:
+// two arrays overlap if they share an element, two objects overlap if they share a key-value pair, and a
+// non-array value is compared as a single element array against an array.
+func (bj ByteJson) Overlaps(other ByteJson) bool {
+	if bj.Type != TpCodeArray && other.Type == TpCodeArray {
+		bj, other = other, bj
+	}
+	switch bj.Type {
+	case TpCodeArray:
+		cnt := bj.GetElemCnt()
+		for i := 0; i < cnt; i++ {
+			if other.Type == TpCodeArray {
+				if other.MemberOf(bj.getArrayElem(i)) {
+					return true
+				}
+			} else if equalByteJson(bj.getArrayElem(i), other) {
+				return true
+			}
+		}
+		return false
+	case TpCodeObject:
+		if other.Type != TpCodeObject {
+			return false
+		}
+		cnt := other.GetElemCnt()
+		for i := 0; i < cnt; i++ {
+			val, ok := bj.lookupKey(other.getObjectKey(i))
+			if ok && equalByteJson(val, other.getObjectVal(i)) {
+				return true
+			}
+		}
+		return false
+	}
+	return equalByteJson(bj, other)
+}
+
+// MemberOf reports whether val is an element of the array bj, with the semantics of MySQL MEMBER OF.
+// If bj is not an array, it is compared with val as a single element array.
+func (bj ByteJson) MemberOf(val ByteJson) bool {
+	if bj.Type != TpCodeArray {
+		return equalByteJson(bj, val)
+	}
+	cnt := bj.GetElemCnt()
+	for i := 0; i < cnt; i++ {
+		if equalByteJson(bj.getArrayElem(i), val) {
+			return true
+		}
+	}
+	return false
+}
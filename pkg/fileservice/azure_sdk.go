@@ -0,0 +1,615 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"os"
+	gotrace "runtime/trace"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/streaming"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blockblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/perfcounter"
+	"github.com/matrixorigin/matrixone/pkg/util/trace"
+	"go.uber.org/zap"
+)
+
+// AzureSDK is an ObjectStorage backed by Azure Blob Storage
+//
+// arguments mapping:
+// Bucket: container name
+// KeyID: storage account name
+// KeySecret: storage account key
+// SessionToken: SAS token
+// Endpoint: blob service endpoint, defaults to https://<account>.blob.core.windows.net
+type AzureSDK struct {
+	name            string
+	client          *container.Client
+	perfCounterSets []*perfcounter.CounterSet
+	listMaxKeys     int32
+}
+
+func NewAzureSDK(
+	ctx context.Context,
+	args ObjectStorageArguments,
+	perfCounterSets []*perfcounter.CounterSet,
+) (_ *AzureSDK, err error) {
+	defer catch(&err)
+
+	// args
+	if err := args.validate(); err != nil {
+		return nil, err
+	}
+
+	// credential arguments
+	accountName := args.KeyID
+	accountKey := args.KeySecret
+	sasToken := args.SessionToken
+	if args.shouldLoadDefaultCredentials() {
+		accountName = firstNonZero(
+			args.KeyID,
+			os.Getenv("AZURE_STORAGE_ACCOUNT"),
+		)
+		accountKey = firstNonZero(
+			args.KeySecret,
+			os.Getenv("AZURE_STORAGE_KEY"),
+		)
+		sasToken = firstNonZero(
+			args.SessionToken,
+			os.Getenv("AZURE_STORAGE_SAS_TOKEN"),
+		)
+	}
+
+	// container url
+	endpoint := strings.TrimSuffix(args.Endpoint, "/")
+	if endpoint == "" {
+		if accountName == "" {
+			return nil, moerr.NewBadConfigNoCtx("azure storage account not specified")
+		}
+		endpoint = fmt.Sprintf("https://%s.blob.core.windows.net", accountName)
+	}
+	if args.Bucket == "" {
+		return nil, moerr.NewBadConfigNoCtx("azure container not specified")
+	}
+	containerURL := endpoint + "/" + args.Bucket
+
+	// options
+	options := &container.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: newHTTPClient(args),
+			Retry: policy.RetryOptions{
+				// retry by DoWithRetry
+				MaxRetries: -1,
+			},
+		},
+	}
+
+	// client
+	var client *container.Client
+	switch {
+
+	case accountName != "" && accountKey != "":
+		// shared key
+		cred, err := container.NewSharedKeyCredential(accountName, accountKey)
+		if err != nil {
+			return nil, err
+		}
+		client, err = container.NewClientWithSharedKeyCredential(containerURL, cred, options)
+		if err != nil {
+			return nil, err
+		}
+
+	case sasToken != "":
+		// SAS token
+		client, err = container.NewClientWithNoCredential(
+			containerURL+"?"+strings.TrimPrefix(sasToken, "?"),
+			options,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		// anonymous
+		client, err = container.NewClientWithNoCredential(containerURL, options)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	logutil.Info("new object storage",
+		zap.Any("sdk", "azure"),
+		zap.Any("arguments", args),
+	)
+
+	if !args.NoBucketValidation {
+		// validate container
+		_, err := client.GetProperties(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &AzureSDK{
+		name:            args.Name,
+		client:          client,
+		perfCounterSets: perfCounterSets,
+	}, nil
+}
+
+var _ ObjectStorage = new(AzureSDK)
+
+func (a *AzureSDK) List(
+	ctx context.Context,
+	prefix string,
+) iter.Seq2[*DirEntry, error] {
+	return func(yield func(*DirEntry, error) bool) {
+		if err := ctx.Err(); err != nil {
+			yield(nil, err)
+			return
+		}
+
+		var marker *string
+
+	loop1:
+		for {
+			resp, err := a.listBlobs(ctx, prefix, marker)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			if resp.Segment != nil {
+				for _, item := range resp.Segment.BlobItems {
+					var size int64
+					if item.Properties != nil && item.Properties.ContentLength != nil {
+						size = *item.Properties.ContentLength
+					}
+					if !yield(&DirEntry{
+						Name: *item.Name,
+						Size: size,
+					}, nil) {
+						break loop1
+					}
+				}
+
+				for _, prefix := range resp.Segment.BlobPrefixes {
+					if !yield(&DirEntry{
+						IsDir: true,
+						Name:  *prefix.Name,
+					}, nil) {
+						break loop1
+					}
+				}
+			}
+
+			if resp.NextMarker == nil || *resp.NextMarker == "" {
+				break
+			}
+			marker = resp.NextMarker
+		}
+
+	}
+}
+
+func (a *AzureSDK) Stat(
+	ctx context.Context,
+	key string,
+) (
+	size int64,
+	err error,
+) {
+
+	defer func() {
+		if a.is404(err) {
+			err = moerr.NewFileNotFoundNoCtx(key)
+		}
+	}()
+
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	resp, err := a.statBlob(
+		ctx,
+		key,
+	)
+	if err != nil {
+		return
+	}
+
+	if resp.ContentLength != nil {
+		size = *resp.ContentLength
+	}
+
+	return
+}
+
+func (a *AzureSDK) Exists(
+	ctx context.Context,
+	key string,
+) (
+	bool,
+	error,
+) {
+
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+
+	_, err := a.statBlob(
+		ctx,
+		key,
+	)
+	if err != nil {
+		if a.is404(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (a *AzureSDK) Write(
+	ctx context.Context,
+	key string,
+	r io.Reader,
+	sizeHint *int64,
+	expire *time.Time,
+) (
+	err error,
+) {
+	defer wrapSizeMismatchErr(&err)
+
+	// expire is not supported, use lifecycle management policies of the storage account instead
+	_ = expire
+
+	if sizeHint != nil && *sizeHint < smallObjectThreshold {
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		if int64(len(data)) != *sizeHint {
+			return moerr.NewSizeNotMatchNoCtx(key)
+		}
+		_, err = DoWithRetry("write", func() (int, error) {
+			return 0, a.putBlob(
+				ctx,
+				key,
+				data,
+			)
+		}, maxRetryAttemps, a.isRetryable)
+		if err != nil {
+			return err
+		}
+
+	} else {
+		var n atomic.Int64
+		if sizeHint != nil {
+			r = &countingReader{
+				R: r,
+				C: &n,
+			}
+		}
+		err = a.uploadStream(
+			ctx,
+			key,
+			r,
+		)
+		if err != nil {
+			return err
+		}
+		if sizeHint != nil && n.Load() != *sizeHint {
+			return moerr.NewSizeNotMatchNoCtx(key)
+		}
+	}
+
+	return
+}
+
+func (a *AzureSDK) Read(
+	ctx context.Context,
+	key string,
+	min *int64,
+	max *int64,
+) (
+	r io.ReadCloser,
+	err error,
+) {
+
+	defer func() {
+		if a.is404(err) {
+			err = moerr.NewFileNotFoundNoCtx(key)
+		}
+	}()
+
+	if max == nil {
+		// read to end
+		r, err := a.getBlob(
+			ctx,
+			key,
+			min,
+			nil,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return r, nil
+	}
+
+	r, err = a.getBlob(
+		ctx,
+		key,
+		min,
+		max,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &readCloser{
+		r:         io.LimitReader(r, int64(*max-*min)),
+		closeFunc: r.Close,
+	}, nil
+}
+
+func (a *AzureSDK) Delete(
+	ctx context.Context,
+	keys ...string,
+) (
+	err error,
+) {
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := a.deleteSingle(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (a *AzureSDK) deleteSingle(ctx context.Context, key string) error {
+	ctx, span := trace.Start(ctx, "AzureSDK.deleteSingle")
+	defer span.End()
+
+	_, err := a.deleteBlob(
+		ctx,
+		key,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (a *AzureSDK) listBlobs(ctx context.Context, prefix string, marker *string) (container.ListBlobsHierarchyResponse, error) {
+	ctx, task := gotrace.NewTask(ctx, "AzureSDK.listBlobs")
+	defer task.End()
+
+	opts := &container.ListBlobsHierarchyOptions{
+		Marker: marker,
+	}
+	if prefix != "" {
+		opts.Prefix = &prefix
+	}
+	if a.listMaxKeys > 0 {
+		opts.MaxResults = &a.listMaxKeys
+	}
+
+	return DoWithRetry(
+		"azure list blobs",
+		func() (container.ListBlobsHierarchyResponse, error) {
+			perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+				counter.FileService.S3.List.Add(1)
+			}, a.perfCounterSets...)
+			pager := a.client.NewListBlobsHierarchyPager("/", opts)
+			return pager.NextPage(ctx)
+		},
+		maxRetryAttemps,
+		a.isRetryable,
+	)
+}
+
+func (a *AzureSDK) statBlob(ctx context.Context, key string) (blob.GetPropertiesResponse, error) {
+	ctx, task := gotrace.NewTask(ctx, "AzureSDK.statBlob")
+	defer task.End()
+
+	return DoWithRetry(
+		"azure get blob properties",
+		func() (blob.GetPropertiesResponse, error) {
+			perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+				counter.FileService.S3.Head.Add(1)
+			}, a.perfCounterSets...)
+			return a.client.NewBlobClient(key).GetProperties(ctx, nil)
+		},
+		maxRetryAttemps,
+		a.isRetryable,
+	)
+}
+
+func (a *AzureSDK) putBlob(
+	ctx context.Context,
+	key string,
+	data []byte,
+) (err error) {
+	ctx, task := gotrace.NewTask(ctx, "AzureSDK.putBlob")
+	defer task.End()
+
+	perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+		counter.FileService.S3.Put.Add(1)
+	}, a.perfCounterSets...)
+
+	_, err = a.client.NewBlockBlobClient(key).Upload(
+		ctx,
+		streaming.NopCloser(bytes.NewReader(data)),
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (a *AzureSDK) uploadStream(
+	ctx context.Context,
+	key string,
+	r io.Reader,
+) (err error) {
+	ctx, task := gotrace.NewTask(ctx, "AzureSDK.uploadStream")
+	defer task.End()
+
+	perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+		counter.FileService.S3.Put.Add(1)
+	}, a.perfCounterSets...)
+
+	// not retryable because Reader may be half consumed
+	_, err = a.client.NewBlockBlobClient(key).UploadStream(
+		ctx,
+		r,
+		&blockblob.UploadStreamOptions{
+			BlockSize: 8 * (1 << 20),
+		},
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (a *AzureSDK) getBlob(ctx context.Context, key string, min *int64, max *int64) (io.ReadCloser, error) {
+	ctx, task := gotrace.NewTask(ctx, "AzureSDK.getBlob")
+	defer task.End()
+
+	if min == nil {
+		min = ptrTo[int64](0)
+	}
+
+	return newRetryableReader(
+		func(offset int64) (io.ReadCloser, error) {
+			opts := &blob.DownloadStreamOptions{
+				Range: blob.HTTPRange{
+					Offset: offset,
+				},
+			}
+			if max != nil {
+				// zero count means to the end
+				count := *max - offset
+				if count <= 0 {
+					count = 1
+				}
+				opts.Range.Count = count
+			}
+
+			return DoWithRetry(
+				"azure download blob",
+				func() (io.ReadCloser, error) {
+					perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+						counter.FileService.S3.Get.Add(1)
+					}, a.perfCounterSets...)
+					resp, err := a.client.NewBlobClient(key).DownloadStream(ctx, opts)
+					if err != nil {
+						return nil, err
+					}
+					return &readCloser{
+						r: resp.Body,
+						closeFunc: func() error {
+							// drain
+							io.Copy(io.Discard, resp.Body)
+							return resp.Body.Close()
+						},
+					}, nil
+				},
+				maxRetryAttemps,
+				a.isRetryable,
+			)
+
+		},
+		*min,
+		a.isRetryable,
+	)
+}
+
+func (a *AzureSDK) deleteBlob(ctx context.Context, key string) (bool, error) {
+	ctx, task := gotrace.NewTask(ctx, "AzureSDK.deleteBlob")
+	defer task.End()
+	return DoWithRetry(
+		"azure delete blob",
+		func() (bool, error) {
+			perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+				counter.FileService.S3.Delete.Add(1)
+			}, a.perfCounterSets...)
+			if _, err := a.client.NewBlobClient(key).Delete(ctx, nil); err != nil {
+				if a.is404(err) {
+					return false, nil
+				}
+				return false, err
+			}
+			return true, nil
+		},
+		maxRetryAttemps,
+		a.isRetryable,
+	)
+}
+
+func (a *AzureSDK) is404(err error) bool {
+	if err == nil {
+		return false
+	}
+	var resp *azcore.ResponseError
+	if errors.As(err, &resp) {
+		return resp.StatusCode == http.StatusNotFound
+	}
+	return false
+}
+
+func (a *AzureSDK) isRetryable(err error) bool {
+	if IsRetryableError(err) {
+		return true
+	}
+	var resp *azcore.ResponseError
+	if errors.As(err, &resp) {
+		switch resp.StatusCode {
+		case http.StatusRequestTimeout,
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout:
+			return true
+		}
+	}
+	return false
+}
@@ -29,15 +29,17 @@ const (
 	diskETLFileServiceBackend = "DISK-ETL"
 	s3FileServiceBackend      = "S3"
 	minioFileServiceBackend   = "MINIO"
+	azureFileServiceBackend   = "AZURE"
+	gcsFileServiceBackend     = "GCS"
 )
 
 // Config fileService config
 type Config struct {
 	// Name name of fileservice, describe what an instance of fileservice is used for
 	Name string `toml:"name"`
-	// Backend fileservice backend. [MEM|DISK|DISK-ETL|S3|MINIO]
+	// Backend fileservice backend. [MEM|DISK|DISK-ETL|S3|MINIO|AZURE|GCS]
 	Backend string `toml:"backend"`
-	// S3 used to create fileservice using s3 as the backend
+	// S3 used to create fileservice using s3, azure blob or gcs as the backend
 	S3 ObjectStorageArguments `toml:"s3"`
 	// Cache specifies configs for cache
 	Cache CacheConfig `toml:"cache"`
@@ -45,6 +47,8 @@ type Config struct {
 	DataDir string `toml:"data-dir"`
 	// FixMissing inidicates the file service to try its best to fix missing files
 	FixMissing bool `toml:"fix-missing"`
+	// Encryption specifies configs for encryption at rest, only DISK, S3, MINIO, AZURE and GCS backends are supported
+	Encryption EncryptionConfig `toml:"encryption"`
 }
 
 // NewFileServicesFunc creates a new *FileServices
@@ -57,6 +61,9 @@ func NewFileService(
 	if cfg.Name == "" {
 		panic("empty name")
 	}
+	if cfg.Encryption.Enable {
+		return newEncryptedFileService(ctx, cfg, perfCounterSets)
+	}
 	switch strings.ToUpper(cfg.Backend) {
 	case memFileServiceBackend:
 		return newMemFileService(cfg, perfCounterSets)
@@ -68,11 +75,46 @@ func NewFileService(
 		return newMinioFileService(ctx, cfg, perfCounterSets)
 	case s3FileServiceBackend:
 		return newS3FileService(ctx, cfg, perfCounterSets)
+	case azureFileServiceBackend:
+		return newAzureFileService(ctx, cfg, perfCounterSets)
+	case gcsFileServiceBackend:
+		return newGCSFileService(ctx, cfg, perfCounterSets)
 	default:
 		return nil, moerr.NewInternalErrorNoCtxf("file service backend %s not implemented", cfg.Backend)
 	}
 }
 
+func newEncryptedFileService(
+	ctx context.Context, cfg Config, perfCounters []*perfcounter.CounterSet,
+) (FileService, error) {
+	switch strings.ToUpper(cfg.Backend) {
+	case diskFileServiceBackend, s3FileServiceBackend, minioFileServiceBackend,
+		azureFileServiceBackend, gcsFileServiceBackend:
+	default:
+		return nil, moerr.NewBadConfigf(ctx, "encryption is not supported by file service backend %s", cfg.Backend)
+	}
+	keyProvider, err := NewKeyProvider(ctx, cfg.Encryption)
+	if err != nil {
+		return nil, err
+	}
+
+	// memory cache and remote cache are maintained by the encrypted fs
+	upstreamConfig := cfg
+	upstreamConfig.Encryption.Enable = false
+	upstreamConfig.Cache = upstreamCacheConfig(cfg.Cache)
+	upstream, err := NewFileService(ctx, upstreamConfig, perfCounters)
+	if err != nil {
+		return nil, err
+	}
+
+	fs, err := NewEncryptedFS(ctx, upstream, keyProvider, cfg.Cache, perfCounters)
+	if err != nil {
+		upstream.Close(ctx)
+		return nil, err
+	}
+	return fs, nil
+}
+
 func newMemFileService(cfg Config, perfCounters []*perfcounter.CounterSet) (FileService, error) {
 	fs, err := NewMemoryFS(
 		cfg.Name,
@@ -132,6 +174,44 @@ func newMinioFileService(
 	return fs, nil
 }
 
+func newAzureFileService(
+	ctx context.Context, cfg Config, perfCounters []*perfcounter.CounterSet,
+) (FileService, error) {
+	cfg.S3.Name = cfg.Name
+	cfg.S3.IsAzure = true
+	fs, err := NewS3FS(
+		ctx,
+		cfg.S3,
+		cfg.Cache,
+		perfCounters,
+		false,
+		false,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return fs, nil
+}
+
+func newGCSFileService(
+	ctx context.Context, cfg Config, perfCounters []*perfcounter.CounterSet,
+) (FileService, error) {
+	cfg.S3.Name = cfg.Name
+	cfg.S3.IsGCS = true
+	fs, err := NewS3FS(
+		ctx,
+		cfg.S3,
+		cfg.Cache,
+		perfCounters,
+		false,
+		false,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return fs, nil
+}
+
 func newS3FileService(
 	ctx context.Context, cfg Config, perfCounters []*perfcounter.CounterSet,
 ) (FileService, error) {
@@ -0,0 +1,587 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"hash/maphash"
+	"io"
+	"iter"
+	"sort"
+
+	"go.uber.org/zap"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/fileservice/fifocache"
+	"github.com/matrixorigin/matrixone/pkg/fileservice/fscache"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/perfcounter"
+	"github.com/matrixorigin/matrixone/pkg/util/toml"
+)
+
+// EncryptedFS encrypts objects written to the upstream file service, and decrypts objects read from it.
+// objects and the disk cache of upstream contain only ciphertext.
+// memory cache and remote cache contain plaintext and are maintained by EncryptedFS,
+// so the upstream should be created without memory cache and remote cache.
+type EncryptedFS struct {
+	name            string
+	upstream        FileService
+	keyProvider     KeyProvider
+	perfCounterSets []*perfcounter.CounterSet
+	asyncUpdate     bool
+
+	memCache    *MemCache
+	remoteCache *RemoteCache
+
+	// path -> *encryptedObject
+	objects *fifocache.Cache[string, *encryptedObject]
+}
+
+const encryptedObjectCacheCapacity = 65536
+
+var encryptedObjectCacheSeed = maphash.MakeSeed()
+
+func NewEncryptedFS(
+	ctx context.Context,
+	upstream FileService,
+	keyProvider KeyProvider,
+	cacheConfig CacheConfig,
+	perfCounterSets []*perfcounter.CounterSet,
+) (*EncryptedFS, error) {
+	fs := &EncryptedFS{
+		name:            upstream.Name(),
+		upstream:        upstream,
+		keyProvider:     keyProvider,
+		perfCounterSets: perfCounterSets,
+		objects: fifocache.New[string, *encryptedObject](
+			fscache.ConstCapacity(encryptedObjectCacheCapacity),
+			func(path string) uint64 {
+				return maphash.String(encryptedObjectCacheSeed, path)
+			},
+			nil, nil, nil,
+		),
+	}
+	if err := fs.initCaches(ctx, cacheConfig); err != nil {
+		return nil, err
+	}
+	return fs, nil
+}
+
+func (e *EncryptedFS) initCaches(ctx context.Context, config CacheConfig) error {
+	config.setDefaults()
+
+	if config.RemoteCacheEnabled {
+		if config.QueryClient == nil {
+			return moerr.NewInternalError(ctx, "query client is nil")
+		}
+		e.remoteCache = NewRemoteCache(config.QueryClient, config.KeyRouterFactory)
+		logutil.Info("fileservice: remote cache initialized",
+			zap.Any("fs-name", e.name),
+		)
+	}
+
+	if config.MemoryCapacity != nil &&
+		*config.MemoryCapacity > DisableCacheCapacity {
+		e.memCache = NewMemCache(
+			fscache.ConstCapacity(int64(*config.MemoryCapacity)),
+			&config.CacheCallbacks,
+			e.perfCounterSets,
+			e.name,
+		)
+		logutil.Info("fileservice: memory cache initialized",
+			zap.Any("fs-name", e.name),
+			zap.Any("capacity", config.MemoryCapacity),
+		)
+	}
+
+	return nil
+}
+
+// upstreamCacheConfig returns the cache config for the upstream file service of an EncryptedFS
+func upstreamCacheConfig(config CacheConfig) CacheConfig {
+	config.MemoryCapacity = ptrTo[toml.ByteSize](DisableCacheCapacity)
+	config.RemoteCacheEnabled = false
+	config.CacheCallbacks = CacheCallbacks{}
+	return config
+}
+
+var _ FileService = new(EncryptedFS)
+
+var _ CachingFileService = new(EncryptedFS)
+
+var _ CacheDataAllocator = new(EncryptedFS)
+
+func (e *EncryptedFS) Name() string {
+	return e.name
+}
+
+func (e *EncryptedFS) AllocateCacheData(ctx context.Context, size int) fscache.Data {
+	if e.memCache != nil {
+		e.memCache.cache.EnsureNBytes(ctx, size)
+	}
+	return DefaultCacheDataAllocator().AllocateCacheData(ctx, size)
+}
+
+func (e *EncryptedFS) CopyToCacheData(ctx context.Context, data []byte) fscache.Data {
+	if e.memCache != nil {
+		e.memCache.cache.EnsureNBytes(ctx, len(data))
+	}
+	return DefaultCacheDataAllocator().CopyToCacheData(ctx, data)
+}
+
+func (e *EncryptedFS) Write(ctx context.Context, vector IOVector) error {
+	return e.write(ctx, vector, e.upstream.Write)
+}
+
+var _ ReplaceableFileService = new(EncryptedFS)
+
+// Replace replaces a whole file, the upstream must be a ReplaceableFileService
+func (e *EncryptedFS) Replace(ctx context.Context, vector IOVector) error {
+	upstream, ok := e.upstream.(ReplaceableFileService)
+	if !ok {
+		return moerr.NewNotSupportedf(ctx, "replace file in %s", e.name)
+	}
+	path, err := ParsePathAtService(vector.FilePath, e.name)
+	if err != nil {
+		return err
+	}
+	e.objects.Delete(ctx, path.File)
+	if e.memCache != nil {
+		if err := e.memCache.DeletePaths(ctx, []string{vector.FilePath}); err != nil {
+			return err
+		}
+	}
+	return e.write(ctx, vector, upstream.Replace)
+}
+
+func (e *EncryptedFS) write(
+	ctx context.Context,
+	vector IOVector,
+	upstreamWrite func(context.Context, IOVector) error,
+) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	path, err := ParsePathAtService(vector.FilePath, e.name)
+	if err != nil {
+		return err
+	}
+
+	keyID, masterKey, err := e.keyProvider.CurrentKey(ctx)
+	if err != nil {
+		return err
+	}
+	header, dataKey, err := newEncryptionHeader(keyID, masterKey)
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(vector.Entries, func(i, j int) bool {
+		return vector.Entries[i].Offset < vector.Entries[j].Offset
+	})
+	size := int64(-1)
+	if s := vector.size(); s != nil {
+		size = *s
+	}
+	reader := newEncryptReader(
+		newIOEntriesReader(ctx, vector.Entries),
+		header,
+		dataKey,
+		size,
+		path.File,
+	)
+	upstreamSize := int64(-1)
+	if size >= 0 {
+		upstreamSize = encryptedSize(size)
+	}
+
+	if err := upstreamWrite(ctx, IOVector{
+		FilePath: vector.FilePath,
+		Entries: []IOEntry{
+			{
+				Size:           upstreamSize,
+				ReaderForWrite: reader,
+			},
+		},
+		ExpireAt: vector.ExpireAt,
+		Policy:   vector.Policy,
+	}); err != nil {
+		return err
+	}
+
+	e.objects.Set(ctx, path.File, &encryptedObject{
+		dataKey:       dataKey,
+		encryptedSize: encryptedSize(reader.numRead),
+		plainSize:     reader.numRead,
+	}, 1)
+
+	return nil
+}
+
+func (e *EncryptedFS) Read(ctx context.Context, vector *IOVector) (err error) {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if len(vector.Entries) == 0 {
+		return moerr.NewEmptyVectorNoCtx()
+	}
+
+	path, err := ParsePathAtService(vector.FilePath, e.name)
+	if err != nil {
+		return err
+	}
+
+	for _, cache := range vector.Caches {
+		if err := readCache(ctx, cache, vector); err != nil {
+			return err
+		}
+		if vector.allDone() {
+			return nil
+		}
+		defer func() {
+			if err != nil {
+				return
+			}
+			err = cache.Update(ctx, vector, false)
+		}()
+	}
+
+	if e.memCache != nil {
+		if err := readCache(ctx, e.memCache, vector); err != nil {
+			return err
+		}
+		if vector.allDone() {
+			return nil
+		}
+		defer func() {
+			if err != nil {
+				return
+			}
+			err = e.memCache.Update(ctx, vector, e.asyncUpdate)
+		}()
+	}
+
+	if e.remoteCache != nil {
+		if err := readCache(ctx, e.remoteCache, vector); err != nil {
+			return err
+		}
+		if vector.allDone() {
+			return nil
+		}
+	}
+
+	return e.read(ctx, vector, path)
+}
+
+func (e *EncryptedFS) read(ctx context.Context, vector *IOVector, path Path) (err error) {
+	object, err := e.getObject(ctx, vector.FilePath, path.File, vector.Policy)
+	if err != nil {
+		return err
+	}
+
+	// sealed blocks to read from upstream
+	upstreamVector := &IOVector{
+		FilePath: vector.FilePath,
+		Policy:   vector.Policy | SkipMemoryCache,
+	}
+	indexes := make([]int, 0, len(vector.Entries))
+	firstBlocks := make([]int64, 0, len(vector.Entries))
+	readClosers := make([]io.ReadCloser, len(vector.Entries))
+	for i, entry := range vector.Entries {
+		if entry.done {
+			continue
+		}
+		if entry.Size == 0 {
+			return moerr.NewEmptyRangeNoCtx(path.File)
+		}
+		if entry.Size < 0 {
+			entry.Size = object.plainSize - entry.Offset
+			if entry.Size <= 0 {
+				return moerr.NewEmptyRangeNoCtx(path.File)
+			}
+			vector.Entries[i].Size = entry.Size
+		}
+		if entry.Offset < 0 || entry.Offset+entry.Size > object.plainSize {
+			return moerr.NewUnexpectedEOFNoCtx(path.File)
+		}
+
+		firstBlock, start, end := object.encryptedRange(entry.Offset, entry.Size)
+		upstreamEntry := IOEntry{
+			Offset: start,
+			Size:   end - start,
+		}
+		if entry.ReadCloserForRead != nil && entry.ToCacheData == nil {
+			// streaming
+			upstreamEntry.ReadCloserForRead = &readClosers[i]
+		}
+		upstreamVector.Entries = append(upstreamVector.Entries, upstreamEntry)
+		indexes = append(indexes, i)
+		firstBlocks = append(firstBlocks, firstBlock)
+	}
+	if len(indexes) == 0 {
+		return nil
+	}
+
+	if err := e.upstream.Read(ctx, upstreamVector); err != nil {
+		return err
+	}
+
+	for j, i := range indexes {
+		upstreamEntry := &upstreamVector.Entries[j]
+		entry := &vector.Entries[i]
+
+		if readClosers[i] != nil {
+			*entry.ReadCloserForRead = newDecryptReader(
+				readClosers[i],
+				object,
+				entry.Offset,
+				entry.Size,
+				firstBlocks[j],
+				upstreamEntry.releaseData,
+			)
+			entry.done = true
+			continue
+		}
+
+		if err := e.fillEntry(ctx, entry, object, firstBlocks[j], upstreamEntry.Data); err != nil {
+			// release remaining upstream data
+			for _, upstreamEntry := range upstreamVector.Entries[j:] {
+				if upstreamEntry.releaseData != nil {
+					upstreamEntry.releaseData()
+				}
+			}
+			return err
+		}
+		if upstreamEntry.releaseData != nil {
+			upstreamEntry.releaseData()
+		}
+	}
+
+	return nil
+}
+
+func (e *EncryptedFS) fillEntry(
+	ctx context.Context,
+	entry *IOEntry,
+	object *encryptedObject,
+	firstBlock int64,
+	sealed []byte,
+) (err error) {
+	finally := entry.prepareData(ctx)
+	defer finally(&err)
+
+	if err := object.decryptRange(entry.Data, entry.Offset, firstBlock, sealed); err != nil {
+		return e.decryptError(ctx, err)
+	}
+
+	if entry.WriterForRead != nil {
+		if _, err := entry.WriterForRead.Write(entry.Data); err != nil {
+			return err
+		}
+	}
+	if entry.ReadCloserForRead != nil {
+		*entry.ReadCloserForRead = io.NopCloser(bytes.NewReader(entry.Data))
+	}
+	if err := entry.setCachedData(ctx, e); err != nil {
+		return err
+	}
+
+	entry.done = true
+	return nil
+}
+
+func (e *EncryptedFS) decryptError(ctx context.Context, err error) error {
+	if errors.Is(err, io.ErrUnexpectedEOF) {
+		return err
+	}
+	return moerr.NewInternalErrorf(ctx, "decrypt %s: %v", e.name, err)
+}
+
+// getObject returns the decryption info of an object
+func (e *EncryptedFS) getObject(ctx context.Context, filePath string, file string, policy Policy) (*encryptedObject, error) {
+	if object, ok := e.objects.Get(ctx, file); ok {
+		return object, nil
+	}
+
+	stat, err := e.upstream.StatFile(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	size, ok := plainSize(stat.Size)
+	if !ok {
+		return nil, moerr.NewInternalErrorf(ctx, "%s is not an encrypted object", file)
+	}
+
+	vec := &IOVector{
+		FilePath: filePath,
+		Entries: []IOEntry{
+			{
+				Size: encryptionHeaderSize,
+			},
+		},
+		Policy: policy | SkipMemoryCache,
+	}
+	if err := e.upstream.Read(ctx, vec); err != nil {
+		return nil, err
+	}
+	defer vec.Release()
+	header := vec.Entries[0].Data
+
+	keyID, ok := parseEncryptionHeader(header)
+	if !ok {
+		return nil, moerr.NewInternalErrorf(ctx, "%s is not an encrypted object", file)
+	}
+	masterKey, err := e.keyProvider.Key(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+	dataKey, err := openEncryptionHeader(header, masterKey)
+	if err != nil {
+		return nil, moerr.NewInternalErrorf(ctx, "failed to decrypt data key of %s: %v", file, err)
+	}
+
+	object := &encryptedObject{
+		dataKey:       dataKey,
+		encryptedSize: stat.Size,
+		plainSize:     size,
+	}
+	e.objects.Set(ctx, file, object, 1)
+	return object, nil
+}
+
+func (e *EncryptedFS) ReadCache(ctx context.Context, vector *IOVector) (err error) {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if len(vector.Entries) == 0 {
+		return moerr.NewEmptyVectorNoCtx()
+	}
+
+	if _, err := ParsePathAtService(vector.FilePath, e.name); err != nil {
+		return err
+	}
+
+	for _, cache := range vector.Caches {
+		if err := readCache(ctx, cache, vector); err != nil {
+			return err
+		}
+		if vector.allDone() {
+			return nil
+		}
+		defer func() {
+			if err != nil {
+				return
+			}
+			err = cache.Update(ctx, vector, false)
+		}()
+	}
+
+	if e.memCache != nil {
+		if err := readCache(ctx, e.memCache, vector); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *EncryptedFS) List(ctx context.Context, dirPath string) iter.Seq2[*DirEntry, error] {
+	return func(yield func(*DirEntry, error) bool) {
+		for entry, err := range e.upstream.List(ctx, dirPath) {
+			if err == nil && !entry.IsDir {
+				if size, ok := plainSize(entry.Size); ok {
+					entry.Size = size
+				}
+			}
+			if !yield(entry, err) {
+				return
+			}
+		}
+	}
+}
+
+func (e *EncryptedFS) Delete(ctx context.Context, filePaths ...string) error {
+	for _, filePath := range filePaths {
+		path, err := ParsePathAtService(filePath, e.name)
+		if err != nil {
+			return err
+		}
+		e.objects.Delete(ctx, path.File)
+	}
+	return errors.Join(
+		e.upstream.Delete(ctx, filePaths...),
+		func() error {
+			if e.memCache == nil {
+				return nil
+			}
+			return e.memCache.DeletePaths(ctx, filePaths)
+		}(),
+		func() error {
+			if e.remoteCache == nil {
+				return nil
+			}
+			return e.remoteCache.DeletePaths(ctx, filePaths)
+		}(),
+	)
+}
+
+func (e *EncryptedFS) StatFile(ctx context.Context, filePath string) (*DirEntry, error) {
+	entry, err := e.upstream.StatFile(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	size, ok := plainSize(entry.Size)
+	if !ok {
+		return nil, moerr.NewInternalErrorf(ctx, "%s is not an encrypted object", filePath)
+	}
+	entry.Size = size
+	return entry, nil
+}
+
+func (e *EncryptedFS) PrefetchFile(ctx context.Context, filePath string) error {
+	return e.upstream.PrefetchFile(ctx, filePath)
+}
+
+func (e *EncryptedFS) Cost() *CostAttr {
+	return e.upstream.Cost()
+}
+
+func (e *EncryptedFS) Close(ctx context.Context) {
+	if e.memCache != nil {
+		e.memCache.Close(ctx)
+	}
+	e.upstream.Close(ctx)
+}
+
+func (e *EncryptedFS) FlushCache(ctx context.Context) {
+	if e.memCache != nil {
+		e.memCache.Flush(ctx)
+	}
+	if fs, ok := e.upstream.(CachingFileService); ok {
+		fs.FlushCache(ctx)
+	}
+}
+
+func (e *EncryptedFS) SetAsyncUpdate(b bool) {
+	e.asyncUpdate = b
+	if fs, ok := e.upstream.(CachingFileService); ok {
+		fs.SetAsyncUpdate(b)
+	}
+}
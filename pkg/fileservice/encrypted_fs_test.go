@@ -0,0 +1,435 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"io"
+	mrand "math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/util/toml"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestKeyring(t *testing.T, ids ...string) string {
+	path := filepath.Join(t.TempDir(), "keyring")
+	for _, id := range ids {
+		appendTestKey(t, path, id)
+	}
+	return path
+}
+
+func appendTestKey(t *testing.T, path string, id string) {
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	assert.Nil(t, err)
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	assert.Nil(t, err)
+	_, err = f.WriteString(id + " " + hex.EncodeToString(key) + "\n")
+	assert.Nil(t, err)
+	assert.Nil(t, f.Close())
+	// ensure modification is observed
+	modTime := time.Now().Add(time.Duration(mrand.Int63n(int64(time.Hour))))
+	assert.Nil(t, os.Chtimes(path, modTime, modTime))
+}
+
+func newTestEncryptedFS(t *testing.T, upstream FileService, keyring string, cacheConfig CacheConfig) *EncryptedFS {
+	ctx := context.Background()
+	keyProvider, err := NewKeyringKeyProvider(ctx, keyring)
+	assert.Nil(t, err)
+	fs, err := NewEncryptedFS(ctx, upstream, keyProvider, cacheConfig, nil)
+	assert.Nil(t, err)
+	return fs
+}
+
+func TestEncryptedFS(t *testing.T) {
+
+	t.Run("file service", func(t *testing.T) {
+		keyring := newTestKeyring(t, "k1")
+		testFileService(t, 0, func(name string) FileService {
+			upstream, err := NewMemoryFS(name, DisabledCacheConfig, nil)
+			assert.Nil(t, err)
+			return newTestEncryptedFS(t, upstream, keyring, DisabledCacheConfig)
+		})
+	})
+
+	t.Run("with memory cache", func(t *testing.T) {
+		keyring := newTestKeyring(t, "k1")
+		testFileService(t, 0, func(name string) FileService {
+			ctx := context.Background()
+			cacheConfig := CacheConfig{
+				MemoryCapacity: ptrTo[toml.ByteSize](128 * 1024),
+			}
+			upstream, err := NewLocalFS(ctx, name, t.TempDir(), upstreamCacheConfig(cacheConfig), nil)
+			assert.Nil(t, err)
+			return newTestEncryptedFS(t, upstream, keyring, cacheConfig)
+		})
+	})
+
+	t.Run("replaceable file service", func(t *testing.T) {
+		keyring := newTestKeyring(t, "k1")
+		testReplaceableFileService(t, func() ReplaceableFileService {
+			ctx := context.Background()
+			upstream, err := NewLocalFS(ctx, "local", t.TempDir(), DisabledCacheConfig, nil)
+			assert.Nil(t, err)
+			return newTestEncryptedFS(t, upstream, keyring, DisabledCacheConfig)
+		})
+	})
+
+	t.Run("caching file service", func(t *testing.T) {
+		keyring := newTestKeyring(t, "k1")
+		testCachingFileService(t, func() CachingFileService {
+			ctx := context.Background()
+			cacheConfig := CacheConfig{
+				MemoryCapacity: ptrTo[toml.ByteSize](128 * 1024),
+			}
+			upstream, err := NewLocalFS(ctx, "local", t.TempDir(), upstreamCacheConfig(cacheConfig), nil)
+			assert.Nil(t, err)
+			return newTestEncryptedFS(t, upstream, keyring, cacheConfig)
+		})
+	})
+
+	t.Run("multi blocks", func(t *testing.T) {
+		ctx := context.Background()
+		upstream, err := NewMemoryFS("mem", DisabledCacheConfig, nil)
+		assert.Nil(t, err)
+		fs := newTestEncryptedFS(t, upstream, newTestKeyring(t, "k1"), DisabledCacheConfig)
+		defer fs.Close(ctx)
+
+		for _, size := range []int{
+			0,
+			1,
+			encryptionBlockSize - 1,
+			encryptionBlockSize,
+			encryptionBlockSize + 1,
+			encryptionBlockSize*3 + encryptionBlockSize/2,
+		} {
+			content := make([]byte, size)
+			_, err := rand.Read(content)
+			assert.Nil(t, err)
+			filePath := "foo" + time.Now().Format("150405.000000000")
+
+			err = fs.Write(ctx, IOVector{
+				FilePath: filePath,
+				Entries: []IOEntry{
+					{
+						Size:           -1,
+						ReaderForWrite: bytes.NewReader(content),
+					},
+				},
+			})
+			assert.Nil(t, err)
+
+			// sizes
+			entry, err := fs.StatFile(ctx, filePath)
+			assert.Nil(t, err)
+			assert.Equal(t, int64(size), entry.Size)
+			entry, err = upstream.StatFile(ctx, filePath)
+			assert.Nil(t, err)
+			assert.Equal(t, encryptedSize(int64(size)), entry.Size)
+			if size == 0 {
+				continue
+			}
+
+			// random ranges
+			for i := 0; i < 32; i++ {
+				offset := mrand.Intn(size)
+				n := mrand.Intn(size-offset) + 1
+				var r io.ReadCloser
+				vec := &IOVector{
+					FilePath: filePath,
+					Entries: []IOEntry{
+						{
+							Offset: int64(offset),
+							Size:   int64(n),
+						},
+						{
+							Offset:            int64(offset),
+							Size:              int64(n),
+							ReadCloserForRead: &r,
+						},
+					},
+				}
+				err = fs.Read(ctx, vec)
+				assert.Nil(t, err)
+				assert.Equal(t, content[offset:offset+n], vec.Entries[0].Data)
+				data, err := io.ReadAll(r)
+				assert.Nil(t, err)
+				assert.Nil(t, r.Close())
+				assert.Equal(t, content[offset:offset+n], data)
+				vec.Release()
+			}
+		}
+	})
+
+	t.Run("ciphertext", func(t *testing.T) {
+		ctx := context.Background()
+		upstream, err := NewMemoryFS("mem", DisabledCacheConfig, nil)
+		assert.Nil(t, err)
+		fs := newTestEncryptedFS(t, upstream, newTestKeyring(t, "k1"), DisabledCacheConfig)
+		defer fs.Close(ctx)
+
+		content := bytes.Repeat([]byte("plaintext"), encryptionBlockSize/4)
+		err = fs.Write(ctx, IOVector{
+			FilePath: "foo",
+			Entries: []IOEntry{
+				{
+					Size: int64(len(content)),
+					Data: content,
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		// upstream contains no plaintext
+		vec := &IOVector{
+			FilePath: "foo",
+			Entries: []IOEntry{
+				{
+					Size: -1,
+				},
+			},
+		}
+		err = upstream.Read(ctx, vec)
+		assert.Nil(t, err)
+		sealed := bytes.Clone(vec.Entries[0].Data)
+		vec.Release()
+		assert.False(t, bytes.Contains(sealed, []byte("plaintext")))
+		keyID, ok := parseEncryptionHeader(sealed[:encryptionHeaderSize])
+		assert.True(t, ok)
+		assert.Equal(t, "k1", keyID)
+
+		// tampered
+		sealed[len(sealed)/2] ^= 1
+		err = upstream.Write(ctx, IOVector{
+			FilePath: "bar",
+			Entries: []IOEntry{
+				{
+					Size: int64(len(sealed)),
+					Data: sealed,
+				},
+			},
+		})
+		assert.Nil(t, err)
+		err = fs.Read(ctx, &IOVector{
+			FilePath: "bar",
+			Entries: []IOEntry{
+				{
+					Size: -1,
+				},
+			},
+		})
+		assert.NotNil(t, err)
+
+		// truncated
+		err = upstream.Write(ctx, IOVector{
+			FilePath: "baz",
+			Entries: []IOEntry{
+				{
+					Size: encryptionHeaderSize + encryptionSealedSize,
+					Data: sealed[:encryptionHeaderSize+encryptionSealedSize],
+				},
+			},
+		})
+		assert.Nil(t, err)
+		err = fs.Read(ctx, &IOVector{
+			FilePath: "baz",
+			Entries: []IOEntry{
+				{
+					Size: -1,
+				},
+			},
+		})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("key rotation", func(t *testing.T) {
+		ctx := context.Background()
+		upstream, err := NewMemoryFS("mem", DisabledCacheConfig, nil)
+		assert.Nil(t, err)
+		keyring := newTestKeyring(t, "k1")
+		fs := newTestEncryptedFS(t, upstream, keyring, DisabledCacheConfig)
+
+		write := func(filePath string) {
+			err := fs.Write(ctx, IOVector{
+				FilePath: filePath,
+				Entries: []IOEntry{
+					{
+						Size: 3,
+						Data: []byte(filePath),
+					},
+				},
+			})
+			assert.Nil(t, err)
+		}
+		keyIDOf := func(filePath string) string {
+			vec := &IOVector{
+				FilePath: filePath,
+				Entries: []IOEntry{
+					{
+						Size: encryptionHeaderSize,
+					},
+				},
+			}
+			err := upstream.Read(ctx, vec)
+			assert.Nil(t, err)
+			defer vec.Release()
+			keyID, ok := parseEncryptionHeader(vec.Entries[0].Data)
+			assert.True(t, ok)
+			return keyID
+		}
+
+		write("foo")
+		appendTestKey(t, keyring, "k2")
+		write("bar")
+		assert.Equal(t, "k1", keyIDOf("foo"))
+		assert.Equal(t, "k2", keyIDOf("bar"))
+
+		// read with a new instance
+		fs = newTestEncryptedFS(t, upstream, keyring, DisabledCacheConfig)
+		defer fs.Close(ctx)
+		for _, filePath := range []string{"foo", "bar"} {
+			vec := &IOVector{
+				FilePath: filePath,
+				Entries: []IOEntry{
+					{
+						Size: -1,
+					},
+				},
+			}
+			err = fs.Read(ctx, vec)
+			assert.Nil(t, err)
+			assert.Equal(t, []byte(filePath), vec.Entries[0].Data)
+			vec.Release()
+		}
+
+		// missing key
+		fs = newTestEncryptedFS(t, upstream, newTestKeyring(t, "k2"), DisabledCacheConfig)
+		defer fs.Close(ctx)
+		err = fs.Read(ctx, &IOVector{
+			FilePath: "foo",
+			Entries: []IOEntry{
+				{
+					Size: -1,
+				},
+			},
+		})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("disk cache", func(t *testing.T) {
+		ctx := context.Background()
+		diskCacheDir := t.TempDir()
+		fs, err := NewFileService(ctx, Config{
+			Name:    "s3",
+			Backend: "S3",
+			S3: ObjectStorageArguments{
+				Endpoint: "disk",
+				Bucket:   t.TempDir(),
+			},
+			Cache: CacheConfig{
+				MemoryCapacity: ptrTo[toml.ByteSize](1 << 20),
+				DiskPath:       ptrTo(diskCacheDir),
+				DiskCapacity:   ptrTo[toml.ByteSize](1 << 20),
+			},
+			Encryption: EncryptionConfig{
+				Enable:      true,
+				KeyringFile: newTestKeyring(t, "k1"),
+			},
+		}, nil)
+		assert.Nil(t, err)
+		defer fs.Close(ctx)
+		_, ok := fs.(*EncryptedFS)
+		assert.True(t, ok)
+
+		content := bytes.Repeat([]byte("plaintext"), 1024)
+		err = fs.Write(ctx, IOVector{
+			FilePath: "foo",
+			Entries: []IOEntry{
+				{
+					Size: int64(len(content)),
+					Data: content,
+				},
+			},
+		})
+		assert.Nil(t, err)
+		for i := 0; i < 2; i++ {
+			vec := &IOVector{
+				FilePath: "foo",
+				Entries: []IOEntry{
+					{
+						Offset: 9,
+						Size:   9,
+					},
+				},
+			}
+			err = fs.Read(ctx, vec)
+			assert.Nil(t, err)
+			assert.Equal(t, []byte("plaintext"), vec.Entries[0].Data)
+			vec.Release()
+		}
+
+		numFiles := 0
+		err = filepath.WalkDir(diskCacheDir, func(path string, d os.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+			numFiles++
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			assert.False(t, bytes.Contains(data, []byte("plaintext")))
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.True(t, numFiles > 0)
+	})
+
+	t.Run("unsupported backend", func(t *testing.T) {
+		_, err := NewFileService(context.Background(), Config{
+			Name:    "mem",
+			Backend: "MEM",
+			Encryption: EncryptionConfig{
+				Enable:      true,
+				KeyringFile: newTestKeyring(t, "k1"),
+			},
+		}, nil)
+		assert.NotNil(t, err)
+	})
+
+}
+
+func TestEncryptedSize(t *testing.T) {
+	for _, size := range []int64{
+		0, 1, encryptionBlockSize - 1, encryptionBlockSize, encryptionBlockSize + 1,
+		encryptionBlockSize * 42, encryptionBlockSize*42 + 42,
+	} {
+		n, ok := plainSize(encryptedSize(size))
+		assert.True(t, ok)
+		assert.Equal(t, size, n)
+	}
+	_, ok := plainSize(encryptionHeaderSize + 1)
+	assert.False(t, ok)
+	_, ok = plainSize(encryptionHeaderSize + encryptionSealedSize + 1)
+	assert.False(t, ok)
+}
@@ -0,0 +1,436 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+const (
+	keyringKeyProvider = "KEYRING"
+)
+
+// EncryptionConfig configs encryption at rest of a file service
+type EncryptionConfig struct {
+	// Enable encrypts all objects written by the file service
+	Enable bool `toml:"enable"`
+	// KeyProvider provides master keys. [KEYRING]
+	KeyProvider string `toml:"key-provider"`
+	// KeyringFile is the keyring file used by the KEYRING key provider
+	KeyringFile string `toml:"keyring-file"`
+}
+
+// KeyProvider provides master keys to wrap and unwrap per-object data keys
+type KeyProvider interface {
+	// CurrentKey returns the key used to encrypt new objects
+	CurrentKey(ctx context.Context) (id string, key []byte, err error)
+	// Key returns the key of the specified id
+	Key(ctx context.Context, id string) ([]byte, error)
+}
+
+// NewKeyProvider creates a KeyProvider from config
+func NewKeyProvider(ctx context.Context, cfg EncryptionConfig) (KeyProvider, error) {
+	switch strings.ToUpper(cfg.KeyProvider) {
+	case "", keyringKeyProvider:
+		if cfg.KeyringFile == "" {
+			return nil, moerr.NewBadConfig(ctx, "empty keyring file")
+		}
+		return NewKeyringKeyProvider(ctx, cfg.KeyringFile)
+	default:
+		return nil, moerr.NewBadConfigf(ctx, "key provider %s not implemented", cfg.KeyProvider)
+	}
+}
+
+// encrypted object layout:
+//
+//	header | block 0 | block 1 | ... | block n-1
+//
+// header:
+//
+//	magic(4) | version(1) | key id length(1) | key id(32) | nonce(12) | wrapped data key(32 + 16)
+//
+// the data key is generated randomly for every object, and wrapped by the master key.
+// plaintext is split into fixed size blocks, every block is sealed by AES-GCM with the data key,
+// using the block index as nonce, so any range can be decrypted without reading the whole object.
+// the last block is sealed with a different additional data to detect truncation.
+// there is always at least one block, the last block may be empty only if the object is empty.
+const (
+	encryptionVersion      = 1
+	encryptionMaxKeyIDSize = 32
+	encryptionDataKeySize  = 32
+	encryptionNonceSize    = 12
+	encryptionTagSize      = 16
+	encryptionBlockSize    = 64 * 1024
+
+	encryptionHeaderSize = 4 + 1 + 1 + encryptionMaxKeyIDSize +
+		encryptionNonceSize + encryptionDataKeySize + encryptionTagSize
+	encryptionHeaderAADSize = 4 + 1 + 1 + encryptionMaxKeyIDSize
+	encryptionSealedSize    = encryptionBlockSize + encryptionTagSize
+)
+
+var encryptionMagic = []byte("MOEC")
+
+var (
+	blockAAD      = []byte{0}
+	finalBlockAAD = []byte{1}
+)
+
+// encryptedSize returns the size of encrypted object
+func encryptedSize(plainSize int64) int64 {
+	numBlocks := max((plainSize+encryptionBlockSize-1)/encryptionBlockSize, 1)
+	return encryptionHeaderSize + plainSize + numBlocks*encryptionTagSize
+}
+
+// plainSize returns the size of plaintext of an encrypted object
+func plainSize(encryptedSize int64) (int64, bool) {
+	n := encryptedSize - encryptionHeaderSize
+	if n < encryptionTagSize {
+		return 0, false
+	}
+	numFullBlocks := n / encryptionSealedSize
+	rem := n % encryptionSealedSize
+	if rem == 0 {
+		return numFullBlocks * encryptionBlockSize, true
+	}
+	if rem < encryptionTagSize {
+		return 0, false
+	}
+	return numFullBlocks*encryptionBlockSize + rem - encryptionTagSize, true
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func blockNonce(index int64) []byte {
+	nonce := make([]byte, encryptionNonceSize)
+	binary.BigEndian.PutUint64(nonce[encryptionNonceSize-8:], uint64(index))
+	return nonce
+}
+
+// newEncryptionHeader generates a data key and returns the header containing the wrapped data key
+func newEncryptionHeader(keyID string, masterKey []byte) (header []byte, dataKey cipher.AEAD, err error) {
+	if len(keyID) == 0 || len(keyID) > encryptionMaxKeyIDSize {
+		return nil, nil, moerr.NewInternalErrorNoCtxf("invalid encryption key id: %s", keyID)
+	}
+	master, err := newAEAD(masterKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key := make([]byte, encryptionDataKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, nil, err
+	}
+	dataKey, err = newAEAD(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	header = make([]byte, encryptionHeaderAADSize, encryptionHeaderSize)
+	copy(header, encryptionMagic)
+	header[4] = encryptionVersion
+	header[5] = byte(len(keyID))
+	copy(header[6:], keyID)
+	nonce := make([]byte, encryptionNonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, nil, err
+	}
+	header = append(header, nonce...)
+	header = master.Seal(header, nonce, key, header[:encryptionHeaderAADSize])
+
+	return header, dataKey, nil
+}
+
+// parseEncryptionHeader returns the key id of header
+func parseEncryptionHeader(header []byte) (keyID string, ok bool) {
+	if len(header) != encryptionHeaderSize ||
+		!bytes.Equal(header[:4], encryptionMagic) ||
+		header[4] != encryptionVersion ||
+		header[5] == 0 ||
+		header[5] > encryptionMaxKeyIDSize {
+		return "", false
+	}
+	return string(header[6 : 6+header[5]]), true
+}
+
+// openEncryptionHeader unwraps the data key in header
+func openEncryptionHeader(header []byte, masterKey []byte) (cipher.AEAD, error) {
+	master, err := newAEAD(masterKey)
+	if err != nil {
+		return nil, err
+	}
+	nonce := header[encryptionHeaderAADSize : encryptionHeaderAADSize+encryptionNonceSize]
+	key, err := master.Open(
+		nil,
+		nonce,
+		header[encryptionHeaderAADSize+encryptionNonceSize:],
+		header[:encryptionHeaderAADSize],
+	)
+	if err != nil {
+		return nil, err
+	}
+	return newAEAD(key)
+}
+
+// encryptReader encrypts plaintext from upstream reader
+type encryptReader struct {
+	upstream io.Reader
+	dataKey  cipher.AEAD
+	// expected plaintext size, -1 if unknown
+	expectedSize int64
+	path         string
+
+	plainBuf  []byte
+	lookahead []byte
+	out       []byte
+	index     int64
+	numRead   int64
+	done      bool
+}
+
+func newEncryptReader(
+	upstream io.Reader,
+	header []byte,
+	dataKey cipher.AEAD,
+	expectedSize int64,
+	path string,
+) *encryptReader {
+	out := make([]byte, 0, encryptionSealedSize)
+	out = append(out, header...)
+	return &encryptReader{
+		upstream:     upstream,
+		dataKey:      dataKey,
+		expectedSize: expectedSize,
+		path:         path,
+		plainBuf:     make([]byte, encryptionBlockSize),
+		lookahead:    make([]byte, 0, 1),
+		out:          out,
+	}
+}
+
+var _ io.Reader = new(encryptReader)
+
+func (e *encryptReader) Read(buf []byte) (int, error) {
+	for len(e.out) == 0 {
+		if e.done {
+			return 0, io.EOF
+		}
+		if err := e.sealNext(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(buf, e.out)
+	e.out = e.out[n:]
+	return n, nil
+}
+
+func (e *encryptReader) sealNext() error {
+	n := copy(e.plainBuf, e.lookahead)
+	e.lookahead = e.lookahead[:0]
+	m, err := io.ReadFull(e.upstream, e.plainBuf[n:])
+	n += m
+	final := false
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		final = true
+	} else if err != nil {
+		return err
+	} else {
+		// full block, peek one byte to decide whether it's the last block
+		m, err := io.ReadFull(e.upstream, e.lookahead[:1])
+		if err == io.EOF {
+			final = true
+		} else if err != nil {
+			return err
+		} else {
+			e.lookahead = e.lookahead[:m]
+		}
+	}
+	e.numRead += int64(n)
+
+	aad := blockAAD
+	if final {
+		if e.expectedSize >= 0 && e.numRead != e.expectedSize {
+			return moerr.NewSizeNotMatchNoCtx(e.path)
+		}
+		aad = finalBlockAAD
+		e.done = true
+	}
+	e.out = e.dataKey.Seal(e.out[:0], blockNonce(e.index), e.plainBuf[:n], aad)
+	e.index++
+	return nil
+}
+
+// encryptedObject is the decryption info of an encrypted object
+type encryptedObject struct {
+	dataKey       cipher.AEAD
+	encryptedSize int64
+	plainSize     int64
+}
+
+func (o *encryptedObject) numBlocks() int64 {
+	return max((o.plainSize+encryptionBlockSize-1)/encryptionBlockSize, 1)
+}
+
+// encryptedRange returns the range of blocks covering plaintext range [offset, offset + size)
+func (o *encryptedObject) encryptedRange(offset, size int64) (firstBlock int64, start int64, end int64) {
+	firstBlock = offset / encryptionBlockSize
+	lastBlock := (offset + size - 1) / encryptionBlockSize
+	start = encryptionHeaderSize + firstBlock*encryptionSealedSize
+	end = min(
+		encryptionHeaderSize+(lastBlock+1)*encryptionSealedSize,
+		o.encryptedSize,
+	)
+	return
+}
+
+// openBlock decrypts the sealed block to dst
+func (o *encryptedObject) openBlock(dst []byte, index int64, sealed []byte) ([]byte, error) {
+	aad := blockAAD
+	if index == o.numBlocks()-1 {
+		aad = finalBlockAAD
+	}
+	return o.dataKey.Open(dst, blockNonce(index), sealed, aad)
+}
+
+// decryptRange decrypts sealed blocks starting from firstBlock, and copies plaintext range [offset, offset + len(dst)) to dst
+func (o *encryptedObject) decryptRange(dst []byte, offset int64, firstBlock int64, sealed []byte) error {
+	plainBuf := make([]byte, 0, encryptionBlockSize)
+	skip := offset - firstBlock*encryptionBlockSize
+	for index := firstBlock; len(dst) > 0; index++ {
+		if len(sealed) == 0 {
+			return io.ErrUnexpectedEOF
+		}
+		l := min(len(sealed), encryptionSealedSize)
+		plain, err := o.openBlock(plainBuf[:0], index, sealed[:l])
+		if err != nil {
+			return err
+		}
+		sealed = sealed[l:]
+		if skip >= int64(len(plain)) {
+			return io.ErrUnexpectedEOF
+		}
+		n := copy(dst, plain[skip:])
+		dst = dst[n:]
+		skip = 0
+	}
+	return nil
+}
+
+// decryptReader decrypts plaintext range from sealed blocks reader
+type decryptReader struct {
+	upstream   io.ReadCloser
+	object     *encryptedObject
+	index      int64
+	skip       int64
+	remaining  int64
+	sealedBuf  []byte
+	plainBuf   []byte
+	out        []byte
+	onClose    func()
+	closedOnce bool
+}
+
+func newDecryptReader(
+	upstream io.ReadCloser,
+	object *encryptedObject,
+	offset int64,
+	size int64,
+	firstBlock int64,
+	onClose func(),
+) *decryptReader {
+	return &decryptReader{
+		upstream:  upstream,
+		object:    object,
+		index:     firstBlock,
+		skip:      offset - firstBlock*encryptionBlockSize,
+		remaining: size,
+		sealedBuf: make([]byte, encryptionSealedSize),
+		plainBuf:  make([]byte, 0, encryptionBlockSize),
+		onClose:   onClose,
+	}
+}
+
+var _ io.ReadCloser = new(decryptReader)
+
+func (d *decryptReader) Read(buf []byte) (int, error) {
+	for len(d.out) == 0 {
+		if d.remaining == 0 {
+			return 0, io.EOF
+		}
+		if err := d.openNext(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(buf, d.out)
+	d.out = d.out[n:]
+	return n, nil
+}
+
+func (d *decryptReader) openNext() error {
+	l := min(
+		int64(encryptionSealedSize),
+		d.object.encryptedSize-encryptionHeaderSize-d.index*encryptionSealedSize,
+	)
+	if l <= 0 {
+		return io.ErrUnexpectedEOF
+	}
+	if _, err := io.ReadFull(d.upstream, d.sealedBuf[:l]); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	plain, err := d.object.openBlock(d.plainBuf[:0], d.index, d.sealedBuf[:l])
+	if err != nil {
+		return err
+	}
+	d.index++
+	if d.skip > int64(len(plain)) {
+		return io.ErrUnexpectedEOF
+	}
+	plain = plain[d.skip:]
+	d.skip = 0
+	if int64(len(plain)) > d.remaining {
+		plain = plain[:d.remaining]
+	}
+	d.remaining -= int64(len(plain))
+	d.out = plain
+	return nil
+}
+
+func (d *decryptReader) Close() error {
+	err := d.upstream.Close()
+	if !d.closedOnce {
+		d.closedOnce = true
+		if d.onClose != nil {
+			d.onClose()
+		}
+	}
+	return err
+}
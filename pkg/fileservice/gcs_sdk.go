@@ -0,0 +1,543 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"iter"
+	gotrace "runtime/trace"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/perfcounter"
+	"github.com/matrixorigin/matrixone/pkg/util/trace"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+// GCSSDK is an ObjectStorage backed by Google Cloud Storage
+//
+// arguments mapping:
+// Bucket: bucket name
+// KeySecret: service account key in JSON
+// Endpoint: JSON API endpoint, defaults to https://storage.googleapis.com
+type GCSSDK struct {
+	name            string
+	client          *storage.Client
+	bucket          *storage.BucketHandle
+	perfCounterSets []*perfcounter.CounterSet
+	listMaxKeys     int
+}
+
+func NewGCSSDK(
+	ctx context.Context,
+	args ObjectStorageArguments,
+	perfCounterSets []*perfcounter.CounterSet,
+) (_ *GCSSDK, err error) {
+	defer catch(&err)
+
+	// args
+	if err := args.validate(); err != nil {
+		return nil, err
+	}
+	if args.Bucket == "" {
+		return nil, moerr.NewBadConfigNoCtx("gcs bucket not specified")
+	}
+
+	// credentials
+	var tokenSource oauth2.TokenSource
+	if args.KeySecret != "" {
+		// service account key
+		creds, err := google.CredentialsFromJSON(ctx, []byte(args.KeySecret), storage.ScopeReadWrite)
+		if err != nil {
+			return nil, err
+		}
+		tokenSource = creds.TokenSource
+	} else if args.shouldLoadDefaultCredentials() {
+		// application default credentials
+		creds, err := google.FindDefaultCredentials(ctx, storage.ScopeReadWrite)
+		if err != nil {
+			return nil, err
+		}
+		tokenSource = creds.TokenSource
+	}
+
+	// http client
+	httpClient := newHTTPClient(args)
+	if tokenSource != nil {
+		httpClient.Transport = &oauth2.Transport{
+			Source: tokenSource,
+			Base:   httpClient.Transport,
+		}
+	}
+
+	// client
+	opts := []option.ClientOption{
+		// authorized by http client
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(httpClient),
+	}
+	if args.Endpoint != "" {
+		opts = append(opts, option.WithEndpoint(
+			strings.TrimSuffix(args.Endpoint, "/")+"/storage/v1/",
+		))
+	}
+	client, err := storage.NewClient(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// retry by DoWithRetry
+	bucket := client.Bucket(args.Bucket).Retryer(
+		storage.WithPolicy(storage.RetryNever),
+	)
+
+	logutil.Info("new object storage",
+		zap.Any("sdk", "gcs"),
+		zap.Any("arguments", args),
+	)
+
+	if !args.NoBucketValidation {
+		// validate bucket
+		_, err := bucket.Attrs(ctx)
+		if err != nil {
+			client.Close()
+			return nil, err
+		}
+	}
+
+	return &GCSSDK{
+		name:            args.Name,
+		client:          client,
+		bucket:          bucket,
+		perfCounterSets: perfCounterSets,
+	}, nil
+}
+
+var _ ObjectStorage = new(GCSSDK)
+
+func (g *GCSSDK) List(
+	ctx context.Context,
+	prefix string,
+) iter.Seq2[*DirEntry, error] {
+	return func(yield func(*DirEntry, error) bool) {
+		if err := ctx.Err(); err != nil {
+			yield(nil, err)
+			return
+		}
+
+		var token string
+
+	loop1:
+		for {
+			attrs, nextToken, err := g.listObjects(ctx, prefix, token)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			for _, attr := range attrs {
+				var entry *DirEntry
+				if attr.Prefix != "" {
+					entry = &DirEntry{
+						IsDir: true,
+						Name:  attr.Prefix,
+					}
+				} else {
+					entry = &DirEntry{
+						Name: attr.Name,
+						Size: attr.Size,
+					}
+				}
+				if !yield(entry, nil) {
+					break loop1
+				}
+			}
+
+			if nextToken == "" {
+				break
+			}
+			token = nextToken
+		}
+
+	}
+}
+
+func (g *GCSSDK) Stat(
+	ctx context.Context,
+	key string,
+) (
+	size int64,
+	err error,
+) {
+
+	defer func() {
+		if g.is404(err) {
+			err = moerr.NewFileNotFoundNoCtx(key)
+		}
+	}()
+
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	attrs, err := g.statObject(
+		ctx,
+		key,
+	)
+	if err != nil {
+		return
+	}
+
+	size = attrs.Size
+
+	return
+}
+
+func (g *GCSSDK) Exists(
+	ctx context.Context,
+	key string,
+) (
+	bool,
+	error,
+) {
+
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+
+	_, err := g.statObject(
+		ctx,
+		key,
+	)
+	if err != nil {
+		if g.is404(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (g *GCSSDK) Write(
+	ctx context.Context,
+	key string,
+	r io.Reader,
+	sizeHint *int64,
+	expire *time.Time,
+) (
+	err error,
+) {
+	defer wrapSizeMismatchErr(&err)
+
+	if sizeHint != nil && *sizeHint < smallObjectThreshold {
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		if int64(len(data)) != *sizeHint {
+			return moerr.NewSizeNotMatchNoCtx(key)
+		}
+		_, err = DoWithRetry("write", func() (int, error) {
+			return 0, g.putObject(
+				ctx,
+				key,
+				bytes.NewReader(data),
+				true,
+				expire,
+			)
+		}, maxRetryAttemps, g.isRetryable)
+		if err != nil {
+			return err
+		}
+
+	} else {
+		var n atomic.Int64
+		if sizeHint != nil {
+			r = &countingReader{
+				R: r,
+				C: &n,
+			}
+		}
+		err = g.putObject(
+			ctx,
+			key,
+			r,
+			false,
+			expire,
+		)
+		if err != nil {
+			return err
+		}
+		if sizeHint != nil && n.Load() != *sizeHint {
+			return moerr.NewSizeNotMatchNoCtx(key)
+		}
+	}
+
+	return
+}
+
+func (g *GCSSDK) Read(
+	ctx context.Context,
+	key string,
+	min *int64,
+	max *int64,
+) (
+	r io.ReadCloser,
+	err error,
+) {
+
+	defer func() {
+		if g.is404(err) {
+			err = moerr.NewFileNotFoundNoCtx(key)
+		}
+	}()
+
+	return g.getObject(
+		ctx,
+		key,
+		min,
+		max,
+	)
+}
+
+func (g *GCSSDK) Delete(
+	ctx context.Context,
+	keys ...string,
+) (
+	err error,
+) {
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := g.deleteSingle(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (g *GCSSDK) deleteSingle(ctx context.Context, key string) error {
+	ctx, span := trace.Start(ctx, "GCSSDK.deleteSingle")
+	defer span.End()
+
+	_, err := g.deleteObject(
+		ctx,
+		key,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (g *GCSSDK) listObjects(ctx context.Context, prefix string, token string) ([]*storage.ObjectAttrs, string, error) {
+	ctx, task := gotrace.NewTask(ctx, "GCSSDK.listObjects")
+	defer task.End()
+
+	query := &storage.Query{
+		Prefix:    prefix,
+		Delimiter: "/",
+	}
+	if err := query.SetAttrSelection([]string{"Name", "Size"}); err != nil {
+		return nil, "", err
+	}
+	pageSize := 1000
+	if g.listMaxKeys > 0 {
+		pageSize = g.listMaxKeys
+	}
+
+	type listResult struct {
+		attrs     []*storage.ObjectAttrs
+		nextToken string
+	}
+	result, err := DoWithRetry(
+		"gcs list objects",
+		func() (listResult, error) {
+			perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+				counter.FileService.S3.List.Add(1)
+			}, g.perfCounterSets...)
+			var ret listResult
+			// new pager for every attempt, pager is not reusable after error
+			pager := iterator.NewPager(g.bucket.Objects(ctx, query), pageSize, token)
+			nextToken, err := pager.NextPage(&ret.attrs)
+			if err != nil {
+				return ret, err
+			}
+			ret.nextToken = nextToken
+			return ret, nil
+		},
+		maxRetryAttemps,
+		g.isRetryable,
+	)
+	if err != nil {
+		return nil, "", err
+	}
+	return result.attrs, result.nextToken, nil
+}
+
+func (g *GCSSDK) statObject(ctx context.Context, key string) (*storage.ObjectAttrs, error) {
+	ctx, task := gotrace.NewTask(ctx, "GCSSDK.statObject")
+	defer task.End()
+
+	return DoWithRetry(
+		"gcs get object attrs",
+		func() (*storage.ObjectAttrs, error) {
+			perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+				counter.FileService.S3.Head.Add(1)
+			}, g.perfCounterSets...)
+			return g.bucket.Object(key).Attrs(ctx)
+		},
+		maxRetryAttemps,
+		g.isRetryable,
+	)
+}
+
+func (g *GCSSDK) putObject(
+	ctx context.Context,
+	key string,
+	r io.Reader,
+	small bool,
+	expire *time.Time,
+) (err error) {
+	ctx, task := gotrace.NewTask(ctx, "GCSSDK.putObject")
+	defer task.End()
+
+	perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+		counter.FileService.S3.Put.Add(1)
+	}, g.perfCounterSets...)
+
+	// cancel the upload if not fully written
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	w := g.bucket.Object(key).NewWriter(ctx)
+	if small {
+		// single request upload
+		w.ChunkSize = 0
+	}
+	if expire != nil {
+		// for lifecycle rules with DaysSinceCustomTime conditions
+		w.CustomTime = *expire
+	}
+
+	// not retryable if not small because Reader may be half consumed
+	if _, err := io.Copy(w, r); err != nil {
+		w.Close()
+		return err
+	}
+	// errors of the upload are returned by Close
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (g *GCSSDK) getObject(ctx context.Context, key string, min *int64, max *int64) (io.ReadCloser, error) {
+	ctx, task := gotrace.NewTask(ctx, "GCSSDK.getObject")
+	defer task.End()
+
+	if min == nil {
+		min = ptrTo[int64](0)
+	}
+
+	return newRetryableReader(
+		func(offset int64) (io.ReadCloser, error) {
+			// negative length means to the end
+			length := int64(-1)
+			if max != nil {
+				length = *max - offset
+				if length < 0 {
+					length = 0
+				}
+			}
+
+			return DoWithRetry(
+				"gcs get object",
+				func() (io.ReadCloser, error) {
+					perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+						counter.FileService.S3.Get.Add(1)
+					}, g.perfCounterSets...)
+					reader, err := g.bucket.Object(key).NewRangeReader(ctx, offset, length)
+					if err != nil {
+						return nil, err
+					}
+					return &readCloser{
+						r: reader,
+						closeFunc: func() error {
+							// drain
+							io.Copy(io.Discard, reader)
+							return reader.Close()
+						},
+					}, nil
+				},
+				maxRetryAttemps,
+				g.isRetryable,
+			)
+
+		},
+		*min,
+		g.isRetryable,
+	)
+}
+
+func (g *GCSSDK) deleteObject(ctx context.Context, key string) (bool, error) {
+	ctx, task := gotrace.NewTask(ctx, "GCSSDK.deleteObject")
+	defer task.End()
+	return DoWithRetry(
+		"gcs delete object",
+		func() (bool, error) {
+			perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+				counter.FileService.S3.Delete.Add(1)
+			}, g.perfCounterSets...)
+			if err := g.bucket.Object(key).Delete(ctx); err != nil {
+				if g.is404(err) {
+					return false, nil
+				}
+				return false, err
+			}
+			return true, nil
+		},
+		maxRetryAttemps,
+		g.isRetryable,
+	)
+}
+
+func (g *GCSSDK) is404(err error) bool {
+	if err == nil {
+		return false
+	}
+	return errors.Is(err, storage.ErrObjectNotExist)
+}
+
+func (g *GCSSDK) isRetryable(err error) bool {
+	return IsRetryableError(err) || storage.ShouldRetry(err)
+}
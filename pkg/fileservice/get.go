@@ -61,6 +61,8 @@ var NoDefaultCredentialsForETL = os.Getenv("MO_NO_DEFAULT_CREDENTIALS") != ""
 // s3,<endpoint>,<region>,<bucket>,<key>,<secret>,<prefix>
 // s3-no-key,<endpoint>,<region>,<bucket>,<prefix>
 // minio,<endpoint>,<region>,<bucket>,<key>,<secret>,<prefix>
+// azure,<endpoint>,<region>,<container>,<account>,<account key>,<prefix>
+// gcs,<endpoint>,<region>,<bucket>,<key>,<service account json>,<prefix>
 // s3-opts,endpoint=<endpoint>,region=<region>,bucket=<bucket>,key=<key>,secret=<secret>,prefix=<prefix>,role-arn=<role arn>,external-id=<external id>
 //
 //	key value pairs can be in any order
@@ -168,7 +170,7 @@ func GetForETL(ctx context.Context, fs FileService, path string) (res ETLFileSer
 				return
 			}
 
-		case "minio":
+		case "minio", "azure", "gcs":
 			arguments := fsPath.ServiceArguments
 			if len(arguments) < 6 {
 				return nil, "", moerr.NewInvalidInputNoCtx("invalid S3 arguments")
@@ -196,7 +198,9 @@ func GetForETL(ctx context.Context, fs FileService, path string) (res ETLFileSer
 					KeySecret:          accessSecret,
 					KeyPrefix:          keyPrefix,
 					Name:               name,
-					IsMinio:            true,
+					IsMinio:            fsPath.Service == "minio",
+					IsAzure:            fsPath.Service == "azure",
+					IsGCS:              fsPath.Service == "gcs",
 				},
 				DisabledCacheConfig,
 				nil,
@@ -0,0 +1,157 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/hex"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+)
+
+// KeyringKeyProvider provides master keys from a local keyring file
+//
+// every non-empty line of the file is a key, formatted as '<key id> <hex encoded key>'
+// lines starting with '#' are comments
+// key size must be 16, 24 or 32 bytes
+// the last key is the current key, to rotate the master key, append a new key to the file.
+// old keys must be kept in the file to decrypt existing objects.
+type KeyringKeyProvider struct {
+	path string
+
+	mu      sync.Mutex
+	modTime time.Time
+	keys    map[string][]byte
+	current string
+}
+
+var _ KeyProvider = new(KeyringKeyProvider)
+
+func NewKeyringKeyProvider(ctx context.Context, path string) (*KeyringKeyProvider, error) {
+	k := &KeyringKeyProvider{
+		path: path,
+	}
+	if err := k.load(ctx); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+func (k *KeyringKeyProvider) CurrentKey(ctx context.Context) (string, []byte, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if err := k.reloadIfModified(ctx); err != nil {
+		return "", nil, err
+	}
+	return k.current, k.keys[k.current], nil
+}
+
+func (k *KeyringKeyProvider) Key(ctx context.Context, id string) ([]byte, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if key, ok := k.keys[id]; ok {
+		return key, nil
+	}
+	if err := k.reloadIfModified(ctx); err != nil {
+		return nil, err
+	}
+	if key, ok := k.keys[id]; ok {
+		return key, nil
+	}
+	return nil, moerr.NewInternalErrorf(ctx, "encryption key %s not found in keyring", id)
+}
+
+func (k *KeyringKeyProvider) reloadIfModified(ctx context.Context) error {
+	stat, err := os.Stat(k.path)
+	if err != nil {
+		return err
+	}
+	if stat.ModTime().Equal(k.modTime) {
+		return nil
+	}
+	return k.load(ctx)
+}
+
+func (k *KeyringKeyProvider) load(ctx context.Context) error {
+	stat, err := os.Stat(k.path)
+	if err != nil {
+		return err
+	}
+	content, err := os.ReadFile(k.path)
+	if err != nil {
+		return err
+	}
+
+	keys := make(map[string][]byte)
+	var current string
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return moerr.NewBadConfigf(ctx, "invalid keyring %s at line %d", k.path, lineNum)
+		}
+		id := fields[0]
+		if len(id) > encryptionMaxKeyIDSize {
+			return moerr.NewBadConfigf(ctx, "key id too long in keyring %s at line %d", k.path, lineNum)
+		}
+		key, err := hex.DecodeString(fields[1])
+		if err != nil {
+			return moerr.NewBadConfigf(ctx, "invalid key in keyring %s at line %d", k.path, lineNum)
+		}
+		switch len(key) {
+		case 16, 24, 32:
+		default:
+			return moerr.NewBadConfigf(ctx, "invalid key size in keyring %s at line %d", k.path, lineNum)
+		}
+		if _, ok := keys[id]; ok {
+			return moerr.NewBadConfigf(ctx, "duplicated key id %s in keyring %s", id, k.path)
+		}
+		keys[id] = key
+		current = id
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	if current == "" {
+		return moerr.NewBadConfigf(ctx, "no key in keyring %s", k.path)
+	}
+
+	if k.current != "" && k.current != current {
+		logutil.Info("fileservice: encryption key rotated",
+			zap.Any("keyring", k.path),
+			zap.Any("from", k.current),
+			zap.Any("to", current),
+		)
+	}
+	k.keys = keys
+	k.current = current
+	k.modTime = stat.ModTime()
+	return nil
+}
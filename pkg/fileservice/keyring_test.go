@@ -0,0 +1,86 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyringKeyProvider(t *testing.T) {
+	ctx := context.Background()
+
+	path := newTestKeyring(t, "k1")
+	provider, err := NewKeyringKeyProvider(ctx, path)
+	assert.Nil(t, err)
+
+	id, key1, err := provider.CurrentKey(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, "k1", id)
+	assert.Equal(t, 32, len(key1))
+
+	// rotate
+	appendTestKey(t, path, "k2")
+	id, key2, err := provider.CurrentKey(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, "k2", id)
+	assert.NotEqual(t, key1, key2)
+
+	key, err := provider.Key(ctx, "k1")
+	assert.Nil(t, err)
+	assert.Equal(t, key1, key)
+	_, err = provider.Key(ctx, "k3")
+	assert.NotNil(t, err)
+
+	// new provider
+	provider, err = NewKeyringKeyProvider(ctx, path)
+	assert.Nil(t, err)
+	id, key, err = provider.CurrentKey(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, "k2", id)
+	assert.Equal(t, key2, key)
+}
+
+func TestKeyringKeyProviderBadKeyring(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := NewKeyringKeyProvider(ctx, filepath.Join(t.TempDir(), "not-exists"))
+	assert.NotNil(t, err)
+
+	for _, content := range []string{
+		"",
+		"# comment only\n",
+		"k1\n",
+		"k1 zz\n",
+		"k1 0011\n",
+		"k1 " + strings.Repeat("00", 32) + "\nk1 " + strings.Repeat("11", 32) + "\n",
+		strings.Repeat("k", encryptionMaxKeyIDSize+1) + " " + strings.Repeat("00", 32) + "\n",
+	} {
+		path := filepath.Join(t.TempDir(), "keyring")
+		assert.Nil(t, os.WriteFile(path, []byte(content), 0600))
+		_, err := NewKeyringKeyProvider(ctx, path)
+		assert.NotNil(t, err, content)
+	}
+
+	_, err = NewKeyProvider(ctx, EncryptionConfig{
+		KeyProvider: "kms",
+	})
+	assert.NotNil(t, err)
+}
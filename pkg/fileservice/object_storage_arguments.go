@@ -40,6 +40,8 @@ type ObjectStorageArguments struct {
 	Bucket    string   `toml:"bucket"`
 	Endpoint  string   `toml:"endpoint"`
 	IsMinio   bool     `toml:"is-minio"`
+	IsAzure   bool     `toml:"is-azure"`
+	IsGCS     bool     `toml:"is-gcs"`
 	Region    string   `toml:"region"`
 	CertFiles []string `toml:"cert-files"`
 
@@ -100,6 +102,10 @@ func (o *ObjectStorageArguments) SetFromString(arguments []string) error {
 			o.Endpoint = value
 		case "is-minio", "minio":
 			o.IsMinio = value != "false" && value != "0"
+		case "is-azure", "azure":
+			o.IsAzure = value != "false" && value != "0"
+		case "is-gcs", "gcs":
+			o.IsGCS = value != "false" && value != "0"
 		case "region":
 			o.Region = value
 		case "cert-files":
@@ -151,7 +157,8 @@ func (o *ObjectStorageArguments) validate() error {
 	}
 
 	// region
-	if o.Region == "" {
+	// azure and gcs do not use region
+	if o.Region == "" && !o.IsAzure && !o.IsGCS {
 
 		// 腾讯云
 		if o.Endpoint != "" && strings.Contains(o.Endpoint, "myqcloud.com") {
@@ -61,6 +61,16 @@ func TestObjectStorageArguments(t *testing.T) {
 		}
 
 	})
+
+	t.Run("azure and gcs", func(t *testing.T) {
+		var args ObjectStorageArguments
+		assert.Nil(t, args.SetFromString([]string{"is-azure=true", "gcs=1"}))
+		assert.True(t, args.IsAzure)
+		assert.True(t, args.IsGCS)
+		assert.Nil(t, args.SetFromString([]string{"azure=false", "is-gcs=0"}))
+		assert.False(t, args.IsAzure)
+		assert.False(t, args.IsGCS)
+	})
 }
 
 func objectStorageArgumentsForTest(defaultName string, t *testing.T) (ret []ObjectStorageArguments) {
@@ -122,6 +132,8 @@ func objectStorageArgumentsForTest(defaultName string, t *testing.T) (ret []Obje
 	// examples
 	//t.Setenv("TEST_S3FS_ALIYUN", "name=aliyun,endpoint=oss-cn-shenzhen.aliyuncs.com,region=oss-cn-shenzhen,bucket=reus-test,key-id=aaa,key-secret=bbb")
 	//t.Setenv("TEST_S3FS_QCLOUD", "name=qcloud,endpoint=https://cos.ap-guangzhou.myqcloud.com,region=ap-guangzhou,bucket=mofstest-1251598405,key-id=aaa,key-secret=bbb")
+	//t.Setenv("TEST_S3FS_AZURITE", "name=azurite,is-azure=true,endpoint=http://127.0.0.1:10000/devstoreaccount1,bucket=test,key-id=devstoreaccount1,key-secret=Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCxaSfwrLY5Zp4vrDmHiXvmeM1uoJKtA==")
+	//t.Setenv("TEST_S3FS_FAKE_GCS", "name=fake-gcs,is-gcs=true,endpoint=http://127.0.0.1:4443,bucket=test,no-default-credentials=true")
 	for _, pairs := range os.Environ() {
 		name, value, ok := strings.Cut(pairs, "=")
 		if !ok {
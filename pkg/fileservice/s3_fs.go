@@ -89,6 +89,21 @@ func NewS3FS(
 			return nil, err
 		}
 
+	case args.IsAzure ||
+		strings.Contains(args.Endpoint, "blob.core.windows.net"):
+		// Azure Blob Storage
+		fs.storage, err = NewAzureSDK(ctx, args, perfCounterSets)
+		if err != nil {
+			return nil, err
+		}
+
+	case args.IsGCS:
+		// Google Cloud Storage
+		fs.storage, err = NewGCSSDK(ctx, args, perfCounterSets)
+		if err != nil {
+			return nil, err
+		}
+
 	case strings.Contains(args.Endpoint, "myqcloud.com"):
 		// 腾讯云
 		fs.storage, err = NewQCloudSDK(ctx, args, perfCounterSets)
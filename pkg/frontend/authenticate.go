@@ -679,6 +679,7 @@ const (
 	PrivilegeTypeCanGrantRoleToOthersInCreateUser // used in checking the privilege of CreateUser with the default role
 	PrivilegeTypeValues
 	PrivilegeTypeUpgradeAccount
+	PrivilegeTypeEvent //include create/alter/drop event
 )
 
 type PrivilegeScope uint8
@@ -814,6 +815,8 @@ func (pt PrivilegeType) String() string {
 		return "execute"
 	case PrivilegeTypeValues:
 		return "values"
+	case PrivilegeTypeEvent:
+		return "event"
 	}
 	panic(fmt.Sprintf("no such privilege type %d", pt))
 }
@@ -870,6 +873,8 @@ func (pt PrivilegeType) Scope() PrivilegeScope {
 		return PrivilegeScopeDatabase
 	case PrivilegeTypeDatabaseOwnership:
 		return PrivilegeScopeDatabase
+	case PrivilegeTypeEvent:
+		return PrivilegeScopeDatabase
 	case PrivilegeTypeSelect:
 		return PrivilegeScopeTable
 	case PrivilegeTypeInsert:
@@ -968,6 +973,11 @@ var (
 		"mo_cdc_task":                 0,
 		"mo_cdc_watermark":            0,
 		catalog.MO_TABLE_STATS:        0,
+		catalog.MO_TRIGGERS:           0,
+		catalog.MO_EVENTS:             0,
+		catalog.MO_EVENT_LOG:          0,
+		catalog.MO_EVENT_HISTORY:      0,
+		catalog.MO_TTL:                0,
 	}
 	createDbInformationSchemaSql = "create database information_schema;"
 	createAutoTableSql           = MoCatalogMoAutoIncrTableDDL
@@ -1006,6 +1016,11 @@ var (
 		MoCatalogMoCdcWatermarkDDL,
 		MoCatalogMoDataKeyDDL,
 		MoCatalogMoTableStatsDDL,
+		MoCatalogMoTriggersDDL,
+		MoCatalogMoEventsDDL,
+		MoCatalogMoEventLogDDL,
+		MoCatalogMoEventHistoryDDL,
+		MoCatalogMoTtlDDL,
 	}
 
 	//drop tables for the tenant
@@ -1025,6 +1040,11 @@ var (
 		`drop view if exists mo_catalog.mo_transactions;`,
 		`drop view if exists mo_catalog.mo_cache;`,
 		`drop table if exists mo_catalog.mo_snapshots;`,
+		`drop table if exists mo_catalog.mo_triggers;`,
+		`drop view if exists mo_catalog.mo_event_history;`,
+		`drop table if exists mo_catalog.mo_events;`,
+		`drop table if exists mo_catalog.mo_event_log;`,
+		`drop table if exists mo_catalog.mo_ttl;`,
 	}
 	dropMoMysqlCompatibilityModeSql = `drop table if exists mo_catalog.mo_mysql_compatibility_mode;`
 	dropAutoIcrColSql               = fmt.Sprintf("drop table if exists mo_catalog.`%s`;", catalog.MOAutoIncrTable)
@@ -2174,6 +2194,7 @@ var (
 		PrivilegeTypeAlterView:         {PrivilegeTypeAlterView, privilegeLevelStar, objectTypeDatabase, objectIDAll, true, "", "", privilegeEntryTypeGeneral, nil},
 		PrivilegeTypeDatabaseAll:       {PrivilegeTypeDatabaseAll, privilegeLevelStar, objectTypeDatabase, objectIDAll, true, "", "", privilegeEntryTypeGeneral, nil},
 		PrivilegeTypeDatabaseOwnership: {PrivilegeTypeDatabaseOwnership, privilegeLevelStar, objectTypeDatabase, objectIDAll, true, "", "", privilegeEntryTypeGeneral, nil},
+		PrivilegeTypeEvent:             {PrivilegeTypeEvent, privilegeLevelStar, objectTypeDatabase, objectIDAll, true, "", "", privilegeEntryTypeGeneral, nil},
 		PrivilegeTypeSelect:            {PrivilegeTypeSelect, privilegeLevelStarStar, objectTypeTable, objectIDAll, true, "", "", privilegeEntryTypeGeneral, nil},
 		PrivilegeTypeInsert:            {PrivilegeTypeInsert, privilegeLevelStarStar, objectTypeTable, objectIDAll, true, "", "", privilegeEntryTypeGeneral, nil},
 		PrivilegeTypeUpdate:            {PrivilegeTypeUpdate, privilegeLevelStarStar, objectTypeTable, objectIDAll, true, "", "", privilegeEntryTypeGeneral, nil},
@@ -5402,6 +5423,42 @@ func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
 		objType = objectTypeDatabase
 		typs = append(typs, PrivilegeTypeCreateView, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
 		writeDatabaseAndTableDirectly = true
+	case *tree.CreateTrigger:
+		objType = objectTypeDatabase
+		typs = append(typs, PrivilegeTypeAlterTable, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
+		writeDatabaseAndTableDirectly = true
+		dbName = string(st.Table.SchemaName)
+		if dbName == "" && st.Name.NumParts > 1 {
+			dbName = st.Name.Parts[1]
+		}
+	case *tree.DropTrigger:
+		objType = objectTypeDatabase
+		typs = append(typs, PrivilegeTypeAlterTable, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
+		writeDatabaseAndTableDirectly = true
+		if st.Name.NumParts > 1 {
+			dbName = st.Name.Parts[1]
+		}
+	case *tree.CreateEvent:
+		objType = objectTypeDatabase
+		typs = append(typs, PrivilegeTypeEvent, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
+		writeDatabaseAndTableDirectly = true
+		if st.Name.NumParts > 1 {
+			dbName = st.Name.Parts[1]
+		}
+	case *tree.AlterEvent:
+		objType = objectTypeDatabase
+		typs = append(typs, PrivilegeTypeEvent, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
+		writeDatabaseAndTableDirectly = true
+		if st.Name.NumParts > 1 {
+			dbName = st.Name.Parts[1]
+		}
+	case *tree.DropEvent:
+		objType = objectTypeDatabase
+		typs = append(typs, PrivilegeTypeEvent, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
+		writeDatabaseAndTableDirectly = true
+		if st.Name.NumParts > 1 {
+			dbName = st.Name.Parts[1]
+		}
 	case *tree.CallStmt: // TODO: redesign privilege for calling a procedure
 		objType = objectTypeDatabase
 		typs = append(typs, PrivilegeTypeCreateView, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
@@ -5463,6 +5520,22 @@ func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
 			},
 		}
 
+		extraEntries = append(extraEntries, entry1)
+		writeDatabaseAndTableDirectly = true
+	case *tree.Merge:
+		objType = objectTypeTable
+		typs = append(typs, PrivilegeTypeTableAll, PrivilegeTypeTableOwnership)
+		entry1 := privilegeEntry{
+			privilegeEntryTyp: privilegeEntryTypeCompound,
+			compound: &compoundEntry{
+				items: []privilegeItem{
+					{privilegeTyp: PrivilegeTypeInsert},
+					{privilegeTyp: PrivilegeTypeUpdate},
+					{privilegeTyp: PrivilegeTypeDelete},
+				},
+			},
+		}
+
 		extraEntries = append(extraEntries, entry1)
 		writeDatabaseAndTableDirectly = true
 	case *tree.Load:
@@ -5573,6 +5646,11 @@ func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
 		if st.Name != nil {
 			dbName = string(st.Name.SchemaName)
 		}
+	case *tree.RefreshMaterializedView:
+		objType = objectTypeTable
+		typs = append(typs, PrivilegeTypeInsert, PrivilegeTypeDelete, PrivilegeTypeTableAll, PrivilegeTypeTableOwnership)
+		writeDatabaseAndTableDirectly = true
+		dbName = string(st.Name.SchemaName)
 	case *tree.MoDump:
 		objType = objectTypeTable
 		typs = append(typs, PrivilegeTypeSelect, PrivilegeTypeTableAll, PrivilegeTypeTableOwnership)
@@ -6881,6 +6959,8 @@ func getSqlForCheckRoleHasPrivilegeWGODependsOnPrivType(privType PrivilegeType)
 		return getSqlForCheckRoleHasPrivilegeWGOOrWithOwnerShip(int64(privType), int64(PrivilegeTypeDatabaseAll), int64(PrivilegeTypeDatabaseOwnership))
 	case PrivilegeTypeDatabaseOwnership:
 		return getSqlForCheckRoleHasPrivilegeWGO(int64(privType))
+	case PrivilegeTypeEvent:
+		return getSqlForCheckRoleHasPrivilegeWGOOrWithOwnerShip(int64(privType), int64(PrivilegeTypeDatabaseAll), int64(PrivilegeTypeDatabaseOwnership))
 
 	// table level privileges
 	case PrivilegeTypeSelect:
@@ -7140,6 +7220,8 @@ func convertAstPrivilegeTypeToPrivilegeType(ctx context.Context, priv tree.Privi
 		privType = PrivilegeTypeReference
 	case tree.PRIVILEGE_TYPE_STATIC_VALUES:
 		privType = PrivilegeTypeValues
+	case tree.PRIVILEGE_TYPE_STATIC_EVENT:
+		privType = PrivilegeTypeEvent
 	default:
 		return 0, moerr.NewInternalErrorf(ctx, "unsupported privilege type %s", priv.ToString())
 	}
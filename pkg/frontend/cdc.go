@@ -354,16 +354,25 @@ func doCreateCdc(ctx context.Context, ses *Session, create *tree.CreateCDC) (err
 		useConsole = true
 	}
 
-	if !useConsole && sinkType != cdc2.MysqlSink && sinkType != cdc2.MatrixoneSink {
+	if !useConsole && sinkType != cdc2.MysqlSink && sinkType != cdc2.MatrixoneSink && sinkType != cdc2.KafkaSink {
 		return moerr.NewInternalErrorf(ctx, "unsupported sink type: %s", create.SinkType)
 	}
 
 	//step 5: check downstream connectivity
-	jsonSinkUri, sinkUriInfo, err := extractUriInfo(ctx, create.SinkUri, cdc2.SinkUriPrefix)
+	sinkUriPrefix := cdc2.SinkUriPrefix
+	if sinkType == cdc2.KafkaSink {
+		sinkUriPrefix = cdc2.KafkaUriPrefix
+	}
+	jsonSinkUri, sinkUriInfo, err := extractUriInfo(ctx, create.SinkUri, sinkUriPrefix)
 	if err != nil {
 		return
 	}
-	if _, err = cdc2.OpenDbConn(sinkUriInfo.User, sinkUriInfo.Password, sinkUriInfo.Ip, sinkUriInfo.Port, cdc2.DefaultSendSqlTimeout); err != nil {
+	if sinkType == cdc2.KafkaSink {
+		err = cdc2.CheckKafkaConn(sinkUriInfo)
+	} else {
+		_, err = cdc2.OpenDbConn(sinkUriInfo.User, sinkUriInfo.Password, sinkUriInfo.Ip, sinkUriInfo.Port, cdc2.DefaultSendSqlTimeout)
+	}
+	if err != nil {
 		err = moerr.NewInternalErrorf(ctx, "failed to connect to sink, please check the connection, err: %v", err)
 		return
 	}
@@ -0,0 +1,529 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+const (
+	eventTypeOneTime   = "ONE TIME"
+	eventTypeRecurring = "RECURRING"
+
+	eventStatusEnabled  = "ENABLED"
+	eventStatusDisabled = "DISABLED"
+)
+
+const (
+	checkEventFormat  = `select event_name from mo_catalog.mo_events where database_id = %d and event_name = '%s';`
+	insertEventFormat = `insert into mo_catalog.mo_events values ('%s', %d, '%s', '%s', '%s', %s, %s, %s, %s, %s, '%s', '%s', now(), now(), null, %s, '%s');`
+	deleteEventFormat = `delete from mo_catalog.mo_events where database_id = %d and event_name = '%s';`
+	// the columns of mo_events which ALTER EVENT may change
+	selectEventForAlterFormat = `select event_type, ifnull(cast(unix_timestamp(execute_at) as bigint), 0), ifnull(interval_value, 0), ifnull(interval_field, ''), ` +
+		`ifnull(cast(unix_timestamp(starts) as bigint), 0), ifnull(cast(unix_timestamp(ends) as bigint), 0), status, on_completion ` +
+		`from mo_catalog.mo_events where database_id = %d and event_name = '%s';`
+	deleteEventLogFormat = `delete from mo_catalog.mo_event_log where database_id = %d and event_name = '%s';`
+	renameEventLogFormat = `update mo_catalog.mo_event_log set database_id = %d, event_name = '%s' where database_id = %d and event_name = '%s';`
+	evalEventTimeFormat  = `select cast(unix_timestamp(%s) as bigint);`
+	evalEventIntervalFmt = `select cast(%s as bigint);`
+)
+
+var eventStringEscaper = strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+
+// eventMonths is the number of months of the interval fields which do not have a fixed duration
+var eventMonths = map[string]int64{
+	"YEAR":    12,
+	"QUARTER": 3,
+	"MONTH":   1,
+}
+
+// eventDurations is the duration of the interval fields which have a fixed duration
+var eventDurations = map[string]time.Duration{
+	"WEEK":   7 * 24 * time.Hour,
+	"DAY":    24 * time.Hour,
+	"HOUR":   time.Hour,
+	"MINUTE": time.Minute,
+	"SECOND": time.Second,
+}
+
+// eventSchedule is the evaluated ON SCHEDULE clause, the times are unix seconds and zero means not set.
+type eventSchedule struct {
+	eventType string
+	executeAt int64
+	interval  int64
+	field     string
+	starts    int64
+	ends      int64
+}
+
+// normalizeEventIntervalField returns the interval field stored in mo_events for the time unit of EVERY.
+func normalizeEventIntervalField(ctx context.Context, unit string) (string, error) {
+	field := strings.TrimPrefix(strings.ToUpper(unit), "SQL_TSI_")
+	if _, ok := eventMonths[field]; ok {
+		return field, nil
+	}
+	if _, ok := eventDurations[field]; ok {
+		return field, nil
+	}
+	return "", moerr.NewNotSupportedf(ctx, "event interval field %s", unit)
+}
+
+// nextEventTime returns the first execution time of the recurring schedule which is after now,
+// the executions are at starts, starts + interval, starts + 2 * interval and so on.
+func nextEventTime(starts, now time.Time, interval int64, field string) time.Time {
+	if starts.After(now) {
+		return starts
+	}
+	if months, ok := eventMonths[field]; ok {
+		step := int(months * interval)
+		diff := (now.Year()-starts.Year())*12 + int(now.Month()) - int(starts.Month())
+		// AddDate normalizes the overflowed days, e.g. Jan 31 + 1 month is Mar 2, so
+		// start one step earlier to not skip it
+		n := max(diff/step-1, 0)
+		next := starts.AddDate(0, n*step, 0)
+		for !next.After(now) {
+			n++
+			next = starts.AddDate(0, n*step, 0)
+		}
+		return next
+	}
+	step := eventDurations[field] * time.Duration(interval)
+	return starts.Add((now.Sub(starts)/step + 1) * step)
+}
+
+// nextExecuteAt returns the next execution time of the schedule after now,
+// false is returned if the event will not be executed any more.
+func (s *eventSchedule) nextExecuteAt(now time.Time) (int64, bool) {
+	if s.eventType == eventTypeOneTime {
+		return s.executeAt, s.executeAt >= now.Unix()
+	}
+	starts := time.Unix(s.starts, 0)
+	if s.starts >= now.Unix() {
+		return s.starts, s.ends == 0 || s.starts <= s.ends
+	}
+	next := nextEventTime(starts, now, s.interval, s.field).Unix()
+	return next, s.ends == 0 || next <= s.ends
+}
+
+// eventTimeValue returns the sql value of the unix seconds which is stored in a timestamp column of mo_events.
+func eventTimeValue(t int64) string {
+	if t == 0 {
+		return "null"
+	}
+	return fmt.Sprintf("from_unixtime(%d)", t)
+}
+
+func evalEventInt64(ctx context.Context, bh BackgroundExec, format string, expr tree.Expr) (int64, bool, error) {
+	sql := fmt.Sprintf(format, tree.StringWithOpts(expr, dialect.MYSQL, tree.WithSingleQuoteString()))
+	bh.ClearExecResultSet()
+	if err := bh.Exec(ctx, sql); err != nil {
+		return 0, false, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return 0, false, err
+	}
+	if !execResultArrayHasData(erArray) {
+		return 0, false, nil
+	}
+	isNull, err := erArray[0].ColumnIsNull(ctx, 0, 0)
+	if err != nil || isNull {
+		return 0, false, err
+	}
+	v, err := erArray[0].GetInt64(ctx, 0, 0)
+	return v, err == nil, err
+}
+
+// evalEventSchedule evaluates the expressions of the ON SCHEDULE clause in the session.
+func evalEventSchedule(ctx context.Context, bh BackgroundExec, schedule *tree.EventSchedule) (*eventSchedule, error) {
+	evalTime := func(expr tree.Expr) (int64, error) {
+		t, ok, err := evalEventInt64(ctx, bh, evalEventTimeFormat, expr)
+		if err != nil {
+			return 0, err
+		}
+		if !ok || t <= 0 {
+			return 0, moerr.NewInvalidInputf(ctx, "incorrect event time value '%s'", tree.String(expr, dialect.MYSQL))
+		}
+		return t, nil
+	}
+
+	var err error
+	s := &eventSchedule{}
+	if schedule.At != nil {
+		s.eventType = eventTypeOneTime
+		if s.executeAt, err = evalTime(schedule.At); err != nil {
+			return nil, err
+		}
+		return s, nil
+	}
+
+	s.eventType = eventTypeRecurring
+	if s.field, err = normalizeEventIntervalField(ctx, schedule.Unit); err != nil {
+		return nil, err
+	}
+	interval, ok, err := evalEventInt64(ctx, bh, evalEventIntervalFmt, schedule.Interval)
+	if err != nil {
+		return nil, err
+	}
+	if !ok || interval <= 0 || interval > 1000000 {
+		return nil, moerr.NewEventInvalidInterval(ctx)
+	}
+	s.interval = interval
+	// the recurring event starts when it is created if STARTS is not specified
+	s.starts = time.Now().Unix()
+	if schedule.Starts != nil {
+		if s.starts, err = evalTime(schedule.Starts); err != nil {
+			return nil, err
+		}
+	}
+	if schedule.Ends != nil {
+		if s.ends, err = evalTime(schedule.Ends); err != nil {
+			return nil, err
+		}
+		if s.ends < s.starts {
+			return nil, moerr.NewEventInvalidEnds(ctx)
+		}
+	}
+	return s, nil
+}
+
+// getEventName returns the database and the name of the event, the database is
+// the current database if it is not specified.
+func getEventName(ctx context.Context, ses *Session, name *tree.UnresolvedObjectName) (string, string, error) {
+	eventName := name.Parts[0]
+	dbName := ses.GetDatabaseName()
+	if name.NumParts > 1 {
+		dbName = name.Parts[1]
+	}
+	if dbName == "" {
+		return "", "", moerr.NewNoDB(ctx)
+	}
+	if err := inputNameIsInvalid(ctx, eventName, dbName); err != nil {
+		return "", "", err
+	}
+	return dbName, eventName, nil
+}
+
+func checkEventExists(ctx context.Context, bh BackgroundExec, dbId int64, eventName string) (bool, error) {
+	bh.ClearExecResultSet()
+	if err := bh.Exec(ctx, fmt.Sprintf(checkEventFormat, dbId, eventName)); err != nil {
+		return false, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return false, err
+	}
+	return execResultArrayHasData(erArray), nil
+}
+
+// getEventDefiner returns the definer of the event, which is the current user if not specified.
+// The body of the event runs as the definer, so only the admin can specify another user as the definer.
+func getEventDefiner(ctx context.Context, ses *Session, definer *tree.UsernameRecord) (string, error) {
+	tenant := ses.GetTenantInfo()
+	if definer == nil {
+		return tenant.GetUser() + "@%", nil
+	}
+	if definer.Username != tenant.GetUser() && !tenant.IsAdminRole() {
+		return "", moerr.NewInternalErrorf(ctx, "only the admin can create or alter the event with the definer '%s'", definer.Username)
+	}
+	return definer.Username + "@" + definer.Hostname, nil
+}
+
+func getEventBody(body tree.Statement) string {
+	return tree.StringWithOpts(body, dialect.MYSQL, tree.WithSingleQuoteString())
+}
+
+// getEventStatus returns the status stored in mo_events, the event which will not be executed any more is disabled.
+func getEventStatus(status tree.EventStatus, hasNext bool) string {
+	if status == tree.EVENT_STATUS_DISABLE || !hasNext {
+		return eventStatusDisabled
+	}
+	return eventStatusEnabled
+}
+
+func doCreateEvent(ctx context.Context, ses *Session, stmt *tree.CreateEvent) (err error) {
+	dbName, eventName, err := getEventName(ctx, ses, stmt.Name)
+	if err != nil {
+		return err
+	}
+	definer, err := getEventDefiner(ctx, ses, stmt.Definer)
+	if err != nil {
+		return err
+	}
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	err = bh.Exec(ctx, "begin;")
+	defer func() {
+		err = finishTxn(ctx, bh, err)
+	}()
+	if err != nil {
+		return err
+	}
+
+	dbId, err := getDatabaseOrTableId(ctx, bh, true, dbName, "")
+	if err != nil {
+		return err
+	}
+	exists, err := checkEventExists(ctx, bh, dbId, eventName)
+	if err != nil {
+		return err
+	}
+	if exists {
+		if stmt.IfNotExists {
+			return nil
+		}
+		return moerr.NewEventAlreadyExists(ctx, eventName)
+	}
+
+	schedule, err := evalEventSchedule(ctx, bh, stmt.Schedule)
+	if err != nil {
+		return err
+	}
+	completion := stmt.Completion
+	if completion == tree.EVENT_COMPLETION_UNSPECIFIED {
+		completion = tree.EVENT_COMPLETION_NOT_PRESERVE
+	}
+	nextAt, hasNext := schedule.nextExecuteAt(time.Now())
+	if !hasNext && completion == tree.EVENT_COMPLETION_NOT_PRESERVE {
+		// the event would be dropped immediately after it is created
+		return moerr.NewEventInThePast(ctx)
+	}
+
+	comment := ""
+	if stmt.Comment != nil {
+		comment = *stmt.Comment
+	}
+	nextValue := "null"
+	if hasNext {
+		nextValue = eventTimeValue(nextAt)
+	}
+	intervalValue, intervalField := "null", "null"
+	if schedule.eventType == eventTypeRecurring {
+		intervalValue = fmt.Sprintf("%d", schedule.interval)
+		intervalField = "'" + schedule.field + "'"
+	}
+	sql := fmt.Sprintf(insertEventFormat,
+		eventName,
+		dbId,
+		eventStringEscaper.Replace(definer),
+		eventStringEscaper.Replace(getEventBody(stmt.Body)),
+		schedule.eventType,
+		eventTimeValue(schedule.executeAt),
+		intervalValue,
+		intervalField,
+		eventTimeValue(schedule.starts),
+		eventTimeValue(schedule.ends),
+		getEventStatus(stmt.Status, hasNext),
+		completion.String(),
+		nextValue,
+		eventStringEscaper.Replace(comment),
+	)
+	return bh.Exec(ctx, sql)
+}
+
+func doAlterEvent(ctx context.Context, ses *Session, stmt *tree.AlterEvent) (err error) {
+	if stmt.Definer == nil && stmt.Schedule == nil && stmt.Completion == tree.EVENT_COMPLETION_UNSPECIFIED &&
+		stmt.Rename == nil && stmt.Status == tree.EVENT_STATUS_UNSPECIFIED && stmt.Comment == nil && stmt.Body == nil {
+		return moerr.NewSyntaxError(ctx, "ALTER EVENT needs at least one clause")
+	}
+	dbName, eventName, err := getEventName(ctx, ses, stmt.Name)
+	if err != nil {
+		return err
+	}
+	definer, err := getEventDefiner(ctx, ses, stmt.Definer)
+	if err != nil {
+		return err
+	}
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	err = bh.Exec(ctx, "begin;")
+	defer func() {
+		err = finishTxn(ctx, bh, err)
+	}()
+	if err != nil {
+		return err
+	}
+
+	dbId, err := getDatabaseOrTableId(ctx, bh, true, dbName, "")
+	if err != nil {
+		return err
+	}
+
+	// the clauses which are not specified keep the old values
+	bh.ClearExecResultSet()
+	if err = bh.Exec(ctx, fmt.Sprintf(selectEventForAlterFormat, dbId, eventName)); err != nil {
+		return err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return err
+	}
+	if !execResultArrayHasData(erArray) {
+		return moerr.NewEventNotExist(ctx, eventName)
+	}
+	schedule := &eventSchedule{}
+	var oldStatus, oldCompletion string
+	if schedule.eventType, err = erArray[0].GetString(ctx, 0, 0); err != nil {
+		return err
+	}
+	if schedule.executeAt, err = erArray[0].GetInt64(ctx, 0, 1); err != nil {
+		return err
+	}
+	if schedule.interval, err = erArray[0].GetInt64(ctx, 0, 2); err != nil {
+		return err
+	}
+	if schedule.field, err = erArray[0].GetString(ctx, 0, 3); err != nil {
+		return err
+	}
+	if schedule.starts, err = erArray[0].GetInt64(ctx, 0, 4); err != nil {
+		return err
+	}
+	if schedule.ends, err = erArray[0].GetInt64(ctx, 0, 5); err != nil {
+		return err
+	}
+	if oldStatus, err = erArray[0].GetString(ctx, 0, 6); err != nil {
+		return err
+	}
+	if oldCompletion, err = erArray[0].GetString(ctx, 0, 7); err != nil {
+		return err
+	}
+
+	if stmt.Schedule != nil {
+		if schedule, err = evalEventSchedule(ctx, bh, stmt.Schedule); err != nil {
+			return err
+		}
+	}
+	completion := oldCompletion
+	if stmt.Completion != tree.EVENT_COMPLETION_UNSPECIFIED {
+		completion = stmt.Completion.String()
+	}
+	status := stmt.Status
+	if status == tree.EVENT_STATUS_UNSPECIFIED && oldStatus == eventStatusDisabled {
+		status = tree.EVENT_STATUS_DISABLE
+	}
+	nextAt, hasNext := schedule.nextExecuteAt(time.Now())
+	if !hasNext && stmt.Schedule != nil && completion == tree.EVENT_COMPLETION_NOT_PRESERVE.String() {
+		return moerr.NewEventInThePast(ctx)
+	}
+
+	sets := []string{
+		"last_altered = now()",
+		fmt.Sprintf("status = '%s'", getEventStatus(status, hasNext)),
+		fmt.Sprintf("on_completion = '%s'", completion),
+	}
+	if hasNext {
+		sets = append(sets, fmt.Sprintf("next_execute_at = %s", eventTimeValue(nextAt)))
+	}
+	if stmt.Schedule != nil {
+		intervalValue, intervalField := "null", "null"
+		if schedule.eventType == eventTypeRecurring {
+			intervalValue = fmt.Sprintf("%d", schedule.interval)
+			intervalField = "'" + schedule.field + "'"
+		}
+		sets = append(sets,
+			fmt.Sprintf("event_type = '%s'", schedule.eventType),
+			fmt.Sprintf("execute_at = %s", eventTimeValue(schedule.executeAt)),
+			fmt.Sprintf("interval_value = %s", intervalValue),
+			fmt.Sprintf("interval_field = %s", intervalField),
+			fmt.Sprintf("starts = %s", eventTimeValue(schedule.starts)),
+			fmt.Sprintf("ends = %s", eventTimeValue(schedule.ends)),
+		)
+	}
+	// the event runs as the user who altered it last, like mysql
+	sets = append(sets, fmt.Sprintf("definer = '%s'", eventStringEscaper.Replace(definer)))
+	if stmt.Comment != nil {
+		sets = append(sets, fmt.Sprintf("event_comment = '%s'", eventStringEscaper.Replace(*stmt.Comment)))
+	}
+	if stmt.Body != nil {
+		sets = append(sets, fmt.Sprintf("event_body = '%s'", eventStringEscaper.Replace(getEventBody(stmt.Body))))
+	}
+	if stmt.Rename != nil {
+		newDbName, newEventName, err := getEventName(ctx, ses, stmt.Rename)
+		if err != nil {
+			return err
+		}
+		newDbId := dbId
+		if newDbName != dbName {
+			if newDbId, err = getDatabaseOrTableId(ctx, bh, true, newDbName, ""); err != nil {
+				return err
+			}
+		}
+		if newDbId != dbId || newEventName != eventName {
+			exists, err := checkEventExists(ctx, bh, newDbId, newEventName)
+			if err != nil {
+				return err
+			}
+			if exists {
+				return moerr.NewEventAlreadyExists(ctx, newEventName)
+			}
+			sets = append(sets, fmt.Sprintf("database_id = %d", newDbId), fmt.Sprintf("event_name = '%s'", newEventName))
+			if err = bh.Exec(ctx, fmt.Sprintf(renameEventLogFormat, newDbId, newEventName, dbId, eventName)); err != nil {
+				return err
+			}
+		}
+	}
+
+	sql := fmt.Sprintf("update mo_catalog.mo_events set %s where database_id = %d and event_name = '%s';",
+		strings.Join(sets, ", "), dbId, eventName)
+	return bh.Exec(ctx, sql)
+}
+
+func doDropEvent(ctx context.Context, ses *Session, stmt *tree.DropEvent) (err error) {
+	dbName, eventName, err := getEventName(ctx, ses, stmt.Name)
+	if err != nil {
+		return err
+	}
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	err = bh.Exec(ctx, "begin;")
+	defer func() {
+		err = finishTxn(ctx, bh, err)
+	}()
+	if err != nil {
+		return err
+	}
+
+	dbId, err := getDatabaseOrTableId(ctx, bh, true, dbName, "")
+	if err != nil {
+		return err
+	}
+	exists, err := checkEventExists(ctx, bh, dbId, eventName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		if stmt.IfExists {
+			return nil
+		}
+		return moerr.NewEventNotExist(ctx, eventName)
+	}
+	if err = bh.Exec(ctx, fmt.Sprintf(deleteEventFormat, dbId, eventName)); err != nil {
+		return err
+	}
+	return bh.Exec(ctx, fmt.Sprintf(deleteEventLogFormat, dbId, eventName))
+}
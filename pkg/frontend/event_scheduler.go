@@ -0,0 +1,281 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/task"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/util/executor"
+	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
+)
+
+const (
+	eventRunSuccess = "SUCCESS"
+	eventRunFailed  = "FAILED"
+
+	// eventLogRetention is how long the run history of the events is kept in mo_event_log
+	eventLogRetention = 7 * 24 * time.Hour
+	// eventErrorMaxLen is the max length of the error message kept in mo_event_log
+	eventErrorMaxLen = 1024
+)
+
+const (
+	selectEventAccountsSql = `select account_id from mo_catalog.mo_account where status != 'suspend';`
+	selectDueEventsFormat  = `select e.database_id, d.datname, e.event_name, e.event_body, e.event_type, ` +
+		`ifnull(e.interval_value, 0), ifnull(e.interval_field, ''), ` +
+		`ifnull(cast(unix_timestamp(e.starts) as bigint), 0), ifnull(cast(unix_timestamp(e.ends) as bigint), 0), e.on_completion, e.definer ` +
+		`from mo_catalog.mo_events e join mo_catalog.mo_database d on e.database_id = d.dat_id ` +
+		`where e.status = 'ENABLED' and e.next_execute_at <= now() and d.account_id = %d;`
+	selectEventDefinerFormat = `select u.user_id, r.role_id, r.role_name from mo_catalog.mo_user u ` +
+		`join mo_catalog.mo_role r on u.default_role = r.role_id where u.user_name = '%s';`
+	insertEventLogFormat  = `insert into mo_catalog.mo_event_log values (%d, '%s', from_unixtime(%d), from_unixtime(%d), '%s', %d, '%s');`
+	rescheduleEventFormat = `update mo_catalog.mo_events set last_executed = from_unixtime(%d), next_execute_at = from_unixtime(%d) where database_id = %d and event_name = '%s';`
+	disableEventFormat    = `update mo_catalog.mo_events set last_executed = from_unixtime(%d), status = 'DISABLED' where database_id = %d and event_name = '%s';`
+	pruneEventLogFormat   = `delete from mo_catalog.mo_event_log where started_at < from_unixtime(%d);`
+)
+
+// dueEvent is the event whose next execution time has come
+type dueEvent struct {
+	databaseId uint64
+	database   string
+	name       string
+	body       string
+	completion string
+	definer    string
+	schedule   eventSchedule
+}
+
+// eventDefiner is the user the body of the event runs as, with its default role
+type eventDefiner struct {
+	userId   uint32
+	roleId   uint32
+	user     string
+	roleName string
+}
+
+// EventSchedulerExecutor returns the executor of the cron task TaskCode_EventScheduler, which
+// runs the due events of all the accounts. The cron task runs every minute, so the events are
+// executed with the granularity of one minute. The bodies of the events are executed by the
+// internal executors of ieFactory as their definers, so the privileges of the definers are checked.
+func EventSchedulerExecutor(
+	sqlExecutor executor.SQLExecutor,
+	ieFactory func() ie.InternalExecutor,
+) func(ctx context.Context, task task.Task) error {
+	return func(ctx context.Context, _ task.Task) error {
+		result, err := sqlExecutor.Exec(ctx, selectEventAccountsSql, executor.Options{}.WithWaitCommittedLogApplied())
+		if err != nil {
+			return err
+		}
+		accounts := make([]int32, 0)
+		result.ReadRows(func(rows int, cols []*vector.Vector) bool {
+			accounts = append(accounts, vector.MustFixedColWithTypeCheck[int32](cols[0])...)
+			return true
+		})
+		result.Close()
+
+		// the failure of one account does not stop the events of the others
+		for _, accountId := range accounts {
+			if err = runDueEvents(ctx, sqlExecutor, ieFactory, uint32(accountId)); err != nil {
+				logutil.Error("failed to run the events of the account",
+					zap.Int32("account", accountId),
+					zap.Error(err))
+			}
+		}
+		return nil
+	}
+}
+
+func runDueEvents(
+	ctx context.Context,
+	sqlExecutor executor.SQLExecutor,
+	ieFactory func() ie.InternalExecutor,
+	accountId uint32,
+) error {
+	opts := executor.Options{}.WithAccountID(accountId).WithDisableTrace()
+	result, err := sqlExecutor.Exec(ctx, fmt.Sprintf(selectDueEventsFormat, accountId), opts)
+	if err != nil {
+		return err
+	}
+	events := make([]dueEvent, 0)
+	result.ReadRows(func(rows int, cols []*vector.Vector) bool {
+		for i := 0; i < rows; i++ {
+			events = append(events, dueEvent{
+				databaseId: vector.GetFixedAtNoTypeCheck[uint64](cols[0], i),
+				database:   cols[1].GetStringAt(i),
+				name:       cols[2].GetStringAt(i),
+				body:       cols[3].GetStringAt(i),
+				completion: cols[9].GetStringAt(i),
+				definer:    cols[10].GetStringAt(i),
+				schedule: eventSchedule{
+					eventType: cols[4].GetStringAt(i),
+					interval:  vector.GetFixedAtNoTypeCheck[int64](cols[5], i),
+					field:     cols[6].GetStringAt(i),
+					starts:    vector.GetFixedAtNoTypeCheck[int64](cols[7], i),
+					ends:      vector.GetFixedAtNoTypeCheck[int64](cols[8], i),
+				},
+			})
+		}
+		return true
+	})
+	result.Close()
+
+	for _, event := range events {
+		if err = runEvent(ctx, sqlExecutor, ieFactory, accountId, event); err != nil {
+			return err
+		}
+	}
+	if len(events) == 0 {
+		return nil
+	}
+	res, err := sqlExecutor.Exec(ctx, fmt.Sprintf(pruneEventLogFormat, time.Now().Add(-eventLogRetention).Unix()), opts)
+	if err != nil {
+		return err
+	}
+	res.Close()
+	return nil
+}
+
+// runEvent runs the body of the event in the database of the event as its definer, then records the run in
+// mo_event_log and schedules the next run. The event which will not run any more is disabled if it is
+// ON COMPLETION PRESERVE, or dropped otherwise.
+func runEvent(
+	ctx context.Context,
+	sqlExecutor executor.SQLExecutor,
+	ieFactory func() ie.InternalExecutor,
+	accountId uint32,
+	event dueEvent,
+) error {
+	started := time.Now()
+	status, errMsg := eventRunSuccess, ""
+	affectedRows, err := runEventBody(ctx, sqlExecutor, ieFactory, accountId, event)
+	if err != nil {
+		status, errMsg = eventRunFailed, err.Error()
+		if len(errMsg) > eventErrorMaxLen {
+			errMsg = errMsg[:eventErrorMaxLen]
+		}
+	}
+	finished := time.Now()
+
+	hasNext := false
+	var nextAt int64
+	if event.schedule.eventType == eventTypeRecurring {
+		nextAt, hasNext = event.schedule.nextExecuteAt(finished)
+	}
+	return sqlExecutor.ExecTxn(ctx, func(txn executor.TxnExecutor) error {
+		sqls := []string{
+			fmt.Sprintf(insertEventLogFormat, event.databaseId, event.name, started.Unix(), finished.Unix(),
+				status, affectedRows, eventStringEscaper.Replace(errMsg)),
+		}
+		switch {
+		case hasNext:
+			sqls = append(sqls, fmt.Sprintf(rescheduleEventFormat, started.Unix(), nextAt, event.databaseId, event.name))
+		case event.completion == tree.EVENT_COMPLETION_PRESERVE.String():
+			sqls = append(sqls, fmt.Sprintf(disableEventFormat, started.Unix(), event.databaseId, event.name))
+		default:
+			sqls = append(sqls, fmt.Sprintf(deleteEventFormat, event.databaseId, event.name))
+		}
+		for _, sql := range sqls {
+			res, err := txn.Exec(sql, executor.StatementOption{})
+			if err != nil {
+				return err
+			}
+			res.Close()
+		}
+		return nil
+	}, executor.Options{}.WithAccountID(accountId).WithDisableTrace())
+}
+
+// runEventBody executes the body of the event as the definer with its default role. The privileges of the
+// body are checked against the definer like the statements of the clients, so the event can do nothing
+// more than its definer.
+func runEventBody(
+	ctx context.Context,
+	sqlExecutor executor.SQLExecutor,
+	ieFactory func() ie.InternalExecutor,
+	accountId uint32,
+	event dueEvent,
+) (uint64, error) {
+	definer, err := getEventDefinerOfAccount(ctx, sqlExecutor, accountId, event.definer)
+	if err != nil {
+		return 0, err
+	}
+	opts := ie.NewOptsBuilder().
+		Database(event.database).
+		Internal(false).
+		AccountId(accountId).
+		UserId(definer.userId).
+		DefaultRoleId(definer.roleId).
+		User(definer.user).
+		DefaultRole(definer.roleName).
+		Finish()
+	res := ieFactory().Query(defines.AttachAccount(ctx, accountId, definer.userId, definer.roleId), event.body, opts)
+	if err = res.Error(); err != nil {
+		return 0, err
+	}
+	if r, ok := res.(*internalExecResult); ok {
+		return r.affectedRows, nil
+	}
+	return 0, nil
+}
+
+// getEventDefinerOfAccount finds the user of the definer 'user@host' of the event in the account.
+func getEventDefinerOfAccount(
+	ctx context.Context,
+	sqlExecutor executor.SQLExecutor,
+	accountId uint32,
+	definer string,
+) (eventDefiner, error) {
+	user := splitEventDefiner(definer)
+	res, err := sqlExecutor.Exec(ctx, fmt.Sprintf(selectEventDefinerFormat, eventStringEscaper.Replace(user)),
+		executor.Options{}.WithAccountID(accountId).WithDisableTrace())
+	if err != nil {
+		return eventDefiner{}, err
+	}
+	defer res.Close()
+	found := false
+	ret := eventDefiner{user: user}
+	res.ReadRows(func(rows int, cols []*vector.Vector) bool {
+		if rows > 0 {
+			found = true
+			ret.userId = uint32(vector.GetFixedAtNoTypeCheck[int32](cols[0], 0))
+			ret.roleId = uint32(vector.GetFixedAtNoTypeCheck[int32](cols[1], 0))
+			ret.roleName = cols[2].GetStringAt(0)
+		}
+		return false
+	})
+	if !found {
+		return eventDefiner{}, moerr.NewInternalErrorf(ctx, "the definer '%s' of the event does not exist", definer)
+	}
+	return ret, nil
+}
+
+// splitEventDefiner returns the user name of the definer 'user@host'.
+func splitEventDefiner(definer string) string {
+	if i := strings.LastIndexByte(definer, '@'); i >= 0 {
+		return definer[:i]
+	}
+	return definer
+}
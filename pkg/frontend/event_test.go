@@ -0,0 +1,160 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+func TestNormalizeEventIntervalField(t *testing.T) {
+	ctx := context.Background()
+	for unit, expected := range map[string]string{
+		"day":         "DAY",
+		"SQL_TSI_DAY": "DAY",
+		"Quarter":     "QUARTER",
+		"minute":      "MINUTE",
+	} {
+		field, err := normalizeEventIntervalField(ctx, unit)
+		require.NoError(t, err)
+		assert.Equal(t, expected, field)
+	}
+
+	for _, unit := range []string{"microsecond", "day_hour", "year_month"} {
+		_, err := normalizeEventIntervalField(ctx, unit)
+		assert.Error(t, err, unit)
+	}
+}
+
+func TestNextEventTime(t *testing.T) {
+	date := func(s string) time.Time {
+		d, err := time.Parse(time.DateTime, s)
+		require.NoError(t, err)
+		return d
+	}
+	tests := []struct {
+		starts   string
+		now      string
+		interval int64
+		field    string
+		expected string
+	}{
+		{"2024-01-01 00:00:00", "2023-12-01 00:00:00", 1, "DAY", "2024-01-01 00:00:00"},
+		{"2024-01-01 00:00:00", "2024-01-01 00:00:00", 1, "DAY", "2024-01-02 00:00:00"},
+		{"2024-01-01 00:00:00", "2024-01-05 12:00:00", 2, "DAY", "2024-01-07 00:00:00"},
+		{"2024-01-01 00:10:00", "2024-01-01 03:00:00", 30, "MINUTE", "2024-01-01 03:10:00"},
+		{"2024-01-01 00:00:00", "2024-01-20 00:00:00", 1, "WEEK", "2024-01-22 00:00:00"},
+		{"2024-01-31 08:00:00", "2024-03-01 00:00:00", 1, "MONTH", "2024-03-02 08:00:00"},
+		{"2024-01-15 08:00:00", "2024-03-15 09:00:00", 1, "MONTH", "2024-04-15 08:00:00"},
+		{"2024-01-15 08:00:00", "2024-03-15 07:00:00", 1, "QUARTER", "2024-04-15 08:00:00"},
+		{"2020-02-29 00:00:00", "2024-06-01 00:00:00", 1, "YEAR", "2025-03-01 00:00:00"},
+	}
+	for _, test := range tests {
+		next := nextEventTime(date(test.starts), date(test.now), test.interval, test.field)
+		assert.Equal(t, date(test.expected), next, "starts %s now %s every %d %s", test.starts, test.now, test.interval, test.field)
+	}
+}
+
+func TestEventScheduleNextExecuteAt(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+
+	oneTime := &eventSchedule{eventType: eventTypeOneTime, executeAt: now.Unix() + 60}
+	next, ok := oneTime.nextExecuteAt(now)
+	assert.True(t, ok)
+	assert.Equal(t, now.Unix()+60, next)
+	_, ok = oneTime.nextExecuteAt(now.Add(time.Hour))
+	assert.False(t, ok)
+
+	recurring := &eventSchedule{
+		eventType: eventTypeRecurring,
+		interval:  1,
+		field:     "HOUR",
+		starts:    now.Unix() - 90*60,
+		ends:      now.Unix() + 2*3600,
+	}
+	next, ok = recurring.nextExecuteAt(now)
+	assert.True(t, ok)
+	assert.Equal(t, now.Unix()+30*60, next)
+	_, ok = recurring.nextExecuteAt(now.Add(2 * time.Hour))
+	assert.False(t, ok)
+
+	recurring.starts = now.Unix() + 60
+	next, ok = recurring.nextExecuteAt(now)
+	assert.True(t, ok)
+	assert.Equal(t, now.Unix()+60, next)
+}
+
+func TestGetEventStatus(t *testing.T) {
+	assert.Equal(t, eventStatusEnabled, getEventStatus(tree.EVENT_STATUS_UNSPECIFIED, true))
+	assert.Equal(t, eventStatusEnabled, getEventStatus(tree.EVENT_STATUS_ENABLE, true))
+	assert.Equal(t, eventStatusDisabled, getEventStatus(tree.EVENT_STATUS_DISABLE, true))
+	assert.Equal(t, eventStatusDisabled, getEventStatus(tree.EVENT_STATUS_ENABLE, false))
+}
+
+func TestGetEventDefiner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	ses := newTestSession(t, ctrl)
+	defer ses.Close()
+	ses.SetTenantInfo(&TenantInfo{Tenant: "acc", User: "u1", DefaultRole: "r1"})
+
+	definer, err := getEventDefiner(ctx, ses, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "u1@%", definer)
+	definer, err = getEventDefiner(ctx, ses, &tree.UsernameRecord{Username: "u1", Hostname: "localhost"})
+	require.NoError(t, err)
+	assert.Equal(t, "u1@localhost", definer)
+	_, err = getEventDefiner(ctx, ses, &tree.UsernameRecord{Username: "u2", Hostname: "%"})
+	assert.Error(t, err)
+
+	// the admin can define the event as another user
+	ses.SetTenantInfo(&TenantInfo{Tenant: "acc", User: "admin", DefaultRole: accountAdminRoleName})
+	definer, err = getEventDefiner(ctx, ses, &tree.UsernameRecord{Username: "u2", Hostname: "%"})
+	require.NoError(t, err)
+	assert.Equal(t, "u2@%", definer)
+}
+
+func TestSplitEventDefiner(t *testing.T) {
+	assert.Equal(t, "u1", splitEventDefiner("u1@%"))
+	assert.Equal(t, "a@b", splitEventDefiner("a@b@localhost"))
+	assert.Equal(t, "u1", splitEventDefiner("u1"))
+}
+
+func TestEventPrivilege(t *testing.T) {
+	typ, err := convertAstPrivilegeTypeToPrivilegeType(context.Background(), tree.PRIVILEGE_TYPE_STATIC_EVENT, tree.OBJECT_TYPE_DATABASE)
+	require.NoError(t, err)
+	assert.Equal(t, PrivilegeTypeEvent, typ)
+	assert.Equal(t, PrivilegeScopeDatabase, typ.Scope())
+
+	name := tree.NewUnresolvedObjectName("db", "e1")
+	for _, stmt := range []tree.Statement{
+		&tree.CreateEvent{Name: name},
+		&tree.AlterEvent{Name: name},
+		&tree.DropEvent{Name: name},
+	} {
+		priv := determinePrivilegeSetOfStatement(stmt)
+		require.NotEmpty(t, priv.entries)
+		assert.Equal(t, PrivilegeTypeEvent, priv.entries[0].privilegeId)
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"sync"
 	"sync/atomic"
 
+	"github.com/parquet-go/parquet-go"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 
@@ -52,6 +53,14 @@ type ExportConfig struct {
 	Symbol      [][]byte
 	// default flush size
 	DefaultBufSize int64
+	// max rows of a parquet row group
+	RowGroupSize int64
+	// file format, csv, jsonline or parquet
+	format string
+	// column types of the result, required by the parquet file
+	typs           []types.Type
+	parquetWriter  *parquet.Writer
+	parquetColumns []int
 
 	//file service & buffer for the line
 	writeParam
@@ -108,6 +117,8 @@ func (ec *ExportConfig) needExportToFile() bool {
 
 func initExportFileParam(ep *ExportConfig, mrs *MysqlResultSet) {
 	ep.DefaultBufSize *= 1024 * 1024
+	ep.format = getExportFormat(ep.userConfig)
+	ep.mrs = mrs
 	n := (int)(mrs.GetColumnCount())
 	if n <= 0 {
 		return
@@ -134,6 +145,11 @@ var openNewFile = func(ctx context.Context, ep *ExportConfig, mrs *MysqlResultSe
 	var err error
 	var filePath string
 	ep.CurFileSize = 0
+	ep.ctx = ctx
+
+	if err = checkExportFormat(ctx, ep.userConfig); err != nil {
+		return err
+	}
 
 	ep.AsyncReader, ep.AsyncWriter = io.Pipe()
 	if len(ep.userConfig.StageFilePath) != 0 {
@@ -186,7 +202,11 @@ var openNewFile = func(ctx context.Context, ep *ExportConfig, mrs *MysqlResultSe
 	ep.AsyncGroup, _ = errgroup.WithContext(ctx)
 	ep.AsyncGroup.Go(asyncWriteFunc)
 
-	if ep.userConfig.Header {
+	if ep.format == tree.PARQUET {
+		if err = openParquetWriter(ctx, ep, mrs); err != nil {
+			return err
+		}
+	} else if ep.format == tree.CSV && ep.userConfig.Header {
 		var header string
 		n := len(mrs.Columns)
 		if n == 0 {
@@ -238,6 +258,13 @@ var formatOutputString = func(oq *ExportConfig, tmp, symbol []byte, enclosed byt
 }
 
 var Close = func(ep *ExportConfig) error {
+	if ep.parquetWriter != nil {
+		// write the footer of the parquet file
+		if err := ep.parquetWriter.Close(); err != nil {
+			return err
+		}
+		ep.parquetWriter = nil
+	}
 	ep.FileCnt++
 	err := ep.AsyncWriter.Close()
 	if err != nil {
@@ -742,12 +769,27 @@ func (ec *ExportConfig) init() {
 }
 
 func (ec *ExportConfig) Write(execCtx *ExecCtx, crs *perfcounter.CounterSet, bat *batch.Batch) error {
+	if ec.format == tree.PARQUET {
+		// the rows are buffered by the parquet writer until the row group is full
+		if err := writeParquetBatch(execCtx.reqCtx, ec, bat); err != nil {
+			execCtx.ses.Error(execCtx.reqCtx,
+				"Error occurred while exporting to parquet file",
+				zap.Error(err))
+			return err
+		}
+		return nil
+	}
+
 	ec.Index.Add(1)
 	copied, err := bat.Dup(execCtx.ses.GetMemPool())
 	if err != nil {
 		return err
 	}
-	go constructByte(execCtx.reqCtx, execCtx.ses, copied, ec.Index.Load(), ec.ByteChan, ec)
+	if ec.format == tree.JSONLINE {
+		go constructJSONLineByte(execCtx.reqCtx, execCtx.ses, copied, ec.Index.Load(), ec.ByteChan, ec)
+	} else {
+		go constructByte(execCtx.reqCtx, execCtx.ses, copied, ec.Index.Load(), ec.ByteChan, ec)
+	}
 
 	if err = exportDataFromBatchToCSVFile(ec); err != nil {
 		execCtx.ses.Error(execCtx.reqCtx,
@@ -0,0 +1,460 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"math"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/parquet-go/parquet-go/compress"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// getExportFormat returns the file format of the export. the format given by
+// the user comes first, otherwise it is inferred from the file extension.
+func getExportFormat(ep *tree.ExportParam) string {
+	if ep.FileFormat != "" {
+		return ep.FileFormat
+	}
+	switch strings.ToLower(path.Ext(ep.FilePath)) {
+	case ".parquet":
+		return tree.PARQUET
+	case ".jsonl", ".jsonline", ".ndjson":
+		return tree.JSONLINE
+	}
+	return tree.CSV
+}
+
+func checkExportFormat(ctx context.Context, ep *tree.ExportParam) error {
+	format := getExportFormat(ep)
+	switch format {
+	case tree.CSV, tree.JSONLINE:
+		if ep.Compression != "" {
+			return moerr.NewNotSupportedf(ctx, "compression of the %s file", format)
+		}
+	case tree.PARQUET:
+		if _, err := getParquetCompression(ctx, ep.Compression); err != nil {
+			return err
+		}
+	default:
+		return moerr.NewNotSupportedf(ctx, "export format %s", format)
+	}
+	return nil
+}
+
+func getParquetCompression(ctx context.Context, name string) (compress.Codec, error) {
+	switch name {
+	case "", "snappy":
+		return &parquet.Snappy, nil
+	case "zstd":
+		return &parquet.Zstd, nil
+	case "gzip":
+		return &parquet.Gzip, nil
+	case "lz4":
+		return &parquet.Lz4Raw, nil
+	case "none", "uncompressed":
+		return &parquet.Uncompressed, nil
+	}
+	return nil, moerr.NewNotSupportedf(ctx, "parquet compression %s", name)
+}
+
+// exportFileWriter writes the parquet file into the pipe of the current export file
+type exportFileWriter struct {
+	ep *ExportConfig
+}
+
+func (w exportFileWriter) Write(p []byte) (int, error) {
+	n, err := Write(w.ep, p)
+	w.ep.CurFileSize += uint64(n)
+	return n, err
+}
+
+func getParquetNode(ctx context.Context, typ types.Type) (parquet.Node, error) {
+	switch typ.Oid {
+	case types.T_bool:
+		return parquet.Leaf(parquet.BooleanType), nil
+	case types.T_bit, types.T_uint64:
+		return parquet.Uint(64), nil
+	case types.T_int8:
+		return parquet.Int(8), nil
+	case types.T_int16:
+		return parquet.Int(16), nil
+	case types.T_int32:
+		return parquet.Int(32), nil
+	case types.T_int64:
+		return parquet.Int(64), nil
+	case types.T_uint8:
+		return parquet.Uint(8), nil
+	case types.T_uint16:
+		return parquet.Uint(16), nil
+	case types.T_uint32:
+		return parquet.Uint(32), nil
+	case types.T_float32:
+		return parquet.Leaf(parquet.FloatType), nil
+	case types.T_float64:
+		return parquet.Leaf(parquet.DoubleType), nil
+	case types.T_decimal64:
+		precision := int(typ.Width)
+		if precision <= 0 || precision > 18 {
+			precision = 18
+		}
+		return parquet.Decimal(int(typ.Scale), precision, parquet.Int64Type), nil
+	case types.T_decimal128:
+		precision := int(typ.Width)
+		if precision <= 0 || precision > 38 {
+			precision = 38
+		}
+		return parquet.Decimal(int(typ.Scale), precision, parquet.FixedLenByteArrayType(16)), nil
+	case types.T_date:
+		return parquet.Date(), nil
+	case types.T_time:
+		return parquet.Time(parquet.Microsecond), nil
+	case types.T_datetime, types.T_timestamp:
+		return parquet.Timestamp(parquet.Microsecond), nil
+	case types.T_char, types.T_varchar, types.T_text, types.T_datalink, types.T_enum,
+		types.T_array_float32, types.T_array_float64, types.T_Rowid, types.T_Blockid:
+		return parquet.String(), nil
+	case types.T_binary, types.T_varbinary, types.T_blob:
+		return parquet.Leaf(parquet.ByteArrayType), nil
+	case types.T_json:
+		return parquet.JSON(), nil
+	case types.T_uuid:
+		return parquet.UUID(), nil
+	}
+	return nil, moerr.NewNotSupportedf(ctx, "export type %s into parquet file", typ.String())
+}
+
+// openParquetWriter creates the parquet writer of the current export file. every
+// column is optional, the leaf columns of a parquet group are sorted by name, so
+// the column index of the result column in the parquet row is recorded.
+func openParquetWriter(ctx context.Context, ep *ExportConfig, mrs *MysqlResultSet) error {
+	if len(ep.typs) != len(mrs.Columns) {
+		return moerr.NewInternalError(ctx, "the column types of the parquet file are unknown")
+	}
+	group := make(parquet.Group, len(mrs.Columns))
+	for i, col := range mrs.Columns {
+		name := col.Name()
+		if _, ok := group[name]; ok {
+			return moerr.NewInvalidInputf(ctx, "duplicate column name %s in parquet file", name)
+		}
+		node, err := getParquetNode(ctx, ep.typs[i])
+		if err != nil {
+			return err
+		}
+		group[name] = parquet.Optional(node)
+	}
+	schema := parquet.NewSchema("matrixone", group)
+	ep.parquetColumns = make([]int, len(mrs.Columns))
+	for i, col := range mrs.Columns {
+		leaf, _ := schema.Lookup(col.Name())
+		ep.parquetColumns[i] = leaf.ColumnIndex
+	}
+
+	codec, err := getParquetCompression(ctx, ep.userConfig.Compression)
+	if err != nil {
+		return err
+	}
+	options := []parquet.WriterOption{schema, parquet.Compression(codec)}
+	if ep.RowGroupSize > 0 {
+		options = append(options, parquet.MaxRowsPerRowGroup(ep.RowGroupSize))
+	}
+	ep.parquetWriter = parquet.NewWriter(exportFileWriter{ep: ep}, options...)
+	return nil
+}
+
+func getParquetValue(vec *vector.Vector, i int) parquet.Value {
+	switch vec.GetType().Oid {
+	case types.T_bool:
+		return parquet.BooleanValue(vector.GetFixedAtNoTypeCheck[bool](vec, i))
+	case types.T_bit, types.T_uint64:
+		return parquet.Int64Value(int64(vector.GetFixedAtNoTypeCheck[uint64](vec, i)))
+	case types.T_int8:
+		return parquet.Int32Value(int32(vector.GetFixedAtNoTypeCheck[int8](vec, i)))
+	case types.T_int16:
+		return parquet.Int32Value(int32(vector.GetFixedAtNoTypeCheck[int16](vec, i)))
+	case types.T_int32:
+		return parquet.Int32Value(vector.GetFixedAtNoTypeCheck[int32](vec, i))
+	case types.T_int64:
+		return parquet.Int64Value(vector.GetFixedAtNoTypeCheck[int64](vec, i))
+	case types.T_uint8:
+		return parquet.Int32Value(int32(vector.GetFixedAtNoTypeCheck[uint8](vec, i)))
+	case types.T_uint16:
+		return parquet.Int32Value(int32(vector.GetFixedAtNoTypeCheck[uint16](vec, i)))
+	case types.T_uint32:
+		return parquet.Int32Value(int32(vector.GetFixedAtNoTypeCheck[uint32](vec, i)))
+	case types.T_float32:
+		return parquet.FloatValue(vector.GetFixedAtNoTypeCheck[float32](vec, i))
+	case types.T_float64:
+		return parquet.DoubleValue(vector.GetFixedAtNoTypeCheck[float64](vec, i))
+	case types.T_decimal64:
+		return parquet.Int64Value(int64(vector.GetFixedAtNoTypeCheck[types.Decimal64](vec, i)))
+	case types.T_decimal128:
+		// big endian two's complement
+		val := vector.GetFixedAtNoTypeCheck[types.Decimal128](vec, i)
+		buf := make([]byte, 16)
+		binary.BigEndian.PutUint64(buf[:8], val.B64_127)
+		binary.BigEndian.PutUint64(buf[8:], val.B0_63)
+		return parquet.FixedLenByteArrayValue(buf)
+	case types.T_date:
+		return parquet.Int32Value(vector.GetFixedAtNoTypeCheck[types.Date](vec, i).DaysSinceUnixEpoch())
+	case types.T_time:
+		return parquet.Int64Value(int64(vector.GetFixedAtNoTypeCheck[types.Time](vec, i)))
+	case types.T_datetime:
+		return parquet.Int64Value(int64(vector.GetFixedAtNoTypeCheck[types.Datetime](vec, i)) - types.GetUnixEpochSecs())
+	case types.T_timestamp:
+		return parquet.Int64Value(int64(vector.GetFixedAtNoTypeCheck[types.Timestamp](vec, i)) - types.GetUnixEpochSecs())
+	case types.T_enum:
+		return parquet.ByteArrayValue([]byte(vector.GetFixedAtNoTypeCheck[types.Enum](vec, i).String()))
+	case types.T_array_float32:
+		return parquet.ByteArrayValue([]byte(types.BytesToArrayToString[float32](vec.GetBytesAt(i))))
+	case types.T_array_float64:
+		return parquet.ByteArrayValue([]byte(types.BytesToArrayToString[float64](vec.GetBytesAt(i))))
+	case types.T_Rowid:
+		return parquet.ByteArrayValue([]byte(vector.GetFixedAtNoTypeCheck[types.Rowid](vec, i).String()))
+	case types.T_Blockid:
+		val := vector.GetFixedAtNoTypeCheck[types.Blockid](vec, i)
+		return parquet.ByteArrayValue([]byte(val.String()))
+	case types.T_json:
+		return parquet.ByteArrayValue([]byte(types.DecodeJson(vec.GetBytesAt(i)).String()))
+	case types.T_uuid:
+		val := vector.GetFixedAtNoTypeCheck[types.Uuid](vec, i)
+		return parquet.FixedLenByteArrayValue(val[:])
+	default:
+		// the bytes are copied by the parquet writer
+		return parquet.ByteArrayValue(vec.GetBytesAt(i))
+	}
+}
+
+// writeParquetBatch writes the batch into the parquet file. the file is switched
+// to a new one at the row group boundary once it is larger than the max file size.
+func writeParquetBatch(ctx context.Context, ep *ExportConfig, bat *batch.Batch) error {
+	if len(bat.Vecs) != len(ep.typs) {
+		return moerr.NewInternalErrorf(ctx, "the batch has %d columns, but the parquet file has %d", len(bat.Vecs), len(ep.typs))
+	}
+	for j, vec := range bat.Vecs {
+		if vec.GetType().Oid != ep.typs[j].Oid {
+			return moerr.NewInternalErrorf(ctx, "the column %d of the batch is %s, but the parquet column is %s",
+				j, vec.GetType().String(), ep.typs[j].String())
+		}
+	}
+
+	rows := make([]parquet.Row, bat.RowCount())
+	for i := range rows {
+		row := make(parquet.Row, len(bat.Vecs))
+		for j, vec := range bat.Vecs {
+			col := ep.parquetColumns[j]
+			if vec.IsNull(uint64(i)) {
+				row[col] = parquet.NullValue().Level(0, 0, col)
+			} else {
+				row[col] = getParquetValue(vec, i).Level(0, 1, col)
+			}
+		}
+		rows[i] = row
+	}
+	if _, err := ep.parquetWriter.WriteRows(rows); err != nil {
+		return err
+	}
+	ep.Rows += uint64(len(rows))
+
+	if ep.userConfig.MaxFileSize != 0 && ep.CurFileSize >= ep.userConfig.MaxFileSize {
+		if err := Close(ep); err != nil {
+			return err
+		}
+		if err := openNewFile(ep.ctx, ep, ep.mrs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendJSONString appends the string as a json string literal
+func appendJSONString(buffer *bytes.Buffer, s []byte) {
+	const hex = "0123456789abcdef"
+	buffer.WriteByte('"')
+	for i := 0; i < len(s); {
+		c := s[i]
+		if c < utf8.RuneSelf {
+			switch {
+			case c == '"' || c == '\\':
+				buffer.WriteByte('\\')
+				buffer.WriteByte(c)
+			case c == '\n':
+				buffer.WriteString(`\n`)
+			case c == '\r':
+				buffer.WriteString(`\r`)
+			case c == '\t':
+				buffer.WriteString(`\t`)
+			case c < 0x20:
+				buffer.WriteString(`\u00`)
+				buffer.WriteByte(hex[c>>4])
+				buffer.WriteByte(hex[c&0xf])
+			default:
+				buffer.WriteByte(c)
+			}
+			i++
+			continue
+		}
+		r, size := utf8.DecodeRune(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			buffer.WriteRune(utf8.RuneError)
+		} else {
+			buffer.Write(s[i : i+size])
+		}
+		i += size
+	}
+	buffer.WriteByte('"')
+}
+
+func appendJSONFloat(buffer *bytes.Buffer, val float64, typ *types.Type, bitSize int) {
+	if math.IsNaN(val) || math.IsInf(val, 0) {
+		appendJSONString(buffer, []byte(strconv.FormatFloat(val, 'f', -1, bitSize)))
+		return
+	}
+	if typ.Scale < 0 || typ.Width == 0 {
+		buffer.WriteString(strconv.FormatFloat(val, 'f', -1, bitSize))
+	} else {
+		buffer.WriteString(strconv.FormatFloat(val, 'f', int(typ.Scale), bitSize))
+	}
+}
+
+// appendJSONLine appends the row of the batch as a json object keyed by the column names
+func appendJSONLine(ctx context.Context, buffer *bytes.Buffer, ep *ExportConfig, bat *batch.Batch, i int, timeZone *time.Location) error {
+	buffer.WriteByte('{')
+	for j, vec := range bat.Vecs {
+		if j > 0 {
+			buffer.WriteByte(',')
+		}
+		appendJSONString(buffer, []byte(ep.mrs.Columns[j].Name()))
+		buffer.WriteByte(':')
+		if vec.IsNull(uint64(i)) {
+			buffer.WriteString("null")
+			continue
+		}
+		typ := vec.GetType()
+		switch typ.Oid {
+		case types.T_json:
+			buffer.WriteString(types.DecodeJson(vec.GetBytesAt(i)).String())
+		case types.T_bool:
+			buffer.WriteString(strconv.FormatBool(vector.GetFixedAtNoTypeCheck[bool](vec, i)))
+		case types.T_bit, types.T_uint64:
+			buffer.WriteString(strconv.FormatUint(vector.GetFixedAtNoTypeCheck[uint64](vec, i), 10))
+		case types.T_int8:
+			buffer.WriteString(strconv.FormatInt(int64(vector.GetFixedAtNoTypeCheck[int8](vec, i)), 10))
+		case types.T_int16:
+			buffer.WriteString(strconv.FormatInt(int64(vector.GetFixedAtNoTypeCheck[int16](vec, i)), 10))
+		case types.T_int32:
+			buffer.WriteString(strconv.FormatInt(int64(vector.GetFixedAtNoTypeCheck[int32](vec, i)), 10))
+		case types.T_int64:
+			buffer.WriteString(strconv.FormatInt(vector.GetFixedAtNoTypeCheck[int64](vec, i), 10))
+		case types.T_uint8:
+			buffer.WriteString(strconv.FormatUint(uint64(vector.GetFixedAtNoTypeCheck[uint8](vec, i)), 10))
+		case types.T_uint16:
+			buffer.WriteString(strconv.FormatUint(uint64(vector.GetFixedAtNoTypeCheck[uint16](vec, i)), 10))
+		case types.T_uint32:
+			buffer.WriteString(strconv.FormatUint(uint64(vector.GetFixedAtNoTypeCheck[uint32](vec, i)), 10))
+		case types.T_float32:
+			appendJSONFloat(buffer, float64(vector.GetFixedAtNoTypeCheck[float32](vec, i)), typ, 32)
+		case types.T_float64:
+			appendJSONFloat(buffer, vector.GetFixedAtNoTypeCheck[float64](vec, i), typ, 64)
+		case types.T_decimal64:
+			buffer.WriteString(vector.GetFixedAtNoTypeCheck[types.Decimal64](vec, i).Format(typ.Scale))
+		case types.T_decimal128:
+			buffer.WriteString(vector.GetFixedAtNoTypeCheck[types.Decimal128](vec, i).Format(typ.Scale))
+		case types.T_array_float32:
+			buffer.WriteString(types.BytesToArrayToString[float32](vec.GetBytesAt(i)))
+		case types.T_array_float64:
+			buffer.WriteString(types.BytesToArrayToString[float64](vec.GetBytesAt(i)))
+		case types.T_char, types.T_varchar, types.T_blob, types.T_text, types.T_binary, types.T_varbinary, types.T_datalink:
+			appendJSONString(buffer, vec.GetBytesAt(i))
+		case types.T_date:
+			appendJSONString(buffer, []byte(vector.GetFixedAtNoTypeCheck[types.Date](vec, i).String()))
+		case types.T_datetime:
+			appendJSONString(buffer, []byte(vector.GetFixedAtNoTypeCheck[types.Datetime](vec, i).String2(typ.Scale)))
+		case types.T_time:
+			appendJSONString(buffer, []byte(vector.GetFixedAtNoTypeCheck[types.Time](vec, i).String2(typ.Scale)))
+		case types.T_timestamp:
+			appendJSONString(buffer, []byte(vector.GetFixedAtNoTypeCheck[types.Timestamp](vec, i).String2(timeZone, typ.Scale)))
+		case types.T_uuid:
+			appendJSONString(buffer, []byte(vector.GetFixedAtNoTypeCheck[types.Uuid](vec, i).String()))
+		case types.T_Rowid:
+			appendJSONString(buffer, []byte(vector.GetFixedAtNoTypeCheck[types.Rowid](vec, i).String()))
+		case types.T_Blockid:
+			val := vector.GetFixedAtNoTypeCheck[types.Blockid](vec, i)
+			appendJSONString(buffer, []byte(val.String()))
+		case types.T_enum:
+			appendJSONString(buffer, []byte(vector.GetFixedAtNoTypeCheck[types.Enum](vec, i).String()))
+		default:
+			return moerr.NewInternalErrorf(ctx, "appendJSONLine : unsupported type %d", typ.Oid)
+		}
+	}
+	buffer.WriteString("}\n")
+	return nil
+}
+
+func constructJSONLineByte(ctx context.Context, obj FeSession, bat *batch.Batch, index int32, ByteChan chan *BatchByte, ep *ExportConfig) {
+	ses := obj.(*Session)
+	defer bat.Clean(ses.GetMemPool())
+
+	buffer := &bytes.Buffer{}
+	for i := 0; i < bat.RowCount(); i++ {
+		if err := appendJSONLine(ctx, buffer, ep, bat, i, ses.GetTimeZone()); err != nil {
+			ByteChan <- &BatchByte{
+				err: err,
+			}
+			return
+		}
+	}
+
+	ByteChan <- &BatchByte{
+		index:     index,
+		writeByte: buffer.Bytes(),
+	}
+	ses.writeCsvBytes.Add(int64(buffer.Len())) // statistic out traffic, CASE 2: select into
+}
+
+// exportDataFromBatchToFile writes the batch into the jsonline or parquet file in the
+// calling goroutine, it is used for the batches read from the query result.
+func exportDataFromBatchToFile(ctx context.Context, ep *ExportConfig, bat *batch.Batch, timeZone *time.Location) error {
+	switch ep.format {
+	case tree.PARQUET:
+		return writeParquetBatch(ctx, ep, bat)
+	case tree.JSONLINE:
+		buffer := &bytes.Buffer{}
+		for i := 0; i < bat.RowCount(); i++ {
+			if err := appendJSONLine(ctx, buffer, ep, bat, i, timeZone); err != nil {
+				return err
+			}
+		}
+		if err := writeToCSVFile(ep, buffer.Bytes()); err != nil {
+			return err
+		}
+		ep.Rows += uint64(bat.RowCount())
+		return nil
+	}
+	return moerr.NewInternalErrorf(ctx, "export batch into %s file", ep.format)
+}
@@ -0,0 +1,194 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+func Test_getExportFormat(t *testing.T) {
+	ctx := context.TODO()
+	require.Equal(t, tree.CSV, getExportFormat(&tree.ExportParam{FilePath: "/tmp/a.csv"}))
+	require.Equal(t, tree.CSV, getExportFormat(&tree.ExportParam{FilePath: "/tmp/a"}))
+	require.Equal(t, tree.PARQUET, getExportFormat(&tree.ExportParam{FilePath: "/tmp/a.PARQUET"}))
+	require.Equal(t, tree.JSONLINE, getExportFormat(&tree.ExportParam{FilePath: "stage://s1/a.jsonl"}))
+	require.Equal(t, tree.PARQUET, getExportFormat(&tree.ExportParam{FilePath: "/tmp/a.csv", FileFormat: tree.PARQUET}))
+
+	require.NoError(t, checkExportFormat(ctx, &tree.ExportParam{FilePath: "/tmp/a.parquet", Compression: "zstd"}))
+	require.Error(t, checkExportFormat(ctx, &tree.ExportParam{FilePath: "/tmp/a.parquet", Compression: "brotli"}))
+	require.Error(t, checkExportFormat(ctx, &tree.ExportParam{FilePath: "/tmp/a.csv", Compression: "zstd"}))
+	require.Error(t, checkExportFormat(ctx, &tree.ExportParam{FilePath: "/tmp/a", FileFormat: "orc"}))
+}
+
+func newTestExportConfig(t *testing.T, filePath string, names []string, typs []types.Type) *ExportConfig {
+	ep := &ExportConfig{
+		userConfig: &tree.ExportParam{
+			Outfile: true,
+			Lines: &tree.Lines{
+				TerminatedBy: &tree.Terminated{Value: "\n"},
+			},
+			Fields: &tree.Fields{
+				Terminated: &tree.Terminated{Value: ","},
+				EnclosedBy: &tree.EnclosedBy{Value: '"'},
+			},
+			Header:   true,
+			FilePath: filePath,
+		},
+		typs: typs,
+	}
+	mrs := &MysqlResultSet{}
+	for _, name := range names {
+		col := new(MysqlColumn)
+		col.SetName(name)
+		mrs.AddColumn(col)
+	}
+	initExportFileParam(ep, mrs)
+	return ep
+}
+
+func newTestExportBatch(t *testing.T, mp *mpool.MPool) *batch.Batch {
+	bat := batch.NewWithSize(4)
+	bat.Vecs[0] = vector.NewVec(types.T_int64.ToType())
+	bat.Vecs[1] = vector.NewVec(types.T_varchar.ToType())
+	bat.Vecs[2] = vector.NewVec(types.New(types.T_decimal64, 10, 2))
+	bat.Vecs[3] = vector.NewVec(types.T_date.ToType())
+
+	d, err := types.ParseDecimal64("12.34", 10, 2)
+	require.NoError(t, err)
+	date, err := types.ParseDateCast("2024-01-02")
+	require.NoError(t, err)
+	require.NoError(t, vector.AppendFixed(bat.Vecs[0], int64(1), false, mp))
+	require.NoError(t, vector.AppendFixed(bat.Vecs[0], int64(2), false, mp))
+	require.NoError(t, vector.AppendBytes(bat.Vecs[1], []byte("a\"b\n"), false, mp))
+	require.NoError(t, vector.AppendBytes(bat.Vecs[1], nil, true, mp))
+	require.NoError(t, vector.AppendFixed(bat.Vecs[2], d, false, mp))
+	require.NoError(t, vector.AppendFixed(bat.Vecs[2], d, false, mp))
+	require.NoError(t, vector.AppendFixed(bat.Vecs[3], date, false, mp))
+	require.NoError(t, vector.AppendFixed(bat.Vecs[3], date, true, mp))
+	bat.SetRowCount(2)
+	return bat
+}
+
+var testExportNames = []string{"id", "name", "price", "day"}
+
+var testExportTypes = []types.Type{
+	types.T_int64.ToType(),
+	types.T_varchar.ToType(),
+	types.New(types.T_decimal64, 10, 2),
+	types.T_date.ToType(),
+}
+
+func Test_exportJSONLineFile(t *testing.T) {
+	ctx := context.TODO()
+	mp := mpool.MustNewZero()
+	bat := newTestExportBatch(t, mp)
+	defer bat.Clean(mp)
+
+	filePath := filepath.Join(t.TempDir(), "export.jsonl")
+	ep := newTestExportConfig(t, filePath, testExportNames, testExportTypes)
+	require.Equal(t, tree.JSONLINE, ep.format)
+	require.NoError(t, openNewFile(ctx, ep, ep.mrs))
+	require.NoError(t, exportDataFromBatchToFile(ctx, ep, bat, time.UTC))
+	require.NoError(t, Close(ep))
+
+	data, err := os.ReadFile(filePath)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	require.Equal(t, []string{
+		`{"id":1,"name":"a\"b\n","price":12.34,"day":"2024-01-02"}`,
+		`{"id":2,"name":null,"price":12.34,"day":null}`,
+	}, lines)
+}
+
+func Test_exportParquetFile(t *testing.T) {
+	ctx := context.TODO()
+	mp := mpool.MustNewZero()
+	bat := newTestExportBatch(t, mp)
+	defer bat.Clean(mp)
+
+	filePath := filepath.Join(t.TempDir(), "export.parquet")
+	ep := newTestExportConfig(t, filePath, testExportNames, testExportTypes)
+	ep.userConfig.Compression = "zstd"
+	ep.RowGroupSize = 1
+	require.Equal(t, tree.PARQUET, ep.format)
+	require.NoError(t, openNewFile(ctx, ep, ep.mrs))
+	require.NoError(t, writeParquetBatch(ctx, ep, bat))
+	require.NoError(t, Close(ep))
+
+	f, err := os.Open(filePath)
+	require.NoError(t, err)
+	defer f.Close()
+	stat, err := f.Stat()
+	require.NoError(t, err)
+	pf, err := parquet.OpenFile(f, stat.Size())
+	require.NoError(t, err)
+	require.Equal(t, int64(2), pf.NumRows())
+	require.Equal(t, 2, len(pf.RowGroups()))
+
+	schema := pf.Schema()
+	for _, name := range testExportNames {
+		leaf, ok := schema.Lookup(name)
+		require.True(t, ok)
+		require.True(t, leaf.Node.Optional())
+	}
+	leaf, _ := schema.Lookup("price")
+	require.Equal(t, "DECIMAL(10,2)", leaf.Node.Type().String())
+	leaf, _ = schema.Lookup("day")
+	require.Equal(t, "DATE", leaf.Node.Type().String())
+
+	rows := make([]parquet.Row, 2)
+	reader := parquet.NewReader(pf)
+	defer reader.Close()
+	for n := 0; n < len(rows); {
+		m, err := reader.ReadRows(rows[n:])
+		require.True(t, m > 0, err)
+		// the values refer to the buffer of the reader
+		for ; m > 0; m-- {
+			rows[n] = rows[n].Clone()
+			n++
+		}
+	}
+	idCol, _ := schema.Lookup("id")
+	nameCol, _ := schema.Lookup("name")
+	dayCol, _ := schema.Lookup("day")
+	require.Equal(t, int64(1), rows[0][idCol.ColumnIndex].Int64())
+	require.Equal(t, "a\"b\n", rows[0][nameCol.ColumnIndex].String())
+	require.True(t, rows[1][nameCol.ColumnIndex].IsNull())
+	require.Equal(t, int32(19724), rows[0][dayCol.ColumnIndex].Int32())
+	require.True(t, rows[1][dayCol.ColumnIndex].IsNull())
+}
+
+func Test_exportParquetDuplicateColumn(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "export.parquet")
+	ep := newTestExportConfig(t, filePath, []string{"a", "a"}, []types.Type{types.T_int64.ToType(), types.T_int64.ToType()})
+	err := openNewFile(context.TODO(), ep, ep.mrs)
+	require.Error(t, err)
+	_ = ep.AsyncWriter.Close()
+	_ = ep.AsyncGroup.Wait()
+}
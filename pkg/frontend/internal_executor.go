@@ -59,6 +59,14 @@ func applyOverride(sess *Session, opts ie.SessionOverrideOptions) {
 		if opts.DefaultRoleId != nil {
 			acc.SetDefaultRoleID(*opts.DefaultRoleId)
 		}
+
+		if opts.User != nil {
+			acc.SetUser(*opts.User)
+		}
+
+		if opts.DefaultRole != nil {
+			acc.SetDefaultRole(*opts.DefaultRole)
+		}
 	}
 
 }
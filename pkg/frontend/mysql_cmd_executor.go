@@ -1266,6 +1266,18 @@ func handleDropPitr(ses *Session, execCtx *ExecCtx, dp *tree.DropPitr) error {
 	return doDropPitr(execCtx.reqCtx, ses, dp)
 }
 
+func handleCreateEvent(ses *Session, execCtx *ExecCtx, ce *tree.CreateEvent) error {
+	return doCreateEvent(execCtx.reqCtx, ses, ce)
+}
+
+func handleAlterEvent(ses *Session, execCtx *ExecCtx, ae *tree.AlterEvent) error {
+	return doAlterEvent(execCtx.reqCtx, ses, ae)
+}
+
+func handleDropEvent(ses *Session, execCtx *ExecCtx, de *tree.DropEvent) error {
+	return doDropEvent(execCtx.reqCtx, ses, de)
+}
+
 func handleAlterPitr(ses *Session, execCtx *ExecCtx, ap *tree.AlterPitr) error {
 	return doAlterPitr(execCtx.reqCtx, ses, ap)
 }
@@ -1518,6 +1530,29 @@ func handleRevokePrivilege(ses FeSession, execCtx *ExecCtx, rp *tree.RevokePrivi
 	return doRevokePrivilege(ctx, ses, rp, bh)
 }
 
+// handleSavepoint sets a savepoint with the session states changed by the writes.
+func handleSavepoint(ses *Session, execCtx *ExecCtx, st *tree.SavePoint) error {
+	sp := &txnSavepoint{
+		name:         string(st.Name),
+		lastInsertID: ses.GetLastInsertID(),
+	}
+	sp.seqCurValues, sp.seqLastValue = ses.getSeqValues()
+	return ses.GetTxnHandler().Savepoint(execCtx, sp)
+}
+
+// handleRollbackToSavepoint rollbacks the txn to the savepoint and restores the
+// session states. The auto increment values allocated after the savepoint are
+// not given back, just like mysql.
+func handleRollbackToSavepoint(ses *Session, execCtx *ExecCtx, st *tree.RollbackToSavePoint) error {
+	sp, err := ses.GetTxnHandler().RollbackToSavepoint(execCtx, string(st.Name))
+	if err != nil {
+		return err
+	}
+	ses.SetLastInsertID(sp.lastInsertID)
+	ses.setSeqValues(sp.seqCurValues, sp.seqLastValue)
+	return nil
+}
+
 // handleSwitchRole switches the role to another role
 func handleSwitchRole(ses FeSession, execCtx *ExecCtx, sr *tree.SetRole) error {
 	return doSwitchRole(execCtx.reqCtx, ses.(*Session), sr)
@@ -2038,7 +2073,7 @@ func buildPlan(reqCtx context.Context, ses FeSession, ctx plan2.CompilerContext,
 	}
 	switch stmt := stmt.(type) {
 	case *tree.Select, *tree.ParenSelect, *tree.ValuesStatement,
-		*tree.Update, *tree.Delete, *tree.Insert,
+		*tree.Update, *tree.Delete, *tree.Insert, *tree.Merge,
 		*tree.ShowDatabases, *tree.ShowTables, *tree.ShowSequences, *tree.ShowColumns, *tree.ShowColumnNumber, *tree.ShowTableNumber,
 		*tree.ShowCreateDatabase, *tree.ShowCreateTable, *tree.ShowIndex,
 		*tree.ExplainStmt, *tree.ExplainAnalyze, *tree.ExplainPhyPlan:
@@ -2570,10 +2605,6 @@ func executeStmtWithWorkspace(ses FeSession,
 	case *tree.RollbackTransaction:
 		execCtx.txnOpt.byRollback = true
 		return nil
-	case *tree.SavePoint, *tree.ReleaseSavePoint:
-		return nil
-	case *tree.RollbackToSavePoint:
-		return moerr.NewInternalError(execCtx.reqCtx, "savepoint has not been implemented yet. please rollback the transaction.")
 	}
 
 	//in session migration, the txn forced to be autocommit.
@@ -3169,6 +3200,21 @@ func checkNodeCanCache(p *plan2.Plan) bool {
 	return true
 }
 
+// withClientTraceParent makes the statement span join the client trace, if the
+// session variable traceparent is set. Invalid value is ignored.
+func withClientTraceParent(ctx context.Context, ses *Session) context.Context {
+	val, err := ses.GetSessionSysVar(trace.TraceParentKey)
+	if err != nil {
+		return ctx
+	}
+	if str, ok := val.(string); ok && str != "" {
+		if sc, ok := trace.ParseTraceParent(str); ok {
+			return trace.ContextWithSpanContext(ctx, sc)
+		}
+	}
+	return ctx
+}
+
 // ExecRequest the server execute the commands from the client following the mysql's routine
 func ExecRequest(ses *Session, execCtx *ExecCtx, req *Request) (resp *Response, err error) {
 	defer func() {
@@ -3191,6 +3237,7 @@ func ExecRequest(ses *Session, execCtx *ExecCtx, req *Request) (resp *Response,
 	defer ses.ExitFPrint(FPExecRequest)
 
 	var span trace.Span
+	execCtx.reqCtx = withClientTraceParent(execCtx.reqCtx, ses)
 	execCtx.reqCtx, span = trace.Start(execCtx.reqCtx, "ExecRequest",
 		trace.WithKind(trace.SpanKindStatement))
 	defer span.End()
@@ -52,6 +52,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/plan/explain"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/matrixorigin/matrixone/pkg/util/fault"
+	"github.com/matrixorigin/matrixone/pkg/util/trace"
 	"github.com/matrixorigin/matrixone/pkg/util/trace/impl/motrace"
 	"github.com/matrixorigin/matrixone/pkg/util/trace/impl/motrace/statistic"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
@@ -1673,3 +1674,29 @@ func Test_handleShowTableStatus(t *testing.T) {
 		convey.So(handleShowTableStatus(ses, ec, shv), convey.ShouldNotBeNil)
 	})
 }
+
+func Test_withClientTraceParent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ses := newSes(nil, ctrl)
+	ctx := context.TODO()
+
+	// not set
+	got := withClientTraceParent(ctx, ses)
+	assert.Equal(t, ctx, got)
+
+	// invalid value
+	err := ses.SetSessionSysVar(ctx, trace.TraceParentKey, "invalid")
+	assert.NoError(t, err)
+	got = withClientTraceParent(ctx, ses)
+	assert.Equal(t, ctx, got)
+
+	// valid value
+	err = ses.SetSessionSysVar(ctx, trace.TraceParentKey, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	assert.NoError(t, err)
+	got = withClientTraceParent(ctx, ses)
+	sc := trace.SpanFromContext(got).SpanContext()
+	assert.Equal(t, "00f067aa0ba902b7", sc.SpanID.String())
+	assert.Equal(t, trace.SpanKindRemote, sc.Kind)
+	assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", trace.FormatTraceParent(sc))
+}
@@ -16,6 +16,7 @@ package frontend
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -47,6 +48,82 @@ type Interpreter struct {
 	argsAttr    map[string]tree.InOutArgType // used for IN, OUT, IN/OUT check
 	argsMap     map[string]tree.Expr         // used for argument to parameter mapping
 	outParamMap map[string]interface{}       // used for storing and updating OUT type arg
+	blockScope  []*spBlockScope              // cursors, conditions and handlers of the BEGIN ... END blocks
+	diag        spDiagnostics                // the current diagnostics area
+	handling    []spDiagnostics              // the stacked diagnostics areas of the running handlers
+}
+
+// spBlockScope holds the cursors, conditions and handlers declared in a BEGIN ... END block.
+type spBlockScope struct {
+	cursors    map[string]*spCursor
+	conditions map[string]*tree.ConditionValue
+	handlers   []*tree.DeclareHandler
+	// the handlers of the block can not catch the conditions raised while one of them is running
+	inactive bool
+}
+
+func newSpBlockScope() *spBlockScope {
+	return &spBlockScope{
+		cursors:    make(map[string]*spCursor),
+		conditions: make(map[string]*tree.ConditionValue),
+	}
+}
+
+// spCursor is a cursor of the stored procedure, the rows of the query are loaded at OPEN.
+type spCursor struct {
+	query  string
+	isOpen bool
+	rows   *MysqlResultSet
+	pos    uint64
+}
+
+// spCondition is a condition in the diagnostics area.
+type spCondition struct {
+	sqlState string
+	errno    uint16
+	message  string
+}
+
+func newSpCondition(err error) spCondition {
+	errno, sqlState, msg := RewriteError(err, "")
+	return spCondition{sqlState: sqlState, errno: errno, message: msg}
+}
+
+// class is the first two characters of the SQLSTATE, '01' is warning, '02' is not found, and the others are exception.
+func (cond spCondition) class() string {
+	if len(cond.sqlState) < 2 {
+		return ""
+	}
+	return cond.sqlState[:2]
+}
+
+type spDiagnostics struct {
+	// the background executor does not report the affected rows, ROW_COUNT is -1 for the statements
+	// returning a result set and 0 for the others.
+	rowCount   int64
+	conditions []spCondition
+}
+
+// spExitBlock is returned by an EXIT handler, the block at level is left after the handler ends.
+type spExitBlock struct {
+	level int
+}
+
+func (e *spExitBlock) Error() string {
+	return fmt.Sprintf("exit the block at level %d", e.level)
+}
+
+// spUnhandled wraps the condition no handler is found for, so the enclosing statements do not look up again.
+type spUnhandled struct {
+	err error
+}
+
+func (e *spUnhandled) Error() string {
+	return e.err.Error()
+}
+
+func (e *spUnhandled) Unwrap() error {
+	return e.err
 }
 
 func (interpreter *Interpreter) GetResult() []ExecResult {
@@ -244,6 +321,7 @@ func (interpreter *Interpreter) ExecuteSp(stmt tree.Statement, dbName string) (e
 
 	// save parameters as local variables
 	*interpreter.varScope = append(*interpreter.varScope, curScope)
+	interpreter.blockScope = append(interpreter.blockScope, newSpBlockScope())
 	for k, v := range interpreter.argsMap {
 		var value interface{}
 		if varParam, ok := v.(*tree.VarExpr); ok {
@@ -278,6 +356,10 @@ func (interpreter *Interpreter) ExecuteSp(stmt tree.Statement, dbName string) (e
 	_, err = interpreter.interpret(stmt)
 
 	if err != nil {
+		var unhandled *spUnhandled
+		if errors.As(err, &unhandled) {
+			return unhandled.err
+		}
 		return err
 	}
 
@@ -300,25 +382,41 @@ func (interpreter *Interpreter) ExecuteSp(stmt tree.Statement, dbName string) (e
 	return nil
 }
 
+// interpret executes the statement, the condition raised by it is passed to the handlers.
 func (interpreter *Interpreter) interpret(stmt tree.Statement) (SpStatus, error) {
+	status, err := interpreter.interpretStmt(stmt)
+	if err != nil {
+		return interpreter.handleCondition(err)
+	}
+	return status, nil
+}
+
+func (interpreter *Interpreter) interpretStmt(stmt tree.Statement) (SpStatus, error) {
 	if stmt == nil {
 		return SpOk, nil
 	}
 	switch st := stmt.(type) {
 	case *tree.CompoundStmt:
-		// create new variable scope and push it
+		// create new variable scope and block scope and push them
 		curScope := make(map[string]interface{})
 		*interpreter.varScope = append(*interpreter.varScope, curScope)
+		level := len(interpreter.blockScope)
+		interpreter.blockScope = append(interpreter.blockScope, newSpBlockScope())
 		interpreter.ses.Info(interpreter.ctx, "current scope level: "+strconv.Itoa(len(*interpreter.varScope)))
+		// pop current scope, the cursors of the block are closed with it
+		defer interpreter.popScope(len(*interpreter.varScope)-1, level)
 		// recursively execute
 		for _, innerSt := range st.Stmts {
 			_, err := interpreter.interpret(innerSt)
 			if err != nil {
+				var exit *spExitBlock
+				if errors.As(err, &exit) && exit.level == level {
+					// an EXIT handler of this block has been executed
+					return SpOk, nil
+				}
 				return SpNotOk, err
 			}
 		}
-		// pop current scope
-		*interpreter.varScope = (*interpreter.varScope)[:len(*interpreter.varScope)-1]
 		return SpOk, nil
 	case *tree.RepeatStmt:
 		for {
@@ -535,20 +633,100 @@ func (interpreter *Interpreter) interpret(stmt tree.Statement) (SpStatus, error)
 				}
 			}
 		}
-	default: // normal sql. Since we don't support SELECT INTO for now, we don't have to worry about updating variables
-		str := interpreter.GetStatementString(st)
-		interpreter.bh.ClearExecResultSet()
-		// For sp variable replacement
-		interpreter.ctx = context.WithValue(interpreter.ctx, defines.VarScopeKey{}, interpreter.varScope)
-		interpreter.ctx = context.WithValue(interpreter.ctx, defines.InSp{}, true)
-		err := interpreter.bh.Exec(interpreter.ctx, str)
+	case *tree.DeclareCondition:
+		conditions := interpreter.currentBlock().conditions
+		name := strings.ToLower(string(st.Name))
+		if _, ok := conditions[name]; ok {
+			return SpNotOk, interpreter.newSpError(moerr.ER_SP_DUP_COND, st.Name)
+		}
+		conditions[name] = st.Value
+		return SpOk, nil
+	case *tree.DeclareCursor:
+		cursors := interpreter.currentBlock().cursors
+		name := strings.ToLower(string(st.Name))
+		if _, ok := cursors[name]; ok {
+			return SpNotOk, interpreter.newSpError(moerr.ER_SP_DUP_CURS, st.Name)
+		}
+		cursors[name] = &spCursor{query: interpreter.GetStatementString(st.Select)}
+		return SpOk, nil
+	case *tree.DeclareHandler:
+		level := len(interpreter.blockScope) - 1
+		for _, cond := range st.Conditions {
+			if cond.Type == tree.CONDITION_NAME && interpreter.lookupCondition(cond.Name, level) == nil {
+				return SpNotOk, interpreter.newSpError(moerr.ER_SP_COND_MISMATCH, cond.Name)
+			}
+		}
+		interpreter.blockScope[level].handlers = append(interpreter.blockScope[level].handlers, st)
+		return SpOk, nil
+	case *tree.OpenCursor:
+		cursor, err := interpreter.lookupCursor(st.Name)
+		if err != nil {
+			return SpNotOk, err
+		}
+		if cursor.isOpen {
+			return SpNotOk, interpreter.newSpError(moerr.ER_SP_CURSOR_ALREADY_OPEN)
+		}
+		// the local variables in the query take their values at OPEN
+		erArray, err := interpreter.execSql(cursor.query)
 		if err != nil {
 			return SpNotOk, err
 		}
-		erArray, err := getResultSet(interpreter.ctx, interpreter.bh)
+		cursor.isOpen, cursor.rows, cursor.pos = true, nil, 0
+		if len(erArray) != 0 {
+			cursor.rows, _ = erArray[0].(*MysqlResultSet)
+		}
+		return SpOk, nil
+	case *tree.FetchCursor:
+		cursor, err := interpreter.lookupCursor(st.Name)
 		if err != nil {
 			return SpNotOk, err
 		}
+		if !cursor.isOpen {
+			return SpNotOk, interpreter.newSpError(moerr.ER_SP_CURSOR_NOT_OPEN)
+		}
+		if cursor.rows == nil || cursor.pos >= cursor.rows.GetRowCount() {
+			return SpNotOk, moerr.NewSpFetchNoData(interpreter.ctx)
+		}
+		if uint64(len(st.Variables)) != cursor.rows.GetColumnCount() {
+			return SpNotOk, interpreter.newSpError(moerr.ER_SP_WRONG_NO_OF_FETCH_ARGS)
+		}
+		for i, name := range st.Variables {
+			value, err := getSpValue(interpreter.ctx, cursor.rows, cursor.pos, uint64(i))
+			if err != nil {
+				return SpNotOk, err
+			}
+			if err = interpreter.SetSpVar(name, value); err != nil {
+				return SpNotOk, err
+			}
+		}
+		cursor.pos++
+		return SpOk, nil
+	case *tree.CloseCursor:
+		cursor, err := interpreter.lookupCursor(st.Name)
+		if err != nil {
+			return SpNotOk, err
+		}
+		if !cursor.isOpen {
+			return SpNotOk, interpreter.newSpError(moerr.ER_SP_CURSOR_NOT_OPEN)
+		}
+		cursor.isOpen, cursor.rows, cursor.pos = false, nil, 0
+		return SpOk, nil
+	case *tree.Signal:
+		return SpNotOk, interpreter.signal(st)
+	case *tree.GetDiagnostics:
+		if err := interpreter.getDiagnostics(st); err != nil {
+			return SpNotOk, err
+		}
+		return SpOk, nil
+	default: // normal sql. Since we don't support SELECT INTO for now, we don't have to worry about updating variables
+		erArray, err := interpreter.execSql(interpreter.GetStatementString(st))
+		if err != nil {
+			return SpNotOk, err
+		}
+		interpreter.diag = spDiagnostics{}
+		if len(erArray) != 0 {
+			interpreter.diag.rowCount = -1
+		}
 		if execResultArrayHasData(erArray) {
 			interpreter.result = append(interpreter.result, erArray[0])
 		}
@@ -556,3 +734,288 @@ func (interpreter *Interpreter) interpret(stmt tree.Statement) (SpStatus, error)
 	}
 	return SpOk, nil
 }
+
+// execSql executes the sql by bh, the local variables are visible to it.
+func (interpreter *Interpreter) execSql(sql string) ([]ExecResult, error) {
+	interpreter.bh.ClearExecResultSet()
+	// For sp variable replacement
+	interpreter.ctx = context.WithValue(interpreter.ctx, defines.VarScopeKey{}, interpreter.varScope)
+	interpreter.ctx = context.WithValue(interpreter.ctx, defines.InSp{}, true)
+	err := interpreter.bh.Exec(interpreter.ctx, sql)
+	if err != nil {
+		return nil, err
+	}
+	return getResultSet(interpreter.ctx, interpreter.bh)
+}
+
+// evalExpr evaluates the expression by sending it to bh with a select
+func (interpreter *Interpreter) evalExpr(expr tree.Expr) (interface{}, error) {
+	erArray, err := interpreter.execSql("select " + interpreter.GetExprString(expr))
+	if err != nil {
+		return nil, err
+	}
+	if !execResultArrayHasData(erArray) {
+		return nil, nil
+	}
+	rs, ok := erArray[0].(*MysqlResultSet)
+	if !ok {
+		return erArray[0].GetString(interpreter.ctx, 0, 0)
+	}
+	return getSpValue(interpreter.ctx, rs, 0, 0)
+}
+
+// getSpValue returns the value at row and col of the result set as the value of a variable.
+func getSpValue(ctx context.Context, rs *MysqlResultSet, row, col uint64) (interface{}, error) {
+	value, err := rs.GetValue(ctx, row, col)
+	if err != nil {
+		return nil, err
+	}
+	if b, ok := value.([]byte); ok {
+		return string(b), nil
+	}
+	return value, nil
+}
+
+// newSpError makes the mysql error of code, it is raised as a condition in the procedure.
+func (interpreter *Interpreter) newSpError(code uint16, args ...interface{}) error {
+	item := moerr.MysqlErrorMsgRefer[code]
+	msg := item.ErrorMsgOrFormat
+	if len(args) != 0 {
+		msg = fmt.Sprintf(msg, args...)
+	}
+	return moerr.NewSignal(interpreter.ctx, item.SqlStates[0], item.ErrorCode, msg)
+}
+
+func (interpreter *Interpreter) currentBlock() *spBlockScope {
+	return interpreter.blockScope[len(interpreter.blockScope)-1]
+}
+
+// popScope pops the variable scopes from varLevel and the block scopes from blockLevel.
+func (interpreter *Interpreter) popScope(varLevel, blockLevel int) {
+	*interpreter.varScope = (*interpreter.varScope)[:varLevel]
+	interpreter.blockScope = interpreter.blockScope[:blockLevel]
+}
+
+func (interpreter *Interpreter) lookupCursor(name tree.Identifier) (*spCursor, error) {
+	for i := len(interpreter.blockScope) - 1; i >= 0; i-- {
+		if cursor, ok := interpreter.blockScope[i].cursors[strings.ToLower(string(name))]; ok {
+			return cursor, nil
+		}
+	}
+	return nil, interpreter.newSpError(moerr.ER_SP_CURSOR_MISMATCH, name)
+}
+
+// lookupCondition looks up the named condition from the block at level outwards.
+func (interpreter *Interpreter) lookupCondition(name tree.Identifier, level int) *tree.ConditionValue {
+	for i := level; i >= 0; i-- {
+		if value, ok := interpreter.blockScope[i].conditions[strings.ToLower(string(name))]; ok {
+			return value
+		}
+	}
+	return nil
+}
+
+// handleCondition looks up the handler of the condition raised by a statement and executes it.
+// With a CONTINUE handler, the execution goes on with the statement following the one raising the condition.
+// With an EXIT handler, the block declaring the handler is left.
+func (interpreter *Interpreter) handleCondition(err error) (SpStatus, error) {
+	var exit *spExitBlock
+	var unhandled *spUnhandled
+	if errors.As(err, &exit) || errors.As(err, &unhandled) {
+		return SpNotOk, err
+	}
+
+	cond := newSpCondition(err)
+	interpreter.diag = spDiagnostics{conditions: []spCondition{cond}}
+	level, handler := interpreter.findHandler(cond)
+	if handler == nil {
+		if cond.class() == "01" {
+			// the unhandled warning does not stop the procedure
+			return SpOk, nil
+		}
+		return SpNotOk, &spUnhandled{err: err}
+	}
+
+	// the handlers of the block declaring the handler and its inner blocks are inactive while it is running
+	inactive := make([]bool, len(interpreter.blockScope)-level)
+	for i, block := range interpreter.blockScope[level:] {
+		inactive[i] = block.inactive
+		block.inactive = true
+	}
+	interpreter.handling = append(interpreter.handling, interpreter.diag)
+	_, err = interpreter.interpret(handler.Body)
+	interpreter.handling = interpreter.handling[:len(interpreter.handling)-1]
+	for i, block := range interpreter.blockScope[level : level+len(inactive)] {
+		block.inactive = inactive[i]
+	}
+	if err != nil {
+		return SpNotOk, err
+	}
+
+	if handler.Action == tree.HANDLER_EXIT {
+		return SpNotOk, &spExitBlock{level: level}
+	}
+	return SpOk, nil
+}
+
+// findHandler looks up the handler of the condition from the innermost block outwards.
+// In a block, the handler for an error code is preferred to the one for a SQLSTATE,
+// which is preferred to the one for SQLWARNING, NOT FOUND or SQLEXCEPTION.
+func (interpreter *Interpreter) findHandler(cond spCondition) (int, *tree.DeclareHandler) {
+	for level := len(interpreter.blockScope) - 1; level >= 0; level-- {
+		block := interpreter.blockScope[level]
+		if block.inactive {
+			continue
+		}
+		var found *tree.DeclareHandler
+		foundRank := 0
+		for _, handler := range block.handlers {
+			for _, value := range handler.Conditions {
+				if rank := interpreter.matchCondition(value, cond, level); rank > foundRank {
+					found, foundRank = handler, rank
+				}
+			}
+		}
+		if found != nil {
+			return level, found
+		}
+	}
+	return -1, nil
+}
+
+// matchCondition returns how specifically the condition value matches the condition, 0 means not matched.
+func (interpreter *Interpreter) matchCondition(value *tree.ConditionValue, cond spCondition, level int) int {
+	if value.Type == tree.CONDITION_NAME {
+		if value = interpreter.lookupCondition(value.Name, level); value == nil {
+			return 0
+		}
+	}
+	switch value.Type {
+	case tree.CONDITION_ERROR_CODE:
+		if value.ErrorCode == cond.errno {
+			return 3
+		}
+	case tree.CONDITION_SQLSTATE:
+		if value.SqlState == cond.sqlState {
+			return 2
+		}
+	case tree.CONDITION_SQLWARNING:
+		if cond.class() == "01" {
+			return 1
+		}
+	case tree.CONDITION_NOT_FOUND:
+		if cond.class() == "02" {
+			return 1
+		}
+	case tree.CONDITION_SQLEXCEPTION:
+		if class := cond.class(); class != "00" && class != "01" && class != "02" {
+			return 1
+		}
+	}
+	return 0
+}
+
+// signal raises the condition of SIGNAL, or the condition being handled for RESIGNAL.
+func (interpreter *Interpreter) signal(st *tree.Signal) error {
+	var cond spCondition
+	if st.IsResignal {
+		if len(interpreter.handling) == 0 {
+			return interpreter.newSpError(moerr.ER_RESIGNAL_WITHOUT_ACTIVE_HANDLER)
+		}
+		cond = interpreter.handling[len(interpreter.handling)-1].conditions[0]
+	}
+
+	if st.Condition != nil {
+		value := st.Condition
+		if value.Type == tree.CONDITION_NAME {
+			if value = interpreter.lookupCondition(value.Name, len(interpreter.blockScope)-1); value == nil {
+				return interpreter.newSpError(moerr.ER_SP_COND_MISMATCH, st.Condition.Name)
+			}
+		}
+		if value.Type != tree.CONDITION_SQLSTATE {
+			return interpreter.newSpError(moerr.ER_SIGNAL_BAD_CONDITION_TYPE)
+		}
+		cond = spCondition{sqlState: value.SqlState}
+		switch cond.class() {
+		case "01":
+			cond.errno = moerr.ER_SIGNAL_WARN
+		case "02":
+			cond.errno = moerr.ER_SIGNAL_NOT_FOUND
+		default:
+			cond.errno = moerr.ER_SIGNAL_EXCEPTION
+		}
+		cond.message = moerr.MysqlErrorMsgRefer[cond.errno].ErrorMsgOrFormat
+	}
+
+	for _, item := range st.Items {
+		value, err := interpreter.evalExpr(item.Value)
+		if err != nil {
+			return err
+		}
+		switch item.Name {
+		case "MESSAGE_TEXT":
+			cond.message = fmt.Sprintf("%v", value)
+		case "MYSQL_ERRNO":
+			errno, err := strconv.ParseUint(fmt.Sprintf("%v", value), 10, 16)
+			if err != nil || errno == 0 {
+				return moerr.NewInvalidInputf(interpreter.ctx, "variable 'MYSQL_ERRNO' can't be set to the value of '%v'", value)
+			}
+			cond.errno = uint16(errno)
+		}
+		// the other condition information items are accepted but not kept
+	}
+	return moerr.NewSignal(interpreter.ctx, cond.sqlState, cond.errno, cond.message)
+}
+
+// getDiagnostics assigns the statement or condition information of the diagnostics area to the targets.
+func (interpreter *Interpreter) getDiagnostics(st *tree.GetDiagnostics) error {
+	area := interpreter.diag
+	if st.Stacked {
+		if len(interpreter.handling) == 0 {
+			return interpreter.newSpError(moerr.ER_GET_STACKED_DA_WITHOUT_ACTIVE_HANDLER)
+		}
+		area = interpreter.handling[len(interpreter.handling)-1]
+	}
+
+	var cond spCondition
+	if st.Condition != nil {
+		value, err := interpreter.evalExpr(st.Condition)
+		if err != nil {
+			return err
+		}
+		number, err := strconv.ParseInt(fmt.Sprintf("%v", value), 10, 64)
+		if err != nil || number < 1 || number > int64(len(area.conditions)) {
+			return interpreter.newSpError(moerr.ER_DA_INVALID_CONDITION_NUMBER)
+		}
+		cond = area.conditions[number-1]
+	}
+
+	for _, item := range st.Items {
+		var value interface{}
+		switch item.Name {
+		case "NUMBER":
+			value = int64(len(area.conditions))
+		case "ROW_COUNT":
+			value = area.rowCount
+		case "RETURNED_SQLSTATE":
+			value = cond.sqlState
+		case "MYSQL_ERRNO":
+			value = int64(cond.errno)
+		case "MESSAGE_TEXT":
+			value = cond.message
+		default:
+			value = ""
+		}
+
+		var err error
+		if strings.HasPrefix(item.Target, "@") {
+			err = interpreter.ses.SetUserDefinedVar(item.Target[1:], value, "")
+		} else {
+			err = interpreter.SetSpVar(item.Target, value)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
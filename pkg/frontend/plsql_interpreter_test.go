@@ -0,0 +1,191 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+func newTestInterpreter(bh BackgroundExec, vars ...string) *Interpreter {
+	scope := make(map[string]interface{})
+	for _, v := range vars {
+		scope[v] = nil
+	}
+	varScope := []map[string]interface{}{scope}
+	return &Interpreter{
+		ctx:         context.TODO(),
+		bh:          bh,
+		fmtctx:      tree.NewFmtCtx(dialect.MYSQL, tree.WithQuoteString(true)),
+		varScope:    &varScope,
+		outParamMap: make(map[string]interface{}),
+		blockScope:  []*spBlockScope{newSpBlockScope()},
+	}
+}
+
+func getErrno(t *testing.T, err error) uint16 {
+	var moErr *moerr.Error
+	require.True(t, errors.As(err, &moErr), err)
+	return moErr.MySQLCode()
+}
+
+// countConditions is a handler body which saves the number of the handled conditions to the variable.
+func countConditions(target string) tree.Statement {
+	return &tree.GetDiagnostics{
+		Stacked: true,
+		Items:   []*tree.DiagnosticsItem{{Target: target, Name: "NUMBER"}},
+	}
+}
+
+func TestInterpreterCursor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mrs := &MysqlResultSet{}
+	for _, name := range []string{"a", "b"} {
+		col := &MysqlColumn{}
+		col.SetName(name)
+		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		mrs.AddColumn(col)
+	}
+	mrs.AddRow([]interface{}{"x", []byte("1")})
+	mrs.AddRow([]interface{}{"y", []byte("2")})
+
+	bh := mock_frontend.NewMockBackgroundExec(ctrl)
+	bh.EXPECT().Exec(gomock.Any(), "select a, b from t").Return(nil).Times(1)
+	bh.EXPECT().GetExecResultSet().Return([]interface{}{mrs}).AnyTimes()
+	bh.EXPECT().ClearExecResultSet().Return().AnyTimes()
+
+	interpreter := newTestInterpreter(bh, "a", "b", "done")
+	stmt, err := parsers.ParseOne(context.TODO(), dialect.MYSQL, "select a, b from t", 1)
+	require.NoError(t, err)
+
+	run := func(st tree.Statement) error {
+		_, err := interpreter.interpret(st)
+		return err
+	}
+	fetch := &tree.FetchCursor{Name: "cur", Variables: []string{"a", "b"}}
+
+	require.NoError(t, run(&tree.DeclareCursor{Name: "cur", Select: stmt.(*tree.Select)}))
+	require.Equal(t, moerr.ER_SP_DUP_CURS, getErrno(t, run(&tree.DeclareCursor{Name: "CUR", Select: stmt.(*tree.Select)})))
+	require.Equal(t, moerr.ER_SP_CURSOR_NOT_OPEN, getErrno(t, run(fetch)))
+	require.Equal(t, moerr.ER_SP_CURSOR_MISMATCH, getErrno(t, run(&tree.OpenCursor{Name: "nocur"})))
+
+	require.NoError(t, run(&tree.DeclareHandler{
+		Action:     tree.HANDLER_CONTINUE,
+		Conditions: []*tree.ConditionValue{{Type: tree.CONDITION_NOT_FOUND}},
+		Body:       countConditions("done"),
+	}))
+	require.NoError(t, run(&tree.OpenCursor{Name: "cur"}))
+	require.Equal(t, moerr.ER_SP_CURSOR_ALREADY_OPEN, getErrno(t, run(&tree.OpenCursor{Name: "cur"})))
+	require.Equal(t, moerr.ER_SP_WRONG_NO_OF_FETCH_ARGS, getErrno(t, run(&tree.FetchCursor{Name: "cur", Variables: []string{"a"}})))
+
+	var got []interface{}
+	for {
+		require.NoError(t, run(fetch))
+		if v, _ := interpreter.GetSpVar("done"); v != nil {
+			break
+		}
+		a, _ := interpreter.GetSpVar("a")
+		b, _ := interpreter.GetSpVar("b")
+		got = append(got, a, b)
+	}
+	require.Equal(t, []interface{}{"x", "1", "y", "2"}, got)
+	v, _ := interpreter.GetSpVar("done")
+	require.Equal(t, int64(1), v)
+	require.Equal(t, "02000", interpreter.diag.conditions[0].sqlState)
+
+	require.NoError(t, run(&tree.CloseCursor{Name: "cur"}))
+	require.Equal(t, moerr.ER_SP_CURSOR_NOT_OPEN, getErrno(t, run(&tree.CloseCursor{Name: "cur"})))
+}
+
+func TestInterpreterHandler(t *testing.T) {
+	interpreter := newTestInterpreter(nil, "x", "y", "z")
+	run := func(st tree.Statement) error {
+		_, err := interpreter.interpret(st)
+		return err
+	}
+	resignal := &tree.Signal{IsResignal: true}
+
+	// the outer block exits on any exception
+	require.NoError(t, run(&tree.DeclareHandler{
+		Action:     tree.HANDLER_EXIT,
+		Conditions: []*tree.ConditionValue{{Type: tree.CONDITION_SQLEXCEPTION}},
+		Body:       countConditions("y"),
+	}))
+
+	// the inner block prefers the handler of the error code and resignals the condition
+	interpreter.blockScope = append(interpreter.blockScope, newSpBlockScope())
+	require.NoError(t, run(&tree.DeclareHandler{
+		Action:     tree.HANDLER_CONTINUE,
+		Conditions: []*tree.ConditionValue{{Type: tree.CONDITION_SQLEXCEPTION}},
+		Body:       countConditions("x"),
+	}))
+	require.NoError(t, run(&tree.DeclareCondition{
+		Name:  "no_table",
+		Value: &tree.ConditionValue{Type: tree.CONDITION_ERROR_CODE, ErrorCode: moerr.ER_NO_SUCH_TABLE},
+	}))
+	require.NoError(t, run(&tree.DeclareHandler{
+		Action:     tree.HANDLER_CONTINUE,
+		Conditions: []*tree.ConditionValue{{Type: tree.CONDITION_NAME, Name: "no_table"}},
+		Body:       resignal,
+	}))
+
+	_, err := interpreter.handleCondition(moerr.NewNoSuchTable(context.TODO(), "db", "t"))
+	var exit *spExitBlock
+	require.True(t, errors.As(err, &exit))
+	require.Equal(t, 0, exit.level)
+	x, _ := interpreter.GetSpVar("x")
+	y, _ := interpreter.GetSpVar("y")
+	require.Nil(t, x)
+	require.Equal(t, int64(1), y)
+	require.False(t, interpreter.blockScope[0].inactive || interpreter.blockScope[1].inactive)
+
+	// the other exceptions are handled by the inner block
+	require.NoError(t, run(&tree.Signal{Condition: &tree.ConditionValue{Type: tree.CONDITION_SQLSTATE, SqlState: "45000"}}))
+	x, _ = interpreter.GetSpVar("x")
+	require.Equal(t, int64(1), x)
+	require.Equal(t, moerr.ER_SIGNAL_EXCEPTION, interpreter.diag.conditions[0].errno)
+	require.Equal(t, "Unhandled user-defined exception condition", interpreter.diag.conditions[0].message)
+
+	// a condition defined with an error code can not be signaled, and the unhandled warning is ignored
+	require.Equal(t, moerr.ER_SIGNAL_BAD_CONDITION_TYPE, getErrno(t, interpreter.signal(&tree.Signal{Condition: &tree.ConditionValue{Type: tree.CONDITION_NAME, Name: "no_table"}})))
+	interpreter.blockScope = interpreter.blockScope[:1]
+	require.NoError(t, run(&tree.Signal{Condition: &tree.ConditionValue{Type: tree.CONDITION_SQLSTATE, SqlState: "01000"}}))
+	require.Equal(t, moerr.ER_SIGNAL_WARN, interpreter.diag.conditions[0].errno)
+
+	// no active handler
+	require.Equal(t, moerr.ER_RESIGNAL_WITHOUT_ACTIVE_HANDLER, getErrno(t, interpreter.signal(resignal)))
+	require.Equal(t, moerr.ER_GET_STACKED_DA_WITHOUT_ACTIVE_HANDLER, getErrno(t, interpreter.getDiagnostics(countConditions("z").(*tree.GetDiagnostics))))
+
+	// the unhandled condition is not handled again by the enclosing statements
+	interpreter.blockScope[0].handlers = nil
+	_, err = interpreter.handleCondition(moerr.NewNoSuchTable(context.TODO(), "db", "t"))
+	var unhandled *spUnhandled
+	require.True(t, errors.As(err, &unhandled))
+	_, err2 := interpreter.handleCondition(err)
+	require.Equal(t, err, err2)
+}
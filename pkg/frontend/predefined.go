@@ -295,6 +295,68 @@ var (
     			takes bigint unsigned,
     			primary key(account_id, database_id, table_id)
 			)`, catalog.MO_TABLE_STATS)
+
+	MoCatalogMoTriggersDDL = fmt.Sprintf(`create table mo_catalog.%s (
+				trigger_name varchar(64),
+				database_id bigint unsigned,
+				table_id bigint unsigned,
+				event_manipulation varchar(10),
+				action_timing varchar(10),
+				action_order int unsigned,
+				action_statement text,
+				definer varchar(288),
+				created_time timestamp,
+				sql_mode varchar(1024),
+				character_set_client varchar(64),
+				collation_connection varchar(64),
+				database_collation varchar(64),
+				primary key(database_id, trigger_name)
+			)`, catalog.MO_TRIGGERS)
+
+	MoCatalogMoEventsDDL = fmt.Sprintf(`create table mo_catalog.%s (
+				event_name varchar(64),
+				database_id bigint unsigned,
+				definer varchar(288),
+				event_body text,
+				event_type varchar(9),
+				execute_at timestamp,
+				interval_value bigint,
+				interval_field varchar(18),
+				starts timestamp,
+				ends timestamp,
+				status varchar(18),
+				on_completion varchar(12),
+				created timestamp,
+				last_altered timestamp,
+				last_executed timestamp,
+				next_execute_at timestamp,
+				event_comment varchar(2048),
+				primary key(database_id, event_name)
+			)`, catalog.MO_EVENTS)
+
+	MoCatalogMoEventLogDDL = fmt.Sprintf(`create table mo_catalog.%s (
+				database_id bigint unsigned,
+				event_name varchar(64),
+				started_at timestamp,
+				finished_at timestamp,
+				status varchar(16),
+				affected_rows bigint unsigned,
+				error_message text,
+				primary key(database_id, event_name, started_at)
+			)`, catalog.MO_EVENT_LOG)
+
+	MoCatalogMoTtlDDL = fmt.Sprintf(`create table mo_catalog.%s (
+				table_id bigint unsigned,
+				database_id bigint unsigned,
+				ttl_column varchar(256),
+				interval_value bigint,
+				interval_unit varchar(16),
+				last_purged timestamp,
+				primary key(table_id)
+			)`, catalog.MO_TTL)
+
+	MoCatalogMoEventHistoryDDL = fmt.Sprintf(`CREATE VIEW mo_catalog.%s AS SELECT db.datname AS event_schema, log.event_name, log.started_at, log.finished_at, log.status, log.affected_rows, log.error_message FROM mo_catalog.%s log join mo_catalog.mo_database db on log.database_id = db.dat_id where db.account_id = current_account_id()`,
+		catalog.MO_EVENT_HISTORY, catalog.MO_EVENT_LOG)
 )
 
 // `mo_catalog` database system tables
@@ -600,10 +600,12 @@ func doDumpQueryResult(ctx context.Context, ses *Session, eParam *tree.ExportPar
 		userConfig: eParam,
 		mrs:        mrs,
 		service:    ses.GetService(),
+		typs:       typs,
 	}
 	//prepare output queue
 	//prepare export param
 	exportParam.DefaultBufSize = getPu(exportParam.service).SV.ExportDataDefaultFlushSize
+	exportParam.RowGroupSize = getPu(exportParam.service).SV.ExportParquetRowGroupSize
 	exportParam.FileService = getPu(exportParam.service).FileService
 	exportParam.Ctx = ctx
 	defer func() {
@@ -648,6 +650,13 @@ func doDumpQueryResult(ctx context.Context, ses *Session, eParam *tree.ExportPar
 			defer release()
 			tmpBatch = bat
 
+			if exportParam.format != tree.CSV {
+				if err = exportDataFromBatchToFile(ctx, exportParam, tmpBatch, ses.GetTimeZone()); err != nil {
+					return err
+				}
+				continue
+			}
+
 			//step2.1: converts it into the csv string
 			//step2.2: writes the csv string into the outfile
 			n := tmpBatch.RowCount()
@@ -32,7 +32,21 @@ func execInFrontend(ses *Session, execCtx *ExecCtx) (stats statistic.StatsArray,
 		RecordStatementTxnID(execCtx.reqCtx, ses)
 	case *tree.CommitTransaction:
 	case *tree.RollbackTransaction:
-	case *tree.SavePoint, *tree.ReleaseSavePoint, *tree.RollbackToSavePoint:
+	case *tree.SavePoint:
+		err = handleSavepoint(ses, execCtx, st)
+		if err != nil {
+			return
+		}
+	case *tree.ReleaseSavePoint:
+		err = ses.GetTxnHandler().ReleaseSavepoint(execCtx, string(st.Name))
+		if err != nil {
+			return
+		}
+	case *tree.RollbackToSavePoint:
+		err = handleRollbackToSavepoint(ses, execCtx, st)
+		if err != nil {
+			return
+		}
 	case *tree.SetRole:
 		ses.EnterFPrint(FPSetRole)
 		defer ses.ExitFPrint(FPSetRole)
@@ -456,6 +470,24 @@ func execInFrontend(ses *Session, execCtx *ExecCtx) (stats statistic.StatsArray,
 		if err != nil {
 			return
 		}
+	case *tree.CreateEvent:
+		ses.EnterFPrint(FPCreateEvent)
+		defer ses.ExitFPrint(FPCreateEvent)
+		if err = handleCreateEvent(ses, execCtx, st); err != nil {
+			return
+		}
+	case *tree.AlterEvent:
+		ses.EnterFPrint(FPAlterEvent)
+		defer ses.ExitFPrint(FPAlterEvent)
+		if err = handleAlterEvent(ses, execCtx, st); err != nil {
+			return
+		}
+	case *tree.DropEvent:
+		ses.EnterFPrint(FPDropEvent)
+		defer ses.ExitFPrint(FPDropEvent)
+		if err = handleDropEvent(ses, execCtx, st); err != nil {
+			return
+		}
 	case *tree.SetConnectionID:
 		ses.EnterFPrint(FPSetConnectionID)
 		defer ses.ExitFPrint(FPSetConnectionID)
@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"maps"
 	"net"
 	"runtime"
 	"strings"
@@ -404,6 +405,24 @@ func (ses *Session) CopySeqToProc(proc *process.Process) {
 	proc.GetSessionInfo().SeqLastValue[0] = *ses.seqLastValue
 }
 
+// getSeqValues returns a copy of the sequence values of the session
+func (ses *Session) getSeqValues() (map[uint64]string, string) {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	return maps.Clone(ses.seqCurValues), *ses.seqLastValue
+}
+
+// setSeqValues restores the sequence values returned by getSeqValues
+func (ses *Session) setSeqValues(curValues map[uint64]string, lastValue string) {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	clear(ses.seqCurValues)
+	for k, v := range curValues {
+		ses.seqCurValues[k] = v
+	}
+	*ses.seqLastValue = lastValue
+}
+
 func (ses *Session) InheritSequenceData(other *Session) {
 	ses.mu.Lock()
 	defer ses.mu.Unlock()
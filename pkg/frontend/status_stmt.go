@@ -18,8 +18,10 @@ import (
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
@@ -48,8 +50,13 @@ func executeStatusStmt(ses *Session, execCtx *ExecCtx) (err error) {
 				mrs.AddColumn(mysqlc)
 			}
 
+			for _, c := range plan2.GetResultColumnsFromPlan(execCtx.cw.Plan()) {
+				ep.typs = append(ep.typs, types.New(types.T(c.Typ.Id), c.Typ.Width, c.Typ.Scale))
+			}
+
 			// open new file
 			ep.DefaultBufSize = getPu(ses.GetService()).SV.ExportDataDefaultFlushSize
+			ep.RowGroupSize = getPu(ses.GetService()).SV.ExportParquetRowGroupSize
 			initExportFileParam(ep, mrs)
 			if err = openNewFile(execCtx.reqCtx, ep, mrs); err != nil {
 				return
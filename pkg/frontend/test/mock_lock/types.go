@@ -333,6 +333,34 @@ func (mr *MockLockServiceMockRecorder) Lock(ctx, tableID, rows, txnID, options a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockLockService)(nil).Lock), ctx, tableID, rows, txnID, options)
 }
 
+// RollbackToSavepoint mocks base method.
+func (m *MockLockService) RollbackToSavepoint(ctx context.Context, txnID []byte, sp lockservice.LockSavepoint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackToSavepoint", ctx, txnID, sp)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackToSavepoint indicates an expected call of RollbackToSavepoint.
+func (mr *MockLockServiceMockRecorder) RollbackToSavepoint(ctx, txnID, sp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackToSavepoint", reflect.TypeOf((*MockLockService)(nil).RollbackToSavepoint), ctx, txnID, sp)
+}
+
+// Savepoint mocks base method.
+func (m *MockLockService) Savepoint(txnID []byte) lockservice.LockSavepoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Savepoint", txnID)
+	ret0, _ := ret[0].(lockservice.LockSavepoint)
+	return ret0
+}
+
+// Savepoint indicates an expected call of Savepoint.
+func (mr *MockLockServiceMockRecorder) Savepoint(txnID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Savepoint", reflect.TypeOf((*MockLockService)(nil).Savepoint), txnID)
+}
+
 // Unlock mocks base method.
 func (m *MockLockService) Unlock(ctx context.Context, txnID []byte, commitTS timestamp.Timestamp, mutations ...lock.ExtraMutation) error {
 	m.ctrl.T.Helper()
@@ -1273,6 +1273,18 @@ func (mr *MockWorkspaceMockRecorder) Readonly() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Readonly", reflect.TypeOf((*MockWorkspace)(nil).Readonly))
 }
 
+// ReleaseSavepoint mocks base method.
+func (m *MockWorkspace) ReleaseSavepoint(id int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReleaseSavepoint", id)
+}
+
+// ReleaseSavepoint indicates an expected call of ReleaseSavepoint.
+func (mr *MockWorkspaceMockRecorder) ReleaseSavepoint(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseSavepoint", reflect.TypeOf((*MockWorkspace)(nil).ReleaseSavepoint), id)
+}
+
 // Rollback mocks base method.
 func (m *MockWorkspace) Rollback(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -1301,6 +1313,35 @@ func (mr *MockWorkspaceMockRecorder) RollbackLastStatement(ctx interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackLastStatement", reflect.TypeOf((*MockWorkspace)(nil).RollbackLastStatement), ctx)
 }
 
+// RollbackToSavepoint mocks base method.
+func (m *MockWorkspace) RollbackToSavepoint(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackToSavepoint", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackToSavepoint indicates an expected call of RollbackToSavepoint.
+func (mr *MockWorkspaceMockRecorder) RollbackToSavepoint(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackToSavepoint", reflect.TypeOf((*MockWorkspace)(nil).RollbackToSavepoint), ctx, id)
+}
+
+// Savepoint mocks base method.
+func (m *MockWorkspace) Savepoint(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Savepoint", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Savepoint indicates an expected call of Savepoint.
+func (mr *MockWorkspaceMockRecorder) Savepoint(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Savepoint", reflect.TypeOf((*MockWorkspace)(nil).Savepoint), ctx)
+}
+
 // SetHaveDDL mocks base method.
 func (m *MockWorkspace) SetHaveDDL(flag bool) {
 	m.ctrl.T.Helper()
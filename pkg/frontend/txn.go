@@ -17,6 +17,7 @@ package frontend
 import (
 	"context"
 	"errors"
+	"strings"
 	"sync"
 
 	"github.com/google/uuid"
@@ -27,6 +28,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	moruntime "github.com/matrixorigin/matrixone/pkg/common/runtime"
 	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/lockservice"
 	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/matrixorigin/matrixone/pkg/txn/clock"
@@ -187,6 +189,23 @@ type TxnHandler struct {
 
 	//the option bits
 	optionBits uint32
+
+	//the savepoints of the active txn, in the order of creation
+	savepoints []*txnSavepoint
+}
+
+// txnSavepoint records the state of the txn at a SAVEPOINT statement.
+type txnSavepoint struct {
+	name string
+	// savepoint id in the workspace
+	workspaceID int
+	// the locks held by the txn, nil if the txn is not pessimistic
+	locks lockservice.LockSavepoint
+
+	// session states which are changed by the writes
+	lastInsertID uint64
+	seqCurValues map[uint64]string
+	seqLastValue string
 }
 
 func InitTxnHandler(service string, storage engine.Engine, connCtx context.Context, txnOp TxnOperator) *TxnHandler {
@@ -236,6 +255,7 @@ func (th *TxnHandler) GetTxnCtx() context.Context {
 // invalidateTxnUnsafe releases the txnOp and clears the server status bit SERVER_STATUS_IN_TRANS
 func (th *TxnHandler) invalidateTxnUnsafe() {
 	th.txnOp = nil
+	th.savepoints = nil
 	resetBits(&th.serverStatus, defaultServerStatus)
 	resetBits(&th.optionBits, defaultOptionBits)
 }
@@ -439,6 +459,87 @@ func (th *TxnHandler) GetTxn() TxnOperator {
 	return th.txnOp
 }
 
+// Savepoint sets a savepoint on the active txn.
+// A savepoint with the same name is replaced.
+func (th *TxnHandler) Savepoint(execCtx *ExecCtx, sp *txnSavepoint) error {
+	th.mu.Lock()
+	defer th.mu.Unlock()
+	if th.txnOp == nil {
+		return moerr.NewInternalError(execCtx.reqCtx, "no active txn for savepoint")
+	}
+	ws := th.txnOp.GetWorkspace()
+	if idx := th.findSavepointUnsafe(sp.name); idx != -1 {
+		ws.ReleaseSavepoint(th.savepoints[idx].workspaceID)
+		th.savepoints = append(th.savepoints[:idx], th.savepoints[idx+1:]...)
+	}
+
+	id, err := ws.Savepoint(execCtx.reqCtx)
+	if err != nil {
+		return err
+	}
+	sp.workspaceID = id
+	if ls := execCtx.proc.Base.LockService; ls != nil && th.txnOp.Txn().IsPessimistic() {
+		sp.locks = ls.Savepoint(th.txnOp.Txn().ID)
+	}
+	th.savepoints = append(th.savepoints, sp)
+	return nil
+}
+
+// RollbackToSavepoint discards the writes after the savepoint and releases the
+// locks acquired after it. The savepoints set after it are removed.
+func (th *TxnHandler) RollbackToSavepoint(execCtx *ExecCtx, name string) (*txnSavepoint, error) {
+	th.mu.Lock()
+	defer th.mu.Unlock()
+	idx := th.findSavepointUnsafe(name)
+	if idx == -1 {
+		return nil, moerr.NewSavepointNotExist(execCtx.reqCtx, name)
+	}
+	sp := th.savepoints[idx]
+	ws := th.txnOp.GetWorkspace()
+	if err := ws.RollbackToSavepoint(execCtx.reqCtx, sp.workspaceID); err != nil {
+		return nil, err
+	}
+	if sp.locks != nil {
+		if err := execCtx.proc.Base.LockService.RollbackToSavepoint(
+			execCtx.reqCtx,
+			th.txnOp.Txn().ID,
+			sp.locks,
+		); err != nil {
+			return nil, err
+		}
+	}
+	for _, later := range th.savepoints[idx+1:] {
+		ws.ReleaseSavepoint(later.workspaceID)
+	}
+	th.savepoints = th.savepoints[:idx+1]
+	return sp, nil
+}
+
+// ReleaseSavepoint removes the savepoint and the savepoints set after it.
+func (th *TxnHandler) ReleaseSavepoint(execCtx *ExecCtx, name string) error {
+	th.mu.Lock()
+	defer th.mu.Unlock()
+	idx := th.findSavepointUnsafe(name)
+	if idx == -1 {
+		return moerr.NewSavepointNotExist(execCtx.reqCtx, name)
+	}
+	ws := th.txnOp.GetWorkspace()
+	for _, sp := range th.savepoints[idx:] {
+		ws.ReleaseSavepoint(sp.workspaceID)
+	}
+	th.savepoints = th.savepoints[:idx]
+	return nil
+}
+
+func (th *TxnHandler) findSavepointUnsafe(name string) int {
+	for i, sp := range th.savepoints {
+		if strings.EqualFold(sp.name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
 // Commit commits the txn.
 // option bits decide the actual commit behaviour
 func (th *TxnHandler) Commit(execCtx *ExecCtx) error {
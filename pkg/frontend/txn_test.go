@@ -17,6 +17,7 @@ package frontend
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sync"
 	"testing"
 	"time"
@@ -32,6 +33,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/pb/txn"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/matrixorigin/matrixone/pkg/txn/clock"
 	"github.com/matrixorigin/matrixone/pkg/txn/rpc"
@@ -47,6 +49,9 @@ type testWorkspace struct {
 	stack      []uint64
 	stmtId     uint64
 	reportErr1 bool
+
+	savepoints    []int
+	nextSavepoint int
 }
 
 func (txn *testWorkspace) Readonly() bool {
@@ -127,6 +132,30 @@ func (txn *testWorkspace) RollbackLastStatement(ctx context.Context) error {
 	return nil
 }
 
+func (txn *testWorkspace) Savepoint(ctx context.Context) (int, error) {
+	txn.mu.Lock()
+	defer txn.mu.Unlock()
+	id := txn.nextSavepoint
+	txn.nextSavepoint++
+	txn.savepoints = append(txn.savepoints, id)
+	return id, nil
+}
+
+func (txn *testWorkspace) RollbackToSavepoint(ctx context.Context, id int) error {
+	txn.mu.Lock()
+	defer txn.mu.Unlock()
+	if !slices.Contains(txn.savepoints, id) {
+		panic("BUG: savepoint not found")
+	}
+	return nil
+}
+
+func (txn *testWorkspace) ReleaseSavepoint(id int) {
+	txn.mu.Lock()
+	defer txn.mu.Unlock()
+	txn.savepoints = slices.DeleteFunc(txn.savepoints, func(v int) bool { return v == id })
+}
+
 func (t *testWorkspace) WriteOffset() uint64 {
 	//TODO implement me
 	panic("implement me")
@@ -758,6 +787,67 @@ func Test_rollbackStatement6(t *testing.T) {
 	})
 }
 
+func Test_savepoint(t *testing.T) {
+	convey.Convey("savepoint", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := defines.AttachAccountId(context.TODO(), sysAccountID)
+		txnClient := mock_frontend.NewMockTxnClient(ctrl)
+		txnClient.EXPECT().New(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, commitTS timestamp.Timestamp, options ...TxnOption) (client.TxnOperator, error) {
+				return newTestTxnOp(), nil
+			}).AnyTimes()
+		eng := mock_frontend.NewMockEngine(ctrl)
+		eng.EXPECT().New(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		eng.EXPECT().Hints().Return(engine.Hints{
+			CommitOrRollbackTimeout: time.Second,
+		}).AnyTimes()
+
+		ses := newTestSession(t, ctrl)
+		getPu("").TxnClient = txnClient
+		ses.txnHandler.storage = eng
+
+		ec := newTestExecCtx(ctx, ctrl)
+		ec.ses = ses
+		ec.proc = testutil.NewProc()
+		ec.txnOpt = FeTxnOption{
+			autoCommit: true,
+			byBegin:    true,
+		}
+		err := ses.GetTxnHandler().Create(ec)
+		convey.So(err, convey.ShouldBeNil)
+		ws := ses.GetTxnHandler().GetTxn().GetWorkspace().(*testWorkspace)
+
+		ses.SetLastInsertID(1)
+		convey.So(handleSavepoint(ses, ec, &tree.SavePoint{Name: "a"}), convey.ShouldBeNil)
+		ses.SetLastInsertID(2)
+		convey.So(handleSavepoint(ses, ec, &tree.SavePoint{Name: "b"}), convey.ShouldBeNil)
+		ses.SetLastInsertID(3)
+		convey.So(handleSavepoint(ses, ec, &tree.SavePoint{Name: "c"}), convey.ShouldBeNil)
+		convey.So(ws.savepoints, convey.ShouldResemble, []int{0, 1, 2})
+
+		// replace the savepoint with the same name
+		convey.So(handleSavepoint(ses, ec, &tree.SavePoint{Name: "C"}), convey.ShouldBeNil)
+		convey.So(ws.savepoints, convey.ShouldResemble, []int{0, 1, 3})
+
+		ses.SetLastInsertID(4)
+		err = handleRollbackToSavepoint(ses, ec, &tree.RollbackToSavePoint{Name: "b"})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ses.GetLastInsertID(), convey.ShouldEqual, 2)
+		convey.So(ws.savepoints, convey.ShouldResemble, []int{0, 1})
+
+		err = handleRollbackToSavepoint(ses, ec, &tree.RollbackToSavePoint{Name: "c"})
+		convey.So(moerr.IsMoErrCode(err, moerr.ErrSavepointNotExist), convey.ShouldBeTrue)
+
+		err = ses.GetTxnHandler().ReleaseSavepoint(ec, "a")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ws.savepoints, convey.ShouldBeEmpty)
+		err = ses.GetTxnHandler().ReleaseSavepoint(ec, "b")
+		convey.So(moerr.IsMoErrCode(err, moerr.ErrSavepointNotExist), convey.ShouldBeTrue)
+	})
+}
+
 func Test_commit(t *testing.T) {
 	convey.Convey("commit txn", t, func() {
 		ctrl := gomock.NewController(t)
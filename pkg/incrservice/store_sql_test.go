@@ -127,6 +127,16 @@ func (tls *testLockService) IsOrphanTxn(ctx context.Context, bytes []byte) (bool
 	return false, moerr.NewInternalErrorNoCtx("return error")
 }
 
+func (tls *testLockService) Savepoint(txnID []byte) lockservice.LockSavepoint {
+	//TODO implement me
+	panic("implement me")
+}
+
+func (tls *testLockService) RollbackToSavepoint(ctx context.Context, txnID []byte, sp lockservice.LockSavepoint) error {
+	//TODO implement me
+	panic("implement me")
+}
+
 func (tls *testLockService) Close() error {
 	//TODO implement me
 	panic("implement me")
@@ -235,6 +235,41 @@ func (s *service) Unlock(
 	return nil
 }
 
+func (s *service) Savepoint(txnID []byte) LockSavepoint {
+	sp := make(LockSavepoint)
+	txn := s.activeTxnHolder.getActiveTxn(txnID, false, "")
+	if txn == nil {
+		return sp
+	}
+
+	txn.RLock()
+	defer txn.RUnlock()
+	if !bytes.Equal(txn.txnID, txnID) {
+		return sp
+	}
+	txn.savepoint(sp)
+	return sp
+}
+
+func (s *service) RollbackToSavepoint(
+	ctx context.Context,
+	txnID []byte,
+	sp LockSavepoint) error {
+	s.wait()
+
+	txn := s.activeTxnHolder.getActiveTxn(txnID, false, "")
+	if txn == nil {
+		return nil
+	}
+
+	txn.Lock()
+	defer txn.Unlock()
+	if !bytes.Equal(txn.txnID, txnID) {
+		return nil
+	}
+	return txn.rollbackToSavepoint(sp, s.getLockTable, s.logger)
+}
+
 func (s *service) IsOrphanTxn(
 	ctx context.Context,
 	txn []byte,
@@ -643,3 +643,45 @@ func checkBind(
 	l := s.tableGroups.get(0, bind.Table)
 	assert.Equal(t, bind, l.getBind())
 }
+
+func TestRollbackToSavepointKeepsRemoteLocks(t *testing.T) {
+	runLockServiceTests(
+		t,
+		[]string{"s1", "s2"},
+		func(alloc *lockTableAllocator, s []*service) {
+			tableID := uint64(10)
+
+			l1 := s[0]
+			l2 := s[1]
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+			defer cancel()
+
+			txn1 := []byte("txn1")
+			txn2 := []byte("txn2")
+			row1 := []byte{1}
+			row2 := []byte{2}
+
+			// the lock table is bound to l1
+			mustAddTestLock(t, ctx, l1, tableID, txn1, [][]byte{row1}, pb.Granularity_Row)
+			lt, err := l1.getLockTable(0, tableID)
+			require.NoError(t, err)
+			local := lt.(*localLockTable)
+
+			sp := l2.Savepoint(txn2)
+			mustAddTestLock(t, ctx, l2, tableID, txn2, [][]byte{row2}, pb.Granularity_Row)
+			lt, err = l2.getLockTable(0, tableID)
+			require.NoError(t, err)
+			_, ok := lt.(*remoteLockTable)
+			require.True(t, ok)
+
+			// the locks on remote lock table are kept until the txn is unlocked
+			require.NoError(t, l2.RollbackToSavepoint(ctx, txn2, sp))
+			checkLock(t, local, row2, [][]byte{txn2}, nil, nil)
+			require.NotEqual(t, sp, l2.Savepoint(txn2))
+
+			require.NoError(t, l2.Unlock(ctx, txn2, timestamp.Timestamp{}))
+			checkLock(t, local, row2, nil, nil, nil)
+			require.NoError(t, l1.Unlock(ctx, txn1, timestamp.Timestamp{}))
+		},
+	)
+}
@@ -141,6 +141,50 @@ func TestReentrantRowLock(t *testing.T) {
 	}
 }
 
+func TestRollbackToSavepoint(t *testing.T) {
+	for name, runner := range runners {
+		t.Run(name, func(t *testing.T) {
+			table := uint64(0)
+			runner(
+				t,
+				table,
+				func(
+					ctx context.Context,
+					s *service,
+					lt *localLockTable) {
+					option := newTestRowExclusiveOptions()
+					txn1 := newTestTxnID(1)
+					txn2 := newTestTxnID(2)
+
+					_, err := s.Lock(ctx, table, newTestRows(1), txn1, option)
+					require.NoError(t, err)
+					defer func() {
+						assert.NoError(t, s.Unlock(ctx, txn1, timestamp.Timestamp{}))
+					}()
+
+					sp := s.Savepoint(txn1)
+					require.Equal(t, 1, sp[0][table])
+
+					_, err = s.Lock(ctx, table, newTestRows(1, 2, 3), txn1, option)
+					require.NoError(t, err)
+					checkLock(t, lt, []byte{2}, [][]byte{txn1}, nil, nil)
+
+					require.NoError(t, s.RollbackToSavepoint(ctx, txn1, sp))
+					checkLock(t, lt, []byte{1}, [][]byte{txn1}, nil, nil)
+					checkLock(t, lt, []byte{2}, nil, nil, nil)
+					checkLock(t, lt, []byte{3}, nil, nil, nil)
+					require.Equal(t, sp, s.Savepoint(txn1))
+
+					// the released rows can be locked by other txn
+					_, err = s.Lock(ctx, table, newTestRows(2, 3), txn2, option)
+					require.NoError(t, err)
+					checkLock(t, lt, []byte{2}, [][]byte{txn2}, nil, nil)
+					require.NoError(t, s.Unlock(ctx, txn2, timestamp.Timestamp{}))
+				})
+		})
+	}
+}
+
 func TestRowLockWithSharedAndExclusive(t *testing.T) {
 	for name, runner := range runners {
 		t.Run(name, func(t *testing.T) {
@@ -129,6 +129,99 @@ func (txn *activeTxn) lockAdded(
 	return nil
 }
 
+func (txn *activeTxn) savepoint(sp LockSavepoint) {
+	for group, h := range txn.lockHolders {
+		m := make(map[uint64]int, len(h.tableKeys))
+		for table, cs := range h.tableKeys {
+			keys := cs.slice()
+			m[table] = keys.len()
+			keys.unref()
+		}
+		sp[group] = m
+	}
+}
+
+// rollbackToSavepoint releases the lock keys added after the savepoint. The keys
+// which are also held before the savepoint are kept.
+func (txn *activeTxn) rollbackToSavepoint(
+	sp LockSavepoint,
+	lockTableFunc func(uint32, uint64) (lockTable, error),
+	logger *log.MOLogger,
+) error {
+	for group, h := range txn.lockHolders {
+		for table, cs := range h.tableKeys {
+			if err := txn.rollbackTableToSavepoint(
+				group,
+				table,
+				cs,
+				sp[group][table],
+				lockTableFunc,
+				logger,
+			); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (txn *activeTxn) rollbackTableToSavepoint(
+	group uint32,
+	table uint64,
+	cs *cowSlice,
+	n int,
+	lockTableFunc func(uint32, uint64) (lockTable, error),
+	logger *log.MOLogger,
+) error {
+	keys := cs.slice()
+	defer keys.unref()
+	if keys.len() <= n {
+		return nil
+	}
+
+	l, err := lockTableFunc(group, table)
+	if err != nil {
+		return err
+	}
+	// The unlock request of the remote lock table releases all the locks of the
+	// txn on the table, so the keys acquired after the savepoint cannot be released
+	// alone. They are kept in tableKeys and released when the txn is unlocked.
+	if _, ok := l.(*localLockTable); !ok {
+		return nil
+	}
+
+	all := keys.all()
+	held := make(map[string]struct{}, n)
+	for _, key := range all[:n] {
+		held[util.UnsafeBytesToString(key)] = struct{}{}
+	}
+	released := make([][]byte, 0, len(all)-n)
+	for _, key := range all[n:] {
+		if _, ok := held[util.UnsafeBytesToString(key)]; !ok {
+			released = append(released, key)
+		}
+	}
+
+	if len(released) > 0 {
+		rcs, err := newCowSlice(txn.fsp, released)
+		if err != nil {
+			return err
+		}
+		logTxnUnlockTable(logger, txn, table)
+		l.unlock(txn, rcs, timestamp.Timestamp{})
+		logTxnUnlockTableCompleted(logger, txn, table, rcs)
+		rcs.close()
+	}
+
+	newV, err := newCowSlice(txn.fsp, all[:n])
+	if err != nil {
+		return err
+	}
+	txn.getHoldLocksLocked(group).tableKeys[table] = newV
+	cs.close()
+	return nil
+}
+
 func (txn *activeTxn) close(
 	txnID []byte,
 	commitTS timestamp.Timestamp,
@@ -108,6 +108,13 @@ type LockService interface {
 	Unlock(ctx context.Context, txnID []byte, commitTS timestamp.Timestamp, mutations ...pb.ExtraMutation) error
 	// IsOrphanTxn check txn is orphan txn
 	IsOrphanTxn(context.Context, []byte) (bool, error)
+	// Savepoint returns the locks held by the txn now, it is used to release the locks
+	// acquired after it by RollbackToSavepoint.
+	Savepoint(txnID []byte) LockSavepoint
+	// RollbackToSavepoint releases the locks acquired by the txn after the savepoint.
+	// Only the locks on the local lock tables are released, locks on the remote lock
+	// tables are kept until the txn is unlocked.
+	RollbackToSavepoint(ctx context.Context, txnID []byte, sp LockSavepoint) error
 
 	// Close close the lock service.
 	Close() error
@@ -127,6 +134,10 @@ type LockService interface {
 	CloseRemoteLockTable(group uint32, tableID, version uint64) (bool, error)
 }
 
+// LockSavepoint records the number of lock keys held by a txn on each
+// table, group -> table -> count.
+type LockSavepoint map[uint32]map[uint64]int
+
 type ResumeLockService interface {
 	LockService
 
@@ -0,0 +1,178 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tokenizer
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+//go:embed dict.txt
+var defaultDictData []byte
+
+var defaultDict struct {
+	sync.Once
+	dict *Dictionary
+	err  error
+}
+
+// DefaultDictionary returns the builtin dictionary of the common chinese words
+func DefaultDictionary() (*Dictionary, error) {
+	defaultDict.Do(func() {
+		defaultDict.dict, defaultDict.err = LoadDictionary(bytes.NewReader(defaultDictData))
+	})
+	return defaultDict.dict, defaultDict.err
+}
+
+// Dictionary is the word frequencies used to segment the CJK text like jieba does, i.e. find
+// the segmentation with the maximum probability among all the words of the dictionary.
+type Dictionary struct {
+	freq map[string]float64
+	// log of the total frequency
+	logTotal float64
+	// max number of runes of the words
+	maxLen int
+}
+
+// NewDictionary returns the dictionary of the word frequencies
+func NewDictionary(words map[string]int) (*Dictionary, error) {
+	d := &Dictionary{freq: make(map[string]float64, len(words))}
+	total := 0.0
+	for w, f := range words {
+		if f <= 0 || len(w) == 0 {
+			return nil, moerr.NewInternalErrorNoCtxf("invalid dictionary word '%s' with frequency %d", w, f)
+		}
+		d.freq[w] = float64(f)
+		total += float64(f)
+		d.maxLen = max(d.maxLen, utf8.RuneCountInString(w))
+	}
+	if total == 0 {
+		return nil, moerr.NewInternalErrorNoCtx("dictionary is empty")
+	}
+	d.logTotal = math.Log(total)
+	return d, nil
+}
+
+// LoadDictionary reads the dictionary in jieba format, one word per line followed by its frequency
+// and an optional part of speech, e.g. "北京 34488 ns".  Empty lines and lines starting with # are ignored.
+func LoadDictionary(r io.Reader) (*Dictionary, error) {
+	words := make(map[string]int)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			return nil, moerr.NewInternalErrorNoCtxf("invalid dictionary line '%s'", line)
+		}
+		f, err := strconv.Atoi(fields[1])
+		if err != nil {
+			return nil, moerr.NewInternalErrorNoCtxf("invalid dictionary line '%s'", line)
+		}
+		words[fields[0]] = f
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return NewDictionary(words)
+}
+
+func (d *Dictionary) Contains(word string) bool {
+	_, ok := d.freq[word]
+	return ok
+}
+
+// Segment returns the end offset (exclusive) of the words of the best segmentation.  offsets are
+// the byte offsets of the runes of s ending with len(s).
+func (d *Dictionary) Segment(s []byte, offsets []int) []int {
+	n := len(offsets) - 1
+	// route[i] is the max log probability of s[offsets[i]:] and next[i] is the rune index of the end of the first word
+	route := make([]float64, n+1)
+	next := make([]int, n+1)
+	for i := n - 1; i >= 0; i-- {
+		// single rune is always a candidate even if it is not in dictionary
+		best := d.logFreq(s[offsets[i]:offsets[i+1]]) - d.logTotal + route[i+1]
+		bestEnd := i + 1
+		for j := i + 2; j <= min(n, i+d.maxLen); j++ {
+			f, ok := d.freq[string(s[offsets[i]:offsets[j]])]
+			if !ok {
+				continue
+			}
+			if r := math.Log(f) - d.logTotal + route[j]; r > best {
+				best, bestEnd = r, j
+			}
+		}
+		route[i], next[i] = best, bestEnd
+	}
+
+	ends := make([]int, 0, n)
+	for i := 0; i < n; i = next[i] {
+		ends = append(ends, next[i])
+	}
+	return ends
+}
+
+func (d *Dictionary) logFreq(word []byte) float64 {
+	if f, ok := d.freq[string(word)]; ok {
+		return math.Log(f)
+	}
+	return 0
+}
+
+// outputDictionary outputs the words of the CJK text from t.begin to pos.  Like the search mode of jieba,
+// the dictionary words of 2 and 3 runes inside a longer word are output before the word to improve the recall.
+func (t *SimpleTokenizer) outputDictionary(pos int, yield func(Token) bool) {
+	ibuf := t.input[t.begin:pos]
+	offsets := runeOffsets(ibuf)
+
+	start := 0
+	for _, end := range t.dict.Segment(ibuf, offsets) {
+		if end-start > 2 {
+			for n := 2; n <= 3 && n < end-start; n++ {
+				for i := start; i+n <= end; i++ {
+					word := ibuf[offsets[i]:offsets[i+n]]
+					if len(word) <= MAX_TOKEN_SIZE && t.dict.Contains(string(word)) {
+						if !t.outputToken(word, offsets[i], yield) {
+							return
+						}
+					}
+				}
+			}
+		}
+
+		word := ibuf[offsets[start]:offsets[end]]
+		if len(word) > MAX_TOKEN_SIZE {
+			word = word[:offsets[start+1]-offsets[start]]
+			for i := start + 2; i <= end && offsets[i]-offsets[start] <= MAX_TOKEN_SIZE; i++ {
+				word = ibuf[offsets[start]:offsets[i]]
+			}
+		}
+		if !t.outputToken(word, offsets[start], yield) {
+			return
+		}
+		start = end
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tokenizer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDictionaryTokenizer(t *testing.T) {
+	require.Equal(t, []string{"清华", "大学", "清华大学", "研究", "研究生"},
+		tokenizeWith(t, TOKENIZER_DICTIONARY, "清华大学研究生", Config{}))
+	require.Equal(t, []string{"我们", "喜欢", "智能", "手机", "智能手机", "和", "iphone"},
+		tokenizeWith(t, TOKENIZER_DICTIONARY, "我们喜欢智能手机和iPhone", Config{}))
+	// unknown runes are single tokens
+	require.Equal(t, []string{"中华", "铅笔", "2b", "的", "好用"},
+		tokenizeWith(t, TOKENIZER_DICTIONARY, "中华铅笔2B的好用", Config{}))
+
+	tok, err := NewTokenizer(TOKENIZER_DICTIONARY, []byte("新款 笔记本电脑"), Config{})
+	require.NoError(t, err)
+	var pos []int32
+	for tk := range tok.Tokenize() {
+		pos = append(pos, tk.BytePos)
+	}
+	// 新款, 电脑, 笔记本, 笔记本电脑
+	require.Equal(t, []int32{0, 16, 7, 7}, pos)
+}
+
+func TestLoadDictionary(t *testing.T) {
+	dict, err := LoadDictionary(strings.NewReader("# comment\n南京 100 ns\n南京市 50\n市长 80\n长江 90\n长江大桥 60\n大桥 40\n"))
+	require.NoError(t, err)
+	require.True(t, dict.Contains("长江大桥"))
+	tok, err := NewDictionaryTokenizer([]byte("南京市长江大桥"), dict)
+	require.NoError(t, err)
+	var words []string
+	for tk := range tok.Tokenize() {
+		words = append(words, string(tk.TokenBytes[1:tk.TokenBytes[0]+1]))
+	}
+	require.Equal(t, []string{"南京", "南京市", "长江", "大桥", "长江大桥"}, words)
+
+	_, err = LoadDictionary(strings.NewReader("南京\n"))
+	require.Error(t, err)
+	_, err = LoadDictionary(strings.NewReader("南京 x\n"))
+	require.Error(t, err)
+	_, err = LoadDictionary(strings.NewReader(""))
+	require.Error(t, err)
+	_, err = NewDictionaryTokenizer(nil, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,70 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tokenizer
+
+import (
+	"testing"
+)
+
+func TestPorterStem(t *testing.T) {
+	cases := map[string]string{
+		"caresses":        "caress",
+		"ponies":          "poni",
+		"cats":            "cat",
+		"feed":            "feed",
+		"agreed":          "agre",
+		"plastered":       "plaster",
+		"motoring":        "motor",
+		"sing":            "sing",
+		"conflated":       "conflat",
+		"troubled":        "troubl",
+		"sized":           "size",
+		"hopping":         "hop",
+		"tanned":          "tan",
+		"falling":         "fall",
+		"hissing":         "hiss",
+		"fizzed":          "fizz",
+		"failing":         "fail",
+		"filing":          "file",
+		"happy":           "happi",
+		"sky":             "sky",
+		"relational":      "relat",
+		"conditional":     "condit",
+		"rational":        "ration",
+		"generalizations": "gener",
+		"oscillators":     "oscil",
+		"running":         "run",
+		"connection":      "connect",
+		"connected":       "connect",
+		"connecting":      "connect",
+		"connections":     "connect",
+		"hopefulness":     "hope",
+		"goodness":        "good",
+		"adjustment":      "adjust",
+		"electrical":      "electr",
+		"searching":       "search",
+		"products":        "product",
+		// not stemmed
+		"is":   "is",
+		"h1n1": "h1n1",
+		"café": "café",
+		"中文搜索": "中文搜索",
+	}
+	for word, stem := range cases {
+		if got := PorterStem(word); got != stem {
+			t.Errorf("PorterStem(%s) = %s, want %s", word, got, stem)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tokenizer
+
+import (
+	"iter"
+	"strings"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+const (
+	TOKENIZER_DEFAULT    = "default"
+	TOKENIZER_NGRAM      = "ngram"
+	TOKENIZER_DICTIONARY = "dictionary"
+
+	STEMMER_NONE   = "none"
+	STEMMER_PORTER = "porter"
+)
+
+type Tokenizer interface {
+	Tokenize() iter.Seq[Token]
+}
+
+// Config is the options of the tokenizer
+type Config struct {
+	// number of runes of the CJK ngram, 0 means DEFAULT_NGRAM_SIZE
+	NgramSize int
+	// the tokens in stopwords are removed
+	Stopwords map[string]struct{}
+	// name of the stemmer applied to the latin tokens, empty means no stemming
+	Stemmer string
+}
+
+// Factory creates the tokenizer of the input
+type Factory func(input []byte, cfg Config) (Tokenizer, error)
+
+var registry = struct {
+	sync.RWMutex
+	factories map[string]Factory
+}{
+	factories: map[string]Factory{
+		TOKENIZER_DEFAULT:    newNgramTokenizer,
+		TOKENIZER_NGRAM:      newNgramTokenizer,
+		TOKENIZER_DICTIONARY: newDictionaryTokenizer,
+	},
+}
+
+// Register registers the tokenizer factory with the name, which can be used by WITH PARSER of the fulltext index
+func Register(name string, factory Factory) {
+	registry.Lock()
+	defer registry.Unlock()
+	registry.factories[strings.ToLower(name)] = factory
+}
+
+func IsRegistered(name string) bool {
+	registry.RLock()
+	defer registry.RUnlock()
+	_, ok := registry.factories[strings.ToLower(name)]
+	return ok
+}
+
+// NewTokenizer returns the tokenizer registered with the name, and the tokens are filtered by the stopwords
+// and stemmed by the stemmer of the config.  Empty name means the default tokenizer.
+func NewTokenizer(name string, input []byte, cfg Config) (Tokenizer, error) {
+	if len(name) == 0 {
+		name = TOKENIZER_DEFAULT
+	}
+	registry.RLock()
+	factory, ok := registry.factories[strings.ToLower(name)]
+	registry.RUnlock()
+	if !ok {
+		return nil, moerr.NewInternalErrorNoCtxf("tokenizer %s not found", name)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	tok, err := factory(input, cfg)
+	if err != nil {
+		return nil, err
+	}
+	if len(cfg.Stopwords) == 0 && !cfg.stemming() {
+		return tok, nil
+	}
+	return &filterTokenizer{tok: tok, cfg: cfg}, nil
+}
+
+func (cfg *Config) Validate() error {
+	if cfg.NgramSize < 0 || cfg.NgramSize > MAX_NGRAM_SIZE {
+		return moerr.NewInternalErrorNoCtxf("invalid ngram_token_size %d, must be between 1 and %d", cfg.NgramSize, MAX_NGRAM_SIZE)
+	}
+	switch strings.ToLower(cfg.Stemmer) {
+	case "", STEMMER_NONE, STEMMER_PORTER:
+	default:
+		return moerr.NewInternalErrorNoCtxf("invalid stemmer %s", cfg.Stemmer)
+	}
+	return nil
+}
+
+// Normalize returns the word stemmed by the config, and false if the word is a stopword
+func (cfg *Config) Normalize(word string) (string, bool) {
+	if _, ok := cfg.Stopwords[word]; ok {
+		return word, false
+	}
+	if cfg.stemming() {
+		word = PorterStem(word)
+	}
+	return word, true
+}
+
+func (cfg *Config) stemming() bool {
+	return strings.ToLower(cfg.Stemmer) == STEMMER_PORTER
+}
+
+func newNgramTokenizer(input []byte, cfg Config) (Tokenizer, error) {
+	n := cfg.NgramSize
+	if n == 0 {
+		n = DEFAULT_NGRAM_SIZE
+	}
+	return NewNgramTokenizer(input, n)
+}
+
+func newDictionaryTokenizer(input []byte, cfg Config) (Tokenizer, error) {
+	dict, err := DefaultDictionary()
+	if err != nil {
+		return nil, err
+	}
+	return NewDictionaryTokenizer(input, dict)
+}
+
+// filterTokenizer removes the stopwords and stems the tokens of the underlying tokenizer
+type filterTokenizer struct {
+	tok Tokenizer
+	cfg Config
+}
+
+func (f *filterTokenizer) Tokenize() iter.Seq[Token] {
+	return func(yield func(Token) bool) {
+		for t := range f.tok.Tokenize() {
+			word := string(t.TokenBytes[1 : t.TokenBytes[0]+1])
+			normalized, ok := f.cfg.Normalize(word)
+			if !ok {
+				continue
+			}
+			if normalized != word {
+				t.TokenBytes = [1 + MAX_TOKEN_SIZE]byte{byte(len(normalized))}
+				copy(t.TokenBytes[1:], normalized)
+			}
+			if !yield(t) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tokenizer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func tokenizeWith(t *testing.T, name string, input string, cfg Config) []string {
+	tok, err := NewTokenizer(name, []byte(input), cfg)
+	require.NoError(t, err)
+	var words []string
+	for tk := range tok.Tokenize() {
+		words = append(words, string(tk.TokenBytes[1:tk.TokenBytes[0]+1]))
+	}
+	return words
+}
+
+func TestNgramSize(t *testing.T) {
+	require.Equal(t, []string{"相见", "见时", "时难", "难"},
+		tokenizeWith(t, TOKENIZER_NGRAM, "相见时难", Config{NgramSize: 2}))
+	require.Equal(t, []string{"相", "见", "hello"},
+		tokenizeWith(t, TOKENIZER_NGRAM, "相见 Hello", Config{NgramSize: 1}))
+	// default is same as the simple tokenizer
+	require.Equal(t, []string{"相见时", "见时难", "时难", "难"},
+		tokenizeWith(t, "", "相见时难", Config{}))
+
+	// 4 bytes runes are truncated to MAX_TOKEN_SIZE
+	words := tokenizeWith(t, TOKENIZER_NGRAM, strings.Repeat("𠀀", 8), Config{NgramSize: 7})
+	require.Equal(t, 8, len(words))
+	require.Equal(t, strings.Repeat("𠀀", 5), words[0])
+
+	_, err := NewTokenizer(TOKENIZER_NGRAM, nil, Config{NgramSize: 8})
+	require.Error(t, err)
+	_, err = NewTokenizer("unknown", nil, Config{})
+	require.Error(t, err)
+	_, err = NewTokenizer(TOKENIZER_NGRAM, nil, Config{Stemmer: "snowball"})
+	require.Error(t, err)
+}
+
+func TestStopwordsAndStemmer(t *testing.T) {
+	cfg := Config{Stopwords: ParseStopwords(STOPWORDS_ENGLISH), Stemmer: STEMMER_PORTER}
+	tok, err := NewTokenizer(TOKENIZER_DEFAULT, []byte("The running shoes of the Runner"), cfg)
+	require.NoError(t, err)
+	var tokens []Token
+	for tk := range tok.Tokenize() {
+		tokens = append(tokens, tk)
+	}
+	require.Equal(t, 3, len(tokens))
+	require.Equal(t, makeToken("run", 1).TokenBytes, tokens[0].TokenBytes)
+	require.Equal(t, int32(4), tokens[0].BytePos)
+	require.Equal(t, makeToken("shoe", 2).TokenBytes, tokens[1].TokenBytes)
+	require.Equal(t, makeToken("runner", 5).TokenBytes, tokens[2].TokenBytes)
+	require.Equal(t, int32(5), tokens[2].TokenPos)
+
+	require.Equal(t, []string{"green", "apple"},
+		tokenizeWith(t, TOKENIZER_DEFAULT, "green and APPLE", Config{Stopwords: ParseStopwords("And, or")}))
+	require.Nil(t, ParseStopwords(STOPWORDS_NONE))
+
+	// register a new tokenizer
+	Register("Unigram", func(input []byte, cfg Config) (Tokenizer, error) {
+		return NewNgramTokenizer(input, 1)
+	})
+	require.True(t, IsRegistered("unigram"))
+	require.Equal(t, []string{"别", "亦", "难"}, tokenizeWith(t, "unigram", "别亦难", Config{NgramSize: 3}))
+}
@@ -26,6 +26,10 @@ import (
 
 const (
 	MAX_TOKEN_SIZE = 23
+
+	// default number of runes of the CJK ngram
+	DEFAULT_NGRAM_SIZE = 3
+	MAX_NGRAM_SIZE     = 7
 )
 
 type Token struct {
@@ -43,15 +47,39 @@ type SimpleTokenizer struct {
 	currTokenPos int
 	latinBuf     bytes.Buffer
 
+	// number of runes of the CJK ngram
+	ngramSize int
+	// segment the CJK text by dictionary instead of ngram if not nil
+	dict *Dictionary
+
 	Done bool
 	Err  error
 }
 
 func NewSimpleTokenizer(input []byte) (*SimpleTokenizer, error) {
+	return NewNgramTokenizer(input, DEFAULT_NGRAM_SIZE)
+}
+
+// NewNgramTokenizer splits the latin text into words and the CJK text into ngrams of n runes
+func NewNgramTokenizer(input []byte, n int) (*SimpleTokenizer, error) {
 	if len(input) > 1024*1024*1024 {
 		return nil, moerr.NewInternalErrorNoCtx("input too large")
 	}
-	return &SimpleTokenizer{input: input}, nil
+	if n < 1 || n > MAX_NGRAM_SIZE {
+		return nil, moerr.NewInternalErrorNoCtxf("invalid ngram size %d", n)
+	}
+	return &SimpleTokenizer{input: input, ngramSize: n}, nil
+}
+
+// NewDictionaryTokenizer splits the latin text into words and the CJK text into the words of the dictionary
+func NewDictionaryTokenizer(input []byte, dict *Dictionary) (*SimpleTokenizer, error) {
+	if len(input) > 1024*1024*1024 {
+		return nil, moerr.NewInternalErrorNoCtx("input too large")
+	}
+	if dict == nil {
+		return nil, moerr.NewInternalErrorNoCtx("dictionary is nil")
+	}
+	return &SimpleTokenizer{input: input, ngramSize: DEFAULT_NGRAM_SIZE, dict: dict}, nil
 }
 
 func isBreakerRune(rune rune) bool {
@@ -164,33 +192,53 @@ func (t *SimpleTokenizer) outputLatin(pos int, yield func(Token) bool) {
 // outputCJK outputs the CJK token from t.begin to pos
 // if token contains latin letter, we do not normalize like outputLatin
 func (t *SimpleTokenizer) outputCJK(pos int, yield func(Token) bool) {
+	if t.dict != nil {
+		t.outputDictionary(pos, yield)
+		return
+	}
+
+	// ngram starts from every rune and the last ngrams are shorter than ngramSize
 	ibuf := t.input[t.begin:pos]
-	ia := 0
-	_, ib := utf8.DecodeRune(ibuf)
-	_, sz := utf8.DecodeRune(ibuf[ib:])
-	ic := ib + sz
-	_, sz = utf8.DecodeRune(ibuf[ic:])
-	id := ic + sz
-
-	for ia < id {
-		token := Token{}
-		token.TokenBytes[0] = byte(id - ia)
-		copy(token.TokenBytes[1:], ibuf[ia:id])
-		token.TokenPos = int32(t.currTokenPos)
-		token.BytePos = int32(t.begin + ia)
-		if !yield(token) {
-			t.Done = true
+	offsets := runeOffsets(ibuf)
+	nrune := len(offsets) - 1
+	for i := 0; i < nrune; i++ {
+		end := offsets[min(i+t.ngramSize, nrune)]
+		// drop the last runes if the ngram is too long
+		for j := min(i+t.ngramSize, nrune); end-offsets[i] > MAX_TOKEN_SIZE; j-- {
+			end = offsets[j-1]
+		}
+		if !t.outputToken(ibuf[offsets[i]:end], offsets[i], yield) {
 			return
 		}
-		t.currTokenPos += 1
-		ia = ib
-		ib = ic
-		ic = id
-		_, sz = utf8.DecodeRune(ibuf[id:])
-		id += sz
 	}
 }
 
+// outputToken outputs the token at offset of t.begin
+func (t *SimpleTokenizer) outputToken(bs []byte, offset int, yield func(Token) bool) bool {
+	token := Token{}
+	token.TokenBytes[0] = byte(len(bs))
+	copy(token.TokenBytes[1:], bs)
+	token.TokenPos = int32(t.currTokenPos)
+	token.BytePos = int32(t.begin + offset)
+	if !yield(token) {
+		t.Done = true
+		return false
+	}
+	t.currTokenPos += 1
+	return true
+}
+
+// runeOffsets returns the byte offset of every rune and the length of the buffer
+func runeOffsets(buf []byte) []int {
+	offsets := make([]int, 0, len(buf)/3+1)
+	for i := 0; i < len(buf); {
+		offsets = append(offsets, i)
+		_, sz := utf8.DecodeRune(buf[i:])
+		i += sz
+	}
+	return append(offsets, len(buf))
+}
+
 func (t *SimpleTokenizer) Tokenize() iter.Seq[Token] {
 	return func(yield func(Token) bool) {
 		if len(t.input) == 0 {
@@ -0,0 +1,55 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tokenizer
+
+import (
+	"strings"
+)
+
+const (
+	STOPWORDS_NONE    = "none"
+	STOPWORDS_ENGLISH = "english"
+)
+
+// same as the default stopword list of InnoDB
+var englishStopwords = []string{
+	"a", "about", "an", "are", "as", "at", "be", "by", "com", "de", "en", "for", "from", "how",
+	"i", "in", "is", "it", "la", "of", "on", "or", "that", "the", "this", "to", "was", "what",
+	"when", "where", "who", "will", "with", "und", "www",
+}
+
+// ParseStopwords returns the stopword set of the stopwords option.  The option is either
+// "none", "english" or the comma separated list of the stopwords.
+func ParseStopwords(option string) map[string]struct{} {
+	option = strings.TrimSpace(option)
+	var words []string
+	switch strings.ToLower(option) {
+	case "", STOPWORDS_NONE:
+		return nil
+	case STOPWORDS_ENGLISH:
+		words = englishStopwords
+	default:
+		words = strings.Split(option, ",")
+	}
+
+	set := make(map[string]struct{}, len(words))
+	for _, w := range words {
+		w = strings.ToLower(strings.TrimSpace(w))
+		if len(w) > 0 {
+			set[w] = struct{}{}
+		}
+	}
+	return set
+}
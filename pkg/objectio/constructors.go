@@ -45,9 +45,9 @@ func constructorFactory(size int64, algo uint8) CacheConstructor {
 			return cacheData, nil
 		}
 
-		// lz4 compress
+		// lz4, zstd or snappy compress
 		decompressed := allocator.AllocateCacheData(ctx, int(size))
-		bs, err := compress.Decompress(data, decompressed.Bytes(), compress.Lz4)
+		bs, err := compress.Decompress(data, decompressed.Bytes(), int(algo))
 		if err != nil {
 			return
 		}
@@ -27,7 +27,6 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
-	"github.com/pierrec/lz4/v4"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
@@ -48,6 +47,8 @@ type objectWriterV1 struct {
 	lastId            uint32
 	name              ObjectName
 	compressBuf       []byte
+	compressAlg       compress.T
+	compressLevel     int
 	bloomFilter       []byte
 	objStats          ObjectStats
 	sortKeySeqnum     uint16
@@ -108,6 +109,7 @@ func newObjectWriterSpecialV1(wt WriterType, fileName string, fs fileservice.Fil
 		blocks:        make([][]blockData, 2),
 		lastId:        0,
 		sortKeySeqnum: math.MaxUint16,
+		compressAlg:   compress.Lz4,
 	}
 	writer.blocks[SchemaData] = make([]blockData, 0)
 	writer.blocks[SchemaTombstone] = make([]blockData, 0)
@@ -127,6 +129,7 @@ func newObjectWriterV1(name ObjectName, fs fileservice.FileService, schemaVersio
 		blocks:        make([][]blockData, 2),
 		lastId:        0,
 		sortKeySeqnum: math.MaxUint16,
+		compressAlg:   compress.Lz4,
 	}
 	writer.blocks[SchemaData] = make([]blockData, 0)
 	writer.blocks[SchemaTombstone] = make([]blockData, 0)
@@ -236,6 +239,13 @@ func (w *objectWriterV1) SetAppendable() {
 	w.appendable = true
 }
 
+// SetCompress sets the compression algorithm of the column data, the default is LZ4.
+// the metadata of the object is always compressed by LZ4.
+func (w *objectWriterV1) SetCompress(alg compress.T, level int) {
+	w.compressAlg = alg
+	w.compressLevel = level
+}
+
 func (w *objectWriterV1) SetSortKeySeqnum(seqnum uint16) {
 	w.sortKeySeqnum = seqnum
 }
@@ -604,19 +614,23 @@ func (w *objectWriterV1) GetDataStats() ObjectStats {
 }
 
 func (w *objectWriterV1) WriteWithCompress(offset uint32, buf []byte) (data []byte, extent Extent, err error) {
+	return w.writeWithCompress(offset, buf, compress.Lz4, 0)
+}
+
+func (w *objectWriterV1) writeWithCompress(offset uint32, buf []byte, alg compress.T, level int) (data []byte, extent Extent, err error) {
 	var tmpData []byte
 	dataLen := len(buf)
-	compressBlockBound := lz4.CompressBlockBound(dataLen)
+	compressBlockBound := compress.CompressBound(dataLen, int(alg))
 	if len(w.compressBuf) < compressBlockBound {
 		w.compressBuf = make([]byte, compressBlockBound)
 	}
-	if tmpData, err = compress.Compress(buf, w.compressBuf[:compressBlockBound], compress.Lz4); err != nil {
+	if tmpData, err = compress.CompressWithLevel(buf, w.compressBuf[:compressBlockBound], int(alg), level); err != nil {
 		return
 	}
 	length := uint32(len(tmpData))
 	data = make([]byte, length)
 	copy(data, tmpData[:length])
-	extent = NewExtent(compress.Lz4, offset, length, uint32(dataLen))
+	extent = NewExtent(uint8(alg), offset, length, uint32(dataLen))
 	return
 }
 
@@ -648,7 +662,7 @@ func (w *objectWriterV1) addBlock(blocks *[]blockData, blockMeta BlockObject, ba
 			return 0, err
 		}
 		var ext Extent
-		if data, ext, err = w.WriteWithCompress(0, buf.Bytes()); err != nil {
+		if data, ext, err = w.writeWithCompress(0, buf.Bytes(), w.compressAlg, w.compressLevel); err != nil {
 			return 0, err
 		}
 		size += len(data)
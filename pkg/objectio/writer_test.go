@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -339,6 +340,58 @@ func TestNewObjectReader(t *testing.T) {
 	assert.Equal(t, uint32(1), meta.BlockCount())
 }
 
+func TestObjectWriterCompress(t *testing.T) {
+	ctx := context.Background()
+
+	dir := InitTestEnv(ModuleName, t.Name())
+	dir = path.Join(dir, "/local")
+	mp := mpool.MustNewZero()
+	bat := newBatch(mp)
+	defer bat.Clean(mp)
+	c := fileservice.Config{
+		Name:    defines.LocalFileServiceName,
+		Backend: "DISK",
+		DataDir: dir,
+		Cache:   fileservice.DisabledCacheConfig,
+	}
+	service, err := fileservice.NewFileService(ctx, c, nil)
+	require.NoError(t, err)
+	defer service.Close(ctx)
+
+	for _, alg := range []compress.T{compress.Lz4, compress.Zstd, compress.Snappy} {
+		name := fmt.Sprintf("%s.blk", alg)
+		objectWriter, err := NewObjectWriterSpecial(WriterNormal, name, service)
+		require.NoError(t, err)
+		objectWriter.SetCompress(alg, 0)
+		_, err = objectWriter.Write(bat)
+		require.NoError(t, err)
+		blocks, err := objectWriter.WriteEnd(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(blocks))
+
+		objectReader, err := NewObjectReaderWithStr(name, service)
+		require.NoError(t, err)
+		ext := blocks[0].BlockHeader().MetaLocation()
+		objectReader.CacheMetaExtent(&ext)
+		metaHeader, err := objectReader.ReadMeta(ctx, nil)
+		require.NoError(t, err)
+		meta, _ := metaHeader.DataMeta()
+		// the metadata is always compressed by LZ4
+		require.Equal(t, uint8(compress.Lz4), ext.Alg())
+		require.Equal(t, uint8(alg), meta.GetBlockMeta(0).MustGetColumn(3).Location().Alg())
+
+		typs := []types.Type{types.T_int64.ToType()}
+		vecs, err := objectReader.ReadOneBlock(ctx, []uint16{3}, typs, 0, mp)
+		require.NoError(t, err)
+		obj, err := Decode(vecs.Entries[0].CachedData.Bytes())
+		require.NoError(t, err)
+		vec := obj.(*vector.Vector)
+		require.Equal(t, bat.RowCount(), vec.Length())
+		require.Equal(t, int64(3), vector.GetFixedAtWithTypeCheck[int64](vec, 3))
+		vecs.Release()
+	}
+}
+
 func newBatch(mp *mpool.MPool) *batch.Batch {
 	types := []types.Type{
 		types.T_int8.ToType(),
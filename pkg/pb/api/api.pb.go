@@ -1770,17 +1770,20 @@ type SchemaExtra struct {
 	DroppedAttrs  []string `protobuf:"bytes,2,rep,name=dropped_attrs,json=droppedAttrs,proto3" json:"dropped_attrs,omitempty"`
 	ColumnChanged bool     `protobuf:"varint,3,opt,name=column_changed,json=columnChanged,proto3" json:"column_changed,omitempty"`
 	// sending mo_tables deletes by this.
-	OldName              string      `protobuf:"bytes,4,opt,name=old_name,json=oldName,proto3" json:"old_name,omitempty"`
-	MinOsizeQuailifed    uint32      `protobuf:"varint,5,opt,name=min_osize_quailifed,json=minOsizeQuailifed,proto3" json:"min_osize_quailifed,omitempty"`
-	MaxObjOnerun         uint32      `protobuf:"varint,6,opt,name=max_obj_onerun,json=maxObjOnerun,proto3" json:"max_obj_onerun,omitempty"`
-	MaxOsizeMergedObj    uint32      `protobuf:"varint,7,opt,name=max_osize_merged_obj,json=maxOsizeMergedObj,proto3" json:"max_osize_merged_obj,omitempty"`
-	Hints                []MergeHint `protobuf:"varint,8,rep,packed,name=hints,proto3,enum=api.MergeHint" json:"hints,omitempty"`
-	MinCnMergeSize       uint64      `protobuf:"varint,9,opt,name=min_cn_merge_size,json=minCnMergeSize,proto3" json:"min_cn_merge_size,omitempty"`
-	BlockMaxRows         uint32      `protobuf:"varint,10,opt,name=block_max_rows,json=blockMaxRows,proto3" json:"block_max_rows,omitempty"`
-	ObjectMaxBlocks      uint32      `protobuf:"varint,11,opt,name=object_max_blocks,json=objectMaxBlocks,proto3" json:"object_max_blocks,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	OldName           string      `protobuf:"bytes,4,opt,name=old_name,json=oldName,proto3" json:"old_name,omitempty"`
+	MinOsizeQuailifed uint32      `protobuf:"varint,5,opt,name=min_osize_quailifed,json=minOsizeQuailifed,proto3" json:"min_osize_quailifed,omitempty"`
+	MaxObjOnerun      uint32      `protobuf:"varint,6,opt,name=max_obj_onerun,json=maxObjOnerun,proto3" json:"max_obj_onerun,omitempty"`
+	MaxOsizeMergedObj uint32      `protobuf:"varint,7,opt,name=max_osize_merged_obj,json=maxOsizeMergedObj,proto3" json:"max_osize_merged_obj,omitempty"`
+	Hints             []MergeHint `protobuf:"varint,8,rep,packed,name=hints,proto3,enum=api.MergeHint" json:"hints,omitempty"`
+	MinCnMergeSize    uint64      `protobuf:"varint,9,opt,name=min_cn_merge_size,json=minCnMergeSize,proto3" json:"min_cn_merge_size,omitempty"`
+	BlockMaxRows      uint32      `protobuf:"varint,10,opt,name=block_max_rows,json=blockMaxRows,proto3" json:"block_max_rows,omitempty"`
+	ObjectMaxBlocks   uint32      `protobuf:"varint,11,opt,name=object_max_blocks,json=objectMaxBlocks,proto3" json:"object_max_blocks,omitempty"`
+	// compression algorithm and level of the column data, see pkg/compress
+	CompressAlg          uint32   `protobuf:"varint,12,opt,name=compress_alg,json=compressAlg,proto3" json:"compress_alg,omitempty"`
+	CompressLevel        int32    `protobuf:"varint,13,opt,name=compress_level,json=compressLevel,proto3" json:"compress_level,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SchemaExtra) Reset()         { *m = SchemaExtra{} }
@@ -1893,6 +1896,20 @@ func (m *SchemaExtra) GetObjectMaxBlocks() uint32 {
 	return 0
 }
 
+func (m *SchemaExtra) GetCompressAlg() uint32 {
+	if m != nil {
+		return m.CompressAlg
+	}
+	return 0
+}
+
+func (m *SchemaExtra) GetCompressLevel() int32 {
+	if m != nil {
+		return m.CompressLevel
+	}
+	return 0
+}
+
 // Int64Map mainly used in unit test
 type Int64Map struct {
 	M                    map[int64]int64 `protobuf:"bytes,1,rep,name=m,proto3" json:"m,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
@@ -2418,165 +2435,167 @@ func init() {
 func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
 
 var fileDescriptor_00212fb1f9d3bf1c = []byte{
-	// 2520 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcb, 0x6f, 0x1c, 0xc7,
-	0xd1, 0xe7, 0x72, 0xdf, 0xb5, 0xaf, 0x61, 0x8b, 0x92, 0xd7, 0xb4, 0x3f, 0x89, 0xdf, 0xf8, 0x45,
-	0xcb, 0x9f, 0x29, 0x7c, 0xb4, 0x93, 0xd8, 0x86, 0x61, 0x43, 0x5c, 0xda, 0xe2, 0x26, 0x24, 0x97,
-	0x19, 0xae, 0x6c, 0xc0, 0x08, 0x30, 0xe8, 0x9d, 0x69, 0x2e, 0x47, 0x3b, 0xd3, 0x3d, 0xea, 0xe9,
-	0x95, 0x48, 0x5f, 0x93, 0x1c, 0x03, 0x04, 0xb9, 0xe5, 0x66, 0x9f, 0x72, 0xc8, 0x35, 0xe7, 0x1c,
-	0x0d, 0x1f, 0x1d, 0xe4, 0xfd, 0x72, 0x1c, 0x07, 0x08, 0x92, 0xfc, 0x15, 0x41, 0x57, 0xf7, 0xec,
-	0x2e, 0x29, 0xd9, 0x89, 0x83, 0x00, 0x3e, 0x90, 0xe8, 0xfa, 0x55, 0x55, 0x4f, 0xbd, 0xba, 0xab,
-	0x7a, 0xa1, 0x4e, 0xd3, 0x68, 0x33, 0x95, 0x42, 0x09, 0x52, 0xa4, 0x69, 0xb4, 0xf6, 0xfc, 0x38,
-	0x52, 0x27, 0xd3, 0xd1, 0x66, 0x20, 0x92, 0x1b, 0x63, 0x31, 0x16, 0x37, 0x90, 0x37, 0x9a, 0x1e,
-	0x23, 0x85, 0x04, 0xae, 0x8c, 0xce, 0x5a, 0x47, 0x45, 0x09, 0xcb, 0x14, 0x4d, 0x52, 0x0b, 0x40,
-	0x1a, 0x53, 0x6e, 0xd6, 0xee, 0xd7, 0xa0, 0x35, 0x3c, 0x38, 0x8c, 0xf8, 0xd8, 0x63, 0x77, 0xa7,
-	0x2c, 0x53, 0xe4, 0x71, 0xa8, 0xa7, 0x54, 0xd2, 0x84, 0x29, 0x26, 0xbb, 0x85, 0xf5, 0xc2, 0x46,
-	0xdd, 0x9b, 0x03, 0xaf, 0xd4, 0xde, 0x7b, 0xff, 0x5a, 0xe1, 0x93, 0xf7, 0xaf, 0x2d, 0xb9, 0x3f,
-	0x29, 0x40, 0x3b, 0xd7, 0xcc, 0x52, 0xc1, 0x33, 0x46, 0xba, 0x50, 0xcd, 0x94, 0x90, 0xac, 0xbf,
-	0x63, 0x15, 0x73, 0x92, 0x3c, 0x0d, 0xed, 0x8c, 0xc9, 0x7b, 0x51, 0xc0, 0x6e, 0x86, 0xa1, 0x64,
-	0x59, 0xd6, 0x5d, 0x46, 0x81, 0x0b, 0x28, 0xee, 0x70, 0x42, 0x65, 0xd8, 0xdf, 0xe9, 0x16, 0xd7,
-	0x0b, 0x1b, 0x25, 0x2f, 0x27, 0xb5, 0x59, 0x92, 0xa5, 0x71, 0x14, 0xd0, 0xfe, 0x4e, 0xb7, 0x84,
-	0xbc, 0x39, 0x40, 0xae, 0x02, 0xc4, 0x62, 0x7c, 0x64, 0x55, 0xcb, 0xc8, 0x5e, 0x40, 0x16, 0xcc,
-	0x7e, 0x05, 0x9c, 0xe1, 0xc1, 0x91, 0x92, 0x8b, 0x76, 0xe3, 0xde, 0x6a, 0x2a, 0xf9, 0x91, 0x9a,
-	0xb9, 0x3c, 0x03, 0x16, 0x74, 0x7f, 0x5c, 0x80, 0xca, 0x5b, 0x2c, 0x50, 0x42, 0x12, 0x02, 0xa5,
-	0x90, 0x2a, 0x8a, 0xd2, 0x4d, 0x0f, 0xd7, 0xe4, 0x2a, 0x94, 0xd4, 0x59, 0xca, 0xd0, 0xb5, 0xc6,
-	0x16, 0x6c, 0x62, 0x94, 0x87, 0x67, 0x29, 0xf3, 0x10, 0x27, 0x6b, 0x50, 0xe3, 0xd3, 0x38, 0xa6,
-	0xa3, 0x98, 0xa1, 0x77, 0x35, 0x6f, 0x46, 0x13, 0x07, 0x8a, 0x3c, 0x4b, 0xd1, 0xb1, 0xa6, 0xa7,
-	0x97, 0xe4, 0x51, 0xa8, 0x45, 0x99, 0x1f, 0x08, 0x9e, 0x29, 0x74, 0xa8, 0xe6, 0x55, 0xa3, 0xac,
-	0xa7, 0x49, 0x2d, 0x1c, 0x33, 0xde, 0xad, 0xac, 0x17, 0x36, 0x5a, 0x9e, 0x5e, 0x6a, 0x73, 0xa8,
-	0x64, 0xb4, 0x5b, 0x35, 0xe6, 0xe8, 0xb5, 0xfb, 0x75, 0x28, 0x6f, 0x53, 0x15, 0x9c, 0x90, 0x35,
-	0x28, 0x53, 0xa5, 0x64, 0xd6, 0x2d, 0xac, 0x17, 0x37, 0xea, 0xdb, 0xa5, 0x0f, 0x3f, 0xbe, 0xb6,
-	0xe4, 0x19, 0x88, 0x3c, 0x05, 0xa5, 0x7b, 0x2c, 0xd0, 0xe9, 0x28, 0x6e, 0x34, 0xb6, 0x1a, 0x9b,
-	0xba, 0xd2, 0x8c, 0x8b, 0x56, 0x0e, 0xd9, 0xee, 0x07, 0x05, 0xa8, 0x0e, 0xb5, 0xa1, 0xfd, 0x1d,
-	0x72, 0x09, 0xca, 0xe1, 0xc8, 0x8f, 0x42, 0xf4, 0xbd, 0xe4, 0x95, 0xc2, 0x51, 0x3f, 0xd4, 0xa0,
-	0x42, 0x70, 0xd9, 0x80, 0x4a, 0x83, 0xff, 0x0b, 0xcd, 0x94, 0x4a, 0x15, 0xa9, 0x48, 0x70, 0xcd,
-	0x33, 0x29, 0x6d, 0xcc, 0xb0, 0x7e, 0x48, 0x2e, 0x43, 0x85, 0x06, 0x81, 0x66, 0x96, 0xd0, 0x9b,
-	0x32, 0x0d, 0x82, 0x7e, 0x48, 0x1e, 0x81, 0x6a, 0x38, 0xf2, 0x39, 0x4d, 0x18, 0xfa, 0x5e, 0xf7,
-	0x2a, 0xe1, 0xe8, 0x80, 0x26, 0x4c, 0x33, 0x94, 0x65, 0x54, 0x0c, 0x43, 0x19, 0xc6, 0x53, 0xd0,
-	0x4e, 0x65, 0x94, 0x50, 0x79, 0xe6, 0x67, 0xec, 0x2e, 0x9f, 0x26, 0x18, 0x8b, 0x96, 0xd7, 0xb2,
-	0xe8, 0x11, 0x82, 0xee, 0x0f, 0x0a, 0xd0, 0x3e, 0x3a, 0xe3, 0xc1, 0x9e, 0x18, 0x0f, 0x69, 0x14,
-	0x7b, 0xec, 0x2e, 0x79, 0x1e, 0xaa, 0x01, 0xf7, 0x4f, 0xe8, 0x3d, 0x86, 0x1e, 0x35, 0xb6, 0x56,
-	0x37, 0xe7, 0x07, 0x66, 0x98, 0xaf, 0xbc, 0x4a, 0xc0, 0x77, 0xe9, 0x3d, 0x66, 0xc5, 0xef, 0x53,
-	0xae, 0x6c, 0xa2, 0x3f, 0x53, 0xfc, 0x6d, 0xca, 0x15, 0x71, 0xa1, 0xac, 0x66, 0x19, 0x6f, 0x6c,
-	0x35, 0x31, 0xc2, 0x36, 0x94, 0x9e, 0x61, 0xb9, 0xdf, 0x82, 0xce, 0x39, 0x9b, 0xb2, 0x54, 0x87,
-	0x2e, 0x98, 0xa4, 0x7e, 0x2c, 0x02, 0xaa, 0x23, 0x65, 0xab, 0xb2, 0x11, 0x4c, 0xd2, 0x3d, 0x0b,
-	0x91, 0xa7, 0xa1, 0x16, 0x88, 0x24, 0xa1, 0x3c, 0xcc, 0xd3, 0x07, 0xb8, 0xf9, 0x1b, 0x5c, 0xc9,
-	0x33, 0x6f, 0xc6, 0x73, 0x5f, 0x83, 0x95, 0x43, 0xc9, 0x34, 0x19, 0xa9, 0xb7, 0x65, 0xa4, 0x58,
-	0x2f, 0x09, 0xc9, 0xb3, 0x00, 0x4c, 0xcb, 0xf9, 0x71, 0x94, 0x29, 0x2c, 0x8c, 0xf3, 0xea, 0x75,
-	0xe4, 0xee, 0x45, 0x99, 0x72, 0xbf, 0x5f, 0x84, 0x32, 0x82, 0xe4, 0x85, 0x5c, 0x09, 0xcb, 0x5c,
-	0x9b, 0xd4, 0xde, 0x5a, 0x9d, 0x2b, 0x99, 0xff, 0x58, 0xf0, 0x46, 0x5d, 0x2f, 0x75, 0x1d, 0xa3,
-	0x97, 0xf3, 0xe2, 0xa8, 0x22, 0xdd, 0x0f, 0xc9, 0x35, 0x68, 0xe8, 0x83, 0x33, 0xa2, 0x19, 0x9b,
-	0x97, 0x07, 0xe4, 0x50, 0x3f, 0x24, 0xff, 0x03, 0x60, 0x74, 0x31, 0xe1, 0x25, 0x73, 0x32, 0x11,
-	0xc1, 0x9c, 0x3f, 0x01, 0xad, 0x99, 0xfe, 0x42, 0xad, 0x34, 0x73, 0x10, 0x85, 0x1e, 0x83, 0xfa,
-	0x71, 0x94, 0x6f, 0x61, 0x6a, 0xa6, 0xa6, 0x01, 0x64, 0x3e, 0x0e, 0xc5, 0x11, 0x55, 0x58, 0x2a,
-	0xb9, 0xff, 0x78, 0x66, 0x3c, 0x0d, 0x93, 0x27, 0xa0, 0x9d, 0x4e, 0xfc, 0xe0, 0x84, 0x05, 0x13,
-	0x7f, 0x74, 0xe6, 0x2b, 0xde, 0xad, 0xad, 0x17, 0x36, 0xca, 0x5e, 0x23, 0x9d, 0xf4, 0x34, 0xb8,
-	0x7d, 0x36, 0xe4, 0xae, 0x84, 0xfa, 0xcc, 0x6f, 0x02, 0x50, 0xe9, 0xf3, 0x8c, 0x49, 0xe5, 0x2c,
-	0xe9, 0xf5, 0x0e, 0x8b, 0x99, 0x62, 0x4e, 0x41, 0xaf, 0x6f, 0xa7, 0x21, 0x55, 0xcc, 0x59, 0x26,
-	0x75, 0x28, 0xdf, 0x8c, 0x15, 0x93, 0x4e, 0x91, 0xac, 0x40, 0xeb, 0x28, 0x65, 0x41, 0x44, 0x63,
-	0x2b, 0x59, 0x22, 0x6d, 0x80, 0x1d, 0xaa, 0xe8, 0x60, 0x74, 0x87, 0x05, 0xca, 0x29, 0x93, 0x4b,
-	0xd0, 0x19, 0x8a, 0x64, 0x94, 0x29, 0xc1, 0x99, 0x05, 0x2b, 0xee, 0x77, 0x0a, 0x00, 0x68, 0x41,
-	0x2a, 0x22, 0xae, 0xc8, 0x73, 0x50, 0x49, 0x22, 0xee, 0xab, 0xec, 0x73, 0x0b, 0xb8, 0x9c, 0x44,
-	0x7c, 0x98, 0xa1, 0x30, 0x3d, 0xd5, 0xc2, 0xcb, 0x9f, 0x2b, 0x4c, 0x4f, 0x87, 0x59, 0x1e, 0x9f,
-	0xe2, 0x43, 0xe3, 0x63, 0xcc, 0xa0, 0x8a, 0xc6, 0x62, 0xdc, 0x9b, 0xa4, 0x5f, 0x9a, 0x19, 0xdf,
-	0x2d, 0x40, 0x63, 0x9f, 0x29, 0xaa, 0xd3, 0xfe, 0x65, 0xda, 0xf1, 0x8f, 0x02, 0x38, 0x98, 0x59,
-	0x3c, 0xde, 0x87, 0x22, 0x8e, 0x82, 0x33, 0xb2, 0x09, 0x97, 0xb4, 0x31, 0x22, 0x8b, 0xde, 0x65,
-	0xfe, 0xdd, 0x29, 0x8d, 0xe2, 0xe8, 0x98, 0x99, 0xbb, 0xb3, 0xe5, 0xad, 0x24, 0x11, 0x1f, 0x68,
-	0xce, 0x37, 0x73, 0x06, 0x79, 0x12, 0xda, 0xda, 0x1e, 0x31, 0xba, 0xe3, 0x0b, 0xce, 0xe4, 0x94,
-	0xa3, 0x5d, 0x2d, 0xaf, 0x99, 0xd0, 0xd3, 0xc1, 0xe8, 0xce, 0x00, 0x31, 0x72, 0x03, 0x56, 0x51,
-	0x0a, 0x77, 0x4d, 0x98, 0x1c, 0xb3, 0x50, 0xab, 0xa0, 0x65, 0x7a, 0x5b, 0x7a, 0x8a, 0xdb, 0xee,
-	0x23, 0x67, 0x30, 0xba, 0x43, 0x9e, 0x84, 0xf2, 0x49, 0xc4, 0x55, 0xd6, 0x2d, 0xad, 0x17, 0x37,
-	0xda, 0x5b, 0x6d, 0xb4, 0x1d, 0xd9, 0xbb, 0x11, 0x57, 0x9e, 0x61, 0x92, 0x67, 0x41, 0x5b, 0xe4,
-	0x07, 0xdc, 0xec, 0xe9, 0xeb, 0x3d, 0x6c, 0x37, 0x6d, 0x27, 0x11, 0xef, 0x71, 0xd4, 0x38, 0x8a,
-	0xde, 0x65, 0xee, 0x4b, 0xb0, 0x3a, 0xf7, 0x15, 0xdb, 0x92, 0xa4, 0xba, 0x16, 0xd7, 0xa1, 0x11,
-	0xcc, 0xa8, 0xcc, 0xf6, 0xc7, 0x45, 0xc8, 0x7d, 0x1e, 0x56, 0x16, 0x35, 0x93, 0x84, 0x71, 0xa5,
-	0x1b, 0x7f, 0x60, 0x96, 0xf9, 0xe8, 0x60, 0x49, 0x77, 0x1f, 0x2e, 0xcf, 0xc5, 0x3d, 0xa6, 0x8f,
-	0x31, 0x2e, 0xf5, 0xc5, 0x22, 0xe2, 0xd0, 0x9c, 0x6b, 0xab, 0x23, 0xe2, 0x10, 0x8f, 0xf5, 0xa3,
-	0x50, 0xe3, 0xec, 0xbe, 0x61, 0x99, 0x41, 0xa3, 0xca, 0xd9, 0x7d, 0xcd, 0x72, 0x39, 0x5c, 0xba,
-	0xb8, 0x5d, 0x4f, 0xc4, 0xff, 0xd9, 0x66, 0xfa, 0x96, 0xce, 0xf4, 0xd8, 0xc4, 0x03, 0xe6, 0xeb,
-	0x96, 0x63, 0xc2, 0xdf, 0xc8, 0xb1, 0x83, 0x69, 0xe2, 0x86, 0x8b, 0xdf, 0xbb, 0x19, 0x86, 0x3d,
-	0x11, 0x4f, 0x13, 0x4e, 0x9e, 0x84, 0x4a, 0x80, 0x2b, 0x5b, 0xa3, 0x4d, 0x33, 0x2d, 0xf4, 0x44,
-	0xbc, 0xc3, 0x8e, 0x3d, 0xcb, 0x23, 0xcf, 0x40, 0x27, 0xc2, 0xeb, 0xc4, 0x4f, 0x45, 0x86, 0x2d,
-	0x13, 0x2d, 0x28, 0x7b, 0x6d, 0x03, 0x1f, 0x5a, 0xd4, 0x3d, 0x82, 0x2b, 0xe7, 0xbe, 0x72, 0x98,
-	0xb7, 0x58, 0xf2, 0x32, 0xb4, 0xe6, 0x3d, 0x38, 0x64, 0xc7, 0xb3, 0x33, 0x81, 0xdf, 0x9b, 0xc9,
-	0x6d, 0x9f, 0xe9, 0xef, 0xce, 0xdb, 0xf5, 0x0e, 0x3b, 0x76, 0xdf, 0x59, 0x4c, 0xf1, 0x8e, 0x14,
-	0xa9, 0xb5, 0xfd, 0x1a, 0x34, 0x62, 0x31, 0x8e, 0x02, 0x1a, 0xfb, 0x51, 0x78, 0x6a, 0x4b, 0x19,
-	0x2c, 0xd4, 0x0f, 0x4f, 0x1f, 0x08, 0xcb, 0xf2, 0x83, 0x61, 0xf9, 0x6b, 0x09, 0x5a, 0x8b, 0x79,
-	0xb8, 0x7b, 0xae, 0x4f, 0x14, 0xce, 0xf7, 0x89, 0xd9, 0xc4, 0xb1, 0xbc, 0x30, 0x71, 0xb8, 0x50,
-	0x9a, 0x44, 0xdc, 0x74, 0x8d, 0xbc, 0xa0, 0x71, 0xc7, 0x6f, 0x44, 0x3c, 0xf4, 0x90, 0x47, 0x5e,
-	0x06, 0xa0, 0x61, 0xe8, 0xdb, 0x48, 0x97, 0xd0, 0xf3, 0xee, 0x5c, 0xf2, 0x7c, 0x4e, 0x76, 0x97,
-	0xbc, 0x3a, 0x9d, 0x25, 0xe8, 0x55, 0x68, 0x84, 0x52, 0xa4, 0xb9, 0x6e, 0x19, 0x75, 0x1f, 0xbd,
-	0xa0, 0x3b, 0x0f, 0xca, 0xee, 0x92, 0x07, 0xe1, 0x3c, 0x44, 0xaf, 0x43, 0x53, 0x62, 0x6d, 0xf9,
-	0xa6, 0xf9, 0x57, 0x50, 0x7d, 0xed, 0x82, 0xfa, 0x42, 0x35, 0xef, 0x2e, 0x79, 0x0d, 0xb9, 0x50,
-	0xdc, 0xaf, 0x43, 0x7b, 0x8a, 0x0d, 0xc3, 0xcf, 0x8f, 0x85, 0xe9, 0x51, 0x57, 0x2e, 0x6c, 0x61,
-	0xcf, 0xcf, 0xee, 0x92, 0xd7, 0x32, 0xf2, 0xf9, 0x81, 0x7a, 0x15, 0x1a, 0xf9, 0x06, 0x99, 0x92,
-	0xd8, 0xb8, 0x1e, 0xb4, 0x7f, 0x7e, 0x6e, 0xb5, 0xfd, 0x76, 0x83, 0x4c, 0x49, 0xf2, 0x2a, 0xd8,
-	0xed, 0xfc, 0x14, 0xaf, 0xb1, 0x6e, 0x1d, 0xf5, 0x2f, 0x5f, 0xd0, 0x37, 0x77, 0xdc, 0xee, 0x92,
-	0xd7, 0x34, 0xd2, 0xf6, 0xce, 0xdb, 0x86, 0x96, 0x0e, 0xfb, 0xac, 0x98, 0xba, 0x80, 0xda, 0x8f,
-	0x3d, 0x18, 0xf9, 0x59, 0xfd, 0xe9, 0x3d, 0xe8, 0xf9, 0xba, 0x05, 0x1b, 0xc1, 0x40, 0xc4, 0xdd,
-	0xc6, 0x43, 0x53, 0x37, 0x3b, 0xbe, 0x3a, 0x75, 0x32, 0x27, 0xb6, 0x1b, 0x50, 0x17, 0x29, 0x93,
-	0x38, 0x25, 0xb9, 0x1f, 0x14, 0xa1, 0x71, 0x14, 0x9c, 0xb0, 0x84, 0xbe, 0x71, 0xaa, 0x24, 0x25,
-	0x4f, 0x43, 0x87, 0xb3, 0x53, 0xa5, 0x77, 0xcd, 0x07, 0x45, 0x53, 0xc0, 0x2d, 0x0d, 0xf7, 0x44,
-	0x6c, 0x06, 0x45, 0x9c, 0x2d, 0xa4, 0x48, 0x53, 0x16, 0xfa, 0x66, 0x78, 0xd6, 0x23, 0x96, 0x9e,
-	0x2d, 0x0c, 0x78, 0xd3, 0x4e, 0xcf, 0x6d, 0x53, 0x1f, 0x7e, 0x70, 0x42, 0xf9, 0x98, 0x85, 0x76,
-	0xae, 0x6f, 0x19, 0xb4, 0x67, 0xc0, 0x73, 0x97, 0x4b, 0xe9, 0xfc, 0xe5, 0xf2, 0x19, 0xed, 0xa1,
-	0xfc, 0xef, 0xb7, 0x87, 0xca, 0x17, 0x68, 0x0f, 0xd5, 0x7f, 0xd9, 0x1e, 0x6a, 0x5f, 0xb8, 0x3d,
-	0xd4, 0x1f, 0xd6, 0x1e, 0xb4, 0x9d, 0xa3, 0x58, 0x04, 0x13, 0x5f, 0xdb, 0x21, 0xc5, 0xfd, 0x0c,
-	0x6b, 0xa0, 0xe5, 0x35, 0x11, 0xdd, 0xa7, 0xa7, 0x9e, 0xb8, 0x9f, 0x91, 0xeb, 0xb0, 0x22, 0x70,
-	0xa6, 0x41, 0x31, 0x64, 0x65, 0x98, 0xeb, 0x96, 0xd7, 0x31, 0x8c, 0x7d, 0x7a, 0xba, 0x8d, 0xb0,
-	0x1b, 0x42, 0xad, 0xcf, 0xd5, 0x57, 0x5f, 0xdc, 0xa7, 0x29, 0x71, 0xa1, 0x90, 0xd8, 0xa1, 0xd5,
-	0xcc, 0x9f, 0x39, 0x67, 0x73, 0xdf, 0x8c, 0xaf, 0x85, 0x64, 0xed, 0x45, 0xa8, 0x18, 0x42, 0x3f,
-	0x97, 0x26, 0xec, 0x0c, 0xd3, 0x5c, 0xf4, 0xf4, 0x92, 0xac, 0x42, 0xf9, 0x1e, 0x8d, 0xa7, 0xe6,
-	0x3e, 0x2f, 0x7a, 0x86, 0x78, 0x65, 0xf9, 0xa5, 0x82, 0xfb, 0x16, 0x34, 0x87, 0x92, 0xf2, 0x6c,
-	0x87, 0x65, 0xfa, 0x76, 0x25, 0x57, 0xa0, 0x22, 0x46, 0x77, 0xfa, 0xf6, 0x9a, 0x2b, 0x7b, 0x96,
-	0xd2, 0xf8, 0x28, 0x9e, 0x68, 0xdc, 0x5c, 0xc8, 0x96, 0xd2, 0xb8, 0x14, 0xf7, 0x35, 0x5e, 0x34,
-	0xb8, 0xa1, 0xdc, 0x6f, 0x17, 0xa0, 0xb1, 0x1d, 0x4f, 0x70, 0x6f, 0xed, 0xc1, 0x73, 0x73, 0x0f,
-	0x1e, 0x31, 0x73, 0xc4, 0x9c, 0x69, 0x9d, 0xb0, 0x0f, 0xb0, 0x42, 0xb2, 0x76, 0xeb, 0x61, 0xae,
-	0x94, 0x8d, 0x2b, 0xcf, 0x2c, 0xba, 0xd2, 0xd8, 0x5a, 0x31, 0xef, 0x8b, 0x05, 0x17, 0x16, 0xbd,
-	0xdb, 0x05, 0x92, 0x7f, 0xe7, 0x98, 0xc9, 0x6d, 0x21, 0x26, 0x11, 0x1f, 0x93, 0x2d, 0xa8, 0x25,
-	0x34, 0x4d, 0x23, 0x3e, 0xce, 0xac, 0x49, 0xce, 0x45, 0x93, 0xac, 0x2d, 0x33, 0x39, 0xf7, 0xa7,
-	0xcb, 0xe0, 0x60, 0xb6, 0x7b, 0xf8, 0xae, 0x30, 0xd6, 0x3d, 0xf4, 0x65, 0x78, 0x19, 0x2a, 0x6a,
-	0x14, 0xcf, 0x6f, 0xef, 0xb2, 0x1a, 0xc5, 0x0f, 0x8c, 0xf6, 0xc5, 0x8b, 0xa3, 0xfd, 0x57, 0xa0,
-	0x96, 0x29, 0x2a, 0x95, 0x8f, 0x23, 0xcb, 0x67, 0x0e, 0x66, 0xd6, 0xae, 0x2a, 0xca, 0x0e, 0x33,
-	0xdd, 0x9a, 0xe6, 0xe5, 0x9e, 0x75, 0xcb, 0xeb, 0xc5, 0x8d, 0xa6, 0x07, 0x49, 0x5e, 0xe7, 0x19,
-	0xbe, 0xab, 0x24, 0xa3, 0x2a, 0x97, 0xa8, 0xa0, 0x44, 0xc3, 0x62, 0x28, 0xf2, 0xff, 0x50, 0x1d,
-	0x99, 0xc8, 0xd8, 0x3b, 0xf7, 0x7c, 0x82, 0xe6, 0x81, 0xf3, 0x72, 0x39, 0xfd, 0x59, 0xbb, 0xd4,
-	0x2f, 0x36, 0x3c, 0x44, 0x75, 0x0f, 0x2c, 0xb4, 0x27, 0x02, 0x9d, 0x37, 0x26, 0x25, 0x9e, 0x95,
-	0xba, 0xa7, 0x97, 0xee, 0x0f, 0x97, 0xa1, 0x8d, 0x01, 0x1c, 0xd2, 0x6c, 0xf2, 0x5f, 0x0f, 0xdf,
-	0xc2, 0xfb, 0xb9, 0x74, 0xee, 0xfd, 0xec, 0x42, 0x4b, 0x09, 0x7b, 0x7c, 0x17, 0x42, 0xd4, 0x50,
-	0x02, 0x8d, 0xc1, 0x00, 0x6c, 0xc2, 0x25, 0x96, 0xa9, 0x28, 0xc1, 0x28, 0x25, 0x2c, 0xf1, 0xa7,
-	0x19, 0x1d, 0x9b, 0x1e, 0x56, 0xf2, 0x56, 0x66, 0xac, 0x7d, 0x96, 0xdc, 0xd6, 0x0c, 0x6d, 0x0b,
-	0x0d, 0x02, 0x31, 0xe5, 0x4a, 0x9b, 0x69, 0xee, 0x98, 0xba, 0x45, 0xcc, 0x5b, 0x7e, 0x9a, 0x31,
-	0xa9, 0x79, 0x35, 0xe4, 0x55, 0x34, 0x69, 0x18, 0x52, 0x98, 0x86, 0x5f, 0x37, 0x0c, 0x4d, 0xf6,
-	0x43, 0xf7, 0x00, 0xda, 0xf3, 0xd7, 0x0d, 0x3e, 0x87, 0xd7, 0xa0, 0xb6, 0x77, 0xfe, 0x29, 0x3c,
-	0xa3, 0xf5, 0xc4, 0xa9, 0xe4, 0x94, 0x07, 0x54, 0xb1, 0xbd, 0x8c, 0xdb, 0x30, 0x2d, 0x42, 0xd7,
-	0xbf, 0x57, 0x84, 0xca, 0x20, 0xed, 0x89, 0x90, 0x91, 0x2a, 0x14, 0x0f, 0x44, 0xea, 0x2c, 0x91,
-	0x15, 0x68, 0x0e, 0xd2, 0x5b, 0x4c, 0xd9, 0x47, 0xb7, 0xf3, 0xb7, 0x2a, 0x71, 0xa0, 0x31, 0x48,
-	0x0f, 0xa5, 0x2d, 0x69, 0xe7, 0xef, 0x55, 0xd2, 0xd0, 0x7a, 0x87, 0x11, 0x1f, 0x3b, 0x1f, 0x75,
-	0x48, 0x13, 0xaa, 0x83, 0xf4, 0xcd, 0x78, 0x9a, 0x9d, 0x38, 0x3f, 0xeb, 0x18, 0xfd, 0xb9, 0x95,
-	0xce, 0xcf, 0x3b, 0xa4, 0x0d, 0xf5, 0x41, 0xda, 0xe7, 0x59, 0xaa, 0x1f, 0x69, 0xbf, 0xe8, 0x90,
-	0x55, 0xe8, 0x0c, 0xd2, 0x9b, 0x61, 0xf8, 0x26, 0x9d, 0xc6, 0xea, 0x10, 0xa5, 0x7e, 0xd9, 0x21,
-	0x2d, 0xa8, 0x0d, 0xd2, 0x6d, 0x1a, 0x4c, 0xa6, 0xa9, 0xf3, 0xab, 0x8e, 0xf9, 0xe8, 0x50, 0xd2,
-	0x80, 0x1d, 0xa5, 0x94, 0x3b, 0xbf, 0xee, 0x90, 0x4b, 0xd0, 0x1e, 0xa4, 0x47, 0x4a, 0x48, 0x3a,
-	0x66, 0x18, 0x60, 0xe7, 0x37, 0x1d, 0xf2, 0x08, 0x90, 0x41, 0x7a, 0x2b, 0x16, 0x23, 0x1a, 0x2f,
-	0x7c, 0xf4, 0xb7, 0x1d, 0x72, 0x05, 0x56, 0xf4, 0x47, 0x15, 0x93, 0x01, 0x4b, 0x95, 0x35, 0xfd,
-	0x77, 0x1d, 0x42, 0xa0, 0xa5, 0x5d, 0xd6, 0x24, 0x66, 0xd6, 0xf9, 0xbd, 0x95, 0xdd, 0x89, 0xb2,
-	0x89, 0xfe, 0xeb, 0xc5, 0x8c, 0x72, 0x26, 0x9d, 0x3f, 0x58, 0x93, 0x3c, 0x46, 0x43, 0x26, 0x9d,
-	0x3f, 0x76, 0xc8, 0x1a, 0x5c, 0x36, 0xa1, 0xa1, 0x8a, 0x65, 0x6a, 0xe1, 0x73, 0x1f, 0xe7, 0xc6,
-	0x71, 0x9a, 0x66, 0x27, 0x42, 0x69, 0x15, 0xe7, 0x4f, 0x73, 0x05, 0xdb, 0xef, 0xb0, 0x37, 0xef,
-	0x45, 0x99, 0x72, 0x3e, 0xb1, 0x76, 0x60, 0x04, 0xfa, 0x1c, 0x5f, 0xaf, 0x7f, 0xee, 0x5c, 0xff,
-	0x51, 0x01, 0xea, 0xb3, 0x51, 0x8d, 0x34, 0xa0, 0xda, 0xe7, 0xf7, 0x68, 0x1c, 0x85, 0xce, 0x12,
-	0x69, 0x41, 0x7d, 0x36, 0x90, 0x39, 0x05, 0x7c, 0x0d, 0xcf, 0xa6, 0x2a, 0x67, 0x99, 0x74, 0xa0,
-	0xb1, 0x30, 0x34, 0x99, 0x17, 0xf4, 0xed, 0xc5, 0xb9, 0xc7, 0x29, 0x91, 0x55, 0x70, 0x72, 0x28,
-	0x9f, 0x6e, 0x9c, 0x32, 0x71, 0xa0, 0x79, 0x7b, 0x61, 0x46, 0x71, 0x2a, 0x1a, 0x59, 0x9c, 0x40,
-	0x1c, 0x5d, 0x00, 0xcd, 0xd9, 0x48, 0xa1, 0xbf, 0x57, 0xbb, 0x7e, 0x0b, 0xea, 0xb3, 0x2e, 0x48,
-	0x6a, 0x50, 0xba, 0x39, 0x55, 0xc2, 0x58, 0x79, 0x20, 0xcc, 0x93, 0x3d, 0x73, 0x0a, 0xa4, 0x09,
-	0xb5, 0xed, 0x68, 0x6c, 0x4c, 0x5a, 0xd6, 0x2f, 0xf6, 0x9e, 0xe0, 0x2a, 0xe2, 0x53, 0x31, 0xcd,
-	0xf0, 0x07, 0x17, 0xa7, 0xb8, 0xfd, 0xda, 0x87, 0x9f, 0x5e, 0x2d, 0x7c, 0xf4, 0xe9, 0xd5, 0xc2,
-	0x27, 0x9f, 0x5e, 0x5d, 0x7a, 0xef, 0x2f, 0x57, 0x0b, 0xef, 0xfc, 0xdf, 0xc2, 0x8f, 0xb8, 0x09,
-	0x55, 0x32, 0x3a, 0x15, 0x32, 0x1a, 0x47, 0x3c, 0x27, 0x38, 0xbb, 0x91, 0x4e, 0xc6, 0x37, 0xd2,
-	0xd1, 0x0d, 0x9a, 0x46, 0xa3, 0x0a, 0xfe, 0x5a, 0xfb, 0xc2, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0xe2, 0xb3, 0x27, 0xb9, 0x0b, 0x16, 0x00, 0x00,
+	// 2546 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xdd, 0x6f, 0x24, 0x47,
+	0x11, 0xf7, 0x78, 0xbf, 0x6b, 0xbf, 0xc6, 0x7d, 0xbe, 0xcb, 0xc6, 0x09, 0x77, 0x66, 0xf2, 0xe5,
+	0x5c, 0x88, 0x4f, 0x38, 0x01, 0x92, 0x28, 0x4a, 0x64, 0xaf, 0x93, 0xf3, 0x82, 0xed, 0x35, 0xe3,
+	0xbd, 0x44, 0x8a, 0x90, 0x46, 0xbd, 0x33, 0xed, 0xf5, 0xdc, 0xce, 0x74, 0xcf, 0xcd, 0xf4, 0xfa,
+	0xec, 0xbc, 0x02, 0x8f, 0x48, 0x11, 0x6f, 0xbc, 0x25, 0x4f, 0x3c, 0xf0, 0xca, 0x33, 0x8f, 0x28,
+	0x8f, 0x41, 0x7c, 0x7f, 0x85, 0x10, 0x24, 0x04, 0xfc, 0x15, 0xa8, 0xab, 0x7b, 0x76, 0xd7, 0x3e,
+	0xe7, 0x20, 0x08, 0x29, 0x0f, 0xb6, 0xba, 0x7e, 0x55, 0xd5, 0x53, 0x55, 0x5d, 0xdd, 0x55, 0xb5,
+	0x50, 0xa3, 0x49, 0xb8, 0x9e, 0xa4, 0x42, 0x0a, 0x52, 0xa0, 0x49, 0xb8, 0xf2, 0xfc, 0x28, 0x94,
+	0xc7, 0x93, 0xe1, 0xba, 0x2f, 0xe2, 0x5b, 0x23, 0x31, 0x12, 0xb7, 0x90, 0x37, 0x9c, 0x1c, 0x21,
+	0x85, 0x04, 0xae, 0xb4, 0xce, 0x4a, 0x5b, 0x86, 0x31, 0xcb, 0x24, 0x8d, 0x13, 0x03, 0x40, 0x12,
+	0x51, 0xae, 0xd7, 0xce, 0x37, 0xa0, 0x39, 0xd8, 0x3f, 0x08, 0xf9, 0xc8, 0x65, 0xf7, 0x26, 0x2c,
+	0x93, 0xe4, 0x71, 0xa8, 0x25, 0x34, 0xa5, 0x31, 0x93, 0x2c, 0xed, 0x58, 0xab, 0xd6, 0x5a, 0xcd,
+	0x9d, 0x01, 0xaf, 0x54, 0xdf, 0xff, 0xe0, 0x86, 0xf5, 0xc9, 0x07, 0x37, 0x16, 0x9c, 0x9f, 0x5a,
+	0xd0, 0xca, 0x35, 0xb3, 0x44, 0xf0, 0x8c, 0x91, 0x0e, 0x54, 0x32, 0x29, 0x52, 0xd6, 0xdb, 0x36,
+	0x8a, 0x39, 0x49, 0x9e, 0x86, 0x56, 0xc6, 0xd2, 0x93, 0xd0, 0x67, 0x9b, 0x41, 0x90, 0xb2, 0x2c,
+	0xeb, 0x2c, 0xa2, 0xc0, 0x05, 0x14, 0x77, 0x38, 0xa6, 0x69, 0xd0, 0xdb, 0xee, 0x14, 0x56, 0xad,
+	0xb5, 0xa2, 0x9b, 0x93, 0xca, 0xac, 0x94, 0x25, 0x51, 0xe8, 0xd3, 0xde, 0x76, 0xa7, 0x88, 0xbc,
+	0x19, 0x40, 0xae, 0x03, 0x44, 0x62, 0x74, 0x68, 0x54, 0x4b, 0xc8, 0x9e, 0x43, 0xe6, 0xcc, 0x7e,
+	0x05, 0xec, 0xc1, 0xfe, 0xa1, 0x4c, 0xe7, 0xed, 0xc6, 0xbd, 0xe5, 0x24, 0xe5, 0x87, 0x72, 0xea,
+	0xf2, 0x14, 0x98, 0xd3, 0xfd, 0x89, 0x05, 0xe5, 0xb7, 0x98, 0x2f, 0x45, 0x4a, 0x08, 0x14, 0x03,
+	0x2a, 0x29, 0x4a, 0x37, 0x5c, 0x5c, 0x93, 0xeb, 0x50, 0x94, 0x67, 0x09, 0x43, 0xd7, 0xea, 0x1b,
+	0xb0, 0x8e, 0x51, 0x1e, 0x9c, 0x25, 0xcc, 0x45, 0x9c, 0xac, 0x40, 0x95, 0x4f, 0xa2, 0x88, 0x0e,
+	0x23, 0x86, 0xde, 0x55, 0xdd, 0x29, 0x4d, 0x6c, 0x28, 0xf0, 0x2c, 0x41, 0xc7, 0x1a, 0xae, 0x5a,
+	0x92, 0x47, 0xa1, 0x1a, 0x66, 0x9e, 0x2f, 0x78, 0x26, 0xd1, 0xa1, 0xaa, 0x5b, 0x09, 0xb3, 0xae,
+	0x22, 0x95, 0x70, 0xc4, 0x78, 0xa7, 0xbc, 0x6a, 0xad, 0x35, 0x5d, 0xb5, 0x54, 0xe6, 0xd0, 0x94,
+	0xd1, 0x4e, 0x45, 0x9b, 0xa3, 0xd6, 0xce, 0x37, 0xa1, 0xb4, 0x45, 0xa5, 0x7f, 0x4c, 0x56, 0xa0,
+	0x44, 0xa5, 0x4c, 0xb3, 0x8e, 0xb5, 0x5a, 0x58, 0xab, 0x6d, 0x15, 0x3f, 0xfc, 0xf8, 0xc6, 0x82,
+	0xab, 0x21, 0xf2, 0x14, 0x14, 0x4f, 0x98, 0xaf, 0x8e, 0xa3, 0xb0, 0x56, 0xdf, 0xa8, 0xaf, 0xab,
+	0x4c, 0xd3, 0x2e, 0x1a, 0x39, 0x64, 0x3b, 0x3f, 0xb7, 0xa0, 0x32, 0x50, 0x86, 0xf6, 0xb6, 0xc9,
+	0x15, 0x28, 0x05, 0x43, 0x2f, 0x0c, 0xd0, 0xf7, 0xa2, 0x5b, 0x0c, 0x86, 0xbd, 0x40, 0x81, 0x12,
+	0xc1, 0x45, 0x0d, 0x4a, 0x05, 0x7e, 0x19, 0x1a, 0x09, 0x4d, 0x65, 0x28, 0x43, 0xc1, 0x15, 0x4f,
+	0x1f, 0x69, 0x7d, 0x8a, 0xf5, 0x02, 0x72, 0x15, 0xca, 0xd4, 0xf7, 0x15, 0xb3, 0x88, 0xde, 0x94,
+	0xa8, 0xef, 0xf7, 0x02, 0xf2, 0x08, 0x54, 0x82, 0xa1, 0xc7, 0x69, 0xcc, 0xd0, 0xf7, 0x9a, 0x5b,
+	0x0e, 0x86, 0xfb, 0x34, 0x66, 0x8a, 0x21, 0x0d, 0xa3, 0xac, 0x19, 0x52, 0x33, 0x9e, 0x82, 0x56,
+	0x92, 0x86, 0x31, 0x4d, 0xcf, 0xbc, 0x8c, 0xdd, 0xe3, 0x93, 0x18, 0x63, 0xd1, 0x74, 0x9b, 0x06,
+	0x3d, 0x44, 0xd0, 0xf9, 0xa1, 0x05, 0xad, 0xc3, 0x33, 0xee, 0xef, 0x8a, 0xd1, 0x80, 0x86, 0x91,
+	0xcb, 0xee, 0x91, 0xe7, 0xa1, 0xe2, 0x73, 0xef, 0x98, 0x9e, 0x30, 0xf4, 0xa8, 0xbe, 0xb1, 0xbc,
+	0x3e, 0xbb, 0x30, 0x83, 0x7c, 0xe5, 0x96, 0x7d, 0xbe, 0x43, 0x4f, 0x98, 0x11, 0xbf, 0x4f, 0xb9,
+	0xec, 0x2c, 0x3e, 0x5c, 0xfc, 0x6d, 0xca, 0x25, 0x71, 0xa0, 0x24, 0xa7, 0x27, 0x5e, 0xdf, 0x68,
+	0x60, 0x84, 0x4d, 0x28, 0x5d, 0xcd, 0x72, 0xbe, 0x03, 0xed, 0x73, 0x36, 0x65, 0x89, 0x0a, 0x9d,
+	0x3f, 0x4e, 0xbc, 0x48, 0xf8, 0x54, 0x45, 0xca, 0x64, 0x65, 0xdd, 0x1f, 0x27, 0xbb, 0x06, 0x22,
+	0x4f, 0x43, 0xd5, 0x17, 0x71, 0x4c, 0x79, 0x90, 0x1f, 0x1f, 0xe0, 0xe6, 0x6f, 0x70, 0x99, 0x9e,
+	0xb9, 0x53, 0x9e, 0xf3, 0x1a, 0x2c, 0x1d, 0xa4, 0x4c, 0x91, 0xa1, 0x7c, 0x3b, 0x0d, 0x25, 0xeb,
+	0xc6, 0x01, 0x79, 0x16, 0x80, 0x29, 0x39, 0x2f, 0x0a, 0x33, 0xd9, 0xb1, 0x1e, 0x50, 0xaf, 0x21,
+	0x77, 0x37, 0xcc, 0xa4, 0xf3, 0x5e, 0x01, 0x4a, 0x08, 0x92, 0x17, 0x72, 0x25, 0x4c, 0x73, 0x65,
+	0x52, 0x6b, 0x63, 0x79, 0xa6, 0xa4, 0xff, 0x63, 0xc2, 0xd7, 0x58, 0xbe, 0x54, 0x79, 0x8c, 0x5e,
+	0xce, 0x92, 0xa3, 0x82, 0x74, 0x2f, 0x20, 0x37, 0xa0, 0xae, 0x2e, 0xce, 0x90, 0x66, 0x6c, 0x96,
+	0x1e, 0x90, 0x43, 0xbd, 0x80, 0x7c, 0x09, 0x40, 0xeb, 0xe2, 0x81, 0x17, 0xf5, 0xcd, 0x44, 0x04,
+	0xcf, 0xfc, 0x09, 0x68, 0x4e, 0xf5, 0xe7, 0x72, 0xa5, 0x91, 0x83, 0x28, 0xf4, 0x18, 0xd4, 0x8e,
+	0xc2, 0x88, 0xcd, 0xe7, 0x4c, 0x55, 0x01, 0xc8, 0x7c, 0x1c, 0x0a, 0x43, 0x2a, 0x31, 0x55, 0x72,
+	0xff, 0xf1, 0xce, 0xb8, 0x0a, 0x26, 0x4f, 0x40, 0x2b, 0x19, 0x7b, 0xfe, 0x31, 0xf3, 0xc7, 0xde,
+	0xf0, 0xcc, 0x93, 0xbc, 0x53, 0x5d, 0xb5, 0xd6, 0x4a, 0x6e, 0x3d, 0x19, 0x77, 0x15, 0xb8, 0x75,
+	0x36, 0xe0, 0x4e, 0x0a, 0xb5, 0xa9, 0xdf, 0x04, 0xa0, 0xdc, 0xe3, 0x19, 0x4b, 0xa5, 0xbd, 0xa0,
+	0xd6, 0xdb, 0x2c, 0x62, 0x92, 0xd9, 0x96, 0x5a, 0xdf, 0x49, 0x02, 0x2a, 0x99, 0xbd, 0x48, 0x6a,
+	0x50, 0xda, 0x8c, 0x24, 0x4b, 0xed, 0x02, 0x59, 0x82, 0xe6, 0x61, 0xc2, 0xfc, 0x90, 0x46, 0x46,
+	0xb2, 0x48, 0x5a, 0x00, 0xdb, 0x54, 0xd2, 0xfe, 0xf0, 0x2e, 0xf3, 0xa5, 0x5d, 0x22, 0x57, 0xa0,
+	0x3d, 0x10, 0xf1, 0x30, 0x93, 0x82, 0x33, 0x03, 0x96, 0x9d, 0xef, 0x59, 0x00, 0x68, 0x41, 0x22,
+	0x42, 0x2e, 0xc9, 0x73, 0x50, 0x8e, 0x43, 0xee, 0xc9, 0xec, 0xa1, 0x09, 0x5c, 0x8a, 0x43, 0x3e,
+	0xc8, 0x50, 0x98, 0x9e, 0x2a, 0xe1, 0xc5, 0x87, 0x0a, 0xd3, 0xd3, 0x41, 0x96, 0xc7, 0xa7, 0x70,
+	0x69, 0x7c, 0xb4, 0x19, 0x54, 0xd2, 0x48, 0x8c, 0xba, 0xe3, 0xe4, 0x0b, 0x33, 0xe3, 0xfb, 0x16,
+	0xd4, 0xf7, 0x98, 0xa4, 0xea, 0xd8, 0xbf, 0x48, 0x3b, 0xfe, 0x65, 0x81, 0x8d, 0x27, 0x8b, 0xd7,
+	0xfb, 0x40, 0x44, 0xa1, 0x7f, 0x46, 0xd6, 0xe1, 0x8a, 0x32, 0x46, 0x64, 0xe1, 0xbb, 0xcc, 0xbb,
+	0x37, 0xa1, 0x61, 0x14, 0x1e, 0x31, 0xfd, 0x76, 0x36, 0xdd, 0xa5, 0x38, 0xe4, 0x7d, 0xc5, 0xf9,
+	0x76, 0xce, 0x20, 0x4f, 0x42, 0x4b, 0xd9, 0x23, 0x86, 0x77, 0x3d, 0xc1, 0x59, 0x3a, 0xe1, 0x68,
+	0x57, 0xd3, 0x6d, 0xc4, 0xf4, 0xb4, 0x3f, 0xbc, 0xdb, 0x47, 0x8c, 0xdc, 0x82, 0x65, 0x94, 0xc2,
+	0x5d, 0x63, 0x96, 0x8e, 0x58, 0xa0, 0x54, 0x3a, 0x05, 0xb3, 0x2d, 0x3d, 0xc5, 0x6d, 0xf7, 0x90,
+	0xd3, 0x1f, 0xde, 0x25, 0x4f, 0x42, 0xe9, 0x38, 0xe4, 0x32, 0xeb, 0x14, 0x57, 0x0b, 0x6b, 0xad,
+	0x8d, 0x16, 0xda, 0x8e, 0xec, 0x9d, 0x90, 0x4b, 0x57, 0x33, 0xc9, 0xb3, 0xa0, 0x2c, 0xf2, 0x7c,
+	0xae, 0xf7, 0xf4, 0xd4, 0x1e, 0xa6, 0x9a, 0xb6, 0xe2, 0x90, 0x77, 0x39, 0x6a, 0x1c, 0x86, 0xef,
+	0x32, 0xe7, 0x25, 0x58, 0x9e, 0xf9, 0x8a, 0x65, 0x29, 0xa5, 0x2a, 0x17, 0x57, 0xa1, 0xee, 0x4f,
+	0xa9, 0xcc, 0xd4, 0xc7, 0x79, 0xc8, 0x79, 0x1e, 0x96, 0xe6, 0x35, 0xe3, 0x98, 0x71, 0xa9, 0x0a,
+	0xbf, 0xaf, 0x97, 0x79, 0xeb, 0x60, 0x48, 0x67, 0x0f, 0xae, 0xce, 0xc4, 0x5d, 0xa6, 0xae, 0x31,
+	0x2e, 0xd5, 0xc3, 0x22, 0xa2, 0x40, 0xdf, 0x6b, 0xa3, 0x23, 0xa2, 0x00, 0xaf, 0xf5, 0xa3, 0x50,
+	0xe5, 0xec, 0xbe, 0x66, 0xe9, 0x46, 0xa3, 0xc2, 0xd9, 0x7d, 0xc5, 0x72, 0x38, 0x5c, 0xb9, 0xb8,
+	0x5d, 0x57, 0x44, 0xff, 0xdb, 0x66, 0xea, 0x95, 0xce, 0x54, 0xdb, 0xc4, 0x7d, 0xe6, 0xa9, 0x92,
+	0xa3, 0xc3, 0x5f, 0xcf, 0xb1, 0xfd, 0x49, 0xec, 0x04, 0xf3, 0xdf, 0xdb, 0x0c, 0x82, 0xae, 0x88,
+	0x26, 0x31, 0x27, 0x4f, 0x42, 0xd9, 0xc7, 0x95, 0xc9, 0xd1, 0x86, 0xee, 0x16, 0xba, 0x22, 0xda,
+	0x66, 0x47, 0xae, 0xe1, 0x91, 0x67, 0xa0, 0x1d, 0xe2, 0x73, 0xe2, 0x25, 0x22, 0xc3, 0x92, 0x89,
+	0x16, 0x94, 0xdc, 0x96, 0x86, 0x0f, 0x0c, 0xea, 0x1c, 0xc2, 0xb5, 0x73, 0x5f, 0x39, 0xc8, 0x4b,
+	0x2c, 0x79, 0x19, 0x9a, 0xb3, 0x1a, 0x1c, 0xb0, 0xa3, 0xe9, 0x9d, 0xc0, 0xef, 0x4d, 0xe5, 0xb6,
+	0xce, 0xd4, 0x77, 0x67, 0xe5, 0x7a, 0x9b, 0x1d, 0x39, 0xef, 0xcc, 0x1f, 0xf1, 0x76, 0x2a, 0x12,
+	0x63, 0xfb, 0x0d, 0xa8, 0x47, 0x62, 0x14, 0xfa, 0x34, 0xf2, 0xc2, 0xe0, 0xd4, 0xa4, 0x32, 0x18,
+	0xa8, 0x17, 0x9c, 0x3e, 0x10, 0x96, 0xc5, 0x07, 0xc3, 0xf2, 0xf7, 0x22, 0x34, 0xe7, 0xcf, 0xe1,
+	0xde, 0xb9, 0x3a, 0x61, 0x9d, 0xaf, 0x13, 0xd3, 0x8e, 0x63, 0x71, 0xae, 0xe3, 0x70, 0xa0, 0x38,
+	0x0e, 0xb9, 0xae, 0x1a, 0x79, 0x42, 0xe3, 0x8e, 0xdf, 0x0a, 0x79, 0xe0, 0x22, 0x8f, 0xbc, 0x0c,
+	0x40, 0x83, 0xc0, 0x33, 0x91, 0x2e, 0xa2, 0xe7, 0x9d, 0x99, 0xe4, 0xf9, 0x33, 0xd9, 0x59, 0x70,
+	0x6b, 0x34, 0x27, 0xc8, 0xab, 0x50, 0x0f, 0x52, 0x91, 0xe4, 0xba, 0x25, 0xd4, 0x7d, 0xf4, 0x82,
+	0xee, 0x2c, 0x28, 0x3b, 0x0b, 0x2e, 0x04, 0x53, 0x8a, 0xbc, 0x0e, 0x8d, 0x14, 0x73, 0xcb, 0xd3,
+	0xc5, 0xbf, 0x8c, 0xea, 0x2b, 0x17, 0xd4, 0xe7, 0xb2, 0x79, 0x67, 0xc1, 0xad, 0xa7, 0x33, 0x92,
+	0xbc, 0x0e, 0xad, 0x09, 0x16, 0x0c, 0x2f, 0xbf, 0x16, 0xba, 0x46, 0x5d, 0xbb, 0xb0, 0x85, 0xb9,
+	0x3f, 0x3b, 0x0b, 0x6e, 0x53, 0xcb, 0x1b, 0x40, 0xd9, 0x9f, 0x6f, 0x90, 0xc9, 0xb4, 0x53, 0xbd,
+	0xd4, 0xfe, 0xd9, 0xbd, 0x55, 0xf6, 0x9b, 0x0d, 0x32, 0x99, 0x92, 0x57, 0xc1, 0x6c, 0xe7, 0x25,
+	0xf8, 0x8c, 0x75, 0x6a, 0xa8, 0x7f, 0xf5, 0x82, 0xbe, 0x7e, 0xe3, 0x76, 0x16, 0xdc, 0x86, 0x96,
+	0xd6, 0x34, 0xd9, 0x82, 0xa6, 0x0a, 0xfb, 0x34, 0x99, 0x3a, 0x80, 0xda, 0x8f, 0x3d, 0x18, 0xf9,
+	0x69, 0xfe, 0xa9, 0x3d, 0xe8, 0xf9, 0xbc, 0x05, 0x13, 0x41, 0x5f, 0x44, 0x9d, 0xfa, 0xa5, 0x47,
+	0x37, 0xbd, 0xbe, 0xea, 0xe8, 0xd2, 0x9c, 0xd8, 0xaa, 0x43, 0x4d, 0x24, 0x2c, 0xc5, 0x2e, 0xc9,
+	0x79, 0xaf, 0x08, 0xf5, 0x43, 0xff, 0x98, 0xc5, 0xf4, 0x8d, 0x53, 0x99, 0x52, 0xf2, 0x34, 0xb4,
+	0x39, 0x3b, 0x95, 0x6a, 0xd7, 0xbc, 0x51, 0xd4, 0x09, 0xdc, 0x54, 0x70, 0x57, 0x44, 0xba, 0x51,
+	0xc4, 0xde, 0x22, 0x15, 0x49, 0xc2, 0x02, 0x4f, 0x37, 0xcf, 0xaa, 0xc5, 0x52, 0xbd, 0x85, 0x06,
+	0x37, 0x4d, 0xf7, 0xdc, 0xd2, 0xf9, 0xe1, 0xf9, 0xc7, 0x94, 0x8f, 0x58, 0x60, 0xfa, 0xfa, 0xa6,
+	0x46, 0xbb, 0x1a, 0x3c, 0xf7, 0xb8, 0x14, 0xcf, 0x3f, 0x2e, 0x9f, 0x51, 0x1e, 0x4a, 0xff, 0x7d,
+	0x79, 0x28, 0x7f, 0x8e, 0xf2, 0x50, 0xf9, 0x8f, 0xe5, 0xa1, 0xfa, 0xb9, 0xcb, 0x43, 0xed, 0xb2,
+	0xf2, 0xa0, 0xec, 0x1c, 0x46, 0xc2, 0x1f, 0x7b, 0xca, 0x8e, 0x54, 0xdc, 0xcf, 0x30, 0x07, 0x9a,
+	0x6e, 0x03, 0xd1, 0x3d, 0x7a, 0xea, 0x8a, 0xfb, 0x19, 0xb9, 0x09, 0x4b, 0x02, 0x7b, 0x1a, 0x14,
+	0x43, 0x56, 0x86, 0x67, 0xdd, 0x74, 0xdb, 0x9a, 0xb1, 0x47, 0x4f, 0xb7, 0x10, 0xc6, 0x8e, 0x58,
+	0xc4, 0x89, 0x1a, 0x13, 0x3d, 0x1a, 0x8d, 0x3a, 0x0d, 0xfd, 0xa8, 0xe4, 0xd8, 0x66, 0x34, 0xd2,
+	0xc7, 0x61, 0x44, 0x22, 0x76, 0xc2, 0xa2, 0x4e, 0x13, 0x5f, 0xcb, 0x66, 0x8e, 0xee, 0x2a, 0xd0,
+	0x09, 0xa0, 0xda, 0xe3, 0xf2, 0xeb, 0x2f, 0xee, 0xd1, 0x84, 0x38, 0x60, 0xc5, 0xa6, 0xfd, 0xd5,
+	0x9d, 0x6c, 0xce, 0x59, 0xdf, 0xd3, 0x8d, 0xb0, 0x15, 0xaf, 0xbc, 0x08, 0x65, 0x4d, 0xa8, 0xc1,
+	0x6b, 0xcc, 0xce, 0x30, 0x61, 0x0a, 0xae, 0x5a, 0x92, 0x65, 0x28, 0x9d, 0xd0, 0x68, 0xa2, 0x2b,
+	0x43, 0xc1, 0xd5, 0xc4, 0x2b, 0x8b, 0x2f, 0x59, 0xce, 0x5b, 0xd0, 0x18, 0xa4, 0x94, 0x67, 0xdb,
+	0x2c, 0x53, 0xef, 0x34, 0xb9, 0x06, 0x65, 0x31, 0xbc, 0xdb, 0x33, 0x0f, 0x66, 0xc9, 0x35, 0x94,
+	0xc2, 0x87, 0xd1, 0x58, 0xe1, 0xfa, 0x69, 0x37, 0x94, 0xc2, 0x53, 0x71, 0x5f, 0xe1, 0x05, 0x8d,
+	0x6b, 0xca, 0xf9, 0xae, 0x05, 0xf5, 0xad, 0x68, 0x8c, 0x7b, 0x2b, 0x0f, 0x9e, 0x9b, 0x79, 0xf0,
+	0x88, 0xee, 0x48, 0x66, 0x4c, 0xe3, 0x84, 0x19, 0xe5, 0xac, 0x78, 0xe5, 0xf6, 0x65, 0xae, 0x94,
+	0xb4, 0x2b, 0xcf, 0xcc, 0xbb, 0x52, 0xdf, 0x58, 0xd2, 0x93, 0xca, 0x9c, 0x0b, 0xf3, 0xde, 0xed,
+	0x00, 0xc9, 0xbf, 0x73, 0xc4, 0xd2, 0x2d, 0x21, 0xc6, 0x21, 0x1f, 0x91, 0x0d, 0xa8, 0xc6, 0x34,
+	0x49, 0x42, 0x3e, 0xca, 0x8c, 0x49, 0xf6, 0x45, 0x93, 0x8c, 0x2d, 0x53, 0x39, 0xe7, 0x67, 0x8b,
+	0x60, 0x63, 0xde, 0x74, 0x71, 0x42, 0xd1, 0xd6, 0x5d, 0x3a, 0x63, 0x5e, 0x85, 0xb2, 0x1c, 0x46,
+	0xb3, 0x3a, 0x50, 0x92, 0xc3, 0xe8, 0x81, 0x21, 0xa1, 0x70, 0x71, 0x48, 0xf8, 0x1a, 0x54, 0x33,
+	0x49, 0x53, 0xe9, 0x61, 0xf3, 0xf3, 0x99, 0x2d, 0x9e, 0xb1, 0xab, 0x82, 0xb2, 0x83, 0x4c, 0x15,
+	0xb9, 0xd9, 0xc5, 0xc9, 0x3a, 0xa5, 0xd5, 0xc2, 0x5a, 0xc3, 0x85, 0x38, 0xbf, 0x31, 0x3a, 0x1f,
+	0x53, 0x46, 0x65, 0x2e, 0x51, 0x46, 0x89, 0xba, 0xc1, 0x50, 0xe4, 0xab, 0x50, 0x19, 0xea, 0xc8,
+	0x98, 0xd7, 0xfb, 0xfc, 0x01, 0xcd, 0x02, 0xe7, 0xe6, 0x72, 0xea, 0xb3, 0x66, 0xa9, 0x66, 0x3f,
+	0xbc, 0x8e, 0x35, 0x17, 0x0c, 0xb4, 0x2b, 0x7c, 0x75, 0x6e, 0x2c, 0x4d, 0xf1, 0xd6, 0xd5, 0x5c,
+	0xb5, 0x74, 0x7e, 0xb4, 0x08, 0x2d, 0x0c, 0xe0, 0x80, 0x66, 0xe3, 0xff, 0x7b, 0xf8, 0xe6, 0x26,
+	0xf1, 0xe2, 0xb9, 0x49, 0xdc, 0x81, 0xa6, 0x14, 0xe6, 0x21, 0x98, 0x0b, 0x51, 0x5d, 0x0a, 0x34,
+	0x06, 0x03, 0xb0, 0x0e, 0x57, 0x58, 0x26, 0xc3, 0x18, 0xa3, 0x14, 0xb3, 0xd8, 0x9b, 0x64, 0x74,
+	0xa4, 0xab, 0x61, 0xd1, 0x5d, 0x9a, 0xb2, 0xf6, 0x58, 0x7c, 0x47, 0x31, 0x94, 0x2d, 0xd4, 0xf7,
+	0xc5, 0x84, 0x4b, 0x65, 0xa6, 0x7e, 0xad, 0x6a, 0x06, 0xd1, 0xbf, 0x0a, 0x4c, 0x32, 0x96, 0x2a,
+	0x5e, 0x15, 0x79, 0x65, 0x45, 0x6a, 0x46, 0x2a, 0x74, 0xeb, 0x50, 0xd3, 0x0c, 0x45, 0xf6, 0x02,
+	0x67, 0x1f, 0x5a, 0xb3, 0x39, 0x09, 0x07, 0xeb, 0x15, 0xa8, 0xee, 0x9e, 0x1f, 0xaa, 0xa7, 0xb4,
+	0xea, 0x5d, 0x65, 0x3a, 0xe1, 0x3e, 0x95, 0x6c, 0x37, 0xe3, 0x26, 0x4c, 0xf3, 0xd0, 0xcd, 0x1f,
+	0x14, 0xa0, 0xdc, 0x4f, 0xba, 0x22, 0x60, 0xa4, 0x02, 0x85, 0x7d, 0x91, 0xd8, 0x0b, 0x64, 0x09,
+	0x1a, 0xfd, 0xe4, 0x36, 0x93, 0x66, 0x7c, 0xb7, 0xff, 0x51, 0x21, 0x36, 0xd4, 0xfb, 0xc9, 0x41,
+	0x6a, 0x52, 0xda, 0xfe, 0x67, 0x85, 0xd4, 0x95, 0x9e, 0xfa, 0xb1, 0xcc, 0xfe, 0xa8, 0x4d, 0x1a,
+	0x50, 0xe9, 0x27, 0x6f, 0x46, 0x93, 0xec, 0xd8, 0xfe, 0x45, 0x5b, 0xeb, 0xcf, 0xac, 0xb4, 0x7f,
+	0xd9, 0x26, 0x2d, 0xa8, 0xf5, 0x93, 0x1e, 0xcf, 0x12, 0x35, 0xee, 0xfd, 0xaa, 0x4d, 0x96, 0xa1,
+	0xdd, 0x4f, 0x36, 0x83, 0xe0, 0x4d, 0x3a, 0x89, 0xe4, 0x01, 0x4a, 0xfd, 0xba, 0x4d, 0x9a, 0x50,
+	0xed, 0x27, 0x5b, 0xd4, 0x1f, 0x4f, 0x12, 0xfb, 0x37, 0x6d, 0xfd, 0xd1, 0x41, 0x4a, 0x7d, 0x76,
+	0x98, 0x50, 0x6e, 0xff, 0xb6, 0x4d, 0xae, 0x40, 0xab, 0x9f, 0x1c, 0x4a, 0x91, 0xd2, 0x11, 0xc3,
+	0x00, 0xdb, 0xbf, 0x6b, 0x93, 0x47, 0x80, 0xf4, 0x93, 0xdb, 0x91, 0x18, 0xd2, 0x68, 0xee, 0xa3,
+	0xbf, 0x6f, 0x93, 0x6b, 0xb0, 0xa4, 0x3e, 0x2a, 0x59, 0xea, 0xb3, 0x44, 0x1a, 0xd3, 0xff, 0xd0,
+	0x26, 0x04, 0x9a, 0xfd, 0x44, 0x93, 0x78, 0xb2, 0xf6, 0x1f, 0x8d, 0xec, 0x76, 0x98, 0x8d, 0xd5,
+	0x5f, 0x37, 0x62, 0x94, 0xb3, 0xd4, 0xfe, 0x93, 0x31, 0xc9, 0x65, 0x34, 0x60, 0xa9, 0xfd, 0xe7,
+	0x36, 0x59, 0x81, 0xab, 0x3a, 0x34, 0x54, 0xb2, 0x4c, 0xce, 0x7d, 0xee, 0xe3, 0xdc, 0x38, 0x4e,
+	0x93, 0xec, 0x58, 0x48, 0xa5, 0x62, 0xff, 0x65, 0xa6, 0x60, 0x2a, 0x27, 0x56, 0x79, 0xf5, 0x23,
+	0x84, 0xfd, 0x89, 0xb1, 0x03, 0x23, 0xd0, 0xe3, 0x38, 0x07, 0xff, 0xb5, 0x7d, 0xf3, 0xc7, 0x16,
+	0xd4, 0xa6, 0x4d, 0x1f, 0xa9, 0x43, 0xa5, 0xc7, 0x4f, 0x68, 0x14, 0x06, 0xf6, 0x02, 0x69, 0x42,
+	0x6d, 0xda, 0xda, 0xd9, 0x16, 0xce, 0xd5, 0xd3, 0xfe, 0xcc, 0x5e, 0x24, 0x6d, 0xa8, 0xcf, 0xb5,
+	0x5f, 0x7a, 0x16, 0xbf, 0x33, 0xdf, 0x41, 0xd9, 0x45, 0xb2, 0x0c, 0x76, 0x0e, 0xe5, 0x7d, 0x92,
+	0x5d, 0x22, 0x36, 0x34, 0xee, 0xcc, 0x75, 0x3b, 0x76, 0x59, 0x21, 0xf3, 0xbd, 0x8c, 0xad, 0x12,
+	0xa0, 0x31, 0x6d, 0x4e, 0xd4, 0xf7, 0xaa, 0x37, 0x6f, 0x43, 0x6d, 0x5a, 0x4f, 0x49, 0x15, 0x8a,
+	0x9b, 0x13, 0x29, 0xb4, 0x95, 0xfb, 0x42, 0x0f, 0xff, 0x99, 0x6d, 0x91, 0x06, 0x54, 0xb7, 0xc2,
+	0x91, 0x36, 0x69, 0x51, 0xcd, 0xfe, 0x5d, 0xc1, 0x65, 0xc8, 0x27, 0x62, 0x92, 0xe1, 0x4f, 0x37,
+	0x76, 0x61, 0xeb, 0xb5, 0x0f, 0x3f, 0xbd, 0x6e, 0x7d, 0xf4, 0xe9, 0x75, 0xeb, 0x93, 0x4f, 0xaf,
+	0x2f, 0xbc, 0xff, 0xb7, 0xeb, 0xd6, 0x3b, 0x5f, 0x99, 0xfb, 0x39, 0x38, 0xa6, 0x32, 0x0d, 0x4f,
+	0x45, 0x1a, 0x8e, 0x42, 0x9e, 0x13, 0x9c, 0xdd, 0x4a, 0xc6, 0xa3, 0x5b, 0xc9, 0xf0, 0x16, 0x4d,
+	0xc2, 0x61, 0x19, 0x7f, 0xf7, 0x7d, 0xe1, 0xdf, 0x03, 0x00, 0xc8, 0x1c, 0xb9, 0xbf, 0x55, 0x16,
+	0x00, 0x00,
 }
 
 func (m *TNPingRequest) Marshal() (dAtA []byte, err error) {
@@ -3908,6 +3927,16 @@ func (m *SchemaExtra) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.CompressLevel != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.CompressLevel))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.CompressAlg != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.CompressAlg))
+		i--
+		dAtA[i] = 0x60
+	}
 	if m.ObjectMaxBlocks != 0 {
 		i = encodeVarintApi(dAtA, i, uint64(m.ObjectMaxBlocks))
 		i--
@@ -5053,6 +5082,12 @@ func (m *SchemaExtra) ProtoSize() (n int) {
 	if m.ObjectMaxBlocks != 0 {
 		n += 1 + sovApi(uint64(m.ObjectMaxBlocks))
 	}
+	if m.CompressAlg != 0 {
+		n += 1 + sovApi(uint64(m.CompressAlg))
+	}
+	if m.CompressLevel != 0 {
+		n += 1 + sovApi(uint64(m.CompressLevel))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -8866,6 +8901,44 @@ func (m *SchemaExtra) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressAlg", wireType)
+			}
+			m.CompressAlg = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompressAlg |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressLevel", wireType)
+			}
+			m.CompressLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompressLevel |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
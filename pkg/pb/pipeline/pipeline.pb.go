@@ -1484,17 +1484,20 @@ func (m *PreInsert) GetClusterByExpr() *plan.Expr {
 
 type PostDml struct {
 	// PostDmlCtx
-	Ref                    *plan.ObjectRef          `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
-	AddAffectedRows        bool                     `protobuf:"varint,2,opt,name=add_affected_rows,json=addAffectedRows,proto3" json:"add_affected_rows,omitempty"`
-	PrimaryKeyIdx          int32                    `protobuf:"varint,3,opt,name=primary_key_idx,json=primaryKeyIdx,proto3" json:"primary_key_idx,omitempty"`
-	PrimaryKeyName         string                   `protobuf:"bytes,4,opt,name=primary_key_name,json=primaryKeyName,proto3" json:"primary_key_name,omitempty"`
-	IsDelete               bool                     `protobuf:"varint,5,opt,name=is_delete,json=isDelete,proto3" json:"is_delete,omitempty"`
-	IsInsert               bool                     `protobuf:"varint,6,opt,name=is_insert,json=isInsert,proto3" json:"is_insert,omitempty"`
-	IsDeleteWithoutFilters bool                     `protobuf:"varint,7,opt,name=is_delete_without_filters,json=isDeleteWithoutFilters,proto3" json:"is_delete_without_filters,omitempty"`
-	FullText               *plan.PostDmlFullTextCtx `protobuf:"bytes,8,opt,name=full_text,json=fullText,proto3" json:"full_text,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{}                 `json:"-"`
-	XXX_unrecognized       []byte                   `json:"-"`
-	XXX_sizecache          int32                    `json:"-"`
+	Ref                    *plan.ObjectRef                  `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+	AddAffectedRows        bool                             `protobuf:"varint,2,opt,name=add_affected_rows,json=addAffectedRows,proto3" json:"add_affected_rows,omitempty"`
+	PrimaryKeyIdx          int32                            `protobuf:"varint,3,opt,name=primary_key_idx,json=primaryKeyIdx,proto3" json:"primary_key_idx,omitempty"`
+	PrimaryKeyName         string                           `protobuf:"bytes,4,opt,name=primary_key_name,json=primaryKeyName,proto3" json:"primary_key_name,omitempty"`
+	IsDelete               bool                             `protobuf:"varint,5,opt,name=is_delete,json=isDelete,proto3" json:"is_delete,omitempty"`
+	IsInsert               bool                             `protobuf:"varint,6,opt,name=is_insert,json=isInsert,proto3" json:"is_insert,omitempty"`
+	IsDeleteWithoutFilters bool                             `protobuf:"varint,7,opt,name=is_delete_without_filters,json=isDeleteWithoutFilters,proto3" json:"is_delete_without_filters,omitempty"`
+	FullText               *plan.PostDmlFullTextCtx         `protobuf:"bytes,8,opt,name=full_text,json=fullText,proto3" json:"full_text,omitempty"`
+	Hnsw                   *plan.PostDmlHnswCtx             `protobuf:"bytes,9,opt,name=hnsw,proto3" json:"hnsw,omitempty"`
+	Trigger                *plan.PostDmlTriggerCtx          `protobuf:"bytes,10,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	Mview                  *plan.PostDmlMaterializedViewCtx `protobuf:"bytes,11,opt,name=mview,proto3" json:"mview,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}                         `json:"-"`
+	XXX_unrecognized       []byte                           `json:"-"`
+	XXX_sizecache          int32                            `json:"-"`
 }
 
 func (m *PostDml) Reset()         { *m = PostDml{} }
@@ -1586,20 +1589,45 @@ func (m *PostDml) GetFullText() *plan.PostDmlFullTextCtx {
 	return nil
 }
 
+func (m *PostDml) GetHnsw() *plan.PostDmlHnswCtx {
+	if m != nil {
+		return m.Hnsw
+	}
+	return nil
+}
+
+func (m *PostDml) GetTrigger() *plan.PostDmlTriggerCtx {
+	if m != nil {
+		return m.Trigger
+	}
+	return nil
+}
+
+func (m *PostDml) GetMview() *plan.PostDmlMaterializedViewCtx {
+	if m != nil {
+		return m.Mview
+	}
+	return nil
+}
+
 type LockTarget struct {
-	TableId              uint64        `protobuf:"varint,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
-	PrimaryColIdxInBat   int32         `protobuf:"varint,2,opt,name=primary_col_idx_in_bat,json=primaryColIdxInBat,proto3" json:"primary_col_idx_in_bat,omitempty"`
-	PrimaryColTyp        plan.Type     `protobuf:"bytes,3,opt,name=primary_col_typ,json=primaryColTyp,proto3" json:"primary_col_typ"`
-	RefreshTsIdxInBat    int32         `protobuf:"varint,4,opt,name=refresh_ts_idx_in_bat,json=refreshTsIdxInBat,proto3" json:"refresh_ts_idx_in_bat,omitempty"`
-	FilterColIdxInBat    int32         `protobuf:"varint,5,opt,name=filter_col_idx_in_bat,json=filterColIdxInBat,proto3" json:"filter_col_idx_in_bat,omitempty"`
-	LockTable            bool          `protobuf:"varint,6,opt,name=lock_table,json=lockTable,proto3" json:"lock_table,omitempty"`
-	ChangeDef            bool          `protobuf:"varint,7,opt,name=ChangeDef,proto3" json:"ChangeDef,omitempty"`
-	Mode                 lock.LockMode `protobuf:"varint,8,opt,name=Mode,proto3,enum=lock.LockMode" json:"Mode,omitempty"`
-	LockRows             *plan.Expr    `protobuf:"bytes,9,opt,name=lock_rows,json=lockRows,proto3" json:"lock_rows,omitempty"`
-	LockTableAtTheEnd    bool          `protobuf:"varint,10,opt,name=lock_table_at_the_end,json=lockTableAtTheEnd,proto3" json:"lock_table_at_the_end,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	TableId              uint64          `protobuf:"varint,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	PrimaryColIdxInBat   int32           `protobuf:"varint,2,opt,name=primary_col_idx_in_bat,json=primaryColIdxInBat,proto3" json:"primary_col_idx_in_bat,omitempty"`
+	PrimaryColTyp        plan.Type       `protobuf:"bytes,3,opt,name=primary_col_typ,json=primaryColTyp,proto3" json:"primary_col_typ"`
+	RefreshTsIdxInBat    int32           `protobuf:"varint,4,opt,name=refresh_ts_idx_in_bat,json=refreshTsIdxInBat,proto3" json:"refresh_ts_idx_in_bat,omitempty"`
+	FilterColIdxInBat    int32           `protobuf:"varint,5,opt,name=filter_col_idx_in_bat,json=filterColIdxInBat,proto3" json:"filter_col_idx_in_bat,omitempty"`
+	LockTable            bool            `protobuf:"varint,6,opt,name=lock_table,json=lockTable,proto3" json:"lock_table,omitempty"`
+	ChangeDef            bool            `protobuf:"varint,7,opt,name=ChangeDef,proto3" json:"ChangeDef,omitempty"`
+	Mode                 lock.LockMode   `protobuf:"varint,8,opt,name=Mode,proto3,enum=lock.LockMode" json:"Mode,omitempty"`
+	LockRows             *plan.Expr      `protobuf:"bytes,9,opt,name=lock_rows,json=lockRows,proto3" json:"lock_rows,omitempty"`
+	LockTableAtTheEnd    bool            `protobuf:"varint,10,opt,name=lock_table_at_the_end,json=lockTableAtTheEnd,proto3" json:"lock_table_at_the_end,omitempty"`
+	WaitPolicy           lock.WaitPolicy `protobuf:"varint,11,opt,name=wait_policy,json=waitPolicy,proto3,enum=lock.WaitPolicy" json:"wait_policy,omitempty"`
+	WaitSec              uint64          `protobuf:"varint,12,opt,name=wait_sec,json=waitSec,proto3" json:"wait_sec,omitempty"`
+	SkipLocked           bool            `protobuf:"varint,13,opt,name=skip_locked,json=skipLocked,proto3" json:"skip_locked,omitempty"`
+	SkipLockedLimit      uint64          `protobuf:"varint,14,opt,name=skip_locked_limit,json=skipLockedLimit,proto3" json:"skip_locked_limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *LockTarget) Reset()         { *m = LockTarget{} }
@@ -1705,6 +1733,34 @@ func (m *LockTarget) GetLockTableAtTheEnd() bool {
 	return false
 }
 
+func (m *LockTarget) GetWaitPolicy() lock.WaitPolicy {
+	if m != nil {
+		return m.WaitPolicy
+	}
+	return lock.WaitPolicy_Wait
+}
+
+func (m *LockTarget) GetWaitSec() uint64 {
+	if m != nil {
+		return m.WaitSec
+	}
+	return 0
+}
+
+func (m *LockTarget) GetSkipLocked() bool {
+	if m != nil {
+		return m.SkipLocked
+	}
+	return false
+}
+
+func (m *LockTarget) GetSkipLockedLimit() uint64 {
+	if m != nil {
+		return m.SkipLockedLimit
+	}
+	return 0
+}
+
 type LockOp struct {
 	Targets              []*LockTarget `protobuf:"bytes,1,rep,name=targets,proto3" json:"targets,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
@@ -5918,371 +5974,376 @@ func init() {
 func init() { proto.RegisterFile("pipeline.proto", fileDescriptor_7ac67a7adf3df9c7) }
 
 var fileDescriptor_7ac67a7adf3df9c7 = []byte{
-	// 5812 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7c, 0x4b, 0x6c, 0x1e, 0x47,
-	0x72, 0xb0, 0xbe, 0xf7, 0x4c, 0x7d, 0x0f, 0x7e, 0x6c, 0xbd, 0x3e, 0x4b, 0xb2, 0x44, 0x8d, 0x2d,
-	0x99, 0x2b, 0x5b, 0x94, 0x4d, 0xaf, 0xfe, 0xf5, 0x1f, 0x67, 0xd7, 0x4b, 0x91, 0xd2, 0x2e, 0x6d,
-	0x91, 0x66, 0x9a, 0x54, 0x8c, 0x2c, 0x82, 0x0c, 0x86, 0x33, 0xfd, 0x7d, 0xdf, 0x2c, 0xe7, 0x9b,
-	0x19, 0xcd, 0x43, 0x22, 0x75, 0x0a, 0x90, 0x5c, 0x73, 0xca, 0x29, 0xc8, 0x25, 0xd8, 0x43, 0x82,
-	0x1c, 0xf2, 0x40, 0x82, 0x9c, 0x82, 0xbd, 0xef, 0xde, 0x72, 0xca, 0x31, 0x08, 0x36, 0xb7, 0x3c,
-	0x6e, 0x9b, 0x20, 0x97, 0x00, 0x41, 0x55, 0xf7, 0x3c, 0xbe, 0x87, 0xa8, 0x87, 0xed, 0x60, 0x17,
-	0xd8, 0x5b, 0x77, 0x55, 0x75, 0x4f, 0x77, 0x55, 0x75, 0x75, 0x75, 0x75, 0xf5, 0x40, 0x2f, 0x74,
-	0x43, 0xe1, 0xb9, 0xbe, 0x58, 0x0b, 0xa3, 0x20, 0x09, 0x98, 0x96, 0xd5, 0x2f, 0xdd, 0x1e, 0xb9,
-	0xc9, 0x38, 0x3d, 0x5c, 0xb3, 0x83, 0xc9, 0x9d, 0x51, 0x30, 0x0a, 0xee, 0x10, 0xc1, 0x61, 0x3a,
-	0xa4, 0x1a, 0x55, 0xa8, 0x24, 0x1b, 0x5e, 0x82, 0xd0, 0xb3, 0x7c, 0x55, 0x5e, 0x4a, 0xdc, 0x89,
-	0x88, 0x13, 0x6b, 0x12, 0x66, 0x48, 0x2f, 0xb0, 0x8f, 0x54, 0x59, 0x4f, 0x8e, 0x15, 0x9d, 0xf1,
-	0xc7, 0x55, 0x68, 0xed, 0x88, 0x38, 0xb6, 0x46, 0x82, 0x19, 0x50, 0x8b, 0x5d, 0x67, 0x50, 0x59,
-	0xa9, 0xac, 0xf6, 0xd6, 0xfb, 0x6b, 0xf9, 0xb0, 0xf6, 0x13, 0x2b, 0x49, 0x63, 0x8e, 0x48, 0xa4,
-	0xb1, 0x27, 0xce, 0xa0, 0x3a, 0x4b, 0xb3, 0x23, 0x92, 0x71, 0xe0, 0x70, 0x44, 0xb2, 0x3e, 0xd4,
-	0x44, 0x14, 0x0d, 0x6a, 0x2b, 0x95, 0xd5, 0x0e, 0xc7, 0x22, 0x63, 0x50, 0x77, 0xac, 0xc4, 0x1a,
-	0xd4, 0x09, 0x44, 0x65, 0xf6, 0x36, 0xf4, 0xc2, 0x28, 0xb0, 0x4d, 0xd7, 0x1f, 0x06, 0x26, 0x61,
-	0x1b, 0x84, 0xed, 0x20, 0x74, 0xdb, 0x1f, 0x06, 0x5b, 0x48, 0x35, 0x80, 0x96, 0xe5, 0x5b, 0xde,
-	0x49, 0x2c, 0x06, 0x4d, 0x42, 0x67, 0x55, 0xd6, 0x83, 0xaa, 0xeb, 0x0c, 0x5a, 0x2b, 0x95, 0xd5,
-	0x3a, 0xaf, 0xba, 0x0e, 0x7e, 0x23, 0x4d, 0x5d, 0x67, 0xa0, 0xc9, 0x6f, 0x60, 0x99, 0x19, 0xd0,
-	0xf1, 0x85, 0x70, 0x76, 0x83, 0x84, 0x8b, 0xd0, 0x3b, 0x19, 0xe8, 0x2b, 0x95, 0x55, 0x8d, 0x4f,
-	0xc1, 0xd8, 0x25, 0xd0, 0x1c, 0x71, 0x98, 0x8e, 0x76, 0xe2, 0xd1, 0x00, 0x56, 0x2a, 0xab, 0x3a,
-	0xcf, 0xeb, 0xc6, 0x23, 0xd0, 0x37, 0x03, 0xdf, 0x17, 0x76, 0x12, 0x44, 0xec, 0x1a, 0xb4, 0xb3,
-	0xe9, 0x9a, 0x8a, 0x4d, 0x0d, 0x0e, 0x19, 0x68, 0xdb, 0x61, 0xef, 0xc0, 0x92, 0x9d, 0x51, 0x9b,
-	0xae, 0xef, 0x88, 0x63, 0xe2, 0x53, 0x83, 0xf7, 0x72, 0xf0, 0x36, 0x42, 0x8d, 0x7f, 0xaf, 0x42,
-	0x6b, 0x7f, 0x9c, 0x0e, 0x87, 0x9e, 0x60, 0x6f, 0x43, 0x57, 0x15, 0x37, 0x03, 0x6f, 0xdb, 0x39,
-	0x56, 0xfd, 0x4e, 0x03, 0xd9, 0x0a, 0xb4, 0x15, 0xe0, 0xe0, 0x24, 0x14, 0xaa, 0xdb, 0x32, 0x68,
-	0xba, 0x9f, 0x1d, 0xd7, 0x27, 0xf6, 0xd7, 0xf8, 0x34, 0x70, 0x86, 0xca, 0x3a, 0x26, 0x89, 0x4c,
-	0x53, 0x59, 0xf4, 0xb5, 0x0d, 0xcf, 0x7d, 0x22, 0xb8, 0x18, 0x6d, 0xfa, 0x09, 0xc9, 0xa5, 0xc1,
-	0xcb, 0x20, 0xb6, 0x0e, 0xe7, 0x63, 0xd9, 0xc4, 0x8c, 0x2c, 0x7f, 0x24, 0x62, 0x33, 0x75, 0xfd,
-	0xe4, 0xff, 0x7d, 0x73, 0xd0, 0x5c, 0xa9, 0xad, 0xd6, 0xf9, 0x59, 0x85, 0xe4, 0x84, 0x7b, 0x44,
-	0x28, 0xf6, 0x3e, 0x9c, 0x9b, 0x69, 0x23, 0x9b, 0xb4, 0x56, 0x6a, 0xab, 0x35, 0xce, 0xa6, 0x9a,
-	0x6c, 0x53, 0x8b, 0xfb, 0xb0, 0x1c, 0xa5, 0x3e, 0x6a, 0xf2, 0x03, 0xd7, 0x4b, 0x44, 0xb4, 0x1f,
-	0x0a, 0x9b, 0xe4, 0xdb, 0x5e, 0xbf, 0xb8, 0x46, 0xca, 0xce, 0x67, 0xd1, 0x7c, 0xbe, 0x85, 0xf1,
-	0xdf, 0x55, 0xd0, 0xb6, 0xdc, 0x38, 0xb4, 0x12, 0x7b, 0xcc, 0x2e, 0x42, 0x6b, 0x98, 0xfa, 0x76,
-	0x21, 0xc1, 0x26, 0x56, 0xb7, 0x1d, 0xf6, 0xeb, 0xb0, 0xe4, 0x05, 0xb6, 0xe5, 0x99, 0xb9, 0xb0,
-	0x06, 0xd5, 0x95, 0xda, 0x6a, 0x7b, 0xfd, 0x6c, 0xa1, 0xe5, 0xb9, 0x32, 0xf0, 0x1e, 0xd1, 0x16,
-	0xca, 0xf1, 0x6d, 0xe8, 0x47, 0x62, 0x12, 0x24, 0xa2, 0xd4, 0xbc, 0x46, 0xcd, 0x59, 0xd1, 0xfc,
-	0x8b, 0xc8, 0x0a, 0x77, 0x03, 0x47, 0xf0, 0x25, 0x49, 0x5b, 0x34, 0xff, 0xa0, 0xc4, 0x4f, 0x31,
-	0x32, 0x5d, 0xe7, 0xd8, 0xa4, 0x0f, 0x0c, 0xea, 0x2b, 0xb5, 0xd5, 0x46, 0xc1, 0x1c, 0x31, 0xda,
-	0x76, 0x8e, 0x1f, 0x22, 0x86, 0x7d, 0x08, 0x17, 0x66, 0x9b, 0xc8, 0x5e, 0x07, 0x0d, 0x6a, 0x73,
-	0x76, 0xaa, 0x0d, 0x27, 0x14, 0xbb, 0x0e, 0x9d, 0xac, 0x51, 0x82, 0x8a, 0xd4, 0x94, 0xa2, 0x8d,
-	0x4b, 0x8a, 0x74, 0x11, 0x5a, 0x6e, 0x6c, 0xc6, 0xae, 0x7f, 0x44, 0x8b, 0x4b, 0xe3, 0x4d, 0x37,
-	0xde, 0x77, 0xfd, 0x23, 0xf6, 0x06, 0x68, 0x91, 0xb0, 0x25, 0x46, 0x23, 0x4c, 0x2b, 0x12, 0x36,
-	0xa1, 0x2e, 0x02, 0x16, 0x4d, 0x3b, 0x11, 0x6a, 0x89, 0x35, 0x23, 0x61, 0x6f, 0x26, 0xc2, 0x88,
-	0xa1, 0xb1, 0x23, 0xa2, 0x91, 0xc0, 0x55, 0x86, 0x0d, 0xf7, 0x6d, 0xcb, 0x27, 0xbe, 0x6b, 0x3c,
-	0xaf, 0xe3, 0x1a, 0x0f, 0xad, 0x28, 0x71, 0x2d, 0x8f, 0x14, 0x5b, 0xe3, 0x59, 0x95, 0x5d, 0x06,
-	0x3d, 0x4e, 0xac, 0x28, 0xc1, 0xd9, 0x91, 0x42, 0x37, 0xb8, 0x46, 0x00, 0x5c, 0x13, 0x17, 0xa1,
-	0x25, 0x7c, 0x87, 0x50, 0x75, 0x29, 0x49, 0xe1, 0x3b, 0xdb, 0xce, 0xb1, 0xf1, 0xb7, 0x15, 0xe8,
-	0xee, 0xa4, 0x5e, 0xe2, 0x6e, 0x44, 0xa3, 0x54, 0x4c, 0xfc, 0x04, 0x6d, 0xc3, 0x96, 0x1b, 0x27,
-	0xea, 0xcb, 0x54, 0x66, 0xab, 0xa0, 0x7f, 0x2f, 0x0a, 0xd2, 0xf0, 0xfe, 0x71, 0x98, 0x49, 0x1a,
-	0xa4, 0x52, 0x21, 0x84, 0x17, 0x48, 0xf6, 0x1e, 0xb4, 0x3f, 0x8f, 0x1c, 0x11, 0xdd, 0x3b, 0x21,
-	0xda, 0xda, 0x1c, 0x6d, 0x19, 0xcd, 0xae, 0x80, 0xbe, 0x2f, 0x42, 0x2b, 0xb2, 0x50, 0x05, 0xea,
-	0x64, 0x50, 0x0a, 0x00, 0xce, 0x95, 0x88, 0xb7, 0x1d, 0xb5, 0xac, 0xb2, 0xaa, 0x31, 0x02, 0x7d,
-	0x63, 0x34, 0x8a, 0xc4, 0xc8, 0x4a, 0xc8, 0xb8, 0x05, 0x21, 0x0d, 0xb7, 0xc6, 0xab, 0x41, 0x48,
-	0x06, 0x14, 0x27, 0x20, 0xf9, 0x43, 0x65, 0x76, 0x15, 0xea, 0x62, 0xf1, 0x78, 0x08, 0xce, 0x2e,
-	0x40, 0xd3, 0x0e, 0xfc, 0xa1, 0x3b, 0x52, 0x66, 0x57, 0xd5, 0x8c, 0x3f, 0xa8, 0x41, 0x83, 0x26,
-	0x87, 0xec, 0x45, 0x53, 0x68, 0x8a, 0x27, 0x96, 0x97, 0x49, 0x05, 0x01, 0xf7, 0x9f, 0x58, 0x1e,
-	0x5b, 0x81, 0x06, 0x76, 0x13, 0x2f, 0xe0, 0x8d, 0x44, 0xb0, 0x9b, 0xd0, 0x40, 0x25, 0x8a, 0xa7,
-	0x47, 0x80, 0x4a, 0x74, 0xaf, 0xfe, 0x93, 0x7f, 0xba, 0x76, 0x86, 0x4b, 0x34, 0x7b, 0x07, 0xea,
-	0xd6, 0x68, 0x14, 0x93, 0x2e, 0x4f, 0x2d, 0xa7, 0x7c, 0xbe, 0x9c, 0x08, 0xd8, 0x5d, 0xd0, 0xa5,
-	0xdc, 0x90, 0xba, 0x41, 0xd4, 0x17, 0x4b, 0x5b, 0x4c, 0x59, 0xa4, 0xbc, 0xa0, 0x44, 0x8e, 0xbb,
-	0xb1, 0xb2, 0x60, 0xa4, 0xd1, 0x1a, 0x2f, 0x00, 0xb8, 0x07, 0x84, 0x91, 0xd8, 0xf0, 0xbc, 0xc0,
-	0xde, 0x77, 0x9f, 0x09, 0xb5, 0x63, 0x4c, 0xc1, 0xd8, 0x4d, 0xe8, 0xed, 0x49, 0x95, 0xe3, 0x22,
-	0x4e, 0xbd, 0x24, 0x56, 0xbb, 0xc8, 0x0c, 0x94, 0xad, 0x01, 0x9b, 0x82, 0x1c, 0xd0, 0xf4, 0xf5,
-	0x95, 0xda, 0x6a, 0x97, 0x2f, 0xc0, 0xb0, 0xb7, 0xa0, 0x3b, 0x42, 0x4e, 0xbb, 0xfe, 0xc8, 0x1c,
-	0x7a, 0x16, 0x6e, 0x30, 0x35, 0xdc, 0x80, 0x32, 0xe0, 0x03, 0xcf, 0x1a, 0x19, 0x3f, 0xaf, 0x42,
-	0x73, 0xdb, 0x8f, 0x45, 0x94, 0xe0, 0x2a, 0xb1, 0x86, 0x43, 0x61, 0x27, 0x42, 0x5a, 0xa7, 0x3a,
-	0xcf, 0xeb, 0x38, 0xcb, 0x83, 0xe0, 0x8b, 0xc8, 0x4d, 0xc4, 0xfe, 0x87, 0x4a, 0x0f, 0x0a, 0x00,
-	0xbb, 0x05, 0xcb, 0x96, 0xe3, 0x98, 0x19, 0xb5, 0x19, 0x05, 0x4f, 0x63, 0x5a, 0x31, 0x1a, 0x5f,
-	0xb2, 0x1c, 0x67, 0x43, 0xc1, 0x79, 0xf0, 0x34, 0x66, 0xd7, 0xa1, 0x16, 0x89, 0x21, 0x69, 0x45,
-	0x7b, 0x7d, 0x49, 0x4a, 0xed, 0xf3, 0xc3, 0x1f, 0x0a, 0x3b, 0xe1, 0x62, 0xc8, 0x11, 0xc7, 0xce,
-	0x41, 0xc3, 0x4a, 0x92, 0x48, 0x4a, 0x41, 0xe7, 0xb2, 0xc2, 0xd6, 0xe0, 0x2c, 0xad, 0xcc, 0xc4,
-	0x0d, 0x7c, 0x33, 0xb1, 0x0e, 0x3d, 0xdc, 0x08, 0x63, 0x65, 0xf3, 0x97, 0x73, 0xd4, 0x01, 0x62,
-	0xb6, 0x9d, 0x18, 0x77, 0x89, 0x59, 0x7a, 0xdf, 0x9a, 0x88, 0x98, 0x4c, 0xbe, 0xce, 0xcf, 0x4e,
-	0xb7, 0xd8, 0x45, 0x14, 0xb2, 0xac, 0x68, 0x83, 0x6b, 0x5b, 0xa3, 0x65, 0xd2, 0xc9, 0x81, 0xb8,
-	0xf4, 0xcf, 0x43, 0xd3, 0x8d, 0x4d, 0xe1, 0x3b, 0xca, 0xdc, 0x34, 0xdc, 0xf8, 0xbe, 0xef, 0xb0,
-	0x77, 0x41, 0x97, 0x5f, 0x71, 0xc4, 0x90, 0xf6, 0xf2, 0xf6, 0x7a, 0x4f, 0x29, 0x25, 0x82, 0xb7,
-	0xc4, 0x90, 0x6b, 0x89, 0x2a, 0x19, 0x3f, 0xae, 0x42, 0x9b, 0x74, 0xe8, 0x51, 0xe8, 0xe0, 0x92,
-	0x7b, 0x0b, 0xba, 0xd3, 0xdc, 0x93, 0x02, 0xe8, 0x58, 0x65, 0xd6, 0x5d, 0x80, 0xe6, 0x86, 0x8d,
-	0xa3, 0x20, 0x09, 0x74, 0xb9, 0xaa, 0xe1, 0xb2, 0xde, 0xbe, 0x97, 0xda, 0x47, 0x22, 0x21, 0xa6,
-	0x77, 0x79, 0x56, 0x45, 0xcc, 0xae, 0xc2, 0xd4, 0x25, 0x46, 0x55, 0xd9, 0x7d, 0x80, 0x7d, 0x31,
-	0x9a, 0x08, 0x3f, 0xd9, 0xb1, 0x42, 0xa5, 0xee, 0x37, 0x66, 0xd4, 0x5d, 0x8e, 0x6d, 0xad, 0xa0,
-	0xbb, 0xef, 0x27, 0xd1, 0x09, 0x2f, 0x35, 0x64, 0xdf, 0x82, 0xa5, 0x94, 0xa8, 0x4c, 0x3b, 0x39,
-	0x36, 0x3d, 0xb4, 0x12, 0x4d, 0xea, 0x4b, 0x49, 0x56, 0x76, 0xb1, 0x99, 0x1c, 0xf3, 0x6e, 0x9a,
-	0x15, 0x1f, 0xba, 0x71, 0x72, 0xe9, 0xdb, 0xb0, 0x34, 0xd3, 0x2f, 0x7a, 0x6e, 0x47, 0xe2, 0x84,
-	0x66, 0xae, 0x73, 0x2c, 0xa2, 0x22, 0x3c, 0xb1, 0xbc, 0x34, 0x73, 0x39, 0x64, 0xe5, 0xd7, 0xaa,
-	0x1f, 0x55, 0x8c, 0x37, 0xa1, 0xb1, 0x11, 0x45, 0x16, 0x91, 0x58, 0x58, 0x18, 0x54, 0x68, 0xdf,
-	0x91, 0x15, 0xc3, 0x86, 0x1a, 0x8e, 0xee, 0x06, 0x54, 0x27, 0x21, 0x61, 0xda, 0xeb, 0xe7, 0x4b,
-	0x93, 0xb3, 0xc2, 0xb5, 0x1d, 0x35, 0x99, 0xea, 0x24, 0xbc, 0x74, 0x17, 0x5a, 0x3b, 0xaf, 0x31,
-	0x86, 0xff, 0xac, 0x83, 0xb6, 0x25, 0x3c, 0x41, 0x32, 0x30, 0xa0, 0x53, 0x56, 0xf3, 0x4c, 0x7e,
-	0x53, 0xaa, 0x6f, 0x40, 0x47, 0xee, 0x84, 0xd4, 0x4a, 0xa8, 0x75, 0x34, 0x05, 0x7b, 0x2d, 0x59,
-	0x5e, 0x01, 0x88, 0x82, 0xa7, 0xa6, 0x2b, 0xb7, 0x23, 0x69, 0xd9, 0xb5, 0x28, 0x78, 0xba, 0x8d,
-	0x1b, 0xd2, 0xff, 0xc9, 0xba, 0xf9, 0x16, 0x0c, 0x4a, 0xeb, 0x06, 0xdd, 0x4c, 0xd3, 0xf5, 0xcd,
-	0x43, 0xf4, 0x79, 0xd4, 0x12, 0x2a, 0xfa, 0x24, 0x2f, 0x74, 0xdb, 0xbf, 0x47, 0x0e, 0x91, 0xb2,
-	0x06, 0xfa, 0x29, 0xd6, 0x60, 0xa1, 0x71, 0x81, 0xc5, 0xc6, 0xe5, 0xde, 0x94, 0x56, 0xb7, 0x49,
-	0xf0, 0x46, 0x21, 0xf8, 0x4c, 0x5a, 0xa7, 0xaa, 0xf4, 0x75, 0xe8, 0xd8, 0x96, 0x6f, 0x26, 0x51,
-	0xea, 0xdb, 0x56, 0x22, 0x06, 0x1d, 0xfa, 0x54, 0xdb, 0xb6, 0xfc, 0x03, 0x05, 0x2a, 0x59, 0x80,
-	0x6e, 0xd9, 0x02, 0xdc, 0x84, 0xa5, 0x30, 0x72, 0x27, 0x56, 0x74, 0x62, 0x1e, 0x89, 0x13, 0x12,
-	0x46, 0x4f, 0xfa, 0xd3, 0x0a, 0xfc, 0x99, 0x38, 0xd9, 0x76, 0x8e, 0xbf, 0xac, 0xee, 0xff, 0x63,
-	0x15, 0xf4, 0xbd, 0x48, 0x28, 0xab, 0x7d, 0x0d, 0xda, 0xb1, 0x3d, 0x16, 0x13, 0x8b, 0xa4, 0xa4,
-	0x7a, 0x00, 0x09, 0x42, 0xe1, 0x4c, 0xdb, 0xa5, 0xea, 0xe9, 0x76, 0x09, 0xc7, 0x21, 0xbd, 0x1d,
-	0x5c, 0x4c, 0x58, 0x2c, 0x8c, 0x71, 0xbd, 0x6c, 0x8c, 0x57, 0xa0, 0x33, 0xb6, 0x62, 0xd3, 0x4a,
-	0x93, 0xc0, 0xb4, 0x03, 0x8f, 0x94, 0x4e, 0xe3, 0x30, 0xb6, 0xe2, 0x8d, 0x34, 0x09, 0x36, 0x03,
-	0xf2, 0x9e, 0xdc, 0xd8, 0x94, 0x8b, 0x5e, 0xed, 0x8b, 0x9a, 0x1b, 0x2b, 0x73, 0xb7, 0x06, 0x67,
-	0x45, 0x9c, 0xb8, 0x13, 0x4b, 0x09, 0xd4, 0xb4, 0x83, 0xd4, 0x4f, 0x68, 0x77, 0xac, 0xf1, 0xe5,
-	0x1c, 0xc5, 0x83, 0xa7, 0x9b, 0x88, 0x60, 0xef, 0x43, 0xcf, 0x0e, 0x26, 0xa1, 0x19, 0x22, 0x5f,
-	0xc9, 0xef, 0x90, 0x8e, 0x78, 0xd9, 0x2f, 0xe8, 0x20, 0xc5, 0xde, 0x91, 0x90, 0x8e, 0xd0, 0x3a,
-	0x2c, 0xd9, 0x5e, 0x1a, 0x27, 0x22, 0x32, 0x0f, 0x55, 0x13, 0x7d, 0xae, 0x49, 0x57, 0x91, 0x48,
-	0xe7, 0x09, 0x19, 0xdb, 0xda, 0x0b, 0xe2, 0x64, 0x6b, 0xe2, 0x65, 0x8a, 0x59, 0x79, 0x55, 0xc5,
-	0xac, 0x2e, 0x56, 0xcc, 0x05, 0xaa, 0x51, 0x5b, 0xa0, 0x1a, 0x6c, 0x15, 0xfa, 0x65, 0x3a, 0x12,
-	0xa9, 0x74, 0xe3, 0x7a, 0x05, 0x21, 0x89, 0x55, 0xf2, 0xd7, 0x91, 0x96, 0xa4, 0x91, 0xf1, 0x57,
-	0x59, 0x11, 0x89, 0x74, 0x49, 0x43, 0x0a, 0xe6, 0x2b, 0x8d, 0xf9, 0xff, 0xf0, 0x46, 0xde, 0xd2,
-	0x7c, 0xea, 0x26, 0xe3, 0x20, 0x4d, 0xcc, 0x21, 0x9d, 0x58, 0x62, 0xe5, 0x75, 0x5f, 0xc8, 0x7a,
-	0xfa, 0x42, 0xa2, 0xe5, 0x79, 0x86, 0x7c, 0xa4, 0x61, 0xea, 0x79, 0x66, 0x22, 0x8e, 0x13, 0x25,
-	0x82, 0x81, 0xe4, 0x8d, 0xe2, 0xdb, 0x83, 0xd4, 0xf3, 0x0e, 0xc4, 0x71, 0x82, 0x16, 0x5f, 0x1b,
-	0xaa, 0x8a, 0xf1, 0x77, 0x35, 0x80, 0x87, 0x81, 0x7d, 0x74, 0x60, 0x45, 0x23, 0x91, 0xa0, 0x2f,
-	0x9f, 0xd9, 0x21, 0x65, 0x27, 0x5b, 0x89, 0xb4, 0x3e, 0x6c, 0x1d, 0x2e, 0x64, 0xf3, 0xb7, 0x03,
-	0x8f, 0xce, 0x15, 0xd2, 0x90, 0xa8, 0x65, 0xc0, 0x14, 0x56, 0x9e, 0x4c, 0xc9, 0x8a, 0xb0, 0x8f,
-	0x0a, 0xde, 0x62, 0x9b, 0xe4, 0x24, 0x24, 0xde, 0x2e, 0xf2, 0x09, 0xbb, 0x45, 0xf3, 0x83, 0x93,
-	0x90, 0xbd, 0x0f, 0xe7, 0x23, 0x31, 0x8c, 0x44, 0x3c, 0x36, 0x93, 0xb8, 0xfc, 0x31, 0xe9, 0xd2,
-	0x2f, 0x2b, 0xe4, 0x41, 0x9c, 0x7f, 0xeb, 0x7d, 0x38, 0x2f, 0x39, 0x35, 0x3b, 0x3c, 0x69, 0x75,
-	0x97, 0x25, 0xb2, 0x3c, 0xba, 0x37, 0x81, 0x82, 0x1f, 0xd2, 0x92, 0x66, 0x0e, 0xa2, 0x47, 0xcc,
-	0x38, 0xf4, 0x04, 0x3a, 0x56, 0x9b, 0x63, 0x3c, 0x75, 0x6e, 0x89, 0xa1, 0x62, 0x7e, 0x01, 0x60,
-	0x06, 0xd4, 0x77, 0x02, 0x47, 0x10, 0xab, 0x7b, 0xeb, 0xbd, 0x35, 0x0a, 0xa3, 0x20, 0x27, 0x11,
-	0xca, 0x09, 0xc7, 0xde, 0x01, 0xea, 0x4e, 0xaa, 0xdf, 0xbc, 0x8e, 0x6b, 0x88, 0x24, 0x1d, 0x7c,
-	0x1f, 0xce, 0x17, 0x23, 0x31, 0xad, 0xc4, 0x4c, 0xc6, 0x82, 0x8c, 0x98, 0x34, 0xa6, 0xcb, 0xf9,
-	0xa0, 0x36, 0x92, 0x83, 0xb1, 0xb8, 0xef, 0x3b, 0xc6, 0x47, 0xd0, 0xc4, 0x8f, 0x7d, 0x1e, 0xb2,
-	0x35, 0x68, 0x25, 0x24, 0xbc, 0x58, 0x6d, 0xa7, 0xe7, 0x0a, 0xab, 0x5a, 0x48, 0x96, 0x67, 0x44,
-	0x06, 0x87, 0xa5, 0xdc, 0x44, 0x3d, 0xf2, 0xdd, 0xc7, 0xa9, 0x60, 0x9f, 0xc0, 0x72, 0x18, 0x09,
-	0xa5, 0x94, 0x66, 0x7a, 0x84, 0x1e, 0x83, 0x5a, 0x5f, 0xe7, 0x94, 0x0e, 0xe5, 0x2d, 0x8e, 0x50,
-	0x7f, 0x7a, 0xe1, 0x54, 0xdd, 0xf8, 0x01, 0x5c, 0xcc, 0x29, 0xf6, 0x85, 0x1d, 0xf8, 0x8e, 0x15,
-	0x9d, 0xd0, 0x6e, 0x32, 0xd3, 0x77, 0xfc, 0x2a, 0x7d, 0xef, 0x53, 0xdf, 0x3f, 0xaa, 0x41, 0xef,
-	0x73, 0x7f, 0x2b, 0x0d, 0x3d, 0x17, 0x2d, 0xfc, 0x67, 0xd2, 0x00, 0x4b, 0xc3, 0x57, 0x29, 0x1b,
-	0xbe, 0x55, 0xe8, 0xab, 0xaf, 0xa0, 0x02, 0x48, 0xb3, 0xa5, 0xe2, 0x2c, 0x12, 0xbe, 0x19, 0x78,
-	0xd2, 0x66, 0x7d, 0x1b, 0xce, 0xa7, 0x34, 0x73, 0x49, 0x39, 0x16, 0xf6, 0x91, 0xf9, 0x9c, 0x23,
-	0x13, 0x93, 0x84, 0xd8, 0x14, 0xc9, 0xc8, 0x80, 0x5d, 0x83, 0x76, 0xd1, 0x3c, 0xb3, 0xbe, 0x90,
-	0x13, 0xd2, 0x48, 0x02, 0xdf, 0x74, 0xb2, 0x21, 0xab, 0xbd, 0x1f, 0xed, 0x76, 0x2f, 0x28, 0x66,
-	0x82, 0x46, 0xe5, 0xb7, 0x60, 0x79, 0x8a, 0x92, 0x46, 0x21, 0xdd, 0xb4, 0xdb, 0x85, 0x18, 0xa7,
-	0xa7, 0x5f, 0xae, 0xe2, 0x78, 0xe4, 0x3e, 0xb9, 0x14, 0x4c, 0x43, 0x33, 0x43, 0x33, 0xf2, 0x83,
-	0x48, 0x28, 0xf5, 0x45, 0x43, 0x43, 0xf5, 0x4b, 0xbb, 0x70, 0x6e, 0x51, 0x2f, 0x0b, 0x36, 0xbb,
-	0x95, 0xf2, 0x66, 0x37, 0x73, 0xdc, 0x2b, 0x36, 0xbe, 0x3f, 0xab, 0x40, 0xfb, 0x41, 0xfa, 0xec,
-	0xd9, 0x89, 0x34, 0x47, 0xac, 0x03, 0x95, 0x5d, 0xea, 0xa5, 0xca, 0x2b, 0xbb, 0xe8, 0x1d, 0xef,
-	0x1d, 0xa1, 0x69, 0xa4, 0x4e, 0x74, 0xae, 0x6a, 0x78, 0x50, 0xdc, 0x3b, 0x3a, 0x38, 0xc5, 0x28,
-	0x48, 0x34, 0x1e, 0x7f, 0xee, 0xa5, 0xae, 0x87, 0x3e, 0x93, 0x5a, 0xff, 0x79, 0x1d, 0x8f, 0x5e,
-	0xdb, 0x43, 0xa9, 0x2f, 0x0f, 0xa2, 0x60, 0x22, 0x35, 0x5a, 0x59, 0xdd, 0x05, 0x18, 0xe3, 0xa7,
-	0x35, 0xa8, 0x7f, 0x1a, 0xb8, 0xbe, 0x0c, 0x5b, 0x78, 0xd2, 0x31, 0x96, 0x1e, 0x6a, 0x2b, 0x12,
-	0x1e, 0x7a, 0xc0, 0x88, 0x42, 0xc5, 0xf0, 0xe4, 0xc9, 0x9a, 0x50, 0x76, 0x20, 0x51, 0xc5, 0xe1,
-	0xba, 0xb2, 0xf0, 0x70, 0x9d, 0x9f, 0x7d, 0xeb, 0x2f, 0x3a, 0xfb, 0xea, 0x9e, 0x18, 0xa2, 0xaa,
-	0xfa, 0x8e, 0xf2, 0xf1, 0xa7, 0x4d, 0x83, 0x18, 0x26, 0x9b, 0x81, 0xef, 0xb0, 0x6f, 0x00, 0x44,
-	0xee, 0x68, 0xac, 0x28, 0x9b, 0xf3, 0xf1, 0x08, 0xc2, 0x12, 0x29, 0x87, 0x37, 0x54, 0x90, 0x4b,
-	0xed, 0x19, 0xe6, 0x21, 0x72, 0x49, 0xce, 0xa3, 0x95, 0x1d, 0x9b, 0x17, 0x87, 0xc7, 0x2e, 0x4c,
-	0x85, 0xc7, 0x88, 0xbb, 0x34, 0xdf, 0x2b, 0x80, 0x9e, 0xc3, 0xd8, 0x0c, 0x7c, 0x33, 0xcc, 0xc2,
-	0x3b, 0x1a, 0x42, 0x3e, 0xf7, 0xf7, 0x8e, 0xd0, 0x82, 0xba, 0xb1, 0xa9, 0xa2, 0x44, 0xea, 0xcc,
-	0x55, 0x3a, 0x62, 0xaf, 0x40, 0xe7, 0x87, 0x81, 0xeb, 0x9b, 0x13, 0x2b, 0x34, 0x13, 0x4b, 0x86,
-	0x51, 0x1b, 0x1c, 0x10, 0xb6, 0x63, 0x85, 0x07, 0xd6, 0x88, 0x5c, 0x24, 0x15, 0x77, 0xc2, 0x45,
-	0xd2, 0x96, 0x04, 0x0a, 0x84, 0xe2, 0xbd, 0x0c, 0x3a, 0x75, 0x41, 0x51, 0xa9, 0x8e, 0x94, 0x3d,
-	0x02, 0x90, 0xa3, 0xc6, 0xbf, 0x56, 0x41, 0xdb, 0xf0, 0x13, 0x97, 0xe4, 0x79, 0x01, 0x9a, 0x11,
-	0x1d, 0xb1, 0x95, 0x34, 0x55, 0x2d, 0x97, 0x58, 0xf5, 0x39, 0x12, 0x9b, 0x92, 0x44, 0xed, 0xa5,
-	0x25, 0x51, 0x3f, 0x4d, 0x12, 0xd3, 0x5c, 0x6b, 0x9c, 0xca, 0xb5, 0xb9, 0xc0, 0xc4, 0xd7, 0x21,
-	0xc6, 0x59, 0x49, 0x68, 0x2f, 0x92, 0x84, 0x3e, 0x2b, 0x09, 0xe3, 0xaf, 0x6b, 0xa0, 0x3d, 0x14,
-	0xc3, 0xe4, 0x57, 0x8b, 0xe7, 0x97, 0x65, 0xf1, 0x18, 0xff, 0x51, 0x03, 0x9d, 0xe3, 0x0c, 0xbf,
-	0x46, 0x99, 0xdd, 0x01, 0x20, 0x59, 0x9c, 0x2e, 0x38, 0x92, 0x97, 0x8c, 0x7d, 0x7d, 0x00, 0x6d,
-	0x29, 0x13, 0xd9, 0xa2, 0xf1, 0x9c, 0x16, 0x52, 0x70, 0x07, 0xf3, 0xf2, 0x6e, 0xbe, 0xb4, 0xbc,
-	0x5b, 0xaf, 0x2d, 0x6f, 0xed, 0xab, 0x90, 0xb7, 0x7e, 0xaa, 0xbc, 0xe1, 0x45, 0xf2, 0x6e, 0xbf,
-	0x48, 0xde, 0x9d, 0x39, 0x79, 0xff, 0xa8, 0x06, 0x5d, 0x92, 0xf7, 0xbe, 0x98, 0x7c, 0x39, 0xa3,
-	0x38, 0x23, 0xa4, 0xda, 0xab, 0x0a, 0xa9, 0xfe, 0xd2, 0x42, 0x6a, 0xbc, 0xb6, 0x90, 0x9a, 0x5f,
-	0x85, 0x90, 0x5a, 0xa7, 0x0a, 0x49, 0x7b, 0x91, 0x90, 0xf4, 0x57, 0x5f, 0x94, 0xb9, 0x90, 0xbe,
-	0xf4, 0xce, 0xf5, 0x2b, 0x21, 0x7d, 0x45, 0x42, 0x82, 0x39, 0x21, 0xa1, 0x67, 0xf1, 0xa5, 0x17,
-	0xd1, 0xd7, 0xe1, 0x59, 0x9c, 0xca, 0xec, 0xc6, 0x57, 0xc1, 0xec, 0xe6, 0xa9, 0xcc, 0x6e, 0xbd,
-	0x88, 0xd9, 0xaf, 0xe1, 0x59, 0xfc, 0x4d, 0x0d, 0x60, 0xdf, 0xf5, 0x47, 0x9e, 0xf8, 0x95, 0x6f,
-	0xf1, 0x4b, 0xe3, 0x5b, 0xfc, 0x7d, 0x15, 0xb4, 0x1d, 0x2b, 0x3a, 0xfa, 0x85, 0x5b, 0x21, 0x6f,
-	0x41, 0x2b, 0xf0, 0xcb, 0xeb, 0xa1, 0x4c, 0xd7, 0x0c, 0xfc, 0x5f, 0x08, 0x95, 0xff, 0x69, 0x03,
-	0xf4, 0x2d, 0xe1, 0xa4, 0xe1, 0x97, 0xd0, 0xf8, 0x5f, 0x16, 0xf3, 0xf2, 0x82, 0xe3, 0xce, 0x2c,
-	0x37, 0x5b, 0x2f, 0xe2, 0xa6, 0x36, 0x77, 0x48, 0x7c, 0x08, 0x67, 0xa7, 0xa2, 0x28, 0x96, 0xbc,
-	0x8a, 0xd3, 0x29, 0x34, 0x77, 0x45, 0x8e, 0x77, 0x37, 0x70, 0xa6, 0x02, 0x29, 0xf2, 0x82, 0x8e,
-	0x2f, 0x07, 0xb3, 0x20, 0xf6, 0x36, 0xf4, 0x1c, 0x14, 0x0d, 0x05, 0x87, 0x28, 0xcc, 0x2b, 0xd3,
-	0x7f, 0x3a, 0x04, 0xdd, 0x0c, 0x3c, 0x8a, 0x5d, 0x7c, 0x04, 0x4b, 0x05, 0x95, 0xb4, 0x2c, 0xed,
-	0xe7, 0x58, 0x96, 0x6e, 0xd6, 0x50, 0xee, 0xc1, 0xd3, 0x1e, 0x73, 0xe7, 0x95, 0x3d, 0xe6, 0xee,
-	0x4b, 0xec, 0xf3, 0xb7, 0xe1, 0x6c, 0x76, 0xf9, 0xa7, 0x82, 0xa1, 0x24, 0xc1, 0x1e, 0x69, 0x50,
-	0x5f, 0xdd, 0xf7, 0x51, 0x28, 0x94, 0x44, 0xf4, 0x31, 0x9c, 0x2b, 0x91, 0xe3, 0xd2, 0x94, 0xf4,
-	0x4b, 0x73, 0xba, 0xb2, 0x9c, 0xb7, 0xc5, 0x2a, 0x36, 0x36, 0x7e, 0xb7, 0x02, 0xad, 0xbd, 0x28,
-	0x70, 0x52, 0x3b, 0x79, 0x4d, 0x4d, 0x9e, 0xd6, 0x90, 0xda, 0x8b, 0x34, 0xa4, 0x3e, 0xab, 0x21,
-	0xc6, 0xef, 0x55, 0x40, 0x57, 0x43, 0x78, 0xb8, 0xfe, 0x35, 0x6d, 0x20, 0x2f, 0x1e, 0xc5, 0x53,
-	0xd0, 0x29, 0xe6, 0x79, 0xaa, 0x49, 0x3c, 0x75, 0x85, 0x55, 0x5f, 0x6b, 0x85, 0x19, 0x7f, 0x58,
-	0x81, 0x2e, 0x85, 0x87, 0x1f, 0xa4, 0xbe, 0xd4, 0xe1, 0xc5, 0x11, 0xd2, 0x15, 0xa8, 0x47, 0x22,
-	0xc9, 0x32, 0x37, 0x3a, 0xf2, 0x33, 0x9b, 0x81, 0xb7, 0x25, 0x86, 0x9c, 0x30, 0xc8, 0x04, 0x2b,
-	0x1a, 0xc5, 0x8b, 0x72, 0x47, 0x10, 0x8e, 0xb3, 0x0a, 0xad, 0xc8, 0x9a, 0xc4, 0x59, 0xee, 0x88,
-	0xac, 0x31, 0x06, 0x75, 0x5a, 0x29, 0x0d, 0x5a, 0x29, 0x54, 0x36, 0x36, 0xe0, 0xfc, 0xfd, 0xe3,
-	0x44, 0x44, 0xbe, 0x45, 0x2b, 0x66, 0x1d, 0xf5, 0x8d, 0x42, 0xc2, 0x19, 0x71, 0xa5, 0x20, 0xc6,
-	0x01, 0x97, 0x33, 0xe3, 0x64, 0xc5, 0xb8, 0x01, 0xed, 0xa1, 0xeb, 0x09, 0x33, 0x18, 0x0e, 0x63,
-	0x91, 0xe0, 0xd7, 0x65, 0x89, 0xa6, 0x55, 0xe3, 0xaa, 0x66, 0xfc, 0xb8, 0x0e, 0x9d, 0xec, 0x53,
-	0x94, 0x39, 0xb4, 0x78, 0xfa, 0x97, 0x41, 0xa7, 0xde, 0x62, 0xf7, 0x99, 0x20, 0x1e, 0xd4, 0xb8,
-	0x86, 0x00, 0x4a, 0xf5, 0xd8, 0x80, 0xe5, 0xd2, 0xa7, 0xcc, 0x24, 0x48, 0x2c, 0x4f, 0xb1, 0xa1,
-	0x74, 0x3f, 0x5d, 0x22, 0xe1, 0x4b, 0x58, 0xf9, 0x9c, 0xca, 0x07, 0x48, 0x8d, 0xec, 0xcd, 0x03,
-	0xc2, 0x73, 0xec, 0x45, 0x0c, 0xfb, 0x1e, 0x2c, 0xe1, 0x6c, 0xd7, 0xe5, 0xaa, 0xa4, 0xf9, 0x4a,
-	0xa3, 0x7a, 0xad, 0xf8, 0xc4, 0x42, 0x9e, 0xf1, 0xae, 0x3f, 0xc5, 0xc2, 0x37, 0x01, 0xec, 0x48,
-	0xe0, 0x82, 0x8d, 0x1f, 0x7b, 0x64, 0x53, 0x75, 0xae, 0x4b, 0xc8, 0xfe, 0x63, 0x2f, 0x9f, 0x69,
-	0xee, 0x60, 0xe8, 0x72, 0xa6, 0xa4, 0xe8, 0xb7, 0xa1, 0x1d, 0x44, 0xee, 0xc8, 0xf5, 0x65, 0xf8,
-	0x5a, 0x5b, 0x30, 0x5a, 0x90, 0x04, 0x14, 0xcc, 0x36, 0xa0, 0x29, 0x15, 0x75, 0xc1, 0x0d, 0x86,
-	0xc2, 0x30, 0x0e, 0xbd, 0x83, 0x43, 0x34, 0x70, 0x94, 0x9c, 0xb9, 0x19, 0x78, 0x94, 0xd0, 0xd2,
-	0x5e, 0xbf, 0x35, 0x3f, 0x2d, 0x94, 0xcf, 0xda, 0x34, 0xb1, 0x0c, 0x60, 0xcf, 0xf4, 0xc0, 0x6e,
-	0xc2, 0x52, 0x9c, 0x44, 0xae, 0x9d, 0xe0, 0x14, 0xcd, 0x49, 0xe0, 0x08, 0xf2, 0x42, 0x34, 0xde,
-	0x95, 0xe0, 0xfd, 0xc7, 0xde, 0x4e, 0xe0, 0x88, 0x4b, 0x1b, 0x70, 0x76, 0x41, 0x77, 0xaf, 0x74,
-	0x6d, 0x6b, 0x03, 0xec, 0x27, 0x91, 0xb0, 0x26, 0xa4, 0x3c, 0xef, 0x40, 0x2b, 0x39, 0xf4, 0xe8,
-	0x4e, 0xb6, 0xb2, 0xf0, 0x4e, 0xb6, 0x99, 0x1c, 0x22, 0x97, 0x4a, 0xea, 0x58, 0xa5, 0xdb, 0x51,
-	0x55, 0xc3, 0x0f, 0x79, 0xee, 0xc4, 0x4d, 0x54, 0xaa, 0xa5, 0xac, 0x18, 0x1f, 0x82, 0x4e, 0x3d,
-	0xd0, 0x37, 0x72, 0x6f, 0xb4, 0x72, 0xaa, 0x37, 0x6a, 0xbc, 0x07, 0xfa, 0x6f, 0xe2, 0x30, 0xa9,
-	0xd1, 0x35, 0x68, 0xd3, 0xbd, 0xbd, 0x79, 0xe8, 0x05, 0xf6, 0x51, 0x76, 0x9f, 0x4c, 0xa0, 0x7b,
-	0x08, 0x31, 0x00, 0xb4, 0x47, 0xbe, 0x1b, 0xf8, 0x1b, 0x9e, 0x67, 0xfc, 0x51, 0x1d, 0xf4, 0xef,
-	0x5b, 0xf1, 0x98, 0xac, 0x04, 0x5b, 0x81, 0xf6, 0xae, 0x10, 0x0e, 0x02, 0x76, 0xac, 0x50, 0xe5,
-	0x74, 0x95, 0x41, 0xec, 0x12, 0x68, 0xdf, 0x97, 0xfe, 0xcf, 0x67, 0xea, 0xa6, 0x34, 0xaf, 0x67,
-	0xad, 0x29, 0x2f, 0x40, 0x64, 0xe9, 0x43, 0x65, 0x10, 0xbb, 0x05, 0x7d, 0xac, 0x52, 0xe6, 0x14,
-	0xea, 0xa0, 0xf0, 0xa4, 0x85, 0xd0, 0xf8, 0x1c, 0x9c, 0xdd, 0x02, 0x40, 0x5f, 0x83, 0x32, 0x0e,
-	0xe2, 0x05, 0x3e, 0x5a, 0x09, 0xcb, 0xae, 0x02, 0x7c, 0x9a, 0x1b, 0x58, 0x95, 0x95, 0x58, 0x82,
-	0xb0, 0xb7, 0xa1, 0xab, 0x6a, 0x5c, 0x0c, 0x37, 0xd5, 0x3d, 0x75, 0x83, 0x4f, 0x03, 0xd9, 0x7d,
-	0x58, 0xe6, 0xaf, 0x9c, 0x2f, 0x3a, 0x07, 0xc2, 0xcd, 0x83, 0x6e, 0x67, 0x9d, 0x34, 0x54, 0x2e,
-	0x75, 0xcb, 0x8d, 0xc9, 0x8b, 0x7b, 0x9e, 0x07, 0x02, 0x5f, 0x95, 0x07, 0xd2, 0x7e, 0x39, 0x0f,
-	0xa4, 0xf3, 0x52, 0x1e, 0x88, 0xf1, 0xf3, 0x1a, 0x74, 0xd4, 0xe6, 0x4a, 0x9b, 0xcf, 0x94, 0xf0,
-	0x2b, 0xa7, 0x0b, 0xbf, 0xfa, 0x72, 0xc2, 0xaf, 0xbd, 0x94, 0xf0, 0xeb, 0xa7, 0x0a, 0x7f, 0xa1,
-	0xd8, 0x1a, 0xaf, 0x2c, 0xb6, 0x17, 0xe9, 0xd0, 0x55, 0x80, 0xfd, 0xdc, 0x97, 0xcc, 0xdc, 0xcf,
-	0x02, 0x32, 0x25, 0x76, 0xed, 0xa5, 0xc4, 0xfe, 0x8b, 0xe9, 0x78, 0x1a, 0xfb, 0x00, 0xb4, 0x7b,
-	0x48, 0x99, 0x2f, 0xe4, 0x6e, 0xe5, 0x55, 0xb9, 0x6b, 0xfc, 0x4f, 0x05, 0x60, 0xdf, 0x9a, 0x84,
-	0xd2, 0xf9, 0x60, 0xdf, 0x85, 0x76, 0x4c, 0x35, 0x79, 0x63, 0x23, 0xdf, 0x0c, 0x94, 0x76, 0xb7,
-	0x82, 0x54, 0x15, 0x71, 0x68, 0x1c, 0xe2, 0xbc, 0x4c, 0xde, 0xbe, 0xec, 0x21, 0xcf, 0xda, 0x68,
-	0x64, 0x04, 0x74, 0x59, 0x7e, 0x03, 0x7a, 0x8a, 0x20, 0x14, 0x91, 0x2d, 0x7c, 0x69, 0x67, 0x2b,
-	0xbc, 0x2b, 0xa1, 0x7b, 0x12, 0xc8, 0x3e, 0xc8, 0xc9, 0xec, 0xc0, 0x4b, 0x27, 0x0b, 0xb5, 0x4d,
-	0x35, 0xd9, 0x94, 0x04, 0xc6, 0x7a, 0x36, 0x15, 0x1a, 0x88, 0x06, 0x75, 0xfc, 0x5e, 0xff, 0x0c,
-	0x6b, 0x43, 0x4b, 0xf5, 0xda, 0xaf, 0xb0, 0x2e, 0xe8, 0x94, 0xba, 0x4c, 0xb8, 0xaa, 0xf1, 0xa7,
-	0x67, 0xa1, 0xbd, 0xed, 0xc7, 0x49, 0x94, 0x4a, 0x21, 0x16, 0x19, 0xba, 0x0d, 0xca, 0xd0, 0x55,
-	0x69, 0x3b, 0x72, 0x1a, 0x94, 0xb6, 0x73, 0x13, 0xea, 0x96, 0x9f, 0xb8, 0xca, 0xd1, 0x2c, 0xa5,
-	0x81, 0x67, 0x01, 0x41, 0x4e, 0x78, 0x76, 0x1b, 0x5a, 0x2a, 0x67, 0x5c, 0xa5, 0x64, 0x2e, 0x4c,
-	0x38, 0xcf, 0x68, 0xd8, 0x1a, 0x68, 0x8e, 0x4a, 0x66, 0x57, 0x8b, 0xa4, 0xd4, 0x75, 0x96, 0xe6,
-	0xce, 0x73, 0x1a, 0x76, 0x1d, 0x6a, 0xd6, 0x48, 0xae, 0x07, 0x4a, 0xa3, 0xc9, 0x48, 0x29, 0x05,
-	0x98, 0x23, 0x8e, 0x19, 0x50, 0x47, 0xf7, 0x96, 0xd6, 0x04, 0x6d, 0x83, 0x19, 0x8d, 0x1c, 0x25,
-	0xe2, 0xd8, 0x1d, 0x75, 0x0a, 0x25, 0x42, 0x6d, 0xf6, 0xbb, 0xd9, 0x85, 0x91, 0x3c, 0x8d, 0x7e,
-	0xaa, 0x1a, 0xc4, 0x62, 0xe2, 0xca, 0x06, 0xfa, 0x6c, 0x83, 0x2c, 0xe8, 0xc6, 0xb5, 0x38, 0x0b,
-	0xbf, 0xdd, 0x85, 0x76, 0x4c, 0xd1, 0x21, 0xd9, 0x04, 0xb2, 0xdc, 0x81, 0xbc, 0x49, 0x1e, 0x3a,
-	0xe2, 0x10, 0x17, 0x61, 0xa4, 0x3b, 0xa0, 0x4f, 0xac, 0xe8, 0x48, 0x36, 0x6a, 0xcf, 0x7e, 0x27,
-	0x0b, 0x5d, 0x70, 0x6d, 0x92, 0x05, 0x31, 0xd6, 0x01, 0xe4, 0xc2, 0xa2, 0x16, 0x9d, 0x59, 0x96,
-	0xe7, 0xc7, 0x75, 0xae, 0x3b, 0xf9, 0xc9, 0xfd, 0x5d, 0x68, 0x85, 0xf2, 0xdc, 0x41, 0xf9, 0x66,
-	0xed, 0xf5, 0xe5, 0xa2, 0x81, 0x3a, 0x90, 0xf0, 0x8c, 0x82, 0x7d, 0x07, 0x7a, 0x32, 0xc1, 0x63,
-	0xa8, 0xdc, 0x74, 0xca, 0x41, 0x9b, 0xca, 0x65, 0x9e, 0xf2, 0xe2, 0x79, 0x37, 0x99, 0x72, 0xea,
-	0x3f, 0x86, 0xae, 0x50, 0x5e, 0x94, 0x19, 0xdb, 0x96, 0x3f, 0xe8, 0x53, 0xf3, 0x0b, 0x8b, 0x9d,
-	0x2c, 0xde, 0x11, 0x65, 0x97, 0x78, 0x15, 0x9a, 0x2a, 0xe9, 0x68, 0x99, 0x5a, 0x95, 0xde, 0xe8,
-	0xc8, 0x3b, 0x72, 0xae, 0xf0, 0xec, 0xde, 0x4c, 0xf6, 0x02, 0xba, 0x51, 0x2c, 0x4b, 0x28, 0x5a,
-	0x9c, 0x92, 0x30, 0x95, 0xd7, 0xf0, 0x99, 0x38, 0x41, 0x5e, 0x16, 0x59, 0x1f, 0x83, 0xb3, 0xb3,
-	0xbc, 0xcc, 0x53, 0x3e, 0xb8, 0x9e, 0x67, 0x7b, 0xa0, 0x41, 0x2a, 0x67, 0xa1, 0xc8, 0x8b, 0xfc,
-	0x73, 0xd4, 0xf4, 0x8d, 0x05, 0x4d, 0xe5, 0x7d, 0x3e, 0x5f, 0x0a, 0x67, 0x92, 0x59, 0xde, 0x03,
-	0x2d, 0x88, 0x1c, 0x4a, 0x2e, 0x1b, 0x9c, 0xa7, 0x15, 0xbf, 0xac, 0x72, 0xc4, 0x64, 0x32, 0x3e,
-	0x19, 0xb2, 0x56, 0x20, 0x2b, 0xec, 0x36, 0x74, 0xc2, 0x28, 0xf8, 0xa1, 0xb0, 0x13, 0xe9, 0x2c,
-	0x5f, 0x98, 0x4f, 0xe2, 0x57, 0x78, 0xf2, 0x9d, 0x0b, 0x67, 0xf8, 0xe2, 0x73, 0x9d, 0xe1, 0x95,
-	0xcc, 0xfd, 0x1b, 0xcc, 0x67, 0x4c, 0x10, 0x02, 0x7b, 0x51, 0x8e, 0xe3, 0x1b, 0xf3, 0xbd, 0x28,
-	0x27, 0x72, 0x00, 0x2d, 0x37, 0x7e, 0xe0, 0x46, 0x71, 0x32, 0xb8, 0x94, 0x6d, 0x3a, 0x54, 0x45,
-	0xb7, 0xd3, 0x8d, 0x1f, 0x5a, 0x71, 0x32, 0xb8, 0x9c, 0xbd, 0xc3, 0xc0, 0x1a, 0xf2, 0x5c, 0x86,
-	0x09, 0x48, 0x7f, 0xaf, 0xcc, 0xf2, 0x3c, 0xbf, 0x08, 0x54, 0xf1, 0x1e, 0xd2, 0xdf, 0x4f, 0x60,
-	0x49, 0xb6, 0x29, 0x96, 0xe4, 0x9b, 0xb3, 0x3a, 0x39, 0x75, 0xa3, 0xc4, 0xbb, 0xd1, 0xd4, 0x05,
-	0x53, 0xde, 0x01, 0x9a, 0x2c, 0xd9, 0xc1, 0xd5, 0x85, 0x1d, 0xe4, 0xc6, 0x4d, 0x76, 0x90, 0x5f,
-	0x7e, 0xdc, 0x82, 0xa6, 0xca, 0x94, 0xbb, 0x36, 0x67, 0xb4, 0x54, 0x4e, 0x28, 0x57, 0x14, 0xec,
-	0x1b, 0xd0, 0xa2, 0x34, 0xa9, 0x20, 0x1c, 0xac, 0xcc, 0x2a, 0xb1, 0xcc, 0x86, 0xe2, 0x4d, 0x4f,
-	0x66, 0x45, 0xbd, 0x0b, 0xad, 0x2c, 0x9e, 0x70, 0x7d, 0x76, 0x61, 0xaa, 0xbd, 0x9d, 0x67, 0x14,
-	0xec, 0x06, 0x34, 0x26, 0x68, 0xd2, 0x07, 0xc6, 0xac, 0x31, 0x94, 0x96, 0x5e, 0x62, 0xc9, 0x10,
-	0xd1, 0x31, 0x41, 0xae, 0xbe, 0xb7, 0xe6, 0x0c, 0x51, 0x7e, 0x86, 0xe0, 0x10, 0x17, 0xe7, 0x89,
-	0xdf, 0x81, 0x4b, 0xe5, 0x0c, 0xa8, 0x2c, 0x3d, 0x4a, 0x9d, 0xff, 0xde, 0xa6, 0x5e, 0xae, 0x2f,
-	0x50, 0xf0, 0xe9, 0x44, 0x2a, 0x7e, 0x31, 0x7c, 0x4e, 0x86, 0xd5, 0xdd, 0x7c, 0xc3, 0x44, 0xbb,
-	0x32, 0xb8, 0x31, 0x37, 0xac, 0x7c, 0xcb, 0xcd, 0xb6, 0x51, 0xda, 0xa9, 0x3f, 0x82, 0xce, 0x30,
-	0x7d, 0xf6, 0xec, 0x44, 0x85, 0x21, 0x06, 0x37, 0xa9, 0x5d, 0xe9, 0xac, 0x5b, 0xca, 0xe7, 0xe1,
-	0xed, 0x61, 0x29, 0xb9, 0xe7, 0x22, 0xb4, 0x6c, 0xdf, 0xb4, 0x1c, 0x27, 0x1a, 0xbc, 0x23, 0xf3,
-	0x79, 0x6c, 0x7f, 0xc3, 0x71, 0x28, 0x31, 0x2a, 0x08, 0x05, 0x3d, 0x68, 0x31, 0x5d, 0x67, 0xb0,
-	0x2a, 0xb7, 0xee, 0x0c, 0xb4, 0xed, 0xd0, 0x53, 0x39, 0x2b, 0xb2, 0x3c, 0x4f, 0x78, 0x48, 0xf0,
-	0x0d, 0xf5, 0x54, 0x4e, 0x81, 0xb6, 0x1d, 0x76, 0x1d, 0x3a, 0x13, 0xeb, 0xd8, 0xcc, 0x20, 0x83,
-	0x5b, 0xf2, 0x1d, 0xd2, 0xc4, 0x3a, 0xde, 0x53, 0x20, 0x54, 0x73, 0x99, 0xc6, 0x4c, 0xca, 0xf6,
-	0xee, 0xac, 0x9a, 0xe7, 0x11, 0x18, 0xae, 0xbb, 0x79, 0x30, 0x86, 0xcc, 0x11, 0x19, 0x61, 0xd3,
-	0x5b, 0x1f, 0xbc, 0x37, 0x6f, 0x8e, 0x54, 0xe8, 0x08, 0xcd, 0x51, 0x16, 0x45, 0x5a, 0x07, 0x90,
-	0xd6, 0x9a, 0x84, 0x7d, 0x7b, 0xb6, 0x4d, 0x7e, 0x96, 0xe3, 0x32, 0x87, 0x97, 0x44, 0xbd, 0x0e,
-	0x40, 0xa7, 0x4a, 0xd9, 0x66, 0x6d, 0xb6, 0x4d, 0x7e, 0x94, 0xe3, 0xfa, 0x93, 0xfc, 0x54, 0x77,
-	0x07, 0xf4, 0x14, 0x0f, 0x6d, 0xa6, 0xe5, 0x79, 0x83, 0x3b, 0xb3, 0x6b, 0x20, 0x3b, 0xcf, 0x71,
-	0x2d, 0x55, 0x25, 0xfc, 0x08, 0xc5, 0xae, 0xc9, 0x8d, 0x1b, 0xbc, 0x3f, 0xfb, 0x91, 0xfc, 0xd0,
-	0xc7, 0xf5, 0x71, 0x7e, 0xfe, 0xfb, 0x18, 0xba, 0x59, 0x08, 0x55, 0x36, 0xfb, 0x60, 0x76, 0xeb,
-	0x28, 0x9f, 0x07, 0x78, 0xf6, 0x18, 0x4c, 0x36, 0xbe, 0x0b, 0x6d, 0xc9, 0x71, 0xd9, 0x74, 0x7d,
-	0x56, 0xc1, 0x0a, 0xa7, 0x92, 0x4b, 0xd1, 0xc8, 0x66, 0x37, 0xa0, 0x61, 0x85, 0xa1, 0x77, 0x32,
-	0xf8, 0x70, 0x76, 0x55, 0x6d, 0x20, 0x98, 0x4b, 0x2c, 0xea, 0xe1, 0x24, 0xf5, 0x12, 0x37, 0x4b,
-	0x48, 0xfe, 0xe6, 0xac, 0x1e, 0x96, 0x1e, 0x3c, 0xf0, 0xf6, 0xa4, 0xf4, 0x32, 0xe3, 0x3d, 0xd0,
-	0xc2, 0x20, 0x4e, 0x4c, 0x67, 0xe2, 0x0d, 0xee, 0xce, 0xed, 0xbe, 0x32, 0xeb, 0x95, 0xb7, 0x42,
-	0x59, 0x30, 0xee, 0x42, 0x67, 0x83, 0x9e, 0x88, 0xba, 0x31, 0x99, 0xf2, 0x1b, 0x50, 0xcf, 0x23,
-	0x84, 0xf9, 0x1e, 0x41, 0x14, 0xcf, 0xc4, 0xb6, 0x3f, 0x0c, 0x38, 0xa1, 0x8d, 0xbf, 0xac, 0x41,
-	0x73, 0x3f, 0x48, 0x23, 0x5b, 0xbc, 0x38, 0x9f, 0xfb, 0xcd, 0x4c, 0x65, 0xfc, 0x22, 0xd7, 0x4d,
-	0x6a, 0x07, 0xa1, 0xcb, 0xc1, 0xc7, 0x1a, 0x05, 0x65, 0xf2, 0xe0, 0xe3, 0x39, 0x68, 0xc8, 0x43,
-	0xbd, 0xcc, 0x28, 0x96, 0x15, 0x5a, 0x2e, 0x69, 0x3c, 0x76, 0x82, 0xa7, 0x3e, 0x2e, 0x97, 0x06,
-	0x25, 0xe4, 0x42, 0x06, 0xda, 0x76, 0xe8, 0x51, 0x4c, 0x46, 0x40, 0xeb, 0x51, 0x46, 0x82, 0x3a,
-	0x19, 0x90, 0x56, 0x65, 0x16, 0xd8, 0x6c, 0x3d, 0x27, 0xb0, 0x79, 0x0b, 0xf2, 0x24, 0x73, 0xe5,
-	0xc0, 0x3d, 0x3f, 0x09, 0x7d, 0x1d, 0xf4, 0xfc, 0x01, 0xb1, 0x72, 0xde, 0xce, 0xad, 0x15, 0x4f,
-	0x8a, 0x0f, 0xb2, 0x12, 0x2f, 0xc8, 0x16, 0x44, 0x3c, 0xc3, 0x28, 0x38, 0x54, 0xc1, 0x29, 0x78,
-	0x95, 0x88, 0xe7, 0x1e, 0xb6, 0xcb, 0xe2, 0xb8, 0x6e, 0x6c, 0xda, 0x81, 0x1f, 0x27, 0x2a, 0x2a,
-	0xd4, 0x72, 0xe3, 0x4d, 0xac, 0x1a, 0xbf, 0x0d, 0x1a, 0x1e, 0xb9, 0x50, 0x84, 0x8c, 0x41, 0x7d,
-	0x62, 0x87, 0xa9, 0x72, 0xc7, 0xa9, 0xac, 0xde, 0x07, 0x4b, 0xe1, 0xa8, 0xf7, 0xc1, 0xc4, 0xba,
-	0x9a, 0x8c, 0x46, 0x62, 0x59, 0xbe, 0x3c, 0x3c, 0xf1, 0x02, 0xcb, 0x51, 0x02, 0xc9, 0xaa, 0xc6,
-	0x5f, 0x54, 0x60, 0x79, 0x2f, 0x0a, 0x6c, 0x11, 0xc7, 0x0f, 0x71, 0x2f, 0xb7, 0xc8, 0x33, 0x63,
-	0x50, 0xa7, 0xa0, 0xa2, 0x7c, 0x98, 0x47, 0x65, 0x54, 0x06, 0x19, 0xad, 0xc9, 0x8f, 0x31, 0x35,
-	0xae, 0x13, 0x84, 0x4e, 0x31, 0x39, 0x9a, 0x1a, 0xd6, 0x4a, 0x68, 0x0a, 0x47, 0xde, 0x80, 0x5e,
-	0xf1, 0x6c, 0x83, 0x7a, 0x50, 0x2f, 0x72, 0x73, 0x28, 0xf5, 0x72, 0x0d, 0xda, 0x91, 0xb0, 0xd0,
-	0xdb, 0xa1, 0x6e, 0x1a, 0x44, 0x03, 0x12, 0x84, 0xfd, 0x18, 0x63, 0xe8, 0xef, 0x45, 0x22, 0xb4,
-	0x22, 0x81, 0x06, 0x74, 0x42, 0x5c, 0xb9, 0x00, 0x4d, 0x4f, 0xf8, 0xa3, 0x64, 0xac, 0xc6, 0xab,
-	0x6a, 0xf9, 0x6b, 0xec, 0x6a, 0xe9, 0x35, 0x36, 0x72, 0x27, 0x12, 0x96, 0x7a, 0xb4, 0x4d, 0x65,
-	0x54, 0x56, 0x3f, 0xf5, 0x54, 0xa0, 0x53, 0xe3, 0xb2, 0x62, 0xfc, 0x79, 0x0d, 0xda, 0x8a, 0x33,
-	0xf4, 0x15, 0xc9, 0xe7, 0x4a, 0xce, 0xe7, 0x3e, 0xd4, 0xe2, 0xc7, 0x9e, 0x62, 0x3c, 0x16, 0xd9,
-	0x87, 0x50, 0xf3, 0xdc, 0x89, 0x3a, 0x07, 0x5d, 0x9e, 0x32, 0xc7, 0xd3, 0xfc, 0x55, 0xc7, 0x59,
-	0xa4, 0x66, 0x97, 0xc9, 0x5c, 0x1e, 0x9b, 0xa8, 0x15, 0x8a, 0x27, 0x68, 0x1a, 0x8f, 0x51, 0xf5,
-	0x90, 0xa9, 0x96, 0x4d, 0x99, 0xbf, 0xd9, 0x7a, 0xe9, 0x72, 0x5d, 0x41, 0xb6, 0x1d, 0xf6, 0x4d,
-	0xd0, 0x62, 0xdf, 0x0a, 0xe3, 0x71, 0x90, 0xa8, 0x73, 0x0f, 0x5b, 0x4b, 0x8e, 0xfd, 0xb5, 0xcd,
-	0xdd, 0x83, 0x63, 0x7f, 0x5f, 0x61, 0xd4, 0xc7, 0x72, 0x4a, 0xf6, 0x1d, 0xe8, 0xc4, 0x22, 0x8e,
-	0xe5, 0xfb, 0x99, 0x61, 0xa0, 0xd6, 0xd1, 0xf9, 0xf2, 0x99, 0x85, 0xb0, 0x38, 0x6b, 0xd5, 0xb8,
-	0x1d, 0x17, 0x20, 0xf6, 0x7d, 0xe8, 0x65, 0xed, 0xbd, 0x60, 0x34, 0x12, 0xd9, 0x0b, 0x89, 0xcb,
-	0x73, 0x3d, 0x3c, 0x24, 0x74, 0xa9, 0x9f, 0x6e, 0x5c, 0x46, 0xb0, 0xef, 0x41, 0x2f, 0x94, 0xc2,
-	0x34, 0x55, 0x14, 0x5e, 0x2e, 0xc1, 0x4b, 0x53, 0xde, 0xc3, 0x94, 0xb0, 0x8b, 0xec, 0xfa, 0x02,
-	0x1e, 0x1b, 0xff, 0x55, 0x81, 0x76, 0x69, 0xd4, 0xf4, 0x46, 0x3e, 0x16, 0x51, 0x16, 0x91, 0xc7,
-	0x32, 0xc2, 0xc6, 0x81, 0x7a, 0x5a, 0xaa, 0x73, 0x2a, 0x23, 0x2c, 0x0a, 0xd4, 0x15, 0x8d, 0xce,
-	0xa9, 0x8c, 0x36, 0x48, 0x1d, 0x41, 0xe5, 0xcb, 0x3c, 0x12, 0x4a, 0x9d, 0x77, 0x0a, 0xe0, 0x36,
-	0x05, 0x98, 0x50, 0x9d, 0x0e, 0xad, 0x38, 0xbb, 0x23, 0xc8, 0xeb, 0xb8, 0xd8, 0x9e, 0x88, 0x08,
-	0xc7, 0xa2, 0xcc, 0x57, 0x56, 0x45, 0x59, 0x93, 0xd9, 0x78, 0x16, 0xf8, 0xf2, 0x1a, 0xb6, 0xc3,
-	0x35, 0x04, 0xfc, 0x20, 0xf0, 0xa9, 0x99, 0x92, 0x2c, 0xf1, 0x53, 0xe7, 0x59, 0x15, 0x8d, 0xc3,
-	0xe3, 0x54, 0xa0, 0x87, 0xe5, 0xd0, 0x1b, 0x4c, 0x9d, 0xb7, 0xa8, 0xbe, 0xed, 0x18, 0xff, 0x56,
-	0x81, 0xe5, 0x39, 0x66, 0xa3, 0x43, 0x83, 0x8c, 0xce, 0x1e, 0x3d, 0x74, 0x78, 0x13, 0xab, 0xdb,
-	0x0e, 0x21, 0x92, 0x09, 0x29, 0x53, 0x55, 0x21, 0x92, 0x09, 0x6a, 0xd2, 0x79, 0x68, 0x26, 0xc7,
-	0x34, 0x5b, 0xb9, 0x30, 0x1a, 0xc9, 0x31, 0x4e, 0x73, 0x03, 0x74, 0x2f, 0x18, 0x99, 0x9e, 0x78,
-	0x22, 0x3c, 0xe2, 0x43, 0x6f, 0xfd, 0xed, 0x53, 0xa4, 0xbc, 0xf6, 0x30, 0x18, 0x3d, 0x44, 0x5a,
-	0xae, 0x79, 0xaa, 0x64, 0x7c, 0x0a, 0x5a, 0x06, 0x65, 0x3a, 0x34, 0xb6, 0xc4, 0x61, 0x3a, 0xea,
-	0x9f, 0x61, 0x1a, 0xd4, 0xb1, 0x45, 0xbf, 0x82, 0xa5, 0x2f, 0xac, 0xc8, 0xef, 0x57, 0x11, 0x7d,
-	0x3f, 0x8a, 0x82, 0xa8, 0x5f, 0xc3, 0xe2, 0x9e, 0xe5, 0xbb, 0x76, 0xbf, 0x8e, 0xc5, 0x07, 0x56,
-	0x62, 0x79, 0xfd, 0x86, 0xf1, 0x57, 0x0d, 0xd0, 0xf6, 0xd4, 0xd7, 0xd9, 0x16, 0x74, 0xf3, 0xdf,
-	0x14, 0x2c, 0x8e, 0xcd, 0xec, 0xcd, 0x16, 0x28, 0x36, 0xd3, 0x09, 0x4b, 0xb5, 0xd9, 0x9f, 0x1d,
-	0x54, 0xe7, 0x7e, 0x76, 0x70, 0x05, 0x6a, 0x8f, 0xa3, 0x93, 0xe9, 0x5b, 0xb4, 0x3d, 0xcf, 0xf2,
-	0x39, 0x82, 0xd9, 0x07, 0xd0, 0x46, 0xb9, 0x9b, 0x31, 0xed, 0xa8, 0x2a, 0xae, 0x51, 0xfe, 0xa5,
-	0x04, 0xc1, 0x39, 0x20, 0x91, 0xda, 0x75, 0xd7, 0x40, 0xb3, 0xc7, 0xae, 0xe7, 0x44, 0xc2, 0x57,
-	0xc1, 0x62, 0x36, 0x3f, 0x64, 0x9e, 0xd3, 0xb0, 0xef, 0xd2, 0x33, 0x80, 0x2c, 0x1e, 0x53, 0xce,
-	0x42, 0x3a, 0x3f, 0x75, 0xe4, 0xcd, 0x28, 0xf8, 0x52, 0x89, 0x9c, 0x36, 0x97, 0xe2, 0x0d, 0x59,
-	0xab, 0xfc, 0x86, 0x4c, 0x3e, 0x80, 0xa7, 0x4d, 0x41, 0xcb, 0x0f, 0x5e, 0x81, 0xe5, 0xb0, 0x9b,
-	0x50, 0xf7, 0x03, 0x47, 0xcc, 0x07, 0x33, 0xb2, 0x7d, 0x88, 0x13, 0x9e, 0xfe, 0x6c, 0x91, 0xc6,
-	0x63, 0x53, 0xee, 0xe7, 0x68, 0x4a, 0x40, 0xbd, 0x61, 0x4d, 0xe3, 0xf1, 0x16, 0xee, 0xe8, 0xa8,
-	0x8c, 0x37, 0xa0, 0x97, 0xcd, 0x45, 0x3d, 0x62, 0x90, 0xc9, 0x17, 0xdd, 0x0c, 0x2a, 0xdf, 0x30,
-	0xac, 0xc1, 0x59, 0x7b, 0x6c, 0xf9, 0xbe, 0xf0, 0xcc, 0xc3, 0x74, 0x38, 0xcc, 0x76, 0x80, 0x0e,
-	0x5d, 0x36, 0x2e, 0x2b, 0xd4, 0x3d, 0xc2, 0xd0, 0x86, 0x62, 0x40, 0xd7, 0x77, 0x3d, 0xf9, 0xf0,
-	0xcf, 0xb4, 0xfd, 0x84, 0xae, 0x91, 0x1b, 0xbc, 0xed, 0xbb, 0x1e, 0xc5, 0x71, 0x37, 0xfd, 0x84,
-	0x7d, 0x02, 0xfd, 0x34, 0x75, 0x9d, 0xd8, 0x4c, 0x82, 0xec, 0xdf, 0x01, 0x74, 0x65, 0x3c, 0xe5,
-	0x28, 0x3e, 0x4a, 0x5d, 0xe7, 0x20, 0x50, 0x7f, 0x0f, 0xe8, 0x12, 0x7d, 0x56, 0x35, 0x3e, 0x81,
-	0x4e, 0x59, 0x77, 0x50, 0x17, 0xe9, 0x04, 0xd5, 0x3f, 0xc3, 0x00, 0x9a, 0xbb, 0x41, 0x34, 0xb1,
-	0xbc, 0x7e, 0x05, 0xcb, 0xf2, 0x65, 0x65, 0xbf, 0xca, 0x3a, 0xa0, 0x65, 0xae, 0x7d, 0xbf, 0x66,
-	0x7c, 0x0c, 0x5a, 0xf6, 0x33, 0x04, 0x7a, 0x85, 0x1e, 0x38, 0x42, 0x3a, 0x36, 0xd2, 0x32, 0x69,
-	0x08, 0x20, 0xa7, 0x26, 0xfb, 0xab, 0x47, 0xb5, 0xf8, 0xab, 0x87, 0xf1, 0x1b, 0xd0, 0x29, 0x0f,
-	0x2e, 0x0b, 0xbd, 0x55, 0x8a, 0xd0, 0xdb, 0x82, 0x56, 0x74, 0x57, 0x16, 0x05, 0x13, 0xb3, 0xe4,
-	0x04, 0x68, 0x08, 0xc0, 0xcf, 0x18, 0xbf, 0x5f, 0x81, 0x06, 0x79, 0xab, 0xb4, 0xb5, 0x60, 0xa1,
-	0x58, 0x3b, 0x0d, 0xae, 0x13, 0x84, 0x66, 0x5a, 0xbe, 0x73, 0xae, 0x3e, 0xff, 0xce, 0xb9, 0x36,
-	0x7d, 0xe7, 0xfc, 0x92, 0x49, 0x49, 0xb7, 0x1e, 0x43, 0x53, 0xfe, 0x48, 0x85, 0x2d, 0x43, 0xf7,
-	0x91, 0x7f, 0xe4, 0x07, 0x4f, 0x7d, 0x09, 0xe8, 0x9f, 0x61, 0x67, 0x61, 0x29, 0x63, 0xba, 0xfa,
-	0x63, 0x4b, 0xbf, 0xc2, 0xfa, 0xd0, 0x21, 0xb1, 0x66, 0x90, 0x2a, 0xbb, 0x02, 0x03, 0xb5, 0x39,
-	0x6c, 0x05, 0xbe, 0xd8, 0x0d, 0x12, 0x77, 0x78, 0x92, 0x61, 0x6b, 0x6c, 0x09, 0xda, 0xfb, 0x49,
-	0x10, 0xee, 0x0b, 0xdf, 0x71, 0xfd, 0x51, 0xbf, 0x7e, 0xeb, 0x01, 0x34, 0xe5, 0xff, 0x5d, 0x4a,
-	0x9f, 0x94, 0x80, 0xfe, 0x19, 0xa4, 0xfe, 0xc2, 0x72, 0x13, 0xd7, 0x1f, 0xed, 0x8a, 0xe3, 0x44,
-	0x1a, 0xa5, 0x87, 0x56, 0x9c, 0xf4, 0xab, 0xac, 0x07, 0xa0, 0x7a, 0xbd, 0xef, 0x3b, 0xfd, 0xda,
-	0xbd, 0xcd, 0x9f, 0xfc, 0xec, 0x6a, 0xe5, 0x1f, 0x7e, 0x76, 0xb5, 0xf2, 0xcf, 0x3f, 0xbb, 0x7a,
-	0xe6, 0x4f, 0xfe, 0xe5, 0x6a, 0xe5, 0x07, 0x1f, 0x94, 0xfe, 0x5e, 0x33, 0xb1, 0x92, 0xc8, 0x3d,
-	0x96, 0xb7, 0x8d, 0x59, 0xc5, 0x17, 0x77, 0xc2, 0xa3, 0xd1, 0x9d, 0xf0, 0xf0, 0x4e, 0xa6, 0x73,
-	0x87, 0x4d, 0xfa, 0x29, 0xcd, 0x87, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xf3, 0xc9, 0xbb, 0x56,
-	0x13, 0x47, 0x00, 0x00,
+	// 5899 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x4b, 0x70, 0x1c, 0xc7,
+	0x75, 0xdc, 0xff, 0xcc, 0xdb, 0x5d, 0x60, 0xd1, 0xfc, 0xad, 0x28, 0x8a, 0x84, 0x46, 0xa2, 0x04,
+	0xd3, 0x22, 0x28, 0x42, 0x96, 0xad, 0xc4, 0xb1, 0x65, 0x10, 0x24, 0x2d, 0xc8, 0x24, 0x85, 0x34,
+	0x40, 0xab, 0xe2, 0x4a, 0x65, 0x6a, 0x30, 0xd3, 0xbb, 0x18, 0x63, 0x76, 0x66, 0x38, 0x1f, 0x02,
+	0xd0, 0x29, 0x55, 0xc9, 0x35, 0xa7, 0x9c, 0x52, 0xb9, 0xa4, 0x7c, 0x48, 0x2a, 0x87, 0x7c, 0x2a,
+	0x39, 0xa6, 0x7c, 0xb7, 0x6f, 0x3e, 0xe5, 0x98, 0x4a, 0x39, 0xb7, 0x38, 0xb9, 0x39, 0xa9, 0x5c,
+	0x52, 0x95, 0x7a, 0xaf, 0xbb, 0x67, 0x66, 0x3f, 0x04, 0x3f, 0x92, 0x52, 0x76, 0x95, 0x4f, 0xdb,
+	0xfd, 0x3e, 0x3d, 0xdd, 0xfd, 0x5e, 0xbf, 0x7e, 0xfd, 0xfa, 0xf5, 0xc2, 0x52, 0xec, 0xc7, 0x22,
+	0xf0, 0x43, 0xb1, 0x1e, 0x27, 0x51, 0x16, 0x31, 0x43, 0xd7, 0x2f, 0xdd, 0x18, 0xfb, 0xd9, 0x41,
+	0xbe, 0xbf, 0xee, 0x46, 0x93, 0x9b, 0xe3, 0x68, 0x1c, 0xdd, 0x24, 0x82, 0xfd, 0x7c, 0x44, 0x35,
+	0xaa, 0x50, 0x49, 0x32, 0x5e, 0x82, 0x38, 0x70, 0x42, 0x55, 0x5e, 0xce, 0xfc, 0x89, 0x48, 0x33,
+	0x67, 0x12, 0x6b, 0x64, 0x10, 0xb9, 0x87, 0xaa, 0x6c, 0x66, 0xc7, 0x8a, 0xce, 0xfa, 0xf3, 0x3a,
+	0x74, 0x1e, 0x88, 0x34, 0x75, 0xc6, 0x82, 0x59, 0xd0, 0x48, 0x7d, 0x6f, 0x58, 0x5b, 0xad, 0xad,
+	0x2d, 0x6d, 0x0c, 0xd6, 0x8b, 0x6e, 0xed, 0x66, 0x4e, 0x96, 0xa7, 0x1c, 0x91, 0x48, 0xe3, 0x4e,
+	0xbc, 0x61, 0x7d, 0x96, 0xe6, 0x81, 0xc8, 0x0e, 0x22, 0x8f, 0x23, 0x92, 0x0d, 0xa0, 0x21, 0x92,
+	0x64, 0xd8, 0x58, 0xad, 0xad, 0xf5, 0x38, 0x16, 0x19, 0x83, 0xa6, 0xe7, 0x64, 0xce, 0xb0, 0x49,
+	0x20, 0x2a, 0xb3, 0x37, 0x61, 0x29, 0x4e, 0x22, 0xd7, 0xf6, 0xc3, 0x51, 0x64, 0x13, 0xb6, 0x45,
+	0xd8, 0x1e, 0x42, 0xb7, 0xc3, 0x51, 0x74, 0x07, 0xa9, 0x86, 0xd0, 0x71, 0x42, 0x27, 0x38, 0x49,
+	0xc5, 0xb0, 0x4d, 0x68, 0x5d, 0x65, 0x4b, 0x50, 0xf7, 0xbd, 0x61, 0x67, 0xb5, 0xb6, 0xd6, 0xe4,
+	0x75, 0xdf, 0xc3, 0x6f, 0xe4, 0xb9, 0xef, 0x0d, 0x0d, 0xf9, 0x0d, 0x2c, 0x33, 0x0b, 0x7a, 0xa1,
+	0x10, 0xde, 0xc3, 0x28, 0xe3, 0x22, 0x0e, 0x4e, 0x86, 0xe6, 0x6a, 0x6d, 0xcd, 0xe0, 0x53, 0x30,
+	0x76, 0x09, 0x0c, 0x4f, 0xec, 0xe7, 0xe3, 0x07, 0xe9, 0x78, 0x08, 0xab, 0xb5, 0x35, 0x93, 0x17,
+	0x75, 0xeb, 0x11, 0x98, 0x5b, 0x51, 0x18, 0x0a, 0x37, 0x8b, 0x12, 0x76, 0x15, 0xba, 0x7a, 0xb8,
+	0xb6, 0x9a, 0xa6, 0x16, 0x07, 0x0d, 0xda, 0xf6, 0xd8, 0xdb, 0xb0, 0xec, 0x6a, 0x6a, 0xdb, 0x0f,
+	0x3d, 0x71, 0x4c, 0xf3, 0xd4, 0xe2, 0x4b, 0x05, 0x78, 0x1b, 0xa1, 0xd6, 0x7f, 0xd4, 0xa1, 0xb3,
+	0x7b, 0x90, 0x8f, 0x46, 0x81, 0x60, 0x6f, 0x42, 0x5f, 0x15, 0xb7, 0xa2, 0x60, 0xdb, 0x3b, 0x56,
+	0xed, 0x4e, 0x03, 0xd9, 0x2a, 0x74, 0x15, 0x60, 0xef, 0x24, 0x16, 0xaa, 0xd9, 0x2a, 0x68, 0xba,
+	0x9d, 0x07, 0x7e, 0x48, 0xd3, 0xdf, 0xe0, 0xd3, 0xc0, 0x19, 0x2a, 0xe7, 0x78, 0xd8, 0x9c, 0xa3,
+	0x72, 0xe8, 0x6b, 0x9b, 0x81, 0xff, 0x44, 0x70, 0x31, 0xde, 0x0a, 0x33, 0x92, 0x4b, 0x8b, 0x57,
+	0x41, 0x6c, 0x03, 0xce, 0xa7, 0x92, 0xc5, 0x4e, 0x9c, 0x70, 0x2c, 0x52, 0x3b, 0xf7, 0xc3, 0xec,
+	0xeb, 0x5f, 0x1b, 0xb6, 0x57, 0x1b, 0x6b, 0x4d, 0x7e, 0x56, 0x21, 0x39, 0xe1, 0x1e, 0x11, 0x8a,
+	0xbd, 0x0b, 0xe7, 0x66, 0x78, 0x24, 0x4b, 0x67, 0xb5, 0xb1, 0xd6, 0xe0, 0x6c, 0x8a, 0x65, 0x9b,
+	0x38, 0xee, 0xc2, 0x4a, 0x92, 0x87, 0xa8, 0xc9, 0xf7, 0xfc, 0x20, 0x13, 0xc9, 0x6e, 0x2c, 0x5c,
+	0x92, 0x6f, 0x77, 0xe3, 0xe2, 0x3a, 0x29, 0x3b, 0x9f, 0x45, 0xf3, 0x79, 0x0e, 0xeb, 0x7f, 0xea,
+	0x60, 0xdc, 0xf1, 0xd3, 0xd8, 0xc9, 0xdc, 0x03, 0x76, 0x11, 0x3a, 0xa3, 0x3c, 0x74, 0x4b, 0x09,
+	0xb6, 0xb1, 0xba, 0xed, 0xb1, 0xdf, 0x81, 0xe5, 0x20, 0x72, 0x9d, 0xc0, 0x2e, 0x84, 0x35, 0xac,
+	0xaf, 0x36, 0xd6, 0xba, 0x1b, 0x67, 0x4b, 0x2d, 0x2f, 0x94, 0x81, 0x2f, 0x11, 0x6d, 0x51, 0x67,
+	0xdf, 0x82, 0x41, 0x22, 0x26, 0x51, 0x26, 0x2a, 0xec, 0x0d, 0x62, 0x67, 0x25, 0xfb, 0xa7, 0x89,
+	0x13, 0x3f, 0x8c, 0x3c, 0xc1, 0x97, 0x25, 0x6d, 0xc9, 0x7e, 0xab, 0x32, 0x9f, 0x62, 0x6c, 0xfb,
+	0xde, 0xb1, 0x4d, 0x1f, 0x18, 0x36, 0x57, 0x1b, 0x6b, 0xad, 0x72, 0x72, 0xc4, 0x78, 0xdb, 0x3b,
+	0xbe, 0x8f, 0x18, 0xf6, 0x1e, 0x5c, 0x98, 0x65, 0x91, 0xad, 0x0e, 0x5b, 0xc4, 0x73, 0x76, 0x8a,
+	0x87, 0x13, 0x8a, 0xbd, 0x0e, 0x3d, 0xcd, 0x94, 0x9d, 0xc4, 0x72, 0x4d, 0xb5, 0x78, 0x37, 0xad,
+	0x28, 0xd2, 0x45, 0xe8, 0xf8, 0xa9, 0x9d, 0xfa, 0xe1, 0x21, 0x2d, 0x2e, 0x83, 0xb7, 0xfd, 0x74,
+	0xd7, 0x0f, 0x0f, 0xd9, 0x2b, 0x60, 0x24, 0xc2, 0x95, 0x18, 0x83, 0x30, 0x9d, 0x44, 0xb8, 0x84,
+	0xba, 0x08, 0x58, 0xb4, 0xdd, 0x4c, 0xa8, 0x25, 0xd6, 0x4e, 0x84, 0xbb, 0x95, 0x09, 0x2b, 0x85,
+	0xd6, 0x03, 0x91, 0x8c, 0x05, 0xae, 0x32, 0x64, 0xdc, 0x75, 0x9d, 0x90, 0xe6, 0xdd, 0xe0, 0x45,
+	0x1d, 0xd7, 0x78, 0xec, 0x24, 0x99, 0xef, 0x04, 0xa4, 0xd8, 0x06, 0xd7, 0x55, 0xf6, 0x2a, 0x98,
+	0x69, 0xe6, 0x24, 0x19, 0x8e, 0x8e, 0x14, 0xba, 0xc5, 0x0d, 0x02, 0xe0, 0x9a, 0xb8, 0x08, 0x1d,
+	0x11, 0x7a, 0x84, 0x6a, 0x4a, 0x49, 0x8a, 0xd0, 0xdb, 0xf6, 0x8e, 0xad, 0x7f, 0xac, 0x41, 0xff,
+	0x41, 0x1e, 0x64, 0xfe, 0x66, 0x32, 0xce, 0xc5, 0x24, 0xcc, 0xd0, 0x36, 0xdc, 0xf1, 0xd3, 0x4c,
+	0x7d, 0x99, 0xca, 0x6c, 0x0d, 0xcc, 0xef, 0x26, 0x51, 0x1e, 0xdf, 0x3d, 0x8e, 0xb5, 0xa4, 0x41,
+	0x2a, 0x15, 0x42, 0x78, 0x89, 0x64, 0xef, 0x40, 0xf7, 0x93, 0xc4, 0x13, 0xc9, 0xed, 0x13, 0xa2,
+	0x6d, 0xcc, 0xd1, 0x56, 0xd1, 0xec, 0x32, 0x98, 0xbb, 0x22, 0x76, 0x12, 0x07, 0x55, 0xa0, 0x49,
+	0x06, 0xa5, 0x04, 0xe0, 0x58, 0x89, 0x78, 0xdb, 0x53, 0xcb, 0x4a, 0x57, 0xad, 0x31, 0x98, 0x9b,
+	0xe3, 0x71, 0x22, 0xc6, 0x4e, 0x46, 0xc6, 0x2d, 0x8a, 0xa9, 0xbb, 0x0d, 0x5e, 0x8f, 0x62, 0x32,
+	0xa0, 0x38, 0x00, 0x39, 0x3f, 0x54, 0x66, 0x57, 0xa0, 0x29, 0x16, 0xf7, 0x87, 0xe0, 0xec, 0x02,
+	0xb4, 0xdd, 0x28, 0x1c, 0xf9, 0x63, 0x65, 0x76, 0x55, 0xcd, 0xfa, 0x93, 0x06, 0xb4, 0x68, 0x70,
+	0x38, 0xbd, 0x68, 0x0a, 0x6d, 0xf1, 0xc4, 0x09, 0xb4, 0x54, 0x10, 0x70, 0xf7, 0x89, 0x13, 0xb0,
+	0x55, 0x68, 0x61, 0x33, 0xe9, 0x82, 0xb9, 0x91, 0x08, 0xf6, 0x16, 0xb4, 0x50, 0x89, 0xd2, 0xe9,
+	0x1e, 0xa0, 0x12, 0xdd, 0x6e, 0xfe, 0xe4, 0x5f, 0xae, 0x9e, 0xe1, 0x12, 0xcd, 0xde, 0x86, 0xa6,
+	0x33, 0x1e, 0xa7, 0xc3, 0xe6, 0xec, 0x72, 0x2a, 0xc6, 0xcb, 0x89, 0x80, 0xbd, 0x0f, 0xa6, 0x94,
+	0x1b, 0x52, 0xb7, 0x88, 0xfa, 0x62, 0x65, 0x8b, 0xa9, 0x8a, 0x94, 0x97, 0x94, 0x38, 0xe3, 0x7e,
+	0xaa, 0x2c, 0x18, 0x69, 0xb4, 0xc1, 0x4b, 0x00, 0xee, 0x01, 0x71, 0x22, 0x36, 0x83, 0x20, 0x72,
+	0x77, 0xfd, 0xcf, 0x84, 0xda, 0x31, 0xa6, 0x60, 0xec, 0x2d, 0x58, 0xda, 0x91, 0x2a, 0xc7, 0x45,
+	0x9a, 0x07, 0x59, 0xaa, 0x76, 0x91, 0x19, 0x28, 0x5b, 0x07, 0x36, 0x05, 0xd9, 0xa3, 0xe1, 0x9b,
+	0xab, 0x8d, 0xb5, 0x3e, 0x5f, 0x80, 0x61, 0x6f, 0x40, 0x7f, 0x8c, 0x33, 0xed, 0x87, 0x63, 0x7b,
+	0x14, 0x38, 0xb8, 0xc1, 0x34, 0x70, 0x03, 0xd2, 0xc0, 0x7b, 0x81, 0x33, 0xb6, 0x7e, 0x59, 0x87,
+	0xf6, 0x76, 0x98, 0x8a, 0x24, 0xc3, 0x55, 0xe2, 0x8c, 0x46, 0xc2, 0xcd, 0x84, 0xb4, 0x4e, 0x4d,
+	0x5e, 0xd4, 0x71, 0x94, 0x7b, 0xd1, 0xa7, 0x89, 0x9f, 0x89, 0xdd, 0xf7, 0x94, 0x1e, 0x94, 0x00,
+	0x76, 0x1d, 0x56, 0x1c, 0xcf, 0xb3, 0x35, 0xb5, 0x9d, 0x44, 0x47, 0x29, 0xad, 0x18, 0x83, 0x2f,
+	0x3b, 0x9e, 0xb7, 0xa9, 0xe0, 0x3c, 0x3a, 0x4a, 0xd9, 0xeb, 0xd0, 0x48, 0xc4, 0x88, 0xb4, 0xa2,
+	0xbb, 0xb1, 0x2c, 0xa5, 0xf6, 0xc9, 0xfe, 0x0f, 0x85, 0x9b, 0x71, 0x31, 0xe2, 0x88, 0x63, 0xe7,
+	0xa0, 0xe5, 0x64, 0x59, 0x22, 0xa5, 0x60, 0x72, 0x59, 0x61, 0xeb, 0x70, 0x96, 0x56, 0x66, 0xe6,
+	0x47, 0xa1, 0x9d, 0x39, 0xfb, 0x01, 0x6e, 0x84, 0xa9, 0xb2, 0xf9, 0x2b, 0x05, 0x6a, 0x0f, 0x31,
+	0xdb, 0x5e, 0x8a, 0xbb, 0xc4, 0x2c, 0x7d, 0xe8, 0x4c, 0x44, 0x4a, 0x26, 0xdf, 0xe4, 0x67, 0xa7,
+	0x39, 0x1e, 0x3a, 0x13, 0x39, 0x65, 0x25, 0x0f, 0xae, 0x6d, 0x83, 0x96, 0x49, 0xaf, 0x00, 0xe2,
+	0xd2, 0x3f, 0x0f, 0x6d, 0x3f, 0xb5, 0x45, 0xe8, 0x29, 0x73, 0xd3, 0xf2, 0xd3, 0xbb, 0xa1, 0xc7,
+	0xbe, 0x0a, 0xa6, 0xfc, 0x8a, 0x27, 0x46, 0xb4, 0x97, 0x77, 0x37, 0x96, 0x94, 0x52, 0x22, 0xf8,
+	0x8e, 0x18, 0x71, 0x23, 0x53, 0x25, 0xeb, 0xc7, 0x75, 0xe8, 0x92, 0x0e, 0x3d, 0x8a, 0x3d, 0x5c,
+	0x72, 0x6f, 0x40, 0x7f, 0x7a, 0xf6, 0xa4, 0x00, 0x7a, 0x4e, 0x75, 0xea, 0x2e, 0x40, 0x7b, 0xd3,
+	0xc5, 0x5e, 0x90, 0x04, 0xfa, 0x5c, 0xd5, 0x70, 0x59, 0x6f, 0xdf, 0xce, 0xdd, 0x43, 0x91, 0xd1,
+	0xa4, 0xf7, 0xb9, 0xae, 0x22, 0xe6, 0xa1, 0xc2, 0x34, 0x25, 0x46, 0x55, 0xd9, 0x5d, 0x80, 0x5d,
+	0x31, 0x9e, 0x88, 0x30, 0x7b, 0xe0, 0xc4, 0x4a, 0xdd, 0xaf, 0xcd, 0xa8, 0xbb, 0xec, 0xdb, 0x7a,
+	0x49, 0x77, 0x37, 0xcc, 0x92, 0x13, 0x5e, 0x61, 0x64, 0xdf, 0x80, 0xe5, 0x9c, 0xa8, 0x6c, 0x37,
+	0x3b, 0xb6, 0x03, 0xb4, 0x12, 0xed, 0xd5, 0x46, 0x29, 0x59, 0xd9, 0xc4, 0x56, 0x76, 0xcc, 0xfb,
+	0xb9, 0x2e, 0xde, 0xf7, 0xd3, 0xec, 0xd2, 0xb7, 0x60, 0x79, 0xa6, 0x5d, 0xf4, 0xdc, 0x0e, 0xc5,
+	0x09, 0x8d, 0xdc, 0xe4, 0x58, 0x44, 0x45, 0x78, 0xe2, 0x04, 0xb9, 0x76, 0x39, 0x64, 0xe5, 0xb7,
+	0xeb, 0x1f, 0xd4, 0xac, 0xd7, 0xa0, 0xb5, 0x99, 0x24, 0x0e, 0x91, 0x38, 0x58, 0x18, 0xd6, 0x68,
+	0xdf, 0x91, 0x15, 0xcb, 0x85, 0x06, 0xf6, 0xee, 0x1a, 0xd4, 0x27, 0x31, 0x61, 0xba, 0x1b, 0xe7,
+	0x2b, 0x83, 0x73, 0xe2, 0xf5, 0x07, 0x6a, 0x30, 0xf5, 0x49, 0x7c, 0xe9, 0x7d, 0xe8, 0x3c, 0x78,
+	0x89, 0x3e, 0xfc, 0x57, 0x13, 0x8c, 0x3b, 0x22, 0x10, 0x24, 0x03, 0x0b, 0x7a, 0x55, 0x35, 0xd7,
+	0xf2, 0xab, 0xc2, 0x90, 0x46, 0xee, 0x84, 0xc4, 0x25, 0xd4, 0x3a, 0x9a, 0x82, 0xbd, 0x94, 0x2c,
+	0x2f, 0x03, 0x24, 0xd1, 0x91, 0xed, 0xcb, 0xed, 0x48, 0x5a, 0x76, 0x23, 0x89, 0x8e, 0xb6, 0x71,
+	0x43, 0xfa, 0x7f, 0x59, 0x37, 0xdf, 0x80, 0x61, 0xc9, 0x43, 0xce, 0xa7, 0xed, 0x87, 0xf6, 0x3e,
+	0xfa, 0x3c, 0x6a, 0x09, 0x95, 0x6d, 0x92, 0x17, 0xba, 0x1d, 0xde, 0x46, 0xa4, 0xb6, 0x06, 0xe6,
+	0x29, 0xd6, 0x60, 0xa1, 0x71, 0x81, 0xc5, 0xc6, 0xe5, 0xf6, 0x94, 0x56, 0x77, 0x49, 0xf0, 0x56,
+	0x29, 0x78, 0x2d, 0xad, 0x53, 0x55, 0xfa, 0x75, 0xe8, 0xb9, 0x4e, 0x68, 0x67, 0x49, 0x1e, 0xba,
+	0x4e, 0x26, 0x86, 0x3d, 0xfa, 0x54, 0xd7, 0x75, 0xc2, 0x3d, 0x05, 0xaa, 0x58, 0x80, 0x7e, 0xd5,
+	0x02, 0xbc, 0x05, 0xcb, 0x71, 0xe2, 0x4f, 0x9c, 0xe4, 0xc4, 0x3e, 0x14, 0x27, 0x24, 0x8c, 0x25,
+	0xe9, 0x4f, 0x2b, 0xf0, 0xf7, 0xc4, 0xc9, 0xb6, 0x77, 0xfc, 0x79, 0x75, 0xff, 0x9f, 0xeb, 0x60,
+	0xee, 0x24, 0x42, 0x59, 0xed, 0xab, 0xd0, 0x4d, 0xdd, 0x03, 0x31, 0x71, 0x48, 0x4a, 0xaa, 0x05,
+	0x90, 0x20, 0x14, 0xce, 0xb4, 0x5d, 0xaa, 0x9f, 0x6e, 0x97, 0xb0, 0x1f, 0xd2, 0xdb, 0xc1, 0xc5,
+	0x84, 0xc5, 0xd2, 0x18, 0x37, 0xab, 0xc6, 0x78, 0x15, 0x7a, 0x07, 0x4e, 0x6a, 0x3b, 0x79, 0x16,
+	0xd9, 0x6e, 0x14, 0x90, 0xd2, 0x19, 0x1c, 0x0e, 0x9c, 0x74, 0x33, 0xcf, 0xa2, 0xad, 0x88, 0xbc,
+	0x27, 0x3f, 0xb5, 0xe5, 0xa2, 0x57, 0xfb, 0xa2, 0xe1, 0xa7, 0xca, 0xdc, 0xad, 0xc3, 0x59, 0x91,
+	0x66, 0xfe, 0xc4, 0x51, 0x02, 0xb5, 0xdd, 0x28, 0x0f, 0x33, 0xda, 0x1d, 0x1b, 0x7c, 0xa5, 0x40,
+	0xf1, 0xe8, 0x68, 0x0b, 0x11, 0xec, 0x5d, 0x58, 0x72, 0xa3, 0x49, 0x6c, 0xc7, 0x38, 0xaf, 0xe4,
+	0x77, 0x48, 0x47, 0xbc, 0xea, 0x17, 0xf4, 0x90, 0x62, 0xe7, 0x50, 0x48, 0x47, 0x68, 0x03, 0x96,
+	0xdd, 0x20, 0x4f, 0x33, 0x91, 0xd8, 0xfb, 0x8a, 0xc5, 0x9c, 0x63, 0xe9, 0x2b, 0x12, 0xe9, 0x3c,
+	0x59, 0xbf, 0x68, 0x40, 0x67, 0x27, 0x4a, 0xb3, 0x3b, 0x93, 0x40, 0x2b, 0x66, 0xed, 0x45, 0x15,
+	0xb3, 0xbe, 0x58, 0x31, 0x17, 0xa8, 0x46, 0x63, 0x81, 0x6a, 0xb0, 0x35, 0x18, 0x54, 0xe9, 0x48,
+	0xa4, 0xd2, 0x8d, 0x5b, 0x2a, 0x09, 0x49, 0xac, 0x72, 0x7e, 0x3d, 0x69, 0x49, 0x5a, 0x7a, 0x7e,
+	0x95, 0x15, 0x91, 0x48, 0x9f, 0x34, 0xa4, 0x9c, 0x7c, 0xa5, 0x31, 0xbf, 0x05, 0xaf, 0x14, 0x9c,
+	0xf6, 0x91, 0x9f, 0x1d, 0x44, 0x79, 0x66, 0x8f, 0xe8, 0xc4, 0x92, 0x2a, 0xaf, 0xfb, 0x82, 0x6e,
+	0xe9, 0x53, 0x89, 0x96, 0xe7, 0x19, 0xf2, 0x91, 0x46, 0x79, 0x10, 0xd8, 0x99, 0x38, 0xce, 0x94,
+	0x08, 0x86, 0x72, 0x6e, 0xd4, 0xbc, 0xdd, 0xcb, 0x83, 0x60, 0x4f, 0x1c, 0x67, 0x68, 0xf1, 0x8d,
+	0x91, 0xaa, 0xb0, 0x35, 0x68, 0x1e, 0x84, 0xe9, 0x91, 0x92, 0xc0, 0xb9, 0x29, 0x8e, 0x8f, 0xc2,
+	0xf4, 0x08, 0xa9, 0x89, 0x82, 0xdd, 0x82, 0x4e, 0x96, 0xf8, 0xe3, 0xb1, 0x48, 0x86, 0x50, 0x3d,
+	0x6a, 0x29, 0xe2, 0x3d, 0x89, 0x43, 0x7a, 0x4d, 0xc7, 0xbe, 0x0e, 0xad, 0xc9, 0x13, 0x5f, 0x1c,
+	0x0d, 0xbb, 0xc4, 0xb0, 0x3a, 0xc5, 0xf0, 0xc0, 0xc9, 0x44, 0xe2, 0x3b, 0x81, 0xff, 0x99, 0xf0,
+	0xbe, 0xef, 0x0b, 0xfa, 0x92, 0x24, 0xb7, 0x7e, 0xd6, 0x04, 0xb8, 0x1f, 0xb9, 0x87, 0x7b, 0x4e,
+	0x32, 0x16, 0x19, 0x1e, 0x30, 0xb4, 0x71, 0x54, 0xc6, 0xbb, 0x93, 0x49, 0x93, 0xc8, 0x36, 0xe0,
+	0x82, 0x16, 0x8a, 0x1b, 0x05, 0x74, 0xd8, 0x91, 0xd6, 0x4d, 0xad, 0x4d, 0xa6, 0xb0, 0xf2, 0xb8,
+	0x4c, 0xa6, 0x8d, 0x7d, 0x00, 0xcb, 0x55, 0x9e, 0xec, 0x24, 0x1e, 0x36, 0xaa, 0xfa, 0x57, 0x71,
+	0x54, 0xfb, 0x25, 0xfb, 0xde, 0x49, 0xcc, 0xde, 0x85, 0xf3, 0x89, 0x18, 0x25, 0x22, 0x3d, 0xb0,
+	0xb3, 0xb4, 0xfa, 0x31, 0x79, 0xce, 0x58, 0x51, 0xc8, 0xbd, 0xb4, 0xf8, 0xd6, 0xbb, 0x70, 0x5e,
+	0x8a, 0x6f, 0xb6, 0x7b, 0x72, 0x2b, 0x58, 0x91, 0xc8, 0x6a, 0xef, 0x5e, 0x03, 0x8a, 0xc8, 0x48,
+	0xf3, 0xae, 0xbd, 0xd6, 0x80, 0x26, 0x63, 0x3f, 0x10, 0xe8, 0xed, 0x6d, 0x1d, 0xe0, 0x51, 0xf8,
+	0x8e, 0x18, 0x29, 0x8d, 0x28, 0x01, 0xcc, 0x82, 0xe6, 0x83, 0xc8, 0x13, 0x24, 0xff, 0xa5, 0x8d,
+	0xa5, 0x75, 0xe4, 0x5b, 0xc7, 0x99, 0x44, 0x28, 0x27, 0x1c, 0x7b, 0x1b, 0xa8, 0x39, 0xb9, 0x26,
+	0xe6, 0x17, 0x9e, 0x81, 0x48, 0x5a, 0x18, 0xef, 0xc2, 0xf9, 0xb2, 0x27, 0xb6, 0x93, 0xd9, 0xd9,
+	0x81, 0x20, 0xcb, 0x2a, 0x2d, 0xfc, 0x4a, 0xd1, 0xa9, 0xcd, 0x6c, 0xef, 0x40, 0xa0, 0x95, 0xbd,
+	0x05, 0xdd, 0x23, 0xc7, 0xcf, 0xec, 0x38, 0x0a, 0x7c, 0xf7, 0x64, 0xd8, 0x55, 0xc1, 0x20, 0xea,
+	0xc5, 0xa7, 0x8e, 0x9f, 0xed, 0x10, 0x9c, 0xc3, 0x51, 0x51, 0x46, 0xd9, 0x12, 0x4b, 0x2a, 0x5c,
+	0x32, 0xe7, 0x4d, 0xde, 0xc1, 0xfa, 0xae, 0x70, 0xc9, 0x7c, 0x1e, 0xfa, 0x31, 0x1e, 0x78, 0x0f,
+	0x85, 0xb6, 0xe7, 0x80, 0xa0, 0xfb, 0x04, 0xc1, 0x55, 0x5e, 0x21, 0xb0, 0x03, 0x7f, 0xe2, 0x67,
+	0x64, 0xd6, 0x9b, 0x7c, 0xb9, 0x24, 0xbb, 0x8f, 0x60, 0xeb, 0x03, 0x68, 0x63, 0xf5, 0x93, 0x98,
+	0xad, 0x43, 0x27, 0x23, 0xbd, 0x4a, 0x95, 0xfb, 0x71, 0xae, 0xdc, 0x85, 0x4a, 0xa5, 0xe3, 0x9a,
+	0xc8, 0xe2, 0xb0, 0x5c, 0x98, 0xf4, 0x47, 0xa1, 0xff, 0x38, 0x17, 0xec, 0x43, 0x58, 0x89, 0x13,
+	0xa1, 0x16, 0xb1, 0x9d, 0x1f, 0xa2, 0x87, 0x35, 0xac, 0x4d, 0xad, 0xa0, 0x82, 0xe3, 0x10, 0xf5,
+	0x7a, 0x29, 0x9e, 0xaa, 0x5b, 0x3f, 0x80, 0x8b, 0x05, 0xc5, 0xae, 0x70, 0xa3, 0xd0, 0x73, 0x92,
+	0x13, 0xda, 0x7d, 0x67, 0xda, 0x4e, 0x5f, 0xa4, 0xed, 0x5d, 0x6a, 0xfb, 0x47, 0x0d, 0x58, 0xfa,
+	0x24, 0xbc, 0x93, 0xc7, 0x81, 0x8f, 0x3b, 0xe2, 0xf7, 0xe4, 0x86, 0x25, 0x37, 0x8a, 0x5a, 0x75,
+	0xa3, 0x58, 0x83, 0x81, 0xfa, 0x0a, 0xea, 0xa6, 0x34, 0xf3, 0x2a, 0x2e, 0x25, 0xe1, 0x5b, 0x51,
+	0x20, 0x6d, 0xfc, 0xb7, 0xe0, 0x7c, 0x4e, 0x23, 0x97, 0x94, 0x07, 0xc2, 0x3d, 0xb4, 0x9f, 0x72,
+	0xc4, 0x64, 0x92, 0x10, 0x59, 0x91, 0x0c, 0x61, 0x28, 0xc8, 0x92, 0x5d, 0xef, 0x56, 0x50, 0x10,
+	0x52, 0x4f, 0xa2, 0xd0, 0xf6, 0x74, 0x97, 0x95, 0xaf, 0x84, 0xfb, 0xdc, 0x52, 0x54, 0x8e, 0x04,
+	0x8d, 0xf0, 0xef, 0xc1, 0xca, 0x14, 0x25, 0xf5, 0x42, 0xba, 0xb5, 0x37, 0x4a, 0x31, 0x4e, 0x0f,
+	0xbf, 0x5a, 0xc5, 0xfe, 0x48, 0xbf, 0x62, 0x39, 0x9a, 0x86, 0x6a, 0xc3, 0x3c, 0x0e, 0xa3, 0x44,
+	0x0c, 0x3b, 0x85, 0x61, 0xa6, 0xfa, 0xa5, 0x87, 0x70, 0x6e, 0x51, 0x2b, 0x0b, 0x9c, 0x83, 0xd5,
+	0xaa, 0x73, 0x30, 0x73, 0x3c, 0x2e, 0x1d, 0x85, 0xbf, 0xaa, 0x41, 0xf7, 0x5e, 0xfe, 0xd9, 0x67,
+	0x27, 0xd2, 0x7c, 0xb3, 0x1e, 0xd4, 0x1e, 0x52, 0x2b, 0x75, 0x5e, 0x7b, 0x88, 0xa7, 0x89, 0x9d,
+	0x43, 0xdc, 0x4a, 0xa8, 0x11, 0x93, 0xab, 0x1a, 0x1e, 0xac, 0x77, 0x0e, 0xf7, 0x4e, 0xb1, 0x57,
+	0x12, 0x8d, 0xc7, 0xc5, 0xdb, 0xb9, 0x1f, 0xa0, 0x8f, 0xa9, 0x4c, 0x53, 0x51, 0xc7, 0xa3, 0xea,
+	0xf6, 0x48, 0xea, 0xcb, 0xbd, 0x24, 0x9a, 0x48, 0x8d, 0x56, 0xbb, 0xd4, 0x02, 0x8c, 0xf5, 0xd3,
+	0x06, 0x34, 0x3f, 0x8e, 0xfc, 0x50, 0x86, 0x79, 0x02, 0x79, 0x90, 0x90, 0x1e, 0x7d, 0x27, 0x11,
+	0x01, 0x9e, 0x18, 0x10, 0xe5, 0x46, 0x0a, 0x55, 0x97, 0x28, 0x37, 0x0a, 0xee, 0x4f, 0x07, 0x23,
+	0x6a, 0x0b, 0x83, 0x11, 0x45, 0xac, 0xa0, 0xf9, 0xac, 0x58, 0x81, 0x19, 0x88, 0x11, 0xaa, 0x6a,
+	0xe8, 0x0d, 0x5b, 0x55, 0x5a, 0x65, 0xb5, 0xc4, 0x28, 0xdb, 0x8a, 0x42, 0x8f, 0x7d, 0x05, 0x20,
+	0xf1, 0xc7, 0x07, 0x8a, 0xb2, 0x3d, 0x47, 0x69, 0x12, 0x96, 0x48, 0x39, 0xbc, 0xa2, 0x82, 0x82,
+	0x6a, 0x8f, 0xb5, 0xf7, 0x71, 0x96, 0xe4, 0x38, 0x3a, 0x3a, 0xcc, 0xb0, 0x38, 0x9c, 0x78, 0x61,
+	0x2a, 0x9c, 0x48, 0xb3, 0x4b, 0xe3, 0xbd, 0x0c, 0xe8, 0x69, 0x1d, 0xd8, 0x51, 0x68, 0xc7, 0x3a,
+	0x1c, 0x66, 0x20, 0xe4, 0x93, 0x70, 0xe7, 0x10, 0x8d, 0x3b, 0xc6, 0xd0, 0x54, 0x48, 0xc2, 0x9c,
+	0x0d, 0x49, 0xac, 0x42, 0xef, 0x87, 0x91, 0x1f, 0xda, 0x13, 0x27, 0xb6, 0x33, 0x47, 0x86, 0x9d,
+	0x5b, 0x1c, 0x10, 0xf6, 0xc0, 0x89, 0xf7, 0x9c, 0x31, 0xd9, 0x44, 0x49, 0x4c, 0x8b, 0xa4, 0x2b,
+	0x09, 0x14, 0x08, 0xc5, 0xfb, 0x2a, 0x98, 0xd4, 0x04, 0x45, 0xf1, 0x7a, 0x52, 0xf6, 0x08, 0xc0,
+	0x19, 0xb5, 0xfe, 0xbd, 0x0e, 0xc6, 0x66, 0x98, 0xf9, 0x24, 0xcf, 0x0b, 0xd0, 0x4e, 0x28, 0x24,
+	0xa1, 0xa4, 0xa9, 0x6a, 0x85, 0xc4, 0xea, 0x4f, 0x91, 0xd8, 0x94, 0x24, 0x1a, 0xcf, 0x2d, 0x89,
+	0xe6, 0x69, 0x92, 0x98, 0x9e, 0xb5, 0xd6, 0xa9, 0xb3, 0x36, 0x17, 0xc8, 0xf9, 0x32, 0xc4, 0x38,
+	0x2b, 0x09, 0xe3, 0x59, 0x92, 0x30, 0x67, 0x25, 0x61, 0xfd, 0x7d, 0x03, 0x8c, 0xfb, 0x62, 0x94,
+	0xfd, 0x66, 0xf1, 0xfc, 0xba, 0x2c, 0x1e, 0xeb, 0x3f, 0x1b, 0x60, 0x72, 0x1c, 0xe1, 0x97, 0x28,
+	0xb3, 0x9b, 0x00, 0x24, 0x8b, 0xd3, 0x05, 0x47, 0xf2, 0x92, 0xb1, 0xc2, 0x5b, 0xd0, 0x95, 0x32,
+	0x91, 0x1c, 0xad, 0xa7, 0x70, 0x48, 0xc1, 0xed, 0xcd, 0xcb, 0xbb, 0xfd, 0xdc, 0xf2, 0xee, 0xbc,
+	0xb4, 0xbc, 0x8d, 0x2f, 0x42, 0xde, 0xe6, 0xa9, 0xf2, 0x86, 0x67, 0xc9, 0xbb, 0xfb, 0x2c, 0x79,
+	0xf7, 0xe6, 0xe4, 0xfd, 0xa3, 0x06, 0xf4, 0x49, 0xde, 0xbb, 0x62, 0xf2, 0xf9, 0x8c, 0xe2, 0x8c,
+	0x90, 0x1a, 0x2f, 0x2a, 0xa4, 0xe6, 0x73, 0x0b, 0xa9, 0xf5, 0xd2, 0x42, 0x6a, 0x7f, 0x11, 0x42,
+	0xea, 0x9c, 0x2a, 0x24, 0xe3, 0x59, 0x42, 0x32, 0x5f, 0x7c, 0x51, 0x16, 0x42, 0xfa, 0xdc, 0x3b,
+	0xd7, 0x6f, 0x84, 0xf4, 0x05, 0x09, 0x09, 0xe6, 0x84, 0x84, 0x9e, 0xc5, 0xe7, 0x5e, 0x44, 0x5f,
+	0x86, 0x67, 0x71, 0xea, 0x64, 0xb7, 0xbe, 0x88, 0xc9, 0x6e, 0x9f, 0x3a, 0xd9, 0x9d, 0x67, 0x4d,
+	0xf6, 0x4b, 0x78, 0x16, 0xff, 0xd0, 0x00, 0xd8, 0xf5, 0xc3, 0x71, 0x20, 0x7e, 0xe3, 0x5b, 0xfc,
+	0xda, 0xf8, 0x16, 0xff, 0x54, 0x07, 0xe3, 0x81, 0x93, 0x1c, 0xfe, 0xca, 0xad, 0x90, 0x37, 0xa0,
+	0x13, 0x85, 0xd5, 0xf5, 0x50, 0xa5, 0x6b, 0x47, 0xe1, 0xaf, 0x84, 0xca, 0xff, 0xb4, 0x05, 0xe6,
+	0x1d, 0xe1, 0xe5, 0xf1, 0xe7, 0xd0, 0xf8, 0x5f, 0x17, 0xf3, 0xf2, 0x8c, 0xe3, 0xce, 0xec, 0x6c,
+	0x76, 0x9e, 0x35, 0x9b, 0xc6, 0xdc, 0x21, 0xf1, 0x3e, 0x9c, 0x9d, 0x8a, 0xa2, 0x38, 0xf2, 0xea,
+	0xd2, 0xa4, 0x78, 0xdd, 0x65, 0xd9, 0x5f, 0xcc, 0x47, 0xa9, 0x46, 0x4e, 0xe4, 0x85, 0x26, 0x5f,
+	0x89, 0x66, 0x41, 0x98, 0xb0, 0xe5, 0xa1, 0x68, 0x28, 0x38, 0x44, 0x61, 0x71, 0x99, 0x2e, 0xd5,
+	0x23, 0xe8, 0x56, 0x14, 0x50, 0xec, 0xe2, 0x03, 0x58, 0x2e, 0xa9, 0xa4, 0x65, 0xe9, 0x3e, 0xc5,
+	0xb2, 0xf4, 0x35, 0xa3, 0xdc, 0x83, 0xa7, 0x3d, 0xe6, 0xde, 0x0b, 0x7b, 0xcc, 0xfd, 0xe7, 0xd8,
+	0xe7, 0x6f, 0xc0, 0x59, 0x7d, 0x59, 0xaa, 0xe2, 0xb4, 0x24, 0xc1, 0x25, 0xd2, 0xa0, 0x81, 0x44,
+	0xc9, 0x28, 0x2d, 0x89, 0xe8, 0x9b, 0x70, 0xae, 0x42, 0x8e, 0x4b, 0x53, 0xd2, 0x2f, 0xcf, 0xe9,
+	0xca, 0x4a, 0xc1, 0x8b, 0x55, 0x64, 0xb6, 0xfe, 0xb0, 0x06, 0x9d, 0x9d, 0x24, 0xf2, 0x72, 0x37,
+	0x7b, 0x49, 0x4d, 0x9e, 0xd6, 0x90, 0xc6, 0xb3, 0x34, 0xa4, 0x39, 0xab, 0x21, 0xd6, 0x1f, 0xd5,
+	0xc0, 0x54, 0x5d, 0xb8, 0xbf, 0xf1, 0x25, 0x6d, 0x20, 0xcf, 0xee, 0xc5, 0x11, 0x98, 0x14, 0xf3,
+	0x3c, 0xd5, 0x24, 0x9e, 0xba, 0xc2, 0xea, 0x2f, 0xb5, 0xc2, 0xac, 0x3f, 0xad, 0x41, 0x9f, 0x22,
+	0xd7, 0xf7, 0xf2, 0x50, 0xea, 0xf0, 0xe2, 0x08, 0xe9, 0x2a, 0x34, 0x13, 0x91, 0xe9, 0x4c, 0x97,
+	0x9e, 0xfc, 0xcc, 0x56, 0x14, 0xe0, 0xc5, 0x1c, 0x61, 0x70, 0x12, 0x9c, 0x64, 0x9c, 0x2e, 0xca,
+	0xb5, 0x41, 0x38, 0x8e, 0x0a, 0x33, 0x7c, 0x26, 0xa9, 0xce, 0xb5, 0x91, 0x35, 0xcc, 0xdb, 0xa1,
+	0x95, 0xd2, 0xa2, 0x95, 0x42, 0x65, 0x6b, 0x13, 0xce, 0xdf, 0x3d, 0xce, 0x44, 0x12, 0x3a, 0xb4,
+	0x62, 0x36, 0x50, 0xdf, 0x28, 0x24, 0xac, 0x89, 0x6b, 0x25, 0x31, 0x76, 0xb8, 0x9a, 0x49, 0x28,
+	0x2b, 0xd6, 0x35, 0xe8, 0x8e, 0xfc, 0x40, 0xd8, 0xd1, 0x68, 0x94, 0x8a, 0x0c, 0xbf, 0x2e, 0x4b,
+	0x34, 0xac, 0x06, 0x57, 0x35, 0xeb, 0xc7, 0x4d, 0xe8, 0xe9, 0x4f, 0x51, 0xa6, 0xd5, 0xe2, 0xe1,
+	0xbf, 0x0a, 0x26, 0xb5, 0x96, 0x62, 0x7a, 0x4c, 0x9d, 0x5a, 0x30, 0x10, 0x40, 0xa9, 0x31, 0x9b,
+	0xb0, 0x52, 0xf9, 0x94, 0x9d, 0x45, 0x99, 0x13, 0x0c, 0x1b, 0xb3, 0xf7, 0xf9, 0x15, 0x12, 0xbe,
+	0x8c, 0x95, 0x4f, 0xa8, 0xbc, 0x87, 0xd4, 0x38, 0xbd, 0x45, 0x40, 0x78, 0x6e, 0x7a, 0x11, 0xc3,
+	0xbe, 0x0b, 0xcb, 0x38, 0xda, 0x0d, 0xb9, 0x2a, 0x69, 0xbc, 0xd2, 0xa8, 0x5e, 0x2d, 0x3f, 0xb1,
+	0x70, 0xce, 0x78, 0x3f, 0xac, 0x56, 0x71, 0xc5, 0xb8, 0x89, 0xc0, 0x05, 0x9b, 0x3e, 0x0e, 0xc8,
+	0xa6, 0x9a, 0xdc, 0x94, 0x90, 0xdd, 0xc7, 0x41, 0x31, 0xd2, 0xc2, 0xc1, 0x30, 0xe5, 0x48, 0x49,
+	0xd1, 0x6f, 0x40, 0x37, 0x4a, 0xfc, 0xb1, 0x1f, 0xca, 0xf0, 0xb5, 0xb1, 0xa0, 0xb7, 0x20, 0x09,
+	0x28, 0x98, 0x6d, 0x41, 0x5b, 0x2a, 0xea, 0x82, 0xcb, 0x15, 0x85, 0x61, 0x1c, 0x96, 0xf6, 0xf6,
+	0xd1, 0xc0, 0x51, 0x32, 0xeb, 0x56, 0x14, 0x50, 0x02, 0x50, 0x77, 0xe3, 0xfa, 0xfc, 0xb0, 0x50,
+	0x3e, 0xeb, 0xd3, 0xc4, 0x32, 0x80, 0x3d, 0xd3, 0x02, 0xde, 0x63, 0xa6, 0x59, 0xe2, 0xbb, 0x19,
+	0x0e, 0xd1, 0x9e, 0xe0, 0x35, 0x50, 0x97, 0x2c, 0x43, 0x5f, 0x82, 0x77, 0x1f, 0x07, 0x78, 0xff,
+	0x73, 0x69, 0x13, 0xce, 0x2e, 0x68, 0xee, 0x85, 0xae, 0xb9, 0x5d, 0x80, 0xdd, 0x2c, 0x11, 0xce,
+	0x84, 0x94, 0xe7, 0x6d, 0xe8, 0x64, 0xfb, 0x01, 0xdd, 0x61, 0xd7, 0x16, 0xde, 0x61, 0xb7, 0xb3,
+	0x7d, 0x9c, 0xa5, 0x8a, 0x3a, 0xd6, 0xe9, 0x36, 0x59, 0xd5, 0xf0, 0x43, 0xf2, 0xee, 0x46, 0xa6,
+	0xa6, 0xca, 0x8a, 0xf5, 0x1e, 0x98, 0xd4, 0x02, 0x7d, 0xa3, 0xf0, 0x46, 0x6b, 0xa7, 0x7a, 0xa3,
+	0xd6, 0x3b, 0x60, 0x7e, 0x1f, 0xbb, 0x49, 0x4c, 0x57, 0xa1, 0x4b, 0x79, 0x0e, 0xf6, 0x3e, 0xde,
+	0x10, 0xa9, 0xa1, 0x01, 0x81, 0x6e, 0x23, 0xc4, 0x02, 0x30, 0x1e, 0x85, 0x7e, 0x14, 0x6e, 0x06,
+	0x81, 0xf5, 0x67, 0x4d, 0x30, 0x3f, 0x72, 0xd2, 0x03, 0xb2, 0x12, 0x98, 0xe9, 0xfa, 0x50, 0x08,
+	0x0f, 0x01, 0x98, 0xae, 0x20, 0x73, 0xe0, 0xaa, 0x20, 0x8c, 0xb1, 0x7f, 0x24, 0xfd, 0x9f, 0xef,
+	0xa9, 0x9b, 0xe5, 0xa2, 0xae, 0xb9, 0x29, 0x8f, 0x42, 0xe8, 0x74, 0xab, 0x2a, 0x88, 0x5d, 0x87,
+	0x01, 0x56, 0x29, 0xd3, 0x0c, 0x75, 0x50, 0x04, 0xd2, 0x42, 0x18, 0x7c, 0x0e, 0xce, 0xae, 0x03,
+	0xa0, 0xaf, 0x41, 0x19, 0x1a, 0xe9, 0x02, 0x1f, 0xad, 0x82, 0x65, 0x57, 0x00, 0x3e, 0x2e, 0x0c,
+	0xac, 0xca, 0xe2, 0xac, 0x40, 0x30, 0xcf, 0x57, 0xd5, 0xb8, 0x18, 0x6d, 0xa9, 0x7b, 0xfd, 0x16,
+	0x9f, 0x06, 0x62, 0x7e, 0x2d, 0x7f, 0xe1, 0xfc, 0xda, 0x39, 0x10, 0x6e, 0x1e, 0x74, 0x9b, 0xed,
+	0xe5, 0xb1, 0x72, 0xa9, 0x3b, 0x78, 0x79, 0xed, 0xe5, 0xf1, 0xd3, 0x3c, 0x10, 0xf8, 0xa2, 0x3c,
+	0x90, 0xee, 0xf3, 0x79, 0x20, 0xbd, 0xe7, 0xf2, 0x40, 0xac, 0x5f, 0x36, 0xa0, 0xa7, 0x36, 0x57,
+	0xda, 0x7c, 0xa6, 0x84, 0x5f, 0x3b, 0x5d, 0xf8, 0xf5, 0xe7, 0x13, 0x7e, 0xe3, 0xb9, 0x84, 0xdf,
+	0x3c, 0x55, 0xf8, 0x0b, 0xc5, 0xd6, 0x7a, 0x61, 0xb1, 0x3d, 0x4b, 0x87, 0xae, 0x00, 0xec, 0x16,
+	0xbe, 0xa4, 0x76, 0x3f, 0x4b, 0xc8, 0x94, 0xd8, 0x8d, 0xe7, 0x12, 0xfb, 0xaf, 0xa6, 0xe3, 0x69,
+	0xed, 0x02, 0xd0, 0xee, 0x21, 0x65, 0xbe, 0x70, 0x76, 0x6b, 0x2f, 0x3a, 0xbb, 0xd6, 0xff, 0xd6,
+	0x00, 0x76, 0x9d, 0x49, 0x2c, 0x9d, 0x0f, 0xf6, 0x1d, 0xe8, 0xa6, 0x54, 0xa3, 0xae, 0xa9, 0x37,
+	0x16, 0x95, 0xdd, 0xad, 0x24, 0x55, 0x45, 0xec, 0x1a, 0x87, 0xb4, 0x28, 0x93, 0xb7, 0x2f, 0x5b,
+	0x28, 0xb2, 0x5c, 0x5a, 0x9a, 0x80, 0xee, 0xf1, 0xaf, 0xc1, 0x92, 0x22, 0x88, 0x45, 0xe2, 0x8a,
+	0x50, 0xda, 0xd9, 0x1a, 0xef, 0x4b, 0xe8, 0x8e, 0x04, 0xb2, 0x5b, 0x05, 0x99, 0x1b, 0x05, 0xf9,
+	0x64, 0xa1, 0xb6, 0x29, 0x96, 0x2d, 0x49, 0x60, 0x6d, 0xe8, 0xa1, 0x50, 0x47, 0x0c, 0x68, 0xe2,
+	0xf7, 0x06, 0x67, 0x58, 0x17, 0x3a, 0xaa, 0xd5, 0x41, 0x8d, 0xf5, 0xc1, 0xa4, 0x54, 0x6f, 0xc2,
+	0xd5, 0xad, 0xbf, 0x3c, 0x0b, 0xdd, 0xed, 0x30, 0xcd, 0x92, 0x5c, 0x0a, 0xb1, 0xcc, 0x68, 0x6e,
+	0x51, 0x46, 0xb3, 0x4a, 0x73, 0x92, 0xc3, 0xc0, 0x22, 0x7b, 0x0b, 0x9a, 0x4e, 0x98, 0xf9, 0xca,
+	0xd1, 0xac, 0xa4, 0xcd, 0xeb, 0x80, 0x20, 0x27, 0x3c, 0xbb, 0x01, 0x1d, 0x95, 0x63, 0xaf, 0x52,
+	0x58, 0x17, 0x26, 0xe8, 0x6b, 0x1a, 0xb6, 0x0e, 0x86, 0xa7, 0x92, 0xff, 0x87, 0xad, 0xd9, 0xa6,
+	0xf5, 0xb3, 0x00, 0x5e, 0xd0, 0x60, 0xda, 0x91, 0x33, 0x96, 0xeb, 0x81, 0xd2, 0x8e, 0x34, 0x29,
+	0xa5, 0x4c, 0x73, 0xc4, 0x61, 0xfa, 0x05, 0xba, 0xb7, 0xc3, 0x8e, 0xde, 0x06, 0x35, 0x8d, 0xec,
+	0x25, 0xe2, 0xd8, 0x4d, 0x75, 0x0a, 0x25, 0x42, 0x63, 0xf6, 0xbb, 0xfa, 0xc2, 0x48, 0x9e, 0x46,
+	0x3f, 0x56, 0x0c, 0xa9, 0x98, 0xf8, 0x92, 0xc1, 0x9c, 0x65, 0xd0, 0x41, 0x37, 0x6e, 0xa4, 0xaa,
+	0xc4, 0xde, 0x87, 0x6e, 0x4a, 0xd1, 0x21, 0xc9, 0x02, 0x3a, 0x77, 0xa0, 0x60, 0x29, 0x42, 0x47,
+	0x1c, 0xd2, 0xa2, 0x8c, 0xdf, 0x99, 0x38, 0xc9, 0xa1, 0x64, 0xea, 0xce, 0x7e, 0x47, 0x87, 0x2e,
+	0xb8, 0x31, 0x51, 0x25, 0xb6, 0x01, 0x20, 0x17, 0x16, 0x71, 0xf4, 0x66, 0xa7, 0xbc, 0x38, 0xae,
+	0x73, 0xd3, 0xd3, 0x45, 0xf6, 0x55, 0xe8, 0xc4, 0xf2, 0xdc, 0x41, 0xf9, 0x1c, 0xdd, 0x8d, 0x95,
+	0x92, 0x41, 0x1d, 0x48, 0xb8, 0xa6, 0x60, 0xdf, 0x86, 0x25, 0x99, 0x7b, 0x32, 0x52, 0x6e, 0xfa,
+	0x70, 0x49, 0x2f, 0x37, 0xcd, 0x33, 0xe5, 0xc5, 0xf3, 0x7e, 0x56, 0xad, 0xb2, 0x6f, 0x42, 0x5f,
+	0x28, 0x2f, 0xca, 0x4e, 0xf1, 0x81, 0xc1, 0x80, 0xd8, 0x2f, 0x2c, 0x76, 0xb2, 0x78, 0x4f, 0x54,
+	0x6a, 0x6c, 0x0d, 0xda, 0x2a, 0x49, 0x6b, 0x85, 0xb8, 0x2a, 0x6f, 0x9a, 0xe4, 0x1d, 0x39, 0x57,
+	0x78, 0x76, 0x7b, 0x26, 0x7b, 0x01, 0xdd, 0x28, 0xa6, 0x13, 0xb0, 0x16, 0xa7, 0x24, 0x4c, 0xe5,
+	0x35, 0x60, 0x86, 0xc6, 0x06, 0x40, 0x99, 0xf5, 0x31, 0x3c, 0x3b, 0x3b, 0x97, 0x45, 0xca, 0x07,
+	0x37, 0x8b, 0x6c, 0x0f, 0x34, 0x48, 0xd5, 0x2c, 0x14, 0x79, 0x91, 0x7f, 0x8e, 0x58, 0x5f, 0x59,
+	0xc0, 0x2a, 0xef, 0xf3, 0xf9, 0x72, 0x3c, 0x0d, 0x60, 0xef, 0x80, 0x11, 0x25, 0x1e, 0x25, 0xe3,
+	0x0d, 0xcf, 0xd3, 0x8a, 0x5f, 0x51, 0x39, 0x75, 0xf2, 0xf1, 0x02, 0x19, 0xb2, 0x4e, 0x24, 0x2b,
+	0xec, 0x06, 0x66, 0xcd, 0x47, 0x98, 0x6c, 0x27, 0x9d, 0xe5, 0x0b, 0xf3, 0x8f, 0x1e, 0x14, 0x9e,
+	0x7c, 0xe7, 0xd2, 0x19, 0xbe, 0xf8, 0x54, 0x67, 0x78, 0x55, 0xbb, 0x7f, 0xc3, 0x39, 0x12, 0x89,
+	0xc0, 0x56, 0x94, 0xe3, 0xf8, 0xca, 0x7c, 0x2b, 0x12, 0x83, 0x39, 0xb8, 0x7e, 0x7a, 0xcf, 0x4f,
+	0xd2, 0x6c, 0x78, 0x49, 0x6f, 0x3a, 0x54, 0x45, 0xb7, 0xd3, 0x4f, 0xef, 0x3b, 0x69, 0x36, 0x7c,
+	0x55, 0xbf, 0x5b, 0xc1, 0x1a, 0xce, 0xb9, 0x0c, 0x13, 0x90, 0xfe, 0x5e, 0x9e, 0x9d, 0xf3, 0xe2,
+	0x22, 0x50, 0xc5, 0x7b, 0xb0, 0xc8, 0x3e, 0x84, 0x65, 0xc9, 0x53, 0x2e, 0xc9, 0xd7, 0x66, 0x75,
+	0x72, 0xea, 0x46, 0x89, 0xf7, 0x93, 0x6a, 0xb5, 0x6c, 0x00, 0x4d, 0x96, 0x6c, 0xe0, 0xca, 0xc2,
+	0x06, 0x0a, 0xe3, 0xd6, 0x4f, 0xaa, 0x55, 0x76, 0x1d, 0xda, 0x2a, 0xb3, 0xf0, 0xea, 0x9c, 0xd1,
+	0x52, 0x39, 0xb4, 0x5c, 0x51, 0xb0, 0xaf, 0x40, 0x87, 0x32, 0xb8, 0xa2, 0x78, 0xb8, 0x3a, 0xab,
+	0xc4, 0x32, 0x1b, 0x8a, 0xb7, 0x03, 0xfa, 0xc5, 0x85, 0xa9, 0xe3, 0x09, 0xaf, 0xcf, 0x2e, 0x4c,
+	0xb5, 0xb7, 0x73, 0x4d, 0xc1, 0xae, 0x41, 0x6b, 0x82, 0x26, 0x7d, 0x68, 0xcd, 0x1a, 0x43, 0x69,
+	0xe9, 0x25, 0x96, 0x0c, 0x11, 0x1d, 0x13, 0xe4, 0xea, 0x7b, 0x63, 0xce, 0x10, 0x15, 0x67, 0x08,
+	0x0e, 0x69, 0x51, 0x66, 0x7f, 0x00, 0x97, 0xaa, 0x19, 0x50, 0x3a, 0x3d, 0x4a, 0x9d, 0xff, 0xde,
+	0xa4, 0x56, 0x5e, 0x5f, 0xa0, 0xe0, 0xd3, 0x89, 0x54, 0xfc, 0x62, 0xbc, 0x18, 0x41, 0xdd, 0x92,
+	0x1b, 0x1d, 0xda, 0x95, 0xe1, 0xb5, 0xb9, 0x6e, 0x15, 0x5b, 0xae, 0xde, 0x46, 0xb1, 0xcc, 0x3e,
+	0x80, 0xde, 0x08, 0x33, 0x76, 0x54, 0x18, 0x62, 0xf8, 0xd6, 0x6a, 0x6d, 0xfa, 0xac, 0x5b, 0xc9,
+	0xe7, 0xe1, 0xdd, 0x51, 0x59, 0xc1, 0x07, 0x49, 0x6e, 0x68, 0x3b, 0x9e, 0x97, 0x0c, 0xdf, 0x96,
+	0xf9, 0x3c, 0x6e, 0xb8, 0xe9, 0x79, 0x94, 0x18, 0x15, 0xc5, 0x82, 0x1e, 0x00, 0x61, 0x6e, 0xe3,
+	0x9a, 0xdc, 0xba, 0x35, 0x68, 0xdb, 0x43, 0x02, 0x0c, 0x18, 0x04, 0x81, 0xc0, 0xa0, 0xd4, 0xf0,
+	0x2b, 0x92, 0x40, 0x83, 0xb6, 0x3d, 0xcc, 0x88, 0x9e, 0x38, 0xc7, 0xb6, 0x86, 0x0c, 0xaf, 0x13,
+	0x45, 0x77, 0xe2, 0x1c, 0xef, 0x28, 0x10, 0xaa, 0xb9, 0x4c, 0xfb, 0x26, 0x65, 0xfb, 0xea, 0xac,
+	0x9a, 0x17, 0x11, 0x18, 0x6e, 0xfa, 0xba, 0x28, 0xcd, 0x11, 0x19, 0x61, 0x3b, 0xd8, 0x18, 0xbe,
+	0x33, 0x6f, 0x8e, 0x54, 0xe8, 0x08, 0xcd, 0x91, 0x2a, 0x22, 0x8f, 0xb4, 0xd6, 0x24, 0xec, 0x1b,
+	0xb3, 0x3c, 0xc5, 0x59, 0x8e, 0x9b, 0x99, 0x2e, 0x22, 0x0f, 0x9d, 0x2a, 0x25, 0xcf, 0xfa, 0x2c,
+	0x4f, 0x71, 0x94, 0xe3, 0xe6, 0x13, 0x5d, 0xc4, 0x7d, 0x2a, 0xc7, 0x43, 0x9b, 0xed, 0x04, 0xc1,
+	0xf0, 0xe6, 0xec, 0x1a, 0xd0, 0xe7, 0x39, 0x6e, 0xe4, 0xaa, 0x84, 0x1f, 0xa1, 0xd8, 0x35, 0xb9,
+	0x71, 0xc3, 0x77, 0x67, 0x3f, 0x52, 0x1c, 0xfa, 0xb8, 0x79, 0xa0, 0x8b, 0xb8, 0x75, 0xe8, 0x10,
+	0xaa, 0x64, 0xbb, 0x35, 0xbb, 0x75, 0x54, 0xcf, 0x03, 0x5c, 0x3f, 0x9e, 0x93, 0xcc, 0xef, 0x43,
+	0x57, 0xce, 0xb8, 0x64, 0xdd, 0x98, 0x55, 0xb0, 0xd2, 0xa9, 0xe4, 0x52, 0x34, 0x92, 0xed, 0x1a,
+	0xb4, 0x9c, 0x18, 0x5f, 0xa3, 0xbe, 0x37, 0xbb, 0xaa, 0x36, 0x11, 0xcc, 0x25, 0x16, 0xf5, 0x70,
+	0x92, 0x07, 0x99, 0xaf, 0x13, 0xb8, 0xbf, 0x36, 0xab, 0x87, 0x95, 0x07, 0x22, 0xbc, 0x3b, 0x29,
+	0x2b, 0x68, 0xe9, 0xe3, 0x28, 0xcd, 0x6c, 0x6f, 0x12, 0x0c, 0xdf, 0x9f, 0xdb, 0x7d, 0x65, 0x56,
+	0x2e, 0xef, 0xc4, 0xb2, 0x60, 0xbd, 0x0f, 0xbd, 0x4d, 0x7a, 0x52, 0xeb, 0xa7, 0x64, 0xca, 0xaf,
+	0x41, 0xb3, 0x88, 0x10, 0x16, 0x7b, 0x04, 0x51, 0x7c, 0x26, 0xf0, 0x59, 0x2e, 0x27, 0xb4, 0xf5,
+	0xb7, 0x0d, 0x68, 0xef, 0x46, 0x79, 0xe2, 0x8a, 0x67, 0xe7, 0xbf, 0xbf, 0xa6, 0x55, 0x26, 0x2c,
+	0x73, 0xdd, 0xa4, 0x76, 0x10, 0xba, 0x1a, 0x7c, 0x6c, 0x50, 0x50, 0xa6, 0x08, 0x3e, 0x9e, 0x83,
+	0x96, 0x3c, 0xd4, 0xcb, 0x0c, 0x6c, 0x59, 0xa1, 0xe5, 0x92, 0xa7, 0x07, 0x5e, 0x74, 0x84, 0x4f,
+	0x84, 0xc8, 0xab, 0x6b, 0x72, 0xd0, 0xa0, 0x6d, 0x8f, 0x1e, 0x11, 0x69, 0x02, 0x5a, 0x8f, 0x32,
+	0x12, 0xd4, 0xd3, 0x40, 0x5a, 0x95, 0x3a, 0xb0, 0xd9, 0x79, 0x4a, 0x60, 0xf3, 0x3a, 0x14, 0x49,
+	0xf9, 0x43, 0x63, 0x61, 0xc0, 0xa3, 0xc0, 0xb3, 0x0d, 0x30, 0x8b, 0x07, 0xd7, 0x45, 0x8e, 0x75,
+	0x01, 0x59, 0xdf, 0xd3, 0x25, 0x5e, 0x92, 0x2d, 0x88, 0x78, 0xc6, 0x49, 0xb4, 0xaf, 0x82, 0x53,
+	0xf0, 0x22, 0x11, 0xcf, 0x1d, 0xe4, 0xd3, 0x71, 0x5c, 0x3f, 0xc5, 0xfb, 0x8c, 0x34, 0x53, 0x51,
+	0xa1, 0x8e, 0x9f, 0x6e, 0x61, 0xd5, 0xfa, 0x7d, 0x30, 0xf0, 0xc8, 0x85, 0x22, 0xc4, 0x48, 0xe3,
+	0xc4, 0x8d, 0x73, 0xe5, 0x8e, 0x53, 0x59, 0xbd, 0xa7, 0x96, 0xc2, 0x51, 0xef, 0xa9, 0x69, 0xea,
+	0x1a, 0x04, 0xa1, 0xb2, 0x7c, 0xa9, 0x79, 0x12, 0x44, 0x8e, 0xa7, 0x04, 0xa2, 0xab, 0xd6, 0xdf,
+	0xd4, 0x60, 0x65, 0x27, 0x89, 0x5c, 0x91, 0xa6, 0x94, 0x88, 0xeb, 0x90, 0x67, 0xc6, 0xa0, 0x49,
+	0x41, 0x45, 0xf9, 0x90, 0x91, 0xca, 0xa8, 0x0c, 0x32, 0x5a, 0x53, 0x1c, 0x63, 0x1a, 0xdc, 0x24,
+	0x08, 0x9d, 0x62, 0x0a, 0x34, 0x31, 0x36, 0x2a, 0x68, 0x0a, 0x47, 0x5e, 0x83, 0xa5, 0xf2, 0x99,
+	0x0b, 0xb5, 0xa0, 0x5e, 0x30, 0x17, 0x50, 0x6a, 0xe5, 0x2a, 0x74, 0x13, 0xe1, 0xa0, 0xb7, 0x43,
+	0xcd, 0xb4, 0x88, 0x06, 0x24, 0x08, 0xdb, 0xb1, 0x0e, 0x60, 0xb0, 0x93, 0x88, 0xd8, 0x49, 0x04,
+	0x1a, 0xd0, 0x09, 0xcd, 0xca, 0x05, 0x68, 0x07, 0x22, 0x1c, 0x67, 0x07, 0xaa, 0xbf, 0xaa, 0x56,
+	0xbc, 0x5e, 0xaf, 0x57, 0x5e, 0xaf, 0xe3, 0xec, 0x24, 0xc2, 0x51, 0x8f, 0xdc, 0xa9, 0x8c, 0xca,
+	0x1a, 0xe6, 0x81, 0x0a, 0x74, 0x1a, 0x5c, 0x56, 0xac, 0xbf, 0x6e, 0x40, 0x57, 0xcd, 0x0c, 0x7d,
+	0x45, 0xce, 0x73, 0xad, 0x98, 0xe7, 0x01, 0x34, 0x30, 0x56, 0x29, 0x27, 0x1e, 0x8b, 0xec, 0x3d,
+	0x68, 0x04, 0xfe, 0x44, 0x9d, 0x83, 0x5e, 0x9d, 0x32, 0xc7, 0xd3, 0xf3, 0xab, 0x8e, 0xb3, 0x48,
+	0x8d, 0xa1, 0xcd, 0x3c, 0xf4, 0x8f, 0x6d, 0xd4, 0x0a, 0x35, 0x27, 0x68, 0x1a, 0x8f, 0x51, 0xf5,
+	0x70, 0x52, 0x1d, 0x97, 0x32, 0x7f, 0xf5, 0x7a, 0xe9, 0x73, 0x53, 0x41, 0xb6, 0x3d, 0xf6, 0x35,
+	0x30, 0xd2, 0xd0, 0x89, 0xd3, 0x83, 0x28, 0x53, 0xe7, 0x1e, 0xb6, 0x8e, 0x7f, 0x11, 0xb0, 0xf5,
+	0x70, 0xef, 0x38, 0xdc, 0x55, 0x18, 0xf5, 0xb1, 0x82, 0x92, 0x7d, 0x1b, 0x7a, 0xa9, 0x48, 0x53,
+	0xf9, 0xde, 0x68, 0x14, 0x0d, 0x3b, 0xb3, 0x06, 0x6a, 0x57, 0x62, 0x71, 0xd4, 0x8a, 0xb9, 0x9b,
+	0x96, 0x20, 0xf6, 0x11, 0x2c, 0x69, 0xfe, 0x20, 0xa2, 0xf7, 0x06, 0xc6, 0xec, 0x88, 0x55, 0x0b,
+	0xf7, 0x09, 0x5d, 0x69, 0xa7, 0x9f, 0x56, 0x11, 0xec, 0xbb, 0xf8, 0x57, 0x02, 0x24, 0x4c, 0x5b,
+	0x45, 0xe1, 0xe5, 0x12, 0xbc, 0x34, 0xe5, 0x3d, 0x4c, 0x09, 0xbb, 0x4c, 0xfc, 0x2f, 0xe1, 0xa9,
+	0xf5, 0xdf, 0x35, 0xe8, 0x56, 0x7a, 0x8d, 0x52, 0xce, 0x53, 0x91, 0xe8, 0x88, 0x3c, 0x96, 0x11,
+	0x76, 0x10, 0xa9, 0xa7, 0xb8, 0x26, 0xa7, 0x32, 0xc2, 0x92, 0x48, 0x5d, 0xd1, 0x98, 0x9c, 0xca,
+	0x68, 0x83, 0xd4, 0x11, 0x94, 0x66, 0x48, 0xae, 0x98, 0x26, 0xef, 0x95, 0xc0, 0x6d, 0x0a, 0x30,
+	0xa1, 0x3a, 0xed, 0x3b, 0xa9, 0xbe, 0x23, 0x28, 0xea, 0xb8, 0xd8, 0x9e, 0x88, 0x04, 0xfb, 0xa2,
+	0xcc, 0x97, 0xae, 0xa2, 0xac, 0xc9, 0x6c, 0x7c, 0x16, 0x85, 0xf2, 0x1a, 0xb6, 0xc7, 0x0d, 0x04,
+	0xfc, 0x20, 0x0a, 0x89, 0x4d, 0x49, 0x96, 0xe6, 0xd3, 0xe4, 0xba, 0x8a, 0xc6, 0xe1, 0x71, 0x2e,
+	0xd0, 0xc3, 0xf2, 0xe8, 0xcd, 0xaa, 0xc9, 0x3b, 0x54, 0xdf, 0xf6, 0xac, 0x5f, 0xd4, 0x60, 0x65,
+	0x6e, 0xb2, 0xd1, 0xa1, 0xc1, 0x89, 0xd6, 0xef, 0x31, 0x7a, 0xbc, 0x8d, 0xd5, 0x6d, 0x8f, 0x10,
+	0xd9, 0x84, 0x94, 0xa9, 0xae, 0x10, 0xd9, 0x04, 0x35, 0xe9, 0x3c, 0xb4, 0xb3, 0x63, 0x1a, 0xad,
+	0x5c, 0x18, 0xad, 0xec, 0x18, 0x87, 0xb9, 0x89, 0x6f, 0x11, 0xc6, 0x76, 0x20, 0x9e, 0x88, 0x80,
+	0xe6, 0x61, 0x69, 0xe3, 0xcd, 0x53, 0xa4, 0xbc, 0x7e, 0x3f, 0x1a, 0xdf, 0x47, 0x5a, 0x7c, 0xa5,
+	0x20, 0x4b, 0xd6, 0xc7, 0x60, 0x68, 0x28, 0x33, 0xa1, 0x75, 0x07, 0xff, 0xa2, 0x61, 0x70, 0x06,
+	0x83, 0x11, 0xc8, 0x31, 0xa8, 0x61, 0xe9, 0x53, 0x27, 0x09, 0x07, 0x75, 0x44, 0xdf, 0x4d, 0x92,
+	0x28, 0x19, 0x34, 0xb0, 0xb8, 0xe3, 0x84, 0xbe, 0x3b, 0x68, 0x62, 0xf1, 0x9e, 0x93, 0x39, 0xc1,
+	0xa0, 0x65, 0xfd, 0x5d, 0x0b, 0x8c, 0x1d, 0xf5, 0x75, 0x76, 0x07, 0xfa, 0xba, 0x27, 0x4f, 0x89,
+	0xcd, 0xec, 0xcc, 0x16, 0x28, 0x36, 0xd3, 0x8b, 0x2b, 0xb5, 0xd9, 0x3f, 0x87, 0xa8, 0xcf, 0xfd,
+	0x39, 0xc4, 0x65, 0x68, 0x3c, 0x4e, 0x4e, 0xa6, 0x6f, 0xd1, 0x76, 0x02, 0x27, 0xe4, 0x08, 0xc6,
+	0xab, 0x4c, 0x94, 0xbb, 0x9d, 0xd2, 0x8e, 0x3a, 0x6c, 0xce, 0x7a, 0xf1, 0x72, 0xa7, 0xe5, 0x80,
+	0x44, 0xb2, 0x8c, 0x71, 0x0d, 0xf7, 0xc0, 0x0f, 0xbc, 0x44, 0x84, 0x2a, 0x58, 0xcc, 0xe6, 0xbb,
+	0xcc, 0x0b, 0x1a, 0xf6, 0x1d, 0x7a, 0x06, 0xa0, 0xe3, 0x31, 0xd5, 0x2c, 0xa4, 0xf3, 0x53, 0x47,
+	0x5e, 0x4d, 0xc1, 0x97, 0x2b, 0xe4, 0xb4, 0xb9, 0x94, 0x6f, 0xee, 0x3a, 0xd5, 0x37, 0x77, 0xf2,
+	0x0f, 0x03, 0x68, 0x53, 0x30, 0x8a, 0x83, 0x57, 0xe4, 0xe0, 0x63, 0xbc, 0x66, 0x88, 0xd7, 0x13,
+	0x73, 0xc1, 0x0c, 0xbd, 0x0f, 0x71, 0xc2, 0xd3, 0x3f, 0x81, 0xe4, 0xe9, 0x81, 0x2d, 0xf7, 0x73,
+	0x34, 0x25, 0xa0, 0xde, 0xfc, 0xe6, 0xe9, 0xc1, 0x1d, 0xdc, 0xd1, 0x51, 0x19, 0xaf, 0xc1, 0x92,
+	0x1e, 0x8b, 0x7a, 0xc4, 0x20, 0x93, 0x2f, 0xfa, 0x1a, 0x2a, 0xdf, 0x30, 0xac, 0xc3, 0x59, 0xf7,
+	0xc0, 0x09, 0x43, 0x11, 0xd8, 0xfb, 0xf9, 0x68, 0xa4, 0x77, 0x80, 0x1e, 0x5d, 0x36, 0xae, 0x28,
+	0xd4, 0x6d, 0xc2, 0xd0, 0x86, 0x62, 0x41, 0x3f, 0xf4, 0x03, 0xf9, 0x50, 0xd2, 0x76, 0xc3, 0x8c,
+	0xae, 0x91, 0x5b, 0xbc, 0x1b, 0xfa, 0x01, 0xc5, 0x71, 0x31, 0x4e, 0xfe, 0x21, 0x0c, 0xf0, 0xef,
+	0x44, 0x52, 0x3b, 0x8b, 0xf4, 0x7f, 0x2d, 0xd0, 0x95, 0xf1, 0x94, 0xa3, 0xf8, 0x28, 0xf7, 0xbd,
+	0xbd, 0x48, 0xfd, 0xdb, 0x42, 0x9f, 0xe8, 0x75, 0xd5, 0xfa, 0x10, 0x7a, 0x55, 0xdd, 0x41, 0x5d,
+	0xa4, 0x13, 0xd4, 0xe0, 0x0c, 0x03, 0x68, 0x3f, 0x8c, 0x92, 0x89, 0x13, 0x0c, 0x6a, 0x58, 0x96,
+	0x2f, 0x51, 0x07, 0x75, 0xd6, 0x03, 0x43, 0xbb, 0xf6, 0x83, 0x86, 0xf5, 0x4d, 0x30, 0xf4, 0x9f,
+	0x47, 0xd0, 0xab, 0xfd, 0xc8, 0x13, 0xd2, 0xb1, 0x91, 0x96, 0xc9, 0x40, 0x00, 0x39, 0x35, 0xfa,
+	0x5f, 0x50, 0xea, 0xe5, 0xbf, 0xa0, 0x58, 0xbf, 0x0b, 0xbd, 0x6a, 0xe7, 0x74, 0xe8, 0xad, 0x56,
+	0x86, 0xde, 0x16, 0x70, 0xe1, 0x67, 0x46, 0x49, 0x34, 0xb1, 0x2b, 0x4e, 0x80, 0x81, 0x00, 0xfc,
+	0x8c, 0xf5, 0xc7, 0x35, 0x68, 0x91, 0xb7, 0x4a, 0x5b, 0x0b, 0x16, 0xca, 0xb5, 0xd3, 0xe2, 0x26,
+	0x41, 0x68, 0xa4, 0xd5, 0x3b, 0xe7, 0xfa, 0xd3, 0xef, 0x9c, 0x1b, 0xd3, 0x77, 0xce, 0xcf, 0x99,
+	0x94, 0x74, 0xfd, 0x31, 0xb4, 0xe5, 0x1f, 0xcf, 0xb0, 0x15, 0xe8, 0x3f, 0x0a, 0x0f, 0xc3, 0xe8,
+	0x28, 0x94, 0x80, 0xc1, 0x19, 0x76, 0x16, 0x96, 0xf5, 0xa4, 0xab, 0x7f, 0xb8, 0x19, 0xd4, 0xd8,
+	0x00, 0x7a, 0x24, 0x56, 0x0d, 0xa9, 0xb3, 0xcb, 0x30, 0x54, 0x9b, 0xc3, 0x9d, 0x28, 0x14, 0x0f,
+	0xa3, 0xcc, 0x1f, 0x9d, 0x68, 0x6c, 0x83, 0x2d, 0x43, 0x77, 0x37, 0x8b, 0xe2, 0x5d, 0x11, 0x7a,
+	0x7e, 0x38, 0x1e, 0x34, 0xaf, 0xdf, 0x83, 0xb6, 0xfc, 0x3f, 0x9c, 0xca, 0x27, 0x25, 0x60, 0x70,
+	0x06, 0xa9, 0xf1, 0xfd, 0x93, 0x1f, 0x8e, 0x1f, 0x8a, 0xe3, 0x4c, 0x1a, 0x25, 0x8c, 0x41, 0x0c,
+	0xea, 0x6c, 0x09, 0x40, 0xb5, 0x7a, 0x37, 0xf4, 0x06, 0x8d, 0xdb, 0x5b, 0x3f, 0xf9, 0xf9, 0x95,
+	0xda, 0xcf, 0x7e, 0x7e, 0xa5, 0xf6, 0xaf, 0x3f, 0xbf, 0x72, 0xe6, 0x2f, 0xfe, 0xed, 0x4a, 0xed,
+	0x07, 0xb7, 0x2a, 0xff, 0xf6, 0x33, 0x71, 0xb2, 0xc4, 0x3f, 0x96, 0xb7, 0x8d, 0xba, 0x12, 0x8a,
+	0x9b, 0xf1, 0xe1, 0xf8, 0x66, 0xbc, 0x7f, 0x53, 0xeb, 0xdc, 0x7e, 0x9b, 0xfe, 0xc4, 0xe7, 0xbd,
+	0xff, 0x1b, 0x00, 0x3e, 0xc0, 0x18, 0xf7, 0x43, 0x48, 0x00, 0x00,
 }
 
 func (m *Message) Marshal() (dAtA []byte, err error) {
@@ -7588,6 +7649,42 @@ func (m *PostDml) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Mview != nil {
+		{
+			size, err := m.Mview.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPipeline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Trigger != nil {
+		{
+			size, err := m.Trigger.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPipeline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Hnsw != nil {
+		{
+			size, err := m.Hnsw.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPipeline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.FullText != nil {
 		{
 			size, err := m.FullText.MarshalToSizedBuffer(dAtA[:i])
@@ -7691,6 +7788,31 @@ func (m *LockTarget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.SkipLockedLimit != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.SkipLockedLimit))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.SkipLocked {
+		i--
+		if m.SkipLocked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.WaitSec != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.WaitSec))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.WaitPolicy != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.WaitPolicy))
+		i--
+		dAtA[i] = 0x58
+	}
 	if m.LockTableAtTheEnd {
 		i--
 		if m.LockTableAtTheEnd {
@@ -7951,21 +8073,21 @@ func (m *OnDuplicateKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.OnDuplicateIdx) > 0 {
-		dAtA34 := make([]byte, len(m.OnDuplicateIdx)*10)
-		var j33 int
+		dAtA37 := make([]byte, len(m.OnDuplicateIdx)*10)
+		var j36 int
 		for _, num1 := range m.OnDuplicateIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA34[j33] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA37[j36] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j33++
+				j36++
 			}
-			dAtA34[j33] = uint8(num)
-			j33++
+			dAtA37[j36] = uint8(num)
+			j36++
 		}
-		i -= j33
-		copy(dAtA[i:], dAtA34[:j33])
-		i = encodeVarintPipeline(dAtA, i, uint64(j33))
+		i -= j36
+		copy(dAtA[i:], dAtA37[:j36])
+		i = encodeVarintPipeline(dAtA, i, uint64(j36))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -8202,40 +8324,40 @@ func (m *Join) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 	}
 	if len(m.ColList) > 0 {
-		dAtA38 := make([]byte, len(m.ColList)*10)
-		var j37 int
+		dAtA41 := make([]byte, len(m.ColList)*10)
+		var j40 int
 		for _, num1 := range m.ColList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA38[j37] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA41[j40] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j37++
+				j40++
 			}
-			dAtA38[j37] = uint8(num)
-			j37++
+			dAtA41[j40] = uint8(num)
+			j40++
 		}
-		i -= j37
-		copy(dAtA[i:], dAtA38[:j37])
-		i = encodeVarintPipeline(dAtA, i, uint64(j37))
+		i -= j40
+		copy(dAtA[i:], dAtA41[:j40])
+		i = encodeVarintPipeline(dAtA, i, uint64(j40))
 		i--
 		dAtA[i] = 0x12
 	}
 	if len(m.RelList) > 0 {
-		dAtA40 := make([]byte, len(m.RelList)*10)
-		var j39 int
+		dAtA43 := make([]byte, len(m.RelList)*10)
+		var j42 int
 		for _, num1 := range m.RelList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA40[j39] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA43[j42] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j39++
+				j42++
 			}
-			dAtA40[j39] = uint8(num)
-			j39++
+			dAtA43[j42] = uint8(num)
+			j42++
 		}
-		i -= j39
-		copy(dAtA[i:], dAtA40[:j39])
-		i = encodeVarintPipeline(dAtA, i, uint64(j39))
+		i -= j42
+		copy(dAtA[i:], dAtA43[:j42])
+		i = encodeVarintPipeline(dAtA, i, uint64(j42))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -8351,21 +8473,21 @@ func (m *AntiJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 	}
 	if len(m.Result) > 0 {
-		dAtA43 := make([]byte, len(m.Result)*10)
-		var j42 int
+		dAtA46 := make([]byte, len(m.Result)*10)
+		var j45 int
 		for _, num1 := range m.Result {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA43[j42] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA46[j45] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j42++
+				j45++
 			}
-			dAtA43[j42] = uint8(num)
-			j42++
+			dAtA46[j45] = uint8(num)
+			j45++
 		}
-		i -= j42
-		copy(dAtA[i:], dAtA43[:j42])
-		i = encodeVarintPipeline(dAtA, i, uint64(j42))
+		i -= j45
+		copy(dAtA[i:], dAtA46[:j45])
+		i = encodeVarintPipeline(dAtA, i, uint64(j45))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -8495,40 +8617,40 @@ func (m *LeftJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 	}
 	if len(m.ColList) > 0 {
-		dAtA46 := make([]byte, len(m.ColList)*10)
-		var j45 int
+		dAtA49 := make([]byte, len(m.ColList)*10)
+		var j48 int
 		for _, num1 := range m.ColList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA46[j45] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA49[j48] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j45++
+				j48++
 			}
-			dAtA46[j45] = uint8(num)
-			j45++
+			dAtA49[j48] = uint8(num)
+			j48++
 		}
-		i -= j45
-		copy(dAtA[i:], dAtA46[:j45])
-		i = encodeVarintPipeline(dAtA, i, uint64(j45))
+		i -= j48
+		copy(dAtA[i:], dAtA49[:j48])
+		i = encodeVarintPipeline(dAtA, i, uint64(j48))
 		i--
 		dAtA[i] = 0x12
 	}
 	if len(m.RelList) > 0 {
-		dAtA48 := make([]byte, len(m.RelList)*10)
-		var j47 int
+		dAtA51 := make([]byte, len(m.RelList)*10)
+		var j50 int
 		for _, num1 := range m.RelList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA48[j47] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA51[j50] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j47++
+				j50++
 			}
-			dAtA48[j47] = uint8(num)
-			j47++
+			dAtA51[j50] = uint8(num)
+			j50++
 		}
-		i -= j47
-		copy(dAtA[i:], dAtA48[:j47])
-		i = encodeVarintPipeline(dAtA, i, uint64(j47))
+		i -= j50
+		copy(dAtA[i:], dAtA51[:j50])
+		i = encodeVarintPipeline(dAtA, i, uint64(j50))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -8672,40 +8794,40 @@ func (m *RightJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 	}
 	if len(m.ColList) > 0 {
-		dAtA51 := make([]byte, len(m.ColList)*10)
-		var j50 int
+		dAtA54 := make([]byte, len(m.ColList)*10)
+		var j53 int
 		for _, num1 := range m.ColList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA51[j50] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA54[j53] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j50++
+				j53++
 			}
-			dAtA51[j50] = uint8(num)
-			j50++
+			dAtA54[j53] = uint8(num)
+			j53++
 		}
-		i -= j50
-		copy(dAtA[i:], dAtA51[:j50])
-		i = encodeVarintPipeline(dAtA, i, uint64(j50))
+		i -= j53
+		copy(dAtA[i:], dAtA54[:j53])
+		i = encodeVarintPipeline(dAtA, i, uint64(j53))
 		i--
 		dAtA[i] = 0x12
 	}
 	if len(m.RelList) > 0 {
-		dAtA53 := make([]byte, len(m.RelList)*10)
-		var j52 int
+		dAtA56 := make([]byte, len(m.RelList)*10)
+		var j55 int
 		for _, num1 := range m.RelList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA53[j52] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA56[j55] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j52++
+				j55++
 			}
-			dAtA53[j52] = uint8(num)
-			j52++
+			dAtA56[j55] = uint8(num)
+			j55++
 		}
-		i -= j52
-		copy(dAtA[i:], dAtA53[:j52])
-		i = encodeVarintPipeline(dAtA, i, uint64(j52))
+		i -= j55
+		copy(dAtA[i:], dAtA56[:j55])
+		i = encodeVarintPipeline(dAtA, i, uint64(j55))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -8835,21 +8957,21 @@ func (m *RightSemiJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 	}
 	if len(m.Result) > 0 {
-		dAtA56 := make([]byte, len(m.Result)*10)
-		var j55 int
+		dAtA59 := make([]byte, len(m.Result)*10)
+		var j58 int
 		for _, num1 := range m.Result {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA56[j55] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA59[j58] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j55++
+				j58++
 			}
-			dAtA56[j55] = uint8(num)
-			j55++
+			dAtA59[j58] = uint8(num)
+			j58++
 		}
-		i -= j55
-		copy(dAtA[i:], dAtA56[:j55])
-		i = encodeVarintPipeline(dAtA, i, uint64(j55))
+		i -= j58
+		copy(dAtA[i:], dAtA59[:j58])
+		i = encodeVarintPipeline(dAtA, i, uint64(j58))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -8979,21 +9101,21 @@ func (m *RightAntiJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 	}
 	if len(m.Result) > 0 {
-		dAtA59 := make([]byte, len(m.Result)*10)
-		var j58 int
+		dAtA62 := make([]byte, len(m.Result)*10)
+		var j61 int
 		for _, num1 := range m.Result {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA59[j58] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA62[j61] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j58++
+				j61++
 			}
-			dAtA59[j58] = uint8(num)
-			j58++
+			dAtA62[j61] = uint8(num)
+			j61++
 		}
-		i -= j58
-		copy(dAtA[i:], dAtA59[:j58])
-		i = encodeVarintPipeline(dAtA, i, uint64(j58))
+		i -= j61
+		copy(dAtA[i:], dAtA62[:j61])
+		i = encodeVarintPipeline(dAtA, i, uint64(j61))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -9109,21 +9231,21 @@ func (m *SemiJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 	}
 	if len(m.Result) > 0 {
-		dAtA62 := make([]byte, len(m.Result)*10)
-		var j61 int
+		dAtA65 := make([]byte, len(m.Result)*10)
+		var j64 int
 		for _, num1 := range m.Result {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA62[j61] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA65[j64] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j61++
+				j64++
 			}
-			dAtA62[j61] = uint8(num)
-			j61++
+			dAtA65[j64] = uint8(num)
+			j64++
 		}
-		i -= j61
-		copy(dAtA[i:], dAtA62[:j61])
-		i = encodeVarintPipeline(dAtA, i, uint64(j61))
+		i -= j64
+		copy(dAtA[i:], dAtA65[:j64])
+		i = encodeVarintPipeline(dAtA, i, uint64(j64))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -9253,40 +9375,40 @@ func (m *SingleJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 	}
 	if len(m.ColList) > 0 {
-		dAtA65 := make([]byte, len(m.ColList)*10)
-		var j64 int
+		dAtA68 := make([]byte, len(m.ColList)*10)
+		var j67 int
 		for _, num1 := range m.ColList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA65[j64] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA68[j67] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j64++
+				j67++
 			}
-			dAtA65[j64] = uint8(num)
-			j64++
+			dAtA68[j67] = uint8(num)
+			j67++
 		}
-		i -= j64
-		copy(dAtA[i:], dAtA65[:j64])
-		i = encodeVarintPipeline(dAtA, i, uint64(j64))
+		i -= j67
+		copy(dAtA[i:], dAtA68[:j67])
+		i = encodeVarintPipeline(dAtA, i, uint64(j67))
 		i--
 		dAtA[i] = 0x12
 	}
 	if len(m.RelList) > 0 {
-		dAtA67 := make([]byte, len(m.RelList)*10)
-		var j66 int
+		dAtA70 := make([]byte, len(m.RelList)*10)
+		var j69 int
 		for _, num1 := range m.RelList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA67[j66] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA70[j69] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j66++
+				j69++
 			}
-			dAtA67[j66] = uint8(num)
-			j66++
+			dAtA70[j69] = uint8(num)
+			j69++
 		}
-		i -= j66
-		copy(dAtA[i:], dAtA67[:j66])
-		i = encodeVarintPipeline(dAtA, i, uint64(j66))
+		i -= j69
+		copy(dAtA[i:], dAtA70[:j69])
+		i = encodeVarintPipeline(dAtA, i, uint64(j69))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -9402,21 +9524,21 @@ func (m *MarkJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 	}
 	if len(m.Result) > 0 {
-		dAtA70 := make([]byte, len(m.Result)*10)
-		var j69 int
+		dAtA73 := make([]byte, len(m.Result)*10)
+		var j72 int
 		for _, num1 := range m.Result {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA70[j69] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA73[j72] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j69++
+				j72++
 			}
-			dAtA70[j69] = uint8(num)
-			j69++
+			dAtA73[j72] = uint8(num)
+			j72++
 		}
-		i -= j69
-		copy(dAtA[i:], dAtA70[:j69])
-		i = encodeVarintPipeline(dAtA, i, uint64(j69))
+		i -= j72
+		copy(dAtA[i:], dAtA73[:j72])
+		i = encodeVarintPipeline(dAtA, i, uint64(j72))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -9462,21 +9584,21 @@ func (m *DedupJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.UpdateColIdxList) > 0 {
-		dAtA72 := make([]byte, len(m.UpdateColIdxList)*10)
-		var j71 int
+		dAtA75 := make([]byte, len(m.UpdateColIdxList)*10)
+		var j74 int
 		for _, num1 := range m.UpdateColIdxList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA72[j71] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA75[j74] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j71++
+				j74++
 			}
-			dAtA72[j71] = uint8(num)
-			j71++
+			dAtA75[j74] = uint8(num)
+			j74++
 		}
-		i -= j71
-		copy(dAtA[i:], dAtA72[:j71])
-		i = encodeVarintPipeline(dAtA, i, uint64(j71))
+		i -= j74
+		copy(dAtA[i:], dAtA75[:j74])
+		i = encodeVarintPipeline(dAtA, i, uint64(j74))
 		i--
 		dAtA[i] = 0x72
 	}
@@ -9597,40 +9719,40 @@ func (m *DedupJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.ColList) > 0 {
-		dAtA74 := make([]byte, len(m.ColList)*10)
-		var j73 int
+		dAtA77 := make([]byte, len(m.ColList)*10)
+		var j76 int
 		for _, num1 := range m.ColList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA74[j73] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA77[j76] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j73++
+				j76++
 			}
-			dAtA74[j73] = uint8(num)
-			j73++
+			dAtA77[j76] = uint8(num)
+			j76++
 		}
-		i -= j73
-		copy(dAtA[i:], dAtA74[:j73])
-		i = encodeVarintPipeline(dAtA, i, uint64(j73))
+		i -= j76
+		copy(dAtA[i:], dAtA77[:j76])
+		i = encodeVarintPipeline(dAtA, i, uint64(j76))
 		i--
 		dAtA[i] = 0x12
 	}
 	if len(m.RelList) > 0 {
-		dAtA76 := make([]byte, len(m.RelList)*10)
-		var j75 int
+		dAtA79 := make([]byte, len(m.RelList)*10)
+		var j78 int
 		for _, num1 := range m.RelList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA76[j75] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA79[j78] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j75++
+				j78++
 			}
-			dAtA76[j75] = uint8(num)
-			j75++
+			dAtA79[j78] = uint8(num)
+			j78++
 		}
-		i -= j75
-		copy(dAtA[i:], dAtA76[:j75])
-		i = encodeVarintPipeline(dAtA, i, uint64(j75))
+		i -= j78
+		copy(dAtA[i:], dAtA79[:j78])
+		i = encodeVarintPipeline(dAtA, i, uint64(j78))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -9677,40 +9799,40 @@ func (m *Product) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x18
 	}
 	if len(m.ColList) > 0 {
-		dAtA78 := make([]byte, len(m.ColList)*10)
-		var j77 int
+		dAtA81 := make([]byte, len(m.ColList)*10)
+		var j80 int
 		for _, num1 := range m.ColList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA78[j77] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA81[j80] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j77++
+				j80++
 			}
-			dAtA78[j77] = uint8(num)
-			j77++
+			dAtA81[j80] = uint8(num)
+			j80++
 		}
-		i -= j77
-		copy(dAtA[i:], dAtA78[:j77])
-		i = encodeVarintPipeline(dAtA, i, uint64(j77))
+		i -= j80
+		copy(dAtA[i:], dAtA81[:j80])
+		i = encodeVarintPipeline(dAtA, i, uint64(j80))
 		i--
 		dAtA[i] = 0x12
 	}
 	if len(m.RelList) > 0 {
-		dAtA80 := make([]byte, len(m.RelList)*10)
-		var j79 int
+		dAtA83 := make([]byte, len(m.RelList)*10)
+		var j82 int
 		for _, num1 := range m.RelList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA80[j79] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA83[j82] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j79++
+				j82++
 			}
-			dAtA80[j79] = uint8(num)
-			j79++
+			dAtA83[j82] = uint8(num)
+			j82++
 		}
-		i -= j79
-		copy(dAtA[i:], dAtA80[:j79])
-		i = encodeVarintPipeline(dAtA, i, uint64(j79))
+		i -= j82
+		copy(dAtA[i:], dAtA83[:j82])
+		i = encodeVarintPipeline(dAtA, i, uint64(j82))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -9759,40 +9881,40 @@ func (m *ProductL2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 	}
 	if len(m.ColList) > 0 {
-		dAtA83 := make([]byte, len(m.ColList)*10)
-		var j82 int
+		dAtA86 := make([]byte, len(m.ColList)*10)
+		var j85 int
 		for _, num1 := range m.ColList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA83[j82] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA86[j85] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j82++
+				j85++
 			}
-			dAtA83[j82] = uint8(num)
-			j82++
+			dAtA86[j85] = uint8(num)
+			j85++
 		}
-		i -= j82
-		copy(dAtA[i:], dAtA83[:j82])
-		i = encodeVarintPipeline(dAtA, i, uint64(j82))
+		i -= j85
+		copy(dAtA[i:], dAtA86[:j85])
+		i = encodeVarintPipeline(dAtA, i, uint64(j85))
 		i--
 		dAtA[i] = 0x12
 	}
 	if len(m.RelList) > 0 {
-		dAtA85 := make([]byte, len(m.RelList)*10)
-		var j84 int
+		dAtA88 := make([]byte, len(m.RelList)*10)
+		var j87 int
 		for _, num1 := range m.RelList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA85[j84] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA88[j87] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j84++
+				j87++
 			}
-			dAtA85[j84] = uint8(num)
-			j84++
+			dAtA88[j87] = uint8(num)
+			j87++
 		}
-		i -= j84
-		copy(dAtA[i:], dAtA85[:j84])
-		i = encodeVarintPipeline(dAtA, i, uint64(j84))
+		i -= j87
+		copy(dAtA[i:], dAtA88[:j87])
+		i = encodeVarintPipeline(dAtA, i, uint64(j87))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -9838,21 +9960,21 @@ func (m *IndexJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.Result) > 0 {
-		dAtA87 := make([]byte, len(m.Result)*10)
-		var j86 int
+		dAtA90 := make([]byte, len(m.Result)*10)
+		var j89 int
 		for _, num1 := range m.Result {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA87[j86] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA90[j89] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j86++
+				j89++
 			}
-			dAtA87[j86] = uint8(num)
-			j86++
+			dAtA90[j89] = uint8(num)
+			j89++
 		}
-		i -= j86
-		copy(dAtA[i:], dAtA87[:j86])
-		i = encodeVarintPipeline(dAtA, i, uint64(j86))
+		i -= j89
+		copy(dAtA[i:], dAtA90[:j89])
+		i = encodeVarintPipeline(dAtA, i, uint64(j89))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -10001,21 +10123,21 @@ func (m *FileOffset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.Offset) > 0 {
-		dAtA89 := make([]byte, len(m.Offset)*10)
-		var j88 int
+		dAtA92 := make([]byte, len(m.Offset)*10)
+		var j91 int
 		for _, num1 := range m.Offset {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA89[j88] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA92[j91] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j88++
+				j91++
 			}
-			dAtA89[j88] = uint8(num)
-			j88++
+			dAtA92[j91] = uint8(num)
+			j91++
 		}
-		i -= j88
-		copy(dAtA[i:], dAtA89[:j88])
-		i = encodeVarintPipeline(dAtA, i, uint64(j88))
+		i -= j91
+		copy(dAtA[i:], dAtA92[:j91])
+		i = encodeVarintPipeline(dAtA, i, uint64(j91))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -10158,21 +10280,21 @@ func (m *ExternalScan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.FileSize) > 0 {
-		dAtA92 := make([]byte, len(m.FileSize)*10)
-		var j91 int
+		dAtA95 := make([]byte, len(m.FileSize)*10)
+		var j94 int
 		for _, num1 := range m.FileSize {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA92[j91] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA95[j94] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j91++
+				j94++
 			}
-			dAtA92[j91] = uint8(num)
-			j91++
+			dAtA95[j94] = uint8(num)
+			j94++
 		}
-		i -= j91
-		copy(dAtA[i:], dAtA92[:j91])
-		i = encodeVarintPipeline(dAtA, i, uint64(j91))
+		i -= j94
+		copy(dAtA[i:], dAtA95[:j94])
+		i = encodeVarintPipeline(dAtA, i, uint64(j94))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -12007,40 +12129,40 @@ func (m *Pipeline) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.NilBatchCnt) > 0 {
-		dAtA148 := make([]byte, len(m.NilBatchCnt)*10)
-		var j147 int
+		dAtA151 := make([]byte, len(m.NilBatchCnt)*10)
+		var j150 int
 		for _, num1 := range m.NilBatchCnt {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA148[j147] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA151[j150] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j147++
+				j150++
 			}
-			dAtA148[j147] = uint8(num)
-			j147++
+			dAtA151[j150] = uint8(num)
+			j150++
 		}
-		i -= j147
-		copy(dAtA[i:], dAtA148[:j147])
-		i = encodeVarintPipeline(dAtA, i, uint64(j147))
+		i -= j150
+		copy(dAtA[i:], dAtA151[:j150])
+		i = encodeVarintPipeline(dAtA, i, uint64(j150))
 		i--
 		dAtA[i] = 0x6a
 	}
 	if len(m.ChannelBufferSize) > 0 {
-		dAtA150 := make([]byte, len(m.ChannelBufferSize)*10)
-		var j149 int
+		dAtA153 := make([]byte, len(m.ChannelBufferSize)*10)
+		var j152 int
 		for _, num1 := range m.ChannelBufferSize {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA150[j149] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA153[j152] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j149++
+				j152++
 			}
-			dAtA150[j149] = uint8(num)
-			j149++
+			dAtA153[j152] = uint8(num)
+			j152++
 		}
-		i -= j149
-		copy(dAtA[i:], dAtA150[:j149])
-		i = encodeVarintPipeline(dAtA, i, uint64(j149))
+		i -= j152
+		copy(dAtA[i:], dAtA153[:j152])
+		i = encodeVarintPipeline(dAtA, i, uint64(j152))
 		i--
 		dAtA[i] = 0x62
 	}
@@ -12277,40 +12399,40 @@ func (m *Apply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.ColList) > 0 {
-		dAtA155 := make([]byte, len(m.ColList)*10)
-		var j154 int
+		dAtA158 := make([]byte, len(m.ColList)*10)
+		var j157 int
 		for _, num1 := range m.ColList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA155[j154] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA158[j157] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j154++
+				j157++
 			}
-			dAtA155[j154] = uint8(num)
-			j154++
+			dAtA158[j157] = uint8(num)
+			j157++
 		}
-		i -= j154
-		copy(dAtA[i:], dAtA155[:j154])
-		i = encodeVarintPipeline(dAtA, i, uint64(j154))
+		i -= j157
+		copy(dAtA[i:], dAtA158[:j157])
+		i = encodeVarintPipeline(dAtA, i, uint64(j157))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if len(m.RelList) > 0 {
-		dAtA157 := make([]byte, len(m.RelList)*10)
-		var j156 int
+		dAtA160 := make([]byte, len(m.RelList)*10)
+		var j159 int
 		for _, num1 := range m.RelList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA157[j156] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA160[j159] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j156++
+				j159++
 			}
-			dAtA157[j156] = uint8(num)
-			j156++
+			dAtA160[j159] = uint8(num)
+			j159++
 		}
-		i -= j156
-		copy(dAtA[i:], dAtA157[:j156])
-		i = encodeVarintPipeline(dAtA, i, uint64(j156))
+		i -= j159
+		copy(dAtA[i:], dAtA160[:j159])
+		i = encodeVarintPipeline(dAtA, i, uint64(j159))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -12923,6 +13045,18 @@ func (m *PostDml) ProtoSize() (n int) {
 		l = m.FullText.ProtoSize()
 		n += 1 + l + sovPipeline(uint64(l))
 	}
+	if m.Hnsw != nil {
+		l = m.Hnsw.ProtoSize()
+		n += 1 + l + sovPipeline(uint64(l))
+	}
+	if m.Trigger != nil {
+		l = m.Trigger.ProtoSize()
+		n += 1 + l + sovPipeline(uint64(l))
+	}
+	if m.Mview != nil {
+		l = m.Mview.ProtoSize()
+		n += 1 + l + sovPipeline(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12965,6 +13099,18 @@ func (m *LockTarget) ProtoSize() (n int) {
 	if m.LockTableAtTheEnd {
 		n += 2
 	}
+	if m.WaitPolicy != 0 {
+		n += 1 + sovPipeline(uint64(m.WaitPolicy))
+	}
+	if m.WaitSec != 0 {
+		n += 1 + sovPipeline(uint64(m.WaitSec))
+	}
+	if m.SkipLocked {
+		n += 2
+	}
+	if m.SkipLockedLimit != 0 {
+		n += 1 + sovPipeline(uint64(m.SkipLockedLimit))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -18891,6 +19037,114 @@ func (m *PostDml) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hnsw", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hnsw == nil {
+				m.Hnsw = &plan.PostDmlHnswCtx{}
+			}
+			if err := m.Hnsw.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Trigger == nil {
+				m.Trigger = &plan.PostDmlTriggerCtx{}
+			}
+			if err := m.Trigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mview", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mview == nil {
+				m.Mview = &plan.PostDmlMaterializedViewCtx{}
+			}
+			if err := m.Mview.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
@@ -19166,6 +19420,83 @@ func (m *LockTarget) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.LockTableAtTheEnd = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitPolicy", wireType)
+			}
+			m.WaitPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WaitPolicy |= lock.WaitPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitSec", wireType)
+			}
+			m.WaitSec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WaitSec |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipLocked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkipLocked = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipLockedLimit", wireType)
+			}
+			m.SkipLockedLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SkipLockedLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
@@ -394,7 +394,7 @@ func (x ForeignKeyDef_RefAction) String() string {
 }
 
 func (ForeignKeyDef_RefAction) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{29, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{30, 0}
 }
 
 type OrderBySpec_OrderByFlag int32
@@ -431,7 +431,7 @@ func (x OrderBySpec_OrderByFlag) String() string {
 }
 
 func (OrderBySpec_OrderByFlag) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{47, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{50, 0}
 }
 
 type FrameClause_FrameType int32
@@ -456,7 +456,7 @@ func (x FrameClause_FrameType) String() string {
 }
 
 func (FrameClause_FrameType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{50, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{53, 0}
 }
 
 type FrameBound_BoundType int32
@@ -484,7 +484,7 @@ func (x FrameBound_BoundType) String() string {
 }
 
 func (FrameBound_BoundType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{51, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{54, 0}
 }
 
 type Node_NodeType int32
@@ -652,7 +652,7 @@ func (x Node_NodeType) String() string {
 }
 
 func (Node_NodeType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{60, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{63, 0}
 }
 
 type Node_JoinType int32
@@ -704,7 +704,7 @@ func (x Node_JoinType) String() string {
 }
 
 func (Node_JoinType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{60, 1}
+	return fileDescriptor_2d655ab2f7683c23, []int{63, 1}
 }
 
 type Node_AggMode int32
@@ -732,7 +732,7 @@ func (x Node_AggMode) String() string {
 }
 
 func (Node_AggMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{60, 2}
+	return fileDescriptor_2d655ab2f7683c23, []int{63, 2}
 }
 
 type Node_FillType int32
@@ -769,7 +769,7 @@ func (x Node_FillType) String() string {
 }
 
 func (Node_FillType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{60, 3}
+	return fileDescriptor_2d655ab2f7683c23, []int{63, 3}
 }
 
 type Node_OnDuplicateAction int32
@@ -797,7 +797,7 @@ func (x Node_OnDuplicateAction) String() string {
 }
 
 func (Node_OnDuplicateAction) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{60, 4}
+	return fileDescriptor_2d655ab2f7683c23, []int{63, 4}
 }
 
 type Node_ApplyType int32
@@ -822,7 +822,7 @@ func (x Node_ApplyType) String() string {
 }
 
 func (Node_ApplyType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{60, 5}
+	return fileDescriptor_2d655ab2f7683c23, []int{63, 5}
 }
 
 type Query_StatementType int32
@@ -862,7 +862,7 @@ func (x Query_StatementType) String() string {
 }
 
 func (Query_StatementType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{74, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{82, 0}
 }
 
 type TransationControl_TclType int32
@@ -890,7 +890,7 @@ func (x TransationControl_TclType) String() string {
 }
 
 func (TransationControl_TclType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{75, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{83, 0}
 }
 
 type TransationBegin_TransationMode int32
@@ -918,7 +918,7 @@ func (x TransationBegin_TransationMode) String() string {
 }
 
 func (TransationBegin_TransationMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{76, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{84, 0}
 }
 
 type DataControl_DclType int32
@@ -967,48 +967,51 @@ func (x DataControl_DclType) String() string {
 }
 
 func (DataControl_DclType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{81, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{89, 0}
 }
 
 type DataDefinition_DdlType int32
 
 const (
-	DataDefinition_CREATE_DATABASE     DataDefinition_DdlType = 0
-	DataDefinition_ALTER_DATABASE      DataDefinition_DdlType = 1
-	DataDefinition_DROP_DATABASE       DataDefinition_DdlType = 2
-	DataDefinition_CREATE_TABLE        DataDefinition_DdlType = 3
-	DataDefinition_ALTER_TABLE         DataDefinition_DdlType = 4
-	DataDefinition_DROP_TABLE          DataDefinition_DdlType = 5
-	DataDefinition_CREATE_INDEX        DataDefinition_DdlType = 6
-	DataDefinition_ALTER_INDEX         DataDefinition_DdlType = 7
-	DataDefinition_DROP_INDEX          DataDefinition_DdlType = 8
-	DataDefinition_TRUNCATE_TABLE      DataDefinition_DdlType = 9
-	DataDefinition_CREATE_VIEW         DataDefinition_DdlType = 10
-	DataDefinition_ALTER_VIEW          DataDefinition_DdlType = 11
-	DataDefinition_DROP_VIEW           DataDefinition_DdlType = 12
-	DataDefinition_SHOW_CREATEDATABASE DataDefinition_DdlType = 13
-	DataDefinition_SHOW_CREATETABLE    DataDefinition_DdlType = 14
-	DataDefinition_SHOW_DATABASES      DataDefinition_DdlType = 15
-	DataDefinition_SHOW_TABLES         DataDefinition_DdlType = 16
-	DataDefinition_SHOW_COLUMNS        DataDefinition_DdlType = 17
-	DataDefinition_SHOW_INDEX          DataDefinition_DdlType = 18
-	DataDefinition_SHOW_VARIABLES      DataDefinition_DdlType = 19
-	DataDefinition_SHOW_WARNINGS       DataDefinition_DdlType = 20
-	DataDefinition_SHOW_ERRORS         DataDefinition_DdlType = 21
-	DataDefinition_SHOW_STATUS         DataDefinition_DdlType = 22
-	DataDefinition_SHOW_PROCESSLIST    DataDefinition_DdlType = 23
-	DataDefinition_SHOW_TABLE_STATUS   DataDefinition_DdlType = 24
-	DataDefinition_SHOW_TARGET         DataDefinition_DdlType = 25
-	DataDefinition_SHOW_COLLATION      DataDefinition_DdlType = 26
-	DataDefinition_LOCK_TABLES         DataDefinition_DdlType = 27
-	DataDefinition_UNLOCK_TABLES       DataDefinition_DdlType = 28
-	DataDefinition_CREATE_SEQUENCE     DataDefinition_DdlType = 29
-	DataDefinition_ALTER_SEQUENCE      DataDefinition_DdlType = 30
-	DataDefinition_DROP_SEQUENCE       DataDefinition_DdlType = 31
-	DataDefinition_SHOW_SEQUENCES      DataDefinition_DdlType = 32
-	DataDefinition_SHOW_CONNECTORS     DataDefinition_DdlType = 33
-	DataDefinition_SHOW_UPGRADE        DataDefinition_DdlType = 34
-	DataDefinition_RENAME_TABLE        DataDefinition_DdlType = 35
+	DataDefinition_CREATE_DATABASE           DataDefinition_DdlType = 0
+	DataDefinition_ALTER_DATABASE            DataDefinition_DdlType = 1
+	DataDefinition_DROP_DATABASE             DataDefinition_DdlType = 2
+	DataDefinition_CREATE_TABLE              DataDefinition_DdlType = 3
+	DataDefinition_ALTER_TABLE               DataDefinition_DdlType = 4
+	DataDefinition_DROP_TABLE                DataDefinition_DdlType = 5
+	DataDefinition_CREATE_INDEX              DataDefinition_DdlType = 6
+	DataDefinition_ALTER_INDEX               DataDefinition_DdlType = 7
+	DataDefinition_DROP_INDEX                DataDefinition_DdlType = 8
+	DataDefinition_TRUNCATE_TABLE            DataDefinition_DdlType = 9
+	DataDefinition_CREATE_VIEW               DataDefinition_DdlType = 10
+	DataDefinition_ALTER_VIEW                DataDefinition_DdlType = 11
+	DataDefinition_DROP_VIEW                 DataDefinition_DdlType = 12
+	DataDefinition_SHOW_CREATEDATABASE       DataDefinition_DdlType = 13
+	DataDefinition_SHOW_CREATETABLE          DataDefinition_DdlType = 14
+	DataDefinition_SHOW_DATABASES            DataDefinition_DdlType = 15
+	DataDefinition_SHOW_TABLES               DataDefinition_DdlType = 16
+	DataDefinition_SHOW_COLUMNS              DataDefinition_DdlType = 17
+	DataDefinition_SHOW_INDEX                DataDefinition_DdlType = 18
+	DataDefinition_SHOW_VARIABLES            DataDefinition_DdlType = 19
+	DataDefinition_SHOW_WARNINGS             DataDefinition_DdlType = 20
+	DataDefinition_SHOW_ERRORS               DataDefinition_DdlType = 21
+	DataDefinition_SHOW_STATUS               DataDefinition_DdlType = 22
+	DataDefinition_SHOW_PROCESSLIST          DataDefinition_DdlType = 23
+	DataDefinition_SHOW_TABLE_STATUS         DataDefinition_DdlType = 24
+	DataDefinition_SHOW_TARGET               DataDefinition_DdlType = 25
+	DataDefinition_SHOW_COLLATION            DataDefinition_DdlType = 26
+	DataDefinition_LOCK_TABLES               DataDefinition_DdlType = 27
+	DataDefinition_UNLOCK_TABLES             DataDefinition_DdlType = 28
+	DataDefinition_CREATE_SEQUENCE           DataDefinition_DdlType = 29
+	DataDefinition_ALTER_SEQUENCE            DataDefinition_DdlType = 30
+	DataDefinition_DROP_SEQUENCE             DataDefinition_DdlType = 31
+	DataDefinition_SHOW_SEQUENCES            DataDefinition_DdlType = 32
+	DataDefinition_SHOW_CONNECTORS           DataDefinition_DdlType = 33
+	DataDefinition_SHOW_UPGRADE              DataDefinition_DdlType = 34
+	DataDefinition_RENAME_TABLE              DataDefinition_DdlType = 35
+	DataDefinition_CREATE_TRIGGER            DataDefinition_DdlType = 36
+	DataDefinition_DROP_TRIGGER              DataDefinition_DdlType = 37
+	DataDefinition_REFRESH_MATERIALIZED_VIEW DataDefinition_DdlType = 38
 )
 
 var DataDefinition_DdlType_name = map[int32]string{
@@ -1048,45 +1051,51 @@ var DataDefinition_DdlType_name = map[int32]string{
 	33: "SHOW_CONNECTORS",
 	34: "SHOW_UPGRADE",
 	35: "RENAME_TABLE",
+	36: "CREATE_TRIGGER",
+	37: "DROP_TRIGGER",
+	38: "REFRESH_MATERIALIZED_VIEW",
 }
 
 var DataDefinition_DdlType_value = map[string]int32{
-	"CREATE_DATABASE":     0,
-	"ALTER_DATABASE":      1,
-	"DROP_DATABASE":       2,
-	"CREATE_TABLE":        3,
-	"ALTER_TABLE":         4,
-	"DROP_TABLE":          5,
-	"CREATE_INDEX":        6,
-	"ALTER_INDEX":         7,
-	"DROP_INDEX":          8,
-	"TRUNCATE_TABLE":      9,
-	"CREATE_VIEW":         10,
-	"ALTER_VIEW":          11,
-	"DROP_VIEW":           12,
-	"SHOW_CREATEDATABASE": 13,
-	"SHOW_CREATETABLE":    14,
-	"SHOW_DATABASES":      15,
-	"SHOW_TABLES":         16,
-	"SHOW_COLUMNS":        17,
-	"SHOW_INDEX":          18,
-	"SHOW_VARIABLES":      19,
-	"SHOW_WARNINGS":       20,
-	"SHOW_ERRORS":         21,
-	"SHOW_STATUS":         22,
-	"SHOW_PROCESSLIST":    23,
-	"SHOW_TABLE_STATUS":   24,
-	"SHOW_TARGET":         25,
-	"SHOW_COLLATION":      26,
-	"LOCK_TABLES":         27,
-	"UNLOCK_TABLES":       28,
-	"CREATE_SEQUENCE":     29,
-	"ALTER_SEQUENCE":      30,
-	"DROP_SEQUENCE":       31,
-	"SHOW_SEQUENCES":      32,
-	"SHOW_CONNECTORS":     33,
-	"SHOW_UPGRADE":        34,
-	"RENAME_TABLE":        35,
+	"CREATE_DATABASE":           0,
+	"ALTER_DATABASE":            1,
+	"DROP_DATABASE":             2,
+	"CREATE_TABLE":              3,
+	"ALTER_TABLE":               4,
+	"DROP_TABLE":                5,
+	"CREATE_INDEX":              6,
+	"ALTER_INDEX":               7,
+	"DROP_INDEX":                8,
+	"TRUNCATE_TABLE":            9,
+	"CREATE_VIEW":               10,
+	"ALTER_VIEW":                11,
+	"DROP_VIEW":                 12,
+	"SHOW_CREATEDATABASE":       13,
+	"SHOW_CREATETABLE":          14,
+	"SHOW_DATABASES":            15,
+	"SHOW_TABLES":               16,
+	"SHOW_COLUMNS":              17,
+	"SHOW_INDEX":                18,
+	"SHOW_VARIABLES":            19,
+	"SHOW_WARNINGS":             20,
+	"SHOW_ERRORS":               21,
+	"SHOW_STATUS":               22,
+	"SHOW_PROCESSLIST":          23,
+	"SHOW_TABLE_STATUS":         24,
+	"SHOW_TARGET":               25,
+	"SHOW_COLLATION":            26,
+	"LOCK_TABLES":               27,
+	"UNLOCK_TABLES":             28,
+	"CREATE_SEQUENCE":           29,
+	"ALTER_SEQUENCE":            30,
+	"DROP_SEQUENCE":             31,
+	"SHOW_SEQUENCES":            32,
+	"SHOW_CONNECTORS":           33,
+	"SHOW_UPGRADE":              34,
+	"RENAME_TABLE":              35,
+	"CREATE_TRIGGER":            36,
+	"DROP_TRIGGER":              37,
+	"REFRESH_MATERIALIZED_VIEW": 38,
 }
 
 func (x DataDefinition_DdlType) String() string {
@@ -1094,7 +1103,7 @@ func (x DataDefinition_DdlType) String() string {
 }
 
 func (DataDefinition_DdlType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{82, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{90, 0}
 }
 
 type AlterTableDrop_Typ int32
@@ -1105,6 +1114,7 @@ const (
 	AlterTableDrop_KEY         AlterTableDrop_Typ = 2
 	AlterTableDrop_PRIMARY_KEY AlterTableDrop_Typ = 3
 	AlterTableDrop_FOREIGN_KEY AlterTableDrop_Typ = 4
+	AlterTableDrop_CHECK       AlterTableDrop_Typ = 5
 )
 
 var AlterTableDrop_Typ_name = map[int32]string{
@@ -1113,6 +1123,7 @@ var AlterTableDrop_Typ_name = map[int32]string{
 	2: "KEY",
 	3: "PRIMARY_KEY",
 	4: "FOREIGN_KEY",
+	5: "CHECK",
 }
 
 var AlterTableDrop_Typ_value = map[string]int32{
@@ -1121,6 +1132,7 @@ var AlterTableDrop_Typ_value = map[string]int32{
 	"KEY":         2,
 	"PRIMARY_KEY": 3,
 	"FOREIGN_KEY": 4,
+	"CHECK":       5,
 }
 
 func (x AlterTableDrop_Typ) String() string {
@@ -1128,7 +1140,7 @@ func (x AlterTableDrop_Typ) String() string {
 }
 
 func (AlterTableDrop_Typ) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{90, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{99, 0}
 }
 
 type AlterTable_AlgorithmType int32
@@ -1159,7 +1171,7 @@ func (x AlterTable_AlgorithmType) String() string {
 }
 
 func (AlterTable_AlgorithmType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{102, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{112, 0}
 }
 
 type MetadataScanInfo_MetadataScanInfoType int32
@@ -1217,7 +1229,7 @@ func (x MetadataScanInfo_MetadataScanInfoType) String() string {
 }
 
 func (MetadataScanInfo_MetadataScanInfoType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{125, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{138, 0}
 }
 
 type Type struct {
@@ -3233,10 +3245,12 @@ type ColDef struct {
 	TblName   string `protobuf:"bytes,17,opt,name=tbl_name,json=tblName,proto3" json:"tbl_name,omitempty"`
 	DbName    string `protobuf:"bytes,18,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	// get origin_name by ColDef.GetOriginCaseName(), letter case: origin
-	OriginName           string   `protobuf:"bytes,19,opt,name=origin_name,json=originName,proto3" json:"origin_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	OriginName string `protobuf:"bytes,19,opt,name=origin_name,json=originName,proto3" json:"origin_name,omitempty"`
+	// not nil for a generated column
+	Generated            *GeneratedCol `protobuf:"bytes,20,opt,name=generated,proto3" json:"generated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *ColDef) Reset()         { *m = ColDef{} }
@@ -3405,6 +3419,88 @@ func (m *ColDef) GetOriginName() string {
 	return ""
 }
 
+func (m *ColDef) GetGenerated() *GeneratedCol {
+	if m != nil {
+		return m.Generated
+	}
+	return nil
+}
+
+type GeneratedCol struct {
+	Expr         *Expr  `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
+	OriginString string `protobuf:"bytes,2,opt,name=origin_string,json=originString,proto3" json:"origin_string,omitempty"`
+	// stored generated column is computed when the row is written,
+	// virtual generated column is computed when the row is read.
+	Stored bool `protobuf:"varint,3,opt,name=stored,proto3" json:"stored,omitempty"`
+	// name of the generated column, it is only set when the generated
+	// columns are saved as a table constraint.
+	ColName              string   `protobuf:"bytes,4,opt,name=col_name,json=colName,proto3" json:"col_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GeneratedCol) Reset()         { *m = GeneratedCol{} }
+func (m *GeneratedCol) String() string { return proto.CompactTextString(m) }
+func (*GeneratedCol) ProtoMessage()    {}
+func (*GeneratedCol) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{24}
+}
+func (m *GeneratedCol) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GeneratedCol) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GeneratedCol.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GeneratedCol) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GeneratedCol.Merge(m, src)
+}
+func (m *GeneratedCol) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *GeneratedCol) XXX_DiscardUnknown() {
+	xxx_messageInfo_GeneratedCol.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GeneratedCol proto.InternalMessageInfo
+
+func (m *GeneratedCol) GetExpr() *Expr {
+	if m != nil {
+		return m.Expr
+	}
+	return nil
+}
+
+func (m *GeneratedCol) GetOriginString() string {
+	if m != nil {
+		return m.OriginString
+	}
+	return ""
+}
+
+func (m *GeneratedCol) GetStored() bool {
+	if m != nil {
+		return m.Stored
+	}
+	return false
+}
+
+func (m *GeneratedCol) GetColName() string {
+	if m != nil {
+		return m.ColName
+	}
+	return ""
+}
+
 type Default struct {
 	Expr         *Expr  `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
 	OriginString string `protobuf:"bytes,2,opt,name=origin_string,json=originString,proto3" json:"origin_string,omitempty"`
@@ -3419,7 +3515,7 @@ func (m *Default) Reset()         { *m = Default{} }
 func (m *Default) String() string { return proto.CompactTextString(m) }
 func (*Default) ProtoMessage()    {}
 func (*Default) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{24}
+	return fileDescriptor_2d655ab2f7683c23, []int{25}
 }
 func (m *Default) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3481,7 +3577,7 @@ func (m *OnUpdate) Reset()         { *m = OnUpdate{} }
 func (m *OnUpdate) String() string { return proto.CompactTextString(m) }
 func (*OnUpdate) ProtoMessage()    {}
 func (*OnUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{25}
+	return fileDescriptor_2d655ab2f7683c23, []int{26}
 }
 func (m *OnUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3537,7 +3633,7 @@ func (m *IndexOption) Reset()         { *m = IndexOption{} }
 func (m *IndexOption) String() string { return proto.CompactTextString(m) }
 func (*IndexOption) ProtoMessage()    {}
 func (*IndexOption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{26}
+	return fileDescriptor_2d655ab2f7683c23, []int{27}
 }
 func (m *IndexOption) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3609,7 +3705,7 @@ func (m *PrimaryKeyDef) Reset()         { *m = PrimaryKeyDef{} }
 func (m *PrimaryKeyDef) String() string { return proto.CompactTextString(m) }
 func (*PrimaryKeyDef) ProtoMessage()    {}
 func (*PrimaryKeyDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{27}
+	return fileDescriptor_2d655ab2f7683c23, []int{28}
 }
 func (m *PrimaryKeyDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3705,7 +3801,7 @@ func (m *IndexDef) Reset()         { *m = IndexDef{} }
 func (m *IndexDef) String() string { return proto.CompactTextString(m) }
 func (*IndexDef) ProtoMessage()    {}
 func (*IndexDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{28}
+	return fileDescriptor_2d655ab2f7683c23, []int{29}
 }
 func (m *IndexDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3837,7 +3933,7 @@ func (m *ForeignKeyDef) Reset()         { *m = ForeignKeyDef{} }
 func (m *ForeignKeyDef) String() string { return proto.CompactTextString(m) }
 func (*ForeignKeyDef) ProtoMessage()    {}
 func (*ForeignKeyDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{29}
+	return fileDescriptor_2d655ab2f7683c23, []int{30}
 }
 func (m *ForeignKeyDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3909,9 +4005,13 @@ func (m *ForeignKeyDef) GetOnUpdate() ForeignKeyDef_RefAction {
 }
 
 type CheckDef struct {
+	// Name for anonymous constraints is [TABLE_NAME]_chk_[N]
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Name for anonymous constraints, __mo_chk_[INDEX_ID]
-	Check                *Expr    `protobuf:"bytes,2,opt,name=check,proto3" json:"check,omitempty"`
+	// check is bound against the table columns, ColRef.Name holds the column name
+	Check *Expr `protobuf:"bytes,2,opt,name=check,proto3" json:"check,omitempty"`
+	// expr_str is the original expression text, used by SHOW CREATE TABLE
+	ExprStr              string   `protobuf:"bytes,3,opt,name=expr_str,json=exprStr,proto3" json:"expr_str,omitempty"`
+	Enforced             bool     `protobuf:"varint,4,opt,name=enforced,proto3" json:"enforced,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3921,7 +4021,7 @@ func (m *CheckDef) Reset()         { *m = CheckDef{} }
 func (m *CheckDef) String() string { return proto.CompactTextString(m) }
 func (*CheckDef) ProtoMessage()    {}
 func (*CheckDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{30}
+	return fileDescriptor_2d655ab2f7683c23, []int{31}
 }
 func (m *CheckDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3964,6 +4064,194 @@ func (m *CheckDef) GetCheck() *Expr {
 	return nil
 }
 
+func (m *CheckDef) GetExprStr() string {
+	if m != nil {
+		return m.ExprStr
+	}
+	return ""
+}
+
+func (m *CheckDef) GetEnforced() bool {
+	if m != nil {
+		return m.Enforced
+	}
+	return false
+}
+
+type TriggerDef struct {
+	// letter case: lower
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// timing is BEFORE or AFTER, event is INSERT, UPDATE or DELETE
+	Timing string `protobuf:"bytes,2,opt,name=timing,proto3" json:"timing,omitempty"`
+	Event  string `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	// create_sql is the formatted CREATE TRIGGER statement, the body is bound when the DML is planned
+	CreateSql            string   `protobuf:"bytes,4,opt,name=create_sql,json=createSql,proto3" json:"create_sql,omitempty"`
+	Definer              string   `protobuf:"bytes,5,opt,name=definer,proto3" json:"definer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TriggerDef) Reset()         { *m = TriggerDef{} }
+func (m *TriggerDef) String() string { return proto.CompactTextString(m) }
+func (*TriggerDef) ProtoMessage()    {}
+func (*TriggerDef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{32}
+}
+func (m *TriggerDef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TriggerDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TriggerDef.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TriggerDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerDef.Merge(m, src)
+}
+func (m *TriggerDef) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *TriggerDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerDef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerDef proto.InternalMessageInfo
+
+func (m *TriggerDef) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TriggerDef) GetTiming() string {
+	if m != nil {
+		return m.Timing
+	}
+	return ""
+}
+
+func (m *TriggerDef) GetEvent() string {
+	if m != nil {
+		return m.Event
+	}
+	return ""
+}
+
+func (m *TriggerDef) GetCreateSql() string {
+	if m != nil {
+		return m.CreateSql
+	}
+	return ""
+}
+
+func (m *TriggerDef) GetDefiner() string {
+	if m != nil {
+		return m.Definer
+	}
+	return ""
+}
+
+// MaterializedViewDef is the definition of a materialized view. The view is kept up to date by the
+// DML on its base table, the rows of the view with the changed keys are recomputed from the query.
+type MaterializedViewDef struct {
+	Database string `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// query is the SELECT of the view with the table names qualified by the database
+	Query string `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	// key_cols are the columns of the base table which identify the rows of the view,
+	// view_key_cols are the columns of the view with the same values
+	KeyCols              []string     `protobuf:"bytes,4,rep,name=key_cols,json=keyCols,proto3" json:"key_cols,omitempty"`
+	ViewKeyCols          []string     `protobuf:"bytes,5,rep,name=view_key_cols,json=viewKeyCols,proto3" json:"view_key_cols,omitempty"`
+	BaseTables           []*ObjectRef `protobuf:"bytes,6,rep,name=base_tables,json=baseTables,proto3" json:"base_tables,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *MaterializedViewDef) Reset()         { *m = MaterializedViewDef{} }
+func (m *MaterializedViewDef) String() string { return proto.CompactTextString(m) }
+func (*MaterializedViewDef) ProtoMessage()    {}
+func (*MaterializedViewDef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{33}
+}
+func (m *MaterializedViewDef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaterializedViewDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaterializedViewDef.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MaterializedViewDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaterializedViewDef.Merge(m, src)
+}
+func (m *MaterializedViewDef) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *MaterializedViewDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaterializedViewDef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaterializedViewDef proto.InternalMessageInfo
+
+func (m *MaterializedViewDef) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+func (m *MaterializedViewDef) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MaterializedViewDef) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *MaterializedViewDef) GetKeyCols() []string {
+	if m != nil {
+		return m.KeyCols
+	}
+	return nil
+}
+
+func (m *MaterializedViewDef) GetViewKeyCols() []string {
+	if m != nil {
+		return m.ViewKeyCols
+	}
+	return nil
+}
+
+func (m *MaterializedViewDef) GetBaseTables() []*ObjectRef {
+	if m != nil {
+		return m.BaseTables
+	}
+	return nil
+}
+
 type ClusterByDef struct {
 	// XXX: Deprecated and to be removed soon. letter case: lower ?
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -3978,7 +4266,7 @@ func (m *ClusterByDef) Reset()         { *m = ClusterByDef{} }
 func (m *ClusterByDef) String() string { return proto.CompactTextString(m) }
 func (*ClusterByDef) ProtoMessage()    {}
 func (*ClusterByDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{31}
+	return fileDescriptor_2d655ab2f7683c23, []int{34}
 }
 func (m *ClusterByDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4033,7 +4321,7 @@ func (m *PropertyDef) Reset()         { *m = PropertyDef{} }
 func (m *PropertyDef) String() string { return proto.CompactTextString(m) }
 func (*PropertyDef) ProtoMessage()    {}
 func (*PropertyDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{32}
+	return fileDescriptor_2d655ab2f7683c23, []int{35}
 }
 func (m *PropertyDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4088,7 +4376,7 @@ func (m *Property) Reset()         { *m = Property{} }
 func (m *Property) String() string { return proto.CompactTextString(m) }
 func (*Property) ProtoMessage()    {}
 func (*Property) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{33}
+	return fileDescriptor_2d655ab2f7683c23, []int{36}
 }
 func (m *Property) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4142,7 +4430,7 @@ func (m *PropertiesDef) Reset()         { *m = PropertiesDef{} }
 func (m *PropertiesDef) String() string { return proto.CompactTextString(m) }
 func (*PropertiesDef) ProtoMessage()    {}
 func (*PropertiesDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{34}
+	return fileDescriptor_2d655ab2f7683c23, []int{37}
 }
 func (m *PropertiesDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4198,7 +4486,7 @@ func (m *PartitionByDef) Reset()         { *m = PartitionByDef{} }
 func (m *PartitionByDef) String() string { return proto.CompactTextString(m) }
 func (*PartitionByDef) ProtoMessage()    {}
 func (*PartitionByDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{35}
+	return fileDescriptor_2d655ab2f7683c23, []int{38}
 }
 func (m *PartitionByDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4309,7 +4597,7 @@ func (m *PartitionExpr) Reset()         { *m = PartitionExpr{} }
 func (m *PartitionExpr) String() string { return proto.CompactTextString(m) }
 func (*PartitionExpr) ProtoMessage()    {}
 func (*PartitionExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{36}
+	return fileDescriptor_2d655ab2f7683c23, []int{39}
 }
 func (m *PartitionExpr) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4365,7 +4653,7 @@ func (m *PartitionColumns) Reset()         { *m = PartitionColumns{} }
 func (m *PartitionColumns) String() string { return proto.CompactTextString(m) }
 func (*PartitionColumns) ProtoMessage()    {}
 func (*PartitionColumns) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{37}
+	return fileDescriptor_2d655ab2f7683c23, []int{40}
 }
 func (m *PartitionColumns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4425,7 +4713,7 @@ func (m *PartitionItem) Reset()         { *m = PartitionItem{} }
 func (m *PartitionItem) String() string { return proto.CompactTextString(m) }
 func (*PartitionItem) ProtoMessage()    {}
 func (*PartitionItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{38}
+	return fileDescriptor_2d655ab2f7683c23, []int{41}
 }
 func (m *PartitionItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4514,7 +4802,7 @@ func (m *ViewDef) Reset()         { *m = ViewDef{} }
 func (m *ViewDef) String() string { return proto.CompactTextString(m) }
 func (*ViewDef) ProtoMessage()    {}
 func (*ViewDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{39}
+	return fileDescriptor_2d655ab2f7683c23, []int{42}
 }
 func (m *ViewDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4564,10 +4852,15 @@ type TableDef struct {
 	Fkeys        []*ForeignKeyDef `protobuf:"bytes,13,rep,name=fkeys,proto3" json:"fkeys,omitempty"`
 	RefChildTbls []uint64         `protobuf:"varint,14,rep,packed,name=ref_child_tbls,json=refChildTbls,proto3" json:"ref_child_tbls,omitempty"`
 	Checks       []*CheckDef      `protobuf:"bytes,15,rep,name=checks,proto3" json:"checks,omitempty"`
-	Partition    *PartitionByDef  `protobuf:"bytes,21,opt,name=partition,proto3" json:"partition,omitempty"`
-	ClusterBy    *ClusterByDef    `protobuf:"bytes,22,opt,name=cluster_by,json=clusterBy,proto3" json:"cluster_by,omitempty"`
-	Props        []*PropertyDef   `protobuf:"bytes,23,rep,name=props,proto3" json:"props,omitempty"`
-	ViewSql      *ViewDef         `protobuf:"bytes,24,opt,name=view_sql,json=viewSql,proto3" json:"view_sql,omitempty"`
+	// triggers of the same timing and event are fired in the order of the list
+	Triggers []*TriggerDef `protobuf:"bytes,16,rep,name=triggers,proto3" json:"triggers,omitempty"`
+	// mview is the definition of the materialized view, ref_mviews are the materialized views on the table
+	Mview     *MaterializedViewDef   `protobuf:"bytes,17,opt,name=mview,proto3" json:"mview,omitempty"`
+	RefMviews []*MaterializedViewDef `protobuf:"bytes,18,rep,name=ref_mviews,json=refMviews,proto3" json:"ref_mviews,omitempty"`
+	Partition *PartitionByDef        `protobuf:"bytes,21,opt,name=partition,proto3" json:"partition,omitempty"`
+	ClusterBy *ClusterByDef          `protobuf:"bytes,22,opt,name=cluster_by,json=clusterBy,proto3" json:"cluster_by,omitempty"`
+	Props     []*PropertyDef         `protobuf:"bytes,23,rep,name=props,proto3" json:"props,omitempty"`
+	ViewSql   *ViewDef               `protobuf:"bytes,24,opt,name=view_sql,json=viewSql,proto3" json:"view_sql,omitempty"`
 	// XXX: Deprecated and to be removed soon.
 	Defs []*TableDef_DefType `protobuf:"bytes,25,rep,name=defs,proto3" json:"defs,omitempty"`
 	// letter case: lower
@@ -4588,7 +4881,7 @@ func (m *TableDef) Reset()         { *m = TableDef{} }
 func (m *TableDef) String() string { return proto.CompactTextString(m) }
 func (*TableDef) ProtoMessage()    {}
 func (*TableDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{40}
+	return fileDescriptor_2d655ab2f7683c23, []int{43}
 }
 func (m *TableDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4708,6 +5001,27 @@ func (m *TableDef) GetChecks() []*CheckDef {
 	return nil
 }
 
+func (m *TableDef) GetTriggers() []*TriggerDef {
+	if m != nil {
+		return m.Triggers
+	}
+	return nil
+}
+
+func (m *TableDef) GetMview() *MaterializedViewDef {
+	if m != nil {
+		return m.Mview
+	}
+	return nil
+}
+
+func (m *TableDef) GetRefMviews() []*MaterializedViewDef {
+	if m != nil {
+		return m.RefMviews
+	}
+	return nil
+}
+
 func (m *TableDef) GetPartition() *PartitionByDef {
 	if m != nil {
 		return m.Partition
@@ -4814,7 +5128,7 @@ func (m *TableDef_DefType) Reset()         { *m = TableDef_DefType{} }
 func (m *TableDef_DefType) String() string { return proto.CompactTextString(m) }
 func (*TableDef_DefType) ProtoMessage()    {}
 func (*TableDef_DefType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{40, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{43, 0}
 }
 func (m *TableDef_DefType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4888,7 +5202,7 @@ func (m *TableFunction) Reset()         { *m = TableFunction{} }
 func (m *TableFunction) String() string { return proto.CompactTextString(m) }
 func (*TableFunction) ProtoMessage()    {}
 func (*TableFunction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{41}
+	return fileDescriptor_2d655ab2f7683c23, []int{44}
 }
 func (m *TableFunction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4957,7 +5271,7 @@ func (m *HashMapStats) Reset()         { *m = HashMapStats{} }
 func (m *HashMapStats) String() string { return proto.CompactTextString(m) }
 func (*HashMapStats) ProtoMessage()    {}
 func (*HashMapStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{42}
+	return fileDescriptor_2d655ab2f7683c23, []int{45}
 }
 func (m *HashMapStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5088,7 +5402,7 @@ func (m *Stats) Reset()         { *m = Stats{} }
 func (m *Stats) String() string { return proto.CompactTextString(m) }
 func (*Stats) ProtoMessage()    {}
 func (*Stats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{43}
+	return fileDescriptor_2d655ab2f7683c23, []int{46}
 }
 func (m *Stats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5185,7 +5499,7 @@ func (m *RowsetExpr) Reset()         { *m = RowsetExpr{} }
 func (m *RowsetExpr) String() string { return proto.CompactTextString(m) }
 func (*RowsetExpr) ProtoMessage()    {}
 func (*RowsetExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{44}
+	return fileDescriptor_2d655ab2f7683c23, []int{47}
 }
 func (m *RowsetExpr) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5239,7 +5553,7 @@ func (m *ColData) Reset()         { *m = ColData{} }
 func (m *ColData) String() string { return proto.CompactTextString(m) }
 func (*ColData) ProtoMessage()    {}
 func (*ColData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{45}
+	return fileDescriptor_2d655ab2f7683c23, []int{48}
 }
 func (m *ColData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5287,7 +5601,7 @@ func (m *RowsetData) Reset()         { *m = RowsetData{} }
 func (m *RowsetData) String() string { return proto.CompactTextString(m) }
 func (*RowsetData) ProtoMessage()    {}
 func (*RowsetData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{46}
+	return fileDescriptor_2d655ab2f7683c23, []int{49}
 }
 func (m *RowsetData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5343,7 +5657,7 @@ func (m *OrderBySpec) Reset()         { *m = OrderBySpec{} }
 func (m *OrderBySpec) String() string { return proto.CompactTextString(m) }
 func (*OrderBySpec) ProtoMessage()    {}
 func (*OrderBySpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{47}
+	return fileDescriptor_2d655ab2f7683c23, []int{50}
 }
 func (m *OrderBySpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5394,21 +5708,24 @@ func (m *OrderBySpec) GetFlag() OrderBySpec_OrderByFlag {
 }
 
 type WindowSpec struct {
-	WindowFunc           *Expr          `protobuf:"bytes,1,opt,name=window_func,json=windowFunc,proto3" json:"window_func,omitempty"`
-	PartitionBy          []*Expr        `protobuf:"bytes,2,rep,name=partition_by,json=partitionBy,proto3" json:"partition_by,omitempty"`
-	OrderBy              []*OrderBySpec `protobuf:"bytes,3,rep,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
-	Frame                *FrameClause   `protobuf:"bytes,4,opt,name=frame,proto3" json:"frame,omitempty"`
-	Name                 string         `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	WindowFunc  *Expr          `protobuf:"bytes,1,opt,name=window_func,json=windowFunc,proto3" json:"window_func,omitempty"`
+	PartitionBy []*Expr        `protobuf:"bytes,2,rep,name=partition_by,json=partitionBy,proto3" json:"partition_by,omitempty"`
+	OrderBy     []*OrderBySpec `protobuf:"bytes,3,rep,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Frame       *FrameClause   `protobuf:"bytes,4,opt,name=frame,proto3" json:"frame,omitempty"`
+	Name        string         `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	// modifiers of the value window functions, e.g. NTH_VALUE(a, 2) FROM LAST IGNORE NULLS.
+	IgnoreNulls          bool     `protobuf:"varint,6,opt,name=ignore_nulls,json=ignoreNulls,proto3" json:"ignore_nulls,omitempty"`
+	FromLast             bool     `protobuf:"varint,7,opt,name=from_last,json=fromLast,proto3" json:"from_last,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *WindowSpec) Reset()         { *m = WindowSpec{} }
 func (m *WindowSpec) String() string { return proto.CompactTextString(m) }
 func (*WindowSpec) ProtoMessage()    {}
 func (*WindowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{48}
+	return fileDescriptor_2d655ab2f7683c23, []int{51}
 }
 func (m *WindowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5472,6 +5789,20 @@ func (m *WindowSpec) GetName() string {
 	return ""
 }
 
+func (m *WindowSpec) GetIgnoreNulls() bool {
+	if m != nil {
+		return m.IgnoreNulls
+	}
+	return false
+}
+
+func (m *WindowSpec) GetFromLast() bool {
+	if m != nil {
+		return m.FromLast
+	}
+	return false
+}
+
 type SampleFuncSpec struct {
 	Rows                 int32    `protobuf:"varint,1,opt,name=Rows,proto3" json:"Rows,omitempty"`
 	Percent              float64  `protobuf:"fixed64,2,opt,name=Percent,proto3" json:"Percent,omitempty"`
@@ -5485,7 +5816,7 @@ func (m *SampleFuncSpec) Reset()         { *m = SampleFuncSpec{} }
 func (m *SampleFuncSpec) String() string { return proto.CompactTextString(m) }
 func (*SampleFuncSpec) ProtoMessage()    {}
 func (*SampleFuncSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{49}
+	return fileDescriptor_2d655ab2f7683c23, []int{52}
 }
 func (m *SampleFuncSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5548,7 +5879,7 @@ func (m *FrameClause) Reset()         { *m = FrameClause{} }
 func (m *FrameClause) String() string { return proto.CompactTextString(m) }
 func (*FrameClause) ProtoMessage()    {}
 func (*FrameClause) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{50}
+	return fileDescriptor_2d655ab2f7683c23, []int{53}
 }
 func (m *FrameClause) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5611,7 +5942,7 @@ func (m *FrameBound) Reset()         { *m = FrameBound{} }
 func (m *FrameBound) String() string { return proto.CompactTextString(m) }
 func (*FrameBound) ProtoMessage()    {}
 func (*FrameBound) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{51}
+	return fileDescriptor_2d655ab2f7683c23, []int{54}
 }
 func (m *FrameBound) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5682,7 +6013,7 @@ func (m *OnDuplicateKeyCtx) Reset()         { *m = OnDuplicateKeyCtx{} }
 func (m *OnDuplicateKeyCtx) String() string { return proto.CompactTextString(m) }
 func (*OnDuplicateKeyCtx) ProtoMessage()    {}
 func (*OnDuplicateKeyCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{52}
+	return fileDescriptor_2d655ab2f7683c23, []int{55}
 }
 func (m *OnDuplicateKeyCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5794,7 +6125,7 @@ func (m *DedupJoinCtx) Reset()         { *m = DedupJoinCtx{} }
 func (m *DedupJoinCtx) String() string { return proto.CompactTextString(m) }
 func (*DedupJoinCtx) ProtoMessage()    {}
 func (*DedupJoinCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{53}
+	return fileDescriptor_2d655ab2f7683c23, []int{56}
 }
 func (m *DedupJoinCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5845,24 +6176,27 @@ func (m *DedupJoinCtx) GetUpdateColExprList() []*Expr {
 }
 
 type UpdateCtx struct {
-	ObjRef               *ObjectRef `protobuf:"bytes,1,opt,name=obj_ref,json=objRef,proto3" json:"obj_ref,omitempty"`
-	TableDef             *TableDef  `protobuf:"bytes,2,opt,name=table_def,json=tableDef,proto3" json:"table_def,omitempty"`
-	PartitionTableIds    []uint64   `protobuf:"varint,3,rep,packed,name=partition_table_ids,json=partitionTableIds,proto3" json:"partition_table_ids,omitempty"`
-	PartitionTableNames  []string   `protobuf:"bytes,4,rep,name=partition_table_names,json=partitionTableNames,proto3" json:"partition_table_names,omitempty"`
-	OldPartitionIdx      int32      `protobuf:"varint,5,opt,name=old_partition_idx,json=oldPartitionIdx,proto3" json:"old_partition_idx,omitempty"`
-	NewPartitionIdx      int32      `protobuf:"varint,6,opt,name=new_partition_idx,json=newPartitionIdx,proto3" json:"new_partition_idx,omitempty"`
-	InsertCols           []ColRef   `protobuf:"bytes,7,rep,name=insert_cols,json=insertCols,proto3" json:"insert_cols"`
-	DeleteCols           []ColRef   `protobuf:"bytes,8,rep,name=delete_cols,json=deleteCols,proto3" json:"delete_cols"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	ObjRef              *ObjectRef `protobuf:"bytes,1,opt,name=obj_ref,json=objRef,proto3" json:"obj_ref,omitempty"`
+	TableDef            *TableDef  `protobuf:"bytes,2,opt,name=table_def,json=tableDef,proto3" json:"table_def,omitempty"`
+	PartitionTableIds   []uint64   `protobuf:"varint,3,rep,packed,name=partition_table_ids,json=partitionTableIds,proto3" json:"partition_table_ids,omitempty"`
+	PartitionTableNames []string   `protobuf:"bytes,4,rep,name=partition_table_names,json=partitionTableNames,proto3" json:"partition_table_names,omitempty"`
+	OldPartitionIdx     int32      `protobuf:"varint,5,opt,name=old_partition_idx,json=oldPartitionIdx,proto3" json:"old_partition_idx,omitempty"`
+	NewPartitionIdx     int32      `protobuf:"varint,6,opt,name=new_partition_idx,json=newPartitionIdx,proto3" json:"new_partition_idx,omitempty"`
+	InsertCols          []ColRef   `protobuf:"bytes,7,rep,name=insert_cols,json=insertCols,proto3" json:"insert_cols"`
+	DeleteCols          []ColRef   `protobuf:"bytes,8,rep,name=delete_cols,json=deleteCols,proto3" json:"delete_cols"`
+	// bool column, the rows whose value is false are not inserted. Used by merge
+	// in which the deleted rows are not inserted again.
+	InsertFilterCol      *ColRef  `protobuf:"bytes,9,opt,name=insert_filter_col,json=insertFilterCol,proto3" json:"insert_filter_col,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *UpdateCtx) Reset()         { *m = UpdateCtx{} }
 func (m *UpdateCtx) String() string { return proto.CompactTextString(m) }
 func (*UpdateCtx) ProtoMessage()    {}
 func (*UpdateCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{54}
+	return fileDescriptor_2d655ab2f7683c23, []int{57}
 }
 func (m *UpdateCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5947,6 +6281,13 @@ func (m *UpdateCtx) GetDeleteCols() []ColRef {
 	return nil
 }
 
+func (m *UpdateCtx) GetInsertFilterCol() *ColRef {
+	if m != nil {
+		return m.InsertFilterCol
+	}
+	return nil
+}
+
 type InsertCtx struct {
 	Ref             *ObjectRef `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
 	AddAffectedRows bool       `protobuf:"varint,2,opt,name=add_affected_rows,json=addAffectedRows,proto3" json:"add_affected_rows,omitempty"`
@@ -5965,7 +6306,7 @@ func (m *InsertCtx) Reset()         { *m = InsertCtx{} }
 func (m *InsertCtx) String() string { return proto.CompactTextString(m) }
 func (*InsertCtx) ProtoMessage()    {}
 func (*InsertCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{55}
+	return fileDescriptor_2d655ab2f7683c23, []int{58}
 }
 func (m *InsertCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6063,7 +6404,7 @@ func (m *ReplaceCtx) Reset()         { *m = ReplaceCtx{} }
 func (m *ReplaceCtx) String() string { return proto.CompactTextString(m) }
 func (*ReplaceCtx) ProtoMessage()    {}
 func (*ReplaceCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{56}
+	return fileDescriptor_2d655ab2f7683c23, []int{59}
 }
 func (m *ReplaceCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6199,7 +6540,7 @@ func (m *AnalyzeInfo) Reset()         { *m = AnalyzeInfo{} }
 func (m *AnalyzeInfo) String() string { return proto.CompactTextString(m) }
 func (*AnalyzeInfo) ProtoMessage()    {}
 func (*AnalyzeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{57}
+	return fileDescriptor_2d655ab2f7683c23, []int{60}
 }
 func (m *AnalyzeInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6464,7 +6805,7 @@ func (m *PartitionPrune) Reset()         { *m = PartitionPrune{} }
 func (m *PartitionPrune) String() string { return proto.CompactTextString(m) }
 func (*PartitionPrune) ProtoMessage()    {}
 func (*PartitionPrune) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{58}
+	return fileDescriptor_2d655ab2f7683c23, []int{61}
 }
 func (m *PartitionPrune) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6519,7 +6860,7 @@ func (m *OriginTableMessageForFuzzy) Reset()         { *m = OriginTableMessageFo
 func (m *OriginTableMessageForFuzzy) String() string { return proto.CompactTextString(m) }
 func (*OriginTableMessageForFuzzy) ProtoMessage()    {}
 func (*OriginTableMessageForFuzzy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{59}
+	return fileDescriptor_2d655ab2f7683c23, []int{62}
 }
 func (m *OriginTableMessageForFuzzy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6651,7 +6992,7 @@ func (m *Node) Reset()         { *m = Node{} }
 func (m *Node) String() string { return proto.CompactTextString(m) }
 func (*Node) ProtoMessage()    {}
 func (*Node) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{60}
+	return fileDescriptor_2d655ab2f7683c23, []int{63}
 }
 func (m *Node) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7164,7 +7505,7 @@ func (m *Snapshot) Reset()         { *m = Snapshot{} }
 func (m *Snapshot) String() string { return proto.CompactTextString(m) }
 func (*Snapshot) ProtoMessage()    {}
 func (*Snapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{61}
+	return fileDescriptor_2d655ab2f7683c23, []int{64}
 }
 func (m *Snapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7220,7 +7561,7 @@ func (m *SnapshotTenant) Reset()         { *m = SnapshotTenant{} }
 func (m *SnapshotTenant) String() string { return proto.CompactTextString(m) }
 func (*SnapshotTenant) ProtoMessage()    {}
 func (*SnapshotTenant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{62}
+	return fileDescriptor_2d655ab2f7683c23, []int{65}
 }
 func (m *SnapshotTenant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7283,7 +7624,7 @@ func (m *ExternScan) Reset()         { *m = ExternScan{} }
 func (m *ExternScan) String() string { return proto.CompactTextString(m) }
 func (*ExternScan) ProtoMessage()    {}
 func (*ExternScan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{63}
+	return fileDescriptor_2d655ab2f7683c23, []int{66}
 }
 func (m *ExternScan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7383,30 +7724,39 @@ func (m *ExternScan) GetTbColToDataCol() map[string]int32 {
 }
 
 type LockTarget struct {
-	TableId              uint64        `protobuf:"varint,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
-	PrimaryColIdxInBat   int32         `protobuf:"varint,2,opt,name=primary_col_idx_in_bat,json=primaryColIdxInBat,proto3" json:"primary_col_idx_in_bat,omitempty"`
-	PrimaryColTyp        Type          `protobuf:"bytes,3,opt,name=primary_col_typ,json=primaryColTyp,proto3" json:"primary_col_typ"`
-	RefreshTsIdxInBat    int32         `protobuf:"varint,4,opt,name=refresh_ts_idx_in_bat,json=refreshTsIdxInBat,proto3" json:"refresh_ts_idx_in_bat,omitempty"`
-	FilterColIdxInBat    int32         `protobuf:"varint,5,opt,name=filter_col_idx_in_bat,json=filterColIdxInBat,proto3" json:"filter_col_idx_in_bat,omitempty"`
-	LockTable            bool          `protobuf:"varint,6,opt,name=lock_table,json=lockTable,proto3" json:"lock_table,omitempty"`
-	IsPartitionTable     bool          `protobuf:"varint,7,opt,name=is_partition_table,json=isPartitionTable,proto3" json:"is_partition_table,omitempty"`
-	PartitionTableIds    []uint64      `protobuf:"varint,8,rep,packed,name=partition_table_ids,json=partitionTableIds,proto3" json:"partition_table_ids,omitempty"`
-	Block                bool          `protobuf:"varint,9,opt,name=block,proto3" json:"block,omitempty"`
-	Mode                 lock.LockMode `protobuf:"varint,10,opt,name=Mode,proto3,enum=lock.LockMode" json:"Mode,omitempty"`
-	PrimaryColRelPos     int32         `protobuf:"varint,11,opt,name=primary_col_rel_pos,json=primaryColRelPos,proto3" json:"primary_col_rel_pos,omitempty"`
-	FilterColRelPos      int32         `protobuf:"varint,12,opt,name=filter_col_rel_pos,json=filterColRelPos,proto3" json:"filter_col_rel_pos,omitempty"`
-	LockRows             *Expr         `protobuf:"bytes,13,opt,name=lock_rows,json=lockRows,proto3" json:"lock_rows,omitempty"`
-	LockTableAtTheEnd    bool          `protobuf:"varint,14,opt,name=lock_table_at_the_end,json=lockTableAtTheEnd,proto3" json:"lock_table_at_the_end,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	TableId            uint64        `protobuf:"varint,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	PrimaryColIdxInBat int32         `protobuf:"varint,2,opt,name=primary_col_idx_in_bat,json=primaryColIdxInBat,proto3" json:"primary_col_idx_in_bat,omitempty"`
+	PrimaryColTyp      Type          `protobuf:"bytes,3,opt,name=primary_col_typ,json=primaryColTyp,proto3" json:"primary_col_typ"`
+	RefreshTsIdxInBat  int32         `protobuf:"varint,4,opt,name=refresh_ts_idx_in_bat,json=refreshTsIdxInBat,proto3" json:"refresh_ts_idx_in_bat,omitempty"`
+	FilterColIdxInBat  int32         `protobuf:"varint,5,opt,name=filter_col_idx_in_bat,json=filterColIdxInBat,proto3" json:"filter_col_idx_in_bat,omitempty"`
+	LockTable          bool          `protobuf:"varint,6,opt,name=lock_table,json=lockTable,proto3" json:"lock_table,omitempty"`
+	IsPartitionTable   bool          `protobuf:"varint,7,opt,name=is_partition_table,json=isPartitionTable,proto3" json:"is_partition_table,omitempty"`
+	PartitionTableIds  []uint64      `protobuf:"varint,8,rep,packed,name=partition_table_ids,json=partitionTableIds,proto3" json:"partition_table_ids,omitempty"`
+	Block              bool          `protobuf:"varint,9,opt,name=block,proto3" json:"block,omitempty"`
+	Mode               lock.LockMode `protobuf:"varint,10,opt,name=Mode,proto3,enum=lock.LockMode" json:"Mode,omitempty"`
+	PrimaryColRelPos   int32         `protobuf:"varint,11,opt,name=primary_col_rel_pos,json=primaryColRelPos,proto3" json:"primary_col_rel_pos,omitempty"`
+	FilterColRelPos    int32         `protobuf:"varint,12,opt,name=filter_col_rel_pos,json=filterColRelPos,proto3" json:"filter_col_rel_pos,omitempty"`
+	LockRows           *Expr         `protobuf:"bytes,13,opt,name=lock_rows,json=lockRows,proto3" json:"lock_rows,omitempty"`
+	LockTableAtTheEnd  bool          `protobuf:"varint,14,opt,name=lock_table_at_the_end,json=lockTableAtTheEnd,proto3" json:"lock_table_at_the_end,omitempty"`
+	// wait_policy is FastFail for NOWAIT and SKIP LOCKED
+	WaitPolicy lock.WaitPolicy `protobuf:"varint,15,opt,name=wait_policy,json=waitPolicy,proto3,enum=lock.WaitPolicy" json:"wait_policy,omitempty"`
+	// wait_sec is the max seconds to wait for a conflict lock, 0 means no limit
+	WaitSec uint64 `protobuf:"varint,16,opt,name=wait_sec,json=waitSec,proto3" json:"wait_sec,omitempty"`
+	// skip_locked skips the rows locked by other txns instead of failing
+	SkipLocked bool `protobuf:"varint,17,opt,name=skip_locked,json=skipLocked,proto3" json:"skip_locked,omitempty"`
+	// skip_locked_limit stops locking more rows once so many rows are locked
+	// in skip locked mode, 0 means no limit
+	SkipLockedLimit      uint64   `protobuf:"varint,18,opt,name=skip_locked_limit,json=skipLockedLimit,proto3" json:"skip_locked_limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *LockTarget) Reset()         { *m = LockTarget{} }
 func (m *LockTarget) String() string { return proto.CompactTextString(m) }
 func (*LockTarget) ProtoMessage()    {}
 func (*LockTarget) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{64}
+	return fileDescriptor_2d655ab2f7683c23, []int{67}
 }
 func (m *LockTarget) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7533,6 +7883,34 @@ func (m *LockTarget) GetLockTableAtTheEnd() bool {
 	return false
 }
 
+func (m *LockTarget) GetWaitPolicy() lock.WaitPolicy {
+	if m != nil {
+		return m.WaitPolicy
+	}
+	return lock.WaitPolicy_Wait
+}
+
+func (m *LockTarget) GetWaitSec() uint64 {
+	if m != nil {
+		return m.WaitSec
+	}
+	return 0
+}
+
+func (m *LockTarget) GetSkipLocked() bool {
+	if m != nil {
+		return m.SkipLocked
+	}
+	return false
+}
+
+func (m *LockTarget) GetSkipLockedLimit() uint64 {
+	if m != nil {
+		return m.SkipLockedLimit
+	}
+	return 0
+}
+
 type PreInsertUkCtx struct {
 	// index of columns(parts of unique key) in pre batch
 	Columns              []int32  `protobuf:"varint,1,rep,packed,name=columns,proto3" json:"columns,omitempty"`
@@ -7548,7 +7926,7 @@ func (m *PreInsertUkCtx) Reset()         { *m = PreInsertUkCtx{} }
 func (m *PreInsertUkCtx) String() string { return proto.CompactTextString(m) }
 func (*PreInsertUkCtx) ProtoMessage()    {}
 func (*PreInsertUkCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{65}
+	return fileDescriptor_2d655ab2f7683c23, []int{68}
 }
 func (m *PreInsertUkCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7617,7 +7995,7 @@ func (m *PreDeleteCtx) Reset()         { *m = PreDeleteCtx{} }
 func (m *PreDeleteCtx) String() string { return proto.CompactTextString(m) }
 func (*PreDeleteCtx) ProtoMessage()    {}
 func (*PreDeleteCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{66}
+	return fileDescriptor_2d655ab2f7683c23, []int{69}
 }
 func (m *PreDeleteCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7669,7 +8047,7 @@ func (m *PreInsertCtx) Reset()         { *m = PreInsertCtx{} }
 func (m *PreInsertCtx) String() string { return proto.CompactTextString(m) }
 func (*PreInsertCtx) ProtoMessage()    {}
 func (*PreInsertCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{67}
+	return fileDescriptor_2d655ab2f7683c23, []int{70}
 }
 func (m *PreInsertCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7754,7 +8132,7 @@ func (m *RuntimeFilterSpec) Reset()         { *m = RuntimeFilterSpec{} }
 func (m *RuntimeFilterSpec) String() string { return proto.CompactTextString(m) }
 func (*RuntimeFilterSpec) ProtoMessage()    {}
 func (*RuntimeFilterSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{68}
+	return fileDescriptor_2d655ab2f7683c23, []int{71}
 }
 func (m *RuntimeFilterSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7822,7 +8200,7 @@ func (m *IdList) Reset()         { *m = IdList{} }
 func (m *IdList) String() string { return proto.CompactTextString(m) }
 func (*IdList) ProtoMessage()    {}
 func (*IdList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{69}
+	return fileDescriptor_2d655ab2f7683c23, []int{72}
 }
 func (m *IdList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7869,7 +8247,7 @@ func (m *ColPosMap) Reset()         { *m = ColPosMap{} }
 func (m *ColPosMap) String() string { return proto.CompactTextString(m) }
 func (*ColPosMap) ProtoMessage()    {}
 func (*ColPosMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{70}
+	return fileDescriptor_2d655ab2f7683c23, []int{73}
 }
 func (m *ColPosMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7927,7 +8305,7 @@ func (m *DeleteCtx) Reset()         { *m = DeleteCtx{} }
 func (m *DeleteCtx) String() string { return proto.CompactTextString(m) }
 func (*DeleteCtx) ProtoMessage()    {}
 func (*DeleteCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{71}
+	return fileDescriptor_2d655ab2f7683c23, []int{74}
 }
 func (m *DeleteCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8047,7 +8425,7 @@ func (m *PostDmlFullTextCtx) Reset()         { *m = PostDmlFullTextCtx{} }
 func (m *PostDmlFullTextCtx) String() string { return proto.CompactTextString(m) }
 func (*PostDmlFullTextCtx) ProtoMessage()    {}
 func (*PostDmlFullTextCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{72}
+	return fileDescriptor_2d655ab2f7683c23, []int{75}
 }
 func (m *PostDmlFullTextCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8104,25 +8482,351 @@ func (m *PostDmlFullTextCtx) GetAlgoParams() string {
 	return ""
 }
 
+type PostDmlHnswCtx struct {
+	SourceTableName      string   `protobuf:"bytes,1,opt,name=source_table_name,json=sourceTableName,proto3" json:"source_table_name,omitempty"`
+	IndexTableName       string   `protobuf:"bytes,2,opt,name=index_table_name,json=indexTableName,proto3" json:"index_table_name,omitempty"`
+	Parts                []string `protobuf:"bytes,3,rep,name=parts,proto3" json:"parts,omitempty"`
+	AlgoParams           string   `protobuf:"bytes,4,opt,name=algo_params,json=algoParams,proto3" json:"algo_params,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PostDmlHnswCtx) Reset()         { *m = PostDmlHnswCtx{} }
+func (m *PostDmlHnswCtx) String() string { return proto.CompactTextString(m) }
+func (*PostDmlHnswCtx) ProtoMessage()    {}
+func (*PostDmlHnswCtx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{76}
+}
+func (m *PostDmlHnswCtx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PostDmlHnswCtx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PostDmlHnswCtx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PostDmlHnswCtx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostDmlHnswCtx.Merge(m, src)
+}
+func (m *PostDmlHnswCtx) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *PostDmlHnswCtx) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostDmlHnswCtx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PostDmlHnswCtx proto.InternalMessageInfo
+
+func (m *PostDmlHnswCtx) GetSourceTableName() string {
+	if m != nil {
+		return m.SourceTableName
+	}
+	return ""
+}
+
+func (m *PostDmlHnswCtx) GetIndexTableName() string {
+	if m != nil {
+		return m.IndexTableName
+	}
+	return ""
+}
+
+func (m *PostDmlHnswCtx) GetParts() []string {
+	if m != nil {
+		return m.Parts
+	}
+	return nil
+}
+
+func (m *PostDmlHnswCtx) GetAlgoParams() string {
+	if m != nil {
+		return m.AlgoParams
+	}
+	return ""
+}
+
+// PostDmlTriggerAction is a statement or a SIGNAL of the row level triggers, which is run for
+// each row in the input batch. The positions are the columns of the input batch.
+type PostDmlTriggerAction struct {
+	// guard is the column whether the action is run for the row, -1 if it is always run
+	Guard int32 `protobuf:"varint,1,opt,name=guard,proto3" json:"guard,omitempty"`
+	// sql_parts are the texts of the statement around the NEW/OLD values at args
+	SqlParts []string `protobuf:"bytes,2,rep,name=sql_parts,json=sqlParts,proto3" json:"sql_parts,omitempty"`
+	Args     []int32  `protobuf:"varint,3,rep,packed,name=args,proto3" json:"args,omitempty"`
+	// the action is a SIGNAL if sql_state is not empty, message is the column of MESSAGE_TEXT,
+	// or -1 to use sql_parts[0] as the message
+	SqlState             string   `protobuf:"bytes,4,opt,name=sql_state,json=sqlState,proto3" json:"sql_state,omitempty"`
+	Errno                uint32   `protobuf:"varint,5,opt,name=errno,proto3" json:"errno,omitempty"`
+	Message              int32    `protobuf:"varint,6,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PostDmlTriggerAction) Reset()         { *m = PostDmlTriggerAction{} }
+func (m *PostDmlTriggerAction) String() string { return proto.CompactTextString(m) }
+func (*PostDmlTriggerAction) ProtoMessage()    {}
+func (*PostDmlTriggerAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{77}
+}
+func (m *PostDmlTriggerAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PostDmlTriggerAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PostDmlTriggerAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PostDmlTriggerAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostDmlTriggerAction.Merge(m, src)
+}
+func (m *PostDmlTriggerAction) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *PostDmlTriggerAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostDmlTriggerAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PostDmlTriggerAction proto.InternalMessageInfo
+
+func (m *PostDmlTriggerAction) GetGuard() int32 {
+	if m != nil {
+		return m.Guard
+	}
+	return 0
+}
+
+func (m *PostDmlTriggerAction) GetSqlParts() []string {
+	if m != nil {
+		return m.SqlParts
+	}
+	return nil
+}
+
+func (m *PostDmlTriggerAction) GetArgs() []int32 {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *PostDmlTriggerAction) GetSqlState() string {
+	if m != nil {
+		return m.SqlState
+	}
+	return ""
+}
+
+func (m *PostDmlTriggerAction) GetErrno() uint32 {
+	if m != nil {
+		return m.Errno
+	}
+	return 0
+}
+
+func (m *PostDmlTriggerAction) GetMessage() int32 {
+	if m != nil {
+		return m.Message
+	}
+	return 0
+}
+
+type PostDmlTriggerCtx struct {
+	Actions              []*PostDmlTriggerAction `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *PostDmlTriggerCtx) Reset()         { *m = PostDmlTriggerCtx{} }
+func (m *PostDmlTriggerCtx) String() string { return proto.CompactTextString(m) }
+func (*PostDmlTriggerCtx) ProtoMessage()    {}
+func (*PostDmlTriggerCtx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{78}
+}
+func (m *PostDmlTriggerCtx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PostDmlTriggerCtx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PostDmlTriggerCtx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PostDmlTriggerCtx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostDmlTriggerCtx.Merge(m, src)
+}
+func (m *PostDmlTriggerCtx) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *PostDmlTriggerCtx) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostDmlTriggerCtx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PostDmlTriggerCtx proto.InternalMessageInfo
+
+func (m *PostDmlTriggerCtx) GetActions() []*PostDmlTriggerAction {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
+// PostDmlMaterializedView is a materialized view to refresh, the positions are the columns of the key_cols
+// of the view in the input batch for the old and the new rows.
+type PostDmlMaterializedView struct {
+	View                 *MaterializedViewDef `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`
+	OldKeyPos            []int32              `protobuf:"varint,2,rep,packed,name=old_key_pos,json=oldKeyPos,proto3" json:"old_key_pos,omitempty"`
+	NewKeyPos            []int32              `protobuf:"varint,3,rep,packed,name=new_key_pos,json=newKeyPos,proto3" json:"new_key_pos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *PostDmlMaterializedView) Reset()         { *m = PostDmlMaterializedView{} }
+func (m *PostDmlMaterializedView) String() string { return proto.CompactTextString(m) }
+func (*PostDmlMaterializedView) ProtoMessage()    {}
+func (*PostDmlMaterializedView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{79}
+}
+func (m *PostDmlMaterializedView) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PostDmlMaterializedView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PostDmlMaterializedView.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PostDmlMaterializedView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostDmlMaterializedView.Merge(m, src)
+}
+func (m *PostDmlMaterializedView) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *PostDmlMaterializedView) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostDmlMaterializedView.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PostDmlMaterializedView proto.InternalMessageInfo
+
+func (m *PostDmlMaterializedView) GetView() *MaterializedViewDef {
+	if m != nil {
+		return m.View
+	}
+	return nil
+}
+
+func (m *PostDmlMaterializedView) GetOldKeyPos() []int32 {
+	if m != nil {
+		return m.OldKeyPos
+	}
+	return nil
+}
+
+func (m *PostDmlMaterializedView) GetNewKeyPos() []int32 {
+	if m != nil {
+		return m.NewKeyPos
+	}
+	return nil
+}
+
+type PostDmlMaterializedViewCtx struct {
+	Views                []*PostDmlMaterializedView `protobuf:"bytes,1,rep,name=views,proto3" json:"views,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *PostDmlMaterializedViewCtx) Reset()         { *m = PostDmlMaterializedViewCtx{} }
+func (m *PostDmlMaterializedViewCtx) String() string { return proto.CompactTextString(m) }
+func (*PostDmlMaterializedViewCtx) ProtoMessage()    {}
+func (*PostDmlMaterializedViewCtx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{80}
+}
+func (m *PostDmlMaterializedViewCtx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PostDmlMaterializedViewCtx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PostDmlMaterializedViewCtx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PostDmlMaterializedViewCtx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostDmlMaterializedViewCtx.Merge(m, src)
+}
+func (m *PostDmlMaterializedViewCtx) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *PostDmlMaterializedViewCtx) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostDmlMaterializedViewCtx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PostDmlMaterializedViewCtx proto.InternalMessageInfo
+
+func (m *PostDmlMaterializedViewCtx) GetViews() []*PostDmlMaterializedView {
+	if m != nil {
+		return m.Views
+	}
+	return nil
+}
+
 type PostDmlCtx struct {
-	Ref                    *ObjectRef          `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
-	AddAffectedRows        bool                `protobuf:"varint,2,opt,name=add_affected_rows,json=addAffectedRows,proto3" json:"add_affected_rows,omitempty"`
-	PrimaryKeyIdx          int32               `protobuf:"varint,3,opt,name=primary_key_idx,json=primaryKeyIdx,proto3" json:"primary_key_idx,omitempty"`
-	PrimaryKeyName         string              `protobuf:"bytes,4,opt,name=primary_key_name,json=primaryKeyName,proto3" json:"primary_key_name,omitempty"`
-	IsDelete               bool                `protobuf:"varint,5,opt,name=is_delete,json=isDelete,proto3" json:"is_delete,omitempty"`
-	IsInsert               bool                `protobuf:"varint,6,opt,name=is_insert,json=isInsert,proto3" json:"is_insert,omitempty"`
-	IsDeleteWithoutFilters bool                `protobuf:"varint,7,opt,name=is_delete_without_filters,json=isDeleteWithoutFilters,proto3" json:"is_delete_without_filters,omitempty"`
-	FullText               *PostDmlFullTextCtx `protobuf:"bytes,8,opt,name=full_text,json=fullText,proto3" json:"full_text,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{}            `json:"-"`
-	XXX_unrecognized       []byte              `json:"-"`
-	XXX_sizecache          int32               `json:"-"`
+	Ref                    *ObjectRef                  `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+	AddAffectedRows        bool                        `protobuf:"varint,2,opt,name=add_affected_rows,json=addAffectedRows,proto3" json:"add_affected_rows,omitempty"`
+	PrimaryKeyIdx          int32                       `protobuf:"varint,3,opt,name=primary_key_idx,json=primaryKeyIdx,proto3" json:"primary_key_idx,omitempty"`
+	PrimaryKeyName         string                      `protobuf:"bytes,4,opt,name=primary_key_name,json=primaryKeyName,proto3" json:"primary_key_name,omitempty"`
+	IsDelete               bool                        `protobuf:"varint,5,opt,name=is_delete,json=isDelete,proto3" json:"is_delete,omitempty"`
+	IsInsert               bool                        `protobuf:"varint,6,opt,name=is_insert,json=isInsert,proto3" json:"is_insert,omitempty"`
+	IsDeleteWithoutFilters bool                        `protobuf:"varint,7,opt,name=is_delete_without_filters,json=isDeleteWithoutFilters,proto3" json:"is_delete_without_filters,omitempty"`
+	FullText               *PostDmlFullTextCtx         `protobuf:"bytes,8,opt,name=full_text,json=fullText,proto3" json:"full_text,omitempty"`
+	Hnsw                   *PostDmlHnswCtx             `protobuf:"bytes,9,opt,name=hnsw,proto3" json:"hnsw,omitempty"`
+	Trigger                *PostDmlTriggerCtx          `protobuf:"bytes,10,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	Mview                  *PostDmlMaterializedViewCtx `protobuf:"bytes,11,opt,name=mview,proto3" json:"mview,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}                    `json:"-"`
+	XXX_unrecognized       []byte                      `json:"-"`
+	XXX_sizecache          int32                       `json:"-"`
 }
 
 func (m *PostDmlCtx) Reset()         { *m = PostDmlCtx{} }
 func (m *PostDmlCtx) String() string { return proto.CompactTextString(m) }
 func (*PostDmlCtx) ProtoMessage()    {}
 func (*PostDmlCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{73}
+	return fileDescriptor_2d655ab2f7683c23, []int{81}
 }
 func (m *PostDmlCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8207,6 +8911,27 @@ func (m *PostDmlCtx) GetFullText() *PostDmlFullTextCtx {
 	return nil
 }
 
+func (m *PostDmlCtx) GetHnsw() *PostDmlHnswCtx {
+	if m != nil {
+		return m.Hnsw
+	}
+	return nil
+}
+
+func (m *PostDmlCtx) GetTrigger() *PostDmlTriggerCtx {
+	if m != nil {
+		return m.Trigger
+	}
+	return nil
+}
+
+func (m *PostDmlCtx) GetMview() *PostDmlMaterializedViewCtx {
+	if m != nil {
+		return m.Mview
+	}
+	return nil
+}
+
 type Query struct {
 	StmtType Query_StatementType `protobuf:"varint,1,opt,name=stmt_type,json=stmtType,proto3,enum=plan.Query_StatementType" json:"stmt_type,omitempty"`
 	// Each step is simply a root node.  Root node refers to other
@@ -8234,7 +8959,7 @@ func (m *Query) Reset()         { *m = Query{} }
 func (m *Query) String() string { return proto.CompactTextString(m) }
 func (*Query) ProtoMessage()    {}
 func (*Query) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{74}
+	return fileDescriptor_2d655ab2f7683c23, []int{82}
 }
 func (m *Query) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8337,7 +9062,7 @@ func (m *TransationControl) Reset()         { *m = TransationControl{} }
 func (m *TransationControl) String() string { return proto.CompactTextString(m) }
 func (*TransationControl) ProtoMessage()    {}
 func (*TransationControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{75}
+	return fileDescriptor_2d655ab2f7683c23, []int{83}
 }
 func (m *TransationControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8441,7 +9166,7 @@ func (m *TransationBegin) Reset()         { *m = TransationBegin{} }
 func (m *TransationBegin) String() string { return proto.CompactTextString(m) }
 func (*TransationBegin) ProtoMessage()    {}
 func (*TransationBegin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{76}
+	return fileDescriptor_2d655ab2f7683c23, []int{84}
 }
 func (m *TransationBegin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8488,7 +9213,7 @@ func (m *TransationCommit) Reset()         { *m = TransationCommit{} }
 func (m *TransationCommit) String() string { return proto.CompactTextString(m) }
 func (*TransationCommit) ProtoMessage()    {}
 func (*TransationCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{77}
+	return fileDescriptor_2d655ab2f7683c23, []int{85}
 }
 func (m *TransationCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8535,7 +9260,7 @@ func (m *TransationRollback) Reset()         { *m = TransationRollback{} }
 func (m *TransationRollback) String() string { return proto.CompactTextString(m) }
 func (*TransationRollback) ProtoMessage()    {}
 func (*TransationRollback) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{78}
+	return fileDescriptor_2d655ab2f7683c23, []int{86}
 }
 func (m *TransationRollback) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8590,7 +9315,7 @@ func (m *Plan) Reset()         { *m = Plan{} }
 func (m *Plan) String() string { return proto.CompactTextString(m) }
 func (*Plan) ProtoMessage()    {}
 func (*Plan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{79}
+	return fileDescriptor_2d655ab2f7683c23, []int{87}
 }
 func (m *Plan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8713,7 +9438,7 @@ func (m *Column) Reset()         { *m = Column{} }
 func (m *Column) String() string { return proto.CompactTextString(m) }
 func (*Column) ProtoMessage()    {}
 func (*Column) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{80}
+	return fileDescriptor_2d655ab2f7683c23, []int{88}
 }
 func (m *Column) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8769,7 +9494,7 @@ func (m *DataControl) Reset()         { *m = DataControl{} }
 func (m *DataControl) String() string { return proto.CompactTextString(m) }
 func (*DataControl) ProtoMessage()    {}
 func (*DataControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{81}
+	return fileDescriptor_2d655ab2f7683c23, []int{89}
 }
 func (m *DataControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8914,6 +9639,9 @@ type DataDefinition struct {
 	//	*DataDefinition_AlterSequence
 	//	*DataDefinition_CreateView
 	//	*DataDefinition_RenameTable
+	//	*DataDefinition_CreateTrigger
+	//	*DataDefinition_DropTrigger
+	//	*DataDefinition_RefreshMaterializedView
 	Definition           isDataDefinition_Definition `protobuf_oneof:"definition"`
 	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
 	XXX_unrecognized     []byte                      `json:"-"`
@@ -8924,7 +9652,7 @@ func (m *DataDefinition) Reset()         { *m = DataDefinition{} }
 func (m *DataDefinition) String() string { return proto.CompactTextString(m) }
 func (*DataDefinition) ProtoMessage()    {}
 func (*DataDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{82}
+	return fileDescriptor_2d655ab2f7683c23, []int{90}
 }
 func (m *DataDefinition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9016,26 +9744,38 @@ type DataDefinition_CreateView struct {
 type DataDefinition_RenameTable struct {
 	RenameTable *RenameTable `protobuf:"bytes,21,opt,name=rename_table,json=renameTable,proto3,oneof" json:"rename_table,omitempty"`
 }
-
-func (*DataDefinition_CreateDatabase) isDataDefinition_Definition() {}
-func (*DataDefinition_AlterDatabase) isDataDefinition_Definition()  {}
-func (*DataDefinition_DropDatabase) isDataDefinition_Definition()   {}
-func (*DataDefinition_CreateTable) isDataDefinition_Definition()    {}
-func (*DataDefinition_AlterTable) isDataDefinition_Definition()     {}
-func (*DataDefinition_DropTable) isDataDefinition_Definition()      {}
-func (*DataDefinition_CreateIndex) isDataDefinition_Definition()    {}
-func (*DataDefinition_AlterIndex) isDataDefinition_Definition()     {}
-func (*DataDefinition_DropIndex) isDataDefinition_Definition()      {}
-func (*DataDefinition_TruncateTable) isDataDefinition_Definition()  {}
-func (*DataDefinition_ShowVariables) isDataDefinition_Definition()  {}
-func (*DataDefinition_AlterView) isDataDefinition_Definition()      {}
-func (*DataDefinition_LockTables) isDataDefinition_Definition()     {}
-func (*DataDefinition_UnlockTables) isDataDefinition_Definition()   {}
-func (*DataDefinition_CreateSequence) isDataDefinition_Definition() {}
-func (*DataDefinition_DropSequence) isDataDefinition_Definition()   {}
-func (*DataDefinition_AlterSequence) isDataDefinition_Definition()  {}
-func (*DataDefinition_CreateView) isDataDefinition_Definition()     {}
-func (*DataDefinition_RenameTable) isDataDefinition_Definition()    {}
+type DataDefinition_CreateTrigger struct {
+	CreateTrigger *CreateTrigger `protobuf:"bytes,22,opt,name=create_trigger,json=createTrigger,proto3,oneof" json:"create_trigger,omitempty"`
+}
+type DataDefinition_DropTrigger struct {
+	DropTrigger *DropTrigger `protobuf:"bytes,23,opt,name=drop_trigger,json=dropTrigger,proto3,oneof" json:"drop_trigger,omitempty"`
+}
+type DataDefinition_RefreshMaterializedView struct {
+	RefreshMaterializedView *RefreshMaterializedView `protobuf:"bytes,24,opt,name=refresh_materialized_view,json=refreshMaterializedView,proto3,oneof" json:"refresh_materialized_view,omitempty"`
+}
+
+func (*DataDefinition_CreateDatabase) isDataDefinition_Definition()          {}
+func (*DataDefinition_AlterDatabase) isDataDefinition_Definition()           {}
+func (*DataDefinition_DropDatabase) isDataDefinition_Definition()            {}
+func (*DataDefinition_CreateTable) isDataDefinition_Definition()             {}
+func (*DataDefinition_AlterTable) isDataDefinition_Definition()              {}
+func (*DataDefinition_DropTable) isDataDefinition_Definition()               {}
+func (*DataDefinition_CreateIndex) isDataDefinition_Definition()             {}
+func (*DataDefinition_AlterIndex) isDataDefinition_Definition()              {}
+func (*DataDefinition_DropIndex) isDataDefinition_Definition()               {}
+func (*DataDefinition_TruncateTable) isDataDefinition_Definition()           {}
+func (*DataDefinition_ShowVariables) isDataDefinition_Definition()           {}
+func (*DataDefinition_AlterView) isDataDefinition_Definition()               {}
+func (*DataDefinition_LockTables) isDataDefinition_Definition()              {}
+func (*DataDefinition_UnlockTables) isDataDefinition_Definition()            {}
+func (*DataDefinition_CreateSequence) isDataDefinition_Definition()          {}
+func (*DataDefinition_DropSequence) isDataDefinition_Definition()            {}
+func (*DataDefinition_AlterSequence) isDataDefinition_Definition()           {}
+func (*DataDefinition_CreateView) isDataDefinition_Definition()              {}
+func (*DataDefinition_RenameTable) isDataDefinition_Definition()             {}
+func (*DataDefinition_CreateTrigger) isDataDefinition_Definition()           {}
+func (*DataDefinition_DropTrigger) isDataDefinition_Definition()             {}
+func (*DataDefinition_RefreshMaterializedView) isDataDefinition_Definition() {}
 
 func (m *DataDefinition) GetDefinition() isDataDefinition_Definition {
 	if m != nil {
@@ -9191,6 +9931,27 @@ func (m *DataDefinition) GetRenameTable() *RenameTable {
 	return nil
 }
 
+func (m *DataDefinition) GetCreateTrigger() *CreateTrigger {
+	if x, ok := m.GetDefinition().(*DataDefinition_CreateTrigger); ok {
+		return x.CreateTrigger
+	}
+	return nil
+}
+
+func (m *DataDefinition) GetDropTrigger() *DropTrigger {
+	if x, ok := m.GetDefinition().(*DataDefinition_DropTrigger); ok {
+		return x.DropTrigger
+	}
+	return nil
+}
+
+func (m *DataDefinition) GetRefreshMaterializedView() *RefreshMaterializedView {
+	if x, ok := m.GetDefinition().(*DataDefinition_RefreshMaterializedView); ok {
+		return x.RefreshMaterializedView
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*DataDefinition) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -9213,6 +9974,9 @@ func (*DataDefinition) XXX_OneofWrappers() []interface{} {
 		(*DataDefinition_AlterSequence)(nil),
 		(*DataDefinition_CreateView)(nil),
 		(*DataDefinition_RenameTable)(nil),
+		(*DataDefinition_CreateTrigger)(nil),
+		(*DataDefinition_DropTrigger)(nil),
+		(*DataDefinition_RefreshMaterializedView)(nil),
 	}
 }
 
@@ -9228,7 +9992,7 @@ func (m *SubscriptionOption) Reset()         { *m = SubscriptionOption{} }
 func (m *SubscriptionOption) String() string { return proto.CompactTextString(m) }
 func (*SubscriptionOption) ProtoMessage()    {}
 func (*SubscriptionOption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{83}
+	return fileDescriptor_2d655ab2f7683c23, []int{91}
 }
 func (m *SubscriptionOption) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9285,7 +10049,7 @@ func (m *CreateDatabase) Reset()         { *m = CreateDatabase{} }
 func (m *CreateDatabase) String() string { return proto.CompactTextString(m) }
 func (*CreateDatabase) ProtoMessage()    {}
 func (*CreateDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{84}
+	return fileDescriptor_2d655ab2f7683c23, []int{92}
 }
 func (m *CreateDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9354,7 +10118,7 @@ func (m *AlterDatabase) Reset()         { *m = AlterDatabase{} }
 func (m *AlterDatabase) String() string { return proto.CompactTextString(m) }
 func (*AlterDatabase) ProtoMessage()    {}
 func (*AlterDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{85}
+	return fileDescriptor_2d655ab2f7683c23, []int{93}
 }
 func (m *AlterDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9416,7 +10180,7 @@ func (m *DropDatabase) Reset()         { *m = DropDatabase{} }
 func (m *DropDatabase) String() string { return proto.CompactTextString(m) }
 func (*DropDatabase) ProtoMessage()    {}
 func (*DropDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{86}
+	return fileDescriptor_2d655ab2f7683c23, []int{94}
 }
 func (m *DropDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9492,7 +10256,7 @@ func (m *FkColName) Reset()         { *m = FkColName{} }
 func (m *FkColName) String() string { return proto.CompactTextString(m) }
 func (*FkColName) ProtoMessage()    {}
 func (*FkColName) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{87}
+	return fileDescriptor_2d655ab2f7683c23, []int{95}
 }
 func (m *FkColName) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9558,7 +10322,7 @@ func (m *ForeignKeyInfo) Reset()         { *m = ForeignKeyInfo{} }
 func (m *ForeignKeyInfo) String() string { return proto.CompactTextString(m) }
 func (*ForeignKeyInfo) ProtoMessage()    {}
 func (*ForeignKeyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{88}
+	return fileDescriptor_2d655ab2f7683c23, []int{96}
 }
 func (m *ForeignKeyInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9640,18 +10404,20 @@ type CreateTable struct {
 	// into mo_foreign_keys
 	UpdateFkSqls []string `protobuf:"bytes,12,rep,name=updateFkSqls,proto3" json:"updateFkSqls,omitempty"`
 	// fks forward reference to me
-	FksReferToMe         []*ForeignKeyInfo `protobuf:"bytes,13,rep,name=fksReferToMe,proto3" json:"fksReferToMe,omitempty"`
-	RetentionDeadline    int64             `protobuf:"varint,14,opt,name=retention_deadline,json=retentionDeadline,proto3" json:"retention_deadline,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	FksReferToMe      []*ForeignKeyInfo `protobuf:"bytes,13,rep,name=fksReferToMe,proto3" json:"fksReferToMe,omitempty"`
+	RetentionDeadline int64             `protobuf:"varint,14,opt,name=retention_deadline,json=retentionDeadline,proto3" json:"retention_deadline,omitempty"`
+	// ttl is the TTL clause of the table, nil if the table has no TTL
+	Ttl                  *TableTTL `protobuf:"bytes,15,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
 func (m *CreateTable) Reset()         { *m = CreateTable{} }
 func (m *CreateTable) String() string { return proto.CompactTextString(m) }
 func (*CreateTable) ProtoMessage()    {}
 func (*CreateTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{89}
+	return fileDescriptor_2d655ab2f7683c23, []int{97}
 }
 func (m *CreateTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9778,6 +10544,79 @@ func (m *CreateTable) GetRetentionDeadline() int64 {
 	return 0
 }
 
+func (m *CreateTable) GetTtl() *TableTTL {
+	if m != nil {
+		return m.Ttl
+	}
+	return nil
+}
+
+// TableTTL is the TTL = column + INTERVAL n unit clause of a table, the rows whose
+// column is earlier than now() - INTERVAL n unit are expired.
+type TableTTL struct {
+	Column   string `protobuf:"bytes,1,opt,name=column,proto3" json:"column,omitempty"`
+	Interval int64  `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	// unit is the interval unit in upper case, e.g. DAY
+	Unit                 string   `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TableTTL) Reset()         { *m = TableTTL{} }
+func (m *TableTTL) String() string { return proto.CompactTextString(m) }
+func (*TableTTL) ProtoMessage()    {}
+func (*TableTTL) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{98}
+}
+func (m *TableTTL) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TableTTL) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TableTTL.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TableTTL) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TableTTL.Merge(m, src)
+}
+func (m *TableTTL) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *TableTTL) XXX_DiscardUnknown() {
+	xxx_messageInfo_TableTTL.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TableTTL proto.InternalMessageInfo
+
+func (m *TableTTL) GetColumn() string {
+	if m != nil {
+		return m.Column
+	}
+	return ""
+}
+
+func (m *TableTTL) GetInterval() int64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *TableTTL) GetUnit() string {
+	if m != nil {
+		return m.Unit
+	}
+	return ""
+}
+
 type AlterTableDrop struct {
 	Typ                  AlterTableDrop_Typ `protobuf:"varint,1,opt,name=typ,proto3,enum=plan.AlterTableDrop_Typ" json:"typ,omitempty"`
 	Name                 string             `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -9791,7 +10630,7 @@ func (m *AlterTableDrop) Reset()         { *m = AlterTableDrop{} }
 func (m *AlterTableDrop) String() string { return proto.CompactTextString(m) }
 func (*AlterTableDrop) ProtoMessage()    {}
 func (*AlterTableDrop) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{90}
+	return fileDescriptor_2d655ab2f7683c23, []int{99}
 }
 func (m *AlterTableDrop) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9856,7 +10695,7 @@ func (m *AlterTableAddFk) Reset()         { *m = AlterTableAddFk{} }
 func (m *AlterTableAddFk) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAddFk) ProtoMessage()    {}
 func (*AlterTableAddFk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{91}
+	return fileDescriptor_2d655ab2f7683c23, []int{100}
 }
 func (m *AlterTableAddFk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9928,7 +10767,7 @@ func (m *AlterTableAddIndex) Reset()         { *m = AlterTableAddIndex{} }
 func (m *AlterTableAddIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAddIndex) ProtoMessage()    {}
 func (*AlterTableAddIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{92}
+	return fileDescriptor_2d655ab2f7683c23, []int{101}
 }
 func (m *AlterTableAddIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10006,7 +10845,7 @@ func (m *AlterTableDropIndex) Reset()         { *m = AlterTableDropIndex{} }
 func (m *AlterTableDropIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterTableDropIndex) ProtoMessage()    {}
 func (*AlterTableDropIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{93}
+	return fileDescriptor_2d655ab2f7683c23, []int{102}
 }
 func (m *AlterTableDropIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10077,7 +10916,7 @@ func (m *AlterTableAlterIndex) Reset()         { *m = AlterTableAlterIndex{} }
 func (m *AlterTableAlterIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAlterIndex) ProtoMessage()    {}
 func (*AlterTableAlterIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{94}
+	return fileDescriptor_2d655ab2f7683c23, []int{103}
 }
 func (m *AlterTableAlterIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10148,7 +10987,7 @@ func (m *AlterTableAlterReIndex) Reset()         { *m = AlterTableAlterReIndex{}
 func (m *AlterTableAlterReIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAlterReIndex) ProtoMessage()    {}
 func (*AlterTableAlterReIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{95}
+	return fileDescriptor_2d655ab2f7683c23, []int{104}
 }
 func (m *AlterTableAlterReIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10218,7 +11057,7 @@ func (m *AlterTableAddPartition) Reset()         { *m = AlterTableAddPartition{}
 func (m *AlterTableAddPartition) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAddPartition) ProtoMessage()    {}
 func (*AlterTableAddPartition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{96}
+	return fileDescriptor_2d655ab2f7683c23, []int{105}
 }
 func (m *AlterTableAddPartition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10279,7 +11118,7 @@ func (m *AlterTableComment) Reset()         { *m = AlterTableComment{} }
 func (m *AlterTableComment) String() string { return proto.CompactTextString(m) }
 func (*AlterTableComment) ProtoMessage()    {}
 func (*AlterTableComment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{97}
+	return fileDescriptor_2d655ab2f7683c23, []int{106}
 }
 func (m *AlterTableComment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10315,6 +11154,54 @@ func (m *AlterTableComment) GetNewComment() string {
 	return ""
 }
 
+type AlterTableTTL struct {
+	// ttl is nil for REMOVE TTL
+	Ttl                  *TableTTL `protobuf:"bytes,1,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *AlterTableTTL) Reset()         { *m = AlterTableTTL{} }
+func (m *AlterTableTTL) String() string { return proto.CompactTextString(m) }
+func (*AlterTableTTL) ProtoMessage()    {}
+func (*AlterTableTTL) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{107}
+}
+func (m *AlterTableTTL) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTableTTL) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTableTTL.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTableTTL) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableTTL.Merge(m, src)
+}
+func (m *AlterTableTTL) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTableTTL) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableTTL.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTableTTL proto.InternalMessageInfo
+
+func (m *AlterTableTTL) GetTtl() *TableTTL {
+	if m != nil {
+		return m.Ttl
+	}
+	return nil
+}
+
 type AlterTableName struct {
 	OldName              string   `protobuf:"bytes,1,opt,name=old_name,json=oldName,proto3" json:"old_name,omitempty"`
 	NewName              string   `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
@@ -10327,7 +11214,7 @@ func (m *AlterTableName) Reset()         { *m = AlterTableName{} }
 func (m *AlterTableName) String() string { return proto.CompactTextString(m) }
 func (*AlterTableName) ProtoMessage()    {}
 func (*AlterTableName) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{98}
+	return fileDescriptor_2d655ab2f7683c23, []int{108}
 }
 func (m *AlterTableName) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10384,7 +11271,7 @@ func (m *AlterAddColumn) Reset()         { *m = AlterAddColumn{} }
 func (m *AlterAddColumn) String() string { return proto.CompactTextString(m) }
 func (*AlterAddColumn) ProtoMessage()    {}
 func (*AlterAddColumn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{99}
+	return fileDescriptor_2d655ab2f7683c23, []int{109}
 }
 func (m *AlterAddColumn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10453,7 +11340,7 @@ func (m *AlterDropColumn) Reset()         { *m = AlterDropColumn{} }
 func (m *AlterDropColumn) String() string { return proto.CompactTextString(m) }
 func (*AlterDropColumn) ProtoMessage()    {}
 func (*AlterDropColumn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{100}
+	return fileDescriptor_2d655ab2f7683c23, []int{110}
 }
 func (m *AlterDropColumn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10507,7 +11394,7 @@ func (m *RenameTable) Reset()         { *m = RenameTable{} }
 func (m *RenameTable) String() string { return proto.CompactTextString(m) }
 func (*RenameTable) ProtoMessage()    {}
 func (*RenameTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{101}
+	return fileDescriptor_2d655ab2f7683c23, []int{111}
 }
 func (m *RenameTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10567,7 +11454,7 @@ func (m *AlterTable) Reset()         { *m = AlterTable{} }
 func (m *AlterTable) String() string { return proto.CompactTextString(m) }
 func (*AlterTable) ProtoMessage()    {}
 func (*AlterTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{102}
+	return fileDescriptor_2d655ab2f7683c23, []int{112}
 }
 func (m *AlterTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10686,6 +11573,7 @@ type AlterTable_Action struct {
 	//	*AlterTable_Action_DropColumn
 	//	*AlterTable_Action_AlterReindex
 	//	*AlterTable_Action_AddPartition
+	//	*AlterTable_Action_AlterTtl
 	Action               isAlterTable_Action_Action `protobuf_oneof:"action"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
@@ -10696,7 +11584,7 @@ func (m *AlterTable_Action) Reset()         { *m = AlterTable_Action{} }
 func (m *AlterTable_Action) String() string { return proto.CompactTextString(m) }
 func (*AlterTable_Action) ProtoMessage()    {}
 func (*AlterTable_Action) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{102, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{112, 0}
 }
 func (m *AlterTable_Action) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10761,6 +11649,9 @@ type AlterTable_Action_AlterReindex struct {
 type AlterTable_Action_AddPartition struct {
 	AddPartition *AlterTableAddPartition `protobuf:"bytes,10,opt,name=addPartition,proto3,oneof" json:"addPartition,omitempty"`
 }
+type AlterTable_Action_AlterTtl struct {
+	AlterTtl *AlterTableTTL `protobuf:"bytes,11,opt,name=alter_ttl,json=alterTtl,proto3,oneof" json:"alter_ttl,omitempty"`
+}
 
 func (*AlterTable_Action_Drop) isAlterTable_Action_Action()         {}
 func (*AlterTable_Action_AddFk) isAlterTable_Action_Action()        {}
@@ -10772,6 +11663,7 @@ func (*AlterTable_Action_AddColumn) isAlterTable_Action_Action()    {}
 func (*AlterTable_Action_DropColumn) isAlterTable_Action_Action()   {}
 func (*AlterTable_Action_AlterReindex) isAlterTable_Action_Action() {}
 func (*AlterTable_Action_AddPartition) isAlterTable_Action_Action() {}
+func (*AlterTable_Action_AlterTtl) isAlterTable_Action_Action()     {}
 
 func (m *AlterTable_Action) GetAction() isAlterTable_Action_Action {
 	if m != nil {
@@ -10850,6 +11742,13 @@ func (m *AlterTable_Action) GetAddPartition() *AlterTableAddPartition {
 	return nil
 }
 
+func (m *AlterTable_Action) GetAlterTtl() *AlterTableTTL {
+	if x, ok := m.GetAction().(*AlterTable_Action_AlterTtl); ok {
+		return x.AlterTtl
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*AlterTable_Action) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -10863,6 +11762,7 @@ func (*AlterTable_Action) XXX_OneofWrappers() []interface{} {
 		(*AlterTable_Action_DropColumn)(nil),
 		(*AlterTable_Action_AlterReindex)(nil),
 		(*AlterTable_Action_AddPartition)(nil),
+		(*AlterTable_Action_AlterTtl)(nil),
 	}
 }
 
@@ -10891,7 +11791,7 @@ func (m *DropTable) Reset()         { *m = DropTable{} }
 func (m *DropTable) String() string { return proto.CompactTextString(m) }
 func (*DropTable) ProtoMessage()    {}
 func (*DropTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{103}
+	return fileDescriptor_2d655ab2f7683c23, []int{113}
 }
 func (m *DropTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11018,7 +11918,7 @@ func (m *CreateView) Reset()         { *m = CreateView{} }
 func (m *CreateView) String() string { return proto.CompactTextString(m) }
 func (*CreateView) ProtoMessage()    {}
 func (*CreateView) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{104}
+	return fileDescriptor_2d655ab2f7683c23, []int{114}
 }
 func (m *CreateView) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11088,7 +11988,7 @@ func (m *AlterView) Reset()         { *m = AlterView{} }
 func (m *AlterView) String() string { return proto.CompactTextString(m) }
 func (*AlterView) ProtoMessage()    {}
 func (*AlterView) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{105}
+	return fileDescriptor_2d655ab2f7683c23, []int{115}
 }
 func (m *AlterView) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11151,7 +12051,7 @@ func (m *CreateSequence) Reset()         { *m = CreateSequence{} }
 func (m *CreateSequence) String() string { return proto.CompactTextString(m) }
 func (*CreateSequence) ProtoMessage()    {}
 func (*CreateSequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{106}
+	return fileDescriptor_2d655ab2f7683c23, []int{116}
 }
 func (m *CreateSequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11215,7 +12115,7 @@ func (m *DropSequence) Reset()         { *m = DropSequence{} }
 func (m *DropSequence) String() string { return proto.CompactTextString(m) }
 func (*DropSequence) ProtoMessage()    {}
 func (*DropSequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{107}
+	return fileDescriptor_2d655ab2f7683c23, []int{117}
 }
 func (m *DropSequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11285,7 +12185,7 @@ func (m *AlterSequence) Reset()         { *m = AlterSequence{} }
 func (m *AlterSequence) String() string { return proto.CompactTextString(m) }
 func (*AlterSequence) ProtoMessage()    {}
 func (*AlterSequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{108}
+	return fileDescriptor_2d655ab2f7683c23, []int{118}
 }
 func (m *AlterSequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11351,7 +12251,7 @@ func (m *CreateIndex) Reset()         { *m = CreateIndex{} }
 func (m *CreateIndex) String() string { return proto.CompactTextString(m) }
 func (*CreateIndex) ProtoMessage()    {}
 func (*CreateIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{109}
+	return fileDescriptor_2d655ab2f7683c23, []int{119}
 }
 func (m *CreateIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11433,7 +12333,7 @@ func (m *AlterIndex) Reset()         { *m = AlterIndex{} }
 func (m *AlterIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterIndex) ProtoMessage()    {}
 func (*AlterIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{110}
+	return fileDescriptor_2d655ab2f7683c23, []int{120}
 }
 func (m *AlterIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11483,7 +12383,7 @@ func (m *DropIndex) Reset()         { *m = DropIndex{} }
 func (m *DropIndex) String() string { return proto.CompactTextString(m) }
 func (*DropIndex) ProtoMessage()    {}
 func (*DropIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{111}
+	return fileDescriptor_2d655ab2f7683c23, []int{121}
 }
 func (m *DropIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11540,6 +12440,229 @@ func (m *DropIndex) GetIndexTableName() string {
 	return ""
 }
 
+type CreateTrigger struct {
+	Database    string      `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	Table       string      `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`
+	IfNotExists bool        `protobuf:"varint,3,opt,name=if_not_exists,json=ifNotExists,proto3" json:"if_not_exists,omitempty"`
+	Trigger     *TriggerDef `protobuf:"bytes,4,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	// order_trigger is the trigger of FOLLOWS or PRECEDES, it is empty if not specified
+	OrderTrigger string `protobuf:"bytes,5,opt,name=order_trigger,json=orderTrigger,proto3" json:"order_trigger,omitempty"`
+	Follows      bool   `protobuf:"varint,6,opt,name=follows,proto3" json:"follows,omitempty"`
+	// action_statement is the trigger body shown by SHOW TRIGGERS
+	ActionStatement      string   `protobuf:"bytes,7,opt,name=action_statement,json=actionStatement,proto3" json:"action_statement,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateTrigger) Reset()         { *m = CreateTrigger{} }
+func (m *CreateTrigger) String() string { return proto.CompactTextString(m) }
+func (*CreateTrigger) ProtoMessage()    {}
+func (*CreateTrigger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{122}
+}
+func (m *CreateTrigger) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateTrigger.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateTrigger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTrigger.Merge(m, src)
+}
+func (m *CreateTrigger) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *CreateTrigger) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTrigger.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateTrigger proto.InternalMessageInfo
+
+func (m *CreateTrigger) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+func (m *CreateTrigger) GetTable() string {
+	if m != nil {
+		return m.Table
+	}
+	return ""
+}
+
+func (m *CreateTrigger) GetIfNotExists() bool {
+	if m != nil {
+		return m.IfNotExists
+	}
+	return false
+}
+
+func (m *CreateTrigger) GetTrigger() *TriggerDef {
+	if m != nil {
+		return m.Trigger
+	}
+	return nil
+}
+
+func (m *CreateTrigger) GetOrderTrigger() string {
+	if m != nil {
+		return m.OrderTrigger
+	}
+	return ""
+}
+
+func (m *CreateTrigger) GetFollows() bool {
+	if m != nil {
+		return m.Follows
+	}
+	return false
+}
+
+func (m *CreateTrigger) GetActionStatement() string {
+	if m != nil {
+		return m.ActionStatement
+	}
+	return ""
+}
+
+type DropTrigger struct {
+	Database             string   `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	IfExists             bool     `protobuf:"varint,3,opt,name=if_exists,json=ifExists,proto3" json:"if_exists,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DropTrigger) Reset()         { *m = DropTrigger{} }
+func (m *DropTrigger) String() string { return proto.CompactTextString(m) }
+func (*DropTrigger) ProtoMessage()    {}
+func (*DropTrigger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{123}
+}
+func (m *DropTrigger) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DropTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DropTrigger.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DropTrigger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropTrigger.Merge(m, src)
+}
+func (m *DropTrigger) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *DropTrigger) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropTrigger.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DropTrigger proto.InternalMessageInfo
+
+func (m *DropTrigger) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+func (m *DropTrigger) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DropTrigger) GetIfExists() bool {
+	if m != nil {
+		return m.IfExists
+	}
+	return false
+}
+
+type RefreshMaterializedView struct {
+	Database             string               `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	Table                string               `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`
+	Mview                *MaterializedViewDef `protobuf:"bytes,3,opt,name=mview,proto3" json:"mview,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *RefreshMaterializedView) Reset()         { *m = RefreshMaterializedView{} }
+func (m *RefreshMaterializedView) String() string { return proto.CompactTextString(m) }
+func (*RefreshMaterializedView) ProtoMessage()    {}
+func (*RefreshMaterializedView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{124}
+}
+func (m *RefreshMaterializedView) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefreshMaterializedView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefreshMaterializedView.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RefreshMaterializedView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshMaterializedView.Merge(m, src)
+}
+func (m *RefreshMaterializedView) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *RefreshMaterializedView) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshMaterializedView.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefreshMaterializedView proto.InternalMessageInfo
+
+func (m *RefreshMaterializedView) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+func (m *RefreshMaterializedView) GetTable() string {
+	if m != nil {
+		return m.Table
+	}
+	return ""
+}
+
+func (m *RefreshMaterializedView) GetMview() *MaterializedViewDef {
+	if m != nil {
+		return m.Mview
+	}
+	return nil
+}
+
 type TruncateTable struct {
 	Database             string        `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
 	Table                string        `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`
@@ -11558,7 +12681,7 @@ func (m *TruncateTable) Reset()         { *m = TruncateTable{} }
 func (m *TruncateTable) String() string { return proto.CompactTextString(m) }
 func (*TruncateTable) ProtoMessage()    {}
 func (*TruncateTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{112}
+	return fileDescriptor_2d655ab2f7683c23, []int{125}
 }
 func (m *TruncateTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11656,7 +12779,7 @@ func (m *ClusterTable) Reset()         { *m = ClusterTable{} }
 func (m *ClusterTable) String() string { return proto.CompactTextString(m) }
 func (*ClusterTable) ProtoMessage()    {}
 func (*ClusterTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{113}
+	return fileDescriptor_2d655ab2f7683c23, []int{126}
 }
 func (m *ClusterTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11718,7 +12841,7 @@ func (m *ShowVariables) Reset()         { *m = ShowVariables{} }
 func (m *ShowVariables) String() string { return proto.CompactTextString(m) }
 func (*ShowVariables) ProtoMessage()    {}
 func (*ShowVariables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{114}
+	return fileDescriptor_2d655ab2f7683c23, []int{127}
 }
 func (m *ShowVariables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11772,7 +12895,7 @@ func (m *SetVariables) Reset()         { *m = SetVariables{} }
 func (m *SetVariables) String() string { return proto.CompactTextString(m) }
 func (*SetVariables) ProtoMessage()    {}
 func (*SetVariables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{115}
+	return fileDescriptor_2d655ab2f7683c23, []int{128}
 }
 func (m *SetVariables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11823,7 +12946,7 @@ func (m *SetVariablesItem) Reset()         { *m = SetVariablesItem{} }
 func (m *SetVariablesItem) String() string { return proto.CompactTextString(m) }
 func (*SetVariablesItem) ProtoMessage()    {}
 func (*SetVariablesItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{116}
+	return fileDescriptor_2d655ab2f7683c23, []int{129}
 }
 func (m *SetVariablesItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11901,7 +13024,7 @@ func (m *Prepare) Reset()         { *m = Prepare{} }
 func (m *Prepare) String() string { return proto.CompactTextString(m) }
 func (*Prepare) ProtoMessage()    {}
 func (*Prepare) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{117}
+	return fileDescriptor_2d655ab2f7683c23, []int{130}
 }
 func (m *Prepare) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11970,7 +13093,7 @@ func (m *Execute) Reset()         { *m = Execute{} }
 func (m *Execute) String() string { return proto.CompactTextString(m) }
 func (*Execute) ProtoMessage()    {}
 func (*Execute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{118}
+	return fileDescriptor_2d655ab2f7683c23, []int{131}
 }
 func (m *Execute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -12024,7 +13147,7 @@ func (m *Deallocate) Reset()         { *m = Deallocate{} }
 func (m *Deallocate) String() string { return proto.CompactTextString(m) }
 func (*Deallocate) ProtoMessage()    {}
 func (*Deallocate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{119}
+	return fileDescriptor_2d655ab2f7683c23, []int{132}
 }
 func (m *Deallocate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -12071,7 +13194,7 @@ func (m *OtherDCL) Reset()         { *m = OtherDCL{} }
 func (m *OtherDCL) String() string { return proto.CompactTextString(m) }
 func (*OtherDCL) ProtoMessage()    {}
 func (*OtherDCL) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{120}
+	return fileDescriptor_2d655ab2f7683c23, []int{133}
 }
 func (m *OtherDCL) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -12119,7 +13242,7 @@ func (m *TableLockInfo) Reset()         { *m = TableLockInfo{} }
 func (m *TableLockInfo) String() string { return proto.CompactTextString(m) }
 func (*TableLockInfo) ProtoMessage()    {}
 func (*TableLockInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{121}
+	return fileDescriptor_2d655ab2f7683c23, []int{134}
 }
 func (m *TableLockInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -12173,7 +13296,7 @@ func (m *LockTables) Reset()         { *m = LockTables{} }
 func (m *LockTables) String() string { return proto.CompactTextString(m) }
 func (*LockTables) ProtoMessage()    {}
 func (*LockTables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{122}
+	return fileDescriptor_2d655ab2f7683c23, []int{135}
 }
 func (m *LockTables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -12219,7 +13342,7 @@ func (m *UnLockTables) Reset()         { *m = UnLockTables{} }
 func (m *UnLockTables) String() string { return proto.CompactTextString(m) }
 func (*UnLockTables) ProtoMessage()    {}
 func (*UnLockTables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{123}
+	return fileDescriptor_2d655ab2f7683c23, []int{136}
 }
 func (m *UnLockTables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -12259,7 +13382,7 @@ func (m *MetadataScanInfos) Reset()         { *m = MetadataScanInfos{} }
 func (m *MetadataScanInfos) String() string { return proto.CompactTextString(m) }
 func (*MetadataScanInfos) ProtoMessage()    {}
 func (*MetadataScanInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{124}
+	return fileDescriptor_2d655ab2f7683c23, []int{137}
 }
 func (m *MetadataScanInfos) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -12316,7 +13439,7 @@ func (m *MetadataScanInfo) Reset()         { *m = MetadataScanInfo{} }
 func (m *MetadataScanInfo) String() string { return proto.CompactTextString(m) }
 func (*MetadataScanInfo) ProtoMessage()    {}
 func (*MetadataScanInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{125}
+	return fileDescriptor_2d655ab2f7683c23, []int{138}
 }
 func (m *MetadataScanInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -12475,6 +13598,7 @@ func init() {
 	proto.RegisterType((*Decimal128)(nil), "plan.decimal128")
 	proto.RegisterType((*ResultColDef)(nil), "plan.ResultColDef")
 	proto.RegisterType((*ColDef)(nil), "plan.ColDef")
+	proto.RegisterType((*GeneratedCol)(nil), "plan.GeneratedCol")
 	proto.RegisterType((*Default)(nil), "plan.Default")
 	proto.RegisterType((*OnUpdate)(nil), "plan.OnUpdate")
 	proto.RegisterType((*IndexOption)(nil), "plan.IndexOption")
@@ -12482,6 +13606,8 @@ func init() {
 	proto.RegisterType((*IndexDef)(nil), "plan.IndexDef")
 	proto.RegisterType((*ForeignKeyDef)(nil), "plan.ForeignKeyDef")
 	proto.RegisterType((*CheckDef)(nil), "plan.CheckDef")
+	proto.RegisterType((*TriggerDef)(nil), "plan.TriggerDef")
+	proto.RegisterType((*MaterializedViewDef)(nil), "plan.MaterializedViewDef")
 	proto.RegisterType((*ClusterByDef)(nil), "plan.ClusterByDef")
 	proto.RegisterType((*PropertyDef)(nil), "plan.PropertyDef")
 	proto.RegisterType((*Property)(nil), "plan.Property")
@@ -12529,6 +13655,11 @@ func init() {
 	proto.RegisterMapType((map[string]int32)(nil), "plan.ColPosMap.MapEntry")
 	proto.RegisterType((*DeleteCtx)(nil), "plan.DeleteCtx")
 	proto.RegisterType((*PostDmlFullTextCtx)(nil), "plan.PostDmlFullTextCtx")
+	proto.RegisterType((*PostDmlHnswCtx)(nil), "plan.PostDmlHnswCtx")
+	proto.RegisterType((*PostDmlTriggerAction)(nil), "plan.PostDmlTriggerAction")
+	proto.RegisterType((*PostDmlTriggerCtx)(nil), "plan.PostDmlTriggerCtx")
+	proto.RegisterType((*PostDmlMaterializedView)(nil), "plan.PostDmlMaterializedView")
+	proto.RegisterType((*PostDmlMaterializedViewCtx)(nil), "plan.PostDmlMaterializedViewCtx")
 	proto.RegisterType((*PostDmlCtx)(nil), "plan.PostDmlCtx")
 	proto.RegisterType((*Query)(nil), "plan.Query")
 	proto.RegisterType((*TransationControl)(nil), "plan.TransationControl")
@@ -12546,6 +13677,7 @@ func init() {
 	proto.RegisterType((*FkColName)(nil), "plan.FkColName")
 	proto.RegisterType((*ForeignKeyInfo)(nil), "plan.ForeignKeyInfo")
 	proto.RegisterType((*CreateTable)(nil), "plan.CreateTable")
+	proto.RegisterType((*TableTTL)(nil), "plan.TableTTL")
 	proto.RegisterType((*AlterTableDrop)(nil), "plan.AlterTableDrop")
 	proto.RegisterType((*AlterTableAddFk)(nil), "plan.AlterTableAddFk")
 	proto.RegisterType((*AlterTableAddIndex)(nil), "plan.AlterTableAddIndex")
@@ -12554,6 +13686,7 @@ func init() {
 	proto.RegisterType((*AlterTableAlterReIndex)(nil), "plan.AlterTableAlterReIndex")
 	proto.RegisterType((*AlterTableAddPartition)(nil), "plan.AlterTableAddPartition")
 	proto.RegisterType((*AlterTableComment)(nil), "plan.AlterTableComment")
+	proto.RegisterType((*AlterTableTTL)(nil), "plan.AlterTableTTL")
 	proto.RegisterType((*AlterTableName)(nil), "plan.AlterTableName")
 	proto.RegisterType((*AlterAddColumn)(nil), "plan.AlterAddColumn")
 	proto.RegisterType((*AlterDropColumn)(nil), "plan.AlterDropColumn")
@@ -12570,6 +13703,9 @@ func init() {
 	proto.RegisterType((*CreateIndex)(nil), "plan.CreateIndex")
 	proto.RegisterType((*AlterIndex)(nil), "plan.AlterIndex")
 	proto.RegisterType((*DropIndex)(nil), "plan.DropIndex")
+	proto.RegisterType((*CreateTrigger)(nil), "plan.CreateTrigger")
+	proto.RegisterType((*DropTrigger)(nil), "plan.DropTrigger")
+	proto.RegisterType((*RefreshMaterializedView)(nil), "plan.RefreshMaterializedView")
 	proto.RegisterType((*TruncateTable)(nil), "plan.TruncateTable")
 	proto.RegisterType((*ClusterTable)(nil), "plan.ClusterTable")
 	proto.RegisterType((*ShowVariables)(nil), "plan.ShowVariables")
@@ -12589,754 +13725,801 @@ func init() {
 func init() { proto.RegisterFile("plan.proto", fileDescriptor_2d655ab2f7683c23) }
 
 var fileDescriptor_2d655ab2f7683c23 = []byte{
-	// 11944 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0xbd, 0x5d, 0x8c, 0x1c, 0x57,
-	0x76, 0x18, 0x3c, 0x3d, 0xfd, 0x7f, 0xfa, 0x67, 0x6a, 0x2e, 0x87, 0x54, 0x93, 0xa2, 0xc8, 0x61,
-	0x89, 0x92, 0x28, 0x4a, 0xa2, 0x24, 0x52, 0x3f, 0xd4, 0x7a, 0xd7, 0xbb, 0x3d, 0x3d, 0x4d, 0x4e,
-	0x2f, 0x7b, 0xba, 0x67, 0xab, 0x7b, 0x48, 0x69, 0x8d, 0xef, 0x2b, 0x54, 0x77, 0x55, 0xcf, 0x94,
-	0xa6, 0xba, 0xaa, 0x55, 0x55, 0xcd, 0x99, 0x11, 0x60, 0x60, 0xbf, 0xcf, 0x80, 0x13, 0xe7, 0x35,
-	0x80, 0x9f, 0xe2, 0x60, 0xed, 0xa7, 0xc0, 0x88, 0x81, 0x00, 0x31, 0xe0, 0x20, 0x08, 0xf2, 0x92,
-	0x3c, 0x38, 0x46, 0x10, 0x04, 0xc8, 0x83, 0x91, 0x04, 0x70, 0x82, 0xcd, 0x83, 0x9f, 0x62, 0x3f,
-	0x38, 0x2f, 0x79, 0x0b, 0xce, 0xb9, 0xb7, 0xaa, 0x6e, 0x75, 0xf7, 0x88, 0xa2, 0x76, 0x8d, 0x24,
-	0x2f, 0x33, 0x75, 0xcf, 0x39, 0xf7, 0xff, 0xde, 0xf3, 0x77, 0xcf, 0xbd, 0x0d, 0x30, 0x73, 0x0c,
-	0xf7, 0xde, 0xcc, 0xf7, 0x42, 0x8f, 0xe5, 0xf0, 0xfb, 0xda, 0x7b, 0x47, 0x76, 0x78, 0x3c, 0x1f,
-	0xdd, 0x1b, 0x7b, 0xd3, 0xf7, 0x8f, 0xbc, 0x23, 0xef, 0x7d, 0x42, 0x8e, 0xe6, 0x13, 0x4a, 0x51,
-	0x82, 0xbe, 0x78, 0xa6, 0x6b, 0xe0, 0x78, 0xe3, 0x13, 0xf1, 0xbd, 0x11, 0xda, 0x53, 0x2b, 0x08,
-	0x8d, 0xe9, 0x8c, 0x03, 0xd4, 0x3f, 0xc9, 0x40, 0x6e, 0x78, 0x3e, 0xb3, 0x58, 0x1d, 0xd6, 0x6d,
-	0xb3, 0x91, 0xd9, 0xce, 0xdc, 0xc9, 0x6b, 0xeb, 0xb6, 0xc9, 0xb6, 0xa1, 0xe2, 0x7a, 0x61, 0x6f,
-	0xee, 0x38, 0xc6, 0xc8, 0xb1, 0x1a, 0xeb, 0xdb, 0x99, 0x3b, 0x25, 0x4d, 0x06, 0xb1, 0x57, 0xa1,
-	0x6c, 0xcc, 0x43, 0x4f, 0xb7, 0xdd, 0xb1, 0xdf, 0xc8, 0x12, 0xbe, 0x84, 0x80, 0x8e, 0x3b, 0xf6,
-	0xd9, 0x16, 0xe4, 0x4f, 0x6d, 0x33, 0x3c, 0x6e, 0xe4, 0xa8, 0x44, 0x9e, 0x40, 0x68, 0x30, 0x36,
-	0x1c, 0xab, 0x91, 0xe7, 0x50, 0x4a, 0x20, 0x34, 0xa4, 0x4a, 0x0a, 0xdb, 0x99, 0x3b, 0x65, 0x8d,
-	0x27, 0xd8, 0x0d, 0x00, 0xcb, 0x9d, 0x4f, 0x9f, 0x1b, 0xce, 0xdc, 0x0a, 0x1a, 0x45, 0x42, 0x49,
-	0x10, 0xf5, 0x87, 0x50, 0x9e, 0x06, 0x47, 0x7b, 0x96, 0x61, 0x5a, 0x3e, 0x7b, 0x05, 0x8a, 0xd3,
-	0xe0, 0x48, 0x0f, 0x8d, 0x23, 0xd1, 0x85, 0xc2, 0x34, 0x38, 0x1a, 0x1a, 0x47, 0xec, 0x2a, 0x94,
-	0x08, 0x71, 0x3e, 0xe3, 0x7d, 0xc8, 0x6b, 0x48, 0x88, 0x3d, 0x56, 0xff, 0x3a, 0x0f, 0xc5, 0xae,
-	0x1d, 0x5a, 0xbe, 0xe1, 0xb0, 0x2b, 0x50, 0xb0, 0x03, 0x77, 0xee, 0x38, 0x94, 0xbd, 0xa4, 0x89,
-	0x14, 0xbb, 0x02, 0x79, 0xfb, 0xe1, 0x73, 0xc3, 0xe1, 0x79, 0xf7, 0xd6, 0x34, 0x9e, 0x64, 0x0d,
-	0x28, 0xd8, 0x1f, 0x7e, 0x82, 0x88, 0xac, 0x40, 0x88, 0x34, 0x61, 0x1e, 0xdc, 0x47, 0x4c, 0x2e,
-	0xc6, 0x50, 0x9a, 0x30, 0x9f, 0x7c, 0x84, 0x18, 0xec, 0x7d, 0x96, 0x30, 0x94, 0xc6, 0x5a, 0xe6,
-	0x54, 0x0b, 0x0e, 0x40, 0x0d, 0x6b, 0x99, 0x47, 0xb5, 0xcc, 0x79, 0x2d, 0x45, 0x81, 0x10, 0x69,
-	0xc2, 0xf0, 0x5a, 0x4a, 0x31, 0x26, 0xae, 0x65, 0xce, 0x6b, 0x29, 0x6f, 0x67, 0xee, 0xe4, 0x08,
-	0xc3, 0x6b, 0xd9, 0x82, 0x9c, 0x89, 0x70, 0xd8, 0xce, 0xdc, 0xc9, 0xec, 0xad, 0x69, 0x94, 0x42,
-	0x68, 0x80, 0xd0, 0x0a, 0x0e, 0x30, 0x42, 0x03, 0x01, 0x1d, 0x21, 0xb4, 0x8a, 0xa3, 0x81, 0xd0,
-	0x91, 0x80, 0x4e, 0x10, 0x5a, 0xdb, 0xce, 0xdc, 0x59, 0x47, 0x28, 0xa6, 0xd8, 0x35, 0x28, 0x9a,
-	0x46, 0x68, 0x21, 0xa2, 0x2e, 0xba, 0x1c, 0x01, 0x10, 0x87, 0x2b, 0x0e, 0x71, 0x1b, 0xa2, 0xd3,
-	0x11, 0x80, 0xa9, 0x50, 0x41, 0xb2, 0x08, 0xaf, 0x08, 0xbc, 0x0c, 0x64, 0x1f, 0x43, 0xd5, 0xb4,
-	0xc6, 0xf6, 0xd4, 0x70, 0x78, 0x9f, 0x36, 0xb7, 0x33, 0x77, 0x2a, 0xf7, 0x37, 0xee, 0xd1, 0x9e,
-	0x88, 0x31, 0x7b, 0x6b, 0x5a, 0x8a, 0x8c, 0x3d, 0x84, 0x9a, 0x48, 0x7f, 0x78, 0x9f, 0x06, 0x96,
-	0x51, 0x3e, 0x25, 0x95, 0xef, 0xc3, 0xfb, 0x0f, 0xf7, 0xd6, 0xb4, 0x34, 0x21, 0xbb, 0x0d, 0xd5,
-	0x78, 0x8b, 0x60, 0xc6, 0x4b, 0xa2, 0x55, 0x29, 0x28, 0x76, 0xeb, 0xcb, 0xc0, 0x73, 0x91, 0x60,
-	0x4b, 0x8c, 0x5b, 0x04, 0x60, 0xdb, 0x00, 0xa6, 0x35, 0x31, 0xe6, 0x4e, 0x88, 0xe8, 0xcb, 0x62,
-	0x00, 0x25, 0x18, 0xbb, 0x01, 0xe5, 0xf9, 0x0c, 0x7b, 0xf9, 0xd4, 0x70, 0x1a, 0x57, 0x04, 0x41,
-	0x02, 0xc2, 0xd2, 0x71, 0x9d, 0x23, 0xf6, 0x15, 0x31, 0xbb, 0x11, 0x00, 0xf7, 0x8a, 0x1d, 0xec,
-	0xd8, 0x6e, 0xa3, 0x41, 0xeb, 0x94, 0x27, 0xd8, 0x75, 0xc8, 0x06, 0xfe, 0xb8, 0x71, 0x95, 0x7a,
-	0x09, 0xbc, 0x97, 0xed, 0xb3, 0x99, 0xaf, 0x21, 0x78, 0xa7, 0x08, 0x79, 0xda, 0x33, 0xea, 0x75,
-	0x28, 0x1d, 0x18, 0xbe, 0x31, 0xd5, 0xac, 0x09, 0x53, 0x20, 0x3b, 0xf3, 0x02, 0xb1, 0x5b, 0xf0,
-	0x53, 0xed, 0x42, 0xe1, 0xa9, 0xe1, 0x23, 0x8e, 0x41, 0xce, 0x35, 0xa6, 0x16, 0x21, 0xcb, 0x1a,
-	0x7d, 0xe3, 0x0e, 0x09, 0xce, 0x83, 0xd0, 0x9a, 0x0a, 0x56, 0x20, 0x52, 0x08, 0x3f, 0x72, 0xbc,
-	0x91, 0xd8, 0x09, 0x25, 0x4d, 0xa4, 0xd4, 0xff, 0x3f, 0x03, 0x85, 0x96, 0xe7, 0x60, 0x71, 0xaf,
-	0x40, 0xd1, 0xb7, 0x1c, 0x3d, 0xa9, 0xae, 0xe0, 0x5b, 0xce, 0x81, 0x17, 0x20, 0x62, 0xec, 0x71,
-	0x04, 0xdf, 0x9b, 0x85, 0xb1, 0x47, 0x88, 0xa8, 0x01, 0x59, 0xa9, 0x01, 0x57, 0xa1, 0x14, 0x8e,
-	0x1c, 0x9d, 0xe0, 0x39, 0x82, 0x17, 0xc3, 0x91, 0xd3, 0x43, 0xd4, 0x2b, 0x50, 0x34, 0x47, 0x1c,
-	0x93, 0x27, 0x4c, 0xc1, 0x1c, 0x21, 0x42, 0xfd, 0x0c, 0xca, 0x9a, 0x71, 0x2a, 0x9a, 0x71, 0x19,
-	0x0a, 0x58, 0x80, 0xe0, 0x72, 0x39, 0x2d, 0x1f, 0x8e, 0x9c, 0x8e, 0x89, 0x60, 0x6c, 0x84, 0x6d,
-	0x52, 0x1b, 0x72, 0x5a, 0x7e, 0xec, 0x39, 0x1d, 0x53, 0x1d, 0x02, 0xb4, 0x3c, 0xdf, 0xff, 0xce,
-	0x5d, 0xd8, 0x82, 0xbc, 0x69, 0xcd, 0xc2, 0x63, 0xce, 0x20, 0x34, 0x9e, 0x50, 0xef, 0x42, 0x09,
-	0xe7, 0xa5, 0x6b, 0x07, 0x21, 0xbb, 0x01, 0x39, 0xc7, 0x0e, 0xc2, 0x46, 0x66, 0x3b, 0xbb, 0x30,
-	0x6b, 0x04, 0x57, 0xb7, 0xa1, 0xb4, 0x6f, 0x9c, 0x3d, 0xc5, 0x99, 0xc3, 0xd2, 0x68, 0x0a, 0xc5,
-	0x94, 0x88, 0xf9, 0xac, 0x02, 0x0c, 0x0d, 0xff, 0xc8, 0x0a, 0x89, 0x9f, 0xfd, 0x4d, 0x06, 0x2a,
-	0x83, 0xf9, 0xe8, 0xab, 0xb9, 0xe5, 0x9f, 0x63, 0x9b, 0xef, 0x40, 0x36, 0x3c, 0x9f, 0x51, 0x8e,
-	0xfa, 0xfd, 0x2b, 0xbc, 0x78, 0x09, 0x7f, 0x0f, 0x33, 0x69, 0x48, 0x82, 0x9d, 0x70, 0x3d, 0xd3,
-	0x8a, 0xc6, 0x20, 0xaf, 0x15, 0x30, 0xd9, 0x31, 0x51, 0x28, 0x78, 0x33, 0x31, 0x0b, 0xeb, 0xde,
-	0x8c, 0x6d, 0x43, 0x7e, 0x7c, 0x6c, 0x3b, 0x26, 0x4d, 0x40, 0xba, 0xcd, 0x1c, 0x81, 0xb3, 0xe4,
-	0x7b, 0xa7, 0x7a, 0x60, 0x7f, 0x1d, 0x31, 0xf9, 0xa2, 0xef, 0x9d, 0x0e, 0xec, 0xaf, 0x2d, 0x75,
-	0x28, 0x24, 0x0d, 0x40, 0x61, 0xd0, 0x6a, 0x76, 0x9b, 0x9a, 0xb2, 0x86, 0xdf, 0xed, 0xcf, 0x3b,
-	0x83, 0xe1, 0x40, 0xc9, 0xb0, 0x3a, 0x40, 0xaf, 0x3f, 0xd4, 0x45, 0x7a, 0x9d, 0x15, 0x60, 0xbd,
-	0xd3, 0x53, 0xb2, 0x48, 0x83, 0xf0, 0x4e, 0x4f, 0xc9, 0xb1, 0x22, 0x64, 0x9b, 0xbd, 0x2f, 0x94,
-	0x3c, 0x7d, 0x74, 0xbb, 0x4a, 0x41, 0xfd, 0xc3, 0x75, 0x28, 0xf7, 0x47, 0x5f, 0x5a, 0xe3, 0x10,
-	0xfb, 0x8c, 0xab, 0xd4, 0xf2, 0x9f, 0x5b, 0x3e, 0x75, 0x3b, 0xab, 0x89, 0x14, 0x76, 0xc4, 0x1c,
-	0x51, 0xe7, 0xb2, 0xda, 0xba, 0x39, 0x22, 0xba, 0xf1, 0xb1, 0x35, 0x35, 0xa8, 0x73, 0x48, 0x47,
-	0x29, 0xdc, 0x15, 0xde, 0xe8, 0x4b, 0xea, 0x5e, 0x56, 0xc3, 0x4f, 0x76, 0x13, 0x2a, 0xbc, 0x0c,
-	0x79, 0x7d, 0x01, 0x07, 0x2d, 0x2e, 0xbe, 0x82, 0xbc, 0xf8, 0x28, 0x27, 0x95, 0xca, 0x91, 0x42,
-	0x82, 0x71, 0x50, 0x4f, 0xac, 0x68, 0x6f, 0xf4, 0x25, 0xc7, 0x96, 0xf8, 0x8a, 0xf6, 0x46, 0x5f,
-	0x12, 0xea, 0x1d, 0xd8, 0x0c, 0xe6, 0xa3, 0x60, 0xec, 0xdb, 0xb3, 0xd0, 0xf6, 0x5c, 0x4e, 0x53,
-	0x26, 0x1a, 0x45, 0x46, 0x10, 0xf1, 0x1d, 0x28, 0xcd, 0xe6, 0x23, 0xdd, 0x76, 0x27, 0x1e, 0x31,
-	0xf7, 0xca, 0xfd, 0x1a, 0x9f, 0x98, 0x83, 0xf9, 0xa8, 0xe3, 0x4e, 0x3c, 0xad, 0x38, 0xe3, 0x1f,
-	0xea, 0x9b, 0x50, 0x14, 0x30, 0x94, 0xde, 0xa1, 0xe5, 0x1a, 0x6e, 0xa8, 0xc7, 0x62, 0xbf, 0xc4,
-	0x01, 0x1d, 0x53, 0xfd, 0xe3, 0x0c, 0x28, 0x03, 0xa9, 0x9a, 0x7d, 0x2b, 0x34, 0x56, 0x72, 0x85,
-	0xd7, 0x00, 0x8c, 0xf1, 0xd8, 0x9b, 0xf3, 0x62, 0xf8, 0xe2, 0x29, 0x0b, 0x48, 0xc7, 0x94, 0xc7,
-	0x26, 0x9b, 0x1a, 0x9b, 0x5b, 0x50, 0x8d, 0xf2, 0x49, 0x1b, 0xba, 0x22, 0x60, 0xd1, 0xe8, 0x04,
-	0xf3, 0xd4, 0xae, 0x2e, 0x06, 0x73, 0x9e, 0xfb, 0x0a, 0x14, 0x48, 0x47, 0x08, 0xa2, 0x11, 0xe7,
-	0x29, 0xf5, 0xcf, 0x33, 0x50, 0xeb, 0xb8, 0xa6, 0x75, 0x36, 0x18, 0x1b, 0x2e, 0xf5, 0x52, 0x85,
-	0x9a, 0x1d, 0xe8, 0x36, 0xc2, 0xf4, 0x60, 0x6c, 0xb8, 0x42, 0xbc, 0x57, 0xec, 0x20, 0xa6, 0xc3,
-	0x3e, 0x70, 0x02, 0xaa, 0x6a, 0x9d, 0x4a, 0x2c, 0x13, 0x84, 0x2a, 0x7b, 0x13, 0x36, 0x46, 0x96,
-	0xe3, 0xb9, 0x47, 0x7a, 0xe8, 0xe9, 0x5c, 0x4f, 0xe1, 0x7d, 0xa9, 0x71, 0xf0, 0xd0, 0x1b, 0x92,
-	0xbe, 0xb2, 0x05, 0xf9, 0x99, 0xe1, 0x87, 0x41, 0x23, 0xb7, 0x9d, 0xc5, 0x2d, 0x4a, 0x09, 0x1c,
-	0x66, 0x3b, 0xd0, 0xe7, 0xae, 0xfd, 0xd5, 0x9c, 0x77, 0xa3, 0xa4, 0x95, 0xec, 0xe0, 0x90, 0xd2,
-	0xec, 0x0e, 0x28, 0xbc, 0x66, 0x2a, 0x56, 0x5e, 0x43, 0x75, 0x82, 0x53, 0xc1, 0xc4, 0xc8, 0xfe,
-	0xde, 0x3a, 0x94, 0x1e, 0xcd, 0xdd, 0x31, 0x4e, 0x06, 0x7b, 0x1d, 0x72, 0x93, 0xb9, 0x3b, 0xa6,
-	0xbe, 0xc4, 0xc2, 0x30, 0xde, 0x03, 0x1a, 0x21, 0x91, 0xbb, 0x18, 0xfe, 0x11, 0x72, 0xa5, 0x25,
-	0xee, 0x82, 0x70, 0xf5, 0x9f, 0x65, 0x78, 0x89, 0x8f, 0x1c, 0xe3, 0x88, 0x95, 0x20, 0xd7, 0xeb,
-	0xf7, 0xda, 0xca, 0x1a, 0xab, 0x42, 0xa9, 0xd3, 0x1b, 0xb6, 0xb5, 0x5e, 0xb3, 0xab, 0x64, 0x68,
-	0xab, 0x0e, 0x9b, 0x3b, 0xdd, 0xb6, 0xb2, 0x8e, 0x98, 0xa7, 0xfd, 0x6e, 0x73, 0xd8, 0xe9, 0xb6,
-	0x95, 0x1c, 0xc7, 0x68, 0x9d, 0xd6, 0x50, 0x29, 0x31, 0x05, 0xaa, 0x07, 0x5a, 0x7f, 0xf7, 0xb0,
-	0xd5, 0xd6, 0x7b, 0x87, 0xdd, 0xae, 0xa2, 0xb0, 0x4b, 0xb0, 0x11, 0x43, 0xfa, 0x1c, 0xb8, 0x8d,
-	0x59, 0x9e, 0x36, 0xb5, 0xa6, 0xf6, 0x58, 0xf9, 0x11, 0x2b, 0x41, 0xb6, 0xf9, 0xf8, 0xb1, 0xf2,
-	0x33, 0xdc, 0xf5, 0xe5, 0x67, 0x9d, 0x9e, 0xfe, 0xb4, 0xd9, 0x3d, 0x6c, 0x2b, 0x3f, 0x5b, 0x8f,
-	0xd2, 0x7d, 0x6d, 0xb7, 0xad, 0x29, 0x3f, 0xcb, 0xb1, 0x4d, 0xa8, 0xfe, 0xb4, 0xdf, 0x6b, 0xef,
-	0x37, 0x0f, 0x0e, 0xa8, 0x21, 0x3f, 0x2b, 0xa9, 0xff, 0x3d, 0x07, 0x39, 0xec, 0x09, 0x53, 0x13,
-	0x0e, 0x17, 0x77, 0x11, 0x59, 0xcc, 0x4e, 0xee, 0x4f, 0xff, 0xe2, 0xe6, 0x1a, 0xe7, 0x6d, 0xb7,
-	0x20, 0xeb, 0xd8, 0x21, 0x4d, 0x6b, 0xbc, 0x2f, 0x84, 0xd6, 0xb7, 0xb7, 0xa6, 0x21, 0x8e, 0xdd,
-	0x80, 0x0c, 0x67, 0x72, 0x95, 0xfb, 0x75, 0xb1, 0x71, 0x84, 0x94, 0xdc, 0x5b, 0xd3, 0x32, 0x33,
-	0x76, 0x1d, 0x32, 0xcf, 0x05, 0xc7, 0xab, 0x72, 0x3c, 0x97, 0x93, 0x88, 0x7d, 0xce, 0xb6, 0x21,
-	0x3b, 0xf6, 0xb8, 0x4e, 0x17, 0xe3, 0xb9, 0xd4, 0xc0, 0xf2, 0xc7, 0x9e, 0xc3, 0x5e, 0x87, 0xac,
-	0x6f, 0x9c, 0xd2, 0xcc, 0xc6, 0xd3, 0x15, 0x8b, 0x25, 0x24, 0xf2, 0x8d, 0x53, 0x6c, 0xc4, 0x84,
-	0x78, 0x44, 0xdc, 0x88, 0x68, 0xbe, 0xb1, 0x9a, 0x09, 0xdb, 0x86, 0xcc, 0x29, 0x71, 0x89, 0x58,
-	0x8d, 0x79, 0x66, 0xbb, 0xa6, 0x77, 0x3a, 0x98, 0x59, 0x63, 0xa4, 0x38, 0x65, 0x6f, 0x40, 0x36,
-	0x98, 0x8f, 0x88, 0x4b, 0x54, 0xee, 0x6f, 0x2e, 0xf1, 0x7b, 0xac, 0x28, 0x98, 0x8f, 0xd8, 0x9b,
-	0x90, 0x1b, 0x7b, 0xbe, 0x2f, 0x38, 0x85, 0x12, 0x35, 0x38, 0x12, 0x75, 0xa8, 0xd6, 0x21, 0x1e,
-	0x2b, 0x0c, 0x49, 0x2b, 0x8c, 0x89, 0x12, 0x59, 0x83, 0x15, 0x86, 0xec, 0xb6, 0x10, 0x60, 0x55,
-	0xb9, 0xd5, 0x91, 0x78, 0xc3, 0x72, 0x10, 0x8b, 0x93, 0x34, 0x35, 0xce, 0x48, 0x67, 0x8c, 0x89,
-	0x22, 0xb9, 0x86, 0x6d, 0x9a, 0x1a, 0x67, 0xec, 0x36, 0x64, 0x9f, 0x5b, 0x63, 0x52, 0x1f, 0xe3,
-	0xda, 0xc4, 0x24, 0x3d, 0xa5, 0xee, 0x21, 0x9a, 0xd6, 0xbd, 0xe7, 0x98, 0xa4, 0x49, 0xc6, 0x73,
-	0xf9, 0xc8, 0x73, 0xcc, 0xa7, 0x34, 0x97, 0x84, 0x44, 0x71, 0x6e, 0xcc, 0xcf, 0x90, 0x1b, 0x29,
-	0x5c, 0xf0, 0x1a, 0xf3, 0xb3, 0x8e, 0x89, 0x8c, 0xdd, 0x35, 0x9f, 0x93, 0xfe, 0x98, 0xd1, 0xf0,
-	0x13, 0x0d, 0x9c, 0xc0, 0x72, 0xac, 0x71, 0x68, 0x3f, 0xb7, 0xc3, 0x73, 0xd2, 0x10, 0x33, 0x9a,
-	0x0c, 0xda, 0x29, 0x40, 0xce, 0x3a, 0x9b, 0xf9, 0xea, 0x1e, 0x14, 0x45, 0x2d, 0x4b, 0x56, 0xd2,
-	0x55, 0x28, 0xd9, 0x81, 0x3e, 0xf6, 0xdc, 0x20, 0x14, 0x7a, 0x51, 0xd1, 0x0e, 0x5a, 0x98, 0x44,
-	0x76, 0x69, 0x1a, 0x21, 0x17, 0x30, 0x55, 0x8d, 0xbe, 0xd5, 0xfb, 0x00, 0x49, 0xb7, 0xb0, 0x4d,
-	0x8e, 0xe5, 0x46, 0x2a, 0x98, 0x63, 0xb9, 0x71, 0x9e, 0x75, 0x29, 0xcf, 0x55, 0x28, 0xc7, 0xba,
-	0x2d, 0xab, 0x42, 0xc6, 0x10, 0xa2, 0x2d, 0x63, 0xa8, 0x77, 0x50, 0xd5, 0x8c, 0xb4, 0xd7, 0x34,
-	0x0e, 0x53, 0x91, 0xc0, 0xcb, 0x8c, 0xd4, 0xef, 0x43, 0x55, 0xb3, 0x82, 0xb9, 0x13, 0xb6, 0x3c,
-	0x67, 0xd7, 0x9a, 0xb0, 0x77, 0x01, 0xe2, 0x74, 0x20, 0x34, 0x90, 0x64, 0xed, 0xee, 0x5a, 0x13,
-	0x4d, 0xc2, 0xab, 0xff, 0x28, 0x47, 0xba, 0xdc, 0x2e, 0x57, 0xa2, 0x84, 0xb6, 0x94, 0x91, 0xb4,
-	0xa5, 0x58, 0x36, 0xac, 0xa7, 0x35, 0xc6, 0x63, 0xdb, 0x34, 0x2d, 0x37, 0xd2, 0x0c, 0x79, 0x0a,
-	0x27, 0xdb, 0x70, 0x8e, 0x68, 0x43, 0xd5, 0xef, 0xb3, 0xa8, 0xd2, 0xe9, 0xcc, 0xb7, 0x82, 0x80,
-	0xeb, 0x24, 0x86, 0x73, 0x14, 0xed, 0xed, 0xfc, 0x37, 0xed, 0xed, 0xab, 0x50, 0x72, 0xbd, 0x50,
-	0x27, 0xbb, 0xad, 0xc0, 0x47, 0x5f, 0x18, 0xa8, 0xec, 0x2d, 0x28, 0x0a, 0x8d, 0x5b, 0x6c, 0x2a,
-	0xb1, 0x5c, 0x76, 0x39, 0x50, 0x8b, 0xb0, 0xac, 0x81, 0x0a, 0xdc, 0x74, 0x6a, 0xb9, 0x61, 0x24,
-	0x83, 0x45, 0x92, 0xbd, 0x03, 0x65, 0xcf, 0xd5, 0xb9, 0x5a, 0x2e, 0x76, 0x95, 0x58, 0xbe, 0x7d,
-	0xf7, 0x90, 0xa0, 0x5a, 0xc9, 0x13, 0x5f, 0xd8, 0x14, 0xc7, 0x3b, 0xd5, 0xc7, 0x86, 0x6f, 0xd2,
-	0xce, 0x2a, 0x69, 0x45, 0xc7, 0x3b, 0x6d, 0x19, 0xbe, 0xc9, 0x75, 0x92, 0xaf, 0xdc, 0xf9, 0x94,
-	0x76, 0x53, 0x4d, 0x13, 0x29, 0x76, 0x1d, 0xca, 0x63, 0x67, 0x1e, 0x84, 0x96, 0xbf, 0x73, 0xce,
-	0x0d, 0x2d, 0x2d, 0x01, 0x60, 0xbb, 0x66, 0xbe, 0x3d, 0x35, 0xfc, 0x73, 0xda, 0x3a, 0x25, 0x2d,
-	0x4a, 0x92, 0xa0, 0x39, 0xb1, 0xcd, 0x33, 0x6e, 0x6d, 0x69, 0x3c, 0x81, 0xf4, 0xc7, 0x64, 0x0b,
-	0x07, 0xb4, 0x3f, 0x4a, 0x5a, 0x94, 0xa4, 0x79, 0xa0, 0x4f, 0xda, 0x11, 0x65, 0x4d, 0xa4, 0x52,
-	0x0a, 0xf5, 0xe6, 0x85, 0x0a, 0x35, 0x5b, 0xd4, 0x69, 0x3c, 0xdf, 0x3e, 0xb2, 0x85, 0x46, 0x72,
-	0x89, 0xeb, 0x34, 0x1c, 0x44, 0x82, 0xea, 0x2b, 0x28, 0x8a, 0x21, 0x46, 0x09, 0x84, 0xdb, 0x27,
-	0xcd, 0x9e, 0xb9, 0x04, 0x42, 0x38, 0x7b, 0x1d, 0x6a, 0xa2, 0xac, 0x20, 0xf4, 0x6d, 0xf7, 0x48,
-	0x2c, 0x9e, 0x2a, 0x07, 0x0e, 0x08, 0x86, 0x8a, 0x02, 0x4e, 0xaf, 0x6e, 0x8c, 0x6c, 0x07, 0xb7,
-	0x69, 0x56, 0xf8, 0x21, 0xe6, 0x8e, 0xd3, 0xe4, 0x20, 0xb5, 0x0f, 0xa5, 0x68, 0x42, 0x7e, 0x25,
-	0x75, 0xaa, 0xbf, 0x9d, 0x81, 0x0a, 0xa9, 0x07, 0x7d, 0x52, 0x7e, 0xd8, 0xbb, 0xc0, 0xc6, 0xbe,
-	0x65, 0x84, 0x96, 0x6e, 0x9d, 0x85, 0xbe, 0x21, 0x94, 0x00, 0xae, 0x49, 0x28, 0x1c, 0xd3, 0x46,
-	0x04, 0xd7, 0x03, 0x6e, 0x42, 0x65, 0x66, 0xf8, 0x41, 0xa4, 0x30, 0xf2, 0x0a, 0x80, 0x83, 0x84,
-	0xba, 0xa6, 0xb8, 0x47, 0xbe, 0x31, 0xd5, 0x43, 0xef, 0xc4, 0x72, 0xb9, 0xaa, 0xcc, 0x8d, 0x84,
-	0x3a, 0xc1, 0x87, 0x08, 0x26, 0x8d, 0xf9, 0x3f, 0x65, 0xa0, 0x76, 0xc0, 0x67, 0xfd, 0x89, 0x75,
-	0xbe, 0xcb, 0x2d, 0xb3, 0x71, 0xb4, 0x63, 0x73, 0x1a, 0x7d, 0xb3, 0x1b, 0x50, 0x99, 0x9d, 0x58,
-	0xe7, 0x7a, 0xca, 0x8a, 0x29, 0x23, 0xa8, 0x45, 0x7b, 0xf3, 0x6d, 0x28, 0x78, 0xd4, 0x11, 0x21,
-	0xe3, 0x84, 0x68, 0x90, 0x7a, 0xa8, 0x09, 0x02, 0x54, 0x97, 0xe2, 0xa2, 0x64, 0xbd, 0x4c, 0x14,
-	0x46, 0xcd, 0xdf, 0x82, 0x3c, 0xa2, 0x82, 0x46, 0x9e, 0xeb, 0x39, 0x94, 0x60, 0x1f, 0x40, 0x6d,
-	0xec, 0x4d, 0x67, 0x7a, 0x94, 0x5d, 0x48, 0xbb, 0x34, 0x4f, 0xa9, 0x20, 0xc9, 0x01, 0x2f, 0x4b,
-	0xfd, 0xdd, 0x2c, 0x94, 0xa8, 0x0d, 0x82, 0xad, 0xd8, 0xe6, 0x59, 0xc4, 0x56, 0xca, 0x5a, 0xde,
-	0x36, 0x91, 0x6b, 0xbf, 0x40, 0x35, 0x8b, 0x55, 0xae, 0xac, 0xac, 0x72, 0x5d, 0x81, 0x82, 0xd0,
-	0xb7, 0x72, 0x9c, 0xef, 0xcc, 0x2f, 0xd6, 0xb6, 0xf2, 0xab, 0xb4, 0x2d, 0x9c, 0x42, 0x4e, 0x63,
-	0x9d, 0xa1, 0x7c, 0xe3, 0xac, 0x05, 0x08, 0xd4, 0x46, 0x88, 0xcc, 0x34, 0x8a, 0x69, 0xa6, 0xd1,
-	0x80, 0xe2, 0x73, 0x3b, 0xb0, 0x71, 0x81, 0x94, 0xf8, 0x36, 0x14, 0x49, 0x69, 0x1a, 0xca, 0x2f,
-	0x9a, 0x86, 0xb8, 0xdb, 0x86, 0x73, 0xc4, 0x55, 0xfa, 0xa8, 0xdb, 0x4d, 0xe7, 0xc8, 0x63, 0x1f,
-	0xc2, 0xe5, 0x04, 0x2d, 0x7a, 0x43, 0x0e, 0x2e, 0xf2, 0xe1, 0x68, 0x2c, 0xa6, 0xa4, 0x1e, 0x91,
-	0xcd, 0x75, 0x17, 0x36, 0xa5, 0x2c, 0x33, 0x54, 0x6f, 0x02, 0xe2, 0x39, 0x65, 0x6d, 0x23, 0x26,
-	0x27, 0xad, 0x27, 0x50, 0xff, 0xcd, 0x3a, 0xd4, 0x1e, 0x79, 0xbe, 0x65, 0x1f, 0xb9, 0xc9, 0xaa,
-	0x5b, 0xd2, 0xfc, 0xa3, 0x95, 0xb8, 0x2e, 0xad, 0xc4, 0x9b, 0x50, 0x99, 0xf0, 0x8c, 0x7a, 0x38,
-	0xe2, 0x0e, 0x81, 0x9c, 0x06, 0x02, 0x34, 0x1c, 0x39, 0xb8, 0x9b, 0x23, 0x02, 0xca, 0x9c, 0xa3,
-	0xcc, 0x51, 0x26, 0x94, 0x35, 0xec, 0x7b, 0xc4, 0x75, 0x4d, 0xcb, 0xb1, 0x42, 0x3e, 0x3d, 0xf5,
-	0xfb, 0xaf, 0x45, 0x92, 0x5e, 0x6a, 0xd3, 0x3d, 0xcd, 0x9a, 0x34, 0x49, 0x3d, 0x42, 0x26, 0xbc,
-	0x4b, 0xe4, 0x22, 0xaf, 0xe0, 0xd8, 0x85, 0x6f, 0x99, 0x97, 0x73, 0x0e, 0x75, 0x08, 0xe5, 0x18,
-	0x8c, 0xba, 0xae, 0xd6, 0x16, 0xfa, 0xed, 0x1a, 0xab, 0x40, 0xb1, 0xd5, 0x1c, 0xb4, 0x9a, 0xbb,
-	0x6d, 0x25, 0x83, 0xa8, 0x41, 0x7b, 0xc8, 0x75, 0xda, 0x75, 0xb6, 0x01, 0x15, 0x4c, 0xed, 0xb6,
-	0x1f, 0x35, 0x0f, 0xbb, 0x43, 0x25, 0xcb, 0x6a, 0x50, 0xee, 0xf5, 0xf5, 0x66, 0x6b, 0xd8, 0xe9,
-	0xf7, 0x94, 0x9c, 0xfa, 0x23, 0x28, 0xb5, 0x8e, 0xad, 0xf1, 0xc9, 0x45, 0xa3, 0x48, 0x06, 0xb5,
-	0x35, 0x3e, 0x11, 0xfa, 0xe9, 0x82, 0x41, 0x6d, 0x8d, 0x4f, 0xd4, 0xa7, 0x50, 0x6d, 0x45, 0x42,
-	0xe1, 0xa2, 0x52, 0xee, 0x43, 0x9d, 0x36, 0xdf, 0x78, 0x14, 0xed, 0xbe, 0xf5, 0x15, 0xbb, 0xaf,
-	0x8a, 0x34, 0xad, 0x91, 0xd8, 0x7e, 0x1f, 0x43, 0xe5, 0xc0, 0xf7, 0x66, 0x96, 0x1f, 0x52, 0xb1,
-	0x0a, 0x64, 0x4f, 0xac, 0x73, 0x51, 0x2a, 0x7e, 0x26, 0x2e, 0x87, 0x75, 0xd9, 0xe5, 0x70, 0x1f,
-	0x4a, 0x51, 0xb6, 0x6f, 0x9d, 0xe7, 0x87, 0xc8, 0xc5, 0x28, 0x8f, 0x6d, 0x05, 0x58, 0xd9, 0x3d,
-	0x80, 0x59, 0x0c, 0x10, 0xda, 0x47, 0xa4, 0x79, 0x8b, 0xc2, 0x35, 0x89, 0x42, 0xfd, 0x9b, 0x2c,
-	0xd4, 0x0f, 0x0c, 0x3f, 0xb4, 0x71, 0x72, 0xf8, 0x30, 0xbc, 0x05, 0x39, 0x5a, 0xf2, 0xdc, 0xbb,
-	0x71, 0x29, 0x56, 0xdb, 0x39, 0x0d, 0xa9, 0x11, 0x44, 0xc0, 0xbe, 0x07, 0xf5, 0x59, 0x04, 0xd6,
-	0x49, 0x36, 0xf0, 0xb1, 0x59, 0xcc, 0x42, 0x63, 0x5e, 0x9b, 0xc9, 0x49, 0xf6, 0x03, 0xd8, 0x4a,
-	0xe7, 0xb5, 0x82, 0x20, 0xe1, 0xa3, 0xf2, 0x64, 0x5d, 0x4a, 0x65, 0xe4, 0x64, 0xac, 0x05, 0x9b,
-	0x49, 0xf6, 0xb1, 0xe7, 0xcc, 0xa7, 0x6e, 0x20, 0xec, 0x88, 0x2b, 0x0b, 0xb5, 0xb7, 0x38, 0x56,
-	0x53, 0x66, 0x0b, 0x10, 0xa6, 0x42, 0x35, 0x86, 0xf5, 0xe6, 0x53, 0xda, 0x12, 0x39, 0x2d, 0x05,
-	0x63, 0x0f, 0x00, 0xe2, 0x34, 0xda, 0xc4, 0xd9, 0x15, 0xfd, 0xeb, 0x84, 0xd6, 0x54, 0x93, 0xc8,
-	0x50, 0xfd, 0x40, 0x66, 0xe0, 0xdb, 0xe1, 0xf1, 0x94, 0xb8, 0x58, 0x56, 0x4b, 0x00, 0xc4, 0x2c,
-	0x03, 0x1d, 0x0d, 0xf0, 0x38, 0x8b, 0x60, 0x68, 0x75, 0x3b, 0x18, 0xcc, 0x47, 0x71, 0xb9, 0x28,
-	0x52, 0x93, 0x5e, 0x4e, 0x83, 0x23, 0xe1, 0xa6, 0x48, 0x5a, 0xb8, 0x1f, 0x1c, 0xb1, 0xfb, 0x70,
-	0x39, 0x21, 0x4a, 0xf8, 0x6f, 0xd0, 0x00, 0xe2, 0xdc, 0xc9, 0xf0, 0xc5, 0x4c, 0x38, 0x50, 0x7f,
-	0x0c, 0xb5, 0xd4, 0xec, 0xbc, 0x50, 0xb8, 0x5f, 0x85, 0x12, 0xfe, 0x47, 0xd1, 0x2e, 0x16, 0x60,
-	0x11, 0xd3, 0x83, 0xd0, 0x57, 0x2d, 0x50, 0x16, 0xc7, 0x9a, 0xdd, 0x26, 0xd7, 0x1d, 0x4d, 0xca,
-	0xb2, 0x0b, 0x2e, 0x42, 0xb1, 0x77, 0x56, 0x4d, 0xe2, 0x3a, 0xb5, 0x7a, 0x69, 0xb2, 0xd4, 0xdf,
-	0x5f, 0x97, 0xda, 0x8c, 0x23, 0xce, 0xde, 0x90, 0x97, 0x9f, 0xb4, 0x71, 0x93, 0x31, 0x23, 0x89,
-	0xf3, 0x36, 0x28, 0x9e, 0x6f, 0xda, 0xae, 0x41, 0xae, 0x44, 0x3e, 0xdc, 0xeb, 0xa4, 0x2d, 0x6e,
-	0x08, 0xf8, 0x81, 0x00, 0xa3, 0xdd, 0x62, 0x5a, 0xb1, 0x67, 0x46, 0xf8, 0x22, 0x64, 0x90, 0x2c,
-	0x9d, 0x72, 0x69, 0xe9, 0xf4, 0x16, 0x94, 0x1d, 0x2b, 0x08, 0xf4, 0xf0, 0xd8, 0x70, 0x49, 0x7e,
-	0xa7, 0x3b, 0x5d, 0x42, 0xe4, 0xf0, 0xd8, 0x70, 0x91, 0xd0, 0x76, 0x75, 0x71, 0xf6, 0x52, 0x58,
-	0x26, 0xb4, 0x5d, 0xb2, 0xdf, 0x50, 0xee, 0x6f, 0xad, 0x9a, 0x58, 0x21, 0x16, 0xd9, 0xf2, 0xbc,
-	0xaa, 0xaf, 0x41, 0xf1, 0xa9, 0x6d, 0x9d, 0x0a, 0x5e, 0xf6, 0xdc, 0xb6, 0x4e, 0x23, 0x5e, 0x86,
-	0xdf, 0xea, 0x5f, 0x95, 0xa0, 0x44, 0xc4, 0xbb, 0x17, 0xbb, 0x6c, 0x5f, 0xc6, 0xda, 0xd8, 0x16,
-	0x72, 0x2a, 0xb7, 0xc2, 0xc6, 0xe1, 0x52, 0xeb, 0x35, 0x00, 0x49, 0x86, 0x72, 0x8d, 0xa0, 0x1c,
-	0xc6, 0xa2, 0x13, 0xd5, 0x74, 0xd2, 0xf1, 0x82, 0xaf, 0x1c, 0xe1, 0x9d, 0x49, 0x00, 0xec, 0x1e,
-	0x57, 0xa2, 0xc9, 0x1f, 0x53, 0x94, 0x19, 0x0b, 0xf5, 0x21, 0x32, 0xe1, 0x49, 0xb3, 0xc6, 0x04,
-	0xe9, 0x07, 0x96, 0x1f, 0x44, 0xdb, 0xa9, 0xa6, 0x45, 0x49, 0xe4, 0x68, 0xa8, 0x3c, 0x09, 0x93,
-	0x3b, 0xda, 0xbe, 0xb2, 0xf6, 0xa7, 0x11, 0x01, 0xbb, 0x03, 0x45, 0x12, 0xd9, 0x16, 0x4a, 0x70,
-	0x89, 0x75, 0x46, 0xca, 0x94, 0x16, 0xa1, 0xd9, 0xdb, 0x90, 0x9f, 0x9c, 0x58, 0xe7, 0x41, 0xa3,
-	0x26, 0xb3, 0x84, 0x94, 0x2c, 0xd4, 0x38, 0x05, 0xbb, 0x0d, 0x75, 0xdf, 0x9a, 0xe8, 0xe4, 0xc4,
-	0x45, 0xe1, 0x1d, 0x34, 0xea, 0x24, 0x9b, 0xab, 0xbe, 0x35, 0x69, 0x21, 0x70, 0x38, 0x72, 0x02,
-	0xf6, 0x26, 0x14, 0x48, 0x2a, 0xa1, 0x8d, 0x21, 0xd5, 0x1c, 0x89, 0x38, 0x4d, 0x60, 0xd9, 0x7d,
-	0x28, 0x27, 0x6c, 0xe3, 0x32, 0x75, 0x68, 0x6b, 0x81, 0x1f, 0x11, 0x1b, 0xd7, 0x12, 0x32, 0xf6,
-	0x21, 0x80, 0xb0, 0x7e, 0xf4, 0xd1, 0x39, 0x1d, 0x8b, 0x54, 0x62, 0xeb, 0x50, 0x12, 0x80, 0xb2,
-	0x8d, 0xf4, 0x16, 0xe4, 0x51, 0x4a, 0x04, 0x8d, 0x57, 0xa8, 0x35, 0x9b, 0x69, 0x11, 0x42, 0xbd,
-	0x23, 0x3c, 0xbb, 0x03, 0x25, 0x5c, 0x5c, 0x3a, 0x4e, 0x61, 0x43, 0x36, 0x07, 0xc5, 0x4a, 0x44,
-	0x2d, 0xcd, 0x3a, 0x1d, 0x7c, 0xe5, 0xb0, 0xbb, 0x90, 0x33, 0xad, 0x49, 0xd0, 0xb8, 0x4a, 0x25,
-	0x5e, 0x91, 0xe6, 0x12, 0x15, 0x87, 0x5d, 0x6b, 0xc2, 0x45, 0x0b, 0xd2, 0xb0, 0x3d, 0xa8, 0xe3,
-	0xd2, 0xbb, 0x4f, 0x8a, 0x37, 0x0e, 0x79, 0xe3, 0x1a, 0xe5, 0xba, 0xb5, 0x90, 0xab, 0x27, 0x88,
-	0x68, 0x82, 0xda, 0x6e, 0xe8, 0x9f, 0x6b, 0x35, 0x57, 0x86, 0xb1, 0x6b, 0x50, 0xb2, 0x83, 0xae,
-	0x37, 0x3e, 0xb1, 0xcc, 0xc6, 0xab, 0x91, 0x93, 0x90, 0xa7, 0xd9, 0x67, 0x50, 0xa3, 0xc5, 0x88,
-	0x49, 0xac, 0xbc, 0x71, 0x5d, 0x16, 0x79, 0x43, 0x19, 0xa5, 0xa5, 0x29, 0x51, 0xdd, 0xb2, 0x03,
-	0x3d, 0xb4, 0xa6, 0x33, 0xcf, 0x47, 0x43, 0xf2, 0xb5, 0xc8, 0xf9, 0x39, 0x8c, 0x40, 0xc8, 0xe7,
-	0xe3, 0x43, 0x5c, 0xdd, 0x9b, 0x4c, 0x02, 0x2b, 0x6c, 0xdc, 0xa0, 0xbd, 0x56, 0x8f, 0xce, 0x72,
-	0xfb, 0x04, 0x25, 0xa5, 0x34, 0xd0, 0xcd, 0x73, 0xd7, 0x98, 0xda, 0xe3, 0xc6, 0x4d, 0x6e, 0xaf,
-	0xda, 0xc1, 0x2e, 0x07, 0xc8, 0x26, 0xe3, 0x76, 0xca, 0x64, 0xbc, 0x04, 0x79, 0x73, 0x84, 0x5b,
-	0xf8, 0x16, 0x15, 0x9b, 0x33, 0x47, 0x1d, 0xf3, 0xda, 0x63, 0x32, 0x13, 0xa9, 0x91, 0x1f, 0x2f,
-	0x28, 0x03, 0xa9, 0xd5, 0x2f, 0x69, 0x0d, 0x7b, 0x6b, 0xb2, 0x4e, 0xb0, 0x93, 0x87, 0xac, 0x69,
-	0x4d, 0xae, 0xfd, 0x08, 0xd8, 0xf2, 0xf0, 0xbe, 0x48, 0x33, 0xc9, 0x0b, 0xcd, 0xe4, 0x7b, 0xeb,
-	0x0f, 0x33, 0xea, 0x67, 0x50, 0x4b, 0xed, 0xd5, 0x95, 0x1a, 0x16, 0xb7, 0x34, 0x8c, 0xa9, 0xf0,
-	0xcc, 0xf0, 0x84, 0xfa, 0xef, 0xb2, 0x50, 0xdd, 0x33, 0x82, 0xe3, 0x7d, 0x63, 0x36, 0x08, 0x8d,
-	0x30, 0xc0, 0x01, 0x3f, 0x36, 0x82, 0xe3, 0xa9, 0x31, 0xe3, 0x66, 0x5d, 0x86, 0x3b, 0x95, 0x04,
-	0x0c, 0x6d, 0x3a, 0x9c, 0x6a, 0x4c, 0xf6, 0xdd, 0x83, 0x27, 0xc2, 0x63, 0x14, 0xa7, 0x91, 0x39,
-	0x04, 0xc7, 0xf3, 0xc9, 0x44, 0xb8, 0x98, 0x4b, 0x5a, 0x94, 0x64, 0xb7, 0xa1, 0x26, 0x3e, 0xc9,
-	0xa6, 0x3b, 0x13, 0xc7, 0xea, 0x69, 0x20, 0x7b, 0x00, 0x15, 0x01, 0x18, 0x46, 0xac, 0xac, 0x1e,
-	0x7b, 0x02, 0x13, 0x84, 0x26, 0x53, 0xb1, 0x9f, 0xc0, 0x65, 0x29, 0xf9, 0xc8, 0xf3, 0xf7, 0xe7,
-	0x4e, 0x68, 0xb7, 0x7a, 0x42, 0x81, 0x7e, 0x75, 0x29, 0x7b, 0x42, 0xa2, 0xad, 0xce, 0x99, 0x6e,
-	0xed, 0xbe, 0xed, 0x0a, 0xf5, 0x22, 0x0d, 0x5c, 0xa0, 0x32, 0xce, 0x88, 0x21, 0xa6, 0xa9, 0x8c,
-	0x33, 0x5c, 0xfe, 0x02, 0xb0, 0x6f, 0x85, 0xc7, 0x9e, 0x49, 0xea, 0x45, 0xbc, 0xfc, 0x07, 0x32,
-	0x4a, 0x4b, 0x53, 0xe2, 0x70, 0xba, 0x73, 0xc7, 0x19, 0xbb, 0x21, 0xd9, 0x50, 0x59, 0x2d, 0x4a,
-	0xa2, 0xb0, 0xf0, 0x0d, 0xf7, 0xc8, 0x0a, 0x1a, 0x95, 0xed, 0xec, 0x9d, 0x8c, 0x26, 0x52, 0xea,
-	0xff, 0xb7, 0x0e, 0x79, 0x3e, 0x93, 0xaf, 0x42, 0x79, 0xe4, 0x78, 0xe3, 0x13, 0xdd, 0x9d, 0x4f,
-	0xa3, 0xe3, 0x11, 0x02, 0xa0, 0xbe, 0x45, 0xb6, 0x8f, 0xf0, 0xf8, 0x65, 0x34, 0xfa, 0xc6, 0x22,
-	0xbd, 0x79, 0x88, 0x75, 0x65, 0x09, 0x2a, 0x52, 0xd8, 0x08, 0xdf, 0x3b, 0xa5, 0xd5, 0x90, 0x23,
-	0x44, 0x94, 0xa4, 0x13, 0x18, 0x92, 0x3b, 0x98, 0x29, 0x4f, 0xb8, 0x12, 0x01, 0x5a, 0x6e, 0xb8,
-	0xe8, 0x9d, 0x2c, 0x2c, 0x79, 0x27, 0xd9, 0x0d, 0x40, 0xcb, 0x6a, 0x6c, 0xf5, 0x5d, 0xab, 0xd5,
-	0xa3, 0x11, 0x2e, 0x69, 0x12, 0x84, 0x7d, 0x12, 0xaf, 0x45, 0xea, 0x91, 0xf0, 0x1d, 0x0b, 0x8e,
-	0x2a, 0xaf, 0x5a, 0x2d, 0x45, 0xa7, 0xb6, 0x01, 0x34, 0xef, 0x34, 0xb0, 0x42, 0xd2, 0xb9, 0x5e,
-	0xa1, 0xe6, 0xa7, 0x0e, 0x3e, 0xbd, 0xd3, 0x03, 0x2f, 0x88, 0x95, 0xb1, 0xf5, 0xd5, 0xca, 0x98,
-	0xfa, 0x3e, 0x14, 0x51, 0xca, 0x1a, 0xa1, 0xc1, 0x6e, 0x0b, 0xaf, 0x26, 0xd7, 0xb2, 0x84, 0x7b,
-	0x37, 0xa9, 0x43, 0xf8, 0x39, 0xbb, 0x51, 0xbd, 0x94, 0xe7, 0x96, 0xe4, 0xe8, 0x88, 0xb9, 0xb5,
-	0x28, 0x50, 0xc8, 0xed, 0x57, 0xa1, 0x8c, 0x4d, 0xa3, 0x13, 0x23, 0xb1, 0xad, 0x4b, 0xbe, 0x77,
-	0xda, 0xc2, 0xb4, 0xfa, 0x9f, 0x33, 0x50, 0xe9, 0xfb, 0x26, 0x8a, 0x89, 0xc1, 0xcc, 0x1a, 0xbf,
-	0x50, 0x77, 0x44, 0x29, 0xef, 0x39, 0x8e, 0x11, 0x6b, 0x5e, 0x28, 0xe5, 0x23, 0x00, 0xfb, 0x10,
-	0x72, 0x13, 0xc7, 0x38, 0xa2, 0xa9, 0x8d, 0x6d, 0x4a, 0xa9, 0xf8, 0xe8, 0xfb, 0x91, 0x63, 0x1c,
-	0x69, 0x44, 0xaa, 0xfe, 0x46, 0x5c, 0x3f, 0x9d, 0xb0, 0xc8, 0xe7, 0x2a, 0x6b, 0x74, 0x7a, 0x39,
-	0x68, 0x29, 0x19, 0x56, 0x82, 0xdc, 0x6e, 0x7b, 0xd0, 0xe2, 0x96, 0x24, 0xda, 0x94, 0x03, 0xfd,
-	0x51, 0x47, 0x1b, 0x0c, 0x95, 0x1c, 0x1d, 0x87, 0x12, 0xa0, 0xdb, 0x1c, 0x0c, 0x95, 0x12, 0x03,
-	0x28, 0x1c, 0xf6, 0x3a, 0x3f, 0x39, 0x6c, 0x2b, 0x8a, 0xfa, 0x1f, 0x32, 0x00, 0x89, 0xfb, 0x9f,
-	0xbd, 0x03, 0x95, 0x53, 0x4a, 0xe9, 0xd2, 0xb9, 0x90, 0xdc, 0x47, 0xe0, 0x68, 0xd2, 0x40, 0xde,
-	0x93, 0x0c, 0x0a, 0x94, 0xb4, 0xcb, 0x07, 0x44, 0x95, 0x59, 0x22, 0xa4, 0xd9, 0xbb, 0x50, 0xf2,
-	0xb0, 0x1f, 0x48, 0x9a, 0x95, 0xc5, 0xac, 0xd4, 0x7d, 0xad, 0xe8, 0xf1, 0x04, 0x4a, 0xe4, 0x89,
-	0x1f, 0x39, 0x8e, 0x62, 0xd2, 0x47, 0x08, 0x6a, 0x39, 0xc6, 0x3c, 0xb0, 0x34, 0x8e, 0x8f, 0x99,
-	0x6c, 0x3e, 0x61, 0xb2, 0xea, 0x4f, 0xa1, 0x3e, 0x30, 0xa6, 0x33, 0xce, 0x8a, 0xa9, 0x63, 0x0c,
-	0x72, 0xb8, 0x26, 0xc4, 0xd2, 0xa3, 0x6f, 0xdc, 0x50, 0x07, 0x96, 0x3f, 0xb6, 0xdc, 0x68, 0xff,
-	0x45, 0x49, 0x64, 0xad, 0x87, 0x81, 0xed, 0x1e, 0x69, 0xde, 0x69, 0x14, 0x8f, 0x14, 0xa5, 0xd5,
-	0x7f, 0x9c, 0x81, 0x8a, 0xd4, 0x0c, 0xf6, 0x7e, 0xca, 0x7e, 0x7c, 0x75, 0xa9, 0x9d, 0xfc, 0x5b,
-	0xb2, 0x23, 0xdf, 0x84, 0x7c, 0x10, 0x1a, 0x7e, 0x74, 0x92, 0xa4, 0x48, 0x39, 0x76, 0xbc, 0xb9,
-	0x6b, 0x6a, 0x1c, 0xcd, 0x54, 0xc8, 0x5a, 0xae, 0x29, 0x4c, 0xc4, 0x65, 0x2a, 0x44, 0xaa, 0xdb,
-	0x50, 0x8e, 0x8b, 0xc7, 0x25, 0xa0, 0xf5, 0x9f, 0x0d, 0x94, 0x35, 0x56, 0x86, 0xbc, 0xd6, 0xec,
-	0x3d, 0x6e, 0x2b, 0x19, 0xf5, 0x8f, 0x33, 0x00, 0x49, 0x2e, 0x76, 0x2f, 0xd5, 0xda, 0x6b, 0x8b,
-	0xa5, 0xde, 0xa3, 0xbf, 0x52, 0x63, 0xaf, 0x43, 0x79, 0xee, 0x12, 0xd0, 0x32, 0x85, 0x94, 0x49,
-	0x00, 0xec, 0x3a, 0x64, 0xa3, 0xc8, 0xa5, 0x85, 0x68, 0x91, 0xe7, 0x86, 0xa3, 0x7e, 0x0f, 0xca,
-	0x71, 0x71, 0xac, 0x06, 0xe5, 0x47, 0xfd, 0x6e, 0xb7, 0xff, 0xac, 0xd3, 0x7b, 0xac, 0xac, 0x61,
-	0xf2, 0x40, 0x6b, 0xb7, 0xda, 0xbb, 0x98, 0xcc, 0xe0, 0x9a, 0x6d, 0x1d, 0x6a, 0x5a, 0xbb, 0x37,
-	0xd4, 0xb5, 0xfe, 0x33, 0x65, 0x5d, 0xfd, 0xad, 0x1c, 0x6c, 0xf6, 0xdd, 0xdd, 0xf9, 0xcc, 0xb1,
-	0xc7, 0x46, 0x68, 0x3d, 0xb1, 0xce, 0x5b, 0xe1, 0x19, 0x0a, 0x4f, 0x23, 0x0c, 0x7d, 0xbe, 0x99,
-	0xcb, 0x1a, 0x4f, 0x70, 0x77, 0x5c, 0x60, 0xf9, 0x21, 0x79, 0x1b, 0xe5, 0x5d, 0x5c, 0xe7, 0xf0,
-	0x96, 0xe7, 0xd0, 0x5e, 0x66, 0x3f, 0x80, 0xcb, 0xdc, 0x85, 0xc7, 0x29, 0x51, 0xc5, 0xe4, 0x96,
-	0x7c, 0x76, 0x69, 0xe9, 0x32, 0x4e, 0x88, 0x59, 0x91, 0x8c, 0x58, 0xd8, 0x4d, 0xa8, 0x24, 0xd9,
-	0xa3, 0xe3, 0x59, 0x88, 0x09, 0xa9, 0x25, 0x9e, 0xab, 0x9b, 0x51, 0xab, 0x75, 0xdb, 0x3c, 0x23,
-	0xe3, 0x28, 0xaf, 0xd5, 0xbd, 0xa4, 0x33, 0x28, 0x60, 0x3f, 0x87, 0xcd, 0x14, 0x25, 0xb5, 0x82,
-	0x9b, 0x47, 0xef, 0x46, 0x47, 0x03, 0x0b, 0xbd, 0x97, 0x21, 0xd8, 0x1c, 0xae, 0xff, 0x6d, 0x78,
-	0x69, 0xa8, 0x38, 0x27, 0xb6, 0x8f, 0x5c, 0xcf, 0xb7, 0x04, 0x33, 0x2f, 0xd9, 0x41, 0x87, 0xd2,
-	0x89, 0x85, 0x22, 0x85, 0x0a, 0x70, 0xd9, 0x11, 0x9d, 0x94, 0x73, 0xb4, 0xcd, 0xa5, 0x63, 0x4e,
-	0x2b, 0x52, 0xba, 0x63, 0xa2, 0x71, 0xce, 0x51, 0x91, 0xd1, 0x01, 0x64, 0x74, 0x54, 0x09, 0xf8,
-	0x94, 0xc3, 0xae, 0xf5, 0x60, 0x6b, 0x55, 0x23, 0x57, 0x68, 0x51, 0xdb, 0xb2, 0x16, 0xb5, 0xe0,
-	0xae, 0x4a, 0x34, 0xaa, 0x7f, 0x92, 0x81, 0xea, 0xae, 0x65, 0xce, 0x67, 0x3f, 0xf6, 0x6c, 0x17,
-	0x17, 0xc0, 0x47, 0x50, 0xf5, 0x1c, 0x93, 0x66, 0x4f, 0x8a, 0x78, 0x49, 0x9d, 0x95, 0x8a, 0x63,
-	0x1d, 0xf0, 0x1c, 0xb3, 0xe5, 0x39, 0x14, 0x1f, 0xf3, 0x1e, 0x5c, 0xe2, 0xae, 0x3c, 0xe1, 0xd9,
-	0x3e, 0xe3, 0x99, 0xd7, 0x69, 0x66, 0x14, 0x8e, 0xe2, 0x8a, 0x0f, 0x91, 0xff, 0x1a, 0x6c, 0x49,
-	0xe4, 0xe4, 0x08, 0x20, 0xfa, 0xe5, 0x45, 0xb2, 0x19, 0xe7, 0x8d, 0x0e, 0x2b, 0xd5, 0xbf, 0x9b,
-	0x85, 0x32, 0x77, 0x04, 0x62, 0x7b, 0xef, 0x40, 0xd1, 0x1b, 0x7d, 0xa9, 0xfb, 0xd6, 0xe4, 0xa2,
-	0x33, 0xf6, 0x82, 0x37, 0xfa, 0x52, 0xb3, 0x26, 0xec, 0x9d, 0x48, 0x86, 0x9b, 0xd6, 0x44, 0x0c,
-	0x4a, 0x3d, 0xad, 0xfd, 0x0b, 0x99, 0xce, 0xdd, 0x5e, 0x97, 0x16, 0x6d, 0x65, 0xdb, 0xe4, 0xce,
-	0xeb, 0x9c, 0xb6, 0x99, 0x36, 0x95, 0x3b, 0x66, 0x70, 0xb1, 0xd3, 0x24, 0x77, 0xa1, 0xd3, 0x84,
-	0xdd, 0x85, 0x4d, 0x1c, 0xea, 0x24, 0x1f, 0x5f, 0xcc, 0xb8, 0xad, 0x36, 0x3c, 0xc7, 0x4c, 0x9c,
-	0x13, 0xe6, 0x19, 0xd2, 0xba, 0xd6, 0xe9, 0x02, 0x6d, 0x81, 0xd3, 0xba, 0xd6, 0x69, 0x8a, 0xf6,
-	0x01, 0x54, 0x92, 0xdd, 0x1a, 0x34, 0x8a, 0x17, 0xcf, 0x60, 0xbc, 0x79, 0x03, 0xcc, 0xc4, 0x1d,
-	0xb9, 0x3c, 0x53, 0xe9, 0xe2, 0x4c, 0x9c, 0x8c, 0x0e, 0x1b, 0xff, 0xf9, 0x3a, 0x94, 0x3b, 0xbc,
-	0x8c, 0xf0, 0x8c, 0xdd, 0x82, 0xec, 0x37, 0x4c, 0x03, 0xe2, 0xb0, 0x1b, 0x86, 0x69, 0xea, 0xc6,
-	0x64, 0x62, 0x8d, 0x43, 0xcb, 0xd4, 0x51, 0xbf, 0x12, 0x4c, 0x6f, 0xc3, 0x30, 0xcd, 0xa6, 0x80,
-	0x93, 0xf0, 0xe0, 0x6e, 0xad, 0xc8, 0xce, 0x4c, 0xa2, 0x39, 0xc8, 0xad, 0x25, 0xcc, 0x4c, 0x7e,
-	0x8c, 0x93, 0x9a, 0xd9, 0xdc, 0x77, 0x9b, 0xd9, 0xfc, 0x4b, 0xcf, 0x6c, 0xe1, 0xe2, 0x99, 0x4d,
-	0xf9, 0xd9, 0x70, 0xa6, 0x8a, 0x34, 0x53, 0x89, 0x30, 0xef, 0x98, 0x67, 0xea, 0x3f, 0xcc, 0x02,
-	0x68, 0xd6, 0xcc, 0x31, 0xc6, 0xd6, 0xff, 0x3d, 0xa3, 0x77, 0x53, 0x5a, 0x26, 0xae, 0x19, 0x85,
-	0x58, 0x45, 0x4b, 0x82, 0xc4, 0xdf, 0xca, 0xe1, 0x2d, 0xbc, 0xf4, 0xf0, 0x16, 0x5f, 0x62, 0x78,
-	0x4b, 0xcb, 0xc3, 0xcb, 0x7e, 0x04, 0xaf, 0xf9, 0xd6, 0xa9, 0x6f, 0x87, 0x96, 0x3e, 0xf1, 0xbd,
-	0xa9, 0x9e, 0x12, 0x06, 0xc8, 0x2b, 0xcb, 0x34, 0x1a, 0x57, 0x05, 0xd1, 0x23, 0xdf, 0x9b, 0xa6,
-	0x05, 0x82, 0xfa, 0x57, 0x25, 0xa8, 0x34, 0x5d, 0xc3, 0x39, 0xff, 0xda, 0xa2, 0x00, 0x25, 0x3a,
-	0xea, 0x99, 0xcd, 0x43, 0x3e, 0xee, 0xfc, 0xf4, 0xbe, 0x4c, 0x10, 0x1a, 0xf1, 0x9b, 0x50, 0xf1,
-	0xe6, 0x61, 0x8c, 0xe7, 0xe7, 0xf9, 0xc0, 0x41, 0x44, 0x10, 0xe7, 0x8f, 0x8f, 0x11, 0xa3, 0xfc,
-	0x64, 0x6d, 0x26, 0xf9, 0x63, 0x0b, 0x24, 0xce, 0x4f, 0x04, 0x28, 0x20, 0xec, 0x29, 0x8d, 0x7c,
-	0x30, 0x9f, 0x5a, 0x7c, 0xf4, 0xb3, 0x3c, 0xdc, 0xb5, 0x25, 0x60, 0x58, 0xca, 0xd4, 0x9a, 0x7a,
-	0xfe, 0x39, 0x2f, 0xa5, 0xc0, 0x4b, 0xe1, 0x20, 0x2a, 0xe5, 0x5d, 0x60, 0xa7, 0x86, 0x1d, 0xea,
-	0xe9, 0xa2, 0xb8, 0xd5, 0xa7, 0x20, 0x66, 0x28, 0x17, 0x77, 0x05, 0x0a, 0xa6, 0x1d, 0x9c, 0x74,
-	0xfa, 0xc2, 0xe2, 0x13, 0x29, 0xec, 0x4b, 0x30, 0x36, 0x50, 0x29, 0x0d, 0xad, 0x80, 0x86, 0x32,
-	0xab, 0x95, 0x11, 0xb2, 0x83, 0x00, 0x54, 0x6a, 0x5c, 0x2b, 0x3c, 0xf5, 0x7c, 0xcc, 0xc9, 0x0d,
-	0xba, 0x04, 0x80, 0xca, 0x1f, 0x92, 0x62, 0x45, 0xe4, 0x42, 0xcb, 0x6a, 0x71, 0x1a, 0x4d, 0x25,
-	0xce, 0x95, 0x08, 0x5b, 0xe5, 0xcd, 0x4f, 0x20, 0xec, 0x36, 0xd4, 0xa9, 0xf9, 0x64, 0xf0, 0x61,
-	0x1f, 0xe8, 0xc8, 0x3d, 0xab, 0x55, 0x11, 0x4a, 0xde, 0x14, 0xa4, 0xfa, 0x0c, 0xae, 0xa6, 0xfa,
-	0xa7, 0x1b, 0xbe, 0x6f, 0x9c, 0xeb, 0x53, 0xe3, 0x4b, 0xcf, 0x27, 0x6f, 0x59, 0x56, 0xbb, 0x22,
-	0x0f, 0x5b, 0x13, 0xd1, 0xfb, 0x88, 0xbd, 0x30, 0xab, 0xed, 0x7a, 0x3e, 0xb9, 0xd2, 0x56, 0x66,
-	0x45, 0x2c, 0xf9, 0x70, 0x68, 0x82, 0xc9, 0xfa, 0x0c, 0x78, 0x98, 0xb4, 0x56, 0x21, 0xd8, 0x0e,
-	0x81, 0xd0, 0x46, 0x0b, 0x1e, 0x70, 0x61, 0xb7, 0x29, 0xa2, 0x19, 0x1f, 0x90, 0x48, 0xe4, 0x88,
-	0x63, 0xcb, 0x30, 0xe9, 0x18, 0x9f, 0x10, 0x7b, 0x96, 0x41, 0x41, 0x32, 0xc1, 0x03, 0x7d, 0x36,
-	0x0f, 0x79, 0x7c, 0xb3, 0x96, 0x0f, 0x1e, 0x1c, 0xcc, 0x43, 0x01, 0x3e, 0xb2, 0x42, 0x8a, 0x6a,
-	0x26, 0xf0, 0x63, 0x2b, 0x44, 0xdd, 0x24, 0x78, 0x10, 0x1d, 0xc9, 0x5d, 0x16, 0x63, 0xfb, 0x40,
-	0x9c, 0xb9, 0xa9, 0x50, 0x8b, 0x91, 0xfa, 0x74, 0xce, 0x03, 0x9a, 0xb3, 0x5a, 0x25, 0x22, 0xd8,
-	0x9f, 0x3b, 0x38, 0xb1, 0x63, 0x63, 0x7c, 0x6c, 0xe9, 0x3e, 0x36, 0xe5, 0x15, 0x3e, 0x75, 0x04,
-	0xd1, 0xb0, 0x35, 0xaf, 0x02, 0x4f, 0xe8, 0xc7, 0x76, 0x48, 0xee, 0xb9, 0xac, 0x56, 0x22, 0xc0,
-	0x9e, 0x1d, 0x22, 0x7f, 0xe2, 0x48, 0xb1, 0x02, 0xa9, 0x88, 0xab, 0x44, 0xb4, 0x41, 0x88, 0x7d,
-	0x82, 0x53, 0x41, 0x77, 0x40, 0x49, 0xd1, 0x62, 0x79, 0xd7, 0x88, 0xb4, 0x2e, 0x91, 0x62, 0xa9,
-	0x6f, 0x02, 0xcf, 0xac, 0xe3, 0xd2, 0xe3, 0x65, 0xbe, 0xca, 0xbd, 0x0f, 0x04, 0xde, 0xb5, 0x83,
-	0x13, 0x2a, 0xf1, 0x36, 0xd4, 0x25, 0x3a, 0x2c, 0xef, 0x3a, 0x5f, 0x19, 0x31, 0x59, 0xaa, 0x8d,
-	0xbe, 0x35, 0xf5, 0x42, 0xd1, 0xcd, 0xd7, 0xa4, 0x36, 0x6a, 0x04, 0x4f, 0xb7, 0x51, 0xd0, 0x62,
-	0x99, 0x37, 0xa4, 0x36, 0x72, 0x52, 0x2c, 0xf5, 0x16, 0x54, 0x91, 0x8b, 0x84, 0x96, 0xcb, 0x37,
-	0xff, 0x4d, 0x3e, 0xb0, 0x02, 0x46, 0xbb, 0xff, 0x16, 0x54, 0xf9, 0xc8, 0x0b, 0xbe, 0xbd, 0xcd,
-	0x49, 0x04, 0x0c, 0x49, 0x54, 0x5f, 0x3a, 0x3a, 0x3b, 0xf0, 0xe7, 0xae, 0xc5, 0x9d, 0x8d, 0xf4,
-	0x69, 0x8a, 0x20, 0x86, 0x38, 0xcd, 0x76, 0xe1, 0x12, 0xf7, 0x31, 0x58, 0x92, 0x0e, 0x11, 0x05,
-	0x11, 0xae, 0x3c, 0x52, 0x62, 0x11, 0x7d, 0x0c, 0x0e, 0xd4, 0x9f, 0x65, 0xe0, 0x5a, 0x9f, 0x22,
-	0x2a, 0x88, 0xc1, 0xee, 0x5b, 0x41, 0x60, 0x1c, 0x59, 0x8f, 0x3c, 0xff, 0xd1, 0xfc, 0xeb, 0xaf,
-	0xcf, 0xd9, 0x1d, 0xd8, 0x38, 0x30, 0x7c, 0xcb, 0x0d, 0x63, 0xf6, 0x2b, 0x74, 0xcc, 0x45, 0x30,
-	0x7b, 0x48, 0xc7, 0x36, 0x96, 0x1b, 0x1e, 0xc6, 0xda, 0xba, 0x68, 0x4b, 0xda, 0x91, 0xbf, 0x44,
-	0xa5, 0xfe, 0xcb, 0x5b, 0x90, 0xeb, 0x79, 0xa6, 0xc5, 0x3e, 0x80, 0x32, 0xc5, 0x36, 0x2f, 0x9f,
-	0x16, 0x22, 0x9a, 0xfe, 0x90, 0xe1, 0x54, 0x72, 0xc5, 0xd7, 0xc5, 0xd1, 0xd0, 0xb7, 0xc8, 0x04,
-	0xa4, 0x70, 0x03, 0x14, 0x68, 0x15, 0xe1, 0x82, 0x22, 0x1f, 0x0a, 0xc7, 0xe0, 0xd8, 0x92, 0x0b,
-	0xdd, 0xb7, 0x5c, 0xd2, 0xd2, 0xf2, 0x5a, 0x9c, 0x26, 0xc3, 0xdb, 0xf7, 0x50, 0xf8, 0xf2, 0xbd,
-	0x9a, 0x5f, 0x61, 0x78, 0x73, 0x3c, 0x6d, 0xde, 0x0f, 0xa0, 0xfc, 0xa5, 0x67, 0xbb, 0xbc, 0xe1,
-	0x85, 0xa5, 0x86, 0xa3, 0x6e, 0xcd, 0x1b, 0xfe, 0xa5, 0xf8, 0x62, 0xaf, 0x43, 0xd1, 0x73, 0x79,
-	0xd9, 0xc5, 0xa5, 0xb2, 0x0b, 0x9e, 0xdb, 0xe5, 0xe1, 0x78, 0xb5, 0xd1, 0xdc, 0x76, 0x4c, 0x94,
-	0x5d, 0x8e, 0x35, 0x09, 0xc5, 0xa9, 0x5e, 0x85, 0x80, 0x7d, 0xb7, 0x6b, 0x4d, 0x42, 0xf6, 0x0e,
-	0x54, 0x26, 0xb6, 0x83, 0x32, 0x9e, 0x0a, 0x2b, 0x2f, 0x15, 0x06, 0x1c, 0x4d, 0x05, 0xbe, 0x01,
-	0xa5, 0x23, 0xdf, 0x9b, 0xcf, 0xf4, 0xd1, 0x39, 0x9d, 0xe6, 0x2d, 0x9c, 0xa3, 0x11, 0x6e, 0xe7,
-	0x1c, 0x05, 0x0d, 0x7d, 0xda, 0xee, 0x91, 0x4e, 0xbe, 0x94, 0xca, 0x76, 0xf6, 0x4e, 0x49, 0xab,
-	0x46, 0x40, 0xf2, 0x92, 0xbc, 0x01, 0x25, 0xe3, 0xe8, 0x48, 0x17, 0x51, 0x85, 0x4b, 0x65, 0x19,
-	0x47, 0x47, 0x54, 0xe5, 0x3d, 0xa8, 0x9d, 0xda, 0xae, 0x1e, 0xcc, 0xac, 0x31, 0xa7, 0xad, 0x2d,
-	0x0f, 0xe5, 0xa9, 0xed, 0x0e, 0x66, 0xd6, 0x98, 0xe8, 0x65, 0x1f, 0x46, 0xfd, 0x85, 0x3e, 0x8c,
-	0x6d, 0xc8, 0x3b, 0xf6, 0xd4, 0x0e, 0x45, 0x9c, 0x61, 0xca, 0xc8, 0x21, 0x04, 0x53, 0xa1, 0x20,
-	0x5c, 0xe5, 0xca, 0x12, 0x89, 0xc0, 0xa4, 0x35, 0xa0, 0xcd, 0x17, 0x68, 0x40, 0x92, 0xc1, 0xc1,
-	0xbe, 0xd9, 0xe0, 0xf8, 0x98, 0xce, 0x13, 0x2d, 0x37, 0xd4, 0xa3, 0x0c, 0x97, 0x56, 0x67, 0xa8,
-	0x72, 0xb2, 0x3e, 0xcf, 0xf6, 0x21, 0x54, 0x7c, 0x72, 0xae, 0xe9, 0xe4, 0x89, 0xdb, 0x92, 0xbd,
-	0x13, 0x89, 0xd7, 0x4d, 0x03, 0x3f, 0xf1, 0xc0, 0x35, 0x61, 0x23, 0x89, 0x9b, 0xe6, 0xc1, 0xe5,
-	0x97, 0x65, 0xe7, 0x7c, 0x2a, 0xd0, 0x5a, 0xe8, 0xf1, 0x35, 0x3b, 0x15, 0x7d, 0xfd, 0x3a, 0xd4,
-	0x78, 0xc4, 0x14, 0x8f, 0x6b, 0x09, 0x48, 0x36, 0x94, 0xb5, 0x2a, 0x01, 0x79, 0xcc, 0x4b, 0xc0,
-	0xee, 0x01, 0x44, 0xda, 0x5f, 0x78, 0x46, 0xc2, 0x21, 0xee, 0x0d, 0x97, 0x20, 0xad, 0xf0, 0x4c,
-	0x2b, 0x9b, 0xd1, 0x27, 0xf2, 0xbc, 0x91, 0xed, 0x9a, 0xb8, 0x8e, 0x42, 0xe3, 0x28, 0x68, 0x34,
-	0x68, 0x9b, 0x55, 0x04, 0x6c, 0x68, 0x1c, 0x05, 0x68, 0x6f, 0x1a, 0x5c, 0xc7, 0xe2, 0xed, 0xbe,
-	0x2a, 0x3b, 0xa3, 0x24, 0xed, 0x4b, 0xab, 0x18, 0x92, 0x2a, 0xf6, 0x29, 0xb0, 0xe8, 0x28, 0x4f,
-	0x32, 0x1f, 0xaf, 0x2d, 0x2d, 0xad, 0x0d, 0x71, 0x96, 0x17, 0x5f, 0xe4, 0xf8, 0x14, 0x6a, 0x69,
-	0x9d, 0xf8, 0xfa, 0x8a, 0xc3, 0x2b, 0x9a, 0x75, 0xad, 0x3a, 0x96, 0xb5, 0xe4, 0xd7, 0xa1, 0xe6,
-	0x7a, 0xa1, 0x4e, 0x7c, 0x9f, 0x32, 0xf2, 0x03, 0x9a, 0xaa, 0xeb, 0x85, 0xad, 0x08, 0x86, 0xe3,
-	0x13, 0x59, 0x5e, 0xe1, 0x19, 0x89, 0x8a, 0x78, 0x7c, 0x62, 0x33, 0x09, 0x55, 0xbe, 0xc8, 0x62,
-	0xc2, 0xa9, 0xe6, 0x16, 0x00, 0x65, 0xb8, 0x99, 0x9a, 0xea, 0xd8, 0x34, 0xd0, 0xc0, 0x4f, 0xcc,
-	0x84, 0x9b, 0x50, 0x09, 0xbc, 0xb9, 0x3f, 0xb6, 0xf4, 0x20, 0xb4, 0x66, 0x8d, 0x6d, 0x1a, 0x51,
-	0xe0, 0xa0, 0x41, 0x68, 0xcd, 0xd8, 0x43, 0xa8, 0xcf, 0x7c, 0x4b, 0x97, 0xe6, 0xe9, 0x96, 0xdc,
-	0xc5, 0x03, 0xdf, 0x4a, 0xa6, 0xaa, 0x3a, 0x93, 0x52, 0x51, 0x4e, 0xa9, 0x07, 0xea, 0x42, 0xce,
-	0xa4, 0x13, 0x98, 0x33, 0xb1, 0xfc, 0x7e, 0x08, 0x9b, 0x52, 0xce, 0xf9, 0x09, 0x65, 0x7e, 0x3d,
-	0x75, 0x96, 0x18, 0x91, 0x1f, 0x9e, 0x60, 0xf6, 0xfa, 0x2c, 0x95, 0x66, 0xcd, 0x05, 0xb7, 0x0e,
-	0xea, 0xe7, 0xb7, 0x29, 0xff, 0x2b, 0x17, 0xf8, 0x6a, 0x52, 0xfe, 0x9e, 0x27, 0xfc, 0xd4, 0xa8,
-	0x13, 0xb4, 0x5d, 0xb3, 0xf1, 0x06, 0xbf, 0x6d, 0x45, 0x09, 0xf6, 0x00, 0xaa, 0x5c, 0x53, 0xa4,
-	0x78, 0xe8, 0xa0, 0xf1, 0xa6, 0xec, 0xd7, 0x26, 0x75, 0x91, 0x10, 0x5a, 0xc5, 0x89, 0xbf, 0x03,
-	0xf6, 0x09, 0x6c, 0xf2, 0x03, 0x05, 0x99, 0xb3, 0xbe, 0xb5, 0xbc, 0xb8, 0x88, 0xe8, 0x51, 0xc2,
-	0x5e, 0x35, 0xb8, 0xea, 0xcf, 0x5d, 0xd2, 0x1e, 0x45, 0xce, 0x99, 0xef, 0x8d, 0x2c, 0x9e, 0xff,
-	0x0e, 0xe5, 0x17, 0xdd, 0xd1, 0x38, 0x19, 0xcf, 0x4b, 0x2c, 0xed, 0x8a, 0x2f, 0x83, 0x0e, 0x30,
-	0xdf, 0x05, 0x65, 0x72, 0x91, 0x40, 0x65, 0xbe, 0xfd, 0x32, 0x65, 0xee, 0x60, 0x3e, 0x2a, 0x93,
-	0x41, 0x6e, 0x3e, 0xb7, 0xcd, 0xc6, 0x5d, 0x1e, 0xba, 0x8c, 0xdf, 0xec, 0x0d, 0xa8, 0xfb, 0xd6,
-	0x78, 0xee, 0x07, 0xf6, 0x73, 0x4b, 0x0f, 0x6c, 0xf7, 0xa4, 0xf1, 0x0e, 0x8d, 0x63, 0x2d, 0x86,
-	0x0e, 0x6c, 0xf7, 0x04, 0x57, 0xac, 0x75, 0x16, 0x5a, 0xbe, 0xcb, 0xaf, 0x68, 0xbc, 0x2b, 0xaf,
-	0xd8, 0x36, 0x21, 0x90, 0xa3, 0x68, 0x60, 0xc5, 0xdf, 0xec, 0x07, 0xb0, 0x91, 0x58, 0x6b, 0x33,
-	0xd4, 0x5d, 0x1a, 0xef, 0xad, 0x3c, 0x66, 0x26, 0xbd, 0x46, 0x4b, 0x62, 0x30, 0xb8, 0x0a, 0x94,
-	0x5e, 0x5b, 0x01, 0x5f, 0x5b, 0xf7, 0xbe, 0xd5, 0xda, 0x1a, 0xd0, 0xda, 0x7a, 0x13, 0x4a, 0xb6,
-	0x1b, 0x5a, 0xfe, 0x73, 0xc3, 0x69, 0xbc, 0xbf, 0x24, 0x03, 0x62, 0x1c, 0xbb, 0x0d, 0xc5, 0xc0,
-	0xb1, 0x91, 0x31, 0x35, 0x3e, 0x58, 0x22, 0x8b, 0x50, 0xec, 0x0e, 0x94, 0xe3, 0xeb, 0x85, 0x8d,
-	0x0f, 0x97, 0xe8, 0x12, 0x24, 0xbb, 0x01, 0xb9, 0x53, 0x5c, 0x8f, 0xf7, 0x97, 0x8f, 0x31, 0x10,
-	0x8e, 0x4a, 0xc3, 0xc4, 0x76, 0x1c, 0xae, 0x34, 0x3c, 0x58, 0x52, 0x1a, 0x1e, 0xd9, 0x8e, 0xc3,
-	0x95, 0x86, 0x89, 0xf8, 0x42, 0x91, 0x4b, 0x39, 0xb0, 0x27, 0x1f, 0x2d, 0x8b, 0x5c, 0xc4, 0x3d,
-	0xa5, 0x8b, 0x98, 0x95, 0x80, 0x7c, 0xf3, 0xfc, 0x88, 0xe1, 0x63, 0x79, 0xac, 0xd2, 0x4e, 0x7b,
-	0x0d, 0x82, 0x38, 0x8d, 0x9a, 0xbf, 0x38, 0x99, 0x40, 0x93, 0xfa, 0x13, 0x7e, 0x3f, 0x88, 0x43,
-	0xd0, 0x9e, 0xfe, 0x00, 0x6a, 0x51, 0x00, 0x1e, 0x56, 0x17, 0x34, 0x3e, 0x5d, 0x6a, 0x41, 0x9a,
-	0x80, 0xed, 0x42, 0x75, 0x82, 0x4a, 0xe4, 0x94, 0xeb, 0x94, 0x8d, 0x87, 0xd4, 0x90, 0xed, 0x48,
-	0x9c, 0x5f, 0xa4, 0x73, 0x6a, 0xa9, 0x5c, 0xec, 0x1e, 0x30, 0x7b, 0xc2, 0xe7, 0x13, 0x6d, 0x74,
-	0xae, 0x37, 0x36, 0x3e, 0xa3, 0xc5, 0xb9, 0x02, 0xc3, 0x1e, 0x40, 0x2d, 0xb0, 0x5c, 0x53, 0x9f,
-	0x06, 0x42, 0x39, 0xf9, 0x1e, 0xb5, 0x53, 0xb0, 0xe1, 0xf8, 0x1a, 0xb2, 0x56, 0x41, 0xaa, 0xfd,
-	0x80, 0x6b, 0x29, 0x0f, 0x00, 0xd7, 0xf9, 0xf3, 0x24, 0xd3, 0xaf, 0x5d, 0x90, 0x09, 0xa9, 0xa2,
-	0x4c, 0x0f, 0xa1, 0x6e, 0x5a, 0xe6, 0x7c, 0xa6, 0x93, 0xee, 0x87, 0xcb, 0xf2, 0xfb, 0x32, 0xbf,
-	0x94, 0xdd, 0xaa, 0x5a, 0xd5, 0x94, 0x9d, 0xac, 0x9f, 0xc2, 0x46, 0xe4, 0xff, 0x0c, 0x85, 0xab,
-	0xf4, 0x07, 0x72, 0x85, 0xb1, 0x7b, 0x53, 0xab, 0xcd, 0xa3, 0xcf, 0xa8, 0x9d, 0x24, 0xe2, 0x03,
-	0xd7, 0x98, 0x05, 0xc7, 0x5e, 0xd8, 0xf8, 0x75, 0x59, 0x5b, 0x19, 0x08, 0xa8, 0x56, 0x45, 0xa2,
-	0x28, 0x85, 0xa2, 0x2b, 0xd9, 0xda, 0xe3, 0xd0, 0x6a, 0xfc, 0x90, 0x8b, 0xae, 0x18, 0xd8, 0x0a,
-	0x71, 0xd8, 0xc0, 0x98, 0xcd, 0x9c, 0x73, 0xbe, 0x1c, 0x7f, 0x44, 0xcb, 0x71, 0x4b, 0x5a, 0x8e,
-	0x4d, 0x44, 0xd2, 0x7a, 0x2c, 0x1b, 0xd1, 0x27, 0xbb, 0x0f, 0xd5, 0x99, 0x17, 0x84, 0xba, 0x39,
-	0x75, 0xa8, 0xff, 0x4d, 0x99, 0x1d, 0x1c, 0x78, 0x41, 0xb8, 0x3b, 0x75, 0x48, 0x80, 0xcd, 0xe2,
-	0x6f, 0xd6, 0x85, 0x4b, 0x29, 0x56, 0x6f, 0xd0, 0x49, 0x7e, 0x63, 0x87, 0x6a, 0xbc, 0x2e, 0xd5,
-	0x28, 0xb1, 0x7c, 0x11, 0x01, 0xba, 0xe9, 0x2d, 0x82, 0xd0, 0xe8, 0xe3, 0x73, 0x10, 0x87, 0x41,
-	0xb7, 0xb8, 0xde, 0x42, 0xd0, 0x28, 0x0e, 0xfa, 0x21, 0x6c, 0x24, 0x54, 0xd8, 0xc1, 0xa0, 0xb1,
-	0x2b, 0xaf, 0x5e, 0xe9, 0xb2, 0x42, 0x2d, 0xca, 0x88, 0xb0, 0x40, 0xfd, 0xb3, 0x3c, 0x94, 0x22,
-	0xbb, 0x83, 0x55, 0xa0, 0x78, 0xd8, 0x7b, 0xd2, 0xeb, 0x3f, 0xeb, 0xf1, 0xeb, 0x90, 0xcd, 0xc1,
-	0xa0, 0xad, 0x0d, 0x15, 0x93, 0xd5, 0x01, 0xe8, 0x52, 0x94, 0x3e, 0x68, 0x35, 0x7b, 0xfc, 0x7a,
-	0x24, 0x5d, 0xc5, 0xe2, 0xe9, 0x75, 0xb6, 0x09, 0xb5, 0x47, 0x87, 0x3d, 0x0a, 0x34, 0xe5, 0xa0,
-	0x2c, 0x82, 0xda, 0x9f, 0xf3, 0x43, 0x46, 0x0e, 0xca, 0x21, 0x68, 0xbf, 0x39, 0x6c, 0x6b, 0x9d,
-	0x08, 0x94, 0xa7, 0x98, 0xd5, 0xfe, 0xa1, 0xd6, 0x12, 0x25, 0x15, 0xd8, 0x65, 0xd8, 0x8c, 0xb3,
-	0x45, 0x45, 0x2a, 0x45, 0x6c, 0xd9, 0x81, 0xd6, 0xff, 0x71, 0xbb, 0x35, 0x54, 0x80, 0x4e, 0x2c,
-	0x1f, 0x3f, 0x56, 0x2a, 0xac, 0x0a, 0xa5, 0xdd, 0xce, 0x60, 0xd8, 0xe9, 0xb5, 0x86, 0x4a, 0x15,
-	0x1b, 0xfc, 0xa8, 0xd3, 0x1d, 0xb6, 0x35, 0xa5, 0xc6, 0x4a, 0x90, 0xfb, 0x71, 0xbf, 0xd3, 0x53,
-	0xea, 0x74, 0x39, 0xac, 0xb9, 0x7f, 0xd0, 0x6d, 0x2b, 0x1b, 0x08, 0x1d, 0xf4, 0xb5, 0xa1, 0xa2,
-	0x20, 0xf4, 0x59, 0xa7, 0xb7, 0xdb, 0x7f, 0xa6, 0x6c, 0xb2, 0x32, 0xe4, 0x0f, 0x7b, 0x58, 0x0d,
-	0x63, 0x35, 0x28, 0xd3, 0xa7, 0xde, 0xec, 0x76, 0x95, 0x4b, 0xd2, 0x31, 0xe7, 0x16, 0xa2, 0xe8,
-	0xd0, 0x74, 0x80, 0x6d, 0xb8, 0x8c, 0x7d, 0x89, 0x93, 0x44, 0x7d, 0x05, 0xcb, 0xd9, 0xef, 0xf4,
-	0x0e, 0x07, 0xca, 0x2b, 0x48, 0x4c, 0x9f, 0x84, 0x69, 0x60, 0x39, 0x9d, 0x1e, 0x0d, 0xe5, 0x0d,
-	0xfc, 0xde, 0x6d, 0x77, 0xdb, 0xc3, 0xb6, 0x72, 0x13, 0x7b, 0xa5, 0xb5, 0x0f, 0xba, 0xcd, 0x56,
-	0x5b, 0xd9, 0xc6, 0x44, 0xb7, 0xdf, 0x7a, 0xa2, 0xf7, 0x0f, 0x94, 0x5b, 0x6c, 0x0b, 0x94, 0x7e,
-	0x4f, 0xdf, 0x3d, 0x3c, 0xe8, 0x76, 0x5a, 0xcd, 0x61, 0x5b, 0x7f, 0xd2, 0xfe, 0x42, 0x51, 0x71,
-	0xd8, 0x0f, 0xb4, 0xb6, 0x2e, 0xca, 0x7a, 0x3d, 0x4a, 0x8b, 0xf2, 0x6e, 0x33, 0x05, 0xaa, 0x8f,
-	0x0e, 0x7f, 0xfa, 0xd3, 0x2f, 0x74, 0x31, 0x0e, 0x6f, 0x60, 0x33, 0x93, 0x1c, 0xfa, 0xe1, 0x13,
-	0xe5, 0xcd, 0x05, 0xd0, 0xe0, 0x89, 0xf2, 0x16, 0x8e, 0x63, 0x34, 0x31, 0xca, 0x1d, 0x24, 0xd0,
-	0xda, 0xad, 0x43, 0x6d, 0xd0, 0x79, 0xda, 0xd6, 0x5b, 0xc3, 0xb6, 0xf2, 0x36, 0x0d, 0x5c, 0xa7,
-	0xf7, 0x44, 0xb9, 0x8b, 0x3d, 0xc3, 0x2f, 0x3e, 0x5d, 0xef, 0x30, 0x06, 0xf5, 0x84, 0x96, 0x60,
-	0xef, 0x22, 0xc9, 0x8e, 0xd6, 0x6f, 0xee, 0xb6, 0x9a, 0x83, 0xa1, 0xf2, 0x1e, 0x0e, 0xcb, 0xe0,
-	0xa0, 0xdb, 0x19, 0x2a, 0xf7, 0xb0, 0xef, 0x8f, 0x9b, 0xc3, 0xbd, 0xb6, 0xa6, 0xbc, 0x8f, 0x33,
-	0x3f, 0xec, 0xec, 0xb7, 0x75, 0x31, 0x0d, 0xf7, 0xb1, 0x8e, 0x47, 0x9d, 0x6e, 0x57, 0x79, 0x40,
-	0x27, 0x7b, 0x4d, 0x6d, 0xd8, 0xa1, 0xb9, 0xff, 0x08, 0x0b, 0x68, 0x1e, 0x1c, 0x74, 0xbf, 0x50,
-	0x3e, 0xc6, 0x0e, 0xee, 0x1f, 0x76, 0x87, 0x1d, 0xfd, 0xf0, 0x60, 0xb7, 0x39, 0x6c, 0x2b, 0x9f,
-	0xd0, 0xc2, 0xe8, 0x0f, 0x86, 0xbb, 0xfb, 0x5d, 0xe5, 0x53, 0xf5, 0x37, 0xa1, 0x14, 0x99, 0xa2,
-	0x98, 0xab, 0xd3, 0xeb, 0xb5, 0x35, 0x65, 0x0d, 0x4b, 0xee, 0xb6, 0x1f, 0x0d, 0x95, 0x0c, 0x9d,
-	0x6a, 0x76, 0x1e, 0xef, 0x0d, 0x95, 0x75, 0xfc, 0xec, 0x1f, 0xe2, 0x20, 0x65, 0xa9, 0x77, 0xed,
-	0xfd, 0x8e, 0x92, 0xc3, 0xaf, 0x66, 0x6f, 0xd8, 0x51, 0xf2, 0xb4, 0x6c, 0x3a, 0xbd, 0xc7, 0xdd,
-	0xb6, 0x52, 0x40, 0xe8, 0x7e, 0x53, 0x7b, 0xa2, 0x14, 0x79, 0xa1, 0xbb, 0xed, 0xcf, 0x95, 0x12,
-	0x2b, 0xc0, 0x7a, 0xf7, 0xbe, 0x52, 0x46, 0xd0, 0x6e, 0x7b, 0xf7, 0xf0, 0x40, 0x01, 0xf5, 0x0e,
-	0x14, 0x9b, 0x47, 0x47, 0xfb, 0x68, 0xe9, 0x63, 0x67, 0x0e, 0xbb, 0x5d, 0xbe, 0x8d, 0x76, 0xfa,
-	0xc3, 0x61, 0x7f, 0x5f, 0xc9, 0xe0, 0xc2, 0x1d, 0xf6, 0x0f, 0x94, 0x75, 0xb5, 0x03, 0xa5, 0x48,
-	0xfc, 0x49, 0xf7, 0x1d, 0x4b, 0x90, 0x3b, 0xd0, 0xda, 0x4f, 0xf9, 0x51, 0x7c, 0xaf, 0xfd, 0x39,
-	0x36, 0x13, 0xbf, 0xb0, 0xa0, 0x2c, 0x56, 0xc4, 0x2f, 0x26, 0xd2, 0x85, 0xc7, 0x6e, 0xa7, 0xd7,
-	0x6e, 0x6a, 0x4a, 0x5e, 0xfd, 0x38, 0x75, 0xca, 0x29, 0xb8, 0x06, 0x56, 0xdf, 0xec, 0x88, 0xea,
-	0x3b, 0x8f, 0x7b, 0x7d, 0xad, 0xcd, 0x6f, 0x50, 0x8a, 0x71, 0x5b, 0x57, 0xdf, 0x81, 0x72, 0xcc,
-	0xf1, 0x70, 0x1d, 0xb5, 0xb4, 0xfe, 0x60, 0xc0, 0x87, 0x79, 0x0d, 0xd3, 0x34, 0x36, 0x3c, 0x9d,
-	0x51, 0xff, 0x5f, 0x28, 0xc5, 0xcc, 0xf6, 0x36, 0xac, 0x0f, 0x07, 0xc2, 0x8b, 0xbf, 0x75, 0x2f,
-	0x79, 0xc2, 0x63, 0x18, 0x7d, 0x69, 0xeb, 0xc3, 0x01, 0x7b, 0x17, 0x0a, 0xfc, 0x02, 0xaf, 0x38,
-	0x88, 0xda, 0x4a, 0x33, 0xf0, 0x21, 0xe1, 0x34, 0x41, 0xa3, 0x76, 0xa1, 0x9e, 0xc6, 0xb0, 0x1b,
-	0x00, 0x1c, 0x27, 0x79, 0x64, 0x24, 0x08, 0xbb, 0x06, 0xd1, 0x05, 0xe1, 0x5d, 0x11, 0x9b, 0x1a,
-	0xa7, 0xd5, 0x7f, 0x90, 0x05, 0x48, 0x54, 0x35, 0x54, 0x06, 0x63, 0x7f, 0x4b, 0x5e, 0x9c, 0x49,
-	0xbf, 0x0a, 0x65, 0xc7, 0x33, 0x4c, 0xf9, 0x29, 0x8e, 0x12, 0x02, 0x68, 0x34, 0xe4, 0xcb, 0x72,
-	0x65, 0x1e, 0x10, 0xc2, 0xae, 0x40, 0x61, 0xe2, 0xf9, 0x53, 0x23, 0x8a, 0x62, 0x15, 0x29, 0x14,
-	0x3d, 0xfc, 0x9c, 0x14, 0x15, 0x56, 0x97, 0x2e, 0xa2, 0x50, 0x48, 0xb4, 0x00, 0x76, 0x11, 0x86,
-	0x26, 0x8d, 0xe5, 0x8e, 0x1d, 0x2f, 0xb0, 0x4c, 0xb4, 0xfa, 0x0b, 0xa4, 0x95, 0x42, 0x04, 0xda,
-	0x39, 0xe7, 0xbd, 0xf5, 0xa7, 0xb6, 0x6b, 0x84, 0xc2, 0x55, 0x4d, 0xbd, 0x8d, 0x20, 0xd8, 0xdc,
-	0x2f, 0x03, 0x4f, 0xb8, 0x5f, 0xf8, 0x91, 0x6b, 0x09, 0x01, 0xd4, 0xdc, 0xd7, 0x00, 0xac, 0x60,
-	0x6c, 0xcc, 0x78, 0xe1, 0x65, 0x2a, 0xbc, 0x2c, 0x20, 0x3b, 0xe7, 0xac, 0x0b, 0xf5, 0xe1, 0x08,
-	0xd9, 0xbd, 0x87, 0x96, 0x74, 0xcb, 0x73, 0x84, 0x63, 0xe4, 0xf6, 0xa2, 0x4e, 0x7b, 0x2f, 0x4d,
-	0xc6, 0xcf, 0x86, 0x17, 0xf2, 0x5e, 0x6b, 0xc2, 0xa5, 0x15, 0x64, 0x2f, 0x15, 0xe3, 0xf6, 0x97,
-	0x39, 0x80, 0xc4, 0x30, 0x49, 0x1d, 0x18, 0x67, 0xd2, 0x07, 0xc6, 0xf7, 0xe1, 0x8a, 0xb8, 0x67,
-	0x16, 0x9f, 0xba, 0xda, 0xae, 0x3e, 0x32, 0xa2, 0xb3, 0x79, 0x26, 0xb0, 0xfc, 0xe0, 0xb5, 0xe3,
-	0xee, 0x18, 0xa8, 0xb3, 0x6c, 0xc8, 0x79, 0xc2, 0xf3, 0x59, 0x3a, 0xb6, 0x40, 0x96, 0x84, 0x49,
-	0xf6, 0xe1, 0xf9, 0x8c, 0x7d, 0x00, 0x97, 0x7d, 0x6b, 0xe2, 0x5b, 0xc1, 0xb1, 0x1e, 0x06, 0x72,
-	0x65, 0x3c, 0xbc, 0x6d, 0x53, 0x20, 0x87, 0x41, 0x5c, 0xd7, 0x07, 0x70, 0x59, 0x98, 0x2c, 0x0b,
-	0xcd, 0xe3, 0x67, 0x9c, 0x9b, 0x1c, 0x29, 0xb7, 0xee, 0x35, 0x00, 0x61, 0xad, 0x45, 0x4f, 0xcc,
-	0x94, 0xb4, 0x32, 0xb7, 0xcc, 0xd0, 0xbc, 0x7e, 0x17, 0x98, 0x1d, 0xe8, 0x0b, 0xc7, 0x45, 0xe2,
-	0x04, 0x5e, 0xb1, 0x83, 0x83, 0xd4, 0x51, 0xd1, 0x45, 0x27, 0x51, 0xa5, 0x8b, 0x4e, 0xa2, 0xb6,
-	0x20, 0x4f, 0x06, 0x9d, 0x38, 0x18, 0xe2, 0x09, 0xa6, 0x42, 0x0e, 0x39, 0x16, 0x1d, 0x62, 0xd4,
-	0xef, 0xd7, 0xef, 0xd1, 0x03, 0x3d, 0x38, 0x3f, 0x08, 0xd5, 0x08, 0xc7, 0xde, 0x83, 0x4b, 0xf2,
-	0xa0, 0x46, 0xaf, 0x4f, 0x54, 0xa8, 0x9b, 0x4a, 0x32, 0x8c, 0x1a, 0x7f, 0x87, 0xe2, 0x1d, 0x60,
-	0xd2, 0xb8, 0x44, 0xd4, 0x55, 0x7e, 0x98, 0x1b, 0x0f, 0x8a, 0x20, 0x7e, 0x0b, 0x68, 0x00, 0xb8,
-	0xcf, 0xb8, 0xb6, 0x6c, 0xbe, 0x20, 0x92, 0xfc, 0xcb, 0x1f, 0xc0, 0xe5, 0x64, 0xec, 0x74, 0x23,
-	0xd4, 0xc3, 0x63, 0x4b, 0xb7, 0x5c, 0x93, 0xae, 0x1e, 0x96, 0xb4, 0xcd, 0x78, 0x18, 0x9b, 0xe1,
-	0xf0, 0xd8, 0x6a, 0xbb, 0xa6, 0xfa, 0x7b, 0x19, 0xa8, 0xa7, 0x6d, 0x27, 0x1e, 0x8e, 0x9e, 0xc4,
-	0xd9, 0xe7, 0x93, 0xd8, 0xfa, 0x57, 0xa1, 0x3c, 0x3b, 0x11, 0x41, 0xf5, 0x11, 0x4b, 0x98, 0x9d,
-	0xf0, 0x60, 0x7a, 0xf6, 0x36, 0x14, 0x67, 0x27, 0x7c, 0xfb, 0x5d, 0xb4, 0x9a, 0x0a, 0x33, 0x1e,
-	0xe7, 0xfa, 0x36, 0x14, 0xe7, 0x82, 0x34, 0x77, 0x11, 0xe9, 0x9c, 0x48, 0xd5, 0x6d, 0xa8, 0xca,
-	0xde, 0x0a, 0xdc, 0x45, 0x68, 0x99, 0xf0, 0x86, 0xe1, 0xa7, 0xfa, 0x5b, 0xeb, 0x44, 0xf2, 0x52,
-	0x47, 0xd0, 0x2f, 0x15, 0x06, 0xb0, 0x4d, 0x81, 0x79, 0x3a, 0x85, 0xdd, 0x8e, 0xbd, 0xe8, 0xdd,
-	0x14, 0x38, 0x36, 0x82, 0xe6, 0x3c, 0xf4, 0x5a, 0x9e, 0x13, 0x3d, 0x1a, 0xc0, 0xef, 0x31, 0xe5,
-	0xe2, 0x47, 0x03, 0xf8, 0x15, 0xc7, 0x0f, 0xc4, 0x65, 0x1f, 0xba, 0x69, 0x47, 0x01, 0x28, 0xf9,
-	0xa5, 0x19, 0xac, 0x46, 0x17, 0xed, 0x28, 0xb6, 0xe4, 0x3e, 0x6c, 0x24, 0x91, 0xd5, 0x51, 0xcc,
-	0xca, 0x62, 0x96, 0x5a, 0x1c, 0x56, 0x8d, 0x49, 0xf5, 0x77, 0x32, 0xb0, 0xb9, 0x64, 0xfc, 0xe3,
-	0x68, 0x25, 0x4f, 0x2c, 0xe1, 0x27, 0xbb, 0x05, 0xd5, 0xa9, 0x11, 0x8e, 0x8f, 0xf5, 0x99, 0x6f,
-	0x4d, 0xec, 0xb3, 0xe8, 0x9d, 0x28, 0x82, 0x1d, 0x10, 0x88, 0xe2, 0x6f, 0x66, 0x33, 0x72, 0x79,
-	0x4c, 0xed, 0x50, 0x5c, 0x75, 0x04, 0x02, 0x75, 0xc9, 0x9d, 0x1a, 0xc5, 0xe6, 0xe5, 0x2e, 0x08,
-	0x25, 0xbc, 0x0e, 0x85, 0x4e, 0xec, 0x64, 0x88, 0x03, 0x48, 0xb2, 0xe2, 0x99, 0x14, 0x0f, 0xca,
-	0x2d, 0x7a, 0x72, 0x65, 0xdf, 0x98, 0xb1, 0xbb, 0x90, 0x9d, 0x1a, 0x33, 0x11, 0x60, 0xd2, 0x88,
-	0xcf, 0x08, 0x38, 0xf6, 0xde, 0xbe, 0x31, 0xe3, 0x2c, 0x16, 0x89, 0xae, 0x7d, 0x02, 0xa5, 0x08,
-	0xf0, 0x52, 0xcc, 0xf4, 0xbf, 0x64, 0xa1, 0xbc, 0x2b, 0xbb, 0x23, 0xd1, 0x78, 0x0a, 0xfd, 0xb9,
-	0x8b, 0xca, 0x40, 0xf4, 0xc0, 0xc4, 0xd8, 0x70, 0x87, 0x02, 0x14, 0x2d, 0xa0, 0xf5, 0x6f, 0x58,
-	0x40, 0xd7, 0x01, 0x7c, 0x32, 0x92, 0xc9, 0x4e, 0xce, 0xc6, 0xc1, 0x8c, 0x1d, 0x53, 0x04, 0x6a,
-	0x2c, 0x9f, 0xd1, 0xe7, 0xbe, 0xfd, 0x19, 0x7d, 0x7e, 0xe5, 0x19, 0xfd, 0xff, 0x31, 0xa7, 0xea,
-	0x6f, 0x26, 0xf2, 0x03, 0xd7, 0x34, 0x92, 0x95, 0x79, 0x78, 0xf3, 0x2c, 0xbe, 0xfe, 0x80, 0x74,
-	0xdf, 0x83, 0x7a, 0x34, 0xcc, 0xa2, 0x63, 0x90, 0xba, 0x71, 0x21, 0x70, 0xdc, 0xd3, 0x5a, 0x0b,
-	0xe5, 0x64, 0x7a, 0x87, 0x56, 0xbe, 0x79, 0x87, 0xaa, 0xbf, 0x9f, 0x01, 0x26, 0x2c, 0xcd, 0x47,
-	0x73, 0xc7, 0x19, 0x5a, 0x67, 0xc4, 0x08, 0xee, 0xc2, 0xa6, 0x70, 0x93, 0x4a, 0xd1, 0x58, 0xe2,
-	0xe4, 0x8a, 0x23, 0x92, 0x93, 0xab, 0x55, 0x97, 0x4d, 0xd7, 0x57, 0x5e, 0x36, 0x5d, 0x7d, 0x89,
-	0xf5, 0x26, 0x54, 0xe4, 0xab, 0x9a, 0x5c, 0x03, 0x02, 0x23, 0xb9, 0xa5, 0xf9, 0x1f, 0xd7, 0x01,
-	0x12, 0x6b, 0xf8, 0x57, 0x1d, 0xe9, 0xb1, 0x62, 0x4a, 0xb2, 0xab, 0xa6, 0xe4, 0x0e, 0x28, 0x32,
-	0x9d, 0x74, 0x67, 0xb8, 0x9e, 0x10, 0x52, 0x37, 0x39, 0x4f, 0x93, 0xee, 0x75, 0x12, 0x4f, 0x13,
-	0x87, 0xc8, 0x22, 0xfa, 0x8d, 0x58, 0xae, 0x10, 0xd1, 0x25, 0x3b, 0xe0, 0x2c, 0x98, 0x7d, 0x06,
-	0x57, 0xe3, 0x9c, 0xfa, 0xa9, 0x1d, 0x1e, 0x7b, 0xf3, 0x50, 0xf8, 0x2d, 0x03, 0x21, 0xa8, 0xaf,
-	0x44, 0x25, 0x3d, 0xe3, 0x68, 0xce, 0xb2, 0x02, 0xf6, 0x31, 0x94, 0x27, 0x73, 0xc7, 0xd1, 0x43,
-	0xeb, 0x2c, 0x14, 0x01, 0xd0, 0x8d, 0x94, 0x23, 0x41, 0x9a, 0x5e, 0xad, 0x34, 0x11, 0x09, 0xf5,
-	0x7f, 0xae, 0x43, 0xfe, 0x27, 0x73, 0xcb, 0x3f, 0x67, 0x9f, 0x40, 0x39, 0x08, 0xa7, 0xa1, 0x7c,
-	0x7a, 0x78, 0x95, 0x17, 0x40, 0x78, 0x3a, 0xfc, 0xb3, 0xa6, 0x96, 0x1b, 0x72, 0xaf, 0x1a, 0xd2,
-	0x92, 0x44, 0xda, 0x82, 0x7c, 0x10, 0x5a, 0xb3, 0x40, 0x44, 0xab, 0xf1, 0x04, 0xdb, 0x86, 0xbc,
-	0xeb, 0x99, 0x56, 0x90, 0x8e, 0x49, 0xeb, 0xa1, 0xd0, 0xe7, 0x08, 0xa6, 0x42, 0x21, 0x9e, 0xf1,
-	0xa5, 0x13, 0x3c, 0x8e, 0xa1, 0x1b, 0x04, 0x96, 0x61, 0xda, 0xee, 0x51, 0x74, 0x07, 0x3b, 0x4e,
-	0xa3, 0xac, 0x25, 0x9d, 0xda, 0x38, 0x8a, 0x1e, 0x44, 0x10, 0x49, 0xb6, 0x0d, 0x15, 0xfc, 0x7c,
-	0xe6, 0xdb, 0xa1, 0x35, 0x78, 0x20, 0xc6, 0x4d, 0x06, 0xa1, 0x46, 0x6c, 0x5a, 0xa1, 0x35, 0x0e,
-	0x07, 0x5f, 0x89, 0x60, 0x2d, 0x8a, 0xc2, 0x89, 0x20, 0xaa, 0x09, 0xb5, 0x54, 0x77, 0x97, 0x5c,
-	0x17, 0x83, 0x76, 0x17, 0x0d, 0xf5, 0x8c, 0x64, 0x7b, 0xaf, 0xcb, 0xf6, 0x76, 0x56, 0x32, 0xc4,
-	0x73, 0x92, 0x65, 0x94, 0x27, 0x33, 0xbe, 0xad, 0x3d, 0x6e, 0x2b, 0x05, 0xf5, 0x0f, 0xd6, 0x61,
-	0x73, 0xe8, 0x1b, 0x6e, 0x60, 0xf0, 0x8b, 0x75, 0x6e, 0xe8, 0x7b, 0x0e, 0xfb, 0x1e, 0x94, 0xc2,
-	0xb1, 0x23, 0x4f, 0xc3, 0xcd, 0x68, 0xd3, 0x2f, 0x90, 0xde, 0x1b, 0x8e, 0xb9, 0x8b, 0xb3, 0x18,
-	0xf2, 0x0f, 0xf6, 0x1e, 0xe4, 0x47, 0xd6, 0x91, 0xed, 0x0a, 0x06, 0x7c, 0x79, 0x31, 0xe3, 0x0e,
-	0x22, 0xf7, 0xd6, 0x34, 0x4e, 0xc5, 0x3e, 0x80, 0xc2, 0xd8, 0x9b, 0x46, 0x92, 0x2a, 0xb9, 0x03,
-	0x24, 0x55, 0x84, 0xd8, 0xbd, 0x35, 0x4d, 0xd0, 0xb1, 0x4f, 0xa0, 0xe4, 0x7b, 0x8e, 0x33, 0x32,
-	0xc6, 0x27, 0x42, 0x86, 0x35, 0x16, 0xf3, 0x68, 0x02, 0xbf, 0xb7, 0xa6, 0xc5, 0xb4, 0xea, 0x3d,
-	0x28, 0x8a, 0xc6, 0xe2, 0x00, 0xec, 0xb4, 0x1f, 0x77, 0xc4, 0x40, 0xb6, 0xfa, 0xfb, 0xfb, 0x9d,
-	0x21, 0xbf, 0x6c, 0xac, 0xf5, 0xbb, 0xdd, 0x9d, 0x66, 0xeb, 0x89, 0xb2, 0xbe, 0x53, 0x82, 0x02,
-	0x77, 0x6c, 0xa9, 0xbf, 0x9d, 0x81, 0x8d, 0x85, 0x0e, 0xb0, 0x87, 0x90, 0x9b, 0xa2, 0x52, 0xc9,
-	0x87, 0xe7, 0xf6, 0xca, 0x5e, 0x4a, 0x69, 0xae, 0x6a, 0x62, 0x0e, 0xf5, 0x33, 0xa8, 0xa7, 0xe1,
-	0x92, 0x7d, 0x5c, 0x83, 0xb2, 0xd6, 0x6e, 0xee, 0xea, 0xfd, 0x1e, 0x5a, 0xa5, 0x68, 0xa5, 0x52,
-	0xf2, 0x99, 0xd6, 0x21, 0x93, 0xf6, 0x37, 0x40, 0x59, 0x1c, 0x18, 0xf6, 0x18, 0x36, 0x50, 0xfd,
-	0x70, 0x2c, 0x2e, 0x28, 0x92, 0x29, 0xbb, 0xb1, 0x62, 0x24, 0x05, 0x19, 0xcd, 0x58, 0x7d, 0x9c,
-	0x4a, 0xab, 0xff, 0x0f, 0xb0, 0xe5, 0x11, 0xfc, 0xd5, 0x15, 0xff, 0x3f, 0x32, 0x90, 0x3b, 0x70,
-	0x0c, 0x97, 0xbd, 0x0e, 0x79, 0x7a, 0x24, 0x47, 0x70, 0xcf, 0x8a, 0xb4, 0xc1, 0x71, 0x59, 0x10,
-	0x8e, 0xbd, 0x03, 0xd9, 0x70, 0x1c, 0x5d, 0xac, 0x7e, 0xe5, 0x82, 0xc5, 0xb7, 0xb7, 0xa6, 0x21,
-	0x15, 0xbb, 0x03, 0x59, 0xd3, 0x8c, 0x22, 0xac, 0x85, 0x1d, 0x8e, 0xc6, 0xdb, 0xae, 0x35, 0xb1,
-	0x5d, 0x5b, 0x3c, 0xea, 0x83, 0x24, 0xec, 0x0d, 0xc8, 0x9a, 0x63, 0x27, 0x1d, 0x2e, 0xcf, 0xcd,
-	0xbc, 0xb8, 0x40, 0x73, 0xec, 0x30, 0x15, 0x6a, 0xa1, 0x7f, 0xae, 0xfb, 0x73, 0x97, 0xe2, 0x93,
-	0x02, 0x61, 0xee, 0x54, 0x50, 0x99, 0x99, 0x53, 0x90, 0x53, 0x20, 0x2e, 0x68, 0xcd, 0x7c, 0x6b,
-	0x66, 0xf8, 0xb1, 0xa1, 0x63, 0x07, 0x07, 0x1c, 0xb0, 0x53, 0x00, 0x7a, 0x3d, 0x54, 0x7d, 0x97,
-	0x5e, 0x70, 0x41, 0x0d, 0x5b, 0x8d, 0xbe, 0x56, 0xdc, 0x7f, 0x15, 0x18, 0xf5, 0x2f, 0xb2, 0x50,
-	0x91, 0xda, 0xc3, 0x3e, 0x82, 0x92, 0x99, 0xde, 0x88, 0x57, 0x97, 0x1a, 0x7d, 0x6f, 0x37, 0xda,
-	0x82, 0xa6, 0x58, 0xde, 0x9f, 0x41, 0x2d, 0xb0, 0x42, 0xfd, 0xb9, 0xe1, 0xdb, 0xfc, 0xdd, 0xae,
-	0x75, 0xd9, 0xa9, 0x3d, 0xb0, 0xc2, 0xa7, 0x11, 0x66, 0x6f, 0x4d, 0xab, 0x06, 0x52, 0x9a, 0xcc,
-	0x00, 0xd1, 0xa5, 0x6c, 0xea, 0x6d, 0x33, 0x0e, 0xdc, 0x5b, 0xd3, 0x22, 0x3c, 0x92, 0x5a, 0x67,
-	0xd6, 0x78, 0x1e, 0x46, 0x66, 0x40, 0x2d, 0xea, 0x10, 0x01, 0xe9, 0x19, 0x45, 0xfe, 0xc9, 0xee,
-	0x23, 0xaf, 0x33, 0x1c, 0xc7, 0x23, 0x9d, 0x2d, 0x2f, 0xbb, 0x98, 0x77, 0x63, 0x38, 0x7f, 0xb6,
-	0x31, 0x4a, 0xb1, 0x37, 0x21, 0xef, 0x85, 0xc7, 0x56, 0xa4, 0x3c, 0x47, 0x6f, 0xc1, 0x20, 0x68,
-	0xb7, 0xd5, 0xc5, 0x95, 0x42, 0x68, 0xf5, 0xe7, 0x19, 0x28, 0x8a, 0x11, 0x60, 0x9b, 0x50, 0x1b,
-	0xb4, 0x87, 0xfa, 0xd3, 0xa6, 0xd6, 0x69, 0xee, 0x74, 0xdb, 0x22, 0xca, 0xff, 0xb1, 0xd6, 0xec,
-	0x09, 0x3e, 0xa9, 0xb5, 0x9f, 0xf6, 0x9f, 0xb4, 0xb9, 0xd3, 0x69, 0xb7, 0xdd, 0xfb, 0x42, 0xc9,
-	0x72, 0xbf, 0x6b, 0xfb, 0xa0, 0xa9, 0x21, 0x97, 0xac, 0x40, 0xb1, 0xfd, 0x79, 0xbb, 0x75, 0x48,
-	0x6c, 0xb2, 0x0e, 0xb0, 0xdb, 0x6e, 0x76, 0xbb, 0xfd, 0x16, 0xb2, 0xcd, 0x02, 0x63, 0x50, 0x6f,
-	0x69, 0xed, 0xe6, 0xb0, 0xad, 0x37, 0x5b, 0xad, 0xfe, 0x61, 0x6f, 0xa8, 0x14, 0xb1, 0xc6, 0x66,
-	0x77, 0xd8, 0xd6, 0x62, 0x10, 0xbd, 0xcf, 0xb5, 0xab, 0xf5, 0x0f, 0x62, 0x48, 0x79, 0xa7, 0x8c,
-	0x26, 0x19, 0xcd, 0x95, 0xfa, 0x97, 0x75, 0xa8, 0xa7, 0x97, 0x26, 0xfb, 0x14, 0x4a, 0xa6, 0x99,
-	0x9a, 0xe3, 0xeb, 0xab, 0x96, 0xf0, 0xbd, 0x5d, 0x33, 0x9a, 0x66, 0xfe, 0xc1, 0x6e, 0x45, 0x1b,
-	0x69, 0x7d, 0x69, 0x23, 0x45, 0xdb, 0xe8, 0x87, 0xb0, 0x21, 0xde, 0x52, 0x31, 0x8d, 0xd0, 0x18,
-	0x19, 0x81, 0x95, 0xde, 0x25, 0x2d, 0x42, 0xee, 0x0a, 0xdc, 0xde, 0x9a, 0x56, 0x1f, 0xa7, 0x20,
-	0xec, 0xfb, 0x50, 0x37, 0xc8, 0xce, 0x8d, 0xf3, 0xe7, 0x64, 0x25, 0xb0, 0x89, 0x38, 0x29, 0x7b,
-	0xcd, 0x90, 0x01, 0xb8, 0x10, 0x4d, 0xdf, 0x9b, 0x25, 0x99, 0xf3, 0xa9, 0xd3, 0x15, 0xdf, 0x9b,
-	0x49, 0x79, 0xab, 0xa6, 0x94, 0x66, 0x9f, 0x40, 0x55, 0xb4, 0x3c, 0xf1, 0x24, 0xc4, 0x5b, 0x96,
-	0x37, 0x9b, 0x94, 0xba, 0xbd, 0x35, 0xad, 0x32, 0x4e, 0x92, 0xec, 0x01, 0x6a, 0x72, 0x89, 0x2e,
-	0x5e, 0x94, 0xd7, 0x1a, 0xb5, 0x36, 0xca, 0x05, 0x46, 0x9c, 0x62, 0x1f, 0x00, 0x50, 0x3b, 0x79,
-	0x9e, 0x52, 0x2a, 0x28, 0xc2, 0xf7, 0x66, 0x51, 0x96, 0xb2, 0x19, 0x25, 0xa4, 0xe6, 0xf1, 0xab,
-	0xa8, 0xe5, 0xe5, 0xe6, 0x51, 0xc4, 0x46, 0xd2, 0x3c, 0x7e, 0xf5, 0x34, 0x6e, 0x1e, 0xcf, 0x06,
-	0x4b, 0xcd, 0x8b, 0x72, 0xf1, 0xe6, 0xf1, 0x4c, 0x51, 0xf3, 0x78, 0x9e, 0xca, 0x62, 0xf3, 0xa2,
-	0x2c, 0xd4, 0x3c, 0x9e, 0xe3, 0xfb, 0x4b, 0xba, 0x7b, 0xf5, 0x42, 0xdd, 0x1d, 0xa7, 0x2d, 0xad,
-	0xbd, 0x7f, 0x1f, 0xea, 0xc1, 0xb1, 0x77, 0x2a, 0x31, 0x90, 0x9a, 0x9c, 0x7b, 0x70, 0xec, 0x9d,
-	0xca, 0x1c, 0xa4, 0x16, 0xc8, 0x00, 0x6c, 0x2d, 0xef, 0x22, 0x5d, 0x36, 0xaf, 0xcb, 0xad, 0xa5,
-	0x1e, 0x3e, 0xb5, 0xad, 0x53, 0x6c, 0xad, 0x11, 0x25, 0x70, 0x50, 0x12, 0xbf, 0x47, 0x20, 0x02,
-	0x81, 0x52, 0x07, 0xfc, 0xa2, 0x26, 0x88, 0x3d, 0x20, 0x01, 0xae, 0xad, 0xb9, 0x2b, 0x67, 0x53,
-	0xe4, 0xb5, 0x75, 0xe8, 0xa6, 0x32, 0x56, 0x39, 0xa9, 0xc8, 0x9a, 0xec, 0x8a, 0xc0, 0xfa, 0x6a,
-	0x6e, 0xb9, 0x63, 0x4b, 0x84, 0x0c, 0xa5, 0x76, 0xc5, 0x40, 0xe0, 0x92, 0x5d, 0x11, 0x41, 0xe2,
-	0x75, 0x1d, 0x67, 0x67, 0x8b, 0xeb, 0x5a, 0xca, 0x4c, 0xeb, 0x3a, 0xce, 0x1a, 0x6f, 0xa8, 0x38,
-	0xef, 0xa5, 0xa5, 0x0d, 0x25, 0x65, 0xe6, 0x1b, 0x2a, 0xce, 0xfd, 0x00, 0xc4, 0x6a, 0xe2, 0x83,
-	0x9b, 0x0a, 0x2c, 0xe2, 0xad, 0x16, 0xa3, 0x0b, 0xe3, 0x38, 0x85, 0x6b, 0xd5, 0xb7, 0xd0, 0x56,
-	0x10, 0x4b, 0xe1, 0xb2, 0xbc, 0x56, 0x35, 0xc2, 0xc4, 0x5b, 0xc9, 0x4f, 0x92, 0xea, 0x1f, 0xe5,
-	0xa1, 0x28, 0x98, 0x0e, 0xbb, 0x04, 0x1b, 0x82, 0xf7, 0xed, 0x36, 0x87, 0xcd, 0x9d, 0xe6, 0x00,
-	0xb5, 0x15, 0x06, 0x75, 0xce, 0xfc, 0x62, 0x58, 0x06, 0x19, 0x22, 0x71, 0xbf, 0x18, 0xb4, 0x8e,
-	0x0c, 0x51, 0xe4, 0xe5, 0x8f, 0x1b, 0x66, 0xd9, 0x06, 0x54, 0x78, 0x46, 0x0e, 0xa0, 0xdb, 0x77,
-	0x94, 0x8b, 0xa7, 0xf3, 0x52, 0x16, 0x7e, 0x18, 0x51, 0x48, 0xb2, 0x70, 0x40, 0x31, 0xce, 0x12,
-	0x9d, 0x56, 0x30, 0xa8, 0x0f, 0xb5, 0xc3, 0x5e, 0x2b, 0xa9, 0xa7, 0x4c, 0x37, 0xa6, 0x78, 0x31,
-	0x4f, 0x3b, 0xed, 0x67, 0x0a, 0x60, 0x26, 0x5e, 0x0a, 0xa5, 0x2b, 0xa8, 0x6f, 0x51, 0x21, 0x94,
-	0xac, 0xb2, 0x57, 0xe0, 0xd2, 0x60, 0xaf, 0xff, 0x4c, 0xe7, 0x99, 0xe2, 0x2e, 0xd4, 0xd8, 0x16,
-	0x28, 0x12, 0x82, 0x17, 0x5f, 0xc7, 0x2a, 0x09, 0x1a, 0x11, 0x0e, 0x94, 0x0d, 0x3a, 0xee, 0x43,
-	0xd8, 0x90, 0x0b, 0x20, 0x05, 0xbb, 0xc2, 0xb3, 0xf6, 0xbb, 0x87, 0xfb, 0xbd, 0x81, 0xb2, 0x89,
-	0x8d, 0x20, 0x08, 0x6f, 0x39, 0x8b, 0x8b, 0x49, 0xc4, 0xd6, 0x25, 0x92, 0x64, 0x08, 0x7b, 0xd6,
-	0xd4, 0x7a, 0x9d, 0xde, 0xe3, 0x81, 0xb2, 0x15, 0x97, 0xdc, 0xd6, 0xb4, 0xbe, 0x36, 0x50, 0x2e,
-	0xc7, 0x80, 0xc1, 0xb0, 0x39, 0x3c, 0x1c, 0x28, 0x57, 0xe2, 0x56, 0x1e, 0x68, 0xfd, 0x56, 0x7b,
-	0x30, 0xe8, 0x76, 0x06, 0x43, 0xe5, 0x15, 0x76, 0x19, 0x36, 0x93, 0x16, 0x45, 0xc4, 0x0d, 0xa9,
-	0xa1, 0xda, 0xe3, 0xf6, 0x50, 0xb9, 0x1a, 0x37, 0xa3, 0xd5, 0xef, 0x76, 0x9b, 0x74, 0x30, 0x75,
-	0x0d, 0x89, 0xe8, 0xc4, 0x4e, 0xf4, 0xe6, 0x55, 0x6c, 0xd7, 0x61, 0x4f, 0x06, 0x5d, 0x97, 0x96,
-	0xc6, 0xa0, 0xfd, 0x93, 0xc3, 0x76, 0xaf, 0xd5, 0x56, 0x5e, 0x4b, 0x96, 0x46, 0x0c, 0xbb, 0x11,
-	0x2f, 0x8d, 0x18, 0x74, 0x33, 0xae, 0x33, 0x02, 0x0d, 0x94, 0x6d, 0x2c, 0x4f, 0xb4, 0xa3, 0xd7,
-	0x6b, 0xb7, 0x86, 0xd8, 0xd7, 0x5b, 0xf1, 0x28, 0x1e, 0x1e, 0x3c, 0xd6, 0x9a, 0xbb, 0x6d, 0x45,
-	0x45, 0x88, 0xd6, 0xee, 0x35, 0xf7, 0xa3, 0xd9, 0x7e, 0x7d, 0xa7, 0x4a, 0x4f, 0x41, 0x0b, 0x71,
-	0xa9, 0xfe, 0x18, 0x98, 0xfc, 0xa6, 0xaa, 0x78, 0x5c, 0x8c, 0x41, 0x6e, 0xe2, 0x7b, 0xd3, 0xe8,
-	0xb6, 0x39, 0x7e, 0xa3, 0xad, 0x36, 0x9b, 0x8f, 0xe8, 0x74, 0x29, 0xb9, 0x8e, 0x2a, 0x83, 0xd4,
-	0x3f, 0xca, 0x40, 0x3d, 0x2d, 0x2a, 0xe9, 0xa9, 0xd3, 0x89, 0xee, 0x7a, 0x21, 0x7f, 0xb5, 0x2a,
-	0x88, 0x9f, 0x3a, 0x9d, 0xf4, 0xbc, 0x90, 0x9e, 0xad, 0x22, 0xd3, 0x31, 0x96, 0x7c, 0xbc, 0xd4,
-	0x38, 0xcd, 0x3a, 0x70, 0x29, 0xf5, 0xe4, 0x6c, 0xea, 0xcd, 0xb0, 0x46, 0xfc, 0x9c, 0xe4, 0x42,
-	0xfb, 0x35, 0x16, 0x2c, 0xf7, 0x49, 0x81, 0x6c, 0xf0, 0x95, 0x23, 0x1c, 0x01, 0xf8, 0xa9, 0xee,
-	0x41, 0x2d, 0x25, 0x99, 0xc9, 0xe2, 0x9f, 0xa4, 0x5b, 0x5a, 0xb2, 0x27, 0x2f, 0x6e, 0xa6, 0xfa,
-	0x87, 0x19, 0xa8, 0xca, 0x72, 0xfa, 0x3b, 0x97, 0x44, 0xd7, 0x4e, 0xc4, 0xb7, 0x6e, 0x9b, 0xd1,
-	0x6b, 0x55, 0x11, 0xa8, 0x43, 0x4f, 0xe0, 0x73, 0x1f, 0xec, 0xa3, 0x93, 0x41, 0xdc, 0x1d, 0x19,
-	0x84, 0x26, 0x33, 0x5d, 0x47, 0x7c, 0xf4, 0x04, 0x09, 0xc4, 0xc5, 0x95, 0x04, 0xa2, 0xde, 0x84,
-	0xf2, 0xa3, 0x93, 0x28, 0x60, 0x40, 0x7e, 0xbb, 0xad, 0xcc, 0xef, 0x30, 0xab, 0x7f, 0x92, 0x81,
-	0x7a, 0xf2, 0x1e, 0x07, 0x85, 0x21, 0xf2, 0xa7, 0x8a, 0xf9, 0x72, 0x58, 0x37, 0x47, 0xc9, 0xeb,
-	0xf8, 0xeb, 0xf2, 0xeb, 0xf8, 0xaf, 0x8b, 0xc2, 0xb2, 0xb2, 0x34, 0x8b, 0xeb, 0x12, 0x37, 0xa4,
-	0x1f, 0x40, 0x15, 0xff, 0x6b, 0xd6, 0xc4, 0xf2, 0x7d, 0x2b, 0x7a, 0xb5, 0x79, 0x89, 0x38, 0x45,
-	0x44, 0x16, 0x89, 0x35, 0x11, 0x8a, 0xd1, 0xca, 0x27, 0x43, 0x10, 0xaf, 0xfe, 0x8b, 0x1c, 0x54,
-	0x24, 0xad, 0xe7, 0x5b, 0x2d, 0xbf, 0xeb, 0x50, 0x4e, 0x1e, 0xa3, 0x10, 0xd7, 0x52, 0x63, 0x40,
-	0x6a, 0xae, 0xb2, 0x0b, 0x73, 0xd5, 0x80, 0xa2, 0x88, 0x57, 0x14, 0x7e, 0xcf, 0x28, 0x99, 0x76,
-	0xec, 0xe5, 0x5f, 0xe0, 0x7a, 0xff, 0x10, 0xaa, 0x92, 0x57, 0x2e, 0x7a, 0xd9, 0x66, 0x91, 0xbe,
-	0x92, 0x78, 0xe8, 0x02, 0x76, 0x19, 0x0a, 0x93, 0x13, 0xdd, 0x1c, 0x45, 0x6e, 0xce, 0xfc, 0xe4,
-	0x64, 0x77, 0x44, 0x47, 0x17, 0x93, 0x58, 0xd0, 0x73, 0x5f, 0x49, 0x69, 0x12, 0x89, 0xf3, 0x3b,
-	0x50, 0x9c, 0x9c, 0xf0, 0x3b, 0x6f, 0x65, 0x39, 0x04, 0x27, 0x19, 0xf2, 0xc2, 0xe4, 0x84, 0x6e,
-	0xc8, 0x7d, 0x06, 0xca, 0x82, 0x4f, 0x35, 0x10, 0x67, 0x85, 0x8b, 0x8d, 0xda, 0x48, 0xbb, 0x57,
-	0x03, 0xf6, 0x3e, 0x6c, 0x09, 0xc9, 0x6b, 0x04, 0x3a, 0x0f, 0xc2, 0xa7, 0xf7, 0x4d, 0xf8, 0x23,
-	0x70, 0x9b, 0x1c, 0xd7, 0x0c, 0x06, 0x84, 0xc1, 0xc5, 0xaa, 0x42, 0x55, 0x5a, 0xbb, 0xfc, 0xf1,
-	0x98, 0xb2, 0x96, 0x82, 0xb1, 0x87, 0x50, 0x9d, 0x9c, 0xf0, 0xb5, 0x30, 0xf4, 0xf6, 0x2d, 0x11,
-	0x58, 0xbd, 0xb5, 0xb8, 0x0a, 0x28, 0x78, 0x36, 0x45, 0xc9, 0xde, 0x03, 0xe6, 0x5b, 0xa1, 0xe5,
-	0x52, 0x4f, 0x4c, 0xcb, 0x30, 0x1d, 0xdb, 0xb5, 0x48, 0xd9, 0xca, 0x6a, 0x9b, 0x31, 0x66, 0x57,
-	0x20, 0xd4, 0x7f, 0x95, 0x81, 0x7a, 0xa2, 0xfd, 0xe2, 0x86, 0x66, 0x77, 0xe5, 0xf7, 0xca, 0x1b,
-	0x8b, 0x0a, 0x32, 0x92, 0xdc, 0x1b, 0x9e, 0xcf, 0xf8, 0xcb, 0x9f, 0xab, 0x5e, 0x00, 0x5a, 0xe5,
-	0x72, 0xcd, 0xae, 0x7c, 0x4d, 0xf9, 0x31, 0x64, 0x87, 0xe7, 0x33, 0xee, 0x69, 0x41, 0x19, 0xc8,
-	0xad, 0x32, 0x2e, 0xfd, 0x28, 0x62, 0xe0, 0x49, 0xfb, 0x0b, 0x7e, 0x25, 0xff, 0x40, 0xeb, 0xec,
-	0x37, 0xb5, 0x2f, 0x28, 0x16, 0x84, 0xb4, 0x84, 0x47, 0x7d, 0xad, 0xdd, 0x79, 0xdc, 0x23, 0x40,
-	0x8e, 0xfc, 0x30, 0x49, 0x13, 0x9b, 0xa6, 0xf9, 0xe8, 0x44, 0x7e, 0x08, 0x25, 0x93, 0x7a, 0x08,
-	0x25, 0x7d, 0x8b, 0x77, 0x7d, 0xf1, 0x16, 0x2f, 0x8b, 0x77, 0x74, 0xcc, 0x1e, 0xd8, 0x5b, 0x90,
-	0x9b, 0x9c, 0x58, 0xe7, 0x69, 0x13, 0x27, 0xbd, 0x19, 0x89, 0x40, 0xfd, 0x45, 0x06, 0x58, 0xaa,
-	0x21, 0x5c, 0xeb, 0xfe, 0xae, 0x6d, 0xf9, 0x14, 0x1a, 0xe2, 0x99, 0x4c, 0x4e, 0x25, 0xf9, 0x78,
-	0xc5, 0x90, 0x5e, 0xf6, 0x92, 0x58, 0xbb, 0xe4, 0x91, 0x22, 0xf6, 0x3e, 0xf0, 0x77, 0x0a, 0x71,
-	0x81, 0xa4, 0x9d, 0x1a, 0x12, 0xaf, 0xd0, 0x12, 0x9a, 0xe4, 0x61, 0x42, 0xf9, 0xc1, 0x45, 0xee,
-	0x1e, 0xde, 0x48, 0x66, 0x8d, 0xf8, 0x87, 0xfa, 0xbb, 0x19, 0xb8, 0x94, 0x5e, 0x10, 0xbf, 0x5c,
-	0x2f, 0xd3, 0xaf, 0x4b, 0x66, 0x17, 0x5f, 0x97, 0x5c, 0xb5, 0x9e, 0x72, 0x2b, 0xd7, 0xd3, 0xdf,
-	0xc9, 0xc0, 0x96, 0x34, 0xfa, 0x89, 0x9d, 0xf4, 0xb7, 0xd4, 0x32, 0xe9, 0x91, 0xc9, 0x5c, 0xea,
-	0x91, 0x49, 0xf5, 0x0f, 0x32, 0x70, 0x65, 0xa1, 0x25, 0x9a, 0xf5, 0xb7, 0xda, 0x96, 0xf4, 0x63,
-	0x94, 0xe4, 0xa2, 0xe6, 0xc1, 0x87, 0xfc, 0xae, 0x21, 0x4b, 0xbf, 0x2e, 0x49, 0x97, 0xad, 0xff,
-	0x75, 0xba, 0x91, 0x66, 0x72, 0x75, 0x88, 0x7d, 0x0c, 0x95, 0x44, 0x63, 0x8a, 0x5e, 0xff, 0x58,
-	0x79, 0xef, 0x48, 0xa6, 0x5b, 0xc9, 0x46, 0xd7, 0xbf, 0x1d, 0x1b, 0x7d, 0x08, 0xd5, 0xb8, 0xe0,
-	0x5d, 0x6b, 0x92, 0xf6, 0x46, 0x2c, 0xbc, 0x56, 0x95, 0xa2, 0x54, 0x3f, 0x82, 0xcd, 0xa4, 0x17,
-	0x2d, 0xf1, 0xc2, 0xda, 0x4d, 0xa8, 0xb8, 0xd6, 0xa9, 0x1e, 0xbd, 0xbf, 0x26, 0xa2, 0x68, 0x5c,
-	0xeb, 0x54, 0x10, 0xa8, 0x8f, 0x64, 0xbe, 0x17, 0xff, 0x0c, 0x80, 0x63, 0xca, 0x33, 0x53, 0xf4,
-	0x1c, 0x33, 0x42, 0x61, 0x69, 0xd2, 0xc4, 0x14, 0x5d, 0xeb, 0x94, 0xd6, 0xdc, 0xa9, 0x28, 0xa7,
-	0x69, 0x9a, 0xe2, 0xc0, 0x7c, 0xd5, 0xbb, 0x45, 0x57, 0xa1, 0x34, 0xf3, 0x53, 0x33, 0x5b, 0x9c,
-	0xf9, 0xbc, 0xda, 0xdb, 0x22, 0x46, 0xe7, 0xa2, 0xc3, 0x75, 0x1e, 0xb5, 0x23, 0x7e, 0x26, 0x24,
-	0x97, 0xfc, 0x4c, 0xc8, 0xc7, 0x82, 0xe5, 0xe1, 0xfe, 0x13, 0x35, 0xc7, 0x87, 0xe8, 0x99, 0x3b,
-	0x35, 0x3a, 0x44, 0x27, 0x0d, 0xd0, 0xfa, 0x4a, 0x84, 0x09, 0xe1, 0xa7, 0xba, 0x03, 0x15, 0xc9,
-	0xb2, 0x43, 0xd5, 0x44, 0xf2, 0x8a, 0x04, 0xe9, 0xb7, 0x61, 0x92, 0x01, 0xd2, 0x2a, 0x89, 0x53,
-	0x24, 0x50, 0x7f, 0x0f, 0x00, 0x12, 0x5c, 0x4a, 0x61, 0xc8, 0x2c, 0x28, 0x0c, 0x2f, 0x75, 0x22,
-	0xff, 0x11, 0xd4, 0xc7, 0xde, 0xec, 0x5c, 0x4f, 0x72, 0x64, 0x57, 0xe6, 0xa8, 0x22, 0xd5, 0x30,
-	0xb9, 0xb4, 0xb3, 0x7c, 0xd2, 0x9a, 0x5b, 0x79, 0xd2, 0xfa, 0x21, 0x14, 0xb9, 0xe3, 0x3e, 0x10,
-	0x97, 0xbe, 0x5e, 0x59, 0xec, 0xe7, 0x3d, 0x11, 0x8d, 0x1a, 0xd1, 0xb1, 0x36, 0x5a, 0xe5, 0xe2,
-	0x31, 0x46, 0xf9, 0x0a, 0xd8, 0x8d, 0xe5, 0x9c, 0x11, 0x19, 0x7f, 0x01, 0xcc, 0x90, 0x93, 0x92,
-	0x92, 0x10, 0x4e, 0x85, 0x37, 0x89, 0x94, 0x84, 0xa2, 0xac, 0x24, 0x0c, 0xa7, 0xdc, 0x87, 0x84,
-	0x4a, 0xc2, 0x7b, 0x70, 0x49, 0x44, 0xc5, 0x63, 0x06, 0x1c, 0x4e, 0xa2, 0xe7, 0x01, 0x50, 0xe2,
-	0xc1, 0x8e, 0xe1, 0x94, 0xb4, 0x6f, 0x24, 0xff, 0x1c, 0xb6, 0xc6, 0xc7, 0x86, 0x7b, 0x64, 0xe9,
-	0xe1, 0xc8, 0xd1, 0xe9, 0x2d, 0x70, 0x7d, 0x6a, 0xcc, 0x84, 0xda, 0xf3, 0xd6, 0x52, 0x63, 0x5b,
-	0x44, 0x3c, 0x1c, 0x39, 0x14, 0xa1, 0x13, 0x9f, 0xc7, 0x6f, 0x8e, 0x17, 0xe1, 0x0b, 0xa7, 0x51,
-	0xb0, 0x78, 0x1a, 0xb5, 0xa4, 0xcd, 0x54, 0x96, 0xb5, 0x99, 0x6b, 0x7f, 0x9e, 0x83, 0x82, 0x88,
-	0xce, 0xbb, 0x0b, 0x39, 0xd3, 0xf7, 0x66, 0x71, 0x10, 0xdd, 0x0a, 0xed, 0x82, 0x7e, 0x12, 0x09,
-	0x15, 0x91, 0x7b, 0x50, 0x30, 0x4c, 0x53, 0x9f, 0x9c, 0xa4, 0x4f, 0x8c, 0x16, 0x04, 0xfd, 0xde,
-	0x9a, 0x96, 0x37, 0x48, 0xe2, 0x7f, 0x0a, 0x65, 0xa4, 0xe7, 0xce, 0xb0, 0x94, 0xbd, 0xb4, 0x2c,
-	0x92, 0xf7, 0xd6, 0xb4, 0x92, 0x11, 0x89, 0xe7, 0x1f, 0xa4, 0x7d, 0x6f, 0x5c, 0x5e, 0x5e, 0x5b,
-	0xca, 0x7a, 0x91, 0x17, 0xee, 0xd7, 0x81, 0x3b, 0x63, 0x62, 0x6e, 0x93, 0x97, 0x0f, 0x27, 0x96,
-	0x78, 0xd3, 0xde, 0x9a, 0xc6, 0xf7, 0x5c, 0xc4, 0xab, 0x3e, 0x8e, 0xfc, 0x62, 0xf1, 0x0f, 0x4f,
-	0xac, 0x18, 0x19, 0xe4, 0x15, 0xb1, 0x73, 0x8c, 0x18, 0x07, 0x66, 0x33, 0xcd, 0x28, 0x6c, 0xa7,
-	0xb8, 0x94, 0x2d, 0xe6, 0x48, 0x94, 0x2d, 0x66, 0x4f, 0x0f, 0xa1, 0x42, 0x2e, 0x2a, 0x91, 0xaf,
-	0xb4, 0x34, 0xb4, 0x09, 0x43, 0x21, 0xc7, 0x7b, 0xc2, 0x5e, 0x5a, 0x51, 0x3f, 0x7d, 0x4b, 0xf6,
-	0x6d, 0x5e, 0x5f, 0x39, 0x50, 0x5a, 0xec, 0xe6, 0xe4, 0x9d, 0xd5, 0x78, 0x1e, 0xb6, 0x03, 0x55,
-	0x43, 0x92, 0x34, 0xc2, 0xd1, 0x79, 0x7d, 0xc5, 0x3c, 0xc5, 0x34, 0x54, 0x86, 0x94, 0x4e, 0x0e,
-	0xe0, 0xae, 0x69, 0x70, 0x65, 0xf5, 0x52, 0x96, 0x23, 0x49, 0x72, 0x3c, 0x92, 0x44, 0x4d, 0x3f,
-	0x9a, 0x92, 0xbe, 0xb9, 0x2a, 0xc5, 0x95, 0xfc, 0x08, 0x6d, 0x64, 0x79, 0xf3, 0x56, 0xa0, 0x18,
-	0x3d, 0x2c, 0x4c, 0x81, 0xaa, 0xad, 0xfe, 0xc1, 0x17, 0x4a, 0x06, 0xc1, 0x9d, 0xde, 0x60, 0xd8,
-	0xec, 0x89, 0xe3, 0xd5, 0x4e, 0x4f, 0x1c, 0xaf, 0xaa, 0xff, 0x36, 0x0b, 0xe5, 0xd8, 0x3d, 0xfc,
-	0xdd, 0x0d, 0xe3, 0xd8, 0xe2, 0xcc, 0xca, 0x16, 0xe7, 0x82, 0xa6, 0x26, 0xbf, 0x44, 0xb2, 0x91,
-	0xd6, 0x87, 0x82, 0xe5, 0x1b, 0x71, 0xf9, 0x6f, 0x79, 0x23, 0x4e, 0x8e, 0x4c, 0x2c, 0xa4, 0x23,
-	0x13, 0x17, 0x1e, 0x97, 0x2e, 0x52, 0x98, 0x8a, 0xfc, 0xb8, 0xf4, 0x85, 0xf1, 0x29, 0xa5, 0x8b,
-	0xe3, 0x53, 0xe8, 0x77, 0xdf, 0x9e, 0xda, 0xd6, 0xa9, 0x08, 0xd0, 0x13, 0xa9, 0xb4, 0xf8, 0x80,
-	0x17, 0x88, 0x8f, 0x6f, 0xc1, 0x8a, 0xd8, 0x7d, 0xd8, 0x9a, 0x9c, 0xc4, 0x0f, 0x69, 0x26, 0x06,
-	0x56, 0x95, 0xba, 0xb1, 0x12, 0xa7, 0xfe, 0xfd, 0x0c, 0x40, 0xe2, 0x43, 0xfd, 0xa5, 0x1d, 0x3c,
-	0x92, 0x0d, 0x9d, 0xfd, 0x06, 0x1b, 0xfa, 0x05, 0xaf, 0x75, 0xa8, 0x5f, 0x41, 0x39, 0xf6, 0x9a,
-	0x7f, 0xf7, 0x35, 0xf6, 0x52, 0x55, 0xfe, 0x66, 0xe4, 0xec, 0x8a, 0xdd, 0xce, 0xbf, 0xec, 0x58,
-	0xa4, 0xaa, 0xcf, 0xbe, 0xa0, 0xfa, 0x33, 0xee, 0x71, 0x8a, 0x2b, 0xff, 0x15, 0x6f, 0x2c, 0x79,
-	0xcd, 0xe7, 0x52, 0x6b, 0x5e, 0x9d, 0x0b, 0xb7, 0xd9, 0x2f, 0x5f, 0xf5, 0x4b, 0x75, 0xf8, 0xaf,
-	0x33, 0x91, 0x6f, 0x27, 0x7e, 0x9e, 0xf4, 0x42, 0x45, 0x6b, 0xb5, 0x7b, 0xea, 0x65, 0xaa, 0xfb,
-	0x46, 0x6b, 0x33, 0xf7, 0x4d, 0xd6, 0xe6, 0x5b, 0x90, 0xe7, 0x02, 0x21, 0x7f, 0x91, 0xa5, 0xc9,
-	0xf1, 0x2f, 0x7c, 0xd0, 0x5f, 0x55, 0x85, 0x62, 0xc9, 0xfb, 0xbb, 0x15, 0x95, 0x1b, 0xfd, 0x18,
-	0x01, 0x26, 0xd0, 0xd8, 0x2f, 0x27, 0x46, 0xe7, 0xcb, 0x8f, 0xc9, 0xaf, 0xcc, 0xdc, 0xfc, 0xa7,
-	0xeb, 0x50, 0x4b, 0x1d, 0x98, 0x7d, 0x87, 0xc6, 0xac, 0xe4, 0xe6, 0xd9, 0xd5, 0xdc, 0xfc, 0xbb,
-	0xbc, 0x43, 0xf5, 0xbf, 0x45, 0x02, 0xa4, 0x62, 0xcc, 0x4a, 0xe9, 0x18, 0x33, 0xe4, 0xa6, 0xd5,
-	0x94, 0x56, 0xbe, 0x4a, 0x7f, 0xcf, 0xac, 0xd4, 0xdf, 0x6f, 0xc4, 0xbf, 0x72, 0xd6, 0xd9, 0xe5,
-	0x86, 0x65, 0x4d, 0x93, 0x20, 0xec, 0x33, 0xb8, 0xca, 0xb5, 0x1a, 0xf1, 0x4b, 0x63, 0xde, 0x44,
-	0x8f, 0x7f, 0x03, 0x4d, 0xc4, 0xcd, 0x5d, 0xe1, 0x04, 0xfc, 0xd7, 0x1e, 0x26, 0xcd, 0x08, 0xab,
-	0x76, 0xa0, 0x96, 0x3a, 0xbd, 0x94, 0x7e, 0x4f, 0x31, 0x23, 0xff, 0x9e, 0x22, 0xdb, 0x86, 0xfc,
-	0xe9, 0xb1, 0xe5, 0x5b, 0x2b, 0xde, 0x6b, 0xe4, 0x08, 0xf5, 0xfb, 0x50, 0x95, 0x23, 0x29, 0xd8,
-	0xbb, 0x90, 0xb7, 0x43, 0x6b, 0x1a, 0xd9, 0x56, 0x57, 0x96, 0x83, 0x2d, 0xc8, 0x90, 0xe6, 0x44,
-	0xea, 0xcf, 0x33, 0xa0, 0x2c, 0xe2, 0xa4, 0x1f, 0x7d, 0xcc, 0x5c, 0xf0, 0xa3, 0x8f, 0xeb, 0xa9,
-	0x46, 0xae, 0xfa, 0xdd, 0xc6, 0xf8, 0xcd, 0xb8, 0xdc, 0x05, 0x6f, 0xc6, 0xb1, 0x37, 0xa1, 0xe4,
-	0x5b, 0xf4, 0x8b, 0x7a, 0xe6, 0x8a, 0x58, 0xe6, 0x18, 0xa7, 0xfe, 0x4e, 0x06, 0x8a, 0x22, 0xec,
-	0x63, 0xa5, 0xb1, 0xfb, 0x36, 0x14, 0xf9, 0xaf, 0xeb, 0x45, 0xc6, 0xff, 0x52, 0x1c, 0x64, 0x84,
-	0x67, 0x37, 0x78, 0x30, 0x4c, 0xda, 0xf8, 0x3d, 0x70, 0x0c, 0x57, 0x23, 0xb8, 0xf8, 0x11, 0x17,
-	0x63, 0x2a, 0x2e, 0xf6, 0xf1, 0xa7, 0x3c, 0x80, 0x40, 0xfc, 0x0e, 0xdf, 0x0f, 0xa0, 0x28, 0xc2,
-	0x4a, 0x56, 0x36, 0xe5, 0x45, 0xbf, 0xbe, 0xb6, 0x0d, 0x90, 0xc4, 0x99, 0xac, 0x2a, 0x41, 0xbd,
-	0x0b, 0xa5, 0x28, 0xb4, 0x04, 0xd7, 0x5f, 0x52, 0xb5, 0x88, 0x55, 0x97, 0x1b, 0xe3, 0x88, 0x27,
-	0x8c, 0xbb, 0xde, 0xf8, 0x84, 0xbc, 0x6a, 0xef, 0x03, 0xc5, 0xf0, 0x0f, 0x97, 0xde, 0x3c, 0x49,
-	0x3f, 0x17, 0x1d, 0x13, 0xb1, 0xbb, 0x10, 0xb3, 0xe3, 0x17, 0x59, 0xcb, 0x6a, 0x33, 0xba, 0x4b,
-	0x42, 0xab, 0xec, 0x81, 0xf0, 0x1e, 0x75, 0xe9, 0x75, 0xa2, 0x94, 0xc3, 0x26, 0xd5, 0x26, 0x4d,
-	0x22, 0x53, 0xeb, 0x50, 0x95, 0xcf, 0xc3, 0xd5, 0x26, 0x6c, 0xee, 0x5b, 0xa1, 0x81, 0x3c, 0x2b,
-	0x7a, 0x3c, 0x82, 0xaf, 0x5f, 0xfc, 0x48, 0xaf, 0xdf, 0x45, 0x3a, 0x8d, 0x13, 0xa9, 0x3f, 0xcf,
-	0x81, 0xb2, 0x88, 0x43, 0x66, 0x12, 0xdf, 0xe1, 0xcc, 0x44, 0x4f, 0xe1, 0x3b, 0xf1, 0x2f, 0x19,
-	0xd1, 0xba, 0x48, 0xfd, 0x4c, 0x0f, 0x07, 0x49, 0x01, 0xab, 0xa9, 0x37, 0xe5, 0x4b, 0x76, 0xb0,
-	0xc7, 0x5f, 0x95, 0x7f, 0x85, 0x3f, 0xd8, 0xe1, 0x78, 0x63, 0x5a, 0xd6, 0x55, 0x7a, 0x9f, 0xa3,
-	0xeb, 0x8d, 0xe9, 0x2d, 0x23, 0x61, 0x70, 0xf3, 0x20, 0xad, 0xaa, 0x56, 0x12, 0x56, 0x36, 0x1d,
-	0x1a, 0x88, 0x30, 0xd6, 0x30, 0x10, 0x57, 0x92, 0x4a, 0x1c, 0x30, 0x0c, 0xa2, 0x97, 0x76, 0xc7,
-	0xe2, 0x37, 0x65, 0xb2, 0xf4, 0xd2, 0x6e, 0xcb, 0xa5, 0xdb, 0x3a, 0xf4, 0x13, 0x48, 0x63, 0xf1,
-	0x13, 0x55, 0xe2, 0x1d, 0x63, 0x44, 0xbd, 0xce, 0x7f, 0x75, 0xc7, 0xb7, 0x82, 0x80, 0x3f, 0xcd,
-	0x55, 0x16, 0xaf, 0x13, 0x09, 0x60, 0xfc, 0x06, 0x98, 0xf8, 0xcd, 0x23, 0x24, 0x01, 0xf1, 0x06,
-	0x18, 0xff, 0xc5, 0x23, 0x24, 0xb8, 0x0a, 0xa5, 0xaf, 0x3d, 0xd7, 0x22, 0xc3, 0xbd, 0x42, 0xad,
-	0x2a, 0x62, 0x7a, 0xdf, 0x98, 0xa9, 0x7f, 0x96, 0x81, 0xad, 0xc5, 0x51, 0xa5, 0x05, 0x53, 0x85,
-	0x52, 0xab, 0xdf, 0xd5, 0x7b, 0xcd, 0xfd, 0xb6, 0xb2, 0xc6, 0x36, 0xa0, 0xd2, 0xdf, 0xf9, 0x71,
-	0xbb, 0x35, 0xe4, 0x80, 0x0c, 0xdd, 0xdc, 0x1c, 0xe8, 0x7b, 0x9d, 0xdd, 0xdd, 0x76, 0x8f, 0x5b,
-	0x29, 0xfd, 0x9d, 0x1f, 0xeb, 0xdd, 0x7e, 0x8b, 0xff, 0x44, 0x4a, 0x74, 0xfa, 0x3e, 0x50, 0x72,
-	0x74, 0xe2, 0x4d, 0x31, 0xa1, 0x98, 0xcc, 0xf3, 0x90, 0xc7, 0x67, 0x03, 0xbd, 0xd5, 0x1b, 0x2a,
-	0x05, 0x4c, 0xf5, 0x0e, 0xbb, 0x5d, 0x4a, 0x51, 0x6c, 0x53, 0xab, 0xbf, 0x7f, 0xa0, 0xb5, 0x07,
-	0x03, 0x7d, 0xd0, 0xf9, 0x69, 0x5b, 0x29, 0x51, 0xcd, 0x5a, 0xe7, 0x71, 0xa7, 0xc7, 0x01, 0x65,
-	0x56, 0x84, 0xec, 0x7e, 0xa7, 0xc7, 0x6f, 0xac, 0xee, 0x37, 0x3f, 0x57, 0x2a, 0xf8, 0x31, 0x38,
-	0xdc, 0x57, 0xaa, 0x77, 0x6f, 0x41, 0x55, 0xfe, 0x9d, 0x31, 0x8a, 0x72, 0xf4, 0x5c, 0x8b, 0xbf,
-	0xc7, 0xdb, 0xfd, 0xfa, 0x23, 0x25, 0x73, 0xf7, 0x37, 0xa5, 0xdf, 0x6f, 0x20, 0x1a, 0x71, 0x18,
-	0x40, 0xf7, 0xf3, 0xf8, 0xfd, 0x3f, 0x72, 0xfd, 0xd3, 0x75, 0xc1, 0xbd, 0xe6, 0x60, 0x8f, 0x1f,
-	0x13, 0x08, 0x0c, 0x01, 0xb2, 0xc9, 0x3b, 0xae, 0x74, 0xfd, 0x96, 0x3e, 0xe3, 0xc3, 0xf6, 0x3c,
-	0xdd, 0x8c, 0xec, 0x0c, 0xb0, 0x73, 0x0a, 0x54, 0xf1, 0x2b, 0xc6, 0x15, 0xef, 0xaa, 0x50, 0x91,
-	0x1e, 0xda, 0xa6, 0x3a, 0x8c, 0xe0, 0x58, 0x3c, 0x0d, 0x8b, 0xe6, 0xa6, 0x92, 0xb9, 0xfb, 0x26,
-	0x4a, 0x0c, 0xf9, 0x99, 0x6b, 0x80, 0x42, 0xcf, 0xf3, 0xa7, 0x86, 0x23, 0xe8, 0xac, 0x79, 0x80,
-	0x74, 0xef, 0xc3, 0xe5, 0x95, 0x8f, 0x76, 0x53, 0xa4, 0xae, 0x3d, 0x9d, 0x39, 0x16, 0x0f, 0x36,
-	0xdd, 0x3b, 0x1f, 0xf9, 0xb6, 0xa9, 0x64, 0xee, 0x3e, 0x8c, 0xae, 0xf0, 0x45, 0x75, 0x77, 0xfb,
-	0xcd, 0x5d, 0x3e, 0xb9, 0xf1, 0xf5, 0xe0, 0xe1, 0x0e, 0x7f, 0xf6, 0x55, 0x6b, 0x0f, 0x0e, 0xbb,
-	0x43, 0x71, 0x15, 0xf9, 0xee, 0x8f, 0xa0, 0x71, 0x51, 0xd4, 0x25, 0xb6, 0xa8, 0xb5, 0xd7, 0xa4,
-	0xc8, 0x56, 0x9c, 0xcc, 0xbe, 0xce, 0x53, 0x19, 0x1e, 0x18, 0xdc, 0x6d, 0x53, 0x44, 0xc6, 0xdd,
-	0x9f, 0x65, 0x24, 0x16, 0x16, 0x45, 0xce, 0xc5, 0x00, 0x31, 0x4b, 0x32, 0x48, 0xb3, 0x0c, 0x53,
-	0xc9, 0xb0, 0x2b, 0xc0, 0x52, 0xa0, 0xae, 0x37, 0x36, 0x1c, 0x65, 0x9d, 0x62, 0x2f, 0x22, 0x38,
-	0xc5, 0x37, 0x2b, 0x59, 0xf6, 0x1a, 0x5c, 0x8d, 0x61, 0x5d, 0xef, 0xf4, 0xc0, 0xb7, 0xd1, 0xd6,
-	0x3e, 0xe7, 0xe8, 0xdc, 0xce, 0x0f, 0xff, 0xf4, 0x17, 0x37, 0x32, 0xff, 0xfe, 0x17, 0x37, 0x32,
-	0xff, 0xf5, 0x17, 0x37, 0xd6, 0x7e, 0xfe, 0xdf, 0x6e, 0x64, 0x7e, 0x2a, 0xff, 0xce, 0xfa, 0xd4,
-	0x08, 0x7d, 0xfb, 0x8c, 0x6f, 0x9a, 0x28, 0xe1, 0x5a, 0xef, 0xcf, 0x4e, 0x8e, 0xde, 0x9f, 0x8d,
-	0xde, 0x47, 0xce, 0x34, 0x2a, 0xd0, 0x2f, 0xaa, 0x3f, 0xf8, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x9e, 0x92, 0xf4, 0xe5, 0xb1, 0x7d, 0x00, 0x00,
+	// 12702 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x7d, 0x5b, 0x8c, 0x24, 0x47,
+	0x72, 0xd8, 0xf6, 0xf4, 0x3b, 0xfa, 0x31, 0x35, 0xb5, 0xb3, 0xbb, 0xbd, 0xcb, 0xe5, 0x72, 0x58,
+	0xe4, 0x91, 0xcb, 0x25, 0xb9, 0x24, 0x67, 0xf9, 0x58, 0x52, 0x77, 0xba, 0xeb, 0xe9, 0xe9, 0xdd,
+	0xed, 0xdb, 0x9e, 0xee, 0xb9, 0xec, 0x9e, 0x5d, 0x1e, 0x05, 0xbb, 0x51, 0xd3, 0x55, 0x3d, 0x53,
+	0x9c, 0xea, 0xaa, 0x66, 0x55, 0xf5, 0xce, 0x0c, 0x61, 0x01, 0x67, 0x09, 0xb0, 0xfc, 0xf8, 0x14,
+	0xa0, 0x2f, 0xdb, 0x90, 0xf4, 0x29, 0xd8, 0x80, 0x01, 0x1b, 0xb0, 0xe1, 0x0f, 0x7f, 0x18, 0xfe,
+	0x90, 0x05, 0x41, 0xb0, 0xbf, 0x0c, 0xc8, 0x80, 0x6c, 0x9c, 0xbf, 0x2d, 0x01, 0x96, 0x7f, 0x0c,
+	0x7f, 0xd8, 0x88, 0xc8, 0xcc, 0xaa, 0xac, 0xee, 0x1e, 0x2e, 0x97, 0x77, 0x82, 0xe5, 0x9f, 0x99,
+	0xca, 0x88, 0xc8, 0x77, 0x66, 0x64, 0x64, 0x44, 0x64, 0x34, 0xc0, 0xcc, 0x35, 0xbd, 0xbb, 0xb3,
+	0xc0, 0x8f, 0x7c, 0x3d, 0x87, 0xdf, 0x37, 0xde, 0x3d, 0x72, 0xa2, 0xe3, 0xf9, 0xe1, 0xdd, 0xb1,
+	0x3f, 0x7d, 0xef, 0xc8, 0x3f, 0xf2, 0xdf, 0x23, 0xe4, 0xe1, 0x7c, 0x42, 0x29, 0x4a, 0xd0, 0x17,
+	0xcf, 0x74, 0x03, 0x5c, 0x7f, 0x7c, 0x22, 0xbe, 0xd7, 0x23, 0x67, 0x6a, 0x87, 0x91, 0x39, 0x9d,
+	0x71, 0x80, 0xf1, 0x2f, 0x33, 0x90, 0x1b, 0x9e, 0xcf, 0x6c, 0xbd, 0x0e, 0x6b, 0x8e, 0xd5, 0xc8,
+	0x6c, 0x65, 0x6e, 0xe7, 0xd9, 0x9a, 0x63, 0xe9, 0x5b, 0x50, 0xf1, 0xfc, 0xa8, 0x37, 0x77, 0x5d,
+	0xf3, 0xd0, 0xb5, 0x1b, 0x6b, 0x5b, 0x99, 0xdb, 0x25, 0xa6, 0x82, 0xf4, 0x97, 0xa0, 0x6c, 0xce,
+	0x23, 0x7f, 0xe4, 0x78, 0xe3, 0xa0, 0x91, 0x25, 0x7c, 0x09, 0x01, 0x1d, 0x6f, 0x1c, 0xe8, 0x9b,
+	0x90, 0x3f, 0x75, 0xac, 0xe8, 0xb8, 0x91, 0xa3, 0x12, 0x79, 0x02, 0xa1, 0xe1, 0xd8, 0x74, 0xed,
+	0x46, 0x9e, 0x43, 0x29, 0x81, 0xd0, 0x88, 0x2a, 0x29, 0x6c, 0x65, 0x6e, 0x97, 0x19, 0x4f, 0xe8,
+	0xb7, 0x00, 0x6c, 0x6f, 0x3e, 0x7d, 0x66, 0xba, 0x73, 0x3b, 0x6c, 0x14, 0x09, 0xa5, 0x40, 0x8c,
+	0x1f, 0x42, 0x79, 0x1a, 0x1e, 0x3d, 0xb2, 0x4d, 0xcb, 0x0e, 0xf4, 0x6b, 0x50, 0x9c, 0x86, 0x47,
+	0xa3, 0xc8, 0x3c, 0x12, 0x5d, 0x28, 0x4c, 0xc3, 0xa3, 0xa1, 0x79, 0xa4, 0x5f, 0x87, 0x12, 0x21,
+	0xce, 0x67, 0xbc, 0x0f, 0x79, 0x86, 0x84, 0xd8, 0x63, 0xe3, 0x2f, 0xf2, 0x50, 0xec, 0x3a, 0x91,
+	0x1d, 0x98, 0xae, 0x7e, 0x15, 0x0a, 0x4e, 0xe8, 0xcd, 0x5d, 0x97, 0xb2, 0x97, 0x98, 0x48, 0xe9,
+	0x57, 0x21, 0xef, 0xdc, 0x7f, 0x66, 0xba, 0x3c, 0xef, 0xa3, 0x4b, 0x8c, 0x27, 0xf5, 0x06, 0x14,
+	0x9c, 0x0f, 0x3e, 0x46, 0x44, 0x56, 0x20, 0x44, 0x9a, 0x30, 0xf7, 0xb6, 0x11, 0x93, 0x8b, 0x31,
+	0xf7, 0xb6, 0x25, 0xe6, 0xe3, 0x0f, 0x11, 0x83, 0xbd, 0xcf, 0x12, 0x86, 0xd2, 0x58, 0xcb, 0x9c,
+	0x6a, 0xc1, 0x01, 0xa8, 0x61, 0x2d, 0x73, 0x59, 0xcb, 0x9c, 0xd7, 0x52, 0x14, 0x08, 0x91, 0x26,
+	0x0c, 0xaf, 0xa5, 0x14, 0x63, 0xe2, 0x5a, 0xe6, 0xbc, 0x96, 0xf2, 0x56, 0xe6, 0x76, 0x8e, 0x30,
+	0xbc, 0x96, 0x4d, 0xc8, 0x59, 0x08, 0x87, 0xad, 0xcc, 0xed, 0xcc, 0xa3, 0x4b, 0x2c, 0x67, 0x09,
+	0x68, 0x88, 0xd0, 0x0a, 0x0e, 0x30, 0x42, 0x43, 0x01, 0x3d, 0x44, 0x68, 0x15, 0x47, 0x03, 0xa1,
+	0x87, 0x02, 0x3a, 0x41, 0x68, 0x6d, 0x2b, 0x73, 0x7b, 0x0d, 0xa1, 0x98, 0xd2, 0x6f, 0x40, 0xd1,
+	0x32, 0x23, 0x1b, 0x11, 0x75, 0xd1, 0x65, 0x09, 0x40, 0x1c, 0xae, 0x38, 0xc4, 0xad, 0x8b, 0x4e,
+	0x4b, 0x80, 0x6e, 0x40, 0x05, 0xc9, 0x24, 0x5e, 0x13, 0x78, 0x15, 0xa8, 0x7f, 0x04, 0x55, 0xcb,
+	0x1e, 0x3b, 0x53, 0xd3, 0xe5, 0x7d, 0xda, 0xd8, 0xca, 0xdc, 0xae, 0x6c, 0xaf, 0xdf, 0xa5, 0x3d,
+	0x11, 0x63, 0x1e, 0x5d, 0x62, 0x29, 0x32, 0xfd, 0x3e, 0xd4, 0x44, 0xfa, 0x83, 0x6d, 0x1a, 0x58,
+	0x9d, 0xf2, 0x69, 0xa9, 0x7c, 0x1f, 0x6c, 0xdf, 0x7f, 0x74, 0x89, 0xa5, 0x09, 0xf5, 0xd7, 0xa1,
+	0x1a, 0x6f, 0x11, 0xcc, 0x78, 0x59, 0xb4, 0x2a, 0x05, 0xc5, 0x6e, 0x7d, 0x19, 0xfa, 0x1e, 0x12,
+	0x6c, 0x8a, 0x71, 0x93, 0x00, 0x7d, 0x0b, 0xc0, 0xb2, 0x27, 0xe6, 0xdc, 0x8d, 0x10, 0x7d, 0x45,
+	0x0c, 0xa0, 0x02, 0xd3, 0x6f, 0x41, 0x79, 0x3e, 0xc3, 0x5e, 0x3e, 0x31, 0xdd, 0xc6, 0x55, 0x41,
+	0x90, 0x80, 0xb0, 0x74, 0x5c, 0xe7, 0x88, 0xbd, 0x26, 0x66, 0x57, 0x02, 0x70, 0xaf, 0x38, 0xe1,
+	0x8e, 0xe3, 0x35, 0x1a, 0xb4, 0x4e, 0x79, 0x42, 0xbf, 0x09, 0xd9, 0x30, 0x18, 0x37, 0xae, 0x53,
+	0x2f, 0x81, 0xf7, 0xb2, 0x7d, 0x36, 0x0b, 0x18, 0x82, 0x77, 0x8a, 0x90, 0xa7, 0x3d, 0x63, 0xdc,
+	0x84, 0xd2, 0xbe, 0x19, 0x98, 0x53, 0x66, 0x4f, 0x74, 0x0d, 0xb2, 0x33, 0x3f, 0x14, 0xbb, 0x05,
+	0x3f, 0x8d, 0x2e, 0x14, 0x9e, 0x98, 0x01, 0xe2, 0x74, 0xc8, 0x79, 0xe6, 0xd4, 0x26, 0x64, 0x99,
+	0xd1, 0x37, 0xee, 0x90, 0xf0, 0x3c, 0x8c, 0xec, 0xa9, 0x60, 0x05, 0x22, 0x85, 0xf0, 0x23, 0xd7,
+	0x3f, 0x14, 0x3b, 0xa1, 0xc4, 0x44, 0xca, 0xf8, 0x8d, 0x0c, 0x14, 0x5a, 0xbe, 0x8b, 0xc5, 0x5d,
+	0x83, 0x62, 0x60, 0xbb, 0xa3, 0xa4, 0xba, 0x42, 0x60, 0xbb, 0xfb, 0x7e, 0x88, 0x88, 0xb1, 0xcf,
+	0x11, 0x7c, 0x6f, 0x16, 0xc6, 0x3e, 0x21, 0x64, 0x03, 0xb2, 0x4a, 0x03, 0xae, 0x43, 0x29, 0x3a,
+	0x74, 0x47, 0x04, 0xcf, 0x11, 0xbc, 0x18, 0x1d, 0xba, 0x3d, 0x44, 0x5d, 0x83, 0xa2, 0x75, 0xc8,
+	0x31, 0x79, 0xc2, 0x14, 0xac, 0x43, 0x44, 0x18, 0x9f, 0x42, 0x99, 0x99, 0xa7, 0xa2, 0x19, 0x57,
+	0xa0, 0x80, 0x05, 0x08, 0x2e, 0x97, 0x63, 0xf9, 0xe8, 0xd0, 0xed, 0x58, 0x08, 0xc6, 0x46, 0x38,
+	0x16, 0xb5, 0x21, 0xc7, 0xf2, 0x63, 0xdf, 0xed, 0x58, 0xc6, 0x10, 0xa0, 0xe5, 0x07, 0xc1, 0x77,
+	0xee, 0xc2, 0x26, 0xe4, 0x2d, 0x7b, 0x16, 0x1d, 0x73, 0x06, 0xc1, 0x78, 0xc2, 0xb8, 0x03, 0x25,
+	0x9c, 0x97, 0xae, 0x13, 0x46, 0xfa, 0x2d, 0xc8, 0xb9, 0x4e, 0x18, 0x35, 0x32, 0x5b, 0xd9, 0x85,
+	0x59, 0x23, 0xb8, 0xb1, 0x05, 0xa5, 0x3d, 0xf3, 0xec, 0x09, 0xce, 0x9c, 0xbe, 0x29, 0xa6, 0x50,
+	0x4c, 0x89, 0x98, 0xcf, 0x2a, 0xc0, 0xd0, 0x0c, 0x8e, 0xec, 0x88, 0xf8, 0xd9, 0x5f, 0x66, 0xa0,
+	0x32, 0x98, 0x1f, 0x7e, 0x35, 0xb7, 0x83, 0x73, 0x6c, 0xf3, 0x6d, 0xc8, 0x46, 0xe7, 0x33, 0xca,
+	0x51, 0xdf, 0xbe, 0xca, 0x8b, 0x57, 0xf0, 0x77, 0x31, 0x13, 0x43, 0x12, 0xec, 0x84, 0xe7, 0x5b,
+	0xb6, 0x1c, 0x83, 0x3c, 0x2b, 0x60, 0xb2, 0x63, 0xe1, 0xa1, 0xe0, 0xcf, 0xc4, 0x2c, 0xac, 0xf9,
+	0x33, 0x7d, 0x0b, 0xf2, 0xe3, 0x63, 0xc7, 0xb5, 0x68, 0x02, 0xd2, 0x6d, 0xe6, 0x08, 0x9c, 0xa5,
+	0xc0, 0x3f, 0x1d, 0x85, 0xce, 0xd7, 0x92, 0xc9, 0x17, 0x03, 0xff, 0x74, 0xe0, 0x7c, 0x6d, 0x1b,
+	0x43, 0x71, 0xd2, 0x00, 0x14, 0x06, 0xad, 0x66, 0xb7, 0xc9, 0xb4, 0x4b, 0xf8, 0xdd, 0xfe, 0xbc,
+	0x33, 0x18, 0x0e, 0xb4, 0x8c, 0x5e, 0x07, 0xe8, 0xf5, 0x87, 0x23, 0x91, 0x5e, 0xd3, 0x0b, 0xb0,
+	0xd6, 0xe9, 0x69, 0x59, 0xa4, 0x41, 0x78, 0xa7, 0xa7, 0xe5, 0xf4, 0x22, 0x64, 0x9b, 0xbd, 0x9f,
+	0x6a, 0x79, 0xfa, 0xe8, 0x76, 0xb5, 0x82, 0xf1, 0x07, 0x6b, 0x50, 0xee, 0x1f, 0x7e, 0x69, 0x8f,
+	0x23, 0xec, 0x33, 0xae, 0x52, 0x3b, 0x78, 0x66, 0x07, 0xd4, 0xed, 0x2c, 0x13, 0x29, 0xec, 0x88,
+	0x75, 0x48, 0x9d, 0xcb, 0xb2, 0x35, 0xeb, 0x90, 0xe8, 0xc6, 0xc7, 0xf6, 0xd4, 0x6c, 0x64, 0x05,
+	0x1d, 0xa5, 0x70, 0x57, 0xf8, 0x87, 0x5f, 0x52, 0xf7, 0xb2, 0x0c, 0x3f, 0xf5, 0x57, 0xa0, 0xc2,
+	0xcb, 0x50, 0xd7, 0x17, 0x70, 0xd0, 0xe2, 0xe2, 0x2b, 0xa8, 0x8b, 0x8f, 0x72, 0x52, 0xa9, 0x1c,
+	0x29, 0x4e, 0x30, 0x0e, 0xea, 0x89, 0x15, 0xed, 0x1f, 0x7e, 0xc9, 0xb1, 0x25, 0xbe, 0xa2, 0xfd,
+	0xc3, 0x2f, 0x09, 0xf5, 0x36, 0x6c, 0x84, 0xf3, 0xc3, 0x70, 0x1c, 0x38, 0xb3, 0xc8, 0xf1, 0x3d,
+	0x4e, 0x53, 0x26, 0x1a, 0x4d, 0x45, 0x10, 0xf1, 0x6d, 0x28, 0xcd, 0xe6, 0x87, 0x23, 0xc7, 0x9b,
+	0xf8, 0xc4, 0xdc, 0x2b, 0xdb, 0x35, 0x3e, 0x31, 0xfb, 0xf3, 0xc3, 0x8e, 0x37, 0xf1, 0x59, 0x71,
+	0xc6, 0x3f, 0x8c, 0x37, 0xa0, 0x28, 0x60, 0x78, 0x7a, 0x47, 0xb6, 0x67, 0x7a, 0xd1, 0x28, 0x3e,
+	0xf6, 0x4b, 0x1c, 0xd0, 0xb1, 0x8c, 0x7f, 0x91, 0x01, 0x6d, 0xa0, 0x54, 0xb3, 0x67, 0x47, 0xe6,
+	0x4a, 0xae, 0xf0, 0x32, 0x80, 0x39, 0x1e, 0xfb, 0x73, 0x5e, 0x0c, 0x5f, 0x3c, 0x65, 0x01, 0xe9,
+	0x58, 0xea, 0xd8, 0x64, 0x53, 0x63, 0xf3, 0x2a, 0x54, 0x65, 0x3e, 0x65, 0x43, 0x57, 0x04, 0x4c,
+	0x8e, 0x4e, 0x38, 0x4f, 0xed, 0xea, 0x62, 0x38, 0xe7, 0xb9, 0xaf, 0x42, 0x81, 0x64, 0x84, 0x50,
+	0x8e, 0x38, 0x4f, 0x19, 0xff, 0x29, 0x03, 0xb5, 0x8e, 0x67, 0xd9, 0x67, 0x83, 0xb1, 0xe9, 0x51,
+	0x2f, 0x0d, 0xa8, 0x39, 0xe1, 0xc8, 0x41, 0xd8, 0x28, 0x1c, 0x9b, 0x9e, 0x38, 0xde, 0x2b, 0x4e,
+	0x18, 0xd3, 0x61, 0x1f, 0x38, 0x01, 0x55, 0xb5, 0x46, 0x25, 0x96, 0x09, 0x42, 0x95, 0xbd, 0x01,
+	0xeb, 0x87, 0xb6, 0xeb, 0x7b, 0x47, 0xa3, 0xc8, 0x1f, 0x51, 0x45, 0xa2, 0x2f, 0x35, 0x0e, 0x1e,
+	0xfa, 0x43, 0x04, 0xe2, 0x16, 0x9d, 0x99, 0x41, 0x14, 0x36, 0x72, 0x5b, 0x59, 0xdc, 0xa2, 0x94,
+	0xc0, 0x61, 0x76, 0xc2, 0xd1, 0xdc, 0x73, 0xbe, 0x9a, 0xf3, 0x6e, 0x94, 0x58, 0xc9, 0x09, 0x0f,
+	0x28, 0xad, 0xdf, 0x06, 0x8d, 0xd7, 0x4c, 0xc5, 0xaa, 0x6b, 0xa8, 0x4e, 0x70, 0x2a, 0x98, 0x18,
+	0xd9, 0xdf, 0x5f, 0x83, 0xd2, 0x83, 0xb9, 0x37, 0xc6, 0xc9, 0xd0, 0x5f, 0x83, 0xdc, 0x64, 0xee,
+	0x8d, 0x1b, 0x19, 0xf5, 0x30, 0x8c, 0xf7, 0x00, 0x23, 0x24, 0x72, 0x17, 0x33, 0x38, 0x42, 0xae,
+	0xb4, 0xc4, 0x5d, 0x10, 0x6e, 0xfc, 0xab, 0x0c, 0x2f, 0xf1, 0x81, 0x6b, 0x1e, 0xe9, 0x25, 0xc8,
+	0xf5, 0xfa, 0xbd, 0xb6, 0x76, 0x49, 0xaf, 0x42, 0xa9, 0xd3, 0x1b, 0xb6, 0x59, 0xaf, 0xd9, 0xd5,
+	0x32, 0xb4, 0x55, 0x87, 0xcd, 0x9d, 0x6e, 0x5b, 0x5b, 0x43, 0xcc, 0x93, 0x7e, 0xb7, 0x39, 0xec,
+	0x74, 0xdb, 0x5a, 0x8e, 0x63, 0x58, 0xa7, 0x35, 0xd4, 0x4a, 0xba, 0x06, 0xd5, 0x7d, 0xd6, 0xdf,
+	0x3d, 0x68, 0xb5, 0x47, 0xbd, 0x83, 0x6e, 0x57, 0xd3, 0xf4, 0xcb, 0xb0, 0x1e, 0x43, 0xfa, 0x1c,
+	0xb8, 0x85, 0x59, 0x9e, 0x34, 0x59, 0x93, 0x3d, 0xd4, 0x7e, 0xa4, 0x97, 0x20, 0xdb, 0x7c, 0xf8,
+	0x50, 0xfb, 0x19, 0xee, 0xfa, 0xf2, 0xd3, 0x4e, 0x6f, 0xf4, 0xa4, 0xd9, 0x3d, 0x68, 0x6b, 0x3f,
+	0x5b, 0x93, 0xe9, 0x3e, 0xdb, 0x6d, 0x33, 0xed, 0x67, 0x39, 0x7d, 0x03, 0xaa, 0x5f, 0xf4, 0x7b,
+	0xed, 0xbd, 0xe6, 0xfe, 0x3e, 0x35, 0xe4, 0x67, 0x25, 0xe3, 0xbf, 0xe7, 0x20, 0x87, 0x3d, 0xd1,
+	0x8d, 0x84, 0xc3, 0xc5, 0x5d, 0x44, 0x16, 0xb3, 0x93, 0xfb, 0xc3, 0x3f, 0x7b, 0xe5, 0x12, 0xe7,
+	0x6d, 0xaf, 0x42, 0xd6, 0x75, 0xa2, 0xc6, 0x9a, 0xba, 0x2f, 0x84, 0xd4, 0xf7, 0xe8, 0x12, 0x43,
+	0x9c, 0x7e, 0x0b, 0x32, 0x9c, 0xc9, 0x55, 0xb6, 0xeb, 0x62, 0xe3, 0x88, 0x53, 0xf2, 0xd1, 0x25,
+	0x96, 0x99, 0xe9, 0x37, 0x21, 0xf3, 0x4c, 0x70, 0xbc, 0x2a, 0xc7, 0xf3, 0x73, 0x12, 0xb1, 0xcf,
+	0xf4, 0x2d, 0xc8, 0x8e, 0x7d, 0x2e, 0xd3, 0xc5, 0x78, 0x7e, 0x6a, 0x60, 0xf9, 0x63, 0xdf, 0xd5,
+	0x5f, 0x83, 0x6c, 0x60, 0x9e, 0x36, 0x0a, 0xea, 0x74, 0xc5, 0xc7, 0x12, 0x12, 0x05, 0xe6, 0x29,
+	0x36, 0x62, 0xd2, 0x28, 0xaa, 0x8d, 0x90, 0xf3, 0x8d, 0xd5, 0x4c, 0xf4, 0x2d, 0xc8, 0x9c, 0x36,
+	0x4a, 0xaa, 0x18, 0xf3, 0xd4, 0xf1, 0x2c, 0xff, 0x74, 0x30, 0xb3, 0xc7, 0x48, 0x71, 0xaa, 0x7f,
+	0x0f, 0xb2, 0xe1, 0xfc, 0x90, 0xb8, 0x44, 0x65, 0x7b, 0x63, 0x89, 0xdf, 0x63, 0x45, 0xe1, 0xfc,
+	0x50, 0x7f, 0x03, 0x72, 0x63, 0x3f, 0x08, 0x1a, 0xa0, 0x96, 0x95, 0x1c, 0x75, 0x28, 0xd6, 0x21,
+	0x1e, 0x2b, 0x8c, 0x1a, 0x15, 0x95, 0x28, 0x39, 0x6b, 0xb0, 0xc2, 0x48, 0x7f, 0x5d, 0x1c, 0x60,
+	0x55, 0xb5, 0xd5, 0xf2, 0x78, 0xc3, 0x72, 0x10, 0x8b, 0x93, 0x34, 0x35, 0xcf, 0x1a, 0x35, 0x95,
+	0x48, 0x9e, 0x6b, 0xd8, 0xa6, 0xa9, 0x79, 0xa6, 0xbf, 0x0e, 0xd9, 0x67, 0xf6, 0xb8, 0x51, 0x57,
+	0x6b, 0x13, 0x93, 0xf4, 0x84, 0xba, 0x87, 0x68, 0x5a, 0xf7, 0xbe, 0x6b, 0x35, 0xd6, 0xd5, 0xb9,
+	0x7c, 0xe0, 0xbb, 0xd6, 0x13, 0x9a, 0x4b, 0x42, 0xe2, 0x71, 0x6e, 0xce, 0xcf, 0x90, 0x1b, 0x69,
+	0xfc, 0xe0, 0x35, 0xe7, 0x67, 0x1d, 0x0b, 0x19, 0xbb, 0x67, 0x3d, 0x23, 0xf9, 0x31, 0xc3, 0xf0,
+	0x13, 0x2f, 0x38, 0xa1, 0xed, 0xda, 0xe3, 0xc8, 0x79, 0xe6, 0x44, 0xe7, 0x24, 0x21, 0x66, 0x98,
+	0x0a, 0xda, 0x29, 0x40, 0xce, 0x3e, 0x9b, 0x05, 0xc6, 0x23, 0x28, 0x8a, 0x5a, 0x96, 0x6e, 0x49,
+	0xd7, 0xa1, 0xe4, 0x84, 0xa3, 0xb1, 0xef, 0x85, 0x91, 0x90, 0x8b, 0x8a, 0x4e, 0xd8, 0xc2, 0x24,
+	0xb2, 0x4b, 0xcb, 0x8c, 0xf8, 0x01, 0x53, 0x65, 0xf4, 0x6d, 0x6c, 0x03, 0x24, 0xdd, 0xc2, 0x36,
+	0xb9, 0xb6, 0x27, 0x45, 0x30, 0xd7, 0xf6, 0xe2, 0x3c, 0x6b, 0x4a, 0x9e, 0xeb, 0x50, 0x8e, 0x65,
+	0x5b, 0xbd, 0x0a, 0x19, 0x53, 0x1c, 0x6d, 0x19, 0xd3, 0xb8, 0x0d, 0x20, 0x50, 0x1f, 0x6c, 0xdf,
+	0x4f, 0xe3, 0x30, 0x25, 0x0f, 0xbc, 0xcc, 0xa1, 0xf1, 0x7d, 0xa8, 0x32, 0x3b, 0x9c, 0xbb, 0x51,
+	0xcb, 0x77, 0x77, 0xed, 0x89, 0xfe, 0x0e, 0x40, 0x9c, 0x0e, 0x85, 0x04, 0x92, 0xac, 0xdd, 0x5d,
+	0x7b, 0xc2, 0x14, 0xbc, 0xf1, 0xa7, 0x39, 0x28, 0x88, 0x8c, 0x89, 0xb4, 0x94, 0x51, 0xa4, 0xa5,
+	0xf8, 0x6c, 0x58, 0x4b, 0x4b, 0x8c, 0xc7, 0x8e, 0x65, 0xd9, 0x9e, 0x94, 0x0c, 0x79, 0x0a, 0x27,
+	0xdb, 0x74, 0x8f, 0x68, 0x43, 0xd5, 0xb7, 0x75, 0x59, 0xe9, 0x74, 0x16, 0xd8, 0x61, 0xc8, 0x65,
+	0x12, 0xd3, 0x3d, 0x92, 0x7b, 0x3b, 0xff, 0x4d, 0x7b, 0xfb, 0x3a, 0x94, 0x3c, 0x3f, 0x1a, 0xd1,
+	0xbd, 0xad, 0xc0, 0x47, 0x5f, 0x5c, 0x50, 0xf5, 0x37, 0xa1, 0x28, 0x24, 0xee, 0x46, 0x51, 0x5d,
+	0x2e, 0xbb, 0x1c, 0xc8, 0x24, 0x56, 0x6f, 0xa0, 0x00, 0x37, 0x9d, 0xda, 0x5e, 0x24, 0xcf, 0x60,
+	0x91, 0xd4, 0xdf, 0x86, 0xb2, 0xef, 0x8d, 0xb8, 0x58, 0xde, 0x28, 0xab, 0xcb, 0xb7, 0xef, 0x1d,
+	0x10, 0x94, 0x95, 0x7c, 0xf1, 0x85, 0x4d, 0x71, 0xfd, 0xd3, 0xd1, 0xd8, 0x0c, 0x2c, 0xda, 0x59,
+	0x25, 0x56, 0x74, 0xfd, 0xd3, 0x96, 0x19, 0x58, 0x5c, 0x26, 0xf9, 0xca, 0x9b, 0x4f, 0x69, 0x37,
+	0xd5, 0x98, 0x48, 0xe9, 0x37, 0xa1, 0x3c, 0x76, 0xe7, 0x61, 0x64, 0x07, 0x3b, 0xe7, 0xfc, 0xa2,
+	0xc5, 0x12, 0x00, 0xb6, 0x6b, 0x16, 0x38, 0x53, 0x33, 0x38, 0xa7, 0xad, 0x53, 0x62, 0x32, 0x49,
+	0x07, 0xcd, 0x89, 0x63, 0x9d, 0xf1, 0xdb, 0x16, 0xe3, 0x09, 0xa4, 0x3f, 0xa6, 0xbb, 0x70, 0x48,
+	0xfb, 0xa3, 0xc4, 0x64, 0x92, 0xe6, 0x81, 0x3e, 0x69, 0x47, 0x94, 0x99, 0x48, 0xa5, 0x04, 0xea,
+	0x8d, 0x0b, 0x05, 0x6a, 0x7d, 0x51, 0xa6, 0xf1, 0x03, 0xe7, 0xc8, 0x11, 0x12, 0xc9, 0x65, 0x42,
+	0x02, 0x07, 0x11, 0xc1, 0xfb, 0x50, 0x3e, 0xb2, 0x3d, 0x3b, 0x30, 0x23, 0xdb, 0xa2, 0xbb, 0x51,
+	0x45, 0x4e, 0xf1, 0x43, 0x09, 0x46, 0x3e, 0x93, 0x10, 0x19, 0x7f, 0x27, 0x03, 0x55, 0x15, 0xa7,
+	0xdf, 0xe2, 0xdb, 0x2e, 0xcd, 0xd6, 0xf9, 0xc9, 0x85, 0x70, 0xfd, 0x35, 0xa8, 0x89, 0x36, 0x84,
+	0x51, 0xe0, 0x78, 0x47, 0x62, 0xd1, 0x55, 0x39, 0x70, 0x40, 0x30, 0x1a, 0xf4, 0xc8, 0x0f, 0x6c,
+	0x4b, 0x2e, 0x3e, 0x9e, 0xc2, 0x4e, 0xe3, 0xfa, 0x55, 0x6f, 0x11, 0x63, 0x9f, 0x3a, 0x6d, 0x7c,
+	0x05, 0x45, 0xb1, 0x3a, 0x7e, 0x39, 0x4d, 0x78, 0x15, 0xaa, 0xb8, 0x32, 0x47, 0xe6, 0xa1, 0xe3,
+	0x22, 0x87, 0xc9, 0x0a, 0x15, 0xca, 0xdc, 0x75, 0x9b, 0x1c, 0x64, 0xf4, 0xa1, 0x24, 0xd7, 0xd2,
+	0x2f, 0xa5, 0x4e, 0x1c, 0xcc, 0x0a, 0x49, 0x36, 0x7d, 0x92, 0xdb, 0xf4, 0x77, 0x40, 0x1f, 0x07,
+	0xb6, 0x19, 0xd9, 0x23, 0xfb, 0x2c, 0x0a, 0x4c, 0x21, 0xbf, 0x70, 0x21, 0x48, 0xe3, 0x98, 0x36,
+	0x22, 0xb8, 0x08, 0xf3, 0x0a, 0x54, 0x66, 0x66, 0x10, 0x4a, 0x59, 0x97, 0x57, 0x00, 0x1c, 0x24,
+	0x24, 0x4d, 0xcd, 0x3b, 0x0a, 0xcc, 0xe9, 0x28, 0xf2, 0x4f, 0x6c, 0x8f, 0x4b, 0xf9, 0xfc, 0x7e,
+	0x53, 0x27, 0xf8, 0x10, 0xc1, 0x24, 0xec, 0xff, 0x69, 0x06, 0x6a, 0xfb, 0x7c, 0xc1, 0x3e, 0xb6,
+	0xcf, 0x77, 0xf9, 0xa5, 0x72, 0x2c, 0x99, 0x4d, 0x8e, 0xd1, 0xb7, 0x7e, 0x0b, 0x2a, 0xb3, 0x13,
+	0xfb, 0x7c, 0x94, 0xba, 0x80, 0x95, 0x11, 0xd4, 0x22, 0xb6, 0xf2, 0x16, 0x14, 0x7c, 0xea, 0x48,
+	0x23, 0xab, 0x9e, 0x6a, 0x4a, 0x0f, 0x99, 0x20, 0x40, 0x49, 0x2f, 0x2e, 0x4a, 0x15, 0x29, 0x45,
+	0x61, 0xd4, 0xfc, 0x4d, 0xc8, 0x23, 0x2a, 0x6c, 0xe4, 0xb9, 0x88, 0x46, 0x09, 0xfd, 0x7d, 0xa8,
+	0x8d, 0xfd, 0xe9, 0x6c, 0x24, 0xb3, 0x8b, 0x83, 0x3a, 0xcd, 0x0e, 0x2b, 0x48, 0xb2, 0xcf, 0xcb,
+	0x32, 0x7e, 0x27, 0x0b, 0x25, 0x6a, 0x83, 0xe0, 0x88, 0x8e, 0x75, 0x26, 0x39, 0x62, 0x99, 0xe5,
+	0x1d, 0x0b, 0x0f, 0x9c, 0xe7, 0x48, 0x95, 0xb1, 0xb4, 0x98, 0x55, 0xa5, 0xc5, 0xab, 0x50, 0x10,
+	0xa2, 0x62, 0x8e, 0xaf, 0xda, 0xf9, 0xc5, 0x82, 0x62, 0x7e, 0x95, 0xa0, 0x88, 0x53, 0xc8, 0x69,
+	0xec, 0x33, 0x3c, 0x9a, 0x39, 0x57, 0x04, 0x02, 0xb5, 0x11, 0xa2, 0xf2, 0xbb, 0x62, 0x9a, 0xdf,
+	0x35, 0xa0, 0xf8, 0xcc, 0x09, 0x1d, 0x5c, 0x20, 0x25, 0xce, 0x41, 0x44, 0x52, 0x99, 0x86, 0xf2,
+	0xf3, 0xa6, 0x21, 0xee, 0xb6, 0xe9, 0x1e, 0xf1, 0xdb, 0x88, 0xec, 0x76, 0xd3, 0x3d, 0xf2, 0xf5,
+	0x0f, 0xe0, 0x4a, 0x82, 0x16, 0xbd, 0x21, 0xdd, 0x1c, 0xa9, 0x9f, 0x98, 0x1e, 0x53, 0x52, 0x8f,
+	0xe8, 0xba, 0x78, 0x07, 0x36, 0x94, 0x2c, 0x33, 0x94, 0xcc, 0x42, 0x62, 0x97, 0x65, 0xb6, 0x1e,
+	0x93, 0x93, 0xc0, 0x16, 0x1a, 0xff, 0x7e, 0x0d, 0x6a, 0x0f, 0xfc, 0xc0, 0x76, 0x8e, 0xbc, 0x64,
+	0xd5, 0x2d, 0x5d, 0x5a, 0xe4, 0x4a, 0x5c, 0x53, 0x56, 0xe2, 0x2b, 0x50, 0x99, 0xf0, 0x8c, 0xa3,
+	0xe8, 0x90, 0xeb, 0x32, 0x72, 0x0c, 0x04, 0x68, 0x78, 0xe8, 0xe2, 0x6e, 0x96, 0x04, 0x94, 0x39,
+	0x47, 0x99, 0x65, 0x26, 0x3c, 0x26, 0xf5, 0xcf, 0xe8, 0xc0, 0xb0, 0x6c, 0xd7, 0x8e, 0xf8, 0xf4,
+	0xd4, 0xb7, 0x5f, 0x96, 0x42, 0x8a, 0xd2, 0xa6, 0xbb, 0xcc, 0x9e, 0x34, 0x49, 0xb2, 0xc3, 0xf3,
+	0x63, 0x97, 0xc8, 0xf5, 0xcf, 0xd4, 0xc3, 0xa6, 0xf0, 0x2d, 0xf3, 0x72, 0xce, 0x61, 0x0c, 0xa1,
+	0x1c, 0x83, 0x51, 0x4c, 0x67, 0x6d, 0x21, 0x9a, 0x5f, 0xd2, 0x2b, 0x50, 0x6c, 0x35, 0x07, 0xad,
+	0xe6, 0x6e, 0x5b, 0xcb, 0x20, 0x6a, 0xd0, 0x1e, 0x72, 0x71, 0x7c, 0x4d, 0x5f, 0x87, 0x0a, 0xa6,
+	0x76, 0xdb, 0x0f, 0x9a, 0x07, 0xdd, 0xa1, 0x96, 0xd5, 0x6b, 0x50, 0xee, 0xf5, 0x47, 0xcd, 0xd6,
+	0xb0, 0xd3, 0xef, 0x69, 0x39, 0xe3, 0x14, 0x4a, 0xad, 0x63, 0x7b, 0x7c, 0x72, 0xd1, 0x28, 0x92,
+	0x2e, 0xc0, 0x1e, 0x9f, 0x34, 0xd6, 0x96, 0x18, 0x16, 0x47, 0x20, 0xaf, 0x45, 0xce, 0x85, 0xfc,
+	0x4a, 0x5c, 0x99, 0x8a, 0x98, 0x1e, 0x44, 0x81, 0x7e, 0x03, 0x4a, 0xb6, 0x37, 0xf1, 0x83, 0xb1,
+	0x6d, 0x89, 0xa5, 0x1e, 0xa7, 0x8d, 0xdf, 0xca, 0x00, 0x0c, 0x03, 0xe7, 0xe8, 0xc8, 0x0e, 0x76,
+	0x2f, 0x56, 0x46, 0x45, 0xce, 0x34, 0x61, 0x82, 0x22, 0x85, 0xbb, 0xca, 0x7e, 0x86, 0x4b, 0x9b,
+	0x57, 0xc7, 0x13, 0xb8, 0x26, 0x05, 0x13, 0x0c, 0xbf, 0x72, 0x05, 0x5f, 0x28, 0x73, 0xc8, 0xe0,
+	0x2b, 0xd4, 0x98, 0xa2, 0x30, 0xe0, 0x78, 0x76, 0x20, 0xef, 0x99, 0x22, 0x69, 0xfc, 0x71, 0x06,
+	0x2e, 0xef, 0x99, 0x91, 0x1d, 0x38, 0xa6, 0xeb, 0x7c, 0x6d, 0x5b, 0x4f, 0x1c, 0xfb, 0x14, 0x9b,
+	0x74, 0x03, 0x4a, 0x28, 0x9a, 0x1d, 0x9a, 0xa1, 0x6c, 0x56, 0x9c, 0x5e, 0x29, 0x09, 0x6d, 0x42,
+	0x9e, 0xa4, 0x70, 0xd9, 0x2c, 0x4a, 0xe0, 0xf0, 0x08, 0x8e, 0x23, 0xef, 0x8c, 0x45, 0xce, 0x5f,
+	0x42, 0x64, 0x66, 0xcf, 0x1c, 0xfb, 0x74, 0x14, 0xe3, 0x39, 0xc3, 0xaa, 0x20, 0xf0, 0xb1, 0xa0,
+	0x79, 0x1f, 0x2a, 0x58, 0xe1, 0x28, 0xbe, 0x09, 0x67, 0x57, 0x5d, 0x06, 0x01, 0x69, 0x86, 0xfc,
+	0x7a, 0xfc, 0x04, 0xaa, 0x2d, 0x29, 0x5f, 0x5c, 0x34, 0xb2, 0xdb, 0x50, 0x27, 0x66, 0x38, 0x3e,
+	0x94, 0xdc, 0x70, 0x6d, 0x05, 0x37, 0xac, 0x22, 0x4d, 0xeb, 0x50, 0xb0, 0xc3, 0x8f, 0xa0, 0xb2,
+	0x1f, 0xf8, 0x33, 0x3b, 0x88, 0xa8, 0x58, 0x0d, 0xb2, 0x27, 0xf6, 0xb9, 0x28, 0x15, 0x3f, 0x13,
+	0xed, 0xd5, 0x9a, 0xaa, 0xbd, 0xda, 0x86, 0x92, 0xcc, 0xf6, 0xad, 0xf3, 0xfc, 0x10, 0x6a, 0x22,
+	0x8f, 0x63, 0x87, 0x58, 0xd9, 0x5d, 0x80, 0x59, 0x0c, 0x10, 0x82, 0xac, 0xbc, 0xc4, 0x89, 0xc2,
+	0x99, 0x42, 0x61, 0xfc, 0x65, 0x16, 0xea, 0xfb, 0x66, 0x10, 0x39, 0xb8, 0x59, 0xf8, 0x30, 0xbc,
+	0x09, 0x39, 0x62, 0x41, 0x5c, 0x51, 0x76, 0x39, 0xbe, 0x01, 0x72, 0x1a, 0x92, 0x48, 0x89, 0x40,
+	0xff, 0x0c, 0xea, 0x33, 0x09, 0x1e, 0xd1, 0x59, 0xcd, 0xc7, 0x66, 0x31, 0x0b, 0xed, 0x81, 0xda,
+	0x4c, 0x4d, 0xea, 0x3f, 0x80, 0xcd, 0x74, 0x5e, 0x3b, 0x0c, 0x93, 0x73, 0x4d, 0xdd, 0x3c, 0x97,
+	0x53, 0x19, 0x39, 0x99, 0xde, 0x82, 0x8d, 0x24, 0xfb, 0xd8, 0x77, 0xe7, 0x53, 0x2f, 0x14, 0x57,
+	0xd2, 0xab, 0x0b, 0xb5, 0xb7, 0x38, 0x96, 0x69, 0xb3, 0x05, 0x88, 0x6e, 0x40, 0x35, 0x86, 0xf5,
+	0xe6, 0x53, 0x5a, 0xed, 0x39, 0x96, 0x82, 0xe9, 0xf7, 0x00, 0xe2, 0xb4, 0x5c, 0x54, 0x8b, 0xfd,
+	0xeb, 0x44, 0xf6, 0x94, 0x29, 0x64, 0x28, 0xc9, 0x22, 0x73, 0x0e, 0x9c, 0xe8, 0x78, 0x4a, 0xa7,
+	0x4a, 0x96, 0x25, 0x00, 0x3a, 0xbc, 0xc2, 0x11, 0xea, 0x72, 0xe2, 0x2c, 0xe2, 0x80, 0xa9, 0x3b,
+	0xe1, 0x60, 0x7e, 0x18, 0x97, 0x8b, 0x22, 0x4e, 0xd2, 0xcb, 0x69, 0x78, 0x24, 0x34, 0x5e, 0x49,
+	0x0b, 0xf7, 0xc2, 0x23, 0x7d, 0x1b, 0xae, 0x24, 0x44, 0xc9, 0x79, 0x18, 0x36, 0x80, 0xf6, 0x48,
+	0x32, 0x7c, 0xf1, 0xa1, 0x18, 0x1a, 0x3f, 0x86, 0x5a, 0x6a, 0x76, 0x9e, 0x2b, 0x6c, 0xa9, 0xac,
+	0x6b, 0x2d, 0xc5, 0xba, 0x0c, 0x1b, 0xb4, 0xc5, 0xb1, 0xd6, 0x5f, 0x27, 0x2d, 0x30, 0x7e, 0xae,
+	0xd0, 0xe6, 0x4a, 0x14, 0x2a, 0xf5, 0x96, 0x27, 0x71, 0x8d, 0x5a, 0xbd, 0x34, 0x59, 0xc6, 0xef,
+	0xad, 0x41, 0x2d, 0x35, 0xe2, 0xfa, 0xf7, 0xd4, 0xe5, 0xa7, 0x6c, 0xdc, 0x64, 0xcc, 0x48, 0x02,
+	0x78, 0x0b, 0x34, 0x3f, 0xb0, 0x1c, 0xcf, 0x24, 0xad, 0x34, 0x1f, 0xee, 0x35, 0xba, 0x78, 0xac,
+	0x0b, 0xf8, 0xbe, 0x00, 0xe3, 0x15, 0xd8, 0xb2, 0x63, 0x25, 0x9f, 0xe0, 0x4e, 0x2a, 0x48, 0x95,
+	0x16, 0x72, 0x69, 0x69, 0xe1, 0x4d, 0x28, 0xbb, 0x76, 0x18, 0x8e, 0xa2, 0x63, 0xd3, 0x6b, 0xe4,
+	0x97, 0x3a, 0x5d, 0x42, 0xe4, 0xf0, 0xd8, 0xf4, 0x90, 0xd0, 0xf1, 0x46, 0xc2, 0x8c, 0x57, 0x58,
+	0x26, 0x74, 0x3c, 0x52, 0x05, 0x20, 0x43, 0xdb, 0x5c, 0x35, 0xb1, 0x42, 0x4c, 0xd1, 0x97, 0xe7,
+	0xd5, 0x78, 0x19, 0x8a, 0x92, 0x25, 0xeb, 0x90, 0x43, 0xe6, 0x28, 0x79, 0x19, 0x7e, 0x1b, 0xbf,
+	0x05, 0x50, 0x22, 0xe2, 0xdd, 0x8b, 0xb5, 0xff, 0x2f, 0x72, 0x71, 0xdd, 0x82, 0x5c, 0xcc, 0xac,
+	0x17, 0x39, 0x22, 0x61, 0xf0, 0xa4, 0x51, 0x64, 0x1a, 0x7e, 0x9a, 0x94, 0xa3, 0x58, 0x94, 0xb9,
+	0x09, 0xe2, 0xd8, 0xc1, 0x73, 0xa8, 0xa0, 0x9e, 0x43, 0xe1, 0x57, 0xae, 0x7e, 0x97, 0xdf, 0xc7,
+	0x48, 0xb5, 0x57, 0x54, 0x19, 0x0b, 0xf5, 0x41, 0x6a, 0x83, 0xe8, 0x92, 0x86, 0x09, 0x92, 0xd7,
+	0xec, 0x20, 0x94, 0xdb, 0xa9, 0xc6, 0x64, 0x12, 0x39, 0x1a, 0x0a, 0xb3, 0x8d, 0x8a, 0x5a, 0x4a,
+	0x4a, 0x1a, 0x67, 0x44, 0xa0, 0xdf, 0x86, 0x22, 0x89, 0x50, 0x36, 0x4a, 0x54, 0x0a, 0xeb, 0x94,
+	0xc2, 0x2d, 0x93, 0x68, 0xfd, 0x2d, 0xc8, 0x4f, 0x4e, 0xec, 0xf3, 0xb0, 0x51, 0x53, 0x59, 0x42,
+	0x4a, 0x36, 0x61, 0x9c, 0x42, 0x7f, 0x1d, 0xea, 0x81, 0x3d, 0x19, 0x91, 0x3d, 0x00, 0x85, 0xa9,
+	0xb0, 0x51, 0x27, 0x59, 0xa9, 0x1a, 0xd8, 0x93, 0x16, 0x02, 0x87, 0x87, 0x6e, 0xa8, 0xbf, 0x01,
+	0x05, 0x92, 0x12, 0xf0, 0xba, 0xaa, 0xd4, 0x2c, 0x45, 0x0e, 0x26, 0xb0, 0xfa, 0x3b, 0x50, 0x8a,
+	0xb8, 0x30, 0x10, 0x36, 0xb4, 0xad, 0x6c, 0xa2, 0x1f, 0x4a, 0x44, 0x04, 0x16, 0x53, 0xe8, 0xef,
+	0x41, 0x7e, 0x4a, 0xeb, 0x80, 0x1b, 0x0a, 0xaf, 0x4b, 0x75, 0xd3, 0xd2, 0x19, 0xce, 0x38, 0x9d,
+	0x7e, 0x1f, 0x00, 0x1b, 0x4b, 0x89, 0xb0, 0xa1, 0x6f, 0x65, 0xbf, 0x39, 0x57, 0x39, 0xb0, 0x27,
+	0x7b, 0x44, 0xab, 0x6f, 0x43, 0x39, 0xe1, 0x67, 0x57, 0xa8, 0xba, 0xcd, 0x05, 0x46, 0x49, 0xe7,
+	0x0b, 0x4b, 0xc8, 0xf4, 0x0f, 0x00, 0xc4, 0x0d, 0x7f, 0x74, 0x78, 0xde, 0xb8, 0xaa, 0x5e, 0x8f,
+	0xd5, 0x93, 0x59, 0xd5, 0x03, 0xbc, 0x09, 0x79, 0x3c, 0xbe, 0xc2, 0xc6, 0xb5, 0xad, 0x6c, 0x22,
+	0x7a, 0x2b, 0xe7, 0x2d, 0xe3, 0x78, 0xb4, 0x02, 0x90, 0xcc, 0x80, 0x6b, 0xab, 0xa1, 0xaa, 0x3c,
+	0x64, 0xdb, 0x8b, 0x88, 0x46, 0x81, 0xe7, 0x0e, 0xe4, 0x2c, 0x7b, 0x12, 0x36, 0xae, 0x6f, 0x65,
+	0x93, 0xf3, 0x43, 0x6e, 0x14, 0xd4, 0x90, 0xf0, 0x33, 0x0f, 0x69, 0xf4, 0x47, 0x50, 0xc7, 0x3d,
+	0xb1, 0x4d, 0x37, 0x34, 0x5c, 0x0b, 0x8d, 0x1b, 0x94, 0xeb, 0xd5, 0x85, 0x5c, 0x3d, 0x41, 0x44,
+	0x2b, 0xa7, 0xed, 0x45, 0xc1, 0x39, 0xab, 0x79, 0x2a, 0x0c, 0x85, 0x26, 0x27, 0xec, 0xfa, 0xe3,
+	0x13, 0xdb, 0x6a, 0xbc, 0x24, 0x15, 0xe1, 0x3c, 0xad, 0x7f, 0x0a, 0x35, 0xda, 0x25, 0x98, 0xc4,
+	0xca, 0x1b, 0x37, 0xd5, 0xb3, 0x78, 0xa8, 0xa2, 0x58, 0x9a, 0x12, 0xe5, 0x72, 0x27, 0x1c, 0x45,
+	0xf6, 0x74, 0xe6, 0x07, 0xa8, 0x2c, 0x79, 0x59, 0x2a, 0xf8, 0x87, 0x12, 0x84, 0x07, 0x50, 0xec,
+	0xa8, 0x30, 0xf2, 0x27, 0x93, 0xd0, 0x8e, 0x1a, 0xb7, 0x88, 0x09, 0xd4, 0xa5, 0xbf, 0x42, 0x9f,
+	0xa0, 0x74, 0x7b, 0x09, 0x47, 0xd6, 0xb9, 0x67, 0x4e, 0x9d, 0x71, 0xe3, 0x15, 0xae, 0x93, 0x71,
+	0xc2, 0x5d, 0x0e, 0x50, 0xd5, 0x22, 0x5b, 0x29, 0xb5, 0xc8, 0x65, 0xc8, 0x5b, 0x87, 0xc8, 0x5b,
+	0x5e, 0xa5, 0x62, 0x73, 0xd6, 0x61, 0xc7, 0xba, 0xf1, 0x90, 0xf4, 0x09, 0xd4, 0xc8, 0x8f, 0x16,
+	0xa4, 0x94, 0xd4, 0xb6, 0x54, 0xc4, 0x19, 0x34, 0x14, 0x27, 0x84, 0x3b, 0x79, 0xc8, 0x5a, 0xf6,
+	0xe4, 0xc6, 0x8f, 0x40, 0x5f, 0x1e, 0xde, 0xe7, 0x89, 0x4c, 0x79, 0x21, 0x32, 0x7d, 0xb6, 0x76,
+	0x3f, 0x63, 0x7c, 0x0a, 0xb5, 0x14, 0x13, 0x59, 0x29, 0xfa, 0xf1, 0x2b, 0xa9, 0x39, 0x15, 0xda,
+	0x47, 0x9e, 0x30, 0xfe, 0x24, 0x0b, 0xd5, 0x47, 0x66, 0x78, 0xbc, 0x67, 0xce, 0x06, 0x91, 0x19,
+	0x85, 0x38, 0xe0, 0xc7, 0x66, 0x78, 0x3c, 0x35, 0x67, 0xfc, 0xfe, 0x9f, 0xe1, 0x8a, 0x53, 0x01,
+	0xc3, 0xcb, 0x3f, 0x4e, 0x35, 0x26, 0xfb, 0xde, 0xfe, 0x63, 0xa1, 0x15, 0x8d, 0xd3, 0xc8, 0xb5,
+	0xc2, 0xe3, 0xf9, 0x64, 0x22, 0xcc, 0x28, 0x25, 0x26, 0x93, 0xfa, 0xeb, 0x50, 0x13, 0x9f, 0x74,
+	0xf9, 0x3f, 0x13, 0xae, 0x23, 0x69, 0xa0, 0x7e, 0x0f, 0x2a, 0x02, 0x30, 0x94, 0x3c, 0xb6, 0x1e,
+	0x6b, 0xbb, 0x13, 0x04, 0x53, 0xa9, 0xf4, 0x9f, 0xc0, 0x15, 0x25, 0xf9, 0xc0, 0x0f, 0xf6, 0xe6,
+	0x6e, 0xe4, 0xb4, 0x7a, 0xe2, 0xa6, 0xf5, 0xd2, 0x52, 0xf6, 0x84, 0x84, 0xad, 0xce, 0x99, 0x6e,
+	0xed, 0x9e, 0xe3, 0x09, 0xb9, 0x27, 0x0d, 0x5c, 0xa0, 0x32, 0xcf, 0x1a, 0xa5, 0x25, 0x2a, 0xf3,
+	0x0c, 0x97, 0xbf, 0x00, 0xec, 0xd9, 0xd1, 0xb1, 0x6f, 0x35, 0xca, 0xea, 0xf2, 0x1f, 0xa8, 0x28,
+	0x96, 0xa6, 0xc4, 0xe1, 0x44, 0x85, 0xd2, 0xd8, 0x8b, 0xe8, 0xb2, 0x9d, 0x65, 0x32, 0x89, 0xa7,
+	0x58, 0x60, 0x7a, 0x47, 0x76, 0xd8, 0xa8, 0x6c, 0x65, 0x6f, 0x67, 0x98, 0x48, 0x19, 0x7f, 0x7b,
+	0x0d, 0xf2, 0x7c, 0x26, 0x5f, 0x82, 0xf2, 0x21, 0xfa, 0x06, 0x8d, 0x50, 0x37, 0x29, 0x4c, 0x80,
+	0x04, 0x40, 0x41, 0x90, 0x2e, 0xc9, 0x42, 0xab, 0x9d, 0x61, 0xf4, 0x8d, 0x45, 0xfa, 0xf3, 0x68,
+	0x2c, 0xee, 0x57, 0x19, 0x26, 0x52, 0xd8, 0x88, 0xc0, 0x3f, 0xa5, 0xd5, 0x90, 0x23, 0x84, 0x4c,
+	0x62, 0x15, 0xfc, 0x40, 0xc4, 0x4c, 0x79, 0xc2, 0x95, 0x08, 0xd0, 0xf2, 0xa2, 0x45, 0x0d, 0x7c,
+	0x61, 0x49, 0x03, 0x8f, 0x3e, 0x40, 0x74, 0x29, 0xec, 0x7b, 0x76, 0xab, 0x47, 0x23, 0x5c, 0x62,
+	0x0a, 0x44, 0xff, 0x38, 0x5e, 0x8b, 0xd4, 0xa3, 0x46, 0x49, 0xe5, 0xa8, 0xea, 0xaa, 0x65, 0x29,
+	0x3a, 0xa3, 0x0d, 0xc0, 0xfc, 0xd3, 0xd0, 0x8e, 0x48, 0x18, 0xbc, 0x46, 0xcd, 0x4f, 0x19, 0xf7,
+	0xfd, 0x53, 0xb4, 0xe1, 0x4b, 0x29, 0x71, 0x6d, 0xb5, 0x94, 0x68, 0xbc, 0x07, 0x45, 0x3c, 0xfe,
+	0xcd, 0xc8, 0x44, 0x5b, 0x08, 0x69, 0xee, 0x33, 0xea, 0x11, 0x95, 0xd4, 0x21, 0x74, 0xf9, 0x5d,
+	0x59, 0x2f, 0xe5, 0x79, 0x55, 0xd1, 0x88, 0xc5, 0xdc, 0x5a, 0x14, 0x28, 0x04, 0x8a, 0x97, 0xa0,
+	0x8c, 0x4d, 0x23, 0xab, 0xa8, 0xd8, 0xd6, 0x68, 0x5f, 0x6f, 0x61, 0xda, 0xf8, 0xcf, 0x19, 0xa8,
+	0xf4, 0x03, 0x0b, 0x8f, 0x09, 0xb4, 0x02, 0x3d, 0x57, 0xa8, 0x45, 0xf1, 0xc3, 0x77, 0x5d, 0x33,
+	0x16, 0x09, 0xcb, 0x2c, 0x01, 0xe8, 0x1f, 0x40, 0x6e, 0xe2, 0x9a, 0x47, 0x8d, 0xac, 0xaa, 0x7c,
+	0x50, 0x8a, 0x97, 0xdf, 0x68, 0x30, 0x64, 0x44, 0x6a, 0xfc, 0x1a, 0x54, 0x14, 0x60, 0xca, 0x76,
+	0x78, 0x89, 0x2c, 0xf4, 0x83, 0x96, 0x96, 0x41, 0xe3, 0xe2, 0x6e, 0x7b, 0xd0, 0xe2, 0x2a, 0x07,
+	0x54, 0x3e, 0x0c, 0x46, 0x0f, 0x3a, 0x6c, 0x30, 0xd4, 0x72, 0x64, 0xf2, 0x27, 0x40, 0xb7, 0x39,
+	0x40, 0x4b, 0x22, 0x40, 0xe1, 0xa0, 0xd7, 0xf9, 0xc9, 0x41, 0x5b, 0xd3, 0x8c, 0xdf, 0x5e, 0x03,
+	0x48, 0x4c, 0x5c, 0xfa, 0xdb, 0x50, 0x39, 0xa5, 0xd4, 0x48, 0xb1, 0x7d, 0xaa, 0x7d, 0x04, 0x8e,
+	0x26, 0xd1, 0xe8, 0x5d, 0xe5, 0xa6, 0x83, 0x27, 0xed, 0xb2, 0x11, 0xb4, 0x32, 0x4b, 0x0e, 0x69,
+	0x94, 0x31, 0x7c, 0xec, 0x07, 0x92, 0x66, 0xd5, 0x63, 0x56, 0xe9, 0x3e, 0x2b, 0xfa, 0x81, 0x25,
+	0x4f, 0xe4, 0x49, 0x20, 0x35, 0x8c, 0x31, 0xe9, 0x03, 0x04, 0xb5, 0x5c, 0x73, 0x1e, 0xda, 0x8c,
+	0xe3, 0x63, 0x26, 0x9b, 0x57, 0x98, 0x2c, 0x1e, 0x57, 0x47, 0x9e, 0x1f, 0xd8, 0x64, 0xb5, 0x08,
+	0x85, 0x82, 0xae, 0xc2, 0x61, 0x68, 0xb9, 0xa0, 0x39, 0x9f, 0x04, 0xfe, 0x74, 0xe4, 0x9a, 0x61,
+	0x24, 0xd6, 0x7c, 0x09, 0x01, 0x5d, 0x33, 0x8c, 0x8c, 0x2f, 0xa0, 0x3e, 0x30, 0xa7, 0x33, 0xce,
+	0xca, 0x69, 0x60, 0x74, 0xc8, 0xe1, 0x9a, 0x12, 0x4b, 0x97, 0xbe, 0x71, 0x43, 0xee, 0xdb, 0xc1,
+	0xd8, 0xf6, 0xe4, 0xfe, 0x95, 0x49, 0x64, 0xcd, 0x07, 0xa1, 0xe3, 0x1d, 0x31, 0xff, 0x54, 0xfa,
+	0xec, 0xc9, 0xb4, 0xf1, 0x4f, 0x32, 0x50, 0x51, 0xba, 0xa1, 0xbf, 0x97, 0xba, 0x18, 0xbf, 0xb4,
+	0xd4, 0x4f, 0xfe, 0xad, 0x5c, 0x90, 0xdf, 0x80, 0x7c, 0x18, 0x99, 0x81, 0xb4, 0xb6, 0x6a, 0x4a,
+	0x8e, 0x1d, 0x7f, 0xee, 0x59, 0x8c, 0xa3, 0xd1, 0xb6, 0x63, 0x7b, 0x56, 0x23, 0x7b, 0x01, 0x15,
+	0x22, 0x8d, 0x2d, 0x28, 0xc7, 0xc5, 0xe3, 0x12, 0x62, 0xfd, 0xa7, 0x03, 0xed, 0x92, 0x5e, 0x86,
+	0x3c, 0x6b, 0xf6, 0x1e, 0xb6, 0xb5, 0x0c, 0x3a, 0x29, 0x40, 0x92, 0x4b, 0xbf, 0x9b, 0x6a, 0xed,
+	0x8d, 0xc5, 0x52, 0xef, 0xd2, 0x5f, 0xa5, 0xb1, 0x37, 0xa1, 0x3c, 0xf7, 0x08, 0x68, 0x5b, 0xe2,
+	0x94, 0x4a, 0x00, 0xe8, 0x51, 0x25, 0xbd, 0xfb, 0x16, 0x3c, 0xaa, 0x9e, 0x99, 0xae, 0xf1, 0x19,
+	0x94, 0xe3, 0xe2, 0x50, 0x6f, 0xf6, 0xa0, 0xdf, 0xed, 0xf6, 0x9f, 0x76, 0x7a, 0x0f, 0xb5, 0x4b,
+	0x98, 0xdc, 0x67, 0xed, 0x56, 0x7b, 0x17, 0x93, 0x19, 0x5c, 0xf3, 0xad, 0x03, 0xc6, 0xda, 0xbd,
+	0xe1, 0x88, 0xf5, 0x9f, 0x6a, 0x6b, 0xc6, 0x6f, 0xe6, 0x60, 0xa3, 0xef, 0xed, 0xce, 0x67, 0xae,
+	0x33, 0x36, 0x23, 0x1b, 0xd5, 0x39, 0xd1, 0x19, 0x1e, 0xbe, 0x66, 0x14, 0x05, 0x9c, 0x19, 0x94,
+	0x19, 0x4f, 0x70, 0xbd, 0x6f, 0x68, 0x07, 0x11, 0xa9, 0xb5, 0x55, 0x2e, 0x50, 0xe7, 0xf0, 0x96,
+	0xef, 0x12, 0x2f, 0xd0, 0x7f, 0x00, 0x57, 0xb8, 0xae, 0x98, 0x53, 0xa2, 0xec, 0xcc, 0x55, 0x14,
+	0xd9, 0xa5, 0xa5, 0xaf, 0x73, 0x42, 0xcc, 0x8a, 0x64, 0x08, 0x43, 0xf5, 0x67, 0x92, 0x5d, 0xaa,
+	0xa3, 0x20, 0x26, 0xa4, 0x96, 0xa0, 0x6e, 0x53, 0xb6, 0x7a, 0x84, 0xf6, 0x27, 0xbc, 0xf5, 0xe5,
+	0x59, 0xdd, 0x4f, 0x3a, 0x83, 0x07, 0xf4, 0xe7, 0xb0, 0x91, 0xa2, 0xa4, 0x56, 0xf0, 0x7b, 0xdf,
+	0x3b, 0xd2, 0x7c, 0xb6, 0xd0, 0x7b, 0x15, 0x82, 0xcd, 0xe1, 0xf2, 0xe3, 0xba, 0x9f, 0x86, 0x0a,
+	0x5f, 0x0a, 0xbe, 0x55, 0xe4, 0xc6, 0x70, 0xc2, 0x0e, 0xa5, 0x93, 0xab, 0x97, 0xe2, 0x4e, 0xc3,
+	0xcf, 0x1e, 0xe9, 0x4d, 0xc2, 0xd1, 0x0e, 0x3f, 0x5d, 0x73, 0xac, 0x48, 0xe9, 0x8e, 0x85, 0x5a,
+	0x07, 0x8e, 0x92, 0xb7, 0x29, 0xa0, 0xdb, 0x54, 0x95, 0x80, 0x4f, 0x38, 0xec, 0x46, 0x0f, 0x36,
+	0x57, 0x35, 0x72, 0x85, 0x14, 0xb6, 0xa5, 0x4a, 0x61, 0x0b, 0x7a, 0xd1, 0x44, 0x22, 0xfb, 0x67,
+	0x19, 0xa8, 0xee, 0xda, 0xd6, 0x7c, 0xf6, 0x63, 0xdf, 0xf1, 0x70, 0x01, 0x7c, 0x08, 0x55, 0xdf,
+	0xb5, 0x68, 0xf6, 0x14, 0xaf, 0xb0, 0x94, 0x3f, 0x81, 0x30, 0x7d, 0x82, 0xef, 0xa2, 0x9d, 0x8c,
+	0x7c, 0xc8, 0xde, 0x85, 0xcb, 0x5c, 0x67, 0x2c, 0x4c, 0x28, 0x67, 0x3c, 0xf3, 0x1a, 0xcd, 0x8c,
+	0xc6, 0x51, 0x5c, 0x70, 0x22, 0xf2, 0x5f, 0x81, 0x4d, 0x85, 0x9c, 0x34, 0x1c, 0x44, 0xbf, 0xbc,
+	0x48, 0x36, 0xe2, 0xbc, 0xd2, 0xa0, 0x6f, 0xfc, 0xdb, 0x2c, 0x94, 0xb9, 0xc6, 0x19, 0xdb, 0x7b,
+	0x1b, 0xd0, 0x59, 0x69, 0x14, 0xd8, 0x93, 0x8b, 0xfc, 0x50, 0x0a, 0xfe, 0xe1, 0x97, 0xe8, 0x93,
+	0xf5, 0xb6, 0x94, 0x01, 0x2c, 0x7b, 0x22, 0x06, 0xa5, 0x9e, 0xbe, 0x3d, 0x08, 0x99, 0x80, 0xeb,
+	0xf3, 0x2e, 0x2f, 0x2a, 0x01, 0x1c, 0x8b, 0x5b, 0x49, 0x72, 0x6c, 0x23, 0xad, 0x03, 0xe8, 0x58,
+	0xe1, 0xc5, 0xda, 0xa0, 0xdc, 0x85, 0xda, 0x20, 0xb4, 0x28, 0xe0, 0x50, 0x27, 0xf9, 0xf8, 0x62,
+	0xc6, 0x6d, 0xb5, 0xee, 0xbb, 0x56, 0xa2, 0x75, 0xb1, 0xce, 0x90, 0xd6, 0xb3, 0x4f, 0x17, 0x68,
+	0x0b, 0x9c, 0xd6, 0xb3, 0x4f, 0x53, 0xb4, 0xf7, 0xa0, 0x92, 0xec, 0x56, 0x74, 0x59, 0xbe, 0x70,
+	0x06, 0xe3, 0xcd, 0x1b, 0x62, 0x26, 0x6e, 0x31, 0xe0, 0x99, 0x4a, 0x17, 0x67, 0xe2, 0x64, 0x94,
+	0xe9, 0x3e, 0x6c, 0xf0, 0x22, 0x46, 0x13, 0xc7, 0xc5, 0xdb, 0x24, 0x2a, 0x6a, 0xcb, 0xcb, 0x1e,
+	0x28, 0x6c, 0x9d, 0x93, 0x3d, 0x20, 0x2a, 0xd4, 0xd5, 0xfe, 0xeb, 0x35, 0x28, 0x77, 0x78, 0xed,
+	0xd1, 0x19, 0x3a, 0xc7, 0x7c, 0xc3, 0x04, 0x22, 0x0e, 0x07, 0xc0, 0xb4, 0xac, 0x91, 0x39, 0x99,
+	0xd8, 0xe3, 0xc8, 0xb6, 0x46, 0x28, 0xd9, 0x09, 0x76, 0xb9, 0x6e, 0x5a, 0x56, 0x53, 0xc0, 0xe9,
+	0xd8, 0xe1, 0x9a, 0x3e, 0x79, 0xc3, 0x4d, 0x7c, 0xa5, 0x48, 0xd3, 0x27, 0x2e, 0xb8, 0xdc, 0xd2,
+	0x98, 0x5a, 0x13, 0xb9, 0xef, 0xb6, 0x26, 0xf2, 0x2f, 0xbc, 0x26, 0x0a, 0x17, 0xaf, 0x89, 0x94,
+	0xea, 0x11, 0xe7, 0xb8, 0x48, 0x73, 0x9c, 0x88, 0x11, 0x1d, 0xeb, 0xcc, 0xf8, 0xc7, 0x59, 0x00,
+	0x66, 0xcf, 0x5c, 0x73, 0x6c, 0xff, 0xff, 0x33, 0x7a, 0xaf, 0x28, 0x0b, 0xcc, 0xb3, 0xa4, 0x03,
+	0xa3, 0x5c, 0x4c, 0x74, 0x70, 0xae, 0x1c, 0xde, 0xc2, 0x0b, 0x0f, 0x6f, 0xf1, 0x05, 0x86, 0xb7,
+	0xb4, 0x3c, 0xbc, 0xfa, 0x8f, 0xe0, 0xe5, 0xc0, 0x3e, 0x0d, 0x9c, 0xc8, 0x1e, 0x91, 0x00, 0x94,
+	0x3a, 0x46, 0x90, 0xcb, 0x96, 0x69, 0x34, 0xae, 0x0b, 0xa2, 0x07, 0x81, 0x3f, 0x4d, 0x1f, 0x25,
+	0xc6, 0x9f, 0x97, 0xa0, 0xd2, 0xf4, 0x4c, 0xf7, 0xfc, 0x6b, 0x9b, 0xdc, 0xff, 0xc8, 0x1a, 0x39,
+	0x9b, 0x47, 0x7c, 0xdc, 0xb9, 0x6f, 0x4c, 0x99, 0x20, 0x34, 0xe2, 0xe8, 0xcd, 0x30, 0x8f, 0x62,
+	0x3c, 0xf7, 0x96, 0x01, 0x0e, 0x22, 0x82, 0x38, 0x7f, 0x6c, 0xe9, 0x96, 0xf9, 0xe9, 0x9e, 0x9b,
+	0xe4, 0x8f, 0xef, 0x3e, 0x71, 0x7e, 0x22, 0xc0, 0xa3, 0xc5, 0x99, 0xd2, 0xc8, 0x87, 0xf3, 0xa9,
+	0xcd, 0x47, 0x3f, 0xcb, 0x9d, 0xc9, 0x5b, 0x02, 0x86, 0xa5, 0x4c, 0xed, 0xa9, 0x1f, 0x9c, 0xf3,
+	0x52, 0x0a, 0xbc, 0x14, 0x0e, 0xa2, 0x52, 0xde, 0x01, 0xfd, 0xd4, 0x74, 0xa2, 0x51, 0xba, 0x28,
+	0x7e, 0xdf, 0xd4, 0x10, 0x33, 0x54, 0x8b, 0xbb, 0x0a, 0x05, 0xcb, 0x09, 0x4f, 0x3a, 0x7d, 0x71,
+	0xd7, 0x14, 0x29, 0xec, 0x0b, 0x7a, 0x40, 0x8e, 0x0e, 0xcf, 0x23, 0x3b, 0xa4, 0xa1, 0xcc, 0xb2,
+	0x32, 0x42, 0x76, 0x10, 0x80, 0xe2, 0x90, 0x67, 0x47, 0xa7, 0x7e, 0x80, 0x39, 0xf9, 0x55, 0x32,
+	0x01, 0xa0, 0xd8, 0x88, 0xa4, 0x58, 0x11, 0x69, 0x15, 0xb3, 0x2c, 0x4e, 0xe3, 0x25, 0x8d, 0x73,
+	0x19, 0xc2, 0x56, 0x79, 0xf3, 0x13, 0x08, 0xea, 0x03, 0xa9, 0xf9, 0x74, 0xd5, 0xc4, 0x3e, 0x90,
+	0x43, 0x4b, 0x96, 0x55, 0x11, 0x4a, 0x7a, 0x1c, 0xa4, 0xfa, 0x14, 0xae, 0xa7, 0xfa, 0x37, 0x32,
+	0x83, 0xc0, 0x3c, 0x1f, 0x4d, 0xcd, 0x2f, 0xfd, 0x80, 0x14, 0x88, 0x59, 0x76, 0x55, 0x1d, 0xb6,
+	0x26, 0xa2, 0xf7, 0x10, 0x7b, 0x61, 0x56, 0xc7, 0xf3, 0x83, 0xc6, 0xfa, 0x45, 0x59, 0x11, 0x4b,
+	0xe2, 0x38, 0x4d, 0x30, 0xdd, 0x7b, 0x43, 0xfe, 0x08, 0x81, 0x55, 0x08, 0xb6, 0x43, 0x20, 0xbc,
+	0x1d, 0x86, 0xf7, 0xf8, 0x31, 0xb9, 0xc1, 0x07, 0x34, 0xbc, 0x47, 0x87, 0x29, 0x47, 0xa0, 0x33,
+	0x4d, 0x43, 0x97, 0x08, 0x7c, 0x8e, 0x82, 0xaa, 0xe6, 0xf0, 0xde, 0x68, 0x36, 0x8f, 0xf8, 0xeb,
+	0x01, 0x96, 0x0f, 0xef, 0xed, 0xcf, 0x23, 0x01, 0x3e, 0xb2, 0xa3, 0xc6, 0xa6, 0x04, 0x3f, 0xb4,
+	0x23, 0x94, 0x6a, 0xc2, 0x7b, 0xd2, 0x6a, 0x7c, 0x45, 0x8c, 0xed, 0x3d, 0x61, 0x16, 0x36, 0xa0,
+	0x16, 0x23, 0x47, 0xd3, 0x39, 0x7f, 0x2e, 0x90, 0x65, 0x15, 0x49, 0xb0, 0x37, 0x77, 0xc9, 0xbc,
+	0x69, 0x8e, 0x8f, 0xed, 0x51, 0x80, 0x4d, 0xb9, 0xc6, 0xa7, 0x8e, 0x20, 0x0c, 0x5b, 0xf3, 0x12,
+	0xf0, 0xc4, 0xe8, 0xd8, 0x89, 0x48, 0x31, 0x98, 0x65, 0x25, 0x02, 0x3c, 0x72, 0x22, 0xe4, 0x4f,
+	0x1c, 0x29, 0x56, 0x20, 0x15, 0x71, 0x9d, 0x88, 0xd6, 0x09, 0xb1, 0x47, 0x70, 0x2a, 0xe8, 0x36,
+	0x68, 0x29, 0x5a, 0x2c, 0xef, 0x06, 0x91, 0xd6, 0x15, 0x52, 0x2c, 0xf5, 0x0d, 0xe0, 0x99, 0x47,
+	0xb8, 0xf4, 0x78, 0x99, 0x2f, 0x71, 0xbd, 0x07, 0x81, 0x77, 0x9d, 0xf0, 0x84, 0x4a, 0x7c, 0x1d,
+	0xea, 0x0a, 0x1d, 0x96, 0x77, 0x93, 0xaf, 0x8c, 0x98, 0x2c, 0xd5, 0xc6, 0xc0, 0x9e, 0xfa, 0x91,
+	0xe8, 0xe6, 0xcb, 0x4a, 0x1b, 0x19, 0xc1, 0xd3, 0x6d, 0x14, 0xb4, 0xc7, 0x0e, 0x57, 0xf5, 0xc9,
+	0x36, 0x72, 0x52, 0x2c, 0xf5, 0x55, 0xa8, 0x22, 0x17, 0x89, 0x6c, 0x8f, 0x6f, 0xfe, 0x57, 0xf8,
+	0xc0, 0x0a, 0x18, 0xed, 0xfe, 0x57, 0xf1, 0xf1, 0x89, 0x6b, 0xc7, 0x7c, 0x7b, 0x8b, 0x93, 0x08,
+	0x18, 0x92, 0x18, 0x81, 0x62, 0x4d, 0xdc, 0x0f, 0xe6, 0x9e, 0xcd, 0xd5, 0x9c, 0xf4, 0x69, 0x09,
+	0x3f, 0x9b, 0x38, 0xad, 0xef, 0xc2, 0x65, 0xae, 0xdd, 0xb0, 0x15, 0xe9, 0x43, 0xba, 0xe8, 0xae,
+	0xb4, 0xb2, 0xe9, 0x92, 0x3e, 0x06, 0x87, 0xc6, 0xcf, 0x32, 0x70, 0xa3, 0x4f, 0x4e, 0x3f, 0xc4,
+	0x60, 0xf7, 0xec, 0x30, 0x34, 0x8f, 0x50, 0x35, 0xf5, 0x60, 0xfe, 0xf5, 0xd7, 0xa8, 0xed, 0x5c,
+	0xdf, 0x37, 0x03, 0xdb, 0x8b, 0x62, 0xf6, 0x2b, 0xa4, 0xd3, 0x45, 0xb0, 0x7e, 0x9f, 0x2c, 0x59,
+	0xb6, 0x17, 0x1d, 0xc4, 0x72, 0xbe, 0x68, 0x4b, 0xda, 0xb6, 0xb1, 0x44, 0x65, 0xfc, 0x9b, 0x57,
+	0x21, 0xd7, 0xf3, 0x2d, 0x72, 0xf7, 0xa2, 0x97, 0x03, 0xcb, 0x06, 0x54, 0x44, 0xd3, 0x1f, 0xba,
+	0x72, 0x95, 0x3c, 0xf1, 0x75, 0xf1, 0x5b, 0x83, 0x57, 0xe9, 0xf2, 0x48, 0x1e, 0x31, 0x78, 0xa0,
+	0x55, 0x84, 0xf2, 0x0b, 0x41, 0x8c, 0x63, 0x70, 0x6c, 0xc9, 0xaa, 0x10, 0xd8, 0x1e, 0xc9, 0x77,
+	0x79, 0x16, 0xa7, 0xe9, 0xca, 0x1f, 0xf8, 0x78, 0xf8, 0xf2, 0xbd, 0x9a, 0x5f, 0x71, 0xe5, 0xe7,
+	0x78, 0xda, 0xbc, 0xef, 0x43, 0xf9, 0x4b, 0xdf, 0xf1, 0x78, 0xc3, 0x0b, 0x4b, 0x0d, 0x47, 0xa9,
+	0x9c, 0x37, 0xfc, 0x4b, 0xf1, 0xa5, 0xbf, 0x06, 0x45, 0xdf, 0xe3, 0x65, 0x17, 0x97, 0xca, 0x2e,
+	0xf8, 0x5e, 0x97, 0x3b, 0xbb, 0xd6, 0x0e, 0xe7, 0x68, 0xf7, 0x40, 0x52, 0x7b, 0x12, 0x09, 0x43,
+	0x67, 0x85, 0x80, 0x7d, 0xaf, 0x6b, 0x4f, 0xd0, 0xaf, 0xb0, 0x22, 0xa4, 0x36, 0x2a, 0xac, 0xbc,
+	0x54, 0x18, 0x70, 0x34, 0x15, 0xf8, 0x3d, 0x28, 0x1d, 0x05, 0xfe, 0x7c, 0x86, 0xaa, 0x09, 0x58,
+	0xa2, 0x2c, 0x12, 0x6e, 0xe7, 0x1c, 0x0f, 0x1a, 0xfa, 0x74, 0xbc, 0xa3, 0x11, 0x69, 0x71, 0x50,
+	0xe7, 0x57, 0x62, 0x55, 0x09, 0x24, 0xfd, 0xcc, 0xf7, 0xa0, 0x64, 0x1e, 0x1d, 0x8d, 0x84, 0xcf,
+	0xee, 0x52, 0x59, 0xe6, 0xd1, 0x11, 0x55, 0x79, 0x17, 0x6a, 0xa7, 0xe8, 0x65, 0x36, 0xb3, 0xc7,
+	0x9c, 0xb6, 0xb6, 0x3c, 0x94, 0xa7, 0x8e, 0x87, 0xca, 0x07, 0xa2, 0x57, 0xb5, 0x27, 0xf5, 0xe7,
+	0x6a, 0x4f, 0xb6, 0x20, 0xef, 0x3a, 0x53, 0x27, 0x12, 0x5e, 0xbc, 0xa9, 0xeb, 0x11, 0x21, 0x74,
+	0x03, 0x0a, 0x42, 0x49, 0xaf, 0x2d, 0x91, 0x08, 0x4c, 0x5a, 0x02, 0xda, 0x78, 0x8e, 0x04, 0xa4,
+	0x5c, 0x55, 0xf4, 0x6f, 0xbe, 0xaa, 0x7c, 0x44, 0x26, 0x56, 0xdb, 0x8b, 0x46, 0x32, 0xc3, 0xe5,
+	0xd5, 0x19, 0xaa, 0x9c, 0xac, 0xcf, 0xb3, 0x7d, 0x00, 0x95, 0x80, 0xd4, 0x7a, 0x23, 0xd2, 0x01,
+	0x6e, 0xaa, 0x7a, 0x8d, 0x44, 0xdf, 0xc7, 0x20, 0x88, 0xbf, 0xf5, 0x26, 0xac, 0x27, 0xaf, 0x12,
+	0xf8, 0xd3, 0x8d, 0x2b, 0xaa, 0x59, 0x20, 0xf5, 0x8c, 0x41, 0xdc, 0x00, 0x6a, 0x8e, 0x0a, 0xc4,
+	0x39, 0xe7, 0x4e, 0x7d, 0xdc, 0xf5, 0x2a, 0xa4, 0xb3, 0xa1, 0xcc, 0xaa, 0x04, 0xe4, 0x6e, 0x59,
+	0x21, 0xfa, 0x47, 0x48, 0xe9, 0x2f, 0x3a, 0x6b, 0x5c, 0x53, 0x7b, 0xc3, 0x4f, 0x90, 0x56, 0x74,
+	0xc6, 0xca, 0x96, 0xfc, 0x44, 0x9e, 0x77, 0xe8, 0x78, 0x16, 0xae, 0xa3, 0xc8, 0x3c, 0x0a, 0x1b,
+	0x0d, 0xda, 0x66, 0x15, 0x01, 0x1b, 0x9a, 0x47, 0x21, 0xde, 0x54, 0x4d, 0x2e, 0x63, 0xf1, 0x76,
+	0x5f, 0x57, 0xd5, 0x60, 0x8a, 0xf4, 0xc5, 0x2a, 0x66, 0x92, 0xd0, 0x3f, 0x01, 0x5d, 0x5a, 0x37,
+	0x95, 0x8b, 0xe7, 0x8d, 0xa5, 0xa5, 0xb5, 0x2e, 0xcc, 0x9b, 0xf1, 0x33, 0xa9, 0x4f, 0xa0, 0x96,
+	0x96, 0x89, 0x6f, 0xae, 0x30, 0x9b, 0xd1, 0xac, 0xb3, 0xea, 0x58, 0x49, 0xe1, 0xf8, 0xa0, 0x77,
+	0x30, 0xf1, 0x7d, 0xca, 0xc8, 0x4d, 0x43, 0x55, 0xcf, 0x8f, 0x5a, 0x12, 0x86, 0xe3, 0x23, 0xef,
+	0x6c, 0xd1, 0x59, 0xe3, 0x96, 0x3a, 0x3e, 0xf1, 0x35, 0x09, 0x45, 0x3e, 0xf1, 0x49, 0x53, 0xcd,
+	0x6f, 0x00, 0x94, 0xe1, 0x95, 0xd4, 0x54, 0xc7, 0x57, 0x03, 0x06, 0x41, 0xfc, 0x4d, 0xef, 0x80,
+	0xfc, 0x79, 0x30, 0xb6, 0x47, 0x61, 0x64, 0xcf, 0x1a, 0x5b, 0x34, 0xa2, 0xc0, 0x41, 0x83, 0xc8,
+	0x9e, 0xe9, 0xf7, 0xa1, 0x3e, 0x0b, 0xec, 0x91, 0x32, 0x4f, 0xaf, 0xaa, 0x5d, 0xdc, 0x0f, 0xec,
+	0x64, 0xaa, 0xaa, 0x33, 0x25, 0x25, 0x73, 0x2a, 0x3d, 0x30, 0x16, 0x72, 0x26, 0x9d, 0xa8, 0xce,
+	0x94, 0x94, 0xfe, 0x43, 0xd8, 0x50, 0x72, 0xce, 0x4f, 0x28, 0xf3, 0x6b, 0x29, 0x2b, 0xa6, 0x24,
+	0x3f, 0x38, 0xc1, 0xec, 0xf5, 0x59, 0x2a, 0xad, 0x37, 0x41, 0x5b, 0x92, 0xcf, 0x5f, 0xa7, 0xfc,
+	0xd7, 0x2e, 0xd0, 0xf2, 0xa4, 0x34, 0x45, 0x8f, 0xb9, 0xbd, 0xaa, 0x13, 0xb6, 0x3d, 0xab, 0xf1,
+	0x3d, 0xfe, 0x96, 0x91, 0x12, 0xfa, 0x3d, 0xa8, 0x72, 0x49, 0x91, 0x5e, 0x1b, 0x84, 0x8d, 0x37,
+	0x54, 0x8d, 0x3a, 0x89, 0x8b, 0x84, 0x60, 0x15, 0x37, 0xfe, 0x0e, 0xf5, 0x8f, 0x61, 0x83, 0x9b,
+	0x32, 0x54, 0xce, 0xfa, 0xe6, 0xf2, 0xe2, 0x22, 0xa2, 0x07, 0x09, 0x7b, 0x65, 0x70, 0x3d, 0x98,
+	0x7b, 0x24, 0x3d, 0x8a, 0x9c, 0xb3, 0xc0, 0x3f, 0xb4, 0x79, 0xfe, 0xdb, 0x5b, 0xd9, 0xa4, 0x3b,
+	0x8c, 0x93, 0xf1, 0xbc, 0xc4, 0xd2, 0xae, 0x06, 0x2a, 0x68, 0x1f, 0xf3, 0x5d, 0x50, 0x26, 0x3f,
+	0x12, 0xa8, 0xcc, 0xb7, 0x5e, 0xa4, 0xcc, 0x1d, 0xcc, 0x47, 0x65, 0xea, 0x90, 0x9b, 0xcf, 0x1d,
+	0xab, 0x71, 0x87, 0x3f, 0x0c, 0xc0, 0x6f, 0xf4, 0x07, 0x09, 0xec, 0xf1, 0x3c, 0x08, 0x9d, 0x67,
+	0xf6, 0x28, 0x74, 0xbc, 0x93, 0xc6, 0xdb, 0x34, 0x8e, 0xb5, 0x18, 0x3a, 0x70, 0xbc, 0x13, 0x5c,
+	0xb1, 0xf6, 0x59, 0x64, 0x07, 0x1e, 0x7f, 0x00, 0xf5, 0x8e, 0xba, 0x62, 0xdb, 0x84, 0x40, 0x8e,
+	0xc2, 0xc0, 0x8e, 0xbf, 0xf5, 0x1f, 0xc0, 0x7a, 0x72, 0x5b, 0x9b, 0xa1, 0xec, 0xd2, 0x78, 0x77,
+	0xa5, 0x81, 0x9b, 0xe4, 0x1a, 0x56, 0x9f, 0xa5, 0xd2, 0x0b, 0x6b, 0x2b, 0xe4, 0x6b, 0xeb, 0xee,
+	0xb7, 0x5a, 0x5b, 0x03, 0x4c, 0xeb, 0x6f, 0x40, 0xc9, 0xf1, 0x22, 0x3b, 0x40, 0x0d, 0xec, 0x7b,
+	0x4b, 0x67, 0x40, 0x8c, 0x43, 0xb7, 0x9b, 0xd0, 0x75, 0x90, 0x31, 0x35, 0xde, 0x5f, 0x22, 0x93,
+	0x28, 0xfd, 0x36, 0x94, 0xe3, 0xc7, 0xbb, 0x8d, 0x0f, 0x96, 0xe8, 0x12, 0x24, 0x1a, 0x50, 0x4e,
+	0x71, 0x3d, 0x6e, 0x2f, 0x11, 0x11, 0x1c, 0x85, 0x86, 0x89, 0xe3, 0xba, 0x5c, 0x68, 0xb8, 0xb7,
+	0x24, 0x34, 0x3c, 0x70, 0x5c, 0x97, 0x0b, 0x0d, 0x13, 0xf1, 0x85, 0x47, 0x2e, 0xe5, 0xc0, 0x9e,
+	0x7c, 0xb8, 0x7c, 0xe4, 0x22, 0xee, 0x09, 0x3d, 0x73, 0xae, 0x84, 0xa4, 0xd5, 0xe7, 0xc6, 0x8d,
+	0x8f, 0xd4, 0xb1, 0x4a, 0xab, 0xfb, 0x19, 0x84, 0x71, 0x1a, 0x25, 0x7f, 0x61, 0x13, 0xc1, 0x2b,
+	0xf5, 0xc7, 0xfc, 0xf5, 0x1d, 0x87, 0xe0, 0x7d, 0xfa, 0x7d, 0xa8, 0x49, 0x1f, 0x51, 0xac, 0x2e,
+	0x6c, 0x7c, 0xb2, 0xd4, 0x82, 0x34, 0x81, 0xbe, 0x0b, 0xd5, 0x09, 0x0a, 0x91, 0x53, 0x2e, 0x53,
+	0x36, 0xee, 0x53, 0x43, 0xb6, 0xe4, 0x71, 0x7e, 0x91, 0xcc, 0xc9, 0x52, 0xb9, 0xf4, 0xbb, 0xa0,
+	0x3b, 0x13, 0x3e, 0x9f, 0x78, 0x47, 0xe7, 0x72, 0x63, 0xe3, 0x53, 0x5a, 0x9c, 0x2b, 0x30, 0xfa,
+	0x3d, 0xa8, 0x85, 0xb6, 0x67, 0xa1, 0xc7, 0x17, 0xdf, 0x24, 0x9f, 0xa9, 0xbe, 0x8c, 0xf1, 0x23,
+	0x7f, 0x34, 0x0d, 0x7a, 0xd6, 0x5e, 0xc8, 0xa5, 0x94, 0x7b, 0x80, 0xeb, 0xfc, 0x59, 0x92, 0xe9,
+	0x57, 0x2e, 0xc8, 0x84, 0x54, 0x32, 0xd3, 0x7d, 0xa8, 0x5b, 0xa8, 0x74, 0x1d, 0x91, 0xec, 0x87,
+	0xcb, 0xf2, 0xfb, 0x2a, 0xbf, 0x54, 0x15, 0xb2, 0xf8, 0xa2, 0x3c, 0x49, 0xe9, 0x9f, 0xc0, 0xba,
+	0xd4, 0x9c, 0x46, 0x42, 0xc9, 0xfa, 0x03, 0xb5, 0xc2, 0x58, 0x31, 0xca, 0x6a, 0x73, 0xf9, 0x29,
+	0xdb, 0x49, 0x47, 0x7c, 0xe8, 0x99, 0xb3, 0xf0, 0xd8, 0x8f, 0x1a, 0xbf, 0xaa, 0x4a, 0x2b, 0x03,
+	0x01, 0x65, 0x55, 0x24, 0x92, 0x29, 0x3c, 0xba, 0x92, 0xad, 0x3d, 0x8e, 0xec, 0xc6, 0x0f, 0xf9,
+	0xd1, 0x15, 0x03, 0x5b, 0x11, 0x0e, 0x1b, 0x98, 0xb3, 0x99, 0x7b, 0xce, 0x97, 0xe3, 0x8f, 0x68,
+	0x39, 0x6e, 0x2a, 0xcb, 0xb1, 0x89, 0x48, 0x5a, 0x8f, 0x65, 0x53, 0x7e, 0xea, 0xdb, 0x50, 0x9d,
+	0xf9, 0x61, 0x34, 0xb2, 0xa6, 0x2e, 0xf5, 0xbf, 0xa9, 0xb2, 0x83, 0x7d, 0x3f, 0x8c, 0x76, 0xa7,
+	0x2e, 0x1d, 0x60, 0xb3, 0xf8, 0x5b, 0xef, 0xc2, 0xe5, 0x14, 0xab, 0x37, 0xc9, 0x87, 0xa0, 0xb1,
+	0x43, 0x35, 0xde, 0x54, 0x6a, 0x54, 0x58, 0xbe, 0x70, 0x52, 0xde, 0xf0, 0x17, 0x41, 0x78, 0xe9,
+	0xe3, 0x73, 0x10, 0x7b, 0xea, 0xb7, 0xb8, 0xdc, 0x42, 0x50, 0xe9, 0xaa, 0x7f, 0x1f, 0xd6, 0x13,
+	0x2a, 0xec, 0x60, 0xd8, 0xd8, 0x55, 0x57, 0xaf, 0xf2, 0x14, 0xa8, 0x26, 0x33, 0x22, 0x2c, 0x34,
+	0xfe, 0x28, 0x0f, 0x25, 0x79, 0xef, 0x40, 0xff, 0xe7, 0x83, 0xde, 0xe3, 0x5e, 0xff, 0x69, 0x8f,
+	0x3f, 0x36, 0x6e, 0x0e, 0x06, 0x6d, 0x36, 0xd4, 0xf0, 0x65, 0x33, 0xd0, 0x93, 0xc3, 0xd1, 0xa0,
+	0xd5, 0xec, 0xf1, 0xc7, 0xc7, 0xf4, 0xd0, 0x91, 0xa7, 0xd7, 0xf4, 0x0d, 0xa8, 0x3d, 0x38, 0xe8,
+	0x91, 0x2f, 0x34, 0x07, 0x65, 0x11, 0xd4, 0xfe, 0x9c, 0x9b, 0x37, 0x39, 0x08, 0x1f, 0x27, 0xd6,
+	0xf6, 0x9a, 0xc3, 0x36, 0xeb, 0x48, 0x50, 0x9e, 0xdc, 0xaa, 0xfb, 0x07, 0xac, 0x25, 0x4a, 0x2a,
+	0xe8, 0x57, 0x60, 0x23, 0xce, 0x26, 0x8b, 0xd4, 0x8a, 0xd8, 0xb2, 0x7d, 0xd6, 0xff, 0x71, 0xbb,
+	0x35, 0xd4, 0x80, 0x6c, 0xa5, 0x0f, 0x1f, 0x6a, 0x15, 0x34, 0xa1, 0xee, 0x76, 0x06, 0xc3, 0x4e,
+	0xaf, 0x35, 0xd4, 0xaa, 0xd8, 0xe0, 0x07, 0x9d, 0xee, 0xb0, 0xcd, 0xb4, 0x1a, 0x9a, 0xc0, 0x7e,
+	0xdc, 0xef, 0xf4, 0xb4, 0x3a, 0x42, 0x07, 0xcd, 0xbd, 0xfd, 0x6e, 0x5b, 0x5b, 0x47, 0xe8, 0xa0,
+	0xcf, 0x86, 0x9a, 0x86, 0xd0, 0xa7, 0x9d, 0xde, 0x6e, 0xff, 0xa9, 0xb6, 0x81, 0x46, 0xb2, 0x83,
+	0x1e, 0x56, 0xa3, 0xa3, 0x35, 0x8a, 0x3e, 0x47, 0xf8, 0x5a, 0xfa, 0xb2, 0x62, 0x60, 0xdd, 0x44,
+	0x14, 0x99, 0x6b, 0x07, 0xd8, 0x86, 0x2b, 0xd8, 0x97, 0x38, 0x49, 0xd4, 0x57, 0xb1, 0x9c, 0xbd,
+	0x4e, 0xef, 0x60, 0xa0, 0x5d, 0x43, 0x62, 0xfa, 0x24, 0x4c, 0x03, 0xcb, 0xe9, 0xf4, 0x68, 0x28,
+	0x6f, 0xe1, 0xf7, 0x6e, 0xbb, 0xdb, 0x1e, 0xb6, 0xb5, 0x57, 0xb0, 0x57, 0xac, 0xbd, 0xdf, 0x6d,
+	0xb6, 0xda, 0xda, 0x16, 0x26, 0xba, 0xfd, 0xd6, 0xe3, 0x51, 0x7f, 0x5f, 0x7b, 0x55, 0xdf, 0x04,
+	0xad, 0xdf, 0x1b, 0xed, 0x1e, 0xec, 0x77, 0x3b, 0xad, 0xe6, 0xb0, 0x3d, 0x7a, 0xdc, 0xfe, 0xa9,
+	0x66, 0xe0, 0xb0, 0xef, 0xb3, 0xf6, 0x48, 0x94, 0xf5, 0x9a, 0x4c, 0x8b, 0xf2, 0x5e, 0xc7, 0xa7,
+	0xa5, 0x0f, 0x0e, 0xbe, 0xf8, 0xe2, 0xa7, 0x23, 0x31, 0x0e, 0xdf, 0xc3, 0x66, 0x26, 0x39, 0x46,
+	0x07, 0x8f, 0xb5, 0x37, 0x16, 0x40, 0x83, 0xc7, 0xda, 0x9b, 0x38, 0x8e, 0x72, 0x62, 0xb4, 0xdb,
+	0x48, 0xc0, 0xda, 0xad, 0x03, 0x36, 0xe8, 0x3c, 0x69, 0x8f, 0x5a, 0xc3, 0xb6, 0xf6, 0x16, 0x0d,
+	0x5c, 0xa7, 0xf7, 0x58, 0xbb, 0x83, 0x3d, 0xc3, 0x2f, 0x3e, 0x5d, 0x6f, 0xeb, 0x3a, 0xd4, 0x13,
+	0x5a, 0x82, 0xbd, 0x83, 0x24, 0x3b, 0xac, 0xdf, 0xdc, 0x6d, 0xa1, 0x95, 0xfa, 0x5d, 0x1c, 0x96,
+	0xc1, 0x7e, 0xb7, 0x33, 0xd4, 0xee, 0x62, 0xdf, 0x1f, 0x36, 0x87, 0x8f, 0xda, 0x4c, 0x7b, 0x0f,
+	0x67, 0x7e, 0xd8, 0xd9, 0x6b, 0x8f, 0xc4, 0x34, 0x6c, 0x63, 0x1d, 0x0f, 0x3a, 0xdd, 0xae, 0x76,
+	0x8f, 0x6c, 0x82, 0x4d, 0x36, 0xec, 0xd0, 0xdc, 0x7f, 0x88, 0x05, 0x34, 0xf7, 0xf7, 0xbb, 0x3f,
+	0xd5, 0x3e, 0xc2, 0x0e, 0xee, 0x1d, 0x74, 0x87, 0x9d, 0xd1, 0xc1, 0xfe, 0x6e, 0x73, 0xd8, 0xd6,
+	0x3e, 0xa6, 0x85, 0xd1, 0x1f, 0x0c, 0x77, 0xf7, 0xba, 0xda, 0x27, 0xc6, 0xaf, 0x43, 0x49, 0x5e,
+	0x45, 0x31, 0x57, 0xa7, 0xd7, 0x6b, 0xe3, 0xb3, 0xf9, 0x12, 0xe4, 0xba, 0xed, 0x07, 0x43, 0x2d,
+	0x83, 0x40, 0xd6, 0x79, 0xf8, 0x68, 0xa8, 0xad, 0xe1, 0x67, 0xff, 0x00, 0x07, 0x29, 0x4b, 0xbd,
+	0x6b, 0xef, 0x75, 0xb4, 0x1c, 0x7e, 0x35, 0x7b, 0xc3, 0x8e, 0x96, 0xa7, 0x65, 0xd3, 0xe9, 0x3d,
+	0xec, 0xb6, 0xb5, 0x02, 0x42, 0xf7, 0x9a, 0xec, 0xb1, 0x56, 0xe4, 0x85, 0xee, 0xb6, 0x3f, 0xd7,
+	0x4a, 0xf8, 0xde, 0xbe, 0xbb, 0xad, 0x95, 0x11, 0xb4, 0xdb, 0xde, 0x3d, 0xd8, 0xd7, 0xc0, 0xb8,
+	0x0d, 0xc5, 0xe6, 0xd1, 0xd1, 0x1e, 0xde, 0xf4, 0xb1, 0x33, 0xf8, 0x70, 0x80, 0xb6, 0xd1, 0x4e,
+	0x7f, 0x38, 0xec, 0xef, 0x69, 0x19, 0x5c, 0xb8, 0xc3, 0xfe, 0xbe, 0xb6, 0x66, 0x74, 0xa0, 0x24,
+	0x8f, 0x3f, 0xe5, 0x35, 0x71, 0x09, 0x72, 0xfb, 0xac, 0xfd, 0x84, 0x3b, 0x01, 0xf4, 0xda, 0x9f,
+	0x63, 0x33, 0xf1, 0x0b, 0x0b, 0xca, 0x62, 0x45, 0xfc, 0xd9, 0x2f, 0x3d, 0x27, 0xee, 0x76, 0x7a,
+	0xed, 0x26, 0xd3, 0xf2, 0xc6, 0x47, 0x29, 0xfb, 0xa8, 0xe0, 0x1a, 0x58, 0x7d, 0xb3, 0x23, 0xaa,
+	0xef, 0x3c, 0xec, 0xf5, 0x59, 0x9b, 0xbf, 0x4f, 0x16, 0xe3, 0xb6, 0x66, 0xbc, 0x0d, 0xe5, 0x98,
+	0xe3, 0xe1, 0x3a, 0x6a, 0xb1, 0xfe, 0x60, 0xc0, 0x87, 0xf9, 0x12, 0xa6, 0x69, 0x6c, 0x78, 0x3a,
+	0x63, 0xfc, 0x4d, 0x28, 0xc5, 0xcc, 0xf6, 0x75, 0x58, 0x1b, 0x0e, 0x84, 0x16, 0x7f, 0xf3, 0x6e,
+	0x12, 0x20, 0x67, 0x28, 0xbf, 0xd8, 0xda, 0x70, 0xa0, 0xbf, 0x03, 0x05, 0xfe, 0x3c, 0x5e, 0x98,
+	0xb0, 0x36, 0xd3, 0x0c, 0x7c, 0x48, 0x38, 0x26, 0x68, 0x8c, 0x2e, 0xd4, 0xd3, 0x18, 0xd4, 0x92,
+	0x72, 0x9c, 0xa2, 0x91, 0x51, 0x20, 0xa8, 0xdb, 0xe0, 0xa9, 0xce, 0xae, 0x70, 0xd7, 0x8d, 0xd3,
+	0xc6, 0x3f, 0xcc, 0x02, 0x24, 0xa2, 0x1a, 0x0a, 0x83, 0xb1, 0xbe, 0x25, 0x2f, 0xac, 0xd9, 0x2f,
+	0x41, 0xd9, 0xf5, 0x4d, 0x4b, 0x0d, 0x74, 0x53, 0x42, 0x00, 0x8d, 0x86, 0xfa, 0x14, 0xb5, 0xcc,
+	0x5d, 0x51, 0x50, 0x4d, 0x3c, 0xf1, 0x83, 0xa9, 0x29, 0x1d, 0x7b, 0x45, 0x0a, 0x8f, 0x1e, 0x6e,
+	0x61, 0x45, 0x81, 0xd5, 0xa3, 0xb7, 0x52, 0xe4, 0x25, 0x2e, 0x80, 0x5d, 0x84, 0xe1, 0x95, 0xc6,
+	0xf6, 0xc6, 0xae, 0x1f, 0xda, 0x16, 0xde, 0xfa, 0x0b, 0x24, 0x95, 0x82, 0x04, 0xed, 0x9c, 0xf3,
+	0xde, 0x06, 0x53, 0xc7, 0x33, 0x23, 0xa1, 0xaa, 0x2e, 0x33, 0x05, 0x82, 0xcd, 0xc5, 0x78, 0x29,
+	0xbc, 0xb9, 0xdc, 0x58, 0x5b, 0x42, 0x00, 0x35, 0xf7, 0x65, 0x00, 0x3b, 0x1c, 0x9b, 0x33, 0x5e,
+	0x78, 0x99, 0x0a, 0x2f, 0x0b, 0xc8, 0xce, 0xb9, 0xde, 0x85, 0xfa, 0xf0, 0x10, 0xd9, 0xbd, 0x8f,
+	0x37, 0xe9, 0x96, 0xef, 0x0a, 0xc5, 0xc8, 0xeb, 0x8b, 0x32, 0xed, 0xdd, 0x34, 0x19, 0xb7, 0x2a,
+	0x2f, 0xe4, 0xbd, 0xd1, 0x84, 0xcb, 0x2b, 0xc8, 0x5e, 0xc8, 0xbb, 0xee, 0x37, 0x0a, 0x00, 0xc9,
+	0xc5, 0x24, 0x65, 0x6a, 0xce, 0xa4, 0x4d, 0xcd, 0xdb, 0x70, 0x55, 0xbc, 0xe2, 0x8c, 0xed, 0xb5,
+	0x8e, 0x37, 0x3a, 0x34, 0xa5, 0x55, 0x5f, 0x17, 0x58, 0x6e, 0xb2, 0xed, 0x78, 0x3b, 0x26, 0xca,
+	0x2c, 0xeb, 0x6a, 0x1e, 0x7c, 0x14, 0x9b, 0xbd, 0xe0, 0x51, 0x6c, 0x2d, 0xc9, 0x3e, 0x3c, 0x9f,
+	0xe9, 0xef, 0xc3, 0x95, 0xc0, 0x9e, 0x04, 0x76, 0x78, 0x3c, 0x8a, 0x42, 0xb5, 0x32, 0xee, 0x58,
+	0xb7, 0x21, 0x90, 0xc3, 0x30, 0xae, 0xeb, 0x7d, 0xb8, 0x92, 0x18, 0x14, 0xd5, 0x1c, 0xdc, 0x3a,
+	0xba, 0x31, 0x91, 0x76, 0xc4, 0x38, 0xc7, 0xcb, 0x00, 0xe2, 0xb6, 0x26, 0x03, 0x38, 0x95, 0x58,
+	0x99, 0xdf, 0xcc, 0xf0, 0x7a, 0xfd, 0x0e, 0xe8, 0x4e, 0x38, 0x5a, 0x30, 0x17, 0x09, 0xdb, 0xbd,
+	0xe6, 0x84, 0xfb, 0x29, 0x53, 0xd1, 0x45, 0x96, 0xa8, 0xd2, 0x45, 0x96, 0xa8, 0x4d, 0xc8, 0xd3,
+	0x85, 0x4e, 0x18, 0x86, 0x78, 0x42, 0x37, 0x20, 0x87, 0x1c, 0x8b, 0x8c, 0x18, 0xf5, 0xed, 0xfa,
+	0x5d, 0x04, 0xd2, 0xc5, 0x11, 0xa1, 0x8c, 0x70, 0x68, 0x37, 0x57, 0x07, 0x55, 0xc6, 0x76, 0xa9,
+	0x50, 0x37, 0xb5, 0x64, 0x18, 0x19, 0x8f, 0xf2, 0xf2, 0x36, 0xe8, 0xca, 0xb8, 0x48, 0xea, 0x2a,
+	0x37, 0x03, 0xc7, 0x83, 0x22, 0x88, 0xd1, 0x33, 0x1e, 0x87, 0x84, 0x74, 0xc6, 0xb5, 0xe5, 0xeb,
+	0x0b, 0x22, 0x49, 0xbf, 0xfc, 0x3e, 0x5c, 0x49, 0xc6, 0x6e, 0x64, 0x46, 0xa3, 0xe8, 0xd8, 0x1e,
+	0xa1, 0x63, 0x4c, 0x9d, 0xba, 0xb3, 0x11, 0x0f, 0x63, 0x33, 0x1a, 0x1e, 0xdb, 0x78, 0x01, 0xf9,
+	0x00, 0x2a, 0x64, 0x4a, 0x99, 0xf9, 0xae, 0x33, 0x3e, 0x27, 0x15, 0x5a, 0x7d, 0x5b, 0xe3, 0x3d,
+	0x7c, 0x6a, 0x3a, 0xd1, 0x3e, 0xc1, 0x19, 0x9c, 0xc6, 0xdf, 0xb8, 0x1a, 0x29, 0x4b, 0x68, 0x8f,
+	0x49, 0x9f, 0x96, 0x63, 0x45, 0x4c, 0x0f, 0xec, 0x31, 0x29, 0x26, 0x4e, 0x9c, 0x19, 0x19, 0x66,
+	0x6c, 0x8b, 0xd4, 0x68, 0x25, 0x06, 0x08, 0x12, 0x6e, 0xb9, 0x77, 0x60, 0x43, 0x21, 0x18, 0x71,
+	0xbd, 0x9d, 0x4e, 0x85, 0xac, 0x27, 0x64, 0x5d, 0x04, 0x1b, 0xff, 0x28, 0x03, 0xf5, 0xf4, 0xb5,
+	0x8e, 0x3f, 0x1e, 0x48, 0x5e, 0x45, 0xe4, 0x93, 0x97, 0x10, 0x2f, 0x41, 0x79, 0x76, 0x22, 0x9e,
+	0x40, 0x48, 0x6e, 0x35, 0x3b, 0xe1, 0x4f, 0x1f, 0xf4, 0xb7, 0xa0, 0x38, 0x3b, 0xe1, 0x9c, 0xe1,
+	0xa2, 0x85, 0x5e, 0x98, 0x71, 0xe7, 0xdf, 0xb7, 0xa0, 0x38, 0x17, 0xa4, 0xb9, 0x8b, 0x48, 0xe7,
+	0x44, 0x6a, 0x6c, 0x41, 0x55, 0x55, 0xa4, 0xe0, 0x06, 0xc7, 0x4b, 0x13, 0x6f, 0x18, 0x7e, 0x1a,
+	0xbf, 0xb9, 0x06, 0xd5, 0xb8, 0x07, 0xdf, 0xd2, 0xbe, 0xfb, 0x42, 0xbe, 0x0d, 0x5b, 0xe4, 0xad,
+	0x38, 0x22, 0x5f, 0x64, 0x34, 0xd8, 0x73, 0xe3, 0x2e, 0x1c, 0x9b, 0x61, 0x73, 0x1e, 0xf9, 0xf8,
+	0xf4, 0x59, 0x44, 0x0b, 0xe1, 0xaf, 0x00, 0x73, 0x71, 0xb4, 0x10, 0x4a, 0xeb, 0xef, 0x8b, 0xa7,
+	0x59, 0xf4, 0x4e, 0x95, 0xbc, 0x6a, 0xf2, 0x4b, 0x8b, 0xab, 0x2a, 0x9f, 0xa9, 0x62, 0x4a, 0xdf,
+	0x86, 0xf5, 0xc4, 0xdd, 0x5c, 0x3a, 0xe2, 0x2c, 0x66, 0xa9, 0xc5, 0xbe, 0xe6, 0x98, 0x34, 0xfe,
+	0x5e, 0x06, 0x36, 0x96, 0xf4, 0x12, 0x38, 0x5a, 0x49, 0x6c, 0x35, 0xfc, 0x44, 0x45, 0xe1, 0xd4,
+	0x8c, 0xc6, 0xc7, 0xa3, 0x59, 0x60, 0x4f, 0x9c, 0x33, 0x19, 0x20, 0x8e, 0x60, 0xfb, 0x04, 0x22,
+	0xa7, 0xa2, 0xd9, 0xcc, 0x0e, 0xc4, 0xc2, 0xe1, 0x0f, 0x85, 0x81, 0x40, 0xb4, 0x66, 0x62, 0x87,
+	0xc5, 0xdc, 0x05, 0xfe, 0x95, 0x37, 0xa1, 0xd0, 0x89, 0xf5, 0x1f, 0xb1, 0x57, 0x4c, 0x56, 0xc4,
+	0x47, 0xf2, 0xa1, 0xdc, 0xa2, 0x58, 0x4b, 0x7b, 0xe6, 0x4c, 0xbf, 0x83, 0x51, 0x26, 0x66, 0xc2,
+	0x6b, 0xa6, 0x11, 0x9b, 0x2f, 0x38, 0xf6, 0xee, 0x9e, 0x39, 0xe3, 0xdc, 0x1f, 0x89, 0x6e, 0x7c,
+	0x0c, 0x25, 0x09, 0x78, 0x21, 0x3e, 0xff, 0x5f, 0xb2, 0x50, 0xde, 0x55, 0x35, 0xa5, 0x78, 0xaf,
+	0x8b, 0x82, 0xb9, 0x87, 0x72, 0x8a, 0x8c, 0x2c, 0x83, 0x56, 0x51, 0x01, 0x92, 0x0b, 0x68, 0xed,
+	0x1b, 0x16, 0xd0, 0x4d, 0x40, 0xad, 0xf0, 0xc8, 0xb1, 0xe8, 0x0a, 0x9f, 0x8d, 0x3d, 0x3c, 0x3b,
+	0x96, 0xf0, 0x3e, 0x59, 0x76, 0x1f, 0xc8, 0x7d, 0x7b, 0xf7, 0x81, 0xfc, 0x4a, 0xf7, 0x81, 0xbf,
+	0x36, 0x06, 0xff, 0x37, 0x92, 0xa3, 0x0d, 0xd7, 0x34, 0x92, 0x95, 0x89, 0x4c, 0x1e, 0x64, 0x8f,
+	0xed, 0x73, 0xa4, 0xfb, 0x0c, 0xea, 0x72, 0x98, 0x45, 0xc7, 0x20, 0xf5, 0x3e, 0x46, 0xe0, 0xa8,
+	0x7a, 0x56, 0x8b, 0xd4, 0x64, 0x7a, 0x87, 0x56, 0xbe, 0x79, 0x87, 0x1a, 0xbf, 0x97, 0x01, 0x5d,
+	0x5c, 0x82, 0x1f, 0xcc, 0x5d, 0x77, 0x68, 0x9f, 0x11, 0x23, 0x40, 0x3e, 0xc8, 0x35, 0xb8, 0x49,
+	0xef, 0xa5, 0x51, 0x8d, 0x23, 0xe2, 0x9e, 0xaf, 0x7c, 0xaa, 0xbd, 0xb6, 0xf2, 0xa9, 0xf6, 0xea,
+	0x27, 0xe0, 0xaf, 0x40, 0x45, 0x7d, 0xe8, 0xcc, 0x85, 0x33, 0x30, 0x93, 0x37, 0xce, 0xc4, 0x68,
+	0x79, 0x1b, 0x1f, 0x79, 0xe1, 0xe9, 0x5f, 0xbb, 0xf6, 0xfd, 0x41, 0x06, 0x36, 0x45, 0xfb, 0xc4,
+	0x13, 0x1d, 0x21, 0xc2, 0x6f, 0x42, 0xfe, 0x68, 0x6e, 0x06, 0x32, 0x7c, 0x0a, 0x4f, 0x90, 0xf9,
+	0xfb, 0x2b, 0x77, 0xc4, 0x6b, 0xe2, 0x8f, 0xe1, 0x4a, 0xe1, 0x57, 0xee, 0x3e, 0x55, 0xa6, 0x8b,
+	0x20, 0x46, 0x59, 0xe2, 0xd2, 0xf4, 0x2d, 0x33, 0x84, 0x91, 0xe4, 0x91, 0x3c, 0x03, 0x1a, 0x0a,
+	0xa9, 0xcd, 0x76, 0x10, 0x78, 0x3e, 0xad, 0xfc, 0x1a, 0xe3, 0x09, 0x3c, 0x88, 0xa4, 0x46, 0xab,
+	0x20, 0x62, 0x40, 0xf2, 0xa4, 0xd1, 0x81, 0x8d, 0x74, 0x5b, 0xb9, 0x2b, 0x5e, 0x91, 0xab, 0x38,
+	0xa4, 0x6b, 0xf6, 0x8d, 0x94, 0x7a, 0x24, 0xd5, 0x2b, 0x26, 0x49, 0x8d, 0xbf, 0x9b, 0x81, 0x6b,
+	0x82, 0x62, 0xf1, 0xe5, 0x90, 0xfe, 0xae, 0xf2, 0x3a, 0xed, 0x1b, 0xdf, 0x17, 0x11, 0x19, 0x86,
+	0x45, 0x40, 0x07, 0x35, 0xdc, 0x13, 0x3c, 0xb0, 0x1c, 0xf6, 0xbe, 0xec, 0xbb, 0xd6, 0x63, 0xfb,
+	0x9c, 0xfb, 0xa5, 0x57, 0x3c, 0xfb, 0x34, 0xc6, 0xf3, 0xd1, 0x29, 0x7b, 0xf4, 0x36, 0x78, 0xdf,
+	0x0f, 0x8d, 0x9f, 0xc0, 0x8d, 0x0b, 0x5a, 0x82, 0xdd, 0xbb, 0x07, 0x79, 0xfe, 0xda, 0x89, 0x77,
+	0xee, 0xe5, 0x54, 0xe7, 0x16, 0x33, 0x30, 0x4e, 0x6b, 0xfc, 0x79, 0x16, 0x20, 0x51, 0x0f, 0xfd,
+	0xb2, 0x5d, 0x9f, 0x56, 0x30, 0x82, 0xec, 0x2a, 0x46, 0x70, 0x1b, 0x34, 0x95, 0x4e, 0x89, 0xf3,
+	0x50, 0x4f, 0x08, 0x69, 0xf1, 0xf2, 0x93, 0x54, 0x79, 0x8b, 0x4f, 0x27, 0xa9, 0xf0, 0xaa, 0xe0,
+	0x48, 0xae, 0x9f, 0x6e, 0x14, 0x62, 0x47, 0x52, 0x4a, 0xa3, 0x37, 0x49, 0x9c, 0x73, 0x74, 0xea,
+	0x44, 0xc7, 0xfe, 0x5c, 0xba, 0xd9, 0x85, 0x42, 0x72, 0xbd, 0x2a, 0x4b, 0x7a, 0xca, 0xd1, 0xfc,
+	0xa0, 0x0c, 0xf5, 0x8f, 0xa0, 0x3c, 0xc1, 0x88, 0x1f, 0x91, 0x7d, 0x16, 0x89, 0xb7, 0x08, 0x8d,
+	0xd4, 0xe8, 0x2a, 0x4c, 0x85, 0x95, 0x26, 0x22, 0xa1, 0xdf, 0x86, 0xdc, 0xb1, 0x17, 0x9e, 0x36,
+	0xca, 0xea, 0xc5, 0x32, 0xbd, 0xc5, 0x19, 0x51, 0xe8, 0x1f, 0x40, 0x51, 0x3c, 0x75, 0x13, 0x1c,
+	0xf0, 0xda, 0xaa, 0x95, 0x89, 0xf4, 0x92, 0x4e, 0xff, 0x58, 0xbe, 0x88, 0xab, 0xa8, 0xba, 0xdc,
+	0x8b, 0x97, 0x87, 0x78, 0x18, 0x67, 0xfc, 0xaf, 0x35, 0xc8, 0xff, 0x84, 0xde, 0xa9, 0x7f, 0x0c,
+	0xe5, 0x30, 0x9a, 0x46, 0xaa, 0x8d, 0x5f, 0xac, 0x60, 0xc2, 0x93, 0x89, 0xde, 0xc6, 0x37, 0xa1,
+	0x5c, 0xf7, 0x8d, 0xb4, 0xf8, 0x85, 0x7b, 0x11, 0x0d, 0x5e, 0x72, 0xfd, 0xf2, 0x04, 0xda, 0x7f,
+	0xd1, 0xe0, 0x1f, 0xa6, 0x7d, 0x4e, 0x51, 0x61, 0xc7, 0x38, 0x02, 0xed, 0xbf, 0x31, 0x73, 0x59,
+	0xb2, 0xb3, 0x73, 0x0c, 0xbd, 0x30, 0xb2, 0x4d, 0x54, 0xef, 0xcb, 0xb7, 0xf1, 0x71, 0x1a, 0x77,
+	0x3b, 0xdd, 0x7c, 0xcd, 0x23, 0x19, 0x14, 0x48, 0x24, 0xf1, 0xc1, 0x09, 0x7e, 0x3e, 0x0d, 0x9c,
+	0xc8, 0x1e, 0xdc, 0x13, 0x93, 0xa9, 0x82, 0xf0, 0xde, 0x6a, 0xd9, 0x91, 0x3d, 0x8e, 0x06, 0x5f,
+	0x09, 0x67, 0xcc, 0x32, 0x53, 0x20, 0x86, 0x05, 0xb5, 0x54, 0x77, 0x97, 0x14, 0x8c, 0x83, 0x76,
+	0x17, 0xd5, 0x69, 0x19, 0x45, 0x43, 0xb6, 0xa6, 0x6a, 0xc5, 0xb2, 0x8a, 0xba, 0x2c, 0xa7, 0xe8,
+	0x2f, 0xf2, 0xa4, 0x6c, 0x6b, 0xb3, 0x87, 0x6d, 0xad, 0x60, 0xfc, 0xfe, 0x1a, 0x6c, 0x0c, 0x03,
+	0xd3, 0x0b, 0x4d, 0xfe, 0x22, 0xd8, 0x8b, 0x02, 0xdf, 0xd5, 0x3f, 0x83, 0x52, 0x34, 0x76, 0xd5,
+	0x69, 0x78, 0x45, 0x9e, 0x7f, 0x0b, 0xa4, 0x77, 0x87, 0x63, 0x6e, 0x88, 0x28, 0x46, 0xfc, 0x43,
+	0x7f, 0x17, 0xf2, 0x87, 0xf6, 0x91, 0xe3, 0x09, 0x59, 0xe4, 0xca, 0x62, 0xc6, 0x1d, 0x44, 0x62,
+	0xe0, 0x59, 0xa2, 0xd2, 0xdf, 0xc7, 0x28, 0x4f, 0x53, 0x29, 0xb4, 0x25, 0x6f, 0x04, 0x95, 0x8a,
+	0x10, 0x8b, 0xc1, 0x65, 0x39, 0x9d, 0xfe, 0x31, 0xc6, 0x7d, 0x74, 0xdd, 0x43, 0x73, 0x7c, 0xd2,
+	0xc8, 0xa9, 0x2b, 0x3f, 0xc9, 0xc3, 0x04, 0xfe, 0xd1, 0x25, 0x16, 0xd3, 0x1a, 0x77, 0xa1, 0x28,
+	0x1a, 0x8b, 0x03, 0xb0, 0xd3, 0x7e, 0xd8, 0x11, 0x03, 0xd9, 0xea, 0xef, 0xed, 0x75, 0x86, 0x3c,
+	0x6a, 0x05, 0xeb, 0x77, 0xbb, 0x3b, 0xcd, 0xd6, 0x63, 0x6d, 0x6d, 0xa7, 0x04, 0x05, 0xce, 0x70,
+	0x31, 0xd4, 0xcd, 0xfa, 0x42, 0x07, 0xf4, 0xfb, 0x90, 0x9b, 0xfa, 0x96, 0x1c, 0x9e, 0xd7, 0x57,
+	0xf6, 0x52, 0x49, 0xf3, 0x0b, 0x21, 0xe6, 0x30, 0x3e, 0x85, 0x7a, 0x1a, 0xae, 0x68, 0xb1, 0x6a,
+	0x50, 0x66, 0xed, 0xe6, 0xee, 0xa8, 0xdf, 0x43, 0xdd, 0x11, 0xea, 0x92, 0x28, 0xf9, 0x94, 0x75,
+	0x48, 0xf1, 0xf4, 0x6b, 0xa0, 0x2d, 0x0e, 0x8c, 0xfe, 0x10, 0xd6, 0x51, 0x12, 0x77, 0x6d, 0x2e,
+	0x33, 0x25, 0x53, 0x76, 0x6b, 0xc5, 0x48, 0x0a, 0x32, 0x9a, 0xb1, 0xfa, 0x38, 0x95, 0x36, 0xfe,
+	0x06, 0xe8, 0xcb, 0x23, 0xf8, 0xcb, 0x2b, 0xfe, 0x7f, 0x66, 0x20, 0xb7, 0xef, 0x9a, 0xf8, 0xf4,
+	0x5e, 0x84, 0xa8, 0xc8, 0xa8, 0xde, 0x37, 0xb4, 0xc1, 0x71, 0x59, 0x10, 0x4e, 0x7f, 0x1b, 0xb2,
+	0xd1, 0x58, 0x46, 0x84, 0xb8, 0x76, 0xc1, 0xe2, 0xc3, 0x68, 0x6d, 0xd1, 0xd8, 0xc5, 0xf0, 0xa3,
+	0x96, 0x25, 0x5f, 0x50, 0x08, 0xa6, 0x86, 0x2a, 0x96, 0x5d, 0x0c, 0xae, 0xe1, 0x88, 0xc0, 0x76,
+	0x48, 0x82, 0x81, 0xeb, 0xac, 0xb1, 0x9b, 0x7e, 0x4e, 0xc3, 0x95, 0x31, 0x71, 0x81, 0xd6, 0x18,
+	0xe3, 0x05, 0xd7, 0x22, 0x74, 0x51, 0x9b, 0x7b, 0xe4, 0x45, 0x18, 0x0a, 0xa5, 0x44, 0x05, 0xe5,
+	0xfa, 0x39, 0xb9, 0x22, 0x86, 0xe2, 0x01, 0xe7, 0x2c, 0xb0, 0x67, 0x66, 0x10, 0xab, 0x23, 0xd0,
+	0xff, 0x8a, 0x00, 0x18, 0xd1, 0x0d, 0x4b, 0x37, 0xde, 0xc1, 0xf5, 0x4d, 0x97, 0x4d, 0x43, 0x7e,
+	0xad, 0x78, 0xb8, 0x2f, 0x30, 0xc6, 0x9f, 0x65, 0xa1, 0xa2, 0xb4, 0x47, 0xff, 0x10, 0x4a, 0xd6,
+	0xd8, 0x5d, 0xc1, 0x0f, 0x15, 0xa2, 0xbb, 0xbb, 0x72, 0x0b, 0x5a, 0xfc, 0x83, 0x1e, 0xf9, 0xd9,
+	0xd1, 0xe8, 0x99, 0x19, 0x38, 0x3c, 0x62, 0xc7, 0x9a, 0x6a, 0x7a, 0x1a, 0xd8, 0xd1, 0x13, 0x89,
+	0xc1, 0x70, 0xc3, 0xa1, 0x92, 0xa6, 0x1b, 0xb1, 0xe8, 0x52, 0x36, 0x15, 0xdf, 0x93, 0x03, 0x31,
+	0x3e, 0xb0, 0xc0, 0x23, 0xa9, 0x7d, 0x66, 0x8f, 0xe7, 0x91, 0xbc, 0x11, 0xd7, 0x64, 0x87, 0x08,
+	0x88, 0xa4, 0x02, 0xaf, 0x6f, 0x23, 0xaf, 0x33, 0x5d, 0xd7, 0xa7, 0xeb, 0x4b, 0x5e, 0x35, 0x04,
+	0xed, 0xc6, 0x70, 0x1e, 0xba, 0x58, 0xa6, 0xf0, 0x85, 0x8f, 0x1f, 0x1d, 0xdb, 0xf2, 0x1e, 0x29,
+	0xe3, 0xa1, 0x21, 0x68, 0xb7, 0xd5, 0xc5, 0x95, 0x42, 0x68, 0xe3, 0x77, 0x33, 0x50, 0x14, 0x23,
+	0x80, 0xda, 0x77, 0x0c, 0x34, 0xf3, 0xa4, 0xc9, 0x3a, 0x68, 0x61, 0x11, 0xaf, 0x78, 0x1e, 0xb2,
+	0x66, 0x4f, 0xf0, 0x49, 0xd6, 0x7e, 0xd2, 0x7f, 0xdc, 0xe6, 0xaa, 0xe1, 0xdd, 0x76, 0xef, 0xa7,
+	0x5a, 0x96, 0x5b, 0x47, 0xda, 0xfb, 0x4d, 0x86, 0x5c, 0xb2, 0x02, 0xc5, 0xf6, 0xe7, 0xed, 0xd6,
+	0x01, 0xb1, 0xc9, 0x3a, 0xc0, 0x6e, 0xbb, 0xd9, 0xed, 0xf6, 0xd1, 0x8a, 0xa0, 0x15, 0x50, 0x5f,
+	0xdf, 0x62, 0x6d, 0xb4, 0x28, 0x34, 0x5b, 0xad, 0xfe, 0x41, 0x6f, 0xa8, 0x15, 0xb1, 0xc6, 0x26,
+	0x9a, 0x0b, 0x62, 0x10, 0xc5, 0xa8, 0xdc, 0x65, 0xfd, 0xfd, 0x18, 0x52, 0xde, 0x29, 0xa3, 0x76,
+	0x82, 0xe6, 0xca, 0xf8, 0x3f, 0x1a, 0xd4, 0xd3, 0x4b, 0x53, 0xff, 0x04, 0x4a, 0x96, 0x95, 0x9a,
+	0xe3, 0x9b, 0xab, 0x96, 0xf0, 0xdd, 0x5d, 0x4b, 0x4e, 0x33, 0xff, 0x40, 0x37, 0x36, 0xbe, 0x91,
+	0xd6, 0x96, 0x36, 0x92, 0xdc, 0x46, 0x3f, 0x84, 0x75, 0x11, 0x8f, 0x26, 0x8e, 0x22, 0x93, 0xda,
+	0x25, 0x2d, 0x42, 0xee, 0x0a, 0xdc, 0xa3, 0x4b, 0xac, 0x3e, 0x4e, 0x41, 0xf4, 0xef, 0x43, 0xdd,
+	0x24, 0x6d, 0x54, 0x9c, 0x3f, 0xa7, 0xde, 0x87, 0x9a, 0x88, 0x53, 0xb2, 0xd7, 0x4c, 0x15, 0x80,
+	0x0b, 0xd1, 0x0a, 0xfc, 0x59, 0x92, 0x39, 0x9f, 0xb2, 0x81, 0x06, 0xfe, 0x4c, 0xc9, 0x5b, 0xb5,
+	0x94, 0x34, 0xbe, 0xb7, 0x14, 0x2d, 0x4f, 0xf4, 0x7d, 0xf1, 0x96, 0xe5, 0xcd, 0xa6, 0xfb, 0x03,
+	0x86, 0xf1, 0x1e, 0x27, 0x49, 0x7c, 0xe4, 0xc0, 0x1b, 0x9c, 0xe8, 0xff, 0xe2, 0xb5, 0x46, 0xad,
+	0x95, 0xb9, 0xc0, 0x8c, 0x53, 0xfa, 0xfb, 0x00, 0xd4, 0x4e, 0x9e, 0xa7, 0x94, 0x72, 0x5d, 0x0a,
+	0xfc, 0x99, 0xcc, 0x52, 0xb6, 0x64, 0x42, 0x69, 0x1e, 0x7f, 0xaa, 0x5e, 0x5e, 0x6e, 0x1e, 0xf9,
+	0x55, 0x25, 0xcd, 0xa3, 0x64, 0xd2, 0x3c, 0x9e, 0x0d, 0x96, 0x9a, 0x27, 0x73, 0x81, 0x19, 0xa7,
+	0xe2, 0xe6, 0xf1, 0x3c, 0x95, 0xc5, 0xe6, 0xc9, 0x2c, 0x65, 0x4b, 0x26, 0x70, 0xda, 0x16, 0xae,
+	0xb1, 0xd5, 0x0b, 0xaf, 0xb1, 0x38, 0x6d, 0xe9, 0x8b, 0xec, 0xf7, 0xa1, 0x1e, 0x1e, 0xfb, 0xa7,
+	0x0a, 0x03, 0xa9, 0xa9, 0xb9, 0x07, 0xc7, 0xfe, 0xa9, 0xca, 0x41, 0x6a, 0xa1, 0x0a, 0xc0, 0xd6,
+	0xf2, 0x2e, 0x92, 0x2c, 0x58, 0x57, 0x5b, 0x4b, 0x3d, 0x44, 0xe9, 0x0f, 0x5b, 0x6b, 0xca, 0x04,
+	0x0e, 0x4a, 0xa2, 0x9d, 0x0c, 0x1b, 0xeb, 0xea, 0xa0, 0x74, 0xa5, 0x66, 0x12, 0x6b, 0x82, 0x58,
+	0x4f, 0x19, 0xe2, 0xda, 0x9a, 0x7b, 0x6a, 0x36, 0x4d, 0x5d, 0x5b, 0x07, 0x5e, 0x2a, 0x63, 0x95,
+	0x93, 0x8a, 0xac, 0xc9, 0xae, 0x08, 0xed, 0xaf, 0xe6, 0xb6, 0x37, 0xb6, 0x1b, 0x1b, 0xcb, 0xbb,
+	0x62, 0x20, 0x70, 0xc9, 0xae, 0x90, 0x90, 0x78, 0x5d, 0xc7, 0xd9, 0xf5, 0xc5, 0x75, 0xad, 0x64,
+	0xae, 0x5a, 0x4a, 0x3a, 0xd9, 0x50, 0x71, 0xde, 0xcb, 0x4b, 0x1b, 0x4a, 0xc9, 0x5c, 0x33, 0x55,
+	0x00, 0x8e, 0x94, 0x68, 0x39, 0x0d, 0x6e, 0xca, 0xfd, 0x8f, 0xb7, 0x5a, 0x8c, 0x2e, 0x8c, 0xe3,
+	0x14, 0xae, 0xd5, 0xc0, 0xc6, 0x0b, 0x8c, 0x58, 0x0a, 0x57, 0xd4, 0xb5, 0xca, 0x08, 0x13, 0x6f,
+	0xa5, 0x20, 0x49, 0x62, 0x53, 0xe5, 0x16, 0x14, 0x37, 0x81, 0xab, 0x6a, 0x53, 0xc5, 0x26, 0xe4,
+	0x28, 0x6c, 0xea, 0x58, 0x05, 0x60, 0xad, 0x7c, 0x4f, 0x89, 0xbc, 0xd7, 0x52, 0x67, 0x2e, 0x6e,
+	0xa4, 0x38, 0x67, 0xc5, 0x4a, 0x92, 0xfa, 0xaf, 0xc1, 0x75, 0x69, 0x4a, 0x98, 0x2a, 0x77, 0x06,
+	0xde, 0x61, 0x1e, 0x6d, 0xe2, 0x65, 0xd9, 0x74, 0x22, 0x5b, 0xbc, 0x59, 0x3c, 0xba, 0xc4, 0xae,
+	0x05, 0xab, 0x51, 0xc6, 0xff, 0xc8, 0x43, 0x51, 0xf0, 0x51, 0x8c, 0x1c, 0x2c, 0xd8, 0xf9, 0x6e,
+	0x73, 0xd8, 0xdc, 0x69, 0x0e, 0x50, 0x00, 0xd3, 0xa1, 0xce, 0xf9, 0x79, 0x0c, 0xcb, 0x20, 0x8f,
+	0x27, 0x86, 0x1e, 0x83, 0xd6, 0x90, 0xc7, 0x8b, 0xbc, 0x3c, 0x66, 0x71, 0x16, 0x4d, 0xb2, 0x3c,
+	0x23, 0x07, 0xd0, 0x83, 0x63, 0xca, 0xc5, 0xd3, 0x79, 0x25, 0x0b, 0xb7, 0x82, 0x16, 0x92, 0x2c,
+	0x1c, 0x50, 0x8c, 0xb3, 0x48, 0x33, 0xa9, 0x0e, 0xf5, 0x21, 0x3b, 0xe8, 0xb5, 0x92, 0x7a, 0xca,
+	0x98, 0x49, 0x14, 0xf3, 0xa4, 0xd3, 0x7e, 0xaa, 0x01, 0x66, 0xe2, 0xa5, 0x50, 0xba, 0x82, 0x22,
+	0x24, 0x15, 0x42, 0xc9, 0xaa, 0x7e, 0x0d, 0x2e, 0x0f, 0x1e, 0xf5, 0x9f, 0x8e, 0x78, 0xa6, 0xb8,
+	0x0b, 0x35, 0xb4, 0x92, 0x2b, 0x08, 0x5e, 0x7c, 0x1d, 0xab, 0x24, 0xa8, 0x24, 0x1c, 0x68, 0xeb,
+	0x58, 0x25, 0xc1, 0x86, 0xfc, 0x4c, 0xd5, 0xb0, 0x2b, 0x3c, 0x6b, 0xbf, 0x7b, 0xb0, 0xd7, 0x1b,
+	0x68, 0x1b, 0xd8, 0x08, 0x82, 0xf0, 0x96, 0xeb, 0x71, 0x31, 0xc9, 0x49, 0x7c, 0x99, 0x0e, 0x67,
+	0x84, 0x3d, 0x6d, 0xb2, 0x5e, 0xa7, 0xf7, 0x70, 0xa0, 0x6d, 0xc6, 0x25, 0xb7, 0x19, 0xeb, 0xb3,
+	0x81, 0x76, 0x25, 0x06, 0x0c, 0x86, 0xcd, 0xe1, 0xc1, 0x40, 0xbb, 0x1a, 0xb7, 0x72, 0x9f, 0xf5,
+	0x5b, 0xed, 0xc1, 0xa0, 0xdb, 0x19, 0x0c, 0xb5, 0x6b, 0xe8, 0xe8, 0x90, 0xb4, 0x48, 0x12, 0x37,
+	0x94, 0x86, 0xb2, 0x87, 0xed, 0xa1, 0x76, 0x3d, 0x6e, 0x46, 0xab, 0xdf, 0xc5, 0x70, 0xd2, 0xfd,
+	0x9e, 0x76, 0x03, 0x89, 0xc8, 0x55, 0x40, 0xf4, 0xe6, 0x25, 0x6c, 0xd7, 0x41, 0x4f, 0x05, 0xdd,
+	0x54, 0x96, 0xc6, 0xa0, 0xfd, 0x93, 0x83, 0x76, 0xaf, 0xd5, 0xd6, 0x5e, 0x4e, 0x96, 0x46, 0x0c,
+	0xbb, 0x15, 0x2f, 0x8d, 0x18, 0xf4, 0x4a, 0x5c, 0xa7, 0x04, 0x0d, 0xb4, 0x2d, 0x2c, 0x4f, 0xb4,
+	0xa3, 0xd7, 0x6b, 0xb7, 0x86, 0xd8, 0xd7, 0x57, 0xe3, 0x51, 0x3c, 0xd8, 0x7f, 0xc8, 0x30, 0x6a,
+	0x9e, 0x81, 0x10, 0xd6, 0xee, 0x35, 0xf7, 0xe4, 0x6c, 0xbf, 0xa6, 0x88, 0x1c, 0x43, 0xd6, 0x79,
+	0xf8, 0xb0, 0xcd, 0xb8, 0xa3, 0x02, 0x5f, 0x58, 0x02, 0xf2, 0x3d, 0xfd, 0x65, 0xb8, 0xce, 0xda,
+	0x0f, 0x58, 0x7b, 0xf0, 0x68, 0x24, 0x5d, 0x11, 0x3a, 0x5f, 0xb4, 0x77, 0xf9, 0x12, 0x78, 0x63,
+	0xa7, 0x4a, 0x3f, 0x13, 0x21, 0xc4, 0x08, 0xe3, 0xc7, 0xa0, 0xab, 0xf1, 0xd6, 0x45, 0xf4, 0x4e,
+	0x1d, 0x72, 0xf8, 0x10, 0x4a, 0x46, 0xe9, 0xc0, 0x6f, 0xbc, 0xc3, 0xce, 0xe6, 0x87, 0x64, 0x1b,
+	0x4f, 0x9e, 0xf1, 0xab, 0x20, 0xe3, 0x9f, 0x66, 0xa0, 0x9e, 0x16, 0x21, 0x28, 0x0c, 0xfa, 0x64,
+	0x84, 0xde, 0xb0, 0x14, 0x16, 0x32, 0x8c, 0xc3, 0xa0, 0x4f, 0x7a, 0x7e, 0x44, 0x71, 0x21, 0xc3,
+	0x54, 0x50, 0xbb, 0xb5, 0x85, 0xa0, 0x76, 0x1d, 0xb8, 0x9c, 0x0a, 0x47, 0x9f, 0x0a, 0xca, 0xd9,
+	0x88, 0x43, 0x4d, 0x2f, 0xb4, 0x9f, 0xe9, 0xe1, 0x72, 0x9f, 0x34, 0xc8, 0x26, 0x51, 0xf8, 0xf0,
+	0xd3, 0x78, 0x04, 0xb5, 0x94, 0xc4, 0x42, 0xea, 0x99, 0x49, 0xba, 0xa5, 0x25, 0x67, 0xf2, 0xfc,
+	0x66, 0xa2, 0xea, 0xb1, 0xaa, 0xca, 0x2f, 0xdf, 0xb9, 0x24, 0x7a, 0x34, 0x27, 0xbe, 0xd1, 0x8c,
+	0x2b, 0xc2, 0x41, 0x4a, 0x50, 0x87, 0x7e, 0x1e, 0x87, 0x9b, 0x69, 0x1e, 0x9c, 0x0c, 0xe2, 0xee,
+	0xa8, 0x20, 0x54, 0x25, 0xd0, 0x33, 0xec, 0x07, 0x8f, 0x91, 0x40, 0x3c, 0xbb, 0x4b, 0x20, 0xc6,
+	0x2b, 0x50, 0x7e, 0x70, 0x22, 0xdd, 0x9d, 0xd4, 0xe0, 0xa8, 0x65, 0x1e, 0xfb, 0x01, 0x7f, 0x9a,
+	0xa7, 0x9e, 0x04, 0x58, 0x22, 0x27, 0x6a, 0xfe, 0x33, 0x06, 0x7c, 0x39, 0xe0, 0xcf, 0x18, 0xc4,
+	0xbf, 0x9c, 0xb3, 0xa6, 0xfe, 0x72, 0xce, 0x6b, 0xa2, 0xb0, 0xac, 0x7a, 0xca, 0xc7, 0x75, 0xf1,
+	0xd2, 0xd1, 0xcd, 0x16, 0xff, 0x33, 0x7b, 0x62, 0x07, 0x81, 0x2d, 0x7f, 0xd1, 0x61, 0x89, 0x38,
+	0x45, 0x44, 0x37, 0x35, 0x7b, 0xd2, 0xc8, 0xab, 0x27, 0x4e, 0x3a, 0x06, 0x14, 0xe2, 0x8d, 0x3f,
+	0xcb, 0x41, 0x45, 0x91, 0x06, 0xbf, 0xd5, 0xf2, 0xbb, 0x89, 0xbf, 0x47, 0x20, 0x83, 0xf8, 0x88,
+	0xe7, 0xf8, 0x31, 0x20, 0x35, 0x57, 0xd9, 0x85, 0xb9, 0xc2, 0xe8, 0x23, 0xdc, 0xdb, 0x5a, 0x98,
+	0x46, 0x64, 0x32, 0xad, 0xfb, 0xcf, 0x3f, 0xc7, 0x3a, 0xf7, 0x01, 0x54, 0x15, 0xc5, 0xb8, 0x0c,
+	0x55, 0xb6, 0x48, 0x5f, 0x49, 0x94, 0xe4, 0x21, 0xbe, 0xe8, 0x9a, 0x9c, 0x8c, 0xac, 0x43, 0x69,
+	0x09, 0xc9, 0x4f, 0x4e, 0x76, 0x0f, 0x79, 0x00, 0x87, 0x58, 0x00, 0xe2, 0x3a, 0xa4, 0xd2, 0x44,
+	0x8a, 0x39, 0xb7, 0xa1, 0x38, 0x39, 0xe1, 0x6f, 0x7d, 0xcb, 0x5b, 0xd9, 0x55, 0x43, 0x5e, 0x98,
+	0x9c, 0xd0, 0x23, 0xdf, 0x4f, 0x41, 0x5b, 0x30, 0xbb, 0x84, 0x0d, 0x58, 0xd9, 0xa8, 0xf5, 0xb4,
+	0x05, 0x06, 0xc3, 0x60, 0x6d, 0x0a, 0x21, 0xc1, 0x0c, 0x47, 0xfc, 0x09, 0x11, 0xc5, 0x85, 0xe2,
+	0x51, 0x56, 0x37, 0x38, 0xae, 0x19, 0x0e, 0x08, 0x83, 0x8b, 0xd5, 0x80, 0xaa, 0xb2, 0x76, 0x79,
+	0x34, 0xb0, 0x32, 0x4b, 0xc1, 0xf4, 0xfb, 0x50, 0x9d, 0x9c, 0xf0, 0xb5, 0x30, 0xf4, 0xf7, 0x6c,
+	0xf1, 0x2c, 0x64, 0x73, 0x71, 0x15, 0x90, 0xeb, 0x7f, 0x8a, 0x52, 0x7f, 0x17, 0xf4, 0xc0, 0x8e,
+	0x6c, 0x8f, 0x7a, 0x62, 0xd9, 0xa6, 0xe5, 0x3a, 0x9e, 0x4d, 0x42, 0x68, 0x96, 0x6d, 0xc4, 0x98,
+	0x5d, 0x81, 0xc0, 0x88, 0xfa, 0x51, 0xe4, 0x0a, 0x89, 0x53, 0xed, 0xeb, 0x70, 0xd8, 0x65, 0x88,
+	0x32, 0x98, 0x08, 0xec, 0x36, 0x1c, 0x76, 0xd1, 0x91, 0x25, 0xbe, 0xc1, 0x93, 0x23, 0x0b, 0x4f,
+	0xd1, 0x43, 0x2c, 0xe9, 0x44, 0xcc, 0x5f, 0x76, 0xc6, 0x69, 0x72, 0xa7, 0xf6, 0x1c, 0x19, 0x26,
+	0x94, 0xbe, 0x8d, 0x3f, 0xc9, 0x40, 0x3d, 0xb9, 0x8b, 0x20, 0x1b, 0x41, 0xa3, 0x62, 0xf2, 0x0b,
+	0x2a, 0x8d, 0xc5, 0xeb, 0x0a, 0x92, 0xa0, 0xa5, 0x99, 0xc7, 0x22, 0x5f, 0x15, 0x48, 0x6e, 0x95,
+	0xad, 0x25, 0xbb, 0xf2, 0xf7, 0x1d, 0x18, 0x64, 0xd1, 0x63, 0x83, 0xf4, 0x5e, 0x78, 0x7c, 0xf3,
+	0x3b, 0x32, 0x3f, 0xb8, 0xc9, 0xcb, 0x0a, 0xdd, 0xe5, 0x28, 0x80, 0xca, 0x3e, 0xeb, 0xec, 0x35,
+	0xd9, 0x4f, 0xc9, 0x7f, 0x8e, 0x04, 0x9c, 0x07, 0x7d, 0xd6, 0xee, 0x3c, 0xec, 0x11, 0x20, 0x87,
+	0xb9, 0x5a, 0x8f, 0xda, 0xad, 0xc7, 0x5a, 0x9e, 0x14, 0x64, 0x49, 0x6b, 0x9b, 0x96, 0xf5, 0xe0,
+	0x44, 0x8d, 0x60, 0x95, 0x49, 0x45, 0xb0, 0x4a, 0x87, 0x4f, 0x58, 0x5b, 0x0c, 0x9f, 0xa0, 0xc7,
+	0x2c, 0x25, 0xe6, 0x4f, 0x18, 0x65, 0x0e, 0x03, 0xbe, 0xa5, 0xef, 0x9e, 0x69, 0x6e, 0x40, 0x04,
+	0xc6, 0xcf, 0x33, 0xa0, 0xa7, 0x1a, 0xc2, 0xaf, 0x43, 0xdf, 0xb5, 0x2d, 0x9f, 0x40, 0x43, 0x04,
+	0xc2, 0xe6, 0x54, 0x8a, 0x45, 0x40, 0x8c, 0xee, 0x15, 0x3f, 0x71, 0x55, 0x4e, 0xc2, 0xde, 0xe9,
+	0xef, 0x01, 0x8f, 0x44, 0x8c, 0x2b, 0x34, 0xad, 0x6d, 0x52, 0x98, 0x15, 0x4b, 0x68, 0x92, 0xd0,
+	0xc3, 0x6a, 0x48, 0x65, 0x6e, 0x4c, 0x58, 0x4f, 0x26, 0x90, 0x18, 0x98, 0xf1, 0x3b, 0x19, 0xb8,
+	0x9c, 0x5e, 0x1b, 0xbf, 0x58, 0x2f, 0xd3, 0xf1, 0xa3, 0xb3, 0x8b, 0xf1, 0xa3, 0x57, 0x2d, 0xad,
+	0xdc, 0xca, 0xa5, 0xf5, 0x5b, 0x19, 0xd8, 0x54, 0x46, 0x3f, 0xb9, 0xc0, 0xfe, 0x15, 0xb5, 0x4c,
+	0x09, 0x23, 0x9d, 0x4b, 0x85, 0x91, 0x36, 0x7e, 0x3f, 0x03, 0x57, 0x17, 0x5a, 0xc2, 0xec, 0xbf,
+	0xd2, 0xb6, 0xa4, 0xc3, 0x4d, 0x93, 0xed, 0x80, 0xfb, 0x6e, 0xf3, 0xa7, 0xda, 0x7a, 0x3a, 0x7e,
+	0x34, 0x45, 0xb9, 0xf8, 0x77, 0xe9, 0x46, 0x5a, 0xc9, 0xcb, 0x4b, 0xf4, 0xd2, 0x4f, 0x44, 0x36,
+	0x69, 0x3e, 0x5b, 0xf9, 0x6c, 0x53, 0xa5, 0x5b, 0xc9, 0xc7, 0xd7, 0xbe, 0x1d, 0x1f, 0xbf, 0x0f,
+	0xd5, 0xb8, 0xe0, 0x5d, 0x7b, 0x92, 0x56, 0x13, 0x2d, 0x84, 0x19, 0x4c, 0x51, 0x1a, 0x1f, 0xc2,
+	0x46, 0xd2, 0x8b, 0x96, 0x88, 0xd9, 0xf9, 0x0a, 0xb7, 0x1b, 0xca, 0x88, 0x9e, 0x7c, 0xa4, 0xc1,
+	0xb3, 0x4f, 0x05, 0x81, 0xf1, 0x01, 0xd4, 0x92, 0x5c, 0xc8, 0x5c, 0x05, 0x2b, 0xce, 0x5c, 0xcc,
+	0x8a, 0x1f, 0xa8, 0x5c, 0x33, 0xfe, 0x59, 0x23, 0xd7, 0x52, 0x27, 0xb3, 0xe8, 0xbb, 0x96, 0x44,
+	0x61, 0x03, 0x94, 0xb9, 0x2c, 0x7a, 0xf6, 0x29, 0x2d, 0xd3, 0x53, 0x51, 0x4e, 0xd3, 0xb2, 0x84,
+	0x1f, 0xd0, 0xaa, 0x18, 0x75, 0xd7, 0xa1, 0x84, 0x4f, 0x4b, 0xd4, 0x02, 0x66, 0x01, 0xaf, 0xf6,
+	0x75, 0xe1, 0x15, 0x79, 0x91, 0xcf, 0x10, 0x61, 0xe5, 0xcf, 0x9e, 0xe5, 0x92, 0x9f, 0x3d, 0xfb,
+	0x48, 0x70, 0x49, 0xdc, 0xb2, 0xa2, 0xe6, 0xd8, 0x37, 0x08, 0x6d, 0xc8, 0xf8, 0x89, 0x90, 0xd0,
+	0xfe, 0x4a, 0x38, 0x66, 0xe2, 0xa7, 0xb1, 0x03, 0x15, 0xe5, 0x96, 0x8e, 0xe2, 0x94, 0xa2, 0xe1,
+	0x0a, 0xd3, 0x71, 0xc0, 0x92, 0x01, 0x62, 0x95, 0x44, 0xc1, 0x15, 0x1a, 0xff, 0x11, 0x00, 0x12,
+	0xdc, 0x37, 0x86, 0x95, 0x7e, 0x21, 0x47, 0xa3, 0x0f, 0xd1, 0x53, 0x68, 0x76, 0x3e, 0x4a, 0x72,
+	0x64, 0x57, 0xe6, 0xa8, 0x22, 0xd5, 0x30, 0x79, 0x26, 0xb9, 0xec, 0x40, 0x92, 0x5b, 0xe9, 0x40,
+	0xf2, 0x41, 0x62, 0x20, 0xcf, 0xab, 0xef, 0x99, 0x92, 0xbe, 0xdc, 0x5d, 0xb0, 0x8e, 0xeb, 0x6d,
+	0xa8, 0xc7, 0x11, 0x81, 0xd5, 0x47, 0xb7, 0xb7, 0x96, 0x73, 0x4a, 0x32, 0x1e, 0xed, 0xd1, 0x54,
+	0x93, 0x8a, 0x60, 0x13, 0x4d, 0x85, 0x66, 0x90, 0x04, 0x9b, 0xa2, 0x2a, 0xd8, 0x0c, 0xa7, 0x5c,
+	0x1f, 0x88, 0x82, 0xcd, 0xbb, 0x70, 0x59, 0xbc, 0x43, 0xc2, 0x0c, 0x38, 0x9c, 0x44, 0xcf, 0x5d,
+	0x4e, 0x45, 0x70, 0xa5, 0xe1, 0x94, 0x6e, 0x0c, 0x48, 0xfe, 0x39, 0x6c, 0x8e, 0x8f, 0x4d, 0xef,
+	0xc8, 0xc6, 0xc0, 0xa5, 0x23, 0xfa, 0x6d, 0x93, 0x11, 0xfa, 0x15, 0x71, 0x51, 0xed, 0xcd, 0xa5,
+	0xc6, 0xb6, 0x88, 0x78, 0x78, 0xe8, 0x92, 0x4f, 0x64, 0xec, 0x66, 0xb4, 0x31, 0x5e, 0x84, 0x2f,
+	0x58, 0x16, 0x61, 0xd1, 0xb2, 0xb8, 0x24, 0x81, 0x55, 0x96, 0x25, 0xb0, 0x1b, 0xff, 0x20, 0x0f,
+	0x05, 0x3e, 0xb0, 0x14, 0xc3, 0x33, 0xf0, 0x67, 0xb1, 0xdb, 0xf2, 0x0a, 0xd9, 0x84, 0x7e, 0xe2,
+	0x11, 0xc5, 0x98, 0xbb, 0x50, 0x40, 0x4b, 0xfc, 0xe4, 0x24, 0x6d, 0xfd, 0x5b, 0x90, 0x0d, 0x50,
+	0x79, 0x6f, 0xe2, 0x87, 0xfe, 0x09, 0x94, 0x91, 0x9e, 0x2b, 0x36, 0x53, 0x77, 0xbc, 0xe5, 0x53,
+	0x1c, 0x8d, 0x79, 0xa6, 0xf8, 0xd6, 0x7f, 0x90, 0xd6, 0xa3, 0xf2, 0x23, 0xf6, 0xc6, 0x52, 0xd6,
+	0x8b, 0x34, 0xaa, 0xbf, 0x0a, 0x5c, 0xb1, 0x16, 0x33, 0xa8, 0xbc, 0x6a, 0x68, 0x5a, 0x62, 0x67,
+	0xa8, 0xc5, 0x33, 0xb9, 0xeb, 0x25, 0xa5, 0x31, 0xca, 0x26, 0xcf, 0x1f, 0xff, 0x90, 0xd6, 0x8a,
+	0x91, 0x41, 0x5e, 0x11, 0x2b, 0x3a, 0x31, 0x41, 0xd9, 0x2c, 0x4b, 0x7a, 0x23, 0x16, 0x97, 0xb2,
+	0xc5, 0x1c, 0x89, 0xb2, 0xc9, 0x84, 0x7e, 0x1f, 0x48, 0x43, 0x26, 0xf3, 0x95, 0x96, 0x86, 0x36,
+	0x61, 0x28, 0x64, 0x44, 0x89, 0x53, 0x7a, 0x4b, 0xf6, 0x33, 0xb0, 0x55, 0x3d, 0xf5, 0xcd, 0x95,
+	0x03, 0xc5, 0x62, 0x95, 0x35, 0xef, 0x2c, 0xe3, 0x79, 0xf4, 0x1d, 0xa8, 0x9a, 0xca, 0xe1, 0xd4,
+	0x80, 0x0b, 0xca, 0x50, 0x68, 0xa8, 0x0c, 0x25, 0x8d, 0x21, 0x6c, 0x05, 0xd3, 0x8a, 0xdc, 0x74,
+	0xb0, 0xe0, 0xd4, 0x29, 0x40, 0x73, 0x4c, 0x80, 0xc8, 0x4d, 0x0c, 0xb0, 0x37, 0x18, 0x5c, 0x5d,
+	0xbd, 0xfc, 0x55, 0xa7, 0xba, 0x1c, 0x77, 0xaa, 0x33, 0xd2, 0x41, 0xb1, 0xd2, 0xf1, 0x05, 0x14,
+	0x17, 0xbb, 0x1f, 0xe1, 0x01, 0xa4, 0x6e, 0xf8, 0x0a, 0x14, 0xe5, 0x2f, 0x14, 0xd0, 0x73, 0x82,
+	0x56, 0x7f, 0x1f, 0x6d, 0xb0, 0x15, 0x28, 0x76, 0x7a, 0x83, 0x61, 0xb3, 0x27, 0xcc, 0xeb, 0x9d,
+	0x9e, 0x30, 0xaf, 0x1b, 0x7f, 0x8c, 0x4e, 0x7a, 0xb1, 0x45, 0xe0, 0x3b, 0x2b, 0x00, 0xe2, 0x9b,
+	0x75, 0x56, 0xbd, 0x59, 0x2f, 0x08, 0x84, 0x6a, 0xa4, 0xa9, 0xf5, 0xb4, 0xd8, 0x15, 0x2e, 0xbf,
+	0x5b, 0xce, 0x7f, 0xcb, 0x77, 0xcb, 0xaa, 0xff, 0x78, 0x21, 0xed, 0x3f, 0xbe, 0xf0, 0x2b, 0x15,
+	0x45, 0xf2, 0xd8, 0x53, 0x7f, 0xa5, 0xe2, 0x42, 0x57, 0xbd, 0xd2, 0xc5, 0xae, 0x7a, 0xf4, 0xdb,
+	0xb7, 0xa8, 0x88, 0x15, 0x6e, 0xd4, 0x22, 0x95, 0x3e, 0x72, 0xe0, 0x39, 0x47, 0xce, 0xb7, 0x60,
+	0x5f, 0xfa, 0x36, 0x6c, 0x4e, 0x4e, 0xe2, 0x08, 0xd0, 0xc9, 0x45, 0xb2, 0x4a, 0xdd, 0x58, 0x89,
+	0x33, 0x7e, 0x3b, 0x03, 0x90, 0xe8, 0xd0, 0x7f, 0x61, 0x45, 0x96, 0xa2, 0x2b, 0xc8, 0x7e, 0x83,
+	0xae, 0xe0, 0x39, 0x31, 0x95, 0x8c, 0xaf, 0xa0, 0x1c, 0x5b, 0x4d, 0xbe, 0xfb, 0x1a, 0x7b, 0xa1,
+	0x2a, 0x7f, 0x5d, 0x2a, 0xf5, 0x62, 0xb3, 0xc3, 0x2f, 0x3a, 0x16, 0xa9, 0xea, 0xb3, 0xcf, 0xa9,
+	0xfe, 0x8c, 0x6b, 0xd6, 0xe2, 0xca, 0x7f, 0xc9, 0x1b, 0x4b, 0x5d, 0xf3, 0xb9, 0xd4, 0x9a, 0x37,
+	0xe6, 0x42, 0x26, 0xfd, 0xc5, 0xab, 0x7e, 0xa1, 0x0e, 0xff, 0x45, 0x46, 0xea, 0xb0, 0xe2, 0xf0,
+	0xd5, 0x17, 0x0a, 0x67, 0xab, 0xd5, 0x70, 0x2f, 0x52, 0xdd, 0x37, 0x5e, 0x6a, 0x73, 0xdf, 0x74,
+	0xa9, 0x7d, 0x13, 0xf2, 0xfc, 0x10, 0xc9, 0x5f, 0x74, 0xa1, 0xe5, 0xf8, 0xe7, 0xfe, 0x32, 0x90,
+	0x61, 0x08, 0x61, 0x94, 0xf7, 0x77, 0x53, 0x96, 0x2b, 0x7f, 0xd5, 0x08, 0x13, 0xa8, 0x53, 0x28,
+	0x27, 0x77, 0xdb, 0x17, 0x1f, 0x93, 0x5f, 0xda, 0xad, 0xf6, 0x7f, 0x67, 0xa0, 0x96, 0xb2, 0x75,
+	0x7d, 0x87, 0xc6, 0x2c, 0x6d, 0xa0, 0xec, 0xf2, 0x06, 0xba, 0x93, 0x78, 0xdc, 0xe5, 0x52, 0xbf,
+	0x85, 0x98, 0x44, 0x9f, 0x97, 0x04, 0xfc, 0x17, 0xba, 0x30, 0x10, 0x8a, 0xcc, 0x91, 0x97, 0xbf,
+	0xd0, 0x65, 0xd9, 0x81, 0x6c, 0x66, 0x03, 0x8a, 0x13, 0xdf, 0x75, 0xd1, 0x19, 0x52, 0x78, 0xa7,
+	0x89, 0x24, 0xfe, 0x70, 0x03, 0x3f, 0x59, 0xb9, 0x6f, 0xab, 0xf2, 0x13, 0x4d, 0xeb, 0x1c, 0x1e,
+	0x7b, 0x9e, 0x19, 0x5f, 0x40, 0x45, 0x31, 0xd6, 0xbd, 0xf0, 0xef, 0xd1, 0xa4, 0x76, 0x50, 0x36,
+	0xbd, 0x83, 0x8c, 0xbf, 0x05, 0xd7, 0x2e, 0xb0, 0xe1, 0x7d, 0x87, 0x21, 0x8e, 0xe3, 0xf1, 0x67,
+	0xbf, 0x5d, 0x3c, 0x7e, 0xe3, 0x9f, 0xaf, 0x41, 0x2d, 0x65, 0x08, 0xff, 0x0e, 0x95, 0xae, 0x3c,
+	0xa5, 0xb3, 0xab, 0x4f, 0xe9, 0xef, 0x12, 0x3f, 0xf2, 0xff, 0xc9, 0xc9, 0x9e, 0x72, 0x68, 0x2d,
+	0xa5, 0x1d, 0x5a, 0xf1, 0x94, 0xac, 0xaa, 0xf5, 0xae, 0xbc, 0xcb, 0x65, 0x56, 0xde, 0xe5, 0x6e,
+	0xc5, 0xbf, 0xe0, 0xdb, 0xd9, 0xe5, 0x7a, 0x89, 0x1a, 0x53, 0x20, 0xe8, 0x0e, 0xcb, 0x25, 0x5c,
+	0xf1, 0x2b, 0xba, 0xfe, 0x64, 0x24, 0xb1, 0x96, 0x70, 0xd2, 0xbd, 0xca, 0x09, 0xf8, 0xcf, 0x81,
+	0x4d, 0x9a, 0x12, 0x6b, 0x74, 0xa0, 0x96, 0xf2, 0x4a, 0x50, 0x7e, 0x2b, 0x3c, 0xa3, 0xfe, 0x56,
+	0x38, 0xfa, 0x84, 0x9e, 0x1e, 0xdb, 0x81, 0xbd, 0x22, 0x4e, 0x33, 0x47, 0xe0, 0xef, 0x57, 0xaa,
+	0x1e, 0x52, 0xfa, 0x3b, 0x90, 0x77, 0x22, 0x7b, 0x2a, 0xef, 0xd9, 0x57, 0x97, 0x9d, 0xa8, 0x48,
+	0x0f, 0xc3, 0x89, 0xd0, 0x1b, 0x49, 0x5b, 0xc4, 0x29, 0x3f, 0x68, 0x9e, 0xb9, 0xe0, 0x07, 0xcd,
+	0xd7, 0x52, 0x8d, 0x5c, 0xf5, 0x9b, 0xe4, 0x71, 0xac, 0xd7, 0xdc, 0x05, 0xb1, 0x5e, 0x31, 0x94,
+	0x45, 0x60, 0xd3, 0xaf, 0x45, 0x5b, 0x2b, 0x9e, 0xeb, 0xc4, 0x38, 0x7c, 0x76, 0x53, 0x14, 0xee,
+	0x5c, 0x2b, 0x15, 0x1f, 0x6f, 0x41, 0x91, 0xff, 0x72, 0xb4, 0xd4, 0x1d, 0x2d, 0x39, 0x5d, 0x4b,
+	0x3c, 0xbe, 0xaa, 0x41, 0x54, 0x5a, 0x11, 0x82, 0x4e, 0x7e, 0x8c, 0xe0, 0xe2, 0x57, 0xfe, 0xcc,
+	0xa9, 0x78, 0x56, 0xcf, 0x03, 0x69, 0x01, 0x81, 0xf8, 0x0b, 0xfa, 0x1f, 0x40, 0x51, 0xb8, 0x8b,
+	0xad, 0x6c, 0xca, 0xf3, 0x7e, 0x59, 0x78, 0x0b, 0x20, 0xf1, 0x1f, 0x5b, 0x55, 0x02, 0xfe, 0x0a,
+	0xba, 0x74, 0x19, 0xc3, 0xf5, 0x97, 0x54, 0x2d, 0x9e, 0x63, 0xa9, 0x8d, 0x71, 0xc5, 0x4f, 0x17,
+	0xa0, 0xe7, 0x08, 0x29, 0x65, 0xdf, 0x03, 0x7a, 0x41, 0x37, 0x5c, 0x8a, 0x38, 0x96, 0xfe, 0x99,
+	0x88, 0x98, 0x48, 0xbf, 0x03, 0xf1, 0x31, 0xfb, 0x3c, 0xcd, 0x89, 0xd1, 0x94, 0x2f, 0x39, 0x69,
+	0x95, 0xdd, 0x13, 0xca, 0xc7, 0x2e, 0xc5, 0x06, 0x4c, 0xe9, 0xfb, 0x52, 0x6d, 0x62, 0x0a, 0x99,
+	0x51, 0x87, 0xaa, 0xea, 0xe7, 0x62, 0x34, 0x61, 0x03, 0x7f, 0x3e, 0x1b, 0x79, 0x96, 0x0c, 0xdd,
+	0xc4, 0xd7, 0x2f, 0x7e, 0xa4, 0xd7, 0xef, 0x22, 0x1d, 0xe3, 0x44, 0xc6, 0xef, 0xe6, 0x40, 0x5b,
+	0xc4, 0xa5, 0x7e, 0xc9, 0x32, 0x93, 0xfa, 0x25, 0x4b, 0x9c, 0x61, 0x9f, 0xd6, 0x45, 0xea, 0x77,
+	0x1c, 0x39, 0x48, 0xf1, 0x8e, 0x4f, 0xfd, 0xc8, 0x4d, 0xc9, 0x09, 0x1f, 0x51, 0x1a, 0x75, 0xb1,
+	0x18, 0xfd, 0xca, 0xf5, 0xc7, 0xb4, 0xac, 0xab, 0x14, 0x1d, 0xab, 0xeb, 0x8f, 0x31, 0x97, 0x54,
+	0xbe, 0x70, 0xe7, 0xcb, 0x2a, 0x2b, 0x71, 0xc0, 0x90, 0x8c, 0x5e, 0xc2, 0x67, 0x3e, 0x0a, 0xc5,
+	0x83, 0xe0, 0x12, 0x07, 0x0c, 0x43, 0x19, 0x61, 0x7f, 0x2c, 0x4e, 0xb4, 0x2c, 0x45, 0xd8, 0xc7,
+	0x9f, 0x00, 0x40, 0x85, 0x20, 0x7a, 0xcc, 0x8f, 0xc5, 0xcf, 0xaf, 0x8a, 0xdf, 0x2f, 0x40, 0xd4,
+	0x6b, 0xfc, 0x67, 0x19, 0x03, 0x3b, 0x0c, 0x79, 0x60, 0xcc, 0xb2, 0x88, 0x0d, 0x28, 0x80, 0x71,
+	0x04, 0x4e, 0xf1, 0xa3, 0x98, 0x48, 0x02, 0x22, 0x02, 0x27, 0x81, 0x88, 0xe0, 0x3a, 0x94, 0xbe,
+	0xf6, 0x3d, 0x9b, 0x94, 0x38, 0x15, 0x6a, 0x55, 0x11, 0xd3, 0x7b, 0xe6, 0xcc, 0xf8, 0xa3, 0x0c,
+	0x6c, 0x2e, 0x8e, 0x2a, 0x2d, 0x98, 0x2a, 0x94, 0x5a, 0xfd, 0xee, 0x08, 0x6d, 0xfe, 0xda, 0x25,
+	0x34, 0xb1, 0xf4, 0x77, 0x30, 0x4e, 0x03, 0x07, 0x64, 0x28, 0x6e, 0xc2, 0x60, 0xf4, 0xa8, 0xb3,
+	0xbb, 0xdb, 0xee, 0xf1, 0xdb, 0x67, 0x7f, 0xe7, 0xc7, 0xa3, 0x6e, 0xbf, 0xc5, 0x7f, 0x43, 0x4f,
+	0xba, 0x06, 0x0c, 0xb4, 0x1c, 0x26, 0xb9, 0xaf, 0x37, 0x26, 0xf3, 0xdc, 0x95, 0xf9, 0xe9, 0x60,
+	0xd4, 0xea, 0x0d, 0xb5, 0x02, 0xa6, 0xf0, 0x21, 0xfc, 0xa8, 0x25, 0x7d, 0x16, 0x5b, 0xfd, 0xbd,
+	0x7d, 0xd6, 0x1e, 0x0c, 0x46, 0x83, 0xce, 0x17, 0x6d, 0xad, 0x44, 0x35, 0xb3, 0xce, 0xc3, 0x4e,
+	0x8f, 0x03, 0xca, 0x68, 0x07, 0xda, 0xeb, 0xf4, 0x78, 0xbc, 0x88, 0xbd, 0xe6, 0xe7, 0x5a, 0x05,
+	0x3f, 0x06, 0x07, 0x7b, 0x5a, 0xf5, 0xce, 0xab, 0x50, 0x55, 0x7f, 0x43, 0x97, 0xbc, 0x97, 0x7d,
+	0xcf, 0xe6, 0x71, 0xf8, 0xbb, 0x5f, 0x7f, 0xa8, 0x65, 0xee, 0xfc, 0xba, 0xf2, 0x83, 0x52, 0x44,
+	0x23, 0xcc, 0x4a, 0xf4, 0x3a, 0x9e, 0xbf, 0xbe, 0x27, 0x23, 0x12, 0x3d, 0xd6, 0x7f, 0xd4, 0x1c,
+	0x3c, 0xe2, 0x06, 0x27, 0x81, 0x21, 0x40, 0x36, 0x89, 0xbf, 0x4e, 0xc1, 0x2f, 0xe8, 0x33, 0xf6,
+	0x38, 0xc9, 0x63, 0x46, 0x72, 0x06, 0x29, 0xa0, 0x3f, 0x04, 0x7e, 0xc5, 0xb8, 0xe2, 0x1d, 0x03,
+	0x2a, 0xca, 0x0f, 0x6c, 0x50, 0x1d, 0x66, 0x78, 0x2c, 0x42, 0xba, 0xa3, 0x1a, 0x41, 0xcb, 0xdc,
+	0x79, 0x03, 0x6a, 0x82, 0x46, 0xfc, 0xbc, 0x05, 0xfe, 0xf6, 0x3f, 0xbe, 0x4b, 0x77, 0x05, 0x9d,
+	0x3d, 0x0f, 0x91, 0xee, 0x3d, 0xb8, 0xb2, 0xf2, 0xc7, 0x3a, 0x90, 0x7e, 0xe0, 0xa0, 0x87, 0x33,
+	0x77, 0x22, 0x7f, 0x74, 0x7e, 0x18, 0x38, 0x96, 0x96, 0xb9, 0x73, 0x5f, 0x3e, 0xa0, 0x97, 0x75,
+	0x77, 0xfb, 0xcd, 0x5d, 0x3e, 0xb9, 0x71, 0x70, 0x8e, 0xe1, 0x0e, 0x0f, 0xd7, 0xce, 0xda, 0x83,
+	0x83, 0xee, 0x50, 0x04, 0x02, 0xb9, 0xf3, 0x23, 0x68, 0x5c, 0xe4, 0x4d, 0xcd, 0x8d, 0x6d, 0x4d,
+	0xf2, 0x58, 0xc7, 0xc9, 0xec, 0x8f, 0x78, 0x2a, 0xc3, 0x1d, 0xfe, 0xbb, 0x6d, 0x72, 0x4b, 0xba,
+	0xf3, 0xb3, 0x8c, 0xc2, 0xc2, 0xa4, 0x47, 0x6c, 0x0c, 0x10, 0xb3, 0xa4, 0x82, 0x98, 0x6d, 0x5a,
+	0x5a, 0x46, 0xbf, 0x0a, 0x7a, 0x0a, 0xd4, 0xf5, 0xc7, 0xa6, 0xab, 0xad, 0x91, 0x03, 0x92, 0x84,
+	0xd3, 0xbb, 0x05, 0x2d, 0x8b, 0xce, 0x26, 0x31, 0xac, 0xeb, 0x9f, 0xee, 0x07, 0x0e, 0xea, 0x50,
+	0xce, 0x39, 0x3a, 0xb7, 0xf3, 0xc3, 0x3f, 0xfc, 0xf9, 0xad, 0xcc, 0x7f, 0xf8, 0xf9, 0xad, 0xcc,
+	0x7f, 0xfd, 0xf9, 0xad, 0x4b, 0xbf, 0xfb, 0xdf, 0x6e, 0x65, 0xbe, 0x78, 0xf7, 0xc8, 0x89, 0x8e,
+	0xe7, 0x87, 0x77, 0xc7, 0xfe, 0xf4, 0xbd, 0xa9, 0x19, 0x05, 0xce, 0x19, 0xdf, 0x34, 0x32, 0xe1,
+	0xd9, 0xef, 0xcd, 0x4e, 0x8e, 0xde, 0x9b, 0x1d, 0xbe, 0x87, 0x9c, 0xe9, 0xb0, 0x30, 0x0b, 0xfc,
+	0xc8, 0xbf, 0xf7, 0x7f, 0x07, 0x00, 0xd5, 0x07, 0x7e, 0x37, 0x8d, 0x88, 0x00, 0x00,
 }
 
 func (m *Type) Marshal() (dAtA []byte, err error) {
@@ -15137,6 +16320,20 @@ func (m *ColDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Generated != nil {
+		{
+			size, err := m.Generated.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
 	if len(m.OriginName) > 0 {
 		i -= len(m.OriginName)
 		copy(dAtA[i:], m.OriginName)
@@ -15304,6 +16501,69 @@ func (m *ColDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GeneratedCol) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GeneratedCol) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GeneratedCol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ColName) > 0 {
+		i -= len(m.ColName)
+		copy(dAtA[i:], m.ColName)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.ColName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Stored {
+		i--
+		if m.Stored {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OriginString) > 0 {
+		i -= len(m.OriginString)
+		copy(dAtA[i:], m.OriginString)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.OriginString)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Expr != nil {
+		{
+			size, err := m.Expr.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Default) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -15525,20 +16785,20 @@ func (m *PrimaryKeyDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x10
 	}
 	if len(m.Cols) > 0 {
-		dAtA30 := make([]byte, len(m.Cols)*10)
-		var j29 int
+		dAtA32 := make([]byte, len(m.Cols)*10)
+		var j31 int
 		for _, num := range m.Cols {
 			for num >= 1<<7 {
-				dAtA30[j29] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA32[j31] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j29++
+				j31++
 			}
-			dAtA30[j29] = uint8(num)
-			j29++
+			dAtA32[j31] = uint8(num)
+			j31++
 		}
-		i -= j29
-		copy(dAtA[i:], dAtA30[:j29])
-		i = encodeVarintPlan(dAtA, i, uint64(j29))
+		i -= j31
+		copy(dAtA[i:], dAtA32[:j31])
+		i = encodeVarintPlan(dAtA, i, uint64(j31))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -15707,20 +16967,20 @@ func (m *ForeignKeyDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x28
 	}
 	if len(m.ForeignCols) > 0 {
-		dAtA33 := make([]byte, len(m.ForeignCols)*10)
-		var j32 int
+		dAtA35 := make([]byte, len(m.ForeignCols)*10)
+		var j34 int
 		for _, num := range m.ForeignCols {
 			for num >= 1<<7 {
-				dAtA33[j32] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA35[j34] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j32++
+				j34++
 			}
-			dAtA33[j32] = uint8(num)
-			j32++
+			dAtA35[j34] = uint8(num)
+			j34++
 		}
-		i -= j32
-		copy(dAtA[i:], dAtA33[:j32])
-		i = encodeVarintPlan(dAtA, i, uint64(j32))
+		i -= j34
+		copy(dAtA[i:], dAtA35[:j34])
+		i = encodeVarintPlan(dAtA, i, uint64(j34))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -15730,20 +16990,20 @@ func (m *ForeignKeyDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x18
 	}
 	if len(m.Cols) > 0 {
-		dAtA35 := make([]byte, len(m.Cols)*10)
-		var j34 int
+		dAtA37 := make([]byte, len(m.Cols)*10)
+		var j36 int
 		for _, num := range m.Cols {
 			for num >= 1<<7 {
-				dAtA35[j34] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA37[j36] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j34++
+				j36++
 			}
-			dAtA35[j34] = uint8(num)
-			j34++
+			dAtA37[j36] = uint8(num)
+			j36++
 		}
-		i -= j34
-		copy(dAtA[i:], dAtA35[:j34])
-		i = encodeVarintPlan(dAtA, i, uint64(j34))
+		i -= j36
+		copy(dAtA[i:], dAtA37[:j36])
+		i = encodeVarintPlan(dAtA, i, uint64(j36))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -15781,6 +17041,23 @@ func (m *CheckDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Enforced {
+		i--
+		if m.Enforced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ExprStr) > 0 {
+		i -= len(m.ExprStr)
+		copy(dAtA[i:], m.ExprStr)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.ExprStr)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Check != nil {
 		{
 			size, err := m.Check.MarshalToSizedBuffer(dAtA[:i])
@@ -15803,6 +17080,148 @@ func (m *CheckDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TriggerDef) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TriggerDef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TriggerDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Definer) > 0 {
+		i -= len(m.Definer)
+		copy(dAtA[i:], m.Definer)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Definer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CreateSql) > 0 {
+		i -= len(m.CreateSql)
+		copy(dAtA[i:], m.CreateSql)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.CreateSql)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Event) > 0 {
+		i -= len(m.Event)
+		copy(dAtA[i:], m.Event)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Event)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Timing) > 0 {
+		i -= len(m.Timing)
+		copy(dAtA[i:], m.Timing)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Timing)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MaterializedViewDef) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaterializedViewDef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaterializedViewDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.BaseTables) > 0 {
+		for iNdEx := len(m.BaseTables) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BaseTables[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ViewKeyCols) > 0 {
+		for iNdEx := len(m.ViewKeyCols) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ViewKeyCols[iNdEx])
+			copy(dAtA[i:], m.ViewKeyCols[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.ViewKeyCols[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.KeyCols) > 0 {
+		for iNdEx := len(m.KeyCols) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.KeyCols[iNdEx])
+			copy(dAtA[i:], m.KeyCols[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.KeyCols[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Database) > 0 {
+		i -= len(m.Database)
+		copy(dAtA[i:], m.Database)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Database)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ClusterByDef) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -16491,6 +17910,52 @@ func (m *TableDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xaa
 	}
+	if len(m.RefMviews) > 0 {
+		for iNdEx := len(m.RefMviews) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefMviews[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.Mview != nil {
+		{
+			size, err := m.Mview.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.Triggers) > 0 {
+		for iNdEx := len(m.Triggers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Triggers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
 	if len(m.Checks) > 0 {
 		for iNdEx := len(m.Checks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -16506,20 +17971,20 @@ func (m *TableDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.RefChildTbls) > 0 {
-		dAtA46 := make([]byte, len(m.RefChildTbls)*10)
-		var j45 int
+		dAtA49 := make([]byte, len(m.RefChildTbls)*10)
+		var j48 int
 		for _, num := range m.RefChildTbls {
 			for num >= 1<<7 {
-				dAtA46[j45] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA49[j48] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j45++
+				j48++
 			}
-			dAtA46[j45] = uint8(num)
-			j45++
+			dAtA49[j48] = uint8(num)
+			j48++
 		}
-		i -= j45
-		copy(dAtA[i:], dAtA46[:j45])
-		i = encodeVarintPlan(dAtA, i, uint64(j45))
+		i -= j48
+		copy(dAtA[i:], dAtA49[:j48])
+		i = encodeVarintPlan(dAtA, i, uint64(j48))
 		i--
 		dAtA[i] = 0x72
 	}
@@ -16757,9 +18222,9 @@ func (m *HashMapStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	if len(m.Ranges) > 0 {
 		for iNdEx := len(m.Ranges) - 1; iNdEx >= 0; iNdEx-- {
-			f50 := math.Float64bits(float64(m.Ranges[iNdEx]))
+			f53 := math.Float64bits(float64(m.Ranges[iNdEx]))
 			i -= 8
-			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f50))
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f53))
 		}
 		i = encodeVarintPlan(dAtA, i, uint64(len(m.Ranges)*8))
 		i--
@@ -17119,6 +18584,26 @@ func (m *WindowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.FromLast {
+		i--
+		if m.FromLast {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.IgnoreNulls {
+		i--
+		if m.IgnoreNulls {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Name) > 0 {
 		i -= len(m.Name)
 		copy(dAtA[i:], m.Name)
@@ -17417,21 +18902,21 @@ func (m *OnDuplicateKeyCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.OnDuplicateIdx) > 0 {
-		dAtA61 := make([]byte, len(m.OnDuplicateIdx)*10)
-		var j60 int
+		dAtA64 := make([]byte, len(m.OnDuplicateIdx)*10)
+		var j63 int
 		for _, num1 := range m.OnDuplicateIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA61[j60] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA64[j63] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j60++
+				j63++
 			}
-			dAtA61[j60] = uint8(num)
-			j60++
+			dAtA64[j63] = uint8(num)
+			j63++
 		}
-		i -= j60
-		copy(dAtA[i:], dAtA61[:j60])
-		i = encodeVarintPlan(dAtA, i, uint64(j60))
+		i -= j63
+		copy(dAtA[i:], dAtA64[:j63])
+		i = encodeVarintPlan(dAtA, i, uint64(j63))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -17514,21 +18999,21 @@ func (m *DedupJoinCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.UpdateColIdxList) > 0 {
-		dAtA63 := make([]byte, len(m.UpdateColIdxList)*10)
-		var j62 int
+		dAtA66 := make([]byte, len(m.UpdateColIdxList)*10)
+		var j65 int
 		for _, num1 := range m.UpdateColIdxList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA63[j62] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA66[j65] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j62++
+				j65++
 			}
-			dAtA63[j62] = uint8(num)
-			j62++
+			dAtA66[j65] = uint8(num)
+			j65++
 		}
-		i -= j62
-		copy(dAtA[i:], dAtA63[:j62])
-		i = encodeVarintPlan(dAtA, i, uint64(j62))
+		i -= j65
+		copy(dAtA[i:], dAtA66[:j65])
+		i = encodeVarintPlan(dAtA, i, uint64(j65))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -17573,6 +19058,18 @@ func (m *UpdateCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.InsertFilterCol != nil {
+		{
+			size, err := m.InsertFilterCol.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.DeleteCols) > 0 {
 		for iNdEx := len(m.DeleteCols) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -17621,20 +19118,20 @@ func (m *UpdateCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.PartitionTableIds) > 0 {
-		dAtA65 := make([]byte, len(m.PartitionTableIds)*10)
-		var j64 int
+		dAtA69 := make([]byte, len(m.PartitionTableIds)*10)
+		var j68 int
 		for _, num := range m.PartitionTableIds {
 			for num >= 1<<7 {
-				dAtA65[j64] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA69[j68] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j64++
+				j68++
 			}
-			dAtA65[j64] = uint8(num)
-			j64++
+			dAtA69[j68] = uint8(num)
+			j68++
 		}
-		i -= j64
-		copy(dAtA[i:], dAtA65[:j64])
-		i = encodeVarintPlan(dAtA, i, uint64(j64))
+		i -= j68
+		copy(dAtA[i:], dAtA69[:j68])
+		i = encodeVarintPlan(dAtA, i, uint64(j68))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -17704,20 +19201,20 @@ func (m *InsertCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.PartitionTableIds) > 0 {
-		dAtA69 := make([]byte, len(m.PartitionTableIds)*10)
-		var j68 int
+		dAtA73 := make([]byte, len(m.PartitionTableIds)*10)
+		var j72 int
 		for _, num := range m.PartitionTableIds {
 			for num >= 1<<7 {
-				dAtA69[j68] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA73[j72] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j68++
+				j72++
 			}
-			dAtA69[j68] = uint8(num)
-			j68++
+			dAtA73[j72] = uint8(num)
+			j72++
 		}
-		i -= j68
-		copy(dAtA[i:], dAtA69[:j68])
-		i = encodeVarintPlan(dAtA, i, uint64(j68))
+		i -= j72
+		copy(dAtA[i:], dAtA73[:j72])
+		i = encodeVarintPlan(dAtA, i, uint64(j72))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -17817,20 +19314,20 @@ func (m *ReplaceCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.PartitionTableIds) > 0 {
-		dAtA73 := make([]byte, len(m.PartitionTableIds)*10)
-		var j72 int
+		dAtA77 := make([]byte, len(m.PartitionTableIds)*10)
+		var j76 int
 		for _, num := range m.PartitionTableIds {
 			for num >= 1<<7 {
-				dAtA73[j72] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA77[j76] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j72++
+				j76++
 			}
-			dAtA73[j72] = uint8(num)
-			j72++
+			dAtA77[j76] = uint8(num)
+			j76++
 		}
-		i -= j72
-		copy(dAtA[i:], dAtA73[:j72])
-		i = encodeVarintPlan(dAtA, i, uint64(j72))
+		i -= j76
+		copy(dAtA[i:], dAtA77[:j76])
+		i = encodeVarintPlan(dAtA, i, uint64(j76))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -18032,40 +19529,40 @@ func (m *AnalyzeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x80
 	}
 	if len(m.TimeConsumedArrayMinor) > 0 {
-		dAtA77 := make([]byte, len(m.TimeConsumedArrayMinor)*10)
-		var j76 int
+		dAtA81 := make([]byte, len(m.TimeConsumedArrayMinor)*10)
+		var j80 int
 		for _, num1 := range m.TimeConsumedArrayMinor {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA77[j76] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA81[j80] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j76++
+				j80++
 			}
-			dAtA77[j76] = uint8(num)
-			j76++
+			dAtA81[j80] = uint8(num)
+			j80++
 		}
-		i -= j76
-		copy(dAtA[i:], dAtA77[:j76])
-		i = encodeVarintPlan(dAtA, i, uint64(j76))
+		i -= j80
+		copy(dAtA[i:], dAtA81[:j80])
+		i = encodeVarintPlan(dAtA, i, uint64(j80))
 		i--
 		dAtA[i] = 0x7a
 	}
 	if len(m.TimeConsumedArrayMajor) > 0 {
-		dAtA79 := make([]byte, len(m.TimeConsumedArrayMajor)*10)
-		var j78 int
+		dAtA83 := make([]byte, len(m.TimeConsumedArrayMajor)*10)
+		var j82 int
 		for _, num1 := range m.TimeConsumedArrayMajor {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA79[j78] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA83[j82] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j78++
+				j82++
 			}
-			dAtA79[j78] = uint8(num)
-			j78++
+			dAtA83[j82] = uint8(num)
+			j82++
 		}
-		i -= j78
-		copy(dAtA[i:], dAtA79[:j78])
-		i = encodeVarintPlan(dAtA, i, uint64(j78))
+		i -= j82
+		copy(dAtA[i:], dAtA83[:j82])
+		i = encodeVarintPlan(dAtA, i, uint64(j82))
 		i--
 		dAtA[i] = 0x72
 	}
@@ -18739,21 +20236,21 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x8a
 	}
 	if len(m.SourceStep) > 0 {
-		dAtA97 := make([]byte, len(m.SourceStep)*10)
-		var j96 int
+		dAtA101 := make([]byte, len(m.SourceStep)*10)
+		var j100 int
 		for _, num1 := range m.SourceStep {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA97[j96] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA101[j100] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j96++
+				j100++
 			}
-			dAtA97[j96] = uint8(num)
-			j96++
+			dAtA101[j100] = uint8(num)
+			j100++
 		}
-		i -= j96
-		copy(dAtA[i:], dAtA97[:j96])
-		i = encodeVarintPlan(dAtA, i, uint64(j96))
+		i -= j100
+		copy(dAtA[i:], dAtA101[:j100])
+		i = encodeVarintPlan(dAtA, i, uint64(j100))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -18844,21 +20341,21 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xca
 	}
 	if len(m.BindingTags) > 0 {
-		dAtA103 := make([]byte, len(m.BindingTags)*10)
-		var j102 int
+		dAtA107 := make([]byte, len(m.BindingTags)*10)
+		var j106 int
 		for _, num1 := range m.BindingTags {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA103[j102] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA107[j106] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j102++
+				j106++
 			}
-			dAtA103[j102] = uint8(num)
-			j102++
+			dAtA107[j106] = uint8(num)
+			j106++
 		}
-		i -= j102
-		copy(dAtA[i:], dAtA103[:j102])
-		i = encodeVarintPlan(dAtA, i, uint64(j102))
+		i -= j106
+		copy(dAtA[i:], dAtA107[:j106])
+		i = encodeVarintPlan(dAtA, i, uint64(j106))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -19108,21 +20605,21 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.Children) > 0 {
-		dAtA113 := make([]byte, len(m.Children)*10)
-		var j112 int
+		dAtA117 := make([]byte, len(m.Children)*10)
+		var j116 int
 		for _, num1 := range m.Children {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA113[j112] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA117[j116] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j112++
+				j116++
 			}
-			dAtA113[j112] = uint8(num)
-			j112++
+			dAtA117[j116] = uint8(num)
+			j116++
 		}
-		i -= j112
-		copy(dAtA[i:], dAtA113[:j112])
-		i = encodeVarintPlan(dAtA, i, uint64(j112))
+		i -= j116
+		copy(dAtA[i:], dAtA117[:j116])
+		i = encodeVarintPlan(dAtA, i, uint64(j116))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -19366,6 +20863,37 @@ func (m *LockTarget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.SkipLockedLimit != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.SkipLockedLimit))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.SkipLocked {
+		i--
+		if m.SkipLocked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.WaitSec != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.WaitSec))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.WaitPolicy != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.WaitPolicy))
+		i--
+		dAtA[i] = 0x78
+	}
 	if m.LockTableAtTheEnd {
 		i--
 		if m.LockTableAtTheEnd {
@@ -19414,20 +20942,20 @@ func (m *LockTarget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x48
 	}
 	if len(m.PartitionTableIds) > 0 {
-		dAtA119 := make([]byte, len(m.PartitionTableIds)*10)
-		var j118 int
+		dAtA123 := make([]byte, len(m.PartitionTableIds)*10)
+		var j122 int
 		for _, num := range m.PartitionTableIds {
 			for num >= 1<<7 {
-				dAtA119[j118] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA123[j122] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j118++
+				j122++
 			}
-			dAtA119[j118] = uint8(num)
-			j118++
+			dAtA123[j122] = uint8(num)
+			j122++
 		}
-		i -= j118
-		copy(dAtA[i:], dAtA119[:j118])
-		i = encodeVarintPlan(dAtA, i, uint64(j118))
+		i -= j122
+		copy(dAtA[i:], dAtA123[:j122])
+		i = encodeVarintPlan(dAtA, i, uint64(j122))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -19534,21 +21062,21 @@ func (m *PreInsertUkCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x10
 	}
 	if len(m.Columns) > 0 {
-		dAtA124 := make([]byte, len(m.Columns)*10)
-		var j123 int
+		dAtA128 := make([]byte, len(m.Columns)*10)
+		var j127 int
 		for _, num1 := range m.Columns {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA124[j123] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA128[j127] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j123++
+				j127++
 			}
-			dAtA124[j123] = uint8(num)
-			j123++
+			dAtA128[j127] = uint8(num)
+			j127++
 		}
-		i -= j123
-		copy(dAtA[i:], dAtA124[:j123])
-		i = encodeVarintPlan(dAtA, i, uint64(j123))
+		i -= j127
+		copy(dAtA[i:], dAtA128[:j127])
+		i = encodeVarintPlan(dAtA, i, uint64(j127))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -19580,21 +21108,21 @@ func (m *PreDeleteCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.Idx) > 0 {
-		dAtA126 := make([]byte, len(m.Idx)*10)
-		var j125 int
+		dAtA130 := make([]byte, len(m.Idx)*10)
+		var j129 int
 		for _, num1 := range m.Idx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA126[j125] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA130[j129] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j125++
+				j129++
 			}
-			dAtA126[j125] = uint8(num)
-			j125++
+			dAtA130[j129] = uint8(num)
+			j129++
 		}
-		i -= j125
-		copy(dAtA[i:], dAtA126[:j125])
-		i = encodeVarintPlan(dAtA, i, uint64(j125))
+		i -= j129
+		copy(dAtA[i:], dAtA130[:j129])
+		i = encodeVarintPlan(dAtA, i, uint64(j129))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -19780,21 +21308,21 @@ func (m *IdList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.List) > 0 {
-		dAtA133 := make([]byte, len(m.List)*10)
-		var j132 int
+		dAtA137 := make([]byte, len(m.List)*10)
+		var j136 int
 		for _, num1 := range m.List {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA133[j132] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA137[j136] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j132++
+				j136++
 			}
-			dAtA133[j132] = uint8(num)
-			j132++
+			dAtA137[j136] = uint8(num)
+			j136++
 		}
-		i -= j132
-		copy(dAtA[i:], dAtA133[:j132])
-		i = encodeVarintPlan(dAtA, i, uint64(j132))
+		i -= j136
+		copy(dAtA[i:], dAtA137[:j136])
+		i = encodeVarintPlan(dAtA, i, uint64(j136))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -19913,20 +21441,20 @@ func (m *DeleteCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.PartitionTableIds) > 0 {
-		dAtA137 := make([]byte, len(m.PartitionTableIds)*10)
-		var j136 int
+		dAtA141 := make([]byte, len(m.PartitionTableIds)*10)
+		var j140 int
 		for _, num := range m.PartitionTableIds {
 			for num >= 1<<7 {
-				dAtA137[j136] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA141[j140] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j136++
+				j140++
 			}
-			dAtA137[j136] = uint8(num)
-			j136++
+			dAtA141[j140] = uint8(num)
+			j140++
 		}
-		i -= j136
-		copy(dAtA[i:], dAtA137[:j136])
-		i = encodeVarintPlan(dAtA, i, uint64(j136))
+		i -= j140
+		copy(dAtA[i:], dAtA141[:j140])
+		i = encodeVarintPlan(dAtA, i, uint64(j140))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -20037,6 +21565,299 @@ func (m *PostDmlFullTextCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PostDmlHnswCtx) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PostDmlHnswCtx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PostDmlHnswCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.AlgoParams) > 0 {
+		i -= len(m.AlgoParams)
+		copy(dAtA[i:], m.AlgoParams)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.AlgoParams)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Parts) > 0 {
+		for iNdEx := len(m.Parts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Parts[iNdEx])
+			copy(dAtA[i:], m.Parts[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.Parts[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.IndexTableName) > 0 {
+		i -= len(m.IndexTableName)
+		copy(dAtA[i:], m.IndexTableName)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.IndexTableName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourceTableName) > 0 {
+		i -= len(m.SourceTableName)
+		copy(dAtA[i:], m.SourceTableName)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.SourceTableName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PostDmlTriggerAction) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PostDmlTriggerAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PostDmlTriggerAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Message != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Message))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Errno != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Errno))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.SqlState) > 0 {
+		i -= len(m.SqlState)
+		copy(dAtA[i:], m.SqlState)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.SqlState)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Args) > 0 {
+		dAtA144 := make([]byte, len(m.Args)*10)
+		var j143 int
+		for _, num1 := range m.Args {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA144[j143] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j143++
+			}
+			dAtA144[j143] = uint8(num)
+			j143++
+		}
+		i -= j143
+		copy(dAtA[i:], dAtA144[:j143])
+		i = encodeVarintPlan(dAtA, i, uint64(j143))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SqlParts) > 0 {
+		for iNdEx := len(m.SqlParts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SqlParts[iNdEx])
+			copy(dAtA[i:], m.SqlParts[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.SqlParts[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Guard != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Guard))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PostDmlTriggerCtx) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PostDmlTriggerCtx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PostDmlTriggerCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Actions) > 0 {
+		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Actions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PostDmlMaterializedView) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PostDmlMaterializedView) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PostDmlMaterializedView) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NewKeyPos) > 0 {
+		dAtA146 := make([]byte, len(m.NewKeyPos)*10)
+		var j145 int
+		for _, num1 := range m.NewKeyPos {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA146[j145] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j145++
+			}
+			dAtA146[j145] = uint8(num)
+			j145++
+		}
+		i -= j145
+		copy(dAtA[i:], dAtA146[:j145])
+		i = encodeVarintPlan(dAtA, i, uint64(j145))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OldKeyPos) > 0 {
+		dAtA148 := make([]byte, len(m.OldKeyPos)*10)
+		var j147 int
+		for _, num1 := range m.OldKeyPos {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA148[j147] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j147++
+			}
+			dAtA148[j147] = uint8(num)
+			j147++
+		}
+		i -= j147
+		copy(dAtA[i:], dAtA148[:j147])
+		i = encodeVarintPlan(dAtA, i, uint64(j147))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.View != nil {
+		{
+			size, err := m.View.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PostDmlMaterializedViewCtx) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PostDmlMaterializedViewCtx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PostDmlMaterializedViewCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Views) > 0 {
+		for iNdEx := len(m.Views) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Views[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PostDmlCtx) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -20061,6 +21882,42 @@ func (m *PostDmlCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Mview != nil {
+		{
+			size, err := m.Mview.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Trigger != nil {
+		{
+			size, err := m.Trigger.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Hnsw != nil {
+		{
+			size, err := m.Hnsw.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.FullText != nil {
 		{
 			size, err := m.FullText.MarshalToSizedBuffer(dAtA[:i])
@@ -20231,21 +22088,21 @@ func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.Steps) > 0 {
-		dAtA142 := make([]byte, len(m.Steps)*10)
-		var j141 int
+		dAtA156 := make([]byte, len(m.Steps)*10)
+		var j155 int
 		for _, num1 := range m.Steps {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA142[j141] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA156[j155] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j141++
+				j155++
 			}
-			dAtA142[j141] = uint8(num)
-			j141++
+			dAtA156[j155] = uint8(num)
+			j155++
 		}
-		i -= j141
-		copy(dAtA[i:], dAtA142[:j141])
-		i = encodeVarintPlan(dAtA, i, uint64(j141))
+		i -= j155
+		copy(dAtA[i:], dAtA156[:j155])
+		i = encodeVarintPlan(dAtA, i, uint64(j155))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -21243,6 +23100,75 @@ func (m *DataDefinition_RenameTable) MarshalToSizedBuffer(dAtA []byte) (int, err
 	}
 	return len(dAtA) - i, nil
 }
+func (m *DataDefinition_CreateTrigger) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataDefinition_CreateTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CreateTrigger != nil {
+		{
+			size, err := m.CreateTrigger.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *DataDefinition_DropTrigger) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataDefinition_DropTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DropTrigger != nil {
+		{
+			size, err := m.DropTrigger.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	return len(dAtA) - i, nil
+}
+func (m *DataDefinition_RefreshMaterializedView) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataDefinition_RefreshMaterializedView) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RefreshMaterializedView != nil {
+		{
+			size, err := m.RefreshMaterializedView.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	return len(dAtA) - i, nil
+}
 func (m *SubscriptionOption) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -21591,6 +23517,18 @@ func (m *CreateTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Ttl != nil {
+		{
+			size, err := m.Ttl.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
 	if m.RetentionDeadline != 0 {
 		i = encodeVarintPlan(dAtA, i, uint64(m.RetentionDeadline))
 		i--
@@ -21738,6 +23676,52 @@ func (m *CreateTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TableTTL) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TableTTL) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TableTTL) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Unit) > 0 {
+		i -= len(m.Unit)
+		copy(dAtA[i:], m.Unit)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Unit)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Interval != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Column) > 0 {
+		i -= len(m.Column)
+		copy(dAtA[i:], m.Column)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Column)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AlterTableDrop) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -22183,6 +24167,45 @@ func (m *AlterTableComment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AlterTableTTL) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterTableTTL) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableTTL) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Ttl != nil {
+		{
+			size, err := m.Ttl.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AlterTableName) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -22747,6 +24770,27 @@ func (m *AlterTable_Action_AddPartition) MarshalToSizedBuffer(dAtA []byte) (int,
 	}
 	return len(dAtA) - i, nil
 }
+func (m *AlterTable_Action_AlterTtl) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTable_Action_AlterTtl) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AlterTtl != nil {
+		{
+			size, err := m.AlterTtl.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *DropTable) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -22772,20 +24816,20 @@ func (m *DropTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.FkChildTblsReferToMe) > 0 {
-		dAtA198 := make([]byte, len(m.FkChildTblsReferToMe)*10)
-		var j197 int
+		dAtA218 := make([]byte, len(m.FkChildTblsReferToMe)*10)
+		var j217 int
 		for _, num := range m.FkChildTblsReferToMe {
 			for num >= 1<<7 {
-				dAtA198[j197] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA218[j217] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j197++
+				j217++
 			}
-			dAtA198[j197] = uint8(num)
-			j197++
+			dAtA218[j217] = uint8(num)
+			j217++
 		}
-		i -= j197
-		copy(dAtA[i:], dAtA198[:j197])
-		i = encodeVarintPlan(dAtA, i, uint64(j197))
+		i -= j217
+		copy(dAtA[i:], dAtA218[:j217])
+		i = encodeVarintPlan(dAtA, i, uint64(j217))
 		i--
 		dAtA[i] = 0x62
 	}
@@ -22830,20 +24874,20 @@ func (m *DropTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.ForeignTbl) > 0 {
-		dAtA201 := make([]byte, len(m.ForeignTbl)*10)
-		var j200 int
+		dAtA221 := make([]byte, len(m.ForeignTbl)*10)
+		var j220 int
 		for _, num := range m.ForeignTbl {
 			for num >= 1<<7 {
-				dAtA201[j200] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA221[j220] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j200++
+				j220++
 			}
-			dAtA201[j200] = uint8(num)
-			j200++
+			dAtA221[j220] = uint8(num)
+			j220++
 		}
-		i -= j200
-		copy(dAtA[i:], dAtA201[:j200])
-		i = encodeVarintPlan(dAtA, i, uint64(j200))
+		i -= j220
+		copy(dAtA[i:], dAtA221[:j220])
+		i = encodeVarintPlan(dAtA, i, uint64(j220))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -23361,6 +25405,197 @@ func (m *DropIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CreateTrigger) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateTrigger) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ActionStatement) > 0 {
+		i -= len(m.ActionStatement)
+		copy(dAtA[i:], m.ActionStatement)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.ActionStatement)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Follows {
+		i--
+		if m.Follows {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.OrderTrigger) > 0 {
+		i -= len(m.OrderTrigger)
+		copy(dAtA[i:], m.OrderTrigger)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.OrderTrigger)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Trigger != nil {
+		{
+			size, err := m.Trigger.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.IfNotExists {
+		i--
+		if m.IfNotExists {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Table) > 0 {
+		i -= len(m.Table)
+		copy(dAtA[i:], m.Table)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Table)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Database) > 0 {
+		i -= len(m.Database)
+		copy(dAtA[i:], m.Database)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Database)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DropTrigger) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DropTrigger) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DropTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IfExists {
+		i--
+		if m.IfExists {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Database) > 0 {
+		i -= len(m.Database)
+		copy(dAtA[i:], m.Database)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Database)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RefreshMaterializedView) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefreshMaterializedView) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefreshMaterializedView) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Mview != nil {
+		{
+			size, err := m.Mview.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Table) > 0 {
+		i -= len(m.Table)
+		copy(dAtA[i:], m.Table)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Table)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Database) > 0 {
+		i -= len(m.Database)
+		copy(dAtA[i:], m.Database)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Database)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TruncateTable) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -23396,20 +25631,20 @@ func (m *TruncateTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x40
 	}
 	if len(m.ForeignTbl) > 0 {
-		dAtA210 := make([]byte, len(m.ForeignTbl)*10)
-		var j209 int
+		dAtA232 := make([]byte, len(m.ForeignTbl)*10)
+		var j231 int
 		for _, num := range m.ForeignTbl {
 			for num >= 1<<7 {
-				dAtA210[j209] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA232[j231] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j209++
+				j231++
 			}
-			dAtA210[j209] = uint8(num)
-			j209++
+			dAtA232[j231] = uint8(num)
+			j231++
 		}
-		i -= j209
-		copy(dAtA[i:], dAtA210[:j209])
-		i = encodeVarintPlan(dAtA, i, uint64(j209))
+		i -= j231
+		copy(dAtA[i:], dAtA232[:j231])
+		i = encodeVarintPlan(dAtA, i, uint64(j231))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -23495,20 +25730,20 @@ func (m *ClusterTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x18
 	}
 	if len(m.AccountIDs) > 0 {
-		dAtA213 := make([]byte, len(m.AccountIDs)*10)
-		var j212 int
+		dAtA235 := make([]byte, len(m.AccountIDs)*10)
+		var j234 int
 		for _, num := range m.AccountIDs {
 			for num >= 1<<7 {
-				dAtA213[j212] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA235[j234] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j212++
+				j234++
 			}
-			dAtA213[j212] = uint8(num)
-			j212++
+			dAtA235[j234] = uint8(num)
+			j234++
 		}
-		i -= j212
-		copy(dAtA[i:], dAtA213[:j212])
-		i = encodeVarintPlan(dAtA, i, uint64(j212))
+		i -= j234
+		copy(dAtA[i:], dAtA235[:j234])
+		i = encodeVarintPlan(dAtA, i, uint64(j234))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -23720,21 +25955,21 @@ func (m *Prepare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.ParamTypes) > 0 {
-		dAtA217 := make([]byte, len(m.ParamTypes)*10)
-		var j216 int
+		dAtA239 := make([]byte, len(m.ParamTypes)*10)
+		var j238 int
 		for _, num1 := range m.ParamTypes {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA217[j216] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA239[j238] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j216++
+				j238++
 			}
-			dAtA217[j216] = uint8(num)
-			j216++
+			dAtA239[j238] = uint8(num)
+			j238++
 		}
-		i -= j216
-		copy(dAtA[i:], dAtA217[:j216])
-		i = encodeVarintPlan(dAtA, i, uint64(j216))
+		i -= j238
+		copy(dAtA[i:], dAtA239[:j238])
+		i = encodeVarintPlan(dAtA, i, uint64(j238))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -23881,21 +26116,21 @@ func (m *OtherDCL) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.ParamTypes) > 0 {
-		dAtA220 := make([]byte, len(m.ParamTypes)*10)
-		var j219 int
+		dAtA242 := make([]byte, len(m.ParamTypes)*10)
+		var j241 int
 		for _, num1 := range m.ParamTypes {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA220[j219] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA242[j241] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j219++
+				j241++
 			}
-			dAtA220[j219] = uint8(num)
-			j219++
+			dAtA242[j241] = uint8(num)
+			j241++
 		}
-		i -= j219
-		copy(dAtA[i:], dAtA220[:j219])
-		i = encodeVarintPlan(dAtA, i, uint64(j219))
+		i -= j241
+		copy(dAtA[i:], dAtA242[:j241])
+		i = encodeVarintPlan(dAtA, i, uint64(j241))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -25142,6 +27377,37 @@ func (m *ColDef) ProtoSize() (n int) {
 	if l > 0 {
 		n += 2 + l + sovPlan(uint64(l))
 	}
+	if m.Generated != nil {
+		l = m.Generated.ProtoSize()
+		n += 2 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GeneratedCol) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Expr != nil {
+		l = m.Expr.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.OriginString)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.Stored {
+		n += 2
+	}
+	l = len(m.ColName)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -25365,6 +27631,87 @@ func (m *CheckDef) ProtoSize() (n int) {
 		l = m.Check.ProtoSize()
 		n += 1 + l + sovPlan(uint64(l))
 	}
+	l = len(m.ExprStr)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.Enforced {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TriggerDef) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.Timing)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.Event)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.CreateSql)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.Definer)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MaterializedViewDef) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Database)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if len(m.KeyCols) > 0 {
+		for _, s := range m.KeyCols {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if len(m.ViewKeyCols) > 0 {
+		for _, s := range m.ViewKeyCols {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if len(m.BaseTables) > 0 {
+		for _, e := range m.BaseTables {
+			l = e.ProtoSize()
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -25670,6 +28017,22 @@ func (m *TableDef) ProtoSize() (n int) {
 			n += 1 + l + sovPlan(uint64(l))
 		}
 	}
+	if len(m.Triggers) > 0 {
+		for _, e := range m.Triggers {
+			l = e.ProtoSize()
+			n += 2 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.Mview != nil {
+		l = m.Mview.ProtoSize()
+		n += 2 + l + sovPlan(uint64(l))
+	}
+	if len(m.RefMviews) > 0 {
+		for _, e := range m.RefMviews {
+			l = e.ProtoSize()
+			n += 2 + l + sovPlan(uint64(l))
+		}
+	}
 	if m.Partition != nil {
 		l = m.Partition.ProtoSize()
 		n += 2 + l + sovPlan(uint64(l))
@@ -25970,6 +28333,12 @@ func (m *WindowSpec) ProtoSize() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPlan(uint64(l))
 	}
+	if m.IgnoreNulls {
+		n += 2
+	}
+	if m.FromLast {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -26184,6 +28553,10 @@ func (m *UpdateCtx) ProtoSize() (n int) {
 			n += 1 + l + sovPlan(uint64(l))
 		}
 	}
+	if m.InsertFilterCol != nil {
+		l = m.InsertFilterCol.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -26893,6 +29266,18 @@ func (m *LockTarget) ProtoSize() (n int) {
 	if m.LockTableAtTheEnd {
 		n += 2
 	}
+	if m.WaitPolicy != 0 {
+		n += 1 + sovPlan(uint64(m.WaitPolicy))
+	}
+	if m.WaitSec != 0 {
+		n += 2 + sovPlan(uint64(m.WaitSec))
+	}
+	if m.SkipLocked {
+		n += 3
+	}
+	if m.SkipLockedLimit != 0 {
+		n += 2 + sovPlan(uint64(m.SkipLockedLimit))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -27127,6 +29512,140 @@ func (m *PostDmlFullTextCtx) ProtoSize() (n int) {
 	return n
 }
 
+func (m *PostDmlHnswCtx) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceTableName)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.IndexTableName)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if len(m.Parts) > 0 {
+		for _, s := range m.Parts {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	l = len(m.AlgoParams)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PostDmlTriggerAction) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Guard != 0 {
+		n += 1 + sovPlan(uint64(m.Guard))
+	}
+	if len(m.SqlParts) > 0 {
+		for _, s := range m.SqlParts {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if len(m.Args) > 0 {
+		l = 0
+		for _, e := range m.Args {
+			l += sovPlan(uint64(e))
+		}
+		n += 1 + sovPlan(uint64(l)) + l
+	}
+	l = len(m.SqlState)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.Errno != 0 {
+		n += 1 + sovPlan(uint64(m.Errno))
+	}
+	if m.Message != 0 {
+		n += 1 + sovPlan(uint64(m.Message))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PostDmlTriggerCtx) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Actions) > 0 {
+		for _, e := range m.Actions {
+			l = e.ProtoSize()
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PostDmlMaterializedView) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.View != nil {
+		l = m.View.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if len(m.OldKeyPos) > 0 {
+		l = 0
+		for _, e := range m.OldKeyPos {
+			l += sovPlan(uint64(e))
+		}
+		n += 1 + sovPlan(uint64(l)) + l
+	}
+	if len(m.NewKeyPos) > 0 {
+		l = 0
+		for _, e := range m.NewKeyPos {
+			l += sovPlan(uint64(e))
+		}
+		n += 1 + sovPlan(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PostDmlMaterializedViewCtx) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Views) > 0 {
+		for _, e := range m.Views {
+			l = e.ProtoSize()
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *PostDmlCtx) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -27160,6 +29679,18 @@ func (m *PostDmlCtx) ProtoSize() (n int) {
 		l = m.FullText.ProtoSize()
 		n += 1 + l + sovPlan(uint64(l))
 	}
+	if m.Hnsw != nil {
+		l = m.Hnsw.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.Trigger != nil {
+		l = m.Trigger.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.Mview != nil {
+		l = m.Mview.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -27732,6 +30263,42 @@ func (m *DataDefinition_RenameTable) ProtoSize() (n int) {
 	}
 	return n
 }
+func (m *DataDefinition_CreateTrigger) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreateTrigger != nil {
+		l = m.CreateTrigger.ProtoSize()
+		n += 2 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *DataDefinition_DropTrigger) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DropTrigger != nil {
+		l = m.DropTrigger.ProtoSize()
+		n += 2 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *DataDefinition_RefreshMaterializedView) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RefreshMaterializedView != nil {
+		l = m.RefreshMaterializedView.ProtoSize()
+		n += 2 + l + sovPlan(uint64(l))
+	}
+	return n
+}
 func (m *SubscriptionOption) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -27950,6 +30517,33 @@ func (m *CreateTable) ProtoSize() (n int) {
 	if m.RetentionDeadline != 0 {
 		n += 1 + sovPlan(uint64(m.RetentionDeadline))
 	}
+	if m.Ttl != nil {
+		l = m.Ttl.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TableTTL) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Column)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovPlan(uint64(m.Interval))
+	}
+	l = len(m.Unit)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -28166,6 +30760,22 @@ func (m *AlterTableComment) ProtoSize() (n int) {
 	return n
 }
 
+func (m *AlterTableTTL) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ttl != nil {
+		l = m.Ttl.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *AlterTableName) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -28451,6 +31061,18 @@ func (m *AlterTable_Action_AddPartition) ProtoSize() (n int) {
 	}
 	return n
 }
+func (m *AlterTable_Action_AlterTtl) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AlterTtl != nil {
+		l = m.AlterTtl.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
 func (m *DropTable) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -28720,6 +31342,91 @@ func (m *DropIndex) ProtoSize() (n int) {
 	return n
 }
 
+func (m *CreateTrigger) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Database)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.Table)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.IfNotExists {
+		n += 2
+	}
+	if m.Trigger != nil {
+		l = m.Trigger.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.OrderTrigger)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.Follows {
+		n += 2
+	}
+	l = len(m.ActionStatement)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DropTrigger) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Database)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.IfExists {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RefreshMaterializedView) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Database)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.Table)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.Mview != nil {
+		l = m.Mview.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *TruncateTable) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -33526,6 +36233,42 @@ func (m *ColDef) Unmarshal(dAtA []byte) error {
 			}
 			m.OriginName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Generated == nil {
+				m.Generated = &GeneratedCol{}
+			}
+			if err := m.Generated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -33548,7 +36291,7 @@ func (m *ColDef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Default) Unmarshal(dAtA []byte) error {
+func (m *GeneratedCol) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33571,10 +36314,10 @@ func (m *Default) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Default: wiretype end group for non-group")
+			return fmt.Errorf("proto: GeneratedCol: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Default: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GeneratedCol: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -33647,7 +36390,7 @@ func (m *Default) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NullAbility", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stored", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -33664,7 +36407,39 @@ func (m *Default) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.NullAbility = bool(v != 0)
+			m.Stored = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ColName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -33687,7 +36462,7 @@ func (m *Default) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OnUpdate) Unmarshal(dAtA []byte) error {
+func (m *Default) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33710,10 +36485,10 @@ func (m *OnUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OnUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: Default: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OnUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Default: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -33784,6 +36559,26 @@ func (m *OnUpdate) Unmarshal(dAtA []byte) error {
 			}
 			m.OriginString = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NullAbility", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NullAbility = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -33806,7 +36601,7 @@ func (m *OnUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IndexOption) Unmarshal(dAtA []byte) error {
+func (m *OnUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33829,17 +36624,17 @@ func (m *IndexOption) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IndexOption: wiretype end group for non-group")
+			return fmt.Errorf("proto: OnUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IndexOption: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OnUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateExtraTable", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expr", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -33849,15 +36644,31 @@ func (m *IndexOption) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.CreateExtraTable = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expr == nil {
+				m.Expr = &Expr{}
+			}
+			if err := m.Expr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParserName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginString", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33885,27 +36696,130 @@ func (m *IndexOption) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ParserName = string(dAtA[iNdEx:postIndex])
+			m.OriginString = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NgramTokenSize", wireType)
-			}
-			m.NgramTokenSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NgramTokenSize |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexOption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexOption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexOption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateExtraTable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CreateExtraTable = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParserName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParserName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NgramTokenSize", wireType)
+			}
+			m.NgramTokenSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NgramTokenSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -35002,6 +37916,58 @@ func (m *CheckDef) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExprStr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExprStr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enforced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enforced = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -35024,7 +37990,7 @@ func (m *CheckDef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterByDef) Unmarshal(dAtA []byte) error {
+func (m *TriggerDef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35047,10 +38013,10 @@ func (m *ClusterByDef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterByDef: wiretype end group for non-group")
+			return fmt.Errorf("proto: TriggerDef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterByDef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TriggerDef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -35087,9 +38053,9 @@ func (m *ClusterByDef) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompCbkeyCol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timing", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -35099,82 +38065,538 @@ func (m *ClusterByDef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CompCbkeyCol == nil {
-				m.CompCbkeyCol = &ColDef{}
-			}
-			if err := m.CompCbkeyCol.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Timing = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPlan
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PropertyDef) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPlan
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PropertyDef: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PropertyDef: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Event = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateSql", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreateSql = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Definer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Definer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MaterializedViewDef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaterializedViewDef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaterializedViewDef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Database = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyCols", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyCols = append(m.KeyCols, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ViewKeyCols", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ViewKeyCols = append(m.ViewKeyCols, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseTables", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseTables = append(m.BaseTables, &ObjectRef{})
+			if err := m.BaseTables[len(m.BaseTables)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterByDef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterByDef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterByDef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompCbkeyCol", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CompCbkeyCol == nil {
+				m.CompCbkeyCol = &ColDef{}
+			}
+			if err := m.CompCbkeyCol.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PropertyDef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PropertyDef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PropertyDef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36844,6 +40266,110 @@ func (m *TableDef) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Triggers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Triggers = append(m.Triggers, &TriggerDef{})
+			if err := m.Triggers[len(m.Triggers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mview", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mview == nil {
+				m.Mview = &MaterializedViewDef{}
+			}
+			if err := m.Mview.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefMviews", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefMviews = append(m.RefMviews, &MaterializedViewDef{})
+			if err := m.RefMviews[len(m.RefMviews)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 21:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
@@ -38611,62 +42137,11 @@ func (m *WindowSpec) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SampleFuncSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPlan
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SampleFuncSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SampleFuncSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rows", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreNulls", wireType)
 			}
-			m.Rows = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -38676,25 +42151,15 @@ func (m *SampleFuncSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Rows |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Percent", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Percent = float64(math.Float64frombits(v))
-		case 3:
+			m.IgnoreNulls = bool(v != 0)
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsingRow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromLast", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -38711,7 +42176,7 @@ func (m *SampleFuncSpec) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.UsingRow = bool(v != 0)
+			m.FromLast = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -38734,7 +42199,7 @@ func (m *SampleFuncSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FrameClause) Unmarshal(dAtA []byte) error {
+func (m *SampleFuncSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38757,17 +42222,17 @@ func (m *FrameClause) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FrameClause: wiretype end group for non-group")
+			return fmt.Errorf("proto: SampleFuncSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FrameClause: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SampleFuncSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rows", wireType)
 			}
-			m.Type = 0
+			m.Rows = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -38777,52 +42242,27 @@ func (m *FrameClause) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= FrameClause_FrameType(b&0x7F) << shift
+				m.Rows |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percent", wireType)
 			}
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Start == nil {
-				m.Start = &FrameBound{}
-			}
-			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Percent = float64(math.Float64frombits(v))
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsingRow", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -38832,28 +42272,12 @@ func (m *FrameClause) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.End == nil {
-				m.End = &FrameBound{}
-			}
-			if err := m.End.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.UsingRow = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -38876,7 +42300,7 @@ func (m *FrameClause) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FrameBound) Unmarshal(dAtA []byte) error {
+func (m *FrameClause) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38899,10 +42323,10 @@ func (m *FrameBound) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FrameBound: wiretype end group for non-group")
+			return fmt.Errorf("proto: FrameClause: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FrameBound: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FrameClause: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -38919,34 +42343,176 @@ func (m *FrameBound) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= FrameBound_BoundType(b&0x7F) << shift
+				m.Type |= FrameClause_FrameType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnBounded", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.UnBounded = bool(v != 0)
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Start == nil {
+				m.Start = &FrameBound{}
+			}
+			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.End == nil {
+				m.End = &FrameBound{}
+			}
+			if err := m.End.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FrameBound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FrameBound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FrameBound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= FrameBound_BoundType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnBounded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UnBounded = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39975,6 +43541,42 @@ func (m *UpdateCtx) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsertFilterCol", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InsertFilterCol == nil {
+				m.InsertFilterCol = &ColRef{}
+			}
+			if err := m.InsertFilterCol.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -45006,6 +48608,83 @@ func (m *LockTarget) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.LockTableAtTheEnd = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitPolicy", wireType)
+			}
+			m.WaitPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WaitPolicy |= lock.WaitPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitSec", wireType)
+			}
+			m.WaitSec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WaitSec |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipLocked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkipLocked = bool(v != 0)
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipLockedLimit", wireType)
+			}
+			m.SkipLockedLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SkipLockedLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -46601,6 +50280,842 @@ func (m *PostDmlFullTextCtx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PostDmlHnswCtx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PostDmlHnswCtx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PostDmlHnswCtx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceTableName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceTableName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexTableName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexTableName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parts = append(m.Parts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlgoParams", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AlgoParams = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PostDmlTriggerAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PostDmlTriggerAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PostDmlTriggerAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guard", wireType)
+			}
+			m.Guard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Guard |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SqlParts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SqlParts = append(m.SqlParts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Args = append(m.Args, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPlan
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPlan
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Args) == 0 {
+					m.Args = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPlan
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Args = append(m.Args, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SqlState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SqlState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errno", wireType)
+			}
+			m.Errno = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Errno |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			m.Message = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Message |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PostDmlTriggerCtx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PostDmlTriggerCtx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PostDmlTriggerCtx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actions = append(m.Actions, &PostDmlTriggerAction{})
+			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PostDmlMaterializedView) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PostDmlMaterializedView: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PostDmlMaterializedView: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field View", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.View == nil {
+				m.View = &MaterializedViewDef{}
+			}
+			if err := m.View.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.OldKeyPos = append(m.OldKeyPos, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPlan
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPlan
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.OldKeyPos) == 0 {
+					m.OldKeyPos = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPlan
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.OldKeyPos = append(m.OldKeyPos, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldKeyPos", wireType)
+			}
+		case 3:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.NewKeyPos = append(m.NewKeyPos, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPlan
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPlan
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.NewKeyPos) == 0 {
+					m.NewKeyPos = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPlan
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.NewKeyPos = append(m.NewKeyPos, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewKeyPos", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PostDmlMaterializedViewCtx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PostDmlMaterializedViewCtx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PostDmlMaterializedViewCtx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Views", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Views = append(m.Views, &PostDmlMaterializedView{})
+			if err := m.Views[len(m.Views)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *PostDmlCtx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -46659,57 +51174,221 @@ func (m *PostDmlCtx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Ref == nil {
-				m.Ref = &ObjectRef{}
+			if m.Ref == nil {
+				m.Ref = &ObjectRef{}
+			}
+			if err := m.Ref.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddAffectedRows", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AddAffectedRows = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKeyIdx", wireType)
+			}
+			m.PrimaryKeyIdx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrimaryKeyIdx |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKeyName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrimaryKeyName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsDelete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsDelete = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsInsert", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsInsert = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsDeleteWithoutFilters", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsDeleteWithoutFilters = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FullText", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FullText == nil {
+				m.FullText = &PostDmlFullTextCtx{}
+			}
+			if err := m.FullText.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hnsw", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hnsw == nil {
+				m.Hnsw = &PostDmlHnswCtx{}
 			}
-			if err := m.Ref.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Hnsw.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddAffectedRows", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.AddAffectedRows = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKeyIdx", wireType)
-			}
-			m.PrimaryKeyIdx = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PrimaryKeyIdx |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKeyName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -46719,87 +51398,31 @@ func (m *PostDmlCtx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrimaryKeyName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsDelete", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsDelete = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsInsert", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsInsert = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsDeleteWithoutFilters", wireType)
+			if m.Trigger == nil {
+				m.Trigger = &PostDmlTriggerCtx{}
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Trigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.IsDeleteWithoutFilters = bool(v != 0)
-		case 8:
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FullText", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mview", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -46826,10 +51449,10 @@ func (m *PostDmlCtx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FullText == nil {
-				m.FullText = &PostDmlFullTextCtx{}
+			if m.Mview == nil {
+				m.Mview = &PostDmlMaterializedViewCtx{}
 			}
-			if err := m.FullText.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Mview.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -47985,50 +52608,226 @@ func (m *DataControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Prepare{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Control = &DataControl_Prepare{v}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Execute", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			v := &Prepare{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Control = &DataControl_Prepare{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Execute", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Execute{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Control = &DataControl_Execute{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deallocate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Deallocate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Control = &DataControl_Deallocate{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Other", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &OtherDCL{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Control = &DataControl_Other{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataDefinition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataDefinition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataDefinition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DdlType", wireType)
+			}
+			m.DdlType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DdlType |= DataDefinition_DdlType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Query == nil {
+				m.Query = &Query{}
 			}
-			v := &Execute{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Query.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Control = &DataControl_Execute{v}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deallocate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateDatabase", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48055,15 +52854,15 @@ func (m *DataControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Deallocate{}
+			v := &CreateDatabase{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Control = &DataControl_Deallocate{v}
+			m.Definition = &DataDefinition_CreateDatabase{v}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Other", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AlterDatabase", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48090,85 +52889,15 @@ func (m *DataControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &OtherDCL{}
+			v := &AlterDatabase{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Control = &DataControl_Other{v}
+			m.Definition = &DataDefinition_AlterDatabase{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DataDefinition) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPlan
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DataDefinition: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataDefinition: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DdlType", wireType)
-			}
-			m.DdlType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DdlType |= DataDefinition_DdlType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DropDatabase", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48195,16 +52924,15 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Query == nil {
-				m.Query = &Query{}
-			}
-			if err := m.Query.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &DropDatabase{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Definition = &DataDefinition_DropDatabase{v}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateDatabase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTable", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48231,15 +52959,15 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &CreateDatabase{}
+			v := &CreateTable{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_CreateDatabase{v}
+			m.Definition = &DataDefinition_CreateTable{v}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AlterDatabase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AlterTable", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48266,15 +52994,15 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &AlterDatabase{}
+			v := &AlterTable{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_AlterDatabase{v}
+			m.Definition = &DataDefinition_AlterTable{v}
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DropDatabase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DropTable", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48301,15 +53029,15 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &DropDatabase{}
+			v := &DropTable{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_DropDatabase{v}
+			m.Definition = &DataDefinition_DropTable{v}
 			iNdEx = postIndex
-		case 6:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateTable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateIndex", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48336,15 +53064,15 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &CreateTable{}
+			v := &CreateIndex{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_CreateTable{v}
+			m.Definition = &DataDefinition_CreateIndex{v}
 			iNdEx = postIndex
-		case 7:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AlterTable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AlterIndex", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48371,15 +53099,15 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &AlterTable{}
+			v := &AlterIndex{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_AlterTable{v}
+			m.Definition = &DataDefinition_AlterIndex{v}
 			iNdEx = postIndex
-		case 8:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DropTable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DropIndex", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48406,15 +53134,15 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &DropTable{}
+			v := &DropIndex{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_DropTable{v}
+			m.Definition = &DataDefinition_DropIndex{v}
 			iNdEx = postIndex
-		case 9:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TruncateTable", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48441,15 +53169,15 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &CreateIndex{}
+			v := &TruncateTable{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_CreateIndex{v}
+			m.Definition = &DataDefinition_TruncateTable{v}
 			iNdEx = postIndex
-		case 10:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AlterIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShowVariables", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48476,15 +53204,15 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &AlterIndex{}
+			v := &ShowVariables{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_AlterIndex{v}
+			m.Definition = &DataDefinition_ShowVariables{v}
 			iNdEx = postIndex
-		case 11:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DropIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AlterView", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48511,15 +53239,15 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &DropIndex{}
+			v := &AlterView{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_DropIndex{v}
+			m.Definition = &DataDefinition_AlterView{v}
 			iNdEx = postIndex
-		case 12:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TruncateTable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockTables", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48546,15 +53274,15 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &TruncateTable{}
+			v := &LockTables{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_TruncateTable{v}
+			m.Definition = &DataDefinition_LockTables{v}
 			iNdEx = postIndex
-		case 13:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShowVariables", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTables", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48581,15 +53309,15 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ShowVariables{}
+			v := &UnLockTables{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_ShowVariables{v}
+			m.Definition = &DataDefinition_UnlockTables{v}
 			iNdEx = postIndex
-		case 14:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AlterView", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateSequence", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48616,15 +53344,15 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &AlterView{}
+			v := &CreateSequence{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_AlterView{v}
+			m.Definition = &DataDefinition_CreateSequence{v}
 			iNdEx = postIndex
-		case 15:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockTables", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DropSequence", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48651,15 +53379,15 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &LockTables{}
+			v := &DropSequence{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_LockTables{v}
+			m.Definition = &DataDefinition_DropSequence{v}
 			iNdEx = postIndex
-		case 16:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTables", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AlterSequence", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48686,15 +53414,15 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &UnLockTables{}
+			v := &AlterSequence{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_UnlockTables{v}
+			m.Definition = &DataDefinition_AlterSequence{v}
 			iNdEx = postIndex
-		case 17:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateSequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateView", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48721,15 +53449,15 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &CreateSequence{}
+			v := &CreateView{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_CreateSequence{v}
+			m.Definition = &DataDefinition_CreateView{v}
 			iNdEx = postIndex
-		case 18:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DropSequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RenameTable", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48756,15 +53484,15 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &DropSequence{}
+			v := &RenameTable{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_DropSequence{v}
+			m.Definition = &DataDefinition_RenameTable{v}
 			iNdEx = postIndex
-		case 19:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AlterSequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTrigger", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48791,15 +53519,15 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &AlterSequence{}
+			v := &CreateTrigger{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_AlterSequence{v}
+			m.Definition = &DataDefinition_CreateTrigger{v}
 			iNdEx = postIndex
-		case 20:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateView", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DropTrigger", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48826,15 +53554,15 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &CreateView{}
+			v := &DropTrigger{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_CreateView{v}
+			m.Definition = &DataDefinition_DropTrigger{v}
 			iNdEx = postIndex
-		case 21:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RenameTable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshMaterializedView", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48861,11 +53589,11 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &RenameTable{}
+			v := &RefreshMaterializedView{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_RenameTable{v}
+			m.Definition = &DataDefinition_RefreshMaterializedView{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -50210,6 +54938,176 @@ func (m *CreateTable) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ttl == nil {
+				m.Ttl = &TableTTL{}
+			}
+			if err := m.Ttl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TableTTL) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TableTTL: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TableTTL: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Column", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Column = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -51502,6 +56400,93 @@ func (m *AlterTableComment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AlterTableTTL) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableTTL: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableTTL: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ttl == nil {
+				m.Ttl = &TableTTL{}
+			}
+			if err := m.Ttl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *AlterTableName) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -52808,6 +57793,41 @@ func (m *AlterTable_Action) Unmarshal(dAtA []byte) error {
 			}
 			m.Action = &AlterTable_Action_AddPartition{v}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlterTtl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterTableTTL{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &AlterTable_Action_AlterTtl{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -53406,10 +58426,447 @@ func (m *CreateView) Unmarshal(dAtA []byte) error {
 			m.Database = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replace", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replace", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Replace = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableDef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TableDef == nil {
+				m.TableDef = &TableDef{}
+			}
+			if err := m.TableDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterView) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterView: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterView: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IfExists", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IfExists = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Database = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableDef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TableDef == nil {
+				m.TableDef = &TableDef{}
+			}
+			if err := m.TableDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateSequence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateSequence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateSequence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IfNotExists", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IfNotExists = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Database = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableDef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TableDef == nil {
+				m.TableDef = &TableDef{}
+			}
+			if err := m.TableDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DropSequence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DropSequence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DropSequence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IfExists", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IfExists = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Database = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -53419,17 +58876,29 @@ func (m *CreateView) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Replace = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Table = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableDef", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableId", wireType)
 			}
-			var msglen int
+			m.TableId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -53439,28 +58908,11 @@ func (m *CreateView) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TableId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TableDef == nil {
-				m.TableDef = &TableDef{}
-			}
-			if err := m.TableDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -53483,7 +58935,7 @@ func (m *CreateView) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AlterView) Unmarshal(dAtA []byte) error {
+func (m *AlterSequence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -53506,10 +58958,10 @@ func (m *AlterView) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlterView: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterSequence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterView: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterSequence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -53564,7 +59016,7 @@ func (m *AlterView) Unmarshal(dAtA []byte) error {
 			}
 			m.Database = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TableDef", wireType)
 			}
@@ -53622,7 +59074,7 @@ func (m *AlterView) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateSequence) Unmarshal(dAtA []byte) error {
+func (m *CreateIndex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -53645,17 +59097,17 @@ func (m *CreateSequence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateSequence: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateIndex: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateSequence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateIndex: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IfNotExists", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -53665,15 +59117,27 @@ func (m *CreateSequence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IfNotExists = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Database = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -53701,7 +59165,7 @@ func (m *CreateSequence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Database = string(dAtA[iNdEx:postIndex])
+			m.Table = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -53739,6 +59203,94 @@ func (m *CreateSequence) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginTablePrimaryKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginTablePrimaryKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Index == nil {
+				m.Index = &CreateTable{}
+			}
+			if err := m.Index.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableExist", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TableExist = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -53761,7 +59313,7 @@ func (m *CreateSequence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DropSequence) Unmarshal(dAtA []byte) error {
+func (m *AlterIndex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -53784,67 +59336,15 @@ func (m *DropSequence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DropSequence: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterIndex: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DropSequence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterIndex: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IfExists", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IfExists = bool(v != 0)
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Database = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -53872,27 +59372,8 @@ func (m *DropSequence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Table = string(dAtA[iNdEx:postIndex])
+			m.Index = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableId", wireType)
-			}
-			m.TableId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TableId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -53915,7 +59396,7 @@ func (m *DropSequence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AlterSequence) Unmarshal(dAtA []byte) error {
+func (m *DropIndex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -53938,17 +59419,17 @@ func (m *AlterSequence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlterSequence: wiretype end group for non-group")
+			return fmt.Errorf("proto: DropIndex: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterSequence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DropIndex: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IfExists", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -53958,15 +59439,27 @@ func (m *AlterSequence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IfExists = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Database = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -53994,13 +59487,13 @@ func (m *AlterSequence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Database = string(dAtA[iNdEx:postIndex])
+			m.Table = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableDef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -54010,27 +59503,55 @@ func (m *AlterSequence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TableDef == nil {
-				m.TableDef = &TableDef{}
+			m.IndexName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexTableName", wireType)
 			}
-			if err := m.TableDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexTableName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -54054,7 +59575,7 @@ func (m *AlterSequence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateIndex) Unmarshal(dAtA []byte) error {
+func (m *CreateTrigger) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -54077,10 +59598,10 @@ func (m *CreateIndex) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateIndex: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateTrigger: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -54148,8 +59669,28 @@ func (m *CreateIndex) Unmarshal(dAtA []byte) error {
 			m.Table = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IfNotExists", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IfNotExists = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableDef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -54176,16 +59717,16 @@ func (m *CreateIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TableDef == nil {
-				m.TableDef = &TableDef{}
+			if m.Trigger == nil {
+				m.Trigger = &TriggerDef{}
 			}
-			if err := m.TableDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Trigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginTablePrimaryKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderTrigger", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -54213,13 +59754,13 @@ func (m *CreateIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginTablePrimaryKey = string(dAtA[iNdEx:postIndex])
+			m.OrderTrigger = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Follows", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -54229,33 +59770,17 @@ func (m *CreateIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Index == nil {
-				m.Index = &CreateTable{}
-			}
-			if err := m.Index.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableExist", wireType)
+			m.Follows = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionStatement", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -54265,12 +59790,24 @@ func (m *CreateIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.TableExist = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActionStatement = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -54293,7 +59830,7 @@ func (m *CreateIndex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AlterIndex) Unmarshal(dAtA []byte) error {
+func (m *DropTrigger) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -54316,15 +59853,15 @@ func (m *AlterIndex) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlterIndex: wiretype end group for non-group")
+			return fmt.Errorf("proto: DropTrigger: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DropTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -54352,8 +59889,60 @@ func (m *AlterIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			m.Database = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IfExists", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IfExists = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -54376,7 +59965,7 @@ func (m *AlterIndex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DropIndex) Unmarshal(dAtA []byte) error {
+func (m *RefreshMaterializedView) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -54399,10 +59988,10 @@ func (m *DropIndex) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DropIndex: wiretype end group for non-group")
+			return fmt.Errorf("proto: RefreshMaterializedView: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DropIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RefreshMaterializedView: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -54471,9 +60060,9 @@ func (m *DropIndex) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mview", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -54483,55 +60072,27 @@ func (m *DropIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IndexName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexTableName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPlan
+			if m.Mview == nil {
+				m.Mview = &MaterializedViewDef{}
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Mview.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.IndexTableName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
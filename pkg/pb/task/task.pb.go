@@ -115,6 +115,8 @@ const (
 	TaskCode_InitCdc TaskCode = 7
 	// MO Table Stats Task
 	TaskCode_MOTableStats TaskCode = 8
+	// EventScheduler runs the due events of CREATE EVENT
+	TaskCode_EventScheduler TaskCode = 9
 )
 
 var TaskCode_name = map[int32]string{
@@ -127,6 +129,7 @@ var TaskCode_name = map[int32]string{
 	6: "Retention",
 	7: "InitCdc",
 	8: "MOTableStats",
+	9: "EventScheduler",
 }
 
 var TaskCode_value = map[string]int32{
@@ -139,6 +142,7 @@ var TaskCode_value = map[string]int32{
 	"Retention":          6,
 	"InitCdc":            7,
 	"MOTableStats":       8,
+	"EventScheduler":     9,
 }
 
 func (x TaskCode) String() string {
@@ -1251,94 +1255,94 @@ func init() {
 func init() { proto.RegisterFile("task.proto", fileDescriptor_ce5d8dd45b4a91ff) }
 
 var fileDescriptor_ce5d8dd45b4a91ff = []byte{
-	// 1380 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xfa, 0xbf, 0x9f, 0xed, 0x74, 0x3b, 0xad, 0xa2, 0x95, 0x55, 0x52, 0xcb, 0x14, 0x11,
-	0x22, 0xd5, 0x81, 0x50, 0x10, 0xad, 0x04, 0x6a, 0x6a, 0x07, 0x35, 0xb4, 0x69, 0xaa, 0x89, 0x73,
-	0xe1, 0x36, 0x5e, 0x4f, 0xb7, 0x8b, 0xed, 0x59, 0x77, 0x76, 0xb6, 0xc4, 0x5f, 0x21, 0x27, 0x6e,
-	0x9c, 0x22, 0xf5, 0x5e, 0x89, 0xaf, 0xc0, 0xb5, 0xc7, 0x7e, 0x02, 0xfe, 0x14, 0x3e, 0x02, 0x57,
-	0x24, 0x34, 0x7f, 0x76, 0x76, 0xed, 0x02, 0x52, 0xa4, 0xde, 0xf6, 0xfd, 0xde, 0x7b, 0x33, 0xef,
-	0xfd, 0xde, 0x1f, 0x8f, 0x01, 0x04, 0x89, 0x27, 0xbd, 0x39, 0x8f, 0x44, 0x84, 0x4a, 0xf2, 0xbb,
-	0x7d, 0x33, 0x08, 0xc5, 0xd3, 0x64, 0xd4, 0xf3, 0xa3, 0xd9, 0x4e, 0x10, 0x05, 0xd1, 0x8e, 0x52,
-	0x8e, 0x92, 0x27, 0x4a, 0x52, 0x82, 0xfa, 0xd2, 0x4e, 0xed, 0xeb, 0x41, 0x14, 0x05, 0x53, 0x9a,
-	0x59, 0x89, 0x70, 0x46, 0x63, 0x41, 0x66, 0x73, 0x63, 0xb0, 0x3e, 0xa3, 0x82, 0x8c, 0x89, 0x20,
-	0x5a, 0xee, 0xfe, 0xe8, 0x40, 0x73, 0x48, 0xe2, 0xc9, 0xa1, 0x81, 0xd1, 0x3a, 0x14, 0x0e, 0x06,
-	0x9e, 0xd3, 0x71, 0xb6, 0xea, 0xb8, 0x70, 0x30, 0x40, 0xdb, 0x50, 0xdb, 0x3f, 0xa5, 0x7e, 0x22,
-	0x22, 0xee, 0x15, 0x3a, 0xce, 0xd6, 0xfa, 0xee, 0x7a, 0x4f, 0x45, 0x29, 0xbd, 0xfa, 0xd1, 0x98,
-	0x62, 0xab, 0x47, 0x1e, 0x54, 0xfb, 0x11, 0x13, 0xf4, 0x54, 0x78, 0xc5, 0x8e, 0xb3, 0xd5, 0xc4,
-	0xa9, 0x88, 0x3e, 0x81, 0xea, 0xd1, 0x5c, 0x84, 0x11, 0x8b, 0xbd, 0x52, 0xc7, 0xd9, 0x6a, 0xec,
-	0x5e, 0xce, 0x0e, 0x31, 0x8a, 0x7b, 0xa5, 0x57, 0xbf, 0x5c, 0x5f, 0xc3, 0xa9, 0x5d, 0xf7, 0xe7,
-	0x02, 0x34, 0x72, 0x6a, 0x74, 0x03, 0x5a, 0x87, 0xe4, 0x14, 0x53, 0xc1, 0x17, 0x43, 0x99, 0x94,
-	0x8a, 0xb1, 0x85, 0x97, 0x41, 0x69, 0xa5, 0xa4, 0x03, 0x26, 0x28, 0x7f, 0x4e, 0xa6, 0x2a, 0xe6,
-	0x22, 0x5e, 0x06, 0xa5, 0xd5, 0x80, 0x4e, 0xc9, 0x62, 0x90, 0x70, 0x22, 0x4f, 0x57, 0xe1, 0x16,
-	0xf1, 0x32, 0x88, 0x3a, 0xd0, 0xe8, 0x47, 0xcc, 0x4f, 0x38, 0xa7, 0xcc, 0x5f, 0xa8, 0xc0, 0x5b,
-	0x38, 0x0f, 0xa1, 0xcf, 0xa0, 0xf2, 0x90, 0x8c, 0xe8, 0x34, 0xf6, 0xca, 0x9d, 0xe2, 0x56, 0x63,
-	0xf7, 0xbd, 0xb7, 0xb2, 0xea, 0x69, 0xfd, 0x3e, 0x13, 0x7c, 0x81, 0x8d, 0xb1, 0xe4, 0x14, 0xd3,
-	0x38, 0x4a, 0xb8, 0x4f, 0xbd, 0x8a, 0xa2, 0xc3, 0x70, 0x9a, 0xa2, 0xd8, 0xea, 0xdb, 0xb7, 0xa1,
-	0x91, 0x3b, 0x02, 0xb9, 0x50, 0x9c, 0xd0, 0x85, 0xa9, 0x8f, 0xfc, 0x44, 0x57, 0xa1, 0xfc, 0x9c,
-	0x4c, 0x13, 0xaa, 0x32, 0xad, 0x63, 0x2d, 0xdc, 0x29, 0x7c, 0xe1, 0x74, 0x6f, 0x65, 0xd7, 0x48,
-	0xbf, 0xfe, 0xe3, 0x13, 0xe5, 0x57, 0xc2, 0xf2, 0x13, 0x6d, 0x40, 0xe5, 0x90, 0xce, 0x22, 0xbe,
-	0x50, 0x8e, 0x25, 0x6c, 0xa4, 0xee, 0x03, 0x68, 0xe9, 0x82, 0x52, 0x4c, 0xe3, 0x64, 0x2a, 0xd0,
-	0x0d, 0x28, 0xc9, 0x3a, 0x2b, 0xdf, 0xf5, 0x5d, 0xd7, 0x46, 0x9a, 0x4c, 0x85, 0xaa, 0xbf, 0xd2,
-	0xca, 0x30, 0xf6, 0x39, 0x37, 0x4d, 0x52, 0xc7, 0x5a, 0xe8, 0xfe, 0x55, 0x80, 0xfa, 0x5e, 0xbc,
-	0x60, 0xbe, 0xa4, 0x24, 0xd7, 0x5b, 0x25, 0xd5, 0x5b, 0xb7, 0xa0, 0x96, 0xf6, 0x9d, 0x72, 0x6b,
-	0xec, 0xa2, 0x8c, 0xc0, 0x54, 0x63, 0xfa, 0xc2, 0x5a, 0xa2, 0x2e, 0x34, 0x1f, 0x13, 0x4e, 0x99,
-	0x90, 0x56, 0x07, 0x03, 0x55, 0xbb, 0x3a, 0x5e, 0xc2, 0xd0, 0x16, 0x54, 0x8e, 0x05, 0x11, 0x89,
-	0x6e, 0x37, 0x1b, 0xb5, 0xd4, 0x6a, 0x1c, 0x1b, 0x3d, 0xda, 0x04, 0x90, 0x28, 0x4e, 0x18, 0xa3,
-	0xdc, 0x2b, 0xab, 0xb3, 0x72, 0x88, 0xca, 0x6b, 0x1e, 0xf9, 0x4f, 0x55, 0xa1, 0x5a, 0x58, 0x0b,
-	0xb2, 0x81, 0x1e, 0x92, 0x58, 0xdc, 0xa7, 0x84, 0x8b, 0x11, 0x25, 0xc2, 0xab, 0xea, 0x06, 0x5a,
-	0x02, 0x51, 0x1b, 0x6a, 0x7d, 0x4e, 0x89, 0xa0, 0x7b, 0xc2, 0xab, 0x29, 0x03, 0x2b, 0xeb, 0xe6,
-	0x9a, 0xcd, 0xa7, 0x54, 0xd0, 0xf1, 0x9e, 0xf0, 0xea, 0x4a, 0x9d, 0x87, 0xd0, 0xed, 0x95, 0x42,
-	0x78, 0xa0, 0x28, 0xba, 0xa2, 0x53, 0x59, 0x52, 0xe1, 0x65, 0xcb, 0xee, 0x9f, 0x8e, 0xbc, 0x39,
-	0x62, 0xef, 0x90, 0xf5, 0xb6, 0x3e, 0x71, 0xff, 0x74, 0xce, 0x0d, 0xe3, 0x56, 0x96, 0xba, 0x47,
-	0xf4, 0x54, 0xc8, 0x09, 0x54, 0x7c, 0x17, 0xb1, 0x95, 0x65, 0xb5, 0x86, 0x3c, 0x0c, 0x02, 0xca,
-	0xf5, 0xd4, 0x96, 0x55, 0x1c, 0x4b, 0xd8, 0x12, 0x4f, 0x95, 0x15, 0x9e, 0xda, 0x50, 0x3b, 0x99,
-	0x8f, 0xb5, 0x4e, 0x93, 0x6c, 0xe5, 0xee, 0x4b, 0x07, 0xdc, 0x7e, 0xc4, 0x18, 0xf5, 0x45, 0xc4,
-	0x07, 0x54, 0x90, 0x70, 0x1a, 0xa3, 0x6b, 0x50, 0x1f, 0x92, 0xd1, 0x94, 0x3e, 0x22, 0x33, 0x6a,
-	0xe6, 0x24, 0x03, 0xd0, 0x97, 0xd9, 0x22, 0x2a, 0xa8, 0x91, 0x7d, 0x5f, 0xe7, 0xbe, 0x7a, 0x4c,
-	0xcf, 0x58, 0xe9, 0xc1, 0x4d, 0x7d, 0xda, 0x77, 0xa0, 0x99, 0x57, 0x5c, 0x68, 0x1c, 0x6f, 0x42,
-	0x75, 0xcf, 0xf7, 0xa3, 0x84, 0x09, 0x55, 0x92, 0xb1, 0x2d, 0xc9, 0x18, 0x21, 0x28, 0xa9, 0x70,
-	0xb5, 0x8f, 0xfa, 0xee, 0x3e, 0x03, 0x57, 0x93, 0xd0, 0x1f, 0xfb, 0x69, 0x6e, 0x1b, 0x50, 0x51,
-	0x0d, 0x3e, 0x36, 0x37, 0x1a, 0x49, 0x92, 0x24, 0xbf, 0x72, 0x67, 0x58, 0x19, 0x7d, 0x04, 0x35,
-	0x73, 0x6d, 0xec, 0x15, 0x55, 0xca, 0x2d, 0x9d, 0xb2, 0x41, 0xb1, 0x55, 0x77, 0x11, 0xb8, 0x98,
-	0x0a, 0xca, 0x64, 0x82, 0xe6, 0xca, 0xee, 0xab, 0x02, 0x54, 0xd3, 0xeb, 0x3b, 0xd0, 0x18, 0xd0,
-	0xd8, 0xe7, 0xa1, 0xa2, 0xc0, 0xc4, 0x90, 0x87, 0x24, 0xf9, 0xe6, 0xb4, 0x83, 0x81, 0x8a, 0xa4,
-	0x85, 0x33, 0x40, 0xfe, 0x3e, 0x18, 0xc1, 0xb4, 0x90, 0x25, 0x44, 0x56, 0x39, 0xa6, 0x9c, 0x11,
-	0xd3, 0x41, 0x75, 0x6c, 0xe5, 0x6c, 0xb3, 0x94, 0x73, 0x9b, 0x05, 0x7d, 0x0e, 0x75, 0x5b, 0x33,
-	0x33, 0x19, 0x1b, 0xff, 0x5e, 0xca, 0xfb, 0x6b, 0x38, 0x33, 0x95, 0x7e, 0x36, 0x47, 0xaf, 0x91,
-	0xf7, 0x5b, 0x4d, 0x5d, 0xfa, 0x59, 0x4c, 0xdd, 0x97, 0x96, 0xc3, 0x6b, 0x2e, 0xdd, 0xb7, 0x52,
-	0x25, 0x75, 0x5f, 0x8a, 0xdd, 0xab, 0x5b, 0xfa, 0xba, 0x7f, 0x97, 0x00, 0x06, 0x84, 0xce, 0xde,
-	0xe9, 0x5c, 0x2e, 0x31, 0x5e, 0xfc, 0x1f, 0xc6, 0x4b, 0xcb, 0x8c, 0x6f, 0xeb, 0x96, 0x19, 0x2e,
-	0xe6, 0x54, 0x11, 0xbb, 0xf4, 0xbb, 0x2e, 0x51, 0x6c, 0xf5, 0x2b, 0x3b, 0xb2, 0xf2, 0xd6, 0x8e,
-	0xfc, 0x58, 0xeb, 0xcd, 0xc6, 0xad, 0xfe, 0xc7, 0xc6, 0xcd, 0xd9, 0xa0, 0x6f, 0x56, 0xf7, 0x67,
-	0x4d, 0x25, 0xdc, 0xee, 0xe9, 0xf7, 0x4b, 0x2f, 0x7d, 0xbf, 0xf4, 0x86, 0xe9, 0xfb, 0xe5, 0x5e,
-	0x4d, 0x26, 0xfe, 0xc3, 0xaf, 0xd7, 0x9d, 0xd5, 0x2d, 0xfb, 0xa1, 0x65, 0x58, 0x6d, 0x51, 0xdb,
-	0xdf, 0x06, 0xc4, 0xb6, 0x7d, 0xef, 0xe6, 0xd6, 0x0c, 0x5c, 0xe0, 0xbe, 0x6c, 0x19, 0xdd, 0xcd,
-	0x2d, 0xa3, 0xc6, 0x45, 0x4e, 0x48, 0xbd, 0xd0, 0x1d, 0x28, 0xef, 0x33, 0xb9, 0xf0, 0x9b, 0x17,
-	0x70, 0xd7, 0x2e, 0xe8, 0x2b, 0xa8, 0xca, 0xcc, 0x71, 0xc2, 0xbc, 0xd6, 0x05, 0xbc, 0x53, 0xa7,
-	0xed, 0x9f, 0x9c, 0x7c, 0x9d, 0x50, 0x03, 0xaa, 0x3a, 0xb1, 0xb1, 0xbb, 0x26, 0x05, 0x59, 0xcc,
-	0x90, 0x05, 0xae, 0x83, 0x5a, 0x72, 0xb6, 0xcc, 0x0f, 0x91, 0x5b, 0x40, 0x00, 0x95, 0xc7, 0x24,
-	0x89, 0xe9, 0xd8, 0x2d, 0xa2, 0xba, 0x19, 0x46, 0xb7, 0x84, 0x9a, 0x50, 0xeb, 0x13, 0xe6, 0xd3,
-	0x29, 0x1d, 0xbb, 0x65, 0x74, 0x05, 0x2e, 0xc9, 0x1f, 0x9f, 0x19, 0xc5, 0xf4, 0x59, 0x42, 0x63,
-	0xe9, 0x59, 0x41, 0x08, 0xd6, 0x95, 0x67, 0x86, 0x55, 0xa5, 0xa1, 0x76, 0xcb, 0xc0, 0x1a, 0xba,
-	0x2a, 0x37, 0x4f, 0x2c, 0x08, 0x17, 0x19, 0x5a, 0xdf, 0x7e, 0xe9, 0xe8, 0x26, 0x55, 0x0f, 0x8c,
-	0x26, 0xd4, 0x86, 0x34, 0x16, 0x47, 0x6c, 0xba, 0x70, 0xd7, 0xd0, 0x3a, 0xc0, 0xf1, 0x22, 0x16,
-	0x74, 0x76, 0xc0, 0x42, 0xe1, 0x3a, 0xf2, 0xa6, 0x43, 0x2a, 0x78, 0xe8, 0x3f, 0x8c, 0x82, 0x43,
-	0xca, 0x03, 0xea, 0x16, 0xd0, 0x06, 0x20, 0x8d, 0x1d, 0x8b, 0x88, 0x93, 0x80, 0x9e, 0xc4, 0x24,
-	0xa0, 0x6e, 0x51, 0xe2, 0x76, 0x1f, 0x3c, 0x20, 0x4f, 0x26, 0xe4, 0x38, 0x64, 0x13, 0xb7, 0x84,
-	0x2e, 0x41, 0x43, 0xb9, 0x1e, 0x8d, 0xbe, 0xa3, 0xbe, 0x70, 0xcb, 0x92, 0x07, 0xbb, 0x00, 0xdc,
-	0x8a, 0xe4, 0x48, 0xde, 0xd6, 0x1f, 0xfb, 0x6e, 0x15, 0xb9, 0xd0, 0x3c, 0x3c, 0x52, 0xbf, 0x2b,
-	0x92, 0xce, 0xd8, 0xad, 0x6d, 0x7f, 0x00, 0x90, 0xbd, 0x8a, 0xa4, 0xf1, 0x71, 0xe2, 0xfb, 0x34,
-	0x8e, 0xdd, 0x35, 0xc9, 0xe0, 0xd7, 0x24, 0x94, 0x44, 0x39, 0xdb, 0x2f, 0x9c, 0x6c, 0xf2, 0xd0,
-	0x35, 0xa8, 0x9e, 0xb0, 0x09, 0x8b, 0xbe, 0x67, 0xee, 0x5a, 0xfb, 0xd2, 0xd9, 0x79, 0xa7, 0x21,
-	0x61, 0x03, 0xa1, 0x5d, 0x40, 0x36, 0x3e, 0x1b, 0xb1, 0xeb, 0xb4, 0xdb, 0x67, 0xe7, 0x9d, 0x0d,
-	0x69, 0xf8, 0xb6, 0xd6, 0x3c, 0x80, 0x75, 0xcc, 0xd2, 0xc4, 0x2d, 0xb4, 0x2f, 0x9f, 0x9d, 0x77,
-	0x5a, 0x6a, 0xa4, 0xed, 0x36, 0xbb, 0x96, 0xdb, 0x66, 0x6e, 0xb1, 0xdd, 0x3a, 0x3b, 0xef, 0xe4,
-	0x76, 0x56, 0xff, 0xf5, 0xef, 0x9b, 0xce, 0xab, 0x37, 0x9b, 0xce, 0xeb, 0x37, 0x9b, 0xce, 0x6f,
-	0x6f, 0x36, 0xd7, 0x5e, 0xfc, 0xb1, 0xe9, 0x7c, 0x9b, 0xff, 0x2b, 0x32, 0x23, 0x82, 0x87, 0xa7,
-	0x11, 0x0f, 0x83, 0x90, 0xa5, 0x02, 0xa3, 0x3b, 0xf3, 0x49, 0xb0, 0x33, 0x1f, 0xed, 0xc8, 0xf1,
-	0x1b, 0x55, 0x54, 0x53, 0x7e, 0xfa, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x23, 0xd8, 0xb8, 0x6b,
-	0xd4, 0x0c, 0x00, 0x00,
+	// 1392 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcd, 0x6e, 0x1b, 0x47,
+	0x12, 0xd6, 0xf0, 0x9f, 0x45, 0x52, 0x1e, 0xb7, 0x0d, 0x61, 0x40, 0x78, 0x65, 0x82, 0xeb, 0xc5,
+	0x6a, 0x05, 0x98, 0xda, 0x28, 0x4e, 0x10, 0x1b, 0x48, 0x10, 0x99, 0x54, 0x60, 0xc5, 0x96, 0x65,
+	0xb4, 0xa4, 0x4b, 0x6e, 0xcd, 0x61, 0x99, 0x66, 0x48, 0xf6, 0xd0, 0x3d, 0x3d, 0x8e, 0xf8, 0x0a,
+	0x3a, 0xe5, 0x96, 0x93, 0x00, 0xdf, 0x03, 0xe4, 0x15, 0x7c, 0xf5, 0xd1, 0x4f, 0x90, 0x1f, 0x27,
+	0x8f, 0x90, 0x6b, 0x80, 0xa0, 0x7f, 0xa6, 0x39, 0xa4, 0x93, 0x00, 0x02, 0x7c, 0xeb, 0xfa, 0xaa,
+	0xaa, 0xab, 0xea, 0xab, 0xea, 0xe2, 0x10, 0x40, 0xb2, 0x78, 0xdc, 0x99, 0x89, 0x48, 0x46, 0xa4,
+	0xa0, 0xce, 0xcd, 0xdb, 0xc3, 0x91, 0x7c, 0x96, 0xf4, 0x3b, 0x61, 0x34, 0xdd, 0x19, 0x46, 0xc3,
+	0x68, 0x47, 0x2b, 0xfb, 0xc9, 0x53, 0x2d, 0x69, 0x41, 0x9f, 0x8c, 0x53, 0xf3, 0xe6, 0x30, 0x8a,
+	0x86, 0x13, 0x5c, 0x58, 0xc9, 0xd1, 0x14, 0x63, 0xc9, 0xa6, 0x33, 0x6b, 0xb0, 0x3e, 0x45, 0xc9,
+	0x06, 0x4c, 0x32, 0x23, 0xb7, 0xbf, 0xf3, 0xa0, 0x7e, 0xc2, 0xe2, 0xf1, 0xa1, 0x85, 0xc9, 0x3a,
+	0xe4, 0x0e, 0x7a, 0x81, 0xd7, 0xf2, 0xb6, 0xaa, 0x34, 0x77, 0xd0, 0x23, 0xdb, 0x50, 0xd9, 0x3f,
+	0xc3, 0x30, 0x91, 0x91, 0x08, 0x72, 0x2d, 0x6f, 0x6b, 0x7d, 0x77, 0xbd, 0xa3, 0xb3, 0x54, 0x5e,
+	0xdd, 0x68, 0x80, 0xd4, 0xe9, 0x49, 0x00, 0xe5, 0x6e, 0xc4, 0x25, 0x9e, 0xc9, 0x20, 0xdf, 0xf2,
+	0xb6, 0xea, 0x34, 0x15, 0xc9, 0x07, 0x50, 0x3e, 0x9a, 0xc9, 0x51, 0xc4, 0xe3, 0xa0, 0xd0, 0xf2,
+	0xb6, 0x6a, 0xbb, 0x57, 0x17, 0x97, 0x58, 0xc5, 0xfd, 0xc2, 0xeb, 0x1f, 0x6f, 0xae, 0xd1, 0xd4,
+	0xae, 0xfd, 0x2a, 0x07, 0xb5, 0x8c, 0x9a, 0xdc, 0x82, 0xc6, 0x21, 0x3b, 0xa3, 0x28, 0xc5, 0xfc,
+	0x44, 0x15, 0xa5, 0x73, 0x6c, 0xd0, 0x65, 0x50, 0x59, 0x69, 0xe9, 0x80, 0x4b, 0x14, 0x2f, 0xd8,
+	0x44, 0xe7, 0x9c, 0xa7, 0xcb, 0xa0, 0xb2, 0xea, 0xe1, 0x84, 0xcd, 0x7b, 0x89, 0x60, 0xea, 0x76,
+	0x9d, 0x6e, 0x9e, 0x2e, 0x83, 0xa4, 0x05, 0xb5, 0x6e, 0xc4, 0xc3, 0x44, 0x08, 0xe4, 0xe1, 0x5c,
+	0x27, 0xde, 0xa0, 0x59, 0x88, 0x7c, 0x04, 0xa5, 0x47, 0xac, 0x8f, 0x93, 0x38, 0x28, 0xb6, 0xf2,
+	0x5b, 0xb5, 0xdd, 0x7f, 0xbd, 0x53, 0x55, 0xc7, 0xe8, 0xf7, 0xb9, 0x14, 0x73, 0x6a, 0x8d, 0x15,
+	0xa7, 0x14, 0xe3, 0x28, 0x11, 0x21, 0x06, 0x25, 0x4d, 0x87, 0xe5, 0x34, 0x45, 0xa9, 0xd3, 0x37,
+	0xef, 0x42, 0x2d, 0x73, 0x05, 0xf1, 0x21, 0x3f, 0xc6, 0xb9, 0xed, 0x8f, 0x3a, 0x92, 0xeb, 0x50,
+	0x7c, 0xc1, 0x26, 0x09, 0xea, 0x4a, 0xab, 0xd4, 0x08, 0xf7, 0x72, 0x9f, 0x78, 0xed, 0x3b, 0x8b,
+	0x30, 0xca, 0xaf, 0xfb, 0xe4, 0x54, 0xfb, 0x15, 0xa8, 0x3a, 0x92, 0x0d, 0x28, 0x1d, 0xe2, 0x34,
+	0x12, 0x73, 0xed, 0x58, 0xa0, 0x56, 0x6a, 0x3f, 0x84, 0x86, 0x69, 0x28, 0x52, 0x8c, 0x93, 0x89,
+	0x24, 0xb7, 0xa0, 0xa0, 0xfa, 0xac, 0x7d, 0xd7, 0x77, 0x7d, 0x97, 0x69, 0x32, 0x91, 0x0a, 0xa7,
+	0x5a, 0xab, 0xd2, 0xd8, 0x17, 0xc2, 0x0e, 0x49, 0x95, 0x1a, 0xa1, 0xfd, 0x7b, 0x0e, 0xaa, 0x7b,
+	0xf1, 0x9c, 0x87, 0x8a, 0x92, 0xcc, 0x6c, 0x15, 0xf4, 0x6c, 0xdd, 0x81, 0x4a, 0x3a, 0x77, 0xda,
+	0xad, 0xb6, 0x4b, 0x16, 0x04, 0xa6, 0x1a, 0x3b, 0x17, 0xce, 0x92, 0xb4, 0xa1, 0xfe, 0x84, 0x09,
+	0xe4, 0x52, 0x59, 0x1d, 0xf4, 0x74, 0xef, 0xaa, 0x74, 0x09, 0x23, 0x5b, 0x50, 0x3a, 0x96, 0x4c,
+	0x26, 0x66, 0xdc, 0x5c, 0xd6, 0x4a, 0x6b, 0x70, 0x6a, 0xf5, 0x64, 0x13, 0x40, 0xa1, 0x34, 0xe1,
+	0x1c, 0x45, 0x50, 0xd4, 0x77, 0x65, 0x10, 0x5d, 0xd7, 0x2c, 0x0a, 0x9f, 0xe9, 0x46, 0x35, 0xa8,
+	0x11, 0xd4, 0x00, 0x3d, 0x62, 0xb1, 0x7c, 0x80, 0x4c, 0xc8, 0x3e, 0x32, 0x19, 0x94, 0xcd, 0x00,
+	0x2d, 0x81, 0xa4, 0x09, 0x95, 0xae, 0x40, 0x26, 0x71, 0x4f, 0x06, 0x15, 0x6d, 0xe0, 0x64, 0x33,
+	0x5c, 0xd3, 0xd9, 0x04, 0x25, 0x0e, 0xf6, 0x64, 0x50, 0xd5, 0xea, 0x2c, 0x44, 0xee, 0xae, 0x34,
+	0x22, 0x00, 0x4d, 0xd1, 0x35, 0x53, 0xca, 0x92, 0x8a, 0x2e, 0x5b, 0xb6, 0x7f, 0xf3, 0x54, 0xe4,
+	0x88, 0xbf, 0x47, 0xd6, 0x9b, 0xe6, 0xc6, 0xfd, 0xb3, 0x99, 0xb0, 0x8c, 0x3b, 0x59, 0xe9, 0x1e,
+	0xe3, 0x99, 0x54, 0x2f, 0x50, 0xf3, 0x9d, 0xa7, 0x4e, 0x56, 0xdd, 0x3a, 0x11, 0xa3, 0xe1, 0x10,
+	0x85, 0x79, 0xb5, 0x45, 0x9d, 0xc7, 0x12, 0xb6, 0xc4, 0x53, 0x69, 0x85, 0xa7, 0x26, 0x54, 0x4e,
+	0x67, 0x03, 0xa3, 0x33, 0x24, 0x3b, 0xb9, 0xfd, 0xbd, 0x07, 0x7e, 0x37, 0xe2, 0x1c, 0x43, 0x19,
+	0x89, 0x1e, 0x4a, 0x36, 0x9a, 0xc4, 0xe4, 0x06, 0x54, 0x4f, 0x58, 0x7f, 0x82, 0x8f, 0xd9, 0x14,
+	0xed, 0x3b, 0x59, 0x00, 0xe4, 0xd3, 0xc5, 0x22, 0xca, 0xe9, 0x27, 0xfb, 0x6f, 0x53, 0xfb, 0xea,
+	0x35, 0x1d, 0x6b, 0x65, 0x1e, 0x6e, 0xea, 0xd3, 0xbc, 0x07, 0xf5, 0xac, 0xe2, 0x52, 0xcf, 0xf1,
+	0x36, 0x94, 0xf7, 0xc2, 0x30, 0x4a, 0xb8, 0xd4, 0x2d, 0x19, 0xb8, 0x96, 0x0c, 0x08, 0x81, 0x82,
+	0x4e, 0xd7, 0xf8, 0xe8, 0x73, 0xfb, 0x39, 0xf8, 0x86, 0x84, 0xee, 0x20, 0x4c, 0x6b, 0xdb, 0x80,
+	0x92, 0x1e, 0xf0, 0x81, 0x8d, 0x68, 0x25, 0x45, 0x92, 0x3a, 0x65, 0xee, 0x70, 0x32, 0xf9, 0x1f,
+	0x54, 0x6c, 0xd8, 0x38, 0xc8, 0xeb, 0x92, 0x1b, 0xa6, 0x64, 0x8b, 0x52, 0xa7, 0x6e, 0x13, 0xf0,
+	0x29, 0x4a, 0xe4, 0xaa, 0x40, 0x1b, 0xb2, 0xfd, 0x3a, 0x07, 0xe5, 0x34, 0x7c, 0x0b, 0x6a, 0x3d,
+	0x8c, 0x43, 0x31, 0xd2, 0x14, 0xd8, 0x1c, 0xb2, 0x90, 0x22, 0xdf, 0xde, 0x76, 0xd0, 0xd3, 0x99,
+	0x34, 0xe8, 0x02, 0x50, 0xbf, 0x0f, 0x56, 0xb0, 0x23, 0x94, 0x8a, 0xba, 0xcb, 0x31, 0x0a, 0xce,
+	0xec, 0x04, 0x55, 0xa9, 0x93, 0x17, 0x9b, 0xa5, 0x98, 0xd9, 0x2c, 0xe4, 0x63, 0xa8, 0xba, 0x9e,
+	0xd9, 0x97, 0xb1, 0xf1, 0xd7, 0xad, 0x7c, 0xb0, 0x46, 0x17, 0xa6, 0xca, 0xcf, 0xd5, 0x18, 0xd4,
+	0xb2, 0x7e, 0xab, 0xa5, 0x2b, 0x3f, 0x87, 0xe9, 0x78, 0x69, 0x3b, 0x82, 0xfa, 0x52, 0xbc, 0x95,
+	0x2e, 0xe9, 0x78, 0x29, 0x76, 0xbf, 0xea, 0xe8, 0x6b, 0xff, 0x51, 0x00, 0xe8, 0x31, 0x9c, 0xbe,
+	0xd7, 0x77, 0xb9, 0xc4, 0x78, 0xfe, 0x1f, 0x18, 0x2f, 0x2c, 0x33, 0xbe, 0x6d, 0x46, 0xe6, 0x64,
+	0x3e, 0xc3, 0xa0, 0xb8, 0xfa, 0xbb, 0xae, 0x50, 0xea, 0xf4, 0x2b, 0x3b, 0xb2, 0xf4, 0xce, 0x8e,
+	0xfc, 0xbf, 0xd1, 0xdb, 0x8d, 0x5b, 0xfe, 0x9b, 0x8d, 0x9b, 0xb1, 0x21, 0x5f, 0xae, 0xee, 0xcf,
+	0x8a, 0x2e, 0xb8, 0xd9, 0x31, 0xdf, 0x2f, 0x9d, 0xf4, 0xfb, 0xa5, 0x73, 0x92, 0x7e, 0xbf, 0xdc,
+	0xaf, 0xa8, 0xc2, 0xbf, 0xfd, 0xe9, 0xa6, 0xb7, 0xba, 0x65, 0xff, 0xeb, 0x18, 0xd6, 0x5b, 0xd4,
+	0xcd, 0xb7, 0x05, 0x69, 0xaa, 0x25, 0x9f, 0x67, 0xd6, 0x0c, 0x5c, 0x22, 0x9e, 0xf3, 0x52, 0x37,
+	0xb8, 0x65, 0x54, 0xbb, 0xcc, 0x0d, 0xa9, 0x17, 0xb9, 0x07, 0xc5, 0x7d, 0xae, 0x16, 0x7e, 0xfd,
+	0x12, 0xee, 0xc6, 0x85, 0x7c, 0x06, 0x65, 0x55, 0x39, 0x4d, 0x78, 0xd0, 0xb8, 0x84, 0x77, 0xea,
+	0xb4, 0xfd, 0x83, 0x97, 0xed, 0x13, 0xa9, 0x41, 0xd9, 0x14, 0x36, 0xf0, 0xd7, 0x94, 0xa0, 0x9a,
+	0x39, 0xe2, 0x43, 0xdf, 0x23, 0x0d, 0xa8, 0xba, 0x1f, 0x22, 0x3f, 0x47, 0x00, 0x4a, 0x4f, 0x58,
+	0x12, 0xe3, 0xc0, 0xcf, 0x93, 0xaa, 0x7d, 0x8c, 0x7e, 0x81, 0xd4, 0xa1, 0xd2, 0x65, 0x3c, 0xc4,
+	0x09, 0x0e, 0xfc, 0x22, 0xb9, 0x06, 0x57, 0xd4, 0x8f, 0xcf, 0x14, 0x29, 0x3e, 0x4f, 0x30, 0x56,
+	0x9e, 0x25, 0x42, 0x60, 0x5d, 0x7b, 0x2e, 0xb0, 0xb2, 0x32, 0x34, 0x6e, 0x0b, 0xb0, 0x42, 0xae,
+	0xab, 0xcd, 0x13, 0x4b, 0x26, 0xe4, 0x02, 0xad, 0x6e, 0xbf, 0xf2, 0xcc, 0x90, 0xea, 0x0f, 0x8c,
+	0x3a, 0x54, 0x4e, 0x30, 0x96, 0x47, 0x7c, 0x32, 0xf7, 0xd7, 0xc8, 0x3a, 0xc0, 0xf1, 0x3c, 0x96,
+	0x38, 0x3d, 0xe0, 0x23, 0xe9, 0x7b, 0x2a, 0xd2, 0x21, 0x4a, 0x31, 0x0a, 0x1f, 0x45, 0xc3, 0x43,
+	0x14, 0x43, 0xf4, 0x73, 0x64, 0x03, 0x88, 0xc1, 0x8e, 0x65, 0x24, 0xd8, 0x10, 0x4f, 0x63, 0x36,
+	0x44, 0x3f, 0xaf, 0x70, 0xb7, 0x0f, 0x1e, 0xb2, 0xa7, 0x63, 0x76, 0x3c, 0xe2, 0x63, 0xbf, 0x40,
+	0xae, 0x40, 0x4d, 0xbb, 0x1e, 0xf5, 0xbf, 0xc6, 0x50, 0xfa, 0x45, 0xc5, 0x83, 0x5b, 0x00, 0x7e,
+	0x49, 0x71, 0xa4, 0xa2, 0x75, 0x07, 0xa1, 0x5f, 0x26, 0x3e, 0xd4, 0x0f, 0x8f, 0xf4, 0xef, 0x8a,
+	0xa2, 0x33, 0xf6, 0x2b, 0x2a, 0x85, 0xfd, 0x17, 0xc8, 0xe5, 0x71, 0xf8, 0x0c, 0x07, 0xc9, 0x04,
+	0x85, 0x5f, 0xdd, 0xfe, 0x0f, 0xc0, 0xe2, 0x4b, 0x49, 0x5d, 0x70, 0x9c, 0x84, 0x21, 0xc6, 0xb1,
+	0xbf, 0xa6, 0x58, 0xfd, 0x82, 0x8d, 0x14, 0x79, 0xde, 0xf6, 0x4b, 0x6f, 0xf1, 0x1a, 0xc9, 0x0d,
+	0x28, 0x9f, 0xf2, 0x31, 0x8f, 0xbe, 0xe1, 0xfe, 0x5a, 0xf3, 0xca, 0xf9, 0x45, 0xab, 0xa6, 0x60,
+	0x0b, 0x91, 0x5d, 0x20, 0x2e, 0x67, 0x57, 0x85, 0xef, 0x35, 0x9b, 0xe7, 0x17, 0xad, 0x0d, 0x65,
+	0xf8, 0xae, 0xd6, 0x7e, 0x14, 0x9b, 0x3a, 0x94, 0x89, 0x9f, 0x6b, 0x5e, 0x3d, 0xbf, 0x68, 0x35,
+	0xd4, 0xd9, 0x29, 0xd4, 0x26, 0x71, 0x6b, 0xcb, 0xcf, 0x37, 0x1b, 0xe7, 0x17, 0xad, 0xcc, 0x1e,
+	0xeb, 0xbe, 0xf9, 0x65, 0xd3, 0x7b, 0xfd, 0x76, 0xd3, 0x7b, 0xf3, 0x76, 0xd3, 0xfb, 0xf9, 0xed,
+	0xe6, 0xda, 0xcb, 0x5f, 0x37, 0xbd, 0xaf, 0xb2, 0x7f, 0x4f, 0xa6, 0x4c, 0x8a, 0xd1, 0x59, 0x24,
+	0x46, 0xc3, 0x11, 0x4f, 0x05, 0x8e, 0x3b, 0xb3, 0xf1, 0x70, 0x67, 0xd6, 0xdf, 0x51, 0x4f, 0xb2,
+	0x5f, 0xd2, 0x83, 0xfa, 0xe1, 0x9f, 0x03, 0x00, 0x7a, 0x6f, 0xbe, 0xd0, 0xe8, 0x0c, 0x00, 0x00,
 }
 
 func (m *TaskMetadata) Marshal() (dAtA []byte, err error) {
@@ -27,6 +27,9 @@ import (
 	"github.com/robfig/cron/v3"
 )
 
+// EventSchedulerTaskID is the task_metadata_id of the cron task which runs the due events
+const EventSchedulerTaskID = "event_scheduler"
+
 // genInitCronTaskSQL Generate `insert` statement for creating system cron tasks, which works on the `mo_task`.`sys_cron_task` table.
 func GenInitCronTaskSQL(codes ...int32) (string, error) {
 	cronParser := cron.NewParser(
@@ -93,6 +96,18 @@ func GenInitCronTaskSQL(codes ...int32) (string, error) {
 
 	cronTasks = append(cronTasks, task4)
 
+	// the event scheduler runs every minute, which is the granularity of the schedule of the events
+	task5, err := createCronTask(
+		task.TaskMetadata{
+			ID:       EventSchedulerTaskID,
+			Executor: task.TaskCode_EventScheduler,
+			Options:  task.TaskOptions{Concurrency: 1},
+		}, export.MergeTaskCronExprEveryMin)
+	if err != nil {
+		return "", err
+	}
+	cronTasks = append(cronTasks, task5)
+
 	sql := fmt.Sprintf(`insert into %s.sys_cron_task (
                            task_metadata_id,
 						   task_metadata_executor,
@@ -378,6 +378,66 @@ func TestGroupConcatExec(t *testing.T) {
 	}
 }
 
+func TestJsonAggExec(t *testing.T) {
+	mg := newTestAggMemoryManager()
+	arrayAggID, objectAggID := gUniqueAggIdForTest(), gUniqueAggIdForTest()
+	RegisterJsonArrayAgg(arrayAggID)
+	RegisterJsonObjectAgg(objectAggID)
+
+	// keys: ["b", "a", "b", "c"].
+	// values: [1, 2, 3, NULL].
+	keys := vector.NewVec(types.T_varchar.ToType())
+	values := vector.NewVec(types.T_int64.ToType())
+	require.NoError(t, vector.AppendStringList(keys, []string{"b", "a", "b", "c"}, nil, mg.Mp()))
+	require.NoError(t, vector.AppendFixedList(values, []int64{1, 2, 3, 0}, []bool{false, false, false, true}, mg.Mp()))
+
+	check := func(aggID int64, inputs []*vector.Vector, expected string) {
+		argTypes := make([]types.Type, len(inputs))
+		for i, in := range inputs {
+			argTypes[i] = *in.GetType()
+		}
+		executor := MakeAgg(mg, aggID, false, argTypes...)
+		require.NoError(t, executor.GroupGrow(2))
+		require.NoError(t, executor.Fill(0, 0, inputs))
+		require.NoError(t, executor.Fill(0, 1, inputs))
+
+		// data merge and marshal.
+		executor2 := MakeAgg(mg, aggID, false, argTypes...)
+		require.NoError(t, executor2.GroupGrow(1))
+		require.NoError(t, executor2.Fill(0, 2, inputs))
+		require.NoError(t, executor2.Fill(0, 3, inputs))
+		executor3, err := CopyAggFuncExec(mg, executor2)
+		require.NoError(t, err)
+		executor2.Free()
+		require.NoError(t, executor.Merge(executor3, 0, 0))
+		executor3.Free()
+
+		v, err := executor.Flush()
+		require.NoError(t, err)
+		require.Equal(t, 2, v.Length())
+		require.Equal(t, expected, types.DecodeJson(v.GetBytesAt(0)).String())
+		// the empty group is NULL.
+		require.True(t, v.IsNull(1))
+		v.Free(mg.Mp())
+		executor.Free()
+	}
+	check(arrayAggID, []*vector.Vector{values}, `[1, 2, 3, null]`)
+	check(objectAggID, []*vector.Vector{keys, values}, `{"a": 2, "b": 3, "c": null}`)
+
+	// the key of json object can not be NULL.
+	nullKeys := vector.NewVec(types.T_varchar.ToType())
+	require.NoError(t, vector.AppendStringList(nullKeys, []string{""}, []bool{true}, mg.Mp()))
+	executor := MakeAgg(mg, objectAggID, false, types.T_varchar.ToType(), types.T_int64.ToType())
+	require.NoError(t, executor.GroupGrow(1))
+	require.Error(t, executor.Fill(0, 0, []*vector.Vector{nullKeys, values}))
+	executor.Free()
+
+	for _, v := range []*vector.Vector{keys, values, nullKeys} {
+		v.Free(mg.Mp())
+	}
+	require.Equal(t, int64(0), mg.Mp().CurrNB())
+}
+
 // TestEmptyNullFlag test if the emptyNull flag is working.
 // if the emptyNull flag is true, empty groups will return NULL as the result.
 func TestEmptyNullFlag(t *testing.T) {
@@ -0,0 +1,252 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggexec
+
+import (
+	"encoding/binary"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+// jsonAggExec is the executor of the special aggregations `json_arrayagg(value)` and `json_objectagg(key, value)`.
+//
+// before Flush, the result of a group is a list of length-prefixed items which is easy to append and merge.
+// for json_arrayagg, each item is an encoded json value.
+// for json_objectagg, the items are pairs of an object key and an encoded json value.
+// the list is converted to the final json array or object at Flush.
+type jsonAggExec struct {
+	multiAggInfo
+	ret aggFuncBytesResult
+	distinctHash
+
+	isObject bool
+}
+
+func (exec *jsonAggExec) marshal() ([]byte, error) {
+	d := exec.multiAggInfo.getEncoded()
+	r, err := exec.ret.marshal()
+	if err != nil {
+		return nil, err
+	}
+	encoded := &EncodedAgg{
+		Info:   d,
+		Result: r,
+		Groups: nil,
+	}
+	return encoded.Marshal()
+}
+
+func (exec *jsonAggExec) unmarshal(_ *mpool.MPool, result []byte, _ [][]byte) error {
+	return exec.ret.unmarshal(result)
+}
+
+func newJsonAggExec(mg AggMemoryManager, info multiAggInfo, isObject bool) AggFuncExec {
+	exec := &jsonAggExec{
+		multiAggInfo: info,
+		ret:          initBytesAggFuncResult(mg, info.retType, info.emptyNull),
+		isObject:     isObject,
+	}
+	if info.distinct {
+		exec.distinctHash = newDistinctHash(mg.Mp(), true)
+	}
+	return exec
+}
+
+func (exec *jsonAggExec) GroupGrow(more int) error {
+	if exec.IsDistinct() {
+		if err := exec.distinctHash.grows(more); err != nil {
+			return err
+		}
+	}
+	return exec.ret.grows(more)
+}
+
+func (exec *jsonAggExec) PreAllocateGroups(more int) error {
+	return exec.ret.preAllocate(more)
+}
+
+func (exec *jsonAggExec) Fill(groupIndex int, row int, vectors []*vector.Vector) error {
+	// the null value is aggregated as json null, but the key of an object can not be null.
+	if exec.isObject && vectors[0].IsNull(uint64(row)) {
+		return moerr.NewInvalidInputNoCtx("JSON documents may not contain NULL member names")
+	}
+
+	if exec.IsDistinct() {
+		if need, err := exec.distinctHash.fill(groupIndex, vectors, row); err != nil || !need {
+			return err
+		}
+	}
+
+	exec.ret.groupToSet = groupIndex
+	exec.ret.setGroupNotEmpty(groupIndex)
+	r := exec.ret.aggGet()
+
+	value := vectors[0]
+	if exec.isObject {
+		r = appendJsonAggItem(r, vectors[0].GetBytesAt(row))
+		value = vectors[1]
+	}
+	bj, err := getJsonAggValue(value, row)
+	if err != nil {
+		return err
+	}
+	encoded, err := bj.Marshal()
+	if err != nil {
+		return err
+	}
+	return exec.ret.aggSet(appendJsonAggItem(r, encoded))
+}
+
+func (exec *jsonAggExec) BulkFill(groupIndex int, vectors []*vector.Vector) error {
+	for row, end := 0, vectors[0].Length(); row < end; row++ {
+		if err := exec.Fill(groupIndex, row, vectors); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (exec *jsonAggExec) BatchFill(offset int, groups []uint64, vectors []*vector.Vector) error {
+	for i, j, idx := offset, offset+len(groups), 0; i < j; i++ {
+		if groups[idx] != GroupNotMatched {
+			if err := exec.Fill(int(groups[idx]-1), i, vectors); err != nil {
+				return err
+			}
+		}
+		idx++
+	}
+	return nil
+}
+
+func (exec *jsonAggExec) SetExtraInformation(partialResult any, _ int) error {
+	return nil
+}
+
+func (exec *jsonAggExec) merge(other *jsonAggExec, idx1, idx2 int) error {
+	exec.ret.groupToSet = idx1
+	other.ret.groupToSet = idx2
+	if err := exec.distinctHash.merge(&other.distinctHash); err != nil {
+		return err
+	}
+	if other.ret.groupIsEmpty(idx2) {
+		return nil
+	}
+	exec.ret.mergeEmpty(other.ret.basicResult, idx1, idx2)
+	v1 := exec.ret.aggGet()
+	return exec.ret.aggSet(append(v1, other.ret.aggGet()...))
+}
+
+func (exec *jsonAggExec) Merge(next AggFuncExec, groupIdx1, groupIdx2 int) error {
+	return exec.merge(next.(*jsonAggExec), groupIdx1, groupIdx2)
+}
+
+func (exec *jsonAggExec) BatchMerge(next AggFuncExec, offset int, groups []uint64) error {
+	other := next.(*jsonAggExec)
+	for i := range groups {
+		if groups[i] == GroupNotMatched {
+			continue
+		}
+		if err := exec.merge(other, int(groups[i])-1, i+offset); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (exec *jsonAggExec) Flush() (*vector.Vector, error) {
+	for i, empty := range exec.ret.empty {
+		if empty {
+			continue
+		}
+		exec.ret.groupToSet = i
+		items := splitJsonAggItems(exec.ret.aggGet())
+
+		var bj bytejson.ByteJson
+		if exec.isObject {
+			keys := make([][]byte, 0, len(items)/2)
+			values := make([]bytejson.ByteJson, 0, len(items)/2)
+			for k := 0; k+1 < len(items); k += 2 {
+				keys = append(keys, items[k])
+				values = append(values, types.DecodeJson(items[k+1]))
+			}
+			var err error
+			if bj, err = bytejson.NewObject(keys, values); err != nil {
+				return nil, err
+			}
+		} else {
+			values := make([]bytejson.ByteJson, len(items))
+			for k, item := range items {
+				values[k] = types.DecodeJson(item)
+			}
+			bj = bytejson.NewArray(values)
+		}
+
+		encoded, err := bj.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		if err = exec.ret.aggSet(encoded); err != nil {
+			return nil, err
+		}
+	}
+	return exec.ret.flush(), nil
+}
+
+func (exec *jsonAggExec) Free() {
+	exec.distinctHash.free()
+	exec.ret.free()
+}
+
+func appendJsonAggItem(dst []byte, item []byte) []byte {
+	dst = binary.LittleEndian.AppendUint32(dst, uint32(len(item)))
+	return append(dst, item...)
+}
+
+func splitJsonAggItems(data []byte) [][]byte {
+	var items [][]byte
+	for len(data) >= 4 {
+		n := binary.LittleEndian.Uint32(data)
+		items = append(items, data[4:4+n])
+		data = data[4+n:]
+	}
+	return items
+}
+
+// getJsonAggValue converts the value at row to json, a string becomes a json string.
+func getJsonAggValue(v *vector.Vector, row int) (bytejson.ByteJson, error) {
+	if v.IsNull(uint64(row)) {
+		return bytejson.Null, nil
+	}
+	switch v.GetType().Oid {
+	case types.T_json:
+		return types.DecodeJson(v.GetBytesAt(row)), nil
+	case types.T_bool:
+		return bytejson.CreateByteJSON(vector.GetFixedAtNoTypeCheck[bool](v, row))
+	case types.T_int64:
+		return bytejson.CreateByteJSON(vector.GetFixedAtNoTypeCheck[int64](v, row))
+	case types.T_uint64:
+		return bytejson.CreateByteJSON(vector.GetFixedAtNoTypeCheck[uint64](v, row))
+	case types.T_float64:
+		return bytejson.CreateByteJSON(vector.GetFixedAtNoTypeCheck[float64](v, row))
+	}
+	if v.GetType().Oid.IsMySQLString() {
+		return bytejson.CreateByteJSON(string(v.GetBytesAt(row)))
+	}
+	return bytejson.Null, moerr.NewInvalidInputNoCtxf("json aggregation does not support type %s", v.GetType().String())
+}
@@ -42,6 +42,16 @@ func RegisterGroupConcatAgg(id int64, sep string) {
 	groupConcatSep = sep
 }
 
+func RegisterJsonArrayAgg(id int64) {
+	specialAgg[id] = true
+	aggIdOfJsonArrayAgg = id
+}
+
+func RegisterJsonObjectAgg(id int64) {
+	specialAgg[id] = true
+	aggIdOfJsonObjectAgg = id
+}
+
 func RegisterApproxCountAgg(id int64) {
 	specialAgg[id] = true
 	aggIdOfApproxCount = id
@@ -72,6 +82,46 @@ func RegisterDenseRankWin(id int64) {
 	winIdOfDenseRank = id
 }
 
+func RegisterPercentRankWin(id int64) {
+	specialAgg[id] = true
+	winIdOfPercentRank = id
+}
+
+func RegisterCumeDistWin(id int64) {
+	specialAgg[id] = true
+	winIdOfCumeDist = id
+}
+
+func RegisterNtileWin(id int64) {
+	specialAgg[id] = true
+	winIdOfNtile = id
+}
+
+func RegisterLagWin(id int64) {
+	specialAgg[id] = true
+	winIdOfLag = id
+}
+
+func RegisterLeadWin(id int64) {
+	specialAgg[id] = true
+	winIdOfLead = id
+}
+
+func RegisterFirstValueWin(id int64) {
+	specialAgg[id] = true
+	winIdOfFirstValue = id
+}
+
+func RegisterLastValueWin(id int64) {
+	specialAgg[id] = true
+	winIdOfLastValue = id
+}
+
+func RegisterNthValueWin(id int64) {
+	specialAgg[id] = true
+	winIdOfNthValue = id
+}
+
 type registeredAggInfo struct {
 	isSingleAgg          bool
 	acceptNull           bool
@@ -112,6 +162,16 @@ var (
 	winIdOfRowNumber      = int64(-7)
 	winIdOfRank           = int64(-8)
 	winIdOfDenseRank      = int64(-9)
+	winIdOfPercentRank    = int64(-10)
+	winIdOfCumeDist       = int64(-11)
+	winIdOfNtile          = int64(-12)
+	winIdOfLag            = int64(-13)
+	winIdOfLead           = int64(-14)
+	winIdOfFirstValue     = int64(-15)
+	winIdOfLastValue      = int64(-16)
+	winIdOfNthValue       = int64(-17)
+	aggIdOfJsonArrayAgg   = int64(-18)
+	aggIdOfJsonObjectAgg  = int64(-19)
 	groupConcatSep        = ","
 	getCroupConcatRet     = func(args ...types.Type) types.Type {
 		for _, p := range args {
@@ -119,6 +119,7 @@ var (
 	_ AggFuncExec = (*multiAggFuncExec1[int8])(nil)
 	_ AggFuncExec = (*multiAggFuncExec2)(nil)
 	_ AggFuncExec = &groupConcatExec{}
+	_ AggFuncExec = &jsonAggExec{}
 )
 
 var (
@@ -244,14 +245,22 @@ func makeSpecialAggExec(
 			return exec, true, err
 		case aggIdOfGroupConcat:
 			return makeGroupConcat(mg, id, isDistinct, params, getCroupConcatRet(params...), groupConcatSep), true, nil
+		case aggIdOfJsonArrayAgg, aggIdOfJsonObjectAgg:
+			return makeJsonAgg(mg, id, isDistinct, params), true, nil
 		case aggIdOfApproxCount:
 			return makeApproxCount(mg, id, params[0]), true, nil
 		case aggIdOfClusterCenters:
 			exec, err := makeClusterCenters(mg, id, isDistinct, params[0])
 			return exec, true, err
-		case winIdOfRowNumber, winIdOfRank, winIdOfDenseRank:
+		case winIdOfRowNumber, winIdOfRank, winIdOfDenseRank, winIdOfNtile:
 			exec, err := makeWindowExec(mg, id, isDistinct)
 			return exec, true, err
+		case winIdOfPercentRank, winIdOfCumeDist:
+			exec, err := makeDistributionWindowExec(mg, id, isDistinct)
+			return exec, true, err
+		case winIdOfLag, winIdOfLead, winIdOfFirstValue, winIdOfLastValue, winIdOfNthValue:
+			exec, err := makeValueWindowExec(mg, id, isDistinct, params[0])
+			return exec, true, err
 		}
 	}
 	return nil, false, nil
@@ -274,6 +283,21 @@ func makeGroupConcat(
 	return newGroupConcatExec(mg, info, separator)
 }
 
+// makeJsonAgg creates an aggregation function executor for `json_arrayagg()` and `json_objectagg()`.
+func makeJsonAgg(
+	mg AggMemoryManager,
+	aggID int64, isDistinct bool,
+	param []types.Type) AggFuncExec {
+	info := multiAggInfo{
+		aggID:     aggID,
+		distinct:  isDistinct,
+		argTypes:  param,
+		retType:   types.T_json.ToType(),
+		emptyNull: true,
+	}
+	return newJsonAggExec(mg, info, aggID == aggIdOfJsonObjectAgg)
+}
+
 func makeCount(
 	mg AggMemoryManager, isStar bool,
 	aggID int64, isDistinct bool,
@@ -331,3 +355,35 @@ func makeWindowExec(
 	}
 	return makeRankDenseRankRowNumber(mg, info), nil
 }
+
+func makeDistributionWindowExec(
+	mg AggMemoryManager, aggID int64, isDistinct bool) (AggFuncExec, error) {
+	if isDistinct {
+		return nil, moerr.NewInternalErrorNoCtx("window function does not support `distinct`")
+	}
+
+	info := singleAggInfo{
+		aggID:     aggID,
+		distinct:  false,
+		argType:   types.T_int64.ToType(),
+		retType:   types.T_float64.ToType(),
+		emptyNull: false,
+	}
+	return makePercentRankCumeDist(mg, info), nil
+}
+
+func makeValueWindowExec(
+	mg AggMemoryManager, aggID int64, isDistinct bool, param types.Type) (AggFuncExec, error) {
+	if isDistinct {
+		return nil, moerr.NewInternalErrorNoCtx("window function does not support `distinct`")
+	}
+
+	info := singleAggInfo{
+		aggID:     aggID,
+		distinct:  false,
+		argType:   param,
+		retType:   ValueWindowReturnType([]types.Type{param}),
+		emptyNull: true,
+	}
+	return newValueWindowExec(mg, info), nil
+}
@@ -25,37 +25,54 @@ func SingleWindowReturnType(_ []types.Type) types.Type {
 	return types.T_int64.ToType()
 }
 
+func DistributionWindowReturnType(_ []types.Type) types.Type {
+	return types.T_float64.ToType()
+}
+
 // special structure for a single column window function.
-type singleWindowExec struct {
+//
+// each group holds the boundaries of a partition, the first element is the start of the partition,
+// the last element is the end of the partition, and the elements in the middle are the ends of the peer groups.
+type singleWindowExec[T int64 | float64] struct {
 	singleAggInfo
-	ret aggFuncResult[int64]
+	ret aggFuncResult[T]
 
 	groups [][]int64
+
+	// buckets is the argument of the NTILE(N).
+	buckets int64
 }
 
 func makeRankDenseRankRowNumber(mg AggMemoryManager, info singleAggInfo) AggFuncExec {
-	return &singleWindowExec{
+	return &singleWindowExec[int64]{
 		singleAggInfo: info,
 		ret:           initFixedAggFuncResult[int64](mg, info.retType, info.emptyNull),
 	}
 }
 
-func (exec *singleWindowExec) GroupGrow(more int) error {
+func makePercentRankCumeDist(mg AggMemoryManager, info singleAggInfo) AggFuncExec {
+	return &singleWindowExec[float64]{
+		singleAggInfo: info,
+		ret:           initFixedAggFuncResult[float64](mg, info.retType, info.emptyNull),
+	}
+}
+
+func (exec *singleWindowExec[T]) GroupGrow(more int) error {
 	exec.groups = append(exec.groups, make([][]int64, more)...)
 	return exec.ret.grows(more)
 }
 
-func (exec *singleWindowExec) PreAllocateGroups(more int) error {
+func (exec *singleWindowExec[T]) PreAllocateGroups(more int) error {
 	return exec.ret.preAllocate(more)
 }
 
-func (exec *singleWindowExec) Fill(groupIndex int, row int, vectors []*vector.Vector) error {
+func (exec *singleWindowExec[T]) Fill(groupIndex int, row int, vectors []*vector.Vector) error {
 	value := vector.MustFixedColWithTypeCheck[int64](vectors[0])[row]
 	exec.groups[groupIndex] = append(exec.groups[groupIndex], value)
 	return nil
 }
 
-func (exec *singleWindowExec) marshal() ([]byte, error) {
+func (exec *singleWindowExec[T]) marshal() ([]byte, error) {
 	d := exec.singleAggInfo.getEncoded()
 	r, err := exec.ret.marshal()
 	if err != nil {
@@ -76,7 +93,7 @@ func (exec *singleWindowExec) marshal() ([]byte, error) {
 	return encoded.Marshal()
 }
 
-func (exec *singleWindowExec) unmarshal(mp *mpool.MPool, result []byte, groups [][]byte) error {
+func (exec *singleWindowExec[T]) unmarshal(mp *mpool.MPool, result []byte, groups [][]byte) error {
 	if len(exec.groups) > 0 {
 		exec.groups = make([][]int64, len(groups))
 		for i := range exec.groups {
@@ -88,22 +105,22 @@ func (exec *singleWindowExec) unmarshal(mp *mpool.MPool, result []byte, groups [
 	return exec.ret.unmarshal(result)
 }
 
-func (exec *singleWindowExec) BulkFill(groupIndex int, vectors []*vector.Vector) error {
+func (exec *singleWindowExec[T]) BulkFill(groupIndex int, vectors []*vector.Vector) error {
 	panic("implement me")
 }
 
-func (exec *singleWindowExec) BatchFill(offset int, groups []uint64, vectors []*vector.Vector) error {
+func (exec *singleWindowExec[T]) BatchFill(offset int, groups []uint64, vectors []*vector.Vector) error {
 	panic("implement me")
 }
 
-func (exec *singleWindowExec) Merge(next AggFuncExec, groupIdx1, groupIdx2 int) error {
-	other := next.(*singleWindowExec)
+func (exec *singleWindowExec[T]) Merge(next AggFuncExec, groupIdx1, groupIdx2 int) error {
+	other := next.(*singleWindowExec[T])
 	exec.groups[groupIdx1] = append(exec.groups[groupIdx1], other.groups[groupIdx2]...)
 	return nil
 }
 
-func (exec *singleWindowExec) BatchMerge(next AggFuncExec, offset int, groups []uint64) error {
-	other := next.(*singleWindowExec)
+func (exec *singleWindowExec[T]) BatchMerge(next AggFuncExec, offset int, groups []uint64) error {
+	other := next.(*singleWindowExec[T])
 	for i := range groups {
 		if groups[i] != GroupNotMatched {
 			groupIdx1 := int(groups[i] - 1)
@@ -115,11 +132,21 @@ func (exec *singleWindowExec) BatchMerge(next AggFuncExec, offset int, groups []
 	return nil
 }
 
-func (exec *singleWindowExec) SetExtraInformation(partialResult any, groupIndex int) error {
+func (exec *singleWindowExec[T]) SetExtraInformation(partialResult any, groupIndex int) error {
+	if exec.singleAggInfo.aggID == winIdOfNtile {
+		// the encoded int64 bucket number.
+		if bs, ok := partialResult.([]byte); ok && len(bs) == 8 {
+			exec.buckets = types.DecodeInt64(bs)
+			if exec.buckets > 0 {
+				return nil
+			}
+		}
+		return moerr.NewInvalidInputNoCtx("Incorrect arguments to ntile")
+	}
 	panic("window function do not support the extra information")
 }
 
-func (exec *singleWindowExec) Flush() (*vector.Vector, error) {
+func (exec *singleWindowExec[T]) Flush() (*vector.Vector, error) {
 	switch exec.singleAggInfo.aggID {
 	case winIdOfRank:
 		return exec.flushRank()
@@ -127,15 +154,21 @@ func (exec *singleWindowExec) Flush() (*vector.Vector, error) {
 		return exec.flushDenseRank()
 	case winIdOfRowNumber:
 		return exec.flushRowNumber()
+	case winIdOfNtile:
+		return exec.flushNtile()
+	case winIdOfPercentRank:
+		return exec.flushPercentRank()
+	case winIdOfCumeDist:
+		return exec.flushCumeDist()
 	}
 	return nil, moerr.NewInternalErrorNoCtx("invalid window function")
 }
 
-func (exec *singleWindowExec) Free() {
+func (exec *singleWindowExec[T]) Free() {
 	exec.ret.free()
 }
 
-func (exec *singleWindowExec) flushRank() (*vector.Vector, error) {
+func (exec *singleWindowExec[T]) flushRank() (*vector.Vector, error) {
 	values := exec.ret.values
 
 	idx := 0
@@ -149,7 +182,7 @@ func (exec *singleWindowExec) flushRank() (*vector.Vector, error) {
 			m := int(group[i] - group[i-1])
 
 			for k := idx + m; idx < k; idx++ {
-				values[idx] = sn
+				values[idx] = T(sn)
 			}
 			sn += int64(m)
 		}
@@ -157,7 +190,7 @@ func (exec *singleWindowExec) flushRank() (*vector.Vector, error) {
 	return exec.ret.flush(), nil
 }
 
-func (exec *singleWindowExec) flushDenseRank() (*vector.Vector, error) {
+func (exec *singleWindowExec[T]) flushDenseRank() (*vector.Vector, error) {
 	values := exec.ret.values
 
 	idx := 0
@@ -171,7 +204,7 @@ func (exec *singleWindowExec) flushDenseRank() (*vector.Vector, error) {
 			m := int(group[i] - group[i-1])
 
 			for k := idx + m; idx < k; idx++ {
-				values[idx] = sn
+				values[idx] = T(sn)
 			}
 			sn++
 		}
@@ -179,7 +212,7 @@ func (exec *singleWindowExec) flushDenseRank() (*vector.Vector, error) {
 	return exec.ret.flush(), nil
 }
 
-func (exec *singleWindowExec) flushRowNumber() (*vector.Vector, error) {
+func (exec *singleWindowExec[T]) flushRowNumber() (*vector.Vector, error) {
 	values := exec.ret.values
 
 	idx := 0
@@ -190,9 +223,90 @@ func (exec *singleWindowExec) flushRowNumber() (*vector.Vector, error) {
 
 		n := group[len(group)-1] - group[0]
 		for j := int64(1); j <= n; j++ {
-			values[idx] = j
+			values[idx] = T(j)
+			idx++
+		}
+	}
+	return exec.ret.flush(), nil
+}
+
+func (exec *singleWindowExec[T]) flushNtile() (*vector.Vector, error) {
+	values := exec.ret.values
+
+	idx := 0
+	for _, group := range exec.groups {
+		if len(group) == 0 {
+			continue
+		}
+
+		// the first (n % buckets) buckets have one more row than the others.
+		n := group[len(group)-1] - group[0]
+		size, large := n/exec.buckets, n%exec.buckets
+		bucket, count := int64(1), int64(0)
+		for j := int64(0); j < n; j++ {
+			limit := size
+			if bucket <= large {
+				limit++
+			}
+			if count >= limit {
+				bucket++
+				count = 0
+			}
+			values[idx] = T(bucket)
+			count++
 			idx++
 		}
 	}
 	return exec.ret.flush(), nil
 }
+
+func (exec *singleWindowExec[T]) flushPercentRank() (*vector.Vector, error) {
+	values := exec.ret.values
+
+	idx := 0
+	for _, group := range exec.groups {
+		if len(group) == 0 {
+			continue
+		}
+
+		// (rank - 1) / (rows in partition - 1)
+		n := group[len(group)-1] - group[0]
+		rank := int64(0)
+		for i := 1; i < len(group); i++ {
+			m := int(group[i] - group[i-1])
+
+			var v float64
+			if n > 1 {
+				v = float64(rank) / float64(n-1)
+			}
+			for k := idx + m; idx < k; idx++ {
+				values[idx] = T(v)
+			}
+			rank += int64(m)
+		}
+	}
+	return exec.ret.flush(), nil
+}
+
+func (exec *singleWindowExec[T]) flushCumeDist() (*vector.Vector, error) {
+	values := exec.ret.values
+
+	idx := 0
+	for _, group := range exec.groups {
+		if len(group) == 0 {
+			continue
+		}
+
+		// rows preceding or peer with the current row / rows in partition
+		n := group[len(group)-1] - group[0]
+		for i := 1; i < len(group); i++ {
+			m := int(group[i] - group[i-1])
+
+			v := float64(group[i]-group[0]) / float64(n)
+			for k := idx + m; idx < k; idx++ {
+				values[idx] = T(v)
+			}
+		}
+	}
+	return exec.ret.flush(), nil
+}
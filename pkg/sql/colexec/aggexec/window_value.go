@@ -0,0 +1,140 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggexec
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+func ValueWindowReturnType(args []types.Type) types.Type {
+	return args[0]
+}
+
+// valueWindowExec is the executor of the value window functions,
+// LAG, LEAD, FIRST_VALUE, LAST_VALUE and NTH_VALUE.
+//
+// the window operator decides which rows belong to a group (the frame of a row,
+// or the target row of LAG and LEAD), and fills them in order.
+// the executor only picks the row it needs and copies the value into the result.
+type valueWindowExec struct {
+	singleAggInfo
+	ret basicResult
+
+	// counts records how many rows were filled into each group.
+	counts []int64
+}
+
+func newValueWindowExec(mg AggMemoryManager, info singleAggInfo) AggFuncExec {
+	exec := &valueWindowExec{
+		singleAggInfo: info,
+	}
+	// the value copied from the source vector can be a null,
+	// so we set the null of empty groups by ourselves during the flush.
+	exec.ret.init(mg, info.retType, false)
+	return exec
+}
+
+func (exec *valueWindowExec) GroupGrow(more int) error {
+	exec.counts = append(exec.counts, make([]int64, more)...)
+	_, _, err := exec.ret.extend(more)
+	return err
+}
+
+func (exec *valueWindowExec) PreAllocateGroups(more int) error {
+	return exec.ret.preAllocate(more)
+}
+
+func (exec *valueWindowExec) Fill(groupIndex int, row int, vectors []*vector.Vector) error {
+	exec.counts[groupIndex]++
+
+	switch exec.singleAggInfo.aggID {
+	case winIdOfLastValue:
+	case winIdOfNthValue:
+		nth := vector.MustFixedColWithTypeCheck[int64](vectors[1])
+		n := nth[0]
+		if !vectors[1].IsConst() {
+			n = nth[row]
+		}
+		if exec.counts[groupIndex] != n {
+			return nil
+		}
+	default:
+		if exec.counts[groupIndex] != 1 {
+			return nil
+		}
+	}
+
+	exec.ret.setGroupNotEmpty(groupIndex)
+	return exec.ret.res.Copy(vectors[0], int64(groupIndex), int64(row), exec.ret.mp)
+}
+
+func (exec *valueWindowExec) BulkFill(groupIndex int, vectors []*vector.Vector) error {
+	return moerr.NewInternalErrorNoCtx("value window function does not support the bulk fill")
+}
+
+func (exec *valueWindowExec) BatchFill(offset int, groups []uint64, vectors []*vector.Vector) error {
+	return moerr.NewInternalErrorNoCtx("value window function does not support the batch fill")
+}
+
+func (exec *valueWindowExec) Merge(next AggFuncExec, groupIdx1, groupIdx2 int) error {
+	return moerr.NewInternalErrorNoCtx("value window function does not support the merge")
+}
+
+func (exec *valueWindowExec) BatchMerge(next AggFuncExec, offset int, groups []uint64) error {
+	return moerr.NewInternalErrorNoCtx("value window function does not support the merge")
+}
+
+func (exec *valueWindowExec) SetExtraInformation(partialResult any, groupIndex int) error {
+	panic("window function do not support the extra information")
+}
+
+func (exec *valueWindowExec) marshal() ([]byte, error) {
+	d := exec.singleAggInfo.getEncoded()
+	r, err := exec.ret.marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	encoded := &EncodedAgg{
+		Info:   d,
+		Result: r,
+		Groups: [][]byte{types.EncodeSlice[int64](exec.counts)},
+	}
+	return encoded.Marshal()
+}
+
+func (exec *valueWindowExec) unmarshal(mp *mpool.MPool, result []byte, groups [][]byte) error {
+	if len(groups) > 0 && len(groups[0]) > 0 {
+		exec.counts = append(exec.counts[:0], types.DecodeSlice[int64](groups[0])...)
+	}
+	return exec.ret.unmarshal0(result)
+}
+
+func (exec *valueWindowExec) Flush() (*vector.Vector, error) {
+	nsp := exec.ret.res.GetNulls()
+	for i, empty := range exec.ret.empty {
+		if empty {
+			nsp.Add(uint64(i))
+		}
+	}
+	return exec.ret.flush(), nil
+}
+
+func (exec *valueWindowExec) Free() {
+	exec.ret.free()
+}
@@ -105,6 +105,7 @@ func (h *ParquetHandler) openFile(param *ExternalParam) error {
 func (h *ParquetHandler) prepare(param *ExternalParam) error {
 	h.cols = make([]*parquet.Column, len(param.Attrs))
 	h.mappers = make([]*columnMapper, len(param.Attrs))
+	h.nestedMappers = make([]*nestedColumnMapper, len(param.Attrs))
 	for colIdx, attr := range param.Attrs {
 		def := param.Cols[colIdx]
 		if def.Hidden {
@@ -115,11 +116,16 @@ func (h *ParquetHandler) prepare(param *ExternalParam) error {
 		if col == nil {
 			return moerr.NewInvalidInputf(param.Ctx, "column %s not found", attr)
 		}
-		if !col.Leaf() {
-			return moerr.NewNYIf(param.Ctx, "load group type column %s", attr)
+		h.cols[colIdx] = col
+		if isNestedColumn(col) {
+			nm := h.getNestedMapper(col, def.Typ)
+			if nm == nil {
+				return moerr.NewNYIf(param.Ctx, "load nested column %s to %s", attr, types.T(def.Typ.Id).String())
+			}
+			h.nestedMappers[colIdx] = nm
+			continue
 		}
 
-		h.cols[colIdx] = col
 		fn := h.getMapper(col, def.Typ)
 		if fn == nil {
 			st := col.Type().String()
@@ -517,6 +523,16 @@ func (h *ParquetHandler) getData(bat *batch.Batch, param *ExternalParam, proc *p
 		}
 
 		vec := bat.Vecs[colIdx]
+		if nm := h.nestedMappers[colIdx]; nm != nil {
+			fin, err := nm.mapping(param.Ctx, h.offset, h.batchCnt, proc, vec)
+			if err != nil {
+				return err
+			}
+			finish = finish || fin
+			length = vec.Length()
+			continue
+		}
+
 		pages := col.Pages()
 		n := h.batchCnt
 		o := h.offset
@@ -0,0 +1,466 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/parquet-go/parquet-go"
+	"github.com/parquet-go/parquet-go/deprecated"
+	"github.com/parquet-go/parquet-go/format"
+)
+
+type nestedKind int
+
+const (
+	nestedStruct nestedKind = iota
+	nestedList
+	nestedMap
+)
+
+// nestedColumnMapper maps a group column or a repeated column of the parquet file.
+// the values of all the leaf columns are read row by row, every row is assembled
+// into a go value by the repetition and definition levels, and the value is
+// converted to json or vector.
+type nestedColumnMapper struct {
+	col    *parquet.Column
+	leaves []*parquet.Column
+	// the LIST and MAP annotations of the group columns, keyed by the column path
+	kinds map[string]nestedKind
+	dt    plan.Type
+}
+
+func isNestedColumn(col *parquet.Column) bool {
+	return !col.Leaf() || col.MaxRepetitionLevel() > 0
+}
+
+func parquetColumnKey(path []string) string {
+	return strings.Join(path, "\x00")
+}
+
+// getNestedKinds collects the LIST and MAP annotations of the group columns from
+// the file metadata, the column of the file does not expose the LIST annotation.
+func getNestedKinds(file *parquet.File) map[string]nestedKind {
+	kinds := make(map[string]nestedKind)
+	schema := file.Metadata().Schema
+	if len(schema) == 0 {
+		return kinds
+	}
+	var walk func(idx int, path []string) int
+	walk = func(idx int, path []string) int {
+		elem := &schema[idx]
+		idx++
+		if elem.NumChildren == 0 {
+			return idx
+		}
+		lt, ct := elem.LogicalType, elem.ConvertedType
+		switch {
+		case lt != nil && lt.List != nil, ct != nil && *ct == deprecated.List:
+			kinds[parquetColumnKey(path)] = nestedList
+		case lt != nil && lt.Map != nil, ct != nil && (*ct == deprecated.Map || *ct == deprecated.MapKeyValue):
+			kinds[parquetColumnKey(path)] = nestedMap
+		}
+		for i := 0; i < int(elem.NumChildren) && idx < len(schema); i++ {
+			idx = walk(idx, append(path[:len(path):len(path)], schema[idx].Name))
+		}
+		return idx
+	}
+	walk(0, nil)
+	return kinds
+}
+
+func (h *ParquetHandler) getNestedMapper(col *parquet.Column, dt plan.Type) *nestedColumnMapper {
+	if col.Optional() && dt.NotNullable {
+		return nil
+	}
+	switch types.T(dt.Id) {
+	case types.T_json, types.T_array_float32, types.T_array_float64:
+	default:
+		return nil
+	}
+	mp := &nestedColumnMapper{
+		col:   col,
+		kinds: getNestedKinds(h.file),
+		dt:    dt,
+	}
+	var collect func(c *parquet.Column)
+	collect = func(c *parquet.Column) {
+		if c.Leaf() {
+			mp.leaves = append(mp.leaves, c)
+			return
+		}
+		for _, child := range c.Columns() {
+			collect(child)
+		}
+	}
+	collect(col)
+	if len(mp.leaves) == 0 {
+		return nil
+	}
+	return mp
+}
+
+// readParquetLeafRows reads the values of n rows from the offset of the leaf column, the
+// values of a row start with the value whose repetition level is 0.
+func readParquetLeafRows(ctx context.Context, col *parquet.Column, offset, n int64) ([][]parquet.Value, bool, error) {
+	var rows [][]parquet.Value
+	finish := false
+	pages := col.Pages()
+	defer pages.Close()
+	for n > 0 {
+		page, err := pages.ReadPage()
+		if errors.Is(err, io.EOF) {
+			finish = true
+			break
+		}
+		if err != nil {
+			return nil, false, moerr.ConvertGoError(ctx, err)
+		}
+
+		nr := page.NumRows()
+		if nr <= offset {
+			offset -= nr
+			continue
+		}
+		if offset > 0 || offset+n < nr {
+			page = page.Slice(offset, min(n+offset, nr))
+		}
+		offset = 0
+		n -= page.NumRows()
+
+		values := make([]parquet.Value, page.NumValues())
+		m, err := page.Values().ReadValues(values)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, false, moerr.ConvertGoError(ctx, err)
+		}
+		for _, v := range values[:m] {
+			// the value may refer to the buffer of the page
+			v = v.Clone()
+			if v.RepetitionLevel() == 0 {
+				rows = append(rows, []parquet.Value{v})
+			} else if len(rows) > 0 {
+				rows[len(rows)-1] = append(rows[len(rows)-1], v)
+			} else {
+				return nil, false, moerr.NewInvalidInputf(ctx, "malformed page of column %s", col.Name())
+			}
+		}
+	}
+	return rows, finish, nil
+}
+
+// mapping reads n rows from the offset and appends them into the vector
+func (mp *nestedColumnMapper) mapping(ctx context.Context, offset, n int64, proc *process.Process, vec *vector.Vector) (bool, error) {
+	leafRows := make([][][]parquet.Value, len(mp.leaves))
+	finish := false
+	for i, leaf := range mp.leaves {
+		rows, fin, err := readParquetLeafRows(ctx, leaf, offset, n)
+		if err != nil {
+			return false, err
+		}
+		if i > 0 && len(rows) != len(leafRows[0]) {
+			return false, moerr.NewInvalidInputf(ctx, "column %s has %d rows, but column %s has %d rows",
+				leaf.Name(), len(rows), mp.leaves[0].Name(), len(leafRows[0]))
+		}
+		leafRows[i] = rows
+		finish = finish || fin
+	}
+
+	columns := make([][]parquet.Value, len(mp.leaves))
+	for i := range leafRows[0] {
+		for j := range leafRows {
+			columns[j] = leafRows[j][i]
+		}
+		v, err := mp.assemble(ctx, mp.col, parquetLevels{}, columns)
+		if err != nil {
+			return false, err
+		}
+		if err = mp.appendValue(ctx, v, proc, vec); err != nil {
+			return false, err
+		}
+	}
+	return finish, nil
+}
+
+type parquetLevels struct {
+	repetitionDepth int
+	definitionLevel int
+}
+
+// assemble assembles the values of a row into a go value, the group is assembled
+// into map[string]any, the LIST and the repeated field are assembled into []any.
+func (mp *nestedColumnMapper) assemble(ctx context.Context, col *parquet.Column, levels parquetLevels, columns [][]parquet.Value) (any, error) {
+	switch {
+	case col.Optional():
+		levels.definitionLevel++
+		if columns[0][0].DefinitionLevel() < levels.definitionLevel {
+			return nil, nil
+		}
+	case col.Repeated():
+		levels.repetitionDepth++
+		levels.definitionLevel++
+		elems := make([]any, 0)
+		if columns[0][0].DefinitionLevel() < levels.definitionLevel {
+			return elems, nil
+		}
+		rest := columns
+		for len(rest[0]) > 0 {
+			elem := make([][]parquet.Value, len(rest))
+			next := make([][]parquet.Value, len(rest))
+			for j, values := range rest {
+				k := min(1, len(values))
+				for k < len(values) && values[k].RepetitionLevel() > levels.repetitionDepth {
+					k++
+				}
+				elem[j], next[j] = values[:k], values[k:]
+			}
+			v, err := mp.assembleRequired(ctx, col, levels, elem)
+			if err != nil {
+				return nil, err
+			}
+			elems = append(elems, v)
+			rest = next
+		}
+		return elems, nil
+	}
+	return mp.assembleRequired(ctx, col, levels, columns)
+}
+
+func (mp *nestedColumnMapper) assembleRequired(ctx context.Context, col *parquet.Column, levels parquetLevels, columns [][]parquet.Value) (any, error) {
+	if col.Leaf() {
+		return parquetLeafValue(col.Type(), columns[0][0]), nil
+	}
+
+	children := col.Columns()
+	switch mp.kinds[parquetColumnKey(col.Path())] {
+	case nestedList:
+		if len(children) != 1 || !children[0].Repeated() {
+			return nil, moerr.NewInvalidInputf(ctx, "malformed LIST column %s", col.Name())
+		}
+		list := children[0]
+		v, err := mp.assemble(ctx, list, levels, columns)
+		if err != nil {
+			return nil, err
+		}
+		elems := v.([]any)
+		// the 3-level list: repeated group list { element }, the legacy 2-level list
+		// is the repeated field itself
+		if !list.Leaf() && len(list.Columns()) == 1 && list.Name() != "array" && list.Name() != col.Name()+"_tuple" {
+			name := list.Columns()[0].Name()
+			for i := range elems {
+				elems[i] = elems[i].(map[string]any)[name]
+			}
+		}
+		return elems, nil
+	case nestedMap:
+		if len(children) != 1 || !children[0].Repeated() || len(children[0].Columns()) != 2 {
+			return nil, moerr.NewInvalidInputf(ctx, "malformed MAP column %s", col.Name())
+		}
+		keyValue := children[0]
+		v, err := mp.assemble(ctx, keyValue, levels, columns)
+		if err != nil {
+			return nil, err
+		}
+		keyName, valueName := keyValue.Columns()[0].Name(), keyValue.Columns()[1].Name()
+		m := make(map[string]any)
+		for _, entry := range v.([]any) {
+			kv := entry.(map[string]any)
+			var key string
+			switch k := kv[keyName].(type) {
+			case string:
+				key = k
+			case nil:
+				continue
+			default:
+				key = fmt.Sprint(k)
+			}
+			m[key] = kv[valueName]
+		}
+		return m, nil
+	}
+
+	obj := make(map[string]any, len(children))
+	off := 0
+	for _, child := range children {
+		end := off + countParquetLeaves(child)
+		v, err := mp.assemble(ctx, child, levels, columns[off:end])
+		if err != nil {
+			return nil, err
+		}
+		obj[child.Name()] = v
+		off = end
+	}
+	return obj, nil
+}
+
+func countParquetLeaves(col *parquet.Column) int {
+	if col.Leaf() {
+		return 1
+	}
+	n := 0
+	for _, child := range col.Columns() {
+		n += countParquetLeaves(child)
+	}
+	return n
+}
+
+// parquetLeafValue converts the value of the leaf column into the value which can
+// be encoded into json, the logical types are converted into strings.
+func parquetLeafValue(typ parquet.Type, v parquet.Value) any {
+	lt := typ.LogicalType()
+	switch v.Kind() {
+	case parquet.Boolean:
+		return v.Boolean()
+	case parquet.Int32, parquet.Int64:
+		i := v.Int64()
+		if v.Kind() == parquet.Int32 {
+			i = int64(v.Int32())
+		}
+		switch {
+		case lt == nil:
+			return i
+		case lt.Date != nil:
+			return time.Unix(i*86400, 0).UTC().Format(time.DateOnly)
+		case lt.Timestamp != nil:
+			return parquetTimeOf(i, &lt.Timestamp.Unit).Format("2006-01-02 15:04:05.999999")
+		case lt.Time != nil:
+			return parquetTimeOf(i, &lt.Time.Unit).Format("15:04:05.999999")
+		case lt.Decimal != nil:
+			r := big.NewRat(i, 1)
+			return json.Number(r.Quo(r, pow10Rat(lt.Decimal.Scale)).FloatString(int(lt.Decimal.Scale)))
+		case lt.Integer != nil && !lt.Integer.IsSigned:
+			if v.Kind() == parquet.Int32 {
+				return uint64(v.Uint32())
+			}
+			return v.Uint64()
+		}
+		return i
+	case parquet.Float:
+		return float64(v.Float())
+	case parquet.Double:
+		return v.Double()
+	case parquet.ByteArray, parquet.FixedLenByteArray:
+		b := v.ByteArray()
+		switch {
+		case lt == nil:
+		case lt.Json != nil:
+			if bj, err := types.ParseSliceToByteJson(b); err == nil {
+				return bj
+			}
+		case lt.UUID != nil:
+			if len(b) == 16 {
+				var u types.Uuid
+				copy(u[:], b)
+				return u.String()
+			}
+		case lt.Decimal != nil:
+			// big endian two's complement
+			n := new(big.Int).SetBytes(b)
+			if len(b) > 0 && b[0]&0x80 != 0 {
+				n.Sub(n, new(big.Int).Lsh(big.NewInt(1), uint(len(b)*8)))
+			}
+			r := new(big.Rat).SetFrac(n, big.NewInt(1))
+			return json.Number(r.Quo(r, pow10Rat(lt.Decimal.Scale)).FloatString(int(lt.Decimal.Scale)))
+		}
+		return string(b)
+	}
+	return v.String()
+}
+
+func pow10Rat(scale int32) *big.Rat {
+	return new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil))
+}
+
+func parquetTimeOf(v int64, unit *format.TimeUnit) time.Time {
+	switch {
+	case unit.Nanos != nil:
+		return time.Unix(0, v).UTC()
+	case unit.Millis != nil:
+		return time.UnixMilli(v).UTC()
+	default:
+		return time.UnixMicro(v).UTC()
+	}
+}
+
+func (mp *nestedColumnMapper) appendValue(ctx context.Context, v any, proc *process.Process, vec *vector.Vector) error {
+	if v == nil {
+		if mp.dt.NotNullable {
+			return moerr.NewConstraintViolationf(ctx, "Column '%s' cannot be null", mp.col.Name())
+		}
+		return vector.AppendBytes(vec, nil, true, proc.Mp())
+	}
+
+	switch types.T(mp.dt.Id) {
+	case types.T_json:
+		bj, err := bytejson.CreateByteJSON(v)
+		if err != nil {
+			return err
+		}
+		data, err := types.EncodeJson(bj)
+		if err != nil {
+			return err
+		}
+		return vector.AppendBytes(vec, data, false, proc.Mp())
+	case types.T_array_float32:
+		arr, err := parquetFloatList[float32](ctx, mp, v)
+		if err != nil {
+			return err
+		}
+		return vector.AppendBytes(vec, types.ArrayToBytes(arr), false, proc.Mp())
+	case types.T_array_float64:
+		arr, err := parquetFloatList[float64](ctx, mp, v)
+		if err != nil {
+			return err
+		}
+		return vector.AppendBytes(vec, types.ArrayToBytes(arr), false, proc.Mp())
+	}
+	return moerr.NewNYIf(ctx, "load nested column to %s", types.T(mp.dt.Id).String())
+}
+
+func parquetFloatList[T types.RealNumbers](ctx context.Context, mp *nestedColumnMapper, v any) ([]T, error) {
+	elems, ok := v.([]any)
+	if !ok {
+		return nil, moerr.NewInvalidInputf(ctx, "column %s is not a LIST", mp.col.Name())
+	}
+	if mp.dt.Width > 0 && int(mp.dt.Width) != len(elems) {
+		return nil, moerr.NewArrayDefMismatchNoCtx(int(mp.dt.Width), len(elems))
+	}
+	arr := make([]T, len(elems))
+	for i, elem := range elems {
+		switch e := elem.(type) {
+		case float64:
+			arr[i] = T(e)
+		case int64:
+			arr[i] = T(e)
+		case uint64:
+			arr[i] = T(e)
+		default:
+			return nil, moerr.NewInvalidInputf(ctx, "the element %v of column %s is not a number", elem, mp.col.Name())
+		}
+	}
+	return arr, nil
+}
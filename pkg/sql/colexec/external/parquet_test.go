@@ -20,8 +20,10 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/parquet-go/parquet-go"
@@ -317,3 +319,101 @@ func Test_getMapper(t *testing.T) {
 		})
 	}
 }
+
+type testParquetAddress struct {
+	City string  `parquet:"city"`
+	Zip  *string `parquet:"zip,optional"`
+}
+
+type testParquetNestedRow struct {
+	ID    int64               `parquet:"id"`
+	Tags  []string            `parquet:"tags,list"`
+	Attrs map[string]int32    `parquet:"attrs"`
+	Addr  *testParquetAddress `parquet:"addr,optional"`
+	Emb   []float32           `parquet:"emb,list"`
+	Nums  []int32             `parquet:"nums"`
+}
+
+func Test_parquetNestedColumns(t *testing.T) {
+	proc := testutil.NewProc()
+
+	zip := "75001"
+	rows := []testParquetNestedRow{
+		{
+			ID:    1,
+			Tags:  []string{"a", "b"},
+			Attrs: map[string]int32{"x": 1},
+			Addr:  &testParquetAddress{City: "paris", Zip: &zip},
+			Emb:   []float32{1, 2, 3},
+			Nums:  []int32{7, 8},
+		},
+		{
+			ID:   2,
+			Tags: []string{},
+			Emb:  []float32{4, 5, 6},
+		},
+	}
+	var buf bytes.Buffer
+	w := parquet.NewGenericWriter[testParquetNestedRow](&buf)
+	_, err := w.Write(rows)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	attrs := []string{"tags", "attrs", "addr", "emb", "nums"}
+	typs := []types.Type{
+		types.T_json.ToType(),
+		types.T_json.ToType(),
+		types.T_json.ToType(),
+		types.New(types.T_array_float32, 3, 0),
+		types.T_json.ToType(),
+	}
+	param := &ExternalParam{
+		ExParamConst: ExParamConst{
+			Attrs:    attrs,
+			FileSize: []int64{int64(buf.Len())},
+			Ctx:      proc.Ctx,
+			Extern: &tree.ExternParam{
+				ExParamConst: tree.ExParamConst{
+					ScanType: tree.INLINE,
+					Data:     buf.String(),
+				},
+			},
+		},
+		ExParam: ExParam{
+			Fileparam: &ExFileparam{FileCnt: 1, FileIndex: 1},
+		},
+	}
+	bat := batch.NewWithSize(len(attrs))
+	for i, typ := range typs {
+		param.Cols = append(param.Cols, &plan.ColDef{
+			Name: attrs[i],
+			Typ:  plan.Type{Id: int32(typ.Oid), Width: typ.Width},
+		})
+		bat.Vecs[i] = vector.NewVec(typ)
+	}
+	defer bat.Clean(proc.Mp())
+
+	require.NoError(t, scanParquetFile(proc.Ctx, param, proc, bat))
+	require.Equal(t, 2, bat.RowCount())
+
+	jsonAt := func(col, row int) string {
+		return types.DecodeJson(bat.Vecs[col].GetBytesAt(row)).String()
+	}
+	require.Equal(t, `["a", "b"]`, jsonAt(0, 0))
+	require.Equal(t, `[]`, jsonAt(0, 1))
+	require.Equal(t, `{"x": 1}`, jsonAt(1, 0))
+	require.Equal(t, `{}`, jsonAt(1, 1))
+	require.Equal(t, `{"city": "paris", "zip": "75001"}`, jsonAt(2, 0))
+	require.True(t, bat.Vecs[2].IsNull(1))
+	require.Equal(t, []float32{1, 2, 3}, types.BytesToArray[float32](bat.Vecs[3].GetBytesAt(0)))
+	require.Equal(t, []float32{4, 5, 6}, types.BytesToArray[float32](bat.Vecs[3].GetBytesAt(1)))
+	require.Equal(t, `[7, 8]`, jsonAt(4, 0))
+	require.Equal(t, `[]`, jsonAt(4, 1))
+
+	// the dimension of the vector column mismatches
+	param.parqh = nil
+	param.Fileparam = &ExFileparam{FileCnt: 1, FileIndex: 1}
+	param.Cols[3].Typ.Width = 4
+	bat.CleanOnlyData()
+	require.Error(t, scanParquetFile(proc.Ctx, param, proc, bat))
+}
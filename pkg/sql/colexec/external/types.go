@@ -241,6 +241,8 @@ type ParquetHandler struct {
 	batchCnt int64
 	cols     []*parquet.Column
 	mappers  []*columnMapper
+	// mappers of the group columns and the repeated columns
+	nestedMappers []*nestedColumnMapper
 }
 
 type columnMapper struct {
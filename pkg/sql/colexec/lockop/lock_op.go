@@ -156,6 +156,9 @@ func performLock(
 	targetIdx int,
 ) error {
 	needRetry := false
+	// rows locked by other txns in skip locked mode, removed from the batch
+	// after all targets are locked
+	var skipped []bool
 	for idx, target := range lockOp.targets {
 		if targetIdx != -1 && targetIdx != idx {
 			continue
@@ -180,23 +183,44 @@ func performLock(
 				}
 			}
 		}
-		locked, defChanged, refreshTS, err := doLock(
-			proc.Ctx,
-			lockOp.engine,
-			analyzer,
-			nil,
-			target.tableID,
-			proc,
-			priVec,
-			target.primaryColumnType,
-			DefaultLockOptions(lockOp.ctr.parker).
-				WithLockMode(lock.LockMode_Exclusive).
-				WithFetchLockRowsFunc(lockOp.ctr.fetchers[idx]).
-				WithMaxBytesPerLock(int(proc.GetLockService().GetConfig().MaxLockRowCount)).
-				WithFilterRows(target.filter, filterCols).
-				WithLockTable(target.lockTable, target.changeDef).
-				WithHasNewVersionInRangeFunc(lockOp.ctr.hasNewVersionInRange),
-		)
+		opts := DefaultLockOptions(lockOp.ctr.parker).
+			WithLockMode(target.mode).
+			WithWaitPolicy(target.waitPolicy, time.Duration(target.waitSec)*time.Second).
+			WithFetchLockRowsFunc(lockOp.ctr.fetchers[idx]).
+			WithMaxBytesPerLock(int(proc.GetLockService().GetConfig().MaxLockRowCount)).
+			WithFilterRows(target.filter, filterCols).
+			WithLockTable(target.lockTable, target.changeDef).
+			WithHasNewVersionInRangeFunc(lockOp.ctr.hasNewVersionInRange)
+
+		var locked, defChanged bool
+		var refreshTS timestamp.Timestamp
+		var err error
+		if target.skipLocked && !target.lockTable {
+			if skipped == nil {
+				skipped = make([]bool, priVec.Length())
+			}
+			locked, defChanged, refreshTS, err = lockOp.lockSkipLocked(
+				proc,
+				analyzer,
+				target,
+				priVec,
+				filterCols,
+				opts,
+				skipped,
+			)
+		} else {
+			locked, defChanged, refreshTS, err = doLock(
+				proc.Ctx,
+				lockOp.engine,
+				analyzer,
+				nil,
+				target.tableID,
+				proc,
+				priVec,
+				target.primaryColumnType,
+				opts,
+			)
+		}
 		if lockOp.logger.Enabled(zap.DebugLevel) {
 			lockOp.logger.Debug("lock result",
 				zap.Uint64("table", target.tableID),
@@ -243,9 +267,121 @@ func performLock(
 	if lockOp.ctr.defChanged {
 		lockOp.ctr.retryError = retryWithDefChangedError
 	}
+	if skipped != nil {
+		var sels []int64
+		for i, skip := range skipped {
+			if skip {
+				sels = append(sels, int64(i))
+			}
+		}
+		if len(sels) > 0 {
+			bat.Shrink(sels, true)
+		}
+	}
 	return nil
 }
 
+// lockSkipLocked locks the rows without waiting, the rows locked by other txns are
+// marked in skipped instead of failing the statement. The rows are locked in batches,
+// and a batch that meets a locked row is split into halves until the locked row is
+// found, so the number of lock requests grows with the number of the locked rows
+// rather than all rows. Once skipLockedLimit rows are locked, the rest rows are
+// skipped without locking, since the limit above will drop them anyway.
+func (lockOp *LockOp) lockSkipLocked(
+	proc *process.Process,
+	analyzer process.Analyzer,
+	target lockTarget,
+	priVec *vector.Vector,
+	filterCols []int32,
+	opts LockOptions,
+	skipped []bool,
+) (bool, bool, timestamp.Timestamp, error) {
+	var sels []int64
+	for i := 0; i < priVec.Length(); i++ {
+		if skipped[i] ||
+			(target.filter != nil && !target.filter(i, filterCols)) {
+			continue
+		}
+		sels = append(sels, int64(i))
+	}
+	if len(sels) == 0 {
+		return false, false, timestamp.Timestamp{}, nil
+	}
+
+	vec := vector.NewVec(*priVec.GetType())
+	defer vec.Free(proc.Mp())
+
+	var locked, defChanged bool
+	var refreshTS timestamp.Timestamp
+	// the filter is applied above, the rows of a batch are copied into vec
+	opts = opts.WithFilterRows(nil, nil)
+
+	var lockRows func(sels []int64, size int) error
+	lockRows = func(sels []int64, size int) error {
+		for len(sels) > 0 {
+			n := min(len(sels), size)
+			if target.skipLockedLimit > 0 {
+				if lockOp.ctr.skipLockedCount >= target.skipLockedLimit {
+					for _, i := range sels {
+						skipped[i] = true
+					}
+					return nil
+				}
+				n = int(min(uint64(n), target.skipLockedLimit-lockOp.ctr.skipLockedCount))
+			}
+
+			rows := sels[:n]
+			sels = sels[n:]
+			vec.CleanOnlyData()
+			if err := vec.Union(priVec, rows, proc.Mp()); err != nil {
+				return err
+			}
+			ok, changed, ts, err := doLock(
+				proc.Ctx,
+				lockOp.engine,
+				analyzer,
+				nil,
+				target.tableID,
+				proc,
+				vec,
+				target.primaryColumnType,
+				opts,
+			)
+			if moerr.IsMoErrCode(err, moerr.ErrLockNoWait) {
+				// the rows before the locked row are held by the txn now, and
+				// they are locked again in the first half without conflict.
+				if n == 1 {
+					skipped[rows[0]] = true
+				} else if err := lockRows(rows, (n+1)/2); err != nil {
+					return err
+				}
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			lockOp.ctr.skipLockedCount += uint64(n)
+			locked = locked || ok
+			defChanged = defChanged || changed
+			if refreshTS.Less(ts) {
+				refreshTS = ts
+			}
+		}
+		return nil
+	}
+
+	// more rows than maxCountPerLock are locked as a range, which may hold the
+	// rows that are not in the batch.
+	size := len(sels)
+	if opts.maxCountPerLock > 0 {
+		size = min(size, opts.maxCountPerLock)
+	}
+	if err := lockRows(sels, size); err != nil {
+		return false, false, timestamp.Timestamp{}, err
+	}
+	return locked, defChanged, refreshTS, nil
+}
+
 // LockTable lock table, all rows in the table will be locked, and wait current txn
 // closed.
 func LockTable(
@@ -435,10 +571,15 @@ func doLock(
 		return false, false, timestamp.Timestamp{}, nil
 	}
 
+	policy := proc.GetWaitPolicy()
+	if opts.waitPolicy == lock.WaitPolicy_FastFail {
+		policy = lock.WaitPolicy_FastFail
+	}
+
 	txn := txnOp.Txn()
 	options := lock.LockOptions{
 		Granularity:     g,
-		Policy:          proc.GetWaitPolicy(),
+		Policy:          policy,
 		Mode:            opts.mode,
 		TableDefChanged: opts.changeDef,
 		Sharding:        opts.sharding,
@@ -466,8 +607,15 @@ func doLock(
 		table = lockservice.ShardingByRow(rows[0])
 	}
 
+	lockCtx := ctx
+	if opts.waitTimeout > 0 {
+		var cancel context.CancelFunc
+		lockCtx, cancel = context.WithTimeout(ctx, opts.waitTimeout)
+		defer cancel()
+	}
+
 	result, err := lockWithRetry(
-		ctx,
+		lockCtx,
 		lockService,
 		table,
 		rows,
@@ -480,6 +628,12 @@ func doLock(
 		pkType,
 	)
 	if err != nil {
+		if opts.waitPolicy == lock.WaitPolicy_FastFail &&
+			moerr.IsMoErrCode(err, moerr.ErrLockConflict) {
+			err = moerr.NewLockNoWait(ctx)
+		} else if lockCtx.Err() != nil && ctx.Err() == nil {
+			err = moerr.NewLockWaitTimeout(ctx)
+		}
 		return false, false, timestamp.Timestamp{}, err
 	}
 	// Record lock waiting time
@@ -720,6 +874,14 @@ func (opts LockOptions) WithLockMode(mode lock.LockMode) LockOptions {
 	return opts
 }
 
+// WithWaitPolicy set how to wait for the locks held by other txns. FastFail
+// returns an error at once, otherwise a positive timeout limits the wait time.
+func (opts LockOptions) WithWaitPolicy(policy lock.WaitPolicy, timeout time.Duration) LockOptions {
+	opts.waitPolicy = policy
+	opts.waitTimeout = timeout
+	return opts
+}
+
 // WithLockTable set lock all table
 func (opts LockOptions) WithLockTable(lockTable, changeDef bool) LockOptions {
 	opts.lockTable = lockTable
@@ -780,6 +942,10 @@ func (lockOp *LockOp) CopyToPipelineTarget() []*pipeline.LockTarget {
 			Mode:               target.mode,
 			LockRows:           plan.DeepCopyExpr(target.lockRows),
 			LockTableAtTheEnd:  target.lockTableAtTheEnd,
+			WaitPolicy:         target.waitPolicy,
+			WaitSec:            target.waitSec,
+			SkipLocked:         target.skipLocked,
+			SkipLockedLimit:    target.skipLockedLimit,
 		}
 	}
 	return targets
@@ -851,6 +1017,28 @@ func (lockOp *LockOp) LockTableWithMode(
 	return lockOp
 }
 
+// WithLockWaitOptions set the lock mode and how to handle the lock conflicts
+// of the targets of the table, used by the FOR SHARE, NOWAIT, WAIT n and
+// SKIP LOCKED of select.
+func (lockOp *LockOp) WithLockWaitOptions(
+	tableID uint64,
+	mode lock.LockMode,
+	policy lock.WaitPolicy,
+	waitSec uint64,
+	skipLocked bool,
+	skipLockedLimit uint64) *LockOp {
+	for idx := range lockOp.targets {
+		if lockOp.targets[idx].tableID == tableID {
+			lockOp.targets[idx].mode = mode
+			lockOp.targets[idx].waitPolicy = policy
+			lockOp.targets[idx].waitSec = waitSec
+			lockOp.targets[idx].skipLocked = skipLocked
+			lockOp.targets[idx].skipLockedLimit = skipLockedLimit
+		}
+	}
+	return lockOp
+}
+
 // AddLockTargetWithPartition add lock targets for partition tables. Our partitioned table implementation
 // has each partition as a separate table. So when modifying data, these rows may belong to different
 // partitions. For lock op does not care about the logic of data and partition mapping calculation, the
@@ -925,6 +1113,7 @@ func (lockOp *LockOp) Reset(proc *process.Process, pipelineFailed bool, err erro
 	lockOp.resetParker()
 	lockOp.ctr.retryError = nil
 	lockOp.ctr.defChanged = false
+	lockOp.ctr.skipLockedCount = 0
 }
 
 // Free free mem
@@ -355,6 +355,172 @@ func TestLockWithHasNewVersionInLockedTS(t *testing.T) {
 	stopper.Stop()
 }
 
+func TestCallLockOpWithNoWait(t *testing.T) {
+	tableID := uint64(10)
+	runLockNonBlockingOpTest(
+		t,
+		[]uint64{tableID},
+		[][]int32{{0, 1, 2}},
+		func(proc *process.Process, arg *LockOp) {
+			arg.WithLockWaitOptions(tableID, lock.LockMode_Exclusive, lock.WaitPolicy_FastFail, 0, false, 0)
+			require.NoError(t, arg.Prepare(proc))
+			arg.ctr.hasNewVersionInRange = testFunc
+
+			conflictRow := lockTestRow(arg, 1)
+			lockByOtherTxn(t, proc, tableID, conflictRow)
+			defer unlockOtherTxn(t, proc)
+
+			_, err := arg.Call(proc)
+			require.Error(t, err)
+			assert.True(t, moerr.IsMoErrCode(err, moerr.ErrLockNoWait))
+		},
+	)
+}
+
+func TestCallLockOpWithWaitTimeout(t *testing.T) {
+	tableID := uint64(10)
+	runLockNonBlockingOpTest(
+		t,
+		[]uint64{tableID},
+		[][]int32{{0, 1, 2}},
+		func(proc *process.Process, arg *LockOp) {
+			arg.WithLockWaitOptions(tableID, lock.LockMode_Exclusive, lock.WaitPolicy_Wait, 1, false, 0)
+			require.NoError(t, arg.Prepare(proc))
+			arg.ctr.hasNewVersionInRange = testFunc
+
+			conflictRow := lockTestRow(arg, 1)
+			lockByOtherTxn(t, proc, tableID, conflictRow)
+			defer unlockOtherTxn(t, proc)
+
+			_, err := arg.Call(proc)
+			require.Error(t, err)
+			assert.True(t, moerr.IsMoErrCode(err, moerr.ErrLockWaitTimeout))
+		},
+	)
+}
+
+func TestCallLockOpWithSkipLocked(t *testing.T) {
+	tableID := uint64(10)
+	runLockNonBlockingOpTest(
+		t,
+		[]uint64{tableID},
+		[][]int32{{0, 1, 2}},
+		func(proc *process.Process, arg *LockOp) {
+			arg.WithLockWaitOptions(tableID, lock.LockMode_Exclusive, lock.WaitPolicy_FastFail, 0, true, 0)
+			require.NoError(t, arg.Prepare(proc))
+			arg.ctr.hasNewVersionInRange = testFunc
+
+			conflictRow := lockTestRow(arg, 1)
+			lockByOtherTxn(t, proc, tableID, conflictRow)
+			defer unlockOtherTxn(t, proc)
+
+			result, err := arg.Call(proc)
+			require.NoError(t, err)
+			assert.Equal(t,
+				[]int32{0, 2},
+				vector.MustFixedColWithTypeCheck[int32](result.Batch.GetVector(0)))
+		},
+	)
+}
+
+func TestCallLockOpWithSkipLockedLimit(t *testing.T) {
+	tableID := uint64(10)
+	runLockNonBlockingOpTest(
+		t,
+		[]uint64{tableID},
+		[][]int32{{0, 1, 2}},
+		func(proc *process.Process, arg *LockOp) {
+			arg.WithLockWaitOptions(tableID, lock.LockMode_Exclusive, lock.WaitPolicy_FastFail, 0, true, 1)
+			require.NoError(t, arg.Prepare(proc))
+			arg.ctr.hasNewVersionInRange = testFunc
+
+			conflictRow := lockTestRow(arg, 0)
+			lockByOtherTxn(t, proc, tableID, conflictRow)
+			defer unlockOtherTxn(t, proc)
+
+			result, err := arg.Call(proc)
+			require.NoError(t, err)
+			assert.Equal(t,
+				[]int32{1},
+				vector.MustFixedColWithTypeCheck[int32](result.Batch.GetVector(0)))
+
+			// the row after the limit is not locked, so other txns can lock it
+			_, err = proc.GetLockService().Lock(
+				proc.Ctx,
+				tableID,
+				[][]byte{lockTestRow(arg, 2)},
+				[]byte("txn01"),
+				lock.LockOptions{Policy: lock.WaitPolicy_FastFail})
+			require.NoError(t, err)
+		},
+	)
+}
+
+func TestCallLockOpWithSkipLockedInBatch(t *testing.T) {
+	tableID := uint64(10)
+	values := make([]int32, 16)
+	for i := range values {
+		values[i] = int32(i)
+	}
+	runLockNonBlockingOpTest(
+		t,
+		[]uint64{tableID},
+		[][]int32{values},
+		func(proc *process.Process, arg *LockOp) {
+			arg.WithLockWaitOptions(tableID, lock.LockMode_Exclusive, lock.WaitPolicy_FastFail, 0, true, 0)
+			require.NoError(t, arg.Prepare(proc))
+			arg.ctr.hasNewVersionInRange = testFunc
+
+			conflictRow := lockTestRow(arg, 5)
+			lockByOtherTxn(t, proc, tableID, conflictRow)
+			defer unlockOtherTxn(t, proc)
+
+			requests := 0
+			runtime.SetupServiceRuntimeTestingContext(proc.GetService())
+			tc := runtime.MustGetTestingContext(proc.GetService())
+			tc.SetAdjustLockResultFunc(func(txnID []byte, tableID uint64, res *lock.Result) {})
+			tc.SetBeforeLockFunc(func(txnID []byte, tableID uint64) { requests++ })
+			defer func() {
+				tc.SetAdjustLockResultFunc(nil)
+				tc.SetBeforeLockFunc(nil)
+			}()
+
+			result, err := arg.Call(proc)
+			require.NoError(t, err)
+			expect := append(append([]int32(nil), values[:5]...), values[6:]...)
+			assert.Equal(t,
+				expect,
+				vector.MustFixedColWithTypeCheck[int32](result.Batch.GetVector(0)))
+			// the batch is split only around the locked row, instead of one request per row
+			assert.Equal(t, 9, requests)
+		},
+	)
+}
+
+func lockTestRow(arg *LockOp, v int32) []byte {
+	arg.ctr.parker.Reset()
+	arg.ctr.parker.EncodeInt32(v)
+	return append([]byte(nil), arg.ctr.parker.Bytes()...)
+}
+
+func lockByOtherTxn(
+	t *testing.T,
+	proc *process.Process,
+	tableID uint64,
+	row []byte) {
+	_, err := proc.GetLockService().Lock(
+		proc.Ctx,
+		tableID,
+		[][]byte{row},
+		[]byte("txn01"),
+		lock.LockOptions{})
+	require.NoError(t, err)
+}
+
+func unlockOtherTxn(t *testing.T, proc *process.Process) {
+	require.NoError(t, proc.GetLockService().Unlock(proc.Ctx, []byte("txn01"), timestamp.Timestamp{PhysicalTime: math.MaxInt64}))
+}
+
 func runLockNonBlockingOpTest(
 	t *testing.T,
 	tables []uint64,
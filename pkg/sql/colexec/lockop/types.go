@@ -15,6 +15,8 @@
 package lockop
 
 import (
+	"time"
+
 	"github.com/matrixorigin/matrixone/pkg/common/log"
 	"github.com/matrixorigin/matrixone/pkg/common/reuse"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -50,6 +52,8 @@ type FetchLockRowsFunc func(
 type LockOptions struct {
 	maxCountPerLock          int
 	mode                     lock.LockMode
+	waitPolicy               lock.WaitPolicy
+	waitTimeout              time.Duration
 	sharding                 lock.Sharding
 	group                    uint32
 	lockTable                bool
@@ -114,6 +118,12 @@ type lockTarget struct {
 	mode                         lock.LockMode
 	lockRows                     *plan.Expr
 	lockTableAtTheEnd            bool
+	// waitPolicy, waitSec, skipLocked and skipLockedLimit come from the
+	// NOWAIT, WAIT n and SKIP LOCKED of select for update/share
+	waitPolicy      lock.WaitPolicy
+	waitSec         uint64
+	skipLocked      bool
+	skipLockedLimit uint64
 }
 
 // RowsFilter used to filter row from primary vector. The row will not lock if filter return false.
@@ -135,4 +145,6 @@ type state struct {
 	fetchers             []FetchLockRowsFunc
 	hasNewVersionInRange hasNewVersionInRangeFunc
 	lockCount            int64
+	// skipLockedCount is the count of rows locked in skip locked mode
+	skipLockedCount uint64
 }
@@ -174,6 +174,7 @@ func prepareTestDeleteMultiUpdateCtx(hasUniqueKey bool, hasSecondaryKey bool, is
 		DeleteCols:      []int{0, 1}, //row_id & pk
 		OldPartitionIdx: -1,
 		NewPartitionIdx: -1,
+		InsertFilterIdx: -1,
 	}
 	updateCtxs := []*MultiUpdateCtx{updateCtx}
 	colCount := 2
@@ -199,6 +200,7 @@ func prepareTestDeleteMultiUpdateCtx(hasUniqueKey bool, hasSecondaryKey bool, is
 			DeleteCols:      []int{2, 3}, //row_id & pk
 			OldPartitionIdx: -1,
 			NewPartitionIdx: -1,
+			InsertFilterIdx: -1,
 		})
 		colCount += 2
 	}
@@ -229,6 +231,7 @@ func prepareTestDeleteMultiUpdateCtx(hasUniqueKey bool, hasSecondaryKey bool, is
 			DeleteCols:      secondaryPkPos,
 			OldPartitionIdx: -1,
 			NewPartitionIdx: -1,
+			InsertFilterIdx: -1,
 		})
 	}
 
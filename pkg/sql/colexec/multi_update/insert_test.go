@@ -230,6 +230,7 @@ func prepareTestInsertMultiUpdateCtx(hasUniqueKey bool, hasSecondaryKey bool, is
 		InsertCols:      []int{0, 1, 2, 3},
 		OldPartitionIdx: -1,
 		NewPartitionIdx: -1,
+		InsertFilterIdx: -1,
 	}
 	colCount := 4
 	updateCtxs := []*MultiUpdateCtx{updateCtx}
@@ -255,6 +256,7 @@ func prepareTestInsertMultiUpdateCtx(hasUniqueKey bool, hasSecondaryKey bool, is
 			InsertCols:      []int{4, 0},
 			OldPartitionIdx: -1,
 			NewPartitionIdx: -1,
+			InsertFilterIdx: -1,
 		})
 		colCount += 1
 	}
@@ -283,6 +285,7 @@ func prepareTestInsertMultiUpdateCtx(hasUniqueKey bool, hasSecondaryKey bool, is
 			InsertCols:      []int{secondaryPkPos, 0},
 			OldPartitionIdx: -1,
 			NewPartitionIdx: -1,
+			InsertFilterIdx: -1,
 		})
 		colCount += 1
 	}
@@ -120,7 +120,9 @@ func (update *MultiUpdate) Prepare(proc *process.Process) error {
 	}
 
 	mainCtx := update.MultiUpdateCtx[0]
-	if len(mainCtx.DeleteCols) > 0 && len(mainCtx.InsertCols) > 0 {
+	if mainCtx.InsertFilterIdx >= 0 {
+		update.ctr.action = actionMerge
+	} else if len(mainCtx.DeleteCols) > 0 && len(mainCtx.InsertCols) > 0 {
 		update.ctr.action = actionUpdate
 	} else if len(mainCtx.InsertCols) > 0 {
 		update.ctr.action = actionInsert
@@ -329,6 +331,13 @@ func (update *MultiUpdate) updateFlushS3Info(proc *process.Process, analyzer pro
 }
 
 func (update *MultiUpdate) updateOneBatch(proc *process.Process, analyzer process.Analyzer, bat *batch.Batch) (err error) {
+	if update.ctr.action == actionMerge {
+		// every row of merge is inserted, updated or deleted
+		update.ctr.affectedRows += uint64(bat.RowCount())
+	}
+
+	filterIdx := -1
+	var filterBat *batch.Batch
 	for i, updateCtx := range update.MultiUpdateCtx {
 		// delete rows
 		if len(updateCtx.DeleteCols) > 0 {
@@ -340,14 +349,29 @@ func (update *MultiUpdate) updateOneBatch(proc *process.Process, analyzer proces
 
 		// insert rows
 		if len(updateCtx.InsertCols) > 0 {
+			insertBat := bat
+			if updateCtx.InsertFilterIdx >= 0 {
+				if updateCtx.InsertFilterIdx != filterIdx {
+					filterIdx = updateCtx.InsertFilterIdx
+					filterBat, err = update.filterInsertRows(proc, bat, filterIdx)
+					if err != nil {
+						return
+					}
+				}
+				insertBat = filterBat
+			}
+			if insertBat.RowCount() == 0 {
+				continue
+			}
+
 			tableType := update.ctr.updateCtxInfos[updateCtx.TableDef.Name].tableType
 			switch tableType {
 			case UpdateMainTable:
-				err = update.insert_main_table(proc, analyzer, i, bat)
+				err = update.insert_main_table(proc, analyzer, i, insertBat)
 			case UpdateUniqueIndexTable:
-				err = update.insert_uniuqe_index_table(proc, analyzer, i, bat)
+				err = update.insert_uniuqe_index_table(proc, analyzer, i, insertBat)
 			case UpdateSecondaryIndexTable:
-				err = update.insert_secondary_index_table(proc, analyzer, i, bat)
+				err = update.insert_secondary_index_table(proc, analyzer, i, insertBat)
 			}
 			if err != nil {
 				return
@@ -357,3 +381,55 @@ func (update *MultiUpdate) updateOneBatch(proc *process.Process, analyzer proces
 
 	return nil
 }
+
+// filterInsertRows returns the rows of bat whose value of the bool column at filterIdx is true
+func (update *MultiUpdate) filterInsertRows(proc *process.Process, bat *batch.Batch, filterIdx int) (*batch.Batch, error) {
+	ctr := &update.ctr
+	rowCount := bat.RowCount()
+	filterVec := bat.Vecs[filterIdx]
+
+	if filterVec.IsConst() {
+		if !filterVec.IsConstNull() && vector.GetFixedAtNoTypeCheck[bool](filterVec, 0) {
+			return bat, nil
+		}
+		rowCount = 0
+	}
+
+	if cap(ctr.filterFlags) < rowCount {
+		ctr.filterFlags = make([]uint8, rowCount)
+	}
+	flags := ctr.filterFlags[:rowCount]
+	insertCount := 0
+	if rowCount > 0 {
+		vals := vector.MustFixedColNoTypeCheck[bool](filterVec)
+		nsp := filterVec.GetNulls()
+		for i := range flags {
+			flags[i] = 0
+			if vals[i] && !nsp.Contains(uint64(i)) {
+				flags[i] = 1
+				insertCount++
+			}
+		}
+		if insertCount == rowCount {
+			return bat, nil
+		}
+	}
+
+	if ctr.filterBuf == nil {
+		ctr.filterBuf = batch.NewWithSize(len(bat.Vecs))
+		for i, vec := range bat.Vecs {
+			ctr.filterBuf.Vecs[i] = vector.NewVec(*vec.GetType())
+		}
+	} else {
+		ctr.filterBuf.CleanOnlyData()
+	}
+	if insertCount > 0 {
+		for i, vec := range bat.Vecs {
+			if err := ctr.filterBuf.Vecs[i].UnionBatch(vec, 0, rowCount, flags, proc.Mp()); err != nil {
+				return nil, err
+			}
+		}
+	}
+	ctr.filterBuf.SetRowCount(insertCount)
+	return ctr.filterBuf, nil
+}
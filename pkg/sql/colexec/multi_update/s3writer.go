@@ -21,6 +21,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -82,6 +83,8 @@ type s3Writer struct {
 	pkIdxs         []int
 	schemaVersions []uint32
 	isClusterBys   []bool
+	compressAlgs   []compress.T
+	compressLevels []int
 
 	deleteBlockMap      [][]map[types.Blockid]*deleteBlockData
 	deleteBlockInfo     [][]*deleteBlockInfo
@@ -111,6 +114,8 @@ func newS3Writer(update *MultiUpdate) (*s3Writer, error) {
 		pkIdxs:         make([]int, 0, tableCount),
 		schemaVersions: make([]uint32, 0, tableCount),
 		isClusterBys:   make([]bool, 0, tableCount),
+		compressAlgs:   make([]compress.T, 0, tableCount),
+		compressLevels: make([]int, 0, tableCount),
 
 		deleteBuf:           make([]*batch.Batch, tableCount),
 		insertBuf:           make([]*batch.Batch, tableCount),
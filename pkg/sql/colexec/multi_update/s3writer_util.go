@@ -21,6 +21,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -68,6 +69,10 @@ func generateBlockWriter(writer *s3Writer,
 		blockWriter.SetSortKey(uint16(sortIdx))
 	}
 
+	if !isDelete && writer.compressAlgs[idx] != compress.None {
+		blockWriter.SetCompress(writer.compressAlgs[idx], writer.compressLevels[idx])
+	}
+
 	if isDelete {
 		blockWriter.SetPrimaryKeyWithType(
 			0,
@@ -109,6 +114,9 @@ func appendCfgToWriter(writer *s3Writer, tableDef *plan.TableDef) {
 	writer.pkIdxs = append(writer.pkIdxs, pkIdx)
 	writer.schemaVersions = append(writer.schemaVersions, tableDef.Version)
 	writer.isClusterBys = append(writer.isClusterBys, tableDef.ClusterBy != nil)
+	alg, level := colexec.GetTableCompression(tableDef)
+	writer.compressAlgs = append(writer.compressAlgs, alg)
+	writer.compressLevels = append(writer.compressLevels, level)
 	if tableDef.Partition == nil {
 		writer.deleteBlockMap[thisIdx] = make([]map[types.Blockid]*deleteBlockData, 1)
 		writer.deleteBlockInfo[thisIdx] = make([]*deleteBlockInfo, 1)
@@ -49,6 +49,7 @@ const (
 	actionInsert actionType = iota
 	actionDelete
 	actionUpdate
+	actionMerge
 )
 
 func init() {
@@ -94,6 +95,10 @@ type container struct {
 
 	insertBuf []*batch.Batch
 	deleteBuf []*batch.Batch
+
+	// the rows to insert of merge and their flags
+	filterBuf   *batch.Batch
+	filterFlags []uint8
 }
 
 type MultiUpdateCtx struct {
@@ -103,6 +108,8 @@ type MultiUpdateCtx struct {
 	InsertCols []int
 	DeleteCols []int
 
+	InsertFilterIdx int // The array index position of the bool column, only the rows whose value is true are inserted. -1 if all rows are inserted
+
 	PartitionTableIDs   []uint64 // Align array index with the partition number
 	PartitionTableNames []string // Align array index with the partition number
 	OldPartitionIdx     int      // The array index position of the partition expression column for delete
@@ -142,6 +149,9 @@ func (update *MultiUpdate) Reset(proc *process.Process, pipelineFailed bool, err
 			buf.CleanOnlyData()
 		}
 	}
+	if update.ctr.filterBuf != nil {
+		update.ctr.filterBuf.CleanOnlyData()
+	}
 	if update.ctr.s3Writer != nil {
 		update.ctr.s3Writer.reset(proc)
 	}
@@ -167,6 +177,12 @@ func (update *MultiUpdate) Free(proc *process.Process, pipelineFailed bool, err
 	}
 	update.ctr.deleteBuf = nil
 
+	if update.ctr.filterBuf != nil {
+		update.ctr.filterBuf.Clean(mp)
+		update.ctr.filterBuf = nil
+	}
+	update.ctr.filterFlags = nil
+
 	if update.ctr.s3Writer != nil {
 		update.ctr.s3Writer.free(proc)
 		update.ctr.s3Writer = nil
@@ -100,6 +100,62 @@ func TestUpdateS3ParitionTable(t *testing.T) {
 	runTestCases(t, proc, []*testCase{case1})
 }
 
+func TestMergeTableWithSecondaryKey(t *testing.T) {
+	_, ctrl, proc := prepareTestCtx(t, false)
+	eng := prepareTestEng(ctrl)
+
+	batchs, affectRows := prepareUpdateTestBatchs(proc.GetMPool(), 3, false, true, false)
+	multiUpdateCtxs := prepareTestUpdateMultiUpdateCtx(false, true, false)
+
+	// the rows whose flag is false are deleted only
+	filterIdx := len(batchs[0].Vecs)
+	for _, bat := range batchs {
+		bat.Vecs = append(bat.Vecs, testutil.NewBoolVector(bat.RowCount(), types.T_bool.ToType(), proc.Mp(), false, nil))
+		bat.Attrs = append(bat.Attrs, "insert_flag")
+	}
+	for _, updateCtx := range multiUpdateCtxs {
+		updateCtx.InsertFilterIdx = filterIdx
+	}
+
+	case1 := buildTestCase(multiUpdateCtxs, eng, batchs, affectRows, UpdateWriteTable)
+	runTestCases(t, proc, []*testCase{case1})
+}
+
+func TestFilterInsertRows(t *testing.T) {
+	proc := testutil.NewProcess()
+	op := NewArgument()
+
+	bat := batch.NewWithSize(2)
+	bat.Vecs[0] = testutil.NewInt64Vector(4, types.T_int64.ToType(), proc.Mp(), false, []int64{1, 2, 3, 4})
+	bat.Vecs[1] = testutil.NewBoolVector(4, types.T_bool.ToType(), proc.Mp(), false, []bool{true, false, true, true})
+	bat.Vecs[1].GetNulls().Add(3)
+	bat.SetRowCount(4)
+
+	res, err := op.filterInsertRows(proc, bat, 1)
+	require.NoError(t, err)
+	require.Equal(t, 2, res.RowCount())
+	require.Equal(t, []int64{1, 3}, vector.MustFixedColWithTypeCheck[int64](res.Vecs[0]))
+
+	// all rows are inserted
+	bat.Vecs[1].Free(proc.Mp())
+	bat.Vecs[1], err = vector.NewConstFixed(types.T_bool.ToType(), true, 4, proc.Mp())
+	require.NoError(t, err)
+	res, err = op.filterInsertRows(proc, bat, 1)
+	require.NoError(t, err)
+	require.Equal(t, bat, res)
+
+	// no row is inserted
+	bat.Vecs[1].Free(proc.Mp())
+	bat.Vecs[1] = vector.NewConstNull(types.T_bool.ToType(), 4, proc.Mp())
+	res, err = op.filterInsertRows(proc, bat, 1)
+	require.NoError(t, err)
+	require.Equal(t, 0, res.RowCount())
+
+	bat.Clean(proc.Mp())
+	op.Free(proc, false, nil)
+	require.Equal(t, int64(0), proc.Mp().CurrNB())
+}
+
 // ----- util function ----
 func buildUpdateTestCase(t *testing.T, hasUniqueKey bool, hasSecondaryKey bool, isPartition bool) (*process.Process, *testCase) {
 	_, ctrl, proc := prepareTestCtx(t, false)
@@ -197,6 +253,7 @@ func prepareTestUpdateMultiUpdateCtx(hasUniqueKey bool, hasSecondaryKey bool, is
 		DeleteCols:      []int{4, 0},       //row_id, a
 		OldPartitionIdx: -1,
 		NewPartitionIdx: -1,
+		InsertFilterIdx: -1,
 	}
 	updateCtxs := []*MultiUpdateCtx{updateCtx}
 	colCount := 5
@@ -224,6 +281,7 @@ func prepareTestUpdateMultiUpdateCtx(hasUniqueKey bool, hasSecondaryKey bool, is
 			DeleteCols:      []int{5, 6}, //del_row_id & del_pk
 			OldPartitionIdx: -1,
 			NewPartitionIdx: -1,
+			InsertFilterIdx: -1,
 		})
 		colCount += 3
 	}
@@ -258,6 +316,7 @@ func prepareTestUpdateMultiUpdateCtx(hasUniqueKey bool, hasSecondaryKey bool, is
 			DeleteCols:      deleteCols,
 			OldPartitionIdx: -1,
 			NewPartitionIdx: -1,
+			InsertFilterIdx: -1,
 		})
 	}
 
@@ -16,8 +16,11 @@ package postdml
 import (
 	"bytes"
 	"fmt"
+	"sort"
 	"strings"
 
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/hnsw"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -31,6 +34,19 @@ var (
 	fulltextInsertSqlFmt    = "INSERT INTO %s SELECT f.* FROM %s as %s CROSS APPLY fulltext_index_tokenize('%s', %s, %s) as f WHERE %s IN (%s)"
 	fulltextDeleteSqlFmt    = "DELETE FROM %s WHERE doc_id IN (%s)"
 	fulltextDeleteAllSqlFmt = "DELETE FROM %s"
+
+	hnswUpdateSqlFmt = "INSERT INTO %s SELECT f.* FROM hnsw_index_update('%s', '%s') AS f ON DUPLICATE KEY UPDATE %s = VALUES(%s)"
+
+	// rebuild the hnsw index instead of updating it key by key if too many rows are changed
+	hnswMaxUpdateKeys = 65536
+
+	mviewDeleteSqlFmt = "DELETE FROM `%s`.`%s` WHERE %s"
+	mviewInsertSqlFmt = "INSERT INTO `%s`.`%s` SELECT * FROM (%s) AS mv WHERE %s"
+
+	// the max number of the keys of the rows recomputed by one SQL of the materialized view
+	mviewMaxRefreshKeys = 8192
+
+	sqlStringEscaper = strings.NewReplacer(`\`, `\\`, `'`, `\'`)
 )
 
 func (postdml *PostDml) String(buf *bytes.Buffer) {
@@ -65,7 +81,18 @@ func (postdml *PostDml) Call(proc *process.Process) (vm.CallResult, error) {
 	if err != nil {
 		return result, err
 	}
-	if result.Batch == nil || result.Batch.IsEmpty() {
+	if result.Batch == nil {
+		if postdml.PostDmlCtx.Hnsw != nil {
+			if err = postdml.flushHnswIndexUpdate(proc); err != nil {
+				return vm.CancelResult, err
+			}
+		}
+		if postdml.PostDmlCtx.Mview != nil {
+			postdml.flushMaterializedViewRefresh(proc)
+		}
+		return result, nil
+	}
+	if result.Batch.IsEmpty() {
 		return result, nil
 	}
 
@@ -81,11 +108,23 @@ func (postdml *PostDml) runPostDml(proc *process.Process, result vm.CallResult)
 
 	var in_list []string
 	bat := result.Batch
-	pkvec := bat.Vecs[postdml.PostDmlCtx.PrimaryKeyIdx]
-	pkTyp := pkvec.GetType()
+
+	if postdml.PostDmlCtx.Trigger != nil {
+		if err := postdml.runTriggerActions(proc, result); err != nil {
+			return err
+		}
+	}
+
+	if postdml.PostDmlCtx.Mview != nil {
+		if err := postdml.collectMaterializedViewKeys(proc, result); err != nil {
+			return err
+		}
+	}
 
 	var values string
-	if !postdml.PostDmlCtx.IsDeleteWithoutFilters {
+	if (postdml.PostDmlCtx.FullText != nil || postdml.PostDmlCtx.Hnsw != nil) && !postdml.PostDmlCtx.IsDeleteWithoutFilters {
+		pkvec := bat.Vecs[postdml.PostDmlCtx.PrimaryKeyIdx]
+		pkTyp := pkvec.GetType()
 		in_list = make([]string, 0, bat.RowCount())
 		for i := 0; i < bat.RowCount(); i++ {
 			pkey, err := GetAnyAsString(pkvec, i)
@@ -146,6 +185,197 @@ func (postdml *PostDml) runPostDml(proc *process.Process, result vm.CallResult)
 		}
 	}
 
+	// the hnsw index is updated once for all the batches
+	if postdml.PostDmlCtx.Hnsw != nil {
+		ctr := &postdml.ctr
+		if postdml.PostDmlCtx.IsDeleteWithoutFilters || len(ctr.hnswKeys)+len(in_list) > hnswMaxUpdateKeys {
+			ctr.hnswKeysFull = true
+			ctr.hnswKeys = nil
+		} else if !ctr.hnswKeysFull {
+			ctr.hnswKeys = append(ctr.hnswKeys, in_list...)
+		}
+	}
+
+	return nil
+}
+
+// runTriggerActions runs the actions of the triggers row by row. A SIGNAL fails the statement,
+// the other actions append their SQL with the NEW/OLD values to proc.Base.PostDmlSqlList.
+func (postdml *PostDml) runTriggerActions(proc *process.Process, result vm.CallResult) error {
+	bat := result.Batch
+	var sql strings.Builder
+	for i := 0; i < bat.RowCount(); i++ {
+		for _, action := range postdml.PostDmlCtx.Trigger.Actions {
+			if action.Guard >= 0 && !isTrueAt(bat.Vecs[action.Guard], i) {
+				continue
+			}
+
+			if action.SqlState != "" {
+				msg := action.SqlParts[0]
+				if action.Message >= 0 {
+					value, err := getSqlValueAt(bat.Vecs[action.Message], i, false)
+					if err != nil {
+						return err
+					}
+					msg = value
+				}
+				return moerr.NewSignal(proc.Ctx, action.SqlState, uint16(action.Errno), msg)
+			}
+
+			sql.Reset()
+			sql.WriteString(action.SqlParts[0])
+			for j, arg := range action.Args {
+				value, err := getSqlValueAt(bat.Vecs[arg], i, true)
+				if err != nil {
+					return err
+				}
+				sql.WriteString(value)
+				sql.WriteString(action.SqlParts[j+1])
+			}
+			proc.Base.PostDmlSqlList.Append(sql.String())
+		}
+	}
+	return nil
+}
+
+// collectMaterializedViewKeys collects the keys of the old and the new rows for each materialized view.
+// The rows of the view with the keys are recomputed once for all the batches, or once for every
+// mviewMaxRefreshKeys keys if too many rows are changed.
+func (postdml *PostDml) collectMaterializedViewKeys(proc *process.Process, result vm.CallResult) error {
+	bat := result.Batch
+	ctr := &postdml.ctr
+	views := postdml.PostDmlCtx.Mview.Views
+	if ctr.mviewKeys == nil {
+		ctr.mviewKeys = make([]map[string]struct{}, len(views))
+	}
+
+	var cond strings.Builder
+	for v, view := range views {
+		if ctr.mviewKeys[v] == nil {
+			ctr.mviewKeys[v] = make(map[string]struct{})
+		}
+		for _, positions := range [][]int32{view.OldKeyPos, view.NewKeyPos} {
+			if len(positions) == 0 {
+				continue
+			}
+			for i := 0; i < bat.RowCount(); i++ {
+				cond.Reset()
+				cond.WriteByte('(')
+				for j, pos := range positions {
+					if j > 0 {
+						cond.WriteString(" AND ")
+					}
+					cond.WriteString("`" + view.View.ViewKeyCols[j] + "`")
+					value, err := getSqlValueAt(bat.Vecs[pos], i, true)
+					if err != nil {
+						return err
+					}
+					if value == "NULL" {
+						cond.WriteString(" IS NULL")
+					} else {
+						cond.WriteString(" = ")
+						cond.WriteString(value)
+					}
+				}
+				cond.WriteByte(')')
+				ctr.mviewKeys[v][cond.String()] = struct{}{}
+				if len(ctr.mviewKeys[v]) >= mviewMaxRefreshKeys {
+					postdml.appendMaterializedViewRefresh(proc, v)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// flushMaterializedViewRefresh appends the SQL to recompute the rows of the materialized views with
+// the keys left.
+func (postdml *PostDml) flushMaterializedViewRefresh(proc *process.Process) {
+	ctr := &postdml.ctr
+	for v := range ctr.mviewKeys {
+		postdml.appendMaterializedViewRefresh(proc, v)
+	}
+	ctr.mviewKeys = nil
+}
+
+// appendMaterializedViewRefresh appends the SQL to recompute the rows of the v-th view with the
+// collected keys, and clears the keys.
+func (postdml *PostDml) appendMaterializedViewRefresh(proc *process.Process, v int) {
+	keys := postdml.ctr.mviewKeys[v]
+	if len(keys) == 0 {
+		return
+	}
+	conds := make([]string, 0, len(keys))
+	for cond := range keys {
+		conds = append(conds, cond)
+	}
+	sort.Strings(conds)
+	where := strings.Join(conds, " OR ")
+	def := postdml.PostDmlCtx.Mview.Views[v].View
+	proc.Base.PostDmlSqlList.Append(fmt.Sprintf(mviewDeleteSqlFmt, def.Database, def.Name, where))
+	proc.Base.PostDmlSqlList.Append(fmt.Sprintf(mviewInsertSqlFmt, def.Database, def.Name, def.Query, where))
+	clear(keys)
+}
+
+func isTrueAt(vec *vector.Vector, i int) bool {
+	if vec.IsConst() {
+		i = 0
+	}
+	return !vec.IsNull(uint64(i)) && vector.GetFixedAtNoTypeCheck[bool](vec, i)
+}
+
+// getSqlValueAt returns the value of the row as a SQL literal if quoted, or as it is.
+func getSqlValueAt(vec *vector.Vector, i int, quoted bool) (string, error) {
+	if vec.IsConst() {
+		i = 0
+	}
+	if vec.IsNull(uint64(i)) {
+		if quoted {
+			return "NULL", nil
+		}
+		return "", nil
+	}
+	value, err := GetAnyAsString(vec, i)
+	if err != nil || !quoted {
+		return value, err
+	}
+	switch vec.GetType().Oid {
+	case types.T_bool, types.T_bit, types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64, types.T_float32, types.T_float64,
+		types.T_decimal64, types.T_decimal128:
+		return value, nil
+	}
+	return "'" + sqlStringEscaper.Replace(value) + "'", nil
+}
+
+// flushHnswIndexUpdate appends the SQL to update the hnsw index with the changed primary keys.
+// hnsw_index_update() reads the current vectors of the keys from the source table, so both the
+// inserted and the deleted rows are handled by the same SQL. Empty keys means rebuilding the index.
+func (postdml *PostDml) flushHnswIndexUpdate(proc *process.Process) error {
+	ctr := &postdml.ctr
+	if !ctr.hnswKeysFull && len(ctr.hnswKeys) == 0 {
+		return nil
+	}
+
+	hctx := postdml.PostDmlCtx.Hnsw
+	dbname := postdml.PostDmlCtx.Ref.GetSchemaName()
+	cfg, err := hnsw.NewConfig(dbname, hctx.SourceTableName, hctx.IndexTableName,
+		postdml.PostDmlCtx.PrimaryKeyName, hctx.Parts[0], hctx.AlgoParams)
+	if err != nil {
+		return err
+	}
+
+	var keys string
+	if !ctr.hnswKeysFull {
+		keys = strings.Join(ctr.hnswKeys, ",")
+	}
+	indextbl := fmt.Sprintf("`%s`.`%s`", dbname, hctx.IndexTableName)
+	sql := fmt.Sprintf(hnswUpdateSqlFmt, indextbl, sqlStringEscaper.Replace(cfg.String()), keys,
+		catalog.SystemSI_HNSW_TblCol_Metadata_val, catalog.SystemSI_HNSW_TblCol_Metadata_val)
+	proc.Base.PostDmlSqlList.Append(sql)
+
+	ctr.hnswKeys = nil
+	ctr.hnswKeysFull = false
 	return nil
 }
 
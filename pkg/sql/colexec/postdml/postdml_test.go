@@ -127,6 +127,72 @@ func TestFullText(t *testing.T) {
 	require.Equal(t, int64(0), proc.GetMPool().CurrNB())
 }
 
+func TestHnsw(t *testing.T) {
+	proc := testutil.NewProc()
+
+	arg := PostDml{
+		PostDmlCtx: &PostDmlCtx{
+			Ref: &plan.ObjectRef{
+				Obj:        0,
+				SchemaName: "testDb",
+				ObjName:    "meta",
+			},
+			PrimaryKeyIdx:  1,
+			PrimaryKeyName: "pk",
+			IsInsert:       true,
+			Hnsw: &PostDmlHnswCtx{
+				SourceTableName: "src",
+				IndexTableName:  "meta",
+				Parts:           []string{"v"},
+				AlgoParams:      `{"m":"8","op_type":"vector_cosine_ops"}`,
+			},
+		},
+		ctr: container{},
+	}
+
+	resetChildren(&arg)
+	err := arg.Prepare(proc)
+	require.NoError(t, err)
+	// the keys of all the batches are flushed at the end
+	for {
+		result, err := arg.Call(proc)
+		require.NoError(t, err)
+		if result.Batch == nil {
+			break
+		}
+	}
+
+	sql := `INSERT INTO ` + "`testDb`.`meta`" + ` SELECT f.* FROM hnsw_index_update('{"db":"testDb","src":"src","meta":"meta","pk":"pk","vec":"v",` +
+		`"param":{"m":"8","ef_construction":"64","ef_search":"40","op_type":"vector_cosine_ops"}}', '1,1000') AS f ` +
+		`ON DUPLICATE KEY UPDATE __mo_index_val = VALUES(__mo_index_val)`
+	require.Equal(t, 1, proc.Base.PostDmlSqlList.Length())
+	rs, ok := proc.Base.PostDmlSqlList.Get(0)
+	require.True(t, ok)
+	require.Equal(t, sql, rs)
+
+	// delete without filters rebuilds the index
+	arg.Reset(proc, false, nil)
+	proc.Base.PostDmlSqlList.Clear()
+	arg.PostDmlCtx.IsDelete = true
+	arg.PostDmlCtx.IsInsert = false
+	arg.PostDmlCtx.IsDeleteWithoutFilters = true
+	resetChildren(&arg)
+	require.NoError(t, arg.Prepare(proc))
+	for {
+		result, err := arg.Call(proc)
+		require.NoError(t, err)
+		if result.Batch == nil {
+			break
+		}
+	}
+	rs, ok = proc.Base.PostDmlSqlList.Get(0)
+	require.True(t, ok)
+	require.Contains(t, rs, `"}}', '') AS f`)
+
+	arg.Free(proc, false, nil)
+	proc.Free()
+}
+
 func resetChildren(arg *PostDml) {
 	op := colexec.NewMockOperator()
 	bat := colexec.MakeMockBatchsWithRowID()
@@ -370,3 +436,162 @@ func TestGetAny(t *testing.T) {
 		require.Equal(t, int64(0), mp.CurrNB())
 	}
 }
+
+func TestTrigger(t *testing.T) {
+	proc := testutil.NewProc()
+	mp := proc.Mp()
+
+	makeBatch := func() *batch.Batch {
+		bat := batch.NewWithSize(4)
+		bat.Vecs[0] = vector.NewVec(types.T_int64.ToType())
+		require.NoError(t, vector.AppendFixedList(bat.Vecs[0], []int64{1, 2}, nil, mp))
+		bat.Vecs[1] = vector.NewVec(types.T_varchar.ToType())
+		require.NoError(t, vector.AppendStringList(bat.Vecs[1], []string{"a", "it's"}, nil, mp))
+		bat.Vecs[2] = vector.NewVec(types.T_bool.ToType())
+		require.NoError(t, vector.AppendFixedList(bat.Vecs[2], []bool{false, true}, nil, mp))
+		bat.Vecs[3] = vector.NewVec(types.T_varchar.ToType())
+		require.NoError(t, vector.AppendStringList(bat.Vecs[3], []string{"", "b is 'it''s'"}, nil, mp))
+		bat.SetRowCount(2)
+		return bat
+	}
+
+	arg := PostDml{
+		PostDmlCtx: &PostDmlCtx{
+			Ref: &plan.ObjectRef{
+				SchemaName: "testDb",
+				ObjName:    "t",
+			},
+			Trigger: &PostDmlTriggerCtx{
+				Actions: []*plan.PostDmlTriggerAction{
+					{
+						Guard:    -1,
+						SqlParts: []string{"insert into testDb.t2 values (", ", ", ")"},
+						Args:     []int32{0, 1},
+						Message:  -1,
+					},
+				},
+			},
+		},
+	}
+
+	op := colexec.NewMockOperator()
+	op.WithBatchs([]*batch.Batch{makeBatch()})
+	arg.AppendChild(op)
+	require.NoError(t, arg.Prepare(proc))
+	for {
+		result, err := arg.Call(proc)
+		require.NoError(t, err)
+		if result.Batch == nil {
+			break
+		}
+	}
+	require.Equal(t, 2, proc.Base.PostDmlSqlList.Length())
+	rs, _ := proc.Base.PostDmlSqlList.Get(0)
+	require.Equal(t, "insert into testDb.t2 values (1, 'a')", rs)
+	rs, _ = proc.Base.PostDmlSqlList.Get(1)
+	require.Equal(t, `insert into testDb.t2 values (2, 'it\'s')`, rs)
+
+	// the SIGNAL guarded by the third column fails the statement at the second row
+	arg.Reset(proc, false, nil)
+	proc.Base.PostDmlSqlList.Clear()
+	arg.PostDmlCtx.Trigger.Actions = []*plan.PostDmlTriggerAction{
+		{
+			Guard:    2,
+			SqlParts: []string{"Unhandled user-defined exception condition"},
+			SqlState: "45000",
+			Errno:    1644,
+			Message:  3,
+		},
+	}
+	arg.Children = nil
+	op = colexec.NewMockOperator()
+	op.WithBatchs([]*batch.Batch{makeBatch()})
+	arg.AppendChild(op)
+	require.NoError(t, arg.Prepare(proc))
+	_, err := arg.Call(proc)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "b is 'it''s'")
+	require.Equal(t, 0, proc.Base.PostDmlSqlList.Length())
+
+	arg.Free(proc, false, nil)
+	proc.Free()
+}
+
+func TestMaterializedView(t *testing.T) {
+	proc := testutil.NewProc()
+	mp := proc.Mp()
+
+	makeBatch := func() *batch.Batch {
+		bat := batch.NewWithSize(2)
+		bat.Vecs[0] = vector.NewVec(types.T_int64.ToType())
+		require.NoError(t, vector.AppendFixedList(bat.Vecs[0], []int64{1, 2, 3}, nil, mp))
+		bat.Vecs[1] = vector.NewVec(types.T_varchar.ToType())
+		require.NoError(t, vector.AppendStringList(bat.Vecs[1], []string{"b", "a", ""}, []bool{false, false, true}, mp))
+		bat.SetRowCount(3)
+		return bat
+	}
+
+	view := &plan.MaterializedViewDef{
+		Database:    "testDb",
+		Name:        "mv",
+		Query:       "select b, count(*) as c from testDb.t group by b",
+		KeyCols:     []string{"b"},
+		ViewKeyCols: []string{"b"},
+	}
+	arg := PostDml{
+		PostDmlCtx: &PostDmlCtx{
+			Ref: &plan.ObjectRef{
+				SchemaName: "testDb",
+				ObjName:    "t",
+			},
+			Mview: &PostDmlMaterializedViewCtx{
+				Views: []*plan.PostDmlMaterializedView{
+					{View: view, NewKeyPos: []int32{1}},
+				},
+			},
+		},
+	}
+
+	run := func() {
+		arg.Children = nil
+		op := colexec.NewMockOperator()
+		op.WithBatchs([]*batch.Batch{makeBatch(), makeBatch()})
+		arg.AppendChild(op)
+		require.NoError(t, arg.Prepare(proc))
+		for {
+			result, err := arg.Call(proc)
+			require.NoError(t, err)
+			if result.Batch == nil {
+				break
+			}
+		}
+	}
+
+	// the rows of the view with the changed keys are recomputed once for all the batches
+	run()
+	require.Equal(t, 2, proc.Base.PostDmlSqlList.Length())
+	rs, _ := proc.Base.PostDmlSqlList.Get(0)
+	require.Equal(t, "DELETE FROM `testDb`.`mv` WHERE (`b` = 'a') OR (`b` = 'b') OR (`b` IS NULL)", rs)
+	rs, _ = proc.Base.PostDmlSqlList.Get(1)
+	require.Equal(t, "INSERT INTO `testDb`.`mv` SELECT * FROM (select b, count(*) as c from testDb.t group by b) AS mv WHERE (`b` = 'a') OR (`b` = 'b') OR (`b` IS NULL)", rs)
+
+	// too many keys are recomputed in several SQL instead of the whole view
+	maxKeys := mviewMaxRefreshKeys
+	mviewMaxRefreshKeys = 2
+	defer func() {
+		mviewMaxRefreshKeys = maxKeys
+	}()
+	arg.Reset(proc, false, nil)
+	proc.Base.PostDmlSqlList.Clear()
+	run()
+	require.Equal(t, 6, proc.Base.PostDmlSqlList.Length())
+	rs, _ = proc.Base.PostDmlSqlList.Get(0)
+	require.Equal(t, "DELETE FROM `testDb`.`mv` WHERE (`b` = 'a') OR (`b` = 'b')", rs)
+	for i := 0; i < proc.Base.PostDmlSqlList.Length(); i++ {
+		rs, _ = proc.Base.PostDmlSqlList.Get(i)
+		require.Contains(t, rs, " WHERE ")
+	}
+
+	arg.Free(proc, false, nil)
+	proc.Free()
+}
@@ -29,6 +29,13 @@ var _ vm.Operator = new(PostDml)
 
 type container struct {
 	affectedRows uint64
+
+	// primary keys of all the batches for the hnsw index
+	hnswKeys     []string
+	hnswKeysFull bool
+
+	// conditions of the keys of the rows to refresh for each materialized view
+	mviewKeys []map[string]struct{}
 }
 
 type PostDml struct {
@@ -76,6 +83,25 @@ type PostDmlFullTextCtx struct {
 	AlgoParams      string
 }
 
+type PostDmlHnswCtx struct {
+	SourceTableName string
+	IndexTableName  string
+	Parts           []string
+	AlgoParams      string
+}
+
+// PostDmlTriggerCtx is the actions of the row-level triggers, the values of NEW/OLD and the
+// guards of the actions are the columns of the batch.
+type PostDmlTriggerCtx struct {
+	Actions []*plan.PostDmlTriggerAction
+}
+
+// PostDmlMaterializedViewCtx is the materialized views on the table, the keys of the rows to
+// refresh are the columns of the batch.
+type PostDmlMaterializedViewCtx struct {
+	Views []*plan.PostDmlMaterializedView
+}
+
 type PostDmlCtx struct {
 	Ref                    *plan.ObjectRef
 	AddAffectedRows        bool
@@ -87,10 +113,15 @@ type PostDmlCtx struct {
 
 	// define various context for different tasks
 	FullText *PostDmlFullTextCtx
+	Hnsw     *PostDmlHnswCtx
+	Trigger  *PostDmlTriggerCtx
+	Mview    *PostDmlMaterializedViewCtx
 }
 
 func (postdml *PostDml) Reset(proc *process.Process, pipelineFailed bool, err error) {
-
+	postdml.ctr.hnswKeys = nil
+	postdml.ctr.hnswKeysFull = false
+	postdml.ctr.mviewKeys = nil
 }
 
 func (postdml *PostDml) Free(proc *process.Process, pipelineFailed bool, err error) {
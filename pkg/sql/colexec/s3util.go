@@ -20,6 +20,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -53,6 +54,9 @@ type S3Writer struct {
 
 	isTombstone bool
 
+	compressAlg   compress.T
+	compressLevel int
+
 	writer *blockio.BlockWriter
 
 	// the third vector only has several rows, not aligns with the other two vectors.
@@ -118,6 +122,7 @@ func NewS3Writer(tableDef *plan.TableDef, partitionIdx int16) (*S3Writer, error)
 		pk:             -1,
 		partitionIndex: partitionIdx,
 	}
+	writer.compressAlg, writer.compressLevel = GetTableCompression(tableDef)
 
 	writer.ResetBlockInfoBat()
 	for i, colDef := range tableDef.Cols {
@@ -157,6 +162,29 @@ func NewS3Writer(tableDef *plan.TableDef, partitionIdx int16) (*S3Writer, error)
 	return writer, nil
 }
 
+// GetTableCompression returns the compression option of the table data,
+// compress.None means the option is not set and the default LZ4 is used.
+func GetTableCompression(tableDef *plan.TableDef) (compress.T, int) {
+	for _, def := range tableDef.GetDefs() {
+		props := def.GetProperties()
+		if props == nil {
+			continue
+		}
+		for _, prop := range props.Properties {
+			if prop.Key != catalog.PropCompression {
+				continue
+			}
+			alg, level, err := compress.ParseAlgorithm(prop.Value)
+			if err != nil {
+				logutil.Warnf("bad compression option %q for %q: %v", prop.Value, tableDef.GetName(), err)
+				return compress.None, 0
+			}
+			return alg, level
+		}
+	}
+	return compress.None, 0
+}
+
 // NewPartitionS3Writer Alloc S3 writers for partitioned table.
 func NewPartitionS3Writer(tableDef *plan.TableDef) ([]*S3Writer, error) {
 	partitionNum := len(tableDef.Partition.PartitionTableNames)
@@ -394,6 +422,10 @@ func (w *S3Writer) generateWriter(proc *process.Process) (objectio.ObjectName, e
 		w.writer.SetSortKey(uint16(w.sortIndex))
 	}
 
+	if w.compressAlg != compress.None {
+		w.writer.SetCompress(w.compressAlg, w.compressLevel)
+	}
+
 	if w.isTombstone {
 		if w.pk > -1 {
 			w.writer.SetPrimaryKeyWithType(
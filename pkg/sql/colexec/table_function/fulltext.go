@@ -186,7 +186,17 @@ func (u *fulltextState) start(tf *TableFunction, proc *process.Process, nthRow i
 	}
 	mode := vector.GetFixedAtNoTypeCheck[int64](v, 0)
 
-	return fulltextIndexMatch(u, proc, tf, source_table, index_table, pattern, mode, u.batch)
+	// parser params of the index
+	var params string
+	if len(tf.ctr.argVecs) > 4 {
+		v = tf.ctr.argVecs[4]
+		if v.GetType().Oid != types.T_varchar {
+			return moerr.NewInvalidInput(proc.Ctx, fmt.Sprintf("Fifth argument (parser params) must be string, but got %s", v.GetType().String()))
+		}
+		params = v.UnsafeGetStringAt(0)
+	}
+
+	return fulltextIndexMatch(u, proc, tf, source_table, index_table, pattern, mode, params, u.batch)
 }
 
 // prepare
@@ -417,10 +427,10 @@ func runCountStar(proc *process.Process, s *fulltext.SearchAccum) (int64, error)
 }
 
 func fulltextIndexMatch(u *fulltextState, proc *process.Process, tableFunction *TableFunction, srctbl, tblname, pattern string,
-	mode int64, bat *batch.Batch) error {
+	mode int64, params string, bat *batch.Batch) error {
 
 	// parse the search string to []Pattern and create SearchAccum
-	s, err := fulltext.NewSearchAccum(srctbl, tblname, pattern, mode, "")
+	s, err := fulltext.NewSearchAccum(srctbl, tblname, pattern, mode, params)
 	if err != nil {
 		return err
 	}
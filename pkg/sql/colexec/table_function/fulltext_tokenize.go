@@ -19,7 +19,6 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/common/datalink"
 	"github.com/matrixorigin/matrixone/pkg/common/fulltext"
-	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -43,6 +42,7 @@ type Document struct {
 type tokenizeState struct {
 	inited bool
 	param  fulltext.FullTextParserParam
+	cfg    tokenizer.Config
 	doc    Document
 	offset int
 	// holding one call batch, tokenizedState owns it.
@@ -104,12 +104,12 @@ func fulltextIndexTokenizePrepare(proc *process.Process, arg *TableFunction) (tv
 func (u *tokenizeState) start(tf *TableFunction, proc *process.Process, nthRow int, analyzer process.Analyzer) error {
 
 	if !u.inited {
-		if len(tf.Params) > 0 {
-			err := json.Unmarshal([]byte(tf.Params), &u.param)
-			if err != nil {
-				return err
-			}
+		param, err := fulltext.ParseParserParam(string(tf.Params))
+		if err != nil {
+			return err
 		}
+		u.param = param
+		u.cfg = param.TokenizerConfig()
 
 		u.batch = tf.createResultBatch()
 		u.doc = Document{Words: make([]FullTextEntry, 0, 512)}
@@ -139,44 +139,7 @@ func (u *tokenizeState) start(tf *TableFunction, proc *process.Process, nthRow i
 	}
 
 	switch u.param.Parser {
-	case "", "ngram", "default":
-
-		var c string
-		for i := 1; i < vlen; i++ {
-			if i > 1 {
-				c += "\n"
-			}
-			data := tf.ctr.argVecs[i].GetStringAt(nthRow)
-
-			// fix issue #19948 return vector type is not datalink even the input argurment type is datalink
-			// so we have to check the input argument instead of vector
-			//if tf.ctr.argVecs[i].GetType().Oid == types.T_datalink {
-			if types.T(tf.Args[i].Typ.Id) == types.T_datalink {
-				// datalink
-				dl, err := datalink.NewDatalink(data, proc)
-				if err != nil {
-					return err
-				}
-				b, err := dl.GetPlainText(proc)
-				if err != nil {
-					return err
-				}
-
-				c += string(b)
-			} else {
-				c += data
-			}
-		}
-
-		tok, _ := tokenizer.NewSimpleTokenizer([]byte(c))
-		for t := range tok.Tokenize() {
-
-			slen := t.TokenBytes[0]
-			word := string(t.TokenBytes[1 : slen+1])
-
-			u.doc.Words = append(u.doc.Words, FullTextEntry{DocId: id, Word: word, Pos: t.BytePos})
-		}
-	case "json":
+	case fulltext.PARSER_JSON:
 		joffset := int32(0)
 		for i := 1; i < vlen; i++ {
 			c := tf.ctr.argVecs[i].GetRawBytesAt(nthRow)
@@ -199,7 +162,10 @@ func (u *tokenizeState) start(tf *TableFunction, proc *process.Process, nthRow i
 				jslen := t.TokenBytes[0]
 				value := string(t.TokenBytes[1 : jslen+1])
 				// tokenize the value
-				tok, _ := tokenizer.NewSimpleTokenizer([]byte(value))
+				tok, err := tokenizer.NewTokenizer(u.param.TokenizerName(), []byte(value), u.cfg)
+				if err != nil {
+					return err
+				}
 				for tt := range tok.Tokenize() {
 					tslen := tt.TokenBytes[0]
 					word := string(tt.TokenBytes[1 : tslen+1])
@@ -210,7 +176,7 @@ func (u *tokenizeState) start(tf *TableFunction, proc *process.Process, nthRow i
 
 			joffset += int32(len(c))
 		}
-	case "json_value":
+	case fulltext.PARSER_JSON_VALUE:
 		joffset := int32(0)
 		for i := 1; i < vlen; i++ {
 			c := tf.ctr.argVecs[i].GetRawBytesAt(nthRow)
@@ -239,7 +205,45 @@ func (u *tokenizeState) start(tf *TableFunction, proc *process.Process, nthRow i
 			joffset += int32(len(c))
 		}
 	default:
-		return moerr.NewInternalError(proc.Ctx, "Invalid fulltext parser")
+		// text is tokenized by the tokenizer registered with the parser name
+		var c string
+		for i := 1; i < vlen; i++ {
+			if i > 1 {
+				c += "\n"
+			}
+			data := tf.ctr.argVecs[i].GetStringAt(nthRow)
+
+			// fix issue #19948 return vector type is not datalink even the input argurment type is datalink
+			// so we have to check the input argument instead of vector
+			//if tf.ctr.argVecs[i].GetType().Oid == types.T_datalink {
+			if types.T(tf.Args[i].Typ.Id) == types.T_datalink {
+				// datalink
+				dl, err := datalink.NewDatalink(data, proc)
+				if err != nil {
+					return err
+				}
+				b, err := dl.GetPlainText(proc)
+				if err != nil {
+					return err
+				}
+
+				c += string(b)
+			} else {
+				c += data
+			}
+		}
+
+		tok, err := tokenizer.NewTokenizer(u.param.TokenizerName(), []byte(c), u.cfg)
+		if err != nil {
+			return err
+		}
+		for t := range tok.Tokenize() {
+
+			slen := t.TokenBytes[0]
+			word := string(t.TokenBytes[1 : slen+1])
+
+			u.doc.Words = append(u.doc.Words, FullTextEntry{DocId: id, Word: word, Pos: t.BytePos})
+		}
 	}
 
 	return nil
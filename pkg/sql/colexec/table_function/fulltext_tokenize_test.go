@@ -132,6 +132,65 @@ func TestFullTextTokenizeCall(t *testing.T) {
 	ut.arg.ctr.state.free(ut.arg, ut.proc, false, nil)
 }
 
+// stopwords are removed by the parser params
+func TestFullTextTokenizeCallStopwords(t *testing.T) {
+
+	ut := newFTTTestCase(mpool.MustNewZero(), fttdefaultAttrs, "{\"parser\":\"ngram\",\"stopwords\":\"english\",\"stemmer\":\"porter\"}")
+
+	inbat := makeBatchFTT(ut.proc)
+
+	ut.arg.Args = makeConstInputExprsFTT()
+
+	// Prepare
+	err := ut.arg.Prepare(ut.proc)
+	require.Nil(t, err)
+
+	for i := range ut.arg.ctr.executorsForArgs {
+		ut.arg.ctr.argVecs[i], err = ut.arg.ctr.executorsForArgs[i].Eval(ut.proc, []*batch.Batch{inbat}, nil)
+		require.Nil(t, err)
+	}
+
+	// start
+	err = ut.arg.ctr.state.start(ut.arg, ut.proc, 0, nil)
+	require.Nil(t, err)
+
+	// first call receive data
+	result, err := ut.arg.ctr.state.call(ut.arg, ut.proc)
+	require.Nil(t, err)
+
+	require.Equal(t, result.Status, vm.ExecNext)
+
+	// only "text" is not stopword
+	require.Equal(t, 1, result.Batch.RowCount())
+	require.Equal(t, "text", result.Batch.Vecs[2].GetStringAt(0))
+
+	// free
+	ut.arg.ctr.state.free(ut.arg, ut.proc, false, nil)
+}
+
+// invalid parser
+func TestFullTextTokenizeInvalidParser(t *testing.T) {
+
+	ut := newFTTTestCase(mpool.MustNewZero(), fttdefaultAttrs, "{\"parser\":\"unknown\"}")
+
+	inbat := makeBatchFTT(ut.proc)
+
+	ut.arg.Args = makeConstInputExprsFTT()
+
+	// Prepare
+	err := ut.arg.Prepare(ut.proc)
+	require.Nil(t, err)
+
+	for i := range ut.arg.ctr.executorsForArgs {
+		ut.arg.ctr.argVecs[i], err = ut.arg.ctr.executorsForArgs[i].Eval(ut.proc, []*batch.Batch{inbat}, nil)
+		require.Nil(t, err)
+	}
+
+	// start
+	err = ut.arg.ctr.state.start(ut.arg, ut.proc, 0, nil)
+	require.NotNil(t, err)
+}
+
 // argvec [src_tbl, index_tbl, pattern, mode int64]
 func TestFullTextTokenizeCallJSON(t *testing.T) {
 
@@ -0,0 +1,463 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table_function
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/hnsw"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/txn/client"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const (
+	hnswSelectSqlFmt     = "SELECT `%s`, `%s` FROM `%s`.`%s`"
+	hnswSelectKeysSqlFmt = "SELECT `%s`, `%s` FROM `%s`.`%s` WHERE `%s` IN (%s)"
+
+	// number of primary keys in the IN list of a SQL
+	hnswKeysPerSql = 8192
+
+	// the whole graph is saved again if there are more delta files, or the changes in them
+	// are more than a quarter of the vectors in the graph
+	hnswMaxDeltaFiles = 32
+	hnswMinDeltaOps   = 1024
+)
+
+type hnswSearchState struct {
+	simpleOneBatchState
+	limit uint64
+}
+
+// start searching the nearest primary keys of the query vector of nthRow
+func (u *hnswSearchState) start(tf *TableFunction, proc *process.Process, nthRow int, analyzer process.Analyzer) error {
+	u.startPreamble(tf, proc, nthRow)
+
+	cfg, err := getHnswConfigArg(proc, tf)
+	if err != nil {
+		return err
+	}
+
+	v := tf.ctr.argVecs[1]
+	if v.IsNull(uint64(nthRow)) {
+		return nil
+	}
+	var query []float32
+	switch v.GetType().Oid {
+	case types.T_array_float32:
+		query = vector.GetArrayAt[float32](v, nthRow)
+	case types.T_array_float64:
+		query = float64sToFloat32s(vector.GetArrayAt[float64](v, nthRow))
+	case types.T_char, types.T_varchar, types.T_text:
+		if query, err = types.StringToArray[float32](v.GetStringAt(nthRow)); err != nil {
+			return err
+		}
+	default:
+		return moerr.NewInvalidInput(proc.Ctx, fmt.Sprintf("Second argument (query vector) must be vector, but got %s", v.GetType().String()))
+	}
+
+	limit := u.limit
+	if limit == 0 {
+		limit = uint64(cfg.Param.EfSearch)
+	}
+
+	idx, err := loadHnswIndex(proc, cfg)
+	if err != nil || idx == nil {
+		return err
+	}
+	results, err := idx.Search(query, int(limit), int(cfg.Param.EfSearch))
+	if err != nil {
+		return err
+	}
+	for _, r := range results {
+		if err = vector.AppendFixed(u.batch.Vecs[0], r.Key, false, proc.Mp()); err != nil {
+			return err
+		}
+		if err = vector.AppendFixed(u.batch.Vecs[1], r.Distance, false, proc.Mp()); err != nil {
+			return err
+		}
+	}
+	u.batch.SetRowCount(len(results))
+	return nil
+}
+
+func hnswSearchPrepare(proc *process.Process, tableFunction *TableFunction) (tvfState, error) {
+	var err error
+	st := &hnswSearchState{}
+	tableFunction.ctr.executorsForArgs, err = colexec.NewExpressionExecutorsFromPlanExpressions(proc, tableFunction.Args)
+	tableFunction.ctr.argVecs = make([]*vector.Vector, len(tableFunction.Args))
+
+	if tableFunction.Limit != nil {
+		if cExpr, ok := tableFunction.Limit.Expr.(*plan.Expr_Lit); ok {
+			if c, ok := cExpr.Lit.Value.(*plan.Literal_U64Val); ok {
+				st.limit = c.U64Val
+			}
+		}
+	}
+	return st, err
+}
+
+type hnswIndexUpdateState struct {
+	simpleOneBatchState
+}
+
+// hnswMetadata is the state of the graph saved in the metadata table
+type hnswMetadata struct {
+	version  int64
+	file     string
+	count    int64
+	deltas   []string
+	deltaOps int64
+	garbage  []hnsw.Garbage
+}
+
+// needCheckpoint returns true if the changes should be saved with the whole graph instead of a delta,
+// to keep the cost of replaying the deltas low.
+func (m *hnswMetadata) needCheckpoint(ops int) bool {
+	return len(m.deltas) >= hnswMaxDeltaFiles || m.deltaOps+int64(ops) > max(m.count/4, hnswMinDeltaOps)
+}
+
+// start updating the graph with the primary keys of nthRow, and return the new rows of the metadata table.
+// The changes of the keys are saved into a delta file, the whole graph is written only if it is rebuilt,
+// or the deltas are too many. The replaced files are kept for FileRetention for the transactions reading
+// them, and removed by a later update.
+func (u *hnswIndexUpdateState) start(tf *TableFunction, proc *process.Process, nthRow int, analyzer process.Analyzer) error {
+	u.startPreamble(tf, proc, nthRow)
+
+	cfg, err := getHnswConfigArg(proc, tf)
+	if err != nil {
+		return err
+	}
+
+	v := tf.ctr.argVecs[1]
+	if v.GetType().Oid != types.T_varchar && v.GetType().Oid != types.T_char {
+		return moerr.NewInvalidInput(proc.Ctx, fmt.Sprintf("Second argument (primary keys) must be string, but got %s", v.GetType().String()))
+	}
+	var keys []string
+	if s := strings.TrimSpace(v.GetStringAt(nthRow)); len(s) > 0 {
+		keys = strings.Split(s, ",")
+		for i := range keys {
+			keys[i] = strings.TrimSpace(keys[i])
+			if _, err = strconv.ParseInt(keys[i], 10, 64); err != nil {
+				return moerr.NewInvalidInput(proc.Ctx, fmt.Sprintf("invalid primary key %s", keys[i]))
+			}
+		}
+	}
+
+	meta, err := readHnswMetadata(proc, cfg)
+	if err != nil {
+		return err
+	}
+	fs, err := fileservice.Get[fileservice.FileService](proc.GetFileService(), defines.SharedFileServiceName)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().Unix()
+	var written, expired []string
+	garbage := make([]hnsw.Garbage, 0, len(meta.garbage))
+	for _, g := range meta.garbage {
+		if now-g.Since >= int64(hnsw.FileRetention/time.Second) {
+			expired = append(expired, g.Path)
+		} else {
+			garbage = append(garbage, g)
+		}
+	}
+
+	var delta *hnsw.Delta
+	if len(keys) > 0 && len(meta.file) > 0 {
+		delta = hnsw.NewDelta(int(cfg.Param.M))
+		for start := 0; start < len(keys); start += hnswKeysPerSql {
+			if err = collectHnswDelta(proc, cfg, delta, keys[start:min(start+hnswKeysPerSql, len(keys))]); err != nil {
+				return err
+			}
+		}
+	}
+
+	if delta != nil && !meta.needCheckpoint(delta.Len()) {
+		file := hnsw.FilePath(cfg.MetadataTable, uuid.NewString())
+		if err = hnsw.SaveDelta(proc.Ctx, fs, file, delta); err != nil {
+			return err
+		}
+		written = append(written, file)
+		meta.deltas = append(meta.deltas, file)
+		meta.deltaOps += int64(delta.Len())
+	} else {
+		var idx *hnsw.Index
+		switch {
+		case delta != nil:
+			cached, err := hnsw.Load(proc.Ctx, fs, meta.file, meta.deltas)
+			if err != nil {
+				return err
+			}
+			// the loaded index is shared by the cache
+			idx = cached.Clone()
+			if err = idx.Apply(delta); err != nil {
+				return err
+			}
+		case len(keys) == 0:
+			// rebuild the whole graph if there is no key
+			sql := fmt.Sprintf(hnswSelectSqlFmt, cfg.PkName, cfg.VecName, cfg.DbName, cfg.SrcTable)
+			if idx, err = updateHnswIndex(proc, cfg, idx, sql, nil); err != nil {
+				return err
+			}
+		default:
+			// the graph is empty
+			for start := 0; start < len(keys); start += hnswKeysPerSql {
+				n := keys[start:min(start+hnswKeysPerSql, len(keys))]
+				sql := fmt.Sprintf(hnswSelectKeysSqlFmt, cfg.PkName, cfg.VecName, cfg.DbName, cfg.SrcTable,
+					cfg.PkName, strings.Join(n, ","))
+				if idx, err = updateHnswIndex(proc, cfg, idx, sql, n); err != nil {
+					return err
+				}
+			}
+		}
+		if idx != nil && idx.NeedCompact() {
+			idx = idx.Compact()
+		}
+
+		// the whole graph replaces all the files
+		for _, file := range append([]string{meta.file}, meta.deltas...) {
+			if len(file) > 0 {
+				garbage = append(garbage, hnsw.Garbage{Path: file, Since: now})
+			}
+		}
+		meta.file, meta.count, meta.deltas, meta.deltaOps = "", 0, nil, 0
+		if idx != nil && idx.Len() > 0 {
+			meta.file = hnsw.FilePath(cfg.MetadataTable, uuid.NewString())
+			if err = hnsw.Save(proc.Ctx, fs, meta.file, idx); err != nil {
+				return err
+			}
+			written = append(written, meta.file)
+			meta.count = int64(idx.Len())
+		}
+	}
+	cleanHnswFilesOnTxnClosed(proc, fs, written, expired)
+
+	rows := [][2]string{
+		{hnsw.MetaKeyVersion, strconv.FormatInt(meta.version+1, 10)},
+		{hnsw.MetaKeyFile, meta.file},
+		{hnsw.MetaKeyCount, strconv.FormatInt(meta.count, 10)},
+		{hnsw.MetaKeyDeltas, strings.Join(meta.deltas, ",")},
+		{hnsw.MetaKeyDeltaOps, strconv.FormatInt(meta.deltaOps, 10)},
+		{hnsw.MetaKeyGarbage, hnsw.FormatGarbage(garbage)},
+	}
+	for _, row := range rows {
+		if err = vector.AppendBytes(u.batch.Vecs[0], []byte(row[0]), false, proc.Mp()); err != nil {
+			return err
+		}
+		if err = vector.AppendBytes(u.batch.Vecs[1], []byte(row[1]), false, proc.Mp()); err != nil {
+			return err
+		}
+	}
+	u.batch.SetRowCount(len(rows))
+	return nil
+}
+
+// cleanHnswFilesOnTxnClosed removes the files written by the statement if the transaction is aborted,
+// or the expired garbage files if it is committed.
+func cleanHnswFilesOnTxnClosed(proc *process.Process, fs fileservice.FileService, written, expired []string) {
+	if len(written) == 0 && len(expired) == 0 {
+		return
+	}
+	proc.GetTxnOperator().AppendEventCallback(client.ClosedEvent, func(event client.TxnEvent) {
+		files := expired
+		if !event.Committed() {
+			files = written
+		}
+		if len(files) == 0 {
+			return
+		}
+		// do not block closing the transaction
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			defer cancel()
+			if err := hnsw.DeleteFiles(ctx, fs, files...); err != nil {
+				logutil.Warn("failed to remove the hnsw index files",
+					zap.Strings("files", files),
+					zap.Error(err))
+			}
+		}()
+	})
+}
+
+func hnswIndexUpdatePrepare(proc *process.Process, tableFunction *TableFunction) (tvfState, error) {
+	var err error
+	st := &hnswIndexUpdateState{}
+	tableFunction.ctr.executorsForArgs, err = colexec.NewExpressionExecutorsFromPlanExpressions(proc, tableFunction.Args)
+	tableFunction.ctr.argVecs = make([]*vector.Vector, len(tableFunction.Args))
+	return st, err
+}
+
+func getHnswConfigArg(proc *process.Process, tf *TableFunction) (*hnsw.Config, error) {
+	v := tf.ctr.argVecs[0]
+	if v.GetType().Oid != types.T_varchar && v.GetType().Oid != types.T_char {
+		return nil, moerr.NewInvalidInput(proc.Ctx, fmt.Sprintf("First argument (config) must be string, but got %s", v.GetType().String()))
+	}
+	return hnsw.ParseConfig(v.GetStringAt(0))
+}
+
+// readHnswMetadata returns the state of the graph saved in the metadata table
+func readHnswMetadata(proc *process.Process, cfg *hnsw.Config) (*hnswMetadata, error) {
+	sql := fmt.Sprintf(hnswSelectSqlFmt, catalog.SystemSI_HNSW_TblCol_Metadata_key,
+		catalog.SystemSI_HNSW_TblCol_Metadata_val, cfg.DbName, cfg.MetadataTable)
+	res, err := ft_runSql(proc, sql)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	meta := &hnswMetadata{}
+	for _, bat := range res.Batches {
+		for i := 0; i < bat.RowCount(); i++ {
+			key, val := bat.Vecs[0].GetStringAt(i), bat.Vecs[1].GetStringAt(i)
+			switch key {
+			case hnsw.MetaKeyVersion:
+				meta.version, err = strconv.ParseInt(val, 10, 64)
+			case hnsw.MetaKeyFile:
+				meta.file = val
+			case hnsw.MetaKeyCount:
+				meta.count, err = strconv.ParseInt(val, 10, 64)
+			case hnsw.MetaKeyDeltas:
+				if len(val) > 0 {
+					meta.deltas = strings.Split(val, ",")
+				}
+			case hnsw.MetaKeyDeltaOps:
+				meta.deltaOps, err = strconv.ParseInt(val, 10, 64)
+			case hnsw.MetaKeyGarbage:
+				meta.garbage, err = hnsw.ParseGarbage(val)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return meta, nil
+}
+
+// loadHnswIndex returns the graph of the latest version, or nil if the graph is empty
+func loadHnswIndex(proc *process.Process, cfg *hnsw.Config) (*hnsw.Index, error) {
+	meta, err := readHnswMetadata(proc, cfg)
+	if err != nil || len(meta.file) == 0 {
+		return nil, err
+	}
+	fs, err := fileservice.Get[fileservice.FileService](proc.GetFileService(), defines.SharedFileServiceName)
+	if err != nil {
+		return nil, err
+	}
+	return hnsw.Load(proc.Ctx, fs, meta.file, meta.deltas)
+}
+
+// collectHnswDelta adds the current vectors of the keys into the delta, and removes the keys
+// which are not found, i.e. the rows are deleted or the vectors are null.
+func collectHnswDelta(proc *process.Process, cfg *hnsw.Config, delta *hnsw.Delta, keys []string) error {
+	sql := fmt.Sprintf(hnswSelectKeysSqlFmt, cfg.PkName, cfg.VecName, cfg.DbName, cfg.SrcTable,
+		cfg.PkName, strings.Join(keys, ","))
+	found := make(map[int64]struct{}, len(keys))
+	err := scanHnswRows(proc, sql, func(pk int64, vec []float32) error {
+		found[pk] = struct{}{}
+		delta.Insert(pk, vec)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		pk, _ := strconv.ParseInt(key, 10, 64)
+		if _, ok := found[pk]; !ok {
+			found[pk] = struct{}{}
+			delta.Remove(pk)
+		}
+	}
+	return nil
+}
+
+// updateHnswIndex inserts the (pk, vector) rows returned by the sql into the graph, and removes
+// the keys which are not returned, i.e. the rows are deleted or the vectors are null.
+func updateHnswIndex(proc *process.Process, cfg *hnsw.Config, idx *hnsw.Index, sql string, keys []string) (*hnsw.Index, error) {
+	if idx != nil {
+		for _, key := range keys {
+			pk, _ := strconv.ParseInt(key, 10, 64)
+			idx.Remove(pk)
+		}
+	}
+	err := scanHnswRows(proc, sql, func(pk int64, vec []float32) (err error) {
+		if idx == nil {
+			if idx, err = hnsw.NewIndexFromConfig(cfg, len(vec)); err != nil {
+				return err
+			}
+		}
+		return idx.Insert(pk, vec)
+	})
+	return idx, err
+}
+
+// scanHnswRows calls fn with the (pk, vector) rows returned by the sql, the rows with null are skipped
+func scanHnswRows(proc *process.Process, sql string, fn func(pk int64, vec []float32) error) error {
+	res, err := ft_runSql(proc, sql)
+	if err != nil {
+		return err
+	}
+	defer res.Close()
+
+	for _, bat := range res.Batches {
+		pkVec, vecVec := bat.Vecs[0], bat.Vecs[1]
+		if pkVec.GetType().Oid != types.T_int64 {
+			return moerr.NewNotSupported(proc.Ctx, "HNSW index requires a single BIGINT primary key")
+		}
+		for i := 0; i < bat.RowCount(); i++ {
+			if pkVec.IsNull(uint64(i)) || vecVec.IsNull(uint64(i)) {
+				continue
+			}
+			pk := vector.GetFixedAtNoTypeCheck[int64](pkVec, i)
+			var vec []float32
+			switch vecVec.GetType().Oid {
+			case types.T_array_float32:
+				vec = vector.GetArrayAt[float32](vecVec, i)
+			case types.T_array_float64:
+				vec = float64sToFloat32s(vector.GetArrayAt[float64](vecVec, i))
+			default:
+				return moerr.NewNotSupported(proc.Ctx, "HNSW only supports VECFXX column types")
+			}
+			if err = fn(pk, vec); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func float64sToFloat32s(in []float64) []float32 {
+	out := make([]float32, len(in))
+	for i, v := range in {
+		out[i] = float32(v)
+	}
+	return out
+}
@@ -0,0 +1,35 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table_function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHnswMetadataNeedCheckpoint(t *testing.T) {
+	meta := &hnswMetadata{count: 100000}
+	require.False(t, meta.needCheckpoint(100))
+	require.True(t, meta.needCheckpoint(30000))
+
+	// small graph checkpoints after hnswMinDeltaOps changes
+	meta = &hnswMetadata{count: 10, deltaOps: hnswMinDeltaOps - 1}
+	require.False(t, meta.needCheckpoint(1))
+	require.True(t, meta.needCheckpoint(2))
+
+	meta = &hnswMetadata{count: 100000, deltas: make([]string, hnswMaxDeltaFiles)}
+	require.True(t, meta.needCheckpoint(1))
+}
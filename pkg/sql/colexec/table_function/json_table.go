@@ -0,0 +1,452 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table_function
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// jsonTablePath is the row path or a nested path of json_table.  Each value matched by the path
+// is the context item of the columns and the nested paths.
+type jsonTablePath struct {
+	path    bytejson.Path
+	columns []*jsonTableColumn
+	nested  []*jsonTablePath
+	// output positions of all the columns under the path, including the nested ones
+	positions []int
+}
+
+type jsonTableColumn struct {
+	param *plan2.JsonTableColumnParam
+	path  bytejson.Path
+	// position of the output column
+	pos int
+	typ types.Type
+	// json values of DEFAULT ON EMPTY and DEFAULT ON ERROR
+	onEmpty bytejson.ByteJson
+	onError bytejson.ByteJson
+}
+
+type jsonTableValue struct {
+	val  any
+	null bool
+}
+
+type jsonTableState struct {
+	simpleOneBatchState
+	inited bool
+	root   *jsonTablePath
+	// values of the current row
+	row []jsonTableValue
+	loc *time.Location
+}
+
+func jsonTablePrepare(proc *process.Process, tableFunction *TableFunction) (tvfState, error) {
+	var err error
+	st := &jsonTableState{}
+
+	var param plan2.JsonTableParam
+	if err = json.Unmarshal(tableFunction.Params, &param); err != nil {
+		return nil, err
+	}
+
+	positions := make(map[string]int, len(tableFunction.Attrs))
+	for i, attr := range tableFunction.Attrs {
+		positions[strings.ToLower(attr)] = i
+	}
+	if st.root, err = newJsonTablePath(param.Path, param.Columns, positions, tableFunction.ctr.retSchema); err != nil {
+		return nil, err
+	}
+	st.row = make([]jsonTableValue, len(tableFunction.Attrs))
+	st.loc = proc.GetSessionInfo().TimeZone
+	if st.loc == nil {
+		st.loc = time.Local
+	}
+
+	tableFunction.ctr.executorsForArgs, err = colexec.NewExpressionExecutorsFromPlanExpressions(proc, tableFunction.Args)
+	tableFunction.ctr.argVecs = make([]*vector.Vector, len(tableFunction.Args))
+	return st, err
+}
+
+func newJsonTablePath(path string, params []*plan2.JsonTableColumnParam, positions map[string]int, retSchema []types.Type) (*jsonTablePath, error) {
+	var err error
+	p := &jsonTablePath{}
+	if p.path, err = types.ParseStringToPath(path); err != nil {
+		return nil, err
+	}
+
+	for _, param := range params {
+		if param.Kind == tree.JSON_TABLE_COLUMN_NESTED {
+			nested, err := newJsonTablePath(param.Path, param.Columns, positions, retSchema)
+			if err != nil {
+				return nil, err
+			}
+			p.nested = append(p.nested, nested)
+			p.positions = append(p.positions, nested.positions...)
+			continue
+		}
+
+		pos, ok := positions[param.Name]
+		if !ok {
+			// column is not used
+			continue
+		}
+		col := &jsonTableColumn{param: param, pos: pos, typ: retSchema[pos]}
+		if param.Kind != tree.JSON_TABLE_COLUMN_ORDINALITY {
+			if col.path, err = types.ParseStringToPath(param.Path); err != nil {
+				return nil, err
+			}
+		}
+		if param.OnEmpty.Type == tree.JSON_TABLE_ON_DEFAULT {
+			if col.onEmpty, err = types.ParseStringToByteJson(param.OnEmpty.Default); err != nil {
+				return nil, err
+			}
+		}
+		if param.OnError.Type == tree.JSON_TABLE_ON_DEFAULT {
+			if col.onError, err = types.ParseStringToByteJson(param.OnError.Default); err != nil {
+				return nil, err
+			}
+		}
+		p.columns = append(p.columns, col)
+		p.positions = append(p.positions, pos)
+	}
+	return p, nil
+}
+
+// start calling tvf on nthRow and put the result in u.batch.  Note that current json_table impl will
+// always return one batch per nthRow.
+func (u *jsonTableState) start(tf *TableFunction, proc *process.Process, nthRow int, analyzer process.Analyzer) error {
+	u.startPreamble(tf, proc, nthRow)
+
+	docVec := tf.ctr.argVecs[0]
+	if !u.inited {
+		switch docVec.GetType().Oid {
+		case types.T_json, types.T_char, types.T_varchar, types.T_text:
+		default:
+			return moerr.NewInvalidInputf(proc.Ctx, "json_table: first argument must be json or string, but got %s", docVec.GetType().String())
+		}
+		u.inited = true
+	}
+
+	if docVec.IsNull(uint64(nthRow)) {
+		return nil
+	}
+
+	var doc bytejson.ByteJson
+	var err error
+	if docVec.GetType().Oid == types.T_json {
+		doc = types.DecodeJson(docVec.GetBytesAt(nthRow))
+	} else if doc, err = types.ParseSliceToByteJson(docVec.GetBytesAt(nthRow)); err != nil {
+		return err
+	}
+
+	n := 0
+	err = u.expand(u.root, doc, func() error {
+		for i, v := range u.row {
+			if err := vector.AppendAny(u.batch.Vecs[i], v.val, v.null, proc.Mp()); err != nil {
+				return err
+			}
+		}
+		n++
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	u.batch.SetRowCount(n)
+	return nil
+}
+
+// expand outputs the rows of the path p with the context item ctx
+func (u *jsonTableState) expand(p *jsonTablePath, ctx bytejson.ByteJson, emit func() error) error {
+	for i, item := range ctx.QueryValues(&p.path) {
+		for _, col := range p.columns {
+			if err := u.eval(col, item, i+1); err != nil {
+				return err
+			}
+		}
+		if err := u.expandNested(p.nested, item, emit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// expandNested outputs the rows of the sibling nested paths one after another, the columns of the other
+// siblings are null.  If none of the nested paths matches, one row is output with all nested columns null.
+func (u *jsonTableState) expandNested(nested []*jsonTablePath, item bytejson.ByteJson, emit func() error) error {
+	if len(nested) == 0 {
+		return emit()
+	}
+
+	for _, p := range nested {
+		u.setNull(p)
+	}
+	rows := 0
+	for _, p := range nested {
+		err := u.expand(p, item, func() error {
+			rows++
+			return emit()
+		})
+		if err != nil {
+			return err
+		}
+		u.setNull(p)
+	}
+	if rows == 0 {
+		return emit()
+	}
+	return nil
+}
+
+func (u *jsonTableState) setNull(p *jsonTablePath) {
+	for _, pos := range p.positions {
+		u.row[pos] = jsonTableValue{null: true}
+	}
+}
+
+// eval evaluates the column with the context item, ordinality is the 1-based index of the context item
+func (u *jsonTableState) eval(col *jsonTableColumn, item bytejson.ByteJson, ordinality int) error {
+	var err error
+	v := &u.row[col.pos]
+	switch col.param.Kind {
+	case tree.JSON_TABLE_COLUMN_ORDINALITY:
+		*v, err = u.convertInt(int64(ordinality), col.typ)
+		return err
+	case tree.JSON_TABLE_COLUMN_EXISTS:
+		exists := int64(0)
+		if len(item.QueryValues(&col.path)) > 0 {
+			exists = 1
+		}
+		*v, err = u.convertInt(exists, col.typ)
+		return err
+	}
+
+	values := item.QueryValues(&col.path)
+	if len(values) == 0 {
+		switch col.param.OnEmpty.Type {
+		case tree.JSON_TABLE_ON_DEFAULT:
+			*v, err = u.convert(col.onEmpty, col.typ)
+			return err
+		case tree.JSON_TABLE_ON_ERROR:
+			return moerr.NewInvalidInputNoCtxf("json_table: missing value for column '%s'", col.param.Name)
+		default:
+			*v = jsonTableValue{null: true}
+			return nil
+		}
+	}
+
+	if len(values) > 1 {
+		err = moerr.NewInvalidInputNoCtxf("json_table: more than one value for column '%s'", col.param.Name)
+	} else {
+		*v, err = u.convert(values[0], col.typ)
+	}
+	if err != nil {
+		switch col.param.OnError.Type {
+		case tree.JSON_TABLE_ON_DEFAULT:
+			*v, err = u.convert(col.onError, col.typ)
+			return err
+		case tree.JSON_TABLE_ON_ERROR:
+			return err
+		default:
+			*v = jsonTableValue{null: true}
+			return nil
+		}
+	}
+	return nil
+}
+
+func (u *jsonTableState) convertInt(i int64, typ types.Type) (jsonTableValue, error) {
+	bj, err := types.ParseStringToByteJson(strconv.FormatInt(i, 10))
+	if err != nil {
+		return jsonTableValue{}, err
+	}
+	return u.convert(bj, typ)
+}
+
+// convert converts the json value to the value of the column type
+func (u *jsonTableState) convert(bj bytejson.ByteJson, typ types.Type) (jsonTableValue, error) {
+	if typ.Oid == types.T_json {
+		data, err := types.EncodeJson(bj)
+		return jsonTableValue{val: data}, err
+	}
+	if bj.IsNull() {
+		return jsonTableValue{null: true}, nil
+	}
+
+	var s string
+	if bj.Type == bytejson.TpCodeObject || bj.Type == bytejson.TpCodeArray {
+		if !typ.Oid.IsMySQLString() {
+			return jsonTableValue{}, moerr.NewInvalidInputNoCtxf("json_table: can not convert %s to %s", bj.String(), typ.String())
+		}
+		s = bj.String()
+	} else {
+		var err error
+		if s, err = bj.Unquote(); err != nil {
+			return jsonTableValue{}, err
+		}
+	}
+
+	val, err := u.convertString(s, bj.Type == bytejson.TpCodeLiteral, typ)
+	if err != nil {
+		return jsonTableValue{}, moerr.NewInvalidInputNoCtxf("json_table: can not convert %s to %s", bj.String(), typ.String())
+	}
+	return jsonTableValue{val: val}, nil
+}
+
+func (u *jsonTableState) convertString(s string, literal bool, typ types.Type) (any, error) {
+	switch typ.Oid {
+	case types.T_char, types.T_varchar, types.T_text, types.T_blob, types.T_binary, types.T_varbinary:
+		if (typ.Oid == types.T_char || typ.Oid == types.T_varchar) && typ.Width > 0 && utf8.RuneCountInString(s) > int(typ.Width) {
+			return nil, moerr.NewDataTruncatedNoCtx("json_table", "value is too long")
+		}
+		return []byte(s), nil
+	case types.T_bool:
+		if literal {
+			return s == "true", nil
+		}
+		f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+		if err != nil {
+			return types.ParseBool(s)
+		}
+		return f != 0, nil
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64:
+		i, err := parseJsonTableInt(s, literal)
+		if err != nil {
+			return nil, err
+		}
+		switch typ.Oid {
+		case types.T_int8:
+			if i < math.MinInt8 || i > math.MaxInt8 {
+				return nil, moerr.NewOutOfRangeNoCtxf("int8", "%d", i)
+			}
+			return int8(i), nil
+		case types.T_int16:
+			if i < math.MinInt16 || i > math.MaxInt16 {
+				return nil, moerr.NewOutOfRangeNoCtxf("int16", "%d", i)
+			}
+			return int16(i), nil
+		case types.T_int32:
+			if i < math.MinInt32 || i > math.MaxInt32 {
+				return nil, moerr.NewOutOfRangeNoCtxf("int32", "%d", i)
+			}
+			return int32(i), nil
+		default:
+			return i, nil
+		}
+	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		i, err := parseJsonTableUint(s, literal)
+		if err != nil {
+			return nil, err
+		}
+		switch typ.Oid {
+		case types.T_uint8:
+			if i > math.MaxUint8 {
+				return nil, moerr.NewOutOfRangeNoCtxf("uint8", "%d", i)
+			}
+			return uint8(i), nil
+		case types.T_uint16:
+			if i > math.MaxUint16 {
+				return nil, moerr.NewOutOfRangeNoCtxf("uint16", "%d", i)
+			}
+			return uint16(i), nil
+		case types.T_uint32:
+			if i > math.MaxUint32 {
+				return nil, moerr.NewOutOfRangeNoCtxf("uint32", "%d", i)
+			}
+			return uint32(i), nil
+		default:
+			return i, nil
+		}
+	case types.T_float32:
+		f, err := strconv.ParseFloat(strings.TrimSpace(s), 32)
+		return float32(f), err
+	case types.T_float64:
+		return strconv.ParseFloat(strings.TrimSpace(s), 64)
+	case types.T_decimal64:
+		return types.ParseDecimal64(strings.TrimSpace(s), typ.Width, typ.Scale)
+	case types.T_decimal128:
+		return types.ParseDecimal128(strings.TrimSpace(s), typ.Width, typ.Scale)
+	case types.T_date:
+		return types.ParseDateCast(s)
+	case types.T_datetime:
+		return types.ParseDatetime(s, typ.Scale)
+	case types.T_timestamp:
+		return types.ParseTimestamp(u.loc, s, typ.Scale)
+	case types.T_time:
+		return types.ParseTime(s, typ.Scale)
+	default:
+		return nil, moerr.NewNotSupportedNoCtxf("json_table: column type %s", typ.String())
+	}
+}
+
+// parseJsonTableInt parses the integer, float is rounded and true/false is 1/0
+func parseJsonTableInt(s string, literal bool) (int64, error) {
+	if literal {
+		if s == "true" {
+			return 1, nil
+		}
+		return 0, nil
+	}
+	s = strings.TrimSpace(s)
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return i, nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, err
+	}
+	f = math.Round(f)
+	if f < math.MinInt64 || f >= math.MaxInt64 {
+		return 0, moerr.NewOutOfRangeNoCtxf("int64", "%s", s)
+	}
+	return int64(f), nil
+}
+
+func parseJsonTableUint(s string, literal bool) (uint64, error) {
+	if literal {
+		if s == "true" {
+			return 1, nil
+		}
+		return 0, nil
+	}
+	s = strings.TrimSpace(s)
+	if i, err := strconv.ParseUint(s, 10, 64); err == nil {
+		return i, nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, err
+	}
+	f = math.Round(f)
+	if f < 0 || f >= math.MaxUint64 {
+		return 0, moerr.NewOutOfRangeNoCtxf("uint64", "%s", s)
+	}
+	return uint64(f), nil
+}
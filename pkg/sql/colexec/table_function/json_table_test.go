@@ -0,0 +1,180 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table_function
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+)
+
+// select * from json_table(doc, '$[*]' columns (
+//
+//	id for ordinality,
+//	a int path '$.a',
+//	s varchar(10) path '$.s' default '"none"' on empty,
+//	has_c int exists path '$.c',
+//	nested path '$.b[*]' columns (bid for ordinality, b int path '$'),
+//	nested path '$.b[*]' columns (b2 bigint path '$'))) as jt
+var jsonTableTestParam = plan2.JsonTableParam{
+	Path: "$[*]",
+	Columns: []*plan2.JsonTableColumnParam{
+		{Kind: tree.JSON_TABLE_COLUMN_ORDINALITY, Name: "id"},
+		{Kind: tree.JSON_TABLE_COLUMN_PATH, Name: "a", Path: "$.a"},
+		{Kind: tree.JSON_TABLE_COLUMN_PATH, Name: "s", Path: "$.s", OnEmpty: tree.JsonTableOnResponse{Type: tree.JSON_TABLE_ON_DEFAULT, Default: `"none"`}},
+		{Kind: tree.JSON_TABLE_COLUMN_EXISTS, Name: "has_c", Path: "$.c"},
+		{Kind: tree.JSON_TABLE_COLUMN_NESTED, Path: "$.b[*]", Columns: []*plan2.JsonTableColumnParam{
+			{Kind: tree.JSON_TABLE_COLUMN_ORDINALITY, Name: "bid"},
+			{Kind: tree.JSON_TABLE_COLUMN_PATH, Name: "b", Path: "$"},
+		}},
+		{Kind: tree.JSON_TABLE_COLUMN_NESTED, Path: "$.b[*]", Columns: []*plan2.JsonTableColumnParam{
+			{Kind: tree.JSON_TABLE_COLUMN_PATH, Name: "b2", Path: "$"},
+		}},
+	},
+}
+
+var jsonTableTestTypes = map[string]types.T{
+	"id":    types.T_uint32,
+	"a":     types.T_int32,
+	"s":     types.T_varchar,
+	"has_c": types.T_int32,
+	"bid":   types.T_uint32,
+	"b":     types.T_int32,
+	"b2":    types.T_int64,
+}
+
+func runJsonTable(t *testing.T, param plan2.JsonTableParam, attrs []string, doc string) ([]string, error) {
+	proc := testutil.NewProc()
+
+	params, err := json.Marshal(param)
+	require.NoError(t, err)
+
+	tf := &TableFunction{
+		Attrs:    attrs,
+		Params:   params,
+		FuncName: "json_table",
+	}
+	for _, attr := range attrs {
+		typ := jsonTableTestTypes[attr]
+		tf.Rets = append(tf.Rets, &plan.ColDef{Name: attr, Typ: plan.Type{Id: int32(typ), Width: 10}})
+		tf.ctr.retSchema = append(tf.ctr.retSchema, types.New(typ, 10, 0))
+	}
+
+	st, err := jsonTablePrepare(proc, tf)
+	require.NoError(t, err)
+
+	docVec, err := vector.NewConstBytes(types.T_varchar.ToType(), []byte(doc), 1, proc.Mp())
+	require.NoError(t, err)
+	tf.ctr.argVecs = append(tf.ctr.argVecs[:0], docVec)
+
+	if err = st.start(tf, proc, 0, nil); err != nil {
+		return nil, err
+	}
+
+	res, err := st.call(tf, proc)
+	require.NoError(t, err)
+	var rows []string
+	if res.Batch != nil {
+		for i := 0; i < res.Batch.RowCount(); i++ {
+			row := ""
+			for j, vec := range res.Batch.Vecs {
+				if j > 0 {
+					row += ","
+				}
+				if vec.IsNull(uint64(i)) {
+					row += "NULL"
+				} else if vec.GetType().Oid == types.T_varchar {
+					row += vec.GetStringAt(i)
+				} else {
+					row += fmt.Sprint(vector.GetAny(vec, i))
+				}
+			}
+			rows = append(rows, row)
+		}
+	}
+	st.free(tf, proc, false, nil)
+	return rows, nil
+}
+
+func TestJsonTable(t *testing.T) {
+	doc := `[{"a": 1, "s": "x", "b": [11, 111]}, {"a": "abc", "c": true}, {"a": 3.6, "s": [1]}]`
+
+	rows, err := runJsonTable(t, jsonTableTestParam, []string{"id", "a", "s", "has_c", "bid", "b", "b2"}, doc)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"1,1,x,0,1,11,NULL",
+		"1,1,x,0,2,111,NULL",
+		"1,1,x,0,NULL,NULL,11",
+		"1,1,x,0,NULL,NULL,111",
+		"2,NULL,none,1,NULL,NULL,NULL",
+		"3,4,[1],0,NULL,NULL,NULL",
+	}, rows)
+
+	// pruned columns
+	rows, err = runJsonTable(t, jsonTableTestParam, []string{"b2", "id"}, doc)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"NULL,1",
+		"NULL,1",
+		"11,1",
+		"111,1",
+		"NULL,2",
+		"NULL,3",
+	}, rows)
+
+	// row path matches nothing
+	rows, err = runJsonTable(t, jsonTableTestParam, []string{"id", "a"}, `{"a": 1}`)
+	require.NoError(t, err)
+	require.Empty(t, rows)
+
+	// invalid json
+	_, err = runJsonTable(t, jsonTableTestParam, []string{"id", "a"}, `[{"a": 1}`)
+	require.Error(t, err)
+}
+
+func TestJsonTableOnResponse(t *testing.T) {
+	param := plan2.JsonTableParam{
+		Path: "$[*]",
+		Columns: []*plan2.JsonTableColumnParam{
+			{Kind: tree.JSON_TABLE_COLUMN_PATH, Name: "a", Path: "$.a",
+				OnEmpty: tree.JsonTableOnResponse{Type: tree.JSON_TABLE_ON_ERROR},
+				OnError: tree.JsonTableOnResponse{Type: tree.JSON_TABLE_ON_DEFAULT, Default: "-1"}},
+		},
+	}
+
+	rows, err := runJsonTable(t, param, []string{"a"}, `[{"a": 1}, {"a": "x"}, {"a": 1e20}]`)
+	require.NoError(t, err)
+	require.Equal(t, []string{"1", "-1", "-1"}, rows)
+
+	_, err = runJsonTable(t, param, []string{"a"}, `[{"a": 1}, {"b": 2}]`)
+	require.Error(t, err)
+
+	param.Columns[0].OnError = tree.JsonTableOnResponse{Type: tree.JSON_TABLE_ON_ERROR}
+	_, err = runJsonTable(t, param, []string{"a"}, `[{"a": "x"}]`)
+	require.Error(t, err)
+
+	param.Columns[0].Path = "$.a[*]"
+	_, err = runJsonTable(t, param, []string{"a"}, `[{"a": [1, 2]}]`)
+	require.Error(t, err)
+}
@@ -149,8 +149,14 @@ func (tableFunction *TableFunction) Prepare(proc *process.Process) error {
 		tblArg.ctr.state, err = fulltextIndexScanPrepare(proc, tblArg)
 	case "fulltext_index_tokenize":
 		tblArg.ctr.state, err = fulltextIndexTokenizePrepare(proc, tblArg)
+	case "hnsw_search":
+		tblArg.ctr.state, err = hnswSearchPrepare(proc, tblArg)
+	case "hnsw_index_update":
+		tblArg.ctr.state, err = hnswIndexUpdatePrepare(proc, tblArg)
 	case "stage_list":
 		tblArg.ctr.state, err = stageListPrepare(proc, tblArg)
+	case "json_table":
+		tblArg.ctr.state, err = jsonTablePrepare(proc, tblArg)
 	default:
 		tblArg.ctr.state = nil
 		err = moerr.NewNotSupported(proc.Ctx, fmt.Sprintf("table function %s is not supported", tblArg.FuncName))
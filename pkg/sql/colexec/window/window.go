@@ -214,7 +214,9 @@ func (ctr *container) resetResultBatch(bat *batch.Batch, vec *vector.Vector) *ba
 func (ctr *container) processFunc(idx int, ap *Window, proc *process.Process, analyzer process.Analyzer) error {
 	var err error
 	n := ctr.bat.Vecs[0].Length()
-	isWinOrder := function.GetFunctionIsWinOrderFunByName(ap.WinSpecList[idx].Expr.(*plan.Expr_W).W.Name)
+	w := ap.WinSpecList[idx].Expr.(*plan.Expr_W).W
+	isWinOrder := function.GetFunctionIsWinOrderFunByName(w.Name)
+	direction := function.GetWinOffsetDirectionByName(w.Name)
 	if isWinOrder {
 		if ctr.ps == nil {
 			ctr.ps = append(ctr.ps, 0)
@@ -251,6 +253,19 @@ func (ctr *container) processFunc(idx int, ap *Window, proc *process.Process, an
 
 			}
 		}
+	} else if direction != 0 {
+		// LAG and LEAD ignore the frame, they pick the row at an offset in the partition.
+		for j := 0; j < n; j++ {
+			start, end := 0, n
+
+			if ctr.ps != nil {
+				start, end = buildPartitionInterval(ctr.ps, j, n)
+			}
+
+			if err = ctr.fillOffsetRow(idx, j, start, end, direction, w.IgnoreNulls); err != nil {
+				return err
+			}
+		}
 	} else {
 		//nullVec := vector.NewConstNull(*ctr.aggVecs[idx].Vec[0].GetType(), 1, proc.Mp())
 		//defer nullVec.Free(proc.Mp())
@@ -284,7 +299,23 @@ func (ctr *container) processFunc(idx int, ap *Window, proc *process.Process, an
 				right = end
 			}
 
+			if w.FromLast {
+				// NTH_VALUE ... FROM LAST counts the rows from the end of the frame.
+				for k := right - 1; k >= left; k-- {
+					if w.IgnoreNulls && ctr.aggVecs[idx].Vec[0].IsNull(uint64(k)) {
+						continue
+					}
+					if err = ctr.bat.Aggs[idx].Fill(j, k, ctr.aggVecs[idx].Vec); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+
 			for k := left; k < right; k++ {
+				if w.IgnoreNulls && ctr.aggVecs[idx].Vec[0].IsNull(uint64(k)) {
+					continue
+				}
 				if err = ctr.bat.Aggs[idx].Fill(j, k, ctr.aggVecs[idx].Vec); err != nil {
 					return err
 				}
@@ -312,6 +343,50 @@ func (ctr *container) processFunc(idx int, ap *Window, proc *process.Process, an
 	return nil
 }
 
+// fillOffsetRow fills the row at the offset from the rowIdx into the group of rowIdx for LAG and LEAD.
+// if there is no such row in the partition [start, end), the default value of rowIdx will be filled.
+func (ctr *container) fillOffsetRow(idx, rowIdx, start, end, direction int, ignoreNulls bool) error {
+	vecs := ctr.aggVecs[idx].Vec
+
+	offset := int64(1)
+	if len(vecs) > 1 {
+		offsets := vector.MustFixedColWithTypeCheck[int64](vecs[1])
+		if vecs[1].IsConst() {
+			offset = offsets[0]
+		} else {
+			offset = offsets[rowIdx]
+		}
+	}
+
+	target := -1
+	if !ignoreNulls {
+		if k := rowIdx + direction*int(offset); k >= start && k < end {
+			target = k
+		}
+	} else if offset == 0 {
+		target = rowIdx
+	} else {
+		// count the non-null rows only.
+		for k, cnt := rowIdx+direction, int64(0); k >= start && k < end; k += direction {
+			if vecs[0].IsNull(uint64(k)) {
+				continue
+			}
+			if cnt++; cnt == offset {
+				target = k
+				break
+			}
+		}
+	}
+
+	if target >= 0 {
+		return ctr.bat.Aggs[idx].Fill(rowIdx, target, vecs[:1])
+	}
+	if len(vecs) > 2 {
+		return ctr.bat.Aggs[idx].Fill(rowIdx, rowIdx, vecs[2:3])
+	}
+	return nil
+}
+
 func (ctr *container) buildInterval(rowIdx, start, end int, frame *plan.FrameClause) (int, int, error) {
 	// FrameClause_ROWS
 	if frame.Type == plan.FrameClause_ROWS {
@@ -575,8 +650,8 @@ func (ctr *container) processOrder(idx int, ap *Window, bat *batch.Batch, proc *
 
 	// shuffle agg vector
 	for k := idx; k < len(ctr.aggVecs); k++ {
-		if len(ctr.aggVecs[k].Vec) > 0 {
-			if err := ctr.aggVecs[k].Vec[0].Shuffle(ctr.sels, proc.Mp()); err != nil {
+		for _, vec := range ctr.aggVecs[k].Vec {
+			if err := vec.Shuffle(ctr.sels, proc.Mp()); err != nil {
 				panic(err)
 			}
 		}
@@ -29,6 +29,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm"
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -156,3 +157,163 @@ func newFunExpr() *plan.Expr {
 		},
 	}
 }
+
+func TestValueWindowFunctions(t *testing.T) {
+	// a: 1, null, 3, 4
+	input := []int64{1, 0, 3, 4}
+	isNull := []bool{false, true, false, false}
+
+	cases := []struct {
+		name        string
+		args        []*plan.Expr
+		ignoreNulls bool
+		fromLast    bool
+		expected    []int64
+		nulls       []bool
+	}{
+		{name: "lag", args: []*plan.Expr{newColExpr(0)},
+			expected: []int64{0, 1, 0, 3}, nulls: []bool{true, false, true, false}},
+		{name: "lead", args: []*plan.Expr{newColExpr(0), newInt64Lit(2), newInt64Lit(-1)},
+			expected: []int64{3, 4, -1, -1}, nulls: []bool{false, false, false, false}},
+		{name: "lag", args: []*plan.Expr{newColExpr(0)}, ignoreNulls: true,
+			expected: []int64{0, 1, 1, 3}, nulls: []bool{true, false, false, false}},
+		{name: "first_value", args: []*plan.Expr{newColExpr(0)},
+			expected: []int64{1, 1, 1, 1}, nulls: []bool{false, false, false, false}},
+		{name: "last_value", args: []*plan.Expr{newColExpr(0)},
+			expected: []int64{4, 4, 4, 4}, nulls: []bool{false, false, false, false}},
+		{name: "nth_value", args: []*plan.Expr{newColExpr(0), newInt64Lit(2)},
+			expected: []int64{0, 0, 0, 0}, nulls: []bool{true, true, true, true}},
+		{name: "nth_value", args: []*plan.Expr{newColExpr(0), newInt64Lit(2)}, ignoreNulls: true, fromLast: true,
+			expected: []int64{3, 3, 3, 3}, nulls: []bool{false, false, false, false}},
+	}
+
+	for _, c := range cases {
+		proc := testutil.NewProcessWithMPool("", mpool.MustNewZero())
+
+		argTypes := make([]types.Type, len(c.args))
+		for i := range argTypes {
+			argTypes[i] = types.T_int64.ToType()
+		}
+		f, err := function.GetFunctionByName(context.Background(), c.name, argTypes)
+		require.NoError(t, err)
+
+		spec := makeWindowSpec()
+		w := spec.Expr.(*plan.Expr_W).W
+		w.Name = c.name
+		w.IgnoreNulls = c.ignoreNulls
+		w.FromLast = c.fromLast
+		w.WindowFunc.Expr.(*plan.Expr_F).F.Func.ObjName = c.name
+
+		arg := &Window{
+			WinSpecList: []*plan.Expr{spec},
+			Types:       []types.Type{types.T_int64.ToType()},
+			Aggs:        []aggexec.AggFuncExecExpression{aggexec.MakeAggFunctionExpression(f.GetEncodedOverloadID(), false, c.args, nil)},
+		}
+
+		vec := vector.NewVec(types.T_int64.ToType())
+		for i := range input {
+			require.NoError(t, vector.AppendFixed(vec, input[i], isNull[i], proc.Mp()))
+		}
+		bat := batch.New([]string{"a"})
+		bat.Vecs[0] = vec
+		bat.SetRowCount(vec.Length())
+		child := colexec.NewMockOperator().WithBatchs([]*batch.Batch{bat})
+		arg.AppendChild(child)
+
+		require.NoError(t, arg.Prepare(proc))
+		result, err := arg.Call(proc)
+		require.NoError(t, err)
+
+		res := result.Batch.Vecs[len(result.Batch.Vecs)-1]
+		vs := vector.MustFixedColWithTypeCheck[int64](res)
+		for i := range c.expected {
+			require.Equal(t, c.nulls[i], res.IsNull(uint64(i)), "%s, row %d", c.name, i)
+			if !c.nulls[i] {
+				require.Equal(t, c.expected[i], vs[i], "%s, row %d", c.name, i)
+			}
+		}
+
+		arg.Free(proc, false, nil)
+		child.Free(proc, false, nil)
+		proc.Free()
+		require.Equal(t, int64(0), proc.Mp().CurrNB())
+	}
+}
+
+func newInt64Lit(v int64) *plan.Expr {
+	return &plan.Expr{
+		Typ: plan.Type{Id: int32(types.T_int64)},
+		Expr: &plan.Expr_Lit{
+			Lit: &plan.Literal{
+				Value: &plan.Literal_I64Val{I64Val: v},
+			},
+		},
+	}
+}
+
+func TestDistributionWindowFunctions(t *testing.T) {
+	// a: 1, 1, 2, 3
+	input := []int64{1, 1, 2, 3}
+
+	cases := []struct {
+		name     string
+		buckets  int64
+		expected any
+	}{
+		{name: "ntile", buckets: 3, expected: []int64{1, 1, 2, 3}},
+		{name: "percent_rank", expected: []float64{0, 0, 2.0 / 3, 1}},
+		{name: "cume_dist", expected: []float64{0.5, 0.5, 0.75, 1}},
+	}
+
+	for _, c := range cases {
+		proc := testutil.NewProcessWithMPool("", mpool.MustNewZero())
+
+		var argTypes []types.Type
+		var cfg []byte
+		if c.buckets > 0 {
+			argTypes = []types.Type{types.T_int64.ToType()}
+			cfg = types.EncodeInt64(&c.buckets)
+		}
+		f, err := function.GetFunctionByName(context.Background(), c.name, argTypes)
+		require.NoError(t, err)
+
+		orderBy := newColExpr(0)
+		orderBy.Typ = plan.Type{Id: int32(types.T_int64)}
+		spec := makeWindowSpec()
+		w := spec.Expr.(*plan.Expr_W).W
+		w.Name = c.name
+		w.OrderBy = []*plan.OrderBySpec{{Expr: orderBy}}
+		w.WindowFunc.Expr.(*plan.Expr_F).F.Func.ObjName = c.name
+
+		arg := &Window{
+			WinSpecList: []*plan.Expr{spec},
+			Types:       []types.Type{types.T_int64.ToType()},
+			Aggs:        []aggexec.AggFuncExecExpression{aggexec.MakeAggFunctionExpression(f.GetEncodedOverloadID(), false, nil, cfg)},
+		}
+
+		vec := vector.NewVec(types.T_int64.ToType())
+		require.NoError(t, vector.AppendFixedList(vec, input, nil, proc.Mp()))
+		bat := batch.New([]string{"a"})
+		bat.Vecs[0] = vec
+		bat.SetRowCount(vec.Length())
+		child := colexec.NewMockOperator().WithBatchs([]*batch.Batch{bat})
+		arg.AppendChild(child)
+
+		require.NoError(t, arg.Prepare(proc))
+		result, err := arg.Call(proc)
+		require.NoError(t, err)
+
+		res := result.Batch.Vecs[len(result.Batch.Vecs)-1]
+		switch expected := c.expected.(type) {
+		case []int64:
+			require.Equal(t, expected, vector.MustFixedColWithTypeCheck[int64](res), c.name)
+		case []float64:
+			require.InDeltaSlice(t, expected, vector.MustFixedColWithTypeCheck[float64](res), 1e-9, c.name)
+		}
+
+		arg.Free(proc, false, nil)
+		child.Free(proc, false, nil)
+		proc.Free()
+		require.Equal(t, int64(0), proc.Mp().CurrNB())
+	}
+}
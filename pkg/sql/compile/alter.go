@@ -89,6 +89,12 @@ func (s *Scope) AlterTableCopy(c *Compile) error {
 		}
 	}
 
+	if !needSkipDbs[dbName] {
+		if err = checkTTLColumn(c, originRel.GetTableID(c.proc.Ctx), qry.CopyTableDef); err != nil {
+			return err
+		}
+	}
+
 	// 3. create temporary replica table which doesn't have foreign key constraints
 	err = c.runSql(qry.CreateTmpTableSql)
 	if err != nil {
@@ -156,6 +162,10 @@ func (s *Scope) AlterTableCopy(c *Compile) error {
 		}
 	}
 
+	if err = dropHnswIndexFiles(c, qry.TableDef.Indexes); err != nil {
+		return err
+	}
+
 	//6. obtain relation for new tables
 	newRel, err := dbSource.Relation(c.proc.Ctx, qry.CopyTableDef.Name, nil)
 	if err != nil {
@@ -187,12 +197,12 @@ func (s *Scope) AlterTableCopy(c *Compile) error {
 	}
 	//--------------------------------------------------------------------------------------------------------------
 	{
-		// 8. invoke reindex for the new table, if it contains ivf index.
+		// 8. invoke reindex for the new table, if it contains ivf or hnsw index.
 		multiTableIndexes := make(map[string]*MultiTableIndex)
 		newTableDef := newRel.CopyTableDef(c.proc.Ctx)
 
 		for _, indexDef := range newTableDef.Indexes {
-			if catalog.IsIvfIndexAlgo(indexDef.IndexAlgo) {
+			if catalog.IsIvfIndexAlgo(indexDef.IndexAlgo) || catalog.IsHnswIndexAlgo(indexDef.IndexAlgo) {
 				if _, ok := multiTableIndexes[indexDef.IndexName]; !ok {
 					multiTableIndexes[indexDef.IndexName] = &MultiTableIndex{
 						IndexAlgo: catalog.ToLower(indexDef.IndexAlgo),
@@ -206,6 +216,8 @@ func (s *Scope) AlterTableCopy(c *Compile) error {
 			switch multiTableIndex.IndexAlgo {
 			case catalog.MoIndexIvfFlatAlgo.ToString():
 				err = s.handleVectorIvfFlatIndex(c, dbSource, multiTableIndex.IndexDefs, qry.Database, newTableDef, nil)
+			case catalog.MoIndexHnswAlgo.ToString():
+				err = s.handleVectorHnswIndex(c, dbSource, multiTableIndex.IndexDefs[catalog.SystemSI_HNSW_TblType_Metadata], qry.Database, newTableDef, nil)
 			}
 			if err != nil {
 				c.proc.Error(c.proc.Ctx, "invoke reindex for the new table for alter table",
@@ -249,6 +261,32 @@ func (s *Scope) AlterTableCopy(c *Compile) error {
 		}
 	}
 
+	// the triggers are kept by the copy table definition, move their records to the new table
+	if len(qry.CopyTableDef.Triggers) > 0 {
+		updateSql := fmt.Sprintf(updateMoTriggersTableIdFormat, newRel.GetTableID(c.proc.Ctx), originRel.GetTableID(c.proc.Ctx))
+		if err = c.runSql(updateSql); err != nil {
+			c.proc.Error(c.proc.Ctx, "update the table id of the triggers in `mo_triggers` for alter table",
+				zap.String("origin tableName", qry.GetTableDef().Name),
+				zap.String("copy table name", qry.CopyTableDef.Name),
+				zap.String("update sql", updateSql),
+				zap.Error(err))
+			return err
+		}
+	}
+
+	// the TTL is kept by the new table
+	if !needSkipDbs[dbName] {
+		updateSql := fmt.Sprintf(updateMoTtlTableIdFormat, newRel.GetTableID(c.proc.Ctx), originRel.GetTableID(c.proc.Ctx))
+		if err = c.runSql(updateSql); err != nil {
+			c.proc.Error(c.proc.Ctx, "update the table id of the TTL in `mo_ttl` for alter table",
+				zap.String("origin tableName", qry.GetTableDef().Name),
+				zap.String("copy table name", qry.CopyTableDef.Name),
+				zap.String("update sql", updateSql),
+				zap.Error(err))
+			return err
+		}
+	}
+
 	if len(qry.TableDef.Fkeys) > 0 {
 		for _, fkey := range qry.CopyTableDef.Fkeys {
 			if err = notifyParentTableFkTableIdChange(c, fkey, originRel.GetTableID(c.proc.Ctx)); err != nil {
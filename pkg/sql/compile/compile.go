@@ -414,6 +414,12 @@ func (c *Compile) run(s *Scope) error {
 		return s.TruncateTable(c)
 	case Replace:
 		return s.replace(c)
+	case CreateTrigger:
+		return s.CreateTrigger(c)
+	case DropTrigger:
+		return s.DropTrigger(c)
+	case RefreshMaterializedView:
+		return s.RefreshMaterializedView(c)
 	}
 	return nil
 }
@@ -679,6 +685,21 @@ func (c *Compile) compileScope(pn *plan.Plan) ([]*Scope, error) {
 				newScope(DropIndex).
 					withPlan(pn),
 			}, nil
+		case plan.DataDefinition_CREATE_TRIGGER:
+			return []*Scope{
+				newScope(CreateTrigger).
+					withPlan(pn),
+			}, nil
+		case plan.DataDefinition_DROP_TRIGGER:
+			return []*Scope{
+				newScope(DropTrigger).
+					withPlan(pn),
+			}, nil
+		case plan.DataDefinition_REFRESH_MATERIALIZED_VIEW:
+			return []*Scope{
+				newScope(RefreshMaterializedView).
+					withPlan(pn),
+			}, nil
 		case plan.DataDefinition_SHOW_DATABASES,
 			plan.DataDefinition_SHOW_TABLES,
 			plan.DataDefinition_SHOW_COLUMNS,
@@ -3240,6 +3261,10 @@ func (c *Compile) compileMultiUpdate(_ []*plan.Node, n *plan.Node, ss []*Scope)
 	// Determine whether to Write S3
 	toWriteS3 := n.Stats.GetOutcnt()*float64(SingleLineSizeEstimate) >
 		float64(DistributedThreshold) || c.anal.qry.LoadWriteS3
+	if n.UpdateCtxList[0].InsertFilterCol != nil {
+		// the s3 writer does not filter the inserted rows
+		toWriteS3 = false
+	}
 
 	currentFirstFlag := c.anal.isFirst
 	if toWriteS3 {
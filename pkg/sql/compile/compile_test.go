@@ -120,6 +120,17 @@ func (w *Ws) RollbackLastStatement(ctx context.Context) error {
 	return nil
 }
 
+func (w *Ws) Savepoint(ctx context.Context) (int, error) {
+	return 0, nil
+}
+
+func (w *Ws) RollbackToSavepoint(ctx context.Context, id int) error {
+	return nil
+}
+
+func (w *Ws) ReleaseSavepoint(id int) {
+}
+
 func (w *Ws) Commit(ctx context.Context) ([]txn.TxnRequest, error) {
 	return nil, nil
 }
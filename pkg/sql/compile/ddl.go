@@ -227,12 +227,27 @@ func (s *Scope) DropDatabase(c *Compile) error {
 		return err
 	}
 
-	// 3. delete fks
+	// 3. delete all trigger record under the database from mo_catalog.mo_triggers
+	deleteSql = fmt.Sprintf(deleteMoTriggersWithDatabaseIdFormat, s.Plan.GetDdl().GetDropDatabase().GetDatabaseId())
+	err = c.runSql(deleteSql)
+	if err != nil {
+		return err
+	}
+
+	// delete all the events under the database and their run history
+	for _, format := range []string{deleteMoEventsWithDatabaseIdFormat, deleteMoEventLogWithDatabaseIdFormat} {
+		deleteSql = fmt.Sprintf(format, s.Plan.GetDdl().GetDropDatabase().GetDatabaseId())
+		if err = c.runSql(deleteSql); err != nil {
+			return err
+		}
+	}
+
+	// 4. delete fks
 	err = c.runSql(s.Plan.GetDdl().GetDropDatabase().GetUpdateFkSql())
 	if err != nil {
 		return err
 	}
-	// 4. delete retention info
+	// 5. delete retention info
 	err = c.runSql(fmt.Sprintf(deleteMoRetentionWithDatabaseNameFormat, dbName))
 	if moerr.IsMoErrCode(err, moerr.ErrNoSuchTable) {
 		return nil
@@ -464,6 +479,7 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 	var addIndex []*plan.IndexDef
 	var dropIndexMap = make(map[string]bool)
 	var alterIndex *plan.IndexDef
+	var dropCheckMap = make(map[string]bool)
 
 	var alterKinds []api.AlterKind
 	var comment string
@@ -492,6 +508,9 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 					}
 					return false
 				})
+			} else if alterTableDrop.Typ == plan.AlterTableDrop_CHECK {
+				alterKinds = addAlterKind(alterKinds, api.AlterKind_UpdateConstraint)
+				dropCheckMap[constraintName] = true
 			} else if alterTableDrop.Typ == plan.AlterTableDrop_INDEX {
 				alterKinds = addAlterKind(alterKinds, api.AlterKind_UpdateConstraint)
 				var notDroppedIndex []*plan.IndexDef
@@ -507,6 +526,9 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 							if err = dbSource.Delete(c.proc.Ctx, indexdef.IndexTableName); err != nil {
 								return err
 							}
+							if err = dropHnswIndexFiles(c, []*plan.IndexDef{indexdef}); err != nil {
+								return err
+							}
 						}
 						//2. delete index object from mo_catalog.mo_indexes
 						deleteSql := fmt.Sprintf(deleteMoIndexesWithTableIdAndIndexNameFormat, tableDef.TblId, indexdef.IndexName)
@@ -581,6 +603,9 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 				} else if !indexDef.Unique && catalog.IsFullTextIndexAlgo(indexDef.IndexAlgo) {
 					// 3. FullText index
 					err = s.handleFullTextIndexTable(c, dbSource, indexDef, qry.Database, tableDef, indexInfo)
+				} else if !indexDef.Unique && catalog.IsHnswIndexAlgo(indexDef.IndexAlgo) {
+					// 3. HNSW index
+					err = s.handleVectorHnswIndex(c, dbSource, indexDef, qry.Database, tableDef, indexInfo)
 				} else if !indexDef.Unique && catalog.IsIvfIndexAlgo(indexDef.IndexAlgo) {
 					// 4. IVF indexDefs are aggregated and handled later
 					if _, ok := multiTableIndexes[indexDef.IndexName]; !ok {
@@ -667,6 +692,8 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 					switch catalog.ToLower(indexAlgo) {
 					case catalog.MoIndexIvfFlatAlgo.ToString():
 						newAlgoParamsMap[catalog.IndexAlgoParamLists] = fmt.Sprintf("%d", tableAlterIndex.IndexAlgoParamList)
+					case catalog.MoIndexHnswAlgo.ToString():
+						// rebuild the graph with the same parameters
 					default:
 						return moerr.NewInternalError(c.proc.Ctx, "invalid index algo type for alter reindex")
 					}
@@ -708,6 +735,8 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 				switch multiTableIndex.IndexAlgo {
 				case catalog.MoIndexIvfFlatAlgo.ToString():
 					err = s.handleVectorIvfFlatIndex(c, dbSource, multiTableIndex.IndexDefs, qry.Database, tableDef, nil)
+				case catalog.MoIndexHnswAlgo.ToString():
+					err = s.handleVectorHnswIndex(c, dbSource, multiTableIndex.IndexDefs[catalog.SystemSI_HNSW_TblType_Metadata], qry.Database, tableDef, nil)
 				}
 
 				if err != nil {
@@ -736,6 +765,10 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 			}
 			act.AddColumn.Pos = pos
 			addCol = append(addCol, act.AddColumn)
+		case *plan.AlterTable_Action_AlterTtl:
+			if err = setTableTTL(c, dbName, databaseId, tblId, act.AlterTtl.Ttl); err != nil {
+				return err
+			}
 		case *plan.AlterTable_Action_AddPartition:
 			alterKinds = append(alterKinds, api.AlterKind_AddPartition)
 			changePartitionDef = act.AddPartition.PartitionDef
@@ -806,6 +839,19 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 			newCt.Cts = append(newCt.Cts, t)
 		case *engine.PrimaryKeyDef:
 			newCt.Cts = append(newCt.Cts, t)
+		case *engine.CheckDef:
+			t.Checks = plan2.RemoveIf[*plan.CheckDef](t.Checks, func(check *plan.CheckDef) bool {
+				return dropCheckMap[check.Name]
+			})
+			newCt.Cts = append(newCt.Cts, t)
+		case *engine.GeneratedColDef:
+			newCt.Cts = append(newCt.Cts, t)
+		case *engine.TriggerDef:
+			newCt.Cts = append(newCt.Cts, t)
+		case *engine.MaterializedViewDef:
+			newCt.Cts = append(newCt.Cts, t)
+		case *engine.RefMaterializedViewDef:
+			newCt.Cts = append(newCt.Cts, t)
 		}
 	}
 	if !originHasFkDef {
@@ -1426,6 +1472,16 @@ func (s *Scope) CreateTable(c *Compile) error {
 		}
 	}
 
+	if qry.Ttl != nil {
+		newRelation, err := dbSource.Relation(c.proc.Ctx, tblName, nil)
+		if err != nil {
+			return err
+		}
+		if err = setTableTTL(c, dbName, dbSource.GetDatabaseId(c.proc.Ctx), newRelation.GetTableID(c.proc.Ctx), qry.Ttl); err != nil {
+			return err
+		}
+	}
+
 	// update mo_pitr table
 	// if mo_pitr table contains the same dbName and tblName, then update the table_id and modified_time
 	// otherwise, skip it
@@ -1447,6 +1503,13 @@ func (s *Scope) CreateTable(c *Compile) error {
 		}
 	}
 
+	// register the materialized view on its base tables
+	if mview := qry.GetTableDef().GetMview(); mview != nil {
+		if err = addMaterializedViewRefs(c, mview); err != nil {
+			return err
+		}
+	}
+
 	if len(partitionTables) == 0 {
 		return nil
 	}
@@ -1755,6 +1818,9 @@ func (s *Scope) CreateIndex(c *Compile) error {
 		} else if !indexDef.Unique && catalog.IsFullTextIndexAlgo(indexAlgo) {
 			// 5. FullText index
 			err = s.handleFullTextIndexTable(c, dbSource, indexDef, qry.Database, originalTableDef, indexInfo)
+		} else if !indexDef.Unique && catalog.IsHnswIndexAlgo(indexAlgo) {
+			// 6. HNSW index
+			err = s.handleVectorHnswIndex(c, dbSource, indexDef, qry.Database, originalTableDef, indexInfo)
 		}
 		if err != nil {
 			return err
@@ -1941,6 +2007,13 @@ func (s *Scope) DropIndex(c *Compile) error {
 		return err
 	}
 
+	var droppedIndexDefs []*plan.IndexDef
+	for _, indexDef := range r.GetTableDef(c.proc.Ctx).Indexes {
+		if indexDef.IndexName == qry.IndexName {
+			droppedIndexDefs = append(droppedIndexDefs, indexDef)
+		}
+	}
+
 	//1. build and update constraint def
 	oldCt, err := GetConstraintDef(c.proc.Ctx, r)
 	if err != nil {
@@ -1969,6 +2042,9 @@ func (s *Scope) DropIndex(c *Compile) error {
 			return err
 		}
 
+		if err = dropHnswIndexFiles(c, droppedIndexDefs); err != nil {
+			return err
+		}
 	}
 
 	//3. delete index object from mo_catalog.mo_indexes
@@ -2242,6 +2318,13 @@ func (s *Scope) TruncateTable(c *Compile) error {
 		return err
 	}
 
+	// the graph files of the truncated hnsw metadata tables are not referenced any more
+	if !isTemp {
+		if err = truncateHnswIndexFiles(c, dbName, rel.GetTableDef(c.proc.Ctx).Indexes); err != nil {
+			return err
+		}
+	}
+
 	// Truncate Index Tables if needed
 	for _, name := range tqry.IndexTableNames {
 		var err error
@@ -2281,7 +2364,6 @@ func (s *Scope) TruncateTable(c *Compile) error {
 		}
 
 	}
-
 	//Truncate Partition subtable if needed
 	for _, name := range tqry.PartitionTableNames {
 		var err error
@@ -2354,6 +2436,32 @@ func (s *Scope) TruncateTable(c *Compile) error {
 	if err != nil {
 		return err
 	}
+
+	// the TTL of the table is kept by the new table
+	if !isTemp && !needSkipDbs[dbName] {
+		updateSql = fmt.Sprintf(updateMoTtlTableIdFormat, newId, oldId)
+		if err = c.runSql(updateSql); err != nil {
+			return err
+		}
+	}
+
+	// update trigger information in mo_catalog.mo_triggers
+	if len(tblDef.Triggers) > 0 {
+		updateSql = fmt.Sprintf(updateMoTriggersTableIdFormat, newId, oldId)
+		err = c.runSql(updateSql)
+		if err != nil {
+			return err
+		}
+	}
+
+	// the materialized views maintained by the table become empty too
+	if !isTemp {
+		for _, mview := range tblDef.RefMviews {
+			if err = truncateMaterializedView(c, mview); err != nil {
+				return err
+			}
+		}
+	}
 	c.addAffectedRows(uint64(affectedRows))
 	return nil
 }
@@ -2528,6 +2636,30 @@ func (s *Scope) DropTable(c *Compile) error {
 		}
 	}
 
+	// delete the TTL of the table in mo_catalog.mo_ttl
+	if !qry.IsView && !isTemp && !needSkipDbs[qry.Database] {
+		deleteSql := fmt.Sprintf(deleteMoTtlWithTableIdFormat, qry.GetTableDef().TblId)
+		if err = c.runSql(deleteSql); err != nil {
+			return err
+		}
+	}
+
+	// delete all trigger record of the table in mo_catalog.mo_triggers
+	if !qry.IsView && qry.Database != catalog.MO_CATALOG && len(qry.GetTableDef().GetTriggers()) > 0 {
+		deleteSql := fmt.Sprintf(deleteMoTriggersWithTableIdFormat, qry.GetTableDef().TblId)
+		err = c.runSql(deleteSql)
+		if err != nil {
+			return err
+		}
+	}
+
+	// unregister the materialized view from its base tables
+	if mview := qry.GetTableDef().GetMview(); mview != nil {
+		if err = removeMaterializedViewRefs(c, mview); err != nil {
+			return err
+		}
+	}
+
 	// delete all partition objects record of the table in mo_catalog.mo_table_partitions
 	if !qry.IsView && qry.Database != catalog.MO_CATALOG && qry.Table != catalog.MO_TABLE_PARTITIONS {
 		if qry.TableDef.Partition != nil {
@@ -2590,6 +2722,7 @@ func (s *Scope) DropTable(c *Compile) error {
 		}
 
 	} else {
+		indexDefs := rel.GetTableDef(c.proc.Ctx).Indexes
 		if err := dbSource.Delete(c.proc.Ctx, tblName); err != nil {
 			return err
 		}
@@ -2603,6 +2736,9 @@ func (s *Scope) DropTable(c *Compile) error {
 			}
 
 		}
+		if err = dropHnswIndexFiles(c, indexDefs); err != nil {
+			return err
+		}
 
 		// delete partition subtable
 		for _, name := range qry.GetPartitionTableNames() {
@@ -2719,6 +2855,43 @@ func planDefsToExeDefs(tableDef *plan.TableDef) ([]engine.TableDef, error) {
 		})
 	}
 
+	if len(tableDef.Checks) > 0 {
+		c.Cts = append(c.Cts, &engine.CheckDef{
+			Checks: tableDef.Checks,
+		})
+	}
+
+	if len(tableDef.Triggers) > 0 {
+		c.Cts = append(c.Cts, &engine.TriggerDef{
+			Triggers: tableDef.Triggers,
+		})
+	}
+
+	if tableDef.Mview != nil {
+		c.Cts = append(c.Cts, &engine.MaterializedViewDef{
+			View: tableDef.Mview,
+		})
+	}
+
+	if len(tableDef.RefMviews) > 0 {
+		c.Cts = append(c.Cts, &engine.RefMaterializedViewDef{
+			Views: tableDef.RefMviews,
+		})
+	}
+
+	var generatedCols []*plan.GeneratedCol
+	for _, col := range tableDef.Cols {
+		if col.Generated != nil {
+			col.Generated.ColName = col.Name
+			generatedCols = append(generatedCols, col.Generated)
+		}
+	}
+	if len(generatedCols) > 0 {
+		c.Cts = append(c.Cts, &engine.GeneratedColDef{
+			Cols: generatedCols,
+		})
+	}
+
 	if len(c.Cts) > 0 {
 		exeDefs = append(exeDefs, c)
 	}
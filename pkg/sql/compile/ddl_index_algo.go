@@ -15,13 +15,23 @@
 package compile
 
 import (
+	"context"
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/hnsw"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/matrixorigin/matrixone/pkg/util/executor"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
@@ -29,6 +39,7 @@ import (
 const (
 	ivfFlatIndexFlag  = "experimental_ivf_index"
 	fulltextIndexFlag = "experimental_fulltext_index"
+	hnswIndexFlag     = "experimental_hnsw_index"
 )
 
 func (s *Scope) handleUniqueIndexTable(c *Compile, dbSource engine.Database,
@@ -122,6 +133,129 @@ func (s *Scope) handleFullTextIndexTable(c *Compile, dbSource engine.Database, i
 	return nil
 }
 
+// handleVectorHnswIndex builds the hnsw graph from the whole table. indexInfo is nil for reindex,
+// in which case the metadata table exists already and its rows are overwritten.
+func (s *Scope) handleVectorHnswIndex(c *Compile, dbSource engine.Database, indexDef *plan.IndexDef,
+	qryDatabase string, originalTableDef *plan.TableDef, indexInfo *plan.CreateTable) error {
+	if ok, err := s.isExperimentalEnabled(c, hnswIndexFlag); err != nil {
+		return err
+	} else if !ok {
+		return moerr.NewInternalErrorNoCtx("HNSW index is not enabled")
+	}
+
+	if indexInfo != nil {
+		if len(indexInfo.GetIndexTables()) != 1 {
+			return moerr.NewInternalErrorNoCtx("index table count not equal to 1")
+		}
+
+		def := indexInfo.GetIndexTables()[0]
+		if err := indexTableBuild(c, def, dbSource); err != nil {
+			return err
+		}
+	}
+
+	insertSQL, err := genInsertIndexTableSqlForHnswIndex(originalTableDef, indexDef, qryDatabase)
+	if err != nil {
+		return err
+	}
+	return c.runSql(insertSQL)
+}
+
+// dropHnswIndexFiles removes all the files of the graphs of the hnsw indexes in indexDefs after
+// the transaction dropping the index tables committed.
+func dropHnswIndexFiles(c *Compile, indexDefs []*plan.IndexDef) error {
+	tables := hnswMetadataTables(indexDefs)
+	if len(tables) == 0 {
+		return nil
+	}
+	return removeHnswFilesOnCommit(c, func(ctx context.Context, fs fileservice.FileService) error {
+		for _, table := range tables {
+			if err := hnsw.DeleteAll(ctx, fs, table); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// truncateHnswIndexFiles removes the files referenced by the metadata tables of the hnsw indexes
+// in indexDefs after the transaction truncating them committed. It must be called before the
+// truncation. The index tables are kept by the truncation, so the files written after it are kept.
+func truncateHnswIndexFiles(c *Compile, dbName string, indexDefs []*plan.IndexDef) error {
+	var files []string
+	for _, table := range hnswMetadataTables(indexDefs) {
+		res, err := c.runSqlWithResult(fmt.Sprintf(selectHnswIndexFilesFormat,
+			catalog.SystemSI_HNSW_TblCol_Metadata_key, catalog.SystemSI_HNSW_TblCol_Metadata_val, dbName, table,
+			catalog.SystemSI_HNSW_TblCol_Metadata_key, hnsw.MetaKeyFile, hnsw.MetaKeyDeltas, hnsw.MetaKeyGarbage), NoAccountId)
+		if err != nil {
+			return err
+		}
+		res.ReadRows(func(rows int, cols []*vector.Vector) bool {
+			for i := 0; i < rows && err == nil; i++ {
+				key, val := cols[0].GetStringAt(i), cols[1].GetStringAt(i)
+				if len(val) == 0 {
+					continue
+				}
+				switch key {
+				case hnsw.MetaKeyFile:
+					files = append(files, val)
+				case hnsw.MetaKeyDeltas:
+					files = append(files, strings.Split(val, ",")...)
+				case hnsw.MetaKeyGarbage:
+					var garbage []hnsw.Garbage
+					if garbage, err = hnsw.ParseGarbage(val); err == nil {
+						for _, g := range garbage {
+							files = append(files, g.Path)
+						}
+					}
+				}
+			}
+			return err == nil
+		})
+		res.Close()
+		if err != nil {
+			return err
+		}
+	}
+	if len(files) == 0 {
+		return nil
+	}
+	return removeHnswFilesOnCommit(c, func(ctx context.Context, fs fileservice.FileService) error {
+		return hnsw.DeleteFiles(ctx, fs, files...)
+	})
+}
+
+func hnswMetadataTables(indexDefs []*plan.IndexDef) []string {
+	var tables []string
+	for _, def := range indexDefs {
+		if catalog.IsHnswIndexAlgo(def.IndexAlgo) && def.IndexAlgoTableType == catalog.SystemSI_HNSW_TblType_Metadata {
+			tables = append(tables, def.IndexTableName)
+		}
+	}
+	return tables
+}
+
+func removeHnswFilesOnCommit(c *Compile, remove func(ctx context.Context, fs fileservice.FileService) error) error {
+	fs, err := fileservice.Get[fileservice.FileService](c.proc.GetFileService(), defines.SharedFileServiceName)
+	if err != nil {
+		return err
+	}
+	c.proc.GetTxnOperator().AppendEventCallback(client.ClosedEvent, func(event client.TxnEvent) {
+		if !event.Committed() {
+			return
+		}
+		// do not block closing the transaction
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			defer cancel()
+			if err := remove(ctx, fs); err != nil {
+				logutil.Warn("failed to remove the files of the hnsw index", zap.Error(err))
+			}
+		}()
+	})
+	return nil
+}
+
 func (s *Scope) handleIndexColCount(c *Compile, indexDef *plan.IndexDef, qryDatabase string, originalTableDef *plan.TableDef) (int64, error) {
 
 	indexColumnName := indexDef.Parts[0]
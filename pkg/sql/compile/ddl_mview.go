@@ -0,0 +1,136 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/lock"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+func (s *Scope) RefreshMaterializedView(c *Compile) error {
+	if s.ScopeAnalyzer == nil {
+		s.ScopeAnalyzer = NewScopeAnalyzer()
+	}
+	s.ScopeAnalyzer.Start()
+	defer s.ScopeAnalyzer.Stop()
+
+	qry := s.Plan.GetDdl().GetRefreshMaterializedView()
+	if err := lockMoDatabase(c, qry.GetDatabase(), lock.LockMode_Shared); err != nil {
+		return err
+	}
+	if err := lockMoTable(c, qry.GetDatabase(), qry.GetTable(), lock.LockMode_Exclusive); err != nil {
+		return err
+	}
+	return refreshMaterializedView(c, qry.GetMview())
+}
+
+// refreshMaterializedView recomputes all rows of the materialized view from its query
+func refreshMaterializedView(c *Compile, mview *plan.MaterializedViewDef) error {
+	if exists, err := materializedViewExists(c, mview); err != nil || !exists {
+		return err
+	}
+	if err := c.runSql(fmt.Sprintf(deleteMaterializedViewFormat, mview.Database, mview.Name)); err != nil {
+		return err
+	}
+	return c.runSql(fmt.Sprintf(insertMaterializedViewFormat, mview.Database, mview.Name, mview.Query))
+}
+
+// truncateMaterializedView removes all rows of the materialized view whose base table is truncated
+func truncateMaterializedView(c *Compile, mview *plan.MaterializedViewDef) error {
+	if exists, err := materializedViewExists(c, mview); err != nil || !exists {
+		return err
+	}
+	return c.runSql(fmt.Sprintf(deleteMaterializedViewFormat, mview.Database, mview.Name))
+}
+
+// materializedViewExists returns false if the view is dropped while the reference is not removed yet
+func materializedViewExists(c *Compile, mview *plan.MaterializedViewDef) (bool, error) {
+	dbSource, err := c.e.Database(c.proc.Ctx, mview.Database, c.proc.GetTxnOperator())
+	if err != nil {
+		return false, err
+	}
+	return dbSource.RelationExists(c.proc.Ctx, mview.Name, nil)
+}
+
+// addMaterializedViewRefs keeps the materialized view in the base tables, so that
+// the DML on the base tables can refresh the view incrementally
+func addMaterializedViewRefs(c *Compile, mview *plan.MaterializedViewDef) error {
+	return updateMaterializedViewRefs(c, mview, false, func(views []*plan.MaterializedViewDef) []*plan.MaterializedViewDef {
+		return append(views, mview)
+	})
+}
+
+// removeMaterializedViewRefs removes the materialized view from the base tables which still exist
+func removeMaterializedViewRefs(c *Compile, mview *plan.MaterializedViewDef) error {
+	return updateMaterializedViewRefs(c, mview, true, func(views []*plan.MaterializedViewDef) []*plan.MaterializedViewDef {
+		for i, view := range views {
+			if view.Database == mview.Database && view.Name == mview.Name {
+				return append(views[:i], views[i+1:]...)
+			}
+		}
+		return views
+	})
+}
+
+func updateMaterializedViewRefs(
+	c *Compile,
+	mview *plan.MaterializedViewDef,
+	ignoreMissing bool,
+	update func([]*plan.MaterializedViewDef) []*plan.MaterializedViewDef,
+) error {
+	for _, base := range mview.BaseTables {
+		dbSource, err := c.e.Database(c.proc.Ctx, base.SchemaName, c.proc.GetTxnOperator())
+		if err != nil {
+			if ignoreMissing {
+				continue
+			}
+			return err
+		}
+		if err = lockMoTable(c, base.SchemaName, base.ObjName, lock.LockMode_Exclusive); err != nil {
+			return err
+		}
+		rel, err := dbSource.Relation(c.proc.Ctx, base.ObjName, nil)
+		if err != nil {
+			if ignoreMissing {
+				continue
+			}
+			return err
+		}
+
+		ct, err := GetConstraintDef(c.proc.Ctx, rel)
+		if err != nil {
+			return err
+		}
+		var refDef *engine.RefMaterializedViewDef
+		for _, def := range ct.Cts {
+			if r, ok := def.(*engine.RefMaterializedViewDef); ok {
+				refDef = r
+				break
+			}
+		}
+		if refDef == nil {
+			refDef = &engine.RefMaterializedViewDef{}
+			ct.Cts = append(ct.Cts, refDef)
+		}
+		refDef.Views = update(refDef.Views)
+		if err = rel.UpdateConstraint(c.proc.Ctx, ct); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,248 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/lock"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/util/executor"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+var triggerStringEscaper = strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+
+// moTrigger is the row of the trigger in mo_catalog.mo_triggers
+type moTrigger struct {
+	tableId uint64
+	event   string
+	timing  string
+	order   uint32
+}
+
+func (s *Scope) CreateTrigger(c *Compile) error {
+	if s.ScopeAnalyzer == nil {
+		s.ScopeAnalyzer = NewScopeAnalyzer()
+	}
+	s.ScopeAnalyzer.Start()
+	defer s.ScopeAnalyzer.Stop()
+
+	qry := s.Plan.GetDdl().GetCreateTrigger()
+	dbName := qry.GetDatabase()
+	tblName := qry.GetTable()
+	trigger := qry.GetTrigger()
+
+	if err := lockMoDatabase(c, dbName, lock.LockMode_Shared); err != nil {
+		return err
+	}
+	dbSource, err := c.e.Database(c.proc.Ctx, dbName, c.proc.GetTxnOperator())
+	if err != nil {
+		return err
+	}
+	if err = lockMoTable(c, dbName, tblName, lock.LockMode_Exclusive); err != nil {
+		return err
+	}
+	rel, err := dbSource.Relation(c.proc.Ctx, tblName, nil)
+	if err != nil {
+		return err
+	}
+	databaseId := dbSource.GetDatabaseId(c.proc.Ctx)
+
+	old, err := getMoTrigger(c, databaseId, trigger.Name)
+	if err != nil {
+		return err
+	}
+	if old != nil {
+		if qry.GetIfNotExists() {
+			return nil
+		}
+		return moerr.NewTriggerAlreadyExists(c.proc.Ctx)
+	}
+
+	// the triggers of the table are kept in the order they are fired
+	ct, err := GetConstraintDef(c.proc.Ctx, rel)
+	if err != nil {
+		return err
+	}
+	var triggerDef *engine.TriggerDef
+	for _, def := range ct.Cts {
+		if t, ok := def.(*engine.TriggerDef); ok {
+			triggerDef = t
+			break
+		}
+	}
+	if triggerDef == nil {
+		triggerDef = &engine.TriggerDef{}
+		ct.Cts = append(ct.Cts, triggerDef)
+	}
+
+	pos := len(triggerDef.Triggers)
+	if qry.GetOrderTrigger() != "" {
+		pos = -1
+		for i, t := range triggerDef.Triggers {
+			if t.Name == qry.GetOrderTrigger() && t.Timing == trigger.Timing && t.Event == trigger.Event {
+				pos = i
+				break
+			}
+		}
+		if pos == -1 {
+			return moerr.NewInvalidInputf(c.proc.Ctx, "Referenced trigger '%s' for the given action time and event type does not exist.", qry.GetOrderTrigger())
+		}
+		if qry.GetFollows() {
+			pos++
+		}
+	}
+	// action_order starts from 1 for each event and timing of the table
+	order := uint32(1)
+	for _, t := range triggerDef.Triggers[:pos] {
+		if t.Timing == trigger.Timing && t.Event == trigger.Event {
+			order++
+		}
+	}
+	triggers := make([]*plan.TriggerDef, 0, len(triggerDef.Triggers)+1)
+	triggers = append(triggers, triggerDef.Triggers[:pos]...)
+	triggers = append(triggers, trigger)
+	triggerDef.Triggers = append(triggers, triggerDef.Triggers[pos:]...)
+	if err = rel.UpdateConstraint(c.proc.Ctx, ct); err != nil {
+		return err
+	}
+
+	tableId := rel.GetTableID(c.proc.Ctx)
+	if err = c.runSql(fmt.Sprintf(updateMoTriggersOrderFormat,
+		"+", tableId, trigger.Event, trigger.Timing, ">=", order)); err != nil {
+		return err
+	}
+
+	sqlMode := getTriggerSessionVariable(c, "sql_mode")
+	charset := getTriggerSessionVariable(c, "character_set_client")
+	collation := getTriggerSessionVariable(c, "collation_connection")
+	dbCollation := getTriggerSessionVariable(c, "collation_database")
+	return c.runSql(fmt.Sprintf(insertMoTriggersFormat,
+		trigger.Name,
+		databaseId,
+		tableId,
+		trigger.Event,
+		trigger.Timing,
+		order,
+		triggerStringEscaper.Replace(qry.GetActionStatement()),
+		triggerStringEscaper.Replace(trigger.Definer),
+		triggerStringEscaper.Replace(sqlMode),
+		triggerStringEscaper.Replace(charset),
+		triggerStringEscaper.Replace(collation),
+		triggerStringEscaper.Replace(dbCollation),
+	))
+}
+
+func (s *Scope) DropTrigger(c *Compile) error {
+	if s.ScopeAnalyzer == nil {
+		s.ScopeAnalyzer = NewScopeAnalyzer()
+	}
+	s.ScopeAnalyzer.Start()
+	defer s.ScopeAnalyzer.Stop()
+
+	qry := s.Plan.GetDdl().GetDropTrigger()
+	dbName := qry.GetDatabase()
+
+	if err := lockMoDatabase(c, dbName, lock.LockMode_Shared); err != nil {
+		return err
+	}
+	dbSource, err := c.e.Database(c.proc.Ctx, dbName, c.proc.GetTxnOperator())
+	if err != nil {
+		if qry.GetIfExists() {
+			return nil
+		}
+		return err
+	}
+	databaseId := dbSource.GetDatabaseId(c.proc.Ctx)
+
+	old, err := getMoTrigger(c, databaseId, qry.GetName())
+	if err != nil {
+		return err
+	}
+	if old == nil {
+		if qry.GetIfExists() {
+			return nil
+		}
+		return moerr.NewTriggerNotExist(c.proc.Ctx)
+	}
+
+	_, tblName, rel, err := c.e.GetRelationById(c.proc.Ctx, c.proc.GetTxnOperator(), old.tableId)
+	if err != nil {
+		return err
+	}
+	if err = lockMoTable(c, dbName, tblName, lock.LockMode_Exclusive); err != nil {
+		return err
+	}
+	ct, err := GetConstraintDef(c.proc.Ctx, rel)
+	if err != nil {
+		return err
+	}
+	for _, def := range ct.Cts {
+		if t, ok := def.(*engine.TriggerDef); ok {
+			for i, trigger := range t.Triggers {
+				if trigger.Name == qry.GetName() {
+					t.Triggers = append(t.Triggers[:i], t.Triggers[i+1:]...)
+					break
+				}
+			}
+		}
+	}
+	if err = rel.UpdateConstraint(c.proc.Ctx, ct); err != nil {
+		return err
+	}
+
+	if err = c.runSql(fmt.Sprintf(deleteMoTriggersWithTriggerNameFormat, databaseId, qry.GetName())); err != nil {
+		return err
+	}
+	return c.runSql(fmt.Sprintf(updateMoTriggersOrderFormat,
+		"-", old.tableId, old.event, old.timing, ">", old.order))
+}
+
+// getMoTrigger returns the row of the trigger in mo_catalog.mo_triggers, or nil if the trigger does not exist
+func getMoTrigger(c *Compile, databaseId string, name string) (*moTrigger, error) {
+	res, err := c.runSqlWithResult(fmt.Sprintf(selectMoTriggersFormat, databaseId, name), NoAccountId)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	var trigger *moTrigger
+	res.ReadRows(func(rows int, cols []*vector.Vector) bool {
+		trigger = &moTrigger{
+			tableId: executor.GetFixedRows[uint64](cols[0])[0],
+			event:   cols[1].GetStringAt(0),
+			timing:  cols[2].GetStringAt(0),
+			order:   executor.GetFixedRows[uint32](cols[3])[0],
+		}
+		return false
+	})
+	return trigger, nil
+}
+
+func getTriggerSessionVariable(c *Compile, name string) string {
+	resolveVariableFunc := c.proc.GetResolveVariableFunc()
+	if resolveVariableFunc == nil {
+		return ""
+	}
+	val, err := resolveVariableFunc(name, true, false)
+	if err != nil || val == nil {
+		return ""
+	}
+	return fmt.Sprint(val)
+}
@@ -0,0 +1,78 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// setTableTTL replaces the TTL of the table in mo_catalog.mo_ttl, a nil ttl removes it.
+// The expired rows are purged in the background by the retention task.
+func setTableTTL(c *Compile, dbName, databaseId string, tableId uint64, ttl *plan.TableTTL) error {
+	if needSkipDbs[dbName] {
+		if ttl == nil {
+			return nil
+		}
+		return moerr.NewNotSupportedf(c.proc.Ctx, "TTL on the table of database %s", dbName)
+	}
+	if err := c.runSql(fmt.Sprintf(deleteMoTtlWithTableIdFormat, tableId)); err != nil {
+		return err
+	}
+	if ttl == nil {
+		return nil
+	}
+	return c.runSql(fmt.Sprintf(insertMoTtlFormat,
+		tableId,
+		databaseId,
+		triggerStringEscaper.Replace(ttl.Column),
+		ttl.Interval,
+		ttl.Unit,
+	))
+}
+
+// checkTTLColumn returns an error if the TTL column of the table is not a time column of the
+// new table definition, the TTL must be removed before the column is dropped or changed.
+func checkTTLColumn(c *Compile, tableId uint64, newTableDef *plan.TableDef) error {
+	res, err := c.runSqlWithResult(fmt.Sprintf(selectMoTtlColumnFormat, tableId), NoAccountId)
+	if err != nil {
+		return err
+	}
+	defer res.Close()
+
+	column := ""
+	res.ReadRows(func(rows int, cols []*vector.Vector) bool {
+		column = cols[0].GetStringAt(0)
+		return false
+	})
+	if column == "" {
+		return nil
+	}
+	for _, col := range newTableDef.Cols {
+		if col.Name != column {
+			continue
+		}
+		switch types.T(col.Typ.Id) {
+		case types.T_date, types.T_datetime, types.T_timestamp:
+			return nil
+		}
+		break
+	}
+	return moerr.NewInvalidInputf(c.proc.Ctx, "column '%s' is used by the TTL of the table, remove the TTL first", column)
+}
@@ -755,8 +755,12 @@ func constructLockOp(n *plan.Node, eng engine.Engine) (*lockop.LockOp, error) {
 		typ := plan2.MakeTypeByPlan2Type(target.PrimaryColTyp)
 		if target.IsPartitionTable {
 			arg.AddLockTargetWithPartition(target.GetPartitionTableIds(), target.GetPrimaryColIdxInBat(), typ, target.GetRefreshTsIdxInBat(), target.GetLockRows(), target.GetLockTableAtTheEnd(), target.GetFilterColIdxInBat())
+			for _, pTblId := range target.PartitionTableIds {
+				arg.WithLockWaitOptions(pTblId, target.Mode, target.WaitPolicy, target.WaitSec, target.SkipLocked, target.SkipLockedLimit)
+			}
 		} else {
 			arg.AddLockTarget(target.GetTableId(), target.GetPrimaryColIdxInBat(), typ, target.GetRefreshTsIdxInBat(), target.GetLockRows(), target.GetLockTableAtTheEnd())
+			arg.WithLockWaitOptions(target.TableId, target.Mode, target.WaitPolicy, target.WaitSec, target.SkipLocked, target.SkipLockedLimit)
 		}
 
 	}
@@ -764,10 +768,10 @@ func constructLockOp(n *plan.Node, eng engine.Engine) (*lockop.LockOp, error) {
 		if target.LockTable {
 			if target.IsPartitionTable {
 				for _, pTblId := range target.PartitionTableIds {
-					arg.LockTable(pTblId, false)
+					arg.LockTableWithMode(pTblId, target.Mode, false)
 				}
 			} else {
-				arg.LockTable(target.TableId, false)
+				arg.LockTableWithMode(target.TableId, target.Mode, false)
 			}
 		}
 	}
@@ -791,11 +795,17 @@ func constructMultiUpdate(n *plan.Node, eg engine.Engine) *multi_update.MultiUpd
 			deleteCols[j] = int(col.ColPos)
 		}
 
+		insertFilterIdx := -1
+		if updateCtx.InsertFilterCol != nil {
+			insertFilterIdx = int(updateCtx.InsertFilterCol.ColPos)
+		}
+
 		arg.MultiUpdateCtx[i] = &multi_update.MultiUpdateCtx{
 			ObjRef:              updateCtx.ObjRef,
 			TableDef:            updateCtx.TableDef,
 			InsertCols:          insertCols,
 			DeleteCols:          deleteCols,
+			InsertFilterIdx:     insertFilterIdx,
 			PartitionTableIDs:   updateCtx.PartitionTableIds,
 			PartitionTableNames: updateCtx.PartitionTableNames,
 			OldPartitionIdx:     int(updateCtx.OldPartitionIdx),
@@ -1285,6 +1295,13 @@ func constructWindow(_ context.Context, n *plan.Node, proc *process.Process) *wi
 				args = f.F.Args[:len(f.F.Args)-1]
 			}
 
+			//for ntile, the only arg is the constant bucket number
+			if f.F.Func.ObjName == plan2.NameNtile {
+				buckets := f.F.Args[0].GetLit().GetI64Val()
+				cfg = types.EncodeInt64(&buckets)
+				args = nil
+			}
+
 			e = f.F.Args[0]
 		}
 		aggregationExpressions[i] = aggexec.MakeAggFunctionExpression(
@@ -2114,6 +2131,27 @@ func constructPostDml(n *plan.Node, eg engine.Engine) *postdml.PostDml {
 		}
 	}
 
+	if oldCtx.Hnsw != nil {
+		delCtx.Hnsw = &postdml.PostDmlHnswCtx{
+			SourceTableName: oldCtx.Hnsw.SourceTableName,
+			IndexTableName:  oldCtx.Hnsw.IndexTableName,
+			Parts:           oldCtx.Hnsw.Parts,
+			AlgoParams:      oldCtx.Hnsw.AlgoParams,
+		}
+	}
+
+	if oldCtx.Trigger != nil {
+		delCtx.Trigger = &postdml.PostDmlTriggerCtx{
+			Actions: oldCtx.Trigger.Actions,
+		}
+	}
+
+	if oldCtx.Mview != nil {
+		delCtx.Mview = &postdml.PostDmlMaterializedViewCtx{
+			Views: oldCtx.Mview.Views,
+		}
+	}
+
 	op := postdml.NewArgument()
 	op.PostDmlCtx = delCtx
 	return op
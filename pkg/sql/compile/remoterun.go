@@ -856,6 +856,10 @@ func convertToPipelineInstruction(op vm.Operator, proc *process.Process, ctx *sc
 			for j, pos := range muCtx.DeleteCols {
 				updateCtxList[i].DeleteCols[j].ColPos = int32(pos)
 			}
+
+			if muCtx.InsertFilterIdx >= 0 {
+				updateCtxList[i].InsertFilterCol = &plan.ColRef{ColPos: int32(muCtx.InsertFilterIdx)}
+			}
 		}
 		in.MultiUpdate = &pipeline.MultiUpdate{
 			AffectedRows:  t.GetAffectedRows(),
@@ -883,6 +887,25 @@ func convertToPipelineInstruction(op vm.Operator, proc *process.Process, ctx *sc
 			}
 			in.PostDml.FullText = fulltext
 		}
+		if t.PostDmlCtx.Hnsw != nil {
+			h := t.PostDmlCtx.Hnsw
+			in.PostDml.Hnsw = &plan.PostDmlHnswCtx{
+				SourceTableName: h.SourceTableName,
+				IndexTableName:  h.IndexTableName,
+				Parts:           h.Parts,
+				AlgoParams:      h.AlgoParams,
+			}
+		}
+		if t.PostDmlCtx.Trigger != nil {
+			in.PostDml.Trigger = &plan.PostDmlTriggerCtx{
+				Actions: t.PostDmlCtx.Trigger.Actions,
+			}
+		}
+		if t.PostDmlCtx.Mview != nil {
+			in.PostDml.Mview = &plan.PostDmlMaterializedViewCtx{
+				Views: t.PostDmlCtx.Mview.Views,
+			}
+		}
 	default:
 		return -1, nil, moerr.NewInternalErrorNoCtx(fmt.Sprintf("unexpected operator: %v", op.OpType()))
 	}
@@ -944,10 +967,11 @@ func convertToVmOperator(opr *pipeline.Instruction, ctx *scopeContext, eng engin
 		for _, target := range t.Targets {
 			typ := plan2.MakeTypeByPlan2Type(target.PrimaryColTyp)
 			lockArg.AddLockTarget(target.GetTableId(), target.GetPrimaryColIdxInBat(), typ, target.GetRefreshTsIdxInBat(), target.GetLockRows(), target.GetLockTableAtTheEnd())
+			lockArg.WithLockWaitOptions(target.TableId, target.Mode, target.WaitPolicy, target.WaitSec, target.SkipLocked, target.SkipLockedLimit)
 		}
 		for _, target := range t.Targets {
 			if target.LockTable {
-				lockArg.LockTable(target.TableId, target.ChangeDef)
+				lockArg.LockTableWithMode(target.TableId, target.Mode, target.ChangeDef)
 			}
 		}
 		op = lockArg
@@ -1393,6 +1417,11 @@ func convertToVmOperator(opr *pipeline.Instruction, ctx *scopeContext, eng engin
 			for j, pos := range muCtx.DeleteCols {
 				arg.MultiUpdateCtx[i].DeleteCols[j] = int(pos.ColPos)
 			}
+
+			arg.MultiUpdateCtx[i].InsertFilterIdx = -1
+			if muCtx.InsertFilterCol != nil {
+				arg.MultiUpdateCtx[i].InsertFilterIdx = int(muCtx.InsertFilterCol.ColPos)
+			}
 		}
 
 		op = arg
@@ -1420,6 +1449,28 @@ func convertToVmOperator(opr *pipeline.Instruction, ctx *scopeContext, eng engin
 			}
 			arg.PostDmlCtx.FullText = fulltext
 		}
+
+		if t.Hnsw != nil {
+			h := t.Hnsw
+			arg.PostDmlCtx.Hnsw = &postdml.PostDmlHnswCtx{
+				SourceTableName: h.SourceTableName,
+				IndexTableName:  h.IndexTableName,
+				Parts:           h.Parts,
+				AlgoParams:      h.AlgoParams,
+			}
+		}
+
+		if t.Trigger != nil {
+			arg.PostDmlCtx.Trigger = &postdml.PostDmlTriggerCtx{
+				Actions: t.Trigger.Actions,
+			}
+		}
+
+		if t.Mview != nil {
+			arg.PostDmlCtx.Mview = &postdml.PostDmlMaterializedViewCtx{
+				Views: t.Mview.Views,
+			}
+		}
 		op = arg
 	default:
 		return op, moerr.NewInternalErrorNoCtx(fmt.Sprintf("unexpected operator: %v", opr.Op))
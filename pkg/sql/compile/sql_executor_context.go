@@ -455,6 +455,11 @@ func (c *compilerContext) getTableDef(
 	var partitionInfo *plan.PartitionByDef
 	var viewSql *plan.ViewDef
 	var foreignKeys []*plan.ForeignKeyDef
+	var checks []*planpb.CheckDef
+	var triggers []*planpb.TriggerDef
+	var mview *planpb.MaterializedViewDef
+	var refMviews []*planpb.MaterializedViewDef
+	var generatedCols *engine.GeneratedColDef
 	var primarykey *plan.PrimaryKeyDef
 	var indexes []*plan.IndexDef
 	var refChildTbls []uint64
@@ -521,6 +526,16 @@ func (c *compilerContext) getTableDef(
 					indexes = k.Indexes
 				case *engine.ForeignKeyDef:
 					foreignKeys = k.Fkeys
+				case *engine.CheckDef:
+					checks = k.Checks
+				case *engine.TriggerDef:
+					triggers = k.Triggers
+				case *engine.MaterializedViewDef:
+					mview = k.View
+				case *engine.RefMaterializedViewDef:
+					refMviews = k.Views
+				case *engine.GeneratedColDef:
+					generatedCols = k
 				case *engine.RefChildTableDef:
 					refChildTbls = k.Tables
 				case *engine.PrimaryKeyDef:
@@ -573,6 +588,9 @@ func (c *compilerContext) getTableDef(
 		SubscriptionName: subscriptionName,
 	}
 
+	if generatedCols != nil {
+		generatedCols.SetColDefs(cols)
+	}
 	tableDef := &plan.TableDef{
 		TblId:        tableId,
 		Name:         tableName,
@@ -584,6 +602,10 @@ func (c *compilerContext) getTableDef(
 		ViewSql:      viewSql,
 		Partition:    partitionInfo,
 		Fkeys:        foreignKeys,
+		Checks:       checks,
+		Triggers:     triggers,
+		Mview:        mview,
+		RefMviews:    refMviews,
 		RefChildTbls: refChildTbls,
 		ClusterBy:    clusterByDef,
 		Indexes:      indexes,
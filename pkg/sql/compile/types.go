@@ -67,6 +67,9 @@ const (
 	DropSequence
 	AlterSequence
 	Replace
+	CreateTrigger
+	DropTrigger
+	RefreshMaterializedView
 )
 
 func (m magicType) String() string {
@@ -109,6 +112,12 @@ func (m magicType) String() string {
 		return "AlterSequence"
 	case Replace:
 		return "Replace"
+	case CreateTrigger:
+		return "CreateTrigger"
+	case DropTrigger:
+		return "DropTrigger"
+	case RefreshMaterializedView:
+		return "RefreshMaterializedView"
 	default:
 		return "Unknown"
 	}
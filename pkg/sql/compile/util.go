@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/hnsw"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
@@ -93,6 +94,33 @@ var (
 	updateMoIndexesAlgoParams                           = `update mo_catalog.mo_indexes set algo_params = '%s' where table_id = %v and name = '%s';`
 )
 
+var (
+	selectMoTriggersFormat                = `select table_id, event_manipulation, action_timing, action_order from mo_catalog.mo_triggers where database_id = %v and trigger_name = '%s';`
+	insertMoTriggersFormat                = `insert into mo_catalog.mo_triggers values ('%s', %v, %v, '%s', '%s', %v, '%s', '%s', now(), '%s', '%s', '%s', '%s');`
+	deleteMoTriggersWithDatabaseIdFormat  = `delete from mo_catalog.mo_triggers where database_id = %v;`
+	deleteMoTriggersWithTableIdFormat     = `delete from mo_catalog.mo_triggers where table_id = %v;`
+	deleteMoTriggersWithTriggerNameFormat = `delete from mo_catalog.mo_triggers where database_id = %v and trigger_name = '%s';`
+	updateMoTriggersOrderFormat           = `update mo_catalog.mo_triggers set action_order = action_order %s 1 where table_id = %v and event_manipulation = '%s' and action_timing = '%s' and action_order %s %v;`
+	updateMoTriggersTableIdFormat         = `update mo_catalog.mo_triggers set table_id = %v where table_id = %v;`
+)
+
+var (
+	selectMoTtlColumnFormat      = `select ttl_column from mo_catalog.mo_ttl where table_id = %v;`
+	insertMoTtlFormat            = `insert into mo_catalog.mo_ttl values (%v, %v, '%s', %v, '%s', null);`
+	deleteMoTtlWithTableIdFormat = `delete from mo_catalog.mo_ttl where table_id = %v;`
+	updateMoTtlTableIdFormat     = `update mo_catalog.mo_ttl set table_id = %v where table_id = %v;`
+)
+
+var (
+	deleteMoEventsWithDatabaseIdFormat   = `delete from mo_catalog.mo_events where database_id = %v;`
+	deleteMoEventLogWithDatabaseIdFormat = `delete from mo_catalog.mo_event_log where database_id = %v;`
+)
+
+var (
+	deleteMaterializedViewFormat = "delete from `%s`.`%s`;"
+	insertMaterializedViewFormat = "insert into `%s`.`%s` select * from (%s) as mv;"
+)
+
 var (
 	dropTableBeforeDropDatabase = "drop table if exists `%v`.`%v`;"
 )
@@ -105,6 +133,8 @@ var (
 
 var (
 	insertIntoFullTextIndexTableFormat = "INSERT INTO `%s`.`%s` SELECT f.* FROM `%s`.`%s` AS %s CROSS APPLY fulltext_index_tokenize('%s', %s, %s) AS f;"
+	insertIntoHnswIndexTableFormat     = "INSERT INTO `%s`.`%s` SELECT f.* FROM hnsw_index_update('%s', '') AS f ON DUPLICATE KEY UPDATE `%s` = VALUES(`%s`);"
+	selectHnswIndexFilesFormat         = "SELECT `%s`, `%s` FROM `%s`.`%s` WHERE `%s` IN ('%s', '%s', '%s');"
 )
 
 // genInsertIndexTableSql: Generate an insert statement for inserting data into the index table
@@ -535,6 +565,21 @@ func GetConstraintDefFromTableDefs(defs []engine.TableDef) *engine.ConstraintDef
 	return cstrDef
 }
 
+// genInsertIndexTableSqlForHnswIndex generates the SQL to build the hnsw graph from the whole table
+// and save the file of the graph into the metadata table
+func genInsertIndexTableSqlForHnswIndex(originalTableDef *plan.TableDef, indexDef *plan.IndexDef, qryDatabase string) (string, error) {
+	cfg, err := hnsw.NewConfig(qryDatabase, originalTableDef.Name, indexDef.IndexTableName,
+		originalTableDef.Pkey.PkeyColName, indexDef.Parts[0], indexDef.IndexAlgoParams)
+	if err != nil {
+		return "", err
+	}
+	cfgStr := strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(cfg.String())
+	return fmt.Sprintf(insertIntoHnswIndexTableFormat,
+		qryDatabase, indexDef.IndexTableName,
+		cfgStr,
+		catalog.SystemSI_HNSW_TblCol_Metadata_val, catalog.SystemSI_HNSW_TblCol_Metadata_val), nil
+}
+
 func genInsertIndexTableSqlForFullTextIndex(originalTableDef *plan.TableDef, indexDef *plan.IndexDef, qryDatabase string) []string {
 	src_alias := "src"
 	pkColName := src_alias + "." + originalTableDef.Pkey.PkeyColName
@@ -25,9 +25,11 @@ func init() {
 		"modify":                     MODIFY,
 		"action":                     ACTION,
 		"against":                    AGAINST,
+		"array":                      ARRAY,
 		"all":                        ALL,
 		"alter":                      ALTER,
 		"algorithm":                  ALGORITHM,
+		"always":                     ALWAYS,
 		"analyze":                    ANALYZE,
 		"phyplan":                    PHYPLAN,
 		"and":                        AND,
@@ -42,7 +44,7 @@ func init() {
 		"avg_row_length":             AVG_ROW_LENGTH,
 		"avg":                        AVG,
 		"bsi":                        BSI,
-		"before":                     UNUSED,
+		"before":                     BEFORE,
 		"begin":                      BEGIN,
 		"between":                    BETWEEN,
 		"bigint":                     BIGINT,
@@ -58,6 +60,7 @@ func init() {
 		"by":                         BY,
 		"btree":                      BTREE,
 		"ivfflat":                    IVFFLAT,
+		"hnsw":                       HNSW,
 		"bit_or":                     BIT_OR,
 		"bit_and":                    BIT_AND,
 		"call":                       CALL,
@@ -87,10 +90,10 @@ func init() {
 		"committed":                  COMMITTED,
 		"commit":                     COMMIT,
 		"compact":                    COMPACT,
-		"condition":                  UNUSED,
+		"condition":                  CONDITION,
 		"constraint":                 CONSTRAINT,
 		"consistent":                 CONSISTENT,
-		"continue":                   UNUSED,
+		"continue":                   CONTINUE,
 		"connection":                 CONNECTION,
 		"connect":                    CONNECT,
 		"convert":                    CONVERT,
@@ -120,7 +123,7 @@ func init() {
 		"current_user":               CURRENT_USER,
 		"current_role":               CURRENT_ROLE,
 		"curtime":                    CURTIME,
-		"cursor":                     UNUSED,
+		"cursor":                     CURSOR,
 		"daemon":                     DAEMON,
 		"database":                   DATABASE,
 		"databases":                  DATABASES,
@@ -161,7 +164,7 @@ func init() {
 		"duplicate":                  DUPLICATE,
 		"delay_key_write":            DELAY_KEY_WRITE,
 		"drainer":                    DRAINER,
-		"each":                       UNUSED,
+		"each":                       EACH,
 		"else":                       ELSE,
 		"elseif":                     ELSEIF,
 		"enclosed":                   ENCLOSED,
@@ -173,7 +176,7 @@ func init() {
 		"escape":                     ESCAPE,
 		"escaped":                    ESCAPED,
 		"exists":                     EXISTS,
-		"exit":                       UNUSED,
+		"exit":                       EXIT,
 		"explain":                    EXPLAIN,
 		"expansion":                  EXPANSION,
 		"extended":                   EXTENDED,
@@ -185,7 +188,7 @@ func init() {
 		"events":                     EVENTS,
 		"engines":                    ENGINES,
 		"false":                      FALSE,
-		"fetch":                      UNUSED,
+		"fetch":                      FETCH,
 		"first":                      FIRST,
 		"after":                      AFTER,
 		"float":                      FLOAT_TYPE,
@@ -202,10 +205,10 @@ func init() {
 		"fields":                     FIELDS,
 		"file":                       FILE,
 		"fixed":                      FIXED,
-		"generated":                  UNUSED,
+		"generated":                  GENERATED,
 		"geometry":                   GEOMETRY,
 		"geometrycollection":         GEOMETRYCOLLECTION,
-		"get":                        UNUSED,
+		"get":                        GET,
 		"global":                     GLOBAL,
 		"grant":                      GRANT,
 		"grants":                     GRANTS,
@@ -266,6 +269,36 @@ func init() {
 		"list":                       LIST,
 		"lists":                      LISTS,
 		"op_type":                    OP_TYPE,
+		"m":                          M,
+		"ef_construction":            EF_CONSTRUCTION,
+		"ef_search":                  EF_SEARCH,
+		"ngram_token_size":           NGRAM_TOKEN_SIZE,
+		"json_table":                 JSON_TABLE,
+		"ordinality":                 ORDINALITY,
+		"nested":                     NESTED,
+		"close":                      CLOSE,
+		"found":                      FOUND,
+		"diagnostics":                DIAGNOSTICS,
+		"stacked":                    STACKED,
+		"follows":                    FOLLOWS,
+		"precedes":                   PRECEDES,
+		"materialized":               MATERIALIZED,
+		"schedule":                   SCHEDULE,
+		"ttl":                        TTL,
+		"remove":                     REMOVE,
+		"matched":                    MATCHED,
+		"completion":                 COMPLETION,
+		"preserve":                   PRESERVE,
+		"starts":                     STARTS,
+		"ends":                       ENDS,
+		"every":                      EVERY,
+		"at":                         AT,
+		"refresh":                    REFRESH,
+		"path":                       PATH,
+		"error":                      ERROR,
+		"empty":                      EMPTY,
+		"stopwords":                  STOPWORDS,
+		"stemmer":                    STEMMER,
 		"reindex":                    REINDEX,
 		"limit":                      LIMIT,
 		"linear":                     LINEAR,
@@ -278,6 +311,7 @@ func init() {
 		"localtime":                  LOCALTIME,
 		"localtimestamp":             LOCALTIMESTAMP,
 		"lock":                       LOCK,
+		"locked":                     LOCKED,
 		"locks":                      LOCKS,
 		"logservice":                 LOGSERVICE,
 		"long":                       UNUSED,
@@ -300,6 +334,7 @@ func init() {
 		"month":                      MONTH,
 		"mode":                       MODE,
 		"memory":                     MEMORY,
+		"member":                     MEMBER,
 		"modifies":                   UNUSED,
 		"multilinestring":            MULTILINESTRING,
 		"multipoint":                 MULTIPOINT,
@@ -317,6 +352,7 @@ func init() {
 		"never":                      NEVER,
 		"not":                        NOT,
 		"no":                         NO,
+		"nowait":                     NOWAIT,
 		"node":                       NODE,
 		"no_write_to_binlog":         UNUSED,
 		"null":                       NULL,
@@ -326,6 +362,7 @@ func init() {
 		"shared":                     SHARED,
 		"exclusive":                  EXCLUSIVE,
 		"offset":                     OFFSET,
+		"of":                         OF,
 		"on":                         ON,
 		"only":                       ONLY,
 		"optimize":                   OPTIMIZE,
@@ -389,7 +426,7 @@ func init() {
 		"replicas":                   REPLICAS,
 		"replication":                REPLICATION,
 		"require":                    REQUIRE,
-		"resignal":                   UNUSED,
+		"resignal":                   RESIGNAL,
 		"restrict":                   RESTRICT,
 		"resume":                     RESUME,
 		"recursive":                  RECURSIVE,
@@ -420,18 +457,19 @@ func init() {
 		"sets":                       SETS,
 		"settings":                   SETTINGS,
 		"share":                      SHARE,
+		"skip":                       SKIP,
 		"show":                       SHOW,
 		"shutdown":                   SHUTDOWN,
-		"signal":                     UNUSED,
+		"signal":                     SIGNAL,
 		"signed":                     SIGNED,
 		"simple":                     SIMPLE,
 		"smallint":                   SMALLINT,
 		"spatial":                    SPATIAL,
 		"specific":                   UNUSED,
 		"sql":                        SQL,
-		"sqlexception":               UNUSED,
-		"sqlstate":                   UNUSED,
-		"sqlwarning":                 UNUSED,
+		"sqlexception":               SQLEXCEPTION,
+		"sqlstate":                   SQLSTATE,
+		"sqlwarning":                 SQLWARNING,
 		"sql_big_result":             SQL_BIG_RESULT,
 		"sql_cache":                  SQL_CACHE,
 		"sql_calc_found_rows":        SQL_CALC_FOUND_ROWS,
@@ -447,7 +485,7 @@ func init() {
 		"stats_auto_recalc":          STATS_AUTO_RECALC,
 		"stats_persistent":           STATS_PERSISTENT,
 		"stats_sample_pages":         STATS_SAMPLE_PAGES,
-		"stored":                     UNUSED,
+		"stored":                     STORED,
 		"storage":                    STORAGE,
 		"stores":                     STORES,
 		"straight_join":              STRAIGHT_JOIN,
@@ -499,9 +537,10 @@ func init() {
 		"varchar":                    VARCHAR,
 		"varcharacter":               UNUSED,
 		"varying":                    UNUSED,
-		"virtual":                    UNUSED,
+		"virtual":                    VIRTUAL,
 		"view":                       VIEW,
 		"visible":                    VISIBLE,
+		"wait":                       WAIT,
 		"week":                       WEEK,
 		"when":                       WHEN,
 		"where":                      WHERE,
@@ -573,6 +612,15 @@ func init() {
 		"prepare":                    PREPARE,
 		"deallocate":                 DEALLOCATE,
 		"dense_rank":                 DENSE_RANK,
+		"percent_rank":               PERCENT_RANK,
+		"cume_dist":                  CUME_DIST,
+		"ntile":                      NTILE,
+		"lag":                        LAG,
+		"lead":                       LEAD,
+		"first_value":                FIRST_VALUE,
+		"last_value":                 LAST_VALUE,
+		"nth_value":                  NTH_VALUE,
+		"respect":                    RESPECT,
 		"reset":                      RESET,
 		"intersect":                  INTERSECT,
 		"minus":                      MINUS,
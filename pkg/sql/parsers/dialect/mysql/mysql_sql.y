@@ -92,6 +92,9 @@ import (
     identifierList tree.IdentifierList
     joinCond tree.JoinCond
     selectLockInfo *tree.SelectLockInfo
+    jsonTableColumn *tree.JsonTableColumn
+    jsonTableColumns []*tree.JsonTableColumn
+    jsonTableOnResponse *tree.JsonTableOnResponse
 
     columnType *tree.T
     unresolvedName *tree.UnresolvedName
@@ -183,6 +186,22 @@ import (
     connectorOptional bool
     fullOpt bool
     boolVal bool
+    conditionValue *tree.ConditionValue
+    conditionValues []*tree.ConditionValue
+    handlerAction tree.HandlerAction
+    signalInfoItem *tree.SignalInfoItem
+    signalInfoItems []*tree.SignalInfoItem
+    diagnosticsItem *tree.DiagnosticsItem
+    diagnosticsItems []*tree.DiagnosticsItem
+    triggerTiming tree.TriggerTiming
+    triggerEvent tree.TriggerEvent
+    triggerOrder *tree.TriggerOrder
+    eventSchedule *tree.EventSchedule
+    eventCompletion tree.EventCompletion
+    eventStatus tree.EventStatus
+    eventComment *string
+    mergeWhen *tree.MergeWhen
+    mergeWhens []*tree.MergeWhen
     int64Val int64
     strs []string
 
@@ -269,7 +288,7 @@ import (
 }
 
 %token LEX_ERROR
-%nonassoc EMPTY
+%nonassoc <str> EMPTY
 %left <str> UNION EXCEPT INTERSECT MINUS
 %nonassoc LOWER_THAN_ORDER
 %nonassoc ORDER
@@ -280,7 +299,7 @@ import (
 %token <str> ALL DISTINCT DISTINCTROW AS EXISTS ASC DESC INTO DUPLICATE DEFAULT LOCK KEYS NULLS FIRST LAST AFTER
 %token <str> INSTANT INPLACE COPY DISABLE ENABLE UNDEFINED MERGE TEMPTABLE DEFINER INVOKER SQL SECURITY CASCADED
 %token <str> VALUES
-%token <str> NEXT VALUE SHARE MODE
+%token <str> NEXT VALUE SHARE MODE NOWAIT WAIT SKIP LOCKED
 %token <str> SQL_NO_CACHE SQL_CACHE
 %left <str> JOIN STRAIGHT_JOIN LEFT RIGHT INNER OUTER CROSS NATURAL USE FORCE CROSS_L2 APPLY DEDUP
 %nonassoc LOWER_THAN_ON
@@ -346,6 +365,7 @@ import (
 %token <str> MAX_ROWS MIN_ROWS PACK_KEYS ROW_FORMAT STATS_AUTO_RECALC STATS_PERSISTENT STATS_SAMPLE_PAGES
 %token <str> DYNAMIC COMPRESSED REDUNDANT COMPACT FIXED COLUMN_FORMAT AUTO_RANDOM ENGINE_ATTRIBUTE SECONDARY_ENGINE_ATTRIBUTE INSERT_METHOD
 %token <str> RESTRICT CASCADE ACTION PARTIAL SIMPLE CHECK ENFORCED
+%token <str> GENERATED ALWAYS STORED VIRTUAL
 %token <str> RANGE LIST ALGORITHM LINEAR PARTITIONS SUBPARTITION SUBPARTITIONS CLUSTER
 %token <str> TYPE ANY SOME EXTERNAL LOCALFILE URL
 %token <str> PREPARE DEALLOCATE RESET
@@ -362,8 +382,31 @@ import (
 %token <str> PROPERTIES
 
 // Secondary Index
-%token <str> PARSER VISIBLE INVISIBLE BTREE HASH RTREE BSI IVFFLAT MASTER
+%token <str> PARSER VISIBLE INVISIBLE BTREE HASH RTREE BSI IVFFLAT MASTER HNSW
 %token <str> ZONEMAP LEADING BOTH TRAILING UNKNOWN LISTS OP_TYPE REINDEX
+%token <str> M EF_CONSTRUCTION EF_SEARCH
+%token <str> NGRAM_TOKEN_SIZE STOPWORDS STEMMER
+
+// JSON_TABLE
+%token <str> JSON_TABLE ORDINALITY NESTED PATH ERROR
+
+// Stored Procedure Condition Handling
+%token <str> CURSOR FETCH CLOSE CONTINUE EXIT SQLSTATE SQLWARNING SQLEXCEPTION CONDITION SIGNAL RESIGNAL GET FOUND DIAGNOSTICS STACKED
+
+// Trigger
+%token <str> BEFORE EACH FOLLOWS PRECEDES
+
+// Materialized View
+%token <str> MATERIALIZED REFRESH
+
+// Event
+%token <str> SCHEDULE COMPLETION PRESERVE STARTS ENDS EVERY AT
+
+// TTL
+%token <str> TTL REMOVE
+
+// Merge
+%token <str> MATCHED
 
 
 // Alter
@@ -442,13 +485,14 @@ import (
 %token <str> STDDEV_POP STDDEV_SAMP SUBDATE SUBSTR SUBSTRING SUM SYSDATE
 %token <str> SYSTEM_USER TRANSLATE TRIM VARIANCE VAR_POP VAR_SAMP AVG RANK ROW_NUMBER
 %token <str> DENSE_RANK BIT_CAST
+%token <str> LAG LEAD FIRST_VALUE LAST_VALUE NTH_VALUE NTILE PERCENT_RANK CUME_DIST RESPECT
 %token <str> BITMAP_BIT_POSITION BITMAP_BUCKET_NUMBER BITMAP_COUNT BITMAP_CONSTRUCT_AGG BITMAP_OR_AGG
 
 // Sequence function
 %token <str> NEXTVAL SETVAL CURRVAL LASTVAL
 
 //JSON function
-%token <str> ARROW
+%token <str> ARROW LONG_ARROW MEMBER OF ARRAY
 
 // Insert
 %token <str> ROW OUTFILE HEADER MAX_FILE_SIZE FORCE_QUOTE PARALLEL STRICT
@@ -523,6 +567,34 @@ import (
 %type <statement> replace_stmt
 %type <statement> do_stmt
 %type <statement> declare_stmt
+%type <statement> open_cursor_stmt fetch_cursor_stmt close_cursor_stmt signal_stmt get_diagnostics_stmt
+%type <conditionValue> condition_value sqlstate_value signal_condition_opt
+%type <conditionValues> condition_value_list
+%type <handlerAction> handler_action
+%type <signalInfoItem> signal_info_item
+%type <signalInfoItems> signal_info_list signal_info_opt
+%type <diagnosticsItem> diagnostics_item
+%type <diagnosticsItems> diagnostics_item_list
+%type <str> diagnostics_target
+%type <boolVal> diagnostics_area_opt
+%type <expr> diagnostics_condition_number
+%type <statement> create_trigger_stmt drop_trigger_stmt trigger_body
+%type <statement> refresh_stmt
+%type <usernameRecord> trigger_definer_opt
+%type <unresolvedObjectName> trigger_name
+%type <triggerTiming> trigger_timing
+%type <triggerEvent> trigger_event
+%type <triggerOrder> trigger_order_opt
+%type <statement> create_event_stmt alter_event_stmt drop_event_stmt event_body event_do_opt
+%type <eventSchedule> event_schedule
+%type <eventCompletion> event_completion_opt event_completion
+%type <eventStatus> event_status_opt
+%type <eventComment> event_comment_opt
+%type <statement> merge_stmt
+%type <mergeWhen> merge_when
+%type <mergeWhens> merge_when_list
+%type <expr> merge_cond_opt
+%type <unresolvedObjectName> event_rename_opt
 %type <statement> values_stmt
 %type <statement> call_stmt
 %type <statement> mo_dump_stmt
@@ -535,7 +607,7 @@ import (
 %type <exportParm> export_data_param_opt
 %type <loadParam> load_param_opt load_param_opt_2
 %type <tailParam> tail_param_opt
-%type <str> json_type_opt
+%type <str> json_type_opt export_format_opt export_compression_opt
 
 // case statement
 %type <statement> case_stmt
@@ -602,6 +674,9 @@ import (
 %type <tableNames> table_name_list alter_publication_table_opt
 %type <columnTableDef> column_def
 %type <columnType> mo_cast_type mysql_cast_type
+%type <jsonTableColumn> json_table_column json_table_on_opt
+%type <jsonTableColumns> json_table_column_list
+%type <jsonTableOnResponse> json_table_on_response
 %type <columnType> column_type char_type spatial_type time_type numeric_type decimal_type int_type as_datatype_opt
 %type <str> integer_opt
 %type <columnAttribute> column_attribute_elem keys
@@ -699,7 +774,7 @@ import (
 %type <updateExprs> update_list on_duplicate_key_update_opt
 %type <completionType> completion_type
 %type <str> password_opt
-%type <boolVal> grant_option_opt enforce enforce_opt
+%type <boolVal> grant_option_opt enforce enforce_opt generated_storage_opt
 
 %type <varAssignmentExpr> var_assignment
 %type <varAssignmentExprs> var_assignment_list
@@ -718,6 +793,7 @@ import (
 %type <clusterByOption> cluster_by_opt
 %type <partitionBy> partition_method sub_partition_method sub_partition_opt
 %type <windowSpec> window_spec_opt window_spec
+%type <boolVal> null_treatment_opt from_first_last_opt
 %type <frameClause> window_frame_clause window_frame_clause_opt
 %type <frameBound> frame_bound frame_bound_start
 %type <frameType> frame_type
@@ -888,6 +964,11 @@ block_type_stmt:
 |   leave_stmt
 |   normal_stmt
 |   declare_stmt
+|   open_cursor_stmt
+|   fetch_cursor_stmt
+|   close_cursor_stmt
+|   signal_stmt
+|   get_diagnostics_stmt
     {
         $$ = $1
     }
@@ -918,6 +999,7 @@ normal_stmt:
 |   mo_dump_stmt
 |   insert_stmt
 |   replace_stmt
+|   merge_stmt
 |   delete_stmt
 |   drop_stmt
 |   truncate_table_stmt
@@ -950,7 +1032,16 @@ normal_stmt:
 |   pause_cdc_stmt
 |   resume_cdc_stmt
 |   restart_cdc_stmt
+|   refresh_stmt
+
 
+refresh_stmt:
+    REFRESH MATERIALIZED VIEW table_name
+    {
+        $$ = &tree.RefreshMaterializedView{
+            Name: *$4,
+        }
+    }
 
 backup_stmt:
     BACKUP STRING FILESYSTEM STRING PARALLELISM STRING backup_type_opt backup_timestamp_opt
@@ -2896,6 +2987,72 @@ update_no_with_stmt:
         }
     }
 
+merge_stmt:
+    MERGE INTO aliased_table_name USING table_factor ON expression merge_when_list
+    {
+        $$ = &tree.Merge{
+            Table: $3,
+            Source: $5,
+            On: $7,
+            Whens: $8,
+        }
+    }
+
+merge_when_list:
+    merge_when
+    {
+        $$ = []*tree.MergeWhen{$1}
+    }
+|   merge_when_list merge_when
+    {
+        $$ = append($1, $2)
+    }
+
+merge_when:
+    WHEN MATCHED merge_cond_opt THEN UPDATE SET update_list
+    {
+        $$ = &tree.MergeWhen{
+            Matched: true,
+            Cond: $3,
+            Action: tree.MergeUpdate,
+            Exprs: $7,
+        }
+    }
+|   WHEN MATCHED merge_cond_opt THEN DELETE
+    {
+        $$ = &tree.MergeWhen{
+            Matched: true,
+            Cond: $3,
+            Action: tree.MergeDelete,
+        }
+    }
+|   WHEN NOT MATCHED merge_cond_opt THEN INSERT VALUES '(' data_values ')'
+    {
+        $$ = &tree.MergeWhen{
+            Cond: $4,
+            Action: tree.MergeInsert,
+            Values: $9,
+        }
+    }
+|   WHEN NOT MATCHED merge_cond_opt THEN INSERT '(' insert_column_list ')' VALUES '(' data_values ')'
+    {
+        $$ = &tree.MergeWhen{
+            Cond: $4,
+            Action: tree.MergeInsert,
+            Columns: $8,
+            Values: $12,
+        }
+    }
+
+merge_cond_opt:
+    {
+        $$ = nil
+    }
+|   AND expression
+    {
+        $$ = $2
+    }
+
 update_list:
     update_value
     {
@@ -3252,6 +3409,7 @@ alter_stmt:
 |   alter_stage_stmt
 |   alter_sequence_stmt
 |   alter_pitr_stmt
+|   alter_event_stmt
 |   rename_stmt
 // |    alter_ddl_stmt
 
@@ -3452,6 +3610,10 @@ alter_option:
         opt := tree.NewAlterOptionAdd(def)
         $$ = tree.AlterTableOption(opt)
     }
+|   REMOVE TTL
+    {
+        $$ = tree.AlterTableOption(tree.NewAlterOptionRemoveTTL())
+    }
 |    MODIFY column_keyword_opt column_def column_position
     {
         var typ  = tree.AlterTableModifyColumn
@@ -3700,6 +3862,12 @@ alter_table_drop:
                 Name: tree.Identifier($2.Compare()),
             }
         }
+|   CHECK ident
+    {
+        var dropType = tree.AlterTableDropCheck;
+        var name = tree.Identifier($2.Compare());
+        $$ = tree.NewAlterOptionDrop(dropType, name);
+    }
 |   PRIMARY KEY
     {
         var dropType = tree.AlterTableDropPrimaryKey;
@@ -3726,6 +3894,12 @@ alter_table_alter:
         var name = tree.Identifier($2.Compare())
         $$ = tree.NewAlterOptionAlterReIndex(name, keyType, algoParamList)
     }
+| REINDEX ident HNSW
+    {
+        var keyType = tree.INDEX_TYPE_HNSW
+        var name = tree.Identifier($2.Compare())
+        $$ = tree.NewAlterOptionAlterReIndex(name, keyType, 0)
+    }
 |   CHECK ident enforce
     {
         var checkType = $1
@@ -4560,6 +4734,8 @@ drop_ddl_stmt:
 |   drop_sequence_stmt
 |   drop_publication_stmt
 |   drop_procedure_stmt
+|   drop_trigger_stmt
+|   drop_event_stmt
 |   drop_stage_stmt
 |   drop_connector_stmt
 |   drop_snapshot_stmt
@@ -4643,6 +4819,14 @@ drop_table_stmt:
         var names = $4
         $$ = tree.NewDropTable(ifExists, names)
     }
+|   DROP MATERIALIZED VIEW exists_opt table_name_list
+    {
+        var ifExists = $4
+        var names = $5
+        t := tree.NewDropTable(ifExists, names)
+        t.IsMaterializedView = true
+        $$ = t
+    }
 
 drop_connector_stmt:
     DROP CONNECTOR exists_opt table_name_list
@@ -4688,6 +4872,24 @@ drop_function_stmt:
         $$ = tree.NewDropFunction(name, args)
     }
 
+drop_trigger_stmt:
+    DROP TRIGGER exists_opt trigger_name
+    {
+        $$ = &tree.DropTrigger{
+            IfExists: $3,
+            Name: $4,
+        }
+    }
+
+drop_event_stmt:
+    DROP EVENT exists_opt trigger_name
+    {
+        $$ = &tree.DropEvent{
+            IfExists: $3,
+            Name: $4,
+        }
+    }
+
 drop_procedure_stmt:
     DROP PROCEDURE proc_name
     {
@@ -5082,7 +5284,7 @@ export_data_param_opt:
     {
         $$ = nil
     }
-|   INTO OUTFILE STRING export_fields export_lines_opt header_opt max_file_size_opt force_quote_opt
+|   INTO OUTFILE STRING export_fields export_lines_opt header_opt max_file_size_opt force_quote_opt export_format_opt export_compression_opt
     {
         $$ = &tree.ExportParam{
             Outfile:    true,
@@ -5092,6 +5294,8 @@ export_data_param_opt:
             Header:     $6,
             MaxFileSize:uint64($7)*1024,
             ForceQuote: $8,
+            FileFormat: $9,
+            Compression:$10,
         }
     }
 
@@ -5214,6 +5418,23 @@ force_quote_opt:
         $$ = $3
     }
 
+export_format_opt:
+    {
+        $$ = ""
+    }
+|   FORMAT STRING
+    {
+        $$ = strings.ToLower($2)
+    }
+
+export_compression_opt:
+    {
+        $$ = ""
+    }
+|   COMPRESSION STRING
+    {
+        $$ = strings.ToLower($2)
+    }
 
 force_quote_list:
     ident
@@ -5486,6 +5707,49 @@ select_lock_opt:
             LockType:tree.SelectLockForUpdate,
         }
     }
+|   FOR UPDATE NOWAIT
+    {
+        $$ = &tree.SelectLockInfo{
+            LockType:tree.SelectLockForUpdateNoWait,
+        }
+    }
+|   FOR UPDATE WAIT INTEGRAL
+    {
+        $$ = &tree.SelectLockInfo{
+            LockType:tree.SelectLockForUpdateWaitN,
+            WaitSec:uint64($4.(int64)),
+        }
+    }
+|   FOR UPDATE SKIP LOCKED
+    {
+        $$ = &tree.SelectLockInfo{
+            LockType:tree.SelectLockForUpdateSkipLocked,
+        }
+    }
+|   FOR SHARE
+    {
+        $$ = &tree.SelectLockInfo{
+            LockType:tree.SelectLockForShare,
+        }
+    }
+|   FOR SHARE NOWAIT
+    {
+        $$ = &tree.SelectLockInfo{
+            LockType:tree.SelectLockForShareNoWait,
+        }
+    }
+|   FOR SHARE SKIP LOCKED
+    {
+        $$ = &tree.SelectLockInfo{
+            LockType:tree.SelectLockForShareSkipLocked,
+        }
+    }
+|   LOCK IN SHARE MODE
+    {
+        $$ = &tree.SelectLockInfo{
+            LockType:tree.SelectLockForShare,
+        }
+    }
 
 select_with_parens:
     '(' select_no_parens ')'
@@ -6151,6 +6415,105 @@ table_function:
                 },
         }
     }
+|   JSON_TABLE '(' expression ',' STRING COLUMNS '(' json_table_column_list ')' ')'
+    {
+        name := tree.NewCStr("json_table", 1)
+        $$ = &tree.TableFunction{
+            Func: &tree.FuncExpr{
+                Func: tree.FuncName2ResolvableFunctionReference(tree.NewUnresolvedName(name)),
+                FuncName: name,
+                Exprs: tree.Exprs{$3},
+                Type: tree.FUNC_TYPE_TABLE,
+            },
+            JsonTable: &tree.JsonTable{
+                Path: $5,
+                Columns: $8,
+            },
+        }
+    }
+
+json_table_column_list:
+    json_table_column
+    {
+        $$ = []*tree.JsonTableColumn{$1}
+    }
+|   json_table_column_list ',' json_table_column
+    {
+        $$ = append($1, $3)
+    }
+
+json_table_column:
+    ident FOR ORDINALITY
+    {
+        $$ = &tree.JsonTableColumn{
+            Kind: tree.JSON_TABLE_COLUMN_ORDINALITY,
+            Name: tree.Identifier($1.Compare()),
+        }
+    }
+|   ident column_type PATH STRING json_table_on_opt
+    {
+        $$ = $5
+        $$.Kind = tree.JSON_TABLE_COLUMN_PATH
+        $$.Name = tree.Identifier($1.Compare())
+        $$.Type = $2
+        $$.Path = $4
+    }
+|   ident column_type EXISTS PATH STRING
+    {
+        $$ = &tree.JsonTableColumn{
+            Kind: tree.JSON_TABLE_COLUMN_EXISTS,
+            Name: tree.Identifier($1.Compare()),
+            Type: $2,
+            Path: $5,
+        }
+    }
+|   NESTED PATH STRING COLUMNS '(' json_table_column_list ')'
+    {
+        $$ = &tree.JsonTableColumn{
+            Kind: tree.JSON_TABLE_COLUMN_NESTED,
+            Path: $3,
+            Columns: $6,
+        }
+    }
+|   NESTED STRING COLUMNS '(' json_table_column_list ')'
+    {
+        $$ = &tree.JsonTableColumn{
+            Kind: tree.JSON_TABLE_COLUMN_NESTED,
+            Path: $2,
+            Columns: $5,
+        }
+    }
+
+json_table_on_opt:
+    {
+        $$ = &tree.JsonTableColumn{}
+    }
+|   json_table_on_response ON EMPTY
+    {
+        $$ = &tree.JsonTableColumn{OnEmpty: $1}
+    }
+|   json_table_on_response ON ERROR
+    {
+        $$ = &tree.JsonTableColumn{OnError: $1}
+    }
+|   json_table_on_response ON EMPTY json_table_on_response ON ERROR
+    {
+        $$ = &tree.JsonTableColumn{OnEmpty: $1, OnError: $4}
+    }
+
+json_table_on_response:
+    NULL
+    {
+        $$ = &tree.JsonTableOnResponse{Type: tree.JSON_TABLE_ON_NULL}
+    }
+|   DEFAULT STRING
+    {
+        $$ = &tree.JsonTableOnResponse{Type: tree.JSON_TABLE_ON_DEFAULT, Default: $2}
+    }
+|   ERROR
+    {
+        $$ = &tree.JsonTableOnResponse{Type: tree.JSON_TABLE_ON_ERROR}
+    }
 
 aliased_table_name:
     table_name as_opt_id index_hint_list_opt
@@ -6322,6 +6685,8 @@ create_ddl_stmt:
 |   create_extension_stmt
 |   create_sequence_stmt
 |   create_procedure_stmt
+|   create_trigger_stmt
+|   create_event_stmt
 |   create_source_stmt
 |   create_connector_stmt
 |   pause_daemon_task_stmt
@@ -6366,114 +6731,341 @@ create_procedure_stmt:
         )
     }
 
-proc_name:
+create_trigger_stmt:
+    CREATE trigger_definer_opt TRIGGER not_exists_opt trigger_name trigger_timing trigger_event ON table_name FOR EACH ROW trigger_order_opt trigger_body
+    {
+        $$ = &tree.CreateTrigger{
+            Definer: $2,
+            IfNotExists: $4,
+            Name: $5,
+            Timing: $6,
+            Event: $7,
+            Table: $9,
+            Order: $13,
+            Body: $14,
+        }
+    }
+
+trigger_definer_opt:
+    {
+        $$ = nil
+    }
+|   DEFINER '=' user_name
+    {
+        $$ = $3
+    }
+
+trigger_name:
     ident
     {
-        prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
-        $$ = tree.NewProcedureName(tree.Identifier($1.Compare()), prefix)
+        $$ = tree.NewUnresolvedObjectName($1.Compare())
     }
 |   ident '.' ident
     {
         dbName := yylex.(*Lexer).GetDbOrTblName($1.Origin())
-        prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(dbName), ExplicitSchema: true}
-        $$ = tree.NewProcedureName(tree.Identifier($3.Compare()), prefix)
+        $$ = tree.NewUnresolvedObjectName(dbName, $3.Compare())
     }
 
-proc_args_list_opt:
+trigger_timing:
+    BEFORE
     {
-        $$ = tree.ProcedureArgs(nil)
+        $$ = tree.TRIGGER_BEFORE
     }
-|   proc_args_list
-
-proc_args_list:
-    proc_arg
+|   AFTER
     {
-        $$ = tree.ProcedureArgs{$1}
+        $$ = tree.TRIGGER_AFTER
     }
-|   proc_args_list ',' proc_arg
+
+trigger_event:
+    INSERT
     {
-        $$ = append($1, $3)
+        $$ = tree.TRIGGER_INSERT
     }
-
-proc_arg:
-    proc_arg_decl
+|   UPDATE
     {
-        $$ = tree.ProcedureArg($1)
+        $$ = tree.TRIGGER_UPDATE
     }
-
-proc_arg_decl:
-    proc_arg_in_out_type column_name column_type
+|   DELETE
     {
-        $$ = tree.NewProcedureArgDecl($1, $2, $3)
+        $$ = tree.TRIGGER_DELETE
     }
 
-proc_arg_in_out_type:
+trigger_order_opt:
     {
-        $$ = tree.TYPE_IN
+        $$ = nil
     }
-|   IN
+|   FOLLOWS ident
     {
-        $$ = tree.TYPE_IN
+        $$ = &tree.TriggerOrder{Follows: true, OtherTrigger: tree.Identifier($2.Compare())}
     }
-|   OUT
+|   PRECEDES ident
     {
-        $$ = tree.TYPE_OUT
+        $$ = &tree.TriggerOrder{OtherTrigger: tree.Identifier($2.Compare())}
     }
-|   INOUT
+
+trigger_body:
+    block_stmt
+|   if_stmt
+|   case_stmt
+|   signal_stmt
+|   insert_stmt
+|   replace_stmt
+|   update_stmt
+|   delete_stmt
+|   set_stmt
     {
-        $$ = tree.TYPE_INOUT
+        $$ = $1
     }
 
-
-create_function_stmt:
-    CREATE replace_opt FUNCTION func_name '(' func_args_list_opt ')' RETURNS func_return LANGUAGE func_lang func_body_import STRING func_handler_opt
+create_event_stmt:
+    CREATE trigger_definer_opt EVENT not_exists_opt trigger_name ON SCHEDULE event_schedule event_completion_opt event_status_opt event_comment_opt DO event_body
     {
-    	if $13 == "" {
-            yylex.Error("no function body error")
-            goto ret1
-        }
-        if $11 == "python" && $14 == "" {
-            yylex.Error("no handler error")
-            goto ret1
+        $$ = &tree.CreateEvent{
+            Definer: $2,
+            IfNotExists: $4,
+            Name: $5,
+            Schedule: $8,
+            Completion: $9,
+            Status: $10,
+            Comment: $11,
+            Body: $13,
         }
-
-        var Replace = $2
-        var Name = $4
-        var Args = $6
-        var ReturnType = $9
-        var Language = $11
-        var Import = $12
-        var Body = $13
-        var Handler = $14
-
-        $$ = tree.NewCreateFunction(
-            Replace,
-            Name,
-            Args,
-            ReturnType,
-            Language,
-            Import,
-            Body,
-            Handler,
-        )
     }
 
-func_name:
-    ident
+alter_event_stmt:
+    ALTER trigger_definer_opt EVENT trigger_name ON SCHEDULE event_schedule event_completion_opt event_rename_opt event_status_opt event_comment_opt event_do_opt
     {
-        prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
-        $$ = tree.NewFuncName(tree.Identifier($1.Compare()), prefix)
+        $$ = &tree.AlterEvent{
+            Definer: $2,
+            Name: $4,
+            Schedule: $7,
+            Completion: $8,
+            Rename: $9,
+            Status: $10,
+            Comment: $11,
+            Body: $12,
+        }
     }
-|   ident '.' ident
+|   ALTER trigger_definer_opt EVENT trigger_name event_completion event_rename_opt event_status_opt event_comment_opt event_do_opt
     {
-        dbName := yylex.(*Lexer).GetDbOrTblName($1.Origin())
-        prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(dbName), ExplicitSchema: true}
-        $$ = tree.NewFuncName(tree.Identifier($3.Compare()), prefix)
+        $$ = &tree.AlterEvent{
+            Definer: $2,
+            Name: $4,
+            Completion: $5,
+            Rename: $6,
+            Status: $7,
+            Comment: $8,
+            Body: $9,
+        }
     }
-
-func_args_list_opt:
+|   ALTER trigger_definer_opt EVENT trigger_name event_rename_opt event_status_opt event_comment_opt event_do_opt
     {
-        $$ = tree.FunctionArgs(nil)
+        $$ = &tree.AlterEvent{
+            Definer: $2,
+            Name: $4,
+            Rename: $5,
+            Status: $6,
+            Comment: $7,
+            Body: $8,
+        }
+    }
+
+event_schedule:
+    AT expression
+    {
+        $$ = &tree.EventSchedule{At: $2}
+    }
+|   EVERY expression time_unit
+    {
+        $$ = &tree.EventSchedule{Interval: $2, Unit: $3}
+    }
+|   EVERY expression time_unit STARTS expression
+    {
+        $$ = &tree.EventSchedule{Interval: $2, Unit: $3, Starts: $5}
+    }
+|   EVERY expression time_unit ENDS expression
+    {
+        $$ = &tree.EventSchedule{Interval: $2, Unit: $3, Ends: $5}
+    }
+|   EVERY expression time_unit STARTS expression ENDS expression
+    {
+        $$ = &tree.EventSchedule{Interval: $2, Unit: $3, Starts: $5, Ends: $7}
+    }
+
+event_completion_opt:
+    {
+        $$ = tree.EVENT_COMPLETION_UNSPECIFIED
+    }
+|   event_completion
+
+event_completion:
+    ON COMPLETION PRESERVE
+    {
+        $$ = tree.EVENT_COMPLETION_PRESERVE
+    }
+|   ON COMPLETION NOT PRESERVE
+    {
+        $$ = tree.EVENT_COMPLETION_NOT_PRESERVE
+    }
+
+event_status_opt:
+    {
+        $$ = tree.EVENT_STATUS_UNSPECIFIED
+    }
+|   ENABLE
+    {
+        $$ = tree.EVENT_STATUS_ENABLE
+    }
+|   DISABLE
+    {
+        $$ = tree.EVENT_STATUS_DISABLE
+    }
+
+event_comment_opt:
+    {
+        $$ = nil
+    }
+|   COMMENT_KEYWORD STRING
+    {
+        comment := $2
+        $$ = &comment
+    }
+
+event_rename_opt:
+    {
+        $$ = nil
+    }
+|   RENAME TO trigger_name
+    {
+        $$ = $3
+    }
+
+event_do_opt:
+    {
+        $$ = nil
+    }
+|   DO event_body
+    {
+        $$ = $2
+    }
+
+event_body:
+    insert_stmt
+|   replace_stmt
+|   update_stmt
+|   delete_stmt
+    {
+        $$ = $1
+    }
+
+proc_name:
+    ident
+    {
+        prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
+        $$ = tree.NewProcedureName(tree.Identifier($1.Compare()), prefix)
+    }
+|   ident '.' ident
+    {
+        dbName := yylex.(*Lexer).GetDbOrTblName($1.Origin())
+        prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(dbName), ExplicitSchema: true}
+        $$ = tree.NewProcedureName(tree.Identifier($3.Compare()), prefix)
+    }
+
+proc_args_list_opt:
+    {
+        $$ = tree.ProcedureArgs(nil)
+    }
+|   proc_args_list
+
+proc_args_list:
+    proc_arg
+    {
+        $$ = tree.ProcedureArgs{$1}
+    }
+|   proc_args_list ',' proc_arg
+    {
+        $$ = append($1, $3)
+    }
+
+proc_arg:
+    proc_arg_decl
+    {
+        $$ = tree.ProcedureArg($1)
+    }
+
+proc_arg_decl:
+    proc_arg_in_out_type column_name column_type
+    {
+        $$ = tree.NewProcedureArgDecl($1, $2, $3)
+    }
+
+proc_arg_in_out_type:
+    {
+        $$ = tree.TYPE_IN
+    }
+|   IN
+    {
+        $$ = tree.TYPE_IN
+    }
+|   OUT
+    {
+        $$ = tree.TYPE_OUT
+    }
+|   INOUT
+    {
+        $$ = tree.TYPE_INOUT
+    }
+
+
+create_function_stmt:
+    CREATE replace_opt FUNCTION func_name '(' func_args_list_opt ')' RETURNS func_return LANGUAGE func_lang func_body_import STRING func_handler_opt
+    {
+    	if $13 == "" {
+            yylex.Error("no function body error")
+            goto ret1
+        }
+        if $11 == "python" && $14 == "" {
+            yylex.Error("no handler error")
+            goto ret1
+        }
+
+        var Replace = $2
+        var Name = $4
+        var Args = $6
+        var ReturnType = $9
+        var Language = $11
+        var Import = $12
+        var Body = $13
+        var Handler = $14
+
+        $$ = tree.NewCreateFunction(
+            Replace,
+            Name,
+            Args,
+            ReturnType,
+            Language,
+            Import,
+            Body,
+            Handler,
+        )
+    }
+
+func_name:
+    ident
+    {
+        prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
+        $$ = tree.NewFuncName(tree.Identifier($1.Compare()), prefix)
+    }
+|   ident '.' ident
+    {
+        dbName := yylex.(*Lexer).GetDbOrTblName($1.Origin())
+        prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(dbName), ExplicitSchema: true}
+        $$ = tree.NewFuncName(tree.Identifier($3.Compare()), prefix)
+    }
+
+func_args_list_opt:
+    {
+        $$ = tree.FunctionArgs(nil)
     }
 |   func_args_list
 
@@ -7405,6 +7997,18 @@ index_option_list:
 	      opt1.AlgoParamList = opt2.AlgoParamList
 	    } else if len(opt2.AlgoParamVectorOpType) > 0 {
 	      opt1.AlgoParamVectorOpType = opt2.AlgoParamVectorOpType
+	    } else if opt2.HnswM > 0 {
+	      opt1.HnswM = opt2.HnswM
+	    } else if opt2.HnswEfConstruction > 0 {
+	      opt1.HnswEfConstruction = opt2.HnswEfConstruction
+	    } else if opt2.HnswEfSearch > 0 {
+	      opt1.HnswEfSearch = opt2.HnswEfSearch
+	    } else if opt2.NgramTokenSize > 0 {
+	      opt1.NgramTokenSize = opt2.NgramTokenSize
+	    } else if len(opt2.Stopwords) > 0 {
+	      opt1.Stopwords = opt2.Stopwords
+	    } else if len(opt2.Stemmer) > 0 {
+	      opt1.Stemmer = opt2.Stemmer
 	    }
             $$ = opt1
         }
@@ -7435,6 +8039,42 @@ index_option:
         io.AlgoParamVectorOpType = $2
         $$ = io
     }
+|   M equal_opt INTEGRAL
+    {
+    	val := int64($3.(int64))
+    	if val <= 0 {
+    		yylex.Error("M should be greater than 0")
+    		return 1
+    	}
+
+	io := tree.NewIndexOption()
+	io.HnswM = val
+	$$ = io
+    }
+|   EF_CONSTRUCTION equal_opt INTEGRAL
+    {
+    	val := int64($3.(int64))
+    	if val <= 0 {
+    		yylex.Error("EF_CONSTRUCTION should be greater than 0")
+    		return 1
+    	}
+
+	io := tree.NewIndexOption()
+	io.HnswEfConstruction = val
+	$$ = io
+    }
+|   EF_SEARCH equal_opt INTEGRAL
+    {
+    	val := int64($3.(int64))
+    	if val <= 0 {
+    		yylex.Error("EF_SEARCH should be greater than 0")
+    		return 1
+    	}
+
+	io := tree.NewIndexOption()
+	io.HnswEfSearch = val
+	$$ = io
+    }
 |   COMMENT_KEYWORD STRING
     {
         io := tree.NewIndexOption()
@@ -7447,6 +8087,30 @@ index_option:
         io.ParserName = $3.Compare()
         $$ = io
     }
+|   NGRAM_TOKEN_SIZE equal_opt INTEGRAL
+    {
+    	val := int64($3.(int64))
+    	if val <= 0 {
+    		yylex.Error("NGRAM_TOKEN_SIZE should be greater than 0")
+    		return 1
+    	}
+
+	io := tree.NewIndexOption()
+	io.NgramTokenSize = val
+	$$ = io
+    }
+|   STOPWORDS equal_opt STRING
+    {
+        io := tree.NewIndexOption()
+        io.Stopwords = $3
+        $$ = io
+    }
+|   STEMMER equal_opt STRING
+    {
+        io := tree.NewIndexOption()
+        io.Stemmer = $3
+        $$ = io
+    }
 |   VISIBLE
     {
         io := tree.NewIndexOption()
@@ -7511,6 +8175,10 @@ using_opt:
     {
 	$$ = tree.INDEX_TYPE_IVFFLAT
     }
+|   USING HNSW
+    {
+	$$ = tree.INDEX_TYPE_HNSW
+    }
 |   USING MASTER
     {
 	$$ = tree.INDEX_TYPE_MASTER
@@ -7755,6 +8423,16 @@ create_table_stmt:
         t.ClusterByOption = $11
         $$ = t
     }
+|   CREATE MATERIALIZED VIEW not_exists_opt table_name AS select_stmt
+    {
+        t := tree.NewCreateTable()
+        t.IsMaterializedView = true
+        t.IsAsSelect = true
+        t.IfNotExists = $4
+        t.Table = *$5
+        t.AsSource = $7
+        $$ = t
+    }
 |   CREATE DYNAMIC TABLE not_exists_opt table_name AS select_stmt source_option_list_opt
     {
         t := tree.NewCreateTable()
@@ -8562,6 +9240,10 @@ table_option:
     {
         $$ = tree.NewTableOptionEncryption($3)
     }
+|   TTL equal_opt column_name '+' interval_expr
+    {
+        $$ = tree.NewTableOptionTTL(tree.NewBinaryExpr(tree.PLUS, $3, $5))
+    }
 |   ENGINE equal_opt table_alias
     {
         $$ = tree.NewTableOptionEngine($3)
@@ -8883,6 +9565,8 @@ index_def:
             	keyTyp = tree.INDEX_TYPE_BTREE
 	    case "ivfflat":
 		keyTyp = tree.INDEX_TYPE_IVFFLAT
+	    case "hnsw":
+		keyTyp = tree.INDEX_TYPE_HNSW
 	    case "master":
 	    	keyTyp = tree.INDEX_TYPE_MASTER
             case "hash":
@@ -8922,6 +9606,8 @@ index_def:
 		keyTyp = tree.INDEX_TYPE_BTREE
 	     case "ivfflat":
 		keyTyp = tree.INDEX_TYPE_IVFFLAT
+	     case "hnsw":
+		keyTyp = tree.INDEX_TYPE_HNSW
 	     case "master":
         	keyTyp = tree.INDEX_TYPE_MASTER
 	     case "hash":
@@ -8962,6 +9648,8 @@ constaint_def:
                 v.ConstraintSymbol = $1
             case *tree.UniqueIndex:
                 v.ConstraintSymbol = $1
+            case *tree.CheckIndex:
+                v.Name = $1
             }
         }
         $$ = $2
@@ -9051,7 +9739,7 @@ constraint_elem:
 
 enforce_opt:
     {
-        $$ = false
+        $$ = true
     }
 |    enforce
 
@@ -9094,6 +9782,7 @@ index_type:
 |   RTREE
 |   ZONEMAP
 |   IVFFLAT
+|   HNSW
 |   MASTER
 |   BSI
 
@@ -9250,7 +9939,7 @@ column_attribute_elem:
     }
 |   constraint_keyword_opt CHECK '(' expression ')'
     {
-        $$ = tree.NewAttributeCheckConstraint($4, false, $1)
+        $$ = tree.NewAttributeCheckConstraint($4, true, $1)
     }
 |   constraint_keyword_opt CHECK '(' expression ')' enforce
     {
@@ -9294,6 +9983,28 @@ column_attribute_elem:
 	{
 		$$ = tree.NewAttributeHeaders()
 	}
+|   generated_always_opt AS '(' expression ')' generated_storage_opt
+    {
+        $$ = tree.NewAttributeGeneratedAlways($4, $6)
+    }
+
+generated_always_opt:
+    {}
+|   GENERATED ALWAYS
+    {}
+
+generated_storage_opt:
+    {
+        $$ = false
+    }
+|   VIRTUAL
+    {
+        $$ = false
+    }
+|   STORED
+    {
+        $$ = true
+    }
 
 enforce:
     ENFORCED
@@ -9546,6 +10257,14 @@ simple_expr:
     {
         $$ = $1
     }
+|   normal_ident ARROW STRING
+    {
+        $$ = tree.NewJsonExtractExpr($1, $3, false)
+    }
+|   normal_ident LONG_ARROW STRING
+    {
+        $$ = tree.NewJsonExtractExpr($1, $3, true)
+    }
 |   variable
     {
         $$ = $1
@@ -9645,6 +10364,10 @@ simple_expr:
     {
         $$ = tree.NewCastExpr($3, $5)
     }
+|   CAST '(' expression AS mo_cast_type ARRAY ')'
+    {
+        $$ = tree.NewArrayCastExpr($3, $5)
+    }
 |   SERIAL_EXTRACT '(' expression ',' expression AS mo_cast_type ')'
     {
 	$$ = tree.NewSerialExtractExpr($3, $5, $7)
@@ -9741,21 +10464,131 @@ function_call_window:
             WindowSpec: $4,
         }
     }
-
-sample_function_expr:
-    SAMPLE '(' '*' ',' INTEGRAL ROWS ')'
+|	PERCENT_RANK '(' ')' window_spec
     {
-	v := int($5.(int64))
-	val, err := tree.NewSampleRowsFuncExpression(v, true, nil, "block")
-	if err != nil {
-	    yylex.Error(err.Error())
-	    goto ret1
-	}
-	$$ = val
-    }
-|   SAMPLE '(' '*' ',' INTEGRAL ROWS ',' STRING ')'
-        {
-    	v := int($5.(int64))
+        name := tree.NewUnresolvedColName($1)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            FuncName: tree.NewCStr($1, 1),
+            WindowSpec: $4,
+        }
+    }
+|	CUME_DIST '(' ')' window_spec
+    {
+        name := tree.NewUnresolvedColName($1)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            FuncName: tree.NewCStr($1, 1),
+            WindowSpec: $4,
+        }
+    }
+|	NTILE '(' expression ')' window_spec
+    {
+        name := tree.NewUnresolvedColName($1)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            FuncName: tree.NewCStr($1, 1),
+            Exprs: tree.Exprs{$3},
+            WindowSpec: $5,
+        }
+    }
+|	LAG '(' expression_list ')' null_treatment_opt window_spec
+    {
+        name := tree.NewUnresolvedColName($1)
+        $6.IgnoreNulls = $5
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            FuncName: tree.NewCStr($1, 1),
+            Exprs: $3,
+            WindowSpec: $6,
+        }
+    }
+|	LEAD '(' expression_list ')' null_treatment_opt window_spec
+    {
+        name := tree.NewUnresolvedColName($1)
+        $6.IgnoreNulls = $5
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            FuncName: tree.NewCStr($1, 1),
+            Exprs: $3,
+            WindowSpec: $6,
+        }
+    }
+|	FIRST_VALUE '(' expression ')' null_treatment_opt window_spec
+    {
+        name := tree.NewUnresolvedColName($1)
+        $6.IgnoreNulls = $5
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            FuncName: tree.NewCStr($1, 1),
+            Exprs: tree.Exprs{$3},
+            WindowSpec: $6,
+        }
+    }
+|	LAST_VALUE '(' expression ')' null_treatment_opt window_spec
+    {
+        name := tree.NewUnresolvedColName($1)
+        $6.IgnoreNulls = $5
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            FuncName: tree.NewCStr($1, 1),
+            Exprs: tree.Exprs{$3},
+            WindowSpec: $6,
+        }
+    }
+|	NTH_VALUE '(' expression ',' expression ')' from_first_last_opt null_treatment_opt window_spec
+    {
+        name := tree.NewUnresolvedColName($1)
+        $9.FromLast = $7
+        $9.IgnoreNulls = $8
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            FuncName: tree.NewCStr($1, 1),
+            Exprs: tree.Exprs{$3, $5},
+            WindowSpec: $9,
+        }
+    }
+
+null_treatment_opt:
+    {
+        $$ = false
+    }
+|   RESPECT NULLS
+    {
+        $$ = false
+    }
+|   IGNORE NULLS
+    {
+        $$ = true
+    }
+
+from_first_last_opt:
+    {
+        $$ = false
+    }
+|   FROM FIRST
+    {
+        $$ = false
+    }
+|   FROM LAST
+    {
+        $$ = true
+    }
+
+sample_function_expr:
+    SAMPLE '(' '*' ',' INTEGRAL ROWS ')'
+    {
+	v := int($5.(int64))
+	val, err := tree.NewSampleRowsFuncExpression(v, true, nil, "block")
+	if err != nil {
+	    yylex.Error(err.Error())
+	    goto ret1
+	}
+	$$ = val
+    }
+|   SAMPLE '(' '*' ',' INTEGRAL ROWS ',' STRING ')'
+        {
+    	v := int($5.(int64))
     	val, err := tree.NewSampleRowsFuncExpression(v, true, nil, $8)
     	if err != nil {
     	    yylex.Error(err.Error())
@@ -11112,6 +11945,10 @@ predicate:
     {
         $$ = tree.NewComparisonExpr(tree.NOT_REG_MATCH, $1, $4)
     }
+|   bit_expr MEMBER OF '(' simple_expr ')'
+    {
+        $$ = tree.NewComparisonExpr(tree.MEMBER_OF, $1, $5)
+    }
 |   bit_expr BETWEEN bit_expr AND predicate
     {
         $$ = tree.NewRangeCond(false, $1, $3, $5)
@@ -12006,6 +12843,335 @@ declare_stmt:
             DefaultVal: $5,
         }
     }
+|   DECLARE ident CURSOR FOR select_stmt
+    {
+        $$ = &tree.DeclareCursor{
+            Name: tree.Identifier($2.Compare()),
+            Select: $5,
+        }
+    }
+|   DECLARE ident CONDITION FOR sqlstate_value
+    {
+        $$ = &tree.DeclareCondition{
+            Name: tree.Identifier($2.Compare()),
+            Value: $5,
+        }
+    }
+|   DECLARE handler_action HANDLER FOR condition_value_list block_type_stmt
+    {
+        $$ = &tree.DeclareHandler{
+            Action: $2,
+            Conditions: $5,
+            Body: $6,
+        }
+    }
+
+handler_action:
+    CONTINUE
+    {
+        $$ = tree.HANDLER_CONTINUE
+    }
+|   EXIT
+    {
+        $$ = tree.HANDLER_EXIT
+    }
+
+condition_value_list:
+    condition_value
+    {
+        $$ = []*tree.ConditionValue{$1}
+    }
+|   condition_value_list ',' condition_value
+    {
+        $$ = append($1, $3)
+    }
+
+condition_value:
+    sqlstate_value
+|   ident
+    {
+        $$ = &tree.ConditionValue{
+            Type: tree.CONDITION_NAME,
+            Name: tree.Identifier($1.Compare()),
+        }
+    }
+|   SQLWARNING
+    {
+        $$ = &tree.ConditionValue{Type: tree.CONDITION_SQLWARNING}
+    }
+|   NOT FOUND
+    {
+        $$ = &tree.ConditionValue{Type: tree.CONDITION_NOT_FOUND}
+    }
+|   SQLEXCEPTION
+    {
+        $$ = &tree.ConditionValue{Type: tree.CONDITION_SQLEXCEPTION}
+    }
+
+sqlstate_value:
+    SQLSTATE STRING
+    {
+        if len($2) != 5 || strings.HasPrefix($2, "00") {
+            yylex.Error(fmt.Sprintf("bad SQLSTATE: '%s'", $2))
+            goto ret1
+        }
+        $$ = &tree.ConditionValue{
+            Type: tree.CONDITION_SQLSTATE,
+            SqlState: $2,
+        }
+    }
+|   SQLSTATE VALUE STRING
+    {
+        if len($3) != 5 || strings.HasPrefix($3, "00") {
+            yylex.Error(fmt.Sprintf("bad SQLSTATE: '%s'", $3))
+            goto ret1
+        }
+        $$ = &tree.ConditionValue{
+            Type: tree.CONDITION_SQLSTATE,
+            SqlState: $3,
+        }
+    }
+|   INTEGRAL
+    {
+        var code uint64
+        switch v := $1.(type) {
+        case uint64:
+            code = v
+        case int64:
+            code = uint64(v)
+        }
+        if code == 0 || code > 65535 {
+            yylex.Error("incorrect condition value")
+            goto ret1
+        }
+        $$ = &tree.ConditionValue{
+            Type: tree.CONDITION_ERROR_CODE,
+            ErrorCode: uint16(code),
+        }
+    }
+
+open_cursor_stmt:
+    OPEN ident
+    {
+        $$ = &tree.OpenCursor{
+            Name: tree.Identifier($2.Compare()),
+        }
+    }
+
+fetch_cursor_stmt:
+    FETCH ident INTO var_name_list
+    {
+        $$ = &tree.FetchCursor{
+            Name: tree.Identifier($2.Compare()),
+            Variables: $4,
+        }
+    }
+|   FETCH FROM ident INTO var_name_list
+    {
+        $$ = &tree.FetchCursor{
+            Name: tree.Identifier($3.Compare()),
+            Variables: $5,
+        }
+    }
+|   FETCH NEXT FROM ident INTO var_name_list
+    {
+        $$ = &tree.FetchCursor{
+            Name: tree.Identifier($4.Compare()),
+            Variables: $6,
+        }
+    }
+
+close_cursor_stmt:
+    CLOSE ident
+    {
+        $$ = &tree.CloseCursor{
+            Name: tree.Identifier($2.Compare()),
+        }
+    }
+
+signal_stmt:
+    SIGNAL sqlstate_value signal_info_opt
+    {
+        if $2.Type == tree.CONDITION_ERROR_CODE {
+            yylex.Error("SIGNAL requires a SQLSTATE or a condition name")
+            goto ret1
+        }
+        $$ = &tree.Signal{
+            Condition: $2,
+            Items: $3,
+        }
+    }
+|   SIGNAL ident signal_info_opt
+    {
+        $$ = &tree.Signal{
+            Condition: &tree.ConditionValue{
+                Type: tree.CONDITION_NAME,
+                Name: tree.Identifier($2.Compare()),
+            },
+            Items: $3,
+        }
+    }
+|   RESIGNAL signal_condition_opt signal_info_opt
+    {
+        $$ = &tree.Signal{
+            IsResignal: true,
+            Condition: $2,
+            Items: $3,
+        }
+    }
+
+signal_condition_opt:
+    {
+        $$ = nil
+    }
+|   sqlstate_value
+    {
+        if $1.Type == tree.CONDITION_ERROR_CODE {
+            yylex.Error("RESIGNAL requires a SQLSTATE or a condition name")
+            goto ret1
+        }
+        $$ = $1
+    }
+|   ident
+    {
+        $$ = &tree.ConditionValue{
+            Type: tree.CONDITION_NAME,
+            Name: tree.Identifier($1.Compare()),
+        }
+    }
+
+signal_info_opt:
+    {
+        $$ = nil
+    }
+|   SET signal_info_list
+    {
+        $$ = $2
+    }
+
+signal_info_list:
+    signal_info_item
+    {
+        $$ = []*tree.SignalInfoItem{$1}
+    }
+|   signal_info_list ',' signal_info_item
+    {
+        $$ = append($1, $3)
+    }
+
+signal_info_item:
+    ident '=' expression
+    {
+        name := strings.ToUpper($1.Compare())
+        switch name {
+        case "CLASS_ORIGIN", "SUBCLASS_ORIGIN", "CONSTRAINT_CATALOG", "CONSTRAINT_SCHEMA", "CONSTRAINT_NAME",
+            "CATALOG_NAME", "SCHEMA_NAME", "TABLE_NAME", "COLUMN_NAME", "CURSOR_NAME", "MESSAGE_TEXT", "MYSQL_ERRNO":
+        default:
+            yylex.Error(fmt.Sprintf("unknown signal condition information item: %s", name))
+            goto ret1
+        }
+        $$ = &tree.SignalInfoItem{
+            Name: name,
+            Value: $3,
+        }
+    }
+
+get_diagnostics_stmt:
+    GET diagnostics_area_opt DIAGNOSTICS diagnostics_item_list
+    {
+        for _, item := range $4 {
+            if item.Name != "NUMBER" && item.Name != "ROW_COUNT" {
+                yylex.Error(fmt.Sprintf("unknown statement information item: %s", item.Name))
+                goto ret1
+            }
+        }
+        $$ = &tree.GetDiagnostics{
+            Stacked: $2,
+            Items: $4,
+        }
+    }
+|   GET diagnostics_area_opt DIAGNOSTICS CONDITION diagnostics_condition_number diagnostics_item_list
+    {
+        for _, item := range $6 {
+            switch item.Name {
+            case "CLASS_ORIGIN", "SUBCLASS_ORIGIN", "RETURNED_SQLSTATE", "MESSAGE_TEXT", "MYSQL_ERRNO", "CONSTRAINT_CATALOG",
+                "CONSTRAINT_SCHEMA", "CONSTRAINT_NAME", "CATALOG_NAME", "SCHEMA_NAME", "TABLE_NAME", "COLUMN_NAME", "CURSOR_NAME":
+            default:
+                yylex.Error(fmt.Sprintf("unknown condition information item: %s", item.Name))
+                goto ret1
+            }
+        }
+        $$ = &tree.GetDiagnostics{
+            Stacked: $2,
+            Condition: $5,
+            Items: $6,
+        }
+    }
+
+diagnostics_area_opt:
+    {
+        $$ = false
+    }
+|   CURRENT
+    {
+        $$ = false
+    }
+|   STACKED
+    {
+        $$ = true
+    }
+
+diagnostics_condition_number:
+    INTEGRAL
+    {
+        str := fmt.Sprintf("%v", $1)
+        switch v := $1.(type) {
+        case uint64:
+            $$ = tree.NewNumVal(v, str, false, tree.P_uint64)
+        case int64:
+            $$ = tree.NewNumVal(v, str, false, tree.P_int64)
+        default:
+            yylex.Error("parse integral fail")
+            goto ret1
+        }
+    }
+|   ident
+    {
+        $$ = tree.NewUnresolvedColName($1.Compare())
+    }
+|   user_variable
+    {
+        $$ = $1
+    }
+
+diagnostics_item_list:
+    diagnostics_item
+    {
+        $$ = []*tree.DiagnosticsItem{$1}
+    }
+|   diagnostics_item_list ',' diagnostics_item
+    {
+        $$ = append($1, $3)
+    }
+
+diagnostics_item:
+    diagnostics_target '=' ident
+    {
+        $$ = &tree.DiagnosticsItem{
+            Target: $1,
+            Name: strings.ToUpper($3.Compare()),
+        }
+    }
+
+diagnostics_target:
+    ident
+    {
+        $$ = $1.Compare()
+    }
+|   AT_ID
+    {
+        $$ = "@" + $1
+    }
 
 spatial_type:
     GEOMETRY
@@ -12351,6 +13517,7 @@ non_reserved_keyword:
     ACCOUNT
 |   ACCOUNTS
 |   AGAINST
+|   ARRAY
 |   AVG_ROW_LENGTH
 |   AUTO_RANDOM
 |   ATTRIBUTE
@@ -12405,6 +13572,11 @@ non_reserved_keyword:
 |   ERRORS
 |   ENFORCED
 |	ENABLE
+|   GENERATED
+|   ALWAYS
+|   STORED
+|   VIRTUAL
+|   OF
 |   FORMAT
 |   FLOAT_TYPE
 |   FULL
@@ -12425,10 +13597,40 @@ non_reserved_keyword:
 |   KEY_BLOCK_SIZE
 |   LISTS
 |   OP_TYPE
+|   M
+|   EF_CONSTRUCTION
+|   EF_SEARCH
+|   NGRAM_TOKEN_SIZE
+|   ORDINALITY
+|   NESTED
+|   CLOSE
+|   FOUND
+|   DIAGNOSTICS
+|   STACKED
+|   FOLLOWS
+|   PRECEDES
+|   MATERIALIZED
+|   REFRESH
+|   SCHEDULE
+|   COMPLETION
+|   PRESERVE
+|   STARTS
+|   ENDS
+|   EVERY
+|   AT
+|   TTL
+|   REMOVE
+|   MATCHED
+|   PATH
+|   ERROR
+|   EMPTY
+|   STOPWORDS
+|   STEMMER
 |   KEYS
 |   LANGUAGE
 |   LESS
 |   LEVEL
+|   LOCKED
 |   LINESTRING
 |   LOGSERVICE
 |   LONGBLOB
@@ -12441,6 +13643,7 @@ non_reserved_keyword:
 |   MEDIUMTEXT
 |   MEMORY
 |   MODE
+|   NOWAIT
 |   MULTILINESTRING
 |   MULTIPOINT
 |   MULTIPOLYGON
@@ -12483,6 +13686,7 @@ non_reserved_keyword:
 |   REPLICAS
 |   RELEASE
 |   RESUME
+|   RESPECT
 |   REVOKE
 |   REPLICATION
 |   ROW_FORMAT
@@ -12493,6 +13697,7 @@ non_reserved_keyword:
 |   SERIALIZABLE
 |   SHARE
 |   SIGNED
+|   SKIP
 |   SMALLINT
 |   SNAPSHOT
 |   PITR
@@ -12528,6 +13733,7 @@ non_reserved_keyword:
 |   VARIABLES
 |   VIEW
 |   WRITE
+|   WAIT
 |   WARNINGS
 |   WORK
 |   X509
@@ -12588,6 +13794,7 @@ non_reserved_keyword:
 |	BOOLEAN
 |   BTREE
 |	IVFFLAT
+|	HNSW
 |	MASTER
 |	COALESCE
 |	CONNECT
@@ -12770,6 +13977,14 @@ not_keyword:
 |   BITMAP_BIT_POSITION
 |   BITMAP_BUCKET_NUMBER
 |   BITMAP_COUNT
+|   LAG
+|   LEAD
+|   FIRST_VALUE
+|   LAST_VALUE
+|   NTH_VALUE
+|   NTILE
+|   PERCENT_RANK
+|   CUME_DIST
 
 
 //mo_keywords:
@@ -121,6 +121,9 @@ var (
 	}, {
 		input:  "alter table t1 alter reindex idx1 IVFFLAT lists = 5",
 		output: "alter table t1 alter reindex idx1 ivfflat lists = 5",
+	}, {
+		input:  "alter table t1 alter reindex idx1 HNSW",
+		output: "alter table t1 alter reindex idx1 hnsw",
 	}, {
 		input:  "create connector for s with (\"type\"='kafka', \"topic\"= 'user', \"partition\" = '1', \"value\"= 'json', \"bootstrap.servers\" = '127.0.0.1:62610');",
 		output: "create connector for s with (type = kafka, topic = user, partition = 1, value = json, bootstrap.servers = 127.0.0.1:62610)",
@@ -151,6 +154,24 @@ var (
 	}, {
 		input:  "select dense_rank() over (partition by col1, col2 order by col3 desc range unbounded preceding) from t1",
 		output: "select dense_rank() over (partition by col1, col2 order by col3 desc range unbounded preceding) from t1",
+	}, {
+		input:  "select lag(a) over (order by b), lead(a, 2, 0) over (partition by c order by b) from t1",
+		output: "select lag(a) over (order by b), lead(a, 2, 0) over (partition by c order by b) from t1",
+	}, {
+		input:  "select lag(a, 1) respect nulls over (order by b), lead(a) ignore nulls over (order by b) from t1",
+		output: "select lag(a, 1) over (order by b), lead(a) ignore nulls over (order by b) from t1",
+	}, {
+		input:  "select first_value(a) over (), last_value(a) ignore nulls over (order by b rows between unbounded preceding and unbounded following) from t1",
+		output: "select first_value(a) over (), last_value(a) ignore nulls over (order by b rows between unbounded preceding and unbounded following) from t1",
+	}, {
+		input:  "select nth_value(a, 2) from first over (order by b), nth_value(a, 3) from last ignore nulls over (order by b) from t1",
+		output: "select nth_value(a, 2) over (order by b), nth_value(a, 3) from last ignore nulls over (order by b) from t1",
+	}, {
+		input:  "select ntile(4) over (order by b), percent_rank() over (partition by a order by b), cume_dist() over (order by b) from t1",
+		output: "select ntile(4) over (order by b), percent_rank() over (partition by a order by b), cume_dist() over (order by b) from t1",
+	}, {
+		input:  "select lag, lead, ntile, respect from t1",
+		output: "select lag, lead, ntile, respect from t1",
 	}, {
 		input:  "select day_key,day_date,day,month,quarter,year,week,day_of_week from bi_date where 1=2;",
 		output: "select day_key, day_date, day, month, quarter, year, week, day_of_week from bi_date where 1 = 2",
@@ -227,6 +248,30 @@ var (
 	}, {
 		input:  "SELECT * FROM kv WHERE k = 1 FOR UPDATE",
 		output: "select * from kv where k = 1 for update",
+	}, {
+		input:  "SELECT * FROM jobs WHERE state = 0 LIMIT 10 FOR UPDATE SKIP LOCKED",
+		output: "select * from jobs where state = 0 limit 10 for update skip locked",
+	}, {
+		input:  "SELECT * FROM kv WHERE k = 1 FOR UPDATE NOWAIT",
+		output: "select * from kv where k = 1 for update nowait",
+	}, {
+		input:  "SELECT * FROM kv WHERE k = 1 FOR UPDATE WAIT 5",
+		output: "select * from kv where k = 1 for update wait 5",
+	}, {
+		input:  "SELECT * FROM kv WHERE k = 1 FOR SHARE",
+		output: "select * from kv where k = 1 for share",
+	}, {
+		input:  "SELECT * FROM kv WHERE k = 1 FOR SHARE NOWAIT",
+		output: "select * from kv where k = 1 for share nowait",
+	}, {
+		input:  "SELECT * FROM kv WHERE k = 1 FOR SHARE SKIP LOCKED",
+		output: "select * from kv where k = 1 for share skip locked",
+	}, {
+		input:  "SELECT * FROM kv WHERE k = 1 LOCK IN SHARE MODE",
+		output: "select * from kv where k = 1 for share",
+	}, {
+		input:  "select wait, skip, locked, nowait from t",
+		output: "select wait, skip, locked, nowait from t",
 	}, {
 		input: "select a from t1 use index(b)",
 	}, {
@@ -261,6 +306,81 @@ var (
 		input: "show plugins",
 	}, {
 		input: "show procedure status",
+	}, {
+		input:  "create trigger trg_ins after insert on t1 for each row insert into audit values (new.a, now())",
+		output: "create trigger trg_ins after insert on t1 for each row insert into audit values (new.a, now())",
+	}, {
+		input:  "CREATE TRIGGER Trg_Del BEFORE DELETE ON db1.t1 FOR EACH ROW PRECEDES trg_other DELETE FROM t2 WHERE id = OLD.id",
+		output: "create trigger trg_del before delete on db1.t1 for each row precedes trg_other delete from t2 where id = OLD.id",
+	}, {
+		input:  "create trigger trg before insert on t1 for each row set new.a = new.a + 1, new.b = upper(new.b)",
+		output: "create trigger trg before insert on t1 for each row set new.a = new.a + 1, new.b = upper(new.b)",
+	}, {
+		input:  "drop trigger trg",
+		output: "drop trigger trg",
+	}, {
+		input:  "DROP TRIGGER IF EXISTS db1.Trg",
+		output: "drop trigger if exists db1.trg",
+	}, {
+		input:  "CREATE MATERIALIZED VIEW IF NOT EXISTS db1.mv AS SELECT a, count(*) AS cnt FROM t1 GROUP BY a",
+		output: "create materialized view if not exists db1.mv as select a, count(*) as cnt from t1 group by a",
+	}, {
+		input:  "create materialized view mv as select t1.a, t2.b from t1 join t2 on t1.a = t2.a",
+		output: "create materialized view mv as select t1.a, t2.b from t1 inner join t2 on t1.a = t2.a",
+	}, {
+		input:  "DROP MATERIALIZED VIEW IF EXISTS db1.mv",
+		output: "drop materialized view if exists db1.mv",
+	}, {
+		input:  "REFRESH MATERIALIZED VIEW db1.mv",
+		output: "refresh materialized view db1.mv",
+	}, {
+		input:  "create table refresh (materialized int)",
+		output: "create table refresh (materialized int)",
+	}, {
+		input:  "CREATE TABLE t1 (a int, created_at datetime) TTL = created_at + INTERVAL 30 DAY",
+		output: "create table t1 (a int, created_at datetime) ttl = created_at + INTERVAL(30, day)",
+	}, {
+		input:  "alter table t1 ttl created_at + interval 12 hour",
+		output: "alter table t1 ttl = created_at + interval(12, hour)",
+	}, {
+		input:  "ALTER TABLE t1 REMOVE TTL",
+		output: "alter table t1 remove ttl",
+	}, {
+		input:  "create table ttl (remove int)",
+		output: "create table ttl (remove int)",
+	}, {
+		input:  "MERGE INTO t1 AS t USING s1 AS s ON t.a = s.a WHEN MATCHED AND s.c = 0 THEN DELETE WHEN MATCHED THEN UPDATE SET b = s.b, c = DEFAULT WHEN NOT MATCHED THEN INSERT (a, b) VALUES (s.a, s.b)",
+		output: "merge into t1 as t using s1 as s on t.a = s.a when matched and s.c = 0 then delete when matched then update set b = s.b, c = default when not matched then insert (a, b) values (s.a, s.b)",
+	}, {
+		input:  "merge into db1.t1 using (select a, b from s1 where b > 1) as s on t1.a = s.a when not matched and s.b is not null then insert values (s.a, s.b, default)",
+		output: "merge into db1.t1 using (select a, b from s1 where b > 1) as s on t1.a = s.a when not matched and s.b is not null then insert values (s.a, s.b, default)",
+	}, {
+		input:  "create table matched (merge int)",
+		output: "create table matched (merge int)",
+	}, {
+		input:  "CREATE EVENT IF NOT EXISTS db1.ev_purge ON SCHEDULE EVERY 1 DAY STARTS '2024-01-01 00:00:00' ON COMPLETION PRESERVE COMMENT 'purge' DO DELETE FROM t1 WHERE created < now() - INTERVAL 7 DAY",
+		output: "create event if not exists db1.ev_purge on schedule every 1 day starts 2024-01-01 00:00:00 on completion preserve comment 'purge' do delete from t1 where created < now() - INTERVAL(7, day)",
+	}, {
+		input:  "create definer = 'u1'@'%' event ev on schedule at current_timestamp + interval 1 hour disable do insert into t1 values (1)",
+		output: "create definer = u1@% event ev on schedule at current_timestamp() + interval(1, hour) disable do insert into t1 values (1)",
+	}, {
+		input:  "create event ev on schedule every 10 minute starts now() ends now() + interval 1 day do update t1 set a = a + 1",
+		output: "create event ev on schedule every 10 minute starts now() ends now() + interval(1, day) do update t1 set a = a + 1",
+	}, {
+		input:  "ALTER EVENT ev ON SCHEDULE EVERY 2 HOUR ON COMPLETION NOT PRESERVE RENAME TO db1.ev2 ENABLE",
+		output: "alter event ev on schedule every 2 hour on completion not preserve rename to db1.ev2 enable",
+	}, {
+		input:  "alter event ev disable comment 'paused'",
+		output: "alter event ev disable comment 'paused'",
+	}, {
+		input:  "alter event ev on completion preserve do insert into t1 select * from t2",
+		output: "alter event ev on completion preserve do insert into t1 select * from t2",
+	}, {
+		input:  "DROP EVENT IF EXISTS db1.Ev",
+		output: "drop event if exists db1.ev",
+	}, {
+		input:  "create table events (at int, every int, starts int, ends int, schedule int)",
+		output: "create table events (at int, every int, starts int, ends int, schedule int)",
 	}, {
 		input: "show triggers from db1 where 1",
 	}, {
@@ -798,6 +918,44 @@ var (
 			output: "create table t (a int) properties(a = b)",
 		}, {
 			input: "create table t (a int, b char, check (1 + 1) enforced)",
+		}, {
+			input:  "create table t (a int, b char, check (a > 0))",
+			output: "create table t (a int, b char, check (a > 0) enforced)",
+		}, {
+			input:  "create table t (a int, b char, constraint chk_a check (a > 0) not enforced)",
+			output: "create table t (a int, b char, constraint chk_a check (a > 0) not enforced)",
+		}, {
+			input:  "create table t (a int check (a > 0))",
+			output: "create table t (a int constraint check (a > 0) enforced)",
+		}, {
+			input:  "alter table t add constraint chk_a check (a > 0)",
+			output: "alter table t add constraint chk_a check (a > 0) enforced",
+		}, {
+			input: "alter table t drop check chk_a",
+		}, {
+			input:  "create table t (a int, b int, c int as (a + b), d int generated always as (a * 2) stored not null)",
+			output: "create table t (a int, b int, c int generated always as (a + b) virtual, d int generated always as (a * 2) stored not null)",
+		}, {
+			input:  "create table t (a int, c varchar(10) generated always as (concat(a, 'x')) virtual)",
+			output: "create table t (a int, c varchar(10) generated always as (concat(a, x)) virtual)",
+		}, {
+			input:  "alter table t add column c int as (a + 1) stored",
+			output: "alter table t add column c int generated always as (a + 1) stored",
+		}, {
+			input:  "create table t (stored int, virtual int, generated int, always int)",
+			output: "create table t (stored int, virtual int, generated int, always int)",
+		}, {
+			input:  "select doc->'$.a', doc->>'$.b' from t where 3 member of (doc->'$.tags')",
+			output: "select json_extract(doc, $.a), json_unquote(json_extract(doc, $.b)) from t where 3 member of (json_extract(doc, $.tags))",
+		}, {
+			input:  "create table t (id int primary key, doc json, index i1 ((cast(doc->>'$.user_id' as unsigned))), index i2 ((cast(doc->'$.tags' as unsigned array))))",
+			output: "create table t (id int primary key, doc json, index i1 ((cast(json_unquote(json_extract(doc, $.user_id)) as unsigned))), index i2 ((cast(json_extract(doc, $.tags) as unsigned array))))",
+		}, {
+			input:  "create index i3 on t ((cast(doc->'$.ids' as char(10) array)))",
+			output: "create index i3 on t ((cast(json_extract(doc, $.ids) as char(10) array)))",
+		}, {
+			input:  "create table t (array int, of int)",
+			output: "create table t (array int, of int)",
 		}, {
 			input: "create table t (a int, b char, foreign key sdf (a, b) references b(a asc, b desc))",
 		}, {
@@ -1511,6 +1669,18 @@ var (
 		}, {
 			input:  "create index idx using ivfflat on A (a) LISTS 10 op_type 'vector_l2_ops'",
 			output: "create index idx using ivfflat on a (a) LISTS 10 OP_TYPE vector_l2_ops ",
+		}, {
+			input:  "create index idx using hnsw on A (a) M 16 EF_CONSTRUCTION = 200 ef_search 64 op_type 'vector_cosine_ops'",
+			output: "create index idx using hnsw on a (a) OP_TYPE vector_cosine_ops M 16 EF_CONSTRUCTION 200 EF_SEARCH 64 ",
+		}, {
+			input:  "create table t1 (a int primary key, b vecf32(3), key idx using hnsw (b) m 8)",
+			output: "create table t1 (a int primary key, b vecf32(3), index idx using hnsw (b)  M 8 )",
+		}, {
+			input:  "create fulltext index ftidx on t1 (b) with parser ngram ngram_token_size = 2 stopwords 'english' stemmer 'porter'",
+			output: "create fulltext index ftidx on t1 (b) with parser ngram NGRAM_TOKEN_SIZE 2 STOPWORDS english STEMMER porter ",
+		}, {
+			input:  "create table t1 (a int primary key, b text, fulltext ftidx (b) with parser dictionary stopwords = 'a,the')",
+			output: "create table t1 (a int primary key, b text, fulltext ftidx (b)  with parser dictionary STOPWORDS a,the )",
 		}, {
 			input: "create index idx1 on a (a)",
 		}, {
@@ -1676,6 +1846,12 @@ var (
 		}, {
 			input:  "select $ from t into outfile '/Users/tmp/test' FIELDS TERMINATED BY ',' LINES TERMINATED BY '\n' header 'FALSE' MAX_FILE_SIZE 100 FORCE_QUOTE (a, b)",
 			output: "select $ from t into outfile /Users/tmp/test fields terminated by , enclosed by \" lines terminated by \n header false max_file_size 102400 force_quote a, b",
+		}, {
+			input:  "select a from t into outfile '/Users/tmp/test.parquet' FORMAT 'PARQUET' COMPRESSION 'zstd'",
+			output: "select a from t into outfile /Users/tmp/test.parquet fields terminated by , enclosed by \" lines terminated by \n header true format parquet compression zstd",
+		}, {
+			input:  "select a from t into outfile 'stage://s1/test.jsonl' MAX_FILE_SIZE 100 FORMAT 'jsonline'",
+			output: "select a from t into outfile stage://s1/test.jsonl fields terminated by , enclosed by \" lines terminated by \n header true max_file_size 102400 format jsonline",
 		}, {
 			input: "drop prepare stmt_name1",
 		}, {
@@ -1938,6 +2114,18 @@ var (
 			input:  `VALUES ROW(5,7,9), ROW(1,2,3), ROW(9,10,11) ORDER BY column_1 LIMIT 2`,
 			output: `values row(5, 7, 9), row(1, 2, 3), row(9, 10, 11) order by column_1 limit 2`,
 		},
+		{
+			input:  `select * from json_table('[{"a":1}]', '$[*]' columns (id for ordinality, a int path '$.a')) as jt`,
+			output: `select * from json_table([{"a":1}], '$[*]' columns (id for ordinality, a int path '$.a')) as jt`,
+		},
+		{
+			input:  `select jt.* from t1, JSON_TABLE(t1.doc, "$.items[*]" COLUMNS (name VARCHAR(20) PATH "$.name" DEFAULT '"none"' ON EMPTY ERROR ON ERROR, price DECIMAL(10,2) PATH "$.price" NULL ON ERROR, has_tag INT EXISTS PATH "$.tag", NESTED PATH '$.tags[*]' COLUMNS (tag_no FOR ORDINALITY, tag JSON PATH '$'), NESTED '$.x' COLUMNS (x TEXT PATH '$'))) AS jt where jt.price > 1`,
+			output: `select jt.* from t1 cross join json_table(t1.doc, '$.items[*]' columns (name varchar(20) path '$.name' default '"none"' on empty error on error, price decimal(10, 2) path '$.price' null on error, has_tag int exists path '$.tag', nested path '$.tags[*]' columns (tag_no for ordinality, tag json path '$'), nested path '$.x' columns (x text path '$'))) as jt where jt.price > 1`,
+		},
+		{
+			input:  `select path, error, empty, nested, ordinality from t1`,
+			output: `select path, error, empty, nested, ordinality from t1`,
+		},
 		{
 			input:  `select * from unnest("a") as f`,
 			output: `select * from unnest(a) as f`,
@@ -3307,6 +3495,47 @@ var (
 					END WHILE;
 				END`,
 		output: "begin declare v1 int default 5; while v1 > 0 do set v1 = v1 - 1; end while; end",
+	}, {
+		input: `BEGIN
+					DECLARE done INT DEFAULT 0;
+					DECLARE a INT;
+					DECLARE no_table CONDITION FOR SQLSTATE VALUE '42S02';
+					DECLARE cur1 CURSOR FOR SELECT id FROM t1;
+					DECLARE CONTINUE HANDLER FOR NOT FOUND SET done = 1;
+					DECLARE EXIT HANDLER FOR no_table, 1062, SQLEXCEPTION, SQLWARNING BEGIN GET STACKED DIAGNOSTICS CONDITION 1 @msg = MESSAGE_TEXT, code = mysql_errno; RESIGNAL; END;
+					OPEN cur1;
+					read_loop: LOOP
+						FETCH NEXT FROM cur1 INTO a;
+						IF done THEN LEAVE read_loop; END IF;
+					END LOOP read_loop;
+					CLOSE cur1;
+					GET DIAGNOSTICS @n = NUMBER, @r = ROW_COUNT;
+				END`,
+		output: "begin declare done int default 0; declare a int default null; declare no_table condition for sqlstate '42S02'; " +
+			"declare cur1 cursor for select id from t1; declare continue handler for not found set done = 1; " +
+			"declare exit handler for no_table, 1062, sqlexception, sqlwarning begin get stacked diagnostics condition 1 @msg = message_text, code = mysql_errno; resignal; end; " +
+			"open cur1; read_loop: loop fetch cur1 into a; if done then leave read_loop; end if; end loop read_loop; close cur1; " +
+			"get diagnostics @n = number, @r = row_count; end",
+	}, {
+		input: `BEGIN
+					SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'bad value', MYSQL_ERRNO = 1644;
+					SIGNAL no_table;
+					RESIGNAL SQLSTATE '45001' SET message_text = 'again';
+				END`,
+		output: "begin signal sqlstate '45000' set message_text = bad value, mysql_errno = 1644; signal no_table; resignal sqlstate '45001' set message_text = again; end",
+	}, {
+		input: `CREATE DEFINER = 'root'@'localhost' TRIGGER IF NOT EXISTS db1.trg_audit BEFORE UPDATE ON t1 FOR EACH ROW FOLLOWS trg_other
+				BEGIN
+					IF NEW.price < 0 THEN
+						SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'negative price';
+					ELSEIF NEW.price > OLD.price THEN
+						SET NEW.raised = 1;
+					END IF;
+					INSERT INTO audit VALUES (OLD.id, OLD.price, NEW.price);
+				END`,
+		output: "create definer = root@localhost trigger if not exists db1.trg_audit before update on t1 for each row follows trg_other " +
+			"begin if NEW.price < 0 then signal sqlstate '45000' set message_text = negative price; elseif NEW.price > OLD.price then set new.raised = 1; end if; " +
+			"insert into audit values (OLD.id, OLD.price, NEW.price); end",
 	}}
 )
 
@@ -3340,6 +3569,36 @@ var (
 	invalidSQL = []struct {
 		input string
 	}{
+		{
+			input: "begin signal sqlstate '00000'; end",
+		},
+		{
+			input: "begin signal 1644; end",
+		},
+		{
+			input: "begin signal sqlstate '45000' set unknown_item = 'x'; end",
+		},
+		{
+			input: "begin get diagnostics @a = message_text; end",
+		},
+		{
+			input: "begin declare undo handler for sqlexception set a = 1; end",
+		},
+		{
+			input: "create trigger trg before insert on t1 for each row select 1",
+		},
+		{
+			input: "create materialized view mv (a, b) as select a, b from t1",
+		},
+		{
+			input: "refresh materialized view",
+		},
+		{
+			input: "create trigger trg before truncate on t1 for each row set new.a = 1",
+		},
+		{
+			input: "create event ev on schedule every 1 day do select 1",
+		},
 		{
 			input: "alter table t1 add constraint index (col3, col4)",
 		},
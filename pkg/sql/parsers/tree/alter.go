@@ -619,6 +619,10 @@ func (node *AlterTable) reset() {
 				opt.Free()
 			case *TableOptionEncryption:
 				opt.Free()
+			case *TableOptionTTL:
+				opt.Free()
+			case *AlterOptionRemoveTTL:
+				opt.Free()
 			default:
 				if opt != nil {
 					panic(fmt.Sprintf("miss Free for %v", option))
@@ -852,6 +856,7 @@ const (
 	AlterTableDropKey
 	AlterTableDropPrimaryKey
 	AlterTableDropForeignKey
+	AlterTableDropCheck
 )
 
 type AlterOptionDrop struct {
@@ -886,6 +891,9 @@ func (node *AlterOptionDrop) Format(ctx *FmtCtx) {
 	case AlterTableDropForeignKey:
 		ctx.WriteString("foreign key ")
 		node.Name.Format(ctx)
+	case AlterTableDropCheck:
+		ctx.WriteString("check ")
+		node.Name.Format(ctx)
 	}
 }
 
@@ -925,6 +933,23 @@ func (node *AlterOptionTableName) reset() {
 	*node = AlterOptionTableName{}
 }
 
+// AlterOptionRemoveTTL is the REMOVE TTL option of ALTER TABLE.
+type AlterOptionRemoveTTL struct {
+	alterOptionImpl
+}
+
+func NewAlterOptionRemoveTTL() *AlterOptionRemoveTTL {
+	return new(AlterOptionRemoveTTL)
+}
+
+func (node *AlterOptionRemoveTTL) Free() {}
+
+func (node *AlterOptionRemoveTTL) Format(ctx *FmtCtx) {
+	ctx.WriteString("remove ttl")
+}
+
+func (node AlterOptionRemoveTTL) TypeName() string { return "tree.AlterOptionRemoveTTL" }
+
 type AlterColPos struct {
 	PreColName *UnresolvedName
 	Pos        int32
@@ -419,6 +419,12 @@ func init() {
 		reuse.DefaultOptions[TableOptionEncryption](), //.
 	) //WithEnableChecker()
 
+	reuse.CreatePool[TableOptionTTL](
+		func() *TableOptionTTL { return &TableOptionTTL{} },
+		func(t *TableOptionTTL) { t.reset() },
+		reuse.DefaultOptions[TableOptionTTL](), //.
+	) //WithEnableChecker()
+
 	reuse.CreatePool[HashType](
 		func() *HashType { return &HashType{} },
 		func(h *HashType) { h.reset() },
@@ -939,6 +945,7 @@ type CreateTable struct {
 	AsSource           *Select
 	IsDynamicTable     bool
 	DTOptions          []TableOption
+	IsMaterializedView bool
 	IsAsSelect         bool
 	IsAsLike           bool
 	LikeTableName      TableName
@@ -950,6 +957,17 @@ func NewCreateTable() *CreateTable {
 }
 
 func (node *CreateTable) Format(ctx *FmtCtx) {
+	if node.IsMaterializedView {
+		ctx.WriteString("create materialized view")
+		if node.IfNotExists {
+			ctx.WriteString(" if not exists")
+		}
+		ctx.WriteByte(' ')
+		node.Table.Format(ctx)
+		ctx.WriteString(" as ")
+		node.AsSource.Format(ctx)
+		return
+	}
 	ctx.WriteString("create")
 	if node.Temporary {
 		ctx.WriteString(" temporary")
@@ -1196,6 +1214,8 @@ func (node *CreateTable) reset() {
 				opt.Free()
 			case *TableOptionEncryption:
 				opt.Free()
+			case *TableOptionTTL:
+				opt.Free()
 			case *RetentionOption:
 				opt.Free()
 			default:
@@ -1287,6 +1307,8 @@ func (node *CreateTable) reset() {
 				opt.Free()
 			case *TableOptionEncryption:
 				opt.Free()
+			case *TableOptionTTL:
+				opt.Free()
 			default:
 				if opt != nil {
 					panic(fmt.Sprintf("miss Free for %v", item))
@@ -1737,7 +1759,14 @@ type AttributeGeneratedAlways struct {
 }
 
 func (node *AttributeGeneratedAlways) Format(ctx *FmtCtx) {
+	ctx.WriteString("generated always as (")
 	node.Expr.Format(ctx)
+	ctx.WriteString(")")
+	if node.Stored {
+		ctx.WriteString(" stored")
+	} else {
+		ctx.WriteString(" virtual")
+	}
 }
 
 func (node AttributeGeneratedAlways) TypeName() string { return "tree.AttributeGeneratedAlways" }
@@ -2020,6 +2049,10 @@ func (it IndexType) ToString() string {
 		return "master"
 	case INDEX_TYPE_FULLTEXT:
 		return "fulltext"
+	case INDEX_TYPE_HNSW:
+		return "hnsw"
+	case INDEX_TYPE_MULTIVALUE:
+		return "multivalue"
 	case INDEX_TYPE_INVALID:
 		return ""
 	default:
@@ -2037,6 +2070,8 @@ const (
 	INDEX_TYPE_IVFFLAT
 	INDEX_TYPE_MASTER
 	INDEX_TYPE_FULLTEXT
+	INDEX_TYPE_HNSW
+	INDEX_TYPE_MULTIVALUE
 )
 
 type VisibleType int
@@ -2069,13 +2104,21 @@ type IndexOption struct {
 	SecondaryEngineAttribute string
 	AlgoParamList            int64
 	AlgoParamVectorOpType    string
+	HnswM                    int64
+	HnswEfConstruction       int64
+	HnswEfSearch             int64
+	NgramTokenSize           int64
+	Stopwords                string
+	Stemmer                  string
 }
 
 // Must follow the following sequence when test
 func (node *IndexOption) Format(ctx *FmtCtx) {
 	if node.KeyBlockSize != 0 || node.ParserName != "" ||
 		node.Comment != "" || node.Visible != VISIBLE_TYPE_INVALID ||
-		node.AlgoParamList != 0 || node.AlgoParamVectorOpType != "" {
+		node.AlgoParamList != 0 || node.AlgoParamVectorOpType != "" ||
+		node.HnswM != 0 || node.HnswEfConstruction != 0 || node.HnswEfSearch != 0 ||
+		node.NgramTokenSize != 0 || node.Stopwords != "" || node.Stemmer != "" {
 		ctx.WriteByte(' ')
 	}
 	if node.KeyBlockSize != 0 {
@@ -2088,6 +2131,21 @@ func (node *IndexOption) Format(ctx *FmtCtx) {
 		ctx.WriteString(node.ParserName)
 		ctx.WriteByte(' ')
 	}
+	if node.NgramTokenSize != 0 {
+		ctx.WriteString("NGRAM_TOKEN_SIZE ")
+		ctx.WriteString(strconv.FormatInt(node.NgramTokenSize, 10))
+		ctx.WriteByte(' ')
+	}
+	if node.Stopwords != "" {
+		ctx.WriteString("STOPWORDS ")
+		ctx.WriteString(node.Stopwords)
+		ctx.WriteByte(' ')
+	}
+	if node.Stemmer != "" {
+		ctx.WriteString("STEMMER ")
+		ctx.WriteString(node.Stemmer)
+		ctx.WriteByte(' ')
+	}
 	if node.Comment != "" {
 		ctx.WriteString("comment ")
 		ctx.WriteString(node.Comment)
@@ -2103,6 +2161,21 @@ func (node *IndexOption) Format(ctx *FmtCtx) {
 		ctx.WriteString(node.AlgoParamVectorOpType)
 		ctx.WriteByte(' ')
 	}
+	if node.HnswM != 0 {
+		ctx.WriteString("M ")
+		ctx.WriteString(strconv.FormatInt(node.HnswM, 10))
+		ctx.WriteByte(' ')
+	}
+	if node.HnswEfConstruction != 0 {
+		ctx.WriteString("EF_CONSTRUCTION ")
+		ctx.WriteString(strconv.FormatInt(node.HnswEfConstruction, 10))
+		ctx.WriteByte(' ')
+	}
+	if node.HnswEfSearch != 0 {
+		ctx.WriteString("EF_SEARCH ")
+		ctx.WriteString(strconv.FormatInt(node.HnswEfSearch, 10))
+		ctx.WriteByte(' ')
+	}
 	if node.Visible != VISIBLE_TYPE_INVALID {
 		ctx.WriteString(node.Visible.ToString())
 	}
@@ -2450,16 +2523,24 @@ func NewFullTextIndex(k []*KeyPart, n string, e bool, io *IndexOption) *FullText
 
 type CheckIndex struct {
 	tableDefImpl
+	Name     string
 	Expr     Expr
 	Enforced bool
 }
 
 func (node *CheckIndex) Format(ctx *FmtCtx) {
+	if node.Name != "" {
+		ctx.WriteString("constraint ")
+		ctx.WriteString(node.Name)
+		ctx.WriteByte(' ')
+	}
 	ctx.WriteString("check (")
 	node.Expr.Format(ctx)
 	ctx.WriteByte(')')
 	if node.Enforced {
 		ctx.WriteString(" enforced")
+	} else {
+		ctx.WriteString(" not enforced")
 	}
 }
 
@@ -3419,6 +3500,33 @@ func NewTableOptionEncryption(e string) *TableOptionEncryption {
 	return t
 }
 
+// TableOptionTTL is the TTL = column + INTERVAL n unit option of the table.
+type TableOptionTTL struct {
+	tableOptionImpl
+	Expr Expr
+}
+
+func (node *TableOptionTTL) Format(ctx *FmtCtx) {
+	ctx.WriteString("ttl = ")
+	node.Expr.Format(ctx)
+}
+
+func (node TableOptionTTL) TypeName() string { return "tree.TableOptionTTL" }
+
+func (node *TableOptionTTL) reset() {
+	*node = TableOptionTTL{}
+}
+
+func (node *TableOptionTTL) Free() {
+	reuse.Free[TableOptionTTL](node, nil)
+}
+
+func NewTableOptionTTL(e Expr) *TableOptionTTL {
+	t := reuse.Alloc[TableOptionTTL](nil)
+	t.Expr = e
+	return t
+}
+
 type PartitionType interface {
 	NodeFormatter
 }
@@ -3818,6 +3926,8 @@ func (node *Partition) reset() {
 				opt.Free()
 			case *TableOptionEncryption:
 				opt.Free()
+			case *TableOptionTTL:
+				opt.Free()
 			default:
 				if opt != nil {
 					panic(fmt.Sprintf("miss Free for %v", item))
@@ -3951,6 +4061,8 @@ func (node *SubPartition) reset() {
 				opt.Free()
 			case *TableOptionEncryption:
 				opt.Free()
+			case *TableOptionTTL:
+				opt.Free()
 			default:
 				if opt != nil {
 					panic(fmt.Sprintf("miss Free for %v", item))
@@ -108,12 +108,17 @@ func NewDropDatabase(n Identifier, i bool) *DropDatabase {
 // DROP Table statement
 type DropTable struct {
 	statementImpl
-	IfExists bool
-	Names    TableNames
+	IfExists           bool
+	Names              TableNames
+	IsMaterializedView bool
 }
 
 func (node *DropTable) Format(ctx *FmtCtx) {
-	ctx.WriteString("drop table")
+	if node.IsMaterializedView {
+		ctx.WriteString("drop materialized view")
+	} else {
+		ctx.WriteString("drop table")
+	}
 	if node.IfExists {
 		ctx.WriteString(" if exists")
 	}
@@ -0,0 +1,195 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+import "strings"
+
+// EventSchedule is the ON SCHEDULE clause of the event, the event runs once at At,
+// or every Interval Unit from Starts to Ends.
+type EventSchedule struct {
+	At       Expr
+	Interval Expr
+	Unit     string
+	Starts   Expr
+	Ends     Expr
+}
+
+func (node *EventSchedule) Format(ctx *FmtCtx) {
+	if node.At != nil {
+		ctx.WriteString("at ")
+		node.At.Format(ctx)
+		return
+	}
+	ctx.WriteString("every ")
+	node.Interval.Format(ctx)
+	ctx.WriteByte(' ')
+	ctx.WriteString(strings.ToLower(node.Unit))
+	if node.Starts != nil {
+		ctx.WriteString(" starts ")
+		node.Starts.Format(ctx)
+	}
+	if node.Ends != nil {
+		ctx.WriteString(" ends ")
+		node.Ends.Format(ctx)
+	}
+}
+
+type EventCompletion int
+
+const (
+	EVENT_COMPLETION_UNSPECIFIED EventCompletion = iota
+	EVENT_COMPLETION_NOT_PRESERVE
+	EVENT_COMPLETION_PRESERVE
+)
+
+func (c EventCompletion) String() string {
+	if c == EVENT_COMPLETION_PRESERVE {
+		return "PRESERVE"
+	}
+	return "NOT PRESERVE"
+}
+
+type EventStatus int
+
+const (
+	EVENT_STATUS_UNSPECIFIED EventStatus = iota
+	EVENT_STATUS_ENABLE
+	EVENT_STATUS_DISABLE
+)
+
+func (s EventStatus) String() string {
+	if s == EVENT_STATUS_DISABLE {
+		return "DISABLED"
+	}
+	return "ENABLED"
+}
+
+func formatEventOptions(ctx *FmtCtx, completion EventCompletion, status EventStatus, comment *string) {
+	if completion != EVENT_COMPLETION_UNSPECIFIED {
+		ctx.WriteString(" on completion ")
+		ctx.WriteString(strings.ToLower(completion.String()))
+	}
+	switch status {
+	case EVENT_STATUS_ENABLE:
+		ctx.WriteString(" enable")
+	case EVENT_STATUS_DISABLE:
+		ctx.WriteString(" disable")
+	}
+	if comment != nil {
+		ctx.WriteString(" comment ")
+		ctx.WriteString("'" + *comment + "'")
+	}
+}
+
+// CreateEvent is the CREATE EVENT statement, Name is [db.]event_name.
+type CreateEvent struct {
+	statementImpl
+	// Definer is nil if it is not specified
+	Definer     *UsernameRecord
+	IfNotExists bool
+	Name        *UnresolvedObjectName
+	Schedule    *EventSchedule
+	Completion  EventCompletion
+	Status      EventStatus
+	Comment     *string
+	Body        Statement
+}
+
+func (node *CreateEvent) Format(ctx *FmtCtx) {
+	ctx.WriteString("create ")
+	if node.Definer != nil {
+		ctx.WriteString("definer = ")
+		ctx.WriteString(node.Definer.Username)
+		ctx.WriteByte('@')
+		ctx.WriteString(node.Definer.Hostname)
+		ctx.WriteByte(' ')
+	}
+	ctx.WriteString("event ")
+	if node.IfNotExists {
+		ctx.WriteString("if not exists ")
+	}
+	node.Name.Format(ctx)
+	ctx.WriteString(" on schedule ")
+	node.Schedule.Format(ctx)
+	formatEventOptions(ctx, node.Completion, node.Status, node.Comment)
+	ctx.WriteString(" do ")
+	node.Body.Format(ctx)
+}
+
+func (node *CreateEvent) GetStatementType() string { return "Create Event" }
+func (node *CreateEvent) GetQueryType() string     { return QueryTypeDDL }
+
+// AlterEvent is the ALTER EVENT statement, the clauses which are not specified are nil or unspecified.
+type AlterEvent struct {
+	statementImpl
+	Definer    *UsernameRecord
+	Name       *UnresolvedObjectName
+	Schedule   *EventSchedule
+	Completion EventCompletion
+	Rename     *UnresolvedObjectName
+	Status     EventStatus
+	Comment    *string
+	Body       Statement
+}
+
+func (node *AlterEvent) Format(ctx *FmtCtx) {
+	ctx.WriteString("alter ")
+	if node.Definer != nil {
+		ctx.WriteString("definer = ")
+		ctx.WriteString(node.Definer.Username)
+		ctx.WriteByte('@')
+		ctx.WriteString(node.Definer.Hostname)
+		ctx.WriteByte(' ')
+	}
+	ctx.WriteString("event ")
+	node.Name.Format(ctx)
+	if node.Schedule != nil {
+		ctx.WriteString(" on schedule ")
+		node.Schedule.Format(ctx)
+	}
+	if node.Rename != nil {
+		formatEventOptions(ctx, node.Completion, EVENT_STATUS_UNSPECIFIED, nil)
+		ctx.WriteString(" rename to ")
+		node.Rename.Format(ctx)
+		formatEventOptions(ctx, EVENT_COMPLETION_UNSPECIFIED, node.Status, node.Comment)
+	} else {
+		formatEventOptions(ctx, node.Completion, node.Status, node.Comment)
+	}
+	if node.Body != nil {
+		ctx.WriteString(" do ")
+		node.Body.Format(ctx)
+	}
+}
+
+func (node *AlterEvent) GetStatementType() string { return "Alter Event" }
+func (node *AlterEvent) GetQueryType() string     { return QueryTypeDDL }
+
+// DropEvent is the DROP EVENT statement, Name is [db.]event_name.
+type DropEvent struct {
+	statementImpl
+	IfExists bool
+	Name     *UnresolvedObjectName
+}
+
+func (node *DropEvent) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop event ")
+	if node.IfExists {
+		ctx.WriteString("if exists ")
+	}
+	node.Name.Format(ctx)
+}
+
+func (node *DropEvent) GetStatementType() string { return "Drop Event" }
+func (node *DropEvent) GetQueryType() string     { return QueryTypeDDL }
@@ -246,6 +246,7 @@ const (
 	ANY
 	SOME
 	ALL
+	MEMBER_OF // MEMBER OF
 )
 
 func (op ComparisonOp) ToString() string {
@@ -290,6 +291,8 @@ func (op ComparisonOp) ToString() string {
 		return "ilike"
 	case NOT_ILIKE:
 		return "not ilike"
+	case MEMBER_OF:
+		return "member of"
 	default:
 		return "Unknown ComparisonExprOperator"
 	}
@@ -319,6 +322,14 @@ func (node *ComparisonExpr) Format(ctx *FmtCtx) {
 		ctx.WriteByte(' ')
 	}
 
+	if node.Op == MEMBER_OF {
+		// the json array of MEMBER OF is always parenthesized
+		ctx.WriteByte('(')
+		node.Right.Format(ctx)
+		ctx.WriteByte(')')
+		return
+	}
+
 	ctx.PrintExpr(node, node.Right, false)
 	if node.Escape != nil {
 		ctx.WriteString(" escape ")
@@ -983,6 +994,22 @@ func (node *FuncExpr) Format(ctx *FmtCtx) {
 	}
 }
 
+// NewJsonExtractExpr returns json_extract(col, path) for col->path, or
+// json_unquote(json_extract(col, path)) for col->>path
+func NewJsonExtractExpr(col Expr, path string, unquote bool) Expr {
+	var expr Expr = &FuncExpr{
+		Func:  FuncName2ResolvableFunctionReference(NewUnresolvedColName("json_extract")),
+		Exprs: Exprs{col, NewNumVal(path, path, false, P_char)},
+	}
+	if unquote {
+		expr = &FuncExpr{
+			Func:  FuncName2ResolvableFunctionReference(NewUnresolvedColName("json_unquote")),
+			Exprs: Exprs{expr},
+		}
+	}
+	return expr
+}
+
 // Accept implements NodeChecker interface
 func (node *FuncExpr) Accept(v Visitor) (Expr, bool) {
 	newNode, skipChildren := v.Enter(node)
@@ -1029,9 +1056,20 @@ type WindowSpec struct {
 	OrderBy     OrderBy
 	HasFrame    bool
 	Frame       *FrameClause
+
+	// FromLast and IgnoreNulls are the optional modifiers of the value window functions,
+	// e.g. NTH_VALUE(a, 2) FROM LAST IGNORE NULLS OVER (...)
+	FromLast    bool
+	IgnoreNulls bool
 }
 
 func (node *WindowSpec) Format(ctx *FmtCtx) {
+	if node.FromLast {
+		ctx.WriteString("from last ")
+	}
+	if node.IgnoreNulls {
+		ctx.WriteString("ignore nulls ")
+	}
 	ctx.WriteString("over (")
 	flag := false
 	if len(node.PartitionBy) > 0 {
@@ -1187,6 +1225,8 @@ type CastExpr struct {
 	exprImpl
 	Expr Expr
 	Type ResolvableTypeReference
+	// Array is true for CAST(expr AS type ARRAY), the key part of a multi-valued index
+	Array bool
 }
 
 func (node *CastExpr) Format(ctx *FmtCtx) {
@@ -1194,6 +1234,9 @@ func (node *CastExpr) Format(ctx *FmtCtx) {
 	node.Expr.Format(ctx)
 	ctx.WriteString(" as ")
 	node.Type.(*T).InternalType.Format(ctx)
+	if node.Array {
+		ctx.WriteString(" array")
+	}
 	ctx.WriteByte(')')
 }
 
@@ -1219,6 +1262,14 @@ func NewCastExpr(e Expr, t ResolvableTypeReference) *CastExpr {
 	}
 }
 
+func NewArrayCastExpr(e Expr, t ResolvableTypeReference) *CastExpr {
+	return &CastExpr{
+		Expr:  e,
+		Type:  t,
+		Array: true,
+	}
+}
+
 type BitCastExpr struct {
 	exprImpl
 	Expr Expr
@@ -28,6 +28,8 @@ type FmtCtx struct {
 	// quoteString string
 	quoteString       bool
 	singleQuoteString bool
+	// formatHook formats the table names and the unresolved names instead if it returns true
+	formatHook func(ctx *FmtCtx, node NodeFormatter) bool
 }
 
 func NewFmtCtx(dialectType dialect.DialectType, opts ...FmtCtxOption) *FmtCtx {
@@ -58,6 +60,14 @@ func WithSingleQuoteString() FmtCtxOption {
 	})
 }
 
+// WithFormatHook sets the function to format the table names and the unresolved names,
+// the node is formatted as usual if the function returns false.
+func WithFormatHook(hook func(ctx *FmtCtx, node NodeFormatter) bool) FmtCtxOption {
+	return FmtCtxOption(func(ctx *FmtCtx) {
+		ctx.formatHook = hook
+	})
+}
+
 // NodeFormatter for formatted output of the node.
 type NodeFormatter interface {
 	Format(ctx *FmtCtx)
@@ -107,6 +107,9 @@ func (node *UnresolvedName) ColNameOrigin() string {
 }
 
 func (node *UnresolvedName) Format(ctx *FmtCtx) {
+	if ctx.formatHook != nil && ctx.formatHook(ctx, node) {
+		return
+	}
 	for i := node.NumParts - 1; i >= 0; i-- {
 		ctx.WriteString(node.CStrParts[i].Origin())
 		if i > 0 {
@@ -0,0 +1,97 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+type MergeAction int
+
+const (
+	MergeUpdate MergeAction = iota
+	MergeDelete
+	MergeInsert
+)
+
+// Merge is the statement
+//
+//	MERGE INTO target USING source ON cond
+//	WHEN MATCHED [AND cond] THEN UPDATE SET ... | DELETE
+//	WHEN NOT MATCHED [AND cond] THEN INSERT [(cols)] VALUES (...)
+//
+// the first clause whose condition is true is applied to each row of source.
+type Merge struct {
+	statementImpl
+	Table  TableExpr
+	Source TableExpr
+	On     Expr
+	Whens  []*MergeWhen
+}
+
+func (node *Merge) Format(ctx *FmtCtx) {
+	ctx.WriteString("merge into ")
+	node.Table.Format(ctx)
+	ctx.WriteString(" using ")
+	node.Source.Format(ctx)
+	ctx.WriteString(" on ")
+	node.On.Format(ctx)
+	for _, w := range node.Whens {
+		ctx.WriteByte(' ')
+		w.Format(ctx)
+	}
+}
+
+func (node *Merge) GetStatementType() string { return "Merge" }
+func (node *Merge) GetQueryType() string     { return QueryTypeDML }
+
+// MergeWhen is a WHEN [NOT] MATCHED clause of the merge statement
+type MergeWhen struct {
+	Matched bool
+	Cond    Expr
+	Action  MergeAction
+
+	// set list of MergeUpdate
+	Exprs UpdateExprs
+	// column list and values of MergeInsert
+	Columns IdentifierList
+	Values  Exprs
+}
+
+func (node *MergeWhen) Format(ctx *FmtCtx) {
+	ctx.WriteString("when ")
+	if !node.Matched {
+		ctx.WriteString("not ")
+	}
+	ctx.WriteString("matched")
+	if node.Cond != nil {
+		ctx.WriteString(" and ")
+		node.Cond.Format(ctx)
+	}
+	ctx.WriteString(" then ")
+	switch node.Action {
+	case MergeUpdate:
+		ctx.WriteString("update set ")
+		node.Exprs.Format(ctx)
+	case MergeDelete:
+		ctx.WriteString("delete")
+	case MergeInsert:
+		ctx.WriteString("insert")
+		if node.Columns != nil {
+			ctx.WriteString(" (")
+			node.Columns.Format(ctx)
+			ctx.WriteByte(')')
+		}
+		ctx.WriteString(" values (")
+		node.Values.Format(ctx)
+		ctx.WriteByte(')')
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+// RefreshMaterializedView is the REFRESH MATERIALIZED VIEW statement, the rows of the view
+// are recomputed from its query.
+type RefreshMaterializedView struct {
+	statementImpl
+	Name TableName
+}
+
+func (node *RefreshMaterializedView) Format(ctx *FmtCtx) {
+	ctx.WriteString("refresh materialized view ")
+	node.Name.Format(ctx)
+}
+
+func (node *RefreshMaterializedView) GetStatementType() string { return "Refresh Materialized View" }
+func (node *RefreshMaterializedView) GetQueryType() string     { return QueryTypeDDL }
@@ -14,6 +14,8 @@
 
 package tree
 
+import "fmt"
+
 // SelectLockType is the lock type for SelectStmt.
 type SelectLockType int
 
@@ -60,4 +62,7 @@ type SelectLockInfo struct {
 
 func (node *SelectLockInfo) Format(ctx *FmtCtx) {
 	ctx.WriteString(node.LockType.String())
+	if node.LockType == SelectLockForUpdateWaitN {
+		ctx.WriteString(fmt.Sprintf(" %d", node.WaitSec))
+	}
 }
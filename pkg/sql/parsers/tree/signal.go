@@ -0,0 +1,103 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+import "strings"
+
+// SignalInfoItem is one of the SET item_name = value of SIGNAL and RESIGNAL,
+// Name is in upper case.
+type SignalInfoItem struct {
+	Name  string
+	Value Expr
+}
+
+// Signal is the SIGNAL or RESIGNAL statement
+type Signal struct {
+	statementImpl
+	IsResignal bool
+	// Condition is nil for RESIGNAL without condition.
+	Condition *ConditionValue
+	Items     []*SignalInfoItem
+}
+
+func (node *Signal) Format(ctx *FmtCtx) {
+	if node.IsResignal {
+		ctx.WriteString("resignal")
+	} else {
+		ctx.WriteString("signal")
+	}
+	if node.Condition != nil {
+		ctx.WriteByte(' ')
+		node.Condition.Format(ctx)
+	}
+	for i, item := range node.Items {
+		if i == 0 {
+			ctx.WriteString(" set ")
+		} else {
+			ctx.WriteString(", ")
+		}
+		ctx.WriteString(strings.ToLower(item.Name))
+		ctx.WriteString(" = ")
+		item.Value.Format(ctx)
+	}
+}
+
+func (node *Signal) GetStatementType() string {
+	if node.IsResignal {
+		return "Resignal"
+	}
+	return "Signal"
+}
+func (node *Signal) GetQueryType() string { return QueryTypeOth }
+
+// DiagnosticsItem is one of the target = item_name of GET DIAGNOSTICS,
+// Target starts with '@' for a user variable and Name is in upper case.
+type DiagnosticsItem struct {
+	Target string
+	Name   string
+}
+
+// GetDiagnostics is the GET [CURRENT | STACKED] DIAGNOSTICS statement
+type GetDiagnostics struct {
+	statementImpl
+	Stacked bool
+	// Condition is the condition number, it is nil for the statement information.
+	Condition Expr
+	Items     []*DiagnosticsItem
+}
+
+func (node *GetDiagnostics) Format(ctx *FmtCtx) {
+	ctx.WriteString("get ")
+	if node.Stacked {
+		ctx.WriteString("stacked ")
+	}
+	ctx.WriteString("diagnostics ")
+	if node.Condition != nil {
+		ctx.WriteString("condition ")
+		node.Condition.Format(ctx)
+		ctx.WriteByte(' ')
+	}
+	for i, item := range node.Items {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		ctx.WriteString(item.Target)
+		ctx.WriteString(" = ")
+		ctx.WriteString(strings.ToLower(item.Name))
+	}
+}
+
+func (node *GetDiagnostics) GetStatementType() string { return "Get Diagnostics" }
+func (node *GetDiagnostics) GetQueryType() string     { return QueryTypeOth }
@@ -506,6 +506,10 @@ func (node *Replace) StmtKind() StmtKind {
 	return defaultStatusTyp
 }
 
+func (node *Merge) StmtKind() StmtKind {
+	return defaultStatusTyp
+}
+
 func (node *CreateIndex) StmtKind() StmtKind {
 	return defaultStatusTyp
 }
@@ -526,6 +530,30 @@ func (node *DropIndex) StmtKind() StmtKind {
 	return defaultStatusTyp
 }
 
+func (node *CreateTrigger) StmtKind() StmtKind {
+	return defaultStatusTyp
+}
+
+func (node *DropTrigger) StmtKind() StmtKind {
+	return defaultStatusTyp
+}
+
+func (node *CreateEvent) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
+
+func (node *AlterEvent) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
+
+func (node *DropEvent) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
+
+func (node *RefreshMaterializedView) StmtKind() StmtKind {
+	return defaultStatusTyp
+}
+
 func (node *AnalyzeStmt) StmtKind() StmtKind {
 	return frontendStatusTyp
 }
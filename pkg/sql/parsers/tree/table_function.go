@@ -18,9 +18,19 @@ type TableFunction struct {
 	statementImpl
 	Func       *FuncExpr
 	SelectStmt *Select
+	// JsonTable is the path and the columns of JSON_TABLE, Func holds the json expression
+	JsonTable *JsonTable
 }
 
 func (t *TableFunction) Format(ctx *FmtCtx) {
+	if t.JsonTable != nil {
+		ctx.WriteString("json_table(")
+		t.Func.Exprs.Format(ctx)
+		ctx.WriteString(", ")
+		t.JsonTable.Format(ctx)
+		ctx.WriteByte(')')
+		return
+	}
 	if t.Func != nil {
 		t.Func.Format(ctx)
 	}
@@ -32,3 +42,109 @@ func (t TableFunction) Id() string {
 
 func (t *TableFunction) GetStatementType() string { return "Table Function" }
 func (t *TableFunction) GetQueryType() string     { return QueryTypeOth }
+
+type JsonTableColumnKind int
+
+const (
+	JSON_TABLE_COLUMN_PATH JsonTableColumnKind = iota
+	JSON_TABLE_COLUMN_EXISTS
+	JSON_TABLE_COLUMN_ORDINALITY
+	JSON_TABLE_COLUMN_NESTED
+)
+
+type JsonTableOnResponseType int
+
+const (
+	JSON_TABLE_ON_NULL JsonTableOnResponseType = iota
+	JSON_TABLE_ON_DEFAULT
+	JSON_TABLE_ON_ERROR
+)
+
+// JsonTable is the row path and the columns of JSON_TABLE(expr, path COLUMNS (column_list))
+type JsonTable struct {
+	Path    string
+	Columns []*JsonTableColumn
+}
+
+func (node *JsonTable) Format(ctx *FmtCtx) {
+	ctx.WriteString("'" + FormatString(node.Path) + "'")
+	ctx.WriteString(" columns (")
+	for i, c := range node.Columns {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		c.Format(ctx)
+	}
+	ctx.WriteByte(')')
+}
+
+// JsonTableColumn is one column of JSON_TABLE, which is one of
+//
+//	name FOR ORDINALITY
+//	name type PATH path [on_empty] [on_error]
+//	name type EXISTS PATH path
+//	NESTED [PATH] path COLUMNS (column_list)
+type JsonTableColumn struct {
+	Kind JsonTableColumnKind
+	Name Identifier
+	Type ResolvableTypeReference
+	Path string
+	// nil means NULL ON EMPTY and NULL ON ERROR
+	OnEmpty *JsonTableOnResponse
+	OnError *JsonTableOnResponse
+	// columns of the nested path
+	Columns []*JsonTableColumn
+}
+
+func (node *JsonTableColumn) Format(ctx *FmtCtx) {
+	switch node.Kind {
+	case JSON_TABLE_COLUMN_ORDINALITY:
+		node.Name.Format(ctx)
+		ctx.WriteString(" for ordinality")
+	case JSON_TABLE_COLUMN_PATH, JSON_TABLE_COLUMN_EXISTS:
+		node.Name.Format(ctx)
+		ctx.WriteByte(' ')
+		node.Type.(*T).InternalType.Format(ctx)
+		if node.Kind == JSON_TABLE_COLUMN_EXISTS {
+			ctx.WriteString(" exists")
+		}
+		ctx.WriteString(" path '" + FormatString(node.Path) + "'")
+		if node.OnEmpty != nil {
+			ctx.WriteByte(' ')
+			node.OnEmpty.Format(ctx)
+			ctx.WriteString(" on empty")
+		}
+		if node.OnError != nil {
+			ctx.WriteByte(' ')
+			node.OnError.Format(ctx)
+			ctx.WriteString(" on error")
+		}
+	case JSON_TABLE_COLUMN_NESTED:
+		ctx.WriteString("nested path '" + FormatString(node.Path) + "' columns (")
+		for i, c := range node.Columns {
+			if i > 0 {
+				ctx.WriteString(", ")
+			}
+			c.Format(ctx)
+		}
+		ctx.WriteByte(')')
+	}
+}
+
+// JsonTableOnResponse is the response of ON EMPTY or ON ERROR
+type JsonTableOnResponse struct {
+	Type JsonTableOnResponseType
+	// json string of DEFAULT
+	Default string
+}
+
+func (node *JsonTableOnResponse) Format(ctx *FmtCtx) {
+	switch node.Type {
+	case JSON_TABLE_ON_NULL:
+		ctx.WriteString("null")
+	case JSON_TABLE_ON_DEFAULT:
+		ctx.WriteString("default '" + FormatString(node.Default) + "'")
+	case JSON_TABLE_ON_ERROR:
+		ctx.WriteString("error")
+	}
+}
@@ -21,6 +21,9 @@ type TableName struct {
 }
 
 func (tn TableName) Format(ctx *FmtCtx) {
+	if ctx.formatHook != nil && ctx.formatHook(ctx, tn) {
+		return
+	}
 	if tn.ExplicitCatalog {
 		ctx.WriteString(string(tn.CatalogName))
 		ctx.WriteByte('.')
@@ -0,0 +1,125 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+import "strings"
+
+type TriggerTiming int
+
+const (
+	TRIGGER_BEFORE TriggerTiming = iota
+	TRIGGER_AFTER
+)
+
+func (t TriggerTiming) String() string {
+	if t == TRIGGER_AFTER {
+		return "AFTER"
+	}
+	return "BEFORE"
+}
+
+type TriggerEvent int
+
+const (
+	TRIGGER_INSERT TriggerEvent = iota
+	TRIGGER_UPDATE
+	TRIGGER_DELETE
+)
+
+func (e TriggerEvent) String() string {
+	switch e {
+	case TRIGGER_UPDATE:
+		return "UPDATE"
+	case TRIGGER_DELETE:
+		return "DELETE"
+	default:
+		return "INSERT"
+	}
+}
+
+// TriggerOrder is the FOLLOWS or PRECEDES clause of CREATE TRIGGER
+type TriggerOrder struct {
+	Follows      bool
+	OtherTrigger Identifier
+}
+
+// CreateTrigger is the CREATE TRIGGER statement, the trigger is fired for each row.
+// Name is [db.]trigger_name.
+type CreateTrigger struct {
+	statementImpl
+	// Definer is nil if it is not specified
+	Definer     *UsernameRecord
+	IfNotExists bool
+	Name        *UnresolvedObjectName
+	Timing      TriggerTiming
+	Event       TriggerEvent
+	Table       *TableName
+	Order       *TriggerOrder
+	Body        Statement
+}
+
+func (node *CreateTrigger) Format(ctx *FmtCtx) {
+	ctx.WriteString("create ")
+	if node.Definer != nil {
+		ctx.WriteString("definer = ")
+		ctx.WriteString(node.Definer.Username)
+		ctx.WriteByte('@')
+		ctx.WriteString(node.Definer.Hostname)
+		ctx.WriteByte(' ')
+	}
+	ctx.WriteString("trigger ")
+	if node.IfNotExists {
+		ctx.WriteString("if not exists ")
+	}
+	node.Name.Format(ctx)
+	ctx.WriteString(" ")
+	ctx.WriteString(strings.ToLower(node.Timing.String()))
+	ctx.WriteString(" ")
+	ctx.WriteString(strings.ToLower(node.Event.String()))
+	ctx.WriteString(" on ")
+	node.Table.Format(ctx)
+	ctx.WriteString(" for each row ")
+	if node.Order != nil {
+		if node.Order.Follows {
+			ctx.WriteString("follows ")
+		} else {
+			ctx.WriteString("precedes ")
+		}
+		ctx.WriteString(string(node.Order.OtherTrigger))
+		ctx.WriteByte(' ')
+	}
+	node.Body.Format(ctx)
+}
+
+func (node *CreateTrigger) GetStatementType() string { return "Create Trigger" }
+func (node *CreateTrigger) GetQueryType() string     { return QueryTypeDDL }
+
+// DropTrigger is the DROP TRIGGER statement, Name is [db.]trigger_name.
+type DropTrigger struct {
+	statementImpl
+	IfExists bool
+	Name     *UnresolvedObjectName
+}
+
+func (node *DropTrigger) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop trigger ")
+	if node.IfExists {
+		ctx.WriteString("if exists ")
+	}
+	node.Name.Format(ctx)
+}
+
+func (node *DropTrigger) GetStatementType() string { return "Drop Trigger" }
+func (node *DropTrigger) GetQueryType() string     { return QueryTypeDDL }
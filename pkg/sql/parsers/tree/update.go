@@ -496,6 +496,10 @@ type ExportParam struct {
 	ForceQuote []string
 	// stage filename path
 	StageFilePath string
+	// file format, csv, jsonline or parquet
+	FileFormat string
+	// compression codec of the parquet file
+	Compression string
 }
 
 func (ep *ExportParam) Format(ctx *FmtCtx) {
@@ -539,6 +543,14 @@ func (ep *ExportParam) format(ctx *FmtCtx, withOutfile bool) {
 			prefix = ", "
 		}
 	}
+	if ep.FileFormat != "" {
+		ctx.WriteString(" format ")
+		ctx.WriteString(ep.FileFormat)
+	}
+	if ep.Compression != "" {
+		ctx.WriteString(" compression ")
+		ctx.WriteString(ep.Compression)
+	}
 }
 
 var _ LoadColumn = &UnresolvedName{}
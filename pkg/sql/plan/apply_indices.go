@@ -106,6 +106,14 @@ func (builder *QueryBuilder) applyIndicesForFilters(nodeID int32, node *plan.Nod
 		return nodeID
 	}
 
+	// 0. Functional and Multi-valued Index Check
+	{
+		builder.rewriteFunctionalIndexFilters(node, colRefCnt)
+		if retID := builder.applyIndicesForFiltersMultiValueIndex(nodeID, node); retID != nodeID {
+			return retID
+		}
+	}
+
 	// 1. Master Index Check
 	{
 		masterIndexes := make([]*plan.IndexDef, 0)
@@ -250,7 +258,7 @@ func (builder *QueryBuilder) applyIndicesForProject(nodeID int32, projNode *plan
 		// 1.a if there are no table scans with multi-table indexes, skip
 		multiTableIndexes := make(map[string]*MultiTableIndex)
 		for _, indexDef := range scanNode.TableDef.Indexes {
-			if catalog.IsIvfIndexAlgo(indexDef.IndexAlgo) {
+			if catalog.IsIvfIndexAlgo(indexDef.IndexAlgo) || catalog.IsHnswIndexAlgo(indexDef.IndexAlgo) {
 				if _, ok := multiTableIndexes[indexDef.IndexName]; !ok {
 					multiTableIndexes[indexDef.IndexName] = &MultiTableIndex{
 						IndexAlgo: catalog.ToLower(indexDef.IndexAlgo),
@@ -328,6 +336,25 @@ func (builder *QueryBuilder) applyIndicesForProject(nodeID int32, projNode *plan
 					distanceFunctionIndexed = true
 					multiTableIndexWithSortDistFn = multiTableIndex
 				}
+			case catalog.MoIndexHnswAlgo.ToString():
+				if !isHnswIndexApplicable(sortNode, scanNode) {
+					continue
+				}
+				idxDef0 := multiTableIndex.IndexDefs[catalog.SystemSI_HNSW_TblType_Metadata]
+				storedParams, err := catalog.IndexParamsStringToMap(idxDef0.IndexAlgoParams)
+				if err != nil {
+					continue
+				}
+
+				// if index is not the order by column, skip
+				if scanNode.TableDef.Name2ColIndex[idxDef0.Parts[0]] != colPosOrderBy {
+					continue
+				}
+
+				if storedParams[catalog.IndexAlgoParamOpType] == distFuncOpTypes[distFnExpr.Func.ObjName] {
+					distanceFunctionIndexed = true
+					multiTableIndexWithSortDistFn = multiTableIndex
+				}
 			}
 			if distanceFunctionIndexed {
 				break
@@ -337,6 +364,10 @@ func (builder *QueryBuilder) applyIndicesForProject(nodeID int32, projNode *plan
 			goto END0
 		}
 
+		if multiTableIndexWithSortDistFn.IndexAlgo == catalog.MoIndexHnswAlgo.ToString() {
+			return builder.applyIndicesForSortUsingHnswIndex(nodeID, sortNode, scanNode, multiTableIndexWithSortDistFn)
+		}
+
 		newSortNode := builder.applyIndicesForSortUsingVectorIndex(nodeID, projNode, sortNode, scanNode,
 			colRefCnt, idxColMap, multiTableIndexWithSortDistFn, colPosOrderBy)
 
@@ -377,7 +408,7 @@ func (builder *QueryBuilder) applyIndicesForFiltersRegularIndex(nodeID int32, no
 
 	indexes := make([]*IndexDef, 0, len(node.TableDef.Indexes))
 	for i := range node.TableDef.Indexes {
-		if node.TableDef.Indexes[i].IndexAlgo == "fulltext" || !node.TableDef.Indexes[i].TableExist {
+		if node.TableDef.Indexes[i].IndexAlgo == "fulltext" || catalog.IsMultiValueIndexAlgo(node.TableDef.Indexes[i].IndexAlgo) || !node.TableDef.Indexes[i].TableExist {
 			continue
 		}
 		indexes = append(indexes, node.TableDef.Indexes[i])
@@ -0,0 +1,334 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"slices"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// multiValueIndexLookupPath expands a JSON array to its elements, a scalar is a single element
+const multiValueIndexLookupPath = "$[0 to last]"
+
+// rewriteFunctionalIndexFilters replaces the expressions of the functional index key parts in
+// the filters of the table scan with their hidden columns, so that the indexes on the hidden
+// columns can be applied to the filters.
+func (builder *QueryBuilder) rewriteFunctionalIndexFilters(node *plan.Node, colRefCnt map[[2]int32]int) {
+	tag := node.BindingTags[0]
+	var keyExprs []*plan.Expr
+	var keyCols []int32
+	for i, col := range node.TableDef.Cols {
+		if !isFunctionalIndexCol(col) || col.Generated.Expr == nil {
+			continue
+		}
+		keyExpr, err := replaceGeneratedColRef(node.TableDef, col.Generated.Expr, func(colIdx int) (*plan.Expr, error) {
+			return GetColExpr(node.TableDef.Cols[colIdx].Typ, tag, int32(colIdx)), nil
+		})
+		if err != nil {
+			continue
+		}
+		keyExprs = append(keyExprs, keyExpr)
+		keyCols = append(keyCols, int32(i))
+	}
+	if len(keyExprs) == 0 {
+		return
+	}
+
+	var rewrite func(expr *plan.Expr) *plan.Expr
+	rewrite = func(expr *plan.Expr) *plan.Expr {
+		for i, keyExpr := range keyExprs {
+			if isSameFunctionalIndexExpr(expr, keyExpr) {
+				decColRefCnt(expr, colRefCnt)
+				colRefCnt[[2]int32{tag, keyCols[i]}]++
+				return GetColExpr(node.TableDef.Cols[keyCols[i]].Typ, tag, keyCols[i])
+			}
+		}
+		if f := expr.GetF(); f != nil {
+			for i, arg := range f.Args {
+				f.Args[i] = rewrite(arg)
+			}
+			return builder.rebindFunctionalIndexCompare(expr, tag, keyCols)
+		}
+		return expr
+	}
+	for i, filter := range node.FilterList {
+		node.FilterList[i] = rewrite(filter)
+	}
+}
+
+// rebindFunctionalIndexCompare binds the comparison again without the cast of the hidden column,
+// which the binder added to the key expression for the constant, so that the constant is cast to
+// the type of the hidden column instead and the index on it can be applied.
+func (builder *QueryBuilder) rebindFunctionalIndexCompare(expr *plan.Expr, tag int32, keyCols []int32) *plan.Expr {
+	f := expr.GetF()
+	switch f.Func.ObjName {
+	case "=", "<", "<=", ">", ">=", "<>", "between", "in":
+	default:
+		return expr
+	}
+
+	args := slices.Clone(f.Args)
+	found := false
+	for i, arg := range args {
+		castFn := arg.GetF()
+		if castFn == nil || castFn.Func.ObjName != "cast" {
+			continue
+		}
+		col := castFn.Args[0].GetCol()
+		if col != nil && col.RelPos == tag && slices.Contains(keyCols, col.ColPos) {
+			args[i] = castFn.Args[0]
+			found = true
+		}
+	}
+	if !found {
+		return expr
+	}
+	newExpr, err := BindFuncExprImplByPlanExpr(builder.GetContext(), f.Func.ObjName, args)
+	if err != nil {
+		return expr
+	}
+	if newExpr, err = ConstantFold(batch.EmptyForConstFoldBatch, newExpr, builder.compCtx.GetProcess(), false, true); err != nil {
+		return expr
+	}
+	return newExpr
+}
+
+func decColRefCnt(expr *plan.Expr, colRefCnt map[[2]int32]int) {
+	switch ne := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		colRefCnt[[2]int32{ne.Col.RelPos, ne.Col.ColPos}]--
+	case *plan.Expr_F:
+		for _, arg := range ne.F.Args {
+			decColRefCnt(arg, colRefCnt)
+		}
+	}
+}
+
+// isSameFunctionalIndexExpr returns true if the filter expression computes the same value as
+// the expression of the functional index key part.
+func isSameFunctionalIndexExpr(expr, keyExpr *plan.Expr) bool {
+	if expr.Typ.Id != keyExpr.Typ.Id {
+		return false
+	}
+	switch ne := keyExpr.Expr.(type) {
+	case *plan.Expr_Col:
+		col := expr.GetCol()
+		return col != nil && col.RelPos == ne.Col.RelPos && col.ColPos == ne.Col.ColPos
+	case *plan.Expr_Lit:
+		lit := expr.GetLit()
+		return lit != nil && lit.String() == ne.Lit.String()
+	case *plan.Expr_T:
+		return expr.GetT() != nil && expr.Typ.Width == keyExpr.Typ.Width && expr.Typ.Scale == keyExpr.Typ.Scale
+	case *plan.Expr_F:
+		f := expr.GetF()
+		if f == nil || f.Func.ObjName != ne.F.Func.ObjName || len(f.Args) != len(ne.F.Args) {
+			return false
+		}
+		for i := range f.Args {
+			if !isSameFunctionalIndexExpr(f.Args[i], ne.F.Args[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// applyIndicesForFiltersMultiValueIndex applies the multi-valued index to a MEMBER OF, JSON_CONTAINS
+// or JSON_OVERLAPS filter on its hidden column with constant values. The index table is scanned
+// for the elements and joined to the table by the primary key, the filter is still evaluated on
+// the table since the index only finds the candidate rows.
+func (builder *QueryBuilder) applyIndicesForFiltersMultiValueIndex(nodeID int32, node *plan.Node) int32 {
+	scanSnapshot := node.ScanSnapshot
+	if scanSnapshot == nil {
+		scanSnapshot = &Snapshot{}
+	}
+
+	for _, idxDef := range node.TableDef.Indexes {
+		if !idxDef.TableExist || !catalog.IsMultiValueIndexAlgo(idxDef.IndexAlgo) {
+			continue
+		}
+		colPos, ok := node.TableDef.Name2ColIndex[idxDef.Parts[0]]
+		if !ok {
+			continue
+		}
+		idxObjRef, idxTableDef := builder.compCtx.Resolve(node.ObjRef.SchemaName, idxDef.IndexTableName, scanSnapshot)
+		if idxTableDef == nil {
+			continue
+		}
+		valuePos := int32(slices.IndexFunc(idxTableDef.Cols, func(col *ColDef) bool {
+			return col.Name == catalog.MultiValueIndex_TabCol_Value
+		}))
+		if valuePos == -1 {
+			continue
+		}
+
+		for _, filter := range node.FilterList {
+			values := builder.getMultiValueIndexKeys(filter, node.BindingTags[0], colPos, idxTableDef.Cols[valuePos].Typ)
+			if len(values) == 0 {
+				continue
+			}
+
+			idxTag := builder.genNewTag()
+			builder.addNameByColRef(idxTag, idxTableDef)
+			valueExpr := GetColExpr(idxTableDef.Cols[valuePos].Typ, idxTag, valuePos)
+			var idxFilter *plan.Expr
+			var err error
+			if len(values) == 1 {
+				idxFilter, err = BindFuncExprImplByPlanExpr(builder.GetContext(), "=", []*plan.Expr{valueExpr, values[0]})
+			} else {
+				idxFilter, err = bindFuncExprAndConstFold(builder.GetContext(), builder.compCtx.GetProcess(), "in", []*plan.Expr{
+					valueExpr,
+					{
+						Typ:  valueExpr.Typ,
+						Expr: &plan.Expr_List{List: &plan.ExprList{List: values}},
+					},
+				})
+			}
+			if err != nil {
+				continue
+			}
+
+			idxTableNodeID := builder.appendNode(&plan.Node{
+				NodeType: plan.Node_TABLE_SCAN,
+				TableDef: idxTableDef,
+				ObjRef:   idxObjRef,
+				IndexScanInfo: plan.IndexScanInfo{
+					IsIndexScan:    true,
+					IndexName:      idxDef.IndexName,
+					BelongToTable:  node.ObjRef.ObjName,
+					Parts:          slices.Clone(idxDef.Parts),
+					IndexTableName: idxDef.IndexTableName,
+				},
+				ParentObjRef: DeepCopyObjectRef(node.ObjRef),
+				FilterList:   []*plan.Expr{idxFilter},
+				BindingTags:  []int32{idxTag},
+				ScanSnapshot: node.ScanSnapshot,
+			}, builder.ctxByNode[node.NodeId])
+			forceScanNodeStatsTP(idxTableNodeID, builder)
+
+			// the primary keys found by the index are pushed to the table scan as a runtime filter
+			pkIdx := node.TableDef.Name2ColIndex[node.TableDef.Pkey.PkeyColName]
+			pkExpr := GetColExpr(node.TableDef.Cols[pkIdx].Typ, node.BindingTags[0], pkIdx)
+			joinCond, _ := BindFuncExprImplByPlanExpr(builder.GetContext(), "=", []*plan.Expr{
+				pkExpr,
+				GetColExpr(pkExpr.Typ, idxTag, 0),
+			})
+			joinNodeID := builder.appendNode(&plan.Node{
+				NodeType: plan.Node_JOIN,
+				Children: []int32{node.NodeId, idxTableNodeID},
+				JoinType: plan.Node_INDEX,
+				OnList:   []*plan.Expr{joinCond},
+				Limit:    node.Limit,
+				Offset:   node.Offset,
+			}, builder.ctxByNode[node.NodeId])
+			node.Limit, node.Offset = nil, nil
+			return joinNodeID
+		}
+	}
+	return nodeID
+}
+
+// getMultiValueIndexKeys returns the elements to look up in the multi-valued index for the filter,
+// or nil if the filter can not use the index:
+//
+//	value MEMBER OF (col)         -> value
+//	JSON_CONTAINS(col, array)     -> the elements of array, all of them must be found
+//	JSON_OVERLAPS(col, array)     -> the elements of array, any of them may be found
+func (builder *QueryBuilder) getMultiValueIndexKeys(filter *plan.Expr, tag int32, colPos int32, elemType plan.Type) []*plan.Expr {
+	fn := filter.GetF()
+	if fn == nil || len(fn.Args) != 2 {
+		return nil
+	}
+	isKeyCol := func(expr *plan.Expr) bool {
+		col := expr.GetCol()
+		return col != nil && col.RelPos == tag && col.ColPos == colPos
+	}
+
+	var values []*plan.Expr
+	switch fn.Func.ObjName {
+	case "member_of":
+		if !isKeyCol(fn.Args[1]) || fn.Args[0].GetLit() == nil || fn.Args[0].GetLit().Isnull {
+			return nil
+		}
+		values = []*plan.Expr{fn.Args[0]}
+	case "json_contains", "json_overlaps":
+		candidate := fn.Args[1]
+		if !isKeyCol(fn.Args[0]) {
+			if fn.Func.ObjName != "json_overlaps" || !isKeyCol(fn.Args[1]) {
+				return nil
+			}
+			candidate = fn.Args[0]
+		}
+		values = getJsonLiteralElems(candidate)
+	}
+	if len(values) == 0 {
+		return nil
+	}
+
+	// the values are cast to the element type of the index, the index can not be used if any of
+	// them can not be cast
+	proc := builder.compCtx.GetProcess()
+	for i, value := range values {
+		castExpr, err := forceCastExpr(builder.GetContext(), value, elemType)
+		if err != nil {
+			return nil
+		}
+		if castExpr, err = ConstantFold(batch.EmptyForConstFoldBatch, castExpr, proc, false, true); err != nil || castExpr.GetLit() == nil || castExpr.GetLit().Isnull {
+			return nil
+		}
+		values[i] = castExpr
+	}
+	return values
+}
+
+// getJsonLiteralElems returns the scalar elements of the JSON text literal as constant expressions
+func getJsonLiteralElems(expr *plan.Expr) []*plan.Expr {
+	lit := expr.GetLit()
+	if lit == nil || lit.Isnull || !types.T(expr.Typ.Id).IsMySQLString() {
+		return nil
+	}
+	bj, err := types.ParseStringToByteJson(lit.GetSval())
+	if err != nil {
+		return nil
+	}
+	path, err := types.ParseStringToPath(multiValueIndexLookupPath)
+	if err != nil {
+		return nil
+	}
+
+	var values []*plan.Expr
+	for _, elem := range bj.QueryValues(&path) {
+		switch elem.Type {
+		case bytejson.TpCodeInt64:
+			values = append(values, makePlan2Int64ConstExprWithType(elem.GetInt64()))
+		case bytejson.TpCodeUint64:
+			values = append(values, makePlan2Uint64ConstExprWithType(elem.GetUint64()))
+		case bytejson.TpCodeFloat64:
+			values = append(values, makePlan2Float64ConstExprWithType(elem.GetFloat64()))
+		case bytejson.TpCodeString:
+			values = append(values, makePlan2StringConstExprWithType(string(elem.GetString())))
+		default:
+			// the objects, arrays and literals are not indexed by the element type
+			return nil
+		}
+	}
+	return values
+}
@@ -0,0 +1,142 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/hnsw"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// applyIndicesForSortUsingHnswIndex rewrites
+//
+//	SELECT ... FROM tbl ORDER BY l2_distance(vec, '[...]') LIMIT k
+//
+// into
+//
+//	SELECT ... FROM tbl JOIN hnsw_search(cfg, '[...]') f ON tbl.pk = f.pk
+//	ORDER BY l2_distance(vec, '[...]') LIMIT k
+//
+// hnsw_search returns the approximate k nearest primary keys, and the sort node is kept to
+// order the joined rows by the exact distance.
+func (builder *QueryBuilder) applyIndicesForSortUsingHnswIndex(nodeID int32, sortNode, scanNode *plan.Node,
+	multiTableIndex *MultiTableIndex) int32 {
+
+	ctx := builder.ctxByNode[nodeID]
+	metaDef := multiTableIndex.IndexDefs[catalog.SystemSI_HNSW_TblType_Metadata]
+	distFnExpr := sortNode.OrderBy[0].Expr.GetF()
+
+	cfg, err := getHnswConfig(scanNode, metaDef)
+	if err != nil {
+		return nodeID
+	}
+
+	// the query vector is bound later, use an empty string as the placeholder
+	exprs := tree.Exprs{
+		tree.NewNumVal[string](cfg, cfg, false, tree.P_char),
+		tree.NewNumVal[string]("", "", false, tree.P_char),
+	}
+	name := tree.NewCStr(hnsw_search_func_name, 1)
+	tmpTableFunc := &tree.AliasedTableExpr{
+		Expr: &tree.TableFunction{
+			Func: &tree.FuncExpr{
+				Func:     tree.FuncName2ResolvableFunctionReference(tree.NewUnresolvedName(name)),
+				FuncName: name,
+				Exprs:    exprs,
+				Type:     tree.FUNC_TYPE_TABLE,
+			},
+		},
+		As: tree.AliasClause{
+			Alias: tree.Identifier(fmt.Sprintf("mo_hnsw_alias_%d", nodeID)),
+		},
+	}
+	tfNodeID, err := builder.buildTable(tmpTableFunc, ctx, -1, nil)
+	if err != nil {
+		return nodeID
+	}
+	tfNode := builder.qry.Nodes[tfNodeID]
+	tfNode.TblFuncExprList[1] = DeepCopyExpr(distFnExpr.Args[1])
+
+	// search the nearest limit + offset keys
+	k := sortNode.Limit.GetLit().GetU64Val()
+	if sortNode.Offset != nil {
+		k += sortNode.Offset.GetLit().GetU64Val()
+	}
+	tfNode.Limit = makePlan2Uint64ConstExprWithType(k)
+
+	pkPos := scanNode.TableDef.Name2ColIndex[scanNode.TableDef.Pkey.PkeyColName]
+	pkType := scanNode.TableDef.Cols[pkPos].Typ
+	wherePkEqPk, err := BindFuncExprImplByPlanExpr(builder.GetContext(), "=", []*Expr{
+		{
+			Typ: pkType,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: scanNode.BindingTags[0],
+					ColPos: pkPos, // tbl.pk
+				},
+			},
+		},
+		{
+			Typ: tfNode.TableDef.Cols[0].Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: tfNode.BindingTags[0],
+					ColPos: 0, // f.pk
+				},
+			},
+		},
+	})
+	if err != nil {
+		return nodeID
+	}
+
+	joinNodeID := builder.appendNode(&plan.Node{
+		NodeType: plan.Node_JOIN,
+		Children: []int32{scanNode.NodeId, tfNodeID},
+		JoinType: plan.Node_INNER,
+		OnList:   []*Expr{wherePkEqPk},
+	}, ctx)
+	sortNode.Children[0] = joinNodeID
+
+	return nodeID
+}
+
+// isHnswIndexApplicable checks the hnsw index can return the rows of the sort node:
+// no filter on the table scan, since the filtered out rows would reduce the result
+// below the limit, and a constant limit to pass to the search.
+func isHnswIndexApplicable(sortNode, scanNode *plan.Node) bool {
+	if len(scanNode.FilterList) > 0 || len(scanNode.BlockFilterList) > 0 {
+		return false
+	}
+	if sortNode.Limit == nil || sortNode.Limit.GetLit() == nil {
+		return false
+	}
+	if sortNode.Offset != nil && sortNode.Offset.GetLit() == nil {
+		return false
+	}
+	return scanNode.TableDef.Pkey != nil && len(scanNode.TableDef.Pkey.Names) == 1
+}
+
+func getHnswConfig(scanNode *plan.Node, metaDef *plan.IndexDef) (string, error) {
+	cfg, err := hnsw.NewConfig(scanNode.ObjRef.SchemaName, scanNode.TableDef.Name, metaDef.IndexTableName,
+		scanNode.TableDef.Pkey.PkeyColName, metaDef.Parts[0], metaDef.IndexAlgoParams)
+	if err != nil {
+		return "", err
+	}
+	return cfg.String(), nil
+}
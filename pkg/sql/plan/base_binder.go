@@ -116,6 +116,9 @@ func (b *baseBinder) baseBindExpr(astExpr tree.Expr, depth int32, isRoot bool) (
 		expr, err = b.bindFuncExprImplByAstExpr("serial_extract", []tree.Expr{astExpr}, depth)
 
 	case *tree.CastExpr:
+		if exprImpl.Array {
+			return nil, moerr.NewNotSupported(b.GetContext(), "CAST ... ARRAY is only supported in a multi-valued index key part")
+		}
 		expr, err = b.impl.BindExpr(exprImpl.Expr, depth, false)
 		if err != nil {
 			return
@@ -311,6 +314,7 @@ func (b *baseBinder) baseBindColRef(astExpr *tree.UnresolvedName, depth int32, i
 	relPos := NotFound
 	colPos := NotFound
 	var typ *plan.Type
+	var colBinding *Binding
 	localErrCtx := errutil.ContextWithNoReport(b.GetContext(), true)
 
 	if len(table) == 0 {
@@ -320,6 +324,7 @@ func (b *baseBinder) baseBindColRef(astExpr *tree.UnresolvedName, depth int32, i
 				colPos = binding.colIdByName[col]
 				typ = DeepCopyType(binding.types[colPos])
 				table = binding.table
+				colBinding = binding
 			} else {
 				return nil, moerr.NewInvalidInputf(b.GetContext(), "ambiguous column reference '%v'", name)
 			}
@@ -335,6 +340,7 @@ func (b *baseBinder) baseBindColRef(astExpr *tree.UnresolvedName, depth int32, i
 			if colPos != NotFound {
 				typ = DeepCopyType(binding.types[colPos])
 				relPos = binding.tag
+				colBinding = binding
 			} else {
 				err = moerr.NewInvalidInputf(localErrCtx, "column '%s' does not exist", name)
 			}
@@ -379,6 +385,11 @@ func (b *baseBinder) baseBindColRef(astExpr *tree.UnresolvedName, depth int32, i
 	}
 
 	if colPos != NotFound {
+		// the virtual generated column is computed from the columns it refers to
+		if expr, err = b.bindVirtualColRef(colBinding, colPos, depth); expr != nil || err != nil {
+			return
+		}
+
 		b.boundCols = append(b.boundCols, table+"."+col)
 
 		expr = &plan.Expr{
@@ -894,6 +905,8 @@ func (b *baseBinder) bindComparisonExpr(astExpr *tree.ComparisonExpr, depth int3
 		op = "reg_match"
 	case tree.NOT_REG_MATCH:
 		op = "not_reg_match"
+	case tree.MEMBER_OF:
+		op = "member_of"
 	default:
 		return nil, moerr.NewNYIf(b.GetContext(), "'%v'", astExpr)
 	}
@@ -595,6 +595,19 @@ func (builder *QueryBuilder) appendDedupAndMultiUpdateNodesForBindInsert(
 		}
 	}
 
+	// check constraints are evaluated on the final rows, after on duplicate key update
+	checkFilters, err := buildCheckFilters(builder, tableDef, tableDef.Name, selectTag, colName2Idx)
+	if err != nil {
+		return 0, err
+	}
+	if len(checkFilters) > 0 {
+		lastNodeID = builder.appendNode(&plan.Node{
+			NodeType:   plan.Node_FILTER,
+			Children:   []int32{lastNodeID},
+			FilterList: checkFilters,
+		}, bindCtx)
+	}
+
 	dmlNode := &plan.Node{
 		NodeType:    plan.Node_MULTI_UPDATE,
 		BindingTags: []int32{builder.genNewTag()},
@@ -788,6 +801,10 @@ func (builder *QueryBuilder) initInsertStmt(bindCtx *BindContext, stmt *tree.Ins
 		return 0, nil, nil, moerr.NewInvalidInput(builder.GetContext(), "insert has unknown select statement")
 	}
 
+	if err = checkInsertGeneratedCols(builder.GetContext(), stmt, tableDef, insertColumns); err != nil {
+		return 0, nil, nil, err
+	}
+
 	if err = builder.addBinding(lastNodeID, tree.AliasClause{Alias: derivedTableName}, bindCtx); err != nil {
 		return 0, nil, nil, err
 	}
@@ -855,9 +872,12 @@ func (builder *QueryBuilder) appendNodesForInsertStmt(
 	)
 
 	columnIsNull := make(map[string]bool)
+	// position in projList1 of the columns in tableDef.Cols
+	colPosInProjList1 := make(map[int]int, len(tableDef.Cols))
 
 	for i, col := range tableDef.Cols {
 		if oldExpr, exists := insertColToExpr[col.Name]; exists {
+			colPosInProjList1[i] = len(projList1)
 			projList2 = append(projList2, &plan.Expr{
 				Typ: oldExpr.Typ,
 				Expr: &plan.Expr_Col{
@@ -913,7 +933,7 @@ func (builder *QueryBuilder) appendNodesForInsertStmt(
 				return 0, nil, nil, err
 			}
 
-			if !col.Typ.AutoIncr {
+			if !col.Typ.AutoIncr && col.Generated == nil {
 				if lit := defExpr.GetLit(); lit != nil {
 					if lit.Isnull {
 						columnIsNull[col.Name] = true
@@ -921,6 +941,7 @@ func (builder *QueryBuilder) appendNodesForInsertStmt(
 				}
 			}
 
+			colPosInProjList1[i] = len(projList1)
 			projList2 = append(projList2, &plan.Expr{
 				Typ: defExpr.Typ,
 				Expr: &plan.Expr_Col{
@@ -936,6 +957,15 @@ func (builder *QueryBuilder) appendNodesForInsertStmt(
 		colName2Idx[tableDef.Name+"."+col.Name] = int32(i)
 	}
 
+	// the values of the generated columns are computed from the inserted ones
+	err := fillGeneratedColExprs(tableDef, projList1, func(colIdx int) (int, bool) {
+		pos, ok := colPosInProjList1[colIdx]
+		return pos, ok
+	})
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
 	skipUniqueIdx := make([]bool, len(tableDef.Indexes))
 	pkName := tableDef.Pkey.PkeyColName
 	pkPos := tableDef.Name2ColIndex[pkName]
@@ -0,0 +1,987 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
+)
+
+const mergeMatchedMoreThanOnceMsg = "target row matched more than once"
+
+// bindMerge binds the merge statement on top of the join and multi_update operators.
+//
+// The source is joined with the target table, left joined if there is a WHEN NOT MATCHED clause,
+// and the index of the first clause whose condition is true is computed for each row. The rows
+// without any clause are filtered out. The matched rows are deleted from the target table and
+// the new values are inserted again, except for the rows of the delete clauses which are filtered
+// by the insert filter column of multi_update. The not matched rows are only inserted.
+// The triggers of the target table are fired by a postdml operator after the clause index is computed.
+func (builder *QueryBuilder) bindMerge(stmt *tree.Merge, bindCtx *BindContext) (int32, error) {
+	ctx := builder.GetContext()
+	dmlCtx := NewDMLContext()
+	dmlCtx.bindsTriggers = true
+	err := dmlCtx.ResolveTables(builder.compCtx, tree.TableExprs{stmt.Table}, nil, nil, false)
+	if err != nil {
+		return 0, err
+	}
+
+	objRef := dmlCtx.objRefs[0]
+	tableDef := dmlCtx.tableDefs[0]
+	alias := dmlCtx.aliases[0]
+	pkName := tableDef.Pkey.PkeyColName
+
+	if tableDef.TableType != catalog.SystemOrdinaryRel {
+		return 0, moerr.NewUnsupportedDML(ctx, "merge into non-ordinary table")
+	}
+	if tableDef.Partition != nil {
+		return 0, moerr.NewUnsupportedDML(ctx, "merge into partition table")
+	}
+	for _, idxDef := range tableDef.Indexes {
+		if !catalog.IsRegularIndexAlgo(idxDef.IndexAlgo) {
+			return 0, moerr.NewUnsupportedDML(ctx, "have vector index table")
+		}
+	}
+
+	// the primary key and the unique keys are never updated, so only the inserted rows need dedup
+	keyCols := make(map[string]bool)
+	for _, colName := range tableDef.Pkey.Names {
+		keyCols[colName] = true
+	}
+	for _, idxDef := range tableDef.Indexes {
+		if idxDef.TableExist && idxDef.Unique {
+			for _, part := range idxDef.Parts {
+				keyCols[catalog.ResolveAlias(part)] = true
+			}
+		}
+	}
+
+	var hasMatched, hasNotMatched, hasDelete, hasInsert, hasWrite bool
+	// the columns written by each clause
+	clauseExprs := make([]map[string]tree.Expr, len(stmt.Whens))
+	updatedCols := make(map[string]bool)
+
+	for i, when := range stmt.Whens {
+		if when.Matched {
+			hasMatched = true
+		} else {
+			hasNotMatched = true
+		}
+
+		switch when.Action {
+		case tree.MergeDelete:
+			hasDelete = true
+
+		case tree.MergeUpdate:
+			hasWrite = true
+			exprs := make(map[string]tree.Expr, len(when.Exprs))
+			for _, updateExpr := range when.Exprs {
+				if len(updateExpr.Names) > 1 {
+					return 0, moerr.NewNYI(ctx, "unsupport expr")
+				}
+				parts := updateExpr.Names[0]
+				if parts.NumParts > 1 && parts.TblName() != alias {
+					return 0, moerr.NewNoSuchTable(ctx, "", parts.TblNameOrigin())
+				}
+				col := FindColumn(tableDef.Cols, parts.ColName())
+				if col == nil || col.Hidden {
+					return 0, moerr.NewInternalErrorf(ctx, "column '%v' not found in table", parts.ColNameOrigin())
+				}
+				if keyCols[col.Name] {
+					return 0, moerr.NewUnsupportedDML(ctx, "merge update primary key or unique key")
+				}
+				exprs[col.Name] = updateExpr.Expr
+				updatedCols[col.Name] = true
+			}
+			if err = addGeneratedColsToUpdate(ctx, tableDef, exprs); err != nil {
+				return 0, err
+			}
+			clauseExprs[i] = exprs
+
+		case tree.MergeInsert:
+			hasInsert = true
+			hasWrite = true
+			if pkName == catalog.FakePrimaryKeyColName {
+				return 0, moerr.NewUnsupportedDML(ctx, "merge insert into table without primary key")
+			}
+
+			var insertCols []string
+			if when.Columns == nil {
+				for _, col := range tableDef.Cols {
+					if !col.Hidden {
+						insertCols = append(insertCols, col.Name)
+					}
+				}
+			} else {
+				for _, column := range when.Columns {
+					colName := strings.ToLower(string(column))
+					if col := FindColumn(tableDef.Cols, colName); col == nil || col.Hidden {
+						return 0, moerr.NewBadFieldError(ctx, colName, tableDef.Name)
+					}
+					insertCols = append(insertCols, colName)
+				}
+			}
+			if len(insertCols) != len(when.Values) {
+				return 0, moerr.NewWrongValueCountOnRow(ctx, 1)
+			}
+
+			exprs := make(map[string]tree.Expr, len(insertCols))
+			for j, colName := range insertCols {
+				col := FindColumn(tableDef.Cols, colName)
+				if _, ok := when.Values[j].(*tree.DefaultVal); !ok && col.Generated != nil {
+					return 0, moerr.NewInvalidInputf(ctx, "The value specified for generated column '%s' in table '%s' is not allowed.", col.GetOriginCaseName(), tableDef.Name)
+				}
+				exprs[colName] = when.Values[j]
+			}
+			clauseExprs[i] = exprs
+		}
+	}
+
+	for _, col := range tableDef.Cols {
+		if col.Typ.AutoIncr && hasInsert {
+			return 0, moerr.NewUnsupportedDML(ctx, "merge insert into table with auto_increment column")
+		}
+		if col.OnUpdate != nil && col.OnUpdate.Expr != nil && len(updatedCols) > 0 && !updatedCols[col.Name] {
+			return 0, moerr.NewUnsupportedDML(ctx, "update column with on update")
+		}
+	}
+
+	// select target.*, clause index, the values of the clauses from source join target
+	var selectList []tree.SelectExpr
+	colName2Idx := make(map[string]int32)
+	for _, col := range tableDef.Cols {
+		colName2Idx[col.Name] = int32(len(selectList))
+		selectList = append(selectList, tree.SelectExpr{
+			Expr: tree.NewUnresolvedName(tree.NewCStr(alias, bindCtx.lower), tree.NewCStr(col.Name, 1)),
+		})
+	}
+
+	rowIDName := tree.NewUnresolvedName(tree.NewCStr(alias, bindCtx.lower), tree.NewCStr(catalog.Row_ID, 1))
+	whens := make([]*tree.When, len(stmt.Whens))
+	for i, when := range stmt.Whens {
+		var cond tree.Expr
+		if when.Matched {
+			cond = tree.NewIsNotNullExpr(rowIDName)
+		} else {
+			cond = tree.NewIsNullExpr(rowIDName)
+		}
+		if when.Cond != nil {
+			cond = tree.NewAndExpr(cond, when.Cond)
+		}
+		whens[i] = tree.NewWhen(cond, tree.NewNumVal(int64(i), strconv.Itoa(i), false, tree.P_int64))
+	}
+	clauseIdxPos := int32(len(selectList))
+	selectList = append(selectList, tree.SelectExpr{
+		Expr: tree.NewCaseExpr(nil, whens, nil),
+	})
+
+	// a target row must not be changed by more than one source row, count the source rows
+	// which take an action on each matched target row. The not matched source rows all
+	// have a null rowid, they are not counted to keep them out of the same partition.
+	matchCountPos := int32(-1)
+	if hasMatched {
+		matchCountPos = int32(len(selectList))
+		selectList = append(selectList, tree.SelectExpr{
+			Expr: &tree.FuncExpr{
+				Func: tree.FuncName2ResolvableFunctionReference(tree.NewUnresolvedColName("count")),
+				Exprs: tree.Exprs{tree.NewCaseExpr(nil, []*tree.When{
+					tree.NewWhen(
+						tree.NewAndExpr(tree.NewIsNotNullExpr(rowIDName), tree.NewIsNotNullExpr(tree.NewCaseExpr(nil, whens, nil))),
+						tree.NewNumVal(int64(1), "1", false, tree.P_int64),
+					),
+				}, nil)},
+				WindowSpec: &tree.WindowSpec{
+					PartitionBy: tree.Exprs{rowIDName},
+					Frame: &tree.FrameClause{
+						Type:  tree.Range,
+						Start: &tree.FrameBound{Type: tree.Preceding, UnBounded: true},
+						End:   &tree.FrameBound{Type: tree.Following, UnBounded: true},
+					},
+				},
+			},
+		})
+	}
+
+	// position in the select list of the value of each column written by each clause
+	valuePos := make([]map[string]int32, len(stmt.Whens))
+	for i, exprs := range clauseExprs {
+		if exprs == nil {
+			continue
+		}
+		valuePos[i] = make(map[string]int32, len(exprs))
+		for _, col := range tableDef.Cols {
+			if expr, ok := exprs[col.Name]; ok {
+				valuePos[i][col.Name] = int32(len(selectList))
+				selectList = append(selectList, tree.SelectExpr{
+					Expr: expr,
+				})
+			}
+		}
+	}
+
+	joinType := tree.JOIN_TYPE_INNER
+	if hasNotMatched {
+		joinType = tree.JOIN_TYPE_LEFT
+	}
+	selectAst := &tree.Select{
+		Select: &tree.SelectClause{
+			Exprs: selectList,
+			From: &tree.From{
+				Tables: tree.TableExprs{
+					tree.NewJoinTableExpr(joinType, stmt.Source, stmt.Table, tree.NewOnJoinCond(stmt.On)),
+				},
+			},
+		},
+	}
+
+	lastNodeID, err := builder.bindSelect(selectAst, bindCtx, false)
+	if err != nil {
+		return 0, err
+	}
+
+	selectNode := builder.qry.Nodes[lastNodeID]
+	selectTag := selectNode.BindingTags[0]
+	selectCol := func(pos int32) *plan.Expr {
+		return &plan.Expr{
+			Typ: selectNode.ProjectList[pos].Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: selectTag,
+					ColPos: pos,
+				},
+			},
+		}
+	}
+
+	clauseIdxExpr := selectCol(clauseIdxPos)
+	notNullExpr, err := BindFuncExprImplByPlanExpr(ctx, "isnotnull", []*plan.Expr{DeepCopyExpr(clauseIdxExpr)})
+	if err != nil {
+		return 0, err
+	}
+	filters := []*plan.Expr{notNullExpr}
+	if matchCountPos >= 0 {
+		matchOnceExpr, err := BindFuncExprImplByPlanExpr(ctx, "<=", []*plan.Expr{selectCol(matchCountPos), makePlan2Int64ConstExprWithType(1)})
+		if err != nil {
+			return 0, err
+		}
+		assertExpr, err := BindFuncExprImplByPlanExpr(ctx, "assert", []*plan.Expr{matchOnceExpr, makePlan2StringConstExprWithType(mergeMatchedMoreThanOnceMsg)})
+		if err != nil {
+			return 0, err
+		}
+		filters = append(filters, assertExpr)
+	}
+	lastNodeID = builder.appendNode(&plan.Node{
+		NodeType:   plan.Node_FILTER,
+		Children:   []int32{lastNodeID},
+		FilterList: filters,
+	}, bindCtx)
+
+	// the index tables are not touched if the rows are only updated and none of the parts are updated
+	idxObjRefs := make([]*plan.ObjectRef, len(tableDef.Indexes))
+	idxTableDefs := make([]*plan.TableDef, len(tableDef.Indexes))
+	idxTags := make([]int32, len(tableDef.Indexes))
+	for i, idxDef := range tableDef.Indexes {
+		if !idxDef.TableExist {
+			continue
+		}
+		needUpdate := hasDelete || hasInsert
+		for _, part := range idxDef.Parts {
+			if updatedCols[catalog.ResolveAlias(part)] {
+				needUpdate = true
+				break
+			}
+		}
+		if !needUpdate {
+			continue
+		}
+
+		idxObjRefs[i], idxTableDefs[i] = builder.compCtx.Resolve(objRef.SchemaName, idxDef.IndexTableName, bindCtx.snapshot)
+		if !hasMatched {
+			continue
+		}
+
+		idxTags[i] = builder.genNewTag()
+		builder.addNameByColRef(idxTags[i], idxTableDefs[i])
+		idxTableNodeID := builder.appendNode(&plan.Node{
+			NodeType:     plan.Node_TABLE_SCAN,
+			TableDef:     idxTableDefs[i],
+			ObjRef:       idxObjRefs[i],
+			BindingTags:  []int32{idxTags[i]},
+			ScanSnapshot: bindCtx.snapshot,
+		}, bindCtx)
+
+		idxColPos := idxTableDefs[i].Name2ColIndex[catalog.IndexTableIndexColName]
+		rightExpr := &plan.Expr{
+			Typ: idxTableDefs[i].Cols[idxColPos].Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: idxTags[i],
+					ColPos: idxColPos,
+				},
+			},
+		}
+		leftExpr, err := makeMergeIdxKeyExpr(ctx, idxDef, func(colName string) *plan.Expr {
+			return selectCol(colName2Idx[colName])
+		})
+		if err != nil {
+			return 0, err
+		}
+		joinCond, err := BindFuncExprImplByPlanExpr(ctx, "=", []*plan.Expr{leftExpr, rightExpr})
+		if err != nil {
+			return 0, err
+		}
+
+		lastNodeID = builder.appendNode(&plan.Node{
+			NodeType: plan.Node_JOIN,
+			Children: []int32{lastNodeID, idxTableNodeID},
+			JoinType: plan.Node_LEFT,
+			OnList:   []*plan.Expr{joinCond},
+		}, bindCtx)
+	}
+
+	// compute the new values of the columns, case clauseIdx when k then value of clause k ... else old value
+	projTag1 := builder.genNewTag()
+	projList1 := make([]*plan.Expr, 0, len(tableDef.Cols)+2)
+	for _, col := range tableDef.Cols {
+		oldExpr := selectCol(colName2Idx[col.Name])
+		if col.Name == catalog.Row_ID || col.Name == catalog.CPrimaryKeyColName ||
+			(tableDef.ClusterBy != nil && col.Name == tableDef.ClusterBy.Name) {
+			projList1 = append(projList1, oldExpr)
+			continue
+		}
+
+		var args []*plan.Expr
+		for i, when := range stmt.Whens {
+			var valueExpr *plan.Expr
+			if pos, ok := valuePos[i][col.Name]; ok {
+				valueExpr = selectNode.ProjectList[pos]
+				if isDefaultValExpr(valueExpr) {
+					valueExpr = nil
+				} else {
+					valueExpr = selectCol(pos)
+				}
+			} else if when.Action != tree.MergeInsert {
+				continue
+			}
+			if valueExpr == nil {
+				if col.Typ.AutoIncr {
+					return 0, moerr.NewUnsupportedDML(ctx, "auto_increment default value")
+				}
+				if valueExpr, err = getDefaultExpr(ctx, col); err != nil {
+					return 0, err
+				}
+			}
+			if err = checkNotNull(ctx, valueExpr, tableDef, col); err != nil {
+				return 0, err
+			}
+			if col.Typ.Id == int32(types.T_enum) {
+				valueExpr, err = funcCastForEnumType(ctx, valueExpr, col.Typ)
+			} else {
+				valueExpr, err = forceCastExpr(ctx, valueExpr, col.Typ)
+			}
+			if err != nil {
+				return 0, err
+			}
+
+			condExpr, err := BindFuncExprImplByPlanExpr(ctx, "=", []*plan.Expr{
+				DeepCopyExpr(clauseIdxExpr),
+				makePlan2Int64ConstExprWithType(int64(i)),
+			})
+			if err != nil {
+				return 0, err
+			}
+			args = append(args, condExpr, valueExpr)
+		}
+
+		if len(args) == 0 {
+			projList1 = append(projList1, oldExpr)
+			continue
+		}
+		args = append(args, oldExpr)
+		newExpr, err := BindFuncExprImplByPlanExpr(ctx, "case", args)
+		if err != nil {
+			return 0, err
+		}
+		if newExpr, err = forceCastExpr(ctx, newExpr, col.Typ); err != nil {
+			return 0, err
+		}
+		projList1 = append(projList1, newExpr)
+	}
+
+	// insert flag, false for the rows of the delete clauses
+	insertFlagPos := int32(len(projList1))
+	var insertFlagExpr *plan.Expr
+	if hasDelete {
+		var deleteConds []*plan.Expr
+		for i, when := range stmt.Whens {
+			if when.Action != tree.MergeDelete {
+				continue
+			}
+			condExpr, err := BindFuncExprImplByPlanExpr(ctx, "=", []*plan.Expr{
+				DeepCopyExpr(clauseIdxExpr),
+				makePlan2Int64ConstExprWithType(int64(i)),
+			})
+			if err != nil {
+				return 0, err
+			}
+			deleteConds = append(deleteConds, condExpr)
+		}
+		isDeleteExpr := deleteConds[0]
+		for _, condExpr := range deleteConds[1:] {
+			if isDeleteExpr, err = BindFuncExprImplByPlanExpr(ctx, "or", []*plan.Expr{isDeleteExpr, condExpr}); err != nil {
+				return 0, err
+			}
+		}
+		if insertFlagExpr, err = BindFuncExprImplByPlanExpr(ctx, "not", []*plan.Expr{isDeleteExpr}); err != nil {
+			return 0, err
+		}
+	} else {
+		insertFlagExpr = makePlan2BoolConstExprWithType(true)
+	}
+	projList1 = append(projList1, insertFlagExpr)
+
+	// the rows and the keys of the old index rows
+	idxOldPos := make([][2]int32, len(tableDef.Indexes))
+	for i, idxTableDef := range idxTableDefs {
+		if idxTableDef == nil || !hasMatched {
+			continue
+		}
+		for k, colName := range []string{catalog.Row_ID, catalog.IndexTableIndexColName} {
+			colPos := idxTableDef.Name2ColIndex[colName]
+			idxOldPos[i][k] = int32(len(projList1))
+			projList1 = append(projList1, &plan.Expr{
+				Typ: idxTableDef.Cols[colPos].Typ,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						RelPos: idxTags[i],
+						ColPos: colPos,
+					},
+				},
+			})
+		}
+	}
+
+	// the rows fire the triggers of the actions of their clauses, the NEW values changed by the
+	// BEFORE triggers are written, and the values used by the actions follow the other columns
+	var triggerActions []*plan.PostDmlTriggerAction
+	if len(tableDef.Triggers) > 0 {
+		oldExprs := make([]*plan.Expr, len(tableDef.Cols))
+		for i, col := range tableDef.Cols {
+			oldExprs[i] = selectCol(colName2Idx[col.Name])
+		}
+		var extraExprs []*plan.Expr
+		extraExprs, triggerActions, err = bindMergeTriggers(builder.compCtx, objRef, tableDef, stmt.Whens, clauseIdxExpr,
+			projList1[:len(tableDef.Cols)], oldExprs, updatedCols, len(projList1))
+		if err != nil {
+			return 0, err
+		}
+		projList1 = append(projList1, extraExprs...)
+	}
+
+	if hasWrite {
+		// the values of the generated columns are computed from the new ones
+		err = fillGeneratedColExprs(tableDef, projList1, func(colIdx int) (int, bool) {
+			return colIdx, true
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		if tableDef.Pkey.CompPkeyCol != nil {
+			args := make([]*plan.Expr, len(tableDef.Pkey.Names))
+			for i, part := range tableDef.Pkey.Names {
+				args[i] = DeepCopyExpr(projList1[tableDef.Name2ColIndex[part]])
+			}
+			pos := tableDef.Name2ColIndex[catalog.CPrimaryKeyColName]
+			if projList1[pos], err = BindFuncExprImplByPlanExpr(ctx, "serial", args); err != nil {
+				return 0, err
+			}
+		}
+
+		if tableDef.ClusterBy != nil && util.JudgeIsCompositeClusterByColumn(tableDef.ClusterBy.Name) {
+			names := util.SplitCompositeClusterByColumnName(tableDef.ClusterBy.Name)
+			args := make([]*plan.Expr, len(names))
+			for i, part := range names {
+				args[i] = DeepCopyExpr(projList1[tableDef.Name2ColIndex[part]])
+			}
+			pos := tableDef.Name2ColIndex[tableDef.ClusterBy.Name]
+			if projList1[pos], err = BindFuncExprImplByPlanExpr(ctx, "serial_full", args); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	lastNodeID = builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_PROJECT,
+		ProjectList: projList1,
+		Children:    []int32{lastNodeID},
+		BindingTags: []int32{projTag1},
+	}, bindCtx)
+
+	if len(triggerActions) > 0 {
+		lastNodeID = builder.appendNode(&plan.Node{
+			NodeType: plan.Node_POSTDML,
+			Children: []int32{lastNodeID},
+			PostDmlCtx: &plan.PostDmlCtx{
+				Ref: objRef,
+				Trigger: &plan.PostDmlTriggerCtx{
+					Actions: triggerActions,
+				},
+			},
+		}, bindCtx)
+	}
+
+	proj1Col := func(pos int32) *plan.Expr {
+		return &plan.Expr{
+			Typ: projList1[pos].Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: projTag1,
+					ColPos: pos,
+				},
+			},
+		}
+	}
+
+	finalProjTag := builder.genNewTag()
+	finalColName2Idx := make(map[string]int32)
+	finalProjList := make([]*plan.Expr, 0, len(projList1)+len(tableDef.Indexes)+1)
+	for i, col := range tableDef.Cols {
+		finalColName2Idx[alias+"."+col.Name] = int32(i)
+		finalProjList = append(finalProjList, proj1Col(int32(i)))
+	}
+	finalProjList = append(finalProjList, proj1Col(insertFlagPos))
+
+	rowIDPos := tableDef.Name2ColIndex[catalog.Row_ID]
+	pkPos := tableDef.Name2ColIndex[pkName]
+	mainRowIDExpr := proj1Col(rowIDPos)
+
+	// keys of the inserted rows which are checked for duplicates, NULL for the matched rows
+	makeDedupKeyExpr := func(keyExpr *plan.Expr) (*plan.Expr, error) {
+		isNullExpr, err := BindFuncExprImplByPlanExpr(ctx, "isnull", []*plan.Expr{DeepCopyExpr(mainRowIDExpr)})
+		if err != nil {
+			return nil, err
+		}
+		dedupExpr, err := BindFuncExprImplByPlanExpr(ctx, "case", []*plan.Expr{isNullExpr, keyExpr})
+		if err != nil {
+			return nil, err
+		}
+		return forceCastExpr(ctx, dedupExpr, keyExpr.Typ)
+	}
+
+	idxNewPos := make([]int32, len(tableDef.Indexes))
+	idxDedupPos := make([]int32, len(tableDef.Indexes))
+	for i, idxTableDef := range idxTableDefs {
+		if idxTableDef == nil {
+			continue
+		}
+		if hasMatched {
+			for k := range idxOldPos[i] {
+				oldPos := idxOldPos[i][k]
+				idxOldPos[i][k] = int32(len(finalProjList))
+				finalProjList = append(finalProjList, proj1Col(oldPos))
+			}
+		}
+		if !hasWrite {
+			continue
+		}
+
+		newKeyExpr, err := makeMergeIdxKeyExpr(ctx, tableDef.Indexes[i], func(colName string) *plan.Expr {
+			return proj1Col(tableDef.Name2ColIndex[colName])
+		})
+		if err != nil {
+			return 0, err
+		}
+		idxNewPos[i] = int32(len(finalProjList))
+		finalProjList = append(finalProjList, newKeyExpr)
+
+		if tableDef.Indexes[i].Unique && hasInsert {
+			dedupExpr, err := makeDedupKeyExpr(DeepCopyExpr(newKeyExpr))
+			if err != nil {
+				return 0, err
+			}
+			idxDedupPos[i] = int32(len(finalProjList))
+			finalProjList = append(finalProjList, dedupExpr)
+		}
+	}
+
+	pkDedupPos := int32(-1)
+	if hasInsert {
+		dedupExpr, err := makeDedupKeyExpr(proj1Col(pkPos))
+		if err != nil {
+			return 0, err
+		}
+		pkDedupPos = int32(len(finalProjList))
+		finalProjList = append(finalProjList, dedupExpr)
+	}
+
+	lastNodeID = builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_PROJECT,
+		ProjectList: finalProjList,
+		Children:    []int32{lastNodeID},
+		BindingTags: []int32{finalProjTag},
+	}, bindCtx)
+
+	if hasWrite {
+		checkFilters, err := buildCheckFilters(builder, tableDef, alias, finalProjTag, finalColName2Idx)
+		if err != nil {
+			return 0, err
+		}
+		if len(checkFilters) > 0 {
+			lastNodeID = builder.appendNode(&plan.Node{
+				NodeType:   plan.Node_FILTER,
+				Children:   []int32{lastNodeID},
+				FilterList: checkFilters,
+			}, bindCtx)
+		}
+	}
+
+	finalCol := func(pos int32) *plan.Expr {
+		return &plan.Expr{
+			Typ: finalProjList[pos].Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: finalProjTag,
+					ColPos: pos,
+				},
+			},
+		}
+	}
+
+	lockTargets := []*plan.LockTarget{
+		{
+			TableId:            tableDef.TblId,
+			PrimaryColIdxInBat: pkPos,
+			PrimaryColRelPos:   finalProjTag,
+			PrimaryColTyp:      tableDef.Cols[pkPos].Typ,
+		},
+	}
+	for i, idxTableDef := range idxTableDefs {
+		if idxTableDef == nil || !tableDef.Indexes[i].Unique || pkDedupPos < 0 {
+			continue
+		}
+		lockTargets = append(lockTargets, &plan.LockTarget{
+			TableId:            idxTableDef.TblId,
+			PrimaryColIdxInBat: idxDedupPos[i],
+			PrimaryColRelPos:   finalProjTag,
+			PrimaryColTyp:      finalProjList[idxDedupPos[i]].Typ,
+		})
+	}
+	lastNodeID = builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_LOCK_OP,
+		Children:    []int32{lastNodeID},
+		TableDef:    tableDef,
+		BindingTags: []int32{builder.genNewTag()},
+		LockTargets: lockTargets,
+	}, bindCtx)
+	reCheckifNeedLockWholeTable(builder)
+
+	// the inserted rows must not conflict with the existing rows
+	if pkDedupPos >= 0 && !builder.canSkipDedup(tableDef) {
+		scanTag := builder.genNewTag()
+		builder.addNameByColRef(scanTag, tableDef)
+		scanNodeID := builder.appendNode(&plan.Node{
+			NodeType:     plan.Node_TABLE_SCAN,
+			TableDef:     tableDef,
+			ObjRef:       objRef,
+			BindingTags:  []int32{scanTag},
+			ScanSnapshot: bindCtx.snapshot,
+		}, bindCtx)
+
+		pkTyp := tableDef.Cols[pkPos].Typ
+		joinCond, err := BindFuncExprImplByPlanExpr(ctx, "=", []*plan.Expr{
+			{
+				Typ: pkTyp,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						RelPos: scanTag,
+						ColPos: pkPos,
+					},
+				},
+			},
+			finalCol(pkDedupPos),
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		dedupColTypes := make([]plan.Type, len(tableDef.Pkey.Names))
+		for j, part := range tableDef.Pkey.Names {
+			dedupColTypes[j] = tableDef.Cols[tableDef.Name2ColIndex[part]].Typ
+		}
+
+		lastNodeID = builder.appendNode(&plan.Node{
+			NodeType:          plan.Node_JOIN,
+			Children:          []int32{scanNodeID, lastNodeID},
+			JoinType:          plan.Node_DEDUP,
+			OnList:            []*plan.Expr{joinCond},
+			OnDuplicateAction: plan.Node_FAIL,
+			DedupColName:      makeMergeDedupColName(tableDef.Pkey.Names),
+			DedupColTypes:     dedupColTypes,
+		}, bindCtx)
+
+		for i, idxTableDef := range idxTableDefs {
+			idxDef := tableDef.Indexes[i]
+			if idxTableDef == nil || !idxDef.Unique {
+				continue
+			}
+
+			idxTag := builder.genNewTag()
+			builder.addNameByColRef(idxTag, idxTableDef)
+			idxTableNodeID := builder.appendNode(&plan.Node{
+				NodeType:     plan.Node_TABLE_SCAN,
+				TableDef:     idxTableDef,
+				ObjRef:       idxObjRefs[i],
+				BindingTags:  []int32{idxTag},
+				ScanSnapshot: bindCtx.snapshot,
+			}, bindCtx)
+
+			idxColPos := idxTableDef.Name2ColIndex[catalog.IndexTableIndexColName]
+			joinCond, err := BindFuncExprImplByPlanExpr(ctx, "=", []*plan.Expr{
+				{
+					Typ: idxTableDef.Cols[idxColPos].Typ,
+					Expr: &plan.Expr_Col{
+						Col: &plan.ColRef{
+							RelPos: idxTag,
+							ColPos: idxColPos,
+						},
+					},
+				},
+				finalCol(idxDedupPos[i]),
+			})
+			if err != nil {
+				return 0, err
+			}
+
+			parts := make([]string, len(idxDef.Parts))
+			dedupColTypes := make([]plan.Type, len(idxDef.Parts))
+			for j, part := range idxDef.Parts {
+				parts[j] = catalog.ResolveAlias(part)
+				dedupColTypes[j] = tableDef.Cols[tableDef.Name2ColIndex[parts[j]]].Typ
+			}
+
+			lastNodeID = builder.appendNode(&plan.Node{
+				NodeType:          plan.Node_JOIN,
+				Children:          []int32{idxTableNodeID, lastNodeID},
+				JoinType:          plan.Node_DEDUP,
+				OnList:            []*plan.Expr{joinCond},
+				OnDuplicateAction: plan.Node_FAIL,
+				DedupColName:      makeMergeDedupColName(parts),
+				DedupColTypes:     dedupColTypes,
+			}, bindCtx)
+		}
+	}
+
+	var insertFilterCol *plan.ColRef
+	if hasWrite {
+		insertFilterCol = &plan.ColRef{
+			RelPos: finalProjTag,
+			ColPos: int32(len(tableDef.Cols)),
+		}
+	}
+
+	mainCtx := &plan.UpdateCtx{
+		ObjRef:          objRef,
+		TableDef:        tableDef,
+		OldPartitionIdx: -1,
+		NewPartitionIdx: -1,
+		InsertFilterCol: insertFilterCol,
+	}
+	if hasWrite {
+		mainCtx.InsertCols = make([]plan.ColRef, 0, len(tableDef.Cols)-1)
+		for i, col := range tableDef.Cols {
+			if col.Name != catalog.Row_ID {
+				mainCtx.InsertCols = append(mainCtx.InsertCols, plan.ColRef{
+					RelPos: finalProjTag,
+					ColPos: int32(i),
+				})
+			}
+		}
+	}
+	if hasMatched {
+		mainCtx.DeleteCols = []plan.ColRef{
+			{
+				RelPos: finalProjTag,
+				ColPos: rowIDPos,
+			},
+			{
+				RelPos: finalProjTag,
+				ColPos: pkPos,
+			},
+		}
+	}
+	updateCtxList := []*plan.UpdateCtx{mainCtx}
+
+	for i, idxTableDef := range idxTableDefs {
+		if idxTableDef == nil {
+			continue
+		}
+		idxCtx := &plan.UpdateCtx{
+			ObjRef:          idxObjRefs[i],
+			TableDef:        idxTableDef,
+			OldPartitionIdx: -1,
+			NewPartitionIdx: -1,
+		}
+		if hasWrite {
+			idxCtx.InsertCols = []plan.ColRef{
+				{
+					RelPos: finalProjTag,
+					ColPos: idxNewPos[i],
+				},
+				{
+					RelPos: finalProjTag,
+					ColPos: pkPos,
+				},
+			}
+			idxCtx.InsertFilterCol = &plan.ColRef{
+				RelPos: insertFilterCol.RelPos,
+				ColPos: insertFilterCol.ColPos,
+			}
+		}
+		if hasMatched {
+			idxCtx.DeleteCols = []plan.ColRef{
+				{
+					RelPos: finalProjTag,
+					ColPos: idxOldPos[i][0],
+				},
+				{
+					RelPos: finalProjTag,
+					ColPos: idxOldPos[i][1],
+				},
+			}
+		}
+		updateCtxList = append(updateCtxList, idxCtx)
+	}
+
+	lastNodeID = builder.appendNode(&plan.Node{
+		NodeType:      plan.Node_MULTI_UPDATE,
+		Children:      []int32{lastNodeID},
+		BindingTags:   []int32{builder.genNewTag()},
+		UpdateCtxList: updateCtxList,
+	}, bindCtx)
+
+	return lastNodeID, err
+}
+
+// bindMergeTriggers binds the triggers fired by the rows of the clauses of MERGE, the rows of the
+// update, insert and delete clauses fire the triggers of the same events. newExprs are the values
+// of the columns written by the clauses, they are changed by the BEFORE triggers, and oldExprs are
+// the values of the matched target rows. The primary key and the indexed columns which are not
+// updated by the clauses can not be changed by the update triggers, since the index tables are not
+// updated for them. The values used by the actions are returned, from the column at extraPos.
+func bindMergeTriggers(ctx CompilerContext, objRef *plan.ObjectRef, tableDef *plan.TableDef, whens []*tree.MergeWhen, clauseIdxExpr *plan.Expr,
+	newExprs, oldExprs []*plan.Expr, updatedCols map[string]bool, extraPos int) ([]*plan.Expr, []*plan.PostDmlTriggerAction, error) {
+
+	var guards [3]*plan.Expr
+	for i, when := range whens {
+		event := tree.TRIGGER_UPDATE
+		switch when.Action {
+		case tree.MergeInsert:
+			event = tree.TRIGGER_INSERT
+		case tree.MergeDelete:
+			event = tree.TRIGGER_DELETE
+		}
+		condExpr, err := BindFuncExprImplByPlanExpr(ctx.GetContext(), "=", []*plan.Expr{
+			DeepCopyExpr(clauseIdxExpr),
+			makePlan2Int64ConstExprWithType(int64(i)),
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		if guards[event] != nil {
+			if condExpr, err = BindFuncExprImplByPlanExpr(ctx.GetContext(), "or", []*plan.Expr{guards[event], condExpr}); err != nil {
+				return nil, nil, err
+			}
+		}
+		guards[event] = condExpr
+	}
+
+	keyCols := make(map[string]bool)
+	for _, name := range tableDef.Pkey.Names {
+		keyCols[name] = true
+	}
+	for _, idxDef := range tableDef.Indexes {
+		for _, part := range idxDef.Parts {
+			keyCols[catalog.ResolveAlias(part)] = true
+		}
+	}
+
+	body := newTriggerBodyBinder(ctx, objRef.SchemaName, tableDef, nil, nil, extraPos)
+	for _, timing := range []tree.TriggerTiming{tree.TRIGGER_BEFORE, tree.TRIGGER_AFTER} {
+		for _, event := range []tree.TriggerEvent{tree.TRIGGER_INSERT, tree.TRIGGER_UPDATE, tree.TRIGGER_DELETE} {
+			triggers := getTriggers(tableDef, timing, event)
+			if guards[event] == nil || len(triggers) == 0 {
+				continue
+			}
+			body.rowGuard = guards[event]
+			body.newExprs, body.oldExprs = newExprs, oldExprs
+			switch event {
+			case tree.TRIGGER_INSERT:
+				body.oldExprs = nil
+			case tree.TRIGGER_DELETE:
+				body.newExprs = nil
+			}
+
+			keyExprs := make(map[int]*plan.Expr)
+			if event == tree.TRIGGER_UPDATE {
+				for i, col := range tableDef.Cols {
+					if keyCols[col.Name] && !updatedCols[col.Name] {
+						keyExprs[i] = newExprs[i]
+					}
+				}
+			}
+			if err := body.bindTriggers(triggers); err != nil {
+				return nil, nil, err
+			}
+			for i, expr := range keyExprs {
+				if newExprs[i] != expr {
+					return nil, nil, moerr.NewNotSupportedf(ctx.GetContext(), "changing the key or indexed column '%s' which is not updated by merge in trigger", tableDef.Cols[i].Name)
+				}
+			}
+		}
+	}
+	return body.extraExprs, body.actions, nil
+}
+
+// makeMergeIdxKeyExpr returns the key of the index table computed from the parts of the index
+func makeMergeIdxKeyExpr(ctx context.Context, idxDef *plan.IndexDef, partExpr func(colName string) *plan.Expr) (*plan.Expr, error) {
+	if idxDef.Unique && len(idxDef.Parts) == 1 {
+		return partExpr(catalog.ResolveAlias(idxDef.Parts[0])), nil
+	}
+
+	args := make([]*plan.Expr, len(idxDef.Parts))
+	for i, part := range idxDef.Parts {
+		args[i] = partExpr(catalog.ResolveAlias(part))
+	}
+	if idxDef.Unique {
+		return BindFuncExprImplByPlanExpr(ctx, "serial", args)
+	}
+	return BindFuncExprImplByPlanExpr(ctx, "serial_full", args)
+}
+
+func makeMergeDedupColName(parts []string) string {
+	if len(parts) == 1 {
+		return parts[0]
+	}
+	return "(" + strings.Join(parts, ",") + ")"
+}
@@ -215,6 +215,26 @@ func (builder *QueryBuilder) bindUpdate(stmt *tree.Update, bindCtx *BindContext)
 		}
 	}
 
+	// compute the generated columns from the new values of the columns
+	for i, alias := range dmlCtx.aliases {
+		if len(dmlCtx.updateCol2Expr[i]) == 0 {
+			continue
+		}
+
+		tableDef := dmlCtx.tableDefs[i]
+		err = fillGeneratedColExprs(tableDef, selectNode.ProjectList, func(colIdx int) (int, bool) {
+			colName := alias + "." + tableDef.Cols[colIdx].Name
+			if pos, ok := updateColName2Idx[colName]; ok {
+				return int(pos), true
+			}
+			pos, ok := colName2Idx[colName]
+			return int(pos), ok
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+
 	selectNodeTag := selectNode.BindingTags[0]
 	idxScanNodes := make([][]*plan.Node, len(dmlCtx.tableDefs))
 	idxNeedUpdate := make([][]bool, len(dmlCtx.tableDefs))
@@ -525,6 +545,26 @@ func (builder *QueryBuilder) bindUpdate(stmt *tree.Update, bindCtx *BindContext)
 		BindingTags: []int32{finalProjTag},
 	}, bindCtx)
 
+	var checkFilters []*plan.Expr
+	for i, tableDef := range dmlCtx.tableDefs {
+		if len(dmlCtx.updateCol2Expr[i]) == 0 {
+			continue
+		}
+
+		filters, err := buildCheckFilters(builder, tableDef, dmlCtx.aliases[i], finalProjTag, finalColName2Idx)
+		if err != nil {
+			return 0, err
+		}
+		checkFilters = append(checkFilters, filters...)
+	}
+	if len(checkFilters) > 0 {
+		lastNodeID = builder.appendNode(&plan.Node{
+			NodeType:   plan.Node_FILTER,
+			Children:   []int32{lastNodeID},
+			FilterList: checkFilters,
+		}, bindCtx)
+	}
+
 	lastNodeID = builder.appendNode(&plan.Node{
 		NodeType:    plan.Node_LOCK_OP,
 		Children:    []int32{lastNodeID},
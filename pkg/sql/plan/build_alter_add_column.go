@@ -91,6 +91,11 @@ func buildAddColumnAndConstraint(ctx CompilerContext, alterPlan *plan.AlterTable
 		return nil, err
 	}
 
+	generated, err := buildGeneratedColAttr(ctx.GetContext(), specNewColumn)
+	if err != nil {
+		return nil, err
+	}
+
 	newCol := &ColDef{
 		ColId: math.MaxUint64,
 		//Primary: originalCol.Primary,
@@ -102,6 +107,7 @@ func buildAddColumnAndConstraint(ctx CompilerContext, alterPlan *plan.AlterTable
 		OriginName: newColNameOrigin,
 		Typ:        colType,
 		Alg:        plan.CompressType_Lz4,
+		Generated:  generated,
 	}
 
 	hasDefaultValue := false
@@ -334,6 +340,12 @@ func DropColumn(ctx CompilerContext, alterPlan *plan.AlterTable, colName string,
 	if err := checkDropColumnWithForeignKey(ctx, tableDef, col); err != nil {
 		return err
 	}
+	if err := checkDropColumnWithCheck(ctx.GetContext(), tableDef, col.Name, col.GetOriginCaseName()); err != nil {
+		return err
+	}
+	if err := checkDropColumnWithGenerated(ctx.GetContext(), tableDef, col.Name, col.GetOriginCaseName()); err != nil {
+		return err
+	}
 	if err := checkVisibleColumnCnt(ctx.GetContext(), tableDef, 0, 1); err != nil {
 		return err
 	}
@@ -55,6 +55,14 @@ func ChangeColumn(ctx CompilerContext, alterPlan *plan.AlterTable, spec *tree.Al
 	if newColName != oldColName && FindColumn(tableDef.Cols, newColName) != nil {
 		return moerr.NewErrDupFieldName(ctx.GetContext(), newColName)
 	}
+	if newColName != oldColName {
+		if err := checkDropColumnWithCheck(ctx.GetContext(), tableDef, oldColName, oldColNameOrigin); err != nil {
+			return err
+		}
+		if err := checkDropColumnWithGenerated(ctx.GetContext(), tableDef, oldColName, oldColNameOrigin); err != nil {
+			return err
+		}
+	}
 
 	//change the name of the column in the foreign key constraint
 	if newColNameOrigin != oldColNameOrigin {
@@ -119,6 +127,14 @@ func buildChangeColumnAndConstraint(ctx CompilerContext, alterPlan *plan.AlterTa
 		return nil, err
 	}
 
+	generated, err := buildGeneratedColAttr(ctx.GetContext(), specNewColumn)
+	if err != nil {
+		return nil, err
+	}
+	if generated != nil && oldCol.Generated != nil && generated.Stored != oldCol.Generated.Stored {
+		return nil, moerr.NewNotSupported(ctx.GetContext(), "changing the STORED status of generated column")
+	}
+
 	newCol := &ColDef{
 		ColId:      oldCol.ColId,
 		Primary:    oldCol.Primary,
@@ -127,6 +143,7 @@ func buildChangeColumnAndConstraint(ctx CompilerContext, alterPlan *plan.AlterTa
 		OriginName: newColNameOrigin,
 		Typ:        colType,
 		Alg:        plan.CompressType_Lz4,
+		Generated:  generated,
 	}
 
 	// If the column null property is not specified, it defaults to allowing null
@@ -89,11 +89,13 @@ func buildAlterTableCopy(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, err
 			case *tree.ForeignKey:
 				return nil, moerr.NewInvalidInputf(ctx.GetContext(), "Do not support this stmt now. %v", optionAdd)
 			case *tree.UniqueIndex:
-				return nil, moerr.NewInvalidInputf(ctx.GetContext(), "Do not support this stmt now. %v", optionAdd)
+				err = AddIndex(ctx, alterTablePlan, optionAdd)
 			case *tree.Index:
-				return nil, moerr.NewInvalidInputf(ctx.GetContext(), "Do not support this stmt now. %v", optionAdd)
+				err = AddIndex(ctx, alterTablePlan, optionAdd)
 			case *tree.ColumnTableDef:
 				return nil, moerr.NewInvalidInputf(ctx.GetContext(), "Do not support this stmt now. %v", optionAdd)
+			case *tree.CheckIndex:
+				err = AddCheck(ctx, alterTablePlan, optionAdd)
 			default:
 				return nil, moerr.NewInvalidInputf(ctx.GetContext(), "Do not support this stmt now. %v", optionAdd)
 			}
@@ -109,7 +111,12 @@ func buildAlterTableCopy(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, err
 			case tree.AlterTableDropPrimaryKey:
 				err = DropPrimaryKey(ctx, alterTablePlan, alterTableCtx)
 			case tree.AlterTableDropForeignKey:
-				return nil, moerr.NewInvalidInputf(ctx.GetContext(), "Do not support this stmt now. %v", option)
+				if findCheckDef(copyTableDef, string(option.Name)) == -1 {
+					return nil, moerr.NewInvalidInputf(ctx.GetContext(), "Do not support this stmt now. %v", option)
+				}
+				err = DropCheck(ctx, alterTablePlan, string(option.Name))
+			case tree.AlterTableDropCheck:
+				err = DropCheck(ctx, alterTablePlan, string(option.Name))
 			default:
 				return nil, moerr.NewInvalidInputf(ctx.GetContext(), "Do not support this stmt now. %v", option)
 			}
@@ -143,6 +150,20 @@ func buildAlterTableCopy(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, err
 		}
 	}
 
+	// the columns may be changed, bind the generated columns and check constraints against the new columns
+	if err = bindGeneratedCols(ctx, copyTableDef); err != nil {
+		return nil, err
+	}
+	if err = rebindCheckDefs(ctx, copyTableDef); err != nil {
+		return nil, err
+	}
+	// the generated columns are computed when the data is inserted into the copy table
+	for _, col := range copyTableDef.Cols {
+		if col.Generated != nil {
+			delete(alterTableCtx.alterColMap, col.Name)
+		}
+	}
+
 	createTmpDdl, _, err := ConstructCreateTableSQL(ctx, copyTableDef, snapshot, true)
 	if err != nil {
 		return nil, err
@@ -291,6 +312,9 @@ func buildAlterTable(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, error)
 	if tableDef.ViewSql != nil {
 		return nil, moerr.NewInternalError(ctx.GetContext(), "you should use alter view statemnt for View")
 	}
+	if tableDef.TableType == catalog.SystemMaterializedRel {
+		return nil, moerr.NewInternalError(ctx.GetContext(), "cannot alter materialized view")
+	}
 	if objRef.PubInfo != nil {
 		return nil, moerr.NewInternalError(ctx.GetContext(), "cannot alter table in subscription database")
 	}
@@ -333,9 +357,20 @@ func ResolveAlterTableAlgorithm(ctx context.Context, validAlterSpecs []tree.Alte
 			case *tree.ForeignKey:
 				algorithm = plan.AlterTable_INPLACE
 			case *tree.UniqueIndex:
-				algorithm = plan.AlterTable_INPLACE
+				// the hidden columns of the expression key parts are added by copying the table
+				if hasExprKeyPart(option.Def.(*tree.UniqueIndex).KeyParts) {
+					algorithm = plan.AlterTable_COPY
+				} else {
+					algorithm = plan.AlterTable_INPLACE
+				}
 			case *tree.Index:
-				algorithm = plan.AlterTable_INPLACE
+				if hasExprKeyPart(option.Def.(*tree.Index).KeyParts) {
+					algorithm = plan.AlterTable_COPY
+				} else {
+					algorithm = plan.AlterTable_INPLACE
+				}
+			case *tree.CheckIndex:
+				algorithm = plan.AlterTable_COPY
 			case *tree.ColumnTableDef:
 				algorithm = plan.AlterTable_INPLACE
 			default:
@@ -353,6 +388,8 @@ func ResolveAlterTableAlgorithm(ctx context.Context, validAlterSpecs []tree.Alte
 				algorithm = plan.AlterTable_COPY
 			case tree.AlterTableDropForeignKey:
 				algorithm = plan.AlterTable_INPLACE
+			case tree.AlterTableDropCheck:
+				algorithm = plan.AlterTable_INPLACE
 			default:
 				algorithm = plan.AlterTable_INPLACE
 			}
@@ -378,6 +415,8 @@ func ResolveAlterTableAlgorithm(ctx context.Context, validAlterSpecs []tree.Alte
 			algorithm = plan.AlterTable_COPY
 		case *tree.TableOptionAutoIncrement:
 			algorithm = plan.AlterTable_INPLACE
+		case *tree.TableOptionTTL, *tree.AlterOptionRemoveTTL:
+			algorithm = plan.AlterTable_INPLACE
 		default:
 			algorithm = plan.AlterTable_INPLACE
 		}
@@ -0,0 +1,302 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// buildCheckDefs binds the table and column CHECK constraints of the create table
+// statement and saves them into tableDef.Checks
+func buildCheckDefs(ctx CompilerContext, stmt *tree.CreateTable, tableDef *TableDef) error {
+	for _, item := range stmt.Defs {
+		switch def := item.(type) {
+		case *tree.ColumnTableDef:
+			for _, attr := range def.Attributes {
+				attrCheck, ok := attr.(*tree.AttributeCheckConstraint)
+				if !ok {
+					continue
+				}
+				checkDef, err := buildCheckDef(ctx, tableDef, attrCheck.Name, attrCheck.Expr, attrCheck.Enforced, def.Name.ColName())
+				if err != nil {
+					return err
+				}
+				tableDef.Checks = append(tableDef.Checks, checkDef)
+			}
+		case *tree.CheckIndex:
+			checkDef, err := buildCheckDef(ctx, tableDef, def.Name, def.Expr, def.Enforced, "")
+			if err != nil {
+				return err
+			}
+			tableDef.Checks = append(tableDef.Checks, checkDef)
+		}
+	}
+	return nil
+}
+
+// buildCheckDef binds one CHECK constraint against the columns of tableDef.
+// colName is not empty for a column check constraint.
+func buildCheckDef(ctx CompilerContext, tableDef *TableDef, name string, astExpr tree.Expr, enforced bool, colName string) (*plan.CheckDef, error) {
+	if name == "" {
+		name = genCheckName(tableDef.Name, tableDef.Checks)
+	} else if findCheckDef(tableDef, name) != -1 {
+		return nil, moerr.NewInvalidInputf(ctx.GetContext(), "duplicate check constraint name '%s'", name)
+	}
+
+	builder := NewQueryBuilder(plan.Query_SELECT, ctx, false, false)
+	bindContext := NewBindContext(builder, nil)
+	nodeID := builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_TABLE_SCAN,
+		Stats:       nil,
+		ObjRef:      nil,
+		TableDef:    tableDef,
+		BindingTags: []int32{builder.genNewTag()},
+	}, bindContext)
+	err := builder.addBinding(nodeID, tree.AliasClause{}, bindContext)
+	if err != nil {
+		return nil, err
+	}
+
+	checkBinder := NewCheckBinder(builder, bindContext, tableDef, colName)
+	expr, err := checkBinder.BindExpr(astExpr, 0, true)
+	if err != nil {
+		return nil, err
+	}
+
+	typ := types.T(expr.Typ.Id)
+	if typ != types.T_bool {
+		if !typ.IsInteger() && !typ.IsFloat() && !typ.IsDecimal() && typ != types.T_any {
+			return nil, moerr.NewInvalidInputf(ctx.GetContext(), "an expression of non-boolean type specified to check constraint '%s'", name)
+		}
+		expr, err = appendCastBeforeExpr(ctx.GetContext(), expr, plan.Type{Id: int32(types.T_bool), NotNullable: expr.Typ.NotNullable})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &plan.CheckDef{
+		Name:     name,
+		Check:    expr,
+		ExprStr:  tree.StringWithOpts(astExpr, dialect.MYSQL, tree.WithSingleQuoteString()),
+		Enforced: enforced,
+	}, nil
+}
+
+// genCheckName generates the name for an anonymous check constraint, [TABLE_NAME]_chk_[N]
+func genCheckName(tableName string, checks []*plan.CheckDef) string {
+	prefix := tableName + "_chk_"
+	seq := 0
+	for _, checkDef := range checks {
+		if !strings.HasPrefix(checkDef.Name, prefix) {
+			continue
+		}
+		if n, err := strconv.Atoi(checkDef.Name[len(prefix):]); err == nil && n > seq {
+			seq = n
+		}
+	}
+	return fmt.Sprintf("%s%d", prefix, seq+1)
+}
+
+// findCheckDef returns the position of the check constraint in tableDef.Checks, or -1
+func findCheckDef(tableDef *TableDef, name string) int {
+	for i, checkDef := range tableDef.Checks {
+		if strings.EqualFold(checkDef.Name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// checkDropColumnWithCheck returns error if the column to be dropped or renamed is used by a check constraint
+func checkDropColumnWithCheck(ctx context.Context, tableDef *TableDef, colName, colNameOrigin string) error {
+	for _, checkDef := range tableDef.Checks {
+		if exprRefersColumn(checkDef.Check, colName) {
+			return moerr.NewInvalidInputf(ctx, "check constraint '%s' uses column '%s', hence column cannot be dropped or renamed", checkDef.Name, colNameOrigin)
+		}
+	}
+	return nil
+}
+
+func exprRefersColumn(expr *Expr, colName string) bool {
+	switch ne := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		return ne.Col.Name == colName
+	case *plan.Expr_F:
+		for _, arg := range ne.F.Args {
+			if exprRefersColumn(arg, colName) {
+				return true
+			}
+		}
+	case *plan.Expr_List:
+		for _, arg := range ne.List.List {
+			if exprRefersColumn(arg, colName) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// rebindCheckDefs binds the check constraints of tableDef again from their text,
+// it is used after the columns of the table are changed by alter table
+func rebindCheckDefs(ctx CompilerContext, tableDef *TableDef) error {
+	checks := tableDef.Checks
+	tableDef.Checks = make([]*plan.CheckDef, 0, len(checks))
+	for _, checkDef := range checks {
+		astExpr, err := parseCheckExprStr(ctx.GetContext(), checkDef.ExprStr)
+		if err != nil {
+			return err
+		}
+		newCheckDef, err := buildCheckDef(ctx, tableDef, checkDef.Name, astExpr, checkDef.Enforced, "")
+		if err != nil {
+			return err
+		}
+		tableDef.Checks = append(tableDef.Checks, newCheckDef)
+	}
+	return nil
+}
+
+func parseCheckExprStr(ctx context.Context, exprStr string) (tree.Expr, error) {
+	ast, err := mysql.ParseOne(ctx, "select "+exprStr, 1)
+	if err != nil {
+		return nil, err
+	}
+	if sel, ok := ast.(*tree.Select); ok {
+		if clause, ok := sel.Select.(*tree.SelectClause); ok && len(clause.Exprs) == 1 {
+			return clause.Exprs[0].Expr, nil
+		}
+	}
+	return nil, moerr.NewInternalErrorf(ctx, "invalid check constraint expression '%s'", exprStr)
+}
+
+// AddCheck adds a check constraint to the copy table, the existing rows are validated
+// when they are inserted into the copy table
+func AddCheck(ctx CompilerContext, alterPlan *plan.AlterTable, def *tree.CheckIndex) error {
+	tableDef := alterPlan.CopyTableDef
+	name := def.Name
+	if name == "" {
+		name = genCheckName(alterPlan.TableDef.Name, tableDef.Checks)
+	}
+	checkDef, err := buildCheckDef(ctx, tableDef, name, def.Expr, def.Enforced, "")
+	if err != nil {
+		return err
+	}
+	tableDef.Checks = append(tableDef.Checks, checkDef)
+	return nil
+}
+
+// DropCheck drops a check constraint from the copy table
+func DropCheck(ctx CompilerContext, alterPlan *plan.AlterTable, name string) error {
+	tableDef := alterPlan.CopyTableDef
+	idx := findCheckDef(tableDef, name)
+	if idx == -1 {
+		return moerr.NewErrCantDropFieldOrKey(ctx.GetContext(), name)
+	}
+	tableDef.Checks = append(tableDef.Checks[:idx], tableDef.Checks[idx+1:]...)
+	return nil
+}
+
+// getRemapCheckExpr returns the check expression with the column refs remapped to the
+// positions in colPosMap, which is keyed by prefix+"."+colName when prefix is not empty.
+func getRemapCheckExpr(checkDef *plan.CheckDef, prefix string, relPos int32, colPosMap map[string]int32) (*Expr, error) {
+	retExpr := DeepCopyExpr(checkDef.Check)
+	err := remapCheckExprColRef(retExpr, prefix, relPos, colPosMap)
+	return retExpr, err
+}
+
+func remapCheckExprColRef(expr *Expr, prefix string, relPos int32, colPosMap map[string]int32) error {
+	switch ne := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		colName := ne.Col.Name
+		if prefix != "" {
+			colName = prefix + "." + colName
+		}
+		colPos, ok := colPosMap[colName]
+		if !ok {
+			return moerr.NewInternalErrorNoCtxf("can not find col '%s' by name in check expr", ne.Col.Name)
+		}
+		ne.Col.RelPos = relPos
+		ne.Col.ColPos = colPos
+	case *plan.Expr_F:
+		for _, arg := range ne.F.Args {
+			if err := remapCheckExprColRef(arg, prefix, relPos, colPosMap); err != nil {
+				return err
+			}
+		}
+	case *plan.Expr_List:
+		for _, arg := range ne.List.List {
+			if err := remapCheckExprColRef(arg, prefix, relPos, colPosMap); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// buildCheckFilters returns one assert expression for each enforced check constraint of
+// tableDef. A row violates the constraint only when the expression is false, NULL passes.
+func buildCheckFilters(builder *QueryBuilder, tableDef *TableDef, prefix string, relPos int32, colPosMap map[string]int32) ([]*Expr, error) {
+	var filters []*Expr
+	for _, checkDef := range tableDef.Checks {
+		if !checkDef.Enforced {
+			continue
+		}
+		checkExpr, err := getRemapCheckExpr(checkDef, prefix, relPos, colPosMap)
+		if err != nil {
+			return nil, err
+		}
+		checkExpr, err = BindFuncExprImplByPlanExpr(builder.GetContext(), "coalesce", []*Expr{checkExpr, makePlan2BoolConstExprWithType(true)})
+		if err != nil {
+			return nil, err
+		}
+		errExpr := makePlan2StringConstExprWithType(fmt.Sprintf("Check constraint '%s' is violated.", checkDef.Name))
+		filterExpr, err := BindFuncExprImplByPlanExpr(builder.GetContext(), "assert", []*Expr{checkExpr, errExpr})
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filterExpr)
+	}
+	return filters, nil
+}
+
+// appendCheckFilterNode appends a filter node which raises an error for the rows violating
+// the enforced check constraints. It is used by the old insert/update plans whose input columns
+// are in the same order as tableDef.Cols.
+func appendCheckFilterNode(builder *QueryBuilder, bindCtx *BindContext, tableDef *TableDef, lastNodeId int32) (int32, error) {
+	colPosMap := make(map[string]int32, len(tableDef.Cols))
+	for i, col := range tableDef.Cols {
+		colPosMap[col.Name] = int32(i)
+	}
+	filters, err := buildCheckFilters(builder, tableDef, "", 0, colPosMap)
+	if err != nil || len(filters) == 0 {
+		return lastNodeId, err
+	}
+	return builder.appendNode(&Node{
+		NodeType:    plan.Node_FILTER,
+		Children:    []int32{lastNodeId},
+		FilterList:  filters,
+		ProjectList: getProjectionByLastNode(builder, lastNodeId),
+	}, bindCtx), nil
+}
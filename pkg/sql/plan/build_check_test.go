@@ -0,0 +1,158 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"strings"
+	"testing"
+
+	moruntime "github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/util/executor"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateTableWithCheck(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	rt := moruntime.DefaultRuntime()
+	moruntime.SetupServiceBasedRuntime("", rt)
+	rt.SetGlobalVariables(moruntime.InternalSQLExecutor, executor.NewMemExecutor(func(sql string) (executor.Result, error) {
+		return executor.Result{}, nil
+	}))
+
+	logicPlan, err := runOneStmt(mock, t, "create table t (a int check (a > 0), b varchar(10), c int, constraint c_gt_a check (c > a), check (b <> 'x') not enforced, check (c))")
+	require.NoError(t, err)
+	checks := logicPlan.GetDdl().GetCreateTable().TableDef.Checks
+	require.Equal(t, 4, len(checks))
+
+	require.Equal(t, "t_chk_1", checks[0].Name)
+	require.Equal(t, "a > 0", checks[0].ExprStr)
+	require.True(t, checks[0].Enforced)
+
+	require.Equal(t, "c_gt_a", checks[1].Name)
+	require.Equal(t, "t_chk_2", checks[2].Name)
+	require.Equal(t, "b != 'x'", checks[2].ExprStr)
+	require.False(t, checks[2].Enforced)
+
+	// integer expression is casted to bool
+	require.Equal(t, "t_chk_3", checks[3].Name)
+	require.Equal(t, "cast", checks[3].Check.GetF().Func.ObjName)
+
+	sqls := []string{
+		"create table t (a int, check (a > (select 1)))",
+		"create table t (a int, check (sum(a) > 0))",
+		"create table t (a int, check (a > rand()))",
+		"create table t (a int, check (a > @v))",
+		"create table t (a int, b int check (a > 0))",
+		"create table t (a int auto_increment, check (a > 0))",
+		"create table t (a int, constraint c1 check (a > 0), constraint c1 check (a < 10))",
+		"create table t (a int, b varchar(10), check (b))",
+		"create table t (a int, check (d > 0))",
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
+func TestDMLWithCheck(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	ctx := mock.CurrentContext().(*MockCompilerContext)
+
+	_, tableDef := ctx.Resolve("tpch", "nation", nil)
+	astExpr, err := parseCheckExprStr(ctx.GetContext(), "n_regionkey > 0")
+	require.NoError(t, err)
+	checkDef, err := buildCheckDef(ctx, tableDef, "", astExpr, true, "")
+	require.NoError(t, err)
+	require.Equal(t, "nation_chk_1", checkDef.Name)
+	ctx.tables["nation"].Checks = []*plan.CheckDef{checkDef}
+
+	sqls := []string{
+		"insert into nation values (1, 'NAME1', 21, 'COMMENT1')",
+		"insert into nation select * from nation2",
+		"update nation set n_regionkey = n_regionkey - 1 where n_nationkey = 1",
+	}
+	for _, sql := range sqls {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		require.NoError(t, err, sql)
+		require.True(t, hasCheckFilter(logicPlan), sql)
+	}
+
+	// not enforced check is not evaluated
+	checkDef.Enforced = false
+	logicPlan, err := runOneStmt(mock, t, sqls[0])
+	require.NoError(t, err)
+	require.False(t, hasCheckFilter(logicPlan))
+}
+
+func hasCheckFilter(logicPlan *Plan) bool {
+	for _, node := range logicPlan.GetQuery().Nodes {
+		if node.NodeType != plan.Node_FILTER {
+			continue
+		}
+		for _, filter := range node.FilterList {
+			if f := filter.GetF(); f != nil && f.Func.ObjName == "assert" &&
+				strings.Contains(f.Args[1].GetLit().GetSval(), "Check constraint") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func TestAlterTableWithCheck(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	ctx := mock.CurrentContext().(*MockCompilerContext)
+
+	_, tableDef := ctx.Resolve("tpch", "nation", nil)
+	astExpr, err := parseCheckExprStr(ctx.GetContext(), "n_regionkey > 0")
+	require.NoError(t, err)
+	checkDef, err := buildCheckDef(ctx, tableDef, "", astExpr, true, "")
+	require.NoError(t, err)
+	ctx.tables["nation"].Checks = []*plan.CheckDef{checkDef}
+
+	// add check is done by copy, the rows are validated when inserted into the copy table
+	logicPlan, err := runOneStmt(mock, t, "alter table nation add constraint chk_key check (n_nationkey >= 0)")
+	require.NoError(t, err)
+	alterTable := logicPlan.GetDdl().GetAlterTable()
+	require.Equal(t, plan.AlterTable_COPY, alterTable.AlgorithmType)
+	require.Equal(t, 2, len(alterTable.CopyTableDef.Checks))
+	require.Contains(t, alterTable.CreateTmpTableSql, "CONSTRAINT `nation_chk_1` CHECK (n_regionkey > 0)")
+	require.Contains(t, alterTable.CreateTmpTableSql, "CONSTRAINT `chk_key` CHECK (n_nationkey >= 0)")
+
+	logicPlan, err = runOneStmt(mock, t, "alter table nation add check (n_nationkey < 1000) not enforced")
+	require.NoError(t, err)
+	alterTable = logicPlan.GetDdl().GetAlterTable()
+	require.Equal(t, "nation_chk_2", alterTable.CopyTableDef.Checks[1].Name)
+	require.Contains(t, alterTable.CreateTmpTableSql, "CONSTRAINT `nation_chk_2` CHECK (n_nationkey < 1000) NOT ENFORCED")
+
+	// drop check only updates the constraint
+	for _, sql := range []string{
+		"alter table nation drop check nation_chk_1",
+		"alter table nation drop constraint nation_chk_1",
+	} {
+		logicPlan, err = runOneStmt(mock, t, sql)
+		require.NoError(t, err)
+		alterTable = logicPlan.GetDdl().GetAlterTable()
+		require.Equal(t, plan.AlterTable_INPLACE, alterTable.AlgorithmType)
+		require.Equal(t, plan.AlterTableDrop_CHECK, alterTable.Actions[0].GetDrop().Typ)
+	}
+
+	sqls := []string{
+		"alter table nation drop check chk_not_exists",
+		"alter table nation add constraint nation_chk_1 check (n_nationkey > 0)",
+		"alter table nation drop column n_regionkey",
+		"alter table nation rename column n_regionkey to n_region",
+		"alter table nation change column n_regionkey n_region int",
+	}
+	runTestShouldError(mock, t, sqls)
+}
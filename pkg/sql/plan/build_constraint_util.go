@@ -178,6 +178,9 @@ func getUpdateTableInfo(ctx CompilerContext, stmt *tree.Update) (*dmlTableInfo,
 		newTblInfo.tableDefs = append(newTblInfo.tableDefs, tblDef)
 		newTblInfo.isClusterTable = append(newTblInfo.isClusterTable, tblInfo.isClusterTable[idx])
 		newTblInfo.alias[alias] = len(newTblInfo.tableDefs) - 1
+		if err = addGeneratedColsToUpdate(ctx.GetContext(), tblDef, columns); err != nil {
+			return nil, err
+		}
 		newTblInfo.updateKeys = append(newTblInfo.updateKeys, columns)
 
 		if !newTblInfo.haveConstraint {
@@ -265,7 +268,8 @@ func setTableExprToDmlTableInfo(ctx CompilerContext, tbl tree.TableExpr, tblInfo
 	var newCols []*ColDef
 	for _, col := range tableDef.Cols {
 		if col.Hidden && tblInfo.typ == "insert" {
-			if col.Name == catalog.FakePrimaryKeyColName {
+			// the hidden columns of the functional indexes are computed like the generated columns
+			if col.Name == catalog.FakePrimaryKeyColName || isFunctionalIndexCol(col) {
 				// fake pk is auto increment, need to fill.
 				// TODO(fagongzi): we need to use a separate tag to mark the columns
 				// for these behaviors, instead of using column names, which needs to
@@ -448,6 +452,10 @@ func initInsertStmt(builder *QueryBuilder, bindCtx *BindContext, stmt *tree.Inse
 		return false, nil, nil, moerr.NewInvalidInput(builder.GetContext(), "insert has unknown select statement")
 	}
 
+	if err = checkInsertGeneratedCols(builder.GetContext(), stmt, tableDef, insertColumns); err != nil {
+		return false, nil, nil, err
+	}
+
 	if err = builder.addBinding(info.rootId, tree.AliasClause{Alias: derivedTableName}, bindCtx); err != nil {
 		return false, nil, nil, err
 	}
@@ -552,7 +560,7 @@ func initInsertStmt(builder *QueryBuilder, bindCtx *BindContext, stmt *tree.Inse
 					// insert without unique
 					// then need check col is not auto_incr & default is not null
 					col := tableDef.Cols[tableDef.Name2ColIndex[name]]
-					if col.Typ.AutoIncr || (col.Default.Expr != nil && !isNullExpr(col.Default.Expr)) {
+					if col.Typ.AutoIncr || col.Generated != nil || (col.Default.Expr != nil && !isNullExpr(col.Default.Expr)) {
 						withoutUniqueCol = false
 						break
 					}
@@ -871,6 +879,10 @@ func deleteToSelect(builder *QueryBuilder, bindCtx *BindContext, node *tree.Dele
 }
 
 func checkNotNull(ctx context.Context, expr *Expr, tableDef *TableDef, col *ColDef) error {
+	if col.Generated != nil {
+		// the generated column is computed after the other columns
+		return nil
+	}
 	isConstantNull := false
 	if ef, ok := expr.Expr.(*plan.Expr_Lit); ok {
 		isConstantNull = ef.Lit.Isnull
@@ -24,9 +24,12 @@ import (
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/fulltext"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/monlp/tokenizer"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
@@ -43,12 +46,12 @@ func genDynamicTableDef(ctx CompilerContext, stmt *tree.Select) (*plan.TableDef,
 	var err error
 	switch s := stmt.Select.(type) {
 	case *tree.ParenSelect:
-		stmtPlan, err = bindAndOptimizeSelectQuery(plan.Query_SELECT, ctx, s.Select, false, true)
+		stmtPlan, err = bindAndOptimizeSelectQuery(plan.Query_SELECT, ctx, s.Select, false, true, false)
 		if err != nil {
 			return nil, err
 		}
 	default:
-		stmtPlan, err = bindAndOptimizeSelectQuery(plan.Query_SELECT, ctx, stmt, false, true)
+		stmtPlan, err = bindAndOptimizeSelectQuery(plan.Query_SELECT, ctx, stmt, false, true, false)
 		if err != nil {
 			return nil, err
 		}
@@ -105,12 +108,12 @@ func genViewTableDef(ctx CompilerContext, stmt *tree.Select) (*plan.TableDef, er
 	var err error
 	switch s := stmt.Select.(type) {
 	case *tree.ParenSelect:
-		stmtPlan, err = bindAndOptimizeSelectQuery(plan.Query_SELECT, ctx, s.Select, false, true)
+		stmtPlan, err = bindAndOptimizeSelectQuery(plan.Query_SELECT, ctx, s.Select, false, true, false)
 		if err != nil {
 			return nil, err
 		}
 	default:
-		stmtPlan, err = bindAndOptimizeSelectQuery(plan.Query_SELECT, ctx, stmt, false, true)
+		stmtPlan, err = bindAndOptimizeSelectQuery(plan.Query_SELECT, ctx, stmt, false, true, false)
 		if err != nil {
 			return nil, err
 		}
@@ -765,6 +768,11 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 
 	// set tableDef
 	var err error
+	if stmt.IsMaterializedView {
+		if createTable.TableDef.Mview, err = buildMaterializedViewDef(ctx, stmt, createTable.Database); err != nil {
+			return nil, err
+		}
+	}
 	if stmt.IsDynamicTable {
 		tableDef, err := genDynamicTableDef(ctx, stmt.AsSource)
 		if err != nil {
@@ -787,6 +795,15 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 		return nil, err
 	}
 
+	// bind the generated columns first, the check constraints may refer to them
+	if err = bindGeneratedCols(ctx, createTable.TableDef); err != nil {
+		return nil, err
+	}
+
+	if err = buildCheckDefs(ctx, stmt, createTable.TableDef); err != nil {
+		return nil, err
+	}
+
 	v, ok := getAutoIncrementOffsetFromVariables(ctx)
 	if ok {
 		createTable.TableDef.AutoIncrOffset = v
@@ -833,12 +850,42 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 			if opt.Value != 0 {
 				createTable.TableDef.AutoIncrOffset = opt.Value - 1
 			}
+		case *tree.TableOptionCompression:
+			alg, _, err := compress.ParseAlgorithm(opt.Compression)
+			if err != nil {
+				return nil, err
+			}
+			// the column data is always compressed, 'none' keeps the default LZ4
+			if alg == compress.None {
+				continue
+			}
+
+			properties := []*plan.Property{
+				{
+					Key:   catalog.PropCompression,
+					Value: strings.ToLower(opt.Compression),
+				},
+			}
+			createTable.TableDef.Defs = append(createTable.TableDef.Defs, &plan.TableDef_DefType{
+				Def: &plan.TableDef_DefType_Properties{
+					Properties: &plan.PropertiesDef{
+						Properties: properties,
+					},
+				},
+			})
 		case *tree.RetentionOption:
 			duration, err := parseDuration(ctx.GetContext(), opt.Period, opt.Unit)
 			if err != nil {
 				return nil, err
 			}
 			createTable.RetentionDeadline = time.Now().Add(duration).Unix()
+		case *tree.TableOptionTTL:
+			if stmt.Temporary || stmt.Param != nil {
+				return nil, moerr.NewNotSupported(ctx.GetContext(), "TTL on the temporary or external table")
+			}
+			if createTable.Ttl, err = buildTableTTL(ctx.GetContext(), createTable.TableDef, opt.Expr); err != nil {
+				return nil, err
+			}
 
 		// these table options is not support in plan
 		// case *tree.TableOptionEngine, *tree.TableOptionSecondaryEngine, *tree.TableOptionCharset,
@@ -852,7 +899,7 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 		// 	*tree.TableOptionUnion, *tree.TableOptionEncryption:
 		// 	return nil, moerr.NewNotSupported("statement: '%v'", tree.String(stmt, dialect.MYSQL))
 		case *tree.TableOptionAUTOEXTEND_SIZE, *tree.TableOptionAvgRowLength,
-			*tree.TableOptionCharset, *tree.TableOptionChecksum, *tree.TableOptionCollate, *tree.TableOptionCompression,
+			*tree.TableOptionCharset, *tree.TableOptionChecksum, *tree.TableOptionCollate,
 			*tree.TableOptionConnection, *tree.TableOptionDataDirectory, *tree.TableOptionIndexDirectory,
 			*tree.TableOptionDelayKeyWrite, *tree.TableOptionEncryption, *tree.TableOptionEngine, *tree.TableOptionEngineAttr,
 			*tree.TableOptionKeyBlockSize, *tree.TableOptionMaxRows, *tree.TableOptionMinRows, *tree.TableOptionPackKeys,
@@ -902,6 +949,8 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 		kind := catalog.SystemOrdinaryRel
 		if stmt.IsClusterTable {
 			kind = catalog.SystemClusterRel
+		} else if stmt.IsMaterializedView {
+			kind = catalog.SystemMaterializedRel
 		}
 		// when create hidden talbe(like: auto_incr_table, index_table)， we set relKind to empty
 		if catalog.IsHiddenTable(createTable.TableDef.Name) {
@@ -1055,6 +1104,7 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 	uniqueIndexInfos := make([]*tree.UniqueIndex, 0)
 	fullTextIndexInfos := make([]*tree.FullTextIndex, 0)
 	secondaryIndexInfos := make([]*tree.Index, 0)
+	functionalIndexInfos := make([]tree.TableDef, 0)
 	fkDatasOfFKSelfRefer := make([]*FkData, 0)
 	dedupFkName := make(UnorderedSet[string])
 	for _, item := range stmt.Defs {
@@ -1150,6 +1200,14 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 				return err
 			}
 
+			generated, err := buildGeneratedColAttr(ctx.GetContext(), def)
+			if err != nil {
+				return err
+			}
+			if generated != nil && stmt.IsAsSelect {
+				return moerr.NewNotSupported(ctx.GetContext(), "generated column in create table ... as select statement")
+			}
+
 			if !checkTableColumnNameValid(colName) {
 				return moerr.NewInvalidInputf(ctx.GetContext(), "table column name '%s' is illegal and conflicts with internal keyword", colNameOrigin)
 			}
@@ -1163,6 +1221,7 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 				Default:    defaultValue,
 				OnUpdate:   onUpdateExpr,
 				Comment:    comment,
+				Generated:  generated,
 			}
 			// if same name col in asSelectCols, overwrite it; add into colMap && createTable.TableDef.Cols later
 			if idx := slices.IndexFunc(asSelectCols, func(c *ColDef) bool { return c.Name == col.Name }); idx != -1 {
@@ -1201,9 +1260,10 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 				indexs = append(indexs, name)
 			}
 		case *tree.Index:
-			err := checkIndexKeypartSupportability(ctx.GetContext(), def.KeyParts)
-			if err != nil {
-				return err
+			// the expression key parts are built after all the columns
+			if hasExprKeyPart(def.KeyParts) {
+				functionalIndexInfos = append(functionalIndexInfos, def)
+				continue
 			}
 
 			secondaryIndexInfos = append(secondaryIndexInfos, def)
@@ -1212,9 +1272,9 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 				indexs = append(indexs, name)
 			}
 		case *tree.UniqueIndex:
-			err := checkIndexKeypartSupportability(ctx.GetContext(), def.KeyParts)
-			if err != nil {
-				return err
+			if hasExprKeyPart(def.KeyParts) {
+				functionalIndexInfos = append(functionalIndexInfos, def)
+				continue
 			}
 
 			uniqueIndexInfos = append(uniqueIndexInfos, def)
@@ -1277,8 +1337,7 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 				fkDatasOfFKSelfRefer = append(fkDatasOfFKSelfRefer, fkData)
 			}
 		case *tree.CheckIndex:
-			// unsupport in plan. will support in next version.
-			// return moerr.NewNYI(ctx.GetContext(), "table def: '%v'", def)
+			// check constraints are bound by buildCheckDefs after all the columns are built
 		default:
 			return moerr.NewNYIf(ctx.GetContext(), "table def: '%v'", def)
 		}
@@ -1319,6 +1378,10 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 		insertSqlBuilder.WriteString(fmt.Sprintf(" from (%s)", fmtCtx.String()))
 
 		createTable.CreateAsSelectSql = insertSqlBuilder.String()
+		// the materialized view is populated by its query with the qualified table names
+		if mview := createTable.TableDef.Mview; mview != nil {
+			createTable.CreateAsSelectSql = fmt.Sprintf("insert into `%s`.`%s` select * from (%s) as mv", mview.Database, mview.Name, mview.Query)
+		}
 	}
 
 	//table must have one visible column
@@ -1326,6 +1389,33 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 		return moerr.NewTableMustHaveVisibleColumn(ctx.GetContext())
 	}
 
+	// add the hidden columns of the functional index key parts
+	for _, item := range functionalIndexInfos {
+		switch def := item.(type) {
+		case *tree.Index:
+			indexInfo, err := buildFunctionalIndex(ctx, createTable.TableDef, colMap, def)
+			if err != nil {
+				return err
+			}
+			secondaryIndexInfos = append(secondaryIndexInfos, indexInfo)
+			// the multi-valued index key is the element of the JSON array, not the column
+			if indexInfo.KeyType != tree.INDEX_TYPE_MULTIVALUE {
+				for _, key := range indexInfo.KeyParts {
+					indexs = append(indexs, key.ColName.ColName())
+				}
+			}
+		case *tree.UniqueIndex:
+			indexInfo, err := buildFunctionalUniqueIndex(ctx, createTable.TableDef, colMap, def)
+			if err != nil {
+				return err
+			}
+			uniqueIndexInfos = append(uniqueIndexInfos, indexInfo)
+			for _, key := range indexInfo.KeyParts {
+				indexs = append(indexs, key.ColName.ColName())
+			}
+		}
+	}
+
 	//add cluster table attribute
 	if stmt.IsClusterTable {
 		if _, ok := colMap[util.GetClusterTableAttributeName()]; ok {
@@ -1608,6 +1698,12 @@ func buildFullTextIndexTable(createTable *plan.CreateTable, indexInfos []*tree.F
 	}
 
 	for _, indexInfo := range indexInfos {
+		if err := checkIndexKeypartSupportability(ctx.GetContext(), indexInfo.KeyParts); err != nil {
+			return err
+		}
+		if err := checkIndexKeypartNotVirtual(ctx.GetContext(), indexInfo.KeyParts, colMap); err != nil {
+			return err
+		}
 		// fulltext only support char, varchar and text
 		for _, keyPart := range indexInfo.KeyParts {
 			nameOrigin := keyPart.ColName.ColNameOrigin()
@@ -1625,9 +1721,8 @@ func buildFullTextIndexTable(createTable *plan.CreateTable, indexInfos []*tree.F
 		// check parser
 		var parsername string
 		if indexInfo.IndexOption != nil && indexInfo.IndexOption.ParserName != "" {
-			// set parser ngram
 			parsername = strings.ToLower(indexInfo.IndexOption.ParserName)
-			if parsername != "ngram" && parsername != "default" && parsername != "json" && parsername != "json_value" {
+			if !fulltext.IsValidParser(parsername) {
 				return moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("Fulltext parser %s not supported", parsername))
 			}
 		}
@@ -1657,8 +1752,17 @@ func buildFullTextIndexTable(createTable *plan.CreateTable, indexInfos []*tree.F
 		indexDef.Parts = indexParts
 		indexDef.TableExist = true
 		if indexInfo.IndexOption != nil {
-			if indexInfo.IndexOption.ParserName != "" {
-				indexDef.Option = &plan.IndexOption{ParserName: indexInfo.IndexOption.ParserName, NgramTokenSize: int32(3)}
+			opt := indexInfo.IndexOption
+			if opt.ParserName != "" || opt.NgramTokenSize != 0 || opt.Stopwords != "" || opt.Stemmer != "" {
+				ngramTokenSize := int32(tokenizer.DEFAULT_NGRAM_SIZE)
+				if opt.NgramTokenSize != 0 {
+					ngramTokenSize = int32(opt.NgramTokenSize)
+				}
+				parserName := opt.ParserName
+				if parserName == "" {
+					parserName = tokenizer.TOKENIZER_DEFAULT
+				}
+				indexDef.Option = &plan.IndexOption{ParserName: parserName, NgramTokenSize: ngramTokenSize}
 				indexDef.IndexAlgoParams, err = catalog.IndexParamsToJsonString(indexInfo)
 				if err != nil {
 					return err
@@ -1776,6 +1880,9 @@ func buildFullTextIndexTable(createTable *plan.CreateTable, indexInfos []*tree.F
 
 func buildUniqueIndexTable(createTable *plan.CreateTable, indexInfos []*tree.UniqueIndex, colMap map[string]*ColDef, pkeyName string, ctx CompilerContext) error {
 	for _, indexInfo := range indexInfos {
+		if err := checkIndexKeypartNotVirtual(ctx.GetContext(), indexInfo.KeyParts, colMap); err != nil {
+			return err
+		}
 		indexDef := &plan.IndexDef{}
 		indexDef.Unique = true
 
@@ -1916,6 +2023,10 @@ func buildSecondaryIndexDef(createTable *plan.CreateTable, indexInfos []*tree.In
 		if err != nil {
 			return err
 		}
+		err = checkIndexKeypartNotVirtual(ctx.GetContext(), indexInfo.KeyParts, colMap)
+		if err != nil {
+			return err
+		}
 
 		var indexDef []*plan.IndexDef
 		var tableDef []*TableDef
@@ -1926,6 +2037,10 @@ func buildSecondaryIndexDef(createTable *plan.CreateTable, indexInfos []*tree.In
 			indexDef, tableDef, err = buildIvfFlatSecondaryIndexDef(ctx, indexInfo, colMap, existedIndexes, pkeyName)
 		case tree.INDEX_TYPE_MASTER:
 			indexDef, tableDef, err = buildMasterSecondaryIndexDef(ctx, indexInfo, colMap, pkeyName)
+		case tree.INDEX_TYPE_HNSW:
+			indexDef, tableDef, err = buildHnswSecondaryIndexDef(ctx, indexInfo, colMap, existedIndexes, pkeyName)
+		case tree.INDEX_TYPE_MULTIVALUE:
+			indexDef, tableDef, err = buildMultiValueSecondaryIndexDef(ctx, indexInfo, colMap, pkeyName)
 		default:
 			return moerr.NewInvalidInputNoCtxf("unsupported index type: %s", indexInfo.KeyType.ToString())
 		}
@@ -2523,6 +2638,105 @@ func buildIvfFlatSecondaryIndexDef(ctx CompilerContext, indexInfo *tree.Index, c
 	return indexDefs, tableDefs, nil
 }
 
+func buildHnswSecondaryIndexDef(ctx CompilerContext, indexInfo *tree.Index, colMap map[string]*ColDef, existedIndexes []*plan.IndexDef, pkeyName string) ([]*plan.IndexDef, []*TableDef, error) {
+
+	indexParts := make([]string, 1)
+
+	// 0. Validate: We only support 1 column of either VECF32 or VECF64 type, and the
+	// graph nodes are identified by a single BIGINT primary key.
+	{
+		if len(indexInfo.KeyParts) != 1 {
+			return nil, nil, moerr.NewNotSupported(ctx.GetContext(), "don't support multi column HNSW vector index")
+		}
+
+		name := indexInfo.KeyParts[0].ColName.ColName()
+		indexParts[0] = name
+
+		if _, ok := colMap[name]; !ok {
+			return nil, nil, moerr.NewInvalidInputf(ctx.GetContext(), "column '%s' is not exist", indexInfo.KeyParts[0].ColName.ColNameOrigin())
+		}
+		if colMap[name].Typ.Id != int32(types.T_array_float32) && colMap[name].Typ.Id != int32(types.T_array_float64) {
+			return nil, nil, moerr.NewNotSupported(ctx.GetContext(), "HNSW only supports VECFXX column types")
+		}
+
+		if pk, ok := colMap[pkeyName]; !ok || pk.Typ.Id != int32(types.T_int64) {
+			return nil, nil, moerr.NewNotSupported(ctx.GetContext(), "HNSW index requires a single BIGINT primary key")
+		}
+
+		for _, existedIndex := range existedIndexes {
+			if catalog.IsHnswIndexAlgo(existedIndex.IndexAlgo) && existedIndex.Parts[0] == name {
+				return nil, nil, moerr.NewNotSupported(ctx.GetContext(), "Multiple HNSW indexes are not allowed to use the same column")
+			}
+		}
+	}
+
+	// 1. create hnsw `metadata` table, the graph itself is saved in the fileservice and
+	// the metadata table records the file of its latest version.
+	indexTableName, err := util.BuildIndexTableName(ctx.GetContext(), false)
+	if err != nil {
+		return nil, nil, err
+	}
+	tableDef := &TableDef{
+		Name:      indexTableName,
+		TableType: catalog.SystemSI_HNSW_TblType_Metadata,
+		Cols:      make([]*ColDef, 2),
+	}
+
+	indexDef, err := CreateIndexDef(indexInfo, indexTableName, catalog.SystemSI_HNSW_TblType_Metadata, indexParts, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// columns: key (PK), val
+	tableDef.Cols[0] = &ColDef{
+		Name: catalog.SystemSI_HNSW_TblCol_Metadata_key,
+		Alg:  plan.CompressType_Lz4,
+		Typ: Type{
+			Id:    int32(types.T_varchar),
+			Width: types.MaxVarcharLen,
+		},
+		Primary: true,
+		Default: &plan.Default{
+			NullAbility:  false,
+			Expr:         nil,
+			OriginString: "",
+		},
+	}
+	tableDef.Cols[1] = &ColDef{
+		Name: catalog.SystemSI_HNSW_TblCol_Metadata_val,
+		Alg:  plan.CompressType_Lz4,
+		Typ: Type{
+			Id:    int32(types.T_varchar),
+			Width: types.MaxVarcharLen,
+		},
+		Default: &plan.Default{
+			NullAbility:  false,
+			Expr:         nil,
+			OriginString: "",
+		},
+	}
+
+	tableDef.Pkey = &PrimaryKeyDef{
+		Names:       []string{catalog.SystemSI_HNSW_TblCol_Metadata_key},
+		PkeyColName: catalog.SystemSI_HNSW_TblCol_Metadata_key,
+	}
+
+	properties := []*plan.Property{
+		{
+			Key:   catalog.SystemRelAttr_Kind,
+			Value: catalog.SystemSI_HNSW_TblType_Metadata,
+		},
+	}
+	tableDef.Defs = append(tableDef.Defs, &plan.TableDef_DefType{
+		Def: &plan.TableDef_DefType_Properties{
+			Properties: &plan.PropertiesDef{
+				Properties: properties,
+			},
+		}})
+
+	return []*plan.IndexDef{indexDef}, []*TableDef{tableDef}, nil
+}
+
 func CreateIndexDef(indexInfo *tree.Index,
 	indexTableName, indexAlgoTableType string,
 	indexParts []string, isUnique bool) (*plan.IndexDef, error) {
@@ -2565,6 +2779,12 @@ func CreateIndexDef(indexInfo *tree.Index,
 			if err != nil {
 				return nil, err
 			}
+		case catalog.MoIndexHnswAlgo:
+			var err error
+			indexDef.IndexAlgoParams, err = catalog.IndexParamsMapToJsonString(catalog.DefaultHnswIndexAlgoOptions())
+			if err != nil {
+				return nil, err
+			}
 		}
 
 	}
@@ -2613,6 +2833,10 @@ func buildTruncateTable(stmt *tree.TruncateTable, ctx CompilerContext) (*Plan, e
 			return nil, moerr.NewNoSuchTable(ctx.GetContext(), truncateTable.Database, truncateTable.Table)
 		}
 
+		if tableDef.TableType == catalog.SystemMaterializedRel {
+			return nil, moerr.NewInternalErrorf(ctx.GetContext(), "can not truncate materialized view '%v'", truncateTable.Table)
+		}
+
 		truncateTable.TableId = tableDef.TblId
 		if tableDef.Fkeys != nil {
 			for _, fk := range tableDef.Fkeys {
@@ -2652,6 +2876,11 @@ func buildTruncateTable(stmt *tree.TruncateTable, ctx CompilerContext) (*Plan, e
 					}
 				} else if indexdef.TableExist && catalog.IsMasterIndexAlgo(indexdef.IndexAlgo) {
 					truncateTable.IndexTableNames = append(truncateTable.IndexTableNames, indexdef.IndexTableName)
+				} else if indexdef.TableExist && catalog.IsHnswIndexAlgo(indexdef.IndexAlgo) {
+					// an empty metadata table means an empty graph
+					truncateTable.IndexTableNames = append(truncateTable.IndexTableNames, indexdef.IndexTableName)
+				} else if indexdef.TableExist && catalog.IsMultiValueIndexAlgo(indexdef.IndexAlgo) {
+					truncateTable.IndexTableNames = append(truncateTable.IndexTableNames, indexdef.IndexTableName)
 				}
 			}
 		}
@@ -2718,6 +2947,15 @@ func buildDropTable(stmt *tree.DropTable, ctx CompilerContext) (*Plan, error) {
 			}
 		}
 
+		if isMaterializedView := tableDef.TableType == catalog.SystemMaterializedRel; stmt.IsMaterializedView && !isMaterializedView {
+			return nil, moerr.NewInvalidInputf(ctx.GetContext(), "'%s.%s' is not a materialized view", dropTable.Database, dropTable.Table)
+		} else if !stmt.IsMaterializedView && isMaterializedView {
+			return nil, moerr.NewInternalError(ctx.GetContext(), "Should use 'drop materialized view' to drop a materialized view")
+		}
+		if views := getMaterializedViews(ctx, tableDef); len(views) > 0 {
+			return nil, moerr.NewInternalErrorf(ctx.GetContext(), "can not drop table '%v' referenced by materialized view '%s'", dropTable.Table, views[0].Name)
+		}
+
 		isView := (tableDef.ViewSql != nil)
 		dropTable.IsView = isView
 
@@ -2954,6 +3192,19 @@ func buildCreateIndex(stmt *tree.CreateIndex, ctx CompilerContext) (*Plan, error
 			return nil, moerr.NewDuplicateKey(ctx.GetContext(), indexName)
 		}
 	}
+	// the hidden columns of the expression key parts are added by copying the table
+	if hasExprKeyPart(stmt.KeyParts) && stmt.IndexCat != tree.INDEX_CATEGORY_FULLTEXT {
+		var def tree.TableDef
+		if stmt.IndexCat == tree.INDEX_CATEGORY_UNIQUE {
+			def = &tree.UniqueIndex{Name: indexName, KeyParts: stmt.KeyParts, IndexOption: stmt.IndexOption}
+		} else {
+			def = &tree.Index{Name: indexName, KeyParts: stmt.KeyParts, IndexOption: stmt.IndexOption, KeyType: stmt.IndexOption.IType}
+		}
+		return buildAlterTable(&tree.AlterTable{
+			Table:   stmt.Table,
+			Options: tree.AlterTableOptions{tree.NewAlterOptionAdd(def)},
+		}, ctx)
+	}
 	// build index
 	var ftIdx *tree.FullTextIndex
 	var uIdx *tree.UniqueIndex
@@ -3182,6 +3433,9 @@ func buildRenameTable(stmt *tree.RenameTable, ctx CompilerContext) (*Plan, error
 		if tableDef.ViewSql != nil {
 			return nil, moerr.NewInternalError(ctx.GetContext(), "you should use alter view statemnt for View")
 		}
+		if tableDef.TableType == catalog.SystemMaterializedRel {
+			return nil, moerr.NewInternalError(ctx.GetContext(), "cannot alter materialized view")
+		}
 		if objRef.PubInfo != nil {
 			return nil, moerr.NewInternalError(ctx.GetContext(), "cannot alter table in subscription database")
 		}
@@ -3339,6 +3593,18 @@ func buildAlterTableInplace(stmt *tree.AlterTable, ctx CompilerContext) (*Plan,
 						break
 					}
 				}
+				// drop constraint may also refer to a check constraint
+				if idx := findCheckDef(tableDef, constraintName); name_not_found && idx != -1 {
+					alterTableDrop.Typ = plan.AlterTableDrop_CHECK
+					alterTableDrop.Name = tableDef.Checks[idx].Name
+					name_not_found = false
+				}
+			case tree.AlterTableDropCheck:
+				alterTableDrop.Typ = plan.AlterTableDrop_CHECK
+				if idx := findCheckDef(tableDef, constraintName); idx != -1 {
+					alterTableDrop.Name = tableDef.Checks[idx].Name
+					name_not_found = false
+				}
 			}
 			if name_not_found {
 				return nil, moerr.NewInternalErrorf(ctx.GetContext(), "Can't DROP '%s'; check that column/key exists", constraintName)
@@ -3525,13 +3791,8 @@ func buildAlterTableInplace(stmt *tree.AlterTable, ctx CompilerContext) (*Plan,
 					},
 				}
 			case *tree.CheckIndex:
-				alterTable.Actions[i] = &plan.AlterTable_Action{
-					Action: &plan.AlterTable_Action_AlterComment{
-						AlterComment: &plan.AlterTableComment{
-							NewComment: comment,
-						},
-					},
-				}
+				// existing rows must be validated, which is done by the copy algorithm
+				return nil, moerr.NewNotSupported(ctx.GetContext(), "add check constraint together with other inplace alter table options")
 			default:
 				return nil, moerr.NewInternalErrorf(ctx.GetContext(), "unsupported alter option: %T", def)
 			}
@@ -3575,6 +3836,8 @@ func buildAlterTableInplace(stmt *tree.AlterTable, ctx CompilerContext) (*Plan,
 					return nil, moerr.NewInternalError(ctx.GetContext(), "lists should be > 0.")
 				}
 				alterTableReIndex.IndexAlgoParamList = opt.AlgoParamList
+			case tree.INDEX_TYPE_HNSW:
+				// the graph is rebuilt with the parameters it was created with
 			default:
 				return nil, moerr.NewInternalErrorf(ctx.GetContext(), "unsupported index type: %v", opt.KeyType)
 			}
@@ -3747,6 +4010,24 @@ func buildAlterTableInplace(stmt *tree.AlterTable, ctx CompilerContext) (*Plan,
 			}
 		case *tree.TableOptionAutoIncrement:
 			return nil, moerr.NewInvalidInput(ctx.GetContext(), "Can't set AutoIncr column value.")
+		case *tree.TableOptionTTL:
+			ttl, err := buildTableTTL(ctx.GetContext(), tableDef, opt.Expr)
+			if err != nil {
+				return nil, err
+			}
+			alterTable.Actions[i] = &plan.AlterTable_Action{
+				Action: &plan.AlterTable_Action_AlterTtl{
+					AlterTtl: &plan.AlterTableTTL{
+						Ttl: ttl,
+					},
+				},
+			}
+		case *tree.AlterOptionRemoveTTL:
+			alterTable.Actions[i] = &plan.AlterTable_Action{
+				Action: &plan.AlterTable_Action_AlterTtl{
+					AlterTtl: &plan.AlterTableTTL{},
+				},
+			}
 		case *tree.AlterOptionAlterCheck, *tree.TableOptionCharset:
 			alterTable.Actions[i] = &plan.AlterTable_Action{
 				Action: &plan.AlterTable_Action_AlterComment{
@@ -4198,6 +4479,72 @@ var unitDurations = map[string]time.Duration{
 	"month":  time.Hour * 24 * 30,
 }
 
+// ttlUnits is the interval units supported by the TTL of a table
+var ttlUnits = map[string]struct{}{
+	"SECOND":  {},
+	"MINUTE":  {},
+	"HOUR":    {},
+	"DAY":     {},
+	"WEEK":    {},
+	"MONTH":   {},
+	"QUARTER": {},
+	"YEAR":    {},
+}
+
+// buildTableTTL checks the TTL = column + INTERVAL n unit clause of the table, the column
+// must be a DATE, DATETIME or TIMESTAMP column of the table and n must be positive.
+func buildTableTTL(ctx context.Context, tableDef *TableDef, expr tree.Expr) (*plan.TableTTL, error) {
+	invalid := func() error {
+		return moerr.NewInvalidInputf(ctx, "TTL must be 'column + INTERVAL n unit', got '%s'", tree.String(expr, dialect.MYSQL))
+	}
+	binary, ok := expr.(*tree.BinaryExpr)
+	if !ok || binary.Op != tree.PLUS {
+		return nil, invalid()
+	}
+	name, ok := binary.Left.(*tree.UnresolvedName)
+	if !ok {
+		return nil, invalid()
+	}
+	interval, ok := binary.Right.(*tree.FuncExpr)
+	if !ok || len(interval.Exprs) != 2 {
+		return nil, invalid()
+	}
+	value, ok := interval.Exprs[0].(*tree.NumVal)
+	if !ok || value.ValType != tree.P_int64 {
+		return nil, invalid()
+	}
+	n, _ := value.Int64()
+	if n <= 0 {
+		return nil, moerr.NewInvalidInputf(ctx, "TTL interval must be positive, got %d", n)
+	}
+	unitVal, ok := interval.Exprs[1].(*tree.NumVal)
+	if !ok {
+		return nil, invalid()
+	}
+	unit := strings.ToUpper(unitVal.String())
+	if _, ok = ttlUnits[unit]; !ok {
+		return nil, moerr.NewNotSupportedf(ctx, "TTL interval unit %s", unit)
+	}
+
+	colName := name.ColName()
+	for _, col := range tableDef.Cols {
+		if !strings.EqualFold(col.Name, colName) || col.Hidden {
+			continue
+		}
+		switch types.T(col.Typ.Id) {
+		case types.T_date, types.T_datetime, types.T_timestamp:
+		default:
+			return nil, moerr.NewNotSupportedf(ctx, "TTL on column '%s' of type %s", col.Name, types.T(col.Typ.Id).String())
+		}
+		return &plan.TableTTL{
+			Column:   col.Name,
+			Interval: n,
+			Unit:     unit,
+		}, nil
+	}
+	return nil, moerr.NewBadFieldError(ctx, colName, tableDef.Name)
+}
+
 func parseDuration(ctx context.Context, period uint64, unit string) (time.Duration, error) {
 	unitDuration, ok := unitDurations[strings.ToLower(unit)]
 	if !ok {
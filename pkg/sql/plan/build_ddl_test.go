@@ -31,6 +31,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/util/executor"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestBuildAlterView(t *testing.T) {
@@ -400,14 +401,6 @@ func TestBuildCreateTableError(t *testing.T) {
 			col3 INT NOT NULL,
 			col4 INT NOT NULL
 		);`,
-
-		`CREATE TABLE t3 (
-			col1 INT NOT NULL,
-			col2 DATE NOT NULL,
-			col3 INT NOT NULL,
-			col4 INT NOT NULL,
-			UNIQUE KEY uk1 ((col1 + col3))
-		);`,
 	}
 	runTestShouldError(mock, t, sqlerrs)
 }
@@ -454,6 +447,78 @@ func TestCreateTableAsSelect(t *testing.T) {
 	runTestShouldPass(mock, t, sqls, false, false)
 }
 
+func TestCreateTableWithCompression(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	rt := moruntime.DefaultRuntime()
+	moruntime.SetupServiceBasedRuntime("", rt)
+	rt.SetGlobalVariables(moruntime.InternalSQLExecutor, executor.NewMemExecutor(func(sql string) (executor.Result, error) {
+		return executor.Result{}, nil
+	}))
+	cases := []struct {
+		sql      string
+		expected string
+	}{
+		{"create table t1 (a int, b varchar(20)) compression = 'ZSTD'", "zstd"},
+		{"create table t1 (a int, b varchar(20)) compression = 'zstd:19'", "zstd:19"},
+		{"create table t1 (a int, b varchar(20)) compression = 'snappy'", "snappy"},
+		{"create table t1 (a int, b varchar(20)) compression = 'none'", ""},
+	}
+	for _, c := range cases {
+		logicPlan, err := runOneStmt(mock, t, c.sql)
+		require.NoError(t, err, c.sql)
+		compression := ""
+		for _, def := range logicPlan.GetDdl().GetCreateTable().GetTableDef().GetDefs() {
+			for _, prop := range def.GetProperties().GetProperties() {
+				if prop.Key == catalog.PropCompression {
+					compression = prop.Value
+				}
+			}
+		}
+		require.Equal(t, c.expected, compression, c.sql)
+	}
+
+	runTestShouldError(mock, t, []string{
+		"create table t1 (a int) compression = 'gzip'",
+		"create table t1 (a int) compression = 'zstd:23'",
+		"create table t1 (a int) compression = 'lz4:1'",
+	})
+}
+
+func TestBuildTableTTL(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	rt := moruntime.DefaultRuntime()
+	moruntime.SetupServiceBasedRuntime("", rt)
+	rt.SetGlobalVariables(moruntime.InternalSQLExecutor, executor.NewMemExecutor(func(sql string) (executor.Result, error) {
+		return executor.Result{}, nil
+	}))
+
+	logicPlan, err := runOneStmt(mock, t, "create table t1 (a int, Created_At datetime) ttl = created_at + interval 30 day")
+	require.NoError(t, err)
+	require.Equal(t, &plan.TableTTL{Column: "created_at", Interval: 30, Unit: "DAY"}, logicPlan.GetDdl().GetCreateTable().GetTtl())
+
+	logicPlan, err = runOneStmt(mock, t, "alter table emp ttl = hiredate + interval 1 year")
+	require.NoError(t, err)
+	actions := logicPlan.GetDdl().GetAlterTable().GetActions()
+	require.Equal(t, 1, len(actions))
+	require.Equal(t, &plan.TableTTL{Column: "hiredate", Interval: 1, Unit: "YEAR"}, actions[0].GetAlterTtl().GetTtl())
+
+	logicPlan, err = runOneStmt(mock, t, "alter table emp remove ttl")
+	require.NoError(t, err)
+	actions = logicPlan.GetDdl().GetAlterTable().GetActions()
+	require.Equal(t, 1, len(actions))
+	require.NotNil(t, actions[0].GetAlterTtl())
+	require.Nil(t, actions[0].GetAlterTtl().GetTtl())
+
+	runTestShouldError(mock, t, []string{
+		"create table t1 (a int, b datetime) ttl = c + interval 1 day",
+		"create table t1 (a int, b datetime) ttl = a + interval 1 day",
+		"create table t1 (a int, b datetime) ttl = b + interval 0 day",
+		"create table t1 (a int, b datetime) ttl = b + interval 1 microsecond",
+		"create table t1 (a int, b datetime) ttl = b + interval 1.5 day",
+		"alter table emp ttl = ename + interval 1 day",
+	})
+}
+
 func TestParseDuration(t *testing.T) {
 
 	cases := []struct {
@@ -67,6 +67,11 @@ func buildDelete(stmt *tree.Delete, ctx CompilerContext, isPrepareStmt bool) (*P
 	// needLockTable := !tblInfo.isMulti && stmt.Where == nil && stmt.Limit == nil
 	// todo will do not lock table now.
 	isDeleteWithoutFilters := !tblInfo.isMulti && stmt.Where == nil && stmt.Limit == nil
+	// the delete triggers are fired for each row and the materialized views are refreshed by the deleted
+	// rows, the table can not be truncated
+	if isDeleteWithoutFilters && (len(tblInfo.tableDefs[0].Triggers) > 0 || len(tblInfo.tableDefs[0].RefMviews) > 0) {
+		isDeleteWithoutFilters = false
+	}
 	needLockTable := isDeleteWithoutFilters
 	for i, tableDef := range tblInfo.tableDefs {
 		deleteBindCtx := NewBindContext(builder, nil)
@@ -107,6 +112,11 @@ func buildDelete(stmt *tree.Delete, ctx CompilerContext, isPrepareStmt bool) (*P
 		nextSourceStep := builder.appendStep(lastNodeId)
 		delPlanCtx.sourceStep = nextSourceStep
 
+		err = buildDeleteTriggerPlans(ctx, builder, deleteBindCtx, delPlanCtx)
+		if err != nil {
+			return nil, err
+		}
+		buildDeleteMaterializedViewPlans(ctx, builder, deleteBindCtx, delPlanCtx)
 		err = buildDeletePlans(ctx, builder, deleteBindCtx, delPlanCtx)
 		if err != nil {
 			return nil, err
@@ -95,6 +95,7 @@ type dmlPlanCtx struct {
 	lockTable              bool //we need lock table in stmt: delete from tbl
 	checkInsertPkDup       bool //if we need check for duplicate values in insert batch.  eg:insert into t values (1).  load data will not check
 	updatePkCol            bool //if update stmt will update the primary key or one of pks
+	onDuplicateKey         bool //if the rows are written by insert on duplicate key update, the rows without the old row are inserted
 	pkFilterExprs          []*Expr
 	isDeleteWithoutFilters bool
 	partitionInfos         map[uint64]*partSubTableInfo // key: Main Table Id, value: Partition sub table information
@@ -152,12 +153,39 @@ func buildInsertPlans(
 		}
 	}
 
-	// add plan: -> preinsert -> sink
+	// add plan: -> [before insert triggers] -> [generated columns] -> [check filter] -> preinsert -> sink
+	if triggers := getTriggers(tableDef, tree.TRIGGER_BEFORE, tree.TRIGGER_INSERT); len(triggers) > 0 {
+		projList := getProjectionByLastNode(builder, lastNodeId)
+		lastNodeId, err = appendTriggerNodes(ctx, builder, bindCtx, objRef, tableDef, triggers, projList, func(colIdx int) (int, bool) {
+			return colIdx, colIdx < len(projList)
+		}, nil, nil, lastNodeId, true)
+		if err != nil {
+			return err
+		}
+	}
+	lastNodeId, err = appendGeneratedColProjectNode(builder, bindCtx, tableDef, lastNodeId)
+	if err != nil {
+		return err
+	}
+	lastNodeId, err = appendCheckFilterNode(builder, bindCtx, tableDef, lastNodeId)
+	if err != nil {
+		return err
+	}
+	colCount := len(tableDef.Cols)
 	lastNodeId = appendPreInsertNode(builder, bindCtx, objRef, tableDef, lastNodeId, false)
 
 	lastNodeId = appendSinkNode(builder, bindCtx, lastNodeId)
 	sourceStep := builder.appendStep(lastNodeId)
 
+	// the after insert triggers see the values of the auto increment and generated columns
+	if triggers := getTriggers(tableDef, tree.TRIGGER_AFTER, tree.TRIGGER_INSERT); len(triggers) > 0 {
+		err = buildAfterInsertTriggerPlans(ctx, builder, bindCtx, objRef, tableDef, colCount, sourceStep, triggers, false)
+		if err != nil {
+			return err
+		}
+	}
+	buildInsertMaterializedViewPlans(ctx, builder, bindCtx, objRef, tableDef, colCount, sourceStep)
+
 	// make insert plans for origin table and related index table
 	insertBindCtx := NewBindContext(builder, nil)
 	updateColLength := 0
@@ -197,12 +225,11 @@ func buildUpdatePlans(ctx CompilerContext, builder *QueryBuilder, bindCtx *BindC
 	newCols := make([]*ColDef, 0, len(updatePlanCtx.tableDef.Cols))
 	oldRowIdPos := len(updatePlanCtx.tableDef.Cols) - 1
 	for _, col := range updatePlanCtx.tableDef.Cols {
-		if col.Hidden && col.Name != catalog.FakePrimaryKeyColName {
+		if col.Hidden && col.Name != catalog.FakePrimaryKeyColName && !isFunctionalIndexCol(col) {
 			continue
 		}
 		newCols = append(newCols, col)
 	}
-	updatePlanCtx.tableDef.Cols = newCols
 	insertColLength := len(updatePlanCtx.insertColPos) + 1
 	projectList := make([]*Expr, insertColLength)
 	for i, idx := range updatePlanCtx.insertColPos {
@@ -230,20 +257,57 @@ func buildUpdatePlans(ctx CompilerContext, builder *QueryBuilder, bindCtx *BindC
 		},
 	}
 
-	//append project node
-	projectNode := &Node{
-		NodeType:    plan.Node_PROJECT,
-		Children:    []int32{lastNodeId},
-		ProjectList: projectList,
+	var triggers []*plan.TriggerDef
+	if !updatePlanCtx.isFkRecursionCall {
+		// refresh the materialized views with the keys of both the old and the new rows
+		lastNodeId = appendUpdateMaterializedViewNode(ctx, builder, bindCtx, updatePlanCtx, newCols, lastNodeId)
+		triggers = append(getTriggers(updatePlanCtx.tableDef, tree.TRIGGER_BEFORE, tree.TRIGGER_UPDATE),
+			getTriggers(updatePlanCtx.tableDef, tree.TRIGGER_AFTER, tree.TRIGGER_UPDATE)...)
+	}
+	if len(triggers) > 0 {
+		//append the project node with the triggers, the old row is at the front of the sink scan
+		lastNodeId, err = appendUpdateTriggerNodes(ctx, builder, bindCtx, updatePlanCtx, triggers, newCols, projectList, lastNodeId)
+		if err != nil {
+			return err
+		}
+	} else {
+		//append project node
+		projectNode := &Node{
+			NodeType:    plan.Node_PROJECT,
+			Children:    []int32{lastNodeId},
+			ProjectList: projectList,
+		}
+		lastNodeId = builder.appendNode(projectNode, bindCtx)
+	}
+	updatePlanCtx.tableDef.Cols = newCols
+	//append generated columns project node
+	lastNodeId, err = appendGeneratedColProjectNode(builder, bindCtx, updatePlanCtx.tableDef, lastNodeId)
+	if err != nil {
+		return err
+	}
+	//append check filter node
+	lastNodeId, err = appendCheckFilterNode(builder, bindCtx, updatePlanCtx.tableDef, lastNodeId)
+	if err != nil {
+		return err
 	}
-	lastNodeId = builder.appendNode(projectNode, bindCtx)
 	//append preinsert node
+	colCount := len(updatePlanCtx.tableDef.Cols)
 	lastNodeId = appendPreInsertNode(builder, bindCtx, updatePlanCtx.objRef, updatePlanCtx.tableDef, lastNodeId, true)
 
 	//append sink node
 	lastNodeId = appendSinkNode(builder, bindCtx, lastNodeId)
 	sourceStep := builder.appendStep(lastNodeId)
 
+	// the rows of insert on duplicate key update without the old row fire the after insert triggers
+	if updatePlanCtx.onDuplicateKey && !updatePlanCtx.isFkRecursionCall {
+		if triggers := getTriggers(updatePlanCtx.tableDef, tree.TRIGGER_AFTER, tree.TRIGGER_INSERT); len(triggers) > 0 {
+			err = buildAfterInsertTriggerPlans(ctx, builder, bindCtx, updatePlanCtx.objRef, updatePlanCtx.tableDef, colCount, sourceStep, triggers, true)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
 	// build insert plan.
 	insertBindCtx := NewBindContext(builder, nil)
 	var partitionExpr *Expr
@@ -861,6 +925,11 @@ func buildInsertPlansWithRelatedHiddenTable(
 				if err != nil {
 					return err
 				}
+			} else if indexdef.TableExist && catalog.IsHnswIndexAlgo(indexdef.IndexAlgo) {
+				err = buildPostInsertHnswIndex(ctx, builder, bindCtx, objRef, tableDef, sourceStep, indexdef)
+				if err != nil {
+					return err
+				}
 			} else if postdml_flag && indexdef.TableExist && catalog.IsFullTextIndexAlgo(indexdef.IndexAlgo) {
 				// TODO: choose either PostInsertFullTextIndex or PreInsertFullTextIndex
 				err = buildPostInsertFullTextIndex(stmt, ctx, builder, bindCtx, objRef, tableDef, updateColLength, sourceStep, ifInsertFromUniqueColMap, indexdef, idx)
@@ -878,6 +947,14 @@ func buildInsertPlansWithRelatedHiddenTable(
 				return err
 			}
 		}
+
+		// the rows of the multi-valued index are deleted for UPDATE, insert them again like the fulltext index
+		if indexdef.TableExist && catalog.IsMultiValueIndexAlgo(indexdef.IndexAlgo) {
+			err = buildPreInsertMultiValueIndex(ctx, builder, bindCtx, objRef, tableDef, updateColLength, sourceStep, indexdef)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	buildPreInsertMultiTableIndexes(ctx, builder, bindCtx, objRef, tableDef, sourceStep, multiTableIndexes)
@@ -1286,7 +1363,10 @@ func makeDeleteNodeInfo(ctx CompilerContext, objRef *ObjectRef, tableDef *TableD
 					}
 				} else if catalog.IsMasterIndexAlgo(indexdef.IndexAlgo) {
 					delNodeInfo.indexTableNames = append(delNodeInfo.indexTableNames, indexdef.IndexTableName)
-				} else if catalog.IsFullTextIndexAlgo(indexdef.IndexAlgo) {
+				} else if catalog.IsFullTextIndexAlgo(indexdef.IndexAlgo) || catalog.IsMultiValueIndexAlgo(indexdef.IndexAlgo) {
+					delNodeInfo.indexTableNames = append(delNodeInfo.indexTableNames, indexdef.IndexTableName)
+				} else if catalog.IsHnswIndexAlgo(indexdef.IndexAlgo) {
+					// an empty metadata table means an empty graph
 					delNodeInfo.indexTableNames = append(delNodeInfo.indexTableNames, indexdef.IndexTableName)
 				}
 			}
@@ -4271,7 +4351,17 @@ func buildDeleteIndexPlans(ctx CompilerContext, builder *QueryBuilder, bindCtx *
 					return false
 				}
 
-				if !isPrimaryKeyUpdated() && !isSecondaryKeyUpdated() {
+				// the multi-valued index rows are always inserted again for UPDATE, see buildPreInsertMultiValueIndex
+				if !isPrimaryKeyUpdated() && !isSecondaryKeyUpdated() && !catalog.IsMultiValueIndexAlgo(indexdef.IndexAlgo) {
+					continue
+				}
+
+				if indexdef.TableExist && catalog.IsHnswIndexAlgo(indexdef.IndexAlgo) {
+					// the old keys are not in the sink when the primary key is updated, rebuild the graph
+					err = buildPostDeleteHnswIndex(ctx, builder, bindCtx, delCtx, indexdef, isPrimaryKeyUpdated())
+					if err != nil {
+						return err
+					}
 					continue
 				}
 			}
@@ -4297,6 +4387,11 @@ func buildDeleteIndexPlans(ctx CompilerContext, builder *QueryBuilder, bindCtx *
 				if err != nil {
 					return err
 				}
+			} else if indexdef.TableExist && catalog.IsHnswIndexAlgo(indexdef.IndexAlgo) {
+				err = buildPostDeleteHnswIndex(ctx, builder, bindCtx, delCtx, indexdef, delCtx.isDeleteWithoutFilters)
+				if err != nil {
+					return err
+				}
 			} else if indexdef.TableExist && catalog.IsFullTextIndexAlgo(indexdef.IndexAlgo) {
 				// TODO: choose either PostDeleteFullTextIndex or PreDeleteFullTextIndex
 				if postdml_flag {
@@ -4307,6 +4402,11 @@ func buildDeleteIndexPlans(ctx CompilerContext, builder *QueryBuilder, bindCtx *
 				if err != nil {
 					return err
 				}
+			} else if indexdef.TableExist && catalog.IsMultiValueIndexAlgo(indexdef.IndexAlgo) {
+				err = buildPreDeleteMultiValueIndex(ctx, builder, bindCtx, delCtx, indexdef, typMap, posMap)
+				if err != nil {
+					return err
+				}
 			}
 		}
 
@@ -4562,6 +4662,12 @@ func buildDeleteRowsFullTextIndex(ctx CompilerContext, builder *QueryBuilder, bi
 
 		deleteIdx := getRowIdPos(indexTableDef)
 		retPkPos := 3 // __mo_fake_pk_col
+		for i, col := range indexTableDef.Cols {
+			if col.Name == catalog.FakePrimaryKeyColName {
+				retPkPos = i
+				break
+			}
+		}
 		retPkTyp := indexTableDef.Cols[retPkPos].Typ
 
 		return lastNodeId, deleteIdx, retPkPos, retPkTyp, nil
@@ -4810,3 +4916,65 @@ func buildPostInsertFullTextIndex(stmt *tree.Insert, ctx CompilerContext, builde
 	return buildPostDmlFullTextIndex(ctx, builder, bindCtx, indexObjRef, indexTableDef, tableDef,
 		sourceStep, indexdef, idx, isDelete, isInsert, isDeleteWithoutFilters)
 }
+
+// build PostDml HNSW Index node. The graph of the HNSW index is saved in the fileservice, so
+// it cannot be maintained by the DML plans of the index table. The PostDml node collects the
+// primary keys of the changed rows instead, and hnsw_index_update() re-reads their vectors
+// from the source table after the pipelines, it updates the graph and the metadata table.
+func buildPostDmlHnswIndex(ctx CompilerContext, builder *QueryBuilder, bindCtx *BindContext, indexObjRef *ObjectRef, tableDef *TableDef,
+	sourceStep int32, indexdef *plan.IndexDef, isDelete, isInsert, isRebuild bool) error {
+
+	lastNodeId := appendSinkScanNode(builder, bindCtx, sourceStep)
+	orgPkColPos, _ := getPkPos(tableDef, false)
+
+	postdmlProject := getProjectionByLastNode(builder, lastNodeId)
+	postdml := &plan.Node{
+		NodeType:    plan.Node_POSTDML,
+		ProjectList: postdmlProject,
+		Children:    []int32{lastNodeId},
+		PostDmlCtx: &plan.PostDmlCtx{
+			Ref:                    indexObjRef,
+			PrimaryKeyIdx:          int32(orgPkColPos),
+			PrimaryKeyName:         tableDef.Pkey.PkeyColName,
+			IsDelete:               isDelete,
+			IsInsert:               isInsert,
+			IsDeleteWithoutFilters: isRebuild,
+			Hnsw: &plan.PostDmlHnswCtx{
+				SourceTableName: tableDef.Name,
+				IndexTableName:  indexdef.IndexTableName,
+				Parts:           indexdef.Parts,
+				AlgoParams:      indexdef.IndexAlgoParams,
+			},
+		},
+	}
+	lastNodeId = builder.appendNode(postdml, bindCtx)
+	builder.appendStep(lastNodeId)
+
+	return nil
+}
+
+// Post Delete HNSW Index to update the graph for both DELETE and UPDATE
+func buildPostDeleteHnswIndex(ctx CompilerContext, builder *QueryBuilder, bindCtx *BindContext, delCtx *dmlPlanCtx,
+	indexdef *plan.IndexDef, isRebuild bool) error {
+
+	indexObjRef, indexTableDef := ctx.Resolve(delCtx.objRef.SchemaName, indexdef.IndexTableName, nil)
+	if indexTableDef == nil {
+		return moerr.NewNoSuchTable(builder.GetContext(), delCtx.objRef.SchemaName, indexdef.IndexName)
+	}
+
+	return buildPostDmlHnswIndex(ctx, builder, bindCtx, indexObjRef, delCtx.tableDef,
+		delCtx.sourceStep, indexdef, true, delCtx.updateColLength > 0, isRebuild)
+}
+
+// Post Insert HNSW Index to add the inserted rows into the graph
+func buildPostInsertHnswIndex(ctx CompilerContext, builder *QueryBuilder, bindCtx *BindContext, objRef *ObjectRef, tableDef *TableDef,
+	sourceStep int32, indexdef *plan.IndexDef) error {
+
+	indexObjRef, indexTableDef := ctx.Resolve(objRef.SchemaName, indexdef.IndexTableName, nil)
+	if indexTableDef == nil {
+		return moerr.NewNoSuchTable(builder.GetContext(), objRef.SchemaName, indexdef.IndexName)
+	}
+
+	return buildPostDmlHnswIndex(ctx, builder, bindCtx, indexObjRef, tableDef,
+		sourceStep, indexdef, false, true, false)
+}
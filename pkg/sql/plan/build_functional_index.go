@@ -0,0 +1,625 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
+)
+
+// A functional index key part like INDEX ((CAST(doc->>'$.id' AS UNSIGNED))) is saved as a hidden
+// stored generated column of the expression, the index is built on the hidden column as usual.
+//
+// A multi-valued index key part like INDEX ((CAST(doc->'$.tags' AS UNSIGNED ARRAY))) is saved as a
+// hidden stored generated column of the JSON array, and the index table holds one row per array
+// element, which is expanded by json_table when the rows are written.
+
+// isFunctionalIndexCol returns true if the column is the hidden column of a functional or
+// multi-valued index key part.
+func isFunctionalIndexCol(col *ColDef) bool {
+	return col != nil && col.Hidden && col.Generated != nil
+}
+
+func hasExprKeyPart(keyParts []*tree.KeyPart) bool {
+	for _, keyPart := range keyParts {
+		if keyPart.Expr != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// getMultiValueCastExpr returns the CAST(... AS type ARRAY) expression of the key part, or nil.
+func getMultiValueCastExpr(keyPart *tree.KeyPart) *tree.CastExpr {
+	if castExpr, ok := keyPart.Expr.(*tree.CastExpr); ok && castExpr.Array {
+		return castExpr
+	}
+	return nil
+}
+
+// buildFunctionalIndex returns a copy of the index whose expression key parts are replaced by
+// hidden generated columns appended to tableDef and colMap.
+func buildFunctionalIndex(ctx CompilerContext, tableDef *TableDef, colMap map[string]*ColDef, def *tree.Index) (*tree.Index, error) {
+	newDef := *def
+	keyParts, multiValue, err := buildFunctionalKeyParts(ctx, tableDef, colMap, def.KeyParts, false)
+	if err != nil {
+		return nil, err
+	}
+	newDef.KeyParts = keyParts
+	if multiValue {
+		if def.KeyType != tree.INDEX_TYPE_INVALID && def.KeyType != tree.INDEX_TYPE_BTREE {
+			return nil, moerr.NewNotSupportedf(ctx.GetContext(), "multi-valued index using %s", def.KeyType.ToString())
+		}
+		newDef.KeyType = tree.INDEX_TYPE_MULTIVALUE
+	}
+	return &newDef, nil
+}
+
+// buildFunctionalUniqueIndex is the same as buildFunctionalIndex for the unique index.
+func buildFunctionalUniqueIndex(ctx CompilerContext, tableDef *TableDef, colMap map[string]*ColDef, def *tree.UniqueIndex) (*tree.UniqueIndex, error) {
+	newDef := *def
+	keyParts, _, err := buildFunctionalKeyParts(ctx, tableDef, colMap, def.KeyParts, true)
+	if err != nil {
+		return nil, err
+	}
+	newDef.KeyParts = keyParts
+	return &newDef, nil
+}
+
+// buildFunctionalKeyParts returns the key parts whose expressions are replaced by hidden generated
+// columns. The key part of a multi-valued index keeps its CAST ... ARRAY expression, which gives
+// the element type of the index table.
+func buildFunctionalKeyParts(ctx CompilerContext, tableDef *TableDef, colMap map[string]*ColDef, keyParts []*tree.KeyPart, unique bool) ([]*tree.KeyPart, bool, error) {
+	multiValue := false
+	newKeyParts := make([]*tree.KeyPart, len(keyParts))
+	for i, keyPart := range keyParts {
+		if keyPart.Expr == nil {
+			newKeyParts[i] = keyPart
+			continue
+		}
+		// INDEX ((a)) is the same as INDEX (a)
+		if name, ok := keyPart.Expr.(*tree.UnresolvedName); ok && name.NumParts == 1 {
+			newKeyParts[i] = tree.NewKeyPart(name, keyPart.Length, keyPart.Direction, nil)
+			continue
+		}
+
+		expr := keyPart.Expr
+		castExpr := getMultiValueCastExpr(keyPart)
+		if castExpr != nil {
+			if unique {
+				return nil, false, moerr.NewNotSupported(ctx.GetContext(), "unique multi-valued index")
+			}
+			if len(keyParts) > 1 {
+				return nil, false, moerr.NewNotSupported(ctx.GetContext(), "multi-valued index with more than one key part")
+			}
+			if _, err := getMultiValueElemType(ctx, castExpr); err != nil {
+				return nil, false, err
+			}
+			expr = castExpr.Expr
+			multiValue = true
+		}
+
+		col, err := appendFunctionalIndexCol(ctx, tableDef, colMap, expr, multiValue)
+		if err != nil {
+			return nil, false, err
+		}
+		colName := tree.NewUnresolvedColName(col.Name)
+		if multiValue {
+			newKeyParts[i] = tree.NewKeyPart(colName, 0, keyPart.Direction, castExpr)
+		} else {
+			newKeyParts[i] = tree.NewKeyPart(colName, 0, keyPart.Direction, nil)
+		}
+	}
+	return newKeyParts, multiValue, nil
+}
+
+// appendFunctionalIndexCol appends the hidden stored generated column of expr to tableDef. The type
+// of the column is the type of the expression, or JSON for a multi-valued index.
+func appendFunctionalIndexCol(ctx CompilerContext, tableDef *TableDef, colMap map[string]*ColDef, expr tree.Expr, multiValue bool) (*ColDef, error) {
+	name := ""
+	for i := 0; ; i++ {
+		name = fmt.Sprintf("%s%d", catalog.FunctionalIndexColPrefix, i)
+		if _, ok := colMap[name]; !ok && FindColumn(tableDef.Cols, name) == nil {
+			break
+		}
+	}
+	col := &ColDef{
+		Name:       name,
+		OriginName: name,
+		Hidden:     true,
+		Alg:        plan.CompressType_Lz4,
+		Default: &plan.Default{
+			NullAbility: true,
+		},
+		Generated: &plan.GeneratedCol{
+			OriginString: tree.StringWithOpts(expr, dialect.MYSQL, tree.WithSingleQuoteString()),
+			Stored:       true,
+		},
+	}
+
+	// the column is bound as the last one of the table to infer its type from the expression
+	tableDef.Cols = append(tableDef.Cols, col)
+	typ, err := inferFunctionalIndexColType(ctx, tableDef, expr, multiValue)
+	if err != nil {
+		tableDef.Cols = tableDef.Cols[:len(tableDef.Cols)-1]
+		return nil, err
+	}
+	col.Typ = typ
+	colMap[col.Name] = col
+	return col, nil
+}
+
+func inferFunctionalIndexColType(ctx CompilerContext, tableDef *TableDef, expr tree.Expr, multiValue bool) (plan.Type, error) {
+	col := tableDef.Cols[len(tableDef.Cols)-1]
+	builder, bindContext, err := newGeneratedColBindContext(ctx, tableDef)
+	if err != nil {
+		return plan.Type{}, err
+	}
+	boundExpr, err := NewGeneratedColBinder(builder, bindContext, tableDef, len(tableDef.Cols)-1).BindExpr(expr, 0, true)
+	if err != nil {
+		return plan.Type{}, err
+	}
+
+	exprTyp := types.T(boundExpr.Typ.Id)
+	if multiValue {
+		if exprTyp != types.T_json && !exprTyp.IsMySQLString() {
+			return plan.Type{}, moerr.NewInvalidInputf(ctx.GetContext(), "multi-valued index key part '%s' is not a JSON value", col.Generated.OriginString)
+		}
+		return plan.Type{Id: int32(types.T_json)}, nil
+	}
+	switch exprTyp {
+	case types.T_json:
+		return plan.Type{}, moerr.NewNotSupportedf(ctx.GetContext(), "functional index on the JSON value '%s', use CAST to give it a type", col.Generated.OriginString)
+	case types.T_any, types.T_enum:
+		return plan.Type{}, moerr.NewInvalidInputf(ctx.GetContext(), "functional index on the expression '%s' of type %s", col.Generated.OriginString, exprTyp.String())
+	}
+	typ := boundExpr.Typ
+	typ.NotNullable = false
+	typ.AutoIncr = false
+	if (exprTyp == types.T_varchar || exprTyp == types.T_char) && typ.Width <= 0 {
+		typ.Width = types.MaxVarcharLen
+	}
+	return typ, nil
+}
+
+// getMultiValueElemType returns the element type of the CAST(... AS type ARRAY) key part.
+func getMultiValueElemType(ctx CompilerContext, castExpr *tree.CastExpr) (plan.Type, error) {
+	typ, err := getTypeFromAst(ctx.GetContext(), castExpr.Type)
+	if err != nil {
+		return typ, err
+	}
+	switch types.T(typ.Id) {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_decimal64, types.T_decimal128,
+		types.T_date, types.T_time, types.T_datetime:
+	case types.T_char, types.T_varchar:
+		typ.Id = int32(types.T_varchar)
+		if typ.Width <= 0 {
+			typ.Width = types.MaxVarcharLen
+		}
+	default:
+		return typ, moerr.NewNotSupportedf(ctx.GetContext(), "multi-valued index of type %s", types.T(typ.Id).String())
+	}
+	return typ, nil
+}
+
+// formatMultiValueCastType returns the text of the type in CAST(... AS type ARRAY)
+func formatMultiValueCastType(castExpr *tree.CastExpr) string {
+	fmtCtx := tree.NewFmtCtx(dialect.MYSQL)
+	castExpr.Type.(*tree.T).InternalType.Format(fmtCtx)
+	return fmtCtx.String()
+}
+
+// formatFunctionalKeyPart returns the text of the key part of the hidden column in SHOW CREATE TABLE
+func formatFunctionalKeyPart(col *ColDef, indexDef *plan.IndexDef) (string, error) {
+	if !catalog.IsMultiValueIndexAlgo(indexDef.IndexAlgo) {
+		return "(" + col.Generated.OriginString + ")", nil
+	}
+	paramMap, err := catalog.IndexParamsStringToMap(indexDef.IndexAlgoParams)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("(cast(%s as %s array))", col.Generated.OriginString, paramMap[catalog.IndexAlgoParamCastType]), nil
+}
+
+// buildMultiValueSecondaryIndexDef builds the index table of the multi-valued index, which holds
+// a row of (primary key, element) for each element of the JSON array.
+func buildMultiValueSecondaryIndexDef(ctx CompilerContext, indexInfo *tree.Index, colMap map[string]*ColDef, pkeyName string) ([]*plan.IndexDef, []*TableDef, error) {
+	if pkeyName == "" || pkeyName == catalog.FakePrimaryKeyColName {
+		return nil, nil, moerr.NewNotSupported(ctx.GetContext(), "multi-valued index on the table without primary key")
+	}
+	keyPart := indexInfo.KeyParts[0]
+	castExpr := getMultiValueCastExpr(keyPart)
+	if castExpr == nil || keyPart.ColName == nil {
+		return nil, nil, moerr.NewInternalError(ctx.GetContext(), "invalid multi-valued index key part")
+	}
+	elemType, err := getMultiValueElemType(ctx, castExpr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	indexTableName, err := util.BuildIndexTableName(ctx.GetContext(), false)
+	if err != nil {
+		return nil, nil, err
+	}
+	indexDef := &plan.IndexDef{
+		IndexName:      indexInfo.Name,
+		Unique:         false,
+		IndexTableName: indexTableName,
+		IndexAlgo:      catalog.MoIndexMultiValueAlgo.ToString(),
+		Parts:          []string{keyPart.ColName.ColName()},
+		TableExist:     true,
+	}
+	indexDef.IndexAlgoParams, err = catalog.IndexParamsMapToJsonString(map[string]string{
+		catalog.IndexAlgoParamCastType: formatMultiValueCastType(castExpr),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if indexInfo.IndexOption != nil {
+		indexDef.Comment = indexInfo.IndexOption.Comment
+	}
+
+	tableDef := &TableDef{
+		Name: indexTableName,
+	}
+	pkeyTyp := colMap[pkeyName].Typ
+	tableDef.Cols = append(tableDef.Cols,
+		&ColDef{
+			Name: catalog.MultiValueIndex_TabCol_Id,
+			Alg:  plan.CompressType_Lz4,
+			Typ: plan.Type{
+				Id:    pkeyTyp.Id,
+				Width: pkeyTyp.Width,
+				Scale: pkeyTyp.Scale,
+			},
+			Default: &plan.Default{
+				NullAbility: false,
+			},
+		},
+		&ColDef{
+			Name: catalog.MultiValueIndex_TabCol_Value,
+			Alg:  plan.CompressType_Lz4,
+			Typ:  elemType,
+			Default: &plan.Default{
+				NullAbility: true,
+			},
+			ClusterBy: true,
+		},
+		&ColDef{
+			Name:   catalog.FakePrimaryKeyColName,
+			Hidden: true,
+			Alg:    plan.CompressType_Lz4,
+			Typ: plan.Type{
+				Id:       int32(types.T_uint64),
+				AutoIncr: true,
+			},
+			Default: &plan.Default{
+				NullAbility: false,
+			},
+			NotNull: true,
+			Primary: true,
+		},
+	)
+	tableDef.Pkey = &PrimaryKeyDef{
+		Names:       []string{catalog.FakePrimaryKeyColName},
+		PkeyColName: catalog.FakePrimaryKeyColName,
+	}
+	// the elements are looked up by the filters on the value column, cluster the rows by it
+	tableDef.ClusterBy = &plan.ClusterByDef{
+		Name: catalog.MultiValueIndex_TabCol_Value,
+	}
+	tableDef.Defs = append(tableDef.Defs, &plan.TableDef_DefType{
+		Def: &plan.TableDef_DefType_Properties{
+			Properties: &plan.PropertiesDef{
+				Properties: []*plan.Property{
+					{
+						Key:   catalog.SystemRelAttr_Kind,
+						Value: catalog.SystemIndexRel,
+					},
+				},
+			},
+		}})
+
+	return []*plan.IndexDef{indexDef}, []*TableDef{tableDef}, nil
+}
+
+// AddIndex adds an index to the copy table of ALTER TABLE. It is used for the index on
+// expressions, whose hidden columns are computed when the rows are inserted into the copy table.
+func AddIndex(ctx CompilerContext, alterPlan *plan.AlterTable, def tree.TableDef) error {
+	tableDef := alterPlan.CopyTableDef
+	colMap := make(map[string]*ColDef)
+	namesMap := make(map[string]bool)
+	for _, col := range tableDef.Cols {
+		colMap[col.Name] = col
+	}
+	for _, indexDef := range tableDef.Indexes {
+		namesMap[indexDef.IndexName] = true
+	}
+
+	indexDef := &plan.IndexDef{}
+	var keyParts []*tree.KeyPart
+	var indexOption *tree.IndexOption
+	switch def := def.(type) {
+	case *tree.Index:
+		if def.KeyType != tree.INDEX_TYPE_INVALID && def.KeyType != tree.INDEX_TYPE_BTREE {
+			return moerr.NewInvalidInputf(ctx.GetContext(), "Do not support this stmt now. %v", def)
+		}
+		indexInfo, err := buildFunctionalIndex(ctx, tableDef, colMap, def)
+		if err != nil {
+			return err
+		}
+		setEmptyIndexName(namesMap, indexInfo)
+		indexDef.IndexName = indexInfo.Name
+		keyParts, indexOption = indexInfo.KeyParts, indexInfo.IndexOption
+		if indexInfo.KeyType == tree.INDEX_TYPE_MULTIVALUE {
+			indexDef.IndexAlgo = catalog.MoIndexMultiValueAlgo.ToString()
+			indexDef.IndexAlgoParams, err = catalog.IndexParamsMapToJsonString(map[string]string{
+				catalog.IndexAlgoParamCastType: formatMultiValueCastType(getMultiValueCastExpr(keyParts[0])),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	case *tree.UniqueIndex:
+		indexInfo, err := buildFunctionalUniqueIndex(ctx, tableDef, colMap, def)
+		if err != nil {
+			return err
+		}
+		setEmptyUniqueIndexName(namesMap, indexInfo)
+		indexDef.IndexName = indexInfo.Name
+		indexDef.Unique = true
+		keyParts, indexOption = indexInfo.KeyParts, indexInfo.IndexOption
+	default:
+		return moerr.NewInvalidInputf(ctx.GetContext(), "Do not support this stmt now. %v", def)
+	}
+
+	for _, existed := range alterPlan.TableDef.Indexes {
+		if existed.IndexName == indexDef.IndexName {
+			return moerr.NewDuplicateKey(ctx.GetContext(), indexDef.IndexName)
+		}
+	}
+	for _, keyPart := range keyParts {
+		colName := keyPart.ColName.ColName()
+		if _, ok := colMap[colName]; !ok {
+			return moerr.NewInvalidInputf(ctx.GetContext(), "column '%s' does not exist", keyPart.ColName.ColNameOrigin())
+		}
+		indexDef.Parts = append(indexDef.Parts, colName)
+	}
+	if indexOption != nil {
+		indexDef.Comment = indexOption.Comment
+	}
+	tableDef.Indexes = append(tableDef.Indexes, indexDef)
+	return nil
+}
+
+// buildPreInsertMultiValueIndex creates the INSERT plan of the multi-valued index table, which
+// expands the JSON array of the hidden column into one row per element:
+//
+//	INSERT INTO index_table SELECT sink.pk, jt.value, NULL FROM sink_scan
+//	CROSS APPLY json_table(sink.hidden_col, '$[0 to last]' columns (value type path '$' error on error)) as jt
+//
+// The scalar value is indexed as a single element array, and the NULL value is not indexed.
+func buildPreInsertMultiValueIndex(ctx CompilerContext, builder *QueryBuilder, bindCtx *BindContext, objRef *ObjectRef, tableDef *TableDef,
+	updateColLength int, sourceStep int32, indexdef *plan.IndexDef) error {
+	isUpdate := updateColLength > 0
+
+	indexObjRef, indexTableDef := ctx.Resolve(objRef.SchemaName, indexdef.IndexTableName, nil)
+	if indexTableDef == nil {
+		return moerr.NewNoSuchTable(builder.GetContext(), objRef.SchemaName, indexdef.IndexName)
+	}
+
+	pkPos, partPos := int32(-1), int32(-1)
+	for i, col := range tableDef.Cols {
+		if col.Name == tableDef.Pkey.PkeyColName {
+			pkPos = int32(i)
+		}
+		if col.Name == indexdef.Parts[0] {
+			partPos = int32(i)
+		}
+	}
+	if pkPos == -1 || partPos == -1 {
+		return moerr.NewInternalErrorf(builder.GetContext(), "invalid multi-valued index %s", indexdef.IndexName)
+	}
+	valueCol := FindColumn(indexTableDef.Cols, catalog.MultiValueIndex_TabCol_Value)
+	if valueCol == nil {
+		return moerr.NewInternalErrorf(builder.GetContext(), "invalid multi-valued index table of %s", indexdef.IndexName)
+	}
+
+	param, err := json.Marshal(JsonTableParam{
+		Path: "$[0 to last]",
+		Columns: []*JsonTableColumnParam{
+			{
+				Kind:    tree.JSON_TABLE_COLUMN_PATH,
+				Name:    valueCol.Name,
+				Path:    "$",
+				OnError: tree.JsonTableOnResponse{Type: tree.JSON_TABLE_ON_ERROR},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	lastNodeId := appendSinkScanNode(builder, bindCtx, sourceStep)
+	tablefunc := &plan.Node{
+		NodeType: plan.Node_FUNCTION_SCAN,
+		Stats:    &plan.Stats{},
+		TableDef: &plan.TableDef{
+			TableType: "func_table",
+			TblFunc: &plan.TableFunction{
+				Name:  json_table_func_name,
+				Param: param,
+			},
+			Cols: []*plan.ColDef{
+				{
+					Name: valueCol.Name,
+					Typ:  valueCol.Typ,
+				},
+			},
+		},
+		BindingTags: []int32{builder.genNewTag()},
+		TblFuncExprList: []*plan.Expr{
+			{
+				Typ: tableDef.Cols[partPos].Typ,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						RelPos: 0,
+						ColPos: partPos,
+					},
+				},
+			},
+		},
+	}
+	tableFuncId := builder.appendNode(tablefunc, bindCtx)
+
+	// cross apply projection = (sink.pk, json_table output value)
+	lastNodeId = builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_APPLY,
+		Children:    []int32{lastNodeId, tableFuncId},
+		ApplyType:   plan.Node_CROSSAPPLY,
+		BindingTags: []int32{builder.genNewTag()},
+		ProjectList: []*plan.Expr{
+			{
+				Typ: tableDef.Cols[pkPos].Typ,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						RelPos: 0,
+						ColPos: pkPos,
+					},
+				},
+			},
+			{
+				Typ: valueCol.Typ,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						RelPos: tablefunc.BindingTags[0],
+						ColPos: 0,
+					},
+				},
+			},
+		},
+	}, bindCtx)
+	crossapply := builder.qry.Nodes[lastNodeId]
+
+	// multi-valued index projection = (pk, value, __mo_fake_pk_col)
+	project := make([]*plan.Expr, 0, 3)
+	for i, expr := range crossapply.ProjectList {
+		project = append(project, &plan.Expr{
+			Typ: expr.Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: crossapply.BindingTags[0],
+					ColPos: int32(i),
+				},
+			},
+		})
+	}
+	// fake primary key hidden column must be a NULL constant. See getDefaultExpr func from build_util.go
+	project = append(project, &plan.Expr{
+		Typ: plan.Type{
+			Id:          int32(types.T_uint64),
+			NotNullable: false,
+		},
+		Expr: &plan.Expr_Lit{
+			Lit: &Const{
+				Isnull: true,
+			},
+		},
+	})
+	lastNodeId = builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_PROJECT,
+		Children:    []int32{lastNodeId},
+		ProjectList: project,
+	}, bindCtx)
+
+	insertEntriesTableDef := DeepCopyTableDef(indexTableDef, false)
+	for _, col := range indexTableDef.Cols {
+		if col.Name != catalog.Row_ID {
+			insertEntriesTableDef.Cols = append(insertEntriesTableDef.Cols, DeepCopyColDef(col))
+		}
+	}
+
+	lastNodeId = builder.appendNode(&Node{
+		NodeType:    plan.Node_PRE_INSERT,
+		Children:    []int32{lastNodeId},
+		ProjectList: project,
+		PreInsertCtx: &plan.PreInsertCtx{
+			Ref:        indexObjRef,
+			TableDef:   DeepCopyTableDef(indexTableDef, true),
+			HasAutoCol: true,
+		},
+	}, bindCtx)
+
+	if lockNodeId, ok := appendLockNode(
+		builder,
+		bindCtx,
+		lastNodeId,
+		indexTableDef,
+		false,
+		false,
+		-1,
+		nil,
+		isUpdate,
+	); ok {
+		lastNodeId = lockNodeId
+	}
+
+	lastNodeId = appendSinkNode(builder, bindCtx, lastNodeId)
+	newSourceStep := builder.appendStep(lastNodeId)
+
+	var pkFilterExprs []*Expr
+	var partitionExpr *Expr
+	var indexSourceColTypes []*Type
+	var fuzzymessage *OriginTableMessageForFuzzy
+	return makeOneInsertPlan(ctx, builder, bindCtx, indexObjRef, insertEntriesTableDef,
+		updateColLength, newSourceStep, false, false, true,
+		pkFilterExprs, partitionExpr, true, false, false,
+		indexSourceColTypes, fuzzymessage)
+}
+
+// buildPreDeleteMultiValueIndex creates the DELETE plan of the multi-valued index table. The rows
+// of the index table are found by the primary key like the fulltext index table. For UPDATE, all
+// the rows of the updated primary keys are deleted and inserted again by buildPreInsertMultiValueIndex.
+func buildPreDeleteMultiValueIndex(ctx CompilerContext, builder *QueryBuilder, bindCtx *BindContext, delCtx *dmlPlanCtx,
+	indexdef *plan.IndexDef, typMap map[string]plan.Type, posMap map[string]int) error {
+	indexObjRef, indexTableDef := ctx.Resolve(delCtx.objRef.SchemaName, indexdef.IndexTableName, nil)
+	if indexTableDef == nil {
+		return moerr.NewNoSuchTable(builder.GetContext(), delCtx.objRef.SchemaName, indexdef.IndexName)
+	}
+
+	lastNodeId, deleteIdx, pkPos, pkTyp, err := buildDeleteRowsFullTextIndex(ctx, builder, bindCtx, delCtx, indexObjRef, indexTableDef, indexdef, typMap, posMap)
+	if err != nil {
+		return err
+	}
+
+	delNodeInfo := makeDeleteNodeInfo(builder.compCtx, indexObjRef, indexTableDef, deleteIdx, -1, false, pkPos, pkTyp, delCtx.lockTable, delCtx.partitionInfos)
+	lastNodeId, err = makeOneDeletePlan(builder, bindCtx, lastNodeId, delNodeInfo, false, true, false)
+	putDeleteNodeInfo(delNodeInfo)
+	if err != nil {
+		return err
+	}
+	builder.appendStep(lastNodeId)
+	return nil
+}
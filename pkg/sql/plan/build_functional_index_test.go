@@ -0,0 +1,213 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	moruntime "github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/util/executor"
+	"github.com/stretchr/testify/require"
+)
+
+const functionalIndexTestSql = "create table t_doc (id int primary key, doc json, " +
+	"key idx_user ((cast(doc->>'$.user_id' as unsigned))), " +
+	"key idx_tags ((cast(doc->'$.tags' as unsigned array))))"
+
+// addFunctionalIndexTestTable creates the t_doc table with a functional and a multi-valued index
+// in the mock catalog
+func addFunctionalIndexTestTable(t *testing.T, mock *MockOptimizer) *plan.CreateTable {
+	rt := moruntime.DefaultRuntime()
+	moruntime.SetupServiceBasedRuntime("", rt)
+	rt.SetGlobalVariables(moruntime.InternalSQLExecutor, executor.NewMemExecutor(func(sql string) (executor.Result, error) {
+		return executor.Result{}, nil
+	}))
+
+	logicPlan, err := runOneStmt(mock, t, functionalIndexTestSql)
+	require.NoError(t, err)
+	createTable := logicPlan.GetDdl().GetCreateTable()
+
+	ctx := mock.CurrentContext().(*MockCompilerContext)
+	register := func(tableDef *TableDef) {
+		tableDef.TableType = catalog.SystemOrdinaryRel
+		tableDef.Name2ColIndex = make(map[string]int32)
+		for i, col := range tableDef.Cols {
+			tableDef.Name2ColIndex[col.Name] = int32(i)
+		}
+		tableDef.Cols = append(tableDef.Cols, MakeRowIdColDef())
+		tableDef.Name2ColIndex[catalog.Row_ID] = int32(len(tableDef.Cols) - 1)
+		ctx.tables[tableDef.Name] = tableDef
+		ctx.objects[tableDef.Name] = &ObjectRef{SchemaName: "tpch", ObjName: tableDef.Name}
+	}
+	for _, idxTableDef := range createTable.IndexTables {
+		register(DeepCopyTableDef(idxTableDef, true))
+	}
+	tableDef := DeepCopyTableDef(createTable.TableDef, true)
+	register(tableDef)
+	return createTable
+}
+
+func TestCreateTableWithFunctionalIndex(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	createTable := addFunctionalIndexTestTable(t, mock)
+	cols := createTable.TableDef.Cols
+	require.Equal(t, catalog.FunctionalIndexColPrefix+"0", cols[2].Name)
+	require.True(t, cols[2].Hidden)
+	require.Equal(t, "cast(json_unquote(json_extract(doc, '$.user_id')) as unsigned)", cols[2].Generated.OriginString)
+	require.Equal(t, catalog.FunctionalIndexColPrefix+"1", cols[3].Name)
+
+	indexes := createTable.TableDef.Indexes
+	require.Len(t, indexes, 2)
+	require.Equal(t, "idx_user", indexes[0].IndexName)
+	require.Equal(t, cols[2].Name, indexes[0].Parts[0])
+	require.Equal(t, "idx_tags", indexes[1].IndexName)
+	require.Equal(t, catalog.MoIndexMultiValueAlgo.ToString(), indexes[1].IndexAlgo)
+	require.Contains(t, indexes[1].IndexAlgoParams, `"cast_type":"unsigned"`)
+
+	logicPlan, err := runOneStmt(mock, t, "create table t_doc2 (id int primary key, doc json, unique key ((doc->>'$.name')))")
+	require.NoError(t, err)
+	indexes = logicPlan.GetDdl().GetCreateTable().TableDef.Indexes
+	require.Equal(t, "functional_index", indexes[0].IndexName)
+	require.True(t, indexes[0].Unique)
+
+	sqls := []string{
+		"create table t (id int primary key, doc json, key ((doc->'$.a')))",
+		"create table t (id int primary key, doc json, unique key ((cast(doc->'$.a' as unsigned array))))",
+		"create table t (id int primary key, doc json, key ((cast(doc->'$.a' as unsigned array)), id))",
+		"create table t (id int primary key, doc json, key ((cast(doc->'$.a' as json array))))",
+		"create table t (doc json, key ((cast(doc->'$.a' as unsigned array))))",
+		"create table t (id int primary key, doc json, key ((rand())))",
+		"select cast(1 as unsigned array)",
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
+func TestShowCreateTableWithFunctionalIndex(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	ctx := mock.CurrentContext().(*MockCompilerContext)
+	createTable := addFunctionalIndexTestTable(t, mock)
+
+	tableDef := ctx.tables["t_doc"]
+	sql, _, err := ConstructCreateTableSQL(ctx, tableDef, nil, false)
+	require.NoError(t, err)
+	require.NotContains(t, sql, catalog.FunctionalIndexColPrefix)
+	require.Contains(t, sql, "KEY `idx_user` ((cast(json_unquote(json_extract(doc, '$.user_id')) as unsigned)))")
+	require.Contains(t, sql, "KEY `idx_tags` ((cast(json_extract(doc, '$.tags') as unsigned array)))")
+
+	// the rendered definition creates the same indexes again
+	logicPlan, err := runOneStmt(mock, t, sql)
+	require.NoError(t, err)
+	recreated := logicPlan.GetDdl().GetCreateTable().TableDef
+	require.Len(t, recreated.Indexes, len(createTable.TableDef.Indexes))
+	require.Equal(t, createTable.TableDef.Indexes[1].IndexAlgoParams, recreated.Indexes[1].IndexAlgoParams)
+}
+
+func TestAlterTableAddFunctionalIndex(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	addFunctionalIndexTestTable(t, mock)
+
+	sqls := []string{
+		"create index idx_name on t_doc ((doc->>'$.name'))",
+		"alter table t_doc add index idx_name ((doc->>'$.name'))",
+		"alter table t_doc add key idx_ids ((cast(doc->'$.ids' as char(10) array)))",
+	}
+	for _, sql := range sqls {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		require.NoError(t, err, sql)
+		alterTable := logicPlan.GetDdl().GetAlterTable()
+		require.Equal(t, plan.AlterTable_COPY, alterTable.AlgorithmType, sql)
+		require.Contains(t, alterTable.CreateTmpTableSql, "((", sql)
+	}
+
+	sqls = []string{
+		"create index idx_user on t_doc ((doc->>'$.name'))",
+		"alter table t_doc add unique key ((cast(doc->'$.ids' as unsigned array)))",
+		"create fulltext index ftidx on t_doc ((doc->>'$.name'))",
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
+func TestDMLWithFunctionalIndex(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	addFunctionalIndexTestTable(t, mock)
+
+	sqls := []string{
+		"insert into t_doc values (1, '{\"user_id\": 1, \"tags\": [1, 2]}')",
+		"update t_doc set doc = '{\"tags\": [3]}' where id = 1",
+		"delete from t_doc where id = 1",
+	}
+	for _, sql := range sqls {
+		_, err := runOneStmt(mock, t, sql)
+		require.NoError(t, err, sql)
+	}
+
+	logicPlan, err := runOneStmt(mock, t, sqls[0])
+	require.NoError(t, err)
+	// the elements of the multi-valued index key are expanded by json_table
+	require.True(t, planHasTableFunction(logicPlan, "json_table"))
+
+	runTestShouldError(mock, t, []string{
+		"insert into t_doc values (1, '{\"tags\": [{\"a\": 1}]}') on duplicate key update doc = null",
+	})
+}
+
+func TestSelectWithFunctionalIndex(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	addFunctionalIndexTestTable(t, mock)
+
+	// the key expression is replaced by the hidden column, the constant is cast to its type
+	logicPlan, err := runOneStmt(mock, t, "select id from t_doc where cast(doc->>'$.user_id' as unsigned) = 5")
+	require.NoError(t, err)
+	filter := logicPlan.GetQuery().Nodes[0].FilterList[0].GetF()
+	require.Equal(t, "t_doc."+catalog.FunctionalIndexColPrefix+"0", filter.Args[0].GetCol().GetName())
+	require.Equal(t, uint64(5), filter.Args[1].GetLit().GetU64Val())
+
+	sqls := []string{
+		"select id from t_doc where 3 member of (doc->'$.tags')",
+		"select id from t_doc where json_contains(doc->'$.tags', '[1, 2]')",
+		"select id from t_doc where json_overlaps('[1, 2]', doc->'$.tags') limit 1",
+	}
+	for _, sql := range sqls {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		require.NoError(t, err, sql)
+		require.True(t, planScansIndex(logicPlan, "idx_tags"), sql)
+	}
+
+	// the index is not used for the elements which are not of the key type
+	logicPlan, err = runOneStmt(mock, t, "select id from t_doc where json_contains(doc->'$.tags', '[{\"a\": 1}]')")
+	require.NoError(t, err)
+	require.False(t, planScansIndex(logicPlan, "idx_tags"))
+}
+
+func planScansIndex(logicPlan *Plan, indexName string) bool {
+	for _, node := range logicPlan.GetQuery().Nodes {
+		if node.NodeType == plan.Node_TABLE_SCAN && node.IndexScanInfo.IsIndexScan && node.IndexScanInfo.IndexName == indexName {
+			return true
+		}
+	}
+	return false
+}
+
+func planHasTableFunction(logicPlan *Plan, funcName string) bool {
+	for _, node := range logicPlan.GetQuery().GetNodes() {
+		if node.NodeType == plan.Node_FUNCTION_SCAN && node.TableDef.TblFunc.Name == funcName {
+			return true
+		}
+	}
+	return false
+}
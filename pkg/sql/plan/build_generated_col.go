@@ -0,0 +1,406 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
+)
+
+// buildGeneratedColAttr returns the generated column definition of the column, or nil if it is
+// not a generated column. The expression is bound by bindGeneratedCols after all the columns
+// of the table are built.
+func buildGeneratedColAttr(ctx context.Context, def *tree.ColumnTableDef) (*plan.GeneratedCol, error) {
+	var generated *tree.AttributeGeneratedAlways
+	notNull, isKey := false, false
+	for _, attr := range def.Attributes {
+		switch attribute := attr.(type) {
+		case *tree.AttributeGeneratedAlways:
+			generated = attribute
+		case *tree.AttributeNull:
+			notNull = !attribute.Is
+		case *tree.AttributePrimaryKey, *tree.AttributeKey, *tree.AttributeUnique, *tree.AttributeUniqueKey:
+			isKey = true
+		}
+	}
+	if generated == nil {
+		return nil, nil
+	}
+
+	colNameOrigin := def.Name.ColNameOrigin()
+	for _, attr := range def.Attributes {
+		switch attr.(type) {
+		case *tree.AttributeDefault:
+			return nil, moerr.NewInvalidInputf(ctx, "generated column '%s' cannot have a default value", colNameOrigin)
+		case *tree.AttributeAutoIncrement:
+			return nil, moerr.NewInvalidInputf(ctx, "generated column '%s' cannot be auto_increment", colNameOrigin)
+		case *tree.AttributeOnUpdate:
+			return nil, moerr.NewInvalidInputf(ctx, "generated column '%s' cannot have on update", colNameOrigin)
+		}
+	}
+	// the virtual generated column is not saved, it can not be a key or checked for NULL on write
+	if !generated.Stored && isKey {
+		return nil, moerr.NewNotSupportedf(ctx, "key on virtual generated column '%s'", colNameOrigin)
+	}
+	if !generated.Stored && notNull {
+		return nil, moerr.NewNotSupportedf(ctx, "not null on virtual generated column '%s'", colNameOrigin)
+	}
+
+	return &plan.GeneratedCol{
+		OriginString: tree.StringWithOpts(generated.Expr, dialect.MYSQL, tree.WithSingleQuoteString()),
+		Stored:       generated.Stored,
+	}, nil
+}
+
+func hasGeneratedCols(tableDef *TableDef) bool {
+	for _, col := range tableDef.Cols {
+		if col.Generated != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func isVirtualCol(col *ColDef) bool {
+	return col != nil && col.Generated != nil && !col.Generated.Stored
+}
+
+// bindGeneratedCols binds the expressions of the generated columns of tableDef from their text.
+// The bound expression refers to the other columns directly, the virtual generated columns in it
+// are expanded when the expression is used.
+func bindGeneratedCols(ctx CompilerContext, tableDef *TableDef) error {
+	if !hasGeneratedCols(tableDef) {
+		return nil
+	}
+
+	builder, bindContext, err := newGeneratedColBindContext(ctx, tableDef)
+	if err != nil {
+		return err
+	}
+
+	for i, col := range tableDef.Cols {
+		if col.Generated == nil {
+			continue
+		}
+		if col.Typ.Id == int32(types.T_enum) {
+			return moerr.NewNotSupportedf(ctx.GetContext(), "ENUM generated column '%s'", col.GetOriginCaseName())
+		}
+
+		astExpr, err := parseCheckExprStr(ctx.GetContext(), col.Generated.OriginString)
+		if err != nil {
+			return err
+		}
+		binder := NewGeneratedColBinder(builder, bindContext, tableDef, i)
+		expr, err := binder.BindExpr(astExpr, 0, true)
+		if err != nil {
+			return err
+		}
+		col.Generated.Expr, err = forceCastExpr(ctx.GetContext(), expr, col.Typ)
+		if err != nil {
+			return err
+		}
+	}
+
+	return checkVirtualColNotInKey(ctx.GetContext(), tableDef)
+}
+
+// newGeneratedColBindContext returns the builder and bind context to bind the generated column
+// expressions of tableDef. The columns are bound without the generated expressions, so no virtual
+// column is expanded.
+func newGeneratedColBindContext(ctx CompilerContext, tableDef *TableDef) (*QueryBuilder, *BindContext, error) {
+	scanTableDef := &TableDef{
+		Name: tableDef.Name,
+		Cols: make([]*ColDef, len(tableDef.Cols)),
+	}
+	for i, col := range tableDef.Cols {
+		scanTableDef.Cols[i] = DeepCopyColDef(col)
+		scanTableDef.Cols[i].Generated = nil
+	}
+
+	builder := NewQueryBuilder(plan.Query_SELECT, ctx, false, false)
+	bindContext := NewBindContext(builder, nil)
+	nodeID := builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_TABLE_SCAN,
+		Stats:       nil,
+		ObjRef:      nil,
+		TableDef:    scanTableDef,
+		BindingTags: []int32{builder.genNewTag()},
+	}, bindContext)
+	if err := builder.addBinding(nodeID, tree.AliasClause{}, bindContext); err != nil {
+		return nil, nil, err
+	}
+	return builder, bindContext, nil
+}
+
+// checkVirtualColNotInKey returns error if a virtual generated column is a part of the
+// primary key, cluster by or an index of the table. Only the stored ones are indexable.
+func checkVirtualColNotInKey(ctx context.Context, tableDef *TableDef) error {
+	var keyParts []string
+	if tableDef.Pkey != nil {
+		keyParts = append(keyParts, tableDef.Pkey.Names...)
+	}
+	if tableDef.ClusterBy != nil && tableDef.ClusterBy.Name != "" {
+		if util.JudgeIsCompositeClusterByColumn(tableDef.ClusterBy.Name) {
+			keyParts = append(keyParts, util.SplitCompositeClusterByColumnName(tableDef.ClusterBy.Name)...)
+		} else {
+			keyParts = append(keyParts, tableDef.ClusterBy.Name)
+		}
+	}
+	for _, idxDef := range tableDef.Indexes {
+		for _, part := range idxDef.Parts {
+			keyParts = append(keyParts, catalog.ResolveAlias(part))
+		}
+	}
+	for _, part := range keyParts {
+		if col := FindColumn(tableDef.Cols, part); isVirtualCol(col) {
+			return moerr.NewNotSupportedf(ctx, "key on virtual generated column '%s'", col.GetOriginCaseName())
+		}
+	}
+	return nil
+}
+
+// checkIndexKeypartNotVirtual returns error if a key part of the index is a virtual generated column
+func checkIndexKeypartNotVirtual(ctx context.Context, keyParts []*tree.KeyPart, colMap map[string]*ColDef) error {
+	for _, keyPart := range keyParts {
+		if keyPart.ColName == nil {
+			continue
+		}
+		if isVirtualCol(colMap[keyPart.ColName.ColName()]) {
+			return moerr.NewNotSupportedf(ctx, "key on virtual generated column '%s'", keyPart.ColName.ColNameOrigin())
+		}
+	}
+	return nil
+}
+
+// checkDropColumnWithGenerated returns error if the column to be dropped or renamed is used by a generated column
+func checkDropColumnWithGenerated(ctx context.Context, tableDef *TableDef, colName, colNameOrigin string) error {
+	for _, col := range tableDef.Cols {
+		if col.Generated == nil || col.Generated.Expr == nil || col.Name == colName {
+			continue
+		}
+		if exprRefersColumn(col.Generated.Expr, colName) {
+			return moerr.NewInvalidInputf(ctx, "Column '%s' has a generated column dependency.", colNameOrigin)
+		}
+	}
+	return nil
+}
+
+// replaceGeneratedColRef returns a copy of expr whose column refs are replaced by the
+// expressions returned by colExpr, which takes the position of the column in tableDef.Cols.
+// The virtual generated columns referred are expanded recursively.
+func replaceGeneratedColRef(tableDef *TableDef, expr *Expr, colExpr func(colIdx int) (*Expr, error)) (*Expr, error) {
+	switch ne := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		for colIdx, col := range tableDef.Cols {
+			if col.Name != ne.Col.Name {
+				continue
+			}
+			if isVirtualCol(col) {
+				return replaceGeneratedColRef(tableDef, col.Generated.Expr, colExpr)
+			}
+			return colExpr(colIdx)
+		}
+		return nil, moerr.NewInternalErrorNoCtxf("can not find col '%s' by name in generated column expr", ne.Col.Name)
+	case *plan.Expr_F:
+		newExpr := DeepCopyExpr(expr)
+		for i, arg := range ne.F.Args {
+			newArg, err := replaceGeneratedColRef(tableDef, arg, colExpr)
+			if err != nil {
+				return nil, err
+			}
+			newExpr.GetF().Args[i] = newArg
+		}
+		return newExpr, nil
+	case *plan.Expr_List:
+		newExpr := DeepCopyExpr(expr)
+		for i, arg := range ne.List.List {
+			newArg, err := replaceGeneratedColRef(tableDef, arg, colExpr)
+			if err != nil {
+				return nil, err
+			}
+			newExpr.GetList().List[i] = newArg
+		}
+		return newExpr, nil
+	default:
+		return DeepCopyExpr(expr), nil
+	}
+}
+
+// makeGeneratedColPlaceholder returns the NULL written for a generated column before it is computed
+func makeGeneratedColPlaceholder(col *ColDef) *Expr {
+	typ := col.Typ
+	typ.NotNullable = false
+	typ.AutoIncr = false
+	return &Expr{
+		Expr: &plan.Expr_Lit{
+			Lit: &Const{
+				Isnull: true,
+			},
+		},
+		Typ: typ,
+	}
+}
+
+// fillGeneratedColExprs replaces the expressions of the generated columns in exprs with the
+// values to be written, colPos returns the position in exprs of the column at colIdx of
+// tableDef.Cols. The virtual generated columns are not saved and always written as NULL.
+func fillGeneratedColExprs(tableDef *TableDef, exprs []*Expr, colPos func(colIdx int) (int, bool)) error {
+	for i, col := range tableDef.Cols {
+		if col.Generated == nil {
+			continue
+		}
+		pos, ok := colPos(i)
+		if !ok {
+			continue
+		}
+		if !col.Generated.Stored {
+			exprs[pos] = makeGeneratedColPlaceholder(col)
+			continue
+		}
+
+		// the generated columns refer only to the generated columns defined prior to them, which are filled already
+		expr, err := replaceGeneratedColRef(tableDef, col.Generated.Expr, func(colIdx int) (*Expr, error) {
+			refPos, ok := colPos(colIdx)
+			if !ok {
+				return nil, moerr.NewInternalErrorNoCtxf("can not find col '%s' in generated column expr", tableDef.Cols[colIdx].Name)
+			}
+			return DeepCopyExpr(exprs[refPos]), nil
+		})
+		if err != nil {
+			return err
+		}
+		exprs[pos] = expr
+	}
+	return nil
+}
+
+// appendGeneratedColProjectNode appends a project node which computes the generated columns. It is
+// used by the old insert/update plans whose input columns are in the same order as tableDef.Cols.
+func appendGeneratedColProjectNode(builder *QueryBuilder, bindCtx *BindContext, tableDef *TableDef, lastNodeId int32) (int32, error) {
+	if !hasGeneratedCols(tableDef) {
+		return lastNodeId, nil
+	}
+	projList := getProjectionByLastNode(builder, lastNodeId)
+	err := fillGeneratedColExprs(tableDef, projList, func(colIdx int) (int, bool) {
+		return colIdx, colIdx < len(projList)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return builder.appendNode(&Node{
+		NodeType:    plan.Node_PROJECT,
+		Children:    []int32{lastNodeId},
+		ProjectList: projList,
+	}, bindCtx), nil
+}
+
+// checkInsertGeneratedCols returns error if a value other than DEFAULT is specified for a generated column
+func checkInsertGeneratedCols(ctx context.Context, stmt *tree.Insert, tableDef *TableDef, insertColumns []string) error {
+	if !hasGeneratedCols(tableDef) {
+		return nil
+	}
+	if len(stmt.OnDuplicateUpdate) > 0 && stmt.OnDuplicateUpdate[0] != nil {
+		return moerr.NewNotSupported(ctx, "insert on duplicate key update for table with generated columns")
+	}
+
+	values, _ := stmt.Rows.Select.(*tree.ValuesClause)
+	for i, colName := range insertColumns {
+		col := FindColumn(tableDef.Cols, colName)
+		if col == nil || col.Generated == nil {
+			continue
+		}
+		allDefault := values != nil
+		if values != nil {
+			for _, row := range values.Rows {
+				if row == nil {
+					continue
+				}
+				if _, ok := row[i].(*tree.DefaultVal); !ok {
+					allDefault = false
+					break
+				}
+			}
+		}
+		if !allDefault {
+			return moerr.NewInvalidInputf(ctx, "The value specified for generated column '%s' in table '%s' is not allowed.", col.GetOriginCaseName(), tableDef.Name)
+		}
+	}
+	return nil
+}
+
+// addGeneratedColsToUpdate checks the updated generated columns and adds the generated columns which
+// should be computed again into updateExprs, as set to DEFAULT. The virtual generated columns are
+// always added so that they are written as NULL.
+func addGeneratedColsToUpdate(ctx context.Context, tableDef *TableDef, updateExprs map[string]tree.Expr) error {
+	if !hasGeneratedCols(tableDef) {
+		return nil
+	}
+
+	updatedCols := make(map[string]bool, len(updateExprs))
+	for colName, expr := range updateExprs {
+		col := FindColumn(tableDef.Cols, colName)
+		if col != nil && col.Generated != nil {
+			if _, ok := expr.(*tree.DefaultVal); !ok {
+				return moerr.NewInvalidInputf(ctx, "The value specified for generated column '%s' in table '%s' is not allowed.", col.GetOriginCaseName(), tableDef.Name)
+			}
+		}
+		updatedCols[colName] = true
+	}
+
+	for _, col := range tableDef.Cols {
+		if col.Generated == nil || updatedCols[col.Name] {
+			continue
+		}
+		if !col.Generated.Stored || generatedColDependsOn(tableDef, col.Generated.Expr, updatedCols) {
+			updateExprs[col.Name] = &tree.DefaultVal{}
+			updatedCols[col.Name] = true
+		}
+	}
+	return nil
+}
+
+// generatedColDependsOn returns whether the expression refers to any of the columns, directly
+// or through the generated columns it refers to
+func generatedColDependsOn(tableDef *TableDef, expr *Expr, colNames map[string]bool) bool {
+	switch ne := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		if colNames[ne.Col.Name] {
+			return true
+		}
+		if col := FindColumn(tableDef.Cols, ne.Col.Name); col != nil && col.Generated != nil && col.Generated.Expr != nil {
+			return generatedColDependsOn(tableDef, col.Generated.Expr, colNames)
+		}
+	case *plan.Expr_F:
+		for _, arg := range ne.F.Args {
+			if generatedColDependsOn(tableDef, arg, colNames) {
+				return true
+			}
+		}
+	case *plan.Expr_List:
+		for _, arg := range ne.List.List {
+			if generatedColDependsOn(tableDef, arg, colNames) {
+				return true
+			}
+		}
+	}
+	return false
+}
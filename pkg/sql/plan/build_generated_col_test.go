@@ -0,0 +1,187 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	moruntime "github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/util/executor"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateTableWithGeneratedCol(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	rt := moruntime.DefaultRuntime()
+	moruntime.SetupServiceBasedRuntime("", rt)
+	rt.SetGlobalVariables(moruntime.InternalSQLExecutor, executor.NewMemExecutor(func(sql string) (executor.Result, error) {
+		return executor.Result{}, nil
+	}))
+
+	logicPlan, err := runOneStmt(mock, t, "create table t (a int, b int, c int as (a + b) stored not null, d bigint generated always as (c * 2) virtual, e int as (d + 1), key(c))")
+	require.NoError(t, err)
+	cols := logicPlan.GetDdl().GetCreateTable().TableDef.Cols
+	require.Nil(t, cols[0].Generated)
+
+	require.True(t, cols[2].Generated.Stored)
+	require.Equal(t, "a + b", cols[2].Generated.OriginString)
+	require.NotNil(t, cols[2].Generated.Expr)
+	require.False(t, cols[2].Default.NullAbility)
+
+	require.False(t, cols[3].Generated.Stored)
+	require.Equal(t, "c * 2", cols[3].Generated.OriginString)
+	// the expression is casted to the type of the column
+	require.Equal(t, cols[3].Typ.Id, cols[3].Generated.Expr.Typ.Id)
+	require.False(t, cols[4].Generated.Stored)
+
+	sqls := []string{
+		"create table t (a int, b int as (a + 1) default 1)",
+		"create table t (a int, b int as (a + 1) auto_increment)",
+		"create table t (a int, b int as (a + 1) not null)",
+		"create table t (a int, b int as (a + 1) primary key)",
+		"create table t (a int, b int as (a + 1) unique key)",
+		"create table t (a int, b int as (a + 1), key(b))",
+		"create table t (a int, b int as (a + 1), primary key(b))",
+		"create table t (a int, b int as (c + 1), c int as (a + 1))",
+		"create table t (a int, b int as (b + 1))",
+		"create table t (a int auto_increment, b int as (a + 1))",
+		"create table t (a int, b int as (a + rand()))",
+		"create table t (a int, b int as (a + (select 1)))",
+		"create table t (a int, b int as (sum(a)))",
+		"create table t (a int, b int as (a + @v))",
+		"create table t (a int, b int as (d + 1))",
+		"create table t (a int, b int as (a + 1)) as select 1 as a",
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
+// setGeneratedCol makes n_regionkey of the mock nation table a generated column of n_nationkey + 1
+func setGeneratedCol(t *testing.T, ctx *MockCompilerContext, stored bool) {
+	tableDef := ctx.tables["nation"]
+	for _, col := range tableDef.Cols {
+		col.Generated = nil
+		if col.Name == "n_regionkey" {
+			col.Generated = &plan.GeneratedCol{OriginString: "n_nationkey + 1", Stored: stored}
+		}
+	}
+	require.NoError(t, bindGeneratedCols(ctx, tableDef))
+}
+
+func TestDMLWithGeneratedCol(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	ctx := mock.CurrentContext().(*MockCompilerContext)
+
+	for _, stored := range []bool{true, false} {
+		setGeneratedCol(t, ctx, stored)
+
+		sqls := []string{
+			"insert into nation (n_nationkey, n_name, n_comment) values (1, 'NAME1', 'COMMENT1')",
+			"insert into nation values (1, 'NAME1', default, 'COMMENT1')",
+			"insert into nation (n_nationkey, n_name, n_comment) select n_nationkey, n_name, n_comment from nation2",
+			"update nation set n_nationkey = n_nationkey + 1 where n_name = 'NAME1'",
+			"update nation set n_regionkey = default",
+		}
+		for _, sql := range sqls {
+			logicPlan, err := runOneStmt(mock, t, sql)
+			require.NoError(t, err, sql)
+			if stored {
+				// the stored generated column is computed on write
+				require.True(t, planHasFunc(logicPlan, "+"), sql)
+			}
+		}
+
+		sqls = []string{
+			"insert into nation values (1, 'NAME1', 21, 'COMMENT1')",
+			"insert into nation select * from nation2",
+			"insert into nation (n_nationkey, n_name, n_comment) values (1, 'NAME1', 'COMMENT1') on duplicate key update n_name = 'NAME2'",
+			"update nation set n_regionkey = 1",
+		}
+		runTestShouldError(mock, t, sqls)
+	}
+
+	// the virtual generated column is computed on read
+	logicPlan, err := runOneStmt(mock, t, "select n_regionkey from nation")
+	require.NoError(t, err)
+	require.True(t, planHasFunc(logicPlan, "+"))
+
+	setGeneratedCol(t, ctx, true)
+	logicPlan, err = runOneStmt(mock, t, "select n_regionkey from nation")
+	require.NoError(t, err)
+	require.False(t, planHasFunc(logicPlan, "+"))
+}
+
+func planHasFunc(logicPlan *Plan, funcName string) bool {
+	var exprHasFunc func(expr *plan.Expr) bool
+	exprHasFunc = func(expr *plan.Expr) bool {
+		if f := expr.GetF(); f != nil {
+			if f.Func.ObjName == funcName {
+				return true
+			}
+			for _, arg := range f.Args {
+				if exprHasFunc(arg) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	for _, node := range logicPlan.GetQuery().Nodes {
+		for _, expr := range node.ProjectList {
+			if exprHasFunc(expr) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func TestAlterTableWithGeneratedCol(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	ctx := mock.CurrentContext().(*MockCompilerContext)
+	setGeneratedCol(t, ctx, true)
+
+	logicPlan, err := runOneStmt(mock, t, "alter table nation add column n_key2 bigint as (n_regionkey * 2) virtual")
+	require.NoError(t, err)
+	alterTable := logicPlan.GetDdl().GetAlterTable()
+	require.Equal(t, plan.AlterTable_COPY, alterTable.AlgorithmType)
+	require.Contains(t, alterTable.CreateTmpTableSql, "`n_regionkey` int GENERATED ALWAYS AS (n_nationkey + 1) STORED")
+	require.Contains(t, alterTable.CreateTmpTableSql, "`n_key2` bigint GENERATED ALWAYS AS (n_regionkey * 2) VIRTUAL")
+	// the generated columns are computed again when the rows are copied
+	require.NotContains(t, alterTable.InsertTmpDataSql, "n_regionkey")
+	require.NotContains(t, alterTable.InsertTmpDataSql, "n_key2")
+
+	// the generated column is converted to a base column by modify
+	logicPlan, err = runOneStmt(mock, t, "alter table nation modify column n_regionkey int")
+	require.NoError(t, err)
+	require.Contains(t, logicPlan.GetDdl().GetAlterTable().InsertTmpDataSql, "n_regionkey")
+
+	sqls := []string{
+		"alter table nation drop column n_nationkey",
+		"alter table nation rename column n_nationkey to n_key",
+		"alter table nation change column n_nationkey n_key int",
+		"alter table nation modify column n_regionkey int as (n_nationkey + 1) virtual",
+		"alter table nation add column n_key2 int as (n_key3 + 1)",
+	}
+	runTestShouldError(mock, t, sqls)
+
+	setGeneratedCol(t, ctx, false)
+	sqls = []string{
+		"create index idx_region on nation(n_regionkey)",
+		"alter table nation add index idx_region(n_regionkey)",
+		"alter table nation add primary key(n_regionkey)",
+	}
+	runTestShouldError(mock, t, sqls)
+}
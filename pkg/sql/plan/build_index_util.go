@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
@@ -65,10 +66,20 @@ func checkDuplicateConstraint(namesMap map[string]bool, name string, foreign boo
 	return nil
 }
 
+// getEmptyIndexNamePrefix returns the name prefix of the index named after its first key part.
+// The index on an expression is named functional_index, same as MySQL.
+func getEmptyIndexNamePrefix(keyPart *tree.KeyPart) string {
+	colName := keyPart.ColName.ColName()
+	if strings.HasPrefix(colName, catalog.FunctionalIndexColPrefix) {
+		return "functional_index"
+	}
+	return colName
+}
+
 // setEmptyUniqueIndexName Set name for unqiue index constraint with an empty name
 func setEmptyUniqueIndexName(namesMap map[string]bool, indexConstr *tree.UniqueIndex) {
 	if indexConstr.Name == "" && len(indexConstr.KeyParts) > 0 {
-		colName := indexConstr.KeyParts[0].ColName.ColName()
+		colName := getEmptyIndexNamePrefix(indexConstr.KeyParts[0])
 		constrName := colName
 		i := 2
 		if strings.EqualFold(constrName, "PRIMARY") {
@@ -88,7 +99,7 @@ func setEmptyUniqueIndexName(namesMap map[string]bool, indexConstr *tree.UniqueI
 // setEmptyIndexName Set name for index constraint with an empty name
 func setEmptyIndexName(namesMap map[string]bool, indexConstr *tree.Index) {
 	if indexConstr.Name == "" && len(indexConstr.KeyParts) > 0 {
-		colName := indexConstr.KeyParts[0].ColName.ColName()
+		colName := getEmptyIndexNamePrefix(indexConstr.KeyParts[0])
 		constrName := colName
 		i := 2
 		if strings.EqualFold(constrName, "PRIMARY") {
@@ -125,11 +136,11 @@ func setEmptyFullTextIndexName(namesMap map[string]bool, indexConstr *tree.FullT
 	}
 }
 
-// TODO
-// Currently, using expression as index keyparts are not supported in matrixone
+// checkIndexKeypartSupportability returns error if an expression key part is not built into
+// a hidden column by buildFunctionalKeyParts.
 func checkIndexKeypartSupportability(context context.Context, keyParts []*tree.KeyPart) error {
 	for _, key := range keyParts {
-		if key.Expr != nil {
+		if key.Expr != nil && key.ColName == nil {
 			return moerr.NewInternalError(context, "unsupported index which using expression as keypart")
 		}
 	}
@@ -116,6 +116,14 @@ func buildInsert(stmt *tree.Insert, ctx CompilerContext, isReplace bool, isPrepa
 			tableDef.Cols = append(tableDef.Cols, tableDef.ClusterBy.CompCbkeyCol)
 		}
 
+		// every row to be inserted fires the before insert triggers, the others are fired after the duplicates are found
+		if triggers := getTriggers(tableDef, tree.TRIGGER_BEFORE, tree.TRIGGER_INSERT); len(triggers) > 0 {
+			lastNodeId, err = appendOnDuplicateKeyInsertTriggerNodes(ctx, builder, bindCtx, objRef, tableDef, triggers, lastNodeId)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		dupProjection := getProjectionByLastNode(builder, lastNodeId)
 		// if table have pk & unique key. we need append an agg node before on_duplicate_key
 		if rewriteInfo.onDuplicateNeedAgg {
@@ -182,7 +190,7 @@ func buildInsert(stmt *tree.Insert, ctx CompilerContext, isReplace bool, isPrepa
 		attrs := make([]string, 0)
 		insertColCount := int32(0)
 		for _, col := range tableDef.Cols {
-			if col.Hidden && col.Name != catalog.FakePrimaryKeyColName {
+			if col.Hidden && col.Name != catalog.FakePrimaryKeyColName && !isFunctionalIndexCol(col) {
 				continue
 			}
 			attrs = append(attrs, col.GetOriginCaseName())
@@ -242,7 +250,7 @@ func buildInsert(stmt *tree.Insert, ctx CompilerContext, isReplace bool, isPrepa
 			}
 		}
 		for _, col := range tableDef.Cols {
-			if col.Hidden && col.Name != catalog.FakePrimaryKeyColName {
+			if col.Hidden && col.Name != catalog.FakePrimaryKeyColName && !isFunctionalIndexCol(col) {
 				continue
 			}
 			updateColLength++
@@ -296,6 +304,7 @@ func buildInsert(stmt *tree.Insert, ctx CompilerContext, isReplace bool, isPrepa
 		upPlanCtx.insertColPos = insertColPos
 		upPlanCtx.updateColPosMap = updateColPosMap
 		upPlanCtx.updatePkCol = updatePkCol
+		upPlanCtx.onDuplicateKey = true
 
 		err = buildUpdatePlans(ctx, builder, updateBindCtx, upPlanCtx, true)
 		if err != nil {
@@ -346,7 +355,7 @@ func getInsertColsFromStmt(ctx context.Context, stmt *tree.Insert, tableDef *Tab
 	tableDef.Name2ColIndex = colToIdx
 	if stmt.Columns == nil {
 		for _, col := range tableDef.Cols {
-			if col.Name != catalog.FakePrimaryKeyColName {
+			if !col.Hidden {
 				insertColsName = append(insertColsName, col.Name)
 			}
 		}
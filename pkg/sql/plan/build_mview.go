@@ -0,0 +1,487 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// buildMaterializedViewDef builds the definition of the materialized view to be created. The view
+// is maintained by the DML on its base table, only the rows of the changed keys are recomputed, so
+// its query must read a single table without joins, and either group by the columns of the view,
+// or select the primary key of the table without aggregation. The other views are not supported.
+func buildMaterializedViewDef(ctx CompilerContext, stmt *tree.CreateTable, dbName string) (*plan.MaterializedViewDef, error) {
+	if IsFkBannedDatabase(dbName) {
+		return nil, moerr.NewInvalidInput(ctx.GetContext(), "materialized view can not be created in system database")
+	}
+	if stmt.AsSource.With != nil {
+		return nil, moerr.NewNotSupported(ctx.GetContext(), "common table expression in materialized view")
+	}
+
+	query, tables, err := formatMaterializedViewQuery(ctx, stmt.AsSource)
+	if err != nil {
+		return nil, err
+	}
+	view := &plan.MaterializedViewDef{
+		Database: dbName,
+		Name:     string(stmt.Table.ObjectName),
+		Query:    query,
+	}
+	for _, tbl := range tables {
+		objRef, tableDef := ctx.Resolve(tbl.SchemaName, tbl.ObjName, nil)
+		if tableDef == nil {
+			return nil, moerr.NewNoSuchTable(ctx.GetContext(), tbl.SchemaName, tbl.ObjName)
+		}
+		if IsFkBannedDatabase(tbl.SchemaName) {
+			return nil, moerr.NewInvalidInput(ctx.GetContext(), "materialized view can not be created on system tables")
+		}
+		if objRef.PubInfo != nil {
+			return nil, moerr.NewInternalError(ctx.GetContext(), "cannot create materialized view on subscription table")
+		}
+		if tableDef.TableType != catalog.SystemOrdinaryRel || tableDef.IsTemporary {
+			return nil, moerr.NewNotSupportedf(ctx.GetContext(), "materialized view on '%s' which is not a base table", tbl.ObjName)
+		}
+		if tbl.SchemaName == dbName && tbl.ObjName == view.Name {
+			return nil, moerr.NewInvalidInputf(ctx.GetContext(), "materialized view '%s' refers to itself", view.Name)
+		}
+		view.BaseTables = append(view.BaseTables, tbl)
+	}
+
+	builder := NewQueryBuilder(plan.Query_SELECT, ctx, false, false)
+	bindCtx := NewBindContext(builder, nil)
+	rootId, err := builder.bindSelect(stmt.AsSource, bindCtx, true)
+	if err != nil {
+		return nil, err
+	}
+	view.KeyCols, view.ViewKeyCols = getMaterializedViewKeys(builder, rootId)
+	if len(view.KeyCols) == 0 {
+		for _, node := range builder.qry.Nodes {
+			if node.NodeType == plan.Node_JOIN || (node.NodeType == plan.Node_TABLE_SCAN && len(view.BaseTables) > 1) {
+				return nil, moerr.NewNotSupported(ctx.GetContext(), "materialized view on the join of tables, which can not be maintained incrementally")
+			}
+		}
+		return nil, moerr.NewNotSupported(ctx.GetContext(), "materialized view which neither groups by its columns nor selects the primary key of its table")
+	}
+	return view, nil
+}
+
+// formatMaterializedViewQuery formats the query with the table names qualified by the database, and
+// returns the tables read by the query.
+func formatMaterializedViewQuery(ctx CompilerContext, stmt *tree.Select) (string, []*plan.ObjectRef, error) {
+	var err error
+	var tables []*plan.ObjectRef
+	seen := make(map[[2]string]bool)
+	query := tree.StringWithOpts(stmt, dialect.MYSQL, tree.WithSingleQuoteString(), tree.WithFormatHook(func(fmtCtx *tree.FmtCtx, node tree.NodeFormatter) bool {
+		n, ok := node.(tree.TableName)
+		if !ok {
+			return false
+		}
+		if n.AtTsExpr != nil {
+			if err == nil {
+				err = moerr.NewNotSupported(ctx.GetContext(), "snapshot read in materialized view")
+			}
+			return false
+		}
+		if !n.ExplicitSchema {
+			n.SchemaName = tree.Identifier(ctx.DefaultDatabase())
+			if n.SchemaName == "" {
+				if err == nil {
+					err = moerr.NewNoDB(ctx.GetContext())
+				}
+				return false
+			}
+			n.ExplicitSchema = true
+			// the qualified name comes back to the hook
+			n.Format(fmtCtx)
+			return true
+		}
+		key := [2]string{string(n.SchemaName), string(n.ObjectName)}
+		if !seen[key] {
+			seen[key] = true
+			tables = append(tables, &plan.ObjectRef{
+				SchemaName: key[0],
+				ObjName:    key[1],
+			})
+		}
+		return false
+	}))
+	return query, tables, err
+}
+
+// getMaterializedViewKeys returns the columns of the base table which identify the rows of the view,
+// and the columns of the view with the same values. Nil is returned if the view can not be refreshed by keys.
+func getMaterializedViewKeys(builder *QueryBuilder, rootId int32) ([]string, []string) {
+	var scanNode, aggNode *plan.Node
+	for _, node := range builder.qry.Nodes {
+		switch node.NodeType {
+		case plan.Node_TABLE_SCAN:
+			if scanNode != nil {
+				return nil, nil
+			}
+			scanNode = node
+		case plan.Node_AGG:
+			if aggNode != nil {
+				return nil, nil
+			}
+			aggNode = node
+		case plan.Node_FILTER, plan.Node_PROJECT:
+		default:
+			return nil, nil
+		}
+	}
+	rootNode := builder.qry.Nodes[rootId]
+	if scanNode == nil || rootNode.NodeType != plan.Node_PROJECT || rootNode.Limit != nil || rootNode.Offset != nil {
+		return nil, nil
+	}
+
+	// the position of the view column which is the column at colPos of the relation tag
+	viewColOf := func(tag, colPos int32) int {
+		for i, expr := range rootNode.ProjectList {
+			if col, ok := expr.Expr.(*plan.Expr_Col); ok && col.Col.RelPos == tag && col.Col.ColPos == colPos {
+				return i
+			}
+		}
+		return -1
+	}
+	scanTag := scanNode.BindingTags[0]
+	scanCols := scanNode.TableDef.Cols
+	headings := builder.qry.Headings
+
+	var keyCols, viewKeyCols []string
+	if aggNode != nil {
+		if len(aggNode.GroupBy) == 0 {
+			return nil, nil
+		}
+		// the grouping sets group by part of the columns
+		for _, flag := range aggNode.GroupingFlag {
+			if !flag {
+				return nil, nil
+			}
+		}
+		groupTag := aggNode.BindingTags[0]
+		for i, expr := range aggNode.GroupBy {
+			col, ok := expr.Expr.(*plan.Expr_Col)
+			if !ok || col.Col.RelPos != scanTag {
+				return nil, nil
+			}
+			pos := viewColOf(groupTag, int32(i))
+			if pos == -1 {
+				return nil, nil
+			}
+			keyCols = append(keyCols, scanCols[col.Col.ColPos].Name)
+			viewKeyCols = append(viewKeyCols, strings.ToLower(headings[pos]))
+		}
+		return keyCols, viewKeyCols
+	}
+
+	pkey := scanNode.TableDef.Pkey
+	if pkey == nil || pkey.PkeyColName == catalog.FakePrimaryKeyColName {
+		return nil, nil
+	}
+	for _, name := range pkey.Names {
+		pos := -1
+		for i, col := range scanCols {
+			if col.Name == name {
+				pos = viewColOf(scanTag, int32(i))
+				break
+			}
+		}
+		if pos == -1 {
+			return nil, nil
+		}
+		keyCols = append(keyCols, name)
+		viewKeyCols = append(viewKeyCols, strings.ToLower(headings[pos]))
+	}
+	return keyCols, viewKeyCols
+}
+
+func buildRefreshMaterializedView(stmt *tree.RefreshMaterializedView, ctx CompilerContext) (*Plan, error) {
+	dbName := string(stmt.Name.SchemaName)
+	if dbName == "" {
+		dbName = ctx.DefaultDatabase()
+	}
+	if dbName == "" {
+		return nil, moerr.NewNoDB(ctx.GetContext())
+	}
+	tblName := string(stmt.Name.ObjectName)
+	_, tableDef := ctx.Resolve(dbName, tblName, nil)
+	if tableDef == nil {
+		return nil, moerr.NewNoSuchTable(ctx.GetContext(), dbName, tblName)
+	}
+	if tableDef.TableType != catalog.SystemMaterializedRel || tableDef.Mview == nil {
+		return nil, moerr.NewInvalidInputf(ctx.GetContext(), "'%s.%s' is not a materialized view", dbName, tblName)
+	}
+
+	return &Plan{
+		Plan: &plan.Plan_Ddl{
+			Ddl: &plan.DataDefinition{
+				DdlType: plan.DataDefinition_REFRESH_MATERIALIZED_VIEW,
+				Definition: &plan.DataDefinition_RefreshMaterializedView{
+					RefreshMaterializedView: &plan.RefreshMaterializedView{
+						Database: dbName,
+						Table:    tableDef.Name,
+						Mview:    tableDef.Mview,
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+// getMaterializedViews returns the materialized views on the table which are maintained by the DML
+// on the table, the views which have been dropped together with their database are skipped.
+func getMaterializedViews(ctx CompilerContext, tableDef *TableDef) []*plan.MaterializedViewDef {
+	var views []*plan.MaterializedViewDef
+	for _, view := range tableDef.RefMviews {
+		if _, viewDef := ctx.Resolve(view.Database, view.Name, nil); viewDef != nil && viewDef.Mview != nil {
+			views = append(views, view)
+		}
+	}
+	return views
+}
+
+// appendMaterializedViewNode appends the POSTDML node to refresh the views with the keys of the rows,
+// the keys of the old rows and the new rows are the columns of the table at oldPos and newPos.
+func appendMaterializedViewNode(builder *QueryBuilder, bindCtx *BindContext, objRef *ObjectRef, views []*plan.MaterializedViewDef,
+	oldPos, newPos func(name string) int, lastNodeId int32) int32 {
+
+	keyPos := func(view *plan.MaterializedViewDef, colPos func(name string) int) []int32 {
+		if colPos == nil {
+			return nil
+		}
+		positions := make([]int32, 0, len(view.KeyCols))
+		for _, name := range view.KeyCols {
+			pos := colPos(name)
+			if pos == -1 {
+				return nil
+			}
+			positions = append(positions, int32(pos))
+		}
+		return positions
+	}
+
+	mviews := make([]*plan.PostDmlMaterializedView, 0, len(views))
+	for _, view := range views {
+		mviews = append(mviews, &plan.PostDmlMaterializedView{
+			View:      view,
+			OldKeyPos: keyPos(view, oldPos),
+			NewKeyPos: keyPos(view, newPos),
+		})
+	}
+
+	return builder.appendNode(&Node{
+		NodeType:    plan.Node_POSTDML,
+		Children:    []int32{lastNodeId},
+		ProjectList: getProjectionByLastNode(builder, lastNodeId),
+		PostDmlCtx: &plan.PostDmlCtx{
+			Ref: objRef,
+			Mview: &plan.PostDmlMaterializedViewCtx{
+				Views: mviews,
+			},
+		},
+	}, bindCtx)
+}
+
+// colPosByName returns the function to find the column of the table by name in the first colCount columns
+func colPosByName(tableDef *TableDef, colCount int) func(name string) int {
+	return func(name string) int {
+		for i, col := range tableDef.Cols[:colCount] {
+			if col.Name == name {
+				return i
+			}
+		}
+		return -1
+	}
+}
+
+func buildInsertMaterializedViewPlans(ctx CompilerContext, builder *QueryBuilder, bindCtx *BindContext, objRef *ObjectRef, tableDef *TableDef,
+	colCount int, sourceStep int32) {
+
+	views := getMaterializedViews(ctx, tableDef)
+	if len(views) == 0 {
+		return
+	}
+	lastNodeId := appendSinkScanNode(builder, bindCtx, sourceStep)
+	lastNodeId = appendMaterializedViewNode(builder, bindCtx, objRef, views, nil, colPosByName(tableDef, colCount), lastNodeId)
+	builder.appendStep(lastNodeId)
+}
+
+func buildDeleteMaterializedViewPlans(ctx CompilerContext, builder *QueryBuilder, bindCtx *BindContext, delCtx *dmlPlanCtx) {
+	views := getMaterializedViews(ctx, delCtx.tableDef)
+	if len(views) == 0 {
+		return
+	}
+	lastNodeId := appendSinkScanNode(builder, bindCtx, delCtx.sourceStep)
+	lastNodeId = appendMaterializedViewNode(builder, bindCtx, delCtx.objRef, views,
+		colPosByName(delCtx.tableDef, len(delCtx.tableDef.Cols)), nil, lastNodeId)
+	builder.appendStep(lastNodeId)
+}
+
+// appendUpdateMaterializedViewNode appends the node to refresh the views on the sink scan of the update,
+// the old row is at the front of the sink scan, and the new values are at the insertColPos of newCols.
+func appendUpdateMaterializedViewNode(ctx CompilerContext, builder *QueryBuilder, bindCtx *BindContext, updateCtx *dmlPlanCtx,
+	newCols []*ColDef, lastNodeId int32) int32 {
+
+	views := getMaterializedViews(ctx, updateCtx.tableDef)
+	if len(views) == 0 {
+		return lastNodeId
+	}
+	newPos := func(name string) int {
+		for i, col := range newCols {
+			if col.Name == name {
+				return updateCtx.insertColPos[i]
+			}
+		}
+		return -1
+	}
+	return appendMaterializedViewNode(builder, bindCtx, updateCtx.objRef, views,
+		colPosByName(updateCtx.tableDef, len(updateCtx.tableDef.Cols)), newPos, lastNodeId)
+}
+
+// matchMaterializedView returns the query on the materialized view with the same plan as the query
+// bound by the builder, or nil if there is no such view. The views read a single table without joins,
+// so the query is compared with them only if it reads a single table without joins as well.
+func matchMaterializedView(builder *QueryBuilder, rootId int32) (*tree.Select, error) {
+	var scanNode *plan.Node
+	for _, node := range builder.qry.Nodes {
+		switch node.NodeType {
+		case plan.Node_TABLE_SCAN:
+			if scanNode != nil {
+				return nil, nil
+			}
+			scanNode = node
+		case plan.Node_FILTER, plan.Node_PROJECT, plan.Node_AGG:
+		default:
+			return nil, nil
+		}
+	}
+	if scanNode == nil || scanNode.TableDef == nil || len(scanNode.TableDef.RefMviews) == 0 {
+		return nil, nil
+	}
+
+	ctx := builder.compCtx
+	for _, view := range getMaterializedViews(ctx, scanNode.TableDef) {
+		ast, err := mysql.ParseOne(ctx.GetContext(), view.Query, 1)
+		if err != nil {
+			return nil, err
+		}
+		viewBuilder := NewQueryBuilder(plan.Query_SELECT, ctx, false, true)
+		viewRootId, err := viewBuilder.bindSelect(ast.(*tree.Select), NewBindContext(viewBuilder, nil), true)
+		// the query of the view may not be bound if its base table is altered
+		if err != nil {
+			continue
+		}
+		if !slices.Equal(builder.qry.Headings, viewBuilder.qry.Headings) ||
+			!isSameMaterializedViewPlan(builder, rootId, viewBuilder, viewRootId, make(map[int32]int32)) {
+			continue
+		}
+		ast, err = mysql.ParseOne(ctx.GetContext(), fmt.Sprintf("select * from `%s`.`%s`", view.Database, view.Name), 1)
+		if err != nil {
+			return nil, err
+		}
+		return ast.(*tree.Select), nil
+	}
+	return nil, nil
+}
+
+// isSameMaterializedViewPlan returns whether the bound plans of the nodes compute the same result. The
+// tags of the nodes are different in the two builders, tags maps the tags of the first one to the second.
+func isSameMaterializedViewPlan(b1 *QueryBuilder, id1 int32, b2 *QueryBuilder, id2 int32, tags map[int32]int32) bool {
+	n1, n2 := b1.qry.Nodes[id1], b2.qry.Nodes[id2]
+	if n1.NodeType != n2.NodeType || len(n1.Children) != len(n2.Children) || len(n1.BindingTags) != len(n2.BindingTags) {
+		return false
+	}
+	for i := range n1.Children {
+		if !isSameMaterializedViewPlan(b1, n1.Children[i], b2, n2.Children[i], tags) {
+			return false
+		}
+	}
+	for i, tag := range n1.BindingTags {
+		tags[tag] = n2.BindingTags[i]
+	}
+
+	if n1.NodeType == plan.Node_TABLE_SCAN {
+		if n1.ObjRef.SchemaName != n2.ObjRef.SchemaName || n1.ObjRef.ObjName != n2.ObjRef.ObjName {
+			return false
+		}
+	}
+	if !slices.Equal(n1.GroupingFlag, n2.GroupingFlag) || len(n1.OrderBy) != len(n2.OrderBy) {
+		return false
+	}
+	for i := range n1.OrderBy {
+		if n1.OrderBy[i].Flag != n2.OrderBy[i].Flag || !isSameMaterializedViewExpr(n1.OrderBy[i].Expr, n2.OrderBy[i].Expr, tags) {
+			return false
+		}
+	}
+	for _, exprs := range [][2][]*plan.Expr{
+		{n1.FilterList, n2.FilterList},
+		{n1.ProjectList, n2.ProjectList},
+		{n1.GroupBy, n2.GroupBy},
+		{n1.AggList, n2.AggList},
+		{{n1.Limit}, {n2.Limit}},
+		{{n1.Offset}, {n2.Offset}},
+	} {
+		if len(exprs[0]) != len(exprs[1]) {
+			return false
+		}
+		for i := range exprs[0] {
+			if !isSameMaterializedViewExpr(exprs[0][i], exprs[1][i], tags) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func isSameMaterializedViewExpr(e1, e2 *plan.Expr, tags map[int32]int32) bool {
+	if e1 == nil || e2 == nil {
+		return e1 == e2
+	}
+	// the names of the columns are qualified by the aliases of the tables
+	var normalize func(expr *plan.Expr, tags map[int32]int32)
+	normalize = func(expr *plan.Expr, tags map[int32]int32) {
+		switch e := expr.Expr.(type) {
+		case *plan.Expr_Col:
+			if tag, ok := tags[e.Col.RelPos]; ok {
+				e.Col.RelPos = tag
+			}
+			e.Col.Name = ""
+		case *plan.Expr_F:
+			for _, arg := range e.F.Args {
+				normalize(arg, tags)
+			}
+		case *plan.Expr_List:
+			for _, item := range e.List.List {
+				normalize(item, tags)
+			}
+		}
+	}
+	e1, e2 = DeepCopyExpr(e1), DeepCopyExpr(e2)
+	normalize(e1, tags)
+	normalize(e2, nil)
+	b1, err1 := e1.Marshal()
+	b2, err2 := e2.Marshal()
+	return err1 == nil && err2 == nil && bytes.Equal(b1, b2)
+}
@@ -0,0 +1,160 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	moruntime "github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/util/executor"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateMaterializedView(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	rt := moruntime.DefaultRuntime()
+	moruntime.SetupServiceBasedRuntime("", rt)
+	rt.SetGlobalVariables(moruntime.InternalSQLExecutor, executor.NewMemExecutor(func(sql string) (executor.Result, error) {
+		return executor.Result{}, nil
+	}))
+
+	sqls := []string{
+		"create materialized view constraint_test.mv as select b, sum(c) as s from constraint_test.t_mview group by b",
+		"create materialized view if not exists constraint_test.mv as select a, b from constraint_test.t_mview where c > 0",
+		"refresh materialized view constraint_test.mv_agg",
+		"drop materialized view constraint_test.mv_agg",
+		"drop table if exists constraint_test.t1",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	sqls = []string{
+		"create materialized view constraint_test.mv as select * from mo_catalog.mo_user",
+		"create materialized view constraint_test.mv as select * from constraint_test.no_such_table",
+		"create materialized view constraint_test.mv as select * from constraint_test.v1",
+		"create materialized view constraint_test.mv as with w as (select a from constraint_test.t1) select * from w",
+		"create materialized view constraint_test.mv_agg as select * from constraint_test.mv_agg",
+		// the views which can not be maintained by the keys of the changed rows
+		"create materialized view constraint_test.mv as select t1.a, t_mview.c from constraint_test.t1 join constraint_test.t_mview on t1.a = t_mview.a",
+		"create materialized view constraint_test.mv as select a, c from constraint_test.t_mview where a in (select a from constraint_test.t1)",
+		"create materialized view constraint_test.mv as select sum(c) from constraint_test.t_mview",
+		"create materialized view constraint_test.mv as select b, c from constraint_test.t_mview",
+		"refresh materialized view constraint_test.t1",
+		"drop materialized view constraint_test.t1",
+		"drop table constraint_test.mv_agg",
+		"drop table constraint_test.t_mview",
+		"truncate table constraint_test.mv_agg",
+		"alter table constraint_test.mv_agg add column d int",
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
+func TestCreateMaterializedViewPlan(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	rt := moruntime.DefaultRuntime()
+	moruntime.SetupServiceBasedRuntime("", rt)
+	rt.SetGlobalVariables(moruntime.InternalSQLExecutor, executor.NewMemExecutor(func(sql string) (executor.Result, error) {
+		return executor.Result{}, nil
+	}))
+
+	getView := func(sql string) *plan.MaterializedViewDef {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		require.NoError(t, err)
+		view := logicPlan.GetDdl().GetCreateTable().TableDef.Mview
+		require.NotNil(t, view)
+		return view
+	}
+
+	view := getView("create materialized view constraint_test.mv as select b, sum(c) as s from t_mview group by b")
+	require.Equal(t, "constraint_test", view.Database)
+	require.Equal(t, "mv", view.Name)
+	require.Equal(t, "select b, sum(c) as s from tpch.t_mview group by b", view.Query)
+	require.Equal(t, []string{"b"}, view.KeyCols)
+	require.Equal(t, []string{"b"}, view.ViewKeyCols)
+	require.Equal(t, 1, len(view.BaseTables))
+
+	// the primary key identifies the rows of the view without aggregation
+	view = getView("create materialized view constraint_test.mv as select a as id, c from constraint_test.t_mview where c > 0")
+	require.Equal(t, []string{"a"}, view.KeyCols)
+	require.Equal(t, []string{"id"}, view.ViewKeyCols)
+
+	_, err := runOneStmt(mock, t, "create materialized view constraint_test.mv as select t1.a, t_mview.c from constraint_test.t1 join constraint_test.t_mview on t1.a = t_mview.a")
+	require.ErrorContains(t, err, "materialized view on the join of tables")
+}
+
+func TestDmlWithMaterializedViews(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	collect := func(sql string) []*plan.PostDmlMaterializedView {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		require.NoError(t, err)
+		var views []*plan.PostDmlMaterializedView
+		for _, node := range logicPlan.GetQuery().Nodes {
+			if node.NodeType == plan.Node_POSTDML && node.PostDmlCtx.Mview != nil {
+				views = append(views, node.PostDmlCtx.Mview.Views...)
+			}
+		}
+		return views
+	}
+
+	views := collect("insert into constraint_test.t_mview values (1, 'a', 1)")
+	require.Equal(t, 1, len(views))
+	require.Equal(t, "mv_agg", views[0].View.Name)
+	require.Nil(t, views[0].OldKeyPos)
+	require.Equal(t, []int32{1}, views[0].NewKeyPos)
+
+	views = collect("delete from constraint_test.t_mview where a = 1")
+	require.Equal(t, 1, len(views))
+	require.Equal(t, []int32{1}, views[0].OldKeyPos)
+	require.Nil(t, views[0].NewKeyPos)
+
+	views = collect("delete from constraint_test.t_mview")
+	require.Equal(t, 1, len(views))
+
+	views = collect("update constraint_test.t_mview set b = 'b' where a = 1")
+	require.Equal(t, 1, len(views))
+	require.Equal(t, 1, len(views[0].OldKeyPos))
+	require.Equal(t, 1, len(views[0].NewKeyPos))
+
+	// the old keys of the inserted rows are null
+	views = collect("insert into constraint_test.t_mview values (1, 'a', 1) on duplicate key update c = 2")
+	require.Equal(t, 1, len(views))
+	require.Equal(t, 1, len(views[0].OldKeyPos))
+	require.Equal(t, 1, len(views[0].NewKeyPos))
+}
+
+func TestSelectFromMaterializedView(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	scans := func(sql string) []string {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		require.NoError(t, err)
+		var names []string
+		for _, node := range logicPlan.GetQuery().Nodes {
+			if node.NodeType == plan.Node_TABLE_SCAN {
+				names = append(names, node.TableDef.Name)
+			}
+		}
+		return names
+	}
+
+	require.Equal(t, []string{"mv_agg"}, scans("select b, sum(c) as s from constraint_test.t_mview group by b"))
+	require.Equal(t, []string{"t_mview"}, scans("select b, sum(c) from constraint_test.t_mview group by b"))
+
+	// the plans are matched regardless of the text of the query
+	require.Equal(t, []string{"mv_agg"}, scans("SELECT x.b, SUM(x.c) AS s FROM constraint_test.t_mview AS x GROUP BY x.b"))
+	require.Equal(t, []string{"t_mview"}, scans("select b, sum(c) as s from constraint_test.t_mview where a > 1 group by b"))
+	require.Equal(t, []string{"t_mview"}, scans("select b, sum(a) as s from constraint_test.t_mview group by b"))
+}
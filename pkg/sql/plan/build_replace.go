@@ -41,6 +41,13 @@ func buildReplace(stmt *tree.Replace, ctx CompilerContext, isPrepareStmt bool, r
 	}
 	tableDef := tblInfo.tableDefs[0]
 	keys := getAllKeys(tableDef)
+	for _, key := range keys {
+		for name := range key {
+			if col := FindColumn(tableDef.Cols, name); col != nil && col.Generated != nil {
+				return nil, moerr.NewNotSupported(ctx.GetContext(), "replace into table with generated column in key")
+			}
+		}
+	}
 	deleteCond := ""
 
 	if keys != nil {
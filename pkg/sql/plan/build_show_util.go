@@ -98,7 +98,17 @@ func ConstructCreateTableSQL(ctx CompilerContext, tableDef *plan.TableDef, snaps
 		fmt.Fprintf(buf, "  `%s` %s", formatStr(colNameOrigin), typeStr)
 
 		//-------------------------------------------------------------------------------------------------------------
-		if col.Typ.AutoIncr {
+		if col.Generated != nil {
+			buf.WriteString(" GENERATED ALWAYS AS (" + col.Generated.OriginString + ")")
+			if col.Generated.Stored {
+				buf.WriteString(" STORED")
+			} else {
+				buf.WriteString(" VIRTUAL")
+			}
+			if !col.Default.NullAbility {
+				buf.WriteString(" NOT NULL")
+			}
+		} else if col.Typ.AutoIncr {
 			buf.WriteString(" NOT NULL AUTO_INCREMENT")
 		} else {
 			if !col.Default.NullAbility {
@@ -194,10 +204,19 @@ func ConstructCreateTableSQL(ctx CompilerContext, tableDef *plan.TableDef, snaps
 					if err != nil {
 						return "", nil, err
 					}
-					parser, ok := paramMap["parser"]
+					parser, ok := paramMap[catalog.IndexAlgoParamParser]
 					if ok {
 						indexStr += " WITH PARSER " + parser
 					}
+					if size, ok := paramMap[catalog.IndexAlgoParamNgramTokenSize]; ok {
+						indexStr += " NGRAM_TOKEN_SIZE " + size
+					}
+					if stopwords, ok := paramMap[catalog.IndexAlgoParamStopwords]; ok {
+						indexStr += fmt.Sprintf(" STOPWORDS '%s'", stopwords)
+					}
+					if stemmer, ok := paramMap[catalog.IndexAlgoParamStemmer]; ok {
+						indexStr += fmt.Sprintf(" STEMMER '%s'", stemmer)
+					}
 				}
 
 			} else {
@@ -207,7 +226,8 @@ func ConstructCreateTableSQL(ctx CompilerContext, tableDef *plan.TableDef, snaps
 					indexStr = "  KEY "
 				}
 				indexStr += fmt.Sprintf("`%s` ", formatStr(indexdef.IndexName))
-				if !catalog.IsNullIndexAlgo(indexdef.IndexAlgo) {
+				isMultiValue := catalog.IsMultiValueIndexAlgo(indexdef.IndexAlgo)
+				if !catalog.IsNullIndexAlgo(indexdef.IndexAlgo) && !isMultiValue {
 					indexStr += fmt.Sprintf("USING %s ", indexdef.IndexAlgo)
 				}
 				indexStr += "("
@@ -220,13 +240,23 @@ func ConstructCreateTableSQL(ctx CompilerContext, tableDef *plan.TableDef, snaps
 						indexStr += ","
 					}
 
+					// the key part on an expression is saved as a hidden generated column
+					if col := FindColumn(tableDef.Cols, part); isFunctionalIndexCol(col) {
+						var partStr string
+						if partStr, err = formatFunctionalKeyPart(col, indexdef); err != nil {
+							return "", nil, err
+						}
+						indexStr += partStr
+						i++
+						continue
+					}
 					part = colNameToOriginName[part]
 					indexStr += fmt.Sprintf("`%s`", formatStr(part))
 					i++
 				}
 
 				indexStr += ")"
-				if indexdef.IndexAlgoParams != "" {
+				if indexdef.IndexAlgoParams != "" && !isMultiValue {
 					var paramList string
 					paramList, err = catalog.IndexParamsToStringList(indexdef.IndexAlgoParams)
 					if err != nil {
@@ -298,6 +328,16 @@ func ConstructCreateTableSQL(ctx CompilerContext, tableDef *plan.TableDef, snaps
 			formatStr(fk.Name), strings.Join(colOriginNames, "`,`"), fkRefDbTblName, strings.Join(fkColOriginNames, "`,`"), fk.OnDelete.String(), fk.OnUpdate.String())
 	}
 
+	for _, check := range tableDef.Checks {
+		if rowCount != 0 {
+			createStr += ",\n"
+		}
+		createStr += fmt.Sprintf("  CONSTRAINT `%s` CHECK (%s)", formatStr(check.Name), check.ExprStr)
+		if !check.Enforced {
+			createStr += " NOT ENFORCED"
+		}
+	}
+
 	if rowCount != 0 {
 		createStr += "\n"
 	}
@@ -120,6 +120,14 @@ func Test_buildTestShowCreateTable(t *testing.T) {
 				FULLTEXT(json1,json2) WITH PARSER json)`,
 			want: "CREATE TABLE `src` (\n  `id` bigint NOT NULL,\n  `json1` json DEFAULT NULL,\n  `json2` json DEFAULT NULL,\n  PRIMARY KEY (`id`),\n FULLTEXT(`json1`) WITH PARSER json,\n FULLTEXT(`json1`,`json2`) WITH PARSER json\n)",
 		},
+		{
+			name: "test8",
+			sql: `CREATE TABLE src (id bigint NOT NULL,
+				body text DEFAULT NULL,
+				PRIMARY KEY (id),
+				FULLTEXT(body) WITH PARSER ngram NGRAM_TOKEN_SIZE 2 STOPWORDS 'english' STEMMER 'Porter')`,
+			want: "CREATE TABLE `src` (\n  `id` bigint NOT NULL,\n  `body` text DEFAULT NULL,\n  PRIMARY KEY (`id`),\n FULLTEXT(`body`) WITH PARSER ngram NGRAM_TOKEN_SIZE 2 STOPWORDS 'english' STEMMER 'porter'\n)",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -23,8 +23,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/lock"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
@@ -436,6 +438,10 @@ func TestSingleTableSQLBuilder(t *testing.T) {
 		"select n_name, count(*) from nation group by n_name order by 2 asc",
 		"select count(distinct 12)",
 		"select nullif(n_name, n_comment), ifnull(n_comment, n_name) from nation",
+		"select json_array(n_nationkey, n_name, null), json_object('k', n_name), json_type(json_array()) from nation",
+		"select json_keys('{\"a\": 1}'), json_length('[1]', '$'), json_valid(n_name), json_remove('[1, 2]', '$[0]') from nation",
+		"select json_merge_patch('{}', '{\"a\": 1}'), json_merge('[1]', '2'), json_contains_path('{}', 'one', '$.a'), json_search('[\"a\"]', 'all', 'a')",
+		"select n_regionkey, json_arrayagg(n_name), json_objectagg(n_name, n_nationkey) from nation group by n_regionkey",
 
 		"select 18446744073709551500",
 		"select 0xffffffffffffffff",
@@ -708,6 +714,54 @@ func TestDelete(t *testing.T) {
 	runTestShouldError(mock, t, sqls)
 }
 
+func TestMerge(t *testing.T) {
+	mock := NewMockOptimizer(true)
+	// should pass
+	sqls := []string{
+		"merge into nation t using nation2 s on t.n_nationkey = s.n_nationkey when matched then update set n_name = s.n_name",
+		"merge into nation t using nation2 s on t.n_nationkey = s.n_nationkey when matched and s.r_regionkey = 0 then delete when matched then update set n_comment = 'c'",
+		"merge into nation t using nation2 s on t.n_nationkey = s.n_nationkey when matched then delete when not matched then insert values (s.n_nationkey, s.n_name, s.r_regionkey, s.n_comment)",
+		"merge into nation t using (select * from nation2 where r_regionkey > 1) s on t.n_nationkey = s.n_nationkey when not matched and s.n_name is not null then insert (n_nationkey, n_name, n_regionkey) values (s.n_nationkey, s.n_name, s.r_regionkey)",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"merge into nation t using nation2 s on t.n_nationkey = s.n_nationkey when matched then update set n_nationkey = 1", // update primary key
+		"merge into nation t using nation2 s on t.n_nationkey = s.n_nationkey when matched then update set n_name2 = 'a'",   // column not exist
+		"merge into nation t using nation2 s on t.n_nationkey = s.n_nationkey when not matched then insert values (1, 'a')", // value count
+		"merge into nation2222 t using nation2 s on t.n_nationkey = s.n_nationkey when matched then delete",                 // table not exist
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
+func TestMergeMatchedOnce(t *testing.T) {
+	mock := NewMockOptimizer(true)
+	hasMatchOnceCheck := func(logicPlan *Plan) bool {
+		for _, node := range logicPlan.GetQuery().Nodes {
+			if node.NodeType != plan.Node_FILTER {
+				continue
+			}
+			for _, filter := range node.FilterList {
+				if f := filter.GetF(); f != nil && f.Func.ObjName == "assert" &&
+					f.Args[1].GetLit().GetSval() == mergeMatchedMoreThanOnceMsg {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	logicPlan, err := runOneStmt(mock, t, "merge into nation t using nation2 s on t.n_regionkey = s.r_regionkey when matched then update set n_name = s.n_name")
+	require.NoError(t, err)
+	require.True(t, hasMatchOnceCheck(logicPlan))
+
+	// the target rows are not changed if there is no matched clause
+	logicPlan, err = runOneStmt(mock, t, "merge into nation t using nation2 s on t.n_nationkey = s.n_nationkey when not matched then insert values (s.n_nationkey, s.n_name, s.r_regionkey, s.n_comment)")
+	require.NoError(t, err)
+	require.False(t, hasMatchOnceCheck(logicPlan))
+}
+
 func TestSubQuery(t *testing.T) {
 	mock := NewMockOptimizer(false)
 	// should pass
@@ -1032,6 +1086,26 @@ func TestBuildUnnest(t *testing.T) {
 	runTestShouldError(mock, t, errSqls)
 }
 
+func TestBuildJsonTable(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	sqls := []string{
+		`select * from json_table('[{"a":1}]', '$[*]' columns (id for ordinality, a int path '$.a')) as jt`,
+		`select jt.b from json_table('{"a":[1,2]}', '$' columns (a json path '$.a', nested path '$.a[*]' columns (b decimal(10,2) path '$' default '0' on empty null on error))) as jt where jt.b > 1`,
+		`select n_name, jt.x from nation, json_table(nation.n_comment, '$[*]' columns (x varchar(10) path '$.x' error on error, e int exists path '$.y')) as jt`,
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+	errSqls := []string{
+		`select * from json_table(1, '$[*]' columns (a int path '$.a')) as jt`,
+		`select * from json_table('[]', 'a[*]' columns (a int path '$.a')) as jt`,
+		`select * from json_table('[]', '$[*]' columns (a int path 'a')) as jt`,
+		`select * from json_table('[]', '$[*]' columns (a int path '$.a', A int path '$.b')) as jt`,
+		`select * from json_table('[]', '$[*]' columns (a int path '$.a' default 'x' on empty)) as jt`,
+		`select * from json_table('[]', '$[*]' columns (a vecf32(3) path '$.a')) as jt`,
+		`select * from json_table('[]', '$[*]' columns (a int path '$.a')) as jt where jt.b = 1`,
+	}
+	runTestShouldError(mock, t, errSqls)
+}
+
 func TestVisitRule(t *testing.T) {
 	sql := "select * from nation where n_nationkey > 10 or n_nationkey=@int_var or abs(-1) > 1"
 	mock := NewMockOptimizer(false)
@@ -1243,3 +1317,93 @@ func Test_limitUint64(t *testing.T) {
 		outPutPlan(logicPlan, true, t)
 	}
 }
+
+func TestSelectLockClause(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	cases := []struct {
+		sql   string
+		check func(*plan.LockTarget)
+	}{
+		{
+			sql: "select * from nation where n_nationkey > 1 for update",
+			check: func(target *plan.LockTarget) {
+				assert.Equal(t, lock.LockMode_Exclusive, target.Mode)
+				assert.Equal(t, lock.WaitPolicy_Wait, target.WaitPolicy)
+				assert.False(t, target.SkipLocked)
+			},
+		},
+		{
+			sql: "select * from nation where n_nationkey > 1 for share",
+			check: func(target *plan.LockTarget) {
+				assert.Equal(t, lock.LockMode_Shared, target.Mode)
+				assert.Equal(t, lock.WaitPolicy_Wait, target.WaitPolicy)
+			},
+		},
+		{
+			sql: "select * from nation where n_nationkey > 1 lock in share mode",
+			check: func(target *plan.LockTarget) {
+				assert.Equal(t, lock.LockMode_Shared, target.Mode)
+			},
+		},
+		{
+			sql: "select * from nation where n_nationkey > 1 for share nowait",
+			check: func(target *plan.LockTarget) {
+				assert.Equal(t, lock.LockMode_Shared, target.Mode)
+				assert.Equal(t, lock.WaitPolicy_FastFail, target.WaitPolicy)
+			},
+		},
+		{
+			sql: "select * from nation where n_nationkey > 1 for update wait 3",
+			check: func(target *plan.LockTarget) {
+				assert.Equal(t, lock.WaitPolicy_Wait, target.WaitPolicy)
+				assert.Equal(t, uint64(3), target.WaitSec)
+			},
+		},
+		{
+			sql: "select * from nation where n_nationkey > 1 for update wait 0",
+			check: func(target *plan.LockTarget) {
+				assert.Equal(t, lock.WaitPolicy_FastFail, target.WaitPolicy)
+			},
+		},
+		{
+			sql: "select * from nation where n_nationkey > 1 limit 10 offset 5 for update skip locked",
+			check: func(target *plan.LockTarget) {
+				assert.Equal(t, lock.WaitPolicy_FastFail, target.WaitPolicy)
+				assert.True(t, target.SkipLocked)
+				assert.Equal(t, uint64(15), target.SkipLockedLimit)
+				assert.Nil(t, target.LockRows)
+			},
+		},
+		{
+			sql: "select * from nation where n_nationkey > 1 order by n_name limit 10 for update skip locked",
+			check: func(target *plan.LockTarget) {
+				assert.True(t, target.SkipLocked)
+				assert.Equal(t, uint64(0), target.SkipLockedLimit)
+			},
+		},
+		{
+			sql: "select * from nation where n_nationkey = 1 for share skip locked",
+			check: func(target *plan.LockTarget) {
+				assert.Equal(t, lock.LockMode_Shared, target.Mode)
+				assert.True(t, target.SkipLocked)
+				assert.Nil(t, target.LockRows)
+			},
+		},
+	}
+	for _, c := range cases {
+		logicPlan, err := runOneStmt(mock, t, c.sql)
+		if err != nil {
+			t.Fatalf("%+v, sql=%v", err, c.sql)
+		}
+		var targets []*plan.LockTarget
+		for _, node := range logicPlan.GetQuery().Nodes {
+			if node.NodeType == plan.Node_LOCK_OP {
+				targets = append(targets, node.LockTargets...)
+			}
+		}
+		if !assert.Equal(t, 1, len(targets), c.sql) {
+			continue
+		}
+		c.check(targets[0])
+	}
+}
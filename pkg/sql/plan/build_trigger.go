@@ -0,0 +1,834 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+func buildCreateTrigger(stmt *tree.CreateTrigger, ctx CompilerContext) (*Plan, error) {
+	dbName := string(stmt.Table.SchemaName)
+	if stmt.Name.NumParts > 1 {
+		if dbName == "" {
+			dbName = stmt.Name.Parts[1]
+		} else if dbName != stmt.Name.Parts[1] {
+			return nil, moerr.NewInvalidInput(ctx.GetContext(), "Trigger in wrong schema")
+		}
+	}
+	if dbName == "" {
+		dbName = ctx.DefaultDatabase()
+	}
+	if dbName == "" {
+		return nil, moerr.NewNoDB(ctx.GetContext())
+	}
+	if IsFkBannedDatabase(dbName) {
+		return nil, moerr.NewInvalidInput(ctx.GetContext(), "Triggers can not be created on system tables")
+	}
+
+	tblName := string(stmt.Table.ObjectName)
+	objRef, tableDef := ctx.Resolve(dbName, tblName, nil)
+	if tableDef == nil {
+		return nil, moerr.NewNoSuchTable(ctx.GetContext(), dbName, tblName)
+	}
+	if objRef.PubInfo != nil {
+		return nil, moerr.NewInternalError(ctx.GetContext(), "cannot create trigger in subscription database")
+	}
+	if tableDef.TableType == catalog.SystemViewRel || tableDef.IsTemporary {
+		return nil, moerr.NewInvalidInputf(ctx.GetContext(), "Trigger's '%s' is view or temporary table", tblName)
+	}
+	if tableDef.TableType != catalog.SystemOrdinaryRel {
+		return nil, moerr.NewNotSupportedf(ctx.GetContext(), "trigger on %s table", tableDef.TableType)
+	}
+
+	name := strings.ToLower(stmt.Name.Parts[0])
+	trigger := &plan.TriggerDef{
+		Name:   name,
+		Timing: stmt.Timing.String(),
+		Event:  stmt.Event.String(),
+	}
+	if stmt.Definer != nil {
+		trigger.Definer = stmt.Definer.Username + "@" + stmt.Definer.Hostname
+	} else {
+		trigger.Definer = ctx.GetUserName() + "@%"
+	}
+
+	// only the body, timing and event are needed when the trigger is fired
+	createStmt := *stmt
+	createStmt.Definer = nil
+	createStmt.IfNotExists = false
+	createStmt.Order = nil
+	createStmt.Name = tree.NewUnresolvedObjectName(name)
+	trigger.CreateSql = tree.StringWithOpts(&createStmt, dialect.MYSQL, tree.WithSingleQuoteString())
+
+	// bind the body against the columns of the table to report its errors on creation
+	if err := checkTriggerBody(ctx, dbName, tableDef, stmt); err != nil {
+		return nil, err
+	}
+
+	createTrigger := &plan.CreateTrigger{
+		Database:        dbName,
+		Table:           tableDef.Name,
+		IfNotExists:     stmt.IfNotExists,
+		Trigger:         trigger,
+		ActionStatement: tree.StringWithOpts(stmt.Body, dialect.MYSQL, tree.WithSingleQuoteString()),
+	}
+	if stmt.Order != nil {
+		orderTrigger := strings.ToLower(string(stmt.Order.OtherTrigger))
+		if findTriggerDef(tableDef.Triggers, orderTrigger, trigger.Timing, trigger.Event) == -1 {
+			return nil, moerr.NewInvalidInputf(ctx.GetContext(), "Referenced trigger '%s' for the given action time and event type does not exist.", orderTrigger)
+		}
+		createTrigger.OrderTrigger = orderTrigger
+		createTrigger.Follows = stmt.Order.Follows
+	}
+
+	return &Plan{
+		Plan: &plan.Plan_Ddl{
+			Ddl: &plan.DataDefinition{
+				DdlType: plan.DataDefinition_CREATE_TRIGGER,
+				Definition: &plan.DataDefinition_CreateTrigger{
+					CreateTrigger: createTrigger,
+				},
+			},
+		},
+	}, nil
+}
+
+func buildDropTrigger(stmt *tree.DropTrigger, ctx CompilerContext) (*Plan, error) {
+	dropTrigger := &plan.DropTrigger{
+		Name:     strings.ToLower(stmt.Name.Parts[0]),
+		IfExists: stmt.IfExists,
+	}
+	if stmt.Name.NumParts > 1 {
+		dropTrigger.Database = stmt.Name.Parts[1]
+	} else {
+		dropTrigger.Database = ctx.DefaultDatabase()
+	}
+	if dropTrigger.Database == "" {
+		return nil, moerr.NewNoDB(ctx.GetContext())
+	}
+
+	return &Plan{
+		Plan: &plan.Plan_Ddl{
+			Ddl: &plan.DataDefinition{
+				DdlType: plan.DataDefinition_DROP_TRIGGER,
+				Definition: &plan.DataDefinition_DropTrigger{
+					DropTrigger: dropTrigger,
+				},
+			},
+		},
+	}, nil
+}
+
+// findTriggerDef returns the position of the trigger in triggers, or -1. The timing and event
+// of the trigger are also matched if they are not empty.
+func findTriggerDef(triggers []*plan.TriggerDef, name, timing, event string) int {
+	for i, trigger := range triggers {
+		if trigger.Name != name {
+			continue
+		}
+		if (timing == "" || trigger.Timing == timing) && (event == "" || trigger.Event == event) {
+			return i
+		}
+	}
+	return -1
+}
+
+// getTriggers returns the triggers of tableDef fired by the event at the timing, in the order they are fired
+func getTriggers(tableDef *TableDef, timing tree.TriggerTiming, event tree.TriggerEvent) []*plan.TriggerDef {
+	var triggers []*plan.TriggerDef
+	for _, trigger := range tableDef.Triggers {
+		if trigger.Timing == timing.String() && trigger.Event == event.String() {
+			triggers = append(triggers, trigger)
+		}
+	}
+	return triggers
+}
+
+func parseCreateTrigger(ctx context.Context, createSql string) (*tree.CreateTrigger, error) {
+	ast, err := mysql.ParseOne(ctx, createSql, 1)
+	if err != nil {
+		return nil, err
+	}
+	if stmt, ok := ast.(*tree.CreateTrigger); ok {
+		return stmt, nil
+	}
+	return nil, moerr.NewInternalErrorf(ctx, "invalid trigger definition '%s'", createSql)
+}
+
+// checkTriggerBody binds the body of the trigger to be created, the rows of the trigger are the
+// columns of the table.
+func checkTriggerBody(ctx CompilerContext, dbName string, tableDef *TableDef, stmt *tree.CreateTrigger) error {
+	rowExprs := make([]*Expr, len(tableDef.Cols))
+	for i, col := range tableDef.Cols {
+		rowExprs[i] = &plan.Expr{
+			Typ: col.Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					ColPos: int32(i),
+					Name:   col.Name,
+				},
+			},
+		}
+	}
+
+	var newExprs, oldExprs []*Expr
+	if stmt.Event != tree.TRIGGER_DELETE {
+		newExprs = rowExprs
+	}
+	if stmt.Event != tree.TRIGGER_INSERT {
+		oldExprs = make([]*Expr, len(rowExprs))
+		for i, expr := range rowExprs {
+			oldExprs[i] = DeepCopyExpr(expr)
+		}
+	}
+	return newTriggerBodyBinder(ctx, dbName, tableDef, newExprs, oldExprs, len(tableDef.Cols)).bindTrigger(stmt)
+}
+
+// appendTriggerNodes appends the plan to fire the triggers for each row of lastNodeId, whose
+// output is projList: a project node computes the NEW row changed by the BEFORE triggers and the
+// values used by the actions, then a POSTDML node runs the actions of the triggers for each row.
+// newPos returns the position in projList of the NEW value of the column at colIdx of
+// tableDef.Cols, it is nil for DELETE. oldExprs are the values of the OLD row by the columns,
+// they are nil for INSERT. The triggers are fired only for the rows that rowGuard is true if it
+// is not nil. The extra columns of the actions are removed after the POSTDML node if stripExtras,
+// so that the output is projList with the changed NEW values.
+func appendTriggerNodes(ctx CompilerContext, builder *QueryBuilder, bindCtx *BindContext, objRef *ObjectRef, tableDef *TableDef,
+	triggers []*plan.TriggerDef, projList []*Expr, newPos func(colIdx int) (int, bool), oldExprs []*Expr, rowGuard *Expr,
+	lastNodeId int32, stripExtras bool) (int32, error) {
+
+	var newExprs []*Expr
+	if newPos != nil {
+		newExprs = make([]*Expr, len(tableDef.Cols))
+		for i := range tableDef.Cols {
+			if pos, ok := newPos(i); ok {
+				newExprs[i] = projList[pos]
+			}
+		}
+	}
+
+	body := newTriggerBodyBinder(ctx, objRef.SchemaName, tableDef, newExprs, oldExprs, len(projList))
+	body.rowGuard = rowGuard
+	if err := body.bindTriggers(triggers); err != nil {
+		return -1, err
+	}
+
+	for i := range body.newExprs {
+		if pos, ok := newPos(i); ok {
+			projList[pos] = body.newExprs[i]
+		}
+	}
+	lastNodeId = builder.appendNode(&Node{
+		NodeType:    plan.Node_PROJECT,
+		Children:    []int32{lastNodeId},
+		ProjectList: append(projList, body.extraExprs...),
+	}, bindCtx)
+	if len(body.actions) == 0 {
+		return lastNodeId, nil
+	}
+
+	lastNodeId = builder.appendNode(&Node{
+		NodeType:    plan.Node_POSTDML,
+		Children:    []int32{lastNodeId},
+		ProjectList: getProjectionByLastNode(builder, lastNodeId),
+		PostDmlCtx: &plan.PostDmlCtx{
+			Ref: objRef,
+			Trigger: &plan.PostDmlTriggerCtx{
+				Actions: body.actions,
+			},
+		},
+	}, bindCtx)
+	if stripExtras && len(body.extraExprs) > 0 {
+		lastNodeId = builder.appendNode(&Node{
+			NodeType:    plan.Node_PROJECT,
+			Children:    []int32{lastNodeId},
+			ProjectList: getProjectionByLastNode(builder, lastNodeId)[:len(projList)],
+		}, bindCtx)
+	}
+	return lastNodeId, nil
+}
+
+// buildAfterInsertTriggerPlans fires the AFTER INSERT triggers with the rows written by the
+// insert plan at sourceStep, so that NEW has the values of the auto-increment and generated
+// columns. colCount is the number of the columns of tableDef before the insert plan is built.
+// If onDuplicateKey, the rows are written by insert on duplicate key update, whose last column
+// is the row id of the old row, the rows which update the old rows are skipped.
+func buildAfterInsertTriggerPlans(ctx CompilerContext, builder *QueryBuilder, bindCtx *BindContext, objRef *ObjectRef, tableDef *TableDef,
+	colCount int, sourceStep int32, triggers []*plan.TriggerDef, onDuplicateKey bool) error {
+
+	lastNodeId := appendSinkScanNode(builder, bindCtx, sourceStep)
+	projList := getProjectionByLastNode(builder, lastNodeId)
+	var rowGuard *Expr
+	if onDuplicateKey {
+		var err error
+		rowGuard, err = BindFuncExprImplByPlanExpr(ctx.GetContext(), "isnull", []*Expr{DeepCopyExpr(projList[len(projList)-1])})
+		if err != nil {
+			return err
+		}
+	}
+	lastNodeId, err := appendTriggerNodes(ctx, builder, bindCtx, objRef, tableDef, triggers, projList, func(colIdx int) (int, bool) {
+		return colIdx, colIdx < colCount
+	}, nil, rowGuard, lastNodeId, false)
+	if err != nil {
+		return err
+	}
+	builder.appendStep(lastNodeId)
+	return nil
+}
+
+// appendUpdateTriggerNodes fires the UPDATE triggers with the rows of the sink scan at lastNodeId,
+// whose front columns are the OLD row. projList is the NEW row by newCols followed by the row id.
+// The rows of insert on duplicate key update without the OLD row are inserted, they are skipped.
+func appendUpdateTriggerNodes(ctx CompilerContext, builder *QueryBuilder, bindCtx *BindContext, updateCtx *dmlPlanCtx,
+	triggers []*plan.TriggerDef, newCols []*ColDef, projList []*Expr, lastNodeId int32) (int32, error) {
+
+	tableDef := updateCtx.tableDef
+	lastNode := builder.qry.Nodes[lastNodeId]
+	oldExprs := make([]*Expr, len(tableDef.Cols))
+	for i, col := range tableDef.Cols {
+		oldExprs[i] = &plan.Expr{
+			Typ: lastNode.ProjectList[i].Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					ColPos: int32(i),
+					Name:   col.Name,
+				},
+			},
+		}
+	}
+	newColPos := make(map[string]int, len(newCols))
+	for i, col := range newCols {
+		newColPos[col.Name] = i
+	}
+	newPos := func(colIdx int) (int, bool) {
+		pos, ok := newColPos[tableDef.Cols[colIdx].Name]
+		return pos, ok
+	}
+
+	// the primary key not in the SET list is not checked for the duplicates
+	pkExprs := make(map[string]*Expr)
+	if !updateCtx.updatePkCol && tableDef.Pkey != nil {
+		for _, name := range tableDef.Pkey.Names {
+			if pos, ok := newColPos[name]; ok {
+				pkExprs[name] = projList[pos]
+			}
+		}
+	}
+
+	var rowGuard *Expr
+	if updateCtx.onDuplicateKey {
+		var err error
+		rowGuard, err = BindFuncExprImplByPlanExpr(ctx.GetContext(), "isnotnull", []*Expr{DeepCopyExpr(oldExprs[updateCtx.rowIdPos])})
+		if err != nil {
+			return -1, err
+		}
+	}
+	lastNodeId, err := appendTriggerNodes(ctx, builder, bindCtx, updateCtx.objRef, tableDef, triggers, projList, newPos, oldExprs, rowGuard, lastNodeId, true)
+	if err != nil {
+		return -1, err
+	}
+	for name, expr := range pkExprs {
+		if projList[newColPos[name]] != expr {
+			return -1, moerr.NewNotSupportedf(ctx.GetContext(), "changing the primary key column '%s' which is not updated by the statement in trigger", name)
+		}
+	}
+	return lastNodeId, nil
+}
+
+// appendOnDuplicateKeyInsertTriggerNodes fires the BEFORE INSERT triggers of insert on duplicate key
+// update with the rows at lastNodeId, whose front columns are the rows to be inserted by the visible
+// columns of tableDef. The old rows have been found by the keys, so the keys can not be changed.
+func appendOnDuplicateKeyInsertTriggerNodes(ctx CompilerContext, builder *QueryBuilder, bindCtx *BindContext, objRef *ObjectRef, tableDef *TableDef,
+	triggers []*plan.TriggerDef, lastNodeId int32) (int32, error) {
+
+	insertPos := make(map[int]int, len(tableDef.Cols))
+	for i, col := range tableDef.Cols {
+		if col.Hidden && col.Name != catalog.FakePrimaryKeyColName && !isFunctionalIndexCol(col) {
+			continue
+		}
+		insertPos[i] = len(insertPos)
+	}
+	newPos := func(colIdx int) (int, bool) {
+		pos, ok := insertPos[colIdx]
+		return pos, ok
+	}
+
+	projList := getProjectionByLastNode(builder, lastNodeId)
+	_, uniqueColNames := GetUniqueColAndIdxFromTableDef(tableDef)
+	keyExprs := make(map[string]*Expr)
+	keyPos := make(map[string]int)
+	for i, col := range tableDef.Cols {
+		if pos, ok := insertPos[i]; ok && uniqueColNames[col.Name] {
+			keyExprs[col.Name] = projList[pos]
+			keyPos[col.Name] = pos
+		}
+	}
+
+	lastNodeId, err := appendTriggerNodes(ctx, builder, bindCtx, objRef, tableDef, triggers, projList, newPos, nil, nil, lastNodeId, true)
+	if err != nil {
+		return -1, err
+	}
+	for name, expr := range keyExprs {
+		if projList[keyPos[name]] != expr {
+			return -1, moerr.NewNotSupportedf(ctx.GetContext(), "changing the key column '%s' of insert on duplicate key update in trigger", name)
+		}
+	}
+	return lastNodeId, nil
+}
+
+// buildDeleteTriggerPlans fires the DELETE triggers with the rows to be deleted at delCtx.sourceStep
+func buildDeleteTriggerPlans(ctx CompilerContext, builder *QueryBuilder, bindCtx *BindContext, delCtx *dmlPlanCtx) error {
+	triggers := append(getTriggers(delCtx.tableDef, tree.TRIGGER_BEFORE, tree.TRIGGER_DELETE),
+		getTriggers(delCtx.tableDef, tree.TRIGGER_AFTER, tree.TRIGGER_DELETE)...)
+	if len(triggers) == 0 {
+		return nil
+	}
+
+	lastNodeId := appendSinkScanNode(builder, bindCtx, delCtx.sourceStep)
+	projList := getProjectionByLastNode(builder, lastNodeId)
+	oldExprs := make([]*Expr, len(delCtx.tableDef.Cols))
+	for i := range oldExprs {
+		oldExprs[i] = projList[i]
+	}
+	lastNodeId, err := appendTriggerNodes(ctx, builder, bindCtx, delCtx.objRef, delCtx.tableDef, triggers, projList, nil, oldExprs, nil, lastNodeId, false)
+	if err != nil {
+		return err
+	}
+	builder.appendStep(lastNodeId)
+	return nil
+}
+
+// triggerBodyBinder binds the bodies of the triggers fired for the same rows. The assignments to
+// NEW are bound into the expressions of NEW, the other statements are bound into the actions of
+// the POSTDML node, the statements in the IF and CASE branches are guarded by the conditions.
+type triggerBodyBinder struct {
+	binder   *TriggerBinder
+	dbName   string
+	tableDef *TableDef
+	timing   string
+	event    string
+	// newExprs and oldExprs are the values of the NEW and OLD rows by the positions in
+	// tableDef.Cols, they are nil if the trigger has no such row
+	newExprs []*Expr
+	oldExprs []*Expr
+	// rowGuard is true for the rows which fire the triggers, nil if all the rows fire them
+	rowGuard *Expr
+	// extraExprs are the values used by the actions, they are appended to the input of the
+	// POSTDML node from the column at extraPos
+	extraPos   int
+	extraExprs []*Expr
+	actions    []*plan.PostDmlTriggerAction
+}
+
+func newTriggerBodyBinder(ctx CompilerContext, dbName string, tableDef *TableDef, newExprs, oldExprs []*Expr, extraPos int) *triggerBodyBinder {
+	b := &triggerBodyBinder{
+		dbName:   dbName,
+		tableDef: tableDef,
+		newExprs: newExprs,
+		oldExprs: oldExprs,
+		extraPos: extraPos,
+	}
+	builder := NewQueryBuilder(plan.Query_SELECT, ctx, false, false)
+	b.binder = NewTriggerBinder(builder, NewBindContext(builder, nil), b)
+	return b
+}
+
+func (b *triggerBodyBinder) GetContext() context.Context {
+	return b.binder.GetContext()
+}
+
+func (b *triggerBodyBinder) bindTriggers(triggers []*plan.TriggerDef) error {
+	for _, trigger := range triggers {
+		stmt, err := parseCreateTrigger(b.GetContext(), trigger.CreateSql)
+		if err != nil {
+			return err
+		}
+		if err = b.bindTrigger(stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *triggerBodyBinder) bindTrigger(stmt *tree.CreateTrigger) error {
+	b.timing = stmt.Timing.String()
+	b.event = stmt.Event.String()
+	return b.bindStmt(stmt.Body, b.rowGuard)
+}
+
+func (b *triggerBodyBinder) bindStmts(stmts []tree.Statement, guard *Expr) error {
+	for _, stmt := range stmts {
+		if err := b.bindStmt(stmt, guard); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// bindStmt binds the statement which is run only for the rows that guard is true, guard is nil
+// if it is run for all the rows.
+func (b *triggerBodyBinder) bindStmt(stmt tree.Statement, guard *Expr) error {
+	if stmt == nil {
+		return nil
+	}
+	switch st := stmt.(type) {
+	case *tree.CompoundStmt:
+		return b.bindStmts(st.Stmts, guard)
+	case *tree.IfStmt:
+		conds := []tree.Expr{st.Cond}
+		bodies := [][]tree.Statement{st.Body}
+		for _, elif := range st.Elifs {
+			conds = append(conds, elif.Cond)
+			bodies = append(bodies, elif.Body)
+		}
+		return b.bindBranches(conds, bodies, st.Else, guard, false)
+	case *tree.CaseStmt:
+		conds := make([]tree.Expr, len(st.Whens))
+		bodies := make([][]tree.Statement, len(st.Whens))
+		for i, when := range st.Whens {
+			conds[i] = when.Cond
+			if st.Expr != nil {
+				conds[i] = tree.NewComparisonExpr(tree.EQUAL, st.Expr, when.Cond)
+			}
+			bodies[i] = when.Body
+		}
+		return b.bindBranches(conds, bodies, st.Else, guard, true)
+	case *tree.SetVar:
+		return b.bindSetVar(st, guard)
+	case *tree.Signal:
+		return b.bindSignal(st, guard)
+	case *tree.Insert, *tree.Replace, *tree.Update, *tree.Delete:
+		return b.bindDml(stmt, guard)
+	}
+	return moerr.NewNotSupportedf(b.GetContext(), "%s statement in trigger", stmt.GetStatementType())
+}
+
+// bindBranches binds the branches of IF or CASE, the first branch whose condition is true is run.
+// The CASE statement without ELSE fails if none of the conditions is true.
+func (b *triggerBodyBinder) bindBranches(conds []tree.Expr, bodies [][]tree.Statement, elseBody []tree.Statement, guard *Expr, isCase bool) error {
+	rest := guard
+	for i, cond := range conds {
+		condExpr, err := b.bindCond(cond)
+		if err != nil {
+			return err
+		}
+		branchGuard, err := b.and(rest, condExpr)
+		if err != nil {
+			return err
+		}
+		if err = b.bindStmts(bodies[i], branchGuard); err != nil {
+			return err
+		}
+		notCond, err := BindFuncExprImplByPlanExpr(b.GetContext(), "not", []*Expr{DeepCopyExpr(condExpr)})
+		if err != nil {
+			return err
+		}
+		if rest, err = b.and(rest, notCond); err != nil {
+			return err
+		}
+	}
+
+	if elseBody != nil {
+		return b.bindStmts(elseBody, rest)
+	}
+	if isCase {
+		item := moerr.MysqlErrorMsgRefer[moerr.ER_SP_CASE_NOT_FOUND]
+		b.addSignal(rest, item.SqlStates[0], item.ErrorCode, nil, item.ErrorMsgOrFormat)
+	}
+	return nil
+}
+
+// bindCond binds the condition of IF or CASE, which is false if it is NULL
+func (b *triggerBodyBinder) bindCond(cond tree.Expr) (*Expr, error) {
+	expr, err := b.binder.BindExpr(cond, 0, true)
+	if err != nil {
+		return nil, err
+	}
+	if expr.Typ.Id != int32(types.T_bool) {
+		if expr, err = appendCastBeforeExpr(b.GetContext(), expr, plan.Type{Id: int32(types.T_bool)}); err != nil {
+			return nil, err
+		}
+	}
+	return BindFuncExprImplByPlanExpr(b.GetContext(), "istrue", []*Expr{expr})
+}
+
+func (b *triggerBodyBinder) and(guard, cond *Expr) (*Expr, error) {
+	if guard == nil {
+		return cond, nil
+	}
+	return BindFuncExprImplByPlanExpr(b.GetContext(), "and", []*Expr{DeepCopyExpr(guard), cond})
+}
+
+// bindSetVar binds SET NEW.col = expr, the value of NEW.col is changed for the rows that guard is true
+func (b *triggerBodyBinder) bindSetVar(stmt *tree.SetVar, guard *Expr) error {
+	for _, assignment := range stmt.Assignments {
+		row, colName, ok := strings.Cut(strings.ToLower(assignment.Name), ".")
+		row = strings.ToUpper(row)
+		if !ok || !assignment.System || assignment.Global || (row != triggerRowNew && row != triggerRowOld) {
+			return moerr.NewNotSupported(b.GetContext(), "setting variables in trigger")
+		}
+		if row == triggerRowOld {
+			return moerr.NewTriggerCantChangeRow(b.GetContext(), triggerRowOld, b.timing)
+		}
+		if b.newExprs == nil {
+			return moerr.NewTriggerNoSuchRow(b.GetContext(), triggerRowNew, b.event)
+		}
+		if b.timing == tree.TRIGGER_AFTER.String() {
+			return moerr.NewTriggerCantChangeRow(b.GetContext(), triggerRowNew, b.timing)
+		}
+
+		colIdx := b.findColumn(colName)
+		if colIdx == -1 {
+			return moerr.NewBadFieldError(b.GetContext(), colName, triggerRowNew)
+		}
+		col := b.tableDef.Cols[colIdx]
+		if col.Generated != nil {
+			return moerr.NewInvalidInputf(b.GetContext(), "The value specified for generated column '%s' in table '%s' is not allowed.", col.GetOriginCaseName(), b.tableDef.Name)
+		}
+		curExpr := b.newExprs[colIdx]
+		if curExpr == nil {
+			return moerr.NewInternalErrorf(b.GetContext(), "can not find the NEW value of column '%s'", col.Name)
+		}
+
+		value, err := b.binder.BindExpr(assignment.Value, 0, true)
+		if err != nil {
+			return err
+		}
+		if value, err = forceCastExpr(b.GetContext(), value, curExpr.Typ); err != nil {
+			return err
+		}
+		if guard != nil {
+			value, err = BindFuncExprImplByPlanExpr(b.GetContext(), "case", []*Expr{DeepCopyExpr(guard), value, curExpr})
+			if err != nil {
+				return err
+			}
+			if value, err = forceCastExpr(b.GetContext(), value, curExpr.Typ); err != nil {
+				return err
+			}
+		}
+		b.newExprs[colIdx] = value
+	}
+	return nil
+}
+
+// bindSignal binds SIGNAL which fails the statement for the rows that guard is true
+func (b *triggerBodyBinder) bindSignal(stmt *tree.Signal, guard *Expr) error {
+	if stmt.IsResignal {
+		return moerr.NewNotSupported(b.GetContext(), "RESIGNAL in trigger")
+	}
+	if stmt.Condition.Type != tree.CONDITION_SQLSTATE {
+		return moerr.NewInvalidInputf(b.GetContext(), "Undefined CONDITION: %s", stmt.Condition.Name)
+	}
+
+	sqlState := stmt.Condition.SqlState
+	var errno uint16
+	switch sqlState[:2] {
+	case "01":
+		errno = moerr.ER_SIGNAL_WARN
+	case "02":
+		errno = moerr.ER_SIGNAL_NOT_FOUND
+	default:
+		errno = moerr.ER_SIGNAL_EXCEPTION
+	}
+	msg := moerr.MysqlErrorMsgRefer[errno].ErrorMsgOrFormat
+
+	var msgExpr *Expr
+	for _, item := range stmt.Items {
+		switch item.Name {
+		case "MESSAGE_TEXT":
+			expr, err := b.binder.BindExpr(item.Value, 0, true)
+			if err != nil {
+				return err
+			}
+			msgExpr, err = forceCastExpr(b.GetContext(), expr, plan.Type{Id: int32(types.T_varchar), Width: types.MaxVarcharLen})
+			if err != nil {
+				return err
+			}
+		case "MYSQL_ERRNO":
+			value := tree.StringWithOpts(item.Value, dialect.MYSQL)
+			code, err := strconv.ParseUint(value, 10, 16)
+			if err != nil || code == 0 {
+				return moerr.NewInvalidInputf(b.GetContext(), "variable 'MYSQL_ERRNO' can't be set to the value of '%s'", value)
+			}
+			errno = uint16(code)
+		}
+		// the other condition information items are accepted but not kept
+	}
+
+	b.addSignal(guard, sqlState, errno, msgExpr, msg)
+	return nil
+}
+
+func (b *triggerBodyBinder) addSignal(guard *Expr, sqlState string, errno uint16, msgExpr *Expr, msg string) {
+	action := &plan.PostDmlTriggerAction{
+		Guard:    b.addGuard(guard),
+		SqlParts: []string{msg},
+		SqlState: sqlState,
+		Errno:    uint32(errno),
+		Message:  -1,
+	}
+	if msgExpr != nil {
+		action.Message = b.addExtra(msgExpr)
+	}
+	b.actions = append(b.actions, action)
+}
+
+// bindDml binds the DML statement into the SQL run after the pipeline, the NEW and OLD values
+// are filled into the SQL for each row. The tables without the database are in the database of
+// the trigger.
+func (b *triggerBodyBinder) bindDml(stmt tree.Statement, guard *Expr) error {
+	var targets tree.TableExprs
+	switch st := stmt.(type) {
+	case *tree.Insert:
+		targets = tree.TableExprs{st.Table}
+	case *tree.Replace:
+		targets = tree.TableExprs{st.Table}
+	case *tree.Update:
+		targets = st.Tables
+	case *tree.Delete:
+		targets = st.Tables
+	}
+	for _, target := range targets {
+		if err := b.checkDmlTarget(target); err != nil {
+			return err
+		}
+	}
+
+	var err error
+	var parts []string
+	var args []int32
+	last := 0
+	sql := tree.StringWithOpts(stmt, dialect.MYSQL, tree.WithSingleQuoteString(), tree.WithFormatHook(func(fmtCtx *tree.FmtCtx, node tree.NodeFormatter) bool {
+		switch n := node.(type) {
+		case tree.TableName:
+			if n.ExplicitSchema {
+				return false
+			}
+			n.SchemaName = tree.Identifier(b.dbName)
+			n.ExplicitSchema = true
+			n.Format(fmtCtx)
+			return true
+		case *tree.UnresolvedName:
+			row := triggerRowOfName(n)
+			if row == "" {
+				return false
+			}
+			expr, bindErr := b.bindRowRef(row, n)
+			if bindErr != nil {
+				if err == nil {
+					err = bindErr
+				}
+				return true
+			}
+			parts = append(parts, fmtCtx.String()[last:])
+			args = append(args, b.addExtra(expr))
+			last = fmtCtx.Len()
+			return true
+		}
+		return false
+	}))
+	if err != nil {
+		return err
+	}
+	parts = append(parts, sql[last:])
+
+	b.actions = append(b.actions, &plan.PostDmlTriggerAction{
+		Guard:    b.addGuard(guard),
+		SqlParts: parts,
+		Args:     args,
+		Message:  -1,
+	})
+	return nil
+}
+
+// checkDmlTarget returns error if the DML in the trigger changes the table of the trigger
+func (b *triggerBodyBinder) checkDmlTarget(target tree.TableExpr) error {
+	if aliased, ok := target.(*tree.AliasedTableExpr); ok {
+		target = aliased.Expr
+	}
+	tbl, ok := target.(*tree.TableName)
+	if !ok {
+		return nil
+	}
+	dbName := string(tbl.SchemaName)
+	if dbName == "" {
+		dbName = b.dbName
+	}
+	if dbName == b.dbName && strings.EqualFold(string(tbl.ObjectName), b.tableDef.Name) {
+		return moerr.NewInvalidInputf(b.GetContext(), "Can't update table '%s' in trigger because it is already used by statement which invoked this trigger.", b.tableDef.Name)
+	}
+	return nil
+}
+
+// bindRowRef returns the value of NEW.col or OLD.col. The generated columns of NEW are computed
+// from the other columns, since the NEW values may be changed by the trigger.
+func (b *triggerBodyBinder) bindRowRef(row string, name *tree.UnresolvedName) (*Expr, error) {
+	exprs := b.newExprs
+	if row == triggerRowOld {
+		exprs = b.oldExprs
+	}
+	if exprs == nil {
+		return nil, moerr.NewTriggerNoSuchRow(b.GetContext(), row, b.event)
+	}
+
+	colIdx := b.findColumn(name.ColName())
+	if colIdx == -1 {
+		return nil, moerr.NewBadFieldError(b.GetContext(), name.ColNameOrigin(), row)
+	}
+	col := b.tableDef.Cols[colIdx]
+	if row == triggerRowNew && col.Generated != nil && col.Generated.Expr != nil {
+		return replaceGeneratedColRef(b.tableDef, col.Generated.Expr, func(refIdx int) (*Expr, error) {
+			if exprs[refIdx] == nil {
+				return nil, moerr.NewInternalErrorf(b.GetContext(), "can not find the NEW value of column '%s'", b.tableDef.Cols[refIdx].Name)
+			}
+			return DeepCopyExpr(exprs[refIdx]), nil
+		})
+	}
+	if exprs[colIdx] == nil {
+		return nil, moerr.NewInternalErrorf(b.GetContext(), "can not find the %s value of column '%s'", row, col.Name)
+	}
+	return DeepCopyExpr(exprs[colIdx]), nil
+}
+
+// findColumn returns the position of the visible column in tableDef.Cols, or -1
+func (b *triggerBodyBinder) findColumn(colName string) int {
+	for i, col := range b.tableDef.Cols {
+		if col.Name == colName && !col.Hidden {
+			return i
+		}
+	}
+	return -1
+}
+
+func (b *triggerBodyBinder) addGuard(guard *Expr) int32 {
+	if guard == nil {
+		return -1
+	}
+	return b.addExtra(DeepCopyExpr(guard))
+}
+
+func (b *triggerBodyBinder) addExtra(expr *Expr) int32 {
+	b.extraExprs = append(b.extraExprs, expr)
+	return int32(b.extraPos + len(b.extraExprs) - 1)
+}
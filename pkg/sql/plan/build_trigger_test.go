@@ -0,0 +1,145 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateTrigger(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	sqls := []string{
+		"create trigger constraint_test.trg before insert on constraint_test.t1 for each row set new.b = 'x'",
+		"create trigger if not exists trg before update on constraint_test.t1 for each row begin if new.a > old.a then set new.b = concat(old.b, new.b); elseif new.a < 0 then signal sqlstate '45000' set message_text = 'negative'; end if; end",
+		"create trigger trg after delete on constraint_test.t1 for each row insert into t_trigger values (old.a, old.b)",
+		"create trigger trg before insert on constraint_test.t1 for each row case new.a when 1 then set new.b = 'one'; end case",
+		"create trigger trg after insert on constraint_test.t_trigger for each row follows ai update t1 set b = new.b where a = new.a",
+		"drop trigger if exists constraint_test.trg",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	sqls = []string{
+		// no such row
+		"create trigger trg before insert on constraint_test.t1 for each row set new.b = old.b",
+		"create trigger trg after delete on constraint_test.t1 for each row insert into t_trigger values (new.a, new.b)",
+		// change OLD or NEW after the row is written
+		"create trigger trg before update on constraint_test.t1 for each row set old.b = 'x'",
+		"create trigger trg after insert on constraint_test.t1 for each row set new.b = 'x'",
+		// unknown column
+		"create trigger trg before insert on constraint_test.t1 for each row set new.c = 'x'",
+		"create trigger trg before insert on constraint_test.t1 for each row set new.b = a",
+		// the table of the trigger is changed by the trigger
+		"create trigger trg after insert on constraint_test.t1 for each row delete from t1 where a = new.a",
+		// the referenced trigger does not exist
+		"create trigger trg after insert on constraint_test.t_trigger for each row precedes bi delete from t1",
+		"create trigger trg before insert on constraint_test.t1 for each row set new.b = (select 1)",
+		"create trigger trg before insert on mo_catalog.mo_user for each row set new.b = 'x'",
+		"create trigger trg before insert on constraint_test.no_such_table for each row set new.b = 'x'",
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
+func TestCreateTriggerPlan(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	logicPlan, err := runOneStmt(mock, t, "create definer = 'u1'@'localhost' trigger constraint_test.trg after insert on constraint_test.t_trigger for each row precedes ai insert into t1 values (new.a, 'x')")
+	require.NoError(t, err)
+	createTrigger := logicPlan.GetDdl().GetCreateTrigger()
+	require.Equal(t, "constraint_test", createTrigger.Database)
+	require.Equal(t, "t_trigger", createTrigger.Table)
+	require.Equal(t, "ai", createTrigger.OrderTrigger)
+	require.False(t, createTrigger.Follows)
+	require.Equal(t, "trg", createTrigger.Trigger.Name)
+	require.Equal(t, "AFTER", createTrigger.Trigger.Timing)
+	require.Equal(t, "INSERT", createTrigger.Trigger.Event)
+	require.Equal(t, "u1@localhost", createTrigger.Trigger.Definer)
+
+	// the stored definition can be parsed again
+	stmt, err := parseCreateTrigger(mock.CurrentContext().GetContext(), createTrigger.Trigger.CreateSql)
+	require.NoError(t, err)
+	require.Nil(t, stmt.Order)
+	require.Nil(t, stmt.Definer)
+}
+
+func TestDmlWithTriggers(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	sqls := []string{
+		"insert into constraint_test.t_trigger values (1, 'a'), (2, 'b')",
+		"update constraint_test.t_trigger set b = 'c' where a = 1",
+		"delete from constraint_test.t_trigger where a = 1",
+		"delete from constraint_test.t_trigger",
+		"insert into constraint_test.t_trigger values (1, 'a') on duplicate key update b = 'c'",
+		"merge into constraint_test.t_trigger as t using constraint_test.t1 as s on t.a = s.a when matched then update set b = s.b when not matched then insert values (s.a, s.b)",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+}
+
+func TestTriggerActions(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	collect := func(sql string) []*plan.PostDmlTriggerAction {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		require.NoError(t, err)
+		var actions []*plan.PostDmlTriggerAction
+		for _, node := range logicPlan.GetQuery().Nodes {
+			if node.NodeType == plan.Node_POSTDML && node.PostDmlCtx.Trigger != nil {
+				actions = append(actions, node.PostDmlCtx.Trigger.Actions...)
+			}
+		}
+		return actions
+	}
+
+	// the after insert trigger inserts into t1 with the values of NEW
+	actions := collect("insert into constraint_test.t_trigger values (1, 'a')")
+	require.Equal(t, 1, len(actions))
+	require.Equal(t, int32(-1), actions[0].Guard)
+	require.Equal(t, []string{"insert into constraint_test.t1 values (", ", ", ")"}, actions[0].SqlParts)
+	require.Equal(t, 2, len(actions[0].Args))
+
+	// the before update trigger signals for the rows that b is null
+	actions = collect("update constraint_test.t_trigger set b = null")
+	require.Equal(t, 1, len(actions))
+	require.NotEqual(t, int32(-1), actions[0].Guard)
+	require.Equal(t, "45000", actions[0].SqlState)
+	require.NotEqual(t, int32(-1), actions[0].Message)
+
+	actions = collect("delete from constraint_test.t_trigger")
+	require.Equal(t, 1, len(actions))
+	require.Equal(t, []string{"delete from constraint_test.t1 where a = ", ""}, actions[0].SqlParts)
+
+	// the inserted rows fire the after insert trigger, the updated rows fire the before update trigger
+	actions = collect("insert into constraint_test.t_trigger values (1, 'a') on duplicate key update b = 'c'")
+	require.Equal(t, 2, len(actions))
+	require.Equal(t, "45000", actions[0].SqlState)
+	require.NotEqual(t, int32(-1), actions[0].Guard)
+	require.Equal(t, []string{"insert into constraint_test.t1 values (", ", ", ")"}, actions[1].SqlParts)
+	require.NotEqual(t, int32(-1), actions[1].Guard)
+
+	// the rows fire the triggers of the actions of their clauses
+	actions = collect("merge into constraint_test.t_trigger as t using constraint_test.t1 as s on t.a = s.a " +
+		"when matched and s.b = 'x' then delete when matched then update set b = s.b when not matched then insert values (s.a, s.b)")
+	require.Equal(t, 3, len(actions))
+	require.Equal(t, "45000", actions[0].SqlState)
+	require.Equal(t, []string{"insert into constraint_test.t1 values (", ", ", ")"}, actions[1].SqlParts)
+	require.Equal(t, []string{"delete from constraint_test.t1 where a = ", ""}, actions[2].SqlParts)
+	for _, action := range actions {
+		require.NotEqual(t, int32(-1), action.Guard)
+	}
+}
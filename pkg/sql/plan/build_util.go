@@ -26,6 +26,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/lock"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
@@ -41,6 +42,63 @@ import (
 // 	return nodeID
 // }
 
+// setLockTargetBySelectLock sets the lock mode and the wait policy of the
+// lock target by the FOR UPDATE/FOR SHARE clause of select.
+func setLockTargetBySelectLock(target *plan.LockTarget, info *tree.SelectLockInfo) {
+	if info == nil {
+		return
+	}
+	switch info.LockType {
+	case tree.SelectLockForShare:
+		target.Mode = lock.LockMode_Shared
+	case tree.SelectLockForUpdateNoWait:
+		target.WaitPolicy = lock.WaitPolicy_FastFail
+	case tree.SelectLockForShareNoWait:
+		target.Mode = lock.LockMode_Shared
+		target.WaitPolicy = lock.WaitPolicy_FastFail
+	case tree.SelectLockForUpdateWaitN:
+		// WAIT 0 is the same as NOWAIT
+		if info.WaitSec == 0 {
+			target.WaitPolicy = lock.WaitPolicy_FastFail
+		}
+		target.WaitSec = info.WaitSec
+	case tree.SelectLockForUpdateSkipLocked:
+		target.WaitPolicy = lock.WaitPolicy_FastFail
+		target.SkipLocked = true
+	case tree.SelectLockForShareSkipLocked:
+		target.Mode = lock.LockMode_Shared
+		target.WaitPolicy = lock.WaitPolicy_FastFail
+		target.SkipLocked = true
+	}
+}
+
+// getSkipLockedLimit returns limit + offset if both of them are constants
+func getSkipLockedLimit(limitExpr, offsetExpr *plan.Expr) (uint64, bool) {
+	getU64 := func(e *plan.Expr) (uint64, bool) {
+		if e == nil {
+			return 0, true
+		}
+		if lit, ok := e.Expr.(*plan.Expr_Lit); ok {
+			if v, ok := lit.Lit.Value.(*plan.Literal_U64Val); ok {
+				return v.U64Val, true
+			}
+		}
+		return 0, false
+	}
+	if limitExpr == nil {
+		return 0, false
+	}
+	limit, ok := getU64(limitExpr)
+	if !ok || limit == 0 {
+		return 0, false
+	}
+	offset, ok := getU64(offsetExpr)
+	if !ok || limit+offset < limit {
+		return 0, false
+	}
+	return limit + offset, true
+}
+
 // reCheckifNeedLockWholeTable checks if the whole table needs to be locked based on the last node's statistics.
 // It returns true if the out count of the last node is greater than the maximum lock count, otherwise it returns false.
 func reCheckifNeedLockWholeTable(builder *QueryBuilder) {
@@ -55,7 +113,8 @@ func reCheckifNeedLockWholeTable(builder *QueryBuilder) {
 		if n.NodeType != plan.Node_LOCK_OP {
 			continue
 		}
-		if !n.LockTargets[0].LockTable {
+		// upgrading to a table lock makes every row conflict in skip locked mode
+		if !n.LockTargets[0].LockTable && !n.LockTargets[0].SkipLocked {
 			reCheckIfNeed := n.Stats.Outcnt > float64(lockconfig.MaxLockRowCount)
 			if reCheckIfNeed {
 				logutil.Infof("Row lock upgraded to table lock for SQL : %s", builder.compCtx.GetRootSql())
@@ -462,6 +521,10 @@ func getFunctionObjRef(funcID int64, name string) *ObjectRef {
 // }
 
 func getDefaultExpr(ctx context.Context, d *plan.ColDef) (*Expr, error) {
+	if d.Generated != nil {
+		// the generated column is computed from the other columns later
+		return makeGeneratedColPlaceholder(d), nil
+	}
 	if !d.Default.NullAbility && d.Default.Expr == nil && !d.Typ.AutoIncr {
 		return nil, moerr.NewInvalidInputf(ctx, "invalid default value for column '%s'", d.Name)
 	}
@@ -0,0 +1,109 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+func NewCheckBinder(builder *QueryBuilder, ctx *BindContext, tableDef *TableDef, colName string) *CheckBinder {
+	c := &CheckBinder{tableDef: tableDef, colName: colName}
+	c.sysCtx = builder.GetContext()
+	c.builder = builder
+	c.ctx = ctx
+	c.impl = c
+	return c
+}
+
+// From: https://dev.mysql.com/doc/refman/8.0/en/create-table-check-constraints.html
+// nondeterministic functions are not permitted in a check constraint expression
+var unsupportedFunctionsInCheckExpr = map[string]int{
+	"connection_id":     0,
+	"current_date":      0,
+	"current_role":      0,
+	"current_time":      0,
+	"current_timestamp": 0,
+	"current_user":      0,
+	"curdate":           0,
+	"curtime":           0,
+	"database":          0,
+	"found_rows":        0,
+	"last_insert_id":    0,
+	"load_file":         0,
+	"localtime":         0,
+	"localtimestamp":    0,
+	"now":               0,
+	"rand":              0,
+	"row_count":         0,
+	"schema":            0,
+	"session_user":      0,
+	"sleep":             0,
+	"sysdate":           0,
+	"system_user":       0,
+	"unix_timestamp":    0,
+	"user":              0,
+	"utc_date":          0,
+	"utc_time":          0,
+	"utc_timestamp":     0,
+	"uuid":              0,
+	"uuid_short":        0,
+}
+
+func (c *CheckBinder) BindExpr(expr tree.Expr, i int32, b bool) (*plan.Expr, error) {
+	switch exprImpl := expr.(type) {
+	case *tree.FuncExpr:
+		if funcRef, ok := exprImpl.Func.FunctionReference.(*tree.UnresolvedName); ok {
+			if _, ok = unsupportedFunctionsInCheckExpr[funcRef.ColName()]; ok {
+				return nil, moerr.NewInvalidInputf(c.GetContext(), "function %s is not allowed in the check constraint expression", funcRef.ColNameOrigin())
+			}
+		}
+	case *tree.VarExpr:
+		return nil, moerr.NewInvalidInput(c.GetContext(), "variables are not allowed in the check constraint expression")
+	case *tree.ParamExpr:
+		return nil, moerr.NewInvalidInput(c.GetContext(), "parameters are not allowed in the check constraint expression")
+	}
+	return c.baseBindExpr(expr, i, b)
+}
+
+func (c *CheckBinder) BindColRef(name *tree.UnresolvedName, i int32, b bool) (*plan.Expr, error) {
+	colName := name.ColName()
+	if c.colName != "" && colName != c.colName {
+		return nil, moerr.NewInvalidInputf(c.GetContext(), "column check constraint references other column '%s'", name.ColNameOrigin())
+	}
+	for _, col := range c.tableDef.Cols {
+		if col.Name == colName && col.Typ.AutoIncr {
+			return nil, moerr.NewInvalidInputf(c.GetContext(), "check constraint cannot refer to an auto-increment column '%s'", name.ColNameOrigin())
+		}
+	}
+	return c.baseBindColRef(name, i, b)
+}
+
+func (c *CheckBinder) BindAggFunc(s string, expr *tree.FuncExpr, i int32, b bool) (*plan.Expr, error) {
+	return nil, moerr.NewSyntaxError(c.GetContext(), "aggregate functions not allowed in check constraint")
+}
+
+func (c *CheckBinder) BindWinFunc(s string, expr *tree.FuncExpr, i int32, b bool) (*plan.Expr, error) {
+	return nil, moerr.NewSyntaxError(c.GetContext(), "window functions not allowed in check constraint")
+}
+
+func (c *CheckBinder) BindSubquery(subquery *tree.Subquery, b bool) (*plan.Expr, error) {
+	return nil, moerr.NewSyntaxError(c.GetContext(), "subquery not allowed in check constraint")
+}
+
+func (c *CheckBinder) BindTimeWindowFunc(funcName string, astExpr *tree.FuncExpr, depth int32, isRoot bool) (*plan.Expr, error) {
+	return nil, moerr.NewInvalidInputf(c.GetContext(), "cannot bind time window functions '%s'", funcName)
+}
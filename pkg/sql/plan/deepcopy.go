@@ -73,6 +73,12 @@ func DeepCopyUpdateCtxList(updateCtxList []*plan.UpdateCtx) []*plan.UpdateCtx {
 			InsertCols:          slices.Clone(ctx.InsertCols),
 			DeleteCols:          slices.Clone(ctx.DeleteCols),
 		}
+		if ctx.InsertFilterCol != nil {
+			result[i].InsertFilterCol = &plan.ColRef{
+				RelPos: ctx.InsertFilterCol.RelPos,
+				ColPos: ctx.InsertFilterCol.ColPos,
+			}
+		}
 	}
 
 	return result
@@ -184,8 +190,13 @@ func DeepCopyLockTarget(target *plan.LockTarget) *plan.LockTarget {
 		FilterColIdxInBat:  target.FilterColIdxInBat,
 		LockTable:          target.LockTable,
 		Block:              target.Block,
+		Mode:               target.Mode,
 		LockRows:           DeepCopyExpr(target.LockRows),
 		LockTableAtTheEnd:  target.LockTableAtTheEnd,
+		WaitPolicy:         target.WaitPolicy,
+		WaitSec:            target.WaitSec,
+		SkipLocked:         target.SkipLocked,
+		SkipLockedLimit:    target.SkipLockedLimit,
 	}
 }
 
@@ -356,6 +367,7 @@ func DeepCopyColDef(col *plan.ColDef) *plan.ColDef {
 		Seqnum:     col.Seqnum,
 		TblName:    col.TblName,
 		DbName:     col.DbName,
+		Generated:  DeepCopyGeneratedCol(col.Generated),
 	}
 }
 
@@ -416,6 +428,36 @@ func DeepCopyOnUpdate(old *plan.OnUpdate) *plan.OnUpdate {
 	}
 }
 
+func DeepCopyGeneratedCol(old *plan.GeneratedCol) *plan.GeneratedCol {
+	if old == nil {
+		return nil
+	}
+	return &plan.GeneratedCol{
+		Expr:         DeepCopyExpr(old.Expr),
+		OriginString: old.OriginString,
+		Stored:       old.Stored,
+		ColName:      old.ColName,
+	}
+}
+
+func DeepCopyMaterializedViewDef(old *plan.MaterializedViewDef) *plan.MaterializedViewDef {
+	if old == nil {
+		return nil
+	}
+	view := &plan.MaterializedViewDef{
+		Database:    old.Database,
+		Name:        old.Name,
+		Query:       old.Query,
+		KeyCols:     slices.Clone(old.KeyCols),
+		ViewKeyCols: slices.Clone(old.ViewKeyCols),
+		BaseTables:  make([]*plan.ObjectRef, len(old.BaseTables)),
+	}
+	for i, ref := range old.BaseTables {
+		view.BaseTables[i] = DeepCopyObjectRef(ref)
+	}
+	return view
+}
+
 func DeepCopyTableDefList(src []*plan.TableDef) []*plan.TableDef {
 	if src == nil {
 		return nil
@@ -475,6 +517,9 @@ func DeepCopyTableDef(table *plan.TableDef, withCols bool) *plan.TableDef {
 		Fkeys:          make([]*plan.ForeignKeyDef, len(table.Fkeys)),
 		RefChildTbls:   slices.Clone(table.RefChildTbls),
 		Checks:         make([]*plan.CheckDef, len(table.Checks)),
+		Triggers:       make([]*plan.TriggerDef, len(table.Triggers)),
+		Mview:          DeepCopyMaterializedViewDef(table.Mview),
+		RefMviews:      make([]*plan.MaterializedViewDef, len(table.RefMviews)),
 		Props:          make([]*plan.PropertyDef, len(table.Props)),
 		Defs:           make([]*plan.TableDef_DefType, len(table.Defs)),
 		Name2ColIndex:  table.Name2ColIndex,
@@ -499,11 +544,27 @@ func DeepCopyTableDef(table *plan.TableDef, withCols bool) *plan.TableDef {
 
 	for idx, col := range table.Checks {
 		newTable.Checks[idx] = &plan.CheckDef{
-			Name:  col.Name,
-			Check: DeepCopyExpr(col.Check),
+			Name:     col.Name,
+			Check:    DeepCopyExpr(col.Check),
+			ExprStr:  col.ExprStr,
+			Enforced: col.Enforced,
 		}
 	}
 
+	for idx, trigger := range table.Triggers {
+		newTable.Triggers[idx] = &plan.TriggerDef{
+			Name:      trigger.Name,
+			Timing:    trigger.Timing,
+			Event:     trigger.Event,
+			CreateSql: trigger.CreateSql,
+			Definer:   trigger.Definer,
+		}
+	}
+
+	for idx, view := range table.RefMviews {
+		newTable.RefMviews[idx] = DeepCopyMaterializedViewDef(view)
+	}
+
 	for idx, prop := range table.Props {
 		newTable.Props[idx] = &plan.PropertyDef{
 			Key:   prop.Key,
@@ -668,6 +729,10 @@ func DeepCopyDataDefinition(old *plan.DataDefinition) *plan.DataDefinition {
 			FkCols:          make([]*plan.FkColName, len(df.CreateTable.FkCols)),
 			PartitionTables: DeepCopyTableDefList(df.CreateTable.GetPartitionTables()),
 		}
+		if df.CreateTable.Ttl != nil {
+			ttl := *df.CreateTable.Ttl
+			CreateTable.Ttl = &ttl
+		}
 		for i, val := range df.CreateTable.FkCols {
 			CreateTable.FkCols[i] = &plan.FkColName{Cols: slices.Clone(val.Cols)}
 		}
@@ -709,6 +774,17 @@ func DeepCopyDataDefinition(old *plan.DataDefinition) *plan.DataDefinition {
 				AlterTable.Actions[i] = &plan.AlterTable_Action{
 					Action: AddFk,
 				}
+			case *plan.AlterTable_Action_AlterTtl:
+				AlterTtl := &plan.AlterTableTTL{}
+				if act.AlterTtl.Ttl != nil {
+					ttl := *act.AlterTtl.Ttl
+					AlterTtl.Ttl = &ttl
+				}
+				AlterTable.Actions[i] = &plan.AlterTable_Action{
+					Action: &plan.AlterTable_Action_AlterTtl{
+						AlterTtl: AlterTtl,
+					},
+				}
 			}
 		}
 
@@ -952,6 +1028,8 @@ func DeepCopyExpr(expr *Expr) *Expr {
 				PartitionBy: DeepCopyExprList(item.W.PartitionBy),
 				OrderBy:     os,
 				Name:        item.W.Name,
+				IgnoreNulls: item.W.IgnoreNulls,
+				FromLast:    item.W.FromLast,
 				Frame: &plan.FrameClause{
 					Type: f.Type,
 					Start: &plan.FrameBound{
@@ -37,6 +37,8 @@ type DMLContext struct {
 	//nameToIdx      map[string]int         // Mapping of table full path name to tableDefs index，such as： 'tpch.nation -> 0'
 	//idToName       map[uint64]string      // Mapping of tableId to full path name of table
 	aliasMap map[string]int // Mapping of table aliases to tableDefs array index,If there is no alias, replace it with the original name of the table
+
+	bindsTriggers bool // the triggers of the tables are fired by the plan of the statement, the other statements fall back to the old planner
 }
 
 func NewDMLContext() *DMLContext {
@@ -123,6 +125,9 @@ func (dmlCtx *DMLContext) ResolveUpdateTables(ctx CompilerContext, stmt *tree.Up
 	dmlCtx.updateCol2Expr = make([]map[string]tree.Expr, len(dmlCtx.tableDefs))
 	for alias, columnMap := range usedTbl {
 		idx := dmlCtx.aliasMap[alias]
+		if err := addGeneratedColsToUpdate(ctx.GetContext(), dmlCtx.tableDefs[idx], columnMap); err != nil {
+			return err
+		}
 		dmlCtx.updateCol2Expr[idx] = columnMap
 	}
 
@@ -230,6 +235,14 @@ func (dmlCtx *DMLContext) ResolveSingleTable(ctx CompilerContext, tbl tree.Table
 		return moerr.NewUnsupportedDML(ctx.GetContext(), "foreign key constraint")
 	}
 
+	if len(tableDef.Triggers) > 0 && !dmlCtx.bindsTriggers {
+		return moerr.NewUnsupportedDML(ctx.GetContext(), "trigger")
+	}
+
+	if len(tableDef.RefMviews) > 0 {
+		return moerr.NewUnsupportedDML(ctx.GetContext(), "materialized view")
+	}
+
 	for _, col := range tableDef.Cols {
 		if types.T(col.Typ.Id).IsArrayRelate() {
 			return moerr.NewUnsupportedDML(ctx.GetContext(), "vector column")
@@ -570,6 +570,10 @@ func (ndesc *NodeDescribeImpl) GetUpdateCtxInfo(ctx context.Context, options *Ex
 					describeColRef(&updateCtx.DeleteCols[i], buf)
 				}
 			}
+			if updateCtx.InsertFilterCol != nil {
+				buf.WriteString(" Insert Filter: ")
+				describeColRef(updateCtx.InsertFilterCol, buf)
+			}
 			lines = append(lines, buf.String())
 		}
 	} else if options.Format == EXPLAIN_FORMAT_JSON {
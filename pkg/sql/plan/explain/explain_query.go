@@ -22,6 +22,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/lock"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/models"
 	"github.com/matrixorigin/matrixone/pkg/util/trace/impl/motrace/statistic"
@@ -271,11 +272,22 @@ func explainStep(ctx context.Context, step *plan.Node, nodes []*plan.Node, setti
 				if nodedescImpl.Node.LockTargets != nil {
 					buf := bytes.NewBuffer(make([]byte, 0, 360))
 					buf.WriteString("Lock level: ")
-					if nodedescImpl.Node.LockTargets[0].LockTable {
+					target := nodedescImpl.Node.LockTargets[0]
+					if target.LockTable {
 						buf.WriteString("Table level lock")
 					} else {
 						buf.WriteString("Row level lock")
 					}
+					if target.Mode == lock.LockMode_Shared {
+						buf.WriteString(", Shared")
+					}
+					if target.SkipLocked {
+						buf.WriteString(", Skip locked")
+					} else if target.WaitPolicy == lock.WaitPolicy_FastFail {
+						buf.WriteString(", No wait")
+					} else if target.WaitSec > 0 {
+						fmt.Fprintf(buf, ", Wait %d seconds", target.WaitSec)
+					}
 					settings.buffer.PushNewLine(buf.String(), false, settings.level)
 				}
 			}
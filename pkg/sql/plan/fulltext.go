@@ -72,11 +72,12 @@ var (
 	}
 )
 
-// arg list [source_table_name, index_table_name, search_against, mode]
+// arg list [source_table_name, index_table_name, search_against, mode, parser_params]
+// parser_params is optional and it is the IndexAlgoParams of the index.
 func (builder *QueryBuilder) buildFullTextIndexScan(tbl *tree.TableFunction, ctx *BindContext, exprs []*plan.Expr, childId int32) (int32, error) {
 
-	if len(exprs) != 4 {
-		return 0, moerr.NewInvalidInput(builder.GetContext(), "Invalid number of arguments (NARGS != 4).")
+	if len(exprs) != 4 && len(exprs) != 5 {
+		return 0, moerr.NewInvalidInput(builder.GetContext(), "Invalid number of arguments (NARGS != 4 or 5).")
 	}
 
 	colDefs := _getColDefs(ftIndexColdefs)
@@ -28,6 +28,14 @@ func RegisterGroupConcat(id int64) {
 	aggexec.RegisterGroupConcatAgg(id, ",")
 }
 
+func RegisterJsonArrayAgg(id int64) {
+	aggexec.RegisterJsonArrayAgg(id)
+}
+
+func RegisterJsonObjectAgg(id int64) {
+	aggexec.RegisterJsonObjectAgg(id)
+}
+
 func RegisterApproxCount(id int64) {
 	aggexec.RegisterApproxCountAgg(id)
 }
@@ -0,0 +1,253 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ctl
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"go.uber.org/zap"
+)
+
+// maxDroppedObjects is the max number of objects dropped in one commit
+const maxDroppedObjects = 1000
+
+type expiredArguments struct {
+	tblId     uint64
+	accountId uint64
+	cutoff    time.Time
+	column    string
+}
+
+// Args:
+//
+// "tableID[.accountID]:cutoff:column"
+//
+// cutoff: the unix seconds, the rows whose column is earlier than it are expired
+// Example: "272510.1:1719849600:created_at"
+func parseArgsForExpiredObjects(arg string) (expiredArguments, error) {
+	args := strings.SplitN(arg, ":", 3)
+	if len(args) != 3 {
+		return expiredArguments{}, moerr.NewInvalidArgNoCtx("dropexpiredobjects", arg)
+	}
+
+	a := expiredArguments{
+		accountId: math.MaxUint64,
+		column:    args[2],
+	}
+	tblAccount := strings.Split(args[0], ".")
+	if len(tblAccount) > 2 {
+		return expiredArguments{}, moerr.NewInvalidArgNoCtx("tableID.accountID", args[0])
+	}
+	tblId, err := strconv.ParseUint(tblAccount[0], 10, 64)
+	if err != nil {
+		return expiredArguments{}, moerr.NewInvalidArgNoCtx("tableID", args[0])
+	}
+	a.tblId = tblId
+	if len(tblAccount) == 2 {
+		accID, err := strconv.ParseUint(tblAccount[1], 10, 32)
+		if err != nil {
+			return expiredArguments{}, moerr.NewInvalidArgNoCtx("accountID", args[0])
+		}
+		a.accountId = accID
+	}
+	cutoff, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil {
+		return expiredArguments{}, moerr.NewInvalidArgNoCtx("cutoff", args[1])
+	}
+	a.cutoff = time.Unix(cutoff, 0)
+	if a.column == "" {
+		return expiredArguments{}, moerr.NewInvalidArgNoCtx("column", arg)
+	}
+	return a, nil
+}
+
+// encodeExpiredCutoff encodes the cutoff in the type of the zonemap. The DATE and DATETIME
+// values are the wall clock of the time zone they were written in, so their cutoff is moved
+// one day earlier to never drop a row which is not expired yet.
+func encodeExpiredCutoff(t types.T, cutoff time.Time) ([]byte, bool) {
+	switch t {
+	case types.T_timestamp:
+		v := types.UnixMicroToTimestamp(cutoff.UnixMicro())
+		return types.EncodeTimestamp(&v), true
+	case types.T_datetime:
+		c := cutoff.AddDate(0, 0, -1)
+		v := types.DatetimeFromClock(int32(c.Year()), uint8(c.Month()), uint8(c.Day()),
+			uint8(c.Hour()), uint8(c.Minute()), uint8(c.Second()), 0)
+		return types.EncodeDatetime(&v), true
+	case types.T_date:
+		c := cutoff.AddDate(0, 0, -1)
+		v := types.DateFromCalendar(int32(c.Year()), uint8(c.Month()), uint8(c.Day()))
+		return types.EncodeDate(&v), true
+	}
+	return nil, false
+}
+
+// isObjectExpired returns true if all the rows of the object are expired, i.e. the column
+// has no null and its max value is earlier than the cutoff.
+func isObjectExpired(zm index.ZM, nullCnt uint32, cutoff time.Time) bool {
+	if !zm.IsInited() || nullCnt != 0 {
+		return false
+	}
+	k, ok := encodeExpiredCutoff(zm.GetType(), cutoff)
+	if !ok {
+		return false
+	}
+	return !zm.AnyGEByValue(k)
+}
+
+// expiredObjects returns the objects whose rows are all expired according to the zonemap of
+// the column in the object meta.
+func expiredObjects(
+	ctx context.Context,
+	fs fileservice.FileService,
+	stats []objectio.ObjectStats,
+	seqnum uint16,
+	cutoff time.Time,
+) ([]objectio.ObjectStats, error) {
+	var expired []objectio.ObjectStats
+	for _, stat := range stats {
+		loc := stat.ObjectLocation()
+		meta, err := objectio.FastLoadObjectMeta(ctx, &loc, false, fs)
+		if err != nil {
+			return nil, err
+		}
+		col := meta.MustDataMeta().MustGetColumn(seqnum)
+		if isObjectExpired(col.ZoneMap(), col.NullCnt(), cutoff) {
+			expired = append(expired, stat)
+		}
+	}
+	return expired, nil
+}
+
+// hasExpiredObjectsDependents returns true if the rows of the table are kept in or referred by
+// other tables or statements: the index tables, the child tables of the foreign keys, the
+// triggers and the materialized views. Dropping the objects of such a table would leave them
+// stale, so its expired rows are only deleted by the caller.
+func hasExpiredObjectsDependents(def *plan.TableDef) bool {
+	for _, idx := range def.Indexes {
+		if idx.TableExist {
+			return true
+		}
+	}
+	return len(def.RefChildTbls) > 0 || len(def.Triggers) > 0 || len(def.RefMviews) > 0
+}
+
+// handleDropExpiredObjects drops the objects of the table whose rows are all expired by the
+// TTL without rewriting them, the remaining expired rows are deleted by the caller.
+func handleDropExpiredObjects(
+	proc *process.Process,
+	service serviceType,
+	parameter string,
+	_ requestSender,
+) (Result, error) {
+	if service != cn {
+		return Result{}, moerr.NewWrongServiceNoCtx("CN", string(service))
+	}
+	txnOp := proc.GetTxnOperator()
+	if txnOp == nil {
+		return Result{}, moerr.NewInternalError(proc.Ctx, "handleDropExpiredObjects: txn operator is nil")
+	}
+	a, err := parseArgsForExpiredObjects(parameter)
+	if err != nil {
+		return Result{}, err
+	}
+
+	ctx := proc.Ctx
+	if a.accountId != math.MaxUint64 {
+		ctx = context.WithValue(proc.Ctx, defines.TenantIDKey{}, uint32(a.accountId))
+	}
+	_, _, rel, err := proc.GetSessionInfo().StorageEngine.GetRelationById(ctx, txnOp, a.tblId)
+	if err != nil {
+		return Result{}, err
+	}
+	def := rel.GetTableDef(ctx)
+	if hasExpiredObjectsDependents(def) {
+		return Result{
+			Method: DropExpiredObjects,
+			Data:   "skipped, the table has dependents",
+		}, nil
+	}
+	seqnum := -1
+	for _, col := range def.Cols {
+		if col.Name == a.column {
+			seqnum = int(col.Seqnum)
+			break
+		}
+	}
+	if seqnum < 0 {
+		return Result{}, moerr.NewBadFieldError(ctx, a.column, def.Name)
+	}
+
+	stats, err := rel.GetNonAppendableObjectStats(ctx)
+	if err != nil {
+		return Result{}, err
+	}
+	expired, err := expiredObjects(ctx, proc.GetFileService(), stats, uint16(seqnum), a.cutoff)
+	if err != nil {
+		return Result{}, err
+	}
+
+	target := getTNShard(proc.GetService())
+	rows := 0
+	for start := 0; start < len(expired); start += maxDroppedObjects {
+		end := min(start+maxDroppedObjects, len(expired))
+		entry := &api.MergeCommitEntry{
+			DbId:      rel.GetDBID(ctx),
+			TblId:     rel.GetTableID(ctx),
+			TableName: rel.GetTableName(),
+			StartTs:   txnOp.SnapshotTS(),
+		}
+		for _, stat := range expired[start:end] {
+			entry.MergedObjs = append(entry.MergedObjs, stat.Clone().Marshal())
+			rows += int(stat.Rows())
+		}
+		payload, err := entry.MarshalBinary()
+		if err != nil {
+			return Result{}, err
+		}
+		resp, err := txnWrite(ctx, target, txnOp, payload)
+		if err != nil {
+			return Result{}, err
+		}
+		resp.Release()
+	}
+
+	if len(expired) > 0 {
+		logutil.Info("[TTL] drop expired objects",
+			zap.String("table", rel.GetTableName()),
+			zap.Int("objects", len(expired)),
+			zap.Int("rows", rows),
+		)
+	}
+	return Result{
+		Method: DropExpiredObjects,
+		Data:   fmt.Sprintf("dropped %d objects, %d rows", len(expired), rows),
+	}, nil
+}
@@ -0,0 +1,108 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ctl
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseArgsForExpiredObjects(t *testing.T) {
+	a, err := parseArgsForExpiredObjects("272510.1:1719849600:created_at")
+	require.NoError(t, err)
+	require.Equal(t, expiredArguments{
+		tblId:     272510,
+		accountId: 1,
+		cutoff:    time.Unix(1719849600, 0),
+		column:    "created_at",
+	}, a)
+
+	a, err = parseArgsForExpiredObjects("272510:1719849600:created_at")
+	require.NoError(t, err)
+	require.Equal(t, uint64(math.MaxUint64), a.accountId)
+
+	for _, s := range []string{
+		"272510:1719849600",
+		"272510:1719849600:",
+		"t1:1719849600:created_at",
+		"272510.1.2:1719849600:created_at",
+		"272510:yesterday:created_at",
+	} {
+		_, err = parseArgsForExpiredObjects(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestIsObjectExpired(t *testing.T) {
+	cutoff := time.Date(2024, 7, 1, 12, 0, 0, 0, time.Local)
+
+	timestampZM := func(min, max time.Time) index.ZM {
+		zm := index.NewZM(types.T_timestamp, 0)
+		index.UpdateZMAny(zm, types.UnixMicroToTimestamp(min.UnixMicro()))
+		index.UpdateZMAny(zm, types.UnixMicroToTimestamp(max.UnixMicro()))
+		return zm
+	}
+	require.True(t, isObjectExpired(timestampZM(cutoff.Add(-time.Hour*48), cutoff.Add(-time.Second)), 0, cutoff))
+	require.False(t, isObjectExpired(timestampZM(cutoff.Add(-time.Hour*48), cutoff), 0, cutoff))
+	require.False(t, isObjectExpired(timestampZM(cutoff.Add(-time.Hour*48), cutoff.Add(-time.Second)), 1, cutoff))
+
+	datetimeZM := func(max time.Time) index.ZM {
+		zm := index.NewZM(types.T_datetime, 0)
+		index.UpdateZMAny(zm, types.DatetimeFromClock(int32(max.Year()), uint8(max.Month()), uint8(max.Day()),
+			uint8(max.Hour()), uint8(max.Minute()), uint8(max.Second()), 0))
+		return zm
+	}
+	// the DATETIME and DATE cutoff is one day earlier
+	require.True(t, isObjectExpired(datetimeZM(cutoff.Add(-time.Hour*25)), 0, cutoff))
+	require.False(t, isObjectExpired(datetimeZM(cutoff.Add(-time.Hour*23)), 0, cutoff))
+
+	dateZM := func(max time.Time) index.ZM {
+		zm := index.NewZM(types.T_date, 0)
+		index.UpdateZMAny(zm, types.DateFromCalendar(int32(max.Year()), uint8(max.Month()), uint8(max.Day())))
+		return zm
+	}
+	require.True(t, isObjectExpired(dateZM(cutoff.AddDate(0, 0, -2)), 0, cutoff))
+	require.False(t, isObjectExpired(dateZM(cutoff.AddDate(0, 0, -1)), 0, cutoff))
+
+	// the zonemap of the column which is not in the object is not inited
+	require.False(t, isObjectExpired(index.NewZM(types.T_timestamp, 0), 0, cutoff))
+	// the column is not a time column
+	intZM := index.NewZM(types.T_int64, 0)
+	index.UpdateZMAny(intZM, int64(1))
+	require.False(t, isObjectExpired(intZM, 0, cutoff))
+}
+
+func TestHasExpiredObjectsDependents(t *testing.T) {
+	require.False(t, hasExpiredObjectsDependents(&plan.TableDef{}))
+	// the index whose table is not created yet
+	require.False(t, hasExpiredObjectsDependents(&plan.TableDef{
+		Indexes: []*plan.IndexDef{{IndexName: "idx", TableExist: false}},
+	}))
+
+	for _, def := range []*plan.TableDef{
+		{Indexes: []*plan.IndexDef{{IndexName: "idx", TableExist: true}}},
+		{RefChildTbls: []uint64{272511}},
+		{Triggers: []*plan.TriggerDef{{}}},
+		{RefMviews: []*plan.MaterializedViewDef{{}}},
+	} {
+		require.True(t, hasExpiredObjectsDependents(def))
+	}
+}
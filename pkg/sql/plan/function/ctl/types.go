@@ -57,6 +57,7 @@ var (
 	CtlReaderMethod          = strings.ToUpper("reader")
 	GetTableShards           = strings.ToUpper("get-table-shards")
 	MoTableStats             = strings.ToUpper("MoTableStats")
+	DropExpiredObjects       = strings.ToUpper("drop-expired-objects")
 )
 
 var (
@@ -98,6 +99,7 @@ var (
 		CtlReaderMethod:          handleCtlReader,
 		GetTableShards:           handleGetTableShards,
 		MoTableStats:             handleMoTableStats,
+		DropExpiredObjects:       handleDropExpiredObjects,
 	}
 )
 
@@ -15,7 +15,9 @@
 package function
 
 import (
+	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
@@ -592,3 +594,816 @@ func (op *opBuiltInJsonSet) buildJsonFunction(parameters []*vector.Vector, resul
 	}
 	return nil
 }
+
+// jsonDocCheckType returns the type a json document argument is cast to, json and strings are kept as they are.
+func jsonDocCheckType(input types.Type) (types.Type, bool) {
+	if input.Oid == types.T_json || input.Oid.IsMySQLString() {
+		return input, true
+	}
+	if canCast, _ := fixedImplicitTypeCast(input, types.T_varchar); canCast {
+		return types.T_varchar.ToType(), true
+	}
+	return input, false
+}
+
+// jsonValueCheckType returns the type a value argument is cast to before it is converted to a json scalar.
+func jsonValueCheckType(input types.Type) (types.Type, bool) {
+	switch input.Oid {
+	case types.T_json, types.T_bool, types.T_int64, types.T_uint64, types.T_float64:
+		return input, true
+	case types.T_int8, types.T_int16, types.T_int32:
+		return types.T_int64.ToType(), true
+	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_bit:
+		return types.T_uint64.ToType(), true
+	case types.T_float32, types.T_decimal64, types.T_decimal128:
+		return types.T_float64.ToType(), true
+	}
+	if input.Oid.IsMySQLString() {
+		return input, true
+	}
+	if canCast, _ := fixedImplicitTypeCast(input, types.T_varchar); canCast {
+		return types.T_varchar.ToType(), true
+	}
+	return input, false
+}
+
+func jsonSearchCheckResult(inputs []types.Type, checkTypes ...func(types.Type) (types.Type, bool)) checkResult {
+	ts := make([]types.Type, len(inputs))
+	allMatch := true
+	for i, input := range inputs {
+		t, ok := checkTypes[i](input)
+		if !ok {
+			return newCheckResultWithFailure(failedFunctionParametersWrong)
+		}
+		if t.Oid != input.Oid {
+			allMatch = false
+		}
+		ts[i] = t
+	}
+	if allMatch {
+		return newCheckResultWithSuccess(0)
+	}
+	return newCheckResultWithCast(0, ts)
+}
+
+// MEMBER OF
+func jsonMemberOfCheckFn(overloads []overload, inputs []types.Type) checkResult {
+	if len(inputs) != 2 {
+		return newCheckResultWithFailure(failedFunctionParametersWrong)
+	}
+	return jsonSearchCheckResult(inputs, jsonValueCheckType, jsonDocCheckType)
+}
+
+// JSON_CONTAINS
+func jsonContainsCheckFn(overloads []overload, inputs []types.Type) checkResult {
+	if len(inputs) != 2 && len(inputs) != 3 {
+		return newCheckResultWithFailure(failedFunctionParametersWrong)
+	}
+	return jsonSearchCheckResult(inputs, jsonDocCheckType, jsonDocCheckType, jsonDocCheckType)
+}
+
+// JSON_OVERLAPS
+func jsonOverlapsCheckFn(overloads []overload, inputs []types.Type) checkResult {
+	if len(inputs) != 2 {
+		return newCheckResultWithFailure(failedFunctionParametersWrong)
+	}
+	return jsonSearchCheckResult(inputs, jsonDocCheckType, jsonDocCheckType)
+}
+
+type jsonGetter func(i uint64) (bytejson.ByteJson, bool, error)
+
+// newJsonDocGetter reads a json document argument, strings are parsed as json text.
+func newJsonDocGetter(vec *vector.Vector) jsonGetter {
+	w := vector.GenerateFunctionStrParameter(vec)
+	if vec.GetType().Oid == types.T_json {
+		return func(i uint64) (bytejson.ByteJson, bool, error) {
+			v, null := w.GetStrValue(i)
+			if null {
+				return bytejson.Null, true, nil
+			}
+			return types.DecodeJson(v), false, nil
+		}
+	}
+	return func(i uint64) (bytejson.ByteJson, bool, error) {
+		v, null := w.GetStrValue(i)
+		if null {
+			return bytejson.Null, true, nil
+		}
+		bj, err := types.ParseSliceToByteJson(v)
+		return bj, false, err
+	}
+}
+
+func newJsonFixedValueGetter[T bool | int64 | uint64 | float64](vec *vector.Vector) jsonGetter {
+	w := vector.GenerateFunctionFixedTypeParameter[T](vec)
+	return func(i uint64) (bytejson.ByteJson, bool, error) {
+		v, null := w.GetValue(i)
+		if null {
+			return bytejson.Null, true, nil
+		}
+		bj, err := bytejson.CreateByteJSON(v)
+		return bj, false, err
+	}
+}
+
+// newJsonValueGetter converts a value argument to a json scalar, strings become json strings.
+func newJsonValueGetter(vec *vector.Vector) jsonGetter {
+	switch vec.GetType().Oid {
+	case types.T_json:
+		return newJsonDocGetter(vec)
+	case types.T_bool:
+		return newJsonFixedValueGetter[bool](vec)
+	case types.T_int64:
+		return newJsonFixedValueGetter[int64](vec)
+	case types.T_uint64:
+		return newJsonFixedValueGetter[uint64](vec)
+	case types.T_float64:
+		return newJsonFixedValueGetter[float64](vec)
+	}
+	w := vector.GenerateFunctionStrParameter(vec)
+	return func(i uint64) (bytejson.ByteJson, bool, error) {
+		v, null := w.GetStrValue(i)
+		if null {
+			return bytejson.Null, true, nil
+		}
+		bj, err := bytejson.CreateByteJSON(string(v))
+		return bj, false, err
+	}
+}
+
+func jsonSearch(result vector.FunctionResultWrapper, length int, getters []jsonGetter, fn func([]bytejson.ByteJson) (bool, bool, error)) error {
+	rs := vector.MustFunctionResult[bool](result)
+	args := make([]bytejson.ByteJson, len(getters))
+	for i := uint64(0); i < uint64(length); i++ {
+		null := false
+		for j, getter := range getters {
+			bj, isNull, err := getter(i)
+			if err != nil {
+				return err
+			}
+			if isNull {
+				null = true
+				break
+			}
+			args[j] = bj
+		}
+		if null {
+			if err := rs.Append(false, true); err != nil {
+				return err
+			}
+			continue
+		}
+		res, isNull, err := fn(args)
+		if err != nil {
+			return err
+		}
+		if err = rs.Append(res, isNull); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// JsonMemberOf implements `value MEMBER OF (json_array)`.
+func JsonMemberOf(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	getters := []jsonGetter{newJsonValueGetter(parameters[0]), newJsonDocGetter(parameters[1])}
+	return jsonSearch(result, length, getters, func(args []bytejson.ByteJson) (bool, bool, error) {
+		return args[1].MemberOf(args[0]), false, nil
+	})
+}
+
+// JsonContains implements JSON_CONTAINS(target, candidate[, path]).
+func JsonContains(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	getters := []jsonGetter{newJsonDocGetter(parameters[0]), newJsonDocGetter(parameters[1])}
+	if len(parameters) == 2 {
+		return jsonSearch(result, length, getters, func(args []bytejson.ByteJson) (bool, bool, error) {
+			return args[0].Contains(args[1]), false, nil
+		})
+	}
+
+	pathWrapper := vector.GenerateFunctionStrParameter(parameters[2])
+	rs := vector.MustFunctionResult[bool](result)
+	for i := uint64(0); i < uint64(length); i++ {
+		pathBytes, pIsNull := pathWrapper.GetStrValue(i)
+		target, tIsNull, err := getters[0](i)
+		if err != nil {
+			return err
+		}
+		candidate, cIsNull, err := getters[1](i)
+		if err != nil {
+			return err
+		}
+		if pIsNull || tIsNull || cIsNull {
+			if err = rs.Append(false, true); err != nil {
+				return err
+			}
+			continue
+		}
+		path, err := types.ParseStringToPath(string(pathBytes))
+		if err != nil {
+			return err
+		}
+		values := target.QueryValues(&path)
+		if len(values) == 0 {
+			if err = rs.Append(false, true); err != nil {
+				return err
+			}
+			continue
+		}
+		if err = rs.Append(values[0].Contains(candidate), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// JsonOverlaps implements JSON_OVERLAPS(json_doc1, json_doc2).
+func JsonOverlaps(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	getters := []jsonGetter{newJsonDocGetter(parameters[0]), newJsonDocGetter(parameters[1])}
+	return jsonSearch(result, length, getters, func(args []bytejson.ByteJson) (bool, bool, error) {
+		return args[0].Overlaps(args[1]), false, nil
+	})
+}
+
+// jsonPathCheckType returns the type a json path argument or other string argument is cast to.
+func jsonPathCheckType(input types.Type) (types.Type, bool) {
+	if input.Oid.IsMySQLString() {
+		return input, true
+	}
+	if canCast, _ := fixedImplicitTypeCast(input, types.T_varchar); canCast {
+		return types.T_varchar.ToType(), true
+	}
+	return input, false
+}
+
+// jsonVariadicCheckResult checks the leading arguments by checkTypes and the rest by restType.
+func jsonVariadicCheckResult(inputs []types.Type, restType func(types.Type) (types.Type, bool), checkTypes ...func(types.Type) (types.Type, bool)) checkResult {
+	fns := make([]func(types.Type) (types.Type, bool), len(inputs))
+	for i := range inputs {
+		if i < len(checkTypes) {
+			fns[i] = checkTypes[i]
+		} else {
+			fns[i] = restType
+		}
+	}
+	return jsonSearchCheckResult(inputs, fns...)
+}
+
+// JSON_ARRAY
+func jsonArrayCheckFn(overloads []overload, inputs []types.Type) checkResult {
+	return jsonVariadicCheckResult(inputs, jsonValueCheckType)
+}
+
+// JSON_OBJECT
+func jsonObjectCheckFn(overloads []overload, inputs []types.Type) checkResult {
+	if len(inputs)%2 != 0 {
+		return newCheckResultWithFailure(failedFunctionParametersWrong)
+	}
+	fns := make([]func(types.Type) (types.Type, bool), len(inputs))
+	for i := range inputs {
+		if i%2 == 0 {
+			fns[i] = jsonPathCheckType
+		} else {
+			fns[i] = jsonValueCheckType
+		}
+	}
+	return jsonSearchCheckResult(inputs, fns...)
+}
+
+// JSON_CONTAINS_PATH
+func jsonContainsPathCheckFn(overloads []overload, inputs []types.Type) checkResult {
+	if len(inputs) < 3 {
+		return newCheckResultWithFailure(failedFunctionParametersWrong)
+	}
+	return jsonVariadicCheckResult(inputs, jsonPathCheckType, jsonDocCheckType)
+}
+
+// JSON_KEYS and JSON_LENGTH
+func jsonDocPathCheckFn(overloads []overload, inputs []types.Type) checkResult {
+	if len(inputs) != 1 && len(inputs) != 2 {
+		return newCheckResultWithFailure(failedFunctionParametersWrong)
+	}
+	return jsonSearchCheckResult(inputs, jsonDocCheckType, jsonPathCheckType)
+}
+
+// JSON_TYPE
+func jsonTypeCheckFn(overloads []overload, inputs []types.Type) checkResult {
+	if len(inputs) != 1 {
+		return newCheckResultWithFailure(failedFunctionParametersWrong)
+	}
+	return jsonSearchCheckResult(inputs, jsonDocCheckType)
+}
+
+// JSON_VALID
+func jsonValidCheckFn(overloads []overload, inputs []types.Type) checkResult {
+	if len(inputs) != 1 {
+		return newCheckResultWithFailure(failedFunctionParametersWrong)
+	}
+	return newCheckResultWithSuccess(0)
+}
+
+// JSON_REMOVE
+func jsonRemoveCheckFn(overloads []overload, inputs []types.Type) checkResult {
+	if len(inputs) < 2 {
+		return newCheckResultWithFailure(failedFunctionParametersWrong)
+	}
+	return jsonVariadicCheckResult(inputs, jsonPathCheckType, jsonDocCheckType)
+}
+
+// JSON_MERGE_PATCH and JSON_MERGE_PRESERVE
+func jsonMergeCheckFn(overloads []overload, inputs []types.Type) checkResult {
+	if len(inputs) < 2 {
+		return newCheckResultWithFailure(failedFunctionParametersWrong)
+	}
+	return jsonVariadicCheckResult(inputs, jsonDocCheckType)
+}
+
+// JSON_SEARCH
+func jsonSearchCheckFn(overloads []overload, inputs []types.Type) checkResult {
+	if len(inputs) < 3 {
+		return newCheckResultWithFailure(failedFunctionParametersWrong)
+	}
+	return jsonVariadicCheckResult(inputs, jsonPathCheckType, jsonDocCheckType)
+}
+
+type jsonPathGetter func(i uint64) (*bytejson.Path, bool, error)
+
+// newJsonPathGetter parses a json path argument, a constant path is parsed only once.
+func newJsonPathGetter(vec *vector.Vector) jsonPathGetter {
+	w := vector.GenerateFunctionStrParameter(vec)
+	var constPath *bytejson.Path
+	return func(i uint64) (*bytejson.Path, bool, error) {
+		if constPath != nil {
+			return constPath, false, nil
+		}
+		v, null := w.GetStrValue(i)
+		if null {
+			return nil, true, nil
+		}
+		path, err := types.ParseStringToPath(string(v))
+		if err != nil {
+			return nil, false, err
+		}
+		if vec.IsConst() {
+			constPath = &path
+		}
+		return &path, false, nil
+	}
+}
+
+// getJsonOneOrAll returns true for 'one' and false for 'all'.
+func getJsonOneOrAll(proc *process.Process, v []byte) (bool, error) {
+	switch strings.ToLower(string(v)) {
+	case "one":
+		return true, nil
+	case "all":
+		return false, nil
+	}
+	return false, moerr.NewInvalidInputf(proc.Ctx, "the oneOrAll argument to json function may take these values: 'one' or 'all', got '%s'", string(v))
+}
+
+// checkJsonSimplePath returns error if the path may select more than one value.
+func checkJsonSimplePath(proc *process.Process, path *bytejson.Path) error {
+	if !path.IsSimple() {
+		return moerr.NewInvalidInputf(proc.Ctx, "in this situation, path expressions may not contain the * and ** tokens or an array range, got '%s'", path.String())
+	}
+	return nil
+}
+
+// jsonBuild evaluates fn on the json arguments of each row, a row with a NULL argument gets NULL
+// unless acceptNull is true, in which case the NULL argument is passed as json null.
+func jsonBuild(result vector.FunctionResultWrapper, length int, getters []jsonGetter, acceptNull bool, fn func([]bytejson.ByteJson) (bytejson.ByteJson, bool, error)) error {
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	args := make([]bytejson.ByteJson, len(getters))
+	for i := uint64(0); i < uint64(length); i++ {
+		null := false
+		for j, getter := range getters {
+			bj, isNull, err := getter(i)
+			if err != nil {
+				return err
+			}
+			if isNull && !acceptNull {
+				null = true
+				break
+			}
+			args[j] = bj
+		}
+		if null {
+			if err := rs.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+		out, isNull, err := fn(args)
+		if err != nil {
+			return err
+		}
+		if err = rs.AppendByteJson(out, isNull); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// JsonArray implements JSON_ARRAY(val, ...).
+func JsonArray(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	getters := make([]jsonGetter, len(parameters))
+	for i, param := range parameters {
+		getters[i] = newJsonValueGetter(param)
+	}
+	return jsonBuild(result, length, getters, true, func(args []bytejson.ByteJson) (bytejson.ByteJson, bool, error) {
+		return bytejson.NewArray(args), false, nil
+	})
+}
+
+// JsonObject implements JSON_OBJECT(key, val, ...).
+func JsonObject(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	keyWrappers := make([]vector.FunctionParameterWrapper[types.Varlena], 0, len(parameters)/2)
+	getters := make([]jsonGetter, 0, len(parameters)/2)
+	for i := 0; i < len(parameters); i += 2 {
+		keyWrappers = append(keyWrappers, vector.GenerateFunctionStrParameter(parameters[i]))
+		getters = append(getters, newJsonValueGetter(parameters[i+1]))
+	}
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	keys := make([][]byte, len(keyWrappers))
+	vals := make([]bytejson.ByteJson, len(getters))
+	for i := uint64(0); i < uint64(length); i++ {
+		for j, w := range keyWrappers {
+			key, null := w.GetStrValue(i)
+			if null {
+				return moerr.NewInvalidInput(proc.Ctx, "JSON documents may not contain NULL member names")
+			}
+			keys[j] = key
+			val, _, err := getters[j](i)
+			if err != nil {
+				return err
+			}
+			vals[j] = val
+		}
+		out, err := bytejson.NewObject(keys, vals)
+		if err != nil {
+			return err
+		}
+		if err = rs.AppendByteJson(out, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// JsonContainsPath implements JSON_CONTAINS_PATH(json_doc, one_or_all, path, ...).
+func JsonContainsPath(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	docGetter := newJsonDocGetter(parameters[0])
+	oneOrAll := vector.GenerateFunctionStrParameter(parameters[1])
+	pathGetters := make([]jsonPathGetter, 0, len(parameters)-2)
+	for _, param := range parameters[2:] {
+		pathGetters = append(pathGetters, newJsonPathGetter(param))
+	}
+	rs := vector.MustFunctionResult[bool](result)
+	for i := uint64(0); i < uint64(length); i++ {
+		doc, null, err := docGetter(i)
+		if err != nil {
+			return err
+		}
+		v, oIsNull := oneOrAll.GetStrValue(i)
+		if null || oIsNull {
+			if err = rs.Append(false, true); err != nil {
+				return err
+			}
+			continue
+		}
+		one, err := getJsonOneOrAll(proc, v)
+		if err != nil {
+			return err
+		}
+
+		res := !one
+		null = false
+		for _, pathGetter := range pathGetters {
+			path, pIsNull, err := pathGetter(i)
+			if err != nil {
+				return err
+			}
+			if pIsNull {
+				null = true
+				break
+			}
+			if _, found := doc.QueryFirst(path); found == one {
+				res = one
+				break
+			}
+		}
+		if err = rs.Append(res, null); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getJsonDocAtPath returns the document or the value at the optional simple path argument.
+func getJsonDocAtPath(proc *process.Process, docGetter jsonGetter, pathGetter jsonPathGetter, i uint64) (bytejson.ByteJson, bool, error) {
+	doc, null, err := docGetter(i)
+	if err != nil || null || pathGetter == nil {
+		return doc, null, err
+	}
+	path, null, err := pathGetter(i)
+	if err != nil || null {
+		return doc, null, err
+	}
+	if err = checkJsonSimplePath(proc, path); err != nil {
+		return doc, false, err
+	}
+	val, found := doc.QueryFirst(path)
+	return val, !found, nil
+}
+
+// JsonKeys implements JSON_KEYS(json_doc[, path]).
+func JsonKeys(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	docGetter := newJsonDocGetter(parameters[0])
+	var pathGetter jsonPathGetter
+	if len(parameters) > 1 {
+		pathGetter = newJsonPathGetter(parameters[1])
+	}
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	for i := uint64(0); i < uint64(length); i++ {
+		val, null, err := getJsonDocAtPath(proc, docGetter, pathGetter, i)
+		if err != nil {
+			return err
+		}
+		keys, ok := bytejson.Null, false
+		if !null {
+			if keys, ok, err = val.Keys(); err != nil {
+				return err
+			}
+		}
+		if err = rs.AppendByteJson(keys, !ok); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// JsonLength implements JSON_LENGTH(json_doc[, path]).
+func JsonLength(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	docGetter := newJsonDocGetter(parameters[0])
+	var pathGetter jsonPathGetter
+	if len(parameters) > 1 {
+		pathGetter = newJsonPathGetter(parameters[1])
+	}
+	rs := vector.MustFunctionResult[int64](result)
+	for i := uint64(0); i < uint64(length); i++ {
+		val, null, err := getJsonDocAtPath(proc, docGetter, pathGetter, i)
+		if err != nil {
+			return err
+		}
+		if null {
+			err = rs.Append(0, true)
+		} else {
+			err = rs.Append(int64(val.Length()), false)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// JsonType implements JSON_TYPE(json_val).
+func JsonType(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	docGetter := newJsonDocGetter(parameters[0])
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	for i := uint64(0); i < uint64(length); i++ {
+		doc, null, err := docGetter(i)
+		if err != nil {
+			return err
+		}
+		if null {
+			err = rs.AppendBytes(nil, true)
+		} else {
+			err = rs.AppendBytes([]byte(doc.TypeName()), false)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// JsonValid implements JSON_VALID(val), only a json value or a string of valid json text is valid.
+func JsonValid(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	rs := vector.MustFunctionResult[bool](result)
+	vec := parameters[0]
+	isString := vec.GetType().Oid.IsMySQLString()
+	var w vector.FunctionParameterWrapper[types.Varlena]
+	if isString {
+		w = vector.GenerateFunctionStrParameter(vec)
+	}
+	for i := uint64(0); i < uint64(length); i++ {
+		if vec.IsNull(i) {
+			if err := rs.Append(false, true); err != nil {
+				return err
+			}
+			continue
+		}
+		valid := vec.GetType().Oid == types.T_json
+		if isString {
+			v, _ := w.GetStrValue(i)
+			_, err := types.ParseSliceToByteJson(v)
+			valid = err == nil
+		}
+		if err := rs.Append(valid, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// JsonRemove implements JSON_REMOVE(json_doc, path, ...).
+func JsonRemove(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	docGetter := newJsonDocGetter(parameters[0])
+	pathGetters := make([]jsonPathGetter, 0, len(parameters)-1)
+	for _, param := range parameters[1:] {
+		pathGetters = append(pathGetters, newJsonPathGetter(param))
+	}
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	paths := make([]*bytejson.Path, len(pathGetters))
+	for i := uint64(0); i < uint64(length); i++ {
+		doc, null, err := docGetter(i)
+		if err != nil {
+			return err
+		}
+		for j := 0; j < len(pathGetters) && !null; j++ {
+			if paths[j], null, err = pathGetters[j](i); err != nil {
+				return err
+			}
+		}
+		if null {
+			if err = rs.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+		out, err := doc.Remove(paths)
+		if err != nil {
+			return err
+		}
+		if err = rs.AppendByteJson(out, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func jsonMerge(parameters []*vector.Vector, result vector.FunctionResultWrapper, length int, merge func([]bytejson.ByteJson) (bytejson.ByteJson, error)) error {
+	getters := make([]jsonGetter, len(parameters))
+	for i, param := range parameters {
+		getters[i] = newJsonDocGetter(param)
+	}
+	return jsonBuild(result, length, getters, false, func(args []bytejson.ByteJson) (bytejson.ByteJson, bool, error) {
+		out, err := merge(args)
+		return out, false, err
+	})
+}
+
+// JsonMergePatch implements JSON_MERGE_PATCH(json_doc, json_doc, ...).
+func JsonMergePatch(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	return jsonMerge(parameters, result, length, bytejson.MergePatch)
+}
+
+// JsonMergePreserve implements JSON_MERGE_PRESERVE(json_doc, json_doc, ...).
+func JsonMergePreserve(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	return jsonMerge(parameters, result, length, bytejson.MergePreserve)
+}
+
+// likePatternToRegexp converts a LIKE pattern with the escape character to a regular expression.
+func likePatternToRegexp(pattern string, escape rune) string {
+	var sb strings.Builder
+	sb.WriteString("^(?s:")
+	escaped := false
+	for _, c := range pattern {
+		switch {
+		case escaped:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+			escaped = false
+		case c == escape:
+			escaped = true
+		case c == '%':
+			sb.WriteString(".*")
+		case c == '_':
+			sb.WriteString(".")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	if escaped {
+		sb.WriteString(regexp.QuoteMeta(string(escape)))
+	}
+	sb.WriteString(")$")
+	return sb.String()
+}
+
+// JsonSearch implements JSON_SEARCH(json_doc, one_or_all, search_str[, escape_char[, path] ...]).
+func JsonSearch(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	docGetter := newJsonDocGetter(parameters[0])
+	oneOrAll := vector.GenerateFunctionStrParameter(parameters[1])
+	searchStr := vector.GenerateFunctionStrParameter(parameters[2])
+	var escapeChar vector.FunctionParameterWrapper[types.Varlena]
+	if len(parameters) > 3 {
+		escapeChar = vector.GenerateFunctionStrParameter(parameters[3])
+	}
+	var pathGetters []jsonPathGetter
+	if len(parameters) > 4 {
+		for _, param := range parameters[4:] {
+			pathGetters = append(pathGetters, newJsonPathGetter(param))
+		}
+	}
+	rootPath, err := types.ParseStringToPath("$")
+	if err != nil {
+		return err
+	}
+
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	regs := make(map[string]*regexp.Regexp)
+	for i := uint64(0); i < uint64(length); i++ {
+		doc, null, err := docGetter(i)
+		if err != nil {
+			return err
+		}
+		v, oIsNull := oneOrAll.GetStrValue(i)
+		pattern, sIsNull := searchStr.GetStrValue(i)
+		if null || oIsNull || sIsNull {
+			if err = rs.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+		one, err := getJsonOneOrAll(proc, v)
+		if err != nil {
+			return err
+		}
+
+		escape := '\\'
+		if escapeChar != nil {
+			if e, eIsNull := escapeChar.GetStrValue(i); !eIsNull && len(e) > 0 {
+				runes := []rune(string(e))
+				if len(runes) != 1 {
+					return moerr.NewInvalidInput(proc.Ctx, "incorrect arguments to ESCAPE")
+				}
+				escape = runes[0]
+			}
+		}
+
+		paths := []*bytejson.Path{&rootPath}
+		if len(pathGetters) > 0 {
+			paths = make([]*bytejson.Path, 0, len(pathGetters))
+			for _, pathGetter := range pathGetters {
+				path, pIsNull, err := pathGetter(i)
+				if err != nil {
+					return err
+				}
+				if pIsNull {
+					null = true
+					break
+				}
+				paths = append(paths, path)
+			}
+		}
+		if null {
+			if err = rs.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+
+		regStr := likePatternToRegexp(string(pattern), escape)
+		reg, ok := regs[regStr]
+		if !ok {
+			if reg, err = regexp.Compile(regStr); err != nil {
+				return err
+			}
+			regs[regStr] = reg
+		}
+		found := doc.Search(paths, reg.Match, one)
+		if len(found) == 0 {
+			if err = rs.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+
+		elems := make([]bytejson.ByteJson, len(found))
+		for j, p := range found {
+			if elems[j], err = bytejson.CreateByteJSON(p); err != nil {
+				return err
+			}
+		}
+		out := elems[0]
+		if len(elems) > 1 {
+			out = bytejson.NewArray(elems)
+		}
+		if err = rs.AppendByteJson(out, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,507 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJsonSearchFunctions(t *testing.T) {
+	testCases := []struct {
+		tcTemp
+		fn fEvalFn
+	}{
+		{
+			tcTemp: tcTemp{
+				info: "member of with integer values",
+				inputs: []FunctionTestInput{
+					NewFunctionTestInput(types.T_int64.ToType(),
+						[]int64{1, 5, 2, 0},
+						[]bool{false, false, false, true}),
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{`[1, 2, 3]`, `[1, 2, 3]`, `2`, `[1]`},
+						[]bool{false, false, false, false}),
+				},
+				expect: NewFunctionTestResult(types.T_bool.ToType(), false,
+					[]bool{true, false, true, false},
+					[]bool{false, false, false, true}),
+			},
+			fn: JsonMemberOf,
+		},
+		{
+			tcTemp: tcTemp{
+				info: "member of with string values",
+				inputs: []FunctionTestInput{
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{"ab", "1"},
+						[]bool{false, false}),
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{`["ab", "cd"]`, `[1]`},
+						[]bool{false, false}),
+				},
+				expect: NewFunctionTestResult(types.T_bool.ToType(), false,
+					[]bool{true, false},
+					[]bool{false, false}),
+			},
+			fn: JsonMemberOf,
+		},
+		{
+			tcTemp: tcTemp{
+				info: "json_contains",
+				inputs: []FunctionTestInput{
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{`{"a": 1, "b": [1, 2]}`, `[1, 2, 3]`, `[1, 2, 3]`},
+						[]bool{false, false, false}),
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{`{"b": 2}`, `[3, 1]`, `[4]`},
+						[]bool{false, false, false}),
+				},
+				expect: NewFunctionTestResult(types.T_bool.ToType(), false,
+					[]bool{true, true, false},
+					[]bool{false, false, false}),
+			},
+			fn: JsonContains,
+		},
+		{
+			tcTemp: tcTemp{
+				info: "json_contains with path",
+				inputs: []FunctionTestInput{
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{`{"a": 1, "b": [1, 2]}`, `{"a": 1, "b": [1, 2]}`, `{"a": 1}`},
+						[]bool{false, false, false}),
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{`2`, `2`, `1`},
+						[]bool{false, false, false}),
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{`$.b`, `$.a`, `$.c`},
+						[]bool{false, false, false}),
+				},
+				expect: NewFunctionTestResult(types.T_bool.ToType(), false,
+					[]bool{true, false, false},
+					[]bool{false, false, true}),
+			},
+			fn: JsonContains,
+		},
+		{
+			tcTemp: tcTemp{
+				info: "json_overlaps",
+				inputs: []FunctionTestInput{
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{`[1, 2, 3]`, `[1, 2, 3]`, `{"a": 1}`, `5`},
+						[]bool{false, false, false, false}),
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{`[3, 4]`, `[4, 5]`, `{"a": 1, "b": 2}`, `[5, 6]`},
+						[]bool{false, false, false, false}),
+				},
+				expect: NewFunctionTestResult(types.T_bool.ToType(), false,
+					[]bool{true, false, true, true},
+					[]bool{false, false, false, false}),
+			},
+			fn: JsonOverlaps,
+		},
+		{
+			tcTemp: tcTemp{
+				info: "json_overlaps with invalid json",
+				inputs: []FunctionTestInput{
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{`[1, 2`},
+						[]bool{false}),
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{`[1]`},
+						[]bool{false}),
+				},
+				expect: NewFunctionTestResult(types.T_bool.ToType(), true, nil, nil),
+			},
+			fn: JsonOverlaps,
+		},
+	}
+
+	proc := testutil.NewProcess()
+	for _, tc := range testCases {
+		fcTC := NewFunctionTestCase(proc, tc.inputs, tc.expect, tc.fn)
+		s, info := fcTC.Run()
+		require.True(t, s, fmt.Sprintf("case is '%s', err info is '%s'", tc.info, info))
+	}
+}
+
+func TestJsonSearchCheckFn(t *testing.T) {
+	res := jsonMemberOfCheckFn(nil, []types.Type{types.T_int32.ToType(), types.T_json.ToType()})
+	require.Equal(t, succeedWithCast, res.status)
+	require.Equal(t, types.T_int64, res.finalType[0].Oid)
+
+	res = jsonContainsCheckFn(nil, []types.Type{types.T_json.ToType(), types.T_varchar.ToType()})
+	require.Equal(t, succeedMatched, res.status)
+
+	res = jsonOverlapsCheckFn(nil, []types.Type{types.T_json.ToType()})
+	require.Equal(t, failedFunctionParametersWrong, res.status)
+}
+
+// encodeJsonTexts returns the storage format of the json texts, an empty text is kept for the NULL row.
+func encodeJsonTexts(t *testing.T, texts ...string) []string {
+	res := make([]string, len(texts))
+	for i, text := range texts {
+		if text == "" {
+			continue
+		}
+		bj, err := types.ParseStringToByteJson(text)
+		require.NoError(t, err)
+		encoded, err := types.EncodeJson(bj)
+		require.NoError(t, err)
+		res[i] = string(encoded)
+	}
+	return res
+}
+
+func TestJsonConstructorFunctions(t *testing.T) {
+	testCases := []struct {
+		tcTemp
+		fn fEvalFn
+	}{
+		{
+			tcTemp: tcTemp{
+				info: "json_array",
+				inputs: []FunctionTestInput{
+					NewFunctionTestInput(types.T_int64.ToType(),
+						[]int64{1, 2},
+						[]bool{false, true}),
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{"a", "b"},
+						[]bool{false, false}),
+					NewFunctionTestInput(types.T_json.ToType(),
+						encodeJsonTexts(t, `{"k": [1]}`, `true`),
+						[]bool{false, false}),
+				},
+				expect: NewFunctionTestResult(types.T_json.ToType(), false,
+					encodeJsonTexts(t, `[1, "a", {"k": [1]}]`, `[null, "b", true]`),
+					[]bool{false, false}),
+			},
+			fn: JsonArray,
+		},
+		{
+			tcTemp: tcTemp{
+				info: "json_object",
+				inputs: []FunctionTestInput{
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{"b", "a"},
+						[]bool{false, false}),
+					NewFunctionTestInput(types.T_float64.ToType(),
+						[]float64{1.5, 2},
+						[]bool{false, true}),
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{"a", "a"},
+						[]bool{false, false}),
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{"x", "y"},
+						[]bool{false, false}),
+				},
+				expect: NewFunctionTestResult(types.T_json.ToType(), false,
+					encodeJsonTexts(t, `{"a": "x", "b": 1.5}`, `{"a": "y"}`),
+					[]bool{false, false}),
+			},
+			fn: JsonObject,
+		},
+		{
+			tcTemp: tcTemp{
+				info: "json_object with null key",
+				inputs: []FunctionTestInput{
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{""},
+						[]bool{true}),
+					NewFunctionTestInput(types.T_int64.ToType(),
+						[]int64{1},
+						[]bool{false}),
+				},
+				expect: NewFunctionTestResult(types.T_json.ToType(), true, nil, nil),
+			},
+			fn: JsonObject,
+		},
+		{
+			tcTemp: tcTemp{
+				info: "json_keys",
+				inputs: []FunctionTestInput{
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{`{"b": 1, "a": {"c": 2}}`, `{"b": 1, "a": {"c": 2}}`, `[1]`, `{"a": 1}`},
+						[]bool{false, false, false, false}),
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{`$`, `$.a`, `$`, `$.b`},
+						[]bool{false, false, false, false}),
+				},
+				expect: NewFunctionTestResult(types.T_json.ToType(), false,
+					encodeJsonTexts(t, `["a", "b"]`, `["c"]`, ``, ``),
+					[]bool{false, false, true, true}),
+			},
+			fn: JsonKeys,
+		},
+		{
+			tcTemp: tcTemp{
+				info: "json_remove",
+				inputs: []FunctionTestInput{
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{`{"a": 1, "b": [1, 2, 3]}`, `[1, 2]`},
+						[]bool{false, false}),
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{`$.b[1]`, `$[5]`},
+						[]bool{false, false}),
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{`$.a`, `$[0]`},
+						[]bool{false, false}),
+				},
+				expect: NewFunctionTestResult(types.T_json.ToType(), false,
+					encodeJsonTexts(t, `{"b": [1, 3]}`, `[2]`),
+					[]bool{false, false}),
+			},
+			fn: JsonRemove,
+		},
+		{
+			tcTemp: tcTemp{
+				info: "json_remove with wildcard path",
+				inputs: []FunctionTestInput{
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{`{"a": 1}`},
+						[]bool{false}),
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{`$.*`},
+						[]bool{false}),
+				},
+				expect: NewFunctionTestResult(types.T_json.ToType(), true, nil, nil),
+			},
+			fn: JsonRemove,
+		},
+		{
+			tcTemp: tcTemp{
+				info: "json_merge_patch",
+				inputs: []FunctionTestInput{
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{`{"a": 1, "b": {"c": 2}}`, `[1]`, `{"a": 1}`},
+						[]bool{false, false, false}),
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{`{"a": null, "b": {"d": 3}}`, `{"a": 1}`, ``},
+						[]bool{false, false, true}),
+				},
+				expect: NewFunctionTestResult(types.T_json.ToType(), false,
+					encodeJsonTexts(t, `{"b": {"c": 2, "d": 3}}`, `{"a": 1}`, ``),
+					[]bool{false, false, true}),
+			},
+			fn: JsonMergePatch,
+		},
+		{
+			tcTemp: tcTemp{
+				info: "json_merge_preserve",
+				inputs: []FunctionTestInput{
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{`{"a": 1}`, `[1]`, `1`},
+						[]bool{false, false, false}),
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{`{"a": 2, "b": 3}`, `{"a": 1}`, `2`},
+						[]bool{false, false, false}),
+				},
+				expect: NewFunctionTestResult(types.T_json.ToType(), false,
+					encodeJsonTexts(t, `{"a": [1, 2], "b": 3}`, `[1, {"a": 1}]`, `[1, 2]`),
+					[]bool{false, false, false}),
+			},
+			fn: JsonMergePreserve,
+		},
+		{
+			tcTemp: tcTemp{
+				info: "json_search",
+				inputs: []FunctionTestInput{
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{`["abc", {"x": "abd", "y": "zz"}]`, `["abc", {"x": "abd", "y": "zz"}]`, `["a%c"]`, `["abc"]`},
+						[]bool{false, false, false, false}),
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{"one", "all", "all", "all"},
+						[]bool{false, false, false, false}),
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{"ab%", "ab_", `a|%c`, "zz"},
+						[]bool{false, false, false, false}),
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{"", "", "|", ""},
+						[]bool{true, true, false, true}),
+				},
+				expect: NewFunctionTestResult(types.T_json.ToType(), false,
+					encodeJsonTexts(t, `"$[0]"`, `["$[0]", "$[1].x"]`, `"$[0]"`, ``),
+					[]bool{false, false, false, true}),
+			},
+			fn: JsonSearch,
+		},
+	}
+
+	proc := testutil.NewProcess()
+	for _, tc := range testCases {
+		fcTC := NewFunctionTestCase(proc, tc.inputs, tc.expect, tc.fn)
+		s, info := fcTC.Run()
+		require.True(t, s, fmt.Sprintf("case is '%s', err info is '%s'", tc.info, info))
+	}
+}
+
+func TestJsonInspectionFunctions(t *testing.T) {
+	testCases := []struct {
+		tcTemp
+		fn fEvalFn
+	}{
+		{
+			tcTemp: tcTemp{
+				info: "json_contains_path",
+				inputs: []FunctionTestInput{
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{`{"a": 1, "b": 2}`, `{"a": 1, "b": 2}`, `{"a": 1}`},
+						[]bool{false, false, false}),
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{"one", "all", "ONE"},
+						[]bool{false, false, false}),
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{`$.a`, `$.a`, `$.c`},
+						[]bool{false, false, false}),
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{`$.c`, `$.c`, `$[0]`},
+						[]bool{false, false, false}),
+				},
+				expect: NewFunctionTestResult(types.T_bool.ToType(), false,
+					[]bool{true, false, true},
+					[]bool{false, false, false}),
+			},
+			fn: JsonContainsPath,
+		},
+		{
+			tcTemp: tcTemp{
+				info: "json_contains_path with invalid one_or_all",
+				inputs: []FunctionTestInput{
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{`{"a": 1}`},
+						[]bool{false}),
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{"some"},
+						[]bool{false}),
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{`$.a`},
+						[]bool{false}),
+				},
+				expect: NewFunctionTestResult(types.T_bool.ToType(), true, nil, nil),
+			},
+			fn: JsonContainsPath,
+		},
+		{
+			tcTemp: tcTemp{
+				info: "json_length",
+				inputs: []FunctionTestInput{
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{`[1, 2, {"a": 3}]`, `{"a": 1, "b": {"c": 1}}`, `"abc"`, `{"a": 1}`},
+						[]bool{false, false, false, false}),
+				},
+				expect: NewFunctionTestResult(types.T_int64.ToType(), false,
+					[]int64{3, 2, 1, 1},
+					[]bool{false, false, false, false}),
+			},
+			fn: JsonLength,
+		},
+		{
+			tcTemp: tcTemp{
+				info: "json_length with path",
+				inputs: []FunctionTestInput{
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{`{"a": 1, "b": {"c": 1}}`, `{"a": 1}`},
+						[]bool{false, false}),
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{`$.b`, `$.c`},
+						[]bool{false, false}),
+				},
+				expect: NewFunctionTestResult(types.T_int64.ToType(), false,
+					[]int64{1, 0},
+					[]bool{false, true}),
+			},
+			fn: JsonLength,
+		},
+		{
+			tcTemp: tcTemp{
+				info: "json_type",
+				inputs: []FunctionTestInput{
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{`{"a": 1}`, `[1]`, `1`, `1.5`, `"s"`, `true`, `null`},
+						[]bool{false, false, false, false, false, false, false}),
+				},
+				expect: NewFunctionTestResult(types.T_varchar.ToType(), false,
+					[]string{"OBJECT", "ARRAY", "INTEGER", "DOUBLE", "STRING", "BOOLEAN", "NULL"},
+					[]bool{false, false, false, false, false, false, false}),
+			},
+			fn: JsonType,
+		},
+		{
+			tcTemp: tcTemp{
+				info: "json_valid",
+				inputs: []FunctionTestInput{
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{`{"a": 1}`, `{"a": 1`, `hello`, ``},
+						[]bool{false, false, false, true}),
+				},
+				expect: NewFunctionTestResult(types.T_bool.ToType(), false,
+					[]bool{true, false, false, false},
+					[]bool{false, false, false, true}),
+			},
+			fn: JsonValid,
+		},
+		{
+			tcTemp: tcTemp{
+				info: "json_valid with non string values",
+				inputs: []FunctionTestInput{
+					NewFunctionTestInput(types.T_int64.ToType(),
+						[]int64{1},
+						[]bool{false}),
+				},
+				expect: NewFunctionTestResult(types.T_bool.ToType(), false,
+					[]bool{false},
+					[]bool{false}),
+			},
+			fn: JsonValid,
+		},
+	}
+
+	proc := testutil.NewProcess()
+	for _, tc := range testCases {
+		fcTC := NewFunctionTestCase(proc, tc.inputs, tc.expect, tc.fn)
+		s, info := fcTC.Run()
+		require.True(t, s, fmt.Sprintf("case is '%s', err info is '%s'", tc.info, info))
+	}
+}
+
+func TestJsonConstructorCheckFn(t *testing.T) {
+	res := jsonArrayCheckFn(nil, []types.Type{types.T_int32.ToType(), types.T_decimal64.ToType()})
+	require.Equal(t, succeedWithCast, res.status)
+	require.Equal(t, types.T_int64, res.finalType[0].Oid)
+	require.Equal(t, types.T_float64, res.finalType[1].Oid)
+
+	res = jsonArrayCheckFn(nil, nil)
+	require.Equal(t, succeedMatched, res.status)
+
+	res = jsonObjectCheckFn(nil, []types.Type{types.T_varchar.ToType()})
+	require.Equal(t, failedFunctionParametersWrong, res.status)
+
+	res = jsonMergeCheckFn(nil, []types.Type{types.T_json.ToType()})
+	require.Equal(t, failedFunctionParametersWrong, res.status)
+
+	res = jsonSearchCheckFn(nil, []types.Type{types.T_json.ToType(), types.T_varchar.ToType(), types.T_varchar.ToType()})
+	require.Equal(t, succeedMatched, res.status)
+}
+
+func TestLikePatternToRegexp(t *testing.T) {
+	require.Equal(t, `^(?s:a.*b\..)$`, likePatternToRegexp(`a%b._`, '\\'))
+	require.Equal(t, `^(?s:100%_)$`, likePatternToRegexp(`100\%\_`, '\\'))
+	require.Equal(t, `^(?s:a_)$`, likePatternToRegexp(`a#_`, '#'))
+	require.Equal(t, `^(?s:a\\)$`, likePatternToRegexp(`a\\`, '\\'))
+}
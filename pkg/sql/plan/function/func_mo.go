@@ -879,6 +879,11 @@ var (
 		"mo_snapshots":                0,
 		"mo_pitr":                     0,
 		catalog.MO_TABLE_STATS:        0,
+		catalog.MO_TRIGGERS:           0,
+		catalog.MO_EVENTS:             0,
+		catalog.MO_EVENT_LOG:          0,
+		catalog.MO_EVENT_HISTORY:      0,
+		catalog.MO_TTL:                0,
 	}
 )
 
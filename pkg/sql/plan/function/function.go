@@ -92,6 +92,22 @@ func GetFunctionIsWinOrderFunByName(name string) bool {
 	return f.isWindowOrder()
 }
 
+// GetWinOffsetDirectionByName returns -1 for LAG, 1 for LEAD and 0 for the other functions.
+// LAG and LEAD read the row at an offset from the current row instead of the rows in the window frame.
+func GetWinOffsetDirectionByName(name string) int {
+	fid, exists := getFunctionIdByNameWithoutErr(name)
+	if !exists {
+		return 0
+	}
+	switch fid {
+	case LAG:
+		return -1
+	case LEAD:
+		return 1
+	}
+	return 0
+}
+
 func GetFunctionIsWinOrderFunById(overloadID int64) bool {
 	fid, _ := DecodeOverloadID(overloadID)
 	return allSupportedFunctions[fid].isWindowOrder()
@@ -405,6 +405,26 @@ const (
 	// fault inject function
 	FAULT_INJECT
 
+	// json search function
+	MEMBER_OF
+	JSON_CONTAINS
+	JSON_OVERLAPS
+	JSON_CONTAINS_PATH
+	JSON_SEARCH
+
+	// json constructor and inspection function
+	JSON_ARRAY
+	JSON_OBJECT
+	JSON_KEYS
+	JSON_LENGTH
+	JSON_TYPE
+	JSON_VALID
+	JSON_REMOVE
+	JSON_MERGE_PATCH
+	JSON_MERGE_PRESERVE
+	JSON_ARRAYAGG
+	JSON_OBJECTAGG
+
 	// FUNCTION_END_NUMBER is not a function, just a flag to record the max number of function.
 	// TODO: every one should put the new function id in front of this one if you want to make a new function.
 	FUNCTION_END_NUMBER
@@ -619,6 +639,14 @@ var functionIdRegister = map[string]int32{
 	"enable_fault_injection":         ENABLE_FAULT_INJECTION,
 	"disable_fault_injection":        DISABLE_FAULT_INJECTION,
 	"dense_rank":                     DENSE_RANK,
+	"percent_rank":                   PERCENT_RANK,
+	"cume_dist":                      CUME_DIST,
+	"ntile":                          NTILE,
+	"lag":                            LAG,
+	"lead":                           LEAD,
+	"first_value":                    FIRST_VALUE,
+	"last_value":                     LAST_VALUE,
+	"nth_value":                      NTH_VALUE,
 	"mo_win_divisor":                 MO_WIN_DIVISOR,
 	"add_fault_point":                ADD_FAULT_POINT,
 	"remove_fault_point":             REMOVE_FAULT_POINT,
@@ -737,4 +765,25 @@ var functionIdRegister = map[string]int32{
 
 	// fault inject function
 	"fault_inject": FAULT_INJECT,
+
+	// json search function
+	"member_of":          MEMBER_OF,
+	"json_contains":      JSON_CONTAINS,
+	"json_overlaps":      JSON_OVERLAPS,
+	"json_contains_path": JSON_CONTAINS_PATH,
+	"json_search":        JSON_SEARCH,
+
+	// json constructor and inspection function
+	"json_array":          JSON_ARRAY,
+	"json_object":         JSON_OBJECT,
+	"json_keys":           JSON_KEYS,
+	"json_length":         JSON_LENGTH,
+	"json_type":           JSON_TYPE,
+	"json_valid":          JSON_VALID,
+	"json_remove":         JSON_REMOVE,
+	"json_merge_patch":    JSON_MERGE_PATCH,
+	"json_merge_preserve": JSON_MERGE_PRESERVE,
+	"json_merge":          JSON_MERGE_PRESERVE,
+	"json_arrayagg":       JSON_ARRAYAGG,
+	"json_objectagg":      JSON_OBJECTAGG,
 }
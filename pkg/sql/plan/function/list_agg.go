@@ -243,6 +243,58 @@ var supportedAggInNewFramework = []FuncNew{
 		},
 	},
 
+	{
+		functionId: JSON_ARRAYAGG,
+		class:      plan.Function_AGG,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			if len(inputs) != 1 {
+				return newCheckResultWithFailure(failedAggParametersWrong)
+			}
+			return jsonSearchCheckResult(inputs, jsonValueCheckType)
+		},
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isAgg:      true,
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_json.ToType()
+				},
+				aggFramework: aggregationLogicOfOverload{
+					str:         "json_arrayagg",
+					aggRegister: agg.RegisterJsonArrayAgg,
+				},
+			},
+		},
+	},
+
+	{
+		functionId: JSON_OBJECTAGG,
+		class:      plan.Function_AGG,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			if len(inputs) != 2 {
+				return newCheckResultWithFailure(failedAggParametersWrong)
+			}
+			return jsonSearchCheckResult(inputs, jsonPathCheckType, jsonValueCheckType)
+		},
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isAgg:      true,
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_json.ToType()
+				},
+				aggFramework: aggregationLogicOfOverload{
+					str:         "json_objectagg",
+					aggRegister: agg.RegisterJsonObjectAgg,
+				},
+			},
+		},
+	},
+
 	{
 		functionId: APPROX_COUNT,
 		class:      plan.Function_AGG,
@@ -15,6 +15,8 @@
 package function
 
 import (
+	"math"
+
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggexec"
@@ -88,4 +90,240 @@ var supportedWindowInNewFramework = []FuncNew{
 			},
 		},
 	},
+	{
+		functionId: PERCENT_RANK,
+		class:      plan.Function_WIN_ORDER,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			if len(inputs) == 0 {
+				return newCheckResultWithSuccess(0)
+			}
+			return newCheckResultWithFailure(failedFunctionParametersWrong)
+		},
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType:    aggexec.DistributionWindowReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str:         "percent_rank",
+					aggRegister: agg.RegisterPercentRank,
+				},
+			},
+		},
+	},
+	{
+		functionId: CUME_DIST,
+		class:      plan.Function_WIN_ORDER,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			if len(inputs) == 0 {
+				return newCheckResultWithSuccess(0)
+			}
+			return newCheckResultWithFailure(failedFunctionParametersWrong)
+		},
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType:    aggexec.DistributionWindowReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str:         "cume_dist",
+					aggRegister: agg.RegisterCumeDist,
+				},
+			},
+		},
+	},
+	{
+		functionId: NTILE,
+		class:      plan.Function_WIN_ORDER,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			if len(inputs) == 1 {
+				return windowIntegerArgsCheck(inputs, 0)
+			}
+			return newCheckResultWithFailure(failedFunctionParametersWrong)
+		},
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType:    aggexec.SingleWindowReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str:         "ntile",
+					aggRegister: agg.RegisterNtile,
+				},
+			},
+		},
+	},
+	{
+		functionId: LAG,
+		class:      plan.Function_WIN_VALUE,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    windowOffsetFunctionCheck,
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType:    aggexec.ValueWindowReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str:         "lag",
+					aggRegister: agg.RegisterLag,
+				},
+			},
+		},
+	},
+	{
+		functionId: LEAD,
+		class:      plan.Function_WIN_VALUE,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    windowOffsetFunctionCheck,
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType:    aggexec.ValueWindowReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str:         "lead",
+					aggRegister: agg.RegisterLead,
+				},
+			},
+		},
+	},
+	{
+		functionId: FIRST_VALUE,
+		class:      plan.Function_WIN_VALUE,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			if len(inputs) == 1 {
+				return newCheckResultWithSuccess(0)
+			}
+			return newCheckResultWithFailure(failedFunctionParametersWrong)
+		},
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType:    aggexec.ValueWindowReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str:         "first_value",
+					aggRegister: agg.RegisterFirstValue,
+				},
+			},
+		},
+	},
+	{
+		functionId: LAST_VALUE,
+		class:      plan.Function_WIN_VALUE,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			if len(inputs) == 1 {
+				return newCheckResultWithSuccess(0)
+			}
+			return newCheckResultWithFailure(failedFunctionParametersWrong)
+		},
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType:    aggexec.ValueWindowReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str:         "last_value",
+					aggRegister: agg.RegisterLastValue,
+				},
+			},
+		},
+	},
+	{
+		functionId: NTH_VALUE,
+		class:      plan.Function_WIN_VALUE,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			if len(inputs) == 2 {
+				return windowIntegerArgsCheck(inputs, 1)
+			}
+			return newCheckResultWithFailure(failedFunctionParametersWrong)
+		},
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType:    aggexec.ValueWindowReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str:         "nth_value",
+					aggRegister: agg.RegisterNthValue,
+				},
+			},
+		},
+	},
+}
+
+// windowIntegerArgsCheck requires the inputs starting from the `from` one to be integers,
+// and casts them to int64 for the window operator to read them directly.
+func windowIntegerArgsCheck(inputs []types.Type, from int) checkResult {
+	needCast := false
+	castType := make([]types.Type, len(inputs))
+	copy(castType, inputs)
+	for i := from; i < len(inputs); i++ {
+		if inputs[i].Oid == types.T_int64 {
+			continue
+		}
+		if !inputs[i].IsIntOrUint() && inputs[i].Oid != types.T_any {
+			return newCheckResultWithFailure(failedFunctionParametersWrong)
+		}
+		castType[i] = types.T_int64.ToType()
+		needCast = true
+	}
+	if needCast {
+		return newCheckResultWithCast(0, castType)
+	}
+	return newCheckResultWithSuccess(0)
+}
+
+// windowOffsetFunctionCheck is the check function of LAG(expr [, N [, default]]) and LEAD(expr [, N [, default]]).
+// N will be cast to int64, and the default value will be cast to the same type as the expr.
+func windowOffsetFunctionCheck(_ []overload, inputs []types.Type) checkResult {
+	if len(inputs) < 1 || len(inputs) > 3 {
+		return newCheckResultWithFailure(failedFunctionParametersWrong)
+	}
+	if len(inputs) < 3 || inputs[0].Eq(inputs[2]) || inputs[2].Oid == types.T_any {
+		return windowIntegerArgsCheck(inputs, 1)
+	}
+	if inputs[0].Oid == types.T_any {
+		r := windowIntegerArgsCheck(inputs[:2], 1)
+		if r.status == failedFunctionParametersWrong {
+			return r
+		}
+		return newCheckResultWithCast(0, []types.Type{inputs[2], types.T_int64.ToType(), inputs[2]})
+	}
+
+	// the expr and the default value should be the same type.
+	minCost := math.MaxInt32
+	retType := types.Type{}
+	source := []types.Type{inputs[0], inputs[2]}
+	target := make([]types.T, 2)
+	for _, rett := range retOperatorIffSupports {
+		target[0], target[1] = rett, rett
+
+		c, cost := tryToMatch(source, target)
+		if c == matchFailed {
+			continue
+		}
+		if cost < minCost {
+			minCost = cost
+			retType = rett.ToType()
+			if retType.Oid.IsDecimal() {
+				setMaxScaleFromSource(&retType, source)
+			} else if retType.Oid.IsMySQLString() {
+				setMaxWidthFromSource(&retType, source)
+			}
+		}
+	}
+	if minCost == math.MaxInt32 {
+		return newCheckResultWithFailure(failedFunctionParametersWrong)
+	}
+	if !inputs[1].IsIntOrUint() {
+		return newCheckResultWithFailure(failedFunctionParametersWrong)
+	}
+	return newCheckResultWithCast(0, []types.Type{retType, types.T_int64.ToType(), retType})
 }
@@ -0,0 +1,126 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+func NewGeneratedColBinder(builder *QueryBuilder, ctx *BindContext, tableDef *TableDef, colIdx int) *GeneratedColBinder {
+	g := &GeneratedColBinder{tableDef: tableDef, colIdx: colIdx}
+	g.sysCtx = builder.GetContext()
+	g.builder = builder
+	g.ctx = ctx
+	g.impl = g
+	return g
+}
+
+func (g *GeneratedColBinder) colNameOrigin() string {
+	return g.tableDef.Cols[g.colIdx].GetOriginCaseName()
+}
+
+func (g *GeneratedColBinder) BindExpr(expr tree.Expr, i int32, b bool) (*plan.Expr, error) {
+	switch exprImpl := expr.(type) {
+	case *tree.FuncExpr:
+		// the nondeterministic functions are not allowed, same as the check constraint
+		if funcRef, ok := exprImpl.Func.FunctionReference.(*tree.UnresolvedName); ok {
+			if _, ok = unsupportedFunctionsInCheckExpr[funcRef.ColName()]; ok {
+				return nil, moerr.NewInvalidInputf(g.GetContext(), "expression of generated column '%s' contains a disallowed function: %s", g.colNameOrigin(), funcRef.ColNameOrigin())
+			}
+		}
+	case *tree.VarExpr:
+		return nil, moerr.NewInvalidInputf(g.GetContext(), "expression of generated column '%s' cannot refer to variables", g.colNameOrigin())
+	case *tree.ParamExpr:
+		return nil, moerr.NewInvalidInputf(g.GetContext(), "expression of generated column '%s' cannot refer to parameters", g.colNameOrigin())
+	}
+	return g.baseBindExpr(expr, i, b)
+}
+
+func (g *GeneratedColBinder) BindColRef(name *tree.UnresolvedName, i int32, b bool) (*plan.Expr, error) {
+	colName := name.ColName()
+	for idx, col := range g.tableDef.Cols {
+		if col.Name != colName {
+			continue
+		}
+		if col.Typ.AutoIncr {
+			return nil, moerr.NewInvalidInputf(g.GetContext(), "generated column '%s' cannot refer to auto-increment column", g.colNameOrigin())
+		}
+		if col.Generated != nil && idx >= g.colIdx {
+			return nil, moerr.NewInvalidInput(g.GetContext(), "generated column can refer only to generated columns defined prior to it")
+		}
+		break
+	}
+	return g.baseBindColRef(name, i, b)
+}
+
+func (g *GeneratedColBinder) BindAggFunc(s string, expr *tree.FuncExpr, i int32, b bool) (*plan.Expr, error) {
+	return nil, moerr.NewSyntaxError(g.GetContext(), "aggregate functions not allowed in generated column")
+}
+
+func (g *GeneratedColBinder) BindWinFunc(s string, expr *tree.FuncExpr, i int32, b bool) (*plan.Expr, error) {
+	return nil, moerr.NewSyntaxError(g.GetContext(), "window functions not allowed in generated column")
+}
+
+func (g *GeneratedColBinder) BindSubquery(subquery *tree.Subquery, b bool) (*plan.Expr, error) {
+	return nil, moerr.NewSyntaxError(g.GetContext(), "subquery not allowed in generated column")
+}
+
+func (g *GeneratedColBinder) BindTimeWindowFunc(funcName string, astExpr *tree.FuncExpr, depth int32, isRoot bool) (*plan.Expr, error) {
+	return nil, moerr.NewInvalidInputf(g.GetContext(), "cannot bind time window functions '%s'", funcName)
+}
+
+// bindVirtualColRef returns the expression of the virtual generated column referred by the
+// column ref, or nil if it is not a virtual generated column of a table scan.
+func (b *baseBinder) bindVirtualColRef(binding *Binding, colPos int32, depth int32) (*plan.Expr, error) {
+	if b.builder == nil || binding == nil || int(binding.nodeId) >= len(b.builder.qry.Nodes) {
+		return nil, nil
+	}
+	node := b.builder.qry.Nodes[binding.nodeId]
+	if node.NodeType != plan.Node_TABLE_SCAN || node.TableDef == nil || int(colPos) >= len(node.TableDef.Cols) {
+		return nil, nil
+	}
+	tableDef := node.TableDef
+	if col := tableDef.Cols[colPos]; !isVirtualCol(col) || col.Generated.Expr == nil {
+		return nil, nil
+	}
+
+	return replaceGeneratedColRef(tableDef, tableDef.Cols[colPos].Generated.Expr, func(colIdx int) (*plan.Expr, error) {
+		refCol := tableDef.Cols[colIdx]
+		b.boundCols = append(b.boundCols, binding.table+"."+refCol.Name)
+		expr := &plan.Expr{
+			Typ: *DeepCopyType(binding.types[colIdx]),
+		}
+		if depth == 0 {
+			expr.Expr = &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: binding.tag,
+					ColPos: int32(colIdx),
+					Name:   binding.cols[colIdx],
+				},
+			}
+		} else {
+			expr.Expr = &plan.Expr_Corr{
+				Corr: &plan.CorrColRef{
+					RelPos: binding.tag,
+					ColPos: int32(colIdx),
+					Depth:  depth,
+				},
+			}
+		}
+		return expr, nil
+	})
+}
@@ -0,0 +1,124 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+var (
+	hnsw_search_func_name       = "hnsw_search"
+	hnsw_index_update_func_name = "hnsw_index_update"
+
+	hnswSearchColDefs = []*plan.ColDef{
+		{
+			Name: "pk",
+			Typ: plan.Type{
+				Id:          int32(types.T_int64),
+				NotNullable: false,
+				Width:       8,
+			},
+		},
+		{
+			Name: "score",
+			Typ: plan.Type{
+				Id:          int32(types.T_float64),
+				NotNullable: false,
+				Width:       8,
+			},
+		},
+	}
+
+	// same columns as the metadata index table
+	hnswIndexUpdateColDefs = []*plan.ColDef{
+		{
+			Name: catalog.SystemSI_HNSW_TblCol_Metadata_key,
+			Typ: plan.Type{
+				Id:          int32(types.T_varchar),
+				NotNullable: false,
+				Width:       types.MaxVarcharLen,
+			},
+		},
+		{
+			Name: catalog.SystemSI_HNSW_TblCol_Metadata_val,
+			Typ: plan.Type{
+				Id:          int32(types.T_varchar),
+				NotNullable: false,
+				Width:       types.MaxVarcharLen,
+			},
+		},
+	}
+)
+
+// select * from hnsw_search(config, query_vector)
+// arg list [config, query_vector]
+func (builder *QueryBuilder) buildHnswSearch(tbl *tree.TableFunction, ctx *BindContext, exprs []*plan.Expr, childId int32) (int32, error) {
+
+	if len(exprs) != 2 {
+		return 0, moerr.NewInvalidInput(builder.GetContext(), "Invalid number of arguments (NARGS != 2).")
+	}
+
+	colDefs := _getColDefs(hnswSearchColDefs)
+
+	node := &plan.Node{
+		NodeType: plan.Node_FUNCTION_SCAN,
+		Stats:    &plan.Stats{},
+		TableDef: &plan.TableDef{
+			TableType: "func_table",
+			TblFunc: &plan.TableFunction{
+				Name:  hnsw_search_func_name,
+				Param: []byte(""),
+			},
+			Cols: colDefs,
+		},
+		BindingTags:     []int32{builder.genNewTag()},
+		TblFuncExprList: exprs,
+		Children:        []int32{childId},
+	}
+	return builder.appendNode(node, ctx), nil
+}
+
+// select * from hnsw_index_update(config, primary_keys)
+// arg list [config, primary_keys], the primary keys are separated by comma, and empty primary
+// keys means rebuilding the index from the whole source table.
+func (builder *QueryBuilder) buildHnswIndexUpdate(tbl *tree.TableFunction, ctx *BindContext, exprs []*plan.Expr, childId int32) (int32, error) {
+
+	if len(exprs) != 2 {
+		return 0, moerr.NewInvalidInput(builder.GetContext(), "Invalid number of arguments (NARGS != 2).")
+	}
+
+	colDefs := _getColDefs(hnswIndexUpdateColDefs)
+
+	node := &plan.Node{
+		NodeType: plan.Node_FUNCTION_SCAN,
+		Stats:    &plan.Stats{},
+		TableDef: &plan.TableDef{
+			TableType: "func_table",
+			TblFunc: &plan.TableFunction{
+				Name:  hnsw_index_update_func_name,
+				Param: []byte(""),
+			},
+			Cols: colDefs,
+		},
+		BindingTags:     []int32{builder.genNewTag()},
+		TblFuncExprList: exprs,
+		Children:        []int32{childId},
+	}
+	return builder.appendNode(node, ctx), nil
+}
@@ -0,0 +1,171 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+const json_table_func_name = "json_table"
+
+// JsonTableParam is the param of the json_table table function.  The output columns of the function
+// are the non-nested columns in depth first order and the column is found by its name at runtime since
+// unused columns are pruned.
+type JsonTableParam struct {
+	Path    string                  `json:"path"`
+	Columns []*JsonTableColumnParam `json:"columns"`
+}
+
+type JsonTableColumnParam struct {
+	Kind    tree.JsonTableColumnKind `json:"kind"`
+	Name    string                   `json:"name,omitempty"`
+	Path    string                   `json:"path,omitempty"`
+	OnEmpty tree.JsonTableOnResponse `json:"on_empty"`
+	OnError tree.JsonTableOnResponse `json:"on_error"`
+	Columns []*JsonTableColumnParam  `json:"columns,omitempty"`
+}
+
+// select * from json_table(expr, path columns (...)) as jt
+// arg list [expr]
+func (builder *QueryBuilder) buildJsonTable(tbl *tree.TableFunction, ctx *BindContext, exprs []*plan.Expr, childId int32) (int32, error) {
+	if tbl.JsonTable == nil || len(exprs) != 1 {
+		return 0, moerr.NewInvalidInput(builder.GetContext(), "json_table: invalid arguments")
+	}
+
+	switch types.T(exprs[0].Typ.Id) {
+	case types.T_json, types.T_char, types.T_varchar, types.T_text:
+	default:
+		return 0, moerr.NewInvalidInputf(builder.GetContext(), "json_table: first argument must be json or string, but got %s", types.T(exprs[0].Typ.Id).String())
+	}
+
+	if _, err := types.ParseStringToPath(tbl.JsonTable.Path); err != nil {
+		return 0, err
+	}
+
+	param := JsonTableParam{Path: tbl.JsonTable.Path}
+	var colDefs []*plan.ColDef
+	var err error
+	names := make(map[string]struct{})
+	if param.Columns, err = builder.buildJsonTableColumns(tbl.JsonTable.Columns, &colDefs, names); err != nil {
+		return 0, err
+	}
+	if len(colDefs) == 0 {
+		return 0, moerr.NewInvalidInput(builder.GetContext(), "json_table: no column is defined")
+	}
+
+	data, err := json.Marshal(param)
+	if err != nil {
+		return 0, err
+	}
+
+	node := &plan.Node{
+		NodeType: plan.Node_FUNCTION_SCAN,
+		Stats:    &plan.Stats{},
+		TableDef: &plan.TableDef{
+			TableType: "func_table",
+			TblFunc: &plan.TableFunction{
+				Name:  json_table_func_name,
+				Param: data,
+			},
+			Cols: colDefs,
+		},
+		BindingTags:     []int32{builder.genNewTag()},
+		Children:        []int32{childId},
+		TblFuncExprList: exprs,
+	}
+	return builder.appendNode(node, ctx), nil
+}
+
+func (builder *QueryBuilder) buildJsonTableColumns(columns []*tree.JsonTableColumn, colDefs *[]*plan.ColDef, names map[string]struct{}) ([]*JsonTableColumnParam, error) {
+	params := make([]*JsonTableColumnParam, 0, len(columns))
+	for _, c := range columns {
+		p := &JsonTableColumnParam{Kind: c.Kind, Path: c.Path}
+		if c.Kind != tree.JSON_TABLE_COLUMN_ORDINALITY {
+			if _, err := types.ParseStringToPath(c.Path); err != nil {
+				return nil, err
+			}
+		}
+
+		if c.Kind == tree.JSON_TABLE_COLUMN_NESTED {
+			var err error
+			if p.Columns, err = builder.buildJsonTableColumns(c.Columns, colDefs, names); err != nil {
+				return nil, err
+			}
+			params = append(params, p)
+			continue
+		}
+
+		p.Name = strings.ToLower(string(c.Name))
+		if _, ok := names[p.Name]; ok {
+			return nil, moerr.NewInvalidInputf(builder.GetContext(), "json_table: duplicate column name '%s'", c.Name)
+		}
+		names[p.Name] = struct{}{}
+
+		var typ plan.Type
+		if c.Kind == tree.JSON_TABLE_COLUMN_ORDINALITY {
+			// same as mysql, ordinality column is unsigned int
+			typ = plan.Type{Id: int32(types.T_uint32), Width: 32, Scale: -1}
+		} else {
+			var err error
+			if typ, err = getTypeFromAst(builder.GetContext(), c.Type); err != nil {
+				return nil, err
+			}
+			if !isJsonTableColumnType(types.T(typ.Id)) {
+				return nil, moerr.NewNotSupportedf(builder.GetContext(), "json_table: column '%s' of type %s", c.Name, types.T(typ.Id).String())
+			}
+		}
+
+		for _, resp := range []*tree.JsonTableOnResponse{c.OnEmpty, c.OnError} {
+			if resp != nil && resp.Type == tree.JSON_TABLE_ON_DEFAULT {
+				if _, err := types.ParseStringToByteJson(resp.Default); err != nil {
+					return nil, moerr.NewInvalidInputf(builder.GetContext(), "json_table: invalid json default value '%s' of column '%s'", resp.Default, c.Name)
+				}
+			}
+		}
+		if c.OnEmpty != nil {
+			p.OnEmpty = *c.OnEmpty
+		}
+		if c.OnError != nil {
+			p.OnError = *c.OnError
+		}
+
+		*colDefs = append(*colDefs, &plan.ColDef{
+			Name:       p.Name,
+			OriginName: string(c.Name),
+			Typ:        typ,
+		})
+		params = append(params, p)
+	}
+	return params, nil
+}
+
+func isJsonTableColumnType(t types.T) bool {
+	switch t {
+	case types.T_bool,
+		types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64, types.T_decimal64, types.T_decimal128,
+		types.T_char, types.T_varchar, types.T_text, types.T_blob, types.T_binary, types.T_varbinary,
+		types.T_json, types.T_date, types.T_datetime, types.T_timestamp, types.T_time:
+		return true
+	}
+	return false
+}
@@ -140,6 +140,9 @@ type Schema struct {
 	idxs      []index
 	fks       []*ForeignKeyDef
 	clusterby *ClusterByDef
+	triggers  []*TriggerDef
+	mview     *MaterializedViewDef
+	refMviews []*MaterializedViewDef
 	outcnt    float64
 	tblId     int64
 }
@@ -716,6 +719,73 @@ func NewMockCompilerContext(isDml bool) *MockCompilerContext {
 		outcnt: 4,
 	}
 
+	constraintTestSchema["t_trigger"] = &Schema{
+		cols: []col{
+			{"a", types.T_int64, false, 0, 0},
+			{"b", types.T_varchar, true, 10, 0},
+			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
+		},
+		pks: []int{0},
+		triggers: []*TriggerDef{
+			{
+				Name:      "bi",
+				Timing:    "BEFORE",
+				Event:     "INSERT",
+				CreateSql: "create trigger bi before insert on t_trigger for each row set new.b = upper(new.b)",
+			},
+			{
+				Name:      "ai",
+				Timing:    "AFTER",
+				Event:     "INSERT",
+				CreateSql: "create trigger ai after insert on t_trigger for each row insert into t1 values (new.a, new.b)",
+			},
+			{
+				Name:      "bu",
+				Timing:    "BEFORE",
+				Event:     "UPDATE",
+				CreateSql: "create trigger bu before update on t_trigger for each row if new.b is null then signal sqlstate '45000' set message_text = 'b can not be null'; end if",
+			},
+			{
+				Name:      "ad",
+				Timing:    "AFTER",
+				Event:     "DELETE",
+				CreateSql: "create trigger ad after delete on t_trigger for each row delete from t1 where a = old.a",
+			},
+		},
+		outcnt: 4,
+	}
+
+	mviewAgg := &MaterializedViewDef{
+		Database:    "constraint_test",
+		Name:        "mv_agg",
+		Query:       "select b, sum(c) as s from constraint_test.t_mview group by b",
+		KeyCols:     []string{"b"},
+		ViewKeyCols: []string{"b"},
+		BaseTables: []*ObjectRef{
+			{SchemaName: "constraint_test", ObjName: "t_mview"},
+		},
+	}
+	constraintTestSchema["t_mview"] = &Schema{
+		cols: []col{
+			{"a", types.T_int64, false, 0, 0},
+			{"b", types.T_varchar, true, 10, 0},
+			{"c", types.T_int64, true, 0, 0},
+			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
+		},
+		pks:       []int{0},
+		refMviews: []*MaterializedViewDef{mviewAgg},
+		outcnt:    4,
+	}
+	constraintTestSchema["mv_agg"] = &Schema{
+		cols: []col{
+			{"b", types.T_varchar, true, 10, 0},
+			{"s", types.T_int64, true, 0, 0},
+			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
+		},
+		mview:  mviewAgg,
+		outcnt: 2,
+	}
+
 	objects := make(map[string]*ObjectRef)
 	tables := make(map[string]*TableDef)
 	stats := make(map[string]*Stats)
@@ -809,6 +879,16 @@ func NewMockCompilerContext(isDml bool) *MockCompilerContext {
 				tableDef.Fkeys = table.fks
 			}
 
+			if table.triggers != nil {
+				tableDef.Triggers = table.triggers
+			}
+
+			if table.mview != nil {
+				tableDef.TableType = catalog.SystemMaterializedRel
+				tableDef.Mview = table.mview
+			}
+			tableDef.RefMviews = table.refMviews
+
 			if table.clusterby != nil {
 				tableDef.ClusterBy = &plan.ClusterByDef{
 					Name: "__mo_cbkey_003pid005pname",
@@ -819,7 +899,7 @@ func NewMockCompilerContext(isDml bool) *MockCompilerContext {
 				properties := []*plan.Property{
 					{
 						Key:   catalog.SystemRelAttr_Kind,
-						Value: catalog.SystemOrdinaryRel,
+						Value: tableDef.TableType,
 					},
 					{
 						Key:   catalog.SystemRelAttr_Comment,
@@ -898,7 +978,8 @@ func NewMockCompilerContext(isDml bool) *MockCompilerContext {
 }
 
 func (m *MockCompilerContext) DatabaseExists(name string, snapshot *Snapshot) bool {
-	return strings.ToLower(name) == "tpch" || strings.ToLower(name) == "mo" || strings.ToLower(name) == "mo_catalog"
+	return strings.ToLower(name) == "tpch" || strings.ToLower(name) == "mo" || strings.ToLower(name) == "mo_catalog" ||
+		strings.ToLower(name) == "constraint_test"
 }
 
 func (m *MockCompilerContext) GetDatabaseId(dbName string, snapshot *Snapshot) (uint64, error) {
@@ -46,6 +46,9 @@ func (builder *QueryBuilder) countColRefs(nodeID int32, colRefCnt map[[2]int32]i
 	for _, updateCtx := range node.UpdateCtxList {
 		increaseRefCntForColRefList(updateCtx.InsertCols, 2, colRefCnt)
 		increaseRefCntForColRefList(updateCtx.DeleteCols, 2, colRefCnt)
+		if updateCtx.InsertFilterCol != nil {
+			increaseRefCntForColRefList([]ColRef{*updateCtx.InsertFilterCol}, 2, colRefCnt)
+		}
 
 		if len(updateCtx.PartitionTableIds) > 0 {
 			colRefs := make([]ColRef, 0, 2)
@@ -265,6 +268,11 @@ func replaceColumnsForNode(node *plan.Node, projMap map[[2]int32]*plan.Expr) {
 	for _, updateCtx := range node.UpdateCtxList {
 		replaceColumnsForColRefList(updateCtx.InsertCols, projMap)
 		replaceColumnsForColRefList(updateCtx.DeleteCols, projMap)
+		if updateCtx.InsertFilterCol != nil {
+			filterCols := []ColRef{*updateCtx.InsertFilterCol}
+			replaceColumnsForColRefList(filterCols, projMap)
+			*updateCtx.InsertFilterCol = filterCols[0]
+		}
 
 		if len(updateCtx.PartitionTableIds) > 0 {
 			oldPartRef := [2]int32{node.BindingTags[1], updateCtx.OldPartitionIdx}
@@ -496,6 +504,9 @@ func (builder *QueryBuilder) removeEffectlessLeftJoins(nodeID int32, tagCnt map[
 	for _, updateCtx := range node.UpdateCtxList {
 		increaseTagCntForColRefList(updateCtx.InsertCols, 2, tagCnt)
 		increaseTagCntForColRefList(updateCtx.DeleteCols, 2, tagCnt)
+		if updateCtx.InsertFilterCol != nil {
+			increaseTagCntForColRefList([]ColRef{*updateCtx.InsertFilterCol}, 2, tagCnt)
+		}
 	}
 
 	for i, childID := range node.Children {
@@ -539,6 +550,9 @@ END:
 	for _, updateCtx := range node.UpdateCtxList {
 		increaseTagCntForColRefList(updateCtx.InsertCols, -2, tagCnt)
 		increaseTagCntForColRefList(updateCtx.DeleteCols, -2, tagCnt)
+		if updateCtx.InsertFilterCol != nil {
+			increaseTagCntForColRefList([]ColRef{*updateCtx.InsertFilterCol}, -2, tagCnt)
+		}
 	}
 
 	return nodeID
@@ -1186,7 +1200,9 @@ func (builder *QueryBuilder) lockTableIfLockNoRowsAtTheEndForDelAndUpdate() (err
 			if !ok {
 				return //unsupport multi delete/multi update
 			}
-			if isMain && !target.LockTable { // do nothing if already a table lock
+			// do nothing if already a table lock, and never lock the rows not
+			// read in skip locked mode
+			if isMain && !target.LockTable && !target.SkipLocked {
 				lockTarget = target
 			}
 		}
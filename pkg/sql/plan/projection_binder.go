@@ -18,6 +18,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
@@ -131,7 +132,12 @@ func (b *ProjectionBinder) BindWinFunc(funcName string, astExpr *tree.FuncExpr,
 	if err != nil {
 		return nil, err
 	}
+	if err = b.resetWindowFuncIntegerArg(funcName, w.WindowFunc); err != nil {
+		return nil, err
+	}
 	w.Name = funcName
+	w.IgnoreNulls = ws.IgnoreNulls
+	w.FromLast = ws.FromLast
 	// partition by
 	for _, group := range ws.PartitionBy {
 		expr, err := b.BindExpr(group, depth, isRoot)
@@ -292,6 +298,49 @@ func (b *ProjectionBinder) makeFrameConstValue(expr tree.Expr, typ *plan.Type) (
 	}, nil
 }
 
+// resetWindowFuncIntegerArg folds the integer argument of NTILE(N), NTH_VALUE(expr, N),
+// LAG(expr, N) and LEAD(expr, N) into a constant, N should be a non-negative integer.
+func (b *ProjectionBinder) resetWindowFuncIntegerArg(funcName string, e *Expr) error {
+	pos, allowZero := 0, false
+	switch funcName {
+	case NameNtile:
+		pos = 0
+	case NameNthValue:
+		pos = 1
+	case NameLag, NameLead:
+		pos, allowZero = 1, true
+	default:
+		return nil
+	}
+
+	args := e.GetF().GetArgs()
+	if len(args) <= pos {
+		return nil
+	}
+	if !rule.IsConstant(args[pos], false) {
+		return moerr.NewInvalidInputf(b.GetContext(), "Incorrect arguments to %s", funcName)
+	}
+
+	executor, err := colexec.NewExpressionExecutor(b.builder.compCtx.GetProcess(), args[pos])
+	if err != nil {
+		return err
+	}
+	defer executor.Free()
+	vec, err := executor.Eval(b.builder.compCtx.GetProcess(), []*batch.Batch{batch.EmptyForConstFoldBatch}, nil)
+	if err != nil {
+		return err
+	}
+	if vec.IsConstNull() || vec.GetType().Oid != types.T_int64 {
+		return moerr.NewInvalidInputf(b.GetContext(), "Incorrect arguments to %s", funcName)
+	}
+	n := vector.MustFixedColWithTypeCheck[int64](vec)[0]
+	if n < 0 || (n == 0 && !allowZero) {
+		return moerr.NewInvalidInputf(b.GetContext(), "Incorrect arguments to %s", funcName)
+	}
+	args[pos] = makePlan2Int64ConstExprWithType(n)
+	return nil
+}
+
 func (b *ProjectionBinder) resetInterval(e *Expr) (*Expr, error) {
 	e1 := e.Expr.(*plan.Expr_List).List.List[0]
 	e2 := e.Expr.(*plan.Expr_List).List.List[1]
@@ -124,11 +124,18 @@ func (builder *QueryBuilder) pushdownFilters(nodeID int32, filters []*plan.Expr,
 
 	case plan.Node_FILTER:
 		canPushdown = filters
+		// assert raises error for the rows it sees, so it can not be evaluated before the filters below it
+		var assertFilters []*plan.Expr
 		for _, filter := range node.FilterList {
+			if f := filter.GetF(); f != nil && f.Func.ObjName == "assert" {
+				assertFilters = append(assertFilters, filter)
+				continue
+			}
 			canPushdown = append(canPushdown, splitPlanConjunction(applyDistributivity(builder.GetContext(), filter))...)
 		}
 
 		childID, cantPushdownChild := builder.pushdownFilters(node.Children[0], canPushdown, separateNonEquiConds)
+		cantPushdownChild = append(cantPushdownChild, assertFilters...)
 
 		if len(cantPushdownChild) > 0 {
 			node.Children[0] = childID
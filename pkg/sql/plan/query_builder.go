@@ -1347,25 +1347,15 @@ func (builder *QueryBuilder) remapAllColRefs(nodeID int32, step int32, colRefCnt
 				Expr: &plan.Expr_Lit{Lit: &plan.Literal{Value: &plan.Literal_I64Val{I64Val: 0}}},
 			})
 		} else {
-			internalRemapping := &ColRefRemapping{
-				globalToLocal: make(map[[2]int32][2]int32),
-			}
-
 			tag := node.BindingTags[0]
-			for i := range node.TableDef.Cols {
+			// a column may be not referred, e.g. the DEFAULT value of a generated column
+			for i, col := range node.TableDef.Cols {
 				globalRef := [2]int32{tag, int32(i)}
 				if colRefCnt[globalRef] == 0 {
 					continue
 				}
-				internalRemapping.addColRef(globalRef)
-			}
-
-			for i, col := range node.TableDef.Cols {
-				if colRefCnt[internalRemapping.localToGlobal[i]] == 0 {
-					continue
-				}
 
-				remapping.addColRef(internalRemapping.localToGlobal[i])
+				remapping.addColRef(globalRef)
 
 				node.ProjectList = append(node.ProjectList, &plan.Expr{
 					Typ: col.Typ,
@@ -1575,6 +1565,10 @@ func (builder *QueryBuilder) remapAllColRefs(nodeID int32, step int32, colRefCnt
 			for _, col := range updateCtx.DeleteCols {
 				colRefCnt[[2]int32{col.RelPos, col.ColPos}]++
 			}
+
+			if col := updateCtx.InsertFilterCol; col != nil {
+				colRefCnt[[2]int32{col.RelPos, col.ColPos}]++
+			}
 		}
 
 		var oldPartRel, newPartRel [2]int32
@@ -1646,6 +1640,14 @@ func (builder *QueryBuilder) remapAllColRefs(nodeID int32, step int32, colRefCnt
 					return nil, err
 				}
 			}
+
+			if col := updateCtx.InsertFilterCol; col != nil {
+				colRefCnt[[2]int32{col.RelPos, col.ColPos}]--
+				err := builder.remapSingleColRef(col, childRemapping.globalToLocal, &remapInfo)
+				if err != nil {
+					return nil, err
+				}
+			}
 		}
 
 		childProjList := builder.qry.Nodes[node.Children[0]].ProjectList
@@ -1738,6 +1740,41 @@ func (builder *QueryBuilder) remapAllColRefs(nodeID int32, step int32, colRefCnt
 			})
 		}
 
+	case plan.Node_POSTDML:
+		// the actions read the columns of the child project by the positions, so all the
+		// columns are kept, and the batch is passed through
+		childNode := builder.qry.Nodes[node.Children[0]]
+		if childNode.NodeType != plan.Node_PROJECT || len(childNode.BindingTags) == 0 {
+			return nil, moerr.NewInternalError(builder.GetContext(), "the child of postdml must be a project")
+		}
+		childTag := childNode.BindingTags[0]
+		for i := range childNode.ProjectList {
+			colRefCnt[[2]int32{childTag, int32(i)}]++
+		}
+
+		childRemapping, err := builder.remapAllColRefs(node.Children[0], step, colRefCnt, colRefBool, sinkColRef)
+		if err != nil {
+			return nil, err
+		}
+
+		childProjList := childNode.ProjectList
+		node.ProjectList = make([]*plan.Expr, 0, len(childProjList))
+		for i, globalRef := range childRemapping.localToGlobal {
+			colRefCnt[globalRef]--
+			remapping.addColRef(globalRef)
+
+			node.ProjectList = append(node.ProjectList, &plan.Expr{
+				Typ: childProjList[i].Typ,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						RelPos: 0,
+						ColPos: int32(i),
+						Name:   builder.nameByColRef[globalRef],
+					},
+				},
+			})
+		}
+
 	default:
 		return nil, moerr.NewInternalError(builder.GetContext(), "unsupport node type")
 	}
@@ -2308,6 +2345,10 @@ func (builder *QueryBuilder) buildUnion(stmt *tree.UnionClause, astOrderBy tree.
 
 const NameGroupConcat = "group_concat"
 const NameClusterCenters = "cluster_centers"
+const NameNtile = "ntile"
+const NameNthValue = "nth_value"
+const NameLag = "lag"
+const NameLead = "lead"
 
 func (bc *BindContext) generateForceWinSpecList() ([]*plan.Expr, error) {
 	windowsSpecList := make([]*plan.Expr, 0, len(bc.aggregates))
@@ -2469,8 +2510,9 @@ func (builder *QueryBuilder) bindSelect(stmt *tree.Select, ctx *BindContext, isR
 	astLimit := stmt.Limit
 	astTimeWindow := stmt.TimeWindow
 
-	if stmt.SelectLockInfo != nil && stmt.SelectLockInfo.LockType == tree.SelectLockForUpdate {
+	if stmt.SelectLockInfo != nil && stmt.SelectLockInfo.LockType != tree.SelectLockNone {
 		builder.isForUpdate = true
+		builder.selectLockInfo = stmt.SelectLockInfo
 	}
 
 	// strip parentheses
@@ -2710,6 +2752,7 @@ func (builder *QueryBuilder) bindSelect(stmt *tree.Select, ctx *BindContext, isR
 				Block:              true,
 				RefreshTsIdxInBat:  -1, //unsupport now
 			}
+			setLockTargetBySelectLock(lockTarget, builder.selectLockInfo)
 			if tableDef.Partition != nil {
 				partTableIDs, _ := getPartTableIdsAndNames(builder.compCtx, objRef, tableDef)
 				lockTarget.IsPartitionTable = true
@@ -3128,6 +3171,17 @@ func (builder *QueryBuilder) bindSelect(stmt *tree.Select, ctx *BindContext, isR
 			}
 		}
 		if builder.isForUpdate {
+			// the limit is applied after the lock op, so in skip locked mode
+			// it's enough to lock limit + offset rows if nothing reorders them
+			if len(orderBys) == 0 && !clause.Distinct {
+				if n, ok := getSkipLockedLimit(limitExpr, offsetExpr); ok {
+					for _, target := range lockNode.LockTargets {
+						if target.SkipLocked {
+							target.SkipLockedLimit = n
+						}
+					}
+				}
+			}
 			lockNode.Children[0] = nodeID
 			nodeID = builder.appendNode(lockNode, ctx)
 		}
@@ -4752,8 +4806,14 @@ func (builder *QueryBuilder) buildTableFunction(tbl *tree.TableFunction, ctx *Bi
 		nodeId, err = builder.buildFullTextIndexScan(tbl, ctx, exprs, childId)
 	case "fulltext_index_tokenize":
 		nodeId, err = builder.buildFullTextIndexTokenize(tbl, ctx, exprs, childId)
+	case "hnsw_search":
+		nodeId, err = builder.buildHnswSearch(tbl, ctx, exprs, childId)
+	case "hnsw_index_update":
+		nodeId, err = builder.buildHnswIndexUpdate(tbl, ctx, exprs, childId)
 	case "stage_list":
 		nodeId, err = builder.buildStageList(tbl, ctx, exprs, childId)
+	case json_table_func_name:
+		nodeId, err = builder.buildJsonTable(tbl, ctx, exprs, childId)
 	default:
 		err = moerr.NewNotSupportedf(builder.GetContext(), "table function '%s' not supported", id)
 	}
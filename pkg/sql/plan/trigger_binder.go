@@ -0,0 +1,82 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+const (
+	triggerRowNew = "NEW"
+	triggerRowOld = "OLD"
+)
+
+func NewTriggerBinder(builder *QueryBuilder, ctx *BindContext, body *triggerBodyBinder) *TriggerBinder {
+	t := &TriggerBinder{body: body}
+	t.sysCtx = builder.GetContext()
+	t.builder = builder
+	t.ctx = ctx
+	t.impl = t
+	return t
+}
+
+func (t *TriggerBinder) BindExpr(expr tree.Expr, i int32, b bool) (*plan.Expr, error) {
+	if _, ok := expr.(*tree.ParamExpr); ok {
+		return nil, moerr.NewInvalidInput(t.GetContext(), "parameters are not allowed in the trigger")
+	}
+	return t.baseBindExpr(expr, i, b)
+}
+
+// BindColRef binds NEW.col and OLD.col to the values of the column in the rows of the trigger,
+// the other column refs are not allowed.
+func (t *TriggerBinder) BindColRef(name *tree.UnresolvedName, i int32, b bool) (*plan.Expr, error) {
+	row := triggerRowOfName(name)
+	if row == "" {
+		return nil, moerr.NewBadFieldError(t.GetContext(), name.ColNameOrigin(), "field list")
+	}
+	return t.body.bindRowRef(row, name)
+}
+
+func (t *TriggerBinder) BindAggFunc(s string, expr *tree.FuncExpr, i int32, b bool) (*plan.Expr, error) {
+	return nil, moerr.NewSyntaxError(t.GetContext(), "aggregate functions not allowed in trigger")
+}
+
+func (t *TriggerBinder) BindWinFunc(s string, expr *tree.FuncExpr, i int32, b bool) (*plan.Expr, error) {
+	return nil, moerr.NewSyntaxError(t.GetContext(), "window functions not allowed in trigger")
+}
+
+func (t *TriggerBinder) BindSubquery(subquery *tree.Subquery, b bool) (*plan.Expr, error) {
+	return nil, moerr.NewNotSupported(t.GetContext(), "subquery in the expression of trigger")
+}
+
+func (t *TriggerBinder) BindTimeWindowFunc(funcName string, astExpr *tree.FuncExpr, depth int32, isRoot bool) (*plan.Expr, error) {
+	return nil, moerr.NewInvalidInputf(t.GetContext(), "cannot bind time window functions '%s'", funcName)
+}
+
+// triggerRowOfName returns NEW or OLD if the name is NEW.col or OLD.col, or empty.
+func triggerRowOfName(name *tree.UnresolvedName) string {
+	if name.NumParts != 2 || name.Star {
+		return ""
+	}
+	switch row := strings.ToUpper(name.TblNameOrigin()); row {
+	case triggerRowNew, triggerRowOld:
+		return row
+	}
+	return ""
+}
@@ -71,6 +71,10 @@ type ForeignKeyDef = plan.ForeignKeyDef
 type ClusterTable = plan.ClusterTable
 type PrimaryKeyDef = plan.PrimaryKeyDef
 type IndexDef = plan.IndexDef
+type TriggerDef = plan.TriggerDef
+type PostDmlTriggerAction = plan.PostDmlTriggerAction
+type MaterializedViewDef = plan.MaterializedViewDef
+type PostDmlMaterializedView = plan.PostDmlMaterializedView
 type SubscriptionMeta = plan.SubscriptionMeta
 type Snapshot = plan.Snapshot
 type SnapshotTenant = plan.SnapshotTenant
@@ -179,6 +183,7 @@ type QueryBuilder struct {
 	mysqlCompatible       bool
 	haveOnDuplicateKey    bool // if it's a plan contain onduplicate key node, we can not use some optmize rule
 	isForUpdate           bool // if it's a query plan for update
+	selectLockInfo        *tree.SelectLockInfo
 	isRestore             bool
 	isSkipResolveTableDef bool
 	skipStats             bool
@@ -399,6 +404,29 @@ type PartitionBinder struct {
 	baseBinder
 }
 
+// CheckBinder for the expression of a CHECK constraint
+type CheckBinder struct {
+	baseBinder
+	tableDef *TableDef
+	// colName is set for a column check constraint, which may only refer to its own column
+	colName string
+}
+
+// GeneratedColBinder for the expression of a generated column
+type GeneratedColBinder struct {
+	baseBinder
+	tableDef *TableDef
+	// colIdx is the position of the generated column in tableDef.Cols
+	colIdx int
+}
+
+// TriggerBinder for the expressions in the body of a trigger, NEW.col and OLD.col are bound to
+// the values of the rows of the trigger
+type TriggerBinder struct {
+	baseBinder
+	body *triggerBodyBinder
+}
+
 // SetBinder for 'set @var = expr'
 type SetBinder struct {
 	baseBinder
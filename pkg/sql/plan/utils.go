@@ -1615,8 +1615,13 @@ func GetForETLWithType(param *tree.ExternParam, prefix string) (res fileservice.
 		w := csv.NewWriter(buf)
 		opts := []string{"s3-opts", "endpoint=" + param.S3Param.Endpoint, "region=" + param.S3Param.Region, "key=" + param.S3Param.APIKey, "secret=" + param.S3Param.APISecret,
 			"bucket=" + param.S3Param.Bucket, "role-arn=" + param.S3Param.RoleArn, "external-id=" + param.S3Param.ExternalId}
-		if strings.ToLower(param.S3Param.Provider) == "minio" {
+		switch strings.ToLower(param.S3Param.Provider) {
+		case "minio":
 			opts = append(opts, "is-minio=true")
+		case "azure":
+			opts = append(opts, "is-azure=true")
+		case "gcs":
+			opts = append(opts, "is-gcs=true")
 		}
 		if err = w.Write(opts); err != nil {
 			return nil, "", err
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"math"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -92,8 +93,7 @@ func (j *JsonDataGetter) GetFieldValue(name string) (interface{}, bool) {
 		}
 	}
 
-	val, ok := j.Data[name]
-	return val, ok
+	return GetNestedFieldValue(j.Data, name)
 }
 
 type ProtoDataGetter struct {
@@ -102,8 +102,51 @@ type ProtoDataGetter struct {
 }
 
 func (p *ProtoDataGetter) GetFieldValue(name string) (interface{}, bool) {
-	val := p.Value.GetFieldByName(name)
-	return val, val != nil
+	msg := p.Value
+	for msg != nil {
+		if fd := msg.GetMessageDescriptor().FindFieldByName(name); fd != nil {
+			val := msg.GetField(fd)
+			return val, val != nil
+		}
+		// the field of a nested message, e.g. address.city
+		head, rest, found := strings.Cut(name, ".")
+		if !found {
+			break
+		}
+		fd := msg.GetMessageDescriptor().FindFieldByName(head)
+		if fd == nil {
+			break
+		}
+		msg, _ = msg.GetField(fd).(*dynamic.Message)
+		name = rest
+	}
+	return nil, false
+}
+
+type AvroDataGetter struct {
+	Value map[string]any
+	Key   []byte
+}
+
+func (a *AvroDataGetter) GetFieldValue(name string) (interface{}, bool) {
+	return GetNestedFieldValue(a.Value, name)
+}
+
+// GetNestedFieldValue returns the value of the field in data, the fields of the
+// nested records can be referred by the dotted path, e.g. address.city.
+func GetNestedFieldValue(data map[string]any, name string) (any, bool) {
+	if val, ok := data[name]; ok {
+		return val, true
+	}
+	head, rest, found := strings.Cut(name, ".")
+	if !found {
+		return nil, false
+	}
+	nested, ok := data[head].(map[string]any)
+	if !ok {
+		return nil, false
+	}
+	return GetNestedFieldValue(nested, rest)
 }
 
 type KafkaAdapterInterface interface {
@@ -114,6 +157,7 @@ type KafkaAdapterInterface interface {
 	ReadMessagesFromPartition(topic string, partition int32, offset int64, limit int) ([]*kafka.Message, error)
 	ReadMessagesFromTopic(topic string, offset int64, limit int64, configs map[string]interface{}) ([]*kafka.Message, error)
 	GetSchemaForTopic(topic string, isKey bool) (schemaregistry.SchemaMetadata, error)
+	GetSchemaByID(topic string, id int, isKey bool) (schemaregistry.SchemaInfo, error)
 
 	GetKafkaConsumer() (*kafka.Consumer, error)
 	ProduceMessage(topic string, key, value []byte) (int64, error)
@@ -398,14 +442,26 @@ func (ka *KafkaAdapter) GetSchemaForTopic(topic string, isKey bool) (schemaregis
 		return schemaregistry.SchemaMetadata{}, moerr.NewInternalError(context.Background(), "schema registry not initialized")
 	}
 
+	// Fetch the schema for the subject
+	return ka.SchemaRegistry.GetLatestSchemaMetadata(SchemaSubject(topic, isKey))
+}
+
+// GetSchemaByID returns the schema of the topic registered with the id,
+// it is used to resolve the writer schema of a message in the confluent wire format.
+func (ka *KafkaAdapter) GetSchemaByID(topic string, id int, isKey bool) (schemaregistry.SchemaInfo, error) {
+	if ka.SchemaRegistry == nil {
+		return schemaregistry.SchemaInfo{}, moerr.NewInternalError(context.Background(), "schema registry not initialized")
+	}
+	return ka.SchemaRegistry.GetBySubjectAndID(SchemaSubject(topic, isKey), id)
+}
+
+// SchemaSubject returns the subject of the topic in the TopicNameStrategy
+func SchemaSubject(topic string, isKey bool) string {
 	subjectSuffix := "value"
 	if isKey {
 		subjectSuffix = "key"
 	}
-	subject := fmt.Sprintf("%s-%s", topic, subjectSuffix)
-
-	// Fetch the schema for the subject
-	return ka.SchemaRegistry.GetLatestSchemaMetadata(subject)
+	return fmt.Sprintf("%s-%s", topic, subjectSuffix)
 }
 
 func (ka *KafkaAdapter) ProduceMessage(topic string, key, value []byte) (int64, error) {
@@ -469,12 +525,12 @@ func PopulateBatchFromMSG(ctx context.Context, ka KafkaAdapterInterface, typs []
 			}
 		}
 	case PROTOBUF:
-		md, err := convertProtobufSchemaToMD(configs["protobuf.schema"].(string), configs["protobuf.message"].(string))
+		md, err := ConvertProtobufSchemaToMD(configs[ProtobufSchemaKey].(string), configs[ProtobufMessagekey].(string))
 		if err != nil {
 			return nil, err
 		}
 		for i, msg := range msgs {
-			msgValue, err := deserializeProtobuf(md, msg.Value, false)
+			msgValue, err := DeserializeProtobuf(md, msg.Value, false)
 			if err != nil {
 				return nil, err
 			}
@@ -489,17 +545,33 @@ func PopulateBatchFromMSG(ctx context.Context, ka KafkaAdapterInterface, typs []
 		if err != nil {
 			return nil, err
 		}
-		md, err := convertProtobufSchemaToMD(schema.Schema, schema.SchemaInfo.Schema)
+		md, err := ConvertProtobufSchemaToMD(schema.Schema, configs[ProtobufMessagekey].(string))
 		if err != nil {
 			return nil, err
 		}
 		for i, msg := range msgs {
-			msgValue, _ := deserializeProtobuf(md, msg.Value, true)
+			msgValue, _ := DeserializeProtobuf(md, msg.Value, true)
 			err := populateOneRowData(ctx, b, attrKeys, &ProtoDataGetter{Value: msgValue, Key: msg.Key}, i, typs, mp)
 			if err != nil {
 				return nil, err
 			}
 		}
+	case AVRO:
+		topic := configs[TopicKey].(string)
+		decoder := NewAvroDecoder(func(id int) (string, error) {
+			info, err := ka.GetSchemaByID(topic, id, false)
+			return info.Schema, err
+		})
+		for i, msg := range msgs {
+			msgValue, err := decoder.Decode(msg.Value)
+			if err != nil {
+				return nil, err
+			}
+			err = populateOneRowData(ctx, b, attrKeys, &AvroDataGetter{Value: msgValue, Key: msg.Key}, i, typs, mp)
+			if err != nil {
+				return nil, err
+			}
+		}
 	default:
 		return nil, moerr.NewInternalErrorf(ctx, "Unsupported value for key: %s", ValueKey)
 	}
@@ -824,7 +896,26 @@ func populateOneRowData(ctx context.Context, bat *batch.Batch, attrKeys []string
 			}
 		case types.T_json:
 			var jsonBytes []byte
-			valueStr := fmt.Sprintf("%v", fieldValue)
+			var valueStr string
+			switch v := fieldValue.(type) {
+			case map[string]any, []any:
+				// nested records and arrays
+				bs, err := json.Marshal(v)
+				if err != nil {
+					nulls.Add(vec.GetNulls(), uint64(rowIdx))
+					continue
+				}
+				valueStr = string(bs)
+			case *dynamic.Message:
+				bs, err := v.MarshalJSON()
+				if err != nil {
+					nulls.Add(vec.GetNulls(), uint64(rowIdx))
+					continue
+				}
+				valueStr = string(bs)
+			default:
+				valueStr = fmt.Sprintf("%v", fieldValue)
+			}
 			byteJson, err := types.ParseStringToByteJson(valueStr)
 			if err != nil {
 				nulls.Add(vec.GetNulls(), uint64(rowIdx))
@@ -953,7 +1044,8 @@ func populateOneRowData(ctx context.Context, bat *batch.Batch, attrKeys []string
 	return nil
 }
 
-func convertProtobufSchemaToMD(schema string, msgTypeName string) (*desc.MessageDescriptor, error) {
+// ConvertProtobufSchemaToMD parses the protobuf schema and returns the descriptor of the message
+func ConvertProtobufSchemaToMD(schema string, msgTypeName string) (*desc.MessageDescriptor, error) {
 	files := map[string]string{
 		"test.proto": schema,
 	}
@@ -968,18 +1060,30 @@ func convertProtobufSchemaToMD(schema string, msgTypeName string) (*desc.Message
 	}
 	fd := fds[0]
 	md := fd.FindMessage(msgTypeName)
+	if md == nil {
+		md = fd.FindMessage(fd.GetPackage() + "." + msgTypeName)
+	}
+	if md == nil {
+		return nil, moerr.NewInternalErrorNoCtxf("protobuf message %s not found", msgTypeName)
+	}
 	return md, nil
 }
 
-func deserializeProtobuf(md *desc.MessageDescriptor, in []byte, isKafkSR bool) (*dynamic.Message, error) {
+// DeserializeProtobuf decodes the message, isKafkSR means the message is in the confluent wire format
+func DeserializeProtobuf(md *desc.MessageDescriptor, in []byte, isKafkSR bool) (*dynamic.Message, error) {
 	dm := dynamic.NewMessage(md)
 	var err error
 	if isKafkSR {
-		bytesRead, _, err := readMessageIndexes(in[5:])
+		if len(in) < srHeaderSize || in[0] != srMagicByte {
+			return nil, moerr.NewInternalErrorNoCtx("invalid protobuf message, unknown magic byte")
+		}
+		bytesRead, _, err := readMessageIndexes(in[srHeaderSize:])
 		if err != nil {
 			return nil, err
 		}
-		proto.Unmarshal(in[5+bytesRead:], dm)
+		if err = proto.Unmarshal(in[srHeaderSize+bytesRead:], dm); err != nil {
+			return nil, err
+		}
 	} else {
 		err = dm.Unmarshal(in)
 	}
@@ -1043,6 +1147,7 @@ func ValidateConfig(ctx context.Context, configs map[string]interface{}, factory
 		RelkindKey,
 		ProtobufMessagekey,
 		ProtobufSchemaKey,
+		SchemaRegistryKey,
 	}
 
 	// Create a set of allowed keys
@@ -1090,6 +1195,11 @@ func ValidateConfig(ctx context.Context, configs map[string]interface{}, factory
 		if _, ok := configs[SchemaRegistryKey]; !ok {
 			return moerr.NewInternalErrorf(ctx, "missing required key: %s", SchemaRegistryKey)
 		}
+	case AVRO:
+		// the writer schema of each message is resolved from the schema registry
+		if _, ok := configs[SchemaRegistryKey]; !ok {
+			return moerr.NewInternalErrorf(ctx, "missing required key: %s", SchemaRegistryKey)
+		}
 	default:
 		return moerr.NewInternalErrorf(ctx, "Unsupported value for key: %s", ValueKey)
 	}
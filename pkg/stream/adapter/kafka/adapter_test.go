@@ -66,6 +66,10 @@ func (m *MockKafkaAdapter) GetSchemaForTopic(topic string, isKey bool) (schemare
 	return schemaregistry.SchemaMetadata{}, nil // Mocked response
 }
 
+func (m *MockKafkaAdapter) GetSchemaByID(topic string, id int, isKey bool) (schemaregistry.SchemaInfo, error) {
+	return schemaregistry.SchemaInfo{}, nil // Mocked response
+}
+
 func (m *MockKafkaAdapter) ProduceMessage(topic string, key, value []byte) (int64, error) {
 	return 0, nil // Mocked response
 }
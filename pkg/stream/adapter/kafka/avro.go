@@ -0,0 +1,268 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mokafka
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/linkedin/goavro/v2"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+const (
+	// confluent wire format: magic byte + 4 bytes big endian schema id + payload
+	srMagicByte  = 0
+	srHeaderSize = 5
+
+	avroDatetimeLayout = "2006-01-02 15:04:05.999999"
+)
+
+// AvroSchemaResolver returns the avro schema registered with the id
+type AvroSchemaResolver func(id int) (string, error)
+
+type avroCodec struct {
+	codec  *goavro.Codec
+	schema any
+	// named types of the schema, keyed by both the full name and the short name
+	named map[string]any
+}
+
+// AvroDecoder decodes the avro messages in the confluent schema registry wire format.
+// the writer schema of a message is resolved by the schema id in its header, and the
+// codec of every schema id is cached.
+type AvroDecoder struct {
+	resolve AvroSchemaResolver
+
+	mu     sync.Mutex
+	codecs map[int]*avroCodec
+}
+
+func NewAvroDecoder(resolve AvroSchemaResolver) *AvroDecoder {
+	return &AvroDecoder{
+		resolve: resolve,
+		codecs:  make(map[int]*avroCodec),
+	}
+}
+
+// Decode decodes a record message into a map, the unions are unwrapped, the nested
+// records are decoded into nested maps, and the logical types are converted into the
+// values which can be cast to the column types.
+func (d *AvroDecoder) Decode(in []byte) (map[string]any, error) {
+	if len(in) < srHeaderSize || in[0] != srMagicByte {
+		return nil, moerr.NewInternalErrorNoCtx("invalid avro message, unknown magic byte")
+	}
+	id := int(binary.BigEndian.Uint32(in[1:srHeaderSize]))
+	c, err := d.getCodec(id)
+	if err != nil {
+		return nil, err
+	}
+	native, _, err := c.codec.NativeFromBinary(in[srHeaderSize:])
+	if err != nil {
+		return nil, err
+	}
+	record, ok := c.normalize(c.schema, "", native).(map[string]any)
+	if !ok {
+		return nil, moerr.NewInternalErrorNoCtxf("avro schema %d is not a record", id)
+	}
+	return record, nil
+}
+
+func (d *AvroDecoder) getCodec(id int) (*avroCodec, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if c, ok := d.codecs[id]; ok {
+		return c, nil
+	}
+	schema, err := d.resolve(id)
+	if err != nil {
+		return nil, err
+	}
+	codec, err := goavro.NewCodec(schema)
+	if err != nil {
+		return nil, err
+	}
+	c := &avroCodec{
+		codec: codec,
+		named: make(map[string]any),
+	}
+	if err = json.Unmarshal([]byte(codec.Schema()), &c.schema); err != nil {
+		return nil, err
+	}
+	c.collectNamed(c.schema, "")
+	d.codecs[id] = c
+	return c, nil
+}
+
+func (c *avroCodec) collectNamed(schema any, namespace string) {
+	switch s := schema.(type) {
+	case []any:
+		for _, branch := range s {
+			c.collectNamed(branch, namespace)
+		}
+	case map[string]any:
+		if name, ok := s["name"].(string); ok {
+			if ns, ok := s["namespace"].(string); ok {
+				namespace = ns
+			}
+			fullName := name
+			if !strings.Contains(name, ".") && namespace != "" {
+				fullName = namespace + "." + name
+			}
+			c.named[fullName] = s
+			c.named[name] = s
+			if idx := strings.LastIndex(fullName, "."); idx >= 0 {
+				namespace = fullName[:idx]
+			}
+		}
+		switch s["type"] {
+		case "record", "error":
+			fields, _ := s["fields"].([]any)
+			for _, f := range fields {
+				if field, ok := f.(map[string]any); ok {
+					c.collectNamed(field["type"], namespace)
+				}
+			}
+		case "array":
+			c.collectNamed(s["items"], namespace)
+		case "map":
+			c.collectNamed(s["values"], namespace)
+		default:
+			c.collectNamed(s["type"], namespace)
+		}
+	}
+}
+
+func avroTypeName(schema any) string {
+	switch s := schema.(type) {
+	case string:
+		return s
+	case map[string]any:
+		if name, ok := s["name"].(string); ok {
+			if ns, ok := s["namespace"].(string); ok && !strings.Contains(name, ".") {
+				return ns + "." + name
+			}
+			return name
+		}
+		if typ, ok := s["type"].(string); ok {
+			return typ
+		}
+	}
+	return ""
+}
+
+func (c *avroCodec) normalize(schema any, namespace string, value any) any {
+	if value == nil {
+		return nil
+	}
+	switch s := schema.(type) {
+	case string:
+		if named, ok := c.named[s]; ok {
+			return c.normalize(named, namespace, value)
+		}
+		if ns := namespace; ns != "" {
+			if named, ok := c.named[ns+"."+s]; ok {
+				return c.normalize(named, namespace, value)
+			}
+		}
+		return value
+	case []any:
+		// goavro wraps the non-null value of a union into a map keyed by the type name
+		wrapped, ok := value.(map[string]any)
+		if !ok || len(wrapped) != 1 {
+			return value
+		}
+		for name, v := range wrapped {
+			for _, branch := range s {
+				branchName := avroTypeName(branch)
+				if branchName == name || strings.HasSuffix(name, "."+branchName) {
+					return c.normalize(branch, namespace, v)
+				}
+				if named, ok := c.named[branchName]; ok && avroTypeName(named) == name {
+					return c.normalize(named, namespace, v)
+				}
+			}
+			return v
+		}
+	case map[string]any:
+		if ns, ok := s["namespace"].(string); ok {
+			namespace = ns
+		}
+		switch s["type"] {
+		case "record", "error":
+			record, ok := value.(map[string]any)
+			if !ok {
+				return value
+			}
+			fields, _ := s["fields"].([]any)
+			for _, f := range fields {
+				field, ok := f.(map[string]any)
+				if !ok {
+					continue
+				}
+				name, _ := field["name"].(string)
+				if v, ok := record[name]; ok {
+					record[name] = c.normalize(field["type"], namespace, v)
+				}
+			}
+			return record
+		case "array":
+			items, ok := value.([]any)
+			if !ok {
+				return value
+			}
+			for i := range items {
+				items[i] = c.normalize(s["items"], namespace, items[i])
+			}
+			return items
+		case "map":
+			values, ok := value.(map[string]any)
+			if !ok {
+				return value
+			}
+			for k, v := range values {
+				values[k] = c.normalize(s["values"], namespace, v)
+			}
+			return values
+		default:
+			if typ, ok := s["type"].([]any); ok {
+				return c.normalize(typ, namespace, value)
+			}
+			return normalizeAvroLogical(s, value)
+		}
+	}
+	return value
+}
+
+func normalizeAvroLogical(schema map[string]any, value any) any {
+	switch v := value.(type) {
+	case time.Time:
+		if schema["logicalType"] == "date" {
+			return v.UTC().Format(time.DateOnly)
+		}
+		return v.UTC().Format(avroDatetimeLayout)
+	case time.Duration:
+		return time.Time{}.Add(v).Format("15:04:05.999999")
+	case *big.Rat:
+		scale, _ := schema["scale"].(float64)
+		return v.FloatString(int(scale))
+	}
+	return value
+}
@@ -0,0 +1,176 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mokafka
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/confluentinc/confluent-kafka-go/v2/schemaregistry"
+	"github.com/linkedin/goavro/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+const testAvroSchema = `{
+	"type": "record",
+	"name": "User",
+	"namespace": "test",
+	"fields": [
+		{"name": "name", "type": "string"},
+		{"name": "age", "type": ["null", "int"]},
+		{"name": "address", "type": ["null", {
+			"type": "record",
+			"name": "Address",
+			"fields": [
+				{"name": "city", "type": "string"},
+				{"name": "zip", "type": ["null", "string"]}
+			]
+		}]},
+		{"name": "tags", "type": {"type": "array", "items": "string"}},
+		{"name": "created", "type": {"type": "long", "logicalType": "timestamp-millis"}}
+	]
+}`
+
+type mockSRKafkaAdapter struct {
+	MockKafkaAdapter
+	registry schemaregistry.Client
+}
+
+func (m *mockSRKafkaAdapter) GetSchemaByID(topic string, id int, isKey bool) (schemaregistry.SchemaInfo, error) {
+	return m.registry.GetBySubjectAndID(SchemaSubject(topic, isKey), id)
+}
+
+func newMockSchemaRegistry(t *testing.T) schemaregistry.Client {
+	registry, err := schemaregistry.NewClient(schemaregistry.NewConfig("mock://"))
+	require.NoError(t, err)
+	return registry
+}
+
+// encodeAvroSR encodes the native value into the confluent wire format
+func encodeAvroSR(t *testing.T, id int, schema string, native map[string]any) []byte {
+	codec, err := goavro.NewCodec(schema)
+	require.NoError(t, err)
+	buf := make([]byte, srHeaderSize)
+	binary.BigEndian.PutUint32(buf[1:], uint32(id))
+	buf, err = codec.BinaryFromNative(buf, native)
+	require.NoError(t, err)
+	return buf
+}
+
+func newTestAvroUser(name string, age any, address any) map[string]any {
+	return map[string]any{
+		"name":    name,
+		"age":     age,
+		"address": address,
+		"tags":    []any{"a", "b"},
+		"created": time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+}
+
+func TestAvroDecoder_Decode(t *testing.T) {
+	registry := newMockSchemaRegistry(t)
+	id, err := registry.Register(SchemaSubject("users", false), schemaregistry.SchemaInfo{Schema: testAvroSchema}, false)
+	require.NoError(t, err)
+
+	decoder := NewAvroDecoder(func(id int) (string, error) {
+		info, err := registry.GetBySubjectAndID(SchemaSubject("users", false), id)
+		return info.Schema, err
+	})
+
+	data := encodeAvroSR(t, id, testAvroSchema, newTestAvroUser(
+		"alice",
+		goavro.Union("int", int32(10)),
+		goavro.Union("test.Address", map[string]any{"city": "paris", "zip": goavro.Union("string", "75001")}),
+	))
+	obj, err := decoder.Decode(data)
+	require.NoError(t, err)
+	require.Equal(t, "alice", obj["name"])
+	require.Equal(t, int32(10), obj["age"])
+	require.Equal(t, []any{"a", "b"}, obj["tags"])
+	require.Equal(t, "2024-01-02 03:04:05", obj["created"])
+	city, ok := GetNestedFieldValue(obj, "address.city")
+	require.True(t, ok)
+	require.Equal(t, "paris", city)
+	zip, ok := GetNestedFieldValue(obj, "address.zip")
+	require.True(t, ok)
+	require.Equal(t, "75001", zip)
+
+	data = encodeAvroSR(t, id, testAvroSchema, newTestAvroUser("bob", nil, nil))
+	obj, err = decoder.Decode(data)
+	require.NoError(t, err)
+	require.Nil(t, obj["age"])
+	_, ok = GetNestedFieldValue(obj, "address.city")
+	require.False(t, ok)
+
+	// bad magic byte
+	data[0] = 1
+	_, err = decoder.Decode(data)
+	require.Error(t, err)
+
+	// unknown schema id
+	data = encodeAvroSR(t, id+100, testAvroSchema, newTestAvroUser("bob", nil, nil))
+	_, err = decoder.Decode(data)
+	require.Error(t, err)
+}
+
+func TestPopulateBatchFromMSGWithAvro(t *testing.T) {
+	topic := "users"
+	ka := &mockSRKafkaAdapter{registry: newMockSchemaRegistry(t)}
+	id, err := ka.registry.Register(SchemaSubject(topic, false), schemaregistry.SchemaInfo{Schema: testAvroSchema}, false)
+	require.NoError(t, err)
+
+	msgs := []*kafka.Message{
+		{Value: encodeAvroSR(t, id, testAvroSchema, newTestAvroUser(
+			"alice",
+			goavro.Union("int", int32(10)),
+			goavro.Union("test.Address", map[string]any{"city": "paris", "zip": goavro.Union("null", nil)}),
+		))},
+		{Value: encodeAvroSR(t, id, testAvroSchema, newTestAvroUser("bob", nil, nil))},
+	}
+	configs := map[string]interface{}{
+		TopicKey:          topic,
+		ValueKey:          string(AVRO),
+		SchemaRegistryKey: "mock://",
+	}
+	attrs := []string{"name", "age", "address.city", "tags", "created"}
+	typs := []types.Type{
+		types.New(types.T_varchar, 30, 0),
+		types.New(types.T_int32, 10, 0),
+		types.New(types.T_varchar, 30, 0),
+		types.New(types.T_json, 0, 0),
+		types.New(types.T_datetime, 0, 0),
+	}
+	mp := mpool.MustNewZero()
+	bat, err := PopulateBatchFromMSG(context.Background(), ka, typs, attrs, msgs, configs, mp)
+	require.NoError(t, err)
+	defer bat.Clean(mp)
+
+	require.Equal(t, 2, bat.RowCount())
+	require.Equal(t, "alice", bat.Vecs[0].GetStringAt(0))
+	require.Equal(t, "bob", bat.Vecs[0].GetStringAt(1))
+	require.Equal(t, int32(10), vector.GetFixedAtNoTypeCheck[int32](bat.Vecs[1], 0))
+	require.True(t, bat.Vecs[1].IsNull(1))
+	require.Equal(t, "paris", bat.Vecs[2].GetStringAt(0))
+	require.True(t, bat.Vecs[2].IsNull(1))
+	require.Equal(t, `["a", "b"]`, types.DecodeJson(bat.Vecs[3].GetBytesAt(0)).String())
+	require.Equal(t, "2024-01-02 03:04:05", vector.GetFixedAtNoTypeCheck[types.Datetime](bat.Vecs[4], 0).String())
+}
@@ -104,7 +104,6 @@ type KafkaMoConnector struct {
 	kafkaAdapter mokafka.KafkaAdapterInterface
 	options      map[string]string
 	ie           ie.InternalExecutor
-	decoder      Decoder
 	converter    Converter
 	resumeC      chan struct{}
 	cancelC      chan struct{}
@@ -139,7 +138,6 @@ func NewKafkaMoConnector(logger *zap.Logger, options map[string]string, ie ie.In
 		logger:      logger,
 		options:     options,
 		ie:          ie,
-		decoder:     newJsonDecoder(),
 		bufferLimit: buffer_limit,
 	}
 	if err := kmc.validateParams(); err != nil {
@@ -179,9 +177,14 @@ func (k *KafkaMoConnector) validateParams() error {
 	}
 
 	// 3. Check for supported value format
-	if k.options["value"] != "json" {
+	if !ConnectorOptConstraint[OptConnectorValue].Validator(k.options["value"]) {
 		return moerr.NewInternalError(context.Background(), "Unsupported value format")
 	}
+	for field := range ConnectorEssentialFormatOpts[k.options["value"]] {
+		if k.options[field] == "" {
+			return moerr.NewInternalError(context.Background(), "missing required params")
+		}
+	}
 
 	return nil
 }
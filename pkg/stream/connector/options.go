@@ -23,8 +23,11 @@ import (
 )
 
 const (
-	SourceKafka string = "kafka"
-	FormatJson  string = "json"
+	SourceKafka      string = "kafka"
+	FormatJson       string = "json"
+	FormatAvro       string = "avro"
+	FormatProtobuf   string = "protobuf"
+	FormatProtobufSR string = "protobuf_sr"
 )
 
 type StmtOpts map[string]string
@@ -95,6 +98,10 @@ const (
 	OptConnectorTopic   = "topic"
 	OptConnectorValue   = "value"
 
+	OptConnectorSchemaRegistry  = "schema.registry"
+	OptConnectorProtobufSchema  = "protobuf.schema"
+	OptConnectorProtobufMessage = "protobuf.message"
+
 	OptConnectorSql = "sql"
 
 	OptConnectorRel       = "relkind"
@@ -105,10 +112,15 @@ const (
 )
 
 var ConnectorOptConstraint = map[string]OptConstraint{
-	OptConnectorType:        enumOpt(SourceKafka),
-	OptConnectorServers:     addressOpt,
-	OptConnectorTopic:       stringOpt,
-	OptConnectorValue:       enumOpt(FormatJson),
+	OptConnectorType:    enumOpt(SourceKafka),
+	OptConnectorServers: addressOpt,
+	OptConnectorTopic:   stringOpt,
+	OptConnectorValue:   enumOpt(FormatJson, FormatAvro, FormatProtobuf, FormatProtobufSR),
+
+	OptConnectorSchemaRegistry:  stringOpt,
+	OptConnectorProtobufSchema:  stringOpt,
+	OptConnectorProtobufMessage: stringOpt,
+
 	OptConnectorSql:         stringOpt,
 	OptConnectorRel:         stringOpt,
 	OptConnectorPartition:   integerOpt,
@@ -128,6 +140,20 @@ var ConnectorEssentialTypeOpts = map[string]map[string]struct{}{
 	},
 }
 
+var ConnectorEssentialFormatOpts = map[string]map[string]struct{}{
+	FormatAvro: {
+		OptConnectorSchemaRegistry: {},
+	},
+	FormatProtobuf: {
+		OptConnectorProtobufSchema:  {},
+		OptConnectorProtobufMessage: {},
+	},
+	FormatProtobufSR: {
+		OptConnectorSchemaRegistry:  {},
+		OptConnectorProtobufMessage: {},
+	},
+}
+
 func MakeStmtOpts(ctx context.Context, opts map[string]string) (StmtOpts, error) {
 	if opts == nil {
 		return StmtOpts{}, nil
@@ -155,5 +181,11 @@ func MakeStmtOpts(ctx context.Context, opts map[string]string) (StmtOpts, error)
 			return StmtOpts{}, moerr.NewErrLackOption(ctx, field)
 		}
 	}
+	fields = ConnectorEssentialFormatOpts[opts[OptConnectorValue]]
+	for field := range fields {
+		if _, ok := opts[field]; !ok {
+			return StmtOpts{}, moerr.NewErrLackOption(ctx, field)
+		}
+	}
 	return mapCopy, nil
 }
@@ -51,6 +51,8 @@ func TestMakeStmtOpts(t *testing.T) {
 		{"type": "my"},
 		{"type": "kafka", "bootstrap.servers": "localhost"},
 		{"type": "kafka", "value": "a"},
+		{"type": "kafka", "bootstrap.servers": "localhost:9092", "topic": "t1", "value": "avro"},
+		{"type": "kafka", "bootstrap.servers": "localhost:9092", "topic": "t1", "value": "protobuf", "protobuf.message": "m"},
 	}
 	for _, opt := range invalidValueOptList {
 		_, err = MakeStmtOpts(context.Background(), opt)
@@ -75,4 +77,15 @@ func TestMakeStmtOpts(t *testing.T) {
 	o, err = MakeStmtOpts(context.Background(), okOpts)
 	assert.NoError(t, err)
 	assert.Equal(t, o, StmtOpts(okOpts))
+
+	okOpts = map[string]string{
+		"type":              "kafka",
+		"bootstrap.servers": "localhost:9092",
+		"topic":             "t1",
+		"value":             "avro",
+		"schema.registry":   "http://localhost:8081",
+	}
+	o, err = MakeStmtOpts(context.Background(), okOpts)
+	assert.NoError(t, err)
+	assert.Equal(t, o, StmtOpts(okOpts))
 }
@@ -896,3 +896,212 @@ func TestFaultInjection(t *testing.T) {
 			}
 		})
 }
+
+func TestMergeWithMatchedAndNotMatchedRows(t *testing.T) {
+	embed.RunBaseClusterTests(
+		func(c embed.Cluster) {
+			cn, err := c.GetCNService(0)
+			require.NoError(t, err)
+
+			db := testutils.GetDatabaseName(t)
+			testutils.CreateTestDatabase(t, db, cn)
+
+			testutils.ExecSQL(
+				t,
+				db,
+				cn,
+				"create table t (id int primary key, v int)",
+				"create table s (id int, v int)",
+				"insert into t values (1, 1), (2, 2)",
+				"insert into s values (1, 10), (3, 30), (4, 40), (5, 50)",
+			)
+
+			// the not matched source rows must not be taken as matching one target row
+			testutils.ExecSQL(
+				t,
+				db,
+				cn,
+				"merge into t using s on t.id = s.id when matched then update set v = s.v when not matched then insert values (s.id, s.v)",
+			)
+
+			exec := testutils.GetSQLExecutor(cn)
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+			defer cancel()
+			ctx = defines.AttachAccountId(ctx, 0)
+
+			readRows := func() map[int32]int32 {
+				res, err := exec.Exec(ctx, "select id, v from t", executor.Options{}.WithDatabase(db))
+				require.NoError(t, err)
+				defer res.Close()
+				rows := make(map[int32]int32)
+				res.ReadRows(
+					func(n int, cols []*vector.Vector) bool {
+						ids := executor.GetFixedRows[int32](cols[0])
+						values := executor.GetFixedRows[int32](cols[1])
+						for i := range ids {
+							rows[ids[i]] = values[i]
+						}
+						return true
+					},
+				)
+				return rows
+			}
+			require.Equal(t, map[int32]int32{1: 10, 2: 2, 3: 30, 4: 40, 5: 50}, readRows())
+
+			// two source rows match the same target row
+			testutils.ExecSQL(t, db, cn, "insert into s values (2, 20), (2, 21)")
+			_, err = exec.Exec(
+				ctx,
+				"merge into t using s on t.id = s.id when matched then update set v = s.v when not matched then insert values (s.id, s.v)",
+				executor.Options{}.WithDatabase(db),
+			)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "target row matched more than once")
+			require.Equal(t, map[int32]int32{1: 10, 2: 2, 3: 30, 4: 40, 5: 50}, readRows())
+		})
+}
+
+func TestInsertOnDuplicateKeyUpdateWithMaterializedView(t *testing.T) {
+	embed.RunBaseClusterTests(
+		func(c embed.Cluster) {
+			cn, err := c.GetCNService(0)
+			require.NoError(t, err)
+
+			db := testutils.GetDatabaseName(t)
+			testutils.CreateTestDatabase(t, db, cn)
+
+			testutils.ExecSQL(
+				t,
+				db,
+				cn,
+				"create table t (id int primary key, g int, v int)",
+				"create table u (id int primary key, w int)",
+				"create materialized view mv as select g, sum(v) as s from t group by g",
+				"insert into t values (1, 1, 10), (2, 1, 20), (3, 2, 30)",
+			)
+
+			exec := testutils.GetSQLExecutor(cn)
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+			defer cancel()
+			ctx = defines.AttachAccountId(ctx, 0)
+
+			readView := func() map[int32]int64 {
+				res, err := exec.Exec(ctx, "select g, s from mv", executor.Options{}.WithDatabase(db))
+				require.NoError(t, err)
+				defer res.Close()
+				rows := make(map[int32]int64)
+				res.ReadRows(
+					func(n int, cols []*vector.Vector) bool {
+						groups := executor.GetFixedRows[int32](cols[0])
+						sums := executor.GetFixedRows[int64](cols[1])
+						for i := range groups {
+							rows[groups[i]] = sums[i]
+						}
+						return true
+					},
+				)
+				return rows
+			}
+			require.Equal(t, map[int32]int64{1: 30, 2: 30}, readView())
+
+			// both the updated and the inserted rows refresh the view
+			testutils.ExecSQL(t, db, cn, "insert into t values (1, 1, 5), (4, 2, 7) on duplicate key update v = v + values(v)")
+			require.Equal(t, map[int32]int64{1: 35, 2: 37}, readView())
+
+			// the row moved to another group refreshes both groups
+			testutils.ExecSQL(t, db, cn, "insert into t values (3, 1, 0) on duplicate key update g = 1")
+			require.Equal(t, map[int32]int64{1: 65, 2: 7}, readView())
+
+			// the view of join can not be maintained by the keys of the changed rows
+			_, err = exec.Exec(ctx, "create materialized view mv2 as select t.g, u.w from t join u on t.id = u.id", executor.Options{}.WithDatabase(db))
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "materialized view on the join of tables")
+		})
+}
+
+func TestTriggersOnInsertOnDuplicateKeyUpdateAndMerge(t *testing.T) {
+	embed.RunBaseClusterTests(
+		func(c embed.Cluster) {
+			cn, err := c.GetCNService(0)
+			require.NoError(t, err)
+
+			db := testutils.GetDatabaseName(t)
+			testutils.CreateTestDatabase(t, db, cn)
+
+			testutils.ExecSQL(
+				t,
+				db,
+				cn,
+				"create table t (id int primary key, v varchar(10))",
+				"create table s (id int, v varchar(10), del int)",
+				"create table log (msg varchar(100))",
+				"create trigger bi before insert on t for each row set new.v = upper(new.v)",
+				"create trigger ai after insert on t for each row insert into log values (concat('ai ', new.id, ' ', new.v))",
+				"create trigger bu before update on t for each row set new.v = concat(new.v, '!')",
+				"create trigger au after update on t for each row insert into log values (concat('au ', old.id, ' ', old.v, ' ', new.v))",
+				"create trigger ad after delete on t for each row insert into log values (concat('ad ', old.id))",
+				"insert into t values (1, 'a'), (2, 'b')",
+				"delete from log",
+			)
+
+			exec := testutils.GetSQLExecutor(cn)
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+			defer cancel()
+			ctx = defines.AttachAccountId(ctx, 0)
+
+			readStrings := func(sql string) []string {
+				res, err := exec.Exec(ctx, sql, executor.Options{}.WithDatabase(db))
+				require.NoError(t, err)
+				defer res.Close()
+				var rows []string
+				res.ReadRows(
+					func(n int, cols []*vector.Vector) bool {
+						rows = append(rows, executor.GetStringRows(cols[0])...)
+						return true
+					},
+				)
+				return rows
+			}
+			readRows := func() []string {
+				return readStrings("select concat(id, ' ', v) from t order by id")
+			}
+			readLog := func() []string {
+				logs := readStrings("select msg from log order by msg")
+				testutils.ExecSQL(t, db, cn, "delete from log")
+				return logs
+			}
+
+			// the duplicate row fires the update triggers with the value changed by the before insert trigger
+			testutils.ExecSQL(t, db, cn, "insert into t values (2, 'x'), (3, 'c') on duplicate key update v = values(v)")
+			require.Equal(t, []string{"1 A", "2 X!", "3 C"}, readRows())
+			require.Equal(t, []string{"ai 3 C", "au 2 B X!"}, readLog())
+
+			// the rows fire the triggers of the actions of their clauses
+			testutils.ExecSQL(
+				t,
+				db,
+				cn,
+				"insert into s values (1, 'm', 1), (3, 'n', 0), (4, 'o', 0)",
+				"merge into t using s on t.id = s.id when matched and s.del = 1 then delete when matched then update set v = s.v when not matched then insert values (s.id, s.v)",
+			)
+			require.Equal(t, []string{"2 X!", "3 n!", "4 O"}, readRows())
+			require.Equal(t, []string{"ad 1", "ai 4 O", "au 3 C n!"}, readLog())
+
+			// the keys used to find the old rows can not be changed by the triggers
+			testutils.ExecSQL(
+				t,
+				db,
+				cn,
+				"create table t2 (id int primary key, v int)",
+				"create trigger bi2 before insert on t2 for each row set new.id = new.id + 10",
+				"create trigger bu2 before update on t2 for each row set new.id = new.id + 10",
+				"insert into t2 values (1, 1)",
+			)
+			_, err = exec.Exec(ctx, "insert into t2 values (11, 2) on duplicate key update v = 2", executor.Options{}.WithDatabase(db))
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "changing the key column 'id'")
+			_, err = exec.Exec(ctx, "merge into t2 using s on t2.id = s.id when matched then update set v = s.del", executor.Options{}.WithDatabase(db))
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "changing the key or indexed column 'id'")
+		})
+}
@@ -263,6 +263,15 @@ type Workspace interface {
 	// RollbackLastStatement rollback the last statement.
 	RollbackLastStatement(ctx context.Context) error
 
+	// Savepoint records the current writes of the workspace and returns the id of
+	// the savepoint.
+	Savepoint(ctx context.Context) (int, error)
+	// RollbackToSavepoint discards the writes after the savepoint, the savepoint
+	// itself is kept.
+	RollbackToSavepoint(ctx context.Context, id int) error
+	// ReleaseSavepoint releases the savepoint without changing the writes.
+	ReleaseSavepoint(id int)
+
 	UpdateSnapshotWriteOffset()
 	GetSnapshotWriteOffset() int
 
@@ -29,6 +29,10 @@ type SessionOverrideOptions struct {
 	AccountId     *uint32
 	UserId        *uint32
 	DefaultRoleId *uint32
+	// User and DefaultRole are the names of the user and the role, the statements are
+	// checked against the privileges of them.
+	User        *string
+	DefaultRole *string
 }
 
 type OptsBuilder struct {
@@ -71,6 +75,16 @@ func (s *OptsBuilder) DefaultRoleId(id uint32) *OptsBuilder {
 	return s
 }
 
+func (s *OptsBuilder) User(name string) *OptsBuilder {
+	s.opts.User = &name
+	return s
+}
+
+func (s *OptsBuilder) DefaultRole(name string) *OptsBuilder {
+	s.opts.DefaultRole = &name
+	return s
+}
+
 func (s *OptsBuilder) Finish() SessionOverrideOptions {
 	return *s.opts
 }
@@ -39,6 +39,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/util/metric"
 	"github.com/matrixorigin/matrixone/pkg/util/metric/stats"
 	v2 "github.com/matrixorigin/matrixone/pkg/util/metric/v2"
+	"github.com/matrixorigin/matrixone/pkg/util/otlp"
 )
 
 const (
@@ -68,6 +69,9 @@ var statusSvr *statusServer
 var internalRegistry *prom.Registry
 var internalExporter metric.MetricExporter
 
+// otlpMetricExporter pushes metrics to OpenTelemetry collector, if otlp endpoint specified.
+var otlpMetricExporter *otlpExporter
+
 var enable bool
 var inited uint32
 
@@ -119,6 +123,10 @@ func InitMetric(
 		internalExporter.Start(serviceCtx)
 		statsLogWriter.Start(serviceCtx)
 		metric.SetMetricExporter(moExporter)
+
+		if SV.OTLP.EnableMetric() {
+			initOTLPExporter(serviceCtx, SV, nodeUUID, role)
+		}
 	}
 
 	if metric.EnableExportToProm() {
@@ -149,6 +157,27 @@ func InitMetric(
 	return true
 }
 
+func initOTLPExporter(ctx context.Context, SV *config.ObservabilityParameters, nodeUUID, role string) {
+	client, err := otlp.NewClient(SV.OTLP)
+	if err != nil {
+		logutil.Errorf("[Metric] init otlp exporter failed: %v", err)
+		return
+	}
+	// collectors in registry are also registered into v2 registry, if exported to prometheus.
+	gatherers := prom.Gatherers{v2.GetPrometheusGatherer()}
+	if !metric.EnableExportToProm() {
+		gatherers = append(gatherers, registry)
+	}
+	otlpMetricExporter = newOTLPExporter(
+		client,
+		gatherers,
+		otlp.NewResource(nodeUUID, role, SV.MoVersion),
+		SV.OTLP.MetricExportInterval.Duration,
+	)
+	otlpMetricExporter.Start(ctx)
+	logutil.Debugf("[Metric] otlp exporter is ready, push to %s", SV.OTLP.Endpoint)
+}
+
 // this cron task can gather some service level metrics,
 func startCrossServicesMetricsTask(ctx context.Context) {
 	go func() {
@@ -200,6 +229,12 @@ func StopMetricSync() {
 		}
 		internalExporter = nil
 	}
+	if otlpMetricExporter != nil {
+		if ch, effect := otlpMetricExporter.Stop(true); effect {
+			<-ch
+		}
+		otlpMetricExporter = nil
+	}
 	if statsLogWriter != nil {
 		if ch, effect := statsLogWriter.Stop(true); effect {
 			<-ch
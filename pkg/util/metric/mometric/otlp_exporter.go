@@ -0,0 +1,261 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mometric
+
+import (
+	"context"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/util/otlp"
+	prom "github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	collectormetricpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+)
+
+// otlpExporter gathers metrics from prometheus registry periodically, and pushes
+// them to an OpenTelemetry collector.
+type otlpExporter struct {
+	client    otlp.Client
+	gather    prom.Gatherer
+	resource  *resourcepb.Resource
+	interval  time.Duration
+	startTime time.Time
+	now       func() time.Time
+
+	isRunning int32
+	cancel    context.CancelFunc
+	stopWg    sync.WaitGroup
+}
+
+func newOTLPExporter(
+	client otlp.Client,
+	gather prom.Gatherer,
+	resource *resourcepb.Resource,
+	interval time.Duration,
+) *otlpExporter {
+	if interval <= 0 {
+		interval = defaultGatherInterval
+	}
+	return &otlpExporter{
+		client:    client,
+		gather:    gather,
+		resource:  resource,
+		interval:  interval,
+		startTime: time.Now(),
+		now:       time.Now,
+	}
+}
+
+func (e *otlpExporter) Start(inputCtx context.Context) bool {
+	if atomic.SwapInt32(&e.isRunning, 1) == 1 {
+		return false
+	}
+	ctx, cancel := context.WithCancel(inputCtx)
+	e.cancel = cancel
+	e.stopWg.Add(1)
+	go func() {
+		defer e.stopWg.Done()
+		ticker := time.NewTicker(e.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				e.gatherAndSend(ctx)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return true
+}
+
+// Stop stops the export loop. If graceful, metrics are pushed once more before the client closed.
+func (e *otlpExporter) Stop(graceful bool) (<-chan struct{}, bool) {
+	if atomic.SwapInt32(&e.isRunning, 0) == 0 {
+		return nil, false
+	}
+	e.cancel()
+	stopCh := make(chan struct{})
+	go func() {
+		e.stopWg.Wait()
+		if graceful {
+			e.gatherAndSend(context.Background())
+		}
+		_ = e.client.Close()
+		close(stopCh)
+	}()
+	return stopCh, true
+}
+
+func (e *otlpExporter) gatherAndSend(ctx context.Context) {
+	prommfs, err := e.gather.Gather()
+	if err != nil {
+		logutil.Errorf("[Metric] otlp gather error: %v", err)
+	}
+	if len(prommfs) == 0 {
+		return
+	}
+	req := &collectormetricpb.ExportMetricsServiceRequest{
+		ResourceMetrics: []*metricpb.ResourceMetrics{{
+			Resource: e.resource,
+			ScopeMetrics: []*metricpb.ScopeMetrics{{
+				Scope:   &commonpb.InstrumentationScope{Name: otlp.ScopeName},
+				Metrics: e.convert(prommfs),
+			}},
+		}},
+	}
+	if err := e.client.ExportMetrics(ctx, req); err != nil {
+		logutil.Errorf("[Metric] otlp export err: %v", err)
+	}
+}
+
+func (e *otlpExporter) convert(mfs []*dto.MetricFamily) []*metricpb.Metric {
+	start := uint64(e.startTime.UnixNano())
+	now := uint64(e.now().UnixNano())
+	metrics := make([]*metricpb.Metric, 0, len(mfs))
+	for _, mf := range mfs {
+		if m := convertMetricFamily(mf, start, now); m != nil {
+			metrics = append(metrics, m)
+		}
+	}
+	return metrics
+}
+
+// convertMetricFamily converts prometheus metric family into OTLP metric.
+// Counter and histogram are exported as cumulative, start from the exporter started.
+func convertMetricFamily(mf *dto.MetricFamily, start, now uint64) *metricpb.Metric {
+	m := &metricpb.Metric{
+		Name:        mf.GetName(),
+		Description: mf.GetHelp(),
+	}
+	switch mf.GetType() {
+	case dto.MetricType_COUNTER:
+		points := make([]*metricpb.NumberDataPoint, 0, len(mf.Metric))
+		for _, pm := range mf.Metric {
+			points = append(points, &metricpb.NumberDataPoint{
+				Attributes:        convertLabels(pm.Label),
+				StartTimeUnixNano: start,
+				TimeUnixNano:      metricTime(pm, now),
+				Value:             &metricpb.NumberDataPoint_AsDouble{AsDouble: pm.GetCounter().GetValue()},
+			})
+		}
+		m.Data = &metricpb.Metric_Sum{Sum: &metricpb.Sum{
+			AggregationTemporality: metricpb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+			IsMonotonic:            true,
+			DataPoints:             points,
+		}}
+	case dto.MetricType_GAUGE, dto.MetricType_UNTYPED:
+		points := make([]*metricpb.NumberDataPoint, 0, len(mf.Metric))
+		for _, pm := range mf.Metric {
+			val := pm.GetGauge().GetValue()
+			if mf.GetType() == dto.MetricType_UNTYPED {
+				val = pm.GetUntyped().GetValue()
+			}
+			points = append(points, &metricpb.NumberDataPoint{
+				Attributes:   convertLabels(pm.Label),
+				TimeUnixNano: metricTime(pm, now),
+				Value:        &metricpb.NumberDataPoint_AsDouble{AsDouble: val},
+			})
+		}
+		m.Data = &metricpb.Metric_Gauge{Gauge: &metricpb.Gauge{DataPoints: points}}
+	case dto.MetricType_HISTOGRAM:
+		points := make([]*metricpb.HistogramDataPoint, 0, len(mf.Metric))
+		for _, pm := range mf.Metric {
+			points = append(points, convertHistogram(pm, start, now))
+		}
+		m.Data = &metricpb.Metric_Histogram{Histogram: &metricpb.Histogram{
+			AggregationTemporality: metricpb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+			DataPoints:             points,
+		}}
+	case dto.MetricType_SUMMARY:
+		points := make([]*metricpb.SummaryDataPoint, 0, len(mf.Metric))
+		for _, pm := range mf.Metric {
+			s := pm.GetSummary()
+			quantiles := make([]*metricpb.SummaryDataPoint_ValueAtQuantile, 0, len(s.GetQuantile()))
+			for _, q := range s.GetQuantile() {
+				quantiles = append(quantiles, &metricpb.SummaryDataPoint_ValueAtQuantile{
+					Quantile: q.GetQuantile(),
+					Value:    q.GetValue(),
+				})
+			}
+			points = append(points, &metricpb.SummaryDataPoint{
+				Attributes:        convertLabels(pm.Label),
+				StartTimeUnixNano: start,
+				TimeUnixNano:      metricTime(pm, now),
+				Count:             s.GetSampleCount(),
+				Sum:               s.GetSampleSum(),
+				QuantileValues:    quantiles,
+			})
+		}
+		m.Data = &metricpb.Metric_Summary{Summary: &metricpb.Summary{DataPoints: points}}
+	default:
+		return nil
+	}
+	return m
+}
+
+// convertHistogram converts the cumulative buckets of prometheus into OTLP bucket counts,
+// which has one more bucket than bounds for (last bound, +Inf].
+func convertHistogram(pm *dto.Metric, start, now uint64) *metricpb.HistogramDataPoint {
+	h := pm.GetHistogram()
+	buckets := h.GetBucket()
+	bounds := make([]float64, 0, len(buckets))
+	counts := make([]uint64, 0, len(buckets)+1)
+	var prev uint64
+	for _, b := range buckets {
+		if math.IsInf(b.GetUpperBound(), 1) {
+			continue
+		}
+		bounds = append(bounds, b.GetUpperBound())
+		counts = append(counts, b.GetCumulativeCount()-prev)
+		prev = b.GetCumulativeCount()
+	}
+	counts = append(counts, h.GetSampleCount()-prev)
+	sum := h.GetSampleSum()
+	return &metricpb.HistogramDataPoint{
+		Attributes:        convertLabels(pm.Label),
+		StartTimeUnixNano: start,
+		TimeUnixNano:      metricTime(pm, now),
+		Count:             h.GetSampleCount(),
+		Sum:               &sum,
+		BucketCounts:      counts,
+		ExplicitBounds:    bounds,
+	}
+}
+
+func convertLabels(labels []*dto.LabelPair) []*commonpb.KeyValue {
+	if len(labels) == 0 {
+		return nil
+	}
+	attrs := make([]*commonpb.KeyValue, 0, len(labels))
+	for _, l := range labels {
+		attrs = append(attrs, otlp.StringKeyValue(l.GetName(), l.GetValue()))
+	}
+	return attrs
+}
+
+func metricTime(pm *dto.Metric, now uint64) uint64 {
+	if pm.TimestampMs != nil {
+		return uint64(time.UnixMilli(pm.GetTimestampMs()).UnixNano())
+	}
+	return now
+}
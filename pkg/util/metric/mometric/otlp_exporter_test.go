@@ -0,0 +1,131 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mometric
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/util/otlp"
+	"github.com/matrixorigin/matrixone/pkg/util/otlp/otlptest"
+	prom "github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metricpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+)
+
+func newOTLPTestRegistry(t *testing.T) *prom.Registry {
+	reg := prom.NewRegistry()
+	counter := prom.NewCounterVec(prom.CounterOpts{Name: "test_counter", Help: "counter"}, []string{"type"})
+	gauge := prom.NewGauge(prom.GaugeOpts{Name: "test_gauge"})
+	histogram := prom.NewHistogram(prom.HistogramOpts{Name: "test_histogram", Buckets: []float64{1, 10}})
+	summary := prom.NewSummary(prom.SummaryOpts{Name: "test_summary", Objectives: map[float64]float64{0.5: 0.05}})
+	require.NoError(t, reg.Register(counter))
+	require.NoError(t, reg.Register(gauge))
+	require.NoError(t, reg.Register(histogram))
+	require.NoError(t, reg.Register(summary))
+
+	counter.WithLabelValues("a").Add(3)
+	gauge.Set(5)
+	histogram.Observe(0.5)
+	histogram.Observe(5)
+	histogram.Observe(50)
+	summary.Observe(1)
+	return reg
+}
+
+func TestOTLPExporterConvert(t *testing.T) {
+	reg := newOTLPTestRegistry(t)
+	mfs, err := reg.Gather()
+	require.NoError(t, err)
+
+	e := newOTLPExporter(nil, reg, otlp.NewResource("node", "CN", ""), time.Second)
+	metrics := make(map[string]*metricpb.Metric)
+	for _, m := range e.convert(mfs) {
+		metrics[m.Name] = m
+	}
+	require.Len(t, metrics, 4)
+
+	sum := metrics["test_counter"].GetSum()
+	require.NotNil(t, sum)
+	assert.True(t, sum.IsMonotonic)
+	assert.Equal(t, metricpb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE, sum.AggregationTemporality)
+	require.Len(t, sum.DataPoints, 1)
+	assert.Equal(t, 3.0, sum.DataPoints[0].GetAsDouble())
+	assert.Equal(t, "type", sum.DataPoints[0].Attributes[0].Key)
+	assert.Equal(t, "a", sum.DataPoints[0].Attributes[0].Value.GetStringValue())
+	assert.Equal(t, "counter", metrics["test_counter"].Description)
+
+	gauge := metrics["test_gauge"].GetGauge()
+	require.NotNil(t, gauge)
+	assert.Equal(t, 5.0, gauge.DataPoints[0].GetAsDouble())
+
+	hist := metrics["test_histogram"].GetHistogram()
+	require.NotNil(t, hist)
+	dp := hist.DataPoints[0]
+	assert.Equal(t, uint64(3), dp.Count)
+	assert.Equal(t, 55.5, dp.GetSum())
+	assert.Equal(t, []float64{1, 10}, dp.ExplicitBounds)
+	assert.Equal(t, []uint64{1, 1, 1}, dp.BucketCounts)
+
+	summary := metrics["test_summary"].GetSummary()
+	require.NotNil(t, summary)
+	assert.Equal(t, uint64(1), summary.DataPoints[0].Count)
+	require.Len(t, summary.DataPoints[0].QuantileValues, 1)
+	assert.Equal(t, 0.5, summary.DataPoints[0].QuantileValues[0].Quantile)
+}
+
+func TestOTLPExporter(t *testing.T) {
+	collector, err := otlptest.NewCollector()
+	require.NoError(t, err)
+	defer collector.Close()
+
+	for _, c := range []struct {
+		protocol string
+		endpoint string
+	}{
+		{protocol: config.OTLPProtocolGRPC, endpoint: collector.GRPCEndpoint()},
+		{protocol: config.OTLPProtocolHTTP, endpoint: collector.HTTPEndpoint()},
+	} {
+		t.Run(c.protocol, func(t *testing.T) {
+			cfg := config.NewOBOTLPConfig()
+			cfg.Protocol = c.protocol
+			cfg.Endpoint = c.endpoint
+			client, err := otlp.NewClient(*cfg)
+			require.NoError(t, err)
+
+			before := len(collector.MetricRequests())
+			e := newOTLPExporter(client, newOTLPTestRegistry(t), otlp.NewResource("node", "CN", ""), 10*time.Millisecond)
+			require.True(t, e.Start(context.Background()))
+			require.False(t, e.Start(context.Background()))
+			require.Eventually(t, func() bool {
+				return len(collector.MetricRequests()) > before
+			}, 5*time.Second, 10*time.Millisecond)
+
+			ch, effect := e.Stop(true)
+			require.True(t, effect)
+			<-ch
+			_, effect = e.Stop(true)
+			require.False(t, effect)
+
+			reqs := collector.MetricRequests()
+			rm := reqs[len(reqs)-1].ResourceMetrics[0]
+			assert.Equal(t, otlp.ScopeName, rm.ScopeMetrics[0].Scope.Name)
+			assert.Len(t, rm.ScopeMetrics[0].Metrics, 4)
+		})
+	}
+}
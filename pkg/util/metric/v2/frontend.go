@@ -139,6 +139,7 @@ var (
 		}, []string{"type"})
 	CdcMysqlConnErrorCounter = cdcErrorCounter.WithLabelValues("mysql-conn")
 	CdcMysqlSinkErrorCounter = cdcErrorCounter.WithLabelValues("mysql-sink")
+	CdcKafkaSinkErrorCounter = cdcErrorCounter.WithLabelValues("kafka-sink")
 
 	cdcProcessingRecordCountGauge = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
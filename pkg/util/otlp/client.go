@@ -0,0 +1,120 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	collectormetricpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	collectortracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+)
+
+const (
+	// ServiceName is the service.name resource attribute of all exported data
+	ServiceName = "matrixone"
+	// ScopeName is the name of the instrumentation scope of all exported data
+	ScopeName = "github.com/matrixorigin/matrixone"
+)
+
+// Client sends OTLP requests to an OpenTelemetry collector
+type Client interface {
+	ExportTraces(ctx context.Context, req *collectortracepb.ExportTraceServiceRequest) error
+	ExportMetrics(ctx context.Context, req *collectormetricpb.ExportMetricsServiceRequest) error
+	Close() error
+}
+
+// NewClient creates a Client by the protocol of cfg
+func NewClient(cfg config.OBOTLPConfig) (Client, error) {
+	cfg.SetDefaultValues()
+	if cfg.Endpoint == "" {
+		return nil, moerr.NewBadConfigNoCtx("otlp endpoint not specified")
+	}
+	switch strings.ToLower(cfg.Protocol) {
+	case config.OTLPProtocolGRPC:
+		return newGRPCClient(cfg)
+	case config.OTLPProtocolHTTP:
+		return newHTTPClient(cfg)
+	default:
+		return nil, moerr.NewBadConfigNoCtxf("otlp protocol %s not supported", cfg.Protocol)
+	}
+}
+
+// NewResource returns the resource describing a MatrixOne node
+func NewResource(nodeUUID, nodeType, version string) *resourcepb.Resource {
+	attrs := []*commonpb.KeyValue{
+		StringKeyValue("service.name", ServiceName),
+	}
+	if nodeUUID != "" {
+		attrs = append(attrs, StringKeyValue("service.instance.id", nodeUUID))
+	}
+	if nodeType != "" {
+		attrs = append(attrs, StringKeyValue("mo.node.type", nodeType))
+	}
+	if version != "" {
+		attrs = append(attrs, StringKeyValue("service.version", version))
+	}
+	return &resourcepb.Resource{
+		Attributes: attrs,
+	}
+}
+
+func StringKeyValue(key, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{
+		Key: key,
+		Value: &commonpb.AnyValue{
+			Value: &commonpb.AnyValue_StringValue{
+				StringValue: value,
+			},
+		},
+	}
+}
+
+func IntKeyValue(key string, value int64) *commonpb.KeyValue {
+	return &commonpb.KeyValue{
+		Key: key,
+		Value: &commonpb.AnyValue{
+			Value: &commonpb.AnyValue_IntValue{
+				IntValue: value,
+			},
+		},
+	}
+}
+
+func DoubleKeyValue(key string, value float64) *commonpb.KeyValue {
+	return &commonpb.KeyValue{
+		Key: key,
+		Value: &commonpb.AnyValue{
+			Value: &commonpb.AnyValue_DoubleValue{
+				DoubleValue: value,
+			},
+		},
+	}
+}
+
+func BoolKeyValue(key string, value bool) *commonpb.KeyValue {
+	return &commonpb.KeyValue{
+		Key: key,
+		Value: &commonpb.AnyValue{
+			Value: &commonpb.AnyValue_BoolValue{
+				BoolValue: value,
+			},
+		},
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/util/otlp/otlptest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	collectormetricpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	collectortracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	metricpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+)
+
+func TestClient(t *testing.T) {
+	collector, err := otlptest.NewCollector()
+	require.NoError(t, err)
+	defer collector.Close()
+
+	for _, c := range []struct {
+		protocol string
+		endpoint string
+	}{
+		{protocol: config.OTLPProtocolGRPC, endpoint: collector.GRPCEndpoint()},
+		{protocol: config.OTLPProtocolHTTP, endpoint: collector.HTTPEndpoint()},
+	} {
+		t.Run(c.protocol, func(t *testing.T) {
+			ctx := context.Background()
+			cfg := config.NewOBOTLPConfig()
+			cfg.Protocol = c.protocol
+			cfg.Endpoint = c.endpoint
+			cfg.Headers = map[string]string{"x-mo-token": c.protocol}
+			client, err := NewClient(*cfg)
+			require.NoError(t, err)
+			defer client.Close()
+
+			resource := NewResource("uuid", "CN", "v1")
+			err = client.ExportTraces(ctx, &collectortracepb.ExportTraceServiceRequest{
+				ResourceSpans: []*tracepb.ResourceSpans{{
+					Resource: resource,
+					ScopeSpans: []*tracepb.ScopeSpans{{
+						Spans: []*tracepb.Span{{Name: "span-" + c.protocol}},
+					}},
+				}},
+			})
+			require.NoError(t, err)
+			err = client.ExportMetrics(ctx, &collectormetricpb.ExportMetricsServiceRequest{
+				ResourceMetrics: []*metricpb.ResourceMetrics{{
+					Resource: resource,
+					ScopeMetrics: []*metricpb.ScopeMetrics{{
+						Metrics: []*metricpb.Metric{{Name: "metric-" + c.protocol}},
+					}},
+				}},
+			})
+			require.NoError(t, err)
+
+			var found bool
+			for _, span := range collector.Spans() {
+				found = found || span.Name == "span-"+c.protocol
+			}
+			assert.True(t, found)
+			found = false
+			for _, m := range collector.Metrics() {
+				found = found || m.Name == "metric-"+c.protocol
+			}
+			assert.True(t, found)
+			found = false
+			for _, headers := range collector.Headers() {
+				found = found || headers["x-mo-token"] == c.protocol
+			}
+			assert.True(t, found)
+		})
+	}
+}
+
+func TestClientBadConfig(t *testing.T) {
+	cfg := config.NewOBOTLPConfig()
+	_, err := NewClient(*cfg)
+	assert.Error(t, err)
+
+	cfg.Endpoint = "127.0.0.1:4317"
+	cfg.Protocol = "thrift"
+	_, err = NewClient(*cfg)
+	assert.Error(t, err)
+}
+
+func TestHTTPClientErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	cfg := config.NewOBOTLPConfig()
+	cfg.Protocol = config.OTLPProtocolHTTP
+	cfg.Endpoint = server.URL
+	client, err := NewClient(*cfg)
+	require.NoError(t, err)
+	defer client.Close()
+	err = client.ExportTraces(context.Background(), &collectortracepb.ExportTraceServiceRequest{})
+	assert.Error(t, err)
+}
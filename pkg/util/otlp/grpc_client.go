@@ -0,0 +1,96 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlp
+
+import (
+	"context"
+	"crypto/tls"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/config"
+	collectormetricpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	collectortracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+type grpcClient struct {
+	conn    *grpc.ClientConn
+	traces  collectortracepb.TraceServiceClient
+	metrics collectormetricpb.MetricsServiceClient
+	headers metadata.MD
+	timeout time.Duration
+}
+
+var _ Client = new(grpcClient)
+
+func newGRPCClient(cfg config.OBOTLPConfig) (*grpcClient, error) {
+	target := cfg.Endpoint
+	useTLS := !cfg.Insecure
+	switch {
+	case strings.HasPrefix(target, "http://"):
+		target = strings.TrimPrefix(target, "http://")
+		useTLS = false
+	case strings.HasPrefix(target, "https://"):
+		target = strings.TrimPrefix(target, "https://")
+		useTLS = true
+	}
+	target = strings.TrimSuffix(target, "/")
+
+	creds := insecure.NewCredentials()
+	if useTLS {
+		creds = credentials.NewTLS(&tls.Config{})
+	}
+	conn, err := grpc.Dial(target, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, err
+	}
+
+	return &grpcClient{
+		conn:    conn,
+		traces:  collectortracepb.NewTraceServiceClient(conn),
+		metrics: collectormetricpb.NewMetricsServiceClient(conn),
+		headers: metadata.New(cfg.Headers),
+		timeout: cfg.Timeout.Duration,
+	}, nil
+}
+
+func (c *grpcClient) ExportTraces(ctx context.Context, req *collectortracepb.ExportTraceServiceRequest) error {
+	ctx, cancel := c.requestContext(ctx)
+	defer cancel()
+	_, err := c.traces.Export(ctx, req)
+	return err
+}
+
+func (c *grpcClient) ExportMetrics(ctx context.Context, req *collectormetricpb.ExportMetricsServiceRequest) error {
+	ctx, cancel := c.requestContext(ctx)
+	defer cancel()
+	_, err := c.metrics.Export(ctx, req)
+	return err
+}
+
+func (c *grpcClient) Close() error {
+	return c.conn.Close()
+}
+
+func (c *grpcClient) requestContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.headers.Len() > 0 {
+		ctx = metadata.NewOutgoingContext(ctx, c.headers)
+	}
+	return context.WithTimeout(ctx, c.timeout)
+}
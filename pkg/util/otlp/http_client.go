@@ -0,0 +1,105 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlp
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	collectormetricpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	collectortracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	httpTracesPath  = "/v1/traces"
+	httpMetricsPath = "/v1/metrics"
+
+	httpContentType = "application/x-protobuf"
+)
+
+type httpClient struct {
+	client     *http.Client
+	tracesURL  string
+	metricsURL string
+	headers    map[string]string
+}
+
+var _ Client = new(httpClient)
+
+func newHTTPClient(cfg config.OBOTLPConfig) (*httpClient, error) {
+	baseURL := strings.TrimSuffix(cfg.Endpoint, "/")
+	if !strings.HasPrefix(baseURL, "http://") && !strings.HasPrefix(baseURL, "https://") {
+		if cfg.Insecure {
+			baseURL = "http://" + baseURL
+		} else {
+			baseURL = "https://" + baseURL
+		}
+	}
+	return &httpClient{
+		client: &http.Client{
+			Timeout: cfg.Timeout.Duration,
+		},
+		tracesURL:  baseURL + httpTracesPath,
+		metricsURL: baseURL + httpMetricsPath,
+		headers:    cfg.Headers,
+	}, nil
+}
+
+func (c *httpClient) ExportTraces(ctx context.Context, req *collectortracepb.ExportTraceServiceRequest) error {
+	return c.post(ctx, c.tracesURL, req)
+}
+
+func (c *httpClient) ExportMetrics(ctx context.Context, req *collectormetricpb.ExportMetricsServiceRequest) error {
+	return c.post(ctx, c.metricsURL, req)
+}
+
+func (c *httpClient) Close() error {
+	c.client.CloseIdleConnections()
+	return nil
+}
+
+func (c *httpClient) post(ctx context.Context, url string, msg proto.Message) error {
+	body, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", httpContentType)
+	for k, v := range c.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return moerr.NewInternalErrorNoCtxf("otlp export to %s failed: %s %s", url, resp.Status, msg)
+	}
+	// drain for connection reuse
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
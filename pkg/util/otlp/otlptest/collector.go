@@ -0,0 +1,245 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package otlptest provides an in-process OpenTelemetry collector stub for tests.
+package otlptest
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	collectormetricpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	collectortracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	metricpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// Collector accepts OTLP requests by both grpc and http, and keeps them in memory
+type Collector struct {
+	grpcServer *grpc.Server
+	grpcAddr   string
+	httpServer *httptest.Server
+	grpcWait   sync.WaitGroup
+
+	mu             sync.Mutex
+	traceRequests  []*collectortracepb.ExportTraceServiceRequest
+	metricRequests []*collectormetricpb.ExportMetricsServiceRequest
+	headers        []map[string]string
+}
+
+func NewCollector() (*Collector, error) {
+	c := new(Collector)
+
+	// grpc
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	c.grpcAddr = listener.Addr().String()
+	c.grpcServer = grpc.NewServer()
+	collectortracepb.RegisterTraceServiceServer(c.grpcServer, &traceService{c: c})
+	collectormetricpb.RegisterMetricsServiceServer(c.grpcServer, &metricsService{c: c})
+	c.grpcWait.Add(1)
+	go func() {
+		defer c.grpcWait.Done()
+		_ = c.grpcServer.Serve(listener)
+	}()
+
+	// http
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/traces", func(w http.ResponseWriter, r *http.Request) {
+		req := new(collectortracepb.ExportTraceServiceRequest)
+		if !decodeHTTPRequest(w, r, req) {
+			return
+		}
+		c.addTraces(req, httpHeaders(r))
+		writeHTTPResponse(w, &collectortracepb.ExportTraceServiceResponse{})
+	})
+	mux.HandleFunc("/v1/metrics", func(w http.ResponseWriter, r *http.Request) {
+		req := new(collectormetricpb.ExportMetricsServiceRequest)
+		if !decodeHTTPRequest(w, r, req) {
+			return
+		}
+		c.addMetrics(req, httpHeaders(r))
+		writeHTTPResponse(w, &collectormetricpb.ExportMetricsServiceResponse{})
+	})
+	c.httpServer = httptest.NewServer(mux)
+
+	return c, nil
+}
+
+// GRPCEndpoint returns the endpoint of the grpc receiver, TLS is not enabled
+func (c *Collector) GRPCEndpoint() string {
+	return "http://" + c.grpcAddr
+}
+
+// HTTPEndpoint returns the endpoint of the http receiver
+func (c *Collector) HTTPEndpoint() string {
+	return c.httpServer.URL
+}
+
+func (c *Collector) Close() {
+	c.grpcServer.Stop()
+	c.grpcWait.Wait()
+	c.httpServer.Close()
+}
+
+// Spans returns all received spans
+func (c *Collector) Spans() []*tracepb.Span {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var ret []*tracepb.Span
+	for _, req := range c.traceRequests {
+		for _, rs := range req.ResourceSpans {
+			for _, ss := range rs.ScopeSpans {
+				ret = append(ret, ss.Spans...)
+			}
+		}
+	}
+	return ret
+}
+
+// Metrics returns all received metrics
+func (c *Collector) Metrics() []*metricpb.Metric {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var ret []*metricpb.Metric
+	for _, req := range c.metricRequests {
+		for _, rm := range req.ResourceMetrics {
+			for _, sm := range rm.ScopeMetrics {
+				ret = append(ret, sm.Metrics...)
+			}
+		}
+	}
+	return ret
+}
+
+// TraceRequests returns all received trace requests
+func (c *Collector) TraceRequests() []*collectortracepb.ExportTraceServiceRequest {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]*collectortracepb.ExportTraceServiceRequest(nil), c.traceRequests...)
+}
+
+// MetricRequests returns all received metric requests
+func (c *Collector) MetricRequests() []*collectormetricpb.ExportMetricsServiceRequest {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]*collectormetricpb.ExportMetricsServiceRequest(nil), c.metricRequests...)
+}
+
+// Headers returns headers of all received requests, keys are in lower case
+func (c *Collector) Headers() []map[string]string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]map[string]string(nil), c.headers...)
+}
+
+func (c *Collector) addTraces(req *collectortracepb.ExportTraceServiceRequest, headers map[string]string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.traceRequests = append(c.traceRequests, req)
+	c.headers = append(c.headers, headers)
+}
+
+func (c *Collector) addMetrics(req *collectormetricpb.ExportMetricsServiceRequest, headers map[string]string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.metricRequests = append(c.metricRequests, req)
+	c.headers = append(c.headers, headers)
+}
+
+type traceService struct {
+	collectortracepb.UnimplementedTraceServiceServer
+	c *Collector
+}
+
+func (s *traceService) Export(
+	ctx context.Context,
+	req *collectortracepb.ExportTraceServiceRequest,
+) (*collectortracepb.ExportTraceServiceResponse, error) {
+	s.c.addTraces(req, grpcHeaders(ctx))
+	return &collectortracepb.ExportTraceServiceResponse{}, nil
+}
+
+type metricsService struct {
+	collectormetricpb.UnimplementedMetricsServiceServer
+	c *Collector
+}
+
+func (s *metricsService) Export(
+	ctx context.Context,
+	req *collectormetricpb.ExportMetricsServiceRequest,
+) (*collectormetricpb.ExportMetricsServiceResponse, error) {
+	s.c.addMetrics(req, grpcHeaders(ctx))
+	return &collectormetricpb.ExportMetricsServiceResponse{}, nil
+}
+
+func grpcHeaders(ctx context.Context) map[string]string {
+	ret := make(map[string]string)
+	md, _ := metadata.FromIncomingContext(ctx)
+	for k, v := range md {
+		if len(v) > 0 {
+			ret[k] = v[0]
+		}
+	}
+	return ret
+}
+
+func httpHeaders(r *http.Request) map[string]string {
+	ret := make(map[string]string)
+	for k := range r.Header {
+		ret[strings.ToLower(k)] = r.Header.Get(k)
+	}
+	return ret
+}
+
+func decodeHTTPRequest(w http.ResponseWriter, r *http.Request, msg proto.Message) bool {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return false
+	}
+	if r.Header.Get("Content-Type") != "application/x-protobuf" {
+		w.WriteHeader(http.StatusUnsupportedMediaType)
+		return false
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return false
+	}
+	if err := proto.Unmarshal(body, msg); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return false
+	}
+	return true
+}
+
+func writeHTTPResponse(w http.ResponseWriter, msg proto.Message) {
+	body, err := proto.Marshal(msg)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	_, _ = w.Write(body)
+}
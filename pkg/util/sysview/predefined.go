@@ -248,30 +248,31 @@ var (
 		"MAXLEN int unsigned" +
 		")"
 
-	InformationSchemaTriggersDDL = "CREATE TABLE information_schema.TRIGGERS (" +
-		"TRIGGER_CATALOG varchar(64)," +
-		"TRIGGER_SCHEMA varchar(64)," +
-		"TRIGGER_NAME varchar(64)," +
-		"EVENT_MANIPULATION varchar(10)," +
-		"EVENT_OBJECT_CATALOG varchar(64)," +
-		"EVENT_OBJECT_SCHEMA varchar(64)," +
-		"EVENT_OBJECT_TABLE varchar(64)," +
-		"ACTION_ORDER int unsigned," +
-		"ACTION_CONDITION binary(0)," +
-		"ACTION_STATEMENT longtext," +
-		"ACTION_ORIENTATION varchar(3)," +
-		"ACTION_TIMING varchar(10)," +
-		"ACTION_REFERENCE_OLD_TABLE binary(0)," +
-		"ACTION_REFERENCE_NEW_TABLE binary(0)," +
-		"ACTION_REFERENCE_OLD_ROW varchar(3)," +
-		"ACTION_REFERENCE_NEW_ROW varchar(3)," +
-		"CREATED timestamp(2)," +
-		"SQL_MODE varchar(10)," +
-		"DEFINER varchar(288)," +
-		"CHARACTER_SET_CLIENT varchar(64)," +
-		"COLLATION_CONNECTION varchar(64)," +
-		"DATABASE_COLLATION varchar(64)" +
-		")"
+	InformationSchemaTriggersDDL = "CREATE VIEW information_schema.TRIGGERS AS " +
+		"SELECT 'def' AS TRIGGER_CATALOG," +
+		"tbl.reldatabase AS TRIGGER_SCHEMA," +
+		"trg.trigger_name AS TRIGGER_NAME," +
+		"trg.event_manipulation AS EVENT_MANIPULATION," +
+		"'def' AS EVENT_OBJECT_CATALOG," +
+		"tbl.reldatabase AS EVENT_OBJECT_SCHEMA," +
+		"tbl.relname AS EVENT_OBJECT_TABLE," +
+		"trg.action_order AS ACTION_ORDER," +
+		"NULL AS ACTION_CONDITION," +
+		"trg.action_statement AS ACTION_STATEMENT," +
+		"'ROW' AS ACTION_ORIENTATION," +
+		"trg.action_timing AS ACTION_TIMING," +
+		"NULL AS ACTION_REFERENCE_OLD_TABLE," +
+		"NULL AS ACTION_REFERENCE_NEW_TABLE," +
+		"'OLD' AS ACTION_REFERENCE_OLD_ROW," +
+		"'NEW' AS ACTION_REFERENCE_NEW_ROW," +
+		"trg.created_time AS CREATED," +
+		"trg.sql_mode AS SQL_MODE," +
+		"trg.definer AS DEFINER," +
+		"trg.character_set_client AS CHARACTER_SET_CLIENT," +
+		"trg.collation_connection AS COLLATION_CONNECTION," +
+		"trg.database_collation AS DATABASE_COLLATION " +
+		"FROM mo_catalog.mo_triggers trg join mo_catalog.mo_tables tbl on trg.table_id = tbl.rel_id " +
+		"where tbl.account_id = current_account_id()"
 
 	InformationSchemaTablesDDL = fmt.Sprintf("CREATE VIEW information_schema.TABLES AS "+
 		"SELECT 'def' AS TABLE_CATALOG,"+
@@ -510,32 +511,33 @@ var (
 		"ENFORCED varchar(3) NOT NULL DEFAULT ''" +
 		")"
 
-	InformationSchemaEventsDDL = "CREATE TABLE information_schema.EVENTS (" +
-		"EVENT_CATALOG varchar(64)," +
-		"EVENT_SCHEMA varchar(64)," +
-		"EVENT_NAME varchar(64) NOT NULL," +
-		"`DEFINER` varchar(288) NOT NULL," +
-		"TIME_ZONE varchar(64) NOT NULL," +
-		"EVENT_BODY varchar(3) NOT NULL DEFAULT ''," +
-		"EVENT_DEFINITION longtext NOT NULL," +
-		"EVENT_TYPE varchar(9) NOT NULL DEFAULT ''," +
-		"EXECUTE_AT datetime," +
-		"INTERVAL_VALUE varchar(256)," +
-		"INTERVAL_FIELD enum('YEAR','QUARTER','MONTH','DAY','HOUR','MINUTE','WEEK','SECOND','MICROSECOND','YEAR_MONTH','DAY_HOUR','DAY_MINUTE','DAY_SECOND','HOUR_MINUTE','HOUR_SECOND','MINUTE_SECOND','DAY_MICROSECOND','HOUR_MICROSECOND','MINUTE_MICROSECOND','SECOND_MICROSECOND')," +
-		"SQL_MODE varchar(64) NOT NULL," +
-		"STARTS datetime," +
-		"ENDS datetime," +
-		"STATUS varchar(21) NOT NULL DEFAULT ''," +
-		"ON_COMPLETION varchar(12) NOT NULL DEFAULT ''," +
-		"CREATED timestamp NOT NULL," +
-		"LAST_ALTERED timestamp NOT NULL," +
-		"LAST_EXECUTED datetime," +
-		"EVENT_COMMENT varchar(2048) NOT NULL," +
-		"ORIGINATOR int unsigned NOT NULL," +
-		"CHARACTER_SET_CLIENT varchar(64) NOT NULL," +
-		"COLLATION_CONNECTION varchar(64) NOT NULL," +
-		"DATABASE_COLLATION varchar(64) NOT NULL" +
-		")"
+	InformationSchemaEventsDDL = "CREATE VIEW information_schema.EVENTS AS " +
+		"SELECT 'def' AS EVENT_CATALOG," +
+		"db.datname AS EVENT_SCHEMA," +
+		"ev.event_name AS EVENT_NAME," +
+		"ev.definer AS `DEFINER`," +
+		"'SYSTEM' AS TIME_ZONE," +
+		"'SQL' AS EVENT_BODY," +
+		"ev.event_body AS EVENT_DEFINITION," +
+		"ev.event_type AS EVENT_TYPE," +
+		"ev.execute_at AS EXECUTE_AT," +
+		"ev.interval_value AS INTERVAL_VALUE," +
+		"ev.interval_field AS INTERVAL_FIELD," +
+		"'' AS SQL_MODE," +
+		"ev.starts AS STARTS," +
+		"ev.ends AS ENDS," +
+		"ev.status AS STATUS," +
+		"ev.on_completion AS ON_COMPLETION," +
+		"ev.created AS CREATED," +
+		"ev.last_altered AS LAST_ALTERED," +
+		"ev.last_executed AS LAST_EXECUTED," +
+		"ev.event_comment AS EVENT_COMMENT," +
+		"0 AS ORIGINATOR," +
+		"'utf8mb4' AS CHARACTER_SET_CLIENT," +
+		"'utf8mb4_0900_ai_ci' AS COLLATION_CONNECTION," +
+		"'utf8mb4_0900_ai_ci' AS DATABASE_COLLATION " +
+		"FROM mo_catalog.mo_events ev join mo_catalog.mo_database db on ev.database_id = db.dat_id " +
+		"where db.account_id = current_account_id()"
 
 	InformationSchemaFilesDDL = "CREATE TABLE information_schema.FILES (" +
 		"FILE_ID  bigint NOT NULL," +
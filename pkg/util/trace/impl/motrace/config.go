@@ -107,6 +107,9 @@ type tracerProviderConfig struct {
 	// labels for db_logger select target cn as operator.
 	labels map[string]string
 
+	// otlp, export spans to OpenTelemetry collector if endpoint specified.
+	otlp config.OBOTLPConfig // WithOTLP
+
 	mux sync.RWMutex
 }
 
@@ -276,6 +279,12 @@ func WithLabels(l map[string]string) tracerProviderOption {
 	}
 }
 
+func WithOTLP(cfg config.OBOTLPConfig) tracerProviderOption {
+	return func(c *tracerProviderConfig) {
+		c.otlp = cfg
+	}
+}
+
 func WithAggregatorWindow(window time.Duration) tracerProviderOption {
 	return func(cfg *tracerProviderConfig) {
 		cfg.aggregationWindow = window
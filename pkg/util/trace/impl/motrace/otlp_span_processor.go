@@ -0,0 +1,287 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package motrace
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/util/otlp"
+	"github.com/matrixorigin/matrixone/pkg/util/trace"
+	collectortracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+const (
+	otlpSpanQueueSize = 8192
+	otlpSpanBatchSize = 512
+
+	// otlpSpanKindKey keeps the original MO span kind, which is more detailed than the OTLP one.
+	otlpSpanKindKey = "mo.span.kind"
+)
+
+var _ trace.SpanProcessor = &otlpSpanProcessor{}
+
+// otlpSpanProcessor converts ended spans into OTLP format and sends them to an
+// OpenTelemetry collector in batches.
+//
+// MOSpan is released into pool once it is handled by batchSpanProcessor, so
+// the conversion is done synchronously in OnEnd.
+type otlpSpanProcessor struct {
+	client   otlp.Client
+	resource *resourcepb.Resource
+	interval time.Duration
+
+	queue   chan *tracepb.Span
+	dropped atomic.Uint64
+
+	stopWait sync.WaitGroup
+	stopOnce sync.Once
+	stopCh   chan struct{}
+}
+
+func newOTLPSpanProcessor(
+	client otlp.Client,
+	resource *resourcepb.Resource,
+	interval time.Duration,
+) *otlpSpanProcessor {
+	if interval <= 0 {
+		interval = time.Second
+	}
+	p := &otlpSpanProcessor{
+		client:   client,
+		resource: resource,
+		interval: interval,
+		queue:    make(chan *tracepb.Span, otlpSpanQueueSize),
+		stopCh:   make(chan struct{}),
+	}
+	p.stopWait.Add(1)
+	go p.loop()
+	return p
+}
+
+// OnStart method does nothing.
+func (p *otlpSpanProcessor) OnStart(ctx context.Context, s trace.Span) {}
+
+// OnEnd converts the span and enqueues it. The span is dropped if the queue is full.
+func (p *otlpSpanProcessor) OnEnd(s trace.Span) {
+	span, ok := s.(*MOSpan)
+	if !ok {
+		return
+	}
+	// spans without trace id are not part of any trace, and are invalid in OTLP.
+	if span.TraceID.IsZero() {
+		return
+	}
+	select {
+	case <-p.stopCh:
+		return
+	default:
+	}
+	select {
+	case p.queue <- convertMOSpan(span):
+	default:
+		p.dropped.Add(1)
+	}
+}
+
+// Shutdown exports all queued spans and closes the client.
+// It only executes once. Subsequent call does nothing.
+func (p *otlpSpanProcessor) Shutdown(ctx context.Context) error {
+	var err error
+	p.stopOnce.Do(func() {
+		wait := make(chan struct{})
+		go func() {
+			close(p.stopCh)
+			p.stopWait.Wait()
+			close(wait)
+		}()
+		select {
+		case <-wait:
+			err = p.client.Close()
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+	})
+	return err
+}
+
+func (p *otlpSpanProcessor) loop() {
+	defer p.stopWait.Done()
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	batch := make([]*tracepb.Span, 0, otlpSpanBatchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		p.export(batch)
+		batch = make([]*tracepb.Span, 0, otlpSpanBatchSize)
+	}
+	for {
+		select {
+		case span := <-p.queue:
+			batch = append(batch, span)
+			if len(batch) >= otlpSpanBatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-p.stopCh:
+			for {
+				select {
+				case span := <-p.queue:
+					batch = append(batch, span)
+					if len(batch) >= otlpSpanBatchSize {
+						flush()
+					}
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+func (p *otlpSpanProcessor) export(spans []*tracepb.Span) {
+	req := &collectortracepb.ExportTraceServiceRequest{
+		ResourceSpans: []*tracepb.ResourceSpans{{
+			Resource: p.resource,
+			ScopeSpans: []*tracepb.ScopeSpans{{
+				Scope: &commonpb.InstrumentationScope{Name: otlp.ScopeName},
+				Spans: spans,
+			}},
+		}},
+	}
+	if err := p.client.ExportTraces(context.Background(), req); err != nil {
+		logutil.Error("failed to export spans by otlp",
+			zap.Int("spans", len(spans)),
+			zap.Uint64("dropped", p.dropped.Load()),
+			zap.Error(err),
+		)
+	}
+}
+
+func convertMOSpan(s *MOSpan) *tracepb.Span {
+	span := &tracepb.Span{
+		TraceId:           append([]byte(nil), s.TraceID[:]...),
+		SpanId:            append([]byte(nil), s.SpanID[:]...),
+		Name:              s.Name,
+		Kind:              convertSpanKind(s.Kind),
+		StartTimeUnixNano: uint64(s.StartTime.UnixNano()),
+		EndTimeUnixNano:   uint64(s.EndTime.UnixNano()),
+		Attributes: []*commonpb.KeyValue{
+			otlp.StringKeyValue(otlpSpanKindKey, s.Kind.String()),
+		},
+	}
+	if s.Parent != nil {
+		if psc := s.Parent.SpanContext(); !psc.SpanID.IsZero() {
+			span.ParentSpanId = append([]byte(nil), psc.SpanID[:]...)
+		}
+	}
+
+	for _, field := range s.ExtraFields {
+		if field.Type == zapcore.ErrorType {
+			if err, ok := field.Interface.(error); ok && err != nil {
+				span.Status = &tracepb.Status{
+					Code:    tracepb.Status_STATUS_CODE_ERROR,
+					Message: err.Error(),
+				}
+			}
+		}
+	}
+	span.Attributes = append(span.Attributes, convertZapFields(s.ExtraFields)...)
+	return span
+}
+
+func convertSpanKind(kind trace.SpanKind) tracepb.Span_SpanKind {
+	switch kind {
+	case trace.SpanKindStatement:
+		return tracepb.Span_SPAN_KIND_SERVER
+	default:
+		return tracepb.Span_SPAN_KIND_INTERNAL
+	}
+}
+
+func convertZapFields(fields []zap.Field) []*commonpb.KeyValue {
+	if len(fields) == 0 {
+		return nil
+	}
+	enc := zapcore.NewMapObjectEncoder()
+	for _, field := range fields {
+		field.AddTo(enc)
+	}
+	keys := make([]string, 0, len(enc.Fields))
+	for k := range enc.Fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	attrs := make([]*commonpb.KeyValue, 0, len(keys))
+	for _, k := range keys {
+		attrs = append(attrs, convertAttribute(k, enc.Fields[k]))
+	}
+	return attrs
+}
+
+func convertAttribute(key string, val any) *commonpb.KeyValue {
+	switch v := val.(type) {
+	case string:
+		return otlp.StringKeyValue(key, v)
+	case bool:
+		return otlp.BoolKeyValue(key, v)
+	case int:
+		return otlp.IntKeyValue(key, int64(v))
+	case int8:
+		return otlp.IntKeyValue(key, int64(v))
+	case int16:
+		return otlp.IntKeyValue(key, int64(v))
+	case int32:
+		return otlp.IntKeyValue(key, int64(v))
+	case int64:
+		return otlp.IntKeyValue(key, v)
+	case uint:
+		return otlp.IntKeyValue(key, int64(v))
+	case uint8:
+		return otlp.IntKeyValue(key, int64(v))
+	case uint16:
+		return otlp.IntKeyValue(key, int64(v))
+	case uint32:
+		return otlp.IntKeyValue(key, int64(v))
+	case uint64:
+		return otlp.IntKeyValue(key, int64(v))
+	case float32:
+		return otlp.DoubleKeyValue(key, float64(v))
+	case float64:
+		return otlp.DoubleKeyValue(key, v)
+	case time.Duration:
+		return otlp.IntKeyValue(key, int64(v))
+	case time.Time:
+		return otlp.StringKeyValue(key, v.Format(time.RFC3339Nano))
+	default:
+		return otlp.StringKeyValue(key, fmt.Sprint(v))
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package motrace
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/util/otlp/otlptest"
+	"github.com/matrixorigin/matrixone/pkg/util/trace"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"go.uber.org/zap"
+)
+
+func TestOTLPSpanProcessor(t *testing.T) {
+	collector, err := otlptest.NewCollector()
+	require.NoError(t, err)
+	defer collector.Close()
+
+	// statement span is recorded only if enabled by mo_ctl
+	trace.InitMOCtledSpan()
+	require.True(t, trace.SetMoCtledSpanState("statement", true, 0))
+	defer trace.SetMoCtledSpanState("statement", false, 0)
+
+	for _, c := range []struct {
+		protocol string
+		endpoint string
+	}{
+		{protocol: config.OTLPProtocolGRPC, endpoint: collector.GRPCEndpoint()},
+		{protocol: config.OTLPProtocolHTTP, endpoint: collector.HTTPEndpoint()},
+	} {
+		t.Run(c.protocol, func(t *testing.T) {
+			cfg := config.NewOBOTLPConfig()
+			cfg.Protocol = c.protocol
+			cfg.Endpoint = c.endpoint
+			p := newMOTracerProvider(
+				WithFSWriterFactory(&dummyFileWriterFactory{}),
+				EnableTracer(true),
+				WithLongSpanTime(0),
+				WithNode("node-uuid", "CN"),
+				WithOTLP(*cfg),
+			)
+			sp, err := newOTLPSpanProcessorByConfig(&p.tracerProviderConfig)
+			require.NoError(t, err)
+			p.spanProcessors = append(p.spanProcessors, sp)
+			tracer := p.Tracer("test").(*MOTracer)
+
+			// statement span continues the trace from client traceparent
+			parent, ok := trace.ParseTraceParent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+			require.True(t, ok)
+			ctx := trace.ContextWithSpanContext(context.Background(), parent)
+			name := "stmt-" + c.protocol
+			ctx, stmtSpan := tracer.Start(ctx, name, trace.WithKind(trace.SpanKindStatement))
+			_, childSpan := tracer.Start(ctx, "child-"+c.protocol)
+			childSpan.AddExtraFields(
+				zap.String("key", "val"),
+				zap.Int64("rows", 10),
+				zap.Error(moerr.NewInternalErrorNoCtx("child failed")),
+			)
+			childSpan.End()
+			stmtSpan.End()
+
+			require.NoError(t, sp.Shutdown(context.Background()))
+
+			var stmt, child *tracepb.Span
+			for _, span := range collector.Spans() {
+				switch span.Name {
+				case name:
+					stmt = span
+				case "child-" + c.protocol:
+					child = span
+				}
+			}
+			require.NotNil(t, stmt)
+			require.NotNil(t, child)
+
+			assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", hex.EncodeToString(stmt.TraceId))
+			assert.Equal(t, "00f067aa0ba902b7", hex.EncodeToString(stmt.ParentSpanId))
+			assert.Equal(t, tracepb.Span_SPAN_KIND_SERVER, stmt.Kind)
+			assert.Equal(t, stmt.TraceId, child.TraceId)
+			assert.Equal(t, stmt.SpanId, child.ParentSpanId)
+			assert.Equal(t, tracepb.Span_SPAN_KIND_INTERNAL, child.Kind)
+			assert.Equal(t, tracepb.Status_STATUS_CODE_ERROR, child.Status.GetCode())
+
+			attrs := make(map[string]string)
+			for _, kv := range child.Attributes {
+				attrs[kv.Key] = kv.Value.String()
+			}
+			assert.Contains(t, attrs["key"], "val")
+			assert.Contains(t, attrs["rows"], "10")
+			assert.Contains(t, attrs[otlpSpanKindKey], "internal")
+		})
+	}
+}
+
+func TestOTLPSpanProcessorSkipNilTrace(t *testing.T) {
+	collector, err := otlptest.NewCollector()
+	require.NoError(t, err)
+	defer collector.Close()
+
+	cfg := config.NewOBOTLPConfig()
+	cfg.Endpoint = collector.GRPCEndpoint()
+	p := newMOTracerProvider(
+		WithFSWriterFactory(&dummyFileWriterFactory{}),
+		EnableTracer(true),
+		WithLongSpanTime(0),
+		WithExportInterval(1),
+		WithOTLP(*cfg),
+	)
+	sp, err := newOTLPSpanProcessorByConfig(&p.tracerProviderConfig)
+	require.NoError(t, err)
+	p.spanProcessors = append(p.spanProcessors, sp)
+	tracer := p.Tracer("test").(*MOTracer)
+
+	// like DefaultContext, which only has node span id
+	var spanID trace.SpanID
+	spanID.SetByUUID("node-uuid")
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.SpanContextWithIDs(trace.NilTraceID, spanID))
+	_, span := tracer.Start(ctx, "nil-trace")
+	span.End()
+	time.Sleep(10 * time.Millisecond)
+
+	require.NoError(t, sp.Shutdown(context.Background()))
+	assert.Empty(t, collector.Spans())
+}
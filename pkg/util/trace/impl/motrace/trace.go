@@ -38,7 +38,9 @@ import (
 	db_holder "github.com/matrixorigin/matrixone/pkg/util/export/etl/db"
 	"github.com/matrixorigin/matrixone/pkg/util/export/table"
 	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
+	"github.com/matrixorigin/matrixone/pkg/util/otlp"
 	"github.com/matrixorigin/matrixone/pkg/util/trace"
+	"go.uber.org/zap"
 )
 
 var gTracerProvider atomic.Value
@@ -76,6 +78,7 @@ func InitWithConfig(ctx context.Context, SV *config.ObservabilityParameters, opt
 		WithTCPPacket(SV.TCPPacket),
 		WithLabels(SV.LabelSelector),
 		WithMaxLogMessageSize(int(SV.MaxLogMessageSize)),
+		WithOTLP(SV.OTLP),
 
 		DebugMode(SV.EnableTraceDebug),
 		WithBufferSizeThreshold(SV.BufferSize),
@@ -161,11 +164,34 @@ func initExporter(ctx context.Context, config *tracerProviderConfig) error {
 	if !p.Start() {
 		return moerr.NewInternalError(ctx, "trace exporter already started")
 	}
+	// otlp processor should go first, span will be freed after handled by batchSpanProcessor.
+	if config.otlp.EnableTrace() {
+		sp, err := newOTLPSpanProcessorByConfig(config)
+		if err != nil {
+			return err
+		}
+		config.spanProcessors = append(config.spanProcessors, sp)
+		logutil.Info("init otlp span processor", zap.String("endpoint", config.otlp.Endpoint))
+	}
 	config.spanProcessors = append(config.spanProcessors, NewBatchSpanProcessor(p))
 	logutil.Info("init trace span processor")
 	return nil
 }
 
+func newOTLPSpanProcessorByConfig(config *tracerProviderConfig) (trace.SpanProcessor, error) {
+	client, err := otlp.NewClient(config.otlp)
+	if err != nil {
+		return nil, err
+	}
+	var version string
+	if v, has := config.resource.Get("version"); has {
+		version, _ = v.(string)
+	}
+	node := config.getNodeResource()
+	resource := otlp.NewResource(node.NodeUuid, node.NodeType, version)
+	return newOTLPSpanProcessor(client, resource, config.exportInterval), nil
+}
+
 // InitSchema
 // PS: only in standalone or CN node can init schema
 func InitSchema(ctx context.Context, sqlExecutor func() ie.InternalExecutor) error {
@@ -0,0 +1,92 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace
+
+import (
+	"encoding/hex"
+	"strings"
+)
+
+// TraceParentKey is the name of the W3C Trace Context header, and of the session variable
+// which carries it from the client.
+const TraceParentKey = "traceparent"
+
+const (
+	traceParentVersion    = "00"
+	traceParentSampled    = "01"
+	traceParentMinLength  = 55
+	traceParentInvalidVer = "ff"
+)
+
+// ParseTraceParent parses a W3C traceparent value, like
+// 00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01.
+// The returned SpanContext is of kind SpanKindRemote, since it is started outside MO.
+func ParseTraceParent(val string) (sc SpanContext, ok bool) {
+	val = strings.TrimSpace(val)
+	if len(val) < traceParentMinLength {
+		return
+	}
+	parts := strings.Split(val, "-")
+	if len(parts) < 4 {
+		return
+	}
+	version, traceID, spanID, flags := parts[0], parts[1], parts[2], parts[3]
+	if len(version) != 2 || version == traceParentInvalidVer || !isLowerHex(version) {
+		return
+	}
+	// version 00 has exactly 4 parts, future versions may append more.
+	if version == traceParentVersion && len(parts) != 4 {
+		return
+	}
+	if len(traceID) != 32 || len(spanID) != 16 || len(flags) != 2 ||
+		!isLowerHex(traceID) || !isLowerHex(spanID) || !isLowerHex(flags) {
+		return
+	}
+	if _, err := hex.Decode(sc.TraceID[:], []byte(traceID)); err != nil {
+		return SpanContext{}, false
+	}
+	if _, err := hex.Decode(sc.SpanID[:], []byte(spanID)); err != nil {
+		return SpanContext{}, false
+	}
+	if sc.TraceID.IsZero() || sc.SpanID.IsZero() {
+		return SpanContext{}, false
+	}
+	sc.Kind = SpanKindRemote
+	return sc, true
+}
+
+// FormatTraceParent returns the W3C traceparent value of sc, with the sampled flag set.
+func FormatTraceParent(sc SpanContext) string {
+	var buf strings.Builder
+	buf.Grow(traceParentMinLength)
+	buf.WriteString(traceParentVersion)
+	buf.WriteByte('-')
+	buf.WriteString(hex.EncodeToString(sc.TraceID[:]))
+	buf.WriteByte('-')
+	buf.WriteString(hex.EncodeToString(sc.SpanID[:]))
+	buf.WriteByte('-')
+	buf.WriteString(traceParentSampled)
+	return buf.String()
+}
+
+func isLowerHex(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
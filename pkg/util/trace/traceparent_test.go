@@ -0,0 +1,68 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTraceParent(t *testing.T) {
+	tests := []struct {
+		name string
+		val  string
+		ok   bool
+	}{
+		{name: "normal", val: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", ok: true},
+		{name: "not sampled", val: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00", ok: true},
+		{name: "spaces", val: " 00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01 ", ok: true},
+		{name: "future version", val: "01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra", ok: true},
+		{name: "empty", val: "", ok: false},
+		{name: "invalid version", val: "ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", ok: false},
+		{name: "version 00 with extra", val: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra", ok: false},
+		{name: "zero trace id", val: "00-00000000000000000000000000000000-00f067aa0ba902b7-01", ok: false},
+		{name: "zero span id", val: "00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01", ok: false},
+		{name: "upper case", val: "00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01", ok: false},
+		{name: "short trace id", val: "00-4bf92f3577b34da6a3ce929d0e0e47-0000f067aa0ba902b7-01", ok: false},
+		{name: "not hex", val: "00-4bf92f3577b34da6a3ce929d0e0e473x-00f067aa0ba902b7-01", ok: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sc, ok := ParseTraceParent(tt.val)
+			require.Equal(t, tt.ok, ok)
+			if !ok {
+				assert.True(t, sc.IsEmpty())
+				return
+			}
+			assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", hexTraceID(sc.TraceID))
+			assert.Equal(t, "00f067aa0ba902b7", sc.SpanID.String())
+			assert.Equal(t, SpanKindRemote, sc.Kind)
+		})
+	}
+}
+
+func TestFormatTraceParent(t *testing.T) {
+	val := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	sc, ok := ParseTraceParent(val)
+	require.True(t, ok)
+	assert.Equal(t, val, FormatTraceParent(sc))
+}
+
+func hexTraceID(t TraceID) string {
+	sc := SpanContext{TraceID: t}
+	return FormatTraceParent(sc)[3:35]
+}
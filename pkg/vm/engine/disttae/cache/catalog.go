@@ -685,6 +685,11 @@ func getTableDef(tblItem *TableItem, coldefs []engine.TableDef) (*plan.TableDef,
 	var partitionInfo *plan.PartitionByDef
 	var viewSql *plan.ViewDef
 	var foreignKeys []*plan.ForeignKeyDef
+	var checks []*plan.CheckDef
+	var triggers []*plan.TriggerDef
+	var mview *plan.MaterializedViewDef
+	var refMviews []*plan.MaterializedViewDef
+	var generatedCols *engine.GeneratedColDef
 	var primarykey *plan.PrimaryKeyDef
 	var indexes []*plan.IndexDef
 	var refChildTbls []uint64
@@ -777,6 +782,16 @@ func getTableDef(tblItem *TableItem, coldefs []engine.TableDef) (*plan.TableDef,
 				indexes = k.Indexes
 			case *engine.ForeignKeyDef:
 				foreignKeys = k.Fkeys
+			case *engine.CheckDef:
+				checks = k.Checks
+			case *engine.TriggerDef:
+				triggers = k.Triggers
+			case *engine.MaterializedViewDef:
+				mview = k.View
+			case *engine.RefMaterializedViewDef:
+				refMviews = k.Views
+			case *engine.GeneratedColDef:
+				generatedCols = k
 			case *engine.RefChildTableDef:
 				refChildTbls = k.Tables
 			case *engine.PrimaryKeyDef:
@@ -803,6 +818,16 @@ func getTableDef(tblItem *TableItem, coldefs []engine.TableDef) (*plan.TableDef,
 		Value: string(api.MustMarshalTblExtra(tblItem.ExtraInfo)),
 	})
 
+	if tblItem.ExtraInfo != nil && tblItem.ExtraInfo.CompressAlg != 0 {
+		properties = append(properties, &plan.Property{
+			Key: catalog.PropCompression,
+			Value: compress.FormatAlgorithm(
+				compress.T(tblItem.ExtraInfo.CompressAlg),
+				int(tblItem.ExtraInfo.CompressLevel),
+			),
+		})
+	}
+
 	if tblItem.CreateSql != "" {
 		properties = append(properties, &plan.Property{
 			Key:   catalog.SystemRelAttr_CreateSQL,
@@ -835,6 +860,9 @@ func getTableDef(tblItem *TableItem, coldefs []engine.TableDef) (*plan.TableDef,
 		clusterByDef.CompCbkeyCol = plan2.GetColDefFromTable(cols, clusterByDef.Name)
 	}
 
+	if generatedCols != nil {
+		generatedCols.SetColDefs(cols)
+	}
 	return &plan.TableDef{
 		TblId:         tblItem.Id,
 		Name:          tblItem.Name,
@@ -848,6 +876,10 @@ func getTableDef(tblItem *TableItem, coldefs []engine.TableDef) (*plan.TableDef,
 		ViewSql:       viewSql,
 		Partition:     partitionInfo,
 		Fkeys:         foreignKeys,
+		Checks:        checks,
+		Triggers:      triggers,
+		Mview:         mview,
+		RefMviews:     refMviews,
 		RefChildTbls:  refChildTbls,
 		ClusterBy:     clusterByDef,
 		Indexes:       indexes,
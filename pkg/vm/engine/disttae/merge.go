@@ -26,6 +26,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -244,7 +245,7 @@ func (t *cnMergeTask) prepareCommitEntry() *api.MergeCommitEntry {
 }
 
 func (t *cnMergeTask) PrepareNewWriter() *blockio.BlockWriter {
-	return blockio.ConstructWriter(
+	writer := blockio.ConstructWriter(
 		t.host.version,
 		t.host.seqnums,
 		t.sortkeyPos,
@@ -252,6 +253,10 @@ func (t *cnMergeTask) PrepareNewWriter() *blockio.BlockWriter {
 		false,
 		t.fs,
 	) // TODO obj.isTombstone
+	if extra := t.host.extraInfo; extra != nil && extra.CompressAlg != 0 {
+		writer.SetCompress(compress.T(extra.CompressAlg), int(extra.CompressLevel))
+	}
+	return writer
 }
 
 // readblock reads block data. there is no rowid column, no ablk
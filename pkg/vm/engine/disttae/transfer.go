@@ -420,6 +420,9 @@ func batchTransferToTombstones(
 	rowids := vector.MustFixedColWithTypeCheck[types.Rowid](targetRowids)
 
 	for pos, endPos := 0, searchPKColumn.Length(); pos < endPos; pos++ {
+		if err = table.getTxn().saveWriteLocked(int(entryPositions[pos])); err != nil {
+			return
+		}
 		entry := txnWrites[entryPositions[pos]]
 		if err = vector.SetFixedAtWithTypeCheck[types.Rowid](
 			entry.bat.GetVector(0),
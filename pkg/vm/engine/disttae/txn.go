@@ -1001,8 +1001,11 @@ func (txn *Transaction) mergeTxnWorkspaceLocked(ctx context.Context) error {
 	txn.restoreTxnTableFunc = txn.restoreTxnTableFunc[:0]
 
 	if len(txn.batchSelectList) > 0 {
-		for _, e := range txn.writes {
+		for i, e := range txn.writes {
 			if sels, ok := txn.batchSelectList[e.bat]; ok {
+				if err := txn.saveWriteLocked(i); err != nil {
+					return err
+				}
 				txn.approximateInMemInsertCnt -= e.bat.RowCount() - len(sels)
 				e.bat.Shrink(sels, false)
 				delete(txn.batchSelectList, e.bat)
@@ -1012,6 +1015,9 @@ func (txn *Transaction) mergeTxnWorkspaceLocked(ctx context.Context) error {
 	if len(txn.tablesInVain) > 0 {
 		for i, e := range txn.writes {
 			if _, ok := txn.tablesInVain[e.tableId]; e.bat != nil && ok {
+				if err := txn.saveWriteLocked(i); err != nil {
+					return err
+				}
 				e.bat.Clean(txn.proc.GetMPool())
 				txn.writes[i].bat = nil
 			}
@@ -1116,6 +1122,11 @@ func (txn *Transaction) compactionBlksLocked(ctx context.Context) error {
 			entry.bat.Attrs[0] != catalog.BlockMeta_MetaLoc {
 			continue
 		}
+		if _, ok := compactedEntries[entry.bat]; ok {
+			if err := txn.saveWriteLocked(i); err != nil {
+				return err
+			}
+		}
 		entry.bat.Shrink(compactedEntries[entry.bat], true)
 		if entry.bat.RowCount() == 0 {
 			txn.writes[i].bat.Clean(txn.proc.GetMPool())
@@ -1390,6 +1401,7 @@ func (txn *Transaction) delTransaction() {
 	if txn.removed {
 		return
 	}
+	txn.freeSavepointsLocked()
 	for i := range txn.writes {
 		if txn.writes[i].bat == nil {
 			continue
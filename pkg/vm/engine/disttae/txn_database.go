@@ -25,6 +25,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -399,6 +400,7 @@ func (db *txnDatabase) createWithID(
 		tbl.tableId = tableId
 		tbl.accountId = accountId
 		tbl.extraInfo = &api.SchemaExtra{}
+		compression := ""
 		for _, def := range defs {
 			switch defVal := def.(type) {
 			case *engine.PropertiesDef:
@@ -412,6 +414,8 @@ func (db *txnDatabase) createWithID(
 						tbl.createSql = property.Value
 					case catalog.PropSchemaExtra:
 						tbl.extraInfo = api.MustUnmarshalTblExtra([]byte(property.Value))
+					case catalog.PropCompression:
+						compression = property.Value
 					default:
 					}
 				}
@@ -430,6 +434,14 @@ func (db *txnDatabase) createWithID(
 			}
 		}
 		tbl.extraInfo.NextColSeqnum = uint32(len(cols) - 1 /*rowid doesn't occupy seqnum*/)
+		if compression != "" {
+			alg, level, err := compress.ParseAlgorithm(compression)
+			if err != nil {
+				return err
+			}
+			tbl.extraInfo.CompressAlg = uint32(alg)
+			tbl.extraInfo.CompressLevel = int32(level)
+		}
 		if tbl.extraInfo.BlockMaxRows == 0 {
 			tbl.extraInfo.BlockMaxRows = options.DefaultBlockMaxRows
 		}
@@ -1062,6 +1062,11 @@ func (tbl *txnTable) GetTableDef(ctx context.Context) *plan.TableDef {
 		var partitionInfo *plan.PartitionByDef
 		var viewSql *plan.ViewDef
 		var foreignKeys []*plan.ForeignKeyDef
+		var checks []*plan.CheckDef
+		var triggers []*plan.TriggerDef
+		var mview *plan.MaterializedViewDef
+		var refMviews []*plan.MaterializedViewDef
+		var generatedCols *engine.GeneratedColDef
 		var primarykey *plan.PrimaryKeyDef
 		var indexes []*plan.IndexDef
 		var refChildTbls []uint64
@@ -1142,6 +1147,16 @@ func (tbl *txnTable) GetTableDef(ctx context.Context) *plan.TableDef {
 					indexes = k.Indexes
 				case *engine.ForeignKeyDef:
 					foreignKeys = k.Fkeys
+				case *engine.CheckDef:
+					checks = k.Checks
+				case *engine.TriggerDef:
+					triggers = k.Triggers
+				case *engine.MaterializedViewDef:
+					mview = k.View
+				case *engine.RefMaterializedViewDef:
+					refMviews = k.Views
+				case *engine.GeneratedColDef:
+					generatedCols = k
 				case *engine.RefChildTableDef:
 					refChildTbls = k.Tables
 				case *engine.PrimaryKeyDef:
@@ -1187,6 +1202,9 @@ func (tbl *txnTable) GetTableDef(ctx context.Context) *plan.TableDef {
 			cols = append(cols, rowIdCol)
 		}
 
+		if generatedCols != nil {
+			generatedCols.SetColDefs(cols)
+		}
 		tbl.tableDef = &plan.TableDef{
 			TblId:         tbl.tableId,
 			Name:          tbl.tableName,
@@ -1200,6 +1218,10 @@ func (tbl *txnTable) GetTableDef(ctx context.Context) *plan.TableDef {
 			ViewSql:       viewSql,
 			Partition:     partitionInfo,
 			Fkeys:         foreignKeys,
+			Checks:        checks,
+			Triggers:      triggers,
+			Mview:         mview,
+			RefMviews:     refMviews,
 			RefChildTbls:  refChildTbls,
 			ClusterBy:     clusterByDef,
 			Indexes:       indexes,
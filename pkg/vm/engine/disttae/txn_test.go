@@ -16,11 +16,18 @@ package disttae
 
 import (
 	"bytes"
+	"context"
 	"sync"
 	"testing"
 
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/stretchr/testify/require"
 )
 
@@ -62,3 +69,92 @@ func Test_GetUncommittedS3Tombstone(t *testing.T) {
 		return true
 	})
 }
+
+func Test_Savepoint(t *testing.T) {
+	ctx := context.Background()
+	txnOp, closeFunc := client.NewTestTxnOperator(ctx)
+	defer closeFunc()
+
+	proc := testutil.NewProc()
+	txn := &Transaction{
+		op:              txnOp,
+		proc:            proc,
+		tableCache:      new(sync.Map),
+		tablesInVain:    make(map[uint64]int),
+		cnBlkId_Pos:     map[types.Blockid]Pos{},
+		batchSelectList: make(map[*batch.Batch][]int64),
+		deletedBlocks: &deletedBlocks{
+			offsets: map[types.Blockid][]int64{},
+		},
+		cn_flushed_s3_tombstone_object_stats_list: new(sync.Map),
+	}
+
+	newBatch := func(rows ...int64) *batch.Batch {
+		bat := batch.NewWithSize(1)
+		bat.Attrs = []string{"a"}
+		bat.Vecs[0] = vector.NewVec(types.T_int64.ToType())
+		for _, row := range rows {
+			require.NoError(t, vector.AppendFixed(bat.Vecs[0], row, false, proc.Mp()))
+		}
+		bat.SetRowCount(len(rows))
+		return bat
+	}
+
+	txn.writes = append(txn.writes, Entry{typ: INSERT, tableId: 1000, bat: newBatch(1, 2, 3)})
+	txn.workspaceSize = 1
+	txn.statementID = 1
+	txn.offsets = []int{0}
+
+	var blkId types.Blockid
+	txn.cnBlkId_Pos[blkId] = Pos{bat: txn.writes[0].bat}
+
+	id, err := txn.Savepoint(ctx)
+	require.NoError(t, err)
+	// the writes are not copied until they are changed
+	require.Empty(t, txn.savepoints[0].writes)
+
+	for i := 0; i < 2; i++ {
+		// writes after the savepoint, including in place changes of the old writes
+		txn.Lock()
+		txn.batchSelectList[txn.writes[0].bat] = []int64{1}
+		require.NoError(t, txn.mergeTxnWorkspaceLocked(ctx))
+		txn.Unlock()
+		require.Equal(t, []int64{2}, vector.MustFixedColNoTypeCheck[int64](txn.writes[0].bat.Vecs[0]))
+		require.Equal(t, 1, len(txn.savepoints[0].writes))
+		txn.writes = append(txn.writes, Entry{typ: INSERT, tableId: 1000, bat: newBatch(4)})
+		txn.workspaceSize = 2
+		txn.statementID = 2
+		txn.offsets = append(txn.offsets, 1)
+
+		require.NoError(t, txn.RollbackToSavepoint(ctx, id))
+		require.Equal(t, 1, len(txn.writes))
+		require.Equal(t, 3, txn.writes[0].bat.RowCount())
+		require.Equal(t, []int64{1, 2, 3}, vector.MustFixedColNoTypeCheck[int64](txn.writes[0].bat.Vecs[0]))
+		require.Same(t, txn.writes[0].bat, txn.cnBlkId_Pos[blkId].bat)
+		require.Equal(t, uint64(1), txn.workspaceSize)
+		require.Equal(t, 1, txn.statementID)
+		require.Equal(t, []int{0}, txn.offsets)
+	}
+
+	// the savepoints after the one rolled back to are removed
+	id2, err := txn.Savepoint(ctx)
+	require.NoError(t, err)
+	require.NoError(t, txn.RollbackToSavepoint(ctx, id))
+	require.Equal(t, 1, len(txn.savepoints))
+	require.Error(t, txn.RollbackToSavepoint(ctx, id2))
+
+	// can not rollback the catalog writes
+	txn.writes = append(txn.writes, Entry{typ: INSERT, tableId: catalog.MO_TABLES_ID})
+	err = txn.RollbackToSavepoint(ctx, id)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrNotSupported))
+
+	txn.ReleaseSavepoint(id)
+	require.Empty(t, txn.savepoints)
+	require.Error(t, txn.RollbackToSavepoint(ctx, id))
+
+	for i := range txn.writes {
+		if txn.writes[i].bat != nil {
+			txn.writes[i].bat.Clean(proc.Mp())
+		}
+	}
+}
@@ -19,13 +19,16 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"maps"
 	"math"
+	"slices"
 	"strconv"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -363,6 +366,44 @@ type Transaction struct {
 	haveDDL atomic.Bool
 
 	writeWorkspaceThreshold uint64
+
+	// savepoints of the txn, in the order of creation
+	savepoints      []*savepoint
+	nextSavepointID int
+}
+
+// savepoint records the state of the workspace. The writes after a savepoint are
+// truncated when rolling back to it, and the older writes are copied into it only
+// if they are changed in place after it, by the deletes of their rows, compaction
+// or transfer.
+type savepoint struct {
+	id     int
+	offset int
+	// the copies of the changed writes before offset, by their positions
+	writes map[int]savepointWrite
+
+	workspaceSize              uint64
+	approximateInMemInsertSize uint64
+	approximateInMemInsertCnt  int
+	approximateInMemDeleteCnt  int
+	pkCount                    int
+
+	statementID        int
+	offsets            []int
+	transferTimestamps []timestamp.Timestamp
+	lastTransferred    types.TS
+	pendingTransfer    bool
+
+	tablesInVain      map[uint64]int
+	cnBlkIdPos        map[types.Blockid]Pos
+	flushedTombstones []objectio.ObjectStats
+}
+
+type savepointWrite struct {
+	// origin is the batch of the write when the savepoint is set, which is
+	// referred by cnBlkIdPos, and bat is the copy of it.
+	origin *batch.Batch
+	bat    *batch.Batch
 }
 
 type Pos struct {
@@ -559,8 +600,9 @@ func (txn *Transaction) IncrStatementID(ctx context.Context, commit bool) error
 	if err := txn.mergeTxnWorkspaceLocked(ctx); err != nil {
 		return err
 	}
-	// dump batch to s3, starting from 0 (begining of the workspace)
-	if err := txn.dumpBatchLocked(ctx, 0); err != nil {
+	// dump batch to s3, starting from 0 (begining of the workspace), or the last
+	// savepoint which rolls back by truncating the writes
+	if err := txn.dumpBatchLocked(ctx, txn.savepointOffsetLocked()); err != nil {
 		return err
 	}
 	txn.offsets = append(txn.offsets, len(txn.writes))
@@ -673,33 +715,27 @@ func (txn *Transaction) adjustUpdateOrderLocked(writeOffset uint64) error {
 }
 
 func (txn *Transaction) gcObjs(start int) error {
+	return txn.gcObjsExcept(start, nil)
+}
+
+// gcObjsExcept gc the s3 objects written by txn.writes[start:], except the
+// objects in keep.
+func (txn *Transaction) gcObjsExcept(start int, keep map[string]struct{}) error {
 	var objsName []string
 	for i := start; i < len(txn.writes); i++ {
-		if txn.writes[i].bat == nil ||
-			txn.writes[i].bat.RowCount() == 0 {
-			continue
-		}
 		//1. Remove blocks from txn.cnBlkId_Pos lazily till txn commits or rollback.
 		//2. Remove the segments generated by this statement lazily till txn commits or rollback.
 		//3. Now, GC the s3 objects(data objects and tombstone objects) asynchronously.
-		if txn.writes[i].fileName != "" {
-			var vec *vector.Vector
-			//  [object_stats, pk]
-			if txn.writes[i].typ == DELETE {
-				vec = txn.writes[i].bat.Vecs[0]
-			} else {
-				// [%!%mo__meta_loc, object_stats]
-				vec = txn.writes[i].bat.Vecs[1]
+		txn.writes[i].iterObjectStats(func(ss objectio.ObjectStats) {
+			name := ss.ObjectName().String()
+			if _, ok := keep[name]; ok {
+				return
 			}
-
-			for j := range vec.Length() {
-				ss := objectio.ObjectStats(vec.GetBytesAt(j))
-				if txn.writes[i].typ == DELETE {
-					txn.cn_flushed_s3_tombstone_object_stats_list.Delete(ss)
-				}
-				objsName = append(objsName, ss.ObjectName().String())
+			if txn.writes[i].typ == DELETE {
+				txn.cn_flushed_s3_tombstone_object_stats_list.Delete(ss)
 			}
-		}
+			objsName = append(objsName, name)
+		})
 	}
 
 	//gc the objects asynchronously.
@@ -813,6 +849,215 @@ func (txn *Transaction) RollbackLastStatement(ctx context.Context) error {
 	txn.incrStatementCalled = false
 	return nil
 }
+func (txn *Transaction) Savepoint(ctx context.Context) (int, error) {
+	txn.Lock()
+	defer txn.Unlock()
+
+	if err := txn.mergeTxnWorkspaceLocked(ctx); err != nil {
+		return 0, err
+	}
+
+	sp := &savepoint{
+		id:                         txn.nextSavepointID,
+		offset:                     len(txn.writes),
+		writes:                     make(map[int]savepointWrite),
+		workspaceSize:              txn.workspaceSize,
+		approximateInMemInsertSize: txn.approximateInMemInsertSize,
+		approximateInMemInsertCnt:  txn.approximateInMemInsertCnt,
+		approximateInMemDeleteCnt:  txn.approximateInMemDeleteCnt,
+		pkCount:                    txn.pkCount,
+		statementID:                txn.statementID,
+		offsets:                    slices.Clone(txn.offsets),
+		transferTimestamps:         slices.Clone(txn.transfer.timestamps),
+		lastTransferred:            txn.transfer.lastTransferred,
+		pendingTransfer:            txn.transfer.pendingTransfer,
+		tablesInVain:               maps.Clone(txn.tablesInVain),
+		cnBlkIdPos:                 maps.Clone(txn.cnBlkId_Pos),
+	}
+	txn.cn_flushed_s3_tombstone_object_stats_list.Range(func(key, _ any) bool {
+		sp.flushedTombstones = append(sp.flushedTombstones, key.(objectio.ObjectStats))
+		return true
+	})
+
+	txn.nextSavepointID++
+	txn.savepoints = append(txn.savepoints, sp)
+	return sp.id, nil
+}
+
+// saveWriteLocked copies the i-th write into the savepoints set after it, before
+// the write is changed in place. A write is copied once for each savepoint.
+func (txn *Transaction) saveWriteLocked(i int) error {
+	for _, sp := range txn.savepoints {
+		if i >= sp.offset || txn.writes[i].bat == nil {
+			continue
+		}
+		if _, ok := sp.writes[i]; ok {
+			continue
+		}
+		bat, err := txn.writes[i].bat.Dup(txn.proc.Mp())
+		if err != nil {
+			return err
+		}
+		sp.writes[i] = savepointWrite{origin: txn.writes[i].bat, bat: bat}
+	}
+	return nil
+}
+
+// savepointOffsetLocked returns the offset of the writes of the last savepoint,
+// the writes before it must not be moved.
+func (txn *Transaction) savepointOffsetLocked() int {
+	if len(txn.savepoints) == 0 {
+		return 0
+	}
+	return txn.savepoints[len(txn.savepoints)-1].offset
+}
+
+// RollbackToSavepoint truncates the writes to the savepoint and restores the older
+// writes changed after it. The savepoint is kept, and the savepoints after it are removed.
+func (txn *Transaction) RollbackToSavepoint(ctx context.Context, id int) error {
+	txn.Lock()
+	defer txn.Unlock()
+
+	idx := slices.IndexFunc(txn.savepoints, func(sp *savepoint) bool {
+		return sp.id == id
+	})
+	if idx == -1 {
+		return moerr.NewInternalErrorf(ctx, "savepoint %d not found", id)
+	}
+	sp := txn.savepoints[idx]
+	if len(txn.writes) < sp.offset {
+		return moerr.NewInternalErrorf(ctx, "savepoint %d is beyond the workspace", id)
+	}
+
+	// the catalog writes can not be rolled back partially, as the created
+	// and dropped tables are cached in the txn.
+	for _, e := range txn.writes[sp.offset:] {
+		if e.isCatalog() {
+			return moerr.NewNotSupported(ctx, "rollback to savepoint across DDL")
+		}
+	}
+
+	// the savepoint is kept after rollback, so restore the changed writes from new copies.
+	mp := txn.proc.Mp()
+	restored := make(map[int]*batch.Batch, len(sp.writes))
+	for i, w := range sp.writes {
+		bat, err := w.bat.Dup(mp)
+		if err != nil {
+			for _, bat := range restored {
+				bat.Clean(mp)
+			}
+			return err
+		}
+		restored[i] = bat
+	}
+
+	// gc the s3 objects generated after the savepoint.
+	keep := make(map[string]struct{})
+	for i, e := range txn.writes[:sp.offset] {
+		if w, ok := sp.writes[i]; ok {
+			e.bat = w.bat
+		}
+		e.iterObjectStats(func(ss objectio.ObjectStats) {
+			keep[ss.ObjectName().String()] = struct{}{}
+		})
+	}
+	if err := txn.gcObjsExcept(sp.offset, keep); err != nil {
+		for _, bat := range restored {
+			bat.Clean(mp)
+		}
+		return err
+	}
+	for i := sp.offset; i < len(txn.writes); i++ {
+		if txn.writes[i].bat != nil {
+			txn.writes[i].bat.Clean(mp)
+		}
+	}
+	txn.writes = txn.writes[:sp.offset]
+
+	remap := make(map[*batch.Batch]*batch.Batch, len(restored))
+	for i, bat := range restored {
+		if txn.writes[i].bat != nil {
+			txn.writes[i].bat.Clean(mp)
+		}
+		txn.writes[i].bat = bat
+		remap[sp.writes[i].origin] = bat
+	}
+	txn.cnBlkId_Pos = make(map[types.Blockid]Pos, len(sp.cnBlkIdPos))
+	for blkId, pos := range sp.cnBlkIdPos {
+		if bat, ok := remap[pos.bat]; ok {
+			pos.bat = bat
+		}
+		txn.cnBlkId_Pos[blkId] = pos
+	}
+
+	txn.workspaceSize = sp.workspaceSize
+	txn.approximateInMemInsertSize = sp.approximateInMemInsertSize
+	txn.approximateInMemInsertCnt = sp.approximateInMemInsertCnt
+	txn.approximateInMemDeleteCnt = sp.approximateInMemDeleteCnt
+	txn.pkCount = sp.pkCount
+	txn.statementID = sp.statementID
+	txn.offsets = slices.Clone(sp.offsets)
+	txn.transfer.timestamps = slices.Clone(sp.transferTimestamps)
+	txn.transfer.lastTransferred = sp.lastTransferred
+	txn.transfer.pendingTransfer = sp.pendingTransfer
+	txn.tablesInVain = maps.Clone(sp.tablesInVain)
+	txn.cn_flushed_s3_tombstone_object_stats_list.Range(func(key, _ any) bool {
+		txn.cn_flushed_s3_tombstone_object_stats_list.Delete(key)
+		return true
+	})
+	for _, stats := range sp.flushedTombstones {
+		txn.cn_flushed_s3_tombstone_object_stats_list.Store(stats, nil)
+	}
+
+	for _, later := range txn.savepoints[idx+1:] {
+		later.free(mp)
+	}
+	txn.savepoints = txn.savepoints[:idx+1]
+
+	for b := range txn.batchSelectList {
+		delete(txn.batchSelectList, b)
+	}
+	txn.restoreTxnTableFunc = txn.restoreTxnTableFunc[:0]
+	txn.deletedBlocks.clean()
+	txn.clearTableCache()
+
+	logutil.Info(
+		"RollbackToSavepoint",
+		zap.String("txn", hex.EncodeToString(txn.op.Txn().ID)),
+		zap.Int("savepoint", id),
+		zap.Int("entries", len(txn.writes)),
+		zap.Int("restored", len(restored)),
+	)
+	return nil
+}
+
+func (txn *Transaction) ReleaseSavepoint(id int) {
+	txn.Lock()
+	defer txn.Unlock()
+
+	txn.savepoints = slices.DeleteFunc(txn.savepoints, func(sp *savepoint) bool {
+		if sp.id != id {
+			return false
+		}
+		sp.free(txn.proc.Mp())
+		return true
+	})
+}
+
+func (txn *Transaction) freeSavepointsLocked() {
+	for _, sp := range txn.savepoints {
+		sp.free(txn.proc.Mp())
+	}
+	txn.savepoints = nil
+}
+
+func (sp *savepoint) free(mp *mpool.MPool) {
+	for _, w := range sp.writes {
+		w.bat.Clean(mp)
+	}
+	sp.writes = nil
+}
+
 func (txn *Transaction) resetSnapshot() error {
 	txn.tableCache.Range(func(key, value interface{}) bool {
 		value.(*txnTableDelegate).origin.resetSnapshot()
@@ -897,6 +1142,26 @@ func (e *Entry) String() string {
 		typesNames[e.typ], e.note, e.tableName, e.databaseName, e.accountId, e.tableId, e.databaseId, batinfo, e.fileName)
 }
 
+// iterObjectStats iterates the stats of the s3 objects written by the entry.
+func (e *Entry) iterObjectStats(fn func(objectio.ObjectStats)) {
+	if e.fileName == "" ||
+		e.bat == nil ||
+		e.bat.RowCount() == 0 {
+		return
+	}
+	var vec *vector.Vector
+	//  [object_stats, pk]
+	if e.typ == DELETE {
+		vec = e.bat.Vecs[0]
+	} else {
+		// [%!%mo__meta_loc, object_stats]
+		vec = e.bat.Vecs[1]
+	}
+	for j := range vec.Length() {
+		fn(objectio.ObjectStats(vec.GetBytesAt(j)))
+	}
+}
+
 func (e *Entry) DatabaseId() uint64 {
 	return e.databaseId
 }
@@ -265,6 +265,11 @@ func (t *Table) GetTableDef(ctx context.Context) *plan.TableDef {
 	var partitionInfo *plan2.PartitionByDef
 	var viewSql *plan2.ViewDef
 	var foreignKeys []*plan2.ForeignKeyDef
+	var checks []*plan.CheckDef
+	var triggers []*plan.TriggerDef
+	var mview *plan.MaterializedViewDef
+	var refMviews []*plan.MaterializedViewDef
+	var generatedCols *engine.GeneratedColDef
 	var primarykey *plan2.PrimaryKeyDef
 	var indexes []*plan2.IndexDef
 	var refChildTbls []uint64
@@ -323,6 +328,16 @@ func (t *Table) GetTableDef(ctx context.Context) *plan.TableDef {
 					indexes = k.Indexes
 				case *engine.ForeignKeyDef:
 					foreignKeys = k.Fkeys
+				case *engine.CheckDef:
+					checks = k.Checks
+				case *engine.TriggerDef:
+					triggers = k.Triggers
+				case *engine.MaterializedViewDef:
+					mview = k.View
+				case *engine.RefMaterializedViewDef:
+					refMviews = k.Views
+				case *engine.GeneratedColDef:
+					generatedCols = k
 				case *engine.RefChildTableDef:
 					refChildTbls = k.Tables
 				case *engine.PrimaryKeyDef:
@@ -368,6 +383,9 @@ func (t *Table) GetTableDef(ctx context.Context) *plan.TableDef {
 	rowIdCol := plan2.MakeRowIdColDef()
 	cols = append(cols, rowIdCol)
 
+	if generatedCols != nil {
+		generatedCols.SetColDefs(cols)
+	}
 	tableDef := &plan2.TableDef{
 		TblId:        t.GetTableID(ctx),
 		Name:         t.tableName,
@@ -379,6 +397,10 @@ func (t *Table) GetTableDef(ctx context.Context) *plan.TableDef {
 		ViewSql:      viewSql,
 		Partition:    partitionInfo,
 		Fkeys:        foreignKeys,
+		Checks:       checks,
+		Triggers:     triggers,
+		Mview:        mview,
+		RefMviews:    refMviews,
 		RefChildTbls: refChildTbls,
 		ClusterBy:    clusterByDef,
 		Indexes:      indexes,
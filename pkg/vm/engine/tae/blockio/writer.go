@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"math"
 
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
@@ -164,6 +165,11 @@ func (w *BlockWriter) SetAppendable() {
 	w.writer.SetAppendable()
 }
 
+// SetCompress sets the compression algorithm of the column data
+func (w *BlockWriter) SetCompress(alg compress.T, level int) {
+	w.writer.SetCompress(alg, level)
+}
+
 func (w *BlockWriter) GetObjectStats(opts ...objectio.ObjectStatsOptions) objectio.ObjectStats {
 	return w.writer.GetObjectStats(opts...)
 }
@@ -26,6 +26,7 @@ import (
 	pkgcatalog "github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -677,6 +678,9 @@ func (task *flushTableTailTask) mergeAObjs(ctx context.Context, isTombstone bool
 	if err != nil {
 		return err
 	}
+	if extra := schema.Extra; extra != nil && extra.CompressAlg != 0 {
+		writer.SetCompress(compress.T(extra.CompressAlg), int(extra.CompressLevel))
+	}
 
 	if schema.HasPK() {
 		pkIdx := schema.GetSingleSortKeyIdx()
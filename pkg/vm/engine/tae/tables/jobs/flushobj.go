@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/objectio"
 	"github.com/matrixorigin/matrixone/pkg/perfcounter"
@@ -104,6 +105,9 @@ func (task *flushObjTask) Execute(ctx context.Context) (err error) {
 		return err
 	}
 	writer.SetAppendable()
+	if extra := task.meta.GetSchema().Extra; extra != nil && extra.CompressAlg != 0 {
+		writer.SetCompress(compress.T(extra.CompressAlg), int(extra.CompressLevel))
+	}
 
 	if task.meta.IsTombstone {
 		writer.SetPrimaryKeyWithType(
@@ -24,6 +24,7 @@ import (
 	pkgcatalog "github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -323,7 +324,7 @@ func (task *mergeObjectsTask) PrepareNewWriter() *blockio.BlockWriter {
 		sortkeyPos = task.schema.GetSingleSortKeyIdx()
 	}
 
-	return blockio.ConstructWriter(
+	writer := blockio.ConstructWriter(
 		task.schema.Version,
 		seqnums,
 		sortkeyPos,
@@ -331,6 +332,10 @@ func (task *mergeObjectsTask) PrepareNewWriter() *blockio.BlockWriter {
 		task.isTombstone,
 		task.rt.Fs.Service,
 	)
+	if extra := task.schema.Extra; extra != nil && extra.CompressAlg != 0 {
+		writer.SetCompress(compress.T(extra.CompressAlg), int(extra.CompressLevel))
+	}
+	return writer
 }
 
 func (task *mergeObjectsTask) DoTransfer() bool {
@@ -568,7 +568,11 @@ func (ml *mockLockService) Unlock(ctx context.Context, txnID []byte,
 	return nil
 }
 func (ml *mockLockService) IsOrphanTxn(context.Context, []byte) (bool, error) { return false, nil }
-func (ml *mockLockService) Close() error                                      { return nil }
+func (ml *mockLockService) Savepoint(txnID []byte) lockservice.LockSavepoint  { return nil }
+func (ml *mockLockService) RollbackToSavepoint(ctx context.Context, txnID []byte, sp lockservice.LockSavepoint) error {
+	return nil
+}
+func (ml *mockLockService) Close() error { return nil }
 func (ml *mockLockService) GetWaitingList(ctx context.Context, txnID []byte) (bool, []lock.WaitTxn, error) {
 	return false, nil, nil
 }
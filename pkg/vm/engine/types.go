@@ -168,6 +168,40 @@ type StreamConfigsDef struct {
 	Configs []*plan.Property
 }
 
+type CheckDef struct {
+	Checks []*plan.CheckDef
+}
+
+type GeneratedColDef struct {
+	Cols []*plan.GeneratedCol
+}
+
+type TriggerDef struct {
+	Triggers []*plan.TriggerDef
+}
+
+// MaterializedViewDef is the definition of the materialized view itself
+type MaterializedViewDef struct {
+	View *plan.MaterializedViewDef
+}
+
+// RefMaterializedViewDef is the materialized views on the base table
+type RefMaterializedViewDef struct {
+	Views []*plan.MaterializedViewDef
+}
+
+// SetColDefs sets the generated column definitions to the columns with the same name
+func (def *GeneratedColDef) SetColDefs(cols []*plan.ColDef) {
+	for _, gen := range def.Cols {
+		for _, col := range cols {
+			if col.Name == gen.ColName {
+				col.Generated = gen
+				break
+			}
+		}
+	}
+}
+
 type TableDef interface {
 	tableDef()
 
@@ -304,6 +338,11 @@ const (
 	ForeignKey
 	PrimaryKey
 	StreamConfig
+	Check
+	GeneratedCol
+	Trigger
+	MaterializedView
+	RefMaterializedView
 )
 
 type EngineType int8
@@ -396,6 +435,86 @@ func (def *ConstraintDef) MarshalBinary() (data []byte, err error) {
 				}
 				buf.Write(bytes)
 			}
+		case *CheckDef:
+			if err := binary.Write(buf, binary.BigEndian, Check); err != nil {
+				return nil, err
+			}
+			if err := binary.Write(buf, binary.BigEndian, uint64(len(def.Checks))); err != nil {
+				return nil, err
+			}
+			for _, c := range def.Checks {
+				bytes, err := c.Marshal()
+				if err != nil {
+					return nil, err
+				}
+				if err := binary.Write(buf, binary.BigEndian, uint64(len(bytes))); err != nil {
+					return nil, err
+				}
+				buf.Write(bytes)
+			}
+		case *GeneratedColDef:
+			if err := binary.Write(buf, binary.BigEndian, GeneratedCol); err != nil {
+				return nil, err
+			}
+			if err := binary.Write(buf, binary.BigEndian, uint64(len(def.Cols))); err != nil {
+				return nil, err
+			}
+			for _, c := range def.Cols {
+				bytes, err := c.Marshal()
+				if err != nil {
+					return nil, err
+				}
+				if err := binary.Write(buf, binary.BigEndian, uint64(len(bytes))); err != nil {
+					return nil, err
+				}
+				buf.Write(bytes)
+			}
+		case *TriggerDef:
+			if err := binary.Write(buf, binary.BigEndian, Trigger); err != nil {
+				return nil, err
+			}
+			if err := binary.Write(buf, binary.BigEndian, uint64(len(def.Triggers))); err != nil {
+				return nil, err
+			}
+			for _, t := range def.Triggers {
+				bytes, err := t.Marshal()
+				if err != nil {
+					return nil, err
+				}
+				if err := binary.Write(buf, binary.BigEndian, uint64(len(bytes))); err != nil {
+					return nil, err
+				}
+				buf.Write(bytes)
+			}
+		case *MaterializedViewDef:
+			if err := binary.Write(buf, binary.BigEndian, MaterializedView); err != nil {
+				return nil, err
+			}
+			bytes, err := def.View.Marshal()
+			if err != nil {
+				return nil, err
+			}
+			if err := binary.Write(buf, binary.BigEndian, uint64(len(bytes))); err != nil {
+				return nil, err
+			}
+			buf.Write(bytes)
+		case *RefMaterializedViewDef:
+			if err := binary.Write(buf, binary.BigEndian, RefMaterializedView); err != nil {
+				return nil, err
+			}
+			if err := binary.Write(buf, binary.BigEndian, uint64(len(def.Views))); err != nil {
+				return nil, err
+			}
+			for _, v := range def.Views {
+				bytes, err := v.Marshal()
+				if err != nil {
+					return nil, err
+				}
+				if err := binary.Write(buf, binary.BigEndian, uint64(len(bytes))); err != nil {
+					return nil, err
+				}
+				buf.Write(bytes)
+			}
 		}
 	}
 	return buf.Bytes(), nil
@@ -481,6 +600,84 @@ func (def *ConstraintDef) UnmarshalBinary(data []byte) error {
 				configs[i] = config
 			}
 			def.Cts = append(def.Cts, &StreamConfigsDef{configs})
+		case Check:
+			length = binary.BigEndian.Uint64(data[l : l+8])
+			l += 8
+			checks := make([]*plan.CheckDef, length)
+
+			for i := 0; i < int(length); i++ {
+				dataLength := binary.BigEndian.Uint64(data[l : l+8])
+				l += 8
+				check := &plan.CheckDef{}
+				err := check.Unmarshal(data[l : l+int(dataLength)])
+				if err != nil {
+					return err
+				}
+				l += int(dataLength)
+				checks[i] = check
+			}
+			def.Cts = append(def.Cts, &CheckDef{checks})
+		case GeneratedCol:
+			length = binary.BigEndian.Uint64(data[l : l+8])
+			l += 8
+			cols := make([]*plan.GeneratedCol, length)
+
+			for i := 0; i < int(length); i++ {
+				dataLength := binary.BigEndian.Uint64(data[l : l+8])
+				l += 8
+				col := &plan.GeneratedCol{}
+				err := col.Unmarshal(data[l : l+int(dataLength)])
+				if err != nil {
+					return err
+				}
+				l += int(dataLength)
+				cols[i] = col
+			}
+			def.Cts = append(def.Cts, &GeneratedColDef{cols})
+		case Trigger:
+			length = binary.BigEndian.Uint64(data[l : l+8])
+			l += 8
+			triggers := make([]*plan.TriggerDef, length)
+
+			for i := 0; i < int(length); i++ {
+				dataLength := binary.BigEndian.Uint64(data[l : l+8])
+				l += 8
+				trigger := &plan.TriggerDef{}
+				err := trigger.Unmarshal(data[l : l+int(dataLength)])
+				if err != nil {
+					return err
+				}
+				l += int(dataLength)
+				triggers[i] = trigger
+			}
+			def.Cts = append(def.Cts, &TriggerDef{triggers})
+		case MaterializedView:
+			dataLength := binary.BigEndian.Uint64(data[l : l+8])
+			l += 8
+			view := &plan.MaterializedViewDef{}
+			err := view.Unmarshal(data[l : l+int(dataLength)])
+			if err != nil {
+				return err
+			}
+			l += int(dataLength)
+			def.Cts = append(def.Cts, &MaterializedViewDef{view})
+		case RefMaterializedView:
+			length = binary.BigEndian.Uint64(data[l : l+8])
+			l += 8
+			views := make([]*plan.MaterializedViewDef, length)
+
+			for i := 0; i < int(length); i++ {
+				dataLength := binary.BigEndian.Uint64(data[l : l+8])
+				l += 8
+				view := &plan.MaterializedViewDef{}
+				err := view.Unmarshal(data[l : l+int(dataLength)])
+				if err != nil {
+					return err
+				}
+				l += int(dataLength)
+				views[i] = view
+			}
+			def.Cts = append(def.Cts, &RefMaterializedViewDef{views})
 		}
 	}
 	return nil
@@ -512,6 +709,21 @@ func (def *ConstraintPB) FromPBVersion() Constraint {
 	if r := def.GetStreamConfigsDef(); r != nil {
 		return r
 	}
+	if r := def.GetCheckDef(); r != nil {
+		return r
+	}
+	if r := def.GetGeneratedColDef(); r != nil {
+		return r
+	}
+	if r := def.GetTriggerDef(); r != nil {
+		return r
+	}
+	if r := def.GetMaterializedViewDef(); r != nil {
+		return r
+	}
+	if r := def.GetRefMaterializedViewDef(); r != nil {
+		return r
+	}
 	panic("no corresponding type")
 }
 
@@ -533,11 +745,16 @@ type Constraint interface {
 }
 
 // TODO: UniqueIndexDef, SecondaryIndexDef will not be tabledef and need to be moved in Constraint to be able modified
-func (*ForeignKeyDef) constraint()    {}
-func (*PrimaryKeyDef) constraint()    {}
-func (*RefChildTableDef) constraint() {}
-func (*IndexDef) constraint()         {}
-func (*StreamConfigsDef) constraint() {}
+func (*ForeignKeyDef) constraint()          {}
+func (*PrimaryKeyDef) constraint()          {}
+func (*RefChildTableDef) constraint()       {}
+func (*IndexDef) constraint()               {}
+func (*StreamConfigsDef) constraint()       {}
+func (*CheckDef) constraint()               {}
+func (*GeneratedColDef) constraint()        {}
+func (*TriggerDef) constraint()             {}
+func (*MaterializedViewDef) constraint()    {}
+func (*RefMaterializedViewDef) constraint() {}
 
 func (def *ForeignKeyDef) ToPBVersion() ConstraintPB {
 	return ConstraintPB{
@@ -576,6 +793,46 @@ func (def *StreamConfigsDef) ToPBVersion() ConstraintPB {
 	}
 }
 
+func (def *CheckDef) ToPBVersion() ConstraintPB {
+	return ConstraintPB{
+		Ct: &ConstraintPB_CheckDef{
+			CheckDef: def,
+		},
+	}
+}
+
+func (def *GeneratedColDef) ToPBVersion() ConstraintPB {
+	return ConstraintPB{
+		Ct: &ConstraintPB_GeneratedColDef{
+			GeneratedColDef: def,
+		},
+	}
+}
+
+func (def *TriggerDef) ToPBVersion() ConstraintPB {
+	return ConstraintPB{
+		Ct: &ConstraintPB_TriggerDef{
+			TriggerDef: def,
+		},
+	}
+}
+
+func (def *MaterializedViewDef) ToPBVersion() ConstraintPB {
+	return ConstraintPB{
+		Ct: &ConstraintPB_MaterializedViewDef{
+			MaterializedViewDef: def,
+		},
+	}
+}
+
+func (def *RefMaterializedViewDef) ToPBVersion() ConstraintPB {
+	return ConstraintPB{
+		Ct: &ConstraintPB_RefMaterializedViewDef{
+			RefMaterializedViewDef: def,
+		},
+	}
+}
+
 type TombstoneType uint8
 
 const (
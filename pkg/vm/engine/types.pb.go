@@ -440,11 +440,211 @@ func (m *StreamConfigsDef) GetConfigs() []*plan.Property {
 	return nil
 }
 
+func (m *CheckDef) Reset()         { *m = CheckDef{} }
+func (m *CheckDef) String() string { return proto.CompactTextString(m) }
+func (*CheckDef) ProtoMessage()    {}
+func (*CheckDef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{10}
+}
+func (m *CheckDef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckDef.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckDef.Merge(m, src)
+}
+func (m *CheckDef) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *CheckDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckDef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckDef proto.InternalMessageInfo
+
+func (m *CheckDef) GetChecks() []*plan.CheckDef {
+	if m != nil {
+		return m.Checks
+	}
+	return nil
+}
+
+func (m *GeneratedColDef) Reset()         { *m = GeneratedColDef{} }
+func (m *GeneratedColDef) String() string { return proto.CompactTextString(m) }
+func (*GeneratedColDef) ProtoMessage()    {}
+func (*GeneratedColDef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{11}
+}
+func (m *GeneratedColDef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GeneratedColDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GeneratedColDef.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GeneratedColDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GeneratedColDef.Merge(m, src)
+}
+func (m *GeneratedColDef) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *GeneratedColDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_GeneratedColDef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GeneratedColDef proto.InternalMessageInfo
+
+func (m *GeneratedColDef) GetCols() []*plan.GeneratedCol {
+	if m != nil {
+		return m.Cols
+	}
+	return nil
+}
+
+func (m *TriggerDef) Reset()         { *m = TriggerDef{} }
+func (m *TriggerDef) String() string { return proto.CompactTextString(m) }
+func (*TriggerDef) ProtoMessage()    {}
+func (*TriggerDef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{12}
+}
+func (m *TriggerDef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TriggerDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TriggerDef.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TriggerDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerDef.Merge(m, src)
+}
+func (m *TriggerDef) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *TriggerDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerDef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerDef proto.InternalMessageInfo
+
+func (m *TriggerDef) GetTriggers() []*plan.TriggerDef {
+	if m != nil {
+		return m.Triggers
+	}
+	return nil
+}
+
+func (m *MaterializedViewDef) Reset()         { *m = MaterializedViewDef{} }
+func (m *MaterializedViewDef) String() string { return proto.CompactTextString(m) }
+func (*MaterializedViewDef) ProtoMessage()    {}
+func (*MaterializedViewDef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{13}
+}
+func (m *MaterializedViewDef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaterializedViewDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaterializedViewDef.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MaterializedViewDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaterializedViewDef.Merge(m, src)
+}
+func (m *MaterializedViewDef) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *MaterializedViewDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaterializedViewDef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaterializedViewDef proto.InternalMessageInfo
+
+func (m *MaterializedViewDef) GetView() *plan.MaterializedViewDef {
+	if m != nil {
+		return m.View
+	}
+	return nil
+}
+
+func (m *RefMaterializedViewDef) Reset()         { *m = RefMaterializedViewDef{} }
+func (m *RefMaterializedViewDef) String() string { return proto.CompactTextString(m) }
+func (*RefMaterializedViewDef) ProtoMessage()    {}
+func (*RefMaterializedViewDef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{14}
+}
+func (m *RefMaterializedViewDef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefMaterializedViewDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefMaterializedViewDef.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RefMaterializedViewDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefMaterializedViewDef.Merge(m, src)
+}
+func (m *RefMaterializedViewDef) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *RefMaterializedViewDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefMaterializedViewDef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefMaterializedViewDef proto.InternalMessageInfo
+
+func (m *RefMaterializedViewDef) GetViews() []*plan.MaterializedViewDef {
+	if m != nil {
+		return m.Views
+	}
+	return nil
+}
+
 func (m *PrimaryKeyDef) Reset()         { *m = PrimaryKeyDef{} }
 func (m *PrimaryKeyDef) String() string { return proto.CompactTextString(m) }
 func (*PrimaryKeyDef) ProtoMessage()    {}
 func (*PrimaryKeyDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{10}
+	return fileDescriptor_d938547f84707355, []int{15}
 }
 func (m *PrimaryKeyDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -484,7 +684,7 @@ func (m *RefChildTableDef) Reset()         { *m = RefChildTableDef{} }
 func (m *RefChildTableDef) String() string { return proto.CompactTextString(m) }
 func (*RefChildTableDef) ProtoMessage()    {}
 func (*RefChildTableDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{11}
+	return fileDescriptor_d938547f84707355, []int{16}
 }
 func (m *RefChildTableDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -524,7 +724,7 @@ func (m *IndexDef) Reset()         { *m = IndexDef{} }
 func (m *IndexDef) String() string { return proto.CompactTextString(m) }
 func (*IndexDef) ProtoMessage()    {}
 func (*IndexDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{12}
+	return fileDescriptor_d938547f84707355, []int{17}
 }
 func (m *IndexDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -569,7 +769,7 @@ func (m *ConstraintDefPB) Reset()         { *m = ConstraintDefPB{} }
 func (m *ConstraintDefPB) String() string { return proto.CompactTextString(m) }
 func (*ConstraintDefPB) ProtoMessage()    {}
 func (*ConstraintDefPB) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{13}
+	return fileDescriptor_d938547f84707355, []int{18}
 }
 func (m *ConstraintDefPB) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -613,6 +813,11 @@ type ConstraintPB struct {
 	//	*ConstraintPB_RefChildTableDef
 	//	*ConstraintPB_IndexDef
 	//	*ConstraintPB_StreamConfigsDef
+	//	*ConstraintPB_CheckDef
+	//	*ConstraintPB_GeneratedColDef
+	//	*ConstraintPB_TriggerDef
+	//	*ConstraintPB_MaterializedViewDef
+	//	*ConstraintPB_RefMaterializedViewDef
 	Ct isConstraintPB_Ct `protobuf_oneof:"ct"`
 }
 
@@ -620,7 +825,7 @@ func (m *ConstraintPB) Reset()         { *m = ConstraintPB{} }
 func (m *ConstraintPB) String() string { return proto.CompactTextString(m) }
 func (*ConstraintPB) ProtoMessage()    {}
 func (*ConstraintPB) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{14}
+	return fileDescriptor_d938547f84707355, []int{19}
 }
 func (m *ConstraintPB) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -670,12 +875,32 @@ type ConstraintPB_IndexDef struct {
 type ConstraintPB_StreamConfigsDef struct {
 	StreamConfigsDef *StreamConfigsDef `protobuf:"bytes,5,opt,name=StreamConfigsDef,proto3,oneof" json:"StreamConfigsDef,omitempty"`
 }
+type ConstraintPB_CheckDef struct {
+	CheckDef *CheckDef `protobuf:"bytes,6,opt,name=CheckDef,proto3,oneof" json:"CheckDef,omitempty"`
+}
+type ConstraintPB_GeneratedColDef struct {
+	GeneratedColDef *GeneratedColDef `protobuf:"bytes,7,opt,name=GeneratedColDef,proto3,oneof" json:"GeneratedColDef,omitempty"`
+}
+type ConstraintPB_TriggerDef struct {
+	TriggerDef *TriggerDef `protobuf:"bytes,8,opt,name=TriggerDef,proto3,oneof" json:"TriggerDef,omitempty"`
+}
+type ConstraintPB_MaterializedViewDef struct {
+	MaterializedViewDef *MaterializedViewDef `protobuf:"bytes,9,opt,name=MaterializedViewDef,proto3,oneof" json:"MaterializedViewDef,omitempty"`
+}
+type ConstraintPB_RefMaterializedViewDef struct {
+	RefMaterializedViewDef *RefMaterializedViewDef `protobuf:"bytes,10,opt,name=RefMaterializedViewDef,proto3,oneof" json:"RefMaterializedViewDef,omitempty"`
+}
 
-func (*ConstraintPB_ForeignKeyDef) isConstraintPB_Ct()    {}
-func (*ConstraintPB_PrimaryKeyDef) isConstraintPB_Ct()    {}
-func (*ConstraintPB_RefChildTableDef) isConstraintPB_Ct() {}
-func (*ConstraintPB_IndexDef) isConstraintPB_Ct()         {}
-func (*ConstraintPB_StreamConfigsDef) isConstraintPB_Ct() {}
+func (*ConstraintPB_ForeignKeyDef) isConstraintPB_Ct()          {}
+func (*ConstraintPB_PrimaryKeyDef) isConstraintPB_Ct()          {}
+func (*ConstraintPB_RefChildTableDef) isConstraintPB_Ct()       {}
+func (*ConstraintPB_IndexDef) isConstraintPB_Ct()               {}
+func (*ConstraintPB_StreamConfigsDef) isConstraintPB_Ct()       {}
+func (*ConstraintPB_CheckDef) isConstraintPB_Ct()               {}
+func (*ConstraintPB_GeneratedColDef) isConstraintPB_Ct()        {}
+func (*ConstraintPB_TriggerDef) isConstraintPB_Ct()             {}
+func (*ConstraintPB_MaterializedViewDef) isConstraintPB_Ct()    {}
+func (*ConstraintPB_RefMaterializedViewDef) isConstraintPB_Ct() {}
 
 func (m *ConstraintPB) GetCt() isConstraintPB_Ct {
 	if m != nil {
@@ -719,6 +944,41 @@ func (m *ConstraintPB) GetStreamConfigsDef() *StreamConfigsDef {
 	return nil
 }
 
+func (m *ConstraintPB) GetCheckDef() *CheckDef {
+	if x, ok := m.GetCt().(*ConstraintPB_CheckDef); ok {
+		return x.CheckDef
+	}
+	return nil
+}
+
+func (m *ConstraintPB) GetGeneratedColDef() *GeneratedColDef {
+	if x, ok := m.GetCt().(*ConstraintPB_GeneratedColDef); ok {
+		return x.GeneratedColDef
+	}
+	return nil
+}
+
+func (m *ConstraintPB) GetTriggerDef() *TriggerDef {
+	if x, ok := m.GetCt().(*ConstraintPB_TriggerDef); ok {
+		return x.TriggerDef
+	}
+	return nil
+}
+
+func (m *ConstraintPB) GetMaterializedViewDef() *MaterializedViewDef {
+	if x, ok := m.GetCt().(*ConstraintPB_MaterializedViewDef); ok {
+		return x.MaterializedViewDef
+	}
+	return nil
+}
+
+func (m *ConstraintPB) GetRefMaterializedViewDef() *RefMaterializedViewDef {
+	if x, ok := m.GetCt().(*ConstraintPB_RefMaterializedViewDef); ok {
+		return x.RefMaterializedViewDef
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ConstraintPB) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -727,6 +987,11 @@ func (*ConstraintPB) XXX_OneofWrappers() []interface{} {
 		(*ConstraintPB_RefChildTableDef)(nil),
 		(*ConstraintPB_IndexDef)(nil),
 		(*ConstraintPB_StreamConfigsDef)(nil),
+		(*ConstraintPB_CheckDef)(nil),
+		(*ConstraintPB_GeneratedColDef)(nil),
+		(*ConstraintPB_TriggerDef)(nil),
+		(*ConstraintPB_MaterializedViewDef)(nil),
+		(*ConstraintPB_RefMaterializedViewDef)(nil),
 	}
 }
 
@@ -749,7 +1014,7 @@ func (m *TableDefPB) Reset()         { *m = TableDefPB{} }
 func (m *TableDefPB) String() string { return proto.CompactTextString(m) }
 func (*TableDefPB) ProtoMessage()    {}
 func (*TableDefPB) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{15}
+	return fileDescriptor_d938547f84707355, []int{20}
 }
 func (m *TableDefPB) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -911,7 +1176,7 @@ func (m *Property) Reset()         { *m = Property{} }
 func (m *Property) String() string { return proto.CompactTextString(m) }
 func (*Property) ProtoMessage()    {}
 func (*Property) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{16}
+	return fileDescriptor_d938547f84707355, []int{21}
 }
 func (m *Property) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -958,7 +1223,7 @@ func (m *Attribute) Reset()         { *m = Attribute{} }
 func (m *Attribute) String() string { return proto.CompactTextString(m) }
 func (*Attribute) ProtoMessage()    {}
 func (*Attribute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{17}
+	return fileDescriptor_d938547f84707355, []int{22}
 }
 func (m *Attribute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1075,6 +1340,11 @@ func init() {
 	proto.RegisterType((*ClusterByDef)(nil), "engine.ClusterByDef")
 	proto.RegisterType((*ForeignKeyDef)(nil), "engine.ForeignKeyDef")
 	proto.RegisterType((*StreamConfigsDef)(nil), "engine.StreamConfigsDef")
+	proto.RegisterType((*CheckDef)(nil), "engine.CheckDef")
+	proto.RegisterType((*GeneratedColDef)(nil), "engine.GeneratedColDef")
+	proto.RegisterType((*TriggerDef)(nil), "engine.TriggerDef")
+	proto.RegisterType((*MaterializedViewDef)(nil), "engine.MaterializedViewDef")
+	proto.RegisterType((*RefMaterializedViewDef)(nil), "engine.RefMaterializedViewDef")
 	proto.RegisterType((*PrimaryKeyDef)(nil), "engine.PrimaryKeyDef")
 	proto.RegisterType((*RefChildTableDef)(nil), "engine.RefChildTableDef")
 	proto.RegisterType((*IndexDef)(nil), "engine.IndexDef")
@@ -1088,70 +1358,82 @@ func init() {
 func init() { proto.RegisterFile("types.proto", fileDescriptor_d938547f84707355) }
 
 var fileDescriptor_d938547f84707355 = []byte{
-	// 1005 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x4f, 0x6f, 0xe2, 0x56,
-	0x10, 0x37, 0x60, 0x08, 0x0c, 0xb0, 0x9b, 0x7a, 0xb3, 0xad, 0x15, 0x55, 0x10, 0xa5, 0x95, 0x96,
-	0x76, 0xb7, 0xb0, 0x4a, 0xa3, 0xaa, 0x42, 0x4a, 0xd5, 0x98, 0x14, 0x81, 0x56, 0x6d, 0xd1, 0x6b,
-	0x9a, 0xbb, 0x81, 0x07, 0x6b, 0x05, 0x6c, 0xcb, 0x7e, 0x68, 0x97, 0x6f, 0xd1, 0x8f, 0xd0, 0x1e,
-	0xfb, 0x4d, 0xf6, 0x98, 0x63, 0xd4, 0x43, 0xd4, 0x26, 0x5f, 0xa0, 0xe7, 0x9c, 0xaa, 0x37, 0x9e,
-	0xe7, 0x7f, 0x5c, 0x7a, 0x7b, 0x33, 0xf3, 0x9b, 0x79, 0xe3, 0xf9, 0x8d, 0x7f, 0x36, 0xd4, 0xc5,
-	0xd6, 0xe7, 0x61, 0xd7, 0x0f, 0x3c, 0xe1, 0x19, 0x15, 0xee, 0x2e, 0x1d, 0x97, 0x1f, 0x7e, 0xb5,
-	0x74, 0xc4, 0xdb, 0xcd, 0xb4, 0x3b, 0xf3, 0xd6, 0xbd, 0xa5, 0xb7, 0xf4, 0x7a, 0x18, 0x9e, 0x6e,
-	0x16, 0x68, 0xa1, 0x81, 0xa7, 0x28, 0xed, 0x10, 0xfc, 0x95, 0xed, 0x46, 0xe7, 0xe3, 0x57, 0x00,
-	0x03, 0x6f, 0xbd, 0xe6, 0xae, 0xb8, 0xe0, 0x0b, 0xc3, 0x84, 0x3d, 0xb2, 0xcc, 0xc2, 0x51, 0xa1,
-	0x53, 0x63, 0xca, 0xec, 0xeb, 0xff, 0xfe, 0xd1, 0xd6, 0x24, 0xfa, 0x8a, 0x07, 0xa1, 0xe3, 0xb9,
-	0x84, 0x26, 0x0b, 0xd1, 0x4d, 0xa6, 0x4c, 0x42, 0x9f, 0x40, 0x63, 0x62, 0x07, 0xc2, 0x11, 0x84,
-	0xff, 0x14, 0x6a, 0xb1, 0x4d, 0xf5, 0x13, 0x07, 0xe5, 0x7c, 0x06, 0x7b, 0x57, 0x0e, 0x7f, 0x27,
-	0xe1, 0x06, 0xe8, 0xf2, 0x48, 0x48, 0x3c, 0x13, 0xe8, 0x1c, 0x1a, 0xe7, 0x42, 0x04, 0xce, 0x74,
-	0x23, 0xb8, 0x44, 0xbe, 0x04, 0x5d, 0xda, 0x88, 0xac, 0x9f, 0x7c, 0xd4, 0x8d, 0xc6, 0xd2, 0x8d,
-	0x31, 0x96, 0xfe, 0xe1, 0xae, 0xad, 0x31, 0x04, 0x51, 0x89, 0x19, 0x34, 0xc7, 0xee, 0x9c, 0xbf,
-	0xbf, 0xb4, 0xa7, 0x2b, 0x1e, 0x35, 0x57, 0xba, 0xdc, 0xfa, 0x58, 0xa2, 0x6c, 0xc1, 0xe3, 0x5d,
-	0xbb, 0x12, 0xc5, 0x99, 0x74, 0x1b, 0x87, 0x50, 0x1d, 0x78, 0xab, 0x9f, 0xec, 0x35, 0x0f, 0xcd,
-	0xe2, 0x51, 0xa9, 0x53, 0x63, 0xb1, 0x2d, 0xfb, 0x94, 0x07, 0xb3, 0x14, 0xf5, 0x29, 0xcf, 0x74,
-	0xc9, 0x8f, 0xd0, 0x9c, 0x04, 0x9e, 0xcf, 0x03, 0xe1, 0xf0, 0x50, 0x5e, 0xf2, 0x0d, 0x40, 0xe2,
-	0x30, 0x0b, 0x47, 0xa5, 0x4e, 0xfd, 0x64, 0x5f, 0xb5, 0x4b, 0x91, 0x2d, 0x75, 0x9b, 0x42, 0x52,
-	0xb9, 0x0e, 0x34, 0x06, 0xab, 0x4d, 0x28, 0x78, 0x60, 0x6d, 0x69, 0x40, 0x78, 0x71, 0x61, 0xe7,
-	0xe2, 0xef, 0xa1, 0x39, 0xf4, 0x02, 0xee, 0x2c, 0xdd, 0x37, 0x1c, 0xa1, 0x5f, 0x40, 0x79, 0x78,
-	0xcd, 0xb7, 0xea, 0xce, 0x67, 0x5d, 0x5c, 0x81, 0x0c, 0x86, 0x45, 0x08, 0xaa, 0x60, 0xc1, 0xfe,
-	0x2f, 0x22, 0xe0, 0xf6, 0x7a, 0xe0, 0xb9, 0x0b, 0x67, 0x89, 0xdd, 0x77, 0xe4, 0x76, 0xa0, 0x45,
-	0x65, 0x9e, 0x44, 0x65, 0x54, 0xe3, 0x4c, 0x85, 0xa9, 0xc6, 0x77, 0xf2, 0xf1, 0x9d, 0xb5, 0x1d,
-	0x6c, 0xa9, 0x8b, 0x17, 0xa0, 0x4f, 0xae, 0xf9, 0x96, 0x78, 0x7a, 0xa6, 0xb2, 0x53, 0x10, 0x86,
-	0x00, 0xca, 0x7f, 0x0d, 0xfb, 0x8c, 0x2f, 0x06, 0x6f, 0x9d, 0xd5, 0x3c, 0xa6, 0xe9, 0x63, 0xa8,
-	0xe0, 0x39, 0x6a, 0x41, 0x67, 0x64, 0x51, 0x46, 0x1f, 0xaa, 0xc8, 0x1a, 0x75, 0x8b, 0x67, 0x9e,
-	0xeb, 0x56, 0x01, 0x98, 0x0a, 0x53, 0xee, 0x0f, 0xf0, 0x74, 0xe0, 0xb9, 0xa1, 0x08, 0x6c, 0x07,
-	0x5f, 0x86, 0x89, 0x65, 0xbc, 0x82, 0xd2, 0x40, 0xa8, 0xf4, 0x03, 0xc5, 0x53, 0x82, 0x9a, 0x58,
-	0xc4, 0x95, 0x84, 0x61, 0x99, 0xc2, 0xf1, 0x6d, 0x11, 0x1a, 0x69, 0x84, 0x71, 0x96, 0xe3, 0x82,
-	0x9e, 0xfe, 0xb9, 0x2a, 0x97, 0x09, 0x8e, 0x34, 0x96, 0x63, 0xee, 0x2c, 0x37, 0x44, 0xb3, 0x98,
-	0x4d, 0xcf, 0x04, 0x65, 0x7a, 0x76, 0xe4, 0xc3, 0xdd, 0x19, 0xe2, 0xa2, 0xd6, 0x4f, 0x4c, 0x55,
-	0x21, 0x1f, 0x1f, 0x69, 0x6c, 0x77, 0xee, 0xdd, 0x64, 0xb2, 0xa6, 0x8e, 0xf9, 0xf1, 0xde, 0x2a,
-	0xff, 0x48, 0x63, 0xc9, 0xf4, 0x87, 0xbb, 0xfb, 0x63, 0x96, 0xb3, 0xf7, 0xe6, 0xe3, 0xf2, 0xde,
-	0xbc, 0x2f, 0x1a, 0xaa, 0xa5, 0x43, 0x71, 0x26, 0x8e, 0xff, 0xd4, 0x01, 0x54, 0x43, 0x13, 0xcb,
-	0x38, 0x4d, 0x4b, 0x17, 0x4d, 0xd5, 0x48, 0x48, 0x52, 0x91, 0x91, 0xc6, 0xd2, 0x12, 0xd7, 0xcf,
-	0x8a, 0x12, 0x8d, 0x33, 0x26, 0x37, 0x1d, 0x1b, 0x69, 0x2c, 0x2b, 0x60, 0x2f, 0x63, 0x71, 0xa2,
-	0x19, 0x3e, 0x55, 0x69, 0xe4, 0x1e, 0x69, 0x2c, 0x96, 0xaf, 0x7e, 0x56, 0xa4, 0x68, 0x6a, 0x07,
-	0x3b, 0xe2, 0x44, 0x17, 0x65, 0x04, 0xed, 0x2c, 0xa7, 0x4e, 0x34, 0xba, 0xe7, 0x99, 0x91, 0xa7,
-	0xf8, 0xca, 0x69, 0xd9, 0x59, 0x4e, 0x77, 0xcc, 0x4a, 0x7e, 0x67, 0x52, 0xc1, 0x68, 0x67, 0xd2,
-	0x2a, 0xd5, 0xcf, 0xea, 0x8c, 0xb9, 0x97, 0xed, 0x3c, 0x1d, 0x93, 0x9d, 0x67, 0x34, 0x69, 0xb0,
-	0xf3, 0x16, 0x99, 0x55, 0x4c, 0xff, 0x64, 0xf7, 0xf5, 0xc1, 0xf0, 0x48, 0x63, 0x3b, 0xef, 0xdd,
-	0x69, 0xfa, 0x33, 0x63, 0xd6, 0xb2, 0xcc, 0x26, 0x11, 0xc9, 0x6c, 0x62, 0xd1, 0xaa, 0x94, 0xa1,
-	0x34, 0xe7, 0x8b, 0xe3, 0x6f, 0xa1, 0xaa, 0x64, 0xc9, 0xd8, 0x87, 0xd2, 0x1b, 0x52, 0x9d, 0x1a,
-	0x93, 0x47, 0xe3, 0x00, 0xca, 0x57, 0xf6, 0x6a, 0xc3, 0x91, 0xfd, 0x1a, 0x8b, 0x0c, 0xd2, 0x81,
-	0xdb, 0x12, 0xd4, 0x62, 0x32, 0xa4, 0xf0, 0x8f, 0xc3, 0x91, 0x33, 0x9f, 0xf3, 0xe8, 0x93, 0x55,
-	0x65, 0xb1, 0x2d, 0xbf, 0x7f, 0xe3, 0x90, 0x79, 0xef, 0xc6, 0x73, 0xac, 0x53, 0x65, 0xca, 0x34,
-	0x9e, 0x40, 0x71, 0x7c, 0x81, 0x3b, 0xa2, 0xb3, 0xe2, 0xf8, 0x22, 0x56, 0x6a, 0x3d, 0x51, 0x6a,
-	0x63, 0x08, 0xa5, 0xf3, 0xd5, 0x12, 0x99, 0x6d, 0x5a, 0xa7, 0x8f, 0x77, 0xed, 0xd7, 0xa9, 0xaf,
-	0xf8, 0xda, 0x16, 0x81, 0xf3, 0xde, 0x0b, 0x9c, 0xa5, 0xe3, 0x2a, 0xc3, 0xe5, 0x3d, 0xff, 0x7a,
-	0xd9, 0x9b, 0x79, 0x6b, 0x3f, 0xe0, 0x61, 0xd8, 0xbd, 0x64, 0xb2, 0x80, 0x71, 0x05, 0xfa, 0xe5,
-	0xd6, 0xe7, 0xc8, 0x71, 0xc3, 0xb2, 0xa4, 0x1e, 0xfd, 0x75, 0xd7, 0xee, 0xff, 0xdf, 0x62, 0xae,
-	0xb0, 0x1d, 0x97, 0x07, 0xbd, 0xe8, 0xbf, 0x42, 0x56, 0x62, 0x58, 0xcf, 0x78, 0x01, 0x7b, 0x17,
-	0x7c, 0x61, 0x6f, 0x56, 0x82, 0x16, 0xa0, 0x19, 0xe9, 0x27, 0x39, 0x99, 0x8a, 0x1a, 0x5f, 0x42,
-	0xf5, 0x67, 0xf7, 0x57, 0x7f, 0x6e, 0x0b, 0x4e, 0x5c, 0x93, 0xd2, 0x2a, 0x2f, 0x8b, 0xe3, 0x72,
-	0x64, 0xa4, 0x4f, 0x48, 0x6b, 0x95, 0x29, 0x53, 0xfe, 0x1c, 0xc4, 0x8b, 0x64, 0x02, 0xc6, 0x12,
-	0x47, 0xfa, 0xc7, 0xa4, 0x9e, 0xf9, 0x31, 0x31, 0x3e, 0x87, 0xe6, 0xf9, 0x46, 0x78, 0x63, 0x77,
-	0x16, 0x70, 0x8c, 0x37, 0x30, 0x37, 0xeb, 0x8c, 0xa8, 0xb5, 0x8e, 0x6e, 0xfe, 0x69, 0x69, 0x1f,
-	0xee, 0x5b, 0x85, 0x9b, 0xfb, 0x56, 0xe1, 0xef, 0xfb, 0x96, 0xf6, 0xdb, 0x43, 0x4b, 0xfb, 0xfd,
-	0xa1, 0x55, 0xb8, 0x79, 0x68, 0x69, 0xb7, 0x0f, 0x2d, 0x6d, 0x5a, 0xc1, 0xbf, 0xa2, 0xaf, 0xff,
-	0x0b, 0x00, 0x00, 0xff, 0xff, 0x6a, 0x0b, 0xa0, 0xb7, 0x67, 0x09, 0x00, 0x00,
+	// 1198 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0x5f, 0xc7, 0xeb, 0x7f, 0xcf, 0x76, 0x13, 0x36, 0xa5, 0x2c, 0x01, 0x39, 0x51, 0x40, 0x34,
+	0xd0, 0xd4, 0xae, 0x42, 0x85, 0x90, 0xa5, 0x02, 0xd9, 0x0d, 0xc1, 0xa6, 0x2a, 0xb1, 0x86, 0x10,
+	0x71, 0xdd, 0xd8, 0xe3, 0xcd, 0x2a, 0xeb, 0x5d, 0x6b, 0x3d, 0x56, 0x6b, 0x3e, 0x05, 0x1f, 0x01,
+	0x8e, 0x5c, 0xf8, 0x1c, 0x3d, 0xe6, 0x58, 0x71, 0x88, 0x20, 0xf9, 0x02, 0x9c, 0x7b, 0x42, 0x33,
+	0xfb, 0x66, 0x77, 0x67, 0xed, 0xa2, 0xde, 0xe6, 0xcd, 0xef, 0xf7, 0x7b, 0xf3, 0xe7, 0xbd, 0x37,
+	0x6f, 0xa0, 0xce, 0x16, 0x53, 0x3a, 0x6b, 0x4f, 0xa3, 0x90, 0x85, 0x46, 0x99, 0x06, 0xae, 0x17,
+	0xd0, 0xad, 0x87, 0xae, 0xc7, 0x2e, 0xe6, 0xe7, 0xed, 0x61, 0x38, 0xe9, 0xb8, 0xa1, 0x1b, 0x76,
+	0x04, 0x7c, 0x3e, 0x1f, 0x0b, 0x4b, 0x18, 0x62, 0x14, 0xcb, 0xb6, 0x60, 0xea, 0x3b, 0x41, 0x3c,
+	0xde, 0xdd, 0x07, 0xb0, 0xc3, 0xc9, 0x84, 0x06, 0xec, 0x88, 0x8e, 0x0d, 0x13, 0x2a, 0x68, 0x99,
+	0x85, 0x9d, 0xc2, 0x5e, 0x8d, 0x48, 0xb3, 0xab, 0xff, 0xfb, 0xfb, 0xb6, 0xc6, 0xd9, 0x67, 0x34,
+	0x9a, 0x79, 0x61, 0x80, 0x6c, 0xb4, 0x04, 0xbb, 0x49, 0xa4, 0x89, 0xec, 0x03, 0x68, 0x0c, 0x9c,
+	0x88, 0x79, 0x0c, 0xf9, 0x1f, 0x42, 0x2d, 0xb1, 0xd1, 0x7f, 0x3a, 0x81, 0x9a, 0x8f, 0xa0, 0x72,
+	0xe6, 0xd1, 0xe7, 0x9c, 0x6e, 0x80, 0xce, 0x87, 0xc8, 0x14, 0x63, 0x24, 0x1d, 0x42, 0xe3, 0x90,
+	0xb1, 0xc8, 0x3b, 0x9f, 0x33, 0xca, 0x99, 0x0f, 0x40, 0xe7, 0xb6, 0x60, 0xd6, 0x0f, 0xde, 0x69,
+	0xc7, 0xd7, 0xd2, 0x4e, 0x38, 0x96, 0xfe, 0xf2, 0x7a, 0x5b, 0x23, 0x82, 0x84, 0x2e, 0x86, 0xd0,
+	0xec, 0x07, 0x23, 0xfa, 0xe2, 0xd4, 0x39, 0xf7, 0x69, 0xbc, 0xb9, 0xe2, 0xe9, 0x62, 0x2a, 0x5c,
+	0x94, 0x2c, 0x78, 0x7d, 0xbd, 0x5d, 0x8e, 0x71, 0xc2, 0xa7, 0x8d, 0x2d, 0xa8, 0xda, 0xa1, 0xff,
+	0x83, 0x33, 0xa1, 0x33, 0x73, 0x6d, 0xa7, 0xb8, 0x57, 0x23, 0x89, 0xcd, 0xf7, 0xc9, 0x07, 0x66,
+	0x31, 0xde, 0x27, 0x1f, 0xe3, 0x22, 0xcf, 0xa0, 0x39, 0x88, 0xc2, 0x29, 0x8d, 0x98, 0x47, 0x67,
+	0x7c, 0x91, 0x2f, 0x00, 0xd2, 0x09, 0xb3, 0xb0, 0x53, 0xdc, 0xab, 0x1f, 0x6c, 0xc8, 0xed, 0x22,
+	0xb2, 0xc0, 0xdd, 0x66, 0x98, 0xe8, 0x6e, 0x0f, 0x1a, 0xb6, 0x3f, 0x9f, 0x31, 0x1a, 0x59, 0x0b,
+	0xbc, 0x20, 0xb1, 0x70, 0x61, 0x69, 0xe1, 0x6f, 0xa0, 0x79, 0x1c, 0x46, 0xd4, 0x73, 0x83, 0xa7,
+	0x54, 0x50, 0x3f, 0x85, 0xd2, 0xf1, 0x25, 0x5d, 0xc8, 0x35, 0x37, 0xdb, 0x22, 0x05, 0x14, 0x0e,
+	0x89, 0x19, 0xe8, 0xc1, 0x82, 0x8d, 0x1f, 0x59, 0x44, 0x9d, 0x89, 0x1d, 0x06, 0x63, 0xcf, 0x15,
+	0xbb, 0xdf, 0x83, 0x0a, 0x5a, 0xe8, 0xe6, 0x4e, 0xec, 0x46, 0x6e, 0x9c, 0x48, 0x18, 0x7d, 0x7c,
+	0x09, 0x55, 0xfb, 0x82, 0x0e, 0x2f, 0xb9, 0xf6, 0x13, 0x28, 0x8b, 0x71, 0x4e, 0x2a, 0x71, 0x82,
+	0x28, 0x2a, 0xbf, 0x86, 0xf5, 0xef, 0x68, 0x40, 0x23, 0x87, 0xd1, 0x91, 0x1d, 0xfa, 0xb1, 0x03,
+	0xdd, 0x0e, 0x7d, 0x29, 0x37, 0x62, 0x79, 0x96, 0x44, 0x04, 0x9e, 0x5c, 0x00, 0x9c, 0x46, 0x9e,
+	0xeb, 0xd2, 0x88, 0x6b, 0xf7, 0xa1, 0x8a, 0x56, 0x7a, 0xe9, 0x42, 0x9f, 0x72, 0x48, 0xc2, 0x40,
+	0x0f, 0xdf, 0xc3, 0xe6, 0x33, 0x87, 0xd1, 0xc8, 0x73, 0x7c, 0xef, 0x17, 0x3a, 0x92, 0x49, 0xf9,
+	0x30, 0x93, 0x94, 0xf5, 0x83, 0xf7, 0x63, 0x37, 0x2b, 0x88, 0x4a, 0xbe, 0x9e, 0xc0, 0x3d, 0x42,
+	0xc7, 0xab, 0xdc, 0x75, 0xa0, 0xc4, 0x87, 0x72, 0x5b, 0xff, 0xe3, 0x2f, 0xe6, 0xa1, 0xc3, 0xaf,
+	0x78, 0x62, 0x79, 0x13, 0x27, 0x5a, 0x60, 0x7c, 0xef, 0x83, 0x3e, 0xb8, 0xa4, 0x0b, 0xdc, 0xd6,
+	0xa6, 0x8c, 0x4b, 0x86, 0x42, 0x04, 0x01, 0xf5, 0x8f, 0x60, 0x83, 0xd0, 0xb1, 0x7d, 0xe1, 0xf9,
+	0xa3, 0xa4, 0x00, 0xee, 0x41, 0x59, 0x8c, 0xe3, 0xbd, 0xe8, 0x04, 0x2d, 0x54, 0x74, 0xa1, 0x2a,
+	0xea, 0x01, 0xf3, 0x40, 0x8c, 0x69, 0x2e, 0x98, 0x92, 0x40, 0x24, 0x8c, 0xda, 0x6f, 0x61, 0xdd,
+	0x0e, 0x83, 0x19, 0x8b, 0x1c, 0x4f, 0x3c, 0x33, 0x03, 0xcb, 0xd8, 0x87, 0xa2, 0xcd, 0xa4, 0xfc,
+	0xae, 0xac, 0x80, 0x94, 0x35, 0xb0, 0xb0, 0x0a, 0x38, 0x4d, 0xb8, 0x29, 0xec, 0xfe, 0x59, 0x82,
+	0x46, 0x96, 0x61, 0x3c, 0xc9, 0x65, 0x39, 0x9e, 0xfe, 0x5d, 0xe9, 0x4e, 0x01, 0x7b, 0x1a, 0xc9,
+	0xd5, 0xc4, 0x93, 0xdc, 0x25, 0x9a, 0x6b, 0xaa, 0x5c, 0x01, 0xb9, 0x5c, 0xbd, 0xf2, 0xe3, 0xe5,
+	0x3b, 0x14, 0x4f, 0x40, 0xfd, 0xc0, 0x94, 0x1e, 0xf2, 0x78, 0x4f, 0x23, 0xcb, 0xf7, 0xde, 0x4e,
+	0x6f, 0xd6, 0xd4, 0x77, 0x0a, 0xd9, 0x17, 0x41, 0xce, 0xf7, 0x34, 0x92, 0xde, 0xfe, 0xf1, 0x72,
+	0x65, 0x9a, 0x25, 0x75, 0xdd, 0x3c, 0xce, 0xd7, 0x5d, 0xaa, 0xe6, 0x76, 0x5a, 0x9d, 0x66, 0x59,
+	0x5d, 0x57, 0xce, 0xf3, 0x75, 0xe5, 0xd8, 0xb0, 0x97, 0x6a, 0xd2, 0xac, 0x08, 0xd9, 0x7b, 0x52,
+	0x96, 0x83, 0x7b, 0x1a, 0x59, 0xaa, 0xe2, 0xc7, 0xd9, 0xba, 0x34, 0xab, 0x42, 0x6f, 0x48, 0x7d,
+	0x8a, 0xf4, 0x34, 0x92, 0xad, 0xdf, 0x93, 0x95, 0xb5, 0x68, 0xd6, 0x84, 0xfc, 0x03, 0x29, 0x5f,
+	0x41, 0xe9, 0x69, 0x64, 0x65, 0x15, 0xff, 0xfc, 0xa6, 0x82, 0x34, 0x41, 0xf8, 0x6c, 0x65, 0x22,
+	0xb8, 0xda, 0xed, 0x1b, 0xf4, 0x71, 0xaa, 0x5a, 0x3a, 0xac, 0x0d, 0xd9, 0xee, 0x1f, 0x3a, 0x80,
+	0x0c, 0xf3, 0xc0, 0xe2, 0x67, 0x4f, 0x5b, 0xad, 0x59, 0x50, 0xcf, 0x9e, 0x22, 0xfc, 0xec, 0xa9,
+	0x65, 0x74, 0xd5, 0x26, 0x8a, 0x49, 0x9a, 0x94, 0x4c, 0x16, 0xeb, 0x69, 0x44, 0x6d, 0xb8, 0x0f,
+	0x92, 0x66, 0x8a, 0x99, 0xb9, 0x2e, 0x65, 0xe9, 0x41, 0x92, 0x76, 0xdb, 0x55, 0x9b, 0xaa, 0xa9,
+	0xab, 0x0b, 0x65, 0x31, 0xbe, 0x50, 0xd6, 0xe6, 0xa5, 0xa4, 0x74, 0x53, 0xb3, 0xa4, 0x96, 0x92,
+	0x02, 0xf2, 0x52, 0x52, 0x26, 0xe2, 0x4a, 0xcc, 0xf4, 0x49, 0xb3, 0xac, 0xca, 0x15, 0x30, 0xae,
+	0xc4, 0xcc, 0x84, 0xd1, 0x55, 0xfb, 0xa2, 0x59, 0x51, 0x77, 0x9e, 0xc5, 0xf8, 0xce, 0xb3, 0x36,
+	0xcf, 0xea, 0xdc, 0xdb, 0x64, 0x56, 0xd5, 0xac, 0xce, 0xc1, 0x3c, 0xab, 0x73, 0x53, 0x3c, 0xb2,
+	0xe9, 0xb7, 0xc8, 0xac, 0xa9, 0x91, 0x4d, 0x11, 0x1e, 0xd9, 0xd4, 0xc2, 0x54, 0x29, 0x41, 0x71,
+	0x44, 0xc7, 0xbc, 0x57, 0xca, 0x36, 0x6a, 0x6c, 0x40, 0xf1, 0x29, 0xbe, 0xe5, 0x35, 0xc2, 0x87,
+	0xc6, 0x5d, 0x28, 0x9d, 0x39, 0xfe, 0x9c, 0x8a, 0xe8, 0xd7, 0x48, 0x6c, 0xe0, 0xeb, 0xfa, 0xaa,
+	0x08, 0xb5, 0x24, 0x18, 0xfc, 0xa3, 0xd2, 0x9f, 0xf5, 0xbc, 0xd1, 0x88, 0xc6, 0x5f, 0xac, 0x2a,
+	0x49, 0x6c, 0xfe, 0x5f, 0xeb, 0xcf, 0x48, 0xf8, 0xbc, 0x3f, 0x12, 0x7e, 0xaa, 0x44, 0x9a, 0xc6,
+	0x1d, 0x58, 0xeb, 0x1f, 0x89, 0x1c, 0xd1, 0xc9, 0x5a, 0xff, 0x28, 0xf9, 0x59, 0xe8, 0xe9, 0xcf,
+	0xc2, 0x38, 0x86, 0xe2, 0xa1, 0xef, 0x8a, 0xc8, 0x36, 0xad, 0xc7, 0xaf, 0xaf, 0xb7, 0x1f, 0x65,
+	0x7e, 0x9d, 0x13, 0x87, 0x45, 0xde, 0x8b, 0x30, 0xf2, 0x5c, 0x2f, 0x90, 0x46, 0x40, 0x3b, 0xd3,
+	0x4b, 0xb7, 0x33, 0x0c, 0x27, 0xd3, 0x88, 0xce, 0x66, 0xed, 0x53, 0xc2, 0x1d, 0x18, 0x67, 0xa0,
+	0x9f, 0x2e, 0xa6, 0x54, 0xc4, 0xb8, 0x61, 0x59, 0xfc, 0x95, 0xff, 0xeb, 0x7a, 0xbb, 0xfb, 0xb6,
+	0xce, 0x02, 0xe6, 0x78, 0x01, 0x8d, 0x3a, 0xf1, 0x3f, 0x98, 0x7b, 0x22, 0xc2, 0x9f, 0x71, 0x1f,
+	0x2a, 0x47, 0x74, 0xec, 0xcc, 0x7d, 0x86, 0x09, 0xd0, 0x8c, 0xbb, 0x12, 0x4e, 0x12, 0x89, 0x1a,
+	0x9f, 0x41, 0xf5, 0x24, 0xf8, 0x69, 0x3a, 0x72, 0x18, 0xc5, 0x58, 0x63, 0xff, 0x92, 0xb3, 0x24,
+	0xc1, 0xf9, 0x95, 0xe1, 0xab, 0x2f, 0xc2, 0x5a, 0x25, 0xd2, 0xe4, 0x9f, 0xd9, 0x24, 0x91, 0xc4,
+	0xab, 0x51, 0x25, 0xe9, 0x44, 0xf6, 0x23, 0x5d, 0x57, 0x3e, 0xd2, 0xc6, 0xc7, 0xd0, 0x3c, 0x9c,
+	0xb3, 0xb0, 0x1f, 0x0c, 0x23, 0x2a, 0xf0, 0x86, 0xd0, 0xaa, 0x93, 0x71, 0x68, 0xad, 0x9d, 0xab,
+	0x7f, 0x5a, 0xda, 0xcb, 0x9b, 0x56, 0xe1, 0xea, 0xa6, 0x55, 0xf8, 0xfb, 0xa6, 0xa5, 0xfd, 0x7a,
+	0xdb, 0xd2, 0x7e, 0xbb, 0x6d, 0x15, 0xae, 0x6e, 0x5b, 0xda, 0xab, 0xdb, 0x96, 0x76, 0x5e, 0x16,
+	0xbf, 0xf8, 0xcf, 0xff, 0x1b, 0x00, 0xa1, 0x0b, 0x4a, 0x0e, 0x17, 0x0c, 0x00, 0x00,
 }
 
 func (m *CommentDef) Marshal() (dAtA []byte, err error) {
@@ -1490,7 +1772,7 @@ func (m *StreamConfigsDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *PrimaryKeyDef) Marshal() (dAtA []byte, err error) {
+func (m *CheckDef) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1500,32 +1782,34 @@ func (m *PrimaryKeyDef) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PrimaryKeyDef) MarshalTo(dAtA []byte) (int, error) {
+func (m *CheckDef) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PrimaryKeyDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CheckDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pkey != nil {
-		{
-			size, err := m.Pkey.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Checks) > 0 {
+		for iNdEx := len(m.Checks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RefChildTableDef) Marshal() (dAtA []byte, err error) {
+func (m *GeneratedColDef) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1535,38 +1819,34 @@ func (m *RefChildTableDef) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RefChildTableDef) MarshalTo(dAtA []byte) (int, error) {
+func (m *GeneratedColDef) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RefChildTableDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GeneratedColDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Tables) > 0 {
-		dAtA4 := make([]byte, len(m.Tables)*10)
-		var j3 int
-		for _, num := range m.Tables {
-			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j3++
+	if len(m.Cols) > 0 {
+		for iNdEx := len(m.Cols) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cols[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
 			}
-			dAtA4[j3] = uint8(num)
-			j3++
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintTypes(dAtA, i, uint64(j3))
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *IndexDef) Marshal() (dAtA []byte, err error) {
+func (m *TriggerDef) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1576,20 +1856,20 @@ func (m *IndexDef) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *IndexDef) MarshalTo(dAtA []byte) (int, error) {
+func (m *TriggerDef) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *IndexDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TriggerDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Indexes) > 0 {
-		for iNdEx := len(m.Indexes) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Triggers) > 0 {
+		for iNdEx := len(m.Triggers) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Indexes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Triggers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1603,7 +1883,7 @@ func (m *IndexDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ConstraintDefPB) Marshal() (dAtA []byte, err error) {
+func (m *MaterializedViewDef) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1613,20 +1893,55 @@ func (m *ConstraintDefPB) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ConstraintDefPB) MarshalTo(dAtA []byte) (int, error) {
+func (m *MaterializedViewDef) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ConstraintDefPB) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MaterializedViewDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Cts) > 0 {
-		for iNdEx := len(m.Cts) - 1; iNdEx >= 0; iNdEx-- {
+	if m.View != nil {
+		{
+			size, err := m.View.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RefMaterializedViewDef) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefMaterializedViewDef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefMaterializedViewDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Views) > 0 {
+		for iNdEx := len(m.Views) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Cts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Views[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1640,7 +1955,7 @@ func (m *ConstraintDefPB) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ConstraintPB) Marshal() (dAtA []byte, err error) {
+func (m *PrimaryKeyDef) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1650,21 +1965,171 @@ func (m *ConstraintPB) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ConstraintPB) MarshalTo(dAtA []byte) (int, error) {
+func (m *PrimaryKeyDef) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ConstraintPB) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PrimaryKeyDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Ct != nil {
+	if m.Pkey != nil {
 		{
-			size := m.Ct.ProtoSize()
-			i -= size
-			if _, err := m.Ct.MarshalTo(dAtA[i:]); err != nil {
+			size, err := m.Pkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RefChildTableDef) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefChildTableDef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefChildTableDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tables) > 0 {
+		dAtA5 := make([]byte, len(m.Tables)*10)
+		var j4 int
+		for _, num := range m.Tables {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintTypes(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexDef) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexDef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Indexes) > 0 {
+		for iNdEx := len(m.Indexes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Indexes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConstraintDefPB) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConstraintDefPB) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConstraintDefPB) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Cts) > 0 {
+		for iNdEx := len(m.Cts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConstraintPB) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConstraintPB) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConstraintPB) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Ct != nil {
+		{
+			size := m.Ct.ProtoSize()
+			i -= size
+			if _, err := m.Ct.MarshalTo(dAtA[i:]); err != nil {
 				return 0, err
 			}
 		}
@@ -1777,6 +2242,111 @@ func (m *ConstraintPB_StreamConfigsDef) MarshalToSizedBuffer(dAtA []byte) (int,
 	}
 	return len(dAtA) - i, nil
 }
+func (m *ConstraintPB_CheckDef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConstraintPB_CheckDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CheckDef != nil {
+		{
+			size, err := m.CheckDef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ConstraintPB_GeneratedColDef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConstraintPB_GeneratedColDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.GeneratedColDef != nil {
+		{
+			size, err := m.GeneratedColDef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ConstraintPB_TriggerDef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConstraintPB_TriggerDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.TriggerDef != nil {
+		{
+			size, err := m.TriggerDef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ConstraintPB_MaterializedViewDef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConstraintPB_MaterializedViewDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MaterializedViewDef != nil {
+		{
+			size, err := m.MaterializedViewDef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ConstraintPB_RefMaterializedViewDef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConstraintPB_RefMaterializedViewDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RefMaterializedViewDef != nil {
+		{
+			size, err := m.RefMaterializedViewDef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	return len(dAtA) - i, nil
+}
 func (m *TableDefPB) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -2319,43 +2889,44 @@ func (m *StreamConfigsDef) ProtoSize() (n int) {
 	return n
 }
 
-func (m *PrimaryKeyDef) ProtoSize() (n int) {
+func (m *CheckDef) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Pkey != nil {
-		l = m.Pkey.ProtoSize()
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Checks) > 0 {
+		for _, e := range m.Checks {
+			l = e.ProtoSize()
+			n += 1 + l + sovTypes(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *RefChildTableDef) ProtoSize() (n int) {
+func (m *GeneratedColDef) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Tables) > 0 {
-		l = 0
-		for _, e := range m.Tables {
-			l += sovTypes(uint64(e))
+	if len(m.Cols) > 0 {
+		for _, e := range m.Cols {
+			l = e.ProtoSize()
+			n += 1 + l + sovTypes(uint64(l))
 		}
-		n += 1 + sovTypes(uint64(l)) + l
 	}
 	return n
 }
 
-func (m *IndexDef) ProtoSize() (n int) {
+func (m *TriggerDef) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Indexes) > 0 {
-		for _, e := range m.Indexes {
+	if len(m.Triggers) > 0 {
+		for _, e := range m.Triggers {
 			l = e.ProtoSize()
 			n += 1 + l + sovTypes(uint64(l))
 		}
@@ -2363,34 +2934,106 @@ func (m *IndexDef) ProtoSize() (n int) {
 	return n
 }
 
-func (m *ConstraintDefPB) ProtoSize() (n int) {
+func (m *MaterializedViewDef) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Cts) > 0 {
-		for _, e := range m.Cts {
-			l = e.ProtoSize()
-			n += 1 + l + sovTypes(uint64(l))
-		}
+	if m.View != nil {
+		l = m.View.ProtoSize()
+		n += 1 + l + sovTypes(uint64(l))
 	}
 	return n
 }
 
-func (m *ConstraintPB) ProtoSize() (n int) {
+func (m *RefMaterializedViewDef) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Ct != nil {
-		n += m.Ct.ProtoSize()
+	if len(m.Views) > 0 {
+		for _, e := range m.Views {
+			l = e.ProtoSize()
+			n += 1 + l + sovTypes(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *ConstraintPB_ForeignKeyDef) ProtoSize() (n int) {
+func (m *PrimaryKeyDef) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pkey != nil {
+		l = m.Pkey.ProtoSize()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *RefChildTableDef) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tables) > 0 {
+		l = 0
+		for _, e := range m.Tables {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *IndexDef) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Indexes) > 0 {
+		for _, e := range m.Indexes {
+			l = e.ProtoSize()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConstraintDefPB) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Cts) > 0 {
+		for _, e := range m.Cts {
+			l = e.ProtoSize()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConstraintPB) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ct != nil {
+		n += m.Ct.ProtoSize()
+	}
+	return n
+}
+
+func (m *ConstraintPB_ForeignKeyDef) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2450,6 +3093,66 @@ func (m *ConstraintPB_StreamConfigsDef) ProtoSize() (n int) {
 	}
 	return n
 }
+func (m *ConstraintPB_CheckDef) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CheckDef != nil {
+		l = m.CheckDef.ProtoSize()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *ConstraintPB_GeneratedColDef) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GeneratedColDef != nil {
+		l = m.GeneratedColDef.ProtoSize()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *ConstraintPB_TriggerDef) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TriggerDef != nil {
+		l = m.TriggerDef.ProtoSize()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *ConstraintPB_MaterializedViewDef) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaterializedViewDef != nil {
+		l = m.MaterializedViewDef.ProtoSize()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *ConstraintPB_RefMaterializedViewDef) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RefMaterializedViewDef != nil {
+		l = m.RefMaterializedViewDef.ProtoSize()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
 func (m *TableDefPB) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -3106,24 +3809,440 @@ func (m *IndexTableDef) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ColNames = append(m.ColNames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PropertiesDef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PropertiesDef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PropertiesDef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Properties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Properties = append(m.Properties, Property{})
+			if err := m.Properties[len(m.Properties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterByDef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterByDef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterByDef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForeignKeyDef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForeignKeyDef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForeignKeyDef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fkeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fkeys = append(m.Fkeys, &plan.ForeignKeyDef{})
+			if err := m.Fkeys[len(m.Fkeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamConfigsDef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamConfigsDef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamConfigsDef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Configs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Configs = append(m.Configs, &plan.Property{})
+			if err := m.Configs[len(m.Configs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckDef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ColNames = append(m.ColNames, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckDef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckDef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checks", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3133,23 +4252,25 @@ func (m *IndexTableDef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Checks = append(m.Checks, &plan.CheckDef{})
+			if err := m.Checks[len(m.Checks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3172,7 +4293,7 @@ func (m *IndexTableDef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PropertiesDef) Unmarshal(dAtA []byte) error {
+func (m *GeneratedColDef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3195,15 +4316,15 @@ func (m *PropertiesDef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PropertiesDef: wiretype end group for non-group")
+			return fmt.Errorf("proto: GeneratedColDef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PropertiesDef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GeneratedColDef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Properties", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cols", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3230,8 +4351,8 @@ func (m *PropertiesDef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Properties = append(m.Properties, Property{})
-			if err := m.Properties[len(m.Properties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Cols = append(m.Cols, &plan.GeneratedCol{})
+			if err := m.Cols[len(m.Cols)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3256,7 +4377,7 @@ func (m *PropertiesDef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterByDef) Unmarshal(dAtA []byte) error {
+func (m *TriggerDef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3279,17 +4400,17 @@ func (m *ClusterByDef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterByDef: wiretype end group for non-group")
+			return fmt.Errorf("proto: TriggerDef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterByDef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TriggerDef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Triggers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3299,23 +4420,25 @@ func (m *ClusterByDef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Triggers = append(m.Triggers, &plan.TriggerDef{})
+			if err := m.Triggers[len(m.Triggers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3338,7 +4461,7 @@ func (m *ClusterByDef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ForeignKeyDef) Unmarshal(dAtA []byte) error {
+func (m *MaterializedViewDef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3361,15 +4484,15 @@ func (m *ForeignKeyDef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ForeignKeyDef: wiretype end group for non-group")
+			return fmt.Errorf("proto: MaterializedViewDef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ForeignKeyDef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MaterializedViewDef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fkeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field View", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3396,8 +4519,10 @@ func (m *ForeignKeyDef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fkeys = append(m.Fkeys, &plan.ForeignKeyDef{})
-			if err := m.Fkeys[len(m.Fkeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.View == nil {
+				m.View = &plan.MaterializedViewDef{}
+			}
+			if err := m.View.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3422,7 +4547,7 @@ func (m *ForeignKeyDef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StreamConfigsDef) Unmarshal(dAtA []byte) error {
+func (m *RefMaterializedViewDef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3445,15 +4570,15 @@ func (m *StreamConfigsDef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StreamConfigsDef: wiretype end group for non-group")
+			return fmt.Errorf("proto: RefMaterializedViewDef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StreamConfigsDef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RefMaterializedViewDef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Configs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Views", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3480,8 +4605,8 @@ func (m *StreamConfigsDef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Configs = append(m.Configs, &plan.Property{})
-			if err := m.Configs[len(m.Configs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Views = append(m.Views, &plan.MaterializedViewDef{})
+			if err := m.Views[len(m.Views)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4090,6 +5215,181 @@ func (m *ConstraintPB) Unmarshal(dAtA []byte) error {
 			}
 			m.Ct = &ConstraintPB_StreamConfigsDef{v}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckDef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CheckDef{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Ct = &ConstraintPB_CheckDef{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GeneratedColDef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &GeneratedColDef{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Ct = &ConstraintPB_GeneratedColDef{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerDef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &TriggerDef{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Ct = &ConstraintPB_TriggerDef{v}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaterializedViewDef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MaterializedViewDef{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Ct = &ConstraintPB_MaterializedViewDef{v}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefMaterializedViewDef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RefMaterializedViewDef{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Ct = &ConstraintPB_RefMaterializedViewDef{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
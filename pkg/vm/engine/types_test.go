@@ -0,0 +1,149 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+func TestConstraintDefMarshalCheckDef(t *testing.T) {
+	def := &ConstraintDef{
+		Cts: []Constraint{
+			&PrimaryKeyDef{Pkey: &plan.PrimaryKeyDef{PkeyColName: "a", Names: []string{"a"}}},
+			&CheckDef{
+				Checks: []*plan.CheckDef{
+					{Name: "t_chk_1", ExprStr: "a > 0", Enforced: true},
+					{Name: "chk_b", ExprStr: "b != 'x'"},
+				},
+			},
+		},
+	}
+	data, err := def.MarshalBinary()
+	assert.NoError(t, err)
+
+	newDef := &ConstraintDef{}
+	assert.NoError(t, newDef.UnmarshalBinary(data))
+	assert.Equal(t, 2, len(newDef.Cts))
+	checkDef, ok := newDef.Cts[1].(*CheckDef)
+	assert.True(t, ok)
+	assert.Equal(t, 2, len(checkDef.Checks))
+	assert.Equal(t, "t_chk_1", checkDef.Checks[0].Name)
+	assert.True(t, checkDef.Checks[0].Enforced)
+	assert.Equal(t, "b != 'x'", checkDef.Checks[1].ExprStr)
+	assert.False(t, checkDef.Checks[1].Enforced)
+
+	pb := checkDef.ToPBVersion()
+	assert.Equal(t, checkDef, pb.FromPBVersion())
+}
+
+func TestConstraintDefMarshalGeneratedColDef(t *testing.T) {
+	def := &ConstraintDef{
+		Cts: []Constraint{
+			&GeneratedColDef{
+				Cols: []*plan.GeneratedCol{
+					{ColName: "c", OriginString: "a + b"},
+					{ColName: "d", OriginString: "a * 2", Stored: true},
+				},
+			},
+		},
+	}
+	data, err := def.MarshalBinary()
+	assert.NoError(t, err)
+
+	newDef := &ConstraintDef{}
+	assert.NoError(t, newDef.UnmarshalBinary(data))
+	assert.Equal(t, 1, len(newDef.Cts))
+	genDef, ok := newDef.Cts[0].(*GeneratedColDef)
+	assert.True(t, ok)
+	assert.Equal(t, 2, len(genDef.Cols))
+	assert.False(t, genDef.Cols[0].Stored)
+	assert.True(t, genDef.Cols[1].Stored)
+
+	cols := []*plan.ColDef{{Name: "a"}, {Name: "c"}, {Name: "d"}}
+	genDef.SetColDefs(cols)
+	assert.Nil(t, cols[0].Generated)
+	assert.Equal(t, "a + b", cols[1].Generated.OriginString)
+	assert.Equal(t, "a * 2", cols[2].Generated.OriginString)
+
+	pb := genDef.ToPBVersion()
+	assert.Equal(t, genDef, pb.FromPBVersion())
+}
+
+func TestConstraintDefMarshalTriggerDef(t *testing.T) {
+	def := &ConstraintDef{
+		Cts: []Constraint{
+			&TriggerDef{
+				Triggers: []*plan.TriggerDef{
+					{Name: "trg_a", Timing: "BEFORE", Event: "INSERT", CreateSql: "create trigger trg_a before insert on t for each row set new.a = 1"},
+					{Name: "trg_b", Timing: "AFTER", Event: "DELETE", Definer: "root@localhost"},
+				},
+			},
+		},
+	}
+	data, err := def.MarshalBinary()
+	assert.NoError(t, err)
+
+	newDef := &ConstraintDef{}
+	assert.NoError(t, newDef.UnmarshalBinary(data))
+	assert.Equal(t, 1, len(newDef.Cts))
+	trgDef, ok := newDef.Cts[0].(*TriggerDef)
+	assert.True(t, ok)
+	assert.Equal(t, 2, len(trgDef.Triggers))
+	assert.Equal(t, "BEFORE", trgDef.Triggers[0].Timing)
+	assert.Equal(t, "root@localhost", trgDef.Triggers[1].Definer)
+
+	pb := trgDef.ToPBVersion()
+	assert.Equal(t, trgDef, pb.FromPBVersion())
+}
+
+func TestConstraintDefMarshalMaterializedViewDef(t *testing.T) {
+	view := &plan.MaterializedViewDef{
+		Database:    "db1",
+		Name:        "mv",
+		Query:       "select a, count(*) as cnt from db1.t group by a",
+		KeyCols:     []string{"a"},
+		ViewKeyCols: []string{"a"},
+		BaseTables:  []*plan.ObjectRef{{SchemaName: "db1", ObjName: "t"}},
+	}
+	def := &ConstraintDef{
+		Cts: []Constraint{
+			&MaterializedViewDef{View: view},
+			&RefMaterializedViewDef{Views: []*plan.MaterializedViewDef{view, {Database: "db1", Name: "mv2"}}},
+		},
+	}
+	data, err := def.MarshalBinary()
+	assert.NoError(t, err)
+
+	newDef := &ConstraintDef{}
+	assert.NoError(t, newDef.UnmarshalBinary(data))
+	assert.Equal(t, 2, len(newDef.Cts))
+	mvDef, ok := newDef.Cts[0].(*MaterializedViewDef)
+	assert.True(t, ok)
+	assert.Equal(t, view.Query, mvDef.View.Query)
+	assert.Equal(t, []string{"a"}, mvDef.View.KeyCols)
+	refDef, ok := newDef.Cts[1].(*RefMaterializedViewDef)
+	assert.True(t, ok)
+	assert.Equal(t, 2, len(refDef.Views))
+	assert.Equal(t, "mv2", refDef.Views[1].Name)
+
+	pb := refDef.ToPBVersion()
+	assert.Equal(t, refDef, pb.FromPBVersion())
+	pb = mvDef.ToPBVersion()
+	assert.Equal(t, mvDef, pb.FromPBVersion())
+}
@@ -8,8 +8,43 @@ savepoint a;
 release savepoint a;
 -- no error
 savepoint b;
--- error
+-- no error
 rollback to savepoint b;
 -- no error
 release savepoint b;
-commit;
\ No newline at end of file
+-- error
+rollback to savepoint b;
+commit;
+
+drop database if exists sp_db;
+create database sp_db;
+use sp_db;
+create table t1(a int primary key, b int);
+insert into t1 values (1, 1), (2, 2);
+
+begin;
+insert into t1 values (3, 3);
+savepoint s1;
+insert into t1 values (4, 4);
+update t1 set b = 10 where a = 1;
+savepoint s2;
+delete from t1 where a = 2;
+select * from t1 order by a;
+rollback to savepoint s2;
+select * from t1 order by a;
+rollback to savepoint s1;
+select * from t1 order by a;
+-- s2 is removed by rollback to s1
+rollback to savepoint s2;
+insert into t1 values (4, 40);
+commit;
+select * from t1 order by a;
+
+begin;
+savepoint s1;
+drop table t1;
+-- error
+rollback to savepoint s1;
+rollback;
+select * from t1 order by a;
+drop database sp_db;
@@ -786,4 +786,20 @@ insert into t1 values (1,2),(2,2);
 prepare s1 from select a from t1 where b = ? order by b limit 1 for update;
 set @b=2;
 execute s1 using @b;
-deallocate prepare s1;
\ No newline at end of file
+deallocate prepare s1;
+
+drop table if exists jobs;
+create table jobs (id int primary key, state int);
+insert into jobs values (1,0),(2,0),(3,0),(4,0),(5,0);
+begin;
+select id from jobs where id in (1, 2) for update;
+-- @session:id=1{
+use select_for_update;
+select id from jobs where state = 0 limit 2 for update skip locked;
+select id from jobs where id = 1 for update nowait;
+select id from jobs where id = 1 for share nowait;
+select id from jobs where id = 1 for update wait 1;
+select id from jobs where id = 5 for share;
+-- @session}
+commit;
+drop table jobs;